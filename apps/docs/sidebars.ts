@@ -23,16 +23,24 @@ const sidebars: SidebarsConfig = {
         "core-concepts/data-model",
         "core-concepts/authentication",
         "core-concepts/webhooks",
+        "core-concepts/event-stream",
         "core-concepts/connectors",
+        "core-concepts/ingest-mappings",
+        "core-concepts/exports",
+        "core-concepts/warehouse-sync",
+        "core-concepts/incremental-sync",
         "core-concepts/ai-enrichment",
         "core-concepts/semantic-search",
+        "core-concepts/graphql",
+        "core-concepts/go-client",
+        "core-concepts/mcp",
       ],
     },
     "api-reference",
     {
       type: "category",
       label: "Reference",
-      items: ["reference/environment-variables", "reference/architecture"],
+      items: ["reference/environment-variables", "reference/errors", "reference/architecture"],
     },
   ],
 };
@@ -0,0 +1,76 @@
+// Command generate-migration writes a versioned migration with the changes between the
+// migration files and the Ent schema. It replays the existing migrations on an empty
+// development database (with pgvector available) to compute the current state:
+//
+//	DEV_DATABASE_URL=postgres://... go run ./cmd/generate-migration add_language_index
+//
+// Run with --hash to only update atlas.sum after editing a migration by hand.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"ariga.io/atlas/sql/migrate"
+	_ "ariga.io/atlas/sql/postgres"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	_ "github.com/lib/pq"
+
+	entmigrate "github.com/formbricks/hub/apps/hub/internal/ent/migrate"
+)
+
+func main() {
+	dirPath := flag.String("dir", "internal/migrations/sql", "Migration directory")
+	devURL := flag.String("dev-url", os.Getenv("DEV_DATABASE_URL"), "Empty PostgreSQL database used to replay the migrations (default $DEV_DATABASE_URL)")
+	hashOnly := flag.Bool("hash", false, "Only update atlas.sum")
+	flag.Parse()
+
+	if err := run(*dirPath, *devURL, *hashOnly, flag.Arg(0)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dirPath, devURL string, hashOnly bool, name string) error {
+	dir, err := migrate.NewLocalDir(dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to open migration directory: %w", err)
+	}
+
+	if hashOnly {
+		sum, err := dir.Checksum()
+		if err != nil {
+			return fmt.Errorf("failed to hash migrations: %w", err)
+		}
+		return migrate.WriteSumFile(dir, sum)
+	}
+
+	if name == "" {
+		return fmt.Errorf("usage: generate-migration [--dev-url url] <name>")
+	}
+	if devURL == "" {
+		return fmt.Errorf("--dev-url or DEV_DATABASE_URL must point to an empty development database")
+	}
+
+	err = schema.Diff(context.Background(), devURL, name, entmigrate.Tables,
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(migrate.DefaultFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+		schema.WithErrNoPlan(true),
+	)
+	if errors.Is(err, migrate.ErrNoPlan) {
+		fmt.Println("The migrations are up to date with the Ent schema")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to generate migration: %w", err)
+	}
+	return nil
+}
@@ -5,12 +5,15 @@ import (
 	"log/slog"
 	"os"
 	"strconv"
+	"time"
 
 	_ "github.com/lib/pq"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/formbricks/hub/apps/hub/internal/api"
 	"github.com/formbricks/hub/apps/hub/internal/config"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/migrations"
 	"github.com/formbricks/hub/apps/hub/internal/queue"
 	"github.com/formbricks/hub/apps/hub/internal/webhook"
 )
@@ -24,9 +27,14 @@ func main() {
 		WebhookUrls:            getEnv("SERVICE_WEBHOOK_URLS", ""),
 		Environment:            getEnv("SERVICE_ENVIRONMENT", "development"),
 		APIKey:                 getEnv("SERVICE_API_KEY", ""),
+		EnrichmentProvider:     getEnv("SERVICE_ENRICHMENT_PROVIDER", "openai"),
+		EmbeddingProvider:      getEnv("SERVICE_EMBEDDING_PROVIDER", "openai"),
 		OpenAIKey:              getEnv("SERVICE_OPEN_AI_KEY", ""),
 		OpenAIEnrichmentModel:  getEnv("SERVICE_OPENAI_ENRICHMENT_MODEL", "gpt-4o-mini"),
 		OpenAIEmbeddingModel:   getEnv("SERVICE_OPENAI_EMBEDDING_MODEL", "text-embedding-3-small"),
+		GeminiKey:              getEnv("SERVICE_GEMINI_KEY", ""),
+		GeminiEnrichmentModel:  getEnv("SERVICE_GEMINI_ENRICHMENT_MODEL", "gemini-2.0-flash"),
+		GeminiEmbeddingModel:   getEnv("SERVICE_GEMINI_EMBEDDING_MODEL", ""),
 		EnrichmentTimeout:      getEnvInt("SERVICE_ENRICHMENT_TIMEOUT", 10),
 		EnrichmentWorkers:      getEnvInt("SERVICE_ENRICHMENT_WORKERS", 3),
 		EnrichmentPollInterval: getEnvInt("SERVICE_ENRICHMENT_POLL_INTERVAL", 1),
@@ -59,11 +67,12 @@ func main() {
 	}
 
 	// Connect to database
-	client, err := ent.Open("postgres", cfg.DatabaseURL)
+	drv, err := sql.Open("postgres", cfg.DatabaseURL)
 	if err != nil {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
+	client := ent.NewClient(ent.Driver(drv))
 	defer func() {
 		if err := client.Close(); err != nil {
 			logger.Error("failed to close database connection", "error", err)
@@ -71,7 +80,12 @@ func main() {
 	}()
 
 	// Run migrations
-	if err := client.Schema.Create(context.Background()); err != nil {
+	migrator, err := migrations.New(drv.DB())
+	if err != nil {
+		logger.Error("invalid migrations", "error", err)
+		os.Exit(1)
+	}
+	if _, err := migrator.Apply(context.Background()); err != nil {
 		logger.Error("failed to run migrations", "error", err)
 		os.Exit(1)
 	}
@@ -83,7 +97,7 @@ func main() {
 	dispatcher := webhook.NewDispatcher(webhookURLs, logger)
 
 	// Create a queue instance for spec generation (routes need it even though spec generation doesn't use it)
-	enrichmentQueue := queue.NewPostgresQueue(client)
+	enrichmentQueue := queue.NewPostgresQueue(client, cfg.JobMaxAttempts, time.Duration(cfg.JobVisibilityTimeout)*time.Second)
 
 	// Generate and export the OpenAPI spec
 	logger.Info("generating OpenAPI specification...")
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/formbricks/hub/apps/hub/internal/analytics"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+// analyticsDestination returns the analytics tool enriched experiences are forwarded to, or
+// nil if analytics forwarding is disabled
+func analyticsDestination(cfg *config.Config) (analytics.Destination, error) {
+	if cfg.AnalyticsForwardProvider != "none" && cfg.AnalyticsForwardAPIKey == "" {
+		return nil, errors.New("SERVICE_ANALYTICS_FORWARD_PROVIDER requires SERVICE_ANALYTICS_FORWARD_API_KEY")
+	}
+	switch cfg.AnalyticsForwardProvider {
+	case "amplitude":
+		return analytics.NewAmplitude(cfg.AnalyticsForwardAPIKey, cfg.AnalyticsForwardRegion), nil
+	case "mixpanel":
+		return analytics.NewMixpanel(cfg.AnalyticsForwardAPIKey, cfg.AnalyticsForwardRegion), nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/connector/appreviews"
+	"github.com/formbricks/hub/apps/hub/internal/connector/appstore"
+	"github.com/formbricks/hub/apps/hub/internal/connector/googleplay"
+)
+
+// appReviewSources returns the App Store apps, once per country, and the Google Play apps
+// whose reviews are fetched
+func appReviewSources(cfg *config.Config) ([]appreviews.Source, error) {
+	appIDs, countries, err := cfg.GetAppStoreApps()
+	if err != nil {
+		return nil, err
+	}
+	packages, err := cfg.GetGooglePlayPackages()
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []appreviews.Source
+	for _, appID := range appIDs {
+		for _, country := range countries {
+			sources = append(sources, appstore.NewSource(appID, country))
+		}
+	}
+	if len(packages) > 0 {
+		key, err := os.ReadFile(cfg.GooglePlayKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SERVICE_GOOGLE_PLAY_KEY_FILE: %w", err)
+		}
+		account, err := googleplay.NewServiceAccount(key, googleplay.Scope)
+		if err != nil {
+			return nil, err
+		}
+		for _, packageName := range packages {
+			sources = append(sources, googleplay.NewSource(packageName, account))
+		}
+	}
+	return sources, nil
+}
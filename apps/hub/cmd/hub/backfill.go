@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/formbricks/hub/apps/hub/internal/backfill"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+// backfillOptions are the flags of the backfill command
+type backfillOptions struct {
+	jobType    string
+	since      string
+	all        bool
+	after      string
+	batchSize  int
+	rate       float64
+	maxPending int
+}
+
+// backfillCommand returns the backfill command, which enqueues AI jobs for existing
+// experiences
+func backfillCommand() *cobra.Command {
+	var opts backfillOptions
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Enqueue enrichment or embedding jobs for existing experiences",
+		Long: "Enqueues low-priority jobs for text experiences that have no enrichment or " +
+			"embedding yet (or all of them with --all), e.g. after configuring an AI provider. " +
+			"Jobs are processed by running Hub workers. Interrupted backfills can be resumed " +
+			"with --after, and experiences that already have a pending job are skipped.",
+		Args: cobra.NoArgs,
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			if err := runBackfill(ctx, cfg, opts); err != nil {
+				stop()
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+	cmd.Flags().StringVar(&opts.jobType, "type", "", "Job type to enqueue: enrichment or embedding (required)")
+	cmd.Flags().StringVar(&opts.since, "since", "", "Only experiences collected since a date (2006-01-02), an RFC 3339 timestamp, or a number of days (30d)")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Include experiences that already have results, e.g. to re-process them with a new provider")
+	cmd.Flags().StringVar(&opts.after, "after", "", "Resume after the experience with this ID, as printed by an interrupted backfill")
+	cmd.Flags().IntVar(&opts.batchSize, "batch-size", 500, "Number of experiences read per batch")
+	cmd.Flags().Float64Var(&opts.rate, "rate", 50, "Maximum jobs enqueued per second (0 for unlimited)")
+	cmd.Flags().IntVar(&opts.maxPending, "max-pending", 1000, "Pause while more jobs of the type are pending, so workers keep up (0 for no limit)")
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
+
+func runBackfill(ctx context.Context, cfg *config.Config, opts backfillOptions) error {
+	jobType := queue.JobType(opts.jobType)
+	switch jobType {
+	case queue.JobTypeEnrichment:
+		if !cfg.IsEnrichmentEnabled() {
+			return fmt.Errorf("enrichment backfills require a configured enrichment provider")
+		}
+	case queue.JobTypeEmbedding:
+		if !cfg.IsEmbeddingEnabled() {
+			return fmt.Errorf("embedding backfills require a configured embedding provider")
+		}
+	default:
+		return fmt.Errorf("--type must be enrichment or embedding")
+	}
+	since, err := backfill.ParseSince(opts.since, time.Now())
+	if err != nil {
+		return err
+	}
+	var after uuid.UUID
+	if opts.after != "" {
+		if after, err = uuid.Parse(opts.after); err != nil {
+			return fmt.Errorf("invalid --after: %w", err)
+		}
+	}
+
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+
+	client := ent.NewClient(ent.Driver(drv))
+	q, err := queue.NewFromConfig(client, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create job queue: %w", err)
+	}
+
+	started := time.Now()
+	progress, err := backfill.Run(ctx, client, q, backfill.Options{
+		JobType:    jobType,
+		Since:      since,
+		All:        opts.all,
+		After:      after,
+		BatchSize:  opts.batchSize,
+		Rate:       opts.rate,
+		MaxPending: opts.maxPending,
+	}, func(p backfill.Progress) {
+		fmt.Printf("%d/%d experiences (%d%%), %d jobs enqueued, last ID %s\n",
+			p.Scanned, p.Total, percent(p.Scanned, p.Total), p.Enqueued, p.Cursor)
+	})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			err = errors.New("backfill interrupted")
+		}
+		if progress.Cursor != uuid.Nil {
+			return fmt.Errorf("%w; resume with --after=%s", err, progress.Cursor)
+		}
+		return err
+	}
+	fmt.Printf("Enqueued %d %s jobs for %d experiences in %s\n",
+		progress.Enqueued, jobType, progress.Scanned, time.Since(started).Round(time.Second))
+	return nil
+}
+
+// percent returns n as a percentage of total, which may have grown since it was counted
+func percent(n, total int) int {
+	if total == 0 {
+		return 100
+	}
+	return min(100, n*100/total)
+}
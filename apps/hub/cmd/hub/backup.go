@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/spf13/cobra"
+
+	"github.com/formbricks/hub/apps/hub/internal/backup"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/dataset"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+// backupStore returns the store of SERVICE_BACKUP_LOCATION
+func backupStore(cfg *config.Config) (backup.Store, error) {
+	return backup.NewStore(cfg.BackupLocation, backup.BucketOptions{
+		Region:          cfg.BackupRegion,
+		Endpoint:        cfg.BackupEndpoint,
+		AccessKeyID:     cfg.BackupAccessKeyID,
+		SecretAccessKey: cfg.BackupSecretAccessKey,
+	})
+}
+
+// backupCommand returns the backup command, which backs up the database to the backup location
+func backupCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up experiences, webhook endpoints and jobs to the backup location",
+		Long: "Writes a consistent snapshot of all experiences, questions, webhook endpoints (with " +
+			"their secrets) and AI jobs to SERVICE_BACKUP_LOCATION, a directory or an s3:// or gs:// " +
+			"URL, encrypted with SERVICE_BACKUP_ENCRYPTION_KEY if set. Hub can keep running. Restore " +
+			"backups with 'hub restore'.",
+		Args: cobra.NoArgs,
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			if err := runBackup(ctx, cfg, output); err != nil {
+				stop()
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "File to write instead of the backup location, or - for standard output")
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List the backups in the backup location, oldest first",
+		Args:  cobra.NoArgs,
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			if err := runBackupList(cmd.Context(), cfg); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+	cmd.AddCommand(list)
+	return cmd
+}
+
+func runBackup(ctx context.Context, cfg *config.Config, output string) error {
+	var store backup.Store
+	if output == "" {
+		var err error
+		if store, err = backupStore(cfg); err != nil {
+			return err
+		}
+	}
+
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+	client := ent.NewClient(ent.Driver(drv))
+
+	var name string
+	var counts dataset.Counts
+	switch output {
+	case "":
+		name, counts, err = backup.Create(ctx, client, store, cfg.BackupEncryptionKey)
+	case "-":
+		name = "standard output"
+		counts, err = backup.Write(ctx, client, os.Stdout, cfg.BackupEncryptionKey)
+	default:
+		name = output
+		var f *os.File
+		f, err = os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to create backup: %w", err)
+		}
+		counts, err = backup.Write(ctx, client, f, cfg.BackupEncryptionKey)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(output)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	// The summary goes to standard error, so it doesn't end up in a piped backup
+	fmt.Fprintf(os.Stderr, "Backed up %d experiences, %d questions, %d webhook endpoints and %d jobs to %s\n",
+		counts.Experiences, counts.Questions, counts.WebhookEndpoints, counts.Jobs, name)
+	if cfg.BackupEncryptionKey == "" {
+		fmt.Fprintln(os.Stderr, "The backup is not encrypted and contains webhook secrets; set SERVICE_BACKUP_ENCRYPTION_KEY to encrypt backups")
+	}
+	return nil
+}
+
+func runBackupList(ctx context.Context, cfg *config.Config) error {
+	store, err := backupStore(cfg)
+	if err != nil {
+		return err
+	}
+	names, err := store.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+// restoreCommand returns the restore command, which loads a backup into the database
+func restoreCommand() *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "restore [backup]",
+		Short: "Restore a backup created with 'hub backup'",
+		Long: "Loads a backup from SERVICE_BACKUP_LOCATION, the newest one if no name is given, or " +
+			"from a file, into the database. Encrypted backups are decrypted with " +
+			"SERVICE_BACKUP_ENCRYPTION_KEY. Records that already exist are skipped, so restore into " +
+			"a new database migrated with 'hub migrate apply'; an interrupted restore can simply be " +
+			"run again. Restored experiences don't trigger webhooks or AI jobs.",
+		Args: cobra.MaximumNArgs(1),
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			if err := runRestore(ctx, cfg, name, file); err != nil {
+				stop()
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "File to restore instead of a backup in the backup location, or - for standard input")
+	return cmd
+}
+
+func runRestore(ctx context.Context, cfg *config.Config, name, file string) error {
+	var r io.Reader
+	switch {
+	case file != "" && name != "":
+		return fmt.Errorf("restore either a backup name or a --file")
+	case file == "-":
+		r = os.Stdin
+	case file != "":
+		f, err := os.Open(file)
+		if err != nil {
+			return fmt.Errorf("failed to open backup: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	default:
+		store, err := backupStore(cfg)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			names, err := store.List(ctx)
+			if err != nil {
+				return err
+			}
+			if len(names) == 0 {
+				return fmt.Errorf("no backups in %s", cfg.BackupLocation)
+			}
+			name = names[len(names)-1]
+		}
+		body, err := store.Open(ctx, name)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = body.Close() }()
+		r = body
+		fmt.Printf("Restoring %s\n", name)
+	}
+
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+
+	counts, err := backup.Restore(ctx, ent.NewClient(ent.Driver(drv)), r, cfg.BackupEncryptionKey, func(c dataset.Counts) {
+		fmt.Printf("%d experiences, %d questions, %d webhook endpoints, %d jobs restored, %d skipped\n",
+			c.Experiences, c.Questions, c.WebhookEndpoints, c.Jobs, c.Skipped)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Restored %d experiences, %d questions, %d webhook endpoints and %d jobs (%d already existed)\n",
+		counts.Experiences, counts.Questions, counts.WebhookEndpoints, counts.Jobs, counts.Skipped)
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/migrations"
+)
+
+// openMigratedDatabase connects to the configured database for subcommands that read or
+// write data, refusing to run against a schema with pending migrations
+func openMigratedDatabase(ctx context.Context, cfg *config.Config) (*sql.Driver, error) {
+	drv, err := sql.Open("postgres", cfg.DatabaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	migrator, err := migrations.New(drv.DB())
+	if err == nil {
+		var pending []migrations.Migration
+		pending, err = migrator.Pending(ctx)
+		if err == nil && len(pending) > 0 {
+			err = fmt.Errorf("the database has %d pending migrations; apply them with 'hub migrate apply'", len(pending))
+		}
+	}
+	if err != nil {
+		_ = drv.Close()
+		return nil, err
+	}
+	return drv, nil
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/spf13/cobra"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/dataset"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+// exportCommand returns the export command, which writes the dataset to a file
+func exportCommand() *cobra.Command {
+	var output string
+	var opts dataset.ExportOptions
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export experiences, webhook endpoints and jobs as compressed JSON Lines",
+		Long: "Writes all experiences (including enrichment and embeddings), questions, webhook " +
+			"endpoints and AI jobs as gzip-compressed JSON Lines, to be loaded into another instance with " +
+			"'hub import'. The export contains webhook secrets, so store it securely.",
+		Args: cobra.NoArgs,
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			if err := runExport(ctx, cfg, output, opts); err != nil {
+				stop()
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "-", "File to write, or - for standard output")
+	cmd.Flags().BoolVar(&opts.WebhookEndpoints, "webhooks", true, "Include webhook endpoints")
+	cmd.Flags().BoolVar(&opts.Jobs, "jobs", true, "Include AI jobs")
+	return cmd
+}
+
+func runExport(ctx context.Context, cfg *config.Config, output string, opts dataset.ExportOptions) error {
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+
+	var w io.Writer = os.Stdout
+	if output != "-" {
+		f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to create export: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		w = f
+	}
+
+	counts, err := dataset.Export(ctx, ent.NewClient(ent.Driver(drv)), w, opts)
+	if err != nil {
+		if output != "-" {
+			_ = os.Remove(output)
+		}
+		return err
+	}
+	// The summary goes to standard error, so it doesn't end up in a piped export
+	fmt.Fprintf(os.Stderr, "Exported %d experiences, %d questions, %d webhook endpoints and %d jobs\n",
+		counts.Experiences, counts.Questions, counts.WebhookEndpoints, counts.Jobs)
+	return nil
+}
+
+// importCommand returns the import command, which loads an export into the database
+func importCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import an export created with 'hub export'",
+		Long: "Creates the experiences, questions, webhook endpoints and jobs of an export, keeping " +
+			"their IDs. Records that already exist are skipped, so an interrupted import can simply be " +
+			"run again. Imported experiences don't trigger webhooks or AI jobs. Use - to read " +
+			"from standard input.",
+		Args: cobra.ExactArgs(1),
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			if err := runImport(ctx, cfg, args[0]); err != nil {
+				stop()
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+}
+
+func runImport(ctx context.Context, cfg *config.Config, input string) error {
+	var r io.Reader = os.Stdin
+	if input != "-" {
+		f, err := os.Open(input)
+		if err != nil {
+			return fmt.Errorf("failed to open export: %w", err)
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+
+	counts, err := dataset.Import(ctx, ent.NewClient(ent.Driver(drv)), r, func(c dataset.Counts) {
+		fmt.Printf("%d experiences, %d questions, %d webhook endpoints, %d jobs imported, %d skipped\n",
+			c.Experiences, c.Questions, c.WebhookEndpoints, c.Jobs, c.Skipped)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Imported %d experiences, %d questions, %d webhook endpoints and %d jobs (%d already existed)\n",
+		counts.Experiences, counts.Questions, counts.WebhookEndpoints, counts.Jobs, counts.Skipped)
+	return nil
+}
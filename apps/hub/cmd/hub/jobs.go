@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/spf13/cobra"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+// cleanupOptions are the flags of the jobs cleanup command
+type cleanupOptions struct {
+	completedDays  int
+	deadLetterDays int
+	reclaim        bool
+	dryRun         bool
+}
+
+// jobsCommand returns the jobs command, which maintains the job queue
+func jobsCommand() *cobra.Command {
+	var opts cleanupOptions
+	cleanup := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Delete old jobs and requeue stuck ones",
+		Long: "Deletes completed and cancelled jobs, optionally purges old dead-letter jobs, and " +
+			"returns processing jobs with an expired lease to the queue. Safe to run from cron " +
+			"while Hub is running; workers reclaim expired jobs on their own as well.",
+		Args: cobra.NoArgs,
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			if err := runJobsCleanup(cmd.Context(), cfg, opts); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+	cleanup.Flags().IntVar(&opts.completedDays, "completed-days", 7, "Delete completed and cancelled jobs processed more than this many days ago (0 keeps them)")
+	cleanup.Flags().IntVar(&opts.deadLetterDays, "dead-letter-days", 0, "Delete dead-letter jobs older than this many days (0 keeps them)")
+	cleanup.Flags().BoolVar(&opts.reclaim, "reclaim", true, "Return processing jobs with an expired lease to the queue")
+	cleanup.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only print what would be changed")
+
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "Maintain the job queue",
+	}
+	cmd.AddCommand(cleanup)
+	return cmd
+}
+
+func runJobsCleanup(ctx context.Context, cfg *config.Config, opts cleanupOptions) error {
+	if cfg.QueueBackend == "sqs" {
+		return fmt.Errorf("jobs cleanup only applies to the postgres queue backend; SQS expires messages itself")
+	}
+	if opts.completedDays < 0 || opts.deadLetterDays < 0 {
+		return fmt.Errorf("--completed-days and --dead-letter-days must not be negative")
+	}
+
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+
+	q := queue.NewPostgresQueue(ent.NewClient(ent.Driver(drv)), cfg.JobMaxAttempts, time.Duration(cfg.JobVisibilityTimeout)*time.Second)
+	verb := "Deleted"
+	if opts.dryRun {
+		verb = "Would delete"
+	}
+
+	prune := func(statuses []string, days int, what string) error {
+		if days == 0 {
+			return nil
+		}
+		before := time.Now().AddDate(0, 0, -days)
+		var count int
+		var err error
+		if opts.dryRun {
+			count, err = q.CountProcessedBefore(ctx, statuses, before)
+		} else {
+			count, err = q.DeleteProcessedBefore(ctx, statuses, before)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s %d %s older than %d days\n", verb, count, what, days)
+		return nil
+	}
+	if err := prune(queue.FinishedStatuses, opts.completedDays, "completed and cancelled jobs"); err != nil {
+		return err
+	}
+	if err := prune(queue.DeadLetterStatuses, opts.deadLetterDays, "dead-letter jobs"); err != nil {
+		return err
+	}
+
+	if opts.reclaim {
+		if opts.dryRun {
+			count, err := q.CountExpired(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Would requeue %d processing jobs with an expired lease\n", count)
+		} else {
+			count, err := q.ReclaimExpired(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Requeued %d processing jobs with an expired lease\n", count)
+		}
+	}
+	return nil
+}
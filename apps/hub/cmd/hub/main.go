@@ -0,0 +1,612 @@
+package main
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/analytics"
+	"github.com/formbricks/hub/apps/hub/internal/anomaly"
+	"github.com/formbricks/hub/apps/hub/internal/api"
+	"github.com/formbricks/hub/apps/hub/internal/backup"
+	"github.com/formbricks/hub/apps/hub/internal/cache"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/connector/appreviews"
+	"github.com/formbricks/hub/apps/hub/internal/embedding"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/errorreport"
+	"github.com/formbricks/hub/apps/hub/internal/migrations"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
+	"github.com/formbricks/hub/apps/hub/internal/warehouse"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+	"github.com/formbricks/hub/apps/hub/internal/worker"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func main() {
+	// Apply the configuration file before Huma CLI reads the environment
+	configPath := config.FilePath(os.Args[1:])
+	if configPath != "" {
+		if err := config.LoadFile(configPath); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	// Create a CLI app with Huma's service configuration
+	var serve bool
+	cli := humacli.New(func(hooks humacli.Hooks, cfg *config.Config) {
+		// Subcommands (e.g. migrate) only need the parsed configuration
+		if !serve {
+			return
+		}
+
+		// Setup logger; the level can be changed by reloading the configuration
+		logLevel := &slog.LevelVar{}
+		logLevel.Set(parseLogLevel(cfg.LogLevel))
+
+		logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+			Level: logLevel,
+		}))
+
+		// Reject invalid body size limits before serving requests
+		if _, err := cfg.GetMaxBodySize(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, err := cfg.GetBodySizeLimits(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, err := cfg.GetListeners(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, err := cfg.GetUnixSocketMode(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, err := cfg.GetDebugAPIKey(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, err := cfg.GetQueryDatabaseURL(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, err := cfg.GetZendeskSubdomain(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, err := cfg.GetMetadataColumns(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, _, err := cfg.GetCSATRange(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+		if _, _, err := cfg.GetRatingRange(); err != nil {
+			logger.Error("invalid configuration", "error", err)
+			os.Exit(1)
+		}
+
+		// Set up tracing before any spans are started
+		sampleRatio, err := cfg.GetTracingSampleRatio()
+		if err != nil {
+			logger.Error("invalid tracing configuration", "error", err)
+			os.Exit(1)
+		}
+		shutdownTracing, err := tracing.Setup(context.Background(), cfg.TracingEndpoint, cfg.TracingServiceName, sampleRatio)
+		if err != nil {
+			logger.Error("failed to set up tracing", "error", err)
+			os.Exit(1)
+		}
+		if cfg.TracingEndpoint != "" {
+			logger.Info("tracing enabled",
+				"endpoint", cfg.TracingEndpoint,
+				"sample_ratio", sampleRatio)
+		}
+
+		// Report panics and 5xx errors of API requests
+		errorReporter, err := errorreport.Setup(errorreport.Options{
+			SentryDSN:    cfg.ErrorReportingSentryDSN,
+			OTLPEndpoint: cfg.ErrorReportingOTLPEndpoint,
+			ServiceName:  cfg.TracingServiceName,
+			Environment:  cfg.ErrorReportingEnvironment,
+		}, logger)
+		if err != nil {
+			logger.Error("invalid error reporting configuration", "error", err)
+			os.Exit(1)
+		}
+		if errorReporter != nil {
+			logger.Info("error reporting enabled",
+				"sentry", cfg.ErrorReportingSentryDSN != "",
+				"otlp_endpoint", cfg.ErrorReportingOTLPEndpoint)
+		}
+
+		// Connect to database
+		drv, err := sql.Open("postgres", cfg.DatabaseURL)
+		if err != nil {
+			logger.Error("failed to connect to database", "error", err)
+			os.Exit(1)
+		}
+
+		// Configure connection pool
+		db := drv.DB()
+		configurePool(db, cfg)
+
+		logger.Info("database connected")
+
+		// Create Ent client with the configured driver, tracing every query
+		client := ent.NewClient(ent.Driver(tracing.Driver(drv)))
+
+		// Apply pending migrations, or refuse to start with an outdated schema
+		migrator, err := migrations.New(db)
+		if err != nil {
+			logger.Error("invalid migrations", "error", err)
+			os.Exit(1)
+		}
+		if cfg.AutoMigrate {
+			applied, err := migrator.Apply(context.Background())
+			if err != nil {
+				logger.Error("failed to run migrations", "error", err)
+				os.Exit(1)
+			}
+			for _, migration := range applied {
+				logger.Info("applied migration",
+					"version", migration.Version,
+					"description", migration.Description,
+					"baseline", migration.Baseline)
+			}
+		} else {
+			pending, err := migrator.Pending(context.Background())
+			if err != nil {
+				logger.Error("failed to check migrations", "error", err)
+				os.Exit(1)
+			}
+			if len(pending) > 0 {
+				logger.Error("the database has pending migrations; apply them with 'hub migrate apply'",
+					"pending", len(pending),
+					"next", pending[0].Version)
+				os.Exit(1)
+			}
+		}
+
+		// Cache responses of read endpoints, invalidated whenever experiences change
+		responseCache, err := cache.NewFromConfig(cfg, logger)
+		if err != nil {
+			logger.Error("failed to create response cache", "error", err)
+			os.Exit(1)
+		}
+		if responseCache != nil {
+			client.ExperienceData.Use(responseCache.Hook())
+			logger.Info("response caching enabled", "backend", cfg.CacheBackend, "ttl_seconds", cfg.CacheTTL)
+		}
+
+		// Create webhook dispatcher for the configured URLs and the endpoints managed via /v1/webhooks
+		webhookURLs := cfg.GetWebhookURLs()
+		dispatcher := webhook.NewDispatcher(webhookURLs, logger)
+		dispatcher.SetEndpointSource(webhook.NewDBEndpoints(client))
+		dispatcher.SetTargetPolicy(webhook.NewTargetPolicy(cfg.GetWebhookAllowedHosts()))
+		if err := dispatcher.RegisterMetrics(prometheus.DefaultRegisterer); err != nil {
+			logger.Error("failed to register webhook metrics", "error", err)
+			os.Exit(1)
+		}
+		dispatcher.SetDeliveryLog(webhook.NewDBDeliveries(client, time.Duration(cfg.WebhookDeliveryRetentionDays)*24*time.Hour))
+		if len(webhookURLs) > 0 {
+			logger.Warn("SERVICE_WEBHOOK_URLS is deprecated; manage webhook endpoints with /v1/webhooks instead", "urls", webhookURLs)
+		}
+
+		// Initialize AI services and workers if configured
+		var enricher *worker.Enricher
+		var enrichmentQueue queue.Queue
+
+		// Check if either enrichment or embedding is enabled
+		if cfg.IsEnrichmentEnabled() || cfg.IsEmbeddingEnabled() {
+			// Create queue (shared by both enrichment and embedding jobs)
+			var err error
+			enrichmentQueue, err = queue.NewFromConfig(client, cfg)
+			if err != nil {
+				logger.Error("failed to create job queue", "error", err)
+				os.Exit(1)
+			}
+			logger.Info("job queue initialized", "backend", cfg.QueueBackend)
+
+			// Start jobs as soon as they are enqueued; workers still poll as a fallback
+			if pgQueue, ok := enrichmentQueue.(*queue.PostgresQueue); ok && cfg.JobNotify {
+				pgQueue.SendNotifications(db)
+				if cfg.RunsWorkers() {
+					if err := pgQueue.Listen(cfg.DatabaseURL, logger); err != nil {
+						logger.Warn("job notifications unavailable, workers will poll", "error", err)
+					}
+				}
+			}
+
+			// Create workers unless this process only serves the API
+			if cfg.RunsWorkers() {
+				// Create enrichment service if configured
+				var enrichmentService *enrichment.Service
+				if cfg.IsEnrichmentEnabled() {
+					var err error
+					enrichmentService, err = enrichment.NewServiceFromConfig(cfg, logger)
+					if err != nil {
+						logger.Error("failed to create enrichment service", "error", err)
+						os.Exit(1)
+					}
+					logger.Info("enrichment service initialized",
+						"provider", enrichmentService.Provider(),
+						"model", enrichmentService.Model(),
+						"fallbacks", len(enrichmentService.Models())-1)
+				}
+
+				// Create embedding service if configured
+				var embeddingService *embedding.Service
+				if cfg.IsEmbeddingEnabled() {
+					embeddingProvider, err := ai.NewEmbeddingProvider(cfg)
+					if err != nil {
+						logger.Error("failed to create embedding provider", "error", err)
+						os.Exit(1)
+					}
+					embeddingService = embedding.NewService(embeddingProvider, cfg.EnrichmentTimeout, logger)
+					logger.Info("embedding service initialized",
+						"provider", embeddingProvider.Name(),
+						"model", embeddingProvider.Model())
+				}
+
+				// Create worker pools: one for both types of jobs, or one per type if embedding
+				// workers are configured
+				pollInterval := time.Duration(cfg.EnrichmentPollInterval) * time.Second
+				enrichmentPool := worker.Pool{
+					Workers:       cfg.EnrichmentWorkers,
+					PollInterval:  pollInterval,
+					MaxWorkers:    cfg.EnrichmentMaxWorkers,
+					JobsPerWorker: cfg.AutoscaleJobsPerWorker,
+				}
+				pools := []worker.Pool{enrichmentPool}
+				if cfg.EmbeddingWorkers > 0 {
+					embeddingPollInterval := pollInterval
+					if cfg.EmbeddingPollInterval > 0 {
+						embeddingPollInterval = time.Duration(cfg.EmbeddingPollInterval) * time.Second
+					}
+					enrichmentPool.JobType = queue.JobTypeEnrichment
+					pools = []worker.Pool{
+						enrichmentPool,
+						{
+							JobType:       queue.JobTypeEmbedding,
+							Workers:       cfg.EmbeddingWorkers,
+							PollInterval:  embeddingPollInterval,
+							MaxWorkers:    cfg.EmbeddingMaxWorkers,
+							JobsPerWorker: cfg.AutoscaleJobsPerWorker,
+						},
+					}
+				}
+				enricher = worker.NewEnricher(
+					enrichmentQueue,
+					enrichmentService,
+					embeddingService,
+					client,
+					dispatcher,
+					pools,
+					float64(cfg.UrgentThreshold)/100,
+					cfg.EnrichmentBatchSize,
+					logger,
+				)
+			}
+		}
+
+		// The background jobs below run on one instance at a time, the one holding their
+		// advisory lock (see package leader)
+
+		// Alert on spikes and drops per source
+		var detector *anomaly.Detector
+		if cfg.AnomalyDetection {
+			detector, err = anomaly.NewDetector(client, db, dispatcher, anomaly.Options{
+				Window:          time.Duration(cfg.AnomalyWindow) * time.Minute,
+				BaselineWindows: cfg.AnomalyBaselineWindows,
+				Threshold:       float64(cfg.AnomalyThreshold),
+				MinResponses:    cfg.AnomalyMinResponses,
+			}, logger)
+			if err != nil {
+				logger.Error("invalid anomaly detection configuration", "error", err)
+				os.Exit(1)
+			}
+		}
+
+		// Fetch App Store and Google Play reviews
+		var reviewFetcher *appreviews.Fetcher
+		reviewSources, err := appReviewSources(cfg)
+		if err != nil {
+			logger.Error("invalid app review configuration", "error", err)
+			os.Exit(1)
+		}
+		if len(reviewSources) > 0 {
+			store := api.NewConnectorStore(cfg, client, dispatcher, logger, enrichmentQueue)
+			reviewFetcher, err = appreviews.NewFetcher(client, db, store, reviewSources, time.Duration(cfg.AppReviewInterval)*time.Minute, logger)
+			if err != nil {
+				logger.Error("invalid app review configuration", "error", err)
+				os.Exit(1)
+			}
+		}
+
+		// Write the scheduled exports of /v1/exports
+		exportScheduler := sink.NewScheduler(client, db, logger)
+
+		// Sync experiences to BigQuery or Snowflake
+		var warehouseSyncer *warehouse.Syncer
+		destination, err := warehouseDestination(cfg)
+		if err != nil {
+			logger.Error("invalid warehouse sync configuration", "error", err)
+			os.Exit(1)
+		}
+		if destination != nil {
+			warehouseSyncer, err = warehouse.NewSyncer(client, db, destination, time.Duration(cfg.WarehouseSyncInterval)*time.Minute, logger)
+			if err != nil {
+				logger.Error("invalid warehouse sync configuration", "error", err)
+				os.Exit(1)
+			}
+			logger.Info("warehouse sync enabled", "stream", destination.Stream())
+		}
+
+		// Append new experiences to a Google Sheet
+		var sheetSyncer *warehouse.Syncer
+		sheet, err := googleSheet(cfg)
+		if err != nil {
+			logger.Error("invalid Google Sheets configuration", "error", err)
+			os.Exit(1)
+		}
+		if sheet != nil {
+			sheetSyncer, err = warehouse.NewSyncer(client, db, sheet, time.Duration(cfg.GoogleSheetsSyncInterval)*time.Minute, logger)
+			if err != nil {
+				logger.Error("invalid Google Sheets configuration", "error", err)
+				os.Exit(1)
+			}
+			logger.Info("Google Sheets export enabled", "stream", sheet.Stream())
+		}
+
+		// Back up the database to SERVICE_BACKUP_LOCATION
+		var backupScheduler *backup.Scheduler
+		if cfg.BackupInterval > 0 {
+			store, err := backupStore(cfg)
+			if err != nil {
+				logger.Error("invalid backup configuration", "error", err)
+				os.Exit(1)
+			}
+			backupScheduler = backup.NewScheduler(client, db, store, cfg.BackupEncryptionKey, time.Duration(cfg.BackupInterval)*time.Hour, cfg.BackupRetention, logger)
+			logger.Info("scheduled backups enabled", "location", cfg.BackupLocation, "interval_hours", cfg.BackupInterval, "encrypted", cfg.BackupEncryptionKey != "")
+		}
+
+		// Forward enriched experiences to Segment and to Amplitude or Mixpanel; they are
+		// dispatched by this process's workers
+		var forwarders []*analytics.Forwarder
+		if cfg.SegmentForwardWriteKey != "" && enricher != nil {
+			forwarders = append(forwarders, analytics.NewForwarder(dispatcher, analytics.NewSegment(cfg.SegmentForwardWriteKey, cfg.SegmentForwardURL), logger))
+			logger.Info("Segment forwarding enabled", "url", cfg.SegmentForwardURL)
+		}
+		forwardDestination, err := analyticsDestination(cfg)
+		if err != nil {
+			logger.Error("invalid analytics forwarding configuration", "error", err)
+			os.Exit(1)
+		}
+		if forwardDestination != nil && enricher != nil {
+			forwarders = append(forwarders, analytics.NewForwarder(dispatcher, forwardDestination, logger))
+			logger.Info("analytics forwarding enabled", "destination", forwardDestination.Name(), "region", cfg.AnalyticsForwardRegion)
+		}
+
+		if cfg.Mode == "worker" && enricher == nil {
+			logger.Error("worker mode requires an enrichment or embedding provider to be configured")
+			os.Exit(1)
+		}
+
+		// Send list, search, and analytics reads to the read replica if configured, so
+		// reporting traffic doesn't slow down ingestion on the primary
+		var replica *ent.Client
+		if cfg.DatabaseReplicaURL != "" && cfg.RunsAPI() {
+			replicaDrv, err := sql.Open("postgres", cfg.DatabaseReplicaURL)
+			if err != nil {
+				logger.Error("failed to connect to read replica", "error", err)
+				os.Exit(1)
+			}
+			configurePool(replicaDrv.DB(), cfg)
+			replica = ent.NewClient(ent.Driver(tracing.Driver(replicaDrv)))
+			logger.Info("read replica connected")
+		}
+
+		// Create server (pass queue for enqueueing jobs) unless this process only runs workers
+		var server *api.Server
+		if cfg.RunsAPI() {
+			server = api.NewServer(cfg, client, replica, dispatcher, enrichmentQueue, responseCache, errorReporter, logger)
+		}
+
+		// Reload runtime-tunable settings from the configuration file on SIGHUP or through the API
+		if configPath != "" {
+			reload := &reloader{
+				path:       configPath,
+				args:       os.Args[1:],
+				cfg:        cfg,
+				logLevel:   logLevel,
+				dispatcher: dispatcher,
+				server:     server,
+				enricher:   enricher,
+				logger:     logger,
+			}
+			if server != nil {
+				server.SetReloadFunc(reload.Reload)
+			}
+			reload.handleSignals()
+		}
+
+		// Tell the CLI how to start the server
+		hooks.OnStart(func() {
+			logger.Info("starting Hub service",
+				"mode", cfg.Mode,
+				"port", cfg.Port,
+				"environment", cfg.Environment,
+				"docs_url", fmt.Sprintf("http://localhost:%d/docs", cfg.Port),
+				"openapi_url", fmt.Sprintf("http://localhost:%d/openapi.json", cfg.Port))
+
+			ctx := context.Background()
+
+			// Re-enrich experiences produced by an older prompt version or model
+			if enricher != nil && cfg.ReenrichStale && cfg.IsEnrichmentEnabled() {
+				go func() {
+					count, err := enricher.EnqueueStale(ctx, cfg.GetEnrichmentModels())
+					if err != nil {
+						logger.Error("failed to enqueue stale enrichments", "error", err)
+						return
+					}
+					logger.Info("enqueued stale enrichments",
+						"count", count,
+						"version", enrichment.PromptVersion)
+				}()
+			}
+
+			if detector != nil {
+				go detector.Run(ctx)
+			}
+			if reviewFetcher != nil {
+				go reviewFetcher.Run(ctx)
+			}
+			for _, forwarder := range forwarders {
+				go forwarder.Run(ctx)
+			}
+			go exportScheduler.Run(ctx)
+			if warehouseSyncer != nil {
+				go warehouseSyncer.Run(ctx)
+			}
+			if sheetSyncer != nil {
+				go sheetSyncer.Run(ctx)
+			}
+			if backupScheduler != nil {
+				go backupScheduler.Run(ctx)
+			}
+
+			// In worker mode, run the workers until Hub is stopped
+			if server == nil {
+				enricher.Start(ctx)
+				return
+			}
+
+			// Start enrichment workers if configured
+			if enricher != nil {
+				go enricher.Start(ctx)
+			}
+
+			// Start HTTP server
+			if err := server.Start(ctx); err != nil {
+				logger.Error("server error", "error", err)
+				os.Exit(1)
+			}
+		})
+
+		// Handle graceful shutdown: stop taking requests first, so nothing enqueues jobs or
+		// dispatches webhooks once the workers and dispatcher are stopped
+		hooks.OnStop(func() {
+			logger.Info("shutting down gracefully...")
+
+			// Drain HTTP requests
+			if server != nil {
+				ctx, cancel := context.WithTimeout(context.Background(), server.ShutdownTimeout())
+				if err := server.Shutdown(ctx); err != nil {
+					logger.Error("HTTP server shutdown error", "error", err)
+				}
+				cancel()
+			}
+
+			// Stop enrichment workers if running
+			if enricher != nil {
+				enricher.Stop(time.Duration(cfg.WorkerShutdownTimeout) * time.Second)
+			}
+
+			// Send the enriched experiences that are still batched
+			for _, forwarder := range forwarders {
+				forwarder.Stop()
+			}
+
+			// Stop checking for anomalies, so another instance takes over
+			if detector != nil {
+				detector.Stop()
+			}
+
+			// Stop fetching app reviews
+			if reviewFetcher != nil {
+				reviewFetcher.Stop()
+			}
+
+			// Stop running exports once the object being written is stored
+			exportScheduler.Stop()
+
+			// Stop syncing the warehouse and the sheet once the batch being written is stored
+			if warehouseSyncer != nil {
+				warehouseSyncer.Stop()
+			}
+			if sheetSyncer != nil {
+				sheetSyncer.Stop()
+			}
+
+			// Stop scheduling backups once the backup being created is stored
+			if backupScheduler != nil {
+				backupScheduler.Stop()
+			}
+
+			// Stop listening for job notifications
+			if pgQueue, ok := enrichmentQueue.(*queue.PostgresQueue); ok {
+				if err := pgQueue.Close(); err != nil {
+					logger.Error("failed to close job notification listener", "error", err)
+				}
+			}
+
+			// Send the webhook deliveries that are still queued
+			if dispatcher != nil {
+				if err := dispatcher.Shutdown(time.Duration(cfg.WebhookShutdownTimeout) * time.Second); err != nil {
+					logger.Error("webhook dispatcher shutdown error", "error", err)
+				}
+			}
+
+			if err := client.Close(); err != nil {
+				logger.Error("failed to close database connection", "error", err)
+			}
+			if replica != nil {
+				if err := replica.Close(); err != nil {
+					logger.Error("failed to close read replica connection", "error", err)
+				}
+			}
+
+			// Export the remaining spans
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				logger.Error("failed to flush traces", "error", err)
+			}
+			if err := errorReporter.Close(ctx); err != nil {
+				logger.Error("failed to flush error reports", "error", err)
+			}
+		})
+	})
+
+	cli.Root().AddCommand(migrateCommand(), seedCommand(), backfillCommand(), exportCommand(), importCommand(), backupCommand(), restoreCommand(), jobsCommand(), mcpCommand())
+
+	// Run the CLI - when passed no commands, it starts the server
+	cmd, _, err := cli.Root().Find(os.Args[1:])
+	serve = err == nil && cmd == cli.Root()
+	cli.Run()
+}
+
+// configurePool applies the connection pool settings to a database
+func configurePool(db *stdsql.DB, cfg *config.Config) {
+	db.SetMaxOpenConns(cfg.DBMaxOpenConns)
+	db.SetMaxIdleConns(cfg.DBMaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(cfg.DBConnMaxLifetime) * time.Minute)
+	db.SetConnMaxIdleTime(time.Duration(cfg.DBConnMaxIdleTime) * time.Minute)
+}
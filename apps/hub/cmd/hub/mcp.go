@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/spf13/cobra"
+
+	"github.com/formbricks/hub/apps/hub/internal/api"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+// mcpCommand returns the mcp command, which serves the MCP server over standard input and
+// output for assistants that start it as a local process
+func mcpCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "mcp",
+		Short: "Serve the MCP tools over standard input and output",
+		Long: "Serves the MCP (Model Context Protocol) tools of the Hub, which search and summarize " +
+			"the feedback, over standard input and output, for AI assistants that start the server " +
+			"as a local process. It reads the database directly, so anyone who can run it has access " +
+			"to all feedback; assistants that connect over the network use /v1/mcp with the API key " +
+			"instead. Logs are written to standard error.",
+		Args: cobra.NoArgs,
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			if err := runMCP(cmd.Context(), cfg); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+}
+
+func runMCP(ctx context.Context, cfg *config.Config) error {
+	// Standard output carries the protocol
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+		Level: parseLogLevel(cfg.LogLevel),
+	}))
+
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+	client := ent.NewClient(ent.Driver(drv))
+
+	reader := client
+	if cfg.DatabaseReplicaURL != "" {
+		replicaDrv, err := sql.Open("postgres", cfg.DatabaseReplicaURL)
+		if err != nil {
+			return fmt.Errorf("failed to connect to read replica: %w", err)
+		}
+		defer func() { _ = replicaDrv.Close() }()
+		reader = ent.NewClient(ent.Driver(replicaDrv))
+	}
+
+	server := api.NewMCPServer(cfg, client, reader, logger)
+	if err := server.Run(ctx, &mcp.StdioTransport{}); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("MCP server failed: %w", err)
+	}
+	return nil
+}
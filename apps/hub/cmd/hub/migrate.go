@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/spf13/cobra"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/migrations"
+)
+
+// migrateCommand returns the migrate command, which manages the versioned database
+// migrations embedded in the binary
+func migrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage database migrations",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "status",
+			Short: "List the migrations and whether they are applied",
+			Args:  cobra.NoArgs,
+			Run: withMigrator(func(ctx context.Context, migrator *migrations.Migrator) error {
+				status, err := migrator.Status(ctx)
+				if err != nil {
+					return err
+				}
+				pending := 0
+				for _, migration := range status {
+					state := "pending"
+					switch {
+					case migration.Applied() && migration.Baseline:
+						state = "baseline " + migration.AppliedAt.Format("2006-01-02 15:04:05")
+					case migration.Applied():
+						state = "applied  " + migration.AppliedAt.Format("2006-01-02 15:04:05")
+					default:
+						pending++
+					}
+					fmt.Printf("%s  %-30s %s\n", migration.Version, migration.Description, state)
+				}
+				fmt.Printf("\n%d migrations, %d pending\n", len(status), pending)
+				return nil
+			}),
+		},
+		&cobra.Command{
+			Use:   "plan",
+			Short: "Print the statements of the pending migrations without applying them",
+			Args:  cobra.NoArgs,
+			Run: withMigrator(func(ctx context.Context, migrator *migrations.Migrator) error {
+				pending, err := migrator.Pending(ctx)
+				if err != nil {
+					return err
+				}
+				if len(pending) == 0 {
+					fmt.Println("The database is up to date")
+					return nil
+				}
+				for _, migration := range pending {
+					fmt.Printf("-- Migration %s %s\n", migration.Version, migration.Description)
+					if migration.Baseline {
+						fmt.Println("-- The schema already exists, so the migration is only recorded as applied")
+						fmt.Println()
+						continue
+					}
+					fmt.Println(strings.Join(migration.Statements, "\n"))
+					fmt.Println()
+				}
+				return nil
+			}),
+		},
+		&cobra.Command{
+			Use:   "apply",
+			Short: "Apply the pending migrations",
+			Args:  cobra.NoArgs,
+			Run: withMigrator(func(ctx context.Context, migrator *migrations.Migrator) error {
+				applied, err := migrator.Apply(ctx)
+				for _, migration := range applied {
+					fmt.Printf("Applied %s %s\n", migration.Version, migration.Description)
+				}
+				if err != nil {
+					return err
+				}
+				if len(applied) == 0 {
+					fmt.Println("The database is up to date")
+				}
+				return nil
+			}),
+		},
+	)
+	return cmd
+}
+
+// withMigrator runs a migrate subcommand against the configured database
+func withMigrator(run func(ctx context.Context, migrator *migrations.Migrator) error) func(*cobra.Command, []string) {
+	return humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+		drv, err := sql.Open("postgres", cfg.DatabaseURL)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "failed to connect to database:", err)
+			os.Exit(1)
+		}
+		defer func() { _ = drv.Close() }()
+
+		migrator, err := migrations.New(drv.DB())
+		if err == nil {
+			err = run(cmd.Context(), migrator)
+		}
+		if err != nil {
+			_ = drv.Close()
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/formbricks/hub/apps/hub/internal/api"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+	"github.com/formbricks/hub/apps/hub/internal/worker"
+)
+
+// enrichmentSettings are the reloadable settings that require a new enrichment service
+var enrichmentSettings = []string{
+	"open_ai_enrichment_model",
+	"gemini_enrichment_model",
+	"custom_enricher_model",
+	"enrichment_fallbacks",
+}
+
+// reloader re-reads the configuration file and applies the runtime-tunable settings to the
+// running components, without interrupting requests or jobs in flight
+type reloader struct {
+	mu   sync.Mutex
+	path string
+	args []string
+	cfg  *config.Config // Configuration applied last
+
+	logLevel   *slog.LevelVar
+	dispatcher *webhook.Dispatcher
+	server     *api.Server      // Nil in worker mode
+	enricher   *worker.Enricher // Nil if this process doesn't run workers
+	logger     *slog.Logger
+}
+
+// Reload applies the changed settings of the configuration file and returns their names.
+// If a setting is invalid, nothing is applied.
+func (r *reloader) Reload(ctx context.Context) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, changed, err := config.Reload(r.path, r.args, r.cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(changed) == 0 {
+		r.logger.Info("configuration reloaded, no settings changed")
+		return nil, nil
+	}
+
+	// Create the new enrichment service first, so a bad model or fallback changes nothing
+	var enrichmentService *enrichment.Service
+	if (r.enricher != nil || r.server != nil) && next.IsEnrichmentEnabled() && changedAny(changed, enrichmentSettings...) {
+		enrichmentService, err = enrichment.NewServiceFromConfig(next, r.logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create enrichment service: %w", err)
+		}
+	}
+
+	if changedAny(changed, "log_level") {
+		r.logLevel.Set(parseLogLevel(next.LogLevel))
+	}
+	if changedAny(changed, "webhook_urls") {
+		r.dispatcher.SetURLs(next.GetWebhookURLs())
+	}
+	if r.server != nil && slices.ContainsFunc(changed, func(name string) bool {
+		return strings.HasPrefix(name, "rate_limit_")
+	}) {
+		r.server.SetRateLimits(next)
+	}
+	if r.server != nil && changedAny(changed, enrichmentSettings...) {
+		r.server.SetEnrichmentConfig(next)
+	}
+	if r.enricher != nil && enrichmentService != nil {
+		r.enricher.SetEnrichmentService(enrichmentService)
+		r.logger.Info("enrichment service updated",
+			"provider", enrichmentService.Provider(),
+			"model", enrichmentService.Model(),
+			"fallbacks", len(enrichmentService.Models())-1)
+	}
+
+	r.cfg = next
+	r.logger.Info("configuration reloaded", "changed", changed)
+	return changed, nil
+}
+
+// handleSignals reloads the configuration whenever the process receives SIGHUP
+func (r *reloader) handleSignals() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	go func() {
+		for range signals {
+			if _, err := r.Reload(context.Background()); err != nil {
+				r.logger.Error("configuration reload failed, keeping the current settings", "error", err)
+			}
+		}
+	}()
+}
+
+// changedAny reports whether one of the settings is among the changed ones
+func changedAny(changed []string, settings ...string) bool {
+	for _, setting := range settings {
+		if slices.Contains(changed, setting) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseLogLevel returns the slog level of a LogLevel setting
+func parseLogLevel(level string) slog.Level {
+	switch level {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	}
+	return slog.LevelInfo
+}
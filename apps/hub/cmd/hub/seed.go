@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humacli"
+	"github.com/spf13/cobra"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/embedding"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/seed"
+)
+
+// seedOptions are the flags of the seed command
+type seedOptions struct {
+	responses  int
+	days       int
+	seed       uint64
+	reset      bool
+	embeddings bool
+}
+
+// seedCommand returns the seed command, which fills the database with demo experiences
+func seedCommand() *cobra.Command {
+	var opts seedOptions
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Fill the database with demo experiences",
+		Long: "Generates realistic survey responses and app reviews in several languages, with " +
+			"sentiment, emotion, topics and urgency already filled in, so search and analytics " +
+			"can be explored without connecting a feedback source. All demo experiences have a " +
+			"source ID starting with \"" + seed.SourceIDPrefix + "\".",
+		Args: cobra.NoArgs,
+		Run: humacli.WithOptions(func(cmd *cobra.Command, args []string, cfg *config.Config) {
+			if err := runSeed(cmd.Context(), cfg, opts); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}),
+	}
+	cmd.Flags().IntVar(&opts.responses, "responses", 200, "Number of survey responses and app reviews to generate")
+	cmd.Flags().IntVar(&opts.days, "days", 90, "Number of days over which the responses are spread")
+	cmd.Flags().Uint64Var(&opts.seed, "seed", 0, "Seed for reproducible data (random if 0)")
+	cmd.Flags().BoolVar(&opts.reset, "reset", false, "Delete previously seeded demo experiences first")
+	cmd.Flags().BoolVar(&opts.embeddings, "embeddings", false, "Enqueue embedding jobs for the text responses (requires an embedding provider)")
+	return cmd
+}
+
+func runSeed(ctx context.Context, cfg *config.Config, opts seedOptions) error {
+	if opts.responses < 1 || opts.days < 1 {
+		return fmt.Errorf("--responses and --days must be at least 1")
+	}
+	if opts.embeddings && !cfg.IsEmbeddingEnabled() {
+		return fmt.Errorf("--embeddings requires a configured embedding provider")
+	}
+	if opts.seed == 0 {
+		opts.seed = uint64(time.Now().UnixNano())
+	}
+
+	drv, err := openMigratedDatabase(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = drv.Close() }()
+
+	client := ent.NewClient(ent.Driver(drv))
+
+	if opts.reset {
+		deleted, err := deleteDemoExperiences(ctx, client)
+		if err != nil {
+			return fmt.Errorf("failed to delete demo experiences: %w", err)
+		}
+		fmt.Printf("Deleted %d demo experiences\n", deleted)
+		if _, err := client.Question.Delete().
+			Where(question.SourceIDHasPrefix(seed.SourceIDPrefix)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete demo questions: %w", err)
+		}
+	}
+
+	experiences := seed.Generate(seed.Options{
+		Responses: opts.responses,
+		Days:      opts.days,
+		Now:       time.Now(),
+		Seed:      opts.seed,
+	})
+	metadataColumns, err := cfg.GetMetadataColumns()
+	if err != nil {
+		return err
+	}
+	created, err := seed.Insert(ctx, client, experiences, metadataColumns)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Created %d experiences from %d responses over %d days (seed %d)\n",
+		len(created), opts.responses, opts.days, opts.seed)
+
+	if !opts.embeddings {
+		return nil
+	}
+	q, err := queue.NewFromConfig(client, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create job queue: %w", err)
+	}
+	enqueued := 0
+	for _, exp := range created {
+		if exp.FieldType != string(models.FieldTypeText) || exp.ValueText == nil || (exp.IsSpam != nil && *exp.IsSpam) {
+			continue
+		}
+		if err := q.EnqueueEmbedding(ctx, exp.ID.String(), embedding.BuildEmbeddingText(exp.FieldLabel, *exp.ValueText), queue.PriorityLow); err != nil {
+			return fmt.Errorf("failed to enqueue embedding job: %w", err)
+		}
+		enqueued++
+	}
+	fmt.Printf("Enqueued %d embedding jobs; they are processed by a running Hub with workers\n", enqueued)
+	return nil
+}
+
+// deleteDemoExperiences deletes the demo experiences and records their deletion, so tools
+// replicating through /v1/sync/changes delete them as well
+func deleteDemoExperiences(ctx context.Context, client *ent.Client) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	ids, err := tx.ExperienceData.Query().
+		Where(experiencedata.SourceIDHasPrefix(seed.SourceIDPrefix)).
+		IDs(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	if _, err := tx.ExperienceData.Delete().Where(experiencedata.SourceIDHasPrefix(seed.SourceIDPrefix)).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	for batch := range slices.Chunk(ids, 1000) {
+		builders := make([]*ent.ExperienceDeletionCreate, len(batch))
+		for i, id := range batch {
+			builders[i] = tx.ExperienceDeletion.Create().SetExperienceID(id)
+		}
+		if err := tx.ExperienceDeletion.CreateBulk(builders...).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return 0, err
+		}
+	}
+	return len(ids), tx.Commit()
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/connector/googleplay"
+	"github.com/formbricks/hub/apps/hub/internal/warehouse"
+)
+
+// warehouseDestination returns the table experiences are synced to, or nil if the warehouse
+// sync is disabled
+func warehouseDestination(cfg *config.Config) (warehouse.Destination, error) {
+	switch cfg.WarehouseProvider {
+	case "bigquery":
+		if cfg.BigQueryProject == "" || cfg.BigQueryDataset == "" || cfg.BigQueryKeyFile == "" {
+			return nil, errors.New("SERVICE_WAREHOUSE_PROVIDER=bigquery requires SERVICE_BIGQUERY_PROJECT, SERVICE_BIGQUERY_DATASET, and SERVICE_BIGQUERY_KEY_FILE")
+		}
+		key, err := os.ReadFile(cfg.BigQueryKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SERVICE_BIGQUERY_KEY_FILE: %w", err)
+		}
+		account, err := googleplay.NewServiceAccount(key, warehouse.BigQueryScope)
+		if err != nil {
+			return nil, err
+		}
+		return warehouse.NewBigQuery(cfg.BigQueryProject, cfg.BigQueryDataset, cfg.WarehouseTable, account), nil
+	case "snowflake":
+		if cfg.SnowflakePrivateKeyFile == "" {
+			return nil, errors.New("SERVICE_WAREHOUSE_PROVIDER=snowflake requires SERVICE_SNOWFLAKE_PRIVATE_KEY_FILE")
+		}
+		key, err := os.ReadFile(cfg.SnowflakePrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SERVICE_SNOWFLAKE_PRIVATE_KEY_FILE: %w", err)
+		}
+		return warehouse.NewSnowflake(warehouse.SnowflakeOptions{
+			Account:    cfg.SnowflakeAccount,
+			User:       cfg.SnowflakeUser,
+			PrivateKey: key,
+			Database:   cfg.SnowflakeDatabase,
+			Schema:     cfg.SnowflakeSchema,
+			Warehouse:  cfg.SnowflakeWarehouse,
+			Role:       cfg.SnowflakeRole,
+			Table:      cfg.WarehouseTable,
+		})
+	default:
+		return nil, nil
+	}
+}
+
+// googleSheet returns the sheet new experiences are appended to, or nil if the Google Sheets
+// export is disabled
+func googleSheet(cfg *config.Config) (*warehouse.GoogleSheet, error) {
+	if cfg.GoogleSheetsSpreadsheetID == "" {
+		return nil, nil
+	}
+	if cfg.GoogleSheetsKeyFile == "" {
+		return nil, errors.New("SERVICE_GOOGLE_SHEETS_SPREADSHEET_ID requires SERVICE_GOOGLE_SHEETS_KEY_FILE")
+	}
+	key, err := os.ReadFile(cfg.GoogleSheetsKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SERVICE_GOOGLE_SHEETS_KEY_FILE: %w", err)
+	}
+	account, err := googleplay.NewServiceAccount(key, warehouse.SheetsScope)
+	if err != nil {
+		return nil, err
+	}
+	sheet, err := warehouse.NewGoogleSheet(cfg.GoogleSheetsSpreadsheetID, cfg.GoogleSheetsSheet, cfg.GetGoogleSheetsColumns(), account)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SERVICE_GOOGLE_SHEETS_COLUMNS: %w", err)
+	}
+	return sheet, nil
+}
@@ -0,0 +1,142 @@
+// Package ai provides provider-agnostic clients for the AI services used by Hub.
+// Chat providers power enrichment (sentiment, topics, etc.) and embedding providers
+// power semantic search. Each provider hides its vendor SDK or REST API behind a small
+// interface so the enrichment and embedding services don't depend on a specific vendor.
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+// Supported provider names
+const (
+	ProviderOpenAI = "openai"
+	ProviderGemini = "gemini"
+	ProviderCustom = "custom" // Team-provided HTTP endpoint, see enrichment.CustomEnricher
+)
+
+// Usage holds the token counts reported for a single request
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// Completion holds the raw text produced by a chat provider
+type Completion struct {
+	Content string
+	Usage   Usage
+}
+
+// Embedding holds the vector produced by an embedding provider
+type Embedding struct {
+	Values []float32
+	Usage  Usage
+}
+
+// ChatProvider generates a completion for a single prompt.
+// Implementations should request deterministic, JSON-formatted output where the API supports it.
+type ChatProvider interface {
+	// Name returns the provider name (e.g., openai, gemini)
+	Name() string
+	// Model returns the model used for completions
+	Model() string
+	// Complete sends the prompt and returns the model's response
+	Complete(ctx context.Context, prompt string) (*Completion, error)
+}
+
+// EmbeddingProvider generates vector embeddings for text
+type EmbeddingProvider interface {
+	// Name returns the provider name (e.g., openai, gemini)
+	Name() string
+	// Model returns the embedding model
+	Model() string
+	// Embed returns an embedding with the requested number of dimensions
+	Embed(ctx context.Context, text string, dimensions int) (*Embedding, error)
+}
+
+// queryKey marks the context of an embedding request for a search query
+type queryKey struct{}
+
+// WithQuery returns a context in which the embedded text is a search query rather than a
+// document that is searched. Providers that embed the two differently, like Gemini, use it
+// to pick the task type.
+func WithQuery(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryKey{}, true)
+}
+
+// isQuery reports whether the text embedded in ctx is a search query
+func isQuery(ctx context.Context) bool {
+	query, _ := ctx.Value(queryKey{}).(bool)
+	return query
+}
+
+// NewChatProviders creates the configured enrichment provider followed by its fallbacks,
+// in the order they should be tried. A custom enrichment provider isn't a chat provider
+// and is skipped; the enrichment service calls it directly.
+func NewChatProviders(cfg *config.Config) ([]ChatProvider, error) {
+	specs := cfg.GetEnrichmentProviders()
+	providers := make([]ChatProvider, 0, len(specs))
+	for _, spec := range specs {
+		if spec.Provider == ProviderCustom {
+			continue
+		}
+		provider, err := NewChatProvider(cfg, spec)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+// NewChatProvider creates a single chat provider for the given provider and model
+func NewChatProvider(cfg *config.Config, spec config.ProviderModel) (ChatProvider, error) {
+	if spec.Model == "" {
+		return nil, fmt.Errorf("no enrichment model configured for provider %s", spec.Provider)
+	}
+
+	switch spec.Provider {
+	case ProviderOpenAI, "":
+		if cfg.OpenAIKey == "" {
+			return nil, fmt.Errorf("enrichment provider %s requires an API key", ProviderOpenAI)
+		}
+		return WithChatTracing(WithChatRateLimit(NewOpenAIChat(cfg.OpenAIKey, spec.Model), sharedLimiter(cfg, ProviderOpenAI))), nil
+	case ProviderGemini:
+		if cfg.GeminiKey == "" {
+			return nil, fmt.Errorf("enrichment provider %s requires an API key", ProviderGemini)
+		}
+		return WithChatTracing(WithChatRateLimit(NewGeminiChat(cfg.GeminiKey, spec.Model), sharedLimiter(cfg, ProviderGemini))), nil
+	default:
+		return nil, fmt.Errorf("unsupported enrichment provider: %s", spec.Provider)
+	}
+}
+
+// NewEmbeddingProvider creates the embedding provider selected in the configuration
+func NewEmbeddingProvider(cfg *config.Config) (EmbeddingProvider, error) {
+	switch cfg.EmbeddingProvider {
+	case ProviderOpenAI, "":
+		return WithEmbeddingTracing(WithEmbeddingRateLimit(NewOpenAIEmbedding(cfg.OpenAIKey, cfg.OpenAIEmbeddingModel), embeddingLimiter(cfg, ProviderOpenAI))), nil
+	case ProviderGemini:
+		return WithEmbeddingTracing(WithEmbeddingRateLimit(NewGeminiEmbedding(cfg.GeminiKey, cfg.GeminiEmbeddingModel), embeddingLimiter(cfg, ProviderGemini))), nil
+	default:
+		return nil, fmt.Errorf("unsupported embedding provider: %s", cfg.EmbeddingProvider)
+	}
+}
+
+// sharedLimiter returns the process-wide rate limiter for a provider, so enrichment,
+// embeddings, and search share one budget
+func sharedLimiter(cfg *config.Config, provider string) *Limiter {
+	return SharedLimiter(provider, cfg.AIRequestsPerMinute, cfg.AITokensPerMinute)
+}
+
+// embeddingLimiter returns the rate limiter for embedding requests to a provider. With a
+// separate embedding budget, enrichment can't use up the budget of embeddings and search.
+func embeddingLimiter(cfg *config.Config, provider string) *Limiter {
+	if cfg.EmbeddingRequestsPerMinute <= 0 && cfg.EmbeddingTokensPerMinute <= 0 {
+		return sharedLimiter(cfg, provider)
+	}
+	return SharedLimiter(provider+"/embedding", cfg.EmbeddingRequestsPerMinute, cfg.EmbeddingTokensPerMinute)
+}
@@ -0,0 +1,206 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// geminiBaseURL is the base URL of the Gemini (Generative Language) REST API
+	geminiBaseURL = "https://generativelanguage.googleapis.com/v1beta"
+	// maxErrorBodySize limits how much of an error response body is included in errors
+	maxErrorBodySize = 1024
+)
+
+// geminiPart is a single content part in a Gemini request or response
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+// geminiContent is a list of parts with an optional role
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+// geminiClient holds the shared HTTP plumbing for Gemini providers
+type geminiClient struct {
+	apiKey     string
+	model      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// post sends a JSON request to a model method (e.g., generateContent) and decodes the response
+func (c *geminiClient) post(ctx context.Context, method string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal gemini request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/models/%s:%s", c.baseURL, url.PathEscape(strings.TrimPrefix(c.model, "models/")), method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create gemini request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-goog-api-key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("gemini api error: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		err := fmt.Errorf("gemini api error: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return &RateLimitError{Provider: ProviderGemini, RetryAfter: ParseRetryAfter(resp.Header), Err: err}
+		}
+		return err
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode gemini response: %w", err)
+	}
+
+	return nil
+}
+
+// GeminiChat is a ChatProvider backed by the Gemini generateContent API
+type GeminiChat struct {
+	geminiClient
+}
+
+// NewGeminiChat creates a new Gemini chat provider
+func NewGeminiChat(apiKey, model string) *GeminiChat {
+	return &GeminiChat{geminiClient{
+		apiKey:     apiKey,
+		model:      model,
+		baseURL:    geminiBaseURL,
+		httpClient: &http.Client{},
+	}}
+}
+
+// Name returns the provider name
+func (p *GeminiChat) Name() string {
+	return ProviderGemini
+}
+
+// Model returns the chat model
+func (p *GeminiChat) Model() string {
+	return p.model
+}
+
+// Complete sends the prompt and requests a JSON response
+func (p *GeminiChat) Complete(ctx context.Context, prompt string) (*Completion, error) {
+	req := map[string]any{
+		"contents": []geminiContent{
+			{Role: "user", Parts: []geminiPart{{Text: prompt}}},
+		},
+		"generationConfig": map[string]any{
+			"temperature":      defaultTemperature,
+			"responseMimeType": "application/json",
+		},
+	}
+
+	var resp struct {
+		Candidates []struct {
+			Content geminiContent `json:"content"`
+		} `json:"candidates"`
+		UsageMetadata struct {
+			PromptTokenCount     int `json:"promptTokenCount"`
+			CandidatesTokenCount int `json:"candidatesTokenCount"`
+		} `json:"usageMetadata"`
+	}
+	if err := p.post(ctx, "generateContent", req, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
+		return nil, fmt.Errorf("no response from gemini")
+	}
+
+	var content strings.Builder
+	for _, part := range resp.Candidates[0].Content.Parts {
+		content.WriteString(part.Text)
+	}
+
+	return &Completion{
+		Content: content.String(),
+		Usage: Usage{
+			PromptTokens:     resp.UsageMetadata.PromptTokenCount,
+			CompletionTokens: resp.UsageMetadata.CandidatesTokenCount,
+		},
+	}, nil
+}
+
+// GeminiEmbedding is an EmbeddingProvider backed by the Gemini embedContent API
+type GeminiEmbedding struct {
+	geminiClient
+}
+
+// NewGeminiEmbedding creates a new Gemini embedding provider
+func NewGeminiEmbedding(apiKey, model string) *GeminiEmbedding {
+	return &GeminiEmbedding{geminiClient{
+		apiKey:     apiKey,
+		model:      model,
+		baseURL:    geminiBaseURL,
+		httpClient: &http.Client{},
+	}}
+}
+
+// Name returns the provider name
+func (p *GeminiEmbedding) Name() string {
+	return ProviderGemini
+}
+
+// Model returns the embedding model
+func (p *GeminiEmbedding) Model() string {
+	return p.model
+}
+
+// Embed generates an embedding vector for the text.
+// Gemini embedding models support reduced output dimensionality, which is used to
+// match the dimensions of the vector column. Experiences are embedded as retrieval
+// documents and search queries, marked with WithQuery, as retrieval queries, which
+// Gemini optimizes to be close to the documents that answer them. The embedContent
+// API doesn't report token usage, so it is estimated from the text length.
+func (p *GeminiEmbedding) Embed(ctx context.Context, text string, dimensions int) (*Embedding, error) {
+	taskType := "RETRIEVAL_DOCUMENT"
+	if isQuery(ctx) {
+		taskType = "RETRIEVAL_QUERY"
+	}
+	req := map[string]any{
+		"content":  geminiContent{Parts: []geminiPart{{Text: text}}},
+		"taskType": taskType,
+	}
+	if dimensions > 0 {
+		req["outputDimensionality"] = dimensions
+	}
+
+	var resp struct {
+		Embedding struct {
+			Values []float32 `json:"values"`
+		} `json:"embedding"`
+	}
+	if err := p.post(ctx, "embedContent", req, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Embedding.Values) == 0 {
+		return nil, fmt.Errorf("no embeddings returned from gemini")
+	}
+
+	return &Embedding{
+		Values: resp.Embedding.Values,
+		Usage:  Usage{PromptTokens: EstimateTokens(text)},
+	}, nil
+}
@@ -0,0 +1,177 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// geminiServer returns a server that checks the API key and path of each request, decodes
+// its body into request, and answers with status and response
+func geminiServer(t *testing.T, path string, request *map[string]any, status int, response string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.EscapedPath() != path {
+			t.Errorf("unexpected request %s %s, want POST %s", r.Method, r.URL.EscapedPath(), path)
+		}
+		if key := r.Header.Get("x-goog-api-key"); key != "g-test" {
+			t.Errorf("x-goog-api-key = %q", key)
+		}
+		if r.URL.Query().Has("key") {
+			t.Error("expected the API key in the header only, not in the URL")
+		}
+		*request = nil
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "7")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGeminiChat(t *testing.T) {
+	var request map[string]any
+	response := `{
+		"candidates": [{"content": {"role": "model", "parts": [{"text": "{\"sentiment\":"}, {"text": "\"positive\"}"}]}}],
+		"usageMetadata": {"promptTokenCount": 120, "candidatesTokenCount": 8}
+	}`
+	server := geminiServer(t, "/models/gemini-2.0-flash:generateContent", &request, http.StatusOK, response)
+
+	chat := NewGeminiChat("g-test", "models/gemini-2.0-flash")
+	chat.baseURL = server.URL
+	completion, err := chat.Complete(context.Background(), "Classify this")
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if completion.Content != `{"sentiment":"positive"}` {
+		t.Errorf("expected the parts to be joined, got %q", completion.Content)
+	}
+	if completion.Usage != (Usage{PromptTokens: 120, CompletionTokens: 8}) {
+		t.Errorf("unexpected usage %+v", completion.Usage)
+	}
+
+	contents, _ := request["contents"].([]any)
+	if len(contents) != 1 || !strings.Contains(mustMarshal(t, contents[0]), `"text":"Classify this"`) || !strings.Contains(mustMarshal(t, contents[0]), `"role":"user"`) {
+		t.Errorf("unexpected contents %v", request["contents"])
+	}
+	generation, _ := request["generationConfig"].(map[string]any)
+	if generation["responseMimeType"] != "application/json" || generation["temperature"] != defaultTemperature {
+		t.Errorf("unexpected generationConfig %v", generation)
+	}
+}
+
+func TestGeminiChatEscapesModel(t *testing.T) {
+	var request map[string]any
+	server := geminiServer(t, "/models/tuned%2Fmy%20model:generateContent", &request, http.StatusOK, `{"candidates": [{"content": {"parts": [{"text": "{}"}]}}]}`)
+
+	chat := NewGeminiChat("g-test", "tuned/my model")
+	chat.baseURL = server.URL
+	if _, err := chat.Complete(context.Background(), "Classify this"); err != nil {
+		t.Errorf("Complete() error = %v", err)
+	}
+}
+
+func TestGeminiChatErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		response  string
+		rateLimit bool
+	}{
+		{name: "no candidates", status: http.StatusOK, response: `{"candidates": [], "promptFeedback": {"blockReason": "SAFETY"}}`},
+		{name: "no parts", status: http.StatusOK, response: `{"candidates": [{"content": {"parts": []}, "finishReason": "MAX_TOKENS"}]}`},
+		{name: "invalid response", status: http.StatusOK, response: `not json`},
+		{name: "server error", status: http.StatusInternalServerError, response: `{"error": {"message": "internal"}}`},
+		{name: "rate limited", status: http.StatusTooManyRequests, response: `{"error": {"status": "RESOURCE_EXHAUSTED"}}`, rateLimit: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request map[string]any
+			server := geminiServer(t, "/models/gemini-2.0-flash:generateContent", &request, tt.status, tt.response)
+
+			chat := NewGeminiChat("g-test", "gemini-2.0-flash")
+			chat.baseURL = server.URL
+			completion, err := chat.Complete(context.Background(), "Classify this")
+			if err == nil {
+				t.Fatalf("expected an error, got %+v", completion)
+			}
+			rlErr, ok := IsRateLimitError(err)
+			if ok != tt.rateLimit {
+				t.Fatalf("IsRateLimitError(%v) = %v, want %v", err, ok, tt.rateLimit)
+			}
+			if ok && (rlErr.Provider != ProviderGemini || rlErr.RetryAfter != 7*time.Second) {
+				t.Errorf("unexpected rate limit error %+v", rlErr)
+			}
+		})
+	}
+}
+
+func TestGeminiEmbedding(t *testing.T) {
+	var request map[string]any
+	server := geminiServer(t, "/models/gemini-embedding-001:embedContent", &request, http.StatusOK, `{"embedding": {"values": [0.25, -0.5, 1]}}`)
+
+	embedder := NewGeminiEmbedding("g-test", "gemini-embedding-001")
+	embedder.baseURL = server.URL
+	embedding, err := embedder.Embed(context.Background(), "The exports keep timing out", 3)
+	if err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if len(embedding.Values) != 3 || embedding.Values[1] != -0.5 {
+		t.Errorf("unexpected values %v", embedding.Values)
+	}
+	if embedding.Usage.PromptTokens != EstimateTokens("The exports keep timing out") {
+		t.Errorf("expected the estimated token count, got %+v", embedding.Usage)
+	}
+	if request["outputDimensionality"] != 3.0 || request["taskType"] != "RETRIEVAL_DOCUMENT" {
+		t.Errorf("unexpected request %v", request)
+	}
+	if !strings.Contains(mustMarshal(t, request["content"]), `"text":"The exports keep timing out"`) {
+		t.Errorf("unexpected content %v", request["content"])
+	}
+
+	// Search queries are embedded for retrieval of the documents, without a reduced size
+	if _, err := embedder.Embed(WithQuery(context.Background()), "slow exports", 0); err != nil {
+		t.Fatalf("Embed() error = %v", err)
+	}
+	if _, ok := request["outputDimensionality"]; ok || request["taskType"] != "RETRIEVAL_QUERY" {
+		t.Errorf("unexpected query request %v", request)
+	}
+}
+
+func TestGeminiEmbeddingErrors(t *testing.T) {
+	var request map[string]any
+	server := geminiServer(t, "/models/gemini-embedding-001:embedContent", &request, http.StatusOK, `{"embedding": {"values": []}}`)
+
+	embedder := NewGeminiEmbedding("g-test", "gemini-embedding-001")
+	embedder.baseURL = server.URL
+	if _, err := embedder.Embed(context.Background(), "text", 3); err == nil {
+		t.Error("expected an error for an empty embedding")
+	}
+
+	limited := geminiServer(t, "/models/gemini-embedding-001:embedContent", &request, http.StatusTooManyRequests, `{}`)
+	embedder.baseURL = limited.URL
+	if _, err := embedder.Embed(context.Background(), "text", 3); err == nil {
+		t.Error("expected an error when rate limited")
+	} else if _, ok := IsRateLimitError(err); !ok {
+		t.Errorf("expected a RateLimitError, got %v", err)
+	}
+}
+
+// mustMarshal returns the JSON encoding of v
+func mustMarshal(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,153 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/option"
+	"github.com/openai/openai-go/v3/shared"
+)
+
+const (
+	// defaultTemperature is the default temperature for OpenAI models that support it
+	defaultTemperature = 0.0
+)
+
+// OpenAIChat is a ChatProvider backed by the OpenAI chat completions API
+type OpenAIChat struct {
+	client openai.Client
+	model  string
+}
+
+// NewOpenAIChat creates a new OpenAI chat provider
+func NewOpenAIChat(apiKey, model string) *OpenAIChat {
+	return &OpenAIChat{
+		client: openai.NewClient(option.WithAPIKey(apiKey)),
+		model:  model,
+	}
+}
+
+// Name returns the provider name
+func (p *OpenAIChat) Name() string {
+	return ProviderOpenAI
+}
+
+// Model returns the chat model
+func (p *OpenAIChat) Model() string {
+	return p.model
+}
+
+// Complete sends the prompt as a single user message
+func (p *OpenAIChat) Complete(ctx context.Context, prompt string) (*Completion, error) {
+	params := openai.ChatCompletionNewParams{
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			{
+				OfUser: &openai.ChatCompletionUserMessageParam{
+					Content: openai.ChatCompletionUserMessageParamContentUnion{
+						OfString: openai.String(prompt),
+					},
+				},
+			},
+		},
+		Model: shared.ChatModel(p.model),
+	}
+
+	// Only set temperature for models that support it (gpt-5-mini requires default temperature=1)
+	if p.model != "gpt-5-mini" {
+		params.Temperature = openai.Float(defaultTemperature)
+	}
+
+	resp, err := p.client.Chat.Completions.New(ctx, params)
+	if err != nil {
+		return nil, wrapOpenAIError(err, "openai api error")
+	}
+
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("no response from openai")
+	}
+
+	return &Completion{
+		Content: resp.Choices[0].Message.Content,
+		Usage: Usage{
+			PromptTokens:     int(resp.Usage.PromptTokens),
+			CompletionTokens: int(resp.Usage.CompletionTokens),
+		},
+	}, nil
+}
+
+// OpenAIEmbedding is an EmbeddingProvider backed by the OpenAI embeddings API
+type OpenAIEmbedding struct {
+	client openai.Client
+	model  string
+}
+
+// NewOpenAIEmbedding creates a new OpenAI embedding provider
+func NewOpenAIEmbedding(apiKey, model string) *OpenAIEmbedding {
+	return &OpenAIEmbedding{
+		client: openai.NewClient(option.WithAPIKey(apiKey)),
+		model:  model,
+	}
+}
+
+// Name returns the provider name
+func (p *OpenAIEmbedding) Name() string {
+	return ProviderOpenAI
+}
+
+// Model returns the embedding model
+func (p *OpenAIEmbedding) Model() string {
+	return p.model
+}
+
+// Embed generates an embedding vector for the text
+func (p *OpenAIEmbedding) Embed(ctx context.Context, text string, dimensions int) (*Embedding, error) {
+	params := openai.EmbeddingNewParams{
+		Input: openai.EmbeddingNewParamsInputUnion{
+			OfArrayOfStrings: []string{text},
+		},
+		Model: p.model,
+	}
+
+	// Only the text-embedding-3 family supports shortening embeddings
+	if strings.HasPrefix(p.model, "text-embedding-3") && dimensions > 0 {
+		params.Dimensions = openai.Int(int64(dimensions))
+	}
+
+	resp, err := p.client.Embeddings.New(ctx, params)
+	if err != nil {
+		return nil, wrapOpenAIError(err, "openai embeddings api error")
+	}
+
+	if len(resp.Data) == 0 {
+		return nil, fmt.Errorf("no embeddings returned from openai")
+	}
+
+	// Convert float64 slice to float32 for pgvector
+	embeddingData := resp.Data[0].Embedding
+	float32Slice := make([]float32, len(embeddingData))
+	for i, v := range embeddingData {
+		float32Slice[i] = float32(v)
+	}
+
+	return &Embedding{
+		Values: float32Slice,
+		Usage:  Usage{PromptTokens: int(resp.Usage.PromptTokens)},
+	}, nil
+}
+
+// wrapOpenAIError converts HTTP 429 responses into a RateLimitError and wraps other errors
+func wrapOpenAIError(err error, msg string) error {
+	var apiErr *openai.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests {
+		var header http.Header
+		if apiErr.Response != nil {
+			header = apiErr.Response.Header
+		}
+		return &RateLimitError{Provider: ProviderOpenAI, RetryAfter: ParseRetryAfter(header), Err: err}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
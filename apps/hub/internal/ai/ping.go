@@ -0,0 +1,83 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/option"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+// Providers returns the AI providers used by the configuration: the enrichment provider
+// and its fallbacks if enrichment is enabled, and the embedding provider if embeddings
+// are enabled. A custom enrichment provider isn't included.
+func Providers(cfg *config.Config) []string {
+	var providers []string
+	add := func(provider string) {
+		if provider == "" {
+			provider = ProviderOpenAI
+		}
+		if provider != ProviderCustom && !slices.Contains(providers, provider) {
+			providers = append(providers, provider)
+		}
+	}
+	if cfg.IsEnrichmentEnabled() {
+		for _, spec := range cfg.GetEnrichmentProviders() {
+			add(spec.Provider)
+		}
+	}
+	if cfg.IsEmbeddingEnabled() {
+		add(cfg.EmbeddingProvider)
+	}
+	return providers
+}
+
+// Ping checks that a provider is reachable and accepts the configured API key. It lists
+// the available models, which uses no tokens, and bypasses the rate limiters.
+func Ping(ctx context.Context, cfg *config.Config, provider string) error {
+	switch provider {
+	case ProviderOpenAI, "":
+		return pingOpenAI(ctx, cfg.OpenAIKey)
+	case ProviderGemini:
+		return pingGemini(ctx, http.DefaultClient, geminiBaseURL, cfg.GeminiKey)
+	default:
+		return fmt.Errorf("unsupported provider: %s", provider)
+	}
+}
+
+// pingOpenAI requests the first page of the model list without retries
+func pingOpenAI(ctx context.Context, apiKey string, opts ...option.RequestOption) error {
+	opts = append([]option.RequestOption{option.WithAPIKey(apiKey), option.WithMaxRetries(0)}, opts...)
+	client := openai.NewClient(opts...)
+	if _, err := client.Models.List(ctx); err != nil {
+		return wrapOpenAIError(err, "openai api error")
+	}
+	return nil
+}
+
+// pingGemini requests a single model of the model list
+func pingGemini(ctx context.Context, httpClient *http.Client, baseURL, apiKey string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/models?pageSize=1", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create gemini request: %w", err)
+	}
+	req.Header.Set("x-goog-api-key", apiKey)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("gemini api error: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("gemini api error: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
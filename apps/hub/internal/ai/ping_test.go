@@ -0,0 +1,86 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/openai/openai-go/v3/option"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+func TestProviders(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Config
+		want []string
+	}{
+		{name: "no AI", cfg: config.Config{EnrichmentProvider: "openai", EmbeddingProvider: "openai"}, want: nil},
+		{
+			name: "openai for both",
+			cfg:  config.Config{EnrichmentProvider: "openai", EmbeddingProvider: "openai", OpenAIKey: "sk", OpenAIEnrichmentModel: "gpt-4o-mini", OpenAIEmbeddingModel: "text-embedding-3-small"},
+			want: []string{"openai"},
+		},
+		{
+			name: "gemini fallback",
+			cfg:  config.Config{EnrichmentProvider: "openai", EnrichmentFallbacks: "gemini", EmbeddingProvider: "gemini", OpenAIKey: "sk", OpenAIEnrichmentModel: "gpt-4o-mini", GeminiKey: "g", GeminiEnrichmentModel: "gemini-2.0-flash", GeminiEmbeddingModel: "gemini-embedding-001"},
+			want: []string{"openai", "gemini"},
+		},
+		{
+			name: "custom enrichment",
+			cfg:  config.Config{EnrichmentProvider: "custom", CustomEnricherURL: "http://enricher", CustomEnricherModel: "custom", EmbeddingProvider: "gemini", GeminiKey: "g", GeminiEmbeddingModel: "gemini-embedding-001"},
+			want: []string{"gemini"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Providers(&tt.cfg); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPingOpenAI(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/models" || r.Header.Get("Authorization") != "Bearer sk-test" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"object":"list","data":[]}`))
+	}))
+	defer server.Close()
+
+	if err := pingOpenAI(context.Background(), "sk-test", option.WithBaseURL(server.URL)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	status = http.StatusUnauthorized
+	if err := pingOpenAI(context.Background(), "sk-test", option.WithBaseURL(server.URL)); err == nil {
+		t.Error("expected an error for a rejected API key")
+	}
+}
+
+func TestPingGemini(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/models" || r.Header.Get("x-goog-api-key") != "g-test" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"models":[]}`))
+	}))
+	defer server.Close()
+
+	if err := pingGemini(context.Background(), server.Client(), server.URL, "g-test"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	status = http.StatusForbidden
+	if err := pingGemini(context.Background(), server.Client(), server.URL, "g-test"); err == nil {
+		t.Error("expected an error for a rejected API key")
+	}
+}
@@ -0,0 +1,73 @@
+package ai
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// modelPrice is the list price of a model in USD per million tokens
+type modelPrice struct {
+	input  float64
+	output float64
+}
+
+// modelPrices holds list prices for known models. Dated model versions
+// (e.g., gpt-4o-mini-2024-07-18) match by prefix.
+var modelPrices = map[string]modelPrice{
+	// OpenAI chat models
+	"gpt-4o-mini":  {input: 0.15, output: 0.60},
+	"gpt-4o":       {input: 2.50, output: 10.00},
+	"gpt-4.1-nano": {input: 0.10, output: 0.40},
+	"gpt-4.1-mini": {input: 0.40, output: 1.60},
+	"gpt-4.1":      {input: 2.00, output: 8.00},
+	"gpt-5-nano":   {input: 0.05, output: 0.40},
+	"gpt-5-mini":   {input: 0.25, output: 2.00},
+	"gpt-5":        {input: 1.25, output: 10.00},
+	// OpenAI embedding models
+	"text-embedding-3-small": {input: 0.02},
+	"text-embedding-3-large": {input: 0.13},
+	"text-embedding-ada-002": {input: 0.10},
+	// Gemini chat models
+	"gemini-2.0-flash-lite": {input: 0.075, output: 0.30},
+	"gemini-2.0-flash":      {input: 0.10, output: 0.40},
+	"gemini-2.5-flash-lite": {input: 0.10, output: 0.40},
+	"gemini-2.5-flash":      {input: 0.30, output: 2.50},
+	"gemini-2.5-pro":        {input: 1.25, output: 10.00},
+	// Gemini embedding models
+	"gemini-embedding-001": {input: 0.15},
+}
+
+// EstimateCost returns the estimated cost in USD of a request to the given model.
+// Unknown models are reported as zero cost.
+func EstimateCost(model string, usage Usage) float64 {
+	price, ok := lookupPrice(model)
+	if !ok {
+		return 0
+	}
+	return (float64(usage.PromptTokens)*price.input + float64(usage.CompletionTokens)*price.output) / 1_000_000
+}
+
+// lookupPrice finds the price of a model by exact name or longest matching prefix
+func lookupPrice(model string) (modelPrice, bool) {
+	model = strings.TrimPrefix(model, "models/")
+	if price, ok := modelPrices[model]; ok {
+		return price, true
+	}
+
+	best := ""
+	for name := range modelPrices {
+		if strings.HasPrefix(model, name) && len(name) > len(best) {
+			best = name
+		}
+	}
+	if best == "" {
+		return modelPrice{}, false
+	}
+	return modelPrices[best], true
+}
+
+// EstimateTokens approximates the token count of text (about 4 characters per token)
+// for APIs that don't report usage
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
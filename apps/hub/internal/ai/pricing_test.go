@@ -0,0 +1,31 @@
+package ai
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name  string
+		model string
+		usage Usage
+		want  float64
+	}{
+		{"chat model", "gpt-4o-mini", Usage{PromptTokens: 1_000_000, CompletionTokens: 1_000_000}, 0.75},
+		{"dated model version", "gpt-4o-mini-2024-07-18", Usage{PromptTokens: 1_000_000}, 0.15},
+		{"longest prefix wins", "gpt-4o-2024-08-06", Usage{PromptTokens: 1_000_000}, 2.50},
+		{"embedding model", "text-embedding-3-small", Usage{PromptTokens: 500_000}, 0.01},
+		{"gemini resource name", "models/gemini-2.0-flash", Usage{PromptTokens: 1_000_000, CompletionTokens: 1_000_000}, 0.50},
+		{"unknown model", "my-local-model", Usage{PromptTokens: 1_000_000}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EstimateCost(tt.model, tt.usage)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("EstimateCost(%q) = %v, want %v", tt.model, got, tt.want)
+			}
+		})
+	}
+}
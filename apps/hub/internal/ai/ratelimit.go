@@ -0,0 +1,237 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxRateLimitRetries is how often a rate-limited request is retried before giving up
+	maxRateLimitRetries = 3
+	// defaultRateLimitBackoff is the first backoff when the provider sends no Retry-After
+	defaultRateLimitBackoff = time.Second
+	// maxRateLimitBackoff caps a single backoff, including provider-supplied Retry-After values
+	maxRateLimitBackoff = time.Minute
+)
+
+// RateLimitError is returned when a provider rejects a request with HTTP 429
+type RateLimitError struct {
+	Provider   string
+	RetryAfter time.Duration // zero if the provider didn't say
+	Err        error
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s rate limit exceeded: %v", e.Provider, e.Err)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// IsRateLimitError reports whether err is (or wraps) a RateLimitError and returns it
+func IsRateLimitError(err error) (*RateLimitError, bool) {
+	var rlErr *RateLimitError
+	if errors.As(err, &rlErr) {
+		return rlErr, true
+	}
+	return nil, false
+}
+
+// ParseRetryAfter reads the Retry-After (seconds or HTTP date) or OpenAI's retry-after-ms header
+func ParseRetryAfter(header http.Header) time.Duration {
+	if header == nil {
+		return 0
+	}
+	if ms, err := strconv.ParseFloat(header.Get("retry-after-ms"), 64); err == nil && ms > 0 {
+		return time.Duration(ms * float64(time.Millisecond))
+	}
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
+
+// Limiter enforces a client-side requests and tokens per minute budget for one provider.
+// It is shared by all chat and embedding clients of that provider, and pauses all of them
+// when the provider reports a rate limit.
+type Limiter struct {
+	requests *rate.Limiter // nil when unlimited
+	tokens   *rate.Limiter // nil when unlimited
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// NewLimiter creates a limiter; a budget of zero or less means unlimited. The buckets hold a
+// full minute's budget, like the providers' own per-minute windows, so a request may use more
+// than a second's worth of tokens and is admitted once that many have accrued.
+func NewLimiter(requestsPerMinute, tokensPerMinute int) *Limiter {
+	l := &Limiter{}
+	if requestsPerMinute > 0 {
+		l.requests = rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60), requestsPerMinute)
+	}
+	if tokensPerMinute > 0 {
+		l.tokens = rate.NewLimiter(rate.Limit(float64(tokensPerMinute)/60), tokensPerMinute)
+	}
+	return l
+}
+
+// Wait blocks until the budget allows a request using the estimated number of tokens,
+// or until a pause requested by the provider is over. A request estimated at more tokens
+// than the whole per-minute budget can never be admitted and fails right away.
+func (l *Limiter) Wait(ctx context.Context, tokens int) error {
+	if l.tokens != nil && tokens > l.tokens.Burst() {
+		return fmt.Errorf("request of about %d tokens exceeds the budget of %d tokens per minute", tokens, l.tokens.Burst())
+	}
+	if err := l.waitForPause(ctx); err != nil {
+		return err
+	}
+	if l.requests != nil {
+		if err := l.requests.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	if l.tokens != nil && tokens > 0 {
+		if err := l.tokens.WaitN(ctx, tokens); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pause stops all requests through this limiter for the given duration
+func (l *Limiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// waitForPause blocks until the limiter is no longer paused. If the pause outlasts the
+// context deadline, it returns a RateLimitError right away instead of waiting.
+func (l *Limiter) waitForPause(ctx context.Context) error {
+	l.mu.Lock()
+	remaining := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+	if remaining <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(remaining).After(deadline) {
+		return &RateLimitError{RetryAfter: remaining, Err: errors.New("client-side backoff in progress")}
+	}
+
+	timer := time.NewTimer(remaining)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// withRateLimitRetry runs call within the limiter's budget. On a rate limit error it pauses
+// the limiter for the provider's Retry-After (or an exponential backoff) and retries.
+func withRateLimitRetry(ctx context.Context, l *Limiter, provider string, tokens int, call func() error) error {
+	backoff := defaultRateLimitBackoff
+	for attempt := 0; ; attempt++ {
+		if err := l.Wait(ctx, tokens); err != nil {
+			if rlErr, ok := IsRateLimitError(err); ok {
+				rlErr.Provider = provider
+			}
+			return err
+		}
+
+		err := call()
+		rlErr, ok := IsRateLimitError(err)
+		if !ok || attempt >= maxRateLimitRetries {
+			return err
+		}
+
+		wait := rlErr.RetryAfter
+		if wait <= 0 {
+			wait = backoff
+			backoff *= 2
+		}
+		l.Pause(min(wait, maxRateLimitBackoff))
+	}
+}
+
+// limiters holds one shared limiter per provider
+var (
+	limitersMu sync.Mutex
+	limiters   = map[string]*Limiter{}
+)
+
+// SharedLimiter returns the limiter for a provider, creating it with the given budget on first use
+func SharedLimiter(provider string, requestsPerMinute, tokensPerMinute int) *Limiter {
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	if l, ok := limiters[provider]; ok {
+		return l
+	}
+	l := NewLimiter(requestsPerMinute, tokensPerMinute)
+	limiters[provider] = l
+	return l
+}
+
+// rateLimitedChat wraps a ChatProvider with a shared Limiter
+type rateLimitedChat struct {
+	ChatProvider
+	limiter *Limiter
+}
+
+// Complete waits for budget, then completes the prompt, retrying on rate limits
+func (p *rateLimitedChat) Complete(ctx context.Context, prompt string) (*Completion, error) {
+	var completion *Completion
+	err := withRateLimitRetry(ctx, p.limiter, p.Name(), EstimateTokens(prompt), func() error {
+		var err error
+		completion, err = p.ChatProvider.Complete(ctx, prompt)
+		return err
+	})
+	return completion, err
+}
+
+// rateLimitedEmbedding wraps an EmbeddingProvider with a shared Limiter
+type rateLimitedEmbedding struct {
+	EmbeddingProvider
+	limiter *Limiter
+}
+
+// Embed waits for budget, then embeds the text, retrying on rate limits
+func (p *rateLimitedEmbedding) Embed(ctx context.Context, text string, dimensions int) (*Embedding, error) {
+	var embedding *Embedding
+	err := withRateLimitRetry(ctx, p.limiter, p.Name(), EstimateTokens(text), func() error {
+		var err error
+		embedding, err = p.EmbeddingProvider.Embed(ctx, text, dimensions)
+		return err
+	})
+	return embedding, err
+}
+
+// WithChatRateLimit wraps a chat provider so its requests go through the limiter
+func WithChatRateLimit(provider ChatProvider, limiter *Limiter) ChatProvider {
+	return &rateLimitedChat{ChatProvider: provider, limiter: limiter}
+}
+
+// WithEmbeddingRateLimit wraps an embedding provider so its requests go through the limiter
+func WithEmbeddingRateLimit(provider EmbeddingProvider, limiter *Limiter) EmbeddingProvider {
+	return &rateLimitedEmbedding{EmbeddingProvider: provider, limiter: limiter}
+}
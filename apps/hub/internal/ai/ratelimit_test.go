@@ -0,0 +1,113 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+	}{
+		{"seconds", http.Header{"Retry-After": []string{"2"}}, 2 * time.Second},
+		{"milliseconds take precedence", http.Header{"Retry-After": []string{"2"}, "Retry-After-Ms": []string{"150"}}, 150 * time.Millisecond},
+		{"missing", http.Header{}, 0},
+		{"invalid", http.Header{"Retry-After": []string{"soon"}}, 0},
+		{"nil header", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseRetryAfter(tt.header); got != tt.want {
+				t.Errorf("ParseRetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithRateLimitRetry(t *testing.T) {
+	t.Run("retries rate limited requests", func(t *testing.T) {
+		limiter := NewLimiter(0, 0)
+		calls := 0
+		err := withRateLimitRetry(context.Background(), limiter, ProviderOpenAI, 10, func() error {
+			calls++
+			if calls < 3 {
+				return &RateLimitError{RetryAfter: time.Millisecond, Err: errors.New("429")}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		limiter := NewLimiter(0, 0)
+		calls := 0
+		err := withRateLimitRetry(context.Background(), limiter, ProviderOpenAI, 10, func() error {
+			calls++
+			return errors.New("bad request")
+		})
+		if err == nil || calls != 1 {
+			t.Errorf("expected a single failed call, got %d calls and error %v", calls, err)
+		}
+	})
+
+	t.Run("gives up when the pause outlasts the deadline", func(t *testing.T) {
+		limiter := NewLimiter(0, 0)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := withRateLimitRetry(ctx, limiter, ProviderGemini, 10, func() error {
+			return &RateLimitError{RetryAfter: 10 * time.Second, Err: errors.New("429")}
+		})
+		rlErr, ok := IsRateLimitError(err)
+		if !ok {
+			t.Fatalf("expected rate limit error, got %v", err)
+		}
+		if rlErr.Provider != ProviderGemini {
+			t.Errorf("expected provider gemini, got %q", rlErr.Provider)
+		}
+	})
+}
+
+func TestLimiterTokens(t *testing.T) {
+	// 600 tokens per minute accrue 10 tokens per second
+	limiter := NewLimiter(0, 600)
+	if err := limiter.Wait(context.Background(), 590); err != nil {
+		t.Fatalf("expected the first request to use the minute's budget, got %v", err)
+	}
+
+	// 50 tokens are more than a second's budget and must wait for them to accrue
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := limiter.Wait(ctx, 50); err == nil {
+		t.Error("expected a request beyond the remaining budget to be throttled")
+	}
+
+	if err := limiter.Wait(context.Background(), 601); err == nil {
+		t.Error("expected an error for a request larger than the per-minute budget")
+	}
+}
+
+func TestLimiterRequests(t *testing.T) {
+	limiter := NewLimiter(120, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	for i := range 120 {
+		if err := limiter.Wait(ctx, 0); err != nil {
+			t.Fatalf("expected request %d of the minute's budget to be admitted, got %v", i+1, err)
+		}
+	}
+	if err := limiter.Wait(ctx, 0); err == nil {
+		t.Error("expected the request beyond the minute's budget to be throttled")
+	}
+}
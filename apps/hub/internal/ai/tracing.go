@@ -0,0 +1,78 @@
+package ai
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
+)
+
+// tracedChat wraps a ChatProvider so each completion, including rate limit waits and
+// retries, runs in a span
+type tracedChat struct {
+	ChatProvider
+}
+
+// Complete completes the prompt in a span
+func (p *tracedChat) Complete(ctx context.Context, prompt string) (*Completion, error) {
+	ctx, span := startAISpan(ctx, "chat", p.Name(), p.Model())
+	defer span.End()
+
+	completion, err := p.ChatProvider.Complete(ctx, prompt)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	setUsage(span, completion.Usage)
+	return completion, nil
+}
+
+// tracedEmbedding wraps an EmbeddingProvider so each embedding request runs in a span
+type tracedEmbedding struct {
+	EmbeddingProvider
+}
+
+// Embed embeds the text in a span
+func (p *tracedEmbedding) Embed(ctx context.Context, text string, dimensions int) (*Embedding, error) {
+	ctx, span := startAISpan(ctx, "embeddings", p.Name(), p.Model())
+	defer span.End()
+
+	embedding, err := p.EmbeddingProvider.Embed(ctx, text, dimensions)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	setUsage(span, embedding.Usage)
+	return embedding, nil
+}
+
+// WithChatTracing wraps a chat provider so its completions are traced
+func WithChatTracing(provider ChatProvider) ChatProvider {
+	return &tracedChat{ChatProvider: provider}
+}
+
+// WithEmbeddingTracing wraps an embedding provider so its requests are traced
+func WithEmbeddingTracing(provider EmbeddingProvider) EmbeddingProvider {
+	return &tracedEmbedding{EmbeddingProvider: provider}
+}
+
+// startAISpan starts a span for a provider request, named like "chat gpt-4o-mini"
+func startAISpan(ctx context.Context, operation, provider, model string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, operation+" "+model,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("gen_ai.operation.name", operation),
+			attribute.String("gen_ai.system", provider),
+			attribute.String("gen_ai.request.model", model),
+		))
+}
+
+// setUsage records the token usage reported by the provider
+func setUsage(span trace.Span, usage Usage) {
+	span.SetAttributes(
+		attribute.Int("gen_ai.usage.input_tokens", usage.PromptTokens),
+		attribute.Int("gen_ai.usage.output_tokens", usage.CompletionTokens),
+	)
+}
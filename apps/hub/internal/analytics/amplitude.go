@@ -0,0 +1,78 @@
+package analytics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+// Amplitude HTTP V2 API endpoints by data region
+var amplitudeURLs = map[string]string{
+	"us": "https://api2.amplitude.com/2/httpapi",
+	"eu": "https://api.eu.amplitude.com/2/httpapi",
+}
+
+// AmplitudeEvent is an event of the HTTP V2 API
+type AmplitudeEvent struct {
+	EventType       string         `json:"event_type"`
+	UserID          string         `json:"user_id,omitempty"`
+	DeviceID        string         `json:"device_id,omitempty"`
+	Time            int64          `json:"time"`
+	InsertID        string         `json:"insert_id"`
+	EventProperties map[string]any `json:"event_properties"`
+}
+
+// Amplitude sends events to an Amplitude project through its HTTP V2 API
+type Amplitude struct {
+	apiKey string
+	url    string
+	client *http.Client
+}
+
+// NewAmplitude returns a destination that sends events to the project of the API key, in
+// the data region us or eu
+func NewAmplitude(apiKey, region string) *Amplitude {
+	url, ok := amplitudeURLs[region]
+	if !ok {
+		url = amplitudeURLs["us"]
+	}
+	return &Amplitude{apiKey: apiKey, url: url, client: &http.Client{Timeout: forwardTimeout}}
+}
+
+// Name names Amplitude in logs
+func (a *Amplitude) Name() string {
+	return "amplitude"
+}
+
+// Send sends experiences as events of their user. Experiences without a user_identifier
+// are sent with their ID as device_id, since Amplitude requires one of them.
+func (a *Amplitude) Send(ctx context.Context, experiences []models.Experience) error {
+	events := make([]AmplitudeEvent, len(experiences))
+	for i := range experiences {
+		events[i] = ToAmplitudeEvent(&experiences[i])
+	}
+	body := map[string]any{
+		"api_key": a.apiKey,
+		"events":  events,
+		// User identifiers of Hub may be shorter than the 5 characters Amplitude expects
+		"options": map[string]any{"min_id_length": 1},
+	}
+	return post(ctx, a.client, a.url, body, nil)
+}
+
+// ToAmplitudeEvent maps an enriched experience to a Feedback Enriched event. The experience
+// ID is the insert_id, so Amplitude only counts an experience once.
+func ToAmplitudeEvent(exp *models.Experience) AmplitudeEvent {
+	event := AmplitudeEvent{
+		EventType:       EnrichedEvent,
+		UserID:          userID(exp),
+		Time:            exp.CollectedAt.UnixMilli(),
+		InsertID:        "hub-" + exp.ID.String(),
+		EventProperties: Properties(exp),
+	}
+	if event.UserID == "" {
+		event.DeviceID = exp.ID.String()
+	}
+	return event
+}
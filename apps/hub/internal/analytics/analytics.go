@@ -0,0 +1,238 @@
+// Package analytics forwards enriched experiences to product analytics tools as events, so
+// feedback can be correlated with what its users do in the product. Segment, Amplitude, and
+// Mixpanel are supported.
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// EnrichedEvent is the name of the events sent for enriched experiences
+const EnrichedEvent = "Feedback Enriched"
+
+const (
+	// forwardBufferSize is the number of enriched experiences buffered while a batch is sent
+	forwardBufferSize = 1000
+	// forwardBatchSize is the most events sent in a batch
+	forwardBatchSize = 100
+	// forwardInterval is how long events wait for a batch to fill up
+	forwardInterval = 5 * time.Second
+	// forwardTimeout is how long a batch may take to send
+	forwardTimeout = 10 * time.Second
+)
+
+// Destination is an analytics tool that events are sent to
+type Destination interface {
+	// Name names the tool in logs
+	Name() string
+	// Send sends a batch of enriched experiences as events
+	Send(ctx context.Context, experiences []models.Experience) error
+}
+
+// Forwarder sends the enriched experiences of this instance's workers to a destination in
+// batches. Batches that fail are logged and dropped; events are deduplicated by the
+// experience ID, so experiences that are enriched again are only counted once.
+type Forwarder struct {
+	dispatcher  *webhook.Dispatcher
+	destination Destination
+	logger      *slog.Logger
+
+	subscription *webhook.Subscription
+	stopChan     chan struct{}
+	stopOnce     sync.Once
+	completed    chan struct{}
+}
+
+// NewForwarder returns a forwarder that sends the experience.enriched events of the
+// dispatcher to destination. It subscribes right away, so no event is missed before Run is
+// called.
+func NewForwarder(dispatcher *webhook.Dispatcher, destination Destination, logger *slog.Logger) *Forwarder {
+	return &Forwarder{
+		dispatcher:   dispatcher,
+		destination:  destination,
+		logger:       logger,
+		subscription: dispatcher.Subscribe(forwardBufferSize),
+		stopChan:     make(chan struct{}),
+		completed:    make(chan struct{}),
+	}
+}
+
+// Run forwards enriched experiences until ctx is canceled or Stop is called. Events that
+// are still buffered or batched then are sent before Run returns.
+func (f *Forwarder) Run(ctx context.Context) {
+	defer close(f.completed)
+	defer func() { f.subscription.Close() }()
+
+	ticker := time.NewTicker(forwardInterval)
+	defer ticker.Stop()
+
+	var batch []models.Experience
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := f.destination.Send(context.WithoutCancel(ctx), batch); err != nil {
+			f.logger.Error("failed to forward experiences", "destination", f.destination.Name(), "events", len(batch), "error", err)
+		}
+		batch = nil
+	}
+	add := func(message webhook.Message) {
+		if message.EventType != webhook.EventExperienceEnriched {
+			return
+		}
+		var event struct {
+			Data models.Experience `json:"data"`
+		}
+		if err := json.Unmarshal(message.Payload, &event); err != nil {
+			f.logger.Warn("failed to decode enriched experience", "error", err)
+			return
+		}
+		batch = append(batch, event.Data)
+		if len(batch) >= forwardBatchSize {
+			flush()
+		}
+	}
+	drain := func() {
+		for {
+			select {
+			case message, ok := <-f.subscription.Messages():
+				if !ok {
+					flush()
+					return
+				}
+				add(message)
+			default:
+				flush()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			drain()
+			return
+		case <-f.stopChan:
+			drain()
+			return
+		case <-ticker.C:
+			flush()
+		case message, ok := <-f.subscription.Messages():
+			if !ok {
+				// Fell behind; the events in between are lost, later ones are forwarded
+				f.logger.Warn("analytics forwarder fell behind, resubscribing", "destination", f.destination.Name())
+				f.subscription = f.dispatcher.Subscribe(forwardBufferSize)
+				continue
+			}
+			add(message)
+		}
+	}
+}
+
+// Stop stops forwarding and waits for the last batch to be sent
+func (f *Forwarder) Stop() {
+	f.stopOnce.Do(func() { close(f.stopChan) })
+	<-f.completed
+}
+
+// Properties returns the properties of the event of an enriched experience. Fields the
+// experience doesn't have are left out, and the value of an NPS question is also its
+// nps_score, so scores can be charted apart from other numbers.
+func Properties(exp *models.Experience) map[string]any {
+	properties := map[string]any{
+		"experience_id": exp.ID.String(),
+		"source_type":   exp.SourceType,
+		"field_id":      exp.FieldID,
+		"field_type":    exp.FieldType,
+	}
+
+	optional := map[string]any{
+		"source_id":       exp.SourceID,
+		"source_name":     exp.SourceName,
+		"field_label":     exp.FieldLabel,
+		"value_text":      exp.ValueText,
+		"value_number":    exp.ValueNumber,
+		"value_boolean":   exp.ValueBoolean,
+		"nps_category":    exp.NPSCategory,
+		"language":        exp.Language,
+		"sentiment":       exp.Sentiment,
+		"sentiment_score": exp.SentimentScore,
+		"emotion":         exp.Emotion,
+		"is_spam":         exp.IsSpam,
+		"urgency_score":   exp.UrgencyScore,
+	}
+	for name, value := range optional {
+		switch value := value.(type) {
+		case *string:
+			if value != nil {
+				properties[name] = *value
+			}
+		case *float64:
+			if value != nil {
+				properties[name] = *value
+			}
+		case *bool:
+			if value != nil {
+				properties[name] = *value
+			}
+		}
+	}
+	if exp.FieldType == string(models.FieldTypeNPS) && exp.ValueNumber != nil {
+		properties["nps_score"] = *exp.ValueNumber
+	}
+	if len(exp.Topics) > 0 {
+		properties["topics"] = exp.Topics
+	}
+	if len(exp.UrgencyReasons) > 0 {
+		properties["urgency_reasons"] = exp.UrgencyReasons
+	}
+	return properties
+}
+
+// userID returns the user identifier of an experience, or "" if it has none
+func userID(exp *models.Experience) string {
+	if exp.UserIdentifier == nil {
+		return ""
+	}
+	return *exp.UserIdentifier
+}
+
+// post sends body as JSON to target, with authenticate setting the credentials of the request
+func post(ctx context.Context, client *http.Client, target string, body any, authenticate func(*http.Request)) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authenticate != nil {
+		authenticate(req)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send batch: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &connector.APIError{Status: resp.StatusCode, Message: string(message)}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// enriched returns an enriched NPS answer, of user if it isn't empty
+func enriched(user string) *models.Experience {
+	score, category, sentiment := 9.0, "promoter", "positive"
+	exp := &models.Experience{
+		ID:          uuid.New(),
+		CollectedAt: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		SourceType:  "formbricks",
+		FieldID:     "nps",
+		FieldType:   "nps",
+		ValueNumber: &score,
+		NPSCategory: &category,
+		Sentiment:   &sentiment,
+		Topics:      []string{"exports"},
+	}
+	if user != "" {
+		exp.UserIdentifier = &user
+	}
+	return exp
+}
+
+func TestProperties(t *testing.T) {
+	properties := Properties(enriched(""))
+	if properties["nps_score"] != 9.0 || properties["nps_category"] != "promoter" || properties["sentiment"] != "positive" {
+		t.Errorf("unexpected properties: %v", properties)
+	}
+	if _, ok := properties["emotion"]; ok {
+		t.Errorf("expected unset fields to be left out, got %v", properties)
+	}
+
+	rating := enriched("")
+	rating.FieldType = "rating"
+	if _, ok := Properties(rating)["nps_score"]; ok {
+		t.Error("expected nps_score only for NPS answers")
+	}
+}
+
+func TestToAmplitudeEvent(t *testing.T) {
+	exp := enriched("user-42")
+	event := ToAmplitudeEvent(exp)
+	if event.EventType != EnrichedEvent || event.UserID != "user-42" || event.DeviceID != "" || event.InsertID != "hub-"+exp.ID.String() {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if event.Time != exp.CollectedAt.UnixMilli() || event.EventProperties["nps_score"] != 9.0 {
+		t.Errorf("unexpected time or properties: %+v", event)
+	}
+
+	anonymous := enriched("")
+	if event := ToAmplitudeEvent(anonymous); event.UserID != "" || event.DeviceID != anonymous.ID.String() {
+		t.Errorf("expected the experience ID as device_id, got %+v", event)
+	}
+}
+
+func TestToMixpanelEvent(t *testing.T) {
+	exp := enriched("user-42")
+	event := ToMixpanelEvent(exp)
+	if event.Event != EnrichedEvent || event.Properties["distinct_id"] != "user-42" || event.Properties["$insert_id"] != exp.ID.String() {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if event.Properties["time"] != exp.CollectedAt.UnixMilli() || event.Properties["sentiment"] != "positive" {
+		t.Errorf("unexpected time or properties: %+v", event.Properties)
+	}
+	if event := ToMixpanelEvent(enriched("")); event.Properties["distinct_id"] != "" {
+		t.Errorf("expected an empty distinct_id, got %v", event.Properties["distinct_id"])
+	}
+}
+
+func TestMixpanelSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if secret, _, _ := r.BasicAuth(); secret != "api-secret" {
+			t.Errorf("API secret = %q", secret)
+		}
+		var events []MixpanelEvent
+		if err := json.NewDecoder(r.Body).Decode(&events); err != nil || len(events) != 1 {
+			t.Errorf("unexpected batch: %v, %v", events, err)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code": 400, "error": "some data points in the request failed validation"}`))
+	}))
+	defer server.Close()
+
+	mixpanel := NewMixpanel("api-secret", "eu")
+	if mixpanel.url != "https://api-eu.mixpanel.com/import?strict=1" {
+		t.Errorf("url = %q, want the EU endpoint", mixpanel.url)
+	}
+	mixpanel.url = server.URL
+	var apiErr *connector.APIError
+	if err := mixpanel.Send(context.Background(), []models.Experience{*enriched("")}); !errors.As(err, &apiErr) || apiErr.Status != http.StatusBadRequest {
+		t.Errorf("Send() error = %v, want the rejection", err)
+	}
+}
+
+func TestToSegmentEvent(t *testing.T) {
+	exp := enriched("user-42")
+	event := ToSegmentEvent(exp)
+	if event.Type != "track" || event.Event != EnrichedEvent || event.MessageID != "hub-"+exp.ID.String() || event.UserID != "user-42" || event.AnonymousID != "" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if !event.Timestamp.Equal(exp.CollectedAt) || event.Properties["sentiment"] != "positive" {
+		t.Errorf("unexpected timestamp or properties: %+v", event)
+	}
+
+	anonymous := enriched("")
+	if event := ToSegmentEvent(anonymous); event.UserID != "" || event.AnonymousID != anonymous.ID.String() {
+		t.Errorf("expected the experience ID as anonymousId, got %+v", event)
+	}
+}
+
+func TestSegmentSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if writeKey, _, _ := r.BasicAuth(); writeKey != "write-key" {
+			t.Errorf("write key = %q", writeKey)
+		}
+		var call struct {
+			Batch []SegmentEvent `json:"batch"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&call); err != nil || len(call.Batch) != 1 {
+			t.Errorf("unexpected batch: %v, %v", call.Batch, err)
+		}
+		_, _ = w.Write([]byte(`{"success": true}`))
+	}))
+	defer server.Close()
+
+	if url := NewSegment("write-key", "").url; url != SegmentBatchURL {
+		t.Errorf("url = %q, want the default batch endpoint", url)
+	}
+	if err := NewSegment("write-key", server.URL).Send(context.Background(), []models.Experience{*enriched("")}); err != nil {
+		t.Errorf("Send() error = %v", err)
+	}
+}
+
+func TestForwarder(t *testing.T) {
+	received := make(chan []AmplitudeEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var call struct {
+			APIKey string           `json:"api_key"`
+			Events []AmplitudeEvent `json:"events"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&call); err != nil {
+			t.Errorf("invalid batch: %v", err)
+		}
+		if call.APIKey != "api-key" {
+			t.Errorf("API key = %q", call.APIKey)
+		}
+		received <- call.Events
+		_, _ = w.Write([]byte(`{"code": 200}`))
+	}))
+	defer server.Close()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	dispatcher := webhook.NewDispatcher(nil, logger)
+	defer func() { _ = dispatcher.Shutdown(time.Second) }()
+
+	amplitude := NewAmplitude("api-key", "us")
+	amplitude.url = server.URL
+	forwarder := NewForwarder(dispatcher, amplitude, logger)
+	go forwarder.Run(context.Background())
+
+	dispatcher.Dispatch(context.Background(), webhook.EventExperienceCreated, &models.Experience{ID: uuid.New()})
+	dispatcher.Dispatch(context.Background(), webhook.EventExperienceEnriched, enriched("user-42"))
+	forwarder.Stop()
+
+	select {
+	case batch := <-received:
+		if len(batch) != 1 || batch[0].UserID != "user-42" || batch[0].EventProperties["field_id"] != "nps" {
+			t.Errorf("expected the enriched experience only, got %+v", batch)
+		}
+	default:
+		t.Fatal("expected the batch to be sent on stop")
+	}
+}
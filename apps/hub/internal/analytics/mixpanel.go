@@ -0,0 +1,67 @@
+package analytics
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+// Mixpanel Import API endpoints by data residency
+var mixpanelURLs = map[string]string{
+	"us": "https://api.mixpanel.com/import?strict=1",
+	"eu": "https://api-eu.mixpanel.com/import?strict=1",
+}
+
+// MixpanelEvent is an event of the Import API
+type MixpanelEvent struct {
+	Event      string         `json:"event"`
+	Properties map[string]any `json:"properties"`
+}
+
+// Mixpanel sends events to a Mixpanel project through its Import API, which, unlike the
+// Track API, accepts experiences collected more than five days ago
+type Mixpanel struct {
+	apiSecret string
+	url       string
+	client    *http.Client
+}
+
+// NewMixpanel returns a destination that sends events to the project of the API secret, in
+// the data residency us or eu
+func NewMixpanel(apiSecret, region string) *Mixpanel {
+	url, ok := mixpanelURLs[region]
+	if !ok {
+		url = mixpanelURLs["us"]
+	}
+	return &Mixpanel{apiSecret: apiSecret, url: url, client: &http.Client{Timeout: forwardTimeout}}
+}
+
+// Name names Mixpanel in logs
+func (m *Mixpanel) Name() string {
+	return "mixpanel"
+}
+
+// Send sends experiences as events of their user, authenticated with the API secret as
+// Basic auth user name
+func (m *Mixpanel) Send(ctx context.Context, experiences []models.Experience) error {
+	events := make([]MixpanelEvent, len(experiences))
+	for i := range experiences {
+		events[i] = ToMixpanelEvent(&experiences[i])
+	}
+	return post(ctx, m.client, m.url, events, func(req *http.Request) {
+		req.SetBasicAuth(m.apiSecret, "")
+	})
+}
+
+// ToMixpanelEvent maps an enriched experience to a Feedback Enriched event. The user
+// identifier is the distinct_id, empty for experiences without one, which Mixpanel keeps
+// apart from users; the experience ID is the $insert_id, so Mixpanel only counts an
+// experience once.
+func ToMixpanelEvent(exp *models.Experience) MixpanelEvent {
+	properties := Properties(exp)
+	properties["time"] = exp.CollectedAt.UnixMilli()
+	properties["distinct_id"] = userID(exp)
+	properties["$insert_id"] = exp.ID.String()
+	return MixpanelEvent{Event: EnrichedEvent, Properties: properties}
+}
@@ -0,0 +1,75 @@
+package analytics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+// SegmentBatchURL is the Tracking API endpoint of Segment that batches are sent to
+const SegmentBatchURL = "https://api.segment.io/v1/batch"
+
+// SegmentEvent is a track call of the Tracking API
+type SegmentEvent struct {
+	Type        string         `json:"type"`
+	Event       string         `json:"event"`
+	MessageID   string         `json:"messageId"`
+	UserID      string         `json:"userId,omitempty"`
+	AnonymousID string         `json:"anonymousId,omitempty"`
+	Timestamp   time.Time      `json:"timestamp"`
+	Properties  map[string]any `json:"properties"`
+}
+
+// Segment sends events to a Segment source, or a pipeline that speaks its Tracking API,
+// through the batch endpoint
+type Segment struct {
+	writeKey string
+	url      string
+	client   *http.Client
+}
+
+// NewSegment returns a destination that sends events to url, SegmentBatchURL if empty,
+// authenticated with the write key of the source
+func NewSegment(writeKey, url string) *Segment {
+	if url == "" {
+		url = SegmentBatchURL
+	}
+	return &Segment{writeKey: writeKey, url: url, client: &http.Client{Timeout: forwardTimeout}}
+}
+
+// Name names Segment in logs
+func (s *Segment) Name() string {
+	return "segment"
+}
+
+// Send sends experiences as track calls, authenticated with the write key as Basic auth
+// user name. Segment accepts batches of up to 500KB.
+func (s *Segment) Send(ctx context.Context, experiences []models.Experience) error {
+	events := make([]SegmentEvent, len(experiences))
+	for i := range experiences {
+		events[i] = ToSegmentEvent(&experiences[i])
+	}
+	return post(ctx, s.client, s.url, map[string]any{"batch": events}, func(req *http.Request) {
+		req.SetBasicAuth(s.writeKey, "")
+	})
+}
+
+// ToSegmentEvent maps an enriched experience to a Feedback Enriched track call. The
+// experience ID is the messageId, so Segment only counts an experience once, and the
+// anonymousId if the experience has no user.
+func ToSegmentEvent(exp *models.Experience) SegmentEvent {
+	event := SegmentEvent{
+		Type:       "track",
+		Event:      EnrichedEvent,
+		MessageID:  "hub-" + exp.ID.String(),
+		UserID:     userID(exp),
+		Timestamp:  exp.CollectedAt,
+		Properties: Properties(exp),
+	}
+	if event.UserID == "" {
+		event.AnonymousID = exp.ID.String()
+	}
+	return event
+}
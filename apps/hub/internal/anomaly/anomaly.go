@@ -0,0 +1,429 @@
+// Package anomaly watches the experiences arriving per source and alerts on spikes and
+// drops. Each window of incoming experiences is compared with the windows before it (the
+// rolling baseline); when the number of experiences or the share of negative ones deviates
+// from the baseline by more than the threshold, an alert.triggered event is dispatched
+// with the window and sample experiences.
+package anomaly
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/leader"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+const (
+	// lockKey is the PostgreSQL advisory lock held by the instance that checks windows, so
+	// each window is checked and alerted on once however many Hub instances run
+	lockKey = 7_241_905_002
+	// maxCheckInterval is how long it may take at most to notice that a window has ended
+	maxCheckInterval = time.Minute
+	// sampleSize is the number of sample experiences sent with an alert
+	sampleSize = 5
+)
+
+// Metrics that alerts are triggered on
+const (
+	MetricVolume        = "volume"         // number of experiences in the window
+	MetricNegativeShare = "negative_share" // share of the enriched experiences with negative sentiment, without spam
+)
+
+// Directions of alerts
+const (
+	DirectionSpike = "spike"
+	DirectionDrop  = "drop"
+)
+
+// Options controls how anomalies are detected
+type Options struct {
+	// Window is the length of the checked windows. Windows are aligned to multiples of it
+	// since the Unix epoch (e.g. whole hours) and checked once they have ended.
+	Window time.Duration
+	// BaselineWindows is the number of preceding windows that form the baseline
+	BaselineWindows int
+	// Threshold is the number of standard deviations from the baseline at which an alert
+	// is triggered
+	Threshold float64
+	// MinResponses is the number of experiences (enriched experiences for sentiment) below
+	// which a window or baseline is too small to alert on
+	MinResponses int
+}
+
+// Alert is the data of an alert.triggered event
+type Alert struct {
+	Metric      string    `json:"metric"`
+	Direction   string    `json:"direction"`
+	SourceType  string    `json:"source_type"`
+	SourceID    string    `json:"source_id,omitempty"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+	// Value is the number of experiences or the negative share of the window
+	Value float64 `json:"value"`
+	// Baseline is the mean number of experiences per window or the negative share of the
+	// baseline windows
+	Baseline float64 `json:"baseline"`
+	// Deviation is the number of standard deviations between value and baseline
+	Deviation float64 `json:"deviation"`
+	// Responses is the number of experiences the value is computed from
+	Responses int                  `json:"responses"`
+	Samples   []*models.Experience `json:"samples"`
+}
+
+// Dispatcher sends alerts to webhook endpoints and event stream subscribers
+type Dispatcher interface {
+	Dispatch(ctx context.Context, eventType webhook.EventType, data interface{})
+}
+
+// Detector periodically checks the ended windows for anomalies
+type Detector struct {
+	client     *ent.Client
+	db         *sql.DB
+	dispatcher Dispatcher
+	opts       Options
+	logger     *slog.Logger
+
+	lock      *leader.Lock // Held while this instance checks windows
+	lastEnd   time.Time    // End of the last checked window
+	stopChan  chan struct{}
+	stopOnce  sync.Once
+	completed chan struct{}
+}
+
+// NewDetector creates a detector; db must be the database of client
+func NewDetector(client *ent.Client, db *sql.DB, dispatcher Dispatcher, opts Options, logger *slog.Logger) (*Detector, error) {
+	switch {
+	case opts.Window < time.Minute:
+		return nil, fmt.Errorf("anomaly window must be at least one minute")
+	case opts.BaselineWindows < 2:
+		return nil, fmt.Errorf("anomaly baseline must have at least 2 windows")
+	case opts.Threshold <= 0:
+		return nil, fmt.Errorf("anomaly threshold must be positive")
+	case opts.MinResponses < 0:
+		return nil, fmt.Errorf("anomaly minimum responses must not be negative")
+	}
+	return &Detector{
+		client:     client,
+		db:         db,
+		dispatcher: dispatcher,
+		opts:       opts,
+		logger:     logger,
+		stopChan:   make(chan struct{}),
+		completed:  make(chan struct{}),
+	}, nil
+}
+
+// Run checks each window once it has ended until ctx is canceled or Stop is called. Only
+// the instance holding the advisory lock checks windows; the others take over when it
+// stops.
+func (d *Detector) Run(ctx context.Context) {
+	defer close(d.completed)
+	defer d.release()
+
+	ticker := time.NewTicker(min(d.opts.Window, maxCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		if d.lead(ctx) {
+			d.check(ctx, time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops checking windows and waits for Run to release the advisory lock
+func (d *Detector) Stop() {
+	d.stopOnce.Do(func() { close(d.stopChan) })
+	<-d.completed
+}
+
+// lead reports whether this instance holds the advisory lock, trying to acquire it if
+// not
+func (d *Detector) lead(ctx context.Context) bool {
+	if d.lock != nil {
+		if d.lock.Held(ctx) {
+			return true
+		}
+		d.logger.Warn("lost anomaly detection lock, connection broken")
+		d.lock.Release()
+		d.lock = nil
+	}
+
+	lock, err := leader.TryAcquire(ctx, d.db, lockKey)
+	if err != nil {
+		d.logger.Warn("failed to acquire anomaly detection lock", "error", err)
+	}
+	if lock == nil {
+		return false
+	}
+
+	// A window that ended well before is assumed to have been checked by the previous
+	// instance holding the lock, so taking over doesn't alert on it again
+	if end := d.windowEnd(time.Now()); time.Since(end) > 2*maxCheckInterval && end.After(d.lastEnd) {
+		d.lastEnd = end
+	}
+	d.lock = lock
+	d.logger.Info("checking windows for anomalies", "window", d.opts.Window)
+	return true
+}
+
+// release gives up the advisory lock, so another instance takes over
+func (d *Detector) release() {
+	if d.lock == nil {
+		return
+	}
+	d.lock.Release()
+	d.lock = nil
+}
+
+// check checks the last window that ended before now, unless it was checked already
+func (d *Detector) check(ctx context.Context, now time.Time) {
+	end := d.windowEnd(now)
+	if !end.After(d.lastEnd) {
+		return
+	}
+
+	alerts, err := d.detect(ctx, end)
+	if err != nil {
+		d.logger.Error("failed to check window for anomalies", "window_end", end, "error", err)
+		return
+	}
+	d.lastEnd = end
+
+	for _, alert := range alerts {
+		if err := d.addSamples(ctx, &alert); err != nil {
+			d.logger.Warn("failed to load anomaly samples", "source_type", alert.SourceType, "error", err)
+		}
+		d.logger.Info("anomaly detected",
+			"metric", alert.Metric,
+			"direction", alert.Direction,
+			"source_type", alert.SourceType,
+			"source_id", alert.SourceID,
+			"window_start", alert.WindowStart,
+			"value", alert.Value,
+			"baseline", alert.Baseline)
+		d.dispatcher.Dispatch(ctx, webhook.EventAlertTriggered, alert)
+	}
+}
+
+// windowEnd returns the end of the last window that ended at or before t
+func (d *Detector) windowEnd(t time.Time) time.Time {
+	seconds := int64(d.opts.Window / time.Second)
+	return time.Unix(t.Unix()/seconds*seconds, 0).UTC()
+}
+
+// source identifies the experiences of a source
+type source struct {
+	sourceType string
+	sourceID   string
+}
+
+// detect counts the experiences per source and window of the window ending at end and
+// its baseline, and returns the alerts of the window. Experiences are assigned to windows
+// by when they arrived (created_at), and duplicates aren't counted.
+func (d *Detector) detect(ctx context.Context, end time.Time) ([]Alert, error) {
+	seconds := int64(d.opts.Window / time.Second)
+	first := end.Unix()/seconds - int64(d.opts.BaselineWindows) - 1 // Number of the first baseline window
+	start := time.Unix(first*seconds, 0)
+
+	var rows []struct {
+		SourceType string  `json:"source_type"`
+		SourceID   *string `json:"source_id"`
+		Window     int64   `json:"window"`
+		Count      int     `json:"count"`
+		Enriched   int     `json:"enriched"`
+		Negative   int     `json:"negative"`
+	}
+	err := d.client.ExperienceData.Query().
+		Where(
+			experiencedata.CreatedAtGTE(start),
+			experiencedata.CreatedAtLT(end),
+			experiencedata.DuplicateOfIsNil(),
+		).
+		GroupBy(experiencedata.FieldSourceType, experiencedata.FieldSourceID).
+		Aggregate(
+			func(s *entsql.Selector) string {
+				expr := fmt.Sprintf("floor(extract(epoch FROM %s) / %d)::bigint", s.C(experiencedata.FieldCreatedAt), seconds)
+				s.GroupBy(expr)
+				return entsql.As(expr, "window")
+			},
+			ent.As(ent.Count(), "count"),
+			func(s *entsql.Selector) string {
+				return entsql.As(fmt.Sprintf("count(*) FILTER (WHERE %s IS NOT NULL AND %s IS NOT TRUE)",
+					s.C(experiencedata.FieldSentiment), s.C(experiencedata.FieldIsSpam)), "enriched")
+			},
+			func(s *entsql.Selector) string {
+				return entsql.As(fmt.Sprintf("count(*) FILTER (WHERE %s = 'negative' AND %s IS NOT TRUE)",
+					s.C(experiencedata.FieldSentiment), s.C(experiencedata.FieldIsSpam)), "negative")
+			},
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count experiences: %w", err)
+	}
+
+	// The last window is the checked one, the ones before it the baseline. Experiences
+	// without a source ID are one source, whether it is null or empty.
+	windows := map[source][]stats{}
+	for _, row := range rows {
+		i := row.Window - first
+		if i < 0 || i > int64(d.opts.BaselineWindows) {
+			continue
+		}
+		key := source{sourceType: row.SourceType}
+		if row.SourceID != nil {
+			key.sourceID = *row.SourceID
+		}
+		if windows[key] == nil {
+			windows[key] = make([]stats, d.opts.BaselineWindows+1)
+		}
+		windows[key][i].count += row.Count
+		windows[key][i].enriched += row.Enriched
+		windows[key][i].negative += row.Negative
+	}
+
+	var alerts []Alert
+	for key, sourceWindows := range windows {
+		last := len(sourceWindows) - 1
+		for _, alert := range evaluate(sourceWindows[last], sourceWindows[:last], d.opts) {
+			alert.SourceType = key.sourceType
+			alert.SourceID = key.sourceID
+			alert.WindowStart = end.Add(-d.opts.Window)
+			alert.WindowEnd = end
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts, nil
+}
+
+// addSamples adds the latest experiences of the source in the window to the alert, only
+// negative ones for negative sentiment alerts
+func (d *Detector) addSamples(ctx context.Context, alert *Alert) error {
+	where := []predicate.ExperienceData{
+		experiencedata.SourceType(alert.SourceType),
+		experiencedata.CreatedAtGTE(alert.WindowStart),
+		experiencedata.CreatedAtLT(alert.WindowEnd),
+		experiencedata.DuplicateOfIsNil(),
+	}
+	if alert.SourceID == "" {
+		where = append(where, experiencedata.Or(experiencedata.SourceIDIsNil(), experiencedata.SourceID("")))
+	} else {
+		where = append(where, experiencedata.SourceID(alert.SourceID))
+	}
+	if alert.Metric == MetricNegativeShare {
+		where = append(where,
+			experiencedata.Sentiment("negative"),
+			experiencedata.Or(experiencedata.IsSpamIsNil(), experiencedata.IsSpam(false)),
+		)
+	}
+
+	alert.Samples = []*models.Experience{}
+	samples, err := d.client.ExperienceData.Query().
+		Where(where...).
+		Order(ent.Desc(experiencedata.FieldCreatedAt)).
+		Limit(sampleSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sample := range samples {
+		alert.Samples = append(alert.Samples, models.FromEnt(sample))
+	}
+	return nil
+}
+
+// stats are the experiences of a source in one window
+type stats struct {
+	count    int // Experiences
+	enriched int // Experiences with a sentiment, without spam
+	negative int // Enriched experiences with negative sentiment
+}
+
+// evaluate compares a window with its baseline windows and returns the alerts without
+// source and window. Sources without experiences in the baseline are new and not alerted
+// on.
+//
+// The volume deviation is measured in standard deviations of the baseline counts, at least
+// the square root of their mean (the deviation of random arrivals at that rate) and at
+// least one. The negative share is compared with the share of all baseline windows, in
+// standard errors of a share of the window's size.
+func evaluate(window stats, baseline []stats, opts Options) []Alert {
+	var count, enriched, negative int
+	for _, b := range baseline {
+		count += b.count
+		enriched += b.enriched
+		negative += b.negative
+	}
+	if count == 0 {
+		return nil
+	}
+
+	var alerts []Alert
+	mean := float64(count) / float64(len(baseline))
+	variance := 0.0
+	for _, b := range baseline {
+		variance += (float64(b.count) - mean) * (float64(b.count) - mean)
+	}
+	sigma := max(math.Sqrt(variance/float64(len(baseline))), math.Sqrt(mean), 1)
+	if deviation := (float64(window.count) - mean) / sigma; math.Abs(deviation) >= opts.Threshold &&
+		max(float64(window.count), mean) >= float64(opts.MinResponses) {
+		alerts = append(alerts, Alert{
+			Metric:    MetricVolume,
+			Direction: direction(deviation),
+			Value:     float64(window.count),
+			Baseline:  round(mean),
+			Deviation: round(deviation),
+			Responses: window.count,
+		})
+	}
+
+	if minEnriched := max(opts.MinResponses, 1); enriched >= minEnriched && window.enriched >= minEnriched {
+		share := float64(window.negative) / float64(window.enriched)
+		baselineShare := float64(negative) / float64(enriched)
+		// Smoothing keeps the error above zero when the baseline has no or only negative experiences
+		p := (float64(negative) + 1) / (float64(enriched) + 2)
+		sigma := math.Sqrt(p * (1 - p) / float64(window.enriched))
+		if deviation := (share - baselineShare) / sigma; math.Abs(deviation) >= opts.Threshold {
+			alerts = append(alerts, Alert{
+				Metric:    MetricNegativeShare,
+				Direction: direction(deviation),
+				Value:     round(share),
+				Baseline:  round(baselineShare),
+				Deviation: round(deviation),
+				Responses: window.enriched,
+			})
+		}
+	}
+	return alerts
+}
+
+// direction returns the direction of a deviation
+func direction(deviation float64) string {
+	if deviation < 0 {
+		return DirectionDrop
+	}
+	return DirectionSpike
+}
+
+// round rounds to three decimals
+func round(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
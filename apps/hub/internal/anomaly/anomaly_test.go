@@ -0,0 +1,73 @@
+package anomaly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvaluate(t *testing.T) {
+	opts := Options{BaselineWindows: 4, Threshold: 3, MinResponses: 10}
+	steady := []stats{{count: 20}, {count: 20}, {count: 20}, {count: 20}}
+	tests := []struct {
+		name      string
+		window    stats
+		baseline  []stats
+		metric    string
+		direction string
+	}{
+		{name: "usual volume", window: stats{count: 22}, baseline: steady},
+		{name: "volume spike", window: stats{count: 60}, baseline: steady, metric: MetricVolume, direction: DirectionSpike},
+		{name: "volume drop", window: stats{count: 0}, baseline: steady, metric: MetricVolume, direction: DirectionDrop},
+		{name: "varying baseline", window: stats{count: 40}, baseline: []stats{{count: 5}, {count: 40}, {count: 10}, {count: 45}}},
+		{name: "new source", window: stats{count: 50}, baseline: make([]stats, 4)},
+		{name: "low volume", window: stats{count: 9}, baseline: []stats{{count: 2}, {count: 2}, {count: 2}, {count: 2}}},
+		{
+			name:      "negative sentiment spike",
+			window:    stats{count: 20, enriched: 20, negative: 12},
+			baseline:  []stats{{count: 20, enriched: 20, negative: 2}, {count: 20, enriched: 20, negative: 2}, {count: 20, enriched: 20, negative: 2}, {count: 20, enriched: 20, negative: 2}},
+			metric:    MetricNegativeShare,
+			direction: DirectionSpike,
+		},
+		{
+			name:     "too few enriched",
+			window:   stats{count: 20, enriched: 5, negative: 5},
+			baseline: []stats{{count: 20, enriched: 20, negative: 2}, {count: 20, enriched: 20, negative: 2}, {count: 20, enriched: 20, negative: 2}, {count: 20, enriched: 20, negative: 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts := evaluate(tt.window, tt.baseline, opts)
+			if tt.metric == "" {
+				if len(alerts) > 0 {
+					t.Fatalf("expected no alerts, got %+v", alerts)
+				}
+				return
+			}
+			if len(alerts) != 1 || alerts[0].Metric != tt.metric || alerts[0].Direction != tt.direction {
+				t.Fatalf("expected a %s %s, got %+v", tt.metric, tt.direction, alerts)
+			}
+		})
+	}
+}
+
+func TestNewDetector_InvalidOptions(t *testing.T) {
+	valid := Options{Window: time.Hour, BaselineWindows: 24, Threshold: 3, MinResponses: 10}
+	tests := map[string]func(*Options){
+		"short window":   func(o *Options) { o.Window = time.Second },
+		"small baseline": func(o *Options) { o.BaselineWindows = 1 },
+		"zero threshold": func(o *Options) { o.Threshold = 0 },
+		"negative min":   func(o *Options) { o.MinResponses = -1 },
+	}
+	for name, invalidate := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := valid
+			invalidate(&opts)
+			if _, err := NewDetector(nil, nil, nil, opts, nil); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+	if _, err := NewDetector(nil, nil, nil, valid, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,1732 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/terms"
+)
+
+// AnalyticsFilter holds the filters shared by the analytics routes
+type AnalyticsFilter struct {
+	SourceType      string `query:"source_type" doc:"Filter by source type"`
+	SourceID        string `query:"source_id" doc:"Filter by source ID"`
+	FieldID         string `query:"field_id" doc:"Filter by field ID"`
+	QuestionID      string `query:"question_id" doc:"Filter by question ID" format:"uuid"`
+	Since           string `query:"since" doc:"Filter by collected_at >= since (ISO 8601 format)" example:"2024-01-01T00:00:00Z"`
+	Until           string `query:"until" doc:"Filter by collected_at <= until (ISO 8601 format)" example:"2024-12-31T23:59:59Z"`
+	SegmentID       string `query:"segment_id" doc:"Only count the experiences of this saved segment (see /v1/segments), in addition to the other filters" format:"uuid"`
+	IncludeExcluded bool   `query:"include_excluded" doc:"Also count the responses flagged as spam or as duplicates (see SERVICE_DUPLICATE_POLICY), which are excluded by default"`
+
+	// segment is the filter of SegmentID, set by resolveSegment
+	segment *experiencefilter.Filter
+}
+
+// Exclusions are the responses matching the filters that weren't counted. A response can be
+// both spam and a duplicate.
+type Exclusions struct {
+	Spam       int `json:"spam" doc:"Responses flagged as spam"`
+	Duplicates int `json:"duplicates" doc:"Responses flagged as duplicates"`
+}
+
+// filter restricts the query to the matching experiences. Responses flagged as spam or as
+// duplicates aren't counted unless IncludeExcluded is set.
+func (f AnalyticsFilter) filter(query *ent.ExperienceDataQuery) (*ent.ExperienceDataQuery, error) {
+	if !f.IncludeExcluded {
+		query = query.Where(
+			experiencedata.DuplicateOfIsNil(),
+			experiencedata.Or(experiencedata.IsSpamIsNil(), experiencedata.IsSpam(false)),
+		)
+	}
+	if f.SourceType != "" {
+		query = query.Where(experiencedata.SourceTypeEQ(f.SourceType))
+	}
+	if f.SourceID != "" {
+		query = query.Where(experiencedata.SourceIDEQ(f.SourceID))
+	}
+	if f.FieldID != "" {
+		query = query.Where(experiencedata.FieldIDEQ(f.FieldID))
+	}
+	if f.QuestionID != "" {
+		questionID, err := parseUUID(f.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(experiencedata.QuestionIDEQ(questionID))
+	}
+	if f.Since != "" {
+		sinceTime, err := time.Parse(time.RFC3339, f.Since)
+		if err != nil {
+			return nil, invalidTimestamp("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+		}
+		query = query.Where(experiencedata.CollectedAtGTE(sinceTime))
+	}
+	if f.Until != "" {
+		untilTime, err := time.Parse(time.RFC3339, f.Until)
+		if err != nil {
+			return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+		}
+		query = query.Where(experiencedata.CollectedAtLTE(untilTime))
+	}
+	if f.segment != nil {
+		return filterExperiences(query, *f.segment)
+	}
+	return query, nil
+}
+
+// resolveSegment loads the filter of the segment_id, if set. Routes call it before filter.
+func (f *AnalyticsFilter) resolveSegment(ctx context.Context, reader *ent.Client, logger *slog.Logger) error {
+	if f.SegmentID == "" {
+		return nil
+	}
+	segment, err := loadSegment(ctx, reader, f.SegmentID, logger)
+	if err != nil {
+		return err
+	}
+	f.segment = segment
+	return nil
+}
+
+// countExcluded counts the responses of the query matching the filter that the filter
+// excludes, or returns nil if it includes them
+func countExcluded(ctx context.Context, f AnalyticsFilter, query *ent.ExperienceDataQuery, logger *slog.Logger) (*Exclusions, error) {
+	if f.IncludeExcluded {
+		return nil, nil
+	}
+	f.IncludeExcluded = true
+	query, err := f.filter(query)
+	if err != nil {
+		return nil, err
+	}
+	var rows []Exclusions
+	err = query.
+		Aggregate(
+			func(s *sql.Selector) string {
+				return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s)", s.C(experiencedata.FieldIsSpam)), "spam")
+			},
+			func(s *sql.Selector) string {
+				return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s IS NOT NULL)", s.C(experiencedata.FieldDuplicateOf)), "duplicates")
+			},
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "aggregate", "exclusions")
+	}
+	exclusions := &Exclusions{}
+	if len(rows) > 0 {
+		*exclusions = rows[0]
+	}
+	return exclusions, nil
+}
+
+// Comparison modes of the compare parameter
+const (
+	comparePreviousPeriod = "previous_period"
+	comparePreviousYear   = "previous_year"
+)
+
+// ComparisonInput adds the comparison with an earlier window to an analytics route
+type ComparisonInput struct {
+	Compare string `query:"compare" enum:"previous_period,previous_year" doc:"Also report the window of the same length before since (previous_period) or the same window one year earlier (previous_year), and the changes since then. Requires since; until defaults to now."`
+}
+
+// ComparisonWindow is the earlier window that results are compared with
+type ComparisonWindow struct {
+	Compare string    `json:"compare" doc:"Comparison mode: previous_period or previous_year"`
+	Since   time.Time `json:"since" doc:"Start of the compared window"`
+	Until   time.Time `json:"until" doc:"End of the compared window; a previous_period ends at since, which isn't included"`
+
+	untilExclusive bool
+}
+
+// comparisonWindow returns the window that the filtered experiences are compared with, or
+// nil if compare is empty
+func (f AnalyticsFilter) comparisonWindow(compare string) (*ComparisonWindow, error) {
+	if compare == "" {
+		return nil, nil
+	}
+	if f.Since == "" {
+		return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidTimeRange, ErrMsgInvalidInput+"compare requires since")
+	}
+	since, err := time.Parse(time.RFC3339, f.Since)
+	if err != nil {
+		return nil, invalidTimestamp("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+	}
+	until := time.Now()
+	if f.Until != "" {
+		if until, err = time.Parse(time.RFC3339, f.Until); err != nil {
+			return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+		}
+	}
+	since, until = since.UTC(), until.UTC()
+	if !since.Before(until) {
+		return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidTimeRange, ErrMsgInvalidInput+"since must be before until")
+	}
+
+	if compare == comparePreviousYear {
+		return &ComparisonWindow{Compare: compare, Since: since.AddDate(-1, 0, 0), Until: until.AddDate(-1, 0, 0)}, nil
+	}
+	return &ComparisonWindow{Compare: compare, Since: since.Add(-until.Sub(since)), Until: since, untilExclusive: true}, nil
+}
+
+// filter restricts the query to the experiences of the window that match the other filters
+func (w *ComparisonWindow) filter(f AnalyticsFilter, query *ent.ExperienceDataQuery) (*ent.ExperienceDataQuery, error) {
+	f.Since, f.Until = "", ""
+	query, err := f.filter(query.Where(experiencedata.CollectedAtGTE(w.Since)))
+	if err != nil {
+		return nil, err
+	}
+	if w.untilExclusive {
+		return query.Where(experiencedata.CollectedAtLT(w.Until)), nil
+	}
+	return query.Where(experiencedata.CollectedAtLTE(w.Until)), nil
+}
+
+// GetNPSInput defines the input for the NPS report
+type GetNPSInput struct {
+	AnalyticsFilter
+	ComparisonInput
+	Interval string `query:"interval" default:"week" enum:"day,week,month" doc:"Size of the time series buckets (UTC; weeks start on Monday)"`
+}
+
+// NPSBreakdown is the NPS of a set of responses
+type NPSBreakdown struct {
+	Score      *float64 `json:"score" doc:"Net Promoter Score from -100 to 100 (percentage of promoters minus percentage of detractors, rounded to one decimal), null without responses"`
+	Responses  int      `json:"responses" doc:"Number of NPS responses"`
+	Promoters  int      `json:"promoters" doc:"Responses scoring 9-10"`
+	Passives   int      `json:"passives" doc:"Responses scoring 7-8"`
+	Detractors int      `json:"detractors" doc:"Responses scoring 0-6"`
+}
+
+// NPSBucket is the NPS of the responses collected in one period
+type NPSBucket struct {
+	Period string `json:"period" doc:"UTC start day of the period (YYYY-MM-DD)" example:"2024-01-15"`
+	NPSBreakdown
+}
+
+// NPSComparison is the NPS of the compared window and the changes since then
+type NPSComparison struct {
+	ComparisonWindow
+	Previous        NPSBreakdown `json:"previous" doc:"NPS of the compared window"`
+	ScoreChange     *float64     `json:"score_change" doc:"score minus the previous score, null if either window has no responses"`
+	ResponsesChange int          `json:"responses_change" doc:"responses minus the previous responses"`
+}
+
+// GetNPSOutput defines the output for the NPS report
+type GetNPSOutput struct {
+	Body struct {
+		NPSBreakdown
+		Interval   string         `json:"interval" doc:"Size of the time series buckets"`
+		Series     []NPSBucket    `json:"series" doc:"NPS per period (oldest first); periods without responses are omitted"`
+		Comparison *NPSComparison `json:"comparison,omitempty" doc:"Comparison with an earlier window, if compare is set"`
+		Excluded   *Exclusions    `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// GetSentimentInput defines the input for the sentiment report
+type GetSentimentInput struct {
+	AnalyticsFilter
+	ComparisonInput
+	Interval string `query:"interval" default:"week" enum:"day,week,month" doc:"Size of the time series buckets (UTC; weeks start on Monday)"`
+	Topic    string `query:"topic" doc:"Only count responses with this topic"`
+	Topics   int    `query:"topics" default:"20" minimum:"0" maximum:"100" doc:"Number of most frequent topics to break down"`
+}
+
+// SentimentBreakdown is the sentiment of a set of enriched responses
+type SentimentBreakdown struct {
+	Responses    int      `json:"responses" doc:"Number of responses with a sentiment"`
+	Positive     int      `json:"positive" doc:"Positive responses"`
+	Neutral      int      `json:"neutral" doc:"Neutral responses"`
+	Negative     int      `json:"negative" doc:"Negative responses"`
+	AverageScore *float64 `json:"average_score" doc:"Mean sentiment_score from -1 to +1, rounded to three decimals; null without scores"`
+}
+
+// SentimentBucket is the sentiment of the responses collected in one period
+type SentimentBucket struct {
+	Period string `json:"period" doc:"UTC start day of the period (YYYY-MM-DD)" example:"2024-01-15"`
+	SentimentBreakdown
+}
+
+// SourceTypeSentiment is the sentiment of the responses from one source type
+type SourceTypeSentiment struct {
+	SourceType string `json:"source_type" doc:"Source type"`
+	SentimentBreakdown
+}
+
+// TopicSentiment is the sentiment of the responses with one topic
+type TopicSentiment struct {
+	Topic string `json:"topic" doc:"Topic"`
+	SentimentBreakdown
+}
+
+// SentimentComparison is the sentiment of the compared window and the changes since then.
+// Share changes are in percentage points and null if either window has no responses.
+type SentimentComparison struct {
+	ComparisonWindow
+	Previous            SentimentBreakdown `json:"previous" doc:"Sentiment of the compared window"`
+	ResponsesChange     int                `json:"responses_change" doc:"responses minus the previous responses"`
+	PositiveShareChange *float64           `json:"positive_share_change" doc:"Change of the percentage of positive responses, in percentage points"`
+	NeutralShareChange  *float64           `json:"neutral_share_change" doc:"Change of the percentage of neutral responses, in percentage points"`
+	NegativeShareChange *float64           `json:"negative_share_change" doc:"Change of the percentage of negative responses, in percentage points"`
+	AverageScoreChange  *float64           `json:"average_score_change" doc:"average_score minus the previous average_score, null if either is null"`
+}
+
+// GetSentimentOutput defines the output for the sentiment report
+type GetSentimentOutput struct {
+	Body struct {
+		SentimentBreakdown
+		Interval     string                `json:"interval" doc:"Size of the time series buckets"`
+		Series       []SentimentBucket     `json:"series" doc:"Sentiment per period (oldest first); periods without responses are omitted"`
+		BySourceType []SourceTypeSentiment `json:"by_source_type" doc:"Sentiment per source type"`
+		ByTopic      []TopicSentiment      `json:"by_topic" doc:"Sentiment of the most frequent topics (most frequent first)"`
+		Comparison   *SentimentComparison  `json:"comparison,omitempty" doc:"Comparison with an earlier window, if compare is set"`
+		Excluded     *Exclusions           `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// sentimentRow is the sentiment of the responses in one group
+type sentimentRow struct {
+	Group        string   `json:"group"`
+	Responses    int      `json:"responses"`
+	Positive     int      `json:"positive"`
+	Neutral      int      `json:"neutral"`
+	Negative     int      `json:"negative"`
+	AverageScore *float64 `json:"average_score"`
+}
+
+// breakdown returns the counts and rounded average of the row
+func (r sentimentRow) breakdown() SentimentBreakdown {
+	breakdown := SentimentBreakdown{
+		Responses: r.Responses,
+		Positive:  r.Positive,
+		Neutral:   r.Neutral,
+		Negative:  r.Negative,
+	}
+	if r.AverageScore != nil {
+		average := math.Round(*r.AverageScore*1000) / 1000
+		breakdown.AverageScore = &average
+	}
+	return breakdown
+}
+
+// defaultTopicWindow is the period compared with the one before if since isn't set
+const defaultTopicWindow = 7 * 24 * time.Hour
+
+// GetTopicTrendsInput defines the input for the topic trends
+type GetTopicTrendsInput struct {
+	AnalyticsFilter
+	Limit    int `query:"limit" default:"10" minimum:"1" maximum:"50" doc:"Number of topics per list"`
+	Examples int `query:"examples" default:"3" minimum:"0" maximum:"10" doc:"Number of example experience IDs per rising or falling topic"`
+}
+
+// TopicTrend is the frequency of a topic in the period and the one before
+type TopicTrend struct {
+	Topic            string      `json:"topic" doc:"Topic"`
+	Count            int         `json:"count" doc:"Responses with the topic in the period"`
+	PreviousCount    int         `json:"previous_count" doc:"Responses with the topic in the previous period"`
+	Change           int         `json:"change" doc:"count minus previous_count"`
+	ChangePercentage *float64    `json:"change_percentage" doc:"Change relative to previous_count, rounded to one decimal; null for topics that are new in the period"`
+	ExampleIDs       []uuid.UUID `json:"example_ids,omitempty" doc:"Most recent experiences with the topic (rising and falling topics only)"`
+}
+
+// GetTopicTrendsOutput defines the output for the topic trends
+type GetTopicTrendsOutput struct {
+	Body struct {
+		Since         time.Time    `json:"since" doc:"Start of the period"`
+		Until         time.Time    `json:"until" doc:"End of the period"`
+		PreviousSince time.Time    `json:"previous_since" doc:"Start of the previous period, which ends at since and is as long as the period"`
+		Topics        []TopicTrend `json:"topics" doc:"Most frequent topics in the period"`
+		Rising        []TopicTrend `json:"rising" doc:"Topics with the largest increase over the previous period"`
+		Falling       []TopicTrend `json:"falling" doc:"Topics with the largest decrease from the previous period"`
+		Excluded      *Exclusions  `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates in both periods that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// dimensionColumns are the columns analytics can be grouped by, besides metadata keys
+var dimensionColumns = []string{
+	experiencedata.FieldSourceType,
+	experiencedata.FieldSourceID,
+	experiencedata.FieldFieldID,
+	experiencedata.FieldFieldType,
+	experiencedata.FieldSentiment,
+	experiencedata.FieldEmotion,
+	experiencedata.FieldNpsCategory,
+	experiencedata.FieldLanguage,
+	experiencedata.FieldCountry,
+	experiencedata.FieldRegion,
+	experiencedata.FieldDevice,
+	experiencedata.FieldPlatform,
+	experiencedata.FieldAppVersion,
+}
+
+// GetTimeSeriesInput defines the input for the time series
+type GetTimeSeriesInput struct {
+	AnalyticsFilter
+	FieldType string `query:"field_type" doc:"Filter by field type"`
+	Metric    string `query:"metric" default:"count" enum:"count,avg_value_number,avg_sentiment_score" doc:"Value of each point: the number of experiences, or the mean value_number or sentiment_score of those that have one"`
+	Interval  string `query:"interval" default:"day" enum:"hour,day,week,month" doc:"Size of the buckets (UTC; weeks start on Monday)"`
+	GroupBy   string `query:"group_by" pattern:"^[a-z_]+$|^metadata\\.[A-Za-z0-9_-]+$" doc:"Split the series by a column (source_type, source_id, field_id, field_type, sentiment, emotion, nps_category, language, country, region, device, platform, app_version) or a metadata key (metadata.<key>)" example:"source_type"`
+	Limit     int    `query:"limit" default:"10" minimum:"1" maximum:"100" doc:"Number of groups with the most experiences to return"`
+}
+
+// TimeSeriesPoint is the metric of one period
+type TimeSeriesPoint struct {
+	Period string   `json:"period" doc:"UTC start of the period: the day (YYYY-MM-DD), or an RFC 3339 timestamp for hours" example:"2024-01-15"`
+	Value  *float64 `json:"value" doc:"Value of the metric, null if no experience has a value to average"`
+	Count  int      `json:"count" doc:"Number of experiences the value is computed from"`
+}
+
+// TimeSeries is the metric over time for one group
+type TimeSeries struct {
+	Group  *string           `json:"group,omitempty" doc:"Value of the group_by column or metadata key; omitted without group_by or for experiences without a value"`
+	Count  int               `json:"count" doc:"Number of experiences in the series"`
+	Points []TimeSeriesPoint `json:"points" doc:"Metric per period (oldest first); periods without experiences are omitted"`
+}
+
+// GetTimeSeriesOutput defines the output for the time series
+type GetTimeSeriesOutput struct {
+	Body struct {
+		Metric   string       `json:"metric" doc:"Metric of the points"`
+		Interval string       `json:"interval" doc:"Size of the buckets"`
+		GroupBy  string       `json:"group_by,omitempty" doc:"Column or metadata key the series are split by"`
+		Series   []TimeSeries `json:"series" doc:"One series per group, largest first"`
+		Excluded *Exclusions  `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// GetCrossTabInput defines the input for the cross-tab
+type GetCrossTabInput struct {
+	AnalyticsFilter
+	FieldType string `query:"field_type" doc:"Filter by field type"`
+	Rows      string `query:"rows" required:"true" pattern:"^[a-z_]+$|^metadata\\.[A-Za-z0-9_-]+$" doc:"Column (source_type, source_id, field_id, field_type, sentiment, emotion, nps_category, language, country, region, device, platform, app_version) or metadata key (metadata.<key>) of the rows" example:"sentiment"`
+	Columns   string `query:"columns" required:"true" pattern:"^[a-z_]+$|^metadata\\.[A-Za-z0-9_-]+$" doc:"Column or metadata key of the columns, like rows" example:"country"`
+	Limit     int    `query:"limit" default:"20" minimum:"1" maximum:"100" doc:"Number of values with the most experiences to return per dimension"`
+}
+
+// CrossTabTotal is the number of experiences with one value of a dimension
+type CrossTabTotal struct {
+	Value      *string `json:"value" doc:"Value of the dimension, null for experiences without one"`
+	Count      int     `json:"count" doc:"Number of experiences"`
+	Percentage float64 `json:"percentage" doc:"Share of all experiences, rounded to one decimal"`
+}
+
+// CrossTabCell is the number of experiences with one combination of row and column values
+type CrossTabCell struct {
+	Row              *string `json:"row" doc:"Row value, null for experiences without one"`
+	Column           *string `json:"column" doc:"Column value, null for experiences without one"`
+	Count            int     `json:"count" doc:"Number of experiences"`
+	Percentage       float64 `json:"percentage" doc:"Share of all experiences, rounded to one decimal"`
+	RowPercentage    float64 `json:"row_percentage" doc:"Share of the experiences of the row, rounded to one decimal"`
+	ColumnPercentage float64 `json:"column_percentage" doc:"Share of the experiences of the column, rounded to one decimal"`
+}
+
+// GetCrossTabOutput defines the output for the cross-tab
+type GetCrossTabOutput struct {
+	Body struct {
+		Rows         string          `json:"rows" doc:"Column or metadata key of the rows"`
+		Columns      string          `json:"columns" doc:"Column or metadata key of the columns"`
+		Total        int             `json:"total" doc:"Number of experiences, including those of rows and columns beyond the limit"`
+		RowTotals    []CrossTabTotal `json:"row_totals" doc:"Experiences per row value, largest first"`
+		ColumnTotals []CrossTabTotal `json:"column_totals" doc:"Experiences per column value, largest first"`
+		Cells        []CrossTabCell  `json:"cells" doc:"Experiences per row and column value, in the order of the rows and then the columns; combinations without experiences are omitted"`
+		Excluded     *Exclusions     `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// overviewWindow is the period of the current NPS, CSAT, sentiment, and topics of the overview
+const overviewWindow = 30 * 24 * time.Hour
+
+// GetOverviewInput defines the input for the dashboard overview
+type GetOverviewInput struct {
+	AnalyticsFilter
+	Topics int `query:"topics" default:"5" minimum:"0" maximum:"50" doc:"Number of most frequent topics of the last 30 days to return"`
+}
+
+// TopicCount is the number of experiences mentioning a topic
+type TopicCount struct {
+	Topic string `json:"topic" doc:"Topic"`
+	Count int    `json:"count" doc:"Number of experiences mentioning the topic"`
+}
+
+// GetOverviewOutput defines the output for the dashboard overview
+type GetOverviewOutput struct {
+	Body struct {
+		AsOf       time.Time          `json:"as_of" doc:"End of the last 7 and 30 days: until, or the time of the request"`
+		Responses  int                `json:"responses" doc:"Number of experiences"`
+		Last7Days  int                `json:"last_7_days" doc:"Number of experiences collected in the last 7 days"`
+		Last30Days int                `json:"last_30_days" doc:"Number of experiences collected in the last 30 days"`
+		NPS        NPSBreakdown       `json:"nps" doc:"NPS of the last 30 days"`
+		CSAT       *ScoreStats        `json:"csat,omitempty" doc:"Scores of the csat responses of the last 30 days; omitted if there are none"`
+		Sentiment  SentimentBreakdown `json:"sentiment" doc:"Sentiment of the enriched experiences of the last 30 days"`
+		Topics     []TopicCount       `json:"topics" doc:"Most frequent topics of the last 30 days (most frequent first)"`
+		Excluded   *Exclusions        `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// maxHistogramValues is the number of distinct values up to which a histogram has a bin per
+// value; number fields with more values are binned into histogramBins equal-width bins
+const (
+	maxHistogramValues = 20
+	histogramBins      = 10
+)
+
+// maxTermTexts is the number of most recent texts the term frequencies are computed from
+const maxTermTexts = 10000
+
+// GetTermsInput defines the input for the term frequencies
+type GetTermsInput struct {
+	AnalyticsFilter
+	Language string `query:"language" doc:"Only count responses in this language (ISO code as stored, e.g. en)"`
+	Limit    int    `query:"limit" default:"50" minimum:"1" maximum:"200" doc:"Number of words and of word pairs"`
+}
+
+// TermCount is how often a word or word pair occurs
+type TermCount struct {
+	Term      string `json:"term" doc:"Lowercase word or word pair"`
+	Count     int    `json:"count" doc:"Occurrences in all responses"`
+	Responses int    `json:"responses" doc:"Number of responses it occurs in"`
+}
+
+// GetTermsOutput defines the output for the term frequencies
+type GetTermsOutput struct {
+	Body struct {
+		Responses int         `json:"responses" doc:"Number of responses the terms are counted in"`
+		Sampled   bool        `json:"sampled" doc:"Whether more responses match; the terms are counted in the most recent ones"`
+		Unigrams  []TermCount `json:"unigrams" doc:"Most frequent words (most frequent first)"`
+		Bigrams   []TermCount `json:"bigrams" doc:"Most frequent pairs of adjacent words (most frequent first)"`
+		Excluded  *Exclusions `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// GetRatingsInput defines the input for the rating aggregates
+type GetRatingsInput struct {
+	AnalyticsFilter
+	ComparisonInput
+	FieldType string  `query:"field_type" enum:"csat,rating,number" doc:"Filter by field type (default: csat, rating, and number)"`
+	TopBoxMin float64 `query:"top_box_min" doc:"Lowest score counted as top box; 0 uses the default. Defaults to the top two points of SERVICE_CSAT_RANGE and SERVICE_RATING_RANGE for csat and rating fields; number fields only get a top box with this parameter."`
+}
+
+// HistogramBin counts the responses with scores from From to To (inclusive). Fields with few
+// distinct scores get a bin per score, with From equal to To.
+type HistogramBin struct {
+	From  float64 `json:"from" doc:"Lowest score in the bin"`
+	To    float64 `json:"to" doc:"Highest score in the bin"`
+	Count int     `json:"count" doc:"Number of responses"`
+}
+
+// RatingAggregate summarizes the scores of one field
+type RatingAggregate struct {
+	SourceType string  `json:"source_type" doc:"Source type"`
+	SourceID   *string `json:"source_id,omitempty" doc:"Source ID"`
+	FieldID    string  `json:"field_id" doc:"Field ID"`
+	FieldType  string  `json:"field_type" doc:"Field type: csat, rating, or number"`
+	ScoreStats
+	Comparison *ScoreComparison `json:"comparison,omitempty" doc:"Scores of the compared window, if compare is set"`
+}
+
+// ScoreComparison is the scores of a field in the compared window and the changes since then
+type ScoreComparison struct {
+	Previous               *ScoreStats `json:"previous" doc:"Scores of the compared window, null if the field had no responses then"`
+	ResponsesChange        int         `json:"responses_change" doc:"responses minus the previous responses"`
+	AverageChange          *float64    `json:"average_change" doc:"average minus the previous average, null without previous responses"`
+	TopBoxPercentageChange *float64    `json:"top_box_percentage_change" doc:"Change of the top-box percentage in percentage points, null without a top box or previous responses"`
+}
+
+// ScoreStats summarizes numeric scores
+type ScoreStats struct {
+	Responses        int            `json:"responses" doc:"Number of responses with a score"`
+	Average          float64        `json:"average" doc:"Mean score, rounded to two decimals"`
+	Median           float64        `json:"median" doc:"Median score"`
+	Min              float64        `json:"min" doc:"Lowest score"`
+	Max              float64        `json:"max" doc:"Highest score"`
+	Percentiles      *Percentiles   `json:"percentiles,omitempty" doc:"Percentiles of the scores"`
+	TopBoxMin        *float64       `json:"top_box_min,omitempty" doc:"Lowest score counted as top box"`
+	TopBoxPercentage *float64       `json:"top_box_percentage,omitempty" doc:"Percentage of responses scoring top_box_min or higher, rounded to one decimal"`
+	Histogram        []HistogramBin `json:"histogram" doc:"Number of responses per score or score range (lowest first)"`
+}
+
+// Percentiles are the scores below which the given share of the scores fall, interpolated
+// between the two nearest scores (PostgreSQL's percentile_cont)
+type Percentiles struct {
+	P50 float64 `json:"p50" doc:"50th percentile (median), rounded to two decimals"`
+	P75 float64 `json:"p75" doc:"75th percentile, rounded to two decimals"`
+	P90 float64 `json:"p90" doc:"90th percentile, rounded to two decimals"`
+	P95 float64 `json:"p95" doc:"95th percentile, rounded to two decimals"`
+}
+
+// GetRatingsOutput defines the output for the rating aggregates
+type GetRatingsOutput struct {
+	Body struct {
+		Data       []RatingAggregate `json:"data" doc:"Aggregates per source and field"`
+		Comparison *ComparisonWindow `json:"comparison,omitempty" doc:"Window the aggregates are compared with, if compare is set"`
+		Excluded   *Exclusions       `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// scoreCount is the number of responses with one score
+type scoreCount struct {
+	Value float64 `json:"value_number"`
+	Count int     `json:"count"`
+}
+
+// fieldScoreCount is the number of responses with one score to a field. Ent only scans
+// into exported embedded structs, so the scoreCount fields are repeated.
+type fieldScoreCount struct {
+	SourceType string  `json:"source_type"`
+	SourceID   *string `json:"source_id"`
+	FieldID    string  `json:"field_id"`
+	FieldType  string  `json:"field_type"`
+	Value      float64 `json:"value_number"`
+	Count      int     `json:"count"`
+}
+
+// fieldPercentiles are the score percentiles of a field
+type fieldPercentiles struct {
+	SourceType string  `json:"source_type"`
+	SourceID   *string `json:"source_id"`
+	FieldID    string  `json:"field_id"`
+	FieldType  string  `json:"field_type"`
+	Percentiles
+}
+
+// sameField reports whether the scores are responses to the same field
+func (c fieldScoreCount) sameField(other fieldScoreCount) bool {
+	return c.SourceType == other.SourceType && stringValue(c.SourceID) == stringValue(other.SourceID) &&
+		c.FieldID == other.FieldID && c.FieldType == other.FieldType
+}
+
+// ratingKey identifies the field of a rating aggregate
+type ratingKey struct {
+	sourceType, sourceID, fieldID, fieldType string
+}
+
+// key returns the field of the aggregate
+func (a RatingAggregate) key() ratingKey {
+	return ratingKey{a.SourceType, stringValue(a.SourceID), a.FieldID, a.FieldType}
+}
+
+// defaultTopBoxes returns the lowest top-box score of csat and rating fields: the top two
+// points of their scale. The ranges are validated at startup.
+func defaultTopBoxes(cfg *config.Config) map[string]float64 {
+	topBoxes := map[string]float64{}
+	if _, maxScore, err := cfg.GetCSATRange(); err == nil {
+		topBoxes[string(models.FieldTypeCSAT)] = maxScore - 1
+	}
+	if _, maxScore, err := cfg.GetRatingRange(); err == nil {
+		topBoxes[string(models.FieldTypeRating)] = maxScore - 1
+	}
+	return topBoxes
+}
+
+// RegisterAnalyticsRoutes registers the routes that aggregate experiences
+func RegisterAnalyticsRoutes(api huma.API, cfg *config.Config, reader *ent.Client, logger *slog.Logger) {
+	topBoxDefaults := defaultTopBoxes(cfg)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-nps",
+		Method:      "GET",
+		Path:        "/v1/analytics/nps",
+		Summary:     "Get the Net Promoter Score",
+		Description: "Reports the NPS of nps responses with their promoter, passive, and detractor counts, overall and per day, week, or month, optionally compared with the previous period or year.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetNPSInput) (*GetNPSOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		window, err := input.comparisonWindow(input.Compare)
+		if err != nil {
+			return nil, err
+		}
+		base := reader.ExperienceData.Query().
+			Where(
+				experiencedata.FieldTypeEQ(string(models.FieldTypeNPS)),
+				experiencedata.NpsCategoryNotNil(),
+			)
+		query, err := input.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+		categories := []ent.AggregateFunc{
+			countWhere(experiencedata.FieldNpsCategory, models.NPSPromoter, "promoters"),
+			countWhere(experiencedata.FieldNpsCategory, models.NPSPassive, "passives"),
+			countWhere(experiencedata.FieldNpsCategory, models.NPSDetractor, "detractors"),
+		}
+
+		interval := input.Interval
+		if interval == "" {
+			interval = "week"
+		}
+
+		var rows []struct {
+			Period     string `json:"period"`
+			Promoters  int    `json:"promoters"`
+			Passives   int    `json:"passives"`
+			Detractors int    `json:"detractors"`
+		}
+		err = query.
+			Aggregate(append([]ent.AggregateFunc{groupByPeriod(interval, "period")}, categories...)...).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "nps")
+		}
+
+		output := &GetNPSOutput{}
+		output.Body.Interval = interval
+		output.Body.Series = make([]NPSBucket, len(rows))
+		for i, row := range rows {
+			output.Body.Series[i] = NPSBucket{
+				Period:       row.Period,
+				NPSBreakdown: npsBreakdown(row.Promoters, row.Passives, row.Detractors),
+			}
+			output.Body.Promoters += row.Promoters
+			output.Body.Passives += row.Passives
+			output.Body.Detractors += row.Detractors
+		}
+		output.Body.NPSBreakdown = npsBreakdown(output.Body.Promoters, output.Body.Passives, output.Body.Detractors)
+		if output.Body.Excluded, err = countExcluded(ctx, input.AnalyticsFilter, base.Clone(), logger); err != nil {
+			return nil, err
+		}
+
+		if window != nil {
+			previousQuery, err := window.filter(input.AnalyticsFilter, base.Clone())
+			if err != nil {
+				return nil, err
+			}
+			var previous []struct {
+				Promoters  int `json:"promoters"`
+				Passives   int `json:"passives"`
+				Detractors int `json:"detractors"`
+			}
+			if err := previousQuery.Aggregate(categories...).Scan(ctx, &previous); err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "nps comparison")
+			}
+			comparison := &NPSComparison{ComparisonWindow: *window, Previous: npsBreakdown(0, 0, 0)}
+			if len(previous) > 0 {
+				comparison.Previous = npsBreakdown(previous[0].Promoters, previous[0].Passives, previous[0].Detractors)
+			}
+			comparison.ScoreChange = change(output.Body.Score, comparison.Previous.Score, 1)
+			comparison.ResponsesChange = output.Body.Responses - comparison.Previous.Responses
+			output.Body.Comparison = comparison
+		}
+
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-ratings",
+		Method:      "GET",
+		Path:        "/v1/analytics/ratings",
+		Summary:     "Get rating aggregates",
+		Description: "Reports the average, median, percentiles, range, histogram, and top-box percentage of the csat, rating, and number responses of each source and field, optionally compared with the previous period or year.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetRatingsInput) (*GetRatingsOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		window, err := input.comparisonWindow(input.Compare)
+		if err != nil {
+			return nil, err
+		}
+		base := reader.ExperienceData.Query().
+			Where(experiencedata.ValueNumberNotNil())
+		if input.FieldType != "" {
+			base = base.Where(experiencedata.FieldTypeEQ(input.FieldType))
+		} else {
+			base = base.Where(experiencedata.FieldTypeIn(string(models.FieldTypeCSAT), string(models.FieldTypeRating), string(models.FieldTypeNumber)))
+		}
+		query, err := input.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+
+		// aggregate summarizes the scores of each field. Counting the responses per score
+		// keeps exact medians cheap, since scales have few scores.
+		aggregate := func(query *ent.ExperienceDataQuery) ([]RatingAggregate, error) {
+			var rows []fieldScoreCount
+			err := query.Clone().
+				GroupBy(
+					experiencedata.FieldSourceType,
+					experiencedata.FieldSourceID,
+					experiencedata.FieldFieldID,
+					experiencedata.FieldFieldType,
+					experiencedata.FieldValueNumber,
+				).
+				Aggregate(ent.Count()).
+				Scan(ctx, &rows)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "ratings")
+			}
+			slices.SortFunc(rows, func(a, b fieldScoreCount) int {
+				return cmp.Or(
+					cmp.Compare(a.SourceType, b.SourceType),
+					cmp.Compare(stringValue(a.SourceID), stringValue(b.SourceID)),
+					cmp.Compare(a.FieldID, b.FieldID),
+					cmp.Compare(a.FieldType, b.FieldType),
+					cmp.Compare(a.Value, b.Value),
+				)
+			})
+
+			aggregates := []RatingAggregate{}
+			for start := 0; start < len(rows); {
+				end := start + 1
+				for end < len(rows) && rows[end].sameField(rows[start]) {
+					end++
+				}
+
+				scores := make([]scoreCount, 0, end-start)
+				for _, row := range rows[start:end] {
+					scores = append(scores, scoreCount{Value: row.Value, Count: row.Count})
+				}
+				var topBoxMin *float64
+				if input.TopBoxMin != 0 {
+					topBoxMin = &input.TopBoxMin
+				} else if value, ok := topBoxDefaults[rows[start].FieldType]; ok {
+					topBoxMin = &value
+				}
+
+				aggregates = append(aggregates, RatingAggregate{
+					SourceType: rows[start].SourceType,
+					SourceID:   rows[start].SourceID,
+					FieldID:    rows[start].FieldID,
+					FieldType:  rows[start].FieldType,
+					ScoreStats: scoreStats(scores, topBoxMin),
+				})
+				start = end
+			}
+
+			var percentiles []fieldPercentiles
+			err = query.
+				GroupBy(
+					experiencedata.FieldSourceType,
+					experiencedata.FieldSourceID,
+					experiencedata.FieldFieldID,
+					experiencedata.FieldFieldType,
+				).
+				Aggregate(percentileAggregates()...).
+				Scan(ctx, &percentiles)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "rating percentiles")
+			}
+			percentilesByField := make(map[ratingKey]*Percentiles, len(percentiles))
+			for i, row := range percentiles {
+				percentilesByField[ratingKey{row.SourceType, stringValue(row.SourceID), row.FieldID, row.FieldType}] = &percentiles[i].Percentiles
+			}
+			for i := range aggregates {
+				aggregates[i].Percentiles = percentilesByField[aggregates[i].key()]
+			}
+			return aggregates, nil
+		}
+
+		output := &GetRatingsOutput{}
+		if output.Body.Data, err = aggregate(query); err != nil {
+			return nil, err
+		}
+		if output.Body.Excluded, err = countExcluded(ctx, input.AnalyticsFilter, base.Clone(), logger); err != nil {
+			return nil, err
+		}
+
+		if window != nil {
+			previousQuery, err := window.filter(input.AnalyticsFilter, base.Clone())
+			if err != nil {
+				return nil, err
+			}
+			previous, err := aggregate(previousQuery)
+			if err != nil {
+				return nil, err
+			}
+			previousByField := make(map[ratingKey]*ScoreStats, len(previous))
+			for i := range previous {
+				previousByField[previous[i].key()] = &previous[i].ScoreStats
+			}
+			for i := range output.Body.Data {
+				current := &output.Body.Data[i]
+				comparison := &ScoreComparison{ResponsesChange: current.Responses}
+				if stats := previousByField[current.key()]; stats != nil {
+					comparison.Previous = stats
+					comparison.ResponsesChange -= stats.Responses
+					comparison.AverageChange = change(&current.Average, &stats.Average, 2)
+					comparison.TopBoxPercentageChange = change(current.TopBoxPercentage, stats.TopBoxPercentage, 1)
+				}
+				current.Comparison = comparison
+			}
+			output.Body.Comparison = window
+		}
+
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-sentiment",
+		Method:      "GET",
+		Path:        "/v1/analytics/sentiment",
+		Summary:     "Get the sentiment distribution",
+		Description: "Reports the positive, neutral, and negative counts and the average sentiment_score of enriched responses, overall, per day, week, or month, per source type, and for the most frequent topics, optionally compared with the previous period or year.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetSentimentInput) (*GetSentimentOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		window, err := input.comparisonWindow(input.Compare)
+		if err != nil {
+			return nil, err
+		}
+		base := reader.ExperienceData.Query().
+			Where(experiencedata.SentimentNotNil())
+		if input.Topic != "" {
+			base = base.Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(experiencedata.FieldTopics, input.Topic))
+			})
+		}
+		query, err := input.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+
+		interval := input.Interval
+		if interval == "" {
+			interval = "week"
+		}
+		sourceType := func(s *sql.Selector) string {
+			column := s.C(experiencedata.FieldSourceType)
+			s.GroupBy(column).OrderBy(column)
+			return sql.As(column, "group")
+		}
+
+		// Each grouping is a query; the totals have no group
+		type grouping struct {
+			group ent.AggregateFunc
+			rows  *[]sentimentRow
+		}
+		var totals, series, bySourceType, byTopic []sentimentRow
+		groups := []grouping{
+			{nil, &totals},
+			{groupByPeriod(interval, "group"), &series},
+			{sourceType, &bySourceType},
+		}
+		if input.Topics > 0 {
+			groups = append(groups, grouping{groupByTopic("group", input.Topics), &byTopic})
+		}
+		for _, g := range groups {
+			fns := sentimentAggregates()
+			if g.group != nil {
+				fns = append([]ent.AggregateFunc{g.group}, fns...)
+			}
+			if err := query.Clone().Aggregate(fns...).Scan(ctx, g.rows); err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "sentiment")
+			}
+		}
+
+		output := &GetSentimentOutput{}
+		if len(totals) > 0 {
+			output.Body.SentimentBreakdown = totals[0].breakdown()
+		}
+		output.Body.Interval = interval
+		output.Body.Series = make([]SentimentBucket, len(series))
+		for i, row := range series {
+			output.Body.Series[i] = SentimentBucket{Period: row.Group, SentimentBreakdown: row.breakdown()}
+		}
+		output.Body.BySourceType = make([]SourceTypeSentiment, len(bySourceType))
+		for i, row := range bySourceType {
+			output.Body.BySourceType[i] = SourceTypeSentiment{SourceType: row.Group, SentimentBreakdown: row.breakdown()}
+		}
+		output.Body.ByTopic = make([]TopicSentiment, len(byTopic))
+		for i, row := range byTopic {
+			output.Body.ByTopic[i] = TopicSentiment{Topic: row.Group, SentimentBreakdown: row.breakdown()}
+		}
+		if output.Body.Excluded, err = countExcluded(ctx, input.AnalyticsFilter, base.Clone(), logger); err != nil {
+			return nil, err
+		}
+
+		if window != nil {
+			previousQuery, err := window.filter(input.AnalyticsFilter, base.Clone())
+			if err != nil {
+				return nil, err
+			}
+			var previous []sentimentRow
+			if err := previousQuery.Aggregate(sentimentAggregates()...).Scan(ctx, &previous); err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "sentiment comparison")
+			}
+			comparison := &SentimentComparison{ComparisonWindow: *window}
+			if len(previous) > 0 {
+				comparison.Previous = previous[0].breakdown()
+			}
+			current, before := output.Body.SentimentBreakdown, comparison.Previous
+			comparison.ResponsesChange = current.Responses - before.Responses
+			if current.Responses > 0 && before.Responses > 0 {
+				shareChange := func(count, previousCount int) *float64 {
+					pp := math.Round((percentage(count, current.Responses)-percentage(previousCount, before.Responses))*10) / 10
+					return &pp
+				}
+				comparison.PositiveShareChange = shareChange(current.Positive, before.Positive)
+				comparison.NeutralShareChange = shareChange(current.Neutral, before.Neutral)
+				comparison.NegativeShareChange = shareChange(current.Negative, before.Negative)
+			}
+			comparison.AverageScoreChange = change(current.AverageScore, before.AverageScore, 3)
+			output.Body.Comparison = comparison
+		}
+
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-topic-trends",
+		Method:      "GET",
+		Path:        "/v1/analytics/topics",
+		Summary:     "Get trending topics",
+		Description: "Compares how often each AI-extracted topic was mentioned from since to until with the period of the same length before, and lists the most frequent, rising, and falling topics with example experiences. The period defaults to the last 7 days.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetTopicTrendsInput) (*GetTopicTrendsOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		until := time.Now().UTC()
+		if input.Until != "" {
+			untilTime, err := time.Parse(time.RFC3339, input.Until)
+			if err != nil {
+				return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+			}
+			until = untilTime.UTC()
+		}
+		since := until.Add(-defaultTopicWindow)
+		if input.Since != "" {
+			sinceTime, err := time.Parse(time.RFC3339, input.Since)
+			if err != nil {
+				return nil, invalidTimestamp("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+			}
+			since = sinceTime.UTC()
+		}
+		if !since.Before(until) {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidTimeRange, ErrMsgInvalidInput+"since must be before until")
+		}
+		previousSince := since.Add(-until.Sub(since))
+
+		// Both periods are read at once, so the filter's own since and until aren't applied
+		filter := input.AnalyticsFilter
+		filter.Since, filter.Until = "", ""
+		base := reader.ExperienceData.Query().
+			Where(
+				experiencedata.CollectedAtGTE(previousSince),
+				experiencedata.CollectedAtLTE(until),
+			)
+		query, err := filter.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+
+		var rows []struct {
+			Topic         string `json:"topic"`
+			Count         int    `json:"count"`
+			PreviousCount int    `json:"previous_count"`
+		}
+		err = query.
+			Aggregate(
+				groupByTopic("topic", 0),
+				countBetween(since, until, true, "count"),
+				countBetween(previousSince, since, false, "previous_count"),
+			).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "topics")
+		}
+
+		trends := make([]TopicTrend, len(rows))
+		for i, row := range rows {
+			trends[i] = TopicTrend{
+				Topic:         row.Topic,
+				Count:         row.Count,
+				PreviousCount: row.PreviousCount,
+				Change:        row.Count - row.PreviousCount,
+			}
+			if row.PreviousCount > 0 {
+				percentage := math.Round(float64(trends[i].Change)/float64(row.PreviousCount)*1000) / 10
+				trends[i].ChangePercentage = &percentage
+			}
+		}
+
+		output := &GetTopicTrendsOutput{}
+		output.Body.Since = since
+		output.Body.Until = until
+		output.Body.PreviousSince = previousSince
+		if output.Body.Excluded, err = countExcluded(ctx, filter, base, logger); err != nil {
+			return nil, err
+		}
+		output.Body.Topics = topTrends(trends, input.Limit, func(t TopicTrend) bool { return t.Count > 0 }, func(a, b TopicTrend) int {
+			return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Topic, b.Topic))
+		})
+		output.Body.Rising = topTrends(trends, input.Limit, func(t TopicTrend) bool { return t.Change > 0 }, func(a, b TopicTrend) int {
+			return cmp.Or(cmp.Compare(b.Change, a.Change), cmp.Compare(b.Count, a.Count), cmp.Compare(a.Topic, b.Topic))
+		})
+		output.Body.Falling = topTrends(trends, input.Limit, func(t TopicTrend) bool { return t.Change < 0 }, func(a, b TopicTrend) int {
+			return cmp.Or(cmp.Compare(a.Change, b.Change), cmp.Compare(b.PreviousCount, a.PreviousCount), cmp.Compare(a.Topic, b.Topic))
+		})
+
+		if input.Examples > 0 {
+			for _, list := range [][]TopicTrend{output.Body.Rising, output.Body.Falling} {
+				for i := range list {
+					ids, err := query.Clone().
+						Where(func(s *sql.Selector) {
+							s.Where(sqljson.ValueContains(experiencedata.FieldTopics, list[i].Topic))
+						}).
+						Order(ent.Desc(experiencedata.FieldCollectedAt)).
+						Limit(input.Examples).
+						IDs(ctx)
+					if err != nil {
+						return nil, handleDatabaseError(logger, err, "list", "topic examples")
+					}
+					list[i].ExampleIDs = ids
+				}
+			}
+		}
+
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-terms",
+		Method:      "GET",
+		Path:        "/v1/analytics/terms",
+		Summary:     "Get word frequencies",
+		Description: "Counts the most frequent words and pairs of adjacent words in the value_text of text responses, without stopwords of the response's language (en, de, fr, es, pt, it, and nl; English if the language is unset), to power word clouds without exporting raw text. Terms are counted in the 10,000 most recent matching responses.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetTermsInput) (*GetTermsOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		base := reader.ExperienceData.Query().
+			Where(
+				experiencedata.FieldTypeEQ(string(models.FieldTypeText)),
+				experiencedata.ValueTextNotNil(),
+			)
+		if input.Language != "" {
+			base = base.Where(experiencedata.LanguageEQ(input.Language))
+		}
+		query, err := input.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+
+		var rows []struct {
+			ValueText string  `json:"value_text"`
+			Language  *string `json:"language"`
+		}
+		err = query.
+			Order(ent.Desc(experiencedata.FieldCollectedAt)).
+			Limit(maxTermTexts+1).
+			Select(experiencedata.FieldValueText, experiencedata.FieldLanguage).
+			Scan(ctx, &rows)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "terms")
+		}
+
+		output := &GetTermsOutput{}
+		if len(rows) > maxTermTexts {
+			rows = rows[:maxTermTexts]
+			output.Body.Sampled = true
+		}
+		texts := make([]terms.Text, len(rows))
+		for i, row := range rows {
+			texts[i] = terms.Text{Text: row.ValueText, Language: stringValue(row.Language)}
+		}
+		counts := terms.Count(texts, cmp.Or(input.Limit, 50))
+
+		termCounts := func(list []terms.Term) []TermCount {
+			result := make([]TermCount, len(list))
+			for i, term := range list {
+				result[i] = TermCount{Term: term.Term, Count: term.Count, Responses: term.Responses}
+			}
+			return result
+		}
+		output.Body.Responses = len(rows)
+		output.Body.Unigrams = termCounts(counts.Unigrams)
+		output.Body.Bigrams = termCounts(counts.Bigrams)
+		if output.Body.Excluded, err = countExcluded(ctx, input.AnalyticsFilter, base, logger); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-time-series",
+		Method:      "GET",
+		Path:        "/v1/analytics/timeseries",
+		Summary:     "Get a time series",
+		Description: "Reports the number of experiences, or the mean value_number or sentiment_score, per hour, day, week, or month, optionally split by a column or metadata key, so dashboards can be built without SQL access.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetTimeSeriesInput) (*GetTimeSeriesOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		metric := cmp.Or(input.Metric, "count")
+		interval := cmp.Or(input.Interval, "day")
+
+		var group ent.AggregateFunc
+		if input.GroupBy != "" {
+			var err error
+			if group, err = groupByDimension(input.GroupBy, "group_by", "group"); err != nil {
+				return nil, err
+			}
+		}
+
+		base := reader.ExperienceData.Query()
+		if input.FieldType != "" {
+			base = base.Where(experiencedata.FieldTypeEQ(input.FieldType))
+		}
+		var value ent.AggregateFunc
+		switch metric {
+		case "avg_value_number":
+			base = base.Where(experiencedata.ValueNumberNotNil())
+			value = ent.As(ent.Mean(experiencedata.FieldValueNumber), "value")
+		case "avg_sentiment_score":
+			base = base.Where(experiencedata.SentimentScoreNotNil())
+			value = ent.As(ent.Mean(experiencedata.FieldSentimentScore), "value")
+		default:
+			value = func(s *sql.Selector) string { return sql.As("count(*)::float8", "value") }
+		}
+		query, err := input.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+
+		fns := []ent.AggregateFunc{
+			groupByPeriod(interval, "period"),
+			value,
+			ent.As(ent.Count(), "count"),
+		}
+		if group != nil {
+			fns = append(fns, group)
+		}
+		var rows []struct {
+			Period string   `json:"period"`
+			Group  *string  `json:"group"`
+			Value  *float64 `json:"value"`
+			Count  int      `json:"count"`
+		}
+		if err := query.Aggregate(fns...).Scan(ctx, &rows); err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "time series")
+		}
+
+		// Rows are ordered by period, so the points of each series are too. Text in PostgreSQL
+		// can't contain NUL, so it keys the experiences without a group value.
+		var series []TimeSeries
+		index := map[string]int{}
+		for _, row := range rows {
+			key := "\x00"
+			if row.Group != nil {
+				key = *row.Group
+			}
+			i, ok := index[key]
+			if !ok {
+				i = len(series)
+				index[key] = i
+				series = append(series, TimeSeries{Group: row.Group})
+			}
+			series[i].Count += row.Count
+			series[i].Points = append(series[i].Points, TimeSeriesPoint{Period: row.Period, Value: row.Value, Count: row.Count})
+		}
+		slices.SortStableFunc(series, func(a, b TimeSeries) int { return cmp.Compare(b.Count, a.Count) })
+		limit := cmp.Or(input.Limit, 10)
+		if len(series) > limit {
+			series = series[:limit]
+		}
+
+		output := &GetTimeSeriesOutput{}
+		output.Body.Metric = metric
+		output.Body.Interval = interval
+		output.Body.GroupBy = input.GroupBy
+		output.Body.Series = append([]TimeSeries{}, series...)
+		if output.Body.Excluded, err = countExcluded(ctx, input.AnalyticsFilter, base, logger); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-crosstab",
+		Method:      "GET",
+		Path:        "/v1/analytics/crosstab",
+		Summary:     "Get a cross-tab",
+		Description: "Counts experiences by the values of two columns or metadata keys, like sentiment by country or NPS category by plan, with the share of each combination of all experiences, of its row, and of its column.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetCrossTabInput) (*GetCrossTabOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		if input.Rows == input.Columns {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"rows and columns must differ")
+		}
+		rowGroup, err := groupByDimension(input.Rows, "rows", "row")
+		if err != nil {
+			return nil, err
+		}
+		columnGroup, err := groupByDimension(input.Columns, "columns", "column")
+		if err != nil {
+			return nil, err
+		}
+
+		base := reader.ExperienceData.Query()
+		if input.FieldType != "" {
+			base = base.Where(experiencedata.FieldTypeEQ(input.FieldType))
+		}
+		query, err := input.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+
+		var rows []struct {
+			Row    *string `json:"row"`
+			Column *string `json:"column"`
+			Count  int     `json:"count"`
+		}
+		err = query.Aggregate(rowGroup, columnGroup, ent.As(ent.Count(), "count")).Scan(ctx, &rows)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "cross-tab")
+		}
+
+		// Text in PostgreSQL can't contain NUL, so it keys the experiences without a value
+		key := func(value *string) string {
+			if value == nil {
+				return "\x00"
+			}
+			return *value
+		}
+		add := func(totals map[string]*CrossTabTotal, value *string, count int) {
+			if totals[key(value)] == nil {
+				totals[key(value)] = &CrossTabTotal{Value: value}
+			}
+			totals[key(value)].Count += count
+		}
+		total := 0
+		rowTotals, columnTotals := map[string]*CrossTabTotal{}, map[string]*CrossTabTotal{}
+		for _, row := range rows {
+			total += row.Count
+			add(rowTotals, row.Row, row.Count)
+			add(columnTotals, row.Column, row.Count)
+		}
+
+		limit := cmp.Or(input.Limit, 20)
+		// largest keeps the limit values with the most experiences, and ranks them
+		largest := func(totals map[string]*CrossTabTotal) ([]CrossTabTotal, map[string]int) {
+			sorted := make([]CrossTabTotal, 0, len(totals))
+			for _, t := range totals {
+				t.Percentage = percentage(t.Count, total)
+				sorted = append(sorted, *t)
+			}
+			slices.SortFunc(sorted, func(a, b CrossTabTotal) int {
+				return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(key(a.Value), key(b.Value)))
+			})
+			sorted = sorted[:min(len(sorted), limit)]
+			rank := make(map[string]int, len(sorted))
+			for i, t := range sorted {
+				rank[key(t.Value)] = i
+			}
+			return sorted, rank
+		}
+		output := &GetCrossTabOutput{}
+		output.Body.Rows = input.Rows
+		output.Body.Columns = input.Columns
+		output.Body.Total = total
+		if output.Body.Excluded, err = countExcluded(ctx, input.AnalyticsFilter, base, logger); err != nil {
+			return nil, err
+		}
+		var rowRank, columnRank map[string]int
+		output.Body.RowTotals, rowRank = largest(rowTotals)
+		output.Body.ColumnTotals, columnRank = largest(columnTotals)
+
+		output.Body.Cells = []CrossTabCell{}
+		for _, row := range rows {
+			rowKey, columnKey := key(row.Row), key(row.Column)
+			if _, ok := rowRank[rowKey]; !ok {
+				continue
+			}
+			if _, ok := columnRank[columnKey]; !ok {
+				continue
+			}
+			output.Body.Cells = append(output.Body.Cells, CrossTabCell{
+				Row:              row.Row,
+				Column:           row.Column,
+				Count:            row.Count,
+				Percentage:       percentage(row.Count, total),
+				RowPercentage:    percentage(row.Count, rowTotals[rowKey].Count),
+				ColumnPercentage: percentage(row.Count, columnTotals[columnKey].Count),
+			})
+		}
+		slices.SortFunc(output.Body.Cells, func(a, b CrossTabCell) int {
+			return cmp.Or(
+				cmp.Compare(rowRank[key(a.Row)], rowRank[key(b.Row)]),
+				cmp.Compare(columnRank[key(a.Column)], columnRank[key(b.Column)]),
+			)
+		})
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-overview",
+		Method:      "GET",
+		Path:        "/v1/analytics/overview",
+		Summary:     "Get a dashboard overview",
+		Description: "Reports the number of experiences overall and in the last 7 and 30 days, and the NPS, CSAT, sentiment split, and most frequent topics of the last 30 days, so a dashboard renders with a single request. The days count back from until, or from now.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetOverviewInput) (*GetOverviewOutput, error) {
+		if err := input.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		asOf := time.Now().UTC()
+		if input.Until != "" {
+			untilTime, err := time.Parse(time.RFC3339, input.Until)
+			if err != nil {
+				return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+			}
+			asOf = untilTime.UTC()
+		}
+		base := reader.ExperienceData.Query()
+		query, err := input.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+		recent := func() *ent.ExperienceDataQuery {
+			return query.Clone().Where(
+				experiencedata.CollectedAtGTE(asOf.Add(-overviewWindow)),
+				experiencedata.CollectedAtLTE(asOf),
+			)
+		}
+
+		var totals []struct {
+			Responses  int `json:"responses"`
+			Last7Days  int `json:"last_7_days"`
+			Last30Days int `json:"last_30_days"`
+		}
+		err = query.Clone().
+			Aggregate(
+				ent.As(ent.Count(), "responses"),
+				countBetween(asOf.Add(-7*24*time.Hour), asOf, true, "last_7_days"),
+				countBetween(asOf.Add(-overviewWindow), asOf, true, "last_30_days"),
+			).
+			Scan(ctx, &totals)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "overview")
+		}
+
+		var nps []struct {
+			Promoters  int `json:"promoters"`
+			Passives   int `json:"passives"`
+			Detractors int `json:"detractors"`
+		}
+		err = recent().
+			Where(
+				experiencedata.FieldTypeEQ(string(models.FieldTypeNPS)),
+				experiencedata.NpsCategoryNotNil(),
+			).
+			Aggregate(
+				countWhere(experiencedata.FieldNpsCategory, models.NPSPromoter, "promoters"),
+				countWhere(experiencedata.FieldNpsCategory, models.NPSPassive, "passives"),
+				countWhere(experiencedata.FieldNpsCategory, models.NPSDetractor, "detractors"),
+			).
+			Scan(ctx, &nps)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "overview nps")
+		}
+
+		var scores []scoreCount
+		err = recent().
+			Where(
+				experiencedata.FieldTypeEQ(string(models.FieldTypeCSAT)),
+				experiencedata.ValueNumberNotNil(),
+			).
+			Order(ent.Asc(experiencedata.FieldValueNumber)).
+			GroupBy(experiencedata.FieldValueNumber).
+			Aggregate(ent.Count()).
+			Scan(ctx, &scores)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "overview csat")
+		}
+
+		var sentiment []sentimentRow
+		err = recent().
+			Where(experiencedata.SentimentNotNil()).
+			Aggregate(sentimentAggregates()...).
+			Scan(ctx, &sentiment)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "overview sentiment")
+		}
+
+		output := &GetOverviewOutput{}
+		output.Body.Topics = []TopicCount{}
+		if input.Topics > 0 {
+			err = recent().
+				Aggregate(groupByTopic("topic", input.Topics), ent.As(ent.Count(), "count")).
+				Scan(ctx, &output.Body.Topics)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "overview topics")
+			}
+		}
+
+		output.Body.AsOf = asOf
+		if output.Body.Excluded, err = countExcluded(ctx, input.AnalyticsFilter, base, logger); err != nil {
+			return nil, err
+		}
+		if len(totals) > 0 {
+			output.Body.Responses = totals[0].Responses
+			output.Body.Last7Days = totals[0].Last7Days
+			output.Body.Last30Days = totals[0].Last30Days
+		}
+		output.Body.NPS = npsBreakdown(0, 0, 0)
+		if len(nps) > 0 {
+			output.Body.NPS = npsBreakdown(nps[0].Promoters, nps[0].Passives, nps[0].Detractors)
+		}
+		if len(scores) > 0 {
+			var topBoxMin *float64
+			if value, ok := topBoxDefaults[string(models.FieldTypeCSAT)]; ok {
+				topBoxMin = &value
+			}
+			csat := scoreStats(scores, topBoxMin)
+			output.Body.CSAT = &csat
+		}
+		if len(sentiment) > 0 {
+			output.Body.Sentiment = sentiment[0].breakdown()
+		}
+		return output, nil
+	})
+}
+
+// metadataKeyPattern matches the metadata keys analytics can be grouped by
+var metadataKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// groupByDimension groups rows by a column of dimensionColumns or a metadata key
+// (metadata.<key>). Other dimensions are rejected, naming the query parameter they were
+// set with, since the dimension is put into the query.
+func groupByDimension(dimension, param, alias string) (ent.AggregateFunc, error) {
+	var expr func(s *sql.Selector) string
+	switch key, isMetadata := strings.CutPrefix(dimension, "metadata."); {
+	case isMetadata && metadataKeyPattern.MatchString(key):
+		expr = func(s *sql.Selector) string {
+			return fmt.Sprintf("%s->>'%s'", s.C(experiencedata.FieldMetadata), key)
+		}
+	case slices.Contains(dimensionColumns, dimension):
+		expr = func(s *sql.Selector) string { return s.C(dimension) }
+	default:
+		return nil, problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+			fmt.Sprintf("%s%s must be one of %s, or metadata.<key>", ErrMsgInvalidInput, param, strings.Join(dimensionColumns, ", ")))
+	}
+	return func(s *sql.Selector) string {
+		e := expr(s)
+		s.GroupBy(e)
+		return sql.As(e, alias)
+	}, nil
+}
+
+// groupByTopic groups rows by each of their topics, so a row with several topics counts once
+// for each. Topics are returned most frequent first, at most limit of them unless it's 0.
+func groupByTopic(alias string, limit int) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		// Topics that aren't an array (JSON null) yield no rows instead of failing the query
+		column := s.C(experiencedata.FieldTopics)
+		s.AppendFromExpr(sql.Expr(fmt.Sprintf("jsonb_array_elements_text(CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s END) AS topic", column)))
+		s.GroupBy("topic").OrderBy("count(*) DESC", "topic")
+		if limit > 0 {
+			s.Limit(limit)
+		}
+		return sql.As("topic", alias)
+	}
+}
+
+// topTrends returns up to limit of the trends that match, sorted with compare
+func topTrends(trends []TopicTrend, limit int, match func(TopicTrend) bool, compare func(a, b TopicTrend) int) []TopicTrend {
+	var matching []TopicTrend
+	for _, trend := range trends {
+		if match(trend) {
+			matching = append(matching, trend)
+		}
+	}
+	slices.SortFunc(matching, compare)
+	if len(matching) > limit {
+		matching = matching[:limit]
+	}
+	if matching == nil {
+		return []TopicTrend{}
+	}
+	return matching
+}
+
+// groupByPeriod groups rows by the UTC start day (YYYY-MM-DD) of the day, week, or month
+// they were collected in, or by the start of the hour as an RFC 3339 timestamp. The interval
+// must be one of these, as it's put into the query.
+func groupByPeriod(interval, alias string) ent.AggregateFunc {
+	layout := "YYYY-MM-DD"
+	if interval == "hour" {
+		layout = `YYYY-MM-DD"T"HH24":00:00Z"`
+	}
+	return func(s *sql.Selector) string {
+		expr := fmt.Sprintf("to_char(date_trunc('%s', %s AT TIME ZONE 'UTC'), '%s')", interval, s.C(experiencedata.FieldCollectedAt), layout)
+		s.GroupBy(expr).OrderBy(expr)
+		return sql.As(expr, alias)
+	}
+}
+
+// sentimentAggregates compute the columns of a sentimentRow besides the group
+func sentimentAggregates() []ent.AggregateFunc {
+	return []ent.AggregateFunc{
+		ent.As(ent.Count(), "responses"),
+		countWhere(experiencedata.FieldSentiment, "positive", "positive"),
+		countWhere(experiencedata.FieldSentiment, "neutral", "neutral"),
+		countWhere(experiencedata.FieldSentiment, "negative", "negative"),
+		ent.As(ent.Mean(experiencedata.FieldSentimentScore), "average_score"),
+	}
+}
+
+// countBetween counts the rows collected from from up to to. The bounds are formatted
+// from times, so they're safe to put into the query.
+func countBetween(from, to time.Time, toInclusive bool, alias string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		op := "<"
+		if toInclusive {
+			op = "<="
+		}
+		column := s.C(experiencedata.FieldCollectedAt)
+		return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %[1]s >= '%[2]s' AND %[1]s %[3]s '%[4]s')",
+			column, from.Format(time.RFC3339Nano), op, to.Format(time.RFC3339Nano)), alias)
+	}
+}
+
+// countWhere counts the rows whose column has the value, which must be a constant
+func countWhere(column, value, alias string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s = '%s')", s.C(column), value), alias)
+	}
+}
+
+// percentileAggregates computes the Percentiles of value_number
+func percentileAggregates() []ent.AggregateFunc {
+	var aggregates []ent.AggregateFunc
+	for _, percentile := range []int{50, 75, 90, 95} {
+		aggregates = append(aggregates, func(s *sql.Selector) string {
+			return sql.As(fmt.Sprintf("round((percentile_cont(%.2f) WITHIN GROUP (ORDER BY %s))::numeric, 2)::float8",
+				float64(percentile)/100, s.C(experiencedata.FieldValueNumber)), fmt.Sprintf("p%d", percentile))
+		})
+	}
+	return aggregates
+}
+
+// scoreStats summarizes the score counts of a field, which must be sorted by score.
+// topBoxMin is nil if the field has no top box.
+func scoreStats(scores []scoreCount, topBoxMin *float64) ScoreStats {
+	var aggregate ScoreStats
+	if len(scores) == 0 {
+		return aggregate
+	}
+
+	var sum float64
+	topBox := 0
+	for _, score := range scores {
+		aggregate.Responses += score.Count
+		sum += score.Value * float64(score.Count)
+		if topBoxMin != nil && score.Value >= *topBoxMin {
+			topBox += score.Count
+		}
+	}
+	aggregate.Average = math.Round(sum/float64(aggregate.Responses)*100) / 100
+	aggregate.Min = scores[0].Value
+	aggregate.Max = scores[len(scores)-1].Value
+
+	// The median is the middle score, or the mean of the two middle scores
+	lower, upper := (aggregate.Responses-1)/2, aggregate.Responses/2
+	var lowerValue float64
+	seen := 0
+	for _, score := range scores {
+		if seen <= lower && lower < seen+score.Count {
+			lowerValue = score.Value
+		}
+		if seen <= upper && upper < seen+score.Count {
+			aggregate.Median = (lowerValue + score.Value) / 2
+			break
+		}
+		seen += score.Count
+	}
+
+	if topBoxMin != nil {
+		percentage := math.Round(float64(topBox)/float64(aggregate.Responses)*1000) / 10
+		aggregate.TopBoxMin = topBoxMin
+		aggregate.TopBoxPercentage = &percentage
+	}
+
+	if len(scores) <= maxHistogramValues {
+		aggregate.Histogram = make([]HistogramBin, len(scores))
+		for i, score := range scores {
+			aggregate.Histogram[i] = HistogramBin{From: score.Value, To: score.Value, Count: score.Count}
+		}
+		return aggregate
+	}
+
+	width := (aggregate.Max - aggregate.Min) / histogramBins
+	aggregate.Histogram = make([]HistogramBin, histogramBins)
+	for i := range aggregate.Histogram {
+		aggregate.Histogram[i].From = aggregate.Min + float64(i)*width
+		aggregate.Histogram[i].To = aggregate.Min + float64(i+1)*width
+	}
+	aggregate.Histogram[histogramBins-1].To = aggregate.Max
+	for _, score := range scores {
+		bin := min(int((score.Value-aggregate.Min)/width), histogramBins-1)
+		aggregate.Histogram[bin].Count += score.Count
+	}
+	return aggregate
+}
+
+// npsBreakdown computes the NPS from the category counts
+func npsBreakdown(promoters, passives, detractors int) NPSBreakdown {
+	breakdown := NPSBreakdown{
+		Responses:  promoters + passives + detractors,
+		Promoters:  promoters,
+		Passives:   passives,
+		Detractors: detractors,
+	}
+	if breakdown.Responses > 0 {
+		score := math.Round(float64(promoters-detractors)/float64(breakdown.Responses)*1000) / 10
+		breakdown.Score = &score
+	}
+	return breakdown
+}
+
+// change returns current minus previous rounded to the decimals of the values, or nil if
+// either is missing
+func change(current, previous *float64, decimals int) *float64 {
+	if current == nil || previous == nil {
+		return nil
+	}
+	scale := math.Pow10(decimals)
+	difference := math.Round((*current-*previous)*scale) / scale
+	return &difference
+}
+
+// percentage returns count as a percentage of total, rounded to one decimal
+func percentage(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*1000) / 10
+}
+
+// stringValue returns the string s points to, or "" if s is nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,708 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+func TestScoreStats(t *testing.T) {
+	topBoxMin := 4.0
+
+	t.Run("csat scale", func(t *testing.T) {
+		// 1, 3, 4, 4, 5, 5
+		got := scoreStats([]scoreCount{{1, 1}, {3, 1}, {4, 2}, {5, 2}}, &topBoxMin)
+		if got.Responses != 6 || got.Average != 3.67 || got.Median != 4 || got.Min != 1 || got.Max != 5 {
+			t.Errorf("unexpected aggregate: %+v", got)
+		}
+		if got.TopBoxPercentage == nil || *got.TopBoxPercentage != 66.7 {
+			t.Errorf("expected top box 66.7%%, got %v", got.TopBoxPercentage)
+		}
+		if len(got.Histogram) != 4 || got.Histogram[2] != (HistogramBin{From: 4, To: 4, Count: 2}) {
+			t.Errorf("unexpected histogram: %+v", got.Histogram)
+		}
+	})
+
+	t.Run("median of an even count", func(t *testing.T) {
+		got := scoreStats([]scoreCount{{2, 1}, {3, 1}}, nil)
+		if got.Median != 2.5 {
+			t.Errorf("expected median 2.5, got %v", got.Median)
+		}
+		if got.TopBoxMin != nil || got.TopBoxPercentage != nil {
+			t.Errorf("expected no top box, got %+v", got)
+		}
+	})
+
+	t.Run("binned numbers", func(t *testing.T) {
+		var scores []scoreCount
+		for value := 0; value <= 100; value++ {
+			scores = append(scores, scoreCount{float64(value), 1})
+		}
+		got := scoreStats(scores, nil)
+		if len(got.Histogram) != histogramBins {
+			t.Fatalf("expected %d bins, got %d", histogramBins, len(got.Histogram))
+		}
+		total := 0
+		for _, bin := range got.Histogram {
+			total += bin.Count
+		}
+		if total != 101 || got.Histogram[0].From != 0 || got.Histogram[histogramBins-1].To != 100 || got.Histogram[histogramBins-1].Count != 11 {
+			t.Errorf("unexpected histogram: %+v", got.Histogram)
+		}
+	})
+
+	t.Run("no scores", func(t *testing.T) {
+		if got := scoreStats(nil, &topBoxMin); got.Responses != 0 || got.TopBoxPercentage != nil {
+			t.Errorf("expected an empty aggregate, got %+v", got)
+		}
+	})
+}
+
+func TestNPSBreakdown(t *testing.T) {
+	got := npsBreakdown(5, 3, 2)
+	if got.Responses != 10 || got.Score == nil || *got.Score != 30 {
+		t.Errorf("unexpected breakdown: %+v", got)
+	}
+	if got := npsBreakdown(0, 0, 0); got.Score != nil {
+		t.Errorf("expected no score without responses, got %v", *got.Score)
+	}
+}
+
+func TestComparisonWindow(t *testing.T) {
+	filter := AnalyticsFilter{Since: "2024-04-01T00:00:00Z", Until: "2024-07-01T00:00:00Z"}
+
+	window, err := filter.comparisonWindow(comparePreviousPeriod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !window.Since.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) || !window.Until.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)) || !window.untilExclusive {
+		t.Errorf("unexpected previous period: %+v", window)
+	}
+
+	window, err = filter.comparisonWindow(comparePreviousYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !window.Since.Equal(time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)) || !window.Until.Equal(time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)) || window.untilExclusive {
+		t.Errorf("unexpected previous year: %+v", window)
+	}
+
+	if window, err := filter.comparisonWindow(""); window != nil || err != nil {
+		t.Errorf("expected no comparison, got %+v (%v)", window, err)
+	}
+	for _, invalid := range []AnalyticsFilter{{}, {Since: "2024-07-01T00:00:00Z", Until: "2024-04-01T00:00:00Z"}} {
+		if _, err := invalid.comparisonWindow(comparePreviousPeriod); err == nil {
+			t.Errorf("expected %+v to be rejected", invalid)
+		}
+	}
+}
+
+func TestChange(t *testing.T) {
+	current, previous := 4.2, 3.9
+	if got := change(&current, &previous, 2); got == nil || *got != 0.3 {
+		t.Errorf("expected 0.3, got %v", got)
+	}
+	if got := change(&current, nil, 2); got != nil {
+		t.Errorf("expected no change without a previous value, got %v", *got)
+	}
+}
+
+func TestTopTrends(t *testing.T) {
+	trends := []TopicTrend{
+		{Topic: "pricing", Count: 5, PreviousCount: 1, Change: 4},
+		{Topic: "onboarding", Count: 0, PreviousCount: 3, Change: -3},
+		{Topic: "support", Count: 2, PreviousCount: 2, Change: 0},
+		{Topic: "billing", Count: 3, PreviousCount: 1, Change: 2},
+	}
+	rising := topTrends(trends, 1, func(t TopicTrend) bool { return t.Change > 0 }, func(a, b TopicTrend) int { return b.Change - a.Change })
+	if len(rising) != 1 || rising[0].Topic != "pricing" {
+		t.Errorf("expected pricing to rise most, got %+v", rising)
+	}
+	if none := topTrends(trends, 10, func(t TopicTrend) bool { return t.Change > 10 }, nil); none == nil || len(none) != 0 {
+		t.Errorf("expected an empty list, got %#v", none)
+	}
+}
+
+func TestNPSAnalytics(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	// Two promoters, a passive, and a detractor in January and one detractor in February
+	ctx := context.Background()
+	for _, response := range []struct {
+		score       float64
+		collectedAt time.Time
+	}{
+		{10, time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)},
+		{9, time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)},
+		{7, time.Date(2024, 1, 17, 12, 0, 0, 0, time.UTC)},
+		{3, time.Date(2024, 1, 24, 12, 0, 0, 0, time.UTC)},
+		{0, time.Date(2024, 2, 7, 12, 0, 0, 0, time.UTC)},
+	} {
+		score := response.score
+		_, err := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetSourceID("survey-123").
+			SetFieldID("nps_score").
+			SetFieldType("nps").
+			SetValueNumber(score).
+			SetNillableNpsCategory(models.NPSCategory("nps", &score)).
+			SetCollectedAt(response.collectedAt).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	t.Run("monthly series", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/nps?source_id=survey-123&interval=month")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetNPSOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if report.Body.Responses != 5 || report.Body.Promoters != 2 || report.Body.Passives != 1 || report.Body.Detractors != 2 {
+			t.Errorf("unexpected counts: %+v", report.Body.NPSBreakdown)
+		}
+		if report.Body.Score == nil || *report.Body.Score != 0 {
+			t.Errorf("expected score 0, got %v", report.Body.Score)
+		}
+		if len(report.Body.Series) != 2 {
+			t.Fatalf("expected 2 periods, got %+v", report.Body.Series)
+		}
+		if january := report.Body.Series[0]; january.Period != "2024-01-01" || january.Score == nil || *january.Score != 25 {
+			t.Errorf("unexpected January bucket: %+v", january)
+		}
+		if february := report.Body.Series[1]; february.Period != "2024-02-01" || february.Score == nil || *february.Score != -100 {
+			t.Errorf("unexpected February bucket: %+v", february)
+		}
+	})
+
+	t.Run("no responses", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/nps?source_id=unknown")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if !strings.Contains(resp.Body.String(), `"score":null`) || !strings.Contains(resp.Body.String(), `"series":[]`) {
+			t.Errorf("expected an empty report, got %s", resp.Body.String())
+		}
+	})
+
+	t.Run("previous period", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/nps?since=2024-02-01T00:00:00Z&until=2024-03-01T00:00:00Z&compare=previous_period")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetNPSOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		comparison := report.Body.Comparison
+		if comparison == nil || !comparison.Since.Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)) {
+			t.Fatalf("expected the 29 days before February, got %+v", comparison)
+		}
+		if comparison.Previous.Responses != 4 || comparison.ResponsesChange != -3 {
+			t.Errorf("unexpected previous responses: %+v", comparison)
+		}
+		if comparison.ScoreChange == nil || *comparison.ScoreChange != -125 {
+			t.Errorf("expected a score change of -125, got %v", comparison.ScoreChange)
+		}
+	})
+
+	t.Run("compare without since", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/nps?compare=previous_year")
+		if resp.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", resp.Code)
+		}
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/nps?since=yesterday")
+		if resp.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", resp.Code)
+		}
+	})
+}
+
+func TestRatingAnalytics(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	// csat scores of 1-5 (see setupTestAPI) and an unrelated text response
+	ctx := context.Background()
+	for _, score := range []float64{2, 4, 5, 5} {
+		_, err := client.ExperienceData.Create().
+			SetSourceType("support").
+			SetSourceID("ticket-survey").
+			SetFieldID("satisfaction").
+			SetFieldType("csat").
+			SetValueNumber(score).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+	_, err := client.ExperienceData.Create().
+		SetSourceType("support").
+		SetSourceID("ticket-survey").
+		SetFieldID("comment").
+		SetFieldType("text").
+		SetValueText("Quick reply").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create test experience: %v", err)
+	}
+
+	resp := api.Get("/v1/analytics/ratings?source_id=ticket-survey")
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var report GetRatingsOutput
+	if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Body.Data) != 1 {
+		t.Fatalf("expected aggregates of one field, got %+v", report.Body.Data)
+	}
+	got := report.Body.Data[0]
+	if got.FieldID != "satisfaction" || got.Responses != 4 || got.Average != 4 || got.Median != 4.5 || len(got.Histogram) != 3 {
+		t.Errorf("unexpected aggregate: %+v", got)
+	}
+	if got.TopBoxMin == nil || *got.TopBoxMin != 4 || got.TopBoxPercentage == nil || *got.TopBoxPercentage != 75 {
+		t.Errorf("expected a top box of 4-5 with 75%%, got %v and %v", got.TopBoxMin, got.TopBoxPercentage)
+	}
+	if got.Percentiles == nil || *got.Percentiles != (Percentiles{P50: 4.5, P75: 5, P90: 5, P95: 5}) {
+		t.Errorf("unexpected percentiles: %+v", got.Percentiles)
+	}
+}
+
+func TestSentimentAnalytics(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for _, response := range []struct {
+		sourceType string
+		sentiment  string
+		score      float64
+		topics     []string
+		spam       bool
+	}{
+		{"survey", "positive", 0.8, []string{"pricing", "support"}, false},
+		{"survey", "negative", -0.6, []string{"pricing"}, false},
+		{"review", "negative", -0.4, nil, false},
+		{"review", "negative", -1, []string{"pricing"}, true},
+	} {
+		_, err := client.ExperienceData.Create().
+			SetSourceType(response.sourceType).
+			SetFieldID("feedback").
+			SetFieldType("text").
+			SetValueText("Feedback").
+			SetSentiment(response.sentiment).
+			SetSentimentScore(response.score).
+			SetTopics(response.topics).
+			SetIsSpam(response.spam).
+			SetCollectedAt(time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	t.Run("breakdowns", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/sentiment?interval=month")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetSentimentOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if report.Body.Responses != 3 || report.Body.Positive != 1 || report.Body.Negative != 2 {
+			t.Errorf("expected the spam response to be skipped, got %+v", report.Body.SentimentBreakdown)
+		}
+		if report.Body.Excluded == nil || *report.Body.Excluded != (Exclusions{Spam: 1}) {
+			t.Errorf("expected one excluded spam response, got %+v", report.Body.Excluded)
+		}
+		if report.Body.AverageScore == nil || *report.Body.AverageScore != -0.067 {
+			t.Errorf("expected average score -0.067, got %v", report.Body.AverageScore)
+		}
+		if len(report.Body.Series) != 1 || report.Body.Series[0].Period != "2024-03-01" {
+			t.Errorf("unexpected series: %+v", report.Body.Series)
+		}
+		if len(report.Body.BySourceType) != 2 || report.Body.BySourceType[0].SourceType != "review" || report.Body.BySourceType[0].Responses != 1 {
+			t.Errorf("unexpected source types: %+v", report.Body.BySourceType)
+		}
+		if len(report.Body.ByTopic) != 2 || report.Body.ByTopic[0].Topic != "pricing" || report.Body.ByTopic[0].Responses != 2 {
+			t.Errorf("unexpected topics: %+v", report.Body.ByTopic)
+		}
+	})
+
+	t.Run("include excluded", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/sentiment?include_excluded=true")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetSentimentOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if report.Body.Responses != 4 || report.Body.Negative != 3 || report.Body.Excluded != nil {
+			t.Errorf("expected the spam response to be counted, got %+v", report.Body)
+		}
+	})
+
+	t.Run("filter by topic", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/sentiment?topic=support")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if !strings.Contains(resp.Body.String(), `"responses":1,"positive":1`) {
+			t.Errorf("expected only the response about support, got %s", resp.Body.String())
+		}
+	})
+}
+
+func TestTopicTrends(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	// pricing is mentioned more often in the second week, onboarding only in the first
+	ctx := context.Background()
+	firstWeek := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	secondWeek := time.Date(2024, 5, 9, 12, 0, 0, 0, time.UTC)
+	var latestPricing uuid.UUID
+	for _, response := range []struct {
+		topics      []string
+		collectedAt time.Time
+	}{
+		{[]string{"pricing", "onboarding"}, firstWeek},
+		{[]string{"onboarding"}, firstWeek},
+		{[]string{"pricing"}, secondWeek},
+		{[]string{"pricing"}, secondWeek.Add(time.Hour)},
+	} {
+		exp, err := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID("feedback").
+			SetFieldType("text").
+			SetValueText("Feedback").
+			SetTopics(response.topics).
+			SetCollectedAt(response.collectedAt).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+		if response.topics[0] == "pricing" {
+			latestPricing = exp.ID
+		}
+	}
+
+	resp := api.Get("/v1/analytics/topics?since=2024-05-08T00:00:00Z&until=2024-05-15T00:00:00Z&examples=1")
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var report GetTopicTrendsOutput
+	if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+		t.Fatal(err)
+	}
+	if !report.Body.PreviousSince.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the previous period to start on May 1, got %v", report.Body.PreviousSince)
+	}
+	if len(report.Body.Topics) != 1 || report.Body.Topics[0].Topic != "pricing" || report.Body.Topics[0].Count != 2 {
+		t.Errorf("unexpected topics: %+v", report.Body.Topics)
+	}
+	if len(report.Body.Rising) != 1 || report.Body.Rising[0].Change != 1 || *report.Body.Rising[0].ChangePercentage != 100 {
+		t.Fatalf("expected pricing to rise by 100%%, got %+v", report.Body.Rising)
+	}
+	if ids := report.Body.Rising[0].ExampleIDs; len(ids) != 1 || ids[0] != latestPricing {
+		t.Errorf("expected the latest pricing response as example, got %v", ids)
+	}
+	if len(report.Body.Falling) != 1 || report.Body.Falling[0].Topic != "onboarding" || report.Body.Falling[0].Count != 0 {
+		t.Errorf("unexpected falling topics: %+v", report.Body.Falling)
+	}
+
+	t.Run("since after until", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/topics?since=2024-05-15T00:00:00Z&until=2024-05-08T00:00:00Z")
+		if resp.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", resp.Code)
+		}
+	})
+}
+
+func TestTimeSeries(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for _, response := range []struct {
+		sourceType  string
+		score       float64
+		plan        string
+		collectedAt time.Time
+	}{
+		{"survey", 4, "pro", time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)},
+		{"survey", 2, "free", time.Date(2024, 6, 3, 15, 0, 0, 0, time.UTC)},
+		{"survey", 5, "pro", time.Date(2024, 6, 4, 9, 0, 0, 0, time.UTC)},
+		{"review", 3, "pro", time.Date(2024, 6, 4, 10, 0, 0, 0, time.UTC)},
+	} {
+		_, err := client.ExperienceData.Create().
+			SetSourceType(response.sourceType).
+			SetFieldID("rating").
+			SetFieldType("rating").
+			SetValueNumber(response.score).
+			SetMetadata(map[string]interface{}{"plan": response.plan}).
+			SetCollectedAt(response.collectedAt).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	t.Run("daily count by source type", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/timeseries?group_by=source_type")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetTimeSeriesOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if len(report.Body.Series) != 2 || *report.Body.Series[0].Group != "survey" || report.Body.Series[0].Count != 3 {
+			t.Fatalf("unexpected series: %+v", report.Body.Series)
+		}
+		points := report.Body.Series[0].Points
+		if len(points) != 2 || points[0].Period != "2024-06-03" || *points[0].Value != 2 || points[1].Period != "2024-06-04" {
+			t.Errorf("unexpected points: %+v", points)
+		}
+	})
+
+	t.Run("hourly average by metadata key", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/timeseries?metric=avg_value_number&interval=hour&group_by=metadata.plan&limit=1")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetTimeSeriesOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if len(report.Body.Series) != 1 || *report.Body.Series[0].Group != "pro" {
+			t.Fatalf("expected only the largest group, got %+v", report.Body.Series)
+		}
+		points := report.Body.Series[0].Points
+		if len(points) != 3 || points[0].Period != "2024-06-03T09:00:00Z" || *points[0].Value != 4 {
+			t.Errorf("unexpected points: %+v", points)
+		}
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/timeseries?group_by=value_text")
+		if resp.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", resp.Code)
+		}
+	})
+}
+
+func TestCrossTab(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for _, response := range []struct {
+		category string
+		country  string
+	}{
+		{"promoter", "DE"},
+		{"promoter", "DE"},
+		{"detractor", "DE"},
+		{"promoter", "US"},
+		{"passive", ""},
+	} {
+		create := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID("nps").
+			SetFieldType("nps").
+			SetNpsCategory(response.category)
+		if response.country != "" {
+			create = create.SetCountry(response.country)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	t.Run("counts and percentages", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/crosstab?rows=nps_category&columns=country")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetCrossTabOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if report.Body.Total != 5 || len(report.Body.RowTotals) != 3 || len(report.Body.ColumnTotals) != 3 {
+			t.Fatalf("unexpected totals: %+v", report.Body)
+		}
+		if *report.Body.RowTotals[0].Value != "promoter" || report.Body.RowTotals[0].Count != 3 || report.Body.RowTotals[0].Percentage != 60 {
+			t.Errorf("unexpected row totals: %+v", report.Body.RowTotals)
+		}
+		cell := report.Body.Cells[0]
+		if *cell.Row != "promoter" || *cell.Column != "DE" || cell.Count != 2 ||
+			cell.Percentage != 40 || cell.RowPercentage != 66.7 || cell.ColumnPercentage != 66.7 {
+			t.Errorf("unexpected first cell: %+v", cell)
+		}
+		last := report.Body.Cells[len(report.Body.Cells)-1]
+		if *last.Row != "passive" || last.Column != nil || last.ColumnPercentage != 100 {
+			t.Errorf("expected the experience without a country last, got %+v", last)
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/crosstab?rows=nps_category&columns=country&limit=1")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetCrossTabOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if report.Body.Total != 5 || len(report.Body.Cells) != 1 || report.Body.Cells[0].Count != 2 {
+			t.Errorf("expected only the promoters in DE, got %+v", report.Body)
+		}
+	})
+
+	t.Run("same dimension twice", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/crosstab?rows=country&columns=country")
+		if resp.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", resp.Code)
+		}
+	})
+}
+
+func TestOverview(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	for _, response := range []struct {
+		fieldType   string
+		category    string
+		score       float64
+		sentiment   string
+		topics      []string
+		collectedAt time.Time
+	}{
+		{fieldType: "nps", category: "promoter", score: 10, collectedAt: now.Add(-24 * time.Hour)},
+		{fieldType: "nps", category: "detractor", score: 3, collectedAt: now.Add(-10 * 24 * time.Hour)},
+		{fieldType: "nps", category: "promoter", score: 9, collectedAt: now.Add(-60 * 24 * time.Hour)},
+		{fieldType: "csat", score: 5, collectedAt: now.Add(-2 * 24 * time.Hour)},
+		{fieldType: "text", sentiment: "negative", topics: []string{"pricing"}, collectedAt: now.Add(-3 * 24 * time.Hour)},
+	} {
+		create := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID(response.fieldType).
+			SetFieldType(response.fieldType).
+			SetCollectedAt(response.collectedAt)
+		if response.fieldType == "text" {
+			create = create.SetValueText("Too expensive").SetSentiment(response.sentiment).SetTopics(response.topics)
+		} else {
+			create = create.SetValueNumber(response.score)
+		}
+		if response.category != "" {
+			create = create.SetNpsCategory(response.category)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	resp := api.Get("/v1/analytics/overview")
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var overview GetOverviewOutput
+	if err := json.Unmarshal(resp.Body.Bytes(), &overview.Body); err != nil {
+		t.Fatal(err)
+	}
+	body := overview.Body
+	if body.Responses != 5 || body.Last7Days != 3 || body.Last30Days != 4 {
+		t.Errorf("unexpected volumes: %d, %d, %d", body.Responses, body.Last7Days, body.Last30Days)
+	}
+	if body.NPS.Responses != 2 || body.NPS.Score == nil || *body.NPS.Score != 0 {
+		t.Errorf("expected the NPS of the last 30 days, got %+v", body.NPS)
+	}
+	if body.CSAT == nil || body.CSAT.Responses != 1 || body.CSAT.Average != 5 {
+		t.Errorf("unexpected csat: %+v", body.CSAT)
+	}
+	if body.Sentiment.Negative != 1 {
+		t.Errorf("unexpected sentiment: %+v", body.Sentiment)
+	}
+	if len(body.Topics) != 1 || body.Topics[0].Topic != "pricing" || body.Topics[0].Count != 1 {
+		t.Errorf("unexpected topics: %+v", body.Topics)
+	}
+}
+
+func TestTerms(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for _, response := range []struct {
+		text     string
+		language string
+		spam     bool
+	}{
+		{text: "The export is slow", language: "en"},
+		{text: "Slow export and slow search", language: "en"},
+		{text: "Der Export ist langsam", language: "de"},
+		{text: "export export export", spam: true},
+	} {
+		create := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID("feedback").
+			SetFieldType("text").
+			SetValueText(response.text).
+			SetIsSpam(response.spam)
+		if response.language != "" {
+			create = create.SetLanguage(response.language)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	resp := api.Get("/v1/analytics/terms?limit=2")
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var report GetTermsOutput
+	if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+		t.Fatal(err)
+	}
+	if report.Body.Responses != 3 || report.Body.Sampled {
+		t.Errorf("expected 3 responses without spam, got %d (sampled: %v)", report.Body.Responses, report.Body.Sampled)
+	}
+	want := []TermCount{{Term: "export", Count: 3, Responses: 3}, {Term: "slow", Count: 3, Responses: 2}}
+	if !slices.Equal(report.Body.Unigrams, want) {
+		t.Errorf("expected %+v, got %+v", want, report.Body.Unigrams)
+	}
+	want = []TermCount{{Term: "slow export", Count: 1, Responses: 1}, {Term: "slow search", Count: 1, Responses: 1}}
+	if !slices.Equal(report.Body.Bigrams, want) {
+		t.Errorf("expected %+v, got %+v", want, report.Body.Bigrams)
+	}
+
+	resp = api.Get("/v1/analytics/terms?language=de")
+	if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"responses":1,`) {
+		t.Errorf("expected only the German response, got %d: %s", resp.Code, resp.Body.String())
+	}
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/auditlog"
+)
+
+// AuditLogItem represents an audit log entry in API responses
+type AuditLogItem struct {
+	ID         uuid.UUID `json:"id" doc:"Entry ID"`
+	Actor      string    `json:"actor" doc:"Who made the call: api_key:<fingerprint> or anonymous"`
+	RequestID  string    `json:"request_id,omitempty" doc:"Request ID of the call"`
+	Method     string    `json:"method" doc:"HTTP method"`
+	Path       string    `json:"path" doc:"Request path"`
+	Operation  string    `json:"operation,omitempty" doc:"API operation ID (e.g., create-experience)"`
+	ResourceID string    `json:"resource_id,omitempty" doc:"ID of the resource in the path, if any"`
+	Status     int       `json:"status" doc:"HTTP status of the response"`
+	Summary    string    `json:"summary" doc:"Description of the call"`
+	EventIDs   []string  `json:"event_ids" doc:"IDs of the webhook events emitted by the call (the id attribute of the events)"`
+	CreatedAt  time.Time `json:"created_at" doc:"When the call was made"`
+}
+
+// ListAuditLogsInput defines the filters for listing audit log entries
+type ListAuditLogsInput struct {
+	Actor      string `query:"actor" doc:"Filter by actor (e.g., api_key:3f2a9c1b7e4d)"`
+	RequestID  string `query:"request_id" doc:"Filter by request ID"`
+	ResourceID string `query:"resource_id" doc:"Filter by the ID of the resource in the path"`
+	Operation  string `query:"operation" doc:"Filter by API operation ID"`
+	EventID    string `query:"event_id" doc:"Find the call that emitted a webhook event"`
+	Since      string `query:"since" doc:"Only entries at or after this time (ISO 8601)" example:"2024-01-01T00:00:00Z"`
+	Until      string `query:"until" doc:"Only entries before this time (ISO 8601)" example:"2024-12-31T23:59:59Z"`
+	Limit      int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset     int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+}
+
+// ListAuditLogsOutput represents the output for listing audit log entries
+type ListAuditLogsOutput struct {
+	Body struct {
+		Data   []AuditLogItem `json:"data" doc:"Entries, newest first"`
+		Total  int            `json:"total" doc:"Total count of entries matching the filters"`
+		Limit  int            `json:"limit" doc:"Limit used in query"`
+		Offset int            `json:"offset" doc:"Offset used in query"`
+	}
+}
+
+// RegisterAuditRoutes registers the audit log routes
+func RegisterAuditRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-audit-logs",
+		Method:      "GET",
+		Path:        "/v1/audit-logs",
+		Summary:     "List audit log entries",
+		Description: "Lists the mutating API calls recorded in the audit log, newest first. Entries are kept for SERVICE_AUDIT_RETENTION_DAYS days.",
+		Tags:        []string{"Audit"},
+	}, func(ctx context.Context, input *ListAuditLogsInput) (*ListAuditLogsOutput, error) {
+		query := client.AuditLog.Query()
+		if input.Actor != "" {
+			query.Where(auditlog.Actor(input.Actor))
+		}
+		if input.RequestID != "" {
+			query.Where(auditlog.RequestID(input.RequestID))
+		}
+		if input.ResourceID != "" {
+			query.Where(auditlog.ResourceID(input.ResourceID))
+		}
+		if input.Operation != "" {
+			query.Where(auditlog.Operation(input.Operation))
+		}
+		if input.EventID != "" {
+			query.Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(auditlog.FieldEventIds, input.EventID))
+			})
+		}
+		if input.Since != "" {
+			since, err := time.Parse(time.RFC3339, input.Since)
+			if err != nil {
+				return nil, invalidTimestamp("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+			}
+			query.Where(auditlog.CreatedAtGTE(since))
+		}
+		if input.Until != "" {
+			until, err := time.Parse(time.RFC3339, input.Until)
+			if err != nil {
+				return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+			}
+			query.Where(auditlog.CreatedAtLT(until))
+		}
+
+		total, err := query.Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "audit logs")
+		}
+
+		entries, err := query.
+			Limit(input.Limit).
+			Offset(input.Offset).
+			Order(ent.Desc(auditlog.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "audit logs")
+		}
+
+		output := &ListAuditLogsOutput{}
+		output.Body.Data = make([]AuditLogItem, len(entries))
+		for i, entry := range entries {
+			output.Body.Data[i] = auditLogToItem(entry)
+		}
+		output.Body.Total = total
+		output.Body.Limit = input.Limit
+		output.Body.Offset = input.Offset
+		return output, nil
+	})
+}
+
+// auditLogToItem converts an audit log entity to its API representation
+func auditLogToItem(entry *ent.AuditLog) AuditLogItem {
+	eventIDs := entry.EventIds
+	if eventIDs == nil {
+		eventIDs = []string{}
+	}
+	return AuditLogItem{
+		ID:         entry.ID,
+		Actor:      entry.Actor,
+		RequestID:  entry.RequestID,
+		Method:     entry.Method,
+		Path:       entry.Path,
+		Operation:  entry.Operation,
+		ResourceID: entry.ResourceID,
+		Status:     entry.Status,
+		Summary:    entry.Summary,
+		EventIDs:   eventIDs,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
@@ -0,0 +1,532 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/connector/intercom"
+	"github.com/formbricks/hub/apps/hub/internal/connector/segment"
+	"github.com/formbricks/hub/apps/hub/internal/connector/typeform"
+	"github.com/formbricks/hub/apps/hub/internal/connector/zendesk"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/errorreport"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// connectorResponseKey is the metadata key that holds the ID of the response an experience
+// was received with
+const connectorResponseKey = "response_id"
+
+// TypeformBackfillInput defines the input for importing historical Typeform responses
+type TypeformBackfillInput struct {
+	Body struct {
+		FormID           string     `json:"form_id" example:"lT4Z3j" doc:"ID of the form, as in its URL" minLength:"1"`
+		Since            *time.Time `json:"since,omitempty" doc:"Only responses submitted at or after this time"`
+		Until            *time.Time `json:"until,omitempty" doc:"Only responses submitted at or before this time"`
+		Before           string     `json:"before,omitempty" doc:"Continue with the responses submitted before this response token, the next_before of the previous call"`
+		PageSize         int        `json:"page_size,omitempty" default:"200" minimum:"1" maximum:"1000" doc:"Number of responses imported by this call"`
+		SkipAIProcessing bool       `json:"skip_ai_processing,omitempty" doc:"Skip AI enrichment and embeddings for the imported experiences"`
+	}
+}
+
+// TypeformBackfillOutput defines the output of a Typeform backfill call
+type TypeformBackfillOutput struct {
+	Body struct {
+		Responses     int    `json:"responses" doc:"Responses read from Typeform"`
+		Created       int    `json:"created" doc:"Experiences created"`
+		AlreadyStored int    `json:"already_stored" doc:"Responses that were stored before, e.g. by the webhook, and were skipped"`
+		NextBefore    string `json:"next_before,omitempty" doc:"Pass as before to import the next, older page; empty once all responses were read"`
+	}
+}
+
+// IntercomBackfillInput defines the input for importing earlier Intercom conversations
+type IntercomBackfillInput struct {
+	Body struct {
+		Since            *time.Time `json:"since,omitempty" doc:"Only conversations updated at or after this time"`
+		Until            *time.Time `json:"until,omitempty" doc:"Only conversations updated at or before this time"`
+		StartingAfter    string     `json:"starting_after,omitempty" doc:"Continue with the next page, the next_starting_after of the previous call"`
+		PageSize         int        `json:"page_size,omitempty" default:"20" minimum:"1" maximum:"150" doc:"Number of conversations imported by this call"`
+		SkipAIProcessing bool       `json:"skip_ai_processing,omitempty" doc:"Skip AI enrichment and embeddings for the imported experiences"`
+	}
+}
+
+// IntercomBackfillOutput defines the output of an Intercom backfill call
+type IntercomBackfillOutput struct {
+	Body struct {
+		Conversations     int    `json:"conversations" doc:"Conversations read from Intercom"`
+		Created           int    `json:"created" doc:"Experiences created"`
+		AlreadyStored     int    `json:"already_stored" doc:"Messages and ratings that were stored before, e.g. by the webhook, and were skipped"`
+		NextStartingAfter string `json:"next_starting_after,omitempty" doc:"Pass as starting_after to import the next page; empty once all conversations were read"`
+	}
+}
+
+// zendeskInitialSync is how far back the first sync of a Zendesk stream reads by default
+const zendeskInitialSync = 30 * 24 * time.Hour
+
+// ZendeskSyncInput defines the input for syncing Zendesk
+type ZendeskSyncInput struct {
+	Body struct {
+		Since            *time.Time `json:"since,omitempty" doc:"Where the first sync of each stream starts; defaults to 30 days ago. Ignored once a stream was synced, as later syncs continue where the last one ended."`
+		SkipAIProcessing bool       `json:"skip_ai_processing,omitempty" doc:"Skip AI enrichment and embeddings for the synced experiences"`
+	}
+}
+
+// ZendeskSyncOutput defines the output of a Zendesk sync call
+type ZendeskSyncOutput struct {
+	Body struct {
+		Comments int  `json:"comments" doc:"Public customer comments read"`
+		Ratings  int  `json:"ratings" doc:"Satisfaction ratings read"`
+		Created  int  `json:"created" doc:"Experiences created"`
+		More     bool `json:"more" doc:"Whether more changes are waiting; call again to sync them"`
+	}
+}
+
+// connectorDelivery is the response to a connector webhook delivery
+type connectorDelivery struct {
+	ResponseID    string `json:"response_id,omitempty"`
+	Created       int    `json:"created"`
+	AlreadyStored bool   `json:"already_stored"`
+}
+
+// segmentDelivery is the response to a call posted to the Segment source. Segment and its
+// SDKs only check success.
+type segmentDelivery struct {
+	Success bool `json:"success"`
+	Events  int  `json:"events"`
+	Created int  `json:"created"`
+}
+
+// configuredConnectors returns the connectors whose webhooks are configured
+func configuredConnectors(cfg *config.Config) []connector.Connector {
+	var connectors []connector.Connector
+	if cfg.TypeformWebhookSecret != "" {
+		connectors = append(connectors, typeform.NewWebhook(cfg.TypeformWebhookSecret))
+	}
+	if cfg.IntercomClientSecret != "" {
+		connectors = append(connectors, intercom.NewWebhook(cfg.IntercomClientSecret))
+	}
+	return connectors
+}
+
+// ConnectorStore stores the responses received by connectors as experiences
+type ConnectorStore struct {
+	creator *experienceCreator
+	logger  *slog.Logger
+}
+
+// NewConnectorStore returns a store of connector responses, used by the connector routes and
+// by connectors that fetch responses in the background
+func NewConnectorStore(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger, enrichmentQueue queue.Queue) *ConnectorStore {
+	return &ConnectorStore{
+		creator: newExperienceCreator(cfg, client, dispatcher, logger, enrichmentQueue),
+		logger:  logger,
+	}
+}
+
+// Store creates the experiences of a fetched response and returns how many were created.
+// AI jobs are enqueued with low priority, so a large fetch doesn't delay new feedback.
+func (s *ConnectorStore) Store(ctx context.Context, sourceType string, response *connector.Response) (int, error) {
+	created, _, err := s.store(ctx, sourceType, response, false, "low")
+	return created, err
+}
+
+// store creates the experiences of a response and returns how many were created. Answers
+// stored before with the same response are skipped, so redelivered and backfilled responses
+// are only stored once; answers with rejected values, such as scores outside the configured
+// range, are logged and skipped too.
+func (s *ConnectorStore) store(ctx context.Context, sourceType string, response *connector.Response, skipAI bool, priority string) (created int, alreadyStored bool, err error) {
+	storedFields, err := s.creator.client.ExperienceData.Query().
+		Where(
+			experiencedata.SourceTypeEQ(sourceType),
+			experiencedata.SourceIDEQ(response.SourceID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueEQ(experiencedata.FieldMetadata, response.ID, sqljson.Path(connectorResponseKey)))
+			},
+		).
+		Unique(true).
+		Select(experiencedata.FieldFieldID).
+		Strings(ctx)
+	if err != nil {
+		return 0, false, handleDatabaseError(s.logger, err, "find stored answers of", response.ID)
+	}
+	stored := make(map[string]bool, len(storedFields))
+	for _, fieldID := range storedFields {
+		stored[fieldID] = true
+	}
+
+	metadata := make(map[string]any, len(response.Metadata)+1)
+	for key, value := range response.Metadata {
+		metadata[key] = value
+	}
+	metadata[connectorResponseKey] = response.ID
+
+	for _, exp := range response.Experiences {
+		if stored[exp.FieldID] {
+			continue
+		}
+		input := &CreateExperienceInput{}
+		input.Body.SourceType = sourceType
+		input.Body.SourceID = &response.SourceID
+		if response.SourceName != "" {
+			input.Body.SourceName = &response.SourceName
+		}
+		input.Body.FieldID = exp.FieldID
+		if exp.FieldLabel != "" {
+			input.Body.FieldLabel = &exp.FieldLabel
+		}
+		input.Body.FieldType = exp.FieldType
+		input.Body.ValueText = exp.ValueText
+		input.Body.ValueNumber = exp.ValueNumber
+		input.Body.ValueBoolean = exp.ValueBoolean
+		input.Body.ValueDate = exp.ValueDate
+		input.Body.ValueJSON = exp.ValueJSON
+		input.Body.CollectedAt = &response.CollectedAt
+		input.Body.Metadata = metadata
+		if response.UserIdentifier != "" {
+			input.Body.UserIdentifier = &response.UserIdentifier
+		}
+		input.Body.SkipAIProcessing = skipAI
+		input.Body.AIPriority = priority
+
+		if _, err := s.creator.create(ctx, input); err != nil {
+			var p *problem.Error
+			if errors.As(err, &p) && p.Status < http.StatusInternalServerError {
+				s.logger.Warn("connector answer skipped", "source_type", sourceType, "response_id", response.ID, "field_id", exp.FieldID, "error", p.Detail)
+				continue
+			}
+			return created, false, err
+		}
+		created++
+	}
+	return created, len(storedFields) > 0 && created == 0, nil
+}
+
+// RegisterConnectorRoutes registers the webhooks of the configured connectors and the Segment
+// source, the backfills of historical Typeform responses and Intercom conversations, and the
+// Zendesk sync. Webhooks
+// are served by the router rather than Huma, as tools can't send the API key; each delivery
+// is authenticated by its signature, or the write key for Segment.
+func RegisterConnectorRoutes(router chi.Router, api huma.API, cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger, enrichmentQueue queue.Queue) {
+	store := NewConnectorStore(cfg, client, dispatcher, logger, enrichmentQueue)
+
+	for _, c := range configuredConnectors(cfg) {
+		router.Post("/v1/connectors/"+c.Name(), func(w http.ResponseWriter, r *http.Request) {
+			body, ok := readConnectorBody(w, r)
+			if !ok {
+				return
+			}
+			response, err := c.Parse(r.Header, body)
+			if errors.Is(err, connector.ErrUnauthorized) {
+				logger.Warn("connector delivery rejected", "connector", c.Name(), "error", err)
+				problem.Write(w, http.StatusUnauthorized, problem.CodeUnauthorized, "Missing or invalid signature")
+				return
+			}
+			if err != nil {
+				problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, err.Error())
+				return
+			}
+
+			delivery := connectorDelivery{}
+			if response != nil {
+				delivery.ResponseID = response.ID
+				delivery.Created, delivery.AlreadyStored, err = store.store(r.Context(), c.Name(), response, false, "")
+				if err != nil {
+					writeConnectorError(w, r, err)
+					return
+				}
+				logger.Info("connector response received", "connector", c.Name(), "response_id", response.ID, "created", delivery.Created)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(delivery)
+		})
+		logger.Info("connector webhook enabled", "connector", c.Name(), "path", "/v1/connectors/"+c.Name())
+	}
+
+	// Segment and its SDKs post track calls and batches with the write key; SDKs configured
+	// with /v1/connectors/segment as their API host append the Tracking API paths
+	if cfg.SegmentWriteKey != "" {
+		source := segment.NewSource(cfg.SegmentWriteKey, cfg.GetSegmentEvents())
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			body, ok := readConnectorBody(w, r)
+			if !ok {
+				return
+			}
+			responses, err := source.Parse(r.Header, body)
+			if errors.Is(err, connector.ErrUnauthorized) {
+				logger.Warn("connector delivery rejected", "connector", segment.SourceType, "error", err)
+				problem.Write(w, http.StatusUnauthorized, problem.CodeUnauthorized, "Missing or invalid write key")
+				return
+			}
+			if err != nil {
+				problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, err.Error())
+				return
+			}
+
+			delivery := segmentDelivery{Success: true, Events: len(responses)}
+			for _, response := range responses {
+				created, _, err := store.store(r.Context(), segment.SourceType, response, false, "")
+				if err != nil {
+					writeConnectorError(w, r, err)
+					return
+				}
+				delivery.Created += created
+			}
+			if len(responses) > 0 {
+				logger.Info("connector events received", "connector", segment.SourceType, "events", delivery.Events, "created", delivery.Created)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(delivery)
+		}
+		for _, path := range []string{"/v1/connectors/segment", "/v1/connectors/segment/v1/track", "/v1/connectors/segment/v1/batch"} {
+			router.Post(path, handler)
+		}
+		logger.Info("connector webhook enabled", "connector", segment.SourceType, "path", "/v1/connectors/segment")
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "backfill-typeform",
+		Method:      "POST",
+		Path:        "/v1/connectors/typeform/backfill",
+		Summary:     "Import historical Typeform responses",
+		Description: "Imports a page of the completed responses of a Typeform form, newest first, with SERVICE_TYPEFORM_TOKEN. Call it again with next_before until that is empty to import all of them. Responses that were stored before, e.g. by the webhook, are skipped, and AI jobs are enqueued with low priority so new feedback isn't delayed.",
+		Tags:        []string{"Connectors"},
+	}, func(ctx context.Context, input *TypeformBackfillInput) (*TypeformBackfillOutput, error) {
+		if cfg.TypeformToken == "" {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Typeform backfills are not enabled. Configure SERVICE_TYPEFORM_TOKEN to enable.")
+		}
+		if input.Body.Since != nil && input.Body.Until != nil && input.Body.Until.Before(*input.Body.Since) {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidTimeRange, "until must not be before since")
+		}
+
+		typeformClient := typeform.NewClient(cfg.TypeformToken)
+		definition, err := typeformClient.Form(ctx, input.Body.FormID)
+		if err != nil {
+			return nil, typeformError(logger, err, input.Body.FormID, "read form")
+		}
+		page, err := typeformClient.Responses(ctx, input.Body.FormID, typeform.ResponsesQuery{
+			Since:    input.Body.Since,
+			Until:    input.Body.Until,
+			Before:   input.Body.Before,
+			PageSize: input.Body.PageSize,
+		})
+		if err != nil {
+			return nil, typeformError(logger, err, input.Body.FormID, "read responses")
+		}
+
+		output := &TypeformBackfillOutput{}
+		for i := range page.Items {
+			created, alreadyStored, err := store.store(ctx, typeform.SourceType, typeform.ToResponse(definition, &page.Items[i]), input.Body.SkipAIProcessing, "low")
+			if err != nil {
+				return nil, err
+			}
+			output.Body.Created += created
+			if alreadyStored {
+				output.Body.AlreadyStored++
+			}
+		}
+		output.Body.Responses = len(page.Items)
+		if len(page.Items) == input.Body.PageSize {
+			output.Body.NextBefore = page.Items[len(page.Items)-1].Token
+		}
+
+		logger.Info("typeform responses imported", "form_id", input.Body.FormID, "responses", output.Body.Responses, "created", output.Body.Created)
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "backfill-intercom",
+		Method:      "POST",
+		Path:        "/v1/connectors/intercom/backfill",
+		Summary:     "Import earlier Intercom conversations",
+		Description: "Imports the customer messages and ratings of a page of Intercom conversations, least recently updated first, with SERVICE_INTERCOM_TOKEN. Call it again with next_starting_after until that is empty to import all of them. Messages and ratings that were stored before, e.g. by the webhook, are skipped, and AI jobs are enqueued with low priority so new feedback isn't delayed.",
+		Tags:        []string{"Connectors"},
+	}, func(ctx context.Context, input *IntercomBackfillInput) (*IntercomBackfillOutput, error) {
+		if cfg.IntercomToken == "" {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Intercom backfills are not enabled. Configure SERVICE_INTERCOM_TOKEN to enable.")
+		}
+		if input.Body.Since != nil && input.Body.Until != nil && input.Body.Until.Before(*input.Body.Since) {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidTimeRange, "until must not be before since")
+		}
+
+		intercomClient := intercom.NewClient(cfg.IntercomToken)
+		workspaceID, err := intercomClient.Workspace(ctx)
+		if err != nil {
+			return nil, handleServiceError(logger, err, "intercom", "read workspace")
+		}
+		page, err := intercomClient.SearchConversations(ctx, intercom.ConversationsQuery{
+			Since:         input.Body.Since,
+			Until:         input.Body.Until,
+			StartingAfter: input.Body.StartingAfter,
+			PageSize:      input.Body.PageSize,
+		})
+		if err != nil {
+			return nil, handleServiceError(logger, err, "intercom", "search conversations")
+		}
+
+		output := &IntercomBackfillOutput{}
+		for _, listed := range page.Conversations {
+			// Listed conversations don't include their parts
+			conversation, err := intercomClient.Conversation(ctx, listed.ID)
+			if err != nil {
+				return nil, handleServiceError(logger, err, "intercom", "read conversation")
+			}
+			for _, response := range intercom.ConversationResponses(workspaceID, conversation) {
+				created, alreadyStored, err := store.store(ctx, intercom.SourceType, response, input.Body.SkipAIProcessing, "low")
+				if err != nil {
+					return nil, err
+				}
+				output.Body.Created += created
+				if alreadyStored {
+					output.Body.AlreadyStored++
+				}
+			}
+		}
+		output.Body.Conversations = len(page.Conversations)
+		output.Body.NextStartingAfter = page.NextStartingAfter()
+
+		logger.Info("intercom conversations imported", "conversations", output.Body.Conversations, "created", output.Body.Created)
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "sync-zendesk",
+		Method:      "POST",
+		Path:        "/v1/connectors/zendesk/sync",
+		Summary:     "Sync Zendesk ticket comments and satisfaction ratings",
+		Description: "Reads the next page of the public comments that customers added to tickets and of the received satisfaction ratings from the Zendesk account configured with SERVICE_ZENDESK_SUBDOMAIN, and stores them as experiences with source_type support. Each stream continues where its last sync ended, so call it on a schedule, e.g. every few minutes, and again right away while more is true.",
+		Tags:        []string{"Connectors"},
+	}, func(ctx context.Context, input *ZendeskSyncInput) (*ZendeskSyncOutput, error) {
+		subdomain, err := cfg.GetZendeskSubdomain()
+		if err != nil || subdomain == "" {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Zendesk sync is not enabled. Configure SERVICE_ZENDESK_SUBDOMAIN, SERVICE_ZENDESK_EMAIL and SERVICE_ZENDESK_API_TOKEN to enable.")
+		}
+		since := time.Now().Add(-zendeskInitialSync)
+		if input.Body.Since != nil {
+			since = *input.Body.Since
+		}
+		zendeskClient := zendesk.NewClient(subdomain, cfg.ZendeskEmail, cfg.ZendeskAPIToken)
+
+		comments, commentsCreated, err := syncZendeskStream(ctx, client, store, logger, zendesk.StreamTicketComments, input.Body.SkipAIProcessing, func(cursor string) (*zendesk.Page, error) {
+			return zendeskClient.SyncComments(ctx, cursor, since)
+		})
+		if err != nil {
+			return nil, err
+		}
+		ratings, ratingsCreated, err := syncZendeskStream(ctx, client, store, logger, zendesk.StreamSatisfactionRatings, input.Body.SkipAIProcessing, func(cursor string) (*zendesk.Page, error) {
+			return zendeskClient.SyncRatings(ctx, cursor, since)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		output := &ZendeskSyncOutput{}
+		output.Body.Comments = len(comments.Responses)
+		output.Body.Ratings = len(ratings.Responses)
+		output.Body.Created = commentsCreated + ratingsCreated
+		output.Body.More = comments.More || ratings.More
+
+		logger.Info("zendesk synced", "comments", output.Body.Comments, "ratings", output.Body.Ratings, "created", output.Body.Created, "more", output.Body.More)
+		return output, nil
+	})
+}
+
+// readConnectorBody reads the body of a connector delivery, writing the problem if it can't
+// be read
+func readConnectorBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			problem.Write(w, http.StatusRequestEntityTooLarge, problem.CodeRequestTooLarge, fmt.Sprintf("Request body exceeds %d bytes", tooLarge.Limit))
+			return nil, false
+		}
+		problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, "Failed to read the request body")
+		return nil, false
+	}
+	return body, true
+}
+
+// writeConnectorError writes the problem of a delivery whose responses failed to be stored,
+// reporting server errors
+func writeConnectorError(w http.ResponseWriter, r *http.Request, err error) {
+	var p *problem.Error
+	if !errors.As(err, &p) {
+		p = problem.New(http.StatusInternalServerError, problem.CodeInternalError, http.StatusText(http.StatusInternalServerError)).WithCause(err)
+	}
+	if p.Status >= http.StatusInternalServerError {
+		errorreport.SetError(r.Context(), cmp.Or(p.Cause(), error(p)))
+	}
+	problem.Write(w, p.Status, p.Code, p.Detail)
+}
+
+// syncZendeskStream reads the next page of a Zendesk stream, stores its responses and saves
+// the cursor of the stream. The cursor is saved after the responses are stored, so a failed
+// sync is retried from the same position; responses stored before are skipped then.
+func syncZendeskStream(ctx context.Context, client *ent.Client, store *ConnectorStore, logger *slog.Logger, stream string, skipAI bool, read func(cursor string) (*zendesk.Page, error)) (*zendesk.Page, int, error) {
+	cursor := ""
+	saved, err := client.ConnectorCursor.Query().Where(connectorcursor.StreamEQ(stream)).Only(ctx)
+	switch {
+	case err == nil:
+		cursor = saved.Cursor
+	case !ent.IsNotFound(err):
+		return nil, 0, handleDatabaseError(logger, err, "read cursor", stream)
+	}
+
+	page, err := read(cursor)
+	if err != nil {
+		return nil, 0, handleServiceError(logger, err, "zendesk", "sync "+stream)
+	}
+
+	created := 0
+	for _, response := range page.Responses {
+		n, _, err := store.store(ctx, zendesk.SourceType, response, skipAI, "")
+		if err != nil {
+			return nil, created, err
+		}
+		created += n
+	}
+
+	if page.Cursor != cursor {
+		updated, err := client.ConnectorCursor.Update().
+			Where(connectorcursor.StreamEQ(stream)).
+			SetCursor(page.Cursor).
+			Save(ctx)
+		if err == nil && updated == 0 {
+			err = client.ConnectorCursor.Create().SetStream(stream).SetCursor(page.Cursor).Exec(ctx)
+		}
+		if err != nil {
+			return nil, created, handleDatabaseError(logger, err, "save cursor", stream)
+		}
+	}
+	return page, created, nil
+}
+
+// typeformError maps an error of the Typeform API: unknown forms are not found, everything
+// else is reported as unavailable
+func typeformError(logger *slog.Logger, err error, formID, operation string) error {
+	var apiErr *connector.APIError
+	if errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound {
+		return problem.New(http.StatusNotFound, problem.CodeNotFound, fmt.Sprintf("Typeform form %s not found", formID))
+	}
+	return handleServiceError(logger, err, "typeform", operation)
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector/intercom"
+	"github.com/formbricks/hub/apps/hub/internal/connector/typeform"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+)
+
+func TestTypeformConnector(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	body := []byte(`{
+		"event_id": "01HW6N8T3",
+		"event_type": "form_response",
+		"form_response": {
+			"form_id": "lT4Z3j",
+			"token": "a3a12ec67a1365927098a606107fac15",
+			"submitted_at": "2024-01-15T10:30:00Z",
+			"hidden": {"user_id": "user-42", "country": "DE"},
+			"definition": {"id": "lT4Z3j", "title": "Customer feedback", "fields": [
+				{"id": "nps1", "type": "nps", "title": "How likely are you to recommend us?"},
+				{"id": "txt1", "type": "long_text", "title": "What could we improve?"},
+				{"id": "mc1", "type": "multiple_choice", "title": "Which features do you use?"}
+			]},
+			"answers": [
+				{"type": "number", "number": 9, "field": {"id": "nps1", "type": "nps"}},
+				{"type": "text", "text": "Faster exports", "field": {"id": "txt1", "type": "long_text"}},
+				{"type": "choices", "choices": {"labels": ["Dashboards", "Alerts"]}, "field": {"id": "mc1", "type": "multiple_choice"}}
+			]
+		}
+	}`)
+	signature := "Typeform-Signature: " + typeform.Sign("test-typeform-secret", body)
+
+	resp := api.Post("/v1/connectors/typeform", signature, bytes.NewReader(body))
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	if !strings.Contains(resp.Body.String(), `"created":4`) {
+		t.Errorf("expected 4 experiences to be created, got %s", resp.Body.String())
+	}
+
+	ctx := context.Background()
+	experiences, err := client.ExperienceData.Query().
+		Where(experiencedata.SourceTypeEQ("typeform"), experiencedata.SourceIDEQ("lT4Z3j")).
+		All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(experiences) != 4 {
+		t.Fatalf("expected 4 experiences, got %d", len(experiences))
+	}
+	for _, exp := range experiences {
+		if exp.SourceName != "Customer feedback" || exp.UserIdentifier != "user-42" || exp.Country == nil || *exp.Country != "DE" || exp.QuestionID == nil {
+			t.Errorf("unexpected experience: %+v", exp)
+		}
+		if exp.FieldID == "nps1" && (exp.FieldType != "nps" || exp.NpsCategory == nil || *exp.NpsCategory != "promoter") {
+			t.Errorf("expected a promoter nps experience, got %+v", exp)
+		}
+	}
+
+	t.Run("redelivery", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/typeform", signature, bytes.NewReader(body))
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"already_stored":true`) {
+			t.Errorf("expected the response to be stored already, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if n := client.ExperienceData.Query().Where(experiencedata.SourceTypeEQ("typeform")).CountX(ctx); n != 4 {
+			t.Errorf("expected still 4 experiences, got %d", n)
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/typeform", "Typeform-Signature: "+typeform.Sign("wrong", body), bytes.NewReader(body))
+		if resp.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", resp.Code)
+		}
+	})
+
+	t.Run("backfill without token", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/typeform/backfill", map[string]interface{}{"form_id": "lT4Z3j"})
+		if resp.Code != http.StatusBadRequest || !strings.Contains(resp.Body.String(), "feature_disabled") {
+			t.Errorf("expected feature_disabled, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+}
+
+func TestZendeskSync(t *testing.T) {
+	api, _, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	resp := api.Post("/v1/connectors/zendesk/sync", map[string]interface{}{})
+	if resp.Code != http.StatusBadRequest || !strings.Contains(resp.Body.String(), "feature_disabled") {
+		t.Errorf("expected feature_disabled, got %d: %s", resp.Code, resp.Body.String())
+	}
+}
+
+func TestIntercomConnector(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	body := []byte(`{
+		"type": "notification_event",
+		"app_id": "ecahpwf5",
+		"topic": "conversation.rating.added",
+		"data": {"item": {
+			"type": "conversation",
+			"id": "147",
+			"created_at": 1705314600,
+			"contacts": {"contacts": [{"id": "5f7f0d217289f8d7", "external_id": "user-42"}]},
+			"conversation_rating": {"rating": 4, "remark": "Quick help", "created_at": 1705318200, "contact": {"id": "5f7f0d217289f8d7", "external_id": "user-42"}}
+		}}
+	}`)
+	signature := "X-Hub-Signature: " + intercom.Sign("test-intercom-secret", body)
+
+	resp := api.Post("/v1/connectors/intercom", signature, bytes.NewReader(body))
+	if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"created":2`) {
+		t.Fatalf("expected the rating and its remark to be created, got %d: %s", resp.Code, resp.Body.String())
+	}
+
+	ctx := context.Background()
+	experiences := client.ExperienceData.Query().Where(experiencedata.SourceTypeEQ("intercom")).AllX(ctx)
+	for _, exp := range experiences {
+		if exp.SourceID != "ecahpwf5" || exp.UserIdentifier != "user-42" {
+			t.Errorf("unexpected experience: %+v", exp)
+		}
+		if exp.FieldID == "conversation_rating" && (exp.ValueNumber == nil || *exp.ValueNumber != 4) {
+			t.Errorf("expected a rating of 4, got %+v", exp)
+		}
+	}
+
+	t.Run("redelivery", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/intercom", signature, bytes.NewReader(body))
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"already_stored":true`) {
+			t.Errorf("expected the rating to be stored already, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/intercom", "X-Hub-Signature: "+intercom.Sign("wrong", body), bytes.NewReader(body))
+		if resp.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", resp.Code)
+		}
+	})
+
+	t.Run("backfill without token", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/intercom/backfill", map[string]interface{}{})
+		if resp.Code != http.StatusBadRequest || !strings.Contains(resp.Body.String(), "feature_disabled") {
+			t.Errorf("expected feature_disabled, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+}
+
+func TestSegmentSource(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	body := []byte(`{"batch": [
+		{"type": "track", "event": "Survey Answered", "messageId": "m-1", "userId": "user-42", "properties": {"nps": 9, "comment": "Love the new exports"}},
+		{"type": "identify", "messageId": "m-2", "userId": "user-42"}
+	]}`)
+	auth := "Authorization: Basic " + base64.StdEncoding.EncodeToString([]byte("test-segment-key:"))
+
+	resp := api.Post("/v1/connectors/segment/v1/batch", auth, bytes.NewReader(body))
+	if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"created":2`) {
+		t.Fatalf("expected the score and comment to be created, got %d: %s", resp.Code, resp.Body.String())
+	}
+
+	ctx := context.Background()
+	experiences := client.ExperienceData.Query().Where(experiencedata.SourceTypeEQ("segment")).AllX(ctx)
+	for _, exp := range experiences {
+		if exp.SourceID != "Survey Answered" || exp.UserIdentifier != "user-42" {
+			t.Errorf("unexpected experience: %+v", exp)
+		}
+		if exp.FieldID == "nps" && exp.FieldType != "nps" {
+			t.Errorf("expected an nps score, got %+v", exp)
+		}
+	}
+
+	t.Run("redelivery", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/segment/v1/batch", auth, bytes.NewReader(body))
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"created":0`) {
+			t.Errorf("expected the event to be stored already, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("invalid write key", func(t *testing.T) {
+		resp := api.Post("/v1/connectors/segment", "Authorization: Basic "+base64.StdEncoding.EncodeToString([]byte("wrong:")), bytes.NewReader(body))
+		if resp.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", resp.Code)
+		}
+	})
+}
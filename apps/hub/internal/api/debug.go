@@ -0,0 +1,40 @@
+package api
+
+import (
+	"expvar"
+	"runtime"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+
+	custommiddleware "github.com/formbricks/hub/apps/hub/internal/middleware"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// debugTimeoutClass exempts profiles from the request timeout, since CPU profiles and
+// execution traces run for as long as the client asks (?seconds=30 by default)
+var debugTimeoutClass = custommiddleware.TimeoutClass{Name: "debug", Patterns: []string{"/debug/pprof/*"}}
+
+// registerDebugRoutes serves Go runtime profiles under /debug/pprof/ and runtime variables
+// under /debug/vars, e.g. to capture goroutine and heap profiles of a misbehaving worker pool:
+//
+//	curl -H "X-API-Key: $KEY" -o heap.pb.gz https://hub.example.com/debug/pprof/heap
+//	go tool pprof -http=: heap.pb.gz
+func registerDebugRoutes(router chi.Router, apiKey string, dispatcher *webhook.Dispatcher) {
+	publishVars(dispatcher)
+	router.Group(func(r chi.Router) {
+		r.Use(custommiddleware.RequireAPIKey(apiKey))
+		r.Mount("/debug", middleware.Profiler())
+	})
+}
+
+// publishVars adds Hub's runtime variables to those of expvar (memstats and cmdline).
+// Variables are global, so only the first server publishes them.
+func publishVars(dispatcher *webhook.Dispatcher) {
+	if expvar.Get("goroutines") == nil {
+		expvar.Publish("goroutines", expvar.Func(func() any { return runtime.NumGoroutine() }))
+	}
+	if dispatcher != nil && expvar.Get("webhooks") == nil {
+		expvar.Publish("webhooks", expvar.Func(func() any { return dispatcher.Status() }))
+	}
+}
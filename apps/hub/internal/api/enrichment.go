@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/usage"
+)
+
+// ListStaleEnrichmentsInput defines the input for listing stale enrichments
+type ListStaleEnrichmentsInput struct {
+	Limit  int `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset int `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+}
+
+// ListStaleEnrichmentsOutput represents the output for listing stale enrichments
+type ListStaleEnrichmentsOutput struct {
+	Body struct {
+		Data           []ExperienceData `json:"data" doc:"Experiences with stale enrichment"`
+		Total          int              `json:"total" doc:"Total count of experiences with stale enrichment"`
+		Limit          int              `json:"limit" doc:"Limit used in query"`
+		Offset         int              `json:"offset" doc:"Offset used in query"`
+		CurrentVersion int              `json:"current_version" doc:"Current enrichment prompt version"`
+		CurrentModels  []string         `json:"current_models" doc:"Configured enrichment models (primary and fallbacks)"`
+	}
+}
+
+// PreviewEnrichmentInput defines the input for previewing an enrichment
+type PreviewEnrichmentInput struct {
+	Body struct {
+		Text     string `json:"text" minLength:"1" maxLength:"10000" doc:"Text to analyze" example:"The new dashboard is great, but exports keep failing"`
+		Provider string `json:"provider,omitempty" enum:"openai,gemini" doc:"Chat provider to use instead of the configured enrichment provider"`
+		Model    string `json:"model,omitempty" doc:"Model to use instead of the provider's configured enrichment model" example:"gpt-4o"`
+	}
+}
+
+// PreviewEnrichmentOutput represents the enrichment that would be stored for the text
+type PreviewEnrichmentOutput struct {
+	Body struct {
+		Sentiment        string         `json:"sentiment" doc:"Detected sentiment: positive, negative, neutral"`
+		SentimentScore   float64        `json:"sentiment_score" doc:"Sentiment intensity from -1 (negative) to +1 (positive)"`
+		Emotion          string         `json:"emotion" doc:"Detected emotion: joy, anger, frustration, sadness, neutral"`
+		Topics           []string       `json:"topics" doc:"Extracted topics"`
+		IsSpam           bool           `json:"is_spam" doc:"Whether the text was flagged as spam"`
+		SpamConfidence   float64        `json:"spam_confidence" doc:"Confidence of the spam verdict from 0 to 1"`
+		UrgencyScore     float64        `json:"urgency_score" doc:"Triage urgency from 0 (routine) to 1 (needs immediate attention)"`
+		UrgencyReasons   []string       `json:"urgency_reasons" doc:"Reasons behind the urgency score"`
+		Attributes       map[string]any `json:"attributes,omitempty" doc:"Attributes returned by a custom enrichment provider"`
+		Provider         string         `json:"provider" doc:"Provider that produced the result (a fallback if the primary failed)"`
+		Model            string         `json:"model" doc:"Model that produced the result"`
+		PromptVersion    int            `json:"prompt_version" doc:"Enrichment prompt version used"`
+		PromptTokens     int            `json:"prompt_tokens" doc:"Prompt (input) tokens"`
+		CompletionTokens int            `json:"completion_tokens" doc:"Completion (output) tokens"`
+		CostUSD          float64        `json:"cost_usd" doc:"Estimated cost in USD based on list prices"`
+	}
+}
+
+// RegisterEnrichmentRoutes registers enrichment maintenance routes. currentConfig returns the
+// configuration to use, which changes when the enrichment models are reloaded.
+func RegisterEnrichmentRoutes(api huma.API, currentConfig func() *config.Config, client *ent.Client, logger *slog.Logger) {
+	usageRecorder := usage.NewRecorder(client, logger)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "preview-enrichment",
+		Method:      "POST",
+		Path:        "/v1/enrichment/preview",
+		Summary:     "Preview the enrichment of a text",
+		Description: "Runs the enrichment prompt over the text with the configured provider, or with the provider and model given in the request, and returns the parsed result without storing anything. Use it to validate a model or prompt change before rolling it out. Token usage is recorded under the preview job type.",
+		Tags:        []string{"Experiences"},
+	}, func(ctx context.Context, input *PreviewEnrichmentInput) (*PreviewEnrichmentOutput, error) {
+		cfg := currentConfig()
+		var svc *enrichment.Service
+		if input.Body.Provider != "" || input.Body.Model != "" {
+			spec := config.ProviderModel{Provider: input.Body.Provider, Model: input.Body.Model}
+			if spec.Provider == "" {
+				spec.Provider = cfg.EnrichmentProvider
+			}
+			if spec.Provider == ai.ProviderCustom {
+				return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidProvider, "A model can't be chosen for the custom enrichment provider")
+			}
+			if spec.Model == "" {
+				spec.Model = cfg.ProviderEnrichmentModel(spec.Provider)
+			}
+
+			provider, err := ai.NewChatProvider(cfg, spec)
+			if err != nil {
+				return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidProvider, fmt.Sprintf("Invalid provider or model: %v", err))
+			}
+			svc = enrichment.NewService([]ai.ChatProvider{provider}, cfg.EnrichmentTimeout, logger)
+		} else {
+			if !cfg.IsEnrichmentEnabled() {
+				return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Enrichment is not enabled. Configure an enrichment provider and its API key, or choose a provider in the request.")
+			}
+
+			var err error
+			svc, err = enrichment.NewServiceFromConfig(cfg, logger)
+			if err != nil {
+				return nil, handleServiceError(logger, err, "enrichment", "create service")
+			}
+		}
+
+		result, err := svc.EnrichText(ctx, input.Body.Text)
+		if err != nil {
+			return nil, handleServiceError(logger, err, "enrichment", "preview")
+		}
+
+		cost := ai.EstimateCost(result.Model, result.Usage)
+		usageRecorder.RecordAsync(usage.Entry{
+			JobType:  usage.JobTypePreview,
+			Provider: result.Provider,
+			Model:    result.Model,
+			Usage:    result.Usage,
+		})
+
+		output := &PreviewEnrichmentOutput{}
+		output.Body.Sentiment = result.Sentiment
+		output.Body.SentimentScore = result.SentimentScore
+		output.Body.Emotion = result.Emotion
+		output.Body.Topics = result.Topics
+		output.Body.IsSpam = result.IsSpam
+		output.Body.SpamConfidence = result.SpamConfidence
+		output.Body.UrgencyScore = result.UrgencyScore
+		output.Body.UrgencyReasons = result.UrgencyReasons
+		output.Body.Attributes = result.Attributes
+		output.Body.Provider = result.Provider
+		output.Body.Model = result.Model
+		output.Body.PromptVersion = enrichment.PromptVersion
+		output.Body.PromptTokens = result.Usage.PromptTokens
+		output.Body.CompletionTokens = result.Usage.CompletionTokens
+		output.Body.CostUSD = cost
+
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-stale-enrichments",
+		Method:      "GET",
+		Path:        "/v1/enrichment/stale",
+		Summary:     "List experiences with stale enrichment",
+		Description: "Lists enriched experiences whose enrichment was produced by an older prompt version or by a model that is no longer configured. Set SERVICE_REENRICH_STALE=true to re-enrich them automatically at startup.",
+		Tags:        []string{"Experiences"},
+	}, func(ctx context.Context, input *ListStaleEnrichmentsInput) (*ListStaleEnrichmentsOutput, error) {
+		currentModels := currentConfig().GetEnrichmentModels()
+		query := client.ExperienceData.Query().
+			Where(enrichment.StalePredicate(currentModels))
+
+		total, err := query.Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "stale enrichments")
+		}
+
+		experiences, err := query.
+			Limit(input.Limit).
+			Offset(input.Offset).
+			Order(ent.Desc(experiencedata.FieldCollectedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "stale enrichments")
+		}
+
+		output := &ListStaleEnrichmentsOutput{}
+		output.Body.Data = make([]ExperienceData, len(experiences))
+		for i, exp := range experiences {
+			output.Body.Data[i] = entityToOutput(exp)
+		}
+		output.Body.Total = total
+		output.Body.Limit = input.Limit
+		output.Body.Offset = input.Offset
+		output.Body.CurrentVersion = enrichment.PromptVersion
+		output.Body.CurrentModels = currentModels
+
+		return output, nil
+	})
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+)
+
+func TestEnrichmentReload(t *testing.T) {
+	server, api, client, cleanup := setupTestServer(t)
+	defer cleanup()
+
+	enricher := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"sentiment": "negative", "topics": ["exports"]}`))
+	}))
+	defer enricher.Close()
+
+	// The custom classifier as configured on startup
+	v1 := *server.config
+	v1.EnrichmentProvider = "custom"
+	v1.CustomEnricherURL = enricher.URL
+	v1.CustomEnricherModel = "classifier-v1"
+	server.SetEnrichmentConfig(&v1)
+
+	resp := api.Post("/v1/experiences", map[string]interface{}{
+		"source_type": "support",
+		"field_id":    "message",
+		"field_type":  "text",
+		"value_text":  "Exports keep failing",
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var exp ExperienceData
+	if err := json.Unmarshal(resp.Body.Bytes(), &exp); err != nil {
+		t.Fatal(err)
+	}
+	err := client.ExperienceData.UpdateOneID(exp.ID).
+		SetSentiment("negative").
+		SetEnrichmentProvider("custom").
+		SetEnrichmentModel("classifier-v1").
+		SetEnrichmentVersion(enrichment.PromptVersion).
+		Exec(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(model string, stale int) {
+		t.Helper()
+		resp := api.Post("/v1/enrichment/preview", map[string]interface{}{"text": "Exports keep failing"})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var preview PreviewEnrichmentOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &preview.Body); err != nil {
+			t.Fatal(err)
+		}
+		if preview.Body.Model != model {
+			t.Errorf("expected the preview to use %s, got %s", model, preview.Body.Model)
+		}
+
+		resp = api.Get("/v1/enrichment/stale")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var list ListStaleEnrichmentsOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &list.Body); err != nil {
+			t.Fatal(err)
+		}
+		if list.Body.Total != stale || !slices.Equal(list.Body.CurrentModels, []string{model}) {
+			t.Errorf("expected %d stale experiences with models [%s], got %d with %v", stale, model, list.Body.Total, list.Body.CurrentModels)
+		}
+	}
+	check("classifier-v1", 0)
+
+	// Reloading a new classifier version makes the enrichment stale and is previewed
+	v2 := v1
+	v2.CustomEnricherModel = "classifier-v2"
+	server.SetEnrichmentConfig(&v2)
+	check("classifier-v2", 1)
+}
@@ -3,13 +3,23 @@
 package api
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
+	"net/http"
+	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
 	"github.com/google/uuid"
 )
 
+func init() {
+	// All errors, including Huma's validation errors, are problem details with an error code
+	huma.NewError = problem.NewError
+}
+
 // Error message constants for consistent client-facing messages
 const (
 	ErrMsgNotFound       = "The requested resource does not exist"
@@ -20,6 +30,18 @@ const (
 	ErrMsgInvalidInput   = "Invalid input: "
 )
 
+// notFoundCodes are the error codes of resources that weren't found, by Ent node label
+var notFoundCodes = map[string]problem.Code{
+	"experience_data":  problem.CodeExperienceNotFound,
+	"enrichment_job":   problem.CodeJobNotFound,
+	"webhook_endpoint": problem.CodeWebhookNotFound,
+	"webhook_delivery": problem.CodeDeliveryNotFound,
+	"question":         problem.CodeQuestionNotFound,
+	"segment":          problem.CodeSegmentNotFound,
+	"export":           problem.CodeExportNotFound,
+	"ingest_mapping":   problem.CodeMappingNotFound,
+}
+
 // handleDatabaseError is a specialized error handler for database operations.
 // It logs the full error details internally but returns sanitized error messages to clients.
 // This prevents leaking internal implementation details like stack traces or database errors.
@@ -30,16 +52,32 @@ func handleDatabaseError(logger *slog.Logger, err error, operation string, resou
 		"resource_id", resourceID)
 
 	// Return sanitized error based on error type
-	if ent.IsNotFound(err) {
-		return huma.Error404NotFound(ErrMsgNotFound)
+	var notFound *ent.NotFoundError
+	if errors.As(err, &notFound) {
+		return problem.New(http.StatusNotFound, notFoundCode(notFound), ErrMsgNotFound)
 	}
 
 	if ent.IsConstraintError(err) {
-		return huma.Error409Conflict(ErrMsgConstraint)
+		return problem.New(http.StatusConflict, problem.CodeAlreadyExists, ErrMsgConstraint)
 	}
 
 	// Don't expose internal database errors to clients
-	return huma.Error500InternalServerError(ErrMsgDatabase)
+	return problem.New(http.StatusInternalServerError, problem.CodeDatabaseError, ErrMsgDatabase).WithCause(err)
+}
+
+// notFoundCode returns the error code of a resource that wasn't found. Ent only exposes the
+// node label through the error message ("ent: <label> not found").
+func notFoundCode(err *ent.NotFoundError) problem.Code {
+	label := strings.TrimSuffix(strings.TrimPrefix(err.Error(), "ent: "), " not found")
+	if code, ok := notFoundCodes[label]; ok {
+		return code
+	}
+	return problem.CodeNotFound
+}
+
+// invalidTimestamp returns the error of a since or until query parameter that isn't RFC3339
+func invalidTimestamp(detail string) error {
+	return problem.New(http.StatusBadRequest, problem.CodeInvalidTimestamp, detail)
 }
 
 // handleServiceError handles errors from service layer (AI enrichment, embeddings, etc).
@@ -50,7 +88,8 @@ func handleServiceError(logger *slog.Logger, err error, service string, operatio
 		"service", service)
 
 	// Return generic error - don't expose service implementation details
-	return huma.Error503ServiceUnavailable(ErrMsgServiceUnavail)
+	return problem.New(http.StatusServiceUnavailable, problem.CodeServiceUnavailable, ErrMsgServiceUnavail).
+		WithCause(fmt.Errorf("%s %s failed: %w", service, operation, err))
 }
 
 // parseUUID parses a UUID string and returns an error if invalid.
@@ -58,7 +97,7 @@ func handleServiceError(logger *slog.Logger, err error, service string, operatio
 func parseUUID(id string) (uuid.UUID, error) {
 	parsed, err := uuid.Parse(id)
 	if err != nil {
-		return uuid.Nil, huma.Error400BadRequest(ErrMsgInvalidUUID)
+		return uuid.Nil, problem.New(http.StatusBadRequest, problem.CodeInvalidID, ErrMsgInvalidUUID)
 	}
 	return parsed, nil
 }
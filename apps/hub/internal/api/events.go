@@ -0,0 +1,234 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	custommiddleware "github.com/formbricks/hub/apps/hub/internal/middleware"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+const (
+	// eventStreamBufferSize is the number of events buffered per connection; connections
+	// that fall further behind are closed
+	eventStreamBufferSize = 256
+	// eventStreamPingInterval is how often connections are checked for liveness
+	eventStreamPingInterval = 30 * time.Second
+	// eventStreamWriteTimeout bounds writing a single message to a client
+	eventStreamWriteTimeout = 10 * time.Second
+	// eventStreamMaxMessageSize limits messages sent by clients
+	eventStreamMaxMessageSize = 64 * 1024
+)
+
+// EventFilters selects the events sent over an event stream connection. Each list matches
+// any of its values; empty lists match all events.
+type EventFilters struct {
+	EventTypes  []string `json:"event_types"`
+	SourceTypes []string `json:"source_type"`
+	Sentiments  []string `json:"sentiment"`
+}
+
+// eventStreamMessage is a message sent over an event stream connection in either direction
+type eventStreamMessage struct {
+	Type    string          `json:"type"`
+	Filters *EventFilters   `json:"filters,omitempty"`
+	Event   json.RawMessage `json:"event,omitempty"`
+	Message string          `json:"message,omitempty"`
+}
+
+// validate rejects unknown event types and sentiments
+func (f *EventFilters) validate() error {
+	for _, eventType := range f.EventTypes {
+		if err := webhook.EventType(eventType).Validate(); err != nil {
+			return err
+		}
+	}
+	for _, sentiment := range f.Sentiments {
+		if sentiment != "positive" && sentiment != "negative" && sentiment != "neutral" {
+			return fmt.Errorf("invalid sentiment: %s", sentiment)
+		}
+	}
+	return nil
+}
+
+// matches reports whether an event passes the filters. Filters on source type and
+// sentiment only match events about an experience that has those fields, so a sentiment
+// filter skips experiences that aren't enriched yet.
+func (f *EventFilters) matches(msg webhook.Message) bool {
+	if len(f.EventTypes) > 0 && !slices.Contains(f.EventTypes, msg.EventType.String()) {
+		return false
+	}
+	if len(f.SourceTypes) == 0 && len(f.Sentiments) == 0 {
+		return true
+	}
+
+	var event struct {
+		Data struct {
+			SourceType string  `json:"source_type"`
+			Sentiment  *string `json:"sentiment"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		return false
+	}
+	if len(f.SourceTypes) > 0 && !slices.Contains(f.SourceTypes, event.Data.SourceType) {
+		return false
+	}
+	if len(f.Sentiments) > 0 && (event.Data.Sentiment == nil || !slices.Contains(f.Sentiments, *event.Data.Sentiment)) {
+		return false
+	}
+	return true
+}
+
+// filtersFromQuery reads filters from repeated or comma-separated query parameters
+func filtersFromQuery(r *http.Request) *EventFilters {
+	values := func(name string) []string {
+		var result []string
+		for _, value := range r.URL.Query()[name] {
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					result = append(result, item)
+				}
+			}
+		}
+		return result
+	}
+	return &EventFilters{
+		EventTypes:  values("event_types"),
+		SourceTypes: values("source_type"),
+		Sentiments:  values("sentiment"),
+	}
+}
+
+// RegisterEventStreamRoutes registers the WebSocket event stream. It is served by the
+// router rather than Huma, so it is protected by its own API key check.
+func RegisterEventStreamRoutes(router chi.Router, cfg *config.Config, dispatcher *webhook.Dispatcher, logger *slog.Logger) {
+	router.Group(func(r chi.Router) {
+		if cfg.APIKey != "" {
+			r.Use(custommiddleware.RequireAPIKey(cfg.APIKey))
+		}
+		r.Get("/v1/events", func(w http.ResponseWriter, r *http.Request) {
+			serveEventStream(w, r, cfg.GetWebsocketAllowedOrigins(), dispatcher, logger)
+		})
+	})
+}
+
+// serveEventStream streams events matching the connection's filters until the client
+// disconnects. Clients change the filters by sending a subscribe message.
+func serveEventStream(w http.ResponseWriter, r *http.Request, origins []string, dispatcher *webhook.Dispatcher, logger *slog.Logger) {
+	filters := filtersFromQuery(r)
+	if err := filters.validate(); err != nil {
+		problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+err.Error())
+		return
+	}
+
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: origins})
+	if err != nil {
+		// Accept has already written the error response
+		logger.Warn("failed to accept event stream connection", "error", err)
+		return
+	}
+	defer func() { _ = conn.CloseNow() }()
+	conn.SetReadLimit(eventStreamMaxMessageSize)
+
+	subscription := dispatcher.Subscribe(eventStreamBufferSize)
+	defer subscription.Close()
+
+	logger.Info("event stream connected", "remote_addr", r.RemoteAddr)
+	defer logger.Info("event stream disconnected", "remote_addr", r.RemoteAddr)
+
+	// The request context isn't cancelled when the server shuts down once the connection
+	// is hijacked; the dispatcher closes the subscription instead
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var mu sync.Mutex
+	write := func(msg eventStreamMessage) error {
+		writeCtx, cancel := context.WithTimeout(ctx, eventStreamWriteTimeout)
+		defer cancel()
+		return wsjson.Write(writeCtx, conn, msg)
+	}
+
+	if err := write(eventStreamMessage{Type: "subscribed", Filters: filters}); err != nil {
+		return
+	}
+
+	// Read filter updates until the client disconnects
+	go func() {
+		defer cancel()
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				return
+			}
+			var msg eventStreamMessage
+			if err := json.Unmarshal(data, &msg); err != nil {
+				_ = write(eventStreamMessage{Type: "error", Message: "invalid JSON message"})
+				continue
+			}
+
+			switch {
+			case msg.Type != "subscribe":
+				_ = write(eventStreamMessage{Type: "error", Message: fmt.Sprintf("unknown message type %q", msg.Type)})
+			case msg.Filters == nil:
+				_ = write(eventStreamMessage{Type: "error", Message: "subscribe message requires filters"})
+			case msg.Filters.validate() != nil:
+				_ = write(eventStreamMessage{Type: "error", Message: msg.Filters.validate().Error()})
+			default:
+				mu.Lock()
+				filters = msg.Filters
+				mu.Unlock()
+				_ = write(eventStreamMessage{Type: "subscribed", Filters: msg.Filters})
+			}
+		}
+	}()
+
+	ping := time.NewTicker(eventStreamPingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case msg, ok := <-subscription.Messages():
+			if !ok {
+				if subscription.Lagged() {
+					_ = conn.Close(websocket.StatusPolicyViolation, "client fell behind")
+				} else {
+					_ = conn.Close(websocket.StatusGoingAway, "server shutting down")
+				}
+				return
+			}
+
+			mu.Lock()
+			current := filters
+			mu.Unlock()
+			if !current.matches(msg) {
+				continue
+			}
+			if err := write(eventStreamMessage{Type: "event", Event: msg.Payload}); err != nil {
+				return
+			}
+		case <-ping.C:
+			pingCtx, cancel := context.WithTimeout(ctx, eventStreamWriteTimeout)
+			err := conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
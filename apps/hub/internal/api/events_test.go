@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+func TestEventFilters(t *testing.T) {
+	message := func(eventType webhook.EventType, data string) webhook.Message {
+		return webhook.Message{EventType: eventType, Payload: []byte(`{"event":"` + eventType.String() + `","data":` + data + `}`)}
+	}
+	created := message(webhook.EventExperienceCreated, `{"source_type":"survey"}`)
+	enriched := message(webhook.EventExperienceEnriched, `{"source_type":"survey","sentiment":"negative"}`)
+
+	tests := []struct {
+		name    string
+		filters EventFilters
+		msg     webhook.Message
+		want    bool
+	}{
+		{"no filters", EventFilters{}, created, true},
+		{"event type", EventFilters{EventTypes: []string{"experience.enriched"}}, created, false},
+		{"source type", EventFilters{SourceTypes: []string{"survey", "review"}}, created, true},
+		{"other source type", EventFilters{SourceTypes: []string{"review"}}, created, false},
+		{"sentiment", EventFilters{Sentiments: []string{"negative"}}, enriched, true},
+		{"sentiment before enrichment", EventFilters{Sentiments: []string{"negative"}}, created, false},
+		{"all filters", EventFilters{EventTypes: []string{"experience.enriched"}, SourceTypes: []string{"survey"}, Sentiments: []string{"negative"}}, enriched, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filters.matches(tt.msg); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := (&EventFilters{Sentiments: []string{"angry"}}).validate(); err == nil {
+		t.Error("validate() accepted an unknown sentiment")
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	dispatcher := webhook.NewDispatcher(nil, logger)
+	defer func() { _ = dispatcher.Shutdown(time.Second) }()
+
+	router := chi.NewRouter()
+	RegisterEventStreamRoutes(router, &config.Config{APIKey: "test-key"}, dispatcher, logger)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/events?source_type=survey"
+
+	if _, resp, err := websocket.Dial(ctx, url, nil); err == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Dial() without API key = %v; want 401", err)
+	}
+
+	conn, _, err := websocket.Dial(ctx, url, &websocket.DialOptions{HTTPHeader: http.Header{"X-API-Key": []string{"test-key"}}})
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer func() { _ = conn.CloseNow() }()
+
+	read := func() eventStreamMessage {
+		t.Helper()
+		var msg eventStreamMessage
+		if err := wsjson.Read(ctx, conn, &msg); err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+		return msg
+	}
+	readEvent := func() map[string]any {
+		t.Helper()
+		msg := read()
+		if msg.Type != "event" {
+			t.Fatalf("expected an event, got %+v", msg)
+		}
+		var event struct {
+			Data map[string]any `json:"data"`
+		}
+		_ = json.Unmarshal(msg.Event, &event)
+		return event.Data
+	}
+
+	if msg := read(); msg.Type != "subscribed" || msg.Filters == nil || len(msg.Filters.SourceTypes) != 1 {
+		t.Fatalf("expected subscription confirmation, got %+v", msg)
+	}
+
+	dispatcher.Dispatch(ctx, webhook.EventExperienceCreated, map[string]any{"id": "1", "source_type": "review"})
+	dispatcher.Dispatch(ctx, webhook.EventExperienceCreated, map[string]any{"id": "2", "source_type": "survey"})
+	if data := readEvent(); data["id"] != "2" {
+		t.Errorf("expected only the survey event, got %v", data)
+	}
+
+	// Filters can be changed on an open connection
+	if err := wsjson.Write(ctx, conn, eventStreamMessage{Type: "subscribe", Filters: &EventFilters{Sentiments: []string{"angry"}}}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if msg := read(); msg.Type != "error" {
+		t.Errorf("expected an error for an invalid sentiment, got %+v", msg)
+	}
+	if err := wsjson.Write(ctx, conn, eventStreamMessage{Type: "subscribe", Filters: &EventFilters{Sentiments: []string{"negative"}}}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if msg := read(); msg.Type != "subscribed" {
+		t.Fatalf("expected subscription confirmation, got %+v", msg)
+	}
+
+	dispatcher.Dispatch(ctx, webhook.EventExperienceEnriched, map[string]any{"id": "3", "source_type": "review", "sentiment": "positive"})
+	dispatcher.Dispatch(ctx, webhook.EventExperienceEnriched, map[string]any{"id": "4", "source_type": "review", "sentiment": "negative"})
+	if data := readEvent(); data["id"] != "4" {
+		t.Errorf("expected only the negative event, got %v", data)
+	}
+}
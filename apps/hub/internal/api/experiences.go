@@ -2,21 +2,32 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
+	"net/http"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
 
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
 	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/questionbank"
 	"github.com/formbricks/hub/apps/hub/internal/queue"
 	"github.com/formbricks/hub/apps/hub/internal/webhook"
 )
 
 // enqueueAIJobs enqueues enrichment and embedding jobs for text responses.
-func enqueueAIJobs(ctx context.Context, logger *slog.Logger, queue queue.Queue, exp *ent.ExperienceData, fieldLabel, valueText string) {
+func enqueueAIJobs(ctx context.Context, logger *slog.Logger, jobQueue queue.Queue, exp *ent.ExperienceData, fieldLabel, valueText string, priority queue.Priority) {
 	// Build text with question context if available (used for both enrichment and embeddings)
 	enrichmentText := valueText
 	if fieldLabel != "" {
@@ -24,103 +35,269 @@ func enqueueAIJobs(ctx context.Context, logger *slog.Logger, queue queue.Queue,
 	}
 
 	// Enqueue enrichment job (sentiment/topics/emotion) with question context
-	if err := queue.Enqueue(ctx, exp.ID.String(), enrichmentText); err != nil {
+	if err := jobQueue.Enqueue(ctx, exp.ID.String(), enrichmentText, priority); err != nil {
 		logger.Warn("failed to enqueue enrichment job", "experience_id", exp.ID, "error", err)
 	} else {
 		logger.Debug("enrichment job enqueued", "experience_id", exp.ID)
 	}
 
 	// Enqueue embedding job (vector generation for semantic search)
-	if err := queue.EnqueueEmbedding(ctx, exp.ID.String(), enrichmentText); err != nil {
+	if err := jobQueue.EnqueueEmbedding(ctx, exp.ID.String(), enrichmentText, priority); err != nil {
 		logger.Warn("failed to enqueue embedding job", "experience_id", exp.ID, "error", err)
 	} else {
 		logger.Debug("embedding job enqueued", "experience_id", exp.ID)
 	}
 }
 
-// RegisterExperienceRoutes registers all experience-related routes
-func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger, enrichmentQueue queue.Queue) {
-	// POST /v1/experiences - Create experience
-	huma.Register(api, huma.Operation{
-		OperationID: "create-experience",
-		Method:      "POST",
-		Path:        "/v1/experiences",
-		Summary:     "Create a new experience data record",
-		Description: "Creates a new experience data record",
-		Tags:        []string{"Experiences"},
-	}, func(ctx context.Context, input *CreateExperienceInput) (*ExperienceOutput, error) {
-		// Set default collected_at if not provided
-		collectedAt := time.Now()
-		if input.Body.CollectedAt != nil {
-			collectedAt = *input.Body.CollectedAt
-		}
+// clearAIResults resets enrichment and embedding fields that were derived from the previous text.
+// Topics are handled by the caller, since they may be replaced by locally extracted keywords.
+func clearAIResults(update *ent.ExperienceDataUpdateOne) {
+	update.
+		ClearSentiment().
+		ClearSentimentScore().
+		ClearEmotion().
+		ClearIsSpam().
+		ClearSpamConfidence().
+		ClearUrgencyScore().
+		ClearUrgencyReasons().
+		ClearEnrichmentProvider().
+		ClearEnrichmentModel().
+		ClearEnrichmentVersion().
+		ClearEnrichmentAttributes().
+		ClearEmbedding().
+		ClearEmbeddingModel().
+		ClearAiInputHash()
+}
 
-		// Create the experience
-		builder := client.ExperienceData.Create().
-			SetSourceType(input.Body.SourceType).
-			SetFieldID(input.Body.FieldID).
-			SetFieldType(input.Body.FieldType).
-			SetCollectedAt(collectedAt)
+// experienceCreator stores new experiences, whether they are posted to the API or received
+// by a connector
+type experienceCreator struct {
+	cfg             *config.Config
+	client          *ent.Client
+	dispatcher      *webhook.Dispatcher
+	logger          *slog.Logger
+	queue           queue.Queue
+	metadataColumns map[string][]string
+	valueRules      models.ValueRules
+}
 
-		// Set optional fields
-		if input.Body.SourceID != nil {
-			builder.SetSourceID(*input.Body.SourceID)
-		}
-		if input.Body.SourceName != nil {
-			builder.SetSourceName(*input.Body.SourceName)
-		}
-		if input.Body.FieldLabel != nil {
-			builder.SetFieldLabel(*input.Body.FieldLabel)
-		}
-		if input.Body.ValueText != nil {
-			builder.SetValueText(*input.Body.ValueText)
-		}
-		if input.Body.ValueNumber != nil {
-			builder.SetValueNumber(*input.Body.ValueNumber)
-		}
-		if input.Body.ValueBoolean != nil {
-			builder.SetValueBoolean(*input.Body.ValueBoolean)
-		}
-		if input.Body.ValueDate != nil {
-			builder.SetValueDate(*input.Body.ValueDate)
-		}
-		if input.Body.ValueJSON != nil {
-			builder.SetValueJSON(input.Body.ValueJSON)
-		}
-		if input.Body.Metadata != nil {
-			builder.SetMetadata(input.Body.Metadata)
-		}
-		if input.Body.Language != nil {
-			builder.SetLanguage(*input.Body.Language)
-		}
-		if input.Body.UserIdentifier != nil {
-			builder.SetUserIdentifier(*input.Body.UserIdentifier)
+func newExperienceCreator(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger, enrichmentQueue queue.Queue) *experienceCreator {
+	c := &experienceCreator{cfg: cfg, client: client, dispatcher: dispatcher, logger: logger, queue: enrichmentQueue}
+
+	// Validated at startup
+	var err error
+	if c.metadataColumns, err = cfg.GetMetadataColumns(); err != nil {
+		logger.Error("invalid metadata columns, they won't be populated", "error", err)
+	}
+	if c.valueRules.CSAT.Min, c.valueRules.CSAT.Max, err = cfg.GetCSATRange(); err != nil {
+		logger.Error("invalid csat range, csat scores won't be checked", "error", err)
+		c.valueRules.CSAT = models.Range{Min: math.Inf(-1), Max: math.Inf(1)}
+	}
+	if c.valueRules.Rating.Min, c.valueRules.Rating.Max, err = cfg.GetRatingRange(); err != nil {
+		logger.Error("invalid rating range, rating scores won't be checked", "error", err)
+		c.valueRules.Rating = models.Range{Min: math.Inf(-1), Max: math.Inf(1)}
+	}
+	return c
+}
+
+// create validates and stores an experience, links its question, enqueues its AI jobs, and
+// dispatches experience.created. A request with the idempotency key of an earlier one returns
+// the experience it created, without jobs or events.
+func (c *experienceCreator) create(ctx context.Context, input *CreateExperienceInput) (*ent.ExperienceData, error) {
+	if input.IdempotencyKey != "" {
+		exp, err := c.findIdempotent(ctx, input.IdempotencyKey)
+		if err != nil || exp != nil {
+			return exp, err
 		}
+	}
+
+	// Reject values that don't fit the field type instead of storing inconsistent rows
+	values := models.Values{
+		Text:    input.Body.ValueText,
+		Number:  input.Body.ValueNumber,
+		Boolean: input.Body.ValueBoolean,
+		Date:    input.Body.ValueDate,
+	}
+	if err := c.valueRules.Validate(models.FieldType(input.Body.FieldType), values, true); err != nil {
+		return nil, problem.New(http.StatusUnprocessableEntity, problem.CodeInvalidValue, err.Error())
+	}
+
+	// Set default collected_at if not provided
+	collectedAt := time.Now()
+	if input.Body.CollectedAt != nil {
+		collectedAt = *input.Body.CollectedAt
+	}
+
+	// Create the experience
+	builder := c.client.ExperienceData.Create().
+		SetSourceType(input.Body.SourceType).
+		SetFieldID(input.Body.FieldID).
+		SetFieldType(input.Body.FieldType).
+		SetCollectedAt(collectedAt)
+
+	// Set optional fields
+	if input.Body.SourceID != nil {
+		builder.SetSourceID(*input.Body.SourceID)
+	}
+	if input.Body.SourceName != nil {
+		builder.SetSourceName(*input.Body.SourceName)
+	}
+	if input.Body.FieldLabel != nil {
+		builder.SetFieldLabel(*input.Body.FieldLabel)
+	}
+	if input.Body.ValueText != nil {
+		builder.SetValueText(*input.Body.ValueText)
+	}
+	if input.Body.ValueNumber != nil {
+		builder.SetValueNumber(*input.Body.ValueNumber)
+	}
+	builder.SetNillableNpsCategory(models.NPSCategory(input.Body.FieldType, input.Body.ValueNumber))
+	if input.Body.ValueBoolean != nil {
+		builder.SetValueBoolean(*input.Body.ValueBoolean)
+	}
+	if input.Body.ValueDate != nil {
+		builder.SetValueDate(*input.Body.ValueDate)
+	}
+	if input.Body.ValueJSON != nil {
+		builder.SetValueJSON(input.Body.ValueJSON)
+	}
+	if input.Body.Metadata != nil {
+		builder.SetMetadata(input.Body.Metadata)
+		models.ExtractMetadataColumns(input.Body.Metadata, c.metadataColumns).Apply(builder.Mutation())
+	}
+	if input.Body.Language != nil {
+		builder.SetLanguage(*input.Body.Language)
+	}
+	if input.Body.UserIdentifier != nil {
+		builder.SetUserIdentifier(*input.Body.UserIdentifier)
+	}
+	if input.IdempotencyKey != "" {
+		builder.SetIdempotencyKey(input.IdempotencyKey)
+	}
 
-		exp, err := builder.Save(ctx)
+	// Exact duplicates of a user's earlier experience are allowed, flagged, or rejected.
+	// Anonymous experiences are never duplicates, as different respondents naturally
+	// give the same answers.
+	contentKey := models.ContentKey{
+		SourceType: input.Body.SourceType,
+		FieldID:    input.Body.FieldID,
+		Values:     values,
+	}
+	if input.Body.SourceID != nil {
+		contentKey.SourceID = *input.Body.SourceID
+	}
+	if input.Body.UserIdentifier != nil {
+		contentKey.UserIdentifier = *input.Body.UserIdentifier
+	}
+	contentHash := models.ContentHash(contentKey)
+	builder.SetNillableContentHash(contentHash)
+	if contentHash != nil && contentKey.UserIdentifier != "" &&
+		(c.cfg.DuplicatePolicy == models.DuplicatePolicyFlag || c.cfg.DuplicatePolicy == models.DuplicatePolicyReject) {
+		original, err := findDuplicate(ctx, c.client, *contentHash, collectedAt, c.cfg.DuplicateWindow)
 		if err != nil {
-			return nil, handleDatabaseError(logger, err, "create", "new")
+			return nil, handleDatabaseError(c.logger, err, "find duplicate of", "experience")
 		}
+		if original != nil {
+			if c.cfg.DuplicatePolicy == models.DuplicatePolicyReject {
+				return nil, problem.New(http.StatusConflict, problem.CodeDuplicateExperience, fmt.Sprintf("Duplicate of experience %s", original.ID))
+			}
+			builder.SetDuplicateOf(original.ID)
+		}
+	}
+
+	// Sensitive sources and backfills can opt out of AI processing entirely
+	sourceID := ""
+	if input.Body.SourceID != nil {
+		sourceID = *input.Body.SourceID
+	}
+	skipAI := input.Body.SkipAIProcessing || c.cfg.SkipsAIForSource(input.Body.SourceType, sourceID)
+	builder.SetSkipAiProcessing(skipAI)
+
+	// Link the question, so analytics don't depend on the label sent with the response
+	response := questionbank.Response{
+		Key:       questionbank.Key{SourceType: input.Body.SourceType, SourceID: sourceID, FieldID: input.Body.FieldID},
+		FieldType: input.Body.FieldType,
+	}
+	if input.Body.FieldLabel != nil {
+		response.Label = *input.Body.FieldLabel
+	}
+	if input.Body.Language != nil {
+		response.Language = *input.Body.Language
+	}
+	questionID, err := questionbank.NewResolver(c.client).Resolve(ctx, response)
+	if err != nil {
+		return nil, handleDatabaseError(c.logger, err, "resolve question", input.Body.FieldID)
+	}
+	builder.SetQuestionID(questionID)
+
+	// Enqueue AI processing jobs if applicable
+	fieldType := models.FieldType(input.Body.FieldType)
+	shouldProcess := fieldType.ShouldEnrich() &&
+		input.Body.ValueText != nil &&
+		*input.Body.ValueText != ""
 
-		// Enqueue AI processing jobs if applicable
-		fieldType := models.FieldType(input.Body.FieldType)
-		shouldProcess := fieldType.ShouldEnrich() &&
-			input.Body.ValueText != nil &&
-			*input.Body.ValueText != ""
+	// Without AI workers (or when AI is skipped), extract topics locally so they are never empty
+	if shouldProcess && (c.queue == nil || skipAI) {
+		builder.SetTopics(enrichment.ExtractKeywords(*input.Body.ValueText, enrichment.MaxTopics))
+	}
 
-		if shouldProcess && enrichmentQueue != nil {
-			fieldLabel := ""
-			if input.Body.FieldLabel != nil {
-				fieldLabel = *input.Body.FieldLabel
+	exp, err := builder.Save(ctx)
+	if err != nil {
+		// A concurrent retry with the same key created the experience first
+		if input.IdempotencyKey != "" && ent.IsConstraintError(err) {
+			if exp, findErr := c.findIdempotent(ctx, input.IdempotencyKey); findErr != nil || exp != nil {
+				return exp, findErr
 			}
-			enqueueAIJobs(ctx, logger, enrichmentQueue, exp, fieldLabel, *input.Body.ValueText)
 		}
+		return nil, handleDatabaseError(c.logger, err, "create", "new")
+	}
+
+	if shouldProcess && c.queue != nil && !skipAI {
+		fieldLabel := ""
+		if input.Body.FieldLabel != nil {
+			fieldLabel = *input.Body.FieldLabel
+		}
+		enqueueAIJobs(ctx, c.logger, c.queue, exp, fieldLabel, *input.Body.ValueText, queue.ParsePriority(input.Body.AIPriority))
+	}
 
-		logger.Info("experience created", "id", exp.ID, "queued_for_ai_processing", shouldProcess && enrichmentQueue != nil)
+	c.logger.Info("experience created", "id", exp.ID, "queued_for_ai_processing", shouldProcess && c.queue != nil && !skipAI)
 
-		// Dispatch webhook asynchronously
-		dispatcher.DispatchAsync(webhook.EventExperienceCreated, entityToOutput(exp))
+	// Dispatch webhook asynchronously
+	c.dispatcher.DispatchAsync(ctx, webhook.EventExperienceCreated, entityToOutput(exp))
+
+	return exp, nil
+}
+
+// findIdempotent returns the experience created with the idempotency key, or nil if there is none
+func (c *experienceCreator) findIdempotent(ctx context.Context, key string) (*ent.ExperienceData, error) {
+	exp, err := c.client.ExperienceData.Query().Where(experiencedata.IdempotencyKey(key)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, handleDatabaseError(c.logger, err, "find idempotent", "experience")
+	}
+	return exp, nil
+}
 
+// RegisterExperienceRoutes registers all experience-related routes. Listing reads from
+// reader, which may be a read replica; everything else uses client.
+func RegisterExperienceRoutes(api huma.API, cfg *config.Config, client *ent.Client, reader *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger, enrichmentQueue queue.Queue) {
+	creator := newExperienceCreator(cfg, client, dispatcher, logger, enrichmentQueue)
+
+	// POST /v1/experiences - Create experience
+	huma.Register(api, huma.Operation{
+		OperationID: "create-experience",
+		Method:      "POST",
+		Path:        "/v1/experiences",
+		Summary:     "Create a new experience data record",
+		Description: "Creates a new experience data record. Send an Idempotency-Key header to retry safely: a request with the key of an earlier one returns the experience it created.",
+		Tags:        []string{"Experiences"},
+	}, func(ctx context.Context, input *CreateExperienceInput) (*ExperienceOutput, error) {
+		exp, err := creator.create(ctx, input)
+		if err != nil {
+			return nil, err
+		}
 		return &ExperienceOutput{Body: entityToOutput(exp)}, nil
 	})
 
@@ -160,37 +337,21 @@ func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webh
 		limit := input.Limit
 		offset := input.Offset
 
-		// Build query
-		query := client.ExperienceData.Query()
+		// Build query, on the read replica if configured
+		query := reader.ExperienceData.Query()
 
-		// Apply filters (check for non-empty strings)
-		if input.SourceType != "" {
-			query = query.Where(experiencedata.SourceTypeEQ(input.SourceType))
-		}
-		if input.SourceID != "" {
-			query = query.Where(experiencedata.SourceIDEQ(input.SourceID))
-		}
-		if input.FieldType != "" {
-			query = query.Where(experiencedata.FieldTypeEQ(input.FieldType))
-		}
-		if input.UserIdentifier != "" {
-			query = query.Where(experiencedata.UserIdentifierEQ(input.UserIdentifier))
+		query, err := filterExperiences(query, input.Filter)
+		if err != nil {
+			return nil, err
 		}
-		if input.Since != "" {
-			// Parse ISO 8601 time string
-			sinceTime, err := time.Parse(time.RFC3339, input.Since)
+		if input.SegmentID != "" {
+			segmentFilter, err := loadSegment(ctx, reader, input.SegmentID, logger)
 			if err != nil {
-				return nil, huma.Error400BadRequest("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+				return nil, err
 			}
-			query = query.Where(experiencedata.CollectedAtGTE(sinceTime))
-		}
-		if input.Until != "" {
-			// Parse ISO 8601 time string
-			untilTime, err := time.Parse(time.RFC3339, input.Until)
-			if err != nil {
-				return nil, huma.Error400BadRequest("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+			if query, err = filterExperiences(query, *segmentFilter); err != nil {
+				return nil, err
 			}
-			query = query.Where(experiencedata.CollectedAtLTE(untilTime))
 		}
 
 		// Get total count
@@ -238,7 +399,7 @@ func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webh
 		Method:      "PATCH",
 		Path:        "/v1/experiences/{id}",
 		Summary:     "Update an experience",
-		Description: "Updates specific fields of an experience data record",
+		Description: "Updates specific fields of an experience data record. Changing value_text clears AI enrichment and embeddings and re-enqueues them for the new text (see SERVICE_REPROCESS_ON_UPDATE).",
 		Tags:        []string{"Experiences"},
 	}, func(ctx context.Context, input *UpdateExperienceInput) (*ExperienceOutput, error) {
 		id, err := parseUUID(input.ID)
@@ -246,9 +407,6 @@ func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webh
 			return nil, err
 		}
 
-		// Track if value_text is being updated for reprocessing
-		valueTextChanged := input.Body.ValueText != nil
-
 		// Build update query
 		update := client.ExperienceData.UpdateOneID(id)
 
@@ -270,6 +428,7 @@ func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webh
 		}
 		if input.Body.Metadata != nil {
 			update.SetMetadata(input.Body.Metadata)
+			models.ExtractMetadataColumns(input.Body.Metadata, creator.metadataColumns).Apply(update.Mutation())
 		}
 		if input.Body.Language != nil {
 			update.SetLanguage(*input.Body.Language)
@@ -278,26 +437,80 @@ func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webh
 			update.SetUserIdentifier(*input.Body.UserIdentifier)
 		}
 
+		// Value validation, the NPS category and AI processing depend on the stored field type
+		values := models.Values{
+			Text:    input.Body.ValueText,
+			Number:  input.Body.ValueNumber,
+			Boolean: input.Body.ValueBoolean,
+			Date:    input.Body.ValueDate,
+		}
+		var existing *ent.ExperienceData
+		if values != (models.Values{}) || input.Body.UserIdentifier != nil {
+			existing, err = client.ExperienceData.Get(ctx, id)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "get", id.String())
+			}
+			if err := creator.valueRules.Validate(models.FieldType(existing.FieldType), values, false); err != nil {
+				return nil, problem.New(http.StatusUnprocessableEntity, problem.CodeInvalidValue, err.Error())
+			}
+			update.SetNillableContentHash(updatedContentHash(existing, values, input.Body.UserIdentifier))
+		}
+		if input.Body.ValueNumber != nil {
+			if category := models.NPSCategory(existing.FieldType, input.Body.ValueNumber); category != nil {
+				update.SetNpsCategory(*category)
+			} else {
+				update.ClearNpsCategory()
+			}
+		}
+
+		// Translations of the old text are stale once value_text changes
+		if input.Body.ValueText != nil && (existing.ValueText == nil || *existing.ValueText != *input.Body.ValueText) {
+			update.ClearTranslations()
+		}
+
+		// When value_text actually changes, AI results for the old text are invalid.
+		// Only text fields are enriched, so the stored field type has to be checked first.
+		reprocess := false
+		skipAI := false
+		if input.Body.ValueText != nil && cfg.ReprocessOnUpdate {
+			textChanged := existing.ValueText == nil || *existing.ValueText != *input.Body.ValueText
+			reprocess = textChanged && models.FieldType(existing.FieldType).ShouldEnrich()
+			skipAI = existing.SkipAiProcessing || cfg.SkipsAIForSource(existing.SourceType, existing.SourceID)
+		}
+
+		if reprocess {
+			clearAIResults(update)
+
+			// Without AI workers (or when AI is skipped), refresh locally extracted topics for the new text
+			if (enrichmentQueue == nil || skipAI) && *input.Body.ValueText != "" {
+				update.SetTopics(enrichment.ExtractKeywords(*input.Body.ValueText, enrichment.MaxTopics))
+			} else {
+				update.ClearTopics()
+			}
+		}
+
 		exp, err := update.Save(ctx)
 		if err != nil {
 			// Use sanitized error handling
 			return nil, handleDatabaseError(logger, err, "update", id.String())
 		}
 
-		// If value_text changed, re-enqueue AI processing jobs to update enrichment/embeddings
-		if valueTextChanged && enrichmentQueue != nil && *input.Body.ValueText != "" {
-			fieldType := models.FieldType(exp.FieldType)
-			if fieldType.ShouldEnrich() {
-				fieldLabel := exp.FieldLabel
-				enqueueAIJobs(ctx, logger, enrichmentQueue, exp, fieldLabel, *input.Body.ValueText)
-				logger.Info("experience updated with AI reprocessing", "id", exp.ID)
+		// Re-enqueue AI processing jobs so enrichment and embeddings reflect the new text.
+		// Jobs still pending for the old text are dropped first.
+		if reprocess && enrichmentQueue != nil && !skipAI {
+			if err := enrichmentQueue.CancelPending(ctx, exp.ID.String()); err != nil {
+				logger.Warn("failed to cancel pending AI jobs", "experience_id", exp.ID, "error", err)
+			}
+			if *input.Body.ValueText != "" {
+				enqueueAIJobs(ctx, logger, enrichmentQueue, exp, exp.FieldLabel, *input.Body.ValueText, queue.PriorityNormal)
 			}
+			logger.Info("experience updated with AI reprocessing", "id", exp.ID)
 		} else {
 			logger.Info("experience updated", "id", exp.ID)
 		}
 
 		// Dispatch webhook asynchronously
-		dispatcher.DispatchAsync(webhook.EventExperienceUpdated, entityToOutput(exp))
+		dispatcher.DispatchAsync(ctx, webhook.EventExperienceUpdated, entityToOutput(exp))
 
 		return &ExperienceOutput{Body: entityToOutput(exp)}, nil
 	})
@@ -323,9 +536,8 @@ func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webh
 			return nil, handleDatabaseError(logger, err, "get for deletion", id.String())
 		}
 
-		// Delete the experience
-		err = client.ExperienceData.DeleteOneID(id).Exec(ctx)
-		if err != nil {
+		// Delete the experience, and record the deletion for the changes feed of /v1/sync/changes
+		if err := deleteExperience(ctx, client, id); err != nil {
 			// Use sanitized error handling
 			return nil, handleDatabaseError(logger, err, "delete", id.String())
 		}
@@ -333,10 +545,107 @@ func RegisterExperienceRoutes(api huma.API, client *ent.Client, dispatcher *webh
 		logger.Info("experience deleted", "id", id)
 
 		// Dispatch webhook asynchronously
-		dispatcher.DispatchAsync(webhook.EventExperienceDeleted, entityToOutput(exp))
+		dispatcher.DispatchAsync(ctx, webhook.EventExperienceDeleted, entityToOutput(exp))
 
 		return &struct{}{}, nil
 	})
+
+	// POST /v1/experiences/{id}/reprocess - Re-enqueue AI processing
+	huma.Register(api, huma.Operation{
+		OperationID:   "reprocess-experience",
+		Method:        "POST",
+		Path:          "/v1/experiences/{id}/reprocess",
+		Summary:       "Re-run AI processing for an experience",
+		Description:   "Enqueues new enrichment and embedding jobs for the experience's current text, by default ahead of normal and backfill jobs. Existing results are kept until the new ones are stored.",
+		Tags:          []string{"Experiences"},
+		DefaultStatus: 202,
+	}, func(ctx context.Context, input *ReprocessExperienceInput) (*ReprocessExperienceOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if enrichmentQueue == nil {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "AI processing is not enabled. Configure an enrichment or embedding provider to enable it.")
+		}
+
+		exp, err := client.ExperienceData.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+
+		if !models.FieldType(exp.FieldType).ShouldEnrich() || exp.ValueText == nil || *exp.ValueText == "" {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidFieldType, "Only experiences with a text response can be processed by AI")
+		}
+		if exp.SkipAiProcessing || cfg.SkipsAIForSource(exp.SourceType, exp.SourceID) {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeAIProcessingDisabled, "AI processing is disabled for this experience")
+		}
+
+		// Replace jobs still waiting in the queue instead of processing the text twice
+		if err := enrichmentQueue.CancelPending(ctx, exp.ID.String()); err != nil {
+			logger.Warn("failed to cancel pending AI jobs", "experience_id", exp.ID, "error", err)
+		}
+		enqueueAIJobs(ctx, logger, enrichmentQueue, exp, exp.FieldLabel, *exp.ValueText, queue.ParsePriority(input.Priority))
+
+		logger.Info("experience queued for AI reprocessing", "id", exp.ID, "priority", input.Priority)
+
+		output := &ReprocessExperienceOutput{}
+		output.Body.ID = exp.ID.String()
+		output.Body.Priority = input.Priority
+		return output, nil
+	})
+}
+
+// findDuplicate returns the earliest experience with the content hash that was collected
+// within windowHours of collectedAt (any time if windowHours is 0), or nil if there is none
+func findDuplicate(ctx context.Context, client *ent.Client, contentHash string, collectedAt time.Time, windowHours int) (*ent.ExperienceData, error) {
+	query := client.ExperienceData.Query().Where(experiencedata.ContentHashEQ(contentHash))
+	if windowHours > 0 {
+		window := time.Duration(windowHours) * time.Hour
+		query = query.Where(
+			experiencedata.CollectedAtGTE(collectedAt.Add(-window)),
+			experiencedata.CollectedAtLTE(collectedAt.Add(window)),
+		)
+	}
+	original, err := query.Order(ent.Asc(experiencedata.FieldCollectedAt)).First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return original, err
+}
+
+// updatedContentHash returns the content hash of an experience after an update of its
+// values or user identifier. The duplicate policy only applies at ingest, so duplicate_of
+// is left as it is.
+func updatedContentHash(existing *ent.ExperienceData, values models.Values, userIdentifier *string) *string {
+	key := models.ContentKey{
+		SourceType:     existing.SourceType,
+		SourceID:       existing.SourceID,
+		FieldID:        existing.FieldID,
+		UserIdentifier: existing.UserIdentifier,
+		Values: models.Values{
+			Text:    existing.ValueText,
+			Number:  existing.ValueNumber,
+			Boolean: existing.ValueBoolean,
+			Date:    existing.ValueDate,
+		},
+	}
+	if values.Text != nil {
+		key.Values.Text = values.Text
+	}
+	if values.Number != nil {
+		key.Values.Number = values.Number
+	}
+	if values.Boolean != nil {
+		key.Values.Boolean = values.Boolean
+	}
+	if values.Date != nil {
+		key.Values.Date = values.Date
+	}
+	if userIdentifier != nil {
+		key.UserIdentifier = *userIdentifier
+	}
+	return models.ContentHash(key)
 }
 
 // entityToOutput converts an Ent entity to the output format via the domain model.
@@ -350,3 +659,118 @@ func entityToOutput(exp *ent.ExperienceData) ExperienceData {
 
 	return apiData
 }
+
+// deleteExperience deletes an experience and records its deletion in one transaction
+func deleteExperience(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.ExperienceData.DeleteOneID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.ExperienceDeletion.Create().SetExperienceID(id).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// filterExperiences restricts the query to the experiences matching the filter
+func filterExperiences(query *ent.ExperienceDataQuery, f experiencefilter.Filter) (*ent.ExperienceDataQuery, error) {
+	// Apply filters (check for non-empty strings)
+	if f.SourceType != "" {
+		query = query.Where(experiencedata.SourceTypeEQ(f.SourceType))
+	}
+	if f.SourceID != "" {
+		query = query.Where(experiencedata.SourceIDEQ(f.SourceID))
+	}
+	if f.FieldType != "" {
+		query = query.Where(experiencedata.FieldTypeEQ(f.FieldType))
+	}
+	if f.QuestionID != "" {
+		questionID, err := parseUUID(f.QuestionID)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(experiencedata.QuestionIDEQ(questionID))
+	}
+	if f.UserIdentifier != "" {
+		query = query.Where(experiencedata.UserIdentifierEQ(f.UserIdentifier))
+	}
+	if f.ContentHash != "" {
+		query = query.Where(experiencedata.ContentHashEQ(f.ContentHash))
+	}
+	switch f.Duplicate {
+	case "true":
+		query = query.Where(experiencedata.DuplicateOfNotNil())
+	case "false":
+		query = query.Where(experiencedata.DuplicateOfIsNil())
+	}
+	if f.Country != "" {
+		query = query.Where(experiencedata.CountryEQ(f.Country))
+	}
+	if f.Region != "" {
+		query = query.Where(experiencedata.RegionEQ(f.Region))
+	}
+	if f.Device != "" {
+		query = query.Where(experiencedata.DeviceEQ(f.Device))
+	}
+	if f.Platform != "" {
+		query = query.Where(experiencedata.PlatformEQ(f.Platform))
+	}
+	if f.AppVersion != "" {
+		query = query.Where(experiencedata.AppVersionEQ(f.AppVersion))
+	}
+	if f.Metadata != "" {
+		// Containment is served by the GIN index on metadata, unlike comparing single keys
+		var contained map[string]any
+		if err := json.Unmarshal([]byte(f.Metadata), &contained); err != nil || contained == nil {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidValue, ErrMsgInvalidInput+"metadata must be a JSON object, e.g. {\"plan\":\"pro\"}")
+		}
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString(s.C(experiencedata.FieldMetadata)).WriteString(" @> ").Arg(f.Metadata).WriteString("::jsonb")
+			}))
+		})
+	}
+	if f.NPSCategory != "" {
+		query = query.Where(experiencedata.NpsCategoryEQ(f.NPSCategory))
+	}
+	switch f.IsSpam {
+	case "true":
+		query = query.Where(experiencedata.IsSpam(true))
+	case "false":
+		// Rows that were never enriched have no spam verdict and are kept
+		query = query.Where(experiencedata.Or(
+			experiencedata.IsSpamIsNil(),
+			experiencedata.IsSpam(false),
+		))
+	}
+	if f.MinUrgency > 0 {
+		query = query.Where(experiencedata.UrgencyScoreGTE(f.MinUrgency))
+	}
+	if f.UrgencyReason != "" {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(experiencedata.FieldUrgencyReasons, f.UrgencyReason))
+		})
+	}
+	if f.Since != "" {
+		// Parse ISO 8601 time string
+		sinceTime, err := time.Parse(time.RFC3339, f.Since)
+		if err != nil {
+			return nil, invalidTimestamp("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+		}
+		query = query.Where(experiencedata.CollectedAtGTE(sinceTime))
+	}
+	if f.Until != "" {
+		// Parse ISO 8601 time string
+		untilTime, err := time.Parse(time.RFC3339, f.Until)
+		if err != nil {
+			return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+		}
+		query = query.Where(experiencedata.CollectedAtLTE(untilTime))
+	}
+	return query, nil
+}
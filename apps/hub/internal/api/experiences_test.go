@@ -1,88 +1,43 @@
 // Package api contains integration tests for the Hub API.
 //
-// These tests use testcontainers-go to spin up real PostgreSQL 18 containers with pgvector,
-// ensuring production parity and testing Postgres-specific features like JSONB
-// columns with GIN indexes and vector embeddings.
-//
-// Requirements:
-//   - Docker must be running
-//   - First run downloads pgvector/pgvector:pg18 image (~90MB)
-//   - Each test file gets a fresh container for isolation
+// These tests use testdb to spin up real PostgreSQL 18 containers with pgvector, ensuring
+// production parity. Docker must be running; each test gets a fresh container for
+// isolation.
 //
 // Run tests: go test ./internal/api/
 package api
 
 import (
 	"context"
-	"database/sql"
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/danielgtaylor/huma/v2/humatest"
-	_ "github.com/lib/pq"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
 
 	"github.com/formbricks/hub/apps/hub/internal/config"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/testdb"
 	"github.com/formbricks/hub/apps/hub/internal/webhook"
 )
 
 // setupTestAPI creates a test API with a real Postgres container
 func setupTestAPI(t *testing.T) (humatest.TestAPI, *ent.Client, func()) {
 	t.Helper()
+	_, testAPI, client, cleanup := setupTestServer(t)
+	return testAPI, client, cleanup
+}
 
-	ctx := context.Background()
-
-	// Start Postgres container with pgvector
-	postgresContainer, err := postgres.Run(ctx,
-		"pgvector/pgvector:pg18",
-		postgres.WithDatabase("test"),
-		postgres.WithUsername("test"),
-		postgres.WithPassword("test"),
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(60*time.Second),
-		),
-	)
-	if err != nil {
-		t.Fatalf("failed to start postgres container: %v", err)
-	}
-
-	// Get connection string
-	connStr, err := postgresContainer.ConnectionString(ctx, "sslmode=disable")
-	if err != nil {
-		t.Fatalf("failed to get connection string: %v", err)
-	}
-
-	// Enable pgvector extension before creating Ent client
-	// Use standard database/sql to execute the extension creation
-	db, err := sql.Open("postgres", connStr)
-	if err != nil {
-		t.Fatalf("failed to open database connection: %v", err)
-	}
-	if _, err := db.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS vector"); err != nil {
-		_ = db.Close()
-		t.Fatalf("failed to enable pgvector extension: %v", err)
-	}
-	_ = db.Close()
-
-	// Connect with Ent
-	client, err := ent.Open("postgres", connStr)
-	if err != nil {
-		t.Fatalf("failed to connect to test database: %v", err)
-	}
+// setupTestServer is setupTestAPI for tests that also change the server's settings
+func setupTestServer(t *testing.T) (*Server, humatest.TestAPI, *ent.Client, func()) {
+	t.Helper()
 
-	// Run migrations
-	if err := client.Schema.Create(ctx); err != nil {
-		t.Fatalf("failed to create schema: %v", err)
-	}
+	client, connStr, closeDB := testdb.New(t)
 
 	// Setup logger
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
@@ -96,30 +51,34 @@ func setupTestAPI(t *testing.T) (humatest.TestAPI, *ent.Client, func()) {
 		RateLimitBurst:       999999,
 		RateLimitGlobal:      999999,
 		RateLimitGlobalBurst: 999999,
+		MetadataColumns:      "country=country,device=device",
+		CSATRange:            "1-5",
+		RatingRange:          "0-10",
+		DuplicatePolicy:      "flag",
+		DuplicateWindow:      24,
+		AdminAPIKey:          "test-admin-key",
+		QueryDatabaseURL:     connStr,
+		QueryTimeout:         1,
+		QueryMaxRows:         2,
+
+		// Sign the deliveries of the Typeform and Intercom connectors and the Segment source
+		TypeformWebhookSecret: "test-typeform-secret",
+		IntercomClientSecret:  "test-intercom-secret",
+		SegmentWriteKey:       "test-segment-key",
 	}
 
 	// Create webhook dispatcher (no webhooks in tests)
 	dispatcher := webhook.NewDispatcher([]string{}, logger)
 
 	// Create server (no enrichment queue in tests)
-	server := NewServer(cfg, client, dispatcher, nil, logger)
+	server := NewServer(cfg, client, nil, dispatcher, nil, nil, nil, logger)
 
 	// Routes are already registered via NewServer.registerRoutes()
 
 	// Create test API
 	testAPI := humatest.Wrap(t, server.api)
 
-	// Cleanup function
-	cleanup := func() {
-		if err := client.Close(); err != nil {
-			t.Logf("failed to close database connection: %v", err)
-		}
-		if err := testcontainers.TerminateContainer(postgresContainer); err != nil {
-			t.Logf("failed to terminate container: %v", err)
-		}
-	}
-
-	return testAPI, client, cleanup
+	return server, testAPI, client, closeDB
 }
 
 func TestCreateExperience(t *testing.T) {
@@ -170,6 +129,87 @@ func TestCreateExperience(t *testing.T) {
 		if !strings.Contains(resp.Body.String(), `"value_number"`) {
 			t.Fatal("expected response to contain value_number field")
 		}
+		if !strings.Contains(resp.Body.String(), `"nps_category":"promoter"`) {
+			t.Fatal("expected the score to be categorized as promoter")
+		}
+		if !strings.Contains(resp.Body.String(), `"country":"US"`) {
+			t.Fatal("expected the country to be copied from metadata")
+		}
+	})
+
+	t.Run("flag duplicate", func(t *testing.T) {
+		var ids []string
+		for _, text := range []string{"Exports keep failing", "  exports KEEP failing "} {
+			resp := api.Post("/v1/experiences", map[string]interface{}{
+				"source_type":     "survey",
+				"field_id":        "feedback",
+				"field_type":      "text",
+				"value_text":      text,
+				"user_identifier": "user-dup",
+			})
+			if resp.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+			}
+			var exp ExperienceData
+			if err := json.Unmarshal(resp.Body.Bytes(), &exp); err != nil {
+				t.Fatal(err)
+			}
+			if exp.ContentHash == nil {
+				t.Fatal("expected a content hash")
+			}
+			ids = append(ids, exp.ID.String())
+			if len(ids) == 1 && exp.DuplicateOf != nil {
+				t.Fatal("expected the first experience not to be a duplicate")
+			}
+			if len(ids) == 2 && (exp.DuplicateOf == nil || exp.DuplicateOf.String() != ids[0]) {
+				t.Fatalf("expected the second experience to duplicate %s, got %v", ids[0], exp.DuplicateOf)
+			}
+		}
+	})
+
+	t.Run("retry with idempotency key", func(t *testing.T) {
+		var ids []string
+		for range 2 {
+			resp := api.Post("/v1/experiences", "Idempotency-Key: import-42", map[string]interface{}{
+				"source_type": "survey",
+				"field_id":    "feedback",
+				"field_type":  "text",
+				"value_text":  "Sent twice",
+			})
+			if resp.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+			}
+			var exp ExperienceData
+			if err := json.Unmarshal(resp.Body.Bytes(), &exp); err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, exp.ID.String())
+		}
+		if ids[0] != ids[1] {
+			t.Fatalf("expected the retry to return experience %s, got %s", ids[0], ids[1])
+		}
+	})
+
+	t.Run("validation error - value does not fit the field type", func(t *testing.T) {
+		for _, body := range []map[string]interface{}{
+			{"field_type": "nps", "value_number": 11.0},
+			{"field_type": "nps"},
+			{"field_type": "nps", "value_text": "9"},
+			{"field_type": "csat", "value_number": 6.0},
+			{"field_type": "boolean", "value_text": "yes"},
+			{"field_type": "date", "value_boolean": true},
+		} {
+			body["source_type"] = "survey"
+			body["field_id"] = "q1"
+			resp := api.Post("/v1/experiences", body)
+
+			if resp.Code != http.StatusUnprocessableEntity {
+				t.Fatalf("expected status 422 for %v, got %d: %s", body, resp.Code, resp.Body.String())
+			}
+			if !strings.Contains(resp.Body.String(), `"code":"invalid_value"`) {
+				t.Errorf("expected an invalid_value problem, got %s", resp.Body.String())
+			}
+		}
 	})
 
 	t.Run("validation error - missing required field", func(t *testing.T) {
@@ -308,6 +348,46 @@ func TestListExperiences(t *testing.T) {
 			t.Fatal("expected response to contain total field")
 		}
 	})
+
+	t.Run("filter by metadata", func(t *testing.T) {
+		for _, metadata := range []map[string]any{
+			{"plan": "pro", "tags": []any{"beta", "eu"}},
+			{"plan": "free", "tags": []any{"beta"}},
+		} {
+			if _, err := client.ExperienceData.Create().
+				SetSourceType("metadata").
+				SetFieldID("q1").
+				SetFieldType("rating").
+				SetMetadata(metadata).
+				Save(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		tests := []struct {
+			metadata string
+			want     int
+		}{
+			{`{"plan":"pro"}`, 1},
+			{`{"tags":["beta"]}`, 2},
+			{`{"plan":"free","tags":["eu"]}`, 0},
+		}
+		for _, tt := range tests {
+			resp := api.Get("/v1/experiences?source_type=metadata&metadata=" + url.QueryEscape(tt.metadata))
+			if resp.Code != http.StatusOK {
+				t.Fatalf("expected status 200 for %s, got %d: %s", tt.metadata, resp.Code, resp.Body.String())
+			}
+			if want := fmt.Sprintf(`"total":%d`, tt.want); !strings.Contains(resp.Body.String(), want) {
+				t.Errorf("expected %s for %s, got %s", want, tt.metadata, resp.Body.String())
+			}
+		}
+
+		for _, metadata := range []string{`plan=pro`, `["pro"]`, `null`} {
+			if resp := api.Get("/v1/experiences?metadata=" + url.QueryEscape(metadata)); resp.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", metadata, resp.Code)
+			}
+		}
+	})
 }
 
 func TestUpdateExperience(t *testing.T) {
@@ -319,7 +399,7 @@ func TestUpdateExperience(t *testing.T) {
 	exp, err := client.ExperienceData.Create().
 		SetSourceType("survey").
 		SetFieldID("q1").
-		SetFieldType("text").
+		SetFieldType("rating").
 		Save(ctx)
 	if err != nil {
 		t.Fatal(err)
@@ -340,6 +420,16 @@ func TestUpdateExperience(t *testing.T) {
 		}
 	})
 
+	t.Run("update with value of another field type", func(t *testing.T) {
+		resp := api.Patch("/v1/experiences/"+exp.ID.String(), map[string]interface{}{
+			"value_text": "8.5",
+		})
+
+		if resp.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("expected status 422, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
 	t.Run("update non-existing experience", func(t *testing.T) {
 		resp := api.Patch("/v1/experiences/01932c8a-8b9e-7000-8000-000000000000", map[string]interface{}{
 			"value_number": 5.0,
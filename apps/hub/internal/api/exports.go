@@ -0,0 +1,450 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+// ExportItem represents a scheduled export in API responses
+type ExportItem struct {
+	ID              uuid.UUID  `json:"id" doc:"Export ID"`
+	Name            string     `json:"name" doc:"Name of the export"`
+	Format          string     `json:"format" doc:"File format: jsonl (gzip-compressed JSON Lines) or parquet"`
+	Provider        string     `json:"provider" doc:"Storage provider: s3 (AWS or S3-compatible) or gcs"`
+	Bucket          string     `json:"bucket" doc:"Bucket that objects are written to"`
+	Region          string     `json:"region,omitempty" doc:"Region of the bucket"`
+	Endpoint        string     `json:"endpoint,omitempty" doc:"URL of the S3-compatible service"`
+	Prefix          string     `json:"prefix" doc:"Folder of the objects, with {date}, {year}, {month}, {day}, and {hour} placeholders"`
+	AccessKeyID     string     `json:"access_key_id,omitempty" doc:"Access key the objects are written with; the secret is not included"`
+	IntervalMinutes int        `json:"interval_minutes" doc:"Minutes between runs"`
+	Enabled         bool       `json:"enabled" doc:"Whether the export runs"`
+	ExportedUntil   *time.Time `json:"exported_until,omitempty" doc:"updated_at of the last experience written; the next run writes the experiences created or changed after it"`
+	NextRunAt       time.Time  `json:"next_run_at" doc:"When the export runs next"`
+	CreatedAt       time.Time  `json:"created_at" doc:"When the export was created"`
+	UpdatedAt       time.Time  `json:"updated_at" doc:"When the export was last updated"`
+}
+
+// CreateExportInput defines the input for creating a scheduled export
+type CreateExportInput struct {
+	Body struct {
+		Name            string `json:"name" doc:"Name of the export" minLength:"1" maxLength:"255" example:"Warehouse raw feedback"`
+		Format          string `json:"format,omitempty" doc:"File format: jsonl (default) for gzip-compressed JSON Lines, or parquet" enum:"jsonl,parquet"`
+		Provider        string `json:"provider,omitempty" doc:"Storage provider: s3 (default) for AWS or an S3-compatible service such as MinIO or R2, or gcs for Google Cloud Storage with HMAC keys" enum:"s3,gcs"`
+		Bucket          string `json:"bucket" doc:"Bucket that objects are written to" minLength:"1" maxLength:"255" example:"acme-feedback"`
+		Region          string `json:"region,omitempty" doc:"Region of the bucket (default us-east-1)" maxLength:"64" example:"eu-central-1"`
+		Endpoint        string `json:"endpoint,omitempty" doc:"URL of an S3-compatible service; omit for AWS and GCS" format:"uri" maxLength:"2048"`
+		Prefix          string `json:"prefix,omitempty" doc:"Folder of the objects, with {date}, {year}, {month}, {day}, and {hour} placeholders for the UTC start of the run" maxLength:"1024" example:"hub/experiences/dt={date}"`
+		AccessKeyID     string `json:"access_key_id,omitempty" doc:"Access key (HMAC access ID for gcs); omit to use the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables of Hub" maxLength:"256"`
+		SecretAccessKey string `json:"secret_access_key,omitempty" doc:"Secret of the access key; required with access_key_id" maxLength:"256"`
+		IntervalMinutes int    `json:"interval_minutes,omitempty" doc:"Minutes between runs (default 60)" minimum:"5" maximum:"10080"`
+		Enabled         *bool  `json:"enabled,omitempty" doc:"Whether the export runs (default true)"`
+	}
+}
+
+// UpdateExportInput defines the input for updating a scheduled export
+type UpdateExportInput struct {
+	ID   string `path:"id" doc:"Export ID (UUID)" format:"uuid"`
+	Body struct {
+		Name            *string `json:"name,omitempty" doc:"Rename the export" minLength:"1" maxLength:"255"`
+		Format          *string `json:"format,omitempty" doc:"Change the file format of future runs" enum:"jsonl,parquet"`
+		Provider        *string `json:"provider,omitempty" doc:"Change the storage provider" enum:"s3,gcs"`
+		Bucket          *string `json:"bucket,omitempty" doc:"Change the bucket" minLength:"1" maxLength:"255"`
+		Region          *string `json:"region,omitempty" doc:"Change the region" maxLength:"64"`
+		Endpoint        *string `json:"endpoint,omitempty" doc:"Change the S3-compatible service; an empty string uses the provider's" maxLength:"2048"`
+		Prefix          *string `json:"prefix,omitempty" doc:"Change the folder of the objects" maxLength:"1024"`
+		AccessKeyID     *string `json:"access_key_id,omitempty" doc:"Rotate the access key; an empty string uses the environment variables of Hub" maxLength:"256"`
+		SecretAccessKey *string `json:"secret_access_key,omitempty" doc:"Rotate the secret of the access key" maxLength:"256"`
+		IntervalMinutes *int    `json:"interval_minutes,omitempty" doc:"Change the minutes between runs" minimum:"5" maximum:"10080"`
+		Enabled         *bool   `json:"enabled,omitempty" doc:"Enable or disable the export"`
+		ResetCursor     bool    `json:"reset_cursor,omitempty" doc:"Write all experiences again in the next run, e.g. after the destination was emptied"`
+	}
+}
+
+// ExportIDInput identifies a single scheduled export
+type ExportIDInput struct {
+	ID string `path:"id" doc:"Export ID (UUID)" format:"uuid"`
+}
+
+// ExportOutput represents the output for a single scheduled export
+type ExportOutput struct {
+	Body ExportItem
+}
+
+// ListExportsOutput represents the output for listing scheduled exports
+type ListExportsOutput struct {
+	Body struct {
+		Data []ExportItem `json:"data" doc:"Exports, oldest first"`
+	}
+}
+
+// ExportRunItem represents a run of a scheduled export in API responses
+type ExportRunItem struct {
+	ID          uuid.UUID  `json:"id" doc:"Run ID"`
+	Status      string     `json:"status" doc:"Run status: running, succeeded, failed"`
+	Experiences int        `json:"experiences" doc:"Number of experiences written"`
+	Bytes       int64      `json:"bytes" doc:"Size of the objects written"`
+	Objects     []string   `json:"objects" doc:"Keys of the objects written"`
+	Error       *string    `json:"error,omitempty" doc:"Why the run failed; the experiences that weren't written are retried in the next run"`
+	StartedAt   time.Time  `json:"started_at" doc:"When the run started"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty" doc:"When the run finished"`
+}
+
+// ListExportRunsInput defines the input for listing an export's runs
+type ListExportRunsInput struct {
+	ID     string `path:"id" doc:"Export ID (UUID)" format:"uuid"`
+	Status string `query:"status" doc:"Filter by run status" enum:"running,succeeded,failed"`
+	Limit  int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+}
+
+// ListExportRunsOutput represents the output for listing an export's runs
+type ListExportRunsOutput struct {
+	Body struct {
+		Data   []ExportRunItem `json:"data" doc:"Runs, newest first"`
+		Total  int             `json:"total" doc:"Total count of runs matching the filter"`
+		Limit  int             `json:"limit" doc:"Limit used in query"`
+		Offset int             `json:"offset" doc:"Offset used in query"`
+	}
+}
+
+// exportToItem converts an Ent entity to the API response type. The secret is omitted.
+func exportToItem(e *ent.Export) ExportItem {
+	return ExportItem{
+		ID:              e.ID,
+		Name:            e.Name,
+		Format:          e.Format,
+		Provider:        e.Provider,
+		Bucket:          e.Bucket,
+		Region:          e.Region,
+		Endpoint:        e.Endpoint,
+		Prefix:          e.Prefix,
+		AccessKeyID:     e.AccessKeyID,
+		IntervalMinutes: e.IntervalMinutes,
+		Enabled:         e.Enabled,
+		ExportedUntil:   e.CursorAt,
+		NextRunAt:       e.NextRunAt,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+// exportRunToItem converts an Ent entity to the API response type
+func exportRunToItem(run *ent.ExportRun) ExportRunItem {
+	objects := run.Objects
+	if objects == nil {
+		objects = []string{}
+	}
+	return ExportRunItem{
+		ID:          run.ID,
+		Status:      run.Status,
+		Experiences: run.Experiences,
+		Bytes:       run.Bytes,
+		Objects:     objects,
+		Error:       run.Error,
+		StartedAt:   run.CreatedAt,
+		FinishedAt:  run.FinishedAt,
+	}
+}
+
+// validateExportDestination rejects storage settings that objects can't be written with
+func validateExportDestination(provider, bucket, endpoint, prefix, accessKeyID, secretAccessKey string) error {
+	if err := sink.ValidateBucket(provider, bucket, endpoint); err != nil {
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidDestination, ErrMsgInvalidInput+err.Error())
+	}
+	if err := sink.ValidatePrefix(prefix); err != nil {
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidDestination, ErrMsgInvalidInput+err.Error())
+	}
+	switch {
+	case (accessKeyID == "") != (secretAccessKey == ""):
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidDestination, ErrMsgInvalidInput+"access_key_id and secret_access_key must be set together")
+	case provider == sink.ProviderGCS && accessKeyID == "":
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidDestination, ErrMsgInvalidInput+"gcs exports require an HMAC key in access_key_id and secret_access_key")
+	}
+	return nil
+}
+
+// RegisterExportRoutes registers routes for managing scheduled exports. The exports run in
+// the background on one Hub instance at a time.
+func RegisterExportRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-export",
+		Method:      "POST",
+		Path:        "/v1/exports",
+		Summary:     "Create a scheduled export",
+		Description: "Schedules an export of experiences to an S3-compatible or Google Cloud Storage bucket. Every interval, the experiences created or changed since the previous run are written as gzip-compressed JSON Lines or Parquet objects to the folder of the prefix, so the bucket holds an incremental snapshot for warehouses and data lakes. The first run, within a minute, writes all experiences. The secret access key is never returned.",
+		Tags:        []string{"Exports"},
+	}, func(ctx context.Context, input *CreateExportInput) (*ExportOutput, error) {
+		body := input.Body
+		if body.Format == "" {
+			body.Format = sink.FormatJSONL
+		}
+		if body.Provider == "" {
+			body.Provider = sink.ProviderS3
+		}
+		if err := validateExportDestination(body.Provider, body.Bucket, body.Endpoint, body.Prefix, body.AccessKeyID, body.SecretAccessKey); err != nil {
+			return nil, err
+		}
+
+		create := client.Export.Create().
+			SetName(body.Name).
+			SetFormat(body.Format).
+			SetProvider(body.Provider).
+			SetBucket(body.Bucket).
+			SetRegion(body.Region).
+			SetEndpoint(body.Endpoint).
+			SetPrefix(body.Prefix).
+			SetAccessKeyID(body.AccessKeyID).
+			SetSecretAccessKey(body.SecretAccessKey)
+		if body.IntervalMinutes > 0 {
+			create.SetIntervalMinutes(body.IntervalMinutes)
+		}
+		if body.Enabled != nil {
+			create.SetEnabled(*body.Enabled)
+		}
+
+		e, err := create.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "create", "export")
+		}
+
+		logger.Info("export created", "id", e.ID, "name", e.Name, "provider", e.Provider, "bucket", e.Bucket)
+		return &ExportOutput{Body: exportToItem(e)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-exports",
+		Method:      "GET",
+		Path:        "/v1/exports",
+		Summary:     "List scheduled exports",
+		Description: "Lists all scheduled exports. Secret access keys are not included.",
+		Tags:        []string{"Exports"},
+	}, func(ctx context.Context, input *struct{}) (*ListExportsOutput, error) {
+		exports, err := client.Export.Query().
+			Order(ent.Asc(export.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "exports")
+		}
+
+		output := &ListExportsOutput{}
+		output.Body.Data = make([]ExportItem, len(exports))
+		for i, e := range exports {
+			output.Body.Data[i] = exportToItem(e)
+		}
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-export",
+		Method:      "GET",
+		Path:        "/v1/exports/{id}",
+		Summary:     "Get a scheduled export",
+		Description: "Retrieves a single scheduled export. The secret access key is not included.",
+		Tags:        []string{"Exports"},
+	}, func(ctx context.Context, input *ExportIDInput) (*ExportOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := client.Export.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		return &ExportOutput{Body: exportToItem(e)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-export",
+		Method:      "PATCH",
+		Path:        "/v1/exports/{id}",
+		Summary:     "Update a scheduled export",
+		Description: "Updates the settings of a scheduled export. Only provided fields are changed; changes apply from the next run. With reset_cursor, the next run writes all experiences again.",
+		Tags:        []string{"Exports"},
+	}, func(ctx context.Context, input *UpdateExportInput) (*ExportOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		current, err := client.Export.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		body := input.Body
+		provider, bucket, endpoint, prefix := current.Provider, current.Bucket, current.Endpoint, current.Prefix
+		accessKeyID, secretAccessKey := current.AccessKeyID, current.SecretAccessKey
+		if body.Provider != nil {
+			provider = *body.Provider
+		}
+		if body.Bucket != nil {
+			bucket = *body.Bucket
+		}
+		if body.Endpoint != nil {
+			endpoint = *body.Endpoint
+		}
+		if body.Prefix != nil {
+			prefix = *body.Prefix
+		}
+		if body.AccessKeyID != nil {
+			accessKeyID = *body.AccessKeyID
+		}
+		if body.SecretAccessKey != nil {
+			secretAccessKey = *body.SecretAccessKey
+		}
+		if body.AccessKeyID != nil && *body.AccessKeyID == "" && body.SecretAccessKey == nil {
+			// Removing the access key removes its secret
+			secretAccessKey = ""
+		}
+		if err := validateExportDestination(provider, bucket, endpoint, prefix, accessKeyID, secretAccessKey); err != nil {
+			return nil, err
+		}
+
+		update := client.Export.UpdateOneID(id).
+			SetProvider(provider).
+			SetBucket(bucket).
+			SetEndpoint(endpoint).
+			SetPrefix(prefix).
+			SetAccessKeyID(accessKeyID).
+			SetSecretAccessKey(secretAccessKey)
+		if body.Name != nil {
+			update.SetName(*body.Name)
+		}
+		if body.Format != nil {
+			update.SetFormat(*body.Format)
+		}
+		if body.Region != nil {
+			update.SetRegion(*body.Region)
+		}
+		if body.IntervalMinutes != nil {
+			update.SetIntervalMinutes(*body.IntervalMinutes)
+		}
+		if body.Enabled != nil {
+			update.SetEnabled(*body.Enabled)
+		}
+		if body.ResetCursor {
+			update.ClearCursorAt().ClearCursorID()
+		}
+
+		e, err := update.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "update", id.String())
+		}
+
+		logger.Info("export updated", "id", id, "reset_cursor", body.ResetCursor)
+		return &ExportOutput{Body: exportToItem(e)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-export",
+		Method:      "DELETE",
+		Path:        "/v1/exports/{id}",
+		Summary:     "Delete a scheduled export",
+		Description: "Deletes a scheduled export and its run history. Objects already written stay in the bucket.",
+		Tags:        []string{"Exports"},
+	}, func(ctx context.Context, input *ExportIDInput) (*struct{}, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := client.Export.DeleteOneID(id).Exec(ctx); err != nil {
+			return nil, handleDatabaseError(logger, err, "delete", id.String())
+		}
+
+		logger.Info("export deleted", "id", id)
+		return &struct{}{}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "run-export",
+		Method:        "POST",
+		Path:          "/v1/exports/{id}/run",
+		Summary:       "Run a scheduled export now",
+		Description:   "Schedules the next run of an enabled export for now; it starts within a minute and shows up in the run history. The following runs keep the interval from then on.",
+		Tags:          []string{"Exports"},
+		DefaultStatus: 202,
+	}, func(ctx context.Context, input *ExportIDInput) (*ExportOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		current, err := client.Export.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		if !current.Enabled {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Export is disabled. Enable it before running it.")
+		}
+
+		e, err := client.Export.UpdateOne(current).
+			SetNextRunAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "update", id.String())
+		}
+
+		logger.Info("export run requested", "id", id)
+		return &ExportOutput{Body: exportToItem(e)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-export-runs",
+		Method:      "GET",
+		Path:        "/v1/exports/{id}/runs",
+		Summary:     "List export runs",
+		Description: "Lists the runs of a scheduled export with the experiences and objects they wrote, newest first.",
+		Tags:        []string{"Exports"},
+	}, func(ctx context.Context, input *ListExportRunsInput) (*ListExportRunsOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := client.Export.Get(ctx, id); err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+
+		query := client.ExportRun.Query().
+			Where(exportrun.ExportID(id))
+		if input.Status != "" {
+			query.Where(exportrun.Status(input.Status))
+		}
+
+		total, err := query.Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "export runs")
+		}
+
+		runs, err := query.
+			Limit(input.Limit).
+			Offset(input.Offset).
+			Order(ent.Desc(exportrun.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "export runs")
+		}
+
+		output := &ListExportRunsOutput{}
+		output.Body.Data = make([]ExportRunItem, len(runs))
+		for i, run := range runs {
+			output.Body.Data[i] = exportRunToItem(run)
+		}
+		output.Body.Total = total
+		output.Body.Limit = input.Limit
+		output.Body.Offset = input.Offset
+		return output, nil
+	})
+}
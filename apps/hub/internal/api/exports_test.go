@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExports(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	resp := api.Post("/v1/exports", map[string]interface{}{
+		"name":              "Warehouse",
+		"format":            "parquet",
+		"bucket":            "acme-feedback",
+		"endpoint":          "http://minio:9000",
+		"prefix":            "hub/dt={date}",
+		"access_key_id":     "AKID",
+		"secret_access_key": "secret",
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	if strings.Contains(resp.Body.String(), "secret_access_key") {
+		t.Error("expected the secret access key to be omitted")
+	}
+	var export ExportItem
+	if err := json.Unmarshal(resp.Body.Bytes(), &export); err != nil {
+		t.Fatal(err)
+	}
+	if export.Provider != "s3" || export.Format != "parquet" || export.IntervalMinutes != 60 || !export.Enabled {
+		t.Errorf("unexpected export: %+v", export)
+	}
+
+	t.Run("invalid destination", func(t *testing.T) {
+		for _, body := range []map[string]interface{}{
+			{"name": "Weekly", "bucket": "acme-feedback", "prefix": "hub/{week}"},
+			{"name": "Weekly", "bucket": "acme-feedback", "access_key_id": "AKID"},
+			{"name": "Weekly", "bucket": "acme-feedback", "provider": "gcs"},
+		} {
+			resp := api.Post("/v1/exports", body)
+			if resp.Code != http.StatusBadRequest || !strings.Contains(resp.Body.String(), "invalid_destination") {
+				t.Errorf("expected invalid_destination for %v, got %d: %s", body, resp.Code, resp.Body.String())
+			}
+		}
+	})
+
+	t.Run("run now", func(t *testing.T) {
+		resp := api.Patch("/v1/exports/"+export.ID.String(), map[string]interface{}{"interval_minutes": 1440, "reset_cursor": true})
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"interval_minutes":1440`) {
+			t.Fatalf("expected the interval to be updated, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if resp := api.Post("/v1/exports/" + export.ID.String() + "/run"); resp.Code != http.StatusAccepted {
+			t.Errorf("expected status 202, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("runs", func(t *testing.T) {
+		_, err := client.ExportRun.Create().
+			SetExportID(export.ID).
+			SetStatus("succeeded").
+			SetExperiences(2).
+			SetObjects([]string{"hub/dt=2024-01-05/experiences-20240105T093000Z-0001.parquet"}).
+			Save(context.Background())
+		if err != nil {
+			t.Fatalf("failed to create test run: %v", err)
+		}
+		resp := api.Get("/v1/exports/" + export.ID.String() + "/runs")
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"total":1`) || !strings.Contains(resp.Body.String(), "-0001.parquet") {
+			t.Errorf("expected the run, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if resp := api.Delete("/v1/exports/" + export.ID.String()); resp.Code != http.StatusNoContent {
+			t.Fatalf("expected status 204, got %d", resp.Code)
+		}
+		resp := api.Get("/v1/exports/" + export.ID.String() + "/runs")
+		if resp.Code != http.StatusNotFound || !strings.Contains(resp.Body.String(), "export_not_found") {
+			t.Errorf("expected export_not_found, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+}
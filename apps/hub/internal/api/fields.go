@@ -0,0 +1,237 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// GetFieldStatsInput defines the input for the statistics of a field
+type GetFieldStatsInput struct {
+	FieldID         string `path:"field_id" doc:"Field ID"`
+	SourceType      string `query:"source_type" doc:"Filter by source type"`
+	SourceID        string `query:"source_id" doc:"Filter by source ID"`
+	FieldType       string `query:"field_type" doc:"Field type to report, if the field ID is used with several"`
+	Since           string `query:"since" doc:"Filter by collected_at >= since (ISO 8601 format)" example:"2024-01-01T00:00:00Z"`
+	Until           string `query:"until" doc:"Filter by collected_at <= until (ISO 8601 format)" example:"2024-12-31T23:59:59Z"`
+	Interval        string `query:"interval" default:"day" enum:"hour,day,week,month" doc:"Size of the response count buckets (UTC; weeks start on Monday)"`
+	Categories      int    `query:"categories" default:"20" minimum:"1" maximum:"100" doc:"Number of most frequent values to count for categorical and text fields"`
+	SegmentID       string `query:"segment_id" doc:"Only count the experiences of this saved segment (see /v1/segments)" format:"uuid"`
+	IncludeExcluded bool   `query:"include_excluded" doc:"Also count the responses flagged as spam or as duplicates (see SERVICE_DUPLICATE_POLICY), which are excluded by default"`
+}
+
+// FieldResponses is the number of responses collected in one period
+type FieldResponses struct {
+	Period string `json:"period" doc:"UTC start of the period: the day (YYYY-MM-DD), or an RFC 3339 timestamp for hours" example:"2024-01-15"`
+	Count  int    `json:"count" doc:"Number of responses"`
+}
+
+// ValueCount is the number of responses with one value
+type ValueCount struct {
+	Value      string  `json:"value" doc:"Response value; true or false for boolean fields"`
+	Count      int     `json:"count" doc:"Number of responses"`
+	Percentage float64 `json:"percentage" doc:"Share of all responses to the field, rounded to one decimal"`
+}
+
+// GetFieldStatsOutput defines the output for the statistics of a field
+type GetFieldStatsOutput struct {
+	Body struct {
+		FieldID         string              `json:"field_id" doc:"Field ID"`
+		FieldType       string              `json:"field_type" doc:"Field type"`
+		Responses       int                 `json:"responses" doc:"Number of responses"`
+		FirstResponseAt time.Time           `json:"first_response_at" doc:"When the first response was collected"`
+		LastResponseAt  time.Time           `json:"last_response_at" doc:"When the last response was collected"`
+		Interval        string              `json:"interval" doc:"Size of the response count buckets"`
+		Series          []FieldResponses    `json:"series" doc:"Responses per period (oldest first); periods without responses are omitted"`
+		Scores          *ScoreStats         `json:"scores,omitempty" doc:"Distribution of the scores of nps, csat, rating, and number fields"`
+		Values          []ValueCount        `json:"values,omitempty" doc:"Most frequent values of categorical, text, and boolean fields (most frequent first)"`
+		Sentiment       *SentimentBreakdown `json:"sentiment,omitempty" doc:"Sentiment of the enriched responses of text fields"`
+		Excluded        *Exclusions         `json:"excluded,omitempty" doc:"Responses flagged as spam or as duplicates that weren't counted; omitted with include_excluded"`
+	}
+}
+
+// RegisterFieldRoutes registers the routes that report on the responses to a field
+func RegisterFieldRoutes(api huma.API, cfg *config.Config, reader *ent.Client, logger *slog.Logger) {
+	topBoxDefaults := defaultTopBoxes(cfg)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-field-stats",
+		Method:      "GET",
+		Path:        "/v1/fields/{field_id}/stats",
+		Summary:     "Get field statistics",
+		Description: "Reports the responses to a field: their number over time, the distribution of scores or the most frequent values, depending on the field type, and the sentiment of text responses. Field IDs are only unique within a source, so narrow the report with source_type and source_id.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetFieldStatsInput) (*GetFieldStatsOutput, error) {
+		filter := AnalyticsFilter{
+			SourceType:      input.SourceType,
+			SourceID:        input.SourceID,
+			FieldID:         input.FieldID,
+			Since:           input.Since,
+			Until:           input.Until,
+			SegmentID:       input.SegmentID,
+			IncludeExcluded: input.IncludeExcluded,
+		}
+		if err := filter.resolveSegment(ctx, reader, logger); err != nil {
+			return nil, err
+		}
+		base := reader.ExperienceData.Query()
+		if input.FieldType != "" {
+			base = base.Where(experiencedata.FieldTypeEQ(input.FieldType))
+		}
+		query, err := filter.filter(base.Clone())
+		if err != nil {
+			return nil, err
+		}
+
+		var fieldTypes []struct {
+			FieldType string    `json:"field_type"`
+			Count     int       `json:"count"`
+			First     time.Time `json:"first"`
+			Last      time.Time `json:"last"`
+		}
+		err = query.Clone().
+			GroupBy(experiencedata.FieldFieldType).
+			Aggregate(
+				ent.Count(),
+				ent.As(ent.Min(experiencedata.FieldCollectedAt), "first"),
+				ent.As(ent.Max(experiencedata.FieldCollectedAt), "last"),
+			).
+			Scan(ctx, &fieldTypes)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "field types")
+		}
+		switch {
+		case len(fieldTypes) == 0:
+			return nil, problem.New(http.StatusNotFound, problem.CodeNotFound, fmt.Sprintf("No responses to field %s", input.FieldID))
+		case len(fieldTypes) > 1:
+			return nil, problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+				fmt.Sprintf("Field %s has responses of several field types. Narrow it with source_type and source_id, or set field_type.", input.FieldID))
+		}
+		fieldType := models.FieldType(fieldTypes[0].FieldType)
+
+		output := &GetFieldStatsOutput{}
+		output.Body.FieldID = input.FieldID
+		output.Body.FieldType = string(fieldType)
+		output.Body.Responses = fieldTypes[0].Count
+		output.Body.FirstResponseAt = fieldTypes[0].First
+		output.Body.LastResponseAt = fieldTypes[0].Last
+		output.Body.Interval = cmp.Or(input.Interval, "day")
+
+		err = query.Clone().
+			Aggregate(groupByPeriod(output.Body.Interval, "period"), ent.As(ent.Count(), "count")).
+			Scan(ctx, &output.Body.Series)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "field responses")
+		}
+
+		switch fieldType {
+		case models.FieldTypeNPS, models.FieldTypeCSAT, models.FieldTypeRating, models.FieldTypeNumber:
+			var scores []scoreCount
+			err = query.Clone().
+				Where(experiencedata.ValueNumberNotNil()).
+				Order(ent.Asc(experiencedata.FieldValueNumber)).
+				GroupBy(experiencedata.FieldValueNumber).
+				Aggregate(ent.Count()).
+				Scan(ctx, &scores)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "field scores")
+			}
+			var topBoxMin *float64
+			if value, ok := topBoxDefaults[string(fieldType)]; ok {
+				topBoxMin = &value
+			}
+			stats := scoreStats(scores, topBoxMin)
+			var percentiles []Percentiles
+			err = query.Clone().
+				Where(experiencedata.ValueNumberNotNil()).
+				Aggregate(percentileAggregates()...).
+				Scan(ctx, &percentiles)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "field percentiles")
+			}
+			if stats.Responses > 0 && len(percentiles) > 0 {
+				stats.Percentiles = &percentiles[0]
+			}
+			output.Body.Scores = &stats
+
+		case models.FieldTypeCategorical, models.FieldTypeText:
+			output.Body.Values, err = valueCounts(ctx, query.Clone(), experiencedata.FieldValueText, input.Categories, output.Body.Responses)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "field values")
+			}
+
+		case models.FieldTypeBoolean:
+			// Booleans are cast to text as true and false
+			output.Body.Values, err = valueCounts(ctx, query.Clone(), experiencedata.FieldValueBoolean, 2, output.Body.Responses)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "field values")
+			}
+		}
+
+		if fieldType == models.FieldTypeText {
+			var rows []sentimentRow
+			err = query.Clone().
+				Where(experiencedata.SentimentNotNil()).
+				Aggregate(sentimentAggregates()...).
+				Scan(ctx, &rows)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "aggregate", "field sentiment")
+			}
+			if len(rows) > 0 && rows[0].Responses > 0 {
+				sentiment := rows[0].breakdown()
+				output.Body.Sentiment = &sentiment
+			}
+		}
+
+		if output.Body.Excluded, err = countExcluded(ctx, filter, base, logger); err != nil {
+			return nil, err
+		}
+		return output, nil
+	})
+}
+
+// valueCounts counts the responses per value of the column, most frequent first, and
+// their share of all responses
+func valueCounts(ctx context.Context, query *ent.ExperienceDataQuery, column string, limit, responses int) ([]ValueCount, error) {
+	var rows []struct {
+		Value string `json:"value"`
+		Count int    `json:"count"`
+	}
+	err := query.
+		Aggregate(
+			func(s *sql.Selector) string {
+				s.Where(sql.NotNull(s.C(column))).
+					GroupBy(s.C(column)).
+					OrderBy("count(*) DESC", s.C(column)).
+					Limit(limit)
+				return sql.As(s.C(column)+"::text", "value")
+			},
+			ent.As(ent.Count(), "count"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]ValueCount, len(rows))
+	for i, row := range rows {
+		values[i] = ValueCount{
+			Value:      row.Value,
+			Count:      row.Count,
+			Percentage: percentage(row.Count, responses),
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestFieldStats(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for _, plan := range []string{"Pro", "Pro", "Free"} {
+		_, err := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetSourceID("onboarding").
+			SetFieldID("plan").
+			SetFieldType("categorical").
+			SetValueText(plan).
+			SetCollectedAt(time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+	for _, score := range []float64{3, 5} {
+		_, err := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetSourceID("onboarding").
+			SetFieldID("satisfaction").
+			SetFieldType("csat").
+			SetValueNumber(score).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	t.Run("categorical values", func(t *testing.T) {
+		resp := api.Get("/v1/fields/plan/stats?source_id=onboarding")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var stats GetFieldStatsOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &stats.Body); err != nil {
+			t.Fatal(err)
+		}
+		if stats.Body.FieldType != "categorical" || stats.Body.Responses != 3 || len(stats.Body.Series) != 1 || stats.Body.Series[0].Count != 3 {
+			t.Errorf("unexpected stats: %+v", stats.Body)
+		}
+		if len(stats.Body.Values) != 2 || stats.Body.Values[0] != (ValueCount{Value: "Pro", Count: 2, Percentage: 66.7}) {
+			t.Errorf("unexpected values: %+v", stats.Body.Values)
+		}
+	})
+
+	t.Run("score distribution", func(t *testing.T) {
+		resp := api.Get("/v1/fields/satisfaction/stats")
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var stats GetFieldStatsOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &stats.Body); err != nil {
+			t.Fatal(err)
+		}
+		if stats.Body.Scores == nil || stats.Body.Scores.Average != 4 || len(stats.Body.Scores.Histogram) != 2 {
+			t.Errorf("unexpected scores: %+v", stats.Body.Scores)
+		}
+		if p := stats.Body.Scores.Percentiles; p == nil || *p != (Percentiles{P50: 4, P75: 4.5, P90: 4.8, P95: 4.9}) {
+			t.Errorf("unexpected percentiles: %+v", p)
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		resp := api.Get("/v1/fields/unknown/stats")
+		if resp.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", resp.Code)
+		}
+	})
+}
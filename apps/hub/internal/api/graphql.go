@@ -0,0 +1,919 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+	custommiddleware "github.com/formbricks/hub/apps/hub/internal/middleware"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+const (
+	// defaultPageSize is the number of nodes of a connection without first or last
+	defaultPageSize = 100
+	// maxPageSize is the most nodes of a connection, like the limit of the REST listings
+	maxPageSize = 1000
+	// idColumn is the primary key of all tables, which orders the rows of the same time
+	idColumn = "id"
+
+	// maxGraphQLRequestSize is the largest GraphQL request, in the body or the query string;
+	// queries are a few KB, so anything larger is abuse rather than a real client
+	maxGraphQLRequestSize = 64 << 10
+	// maxQueryDepth is the deepest nesting of fields, which leaves room for the introspection
+	// query of GraphiQL and codegen tools
+	maxQueryDepth = 15
+	// maxQueryFields is the most fields a query selects, with fragments expanded where they
+	// are spread, so a few fragments can't select millions of fields
+	maxQueryFields = 1000
+	// maxQueryAliases is the most aliased fields of a query. Each alias of experiences or
+	// experienceAggregate runs its own database query.
+	maxQueryAliases = 20
+)
+
+// graphQLRequest is the body of a GraphQL request sent by POST
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// graphQLError is a problem returned to GraphQL clients, with its code in the extensions
+type graphQLError struct {
+	p *problem.Error
+}
+
+func (e graphQLError) Error() string { return e.p.Detail }
+
+// Extensions returns the code of the problem, so clients branch on it like on REST errors
+func (e graphQLError) Extensions() map[string]any {
+	return map[string]any{"code": string(e.p.Code)}
+}
+
+// toGraphQLError returns a resolver error with the code of its problem
+func toGraphQLError(err error) error {
+	var p *problem.Error
+	if errors.As(err, &p) {
+		return graphQLError{p}
+	}
+	return err
+}
+
+// RegisterGraphQLRoutes registers the read-only GraphQL API at /v1/graphql, protected by the
+// API key like the REST API. It's served outside of Huma, as GraphQL has its own schema and
+// error format, and reads from the replica if configured.
+func RegisterGraphQLRoutes(router chi.Router, cfg *config.Config, reader *ent.Client, logger *slog.Logger) {
+	schema, err := newGraphQLSchema(reader, logger)
+	if err != nil {
+		// The schema is static, so this only happens if it was changed incorrectly
+		logger.Error("GraphQL API disabled", "error", err)
+		return
+	}
+	router.Group(func(r chi.Router) {
+		if cfg.APIKey != "" {
+			r.Use(custommiddleware.RequireAPIKey(cfg.APIKey))
+		}
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			serveGraphQL(w, r, schema)
+		}
+		r.Get("/v1/graphql", handler)
+		r.Post("/v1/graphql", handler)
+	})
+}
+
+// serveGraphQL executes a query sent as a JSON body, or as query parameters with GET
+func serveGraphQL(w http.ResponseWriter, r *http.Request, schema graphql.Schema) {
+	var req graphQLRequest
+	if r.Method == http.MethodGet {
+		if len(r.URL.RawQuery) > maxGraphQLRequestSize {
+			problem.Write(w, http.StatusRequestEntityTooLarge, problem.CodeRequestTooLarge, "Query string too large")
+			return
+		}
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"variables must be a JSON object")
+				return
+			}
+		}
+	} else if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLRequestSize)).Decode(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			problem.Write(w, http.StatusRequestEntityTooLarge, problem.CodeRequestTooLarge, "Request body too large")
+			return
+		}
+		problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"expected a JSON body with a query")
+		return
+	}
+	if req.Query == "" {
+		problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"query is required")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(executeGraphQL(r.Context(), schema, req))
+}
+
+// executeGraphQL runs a query like graphql.Do, but rejects queries beyond the limits on
+// depth, fields, and aliases after validating them and before resolving any field
+func executeGraphQL(ctx context.Context, schema graphql.Schema, req graphQLRequest) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{
+		Body: []byte(req.Query),
+		Name: "GraphQL request",
+	})})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if validation := graphql.ValidateDocument(&schema, doc, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+	if err := checkQueryLimits(doc); err != nil {
+		// Wrapped, as only the original error of a GraphQL error carries its extensions
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(&gqlerrors.Error{Message: err.Error(), OriginalError: toGraphQLError(err)})}
+	}
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+}
+
+// checkQueryLimits checks the depth, fields, and aliases of the operations of a validated
+// query, whose fragments therefore exist and don't spread themselves
+func checkQueryLimits(doc *ast.Document) error {
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	var depth, fields, aliases int
+	var walk func(set *ast.SelectionSet, level int)
+	walk = func(set *ast.SelectionSet, level int) {
+		// Stop at the first limit exceeded, as fragments may expand to far more fields
+		if set == nil || depth > maxQueryDepth || fields > maxQueryFields {
+			return
+		}
+		for _, selection := range set.Selections {
+			switch s := selection.(type) {
+			case *ast.Field:
+				depth = max(depth, level)
+				fields++
+				if s.Alias != nil && s.Alias.Value != s.Name.Value {
+					aliases++
+				}
+				walk(s.SelectionSet, level+1)
+			case *ast.InlineFragment:
+				walk(s.SelectionSet, level)
+			case *ast.FragmentSpread:
+				if fragment := fragments[s.Name.Value]; fragment != nil {
+					walk(fragment.SelectionSet, level)
+				}
+			}
+		}
+	}
+	for _, def := range doc.Definitions {
+		if operation, ok := def.(*ast.OperationDefinition); ok {
+			walk(operation.SelectionSet, 1)
+		}
+	}
+
+	switch {
+	case depth > maxQueryDepth:
+		return problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+			fmt.Sprintf("%squery nests fields more than %d levels deep", ErrMsgInvalidInput, maxQueryDepth))
+	case fields > maxQueryFields:
+		return problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+			fmt.Sprintf("%squery selects more than %d fields, counting fragments where they are spread", ErrMsgInvalidInput, maxQueryFields))
+	case aliases > maxQueryAliases:
+		return problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+			fmt.Sprintf("%squery has more than %d aliases", ErrMsgInvalidInput, maxQueryAliases))
+	}
+	return nil
+}
+
+// experienceNode is an experience as resolved by GraphQL: its REST representation, and its
+// question if the query selected it
+type experienceNode struct {
+	ExperienceData
+	question *ent.Question
+}
+
+// Resolve resolves the fields of the REST representation by name, and the question edge
+func (n *experienceNode) Resolve(p graphql.ResolveParams) (any, error) {
+	if p.Info.FieldName == "question" {
+		if n.question == nil {
+			return nil, nil
+		}
+		return questionToItem(n.question), nil
+	}
+	p.Source = n.ExperienceData
+	return graphql.DefaultResolveFn(p)
+}
+
+// toExperienceNode converts an Ent entity to a GraphQL experience
+func toExperienceNode(exp *ent.ExperienceData) *experienceNode {
+	return &experienceNode{ExperienceData: entityToOutput(exp), question: exp.Edges.Question}
+}
+
+// experienceWhere is the ExperienceWhereInput of a query, the filters of GET /v1/experiences
+type experienceWhere struct {
+	SourceType     string     `json:"sourceType,omitempty"`
+	SourceID       string     `json:"sourceId,omitempty"`
+	FieldType      string     `json:"fieldType,omitempty"`
+	QuestionID     string     `json:"questionId,omitempty"`
+	UserIdentifier string     `json:"userIdentifier,omitempty"`
+	ContentHash    string     `json:"contentHash,omitempty"`
+	Duplicate      *bool      `json:"duplicate,omitempty"`
+	Country        string     `json:"country,omitempty"`
+	Region         string     `json:"region,omitempty"`
+	Device         string     `json:"device,omitempty"`
+	Platform       string     `json:"platform,omitempty"`
+	AppVersion     string     `json:"appVersion,omitempty"`
+	NPSCategory    string     `json:"npsCategory,omitempty"`
+	IsSpam         *bool      `json:"isSpam,omitempty"`
+	MinUrgency     float64    `json:"minUrgency,omitempty"`
+	UrgencyReason  string     `json:"urgencyReason,omitempty"`
+	Since          *time.Time `json:"since,omitempty"`
+	Until          *time.Time `json:"until,omitempty"`
+	SegmentID      string     `json:"segmentId,omitempty"`
+}
+
+// filter returns the filter of GET /v1/experiences with the same conditions
+func (w experienceWhere) filter() experiencefilter.Filter {
+	f := experiencefilter.Filter{
+		SourceType:     w.SourceType,
+		SourceID:       w.SourceID,
+		FieldType:      w.FieldType,
+		QuestionID:     w.QuestionID,
+		UserIdentifier: w.UserIdentifier,
+		ContentHash:    w.ContentHash,
+		Country:        w.Country,
+		Region:         w.Region,
+		Device:         w.Device,
+		Platform:       w.Platform,
+		AppVersion:     w.AppVersion,
+		NPSCategory:    w.NPSCategory,
+		MinUrgency:     w.MinUrgency,
+		UrgencyReason:  w.UrgencyReason,
+	}
+	if w.Duplicate != nil {
+		f.Duplicate = fmt.Sprint(*w.Duplicate)
+	}
+	if w.IsSpam != nil {
+		f.IsSpam = fmt.Sprint(*w.IsSpam)
+	}
+	if w.Since != nil {
+		f.Since = w.Since.Format(time.RFC3339Nano)
+	}
+	if w.Until != nil {
+		f.Until = w.Until.Format(time.RFC3339Nano)
+	}
+	return f
+}
+
+// filterGraphQLExperiences restricts the query to the experiences matching the where argument
+// of a field, including the filter of its segment
+func filterGraphQLExperiences(ctx context.Context, reader *ent.Client, query *ent.ExperienceDataQuery, args map[string]any, logger *slog.Logger) (*ent.ExperienceDataQuery, error) {
+	var where experienceWhere
+	if err := decodeArg(args, "where", &where); err != nil {
+		return nil, err
+	}
+	query, err := filterExperiences(query, where.filter())
+	if err != nil || where.SegmentID == "" {
+		return query, err
+	}
+	segmentFilter, err := loadSegment(ctx, reader, where.SegmentID, logger)
+	if err != nil {
+		return nil, err
+	}
+	return filterExperiences(query, *segmentFilter)
+}
+
+// decodeArg decodes an input object argument into v, whose JSON names are the GraphQL ones
+func decodeArg(args map[string]any, name string, v any) error {
+	value, ok := args[name]
+	if !ok || value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = json.Unmarshal(data, v)
+	}
+	if err != nil {
+		return problem.New(http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"invalid "+name)
+	}
+	return nil
+}
+
+// pageArgs are the Relay pagination arguments of a connection field
+type pageArgs struct {
+	// size is the number of nodes of the page, read from the end of the connection if backward
+	size          int
+	backward      bool
+	after, before *triggerCursor
+}
+
+// parsePageArgs parses the first, after, last, and before arguments. A connection is read
+// forward unless last is set; without first or last, the first defaultPageSize nodes are read.
+func parsePageArgs(args map[string]any) (pageArgs, error) {
+	first, hasFirst := args["first"].(int)
+	last, hasLast := args["last"].(int)
+	a := pageArgs{size: defaultPageSize}
+	switch {
+	case hasFirst && hasLast:
+		return a, problem.New(http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"set either first or last")
+	case hasFirst:
+		a.size = first
+	case hasLast:
+		a.size, a.backward = last, true
+	}
+	if a.size < 0 || a.size > maxPageSize {
+		return a, problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+			fmt.Sprintf("%sfirst and last must be between 0 and %d", ErrMsgInvalidInput, maxPageSize))
+	}
+
+	var err error
+	if a.after, err = parsePageCursor(args, "after"); err != nil {
+		return a, err
+	}
+	if a.before, err = parsePageCursor(args, "before"); err != nil {
+		return a, err
+	}
+	return a, nil
+}
+
+// parsePageCursor parses the cursor argument name, if it's set
+func parsePageCursor(args map[string]any, name string) (*triggerCursor, error) {
+	value, _ := args[name].(string)
+	if value == "" {
+		return nil, nil
+	}
+	c, err := parseTriggerCursor(value)
+	if err != nil {
+		return nil, problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+			fmt.Sprintf("%sinvalid %s: pass the cursor of an edge or of pageInfo", ErrMsgInvalidInput, name))
+	}
+	return &c, nil
+}
+
+// limit returns the number of nodes to read, one more than returned to know if more follow
+func (a pageArgs) limit() int {
+	return a.size + 1
+}
+
+// where returns the condition selecting the nodes between the cursors of a connection
+// ordered by column and then ID
+func (a pageArgs) where(column string, desc bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		var preds []*sql.Predicate
+		if a.after != nil {
+			preds = append(preds, beyondCursor(s, column, *a.after, !desc))
+		}
+		if a.before != nil {
+			preds = append(preds, beyondCursor(s, column, *a.before, desc))
+		}
+		if len(preds) > 0 {
+			s.Where(sql.And(preds...))
+		}
+	}
+}
+
+// beyondCursor selects the nodes after the cursor by column and then ID, or before it
+func beyondCursor(s *sql.Selector, column string, c triggerCursor, after bool) *sql.Predicate {
+	compare := sql.LT
+	if after {
+		compare = sql.GT
+	}
+	return sql.Or(
+		compare(s.C(column), c.At),
+		sql.And(sql.EQ(s.C(column), c.At), compare(s.C(idColumn), c.ID)),
+	)
+}
+
+// pageOrder returns the order of the rows to read: the order of the connection, reversed
+// when it's read from its end
+func pageOrder[O ~func(*sql.Selector)](a pageArgs, column string, desc bool) []O {
+	if desc != a.backward {
+		return []O{O(ent.Desc(column)), O(ent.Desc(idColumn))}
+	}
+	return []O{O(ent.Asc(column)), O(ent.Asc(idColumn))}
+}
+
+// connection is a Relay connection. Its total count is only queried if it's selected.
+type connection struct {
+	Edges    []connectionEdge
+	PageInfo pageInfo
+	count    func() (int, error)
+}
+
+// connectionEdge is a node of a connection and its cursor
+type connectionEdge struct {
+	Node   any
+	Cursor string
+}
+
+// pageInfo tells whether nodes follow the edges of a connection
+type pageInfo struct {
+	HasNextPage     bool
+	HasPreviousPage bool
+	StartCursor     *string
+	EndCursor       *string
+}
+
+// newConnection returns the connection of the rows read with the page arguments, in the
+// order of the connection. cursor returns the position of a row.
+func newConnection[T any](rows []T, a pageArgs, node func(T) any, cursor func(T) triggerCursor, count func() (int, error)) *connection {
+	more := len(rows) > a.size
+	if more {
+		rows = rows[:a.size]
+	}
+	if a.backward {
+		slices.Reverse(rows)
+	}
+
+	c := &connection{Edges: make([]connectionEdge, len(rows)), count: count}
+	for i, row := range rows {
+		c.Edges[i] = connectionEdge{Node: node(row), Cursor: cursor(row).encode()}
+	}
+	if a.backward {
+		c.PageInfo.HasPreviousPage = more
+		c.PageInfo.HasNextPage = a.before != nil
+	} else {
+		c.PageInfo.HasNextPage = more
+		c.PageInfo.HasPreviousPage = a.after != nil
+	}
+	if len(c.Edges) > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[len(c.Edges)-1].Cursor
+	}
+	return c
+}
+
+// selects reports whether the query selects the field at the path below the resolved field,
+// including through fragments
+func selects(info graphql.ResolveInfo, path ...string) bool {
+	var walk func(set *ast.SelectionSet, path []string) bool
+	walk = func(set *ast.SelectionSet, path []string) bool {
+		if set == nil {
+			return false
+		}
+		for _, selection := range set.Selections {
+			switch s := selection.(type) {
+			case *ast.Field:
+				if s.Name.Value == path[0] && (len(path) == 1 || walk(s.SelectionSet, path[1:])) {
+					return true
+				}
+			case *ast.InlineFragment:
+				if walk(s.SelectionSet, path) {
+					return true
+				}
+			case *ast.FragmentSpread:
+				if fragment, ok := info.Fragments[s.Name.Value].(*ast.FragmentDefinition); ok && walk(fragment.SelectionSet, path) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, field := range info.FieldASTs {
+		if walk(field.SelectionSet, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// experienceOrderColumns are the columns experiences can be ordered by, by GraphQL name
+var experienceOrderColumns = map[string]string{
+	"COLLECTED_AT": experiencedata.FieldCollectedAt,
+	"CREATED_AT":   experiencedata.FieldCreatedAt,
+	"UPDATED_AT":   experiencedata.FieldUpdatedAt,
+}
+
+// experienceOrderTime returns the value of an order column of an experience
+func experienceOrderTime(exp *ent.ExperienceData, column string) time.Time {
+	switch column {
+	case experiencedata.FieldCreatedAt:
+		return exp.CreatedAt
+	case experiencedata.FieldUpdatedAt:
+		return exp.UpdatedAt
+	default:
+		return exp.CollectedAt
+	}
+}
+
+// aggregateRow is a row of experienceAggregate
+type aggregateRow struct {
+	Group             *string  `json:"group"`
+	Period            *string  `json:"period"`
+	Count             int      `json:"count"`
+	AvgValueNumber    *float64 `json:"avg_value_number"`
+	AvgSentimentScore *float64 `json:"avg_sentiment_score"`
+}
+
+// newGraphQLSchema returns the schema of the GraphQL API, resolved with reader
+func newGraphQLSchema(reader *ent.Client, logger *slog.Logger) (graphql.Schema, error) {
+	jsonScalar := graphql.NewScalar(graphql.ScalarConfig{
+		Name:        "JSON",
+		Description: "Arbitrary JSON value, such as metadata",
+		Serialize:   func(value any) any { return value },
+	})
+	nonNull := graphql.NewNonNull
+	list := func(t graphql.Type) graphql.Output { return nonNull(graphql.NewList(nonNull(t))) }
+
+	questionType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Question",
+		Description: "A question of the question bank, identified by its source and field",
+		Fields: graphql.Fields{
+			"id":         {Type: nonNull(graphql.ID), Description: "Question ID, referenced by the questionId of experiences"},
+			"sourceType": {Type: nonNull(graphql.String), Description: "Type of feedback source"},
+			"sourceId":   {Type: nonNull(graphql.String), Description: "Source ID of the experiences; empty if they have none"},
+			"fieldId":    {Type: nonNull(graphql.String), Description: "Identifier of the question/field"},
+			"fieldType":  {Type: graphql.String, Description: "Field type of the first response"},
+			"label":      {Type: graphql.String, Description: "Canonical label, used when there is none in a language"},
+			"labels":     {Type: jsonScalar, Description: "Canonical labels by ISO language code"},
+			"metadata":   {Type: jsonScalar, Description: "Display metadata, such as the scale, choices, or help text"},
+			"createdAt":  {Type: nonNull(graphql.DateTime), Description: "When the question was created"},
+			"updatedAt":  {Type: nonNull(graphql.DateTime), Description: "When the question was last updated"},
+		},
+	})
+
+	experienceType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Experience",
+		Description: "A single question/response pair, with the fields of GET /v1/experiences/{id}",
+		Fields: graphql.Fields{
+			"id":               {Type: nonNull(graphql.ID), Description: "UUIDv7 primary key"},
+			"collectedAt":      {Type: nonNull(graphql.DateTime), Description: "When the feedback was collected"},
+			"createdAt":        {Type: nonNull(graphql.DateTime), Description: "When this record was created"},
+			"updatedAt":        {Type: nonNull(graphql.DateTime), Description: "When this record was last updated"},
+			"sourceType":       {Type: nonNull(graphql.String), Description: "Type of feedback source"},
+			"sourceId":         {Type: graphql.String, Description: "Reference to survey/form/ticket ID"},
+			"sourceName":       {Type: graphql.String, Description: "Human-readable name"},
+			"fieldId":          {Type: nonNull(graphql.String), Description: "Identifier for the question/field"},
+			"fieldLabel":       {Type: graphql.String, Description: "The actual question text"},
+			"fieldType":        {Type: nonNull(graphql.String), Description: "Type of field"},
+			"questionId":       {Type: graphql.ID, Description: "Question of the question bank this is a response to"},
+			"question":         {Type: questionType, Description: "Question of the question bank this is a response to"},
+			"valueText":        {Type: graphql.String, Description: "Text response"},
+			"valueNumber":      {Type: graphql.Float, Description: "Numeric response"},
+			"valueBoolean":     {Type: graphql.Boolean, Description: "Boolean response"},
+			"valueDate":        {Type: graphql.DateTime, Description: "Date response"},
+			"valueJson":        {Type: jsonScalar, Description: "Complex response"},
+			"npsCategory":      {Type: graphql.String, Description: "NPS category of nps scores: promoter (9-10), passive (7-8), detractor (0-6)"},
+			"metadata":         {Type: jsonScalar, Description: "Additional context"},
+			"country":          {Type: graphql.String, Description: "Country of the respondent, from metadata"},
+			"region":           {Type: graphql.String, Description: "Region or state of the respondent, from metadata"},
+			"device":           {Type: graphql.String, Description: "Device type, from metadata"},
+			"platform":         {Type: graphql.String, Description: "Platform or operating system, from metadata"},
+			"appVersion":       {Type: graphql.String, Description: "App version, from metadata"},
+			"language":         {Type: graphql.String, Description: "ISO language code"},
+			"translations":     {Type: jsonScalar, Description: "Machine translations of value_text and field_label by ISO language code"},
+			"userIdentifier":   {Type: graphql.String, Description: "User identifier"},
+			"contentHash":      {Type: graphql.String, Description: "SHA-256 of the normalized source, field, user, and value; equal for exact duplicates"},
+			"duplicateOf":      {Type: graphql.ID, Description: "Earlier experience this one duplicates"},
+			"skipAiProcessing": {Type: nonNull(graphql.Boolean), Description: "Whether the experience is excluded from AI enrichment and embeddings"},
+			"sentiment":        {Type: graphql.String, Description: "AI-detected sentiment: positive, negative, neutral"},
+			"sentimentScore":   {Type: graphql.Float, Description: "Sentiment intensity from -1 (negative) to +1 (positive)"},
+			"emotion":          {Type: graphql.String, Description: "AI-detected emotion"},
+			"topics":           {Type: graphql.NewList(nonNull(graphql.String)), Description: "Key topics extracted by AI"},
+			"isSpam":           {Type: graphql.Boolean, Description: "Whether AI flagged the response as spam"},
+			"spamConfidence":   {Type: graphql.Float, Description: "Confidence of the spam verdict from 0 to 1"},
+			"urgencyScore":     {Type: graphql.Float, Description: "AI-estimated triage urgency from 0 (routine) to 1 (needs immediate attention)"},
+			"urgencyReasons":   {Type: graphql.NewList(nonNull(graphql.String)), Description: "Reasons behind the urgency score"},
+		},
+	})
+
+	pageInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage":     {Type: nonNull(graphql.Boolean)},
+			"hasPreviousPage": {Type: nonNull(graphql.Boolean)},
+			"startCursor":     {Type: graphql.String},
+			"endCursor":       {Type: graphql.String},
+		},
+	})
+	connectionType := func(name string, node *graphql.Object) *graphql.Object {
+		edge := graphql.NewObject(graphql.ObjectConfig{
+			Name: name + "Edge",
+			Fields: graphql.Fields{
+				"node":   {Type: nonNull(node)},
+				"cursor": {Type: nonNull(graphql.String)},
+			},
+		})
+		return graphql.NewObject(graphql.ObjectConfig{
+			Name: name + "Connection",
+			Fields: graphql.Fields{
+				"edges":    {Type: list(edge)},
+				"pageInfo": {Type: nonNull(pageInfoType)},
+				"totalCount": {
+					Type:        nonNull(graphql.Int),
+					Description: "Number of nodes matching the filters, regardless of the pagination",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return p.Source.(*connection).count()
+					},
+				},
+			},
+		})
+	}
+	pageArgsConfig := func() graphql.FieldConfigArgument {
+		return graphql.FieldConfigArgument{
+			"first":  {Type: graphql.Int, Description: fmt.Sprintf("Number of nodes from the start, at most %d", maxPageSize)},
+			"after":  {Type: graphql.String, Description: "Cursor to read the nodes after"},
+			"last":   {Type: graphql.Int, Description: fmt.Sprintf("Number of nodes from the end, at most %d", maxPageSize)},
+			"before": {Type: graphql.String, Description: "Cursor to read the nodes before"},
+		}
+	}
+
+	enum := func(name, description string, values ...string) *graphql.Enum {
+		config := graphql.EnumValueConfigMap{}
+		for _, value := range values {
+			config[value] = &graphql.EnumValueConfig{Value: value}
+		}
+		return graphql.NewEnum(graphql.EnumConfig{Name: name, Description: description, Values: config})
+	}
+	lowerEnum := func(name, description string, values ...string) *graphql.Enum {
+		config := graphql.EnumValueConfigMap{}
+		for _, value := range values {
+			config[value] = &graphql.EnumValueConfig{Value: strings.ToLower(value)}
+		}
+		return graphql.NewEnum(graphql.EnumConfig{Name: name, Description: description, Values: config})
+	}
+
+	whereType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "ExperienceWhereInput",
+		Description: "Filters of experiences, like the filters of GET /v1/experiences; all set filters must match",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"sourceType":     {Type: graphql.String},
+			"sourceId":       {Type: graphql.String},
+			"fieldType":      {Type: graphql.String},
+			"questionId":     {Type: graphql.ID, Description: "Responses to the question, including responses sent with other labels"},
+			"userIdentifier": {Type: graphql.String},
+			"contentHash":    {Type: graphql.String},
+			"duplicate":      {Type: graphql.Boolean, Description: "Whether the experience was flagged as a duplicate"},
+			"country":        {Type: graphql.String},
+			"region":         {Type: graphql.String},
+			"device":         {Type: graphql.String},
+			"platform":       {Type: graphql.String},
+			"appVersion":     {Type: graphql.String},
+			"npsCategory":    {Type: lowerEnum("NpsCategory", "NPS category of nps responses", "PROMOTER", "PASSIVE", "DETRACTOR")},
+			"isSpam":         {Type: graphql.Boolean, Description: "Whether AI flagged the response as spam; false keeps responses without a verdict"},
+			"minUrgency":     {Type: graphql.Float, Description: "Minimum urgency score (0-1)"},
+			"urgencyReason": {Type: lowerEnum("UrgencyReason", "Reason behind an urgency score",
+				"CHURN_RISK", "BUG_REPORT", "LEGAL_THREAT", "SECURITY_ISSUE", "BILLING_ISSUE", "OUTAGE")},
+			"since":     {Type: graphql.DateTime, Description: "Collected at or after"},
+			"until":     {Type: graphql.DateTime, Description: "Collected at or before"},
+			"segmentId": {Type: graphql.ID, Description: "Only the experiences of this saved segment, in addition to the other filters"},
+		},
+	})
+	orderType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ExperienceOrder",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field":     {Type: enum("ExperienceOrderField", "Time experiences can be ordered by", "COLLECTED_AT", "CREATED_AT", "UPDATED_AT"), DefaultValue: "COLLECTED_AT"},
+			"direction": {Type: enum("OrderDirection", "Direction of an order", "ASC", "DESC"), DefaultValue: "DESC"},
+		},
+	})
+
+	experiencesArgs := pageArgsConfig()
+	experiencesArgs["where"] = &graphql.ArgumentConfig{Type: whereType}
+	experiencesArgs["orderBy"] = &graphql.ArgumentConfig{Type: orderType, Description: "Order of the experiences, newest collected first by default"}
+
+	questionsArgs := pageArgsConfig()
+	questionsArgs["sourceType"] = &graphql.ArgumentConfig{Type: graphql.String}
+	questionsArgs["sourceId"] = &graphql.ArgumentConfig{Type: graphql.String}
+	questionsArgs["fieldId"] = &graphql.ArgumentConfig{Type: graphql.String}
+
+	groupType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "ExperienceGroup",
+		Description: "Aggregates of the experiences of a group and period",
+		Fields: graphql.Fields{
+			"group":             {Type: graphql.String, Description: "Value of the groupBy column or metadata key; null for experiences without one"},
+			"period":            {Type: graphql.String, Description: "UTC start of the period, a date or the start of an hour"},
+			"count":             {Type: nonNull(graphql.Int), Description: "Number of experiences"},
+			"avgValueNumber":    {Type: graphql.Float, Description: "Mean value_number"},
+			"avgSentimentScore": {Type: graphql.Float, Description: "Mean sentiment score"},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"experience": {
+				Type:        experienceType,
+				Description: "Experience by ID, or null if there is none",
+				Args:        graphql.FieldConfigArgument{"id": {Type: nonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := uuid.Parse(p.Args["id"].(string))
+					if err != nil {
+						return nil, nil
+					}
+					q := reader.ExperienceData.Query().Where(experiencedata.ID(id))
+					if selects(p.Info, "question") {
+						q = q.WithQuestion()
+					}
+					exp, err := q.Only(p.Context)
+					if ent.IsNotFound(err) {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, toGraphQLError(handleDatabaseError(logger, err, "get", id.String()))
+					}
+					return toExperienceNode(exp), nil
+				},
+			},
+			"experiences": {
+				Type:        nonNull(connectionType("Experience", experienceType)),
+				Description: "Experiences matching the filters, paginated with cursors",
+				Args:        experiencesArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					a, err := parsePageArgs(p.Args)
+					if err != nil {
+						return nil, toGraphQLError(err)
+					}
+					base, err := filterGraphQLExperiences(p.Context, reader, reader.ExperienceData.Query(), p.Args, logger)
+					if err != nil {
+						return nil, toGraphQLError(err)
+					}
+					column, desc := experiencedata.FieldCollectedAt, true
+					if order, ok := p.Args["orderBy"].(map[string]any); ok {
+						field, _ := order["field"].(string)
+						column = cmp.Or(experienceOrderColumns[field], column)
+						desc = order["direction"] != "ASC"
+					}
+
+					q := base.Clone().Where(a.where(column, desc)).Order(pageOrder[experiencedata.OrderOption](a, column, desc)...).Limit(a.limit())
+					if selects(p.Info, "edges", "node", "question") {
+						q = q.WithQuestion()
+					}
+					rows, err := q.All(p.Context)
+					if err != nil {
+						return nil, toGraphQLError(handleDatabaseError(logger, err, "list", "experiences"))
+					}
+					return newConnection(rows, a,
+						func(exp *ent.ExperienceData) any { return toExperienceNode(exp) },
+						func(exp *ent.ExperienceData) triggerCursor {
+							return triggerCursor{At: experienceOrderTime(exp, column), ID: exp.ID}
+						},
+						func() (int, error) {
+							n, err := base.Count(p.Context)
+							if err != nil {
+								return 0, toGraphQLError(handleDatabaseError(logger, err, "count", "experiences"))
+							}
+							return n, nil
+						}), nil
+				},
+			},
+			"question": {
+				Type:        questionType,
+				Description: "Question by ID, or null if there is none",
+				Args:        graphql.FieldConfigArgument{"id": {Type: nonNull(graphql.ID)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					id, err := uuid.Parse(p.Args["id"].(string))
+					if err != nil {
+						return nil, nil
+					}
+					q, err := reader.Question.Get(p.Context, id)
+					if ent.IsNotFound(err) {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, toGraphQLError(handleDatabaseError(logger, err, "get", id.String()))
+					}
+					return questionToItem(q), nil
+				},
+			},
+			"questions": {
+				Type:        nonNull(connectionType("Question", questionType)),
+				Description: "Questions of the question bank, oldest first, paginated with cursors",
+				Args:        questionsArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					a, err := parsePageArgs(p.Args)
+					if err != nil {
+						return nil, toGraphQLError(err)
+					}
+					base := reader.Question.Query()
+					if v, _ := p.Args["sourceType"].(string); v != "" {
+						base = base.Where(question.SourceTypeEQ(v))
+					}
+					if v, _ := p.Args["sourceId"].(string); v != "" {
+						base = base.Where(question.SourceIDEQ(v))
+					}
+					if v, _ := p.Args["fieldId"].(string); v != "" {
+						base = base.Where(question.FieldIDEQ(v))
+					}
+
+					rows, err := base.Clone().
+						Where(a.where(question.FieldCreatedAt, false)).
+						Order(pageOrder[question.OrderOption](a, question.FieldCreatedAt, false)...).
+						Limit(a.limit()).
+						All(p.Context)
+					if err != nil {
+						return nil, toGraphQLError(handleDatabaseError(logger, err, "list", "questions"))
+					}
+					return newConnection(rows, a,
+						func(q *ent.Question) any { return questionToItem(q) },
+						func(q *ent.Question) triggerCursor { return triggerCursor{At: q.CreatedAt, ID: q.ID} },
+						func() (int, error) {
+							n, err := base.Count(p.Context)
+							if err != nil {
+								return 0, toGraphQLError(handleDatabaseError(logger, err, "count", "questions"))
+							}
+							return n, nil
+						}), nil
+				},
+			},
+			"experienceAggregate": {
+				Type: list(groupType),
+				Description: "Counts and means of the experiences matching the filters, optionally per group and period. " +
+					"Without groupBy and interval, a single group of all experiences is returned. Responses flagged as " +
+					"spam or as duplicates aren't counted unless includeExcluded is set, like in /v1/analytics.",
+				Args: graphql.FieldConfigArgument{
+					"where":           {Type: whereType},
+					"groupBy":         {Type: graphql.String, Description: "Column (e.g. country, sentiment, nps_category) or metadata.<key> to group by"},
+					"interval":        {Type: lowerEnum("AggregateInterval", "Period of time groups", "HOUR", "DAY", "WEEK", "MONTH"), Description: "Period to group by, by collected_at"},
+					"includeExcluded": {Type: graphql.Boolean, DefaultValue: false, Description: "Also count responses flagged as spam or as duplicates"},
+					"limit":           {Type: graphql.Int, DefaultValue: defaultPageSize, Description: fmt.Sprintf("Most groups to return, at most %d", maxPageSize)},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					limit, _ := p.Args["limit"].(int)
+					if limit < 1 || limit > maxPageSize {
+						return nil, toGraphQLError(problem.New(http.StatusBadRequest, problem.CodeBadRequest,
+							fmt.Sprintf("%slimit must be between 1 and %d", ErrMsgInvalidInput, maxPageSize)))
+					}
+					q, err := filterGraphQLExperiences(p.Context, reader, reader.ExperienceData.Query(), p.Args, logger)
+					if err != nil {
+						return nil, toGraphQLError(err)
+					}
+					if excluded, _ := p.Args["includeExcluded"].(bool); !excluded {
+						q = q.Where(
+							experiencedata.DuplicateOfIsNil(),
+							experiencedata.Or(experiencedata.IsSpamIsNil(), experiencedata.IsSpam(false)),
+						)
+					}
+
+					fns := []ent.AggregateFunc{
+						ent.As(ent.Count(), "count"),
+						ent.As(ent.Mean(experiencedata.FieldValueNumber), "avg_value_number"),
+						ent.As(ent.Mean(experiencedata.FieldSentimentScore), "avg_sentiment_score"),
+					}
+					interval, _ := p.Args["interval"].(string)
+					if interval != "" {
+						fns = append(fns, groupByPeriod(interval, "period"))
+					}
+					if groupBy, _ := p.Args["groupBy"].(string); groupBy != "" {
+						group, err := groupByDimension(groupBy, "groupBy", "group")
+						if err != nil {
+							return nil, toGraphQLError(err)
+						}
+						fns = append(fns, group)
+					}
+
+					var rows []aggregateRow
+					if err := q.Aggregate(fns...).Scan(p.Context, &rows); err != nil {
+						return nil, toGraphQLError(handleDatabaseError(logger, err, "aggregate", "experiences"))
+					}
+					// Periods are in order; groups of a period, or groups without periods, come largest first
+					slices.SortStableFunc(rows, func(a, b aggregateRow) int {
+						if interval != "" {
+							if c := cmp.Compare(*a.Period, *b.Period); c != 0 {
+								return c
+							}
+						}
+						return cmp.Compare(b.Count, a.Count)
+					})
+					if len(rows) > limit {
+						rows = rows[:limit]
+					}
+					return rows, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
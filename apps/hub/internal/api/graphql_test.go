@@ -0,0 +1,306 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/testutil"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+func TestPageArgs(t *testing.T) {
+	a, err := parsePageArgs(map[string]any{})
+	if err != nil || a.size != defaultPageSize || a.backward {
+		t.Errorf("expected the first %d nodes by default, got %+v, %v", defaultPageSize, a, err)
+	}
+
+	after := triggerCursor{At: time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC), ID: uuid.New()}
+	a, err = parsePageArgs(map[string]any{"last": 5, "after": after.encode()})
+	if err != nil || a.size != 5 || !a.backward || a.after == nil || a.after.ID != after.ID || a.before != nil {
+		t.Errorf("unexpected page arguments %+v, %v", a, err)
+	}
+
+	for _, args := range []map[string]any{
+		{"first": 1, "last": 1},
+		{"first": maxPageSize + 1},
+		{"last": -1},
+		{"after": "not-a-cursor"},
+	} {
+		if _, err := parsePageArgs(args); err == nil {
+			t.Errorf("expected an error for %v", args)
+		}
+	}
+}
+
+func TestNewConnection(t *testing.T) {
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	rows := []int{1, 2, 3}
+	cursor := func(i int) triggerCursor { return triggerCursor{At: at.Add(time.Duration(i) * time.Minute)} }
+	node := func(i int) any { return i }
+
+	forward := newConnection(rows, pageArgs{size: 2}, node, cursor, nil)
+	if len(forward.Edges) != 2 || forward.Edges[0].Node != 1 || !forward.PageInfo.HasNextPage || forward.PageInfo.HasPreviousPage {
+		t.Errorf("unexpected forward page: %+v", forward)
+	}
+	if *forward.PageInfo.EndCursor != cursor(2).encode() {
+		t.Errorf("expected the end cursor of the second row, got %s", *forward.PageInfo.EndCursor)
+	}
+
+	// Read from the end, rows come newest first and are returned in the order of the connection
+	backward := newConnection([]int{3, 2}, pageArgs{size: 2, backward: true, before: &triggerCursor{}}, node, cursor, nil)
+	if len(backward.Edges) != 2 || backward.Edges[0].Node != 2 || backward.PageInfo.HasPreviousPage || !backward.PageInfo.HasNextPage {
+		t.Errorf("unexpected backward page: %+v", backward)
+	}
+
+	if empty := newConnection(nil, pageArgs{size: 2}, node, cursor, nil); empty.PageInfo.StartCursor != nil || len(empty.Edges) != 0 {
+		t.Errorf("unexpected empty page: %+v", empty)
+	}
+}
+
+func TestGraphQLSchema(t *testing.T) {
+	schema, err := newGraphQLSchema(nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("newGraphQLSchema() error = %v", err)
+	}
+
+	// Arguments are checked before the database is queried
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ experiences(first: 5000) { totalCount } }`})
+	if len(result.Errors) != 1 || result.Errors[0].Extensions["code"] != "bad_request" {
+		t.Errorf("expected a bad_request error, got %+v", result.Errors)
+	}
+
+	result = graphql.Do(graphql.Params{Schema: schema, RequestString: `{ experiences { edges { node { secret } } } }`})
+	if len(result.Errors) == 0 {
+		t.Error("expected a validation error for an unknown field")
+	}
+
+	result = graphql.Do(graphql.Params{Schema: schema, RequestString: `{ __type(name: "Experience") { fields { name } } }`})
+	if len(result.Errors) != 0 || !strings.Contains(mustJSON(t, result.Data), `"sentimentScore"`) {
+		t.Errorf("expected the experience fields to be introspectable, got %+v", result)
+	}
+}
+
+func TestGraphQLRequests(t *testing.T) {
+	router := chi.NewRouter()
+	RegisterGraphQLRoutes(router, &config.Config{APIKey: "test-key"}, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := serve(httptest.NewRequest(http.MethodPost, "/v1/graphql", strings.NewReader(`{"query":"{ __typename }"}`))); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 without the API key, got %d", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", strings.NewReader(`{"query":"query Name { __typename }","operationName":"Name"}`))
+	req.Header.Set("X-API-Key", "test-key")
+	if rec := serve(req); rec.Code != http.StatusOK || rec.Body.String() != "{\"data\":{\"__typename\":\"Query\"}}\n" {
+		t.Errorf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/graphql?query=%7B+__typename+%7D", nil)
+	req.Header.Set("X-API-Key", "test-key")
+	if rec := serve(req); rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"Query"`) {
+		t.Errorf("unexpected response to GET %d: %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/graphql", strings.NewReader(`{}`))
+	req.Header.Set("X-API-Key", "test-key")
+	if rec := serve(req); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 without a query, got %d", rec.Code)
+	}
+
+	large := "{ __typename " + strings.Repeat(" ", maxGraphQLRequestSize) + "}"
+	req = httptest.NewRequest(http.MethodGet, "/v1/graphql?query="+url.QueryEscape(large), nil)
+	req.Header.Set("X-API-Key", "test-key")
+	if rec := serve(req); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status 413 for a large query string, got %d", rec.Code)
+	}
+	req = httptest.NewRequest(http.MethodPost, "/v1/graphql", strings.NewReader(mustJSON(t, map[string]string{"query": large})))
+	req.Header.Set("X-API-Key", "test-key")
+	if rec := serve(req); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status 413 for a large body, got %d", rec.Code)
+	}
+}
+
+func TestGraphQLLimits(t *testing.T) {
+	schema, err := newGraphQLSchema(nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("newGraphQLSchema() error = %v", err)
+	}
+
+	deep := `{ __type(name: "Query") { fields { type ` + strings.Repeat("{ ofType ", maxQueryDepth) + "{ name }" + strings.Repeat(" }", maxQueryDepth) + ` } } }`
+	aliases := "{"
+	for i := range maxQueryAliases + 1 {
+		aliases += fmt.Sprintf(" t%d: __typename", i)
+	}
+	aliases += " }"
+	// Each level spreads the fragment of the level below ten times
+	fragments := "{ __schema { types { ...F0 } } }"
+	for i := range 4 {
+		fragments += fmt.Sprintf(" fragment F%d on __Type { name%s }", i, strings.Repeat(fmt.Sprintf(" ofType { ...F%d }", i+1), 10))
+	}
+	fragments += " fragment F4 on __Type { name }"
+
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{name: "introspection query", query: testutil.IntrospectionQuery},
+		{name: "too deep", query: deep, wantErr: true},
+		{name: "too many aliases", query: aliases, wantErr: true},
+		{name: "too many fields", query: fragments, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := executeGraphQL(context.Background(), schema, graphQLRequest{Query: tt.query})
+			if !tt.wantErr {
+				if len(result.Errors) != 0 {
+					t.Errorf("unexpected errors: %v", result.Errors)
+				}
+				return
+			}
+			if len(result.Errors) != 1 || result.Errors[0].Extensions["code"] != "bad_request" || result.Data != nil {
+				t.Errorf("expected a bad_request error without data, got %+v", result)
+			}
+		})
+	}
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestGraphQL(t *testing.T) {
+	api, _, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	collected := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	for i, score := range []float64{9, 10, 3} {
+		resp := api.Post("/v1/experiences", map[string]interface{}{
+			"source_type":     "survey",
+			"source_id":       "graphql-nps",
+			"field_id":        "nps",
+			"field_label":     "How likely are you to recommend us?",
+			"field_type":      "nps",
+			"value_number":    score,
+			"user_identifier": fmt.Sprintf("user-%d", i),
+			"collected_at":    collected.Add(time.Duration(i) * time.Hour),
+		})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+	}
+
+	type page struct {
+		TotalCount int `json:"totalCount"`
+		Edges      []struct {
+			Cursor string `json:"cursor"`
+			Node   struct {
+				ValueNumber float64 `json:"valueNumber"`
+				Question    struct {
+					Label string `json:"label"`
+				} `json:"question"`
+			} `json:"node"`
+		} `json:"edges"`
+		PageInfo struct {
+			HasNextPage bool   `json:"hasNextPage"`
+			EndCursor   string `json:"endCursor"`
+		} `json:"pageInfo"`
+	}
+	query := func(q string, variables map[string]any, data any) []map[string]any {
+		t.Helper()
+		resp := api.Post("/v1/graphql", map[string]any{"query": q, "variables": variables})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var result struct {
+			Data   json.RawMessage  `json:"data"`
+			Errors []map[string]any `json:"errors"`
+		}
+		if err := json.Unmarshal(resp.Body.Bytes(), &result); err != nil {
+			t.Fatal(err)
+		}
+		if data != nil && len(result.Errors) == 0 {
+			if err := json.Unmarshal(result.Data, data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return result.Errors
+	}
+
+	const experiences = `query($after: String) {
+		experiences(first: 2, after: $after, where: {sourceId: "graphql-nps"}, orderBy: {field: COLLECTED_AT, direction: ASC}) {
+			totalCount
+			edges { cursor node { valueNumber question { label } } }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`
+	var first struct{ Experiences page }
+	if errs := query(experiences, nil, &first); errs != nil {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if first.Experiences.TotalCount != 3 || len(first.Experiences.Edges) != 2 || !first.Experiences.PageInfo.HasNextPage {
+		t.Fatalf("unexpected first page: %+v", first.Experiences)
+	}
+	if node := first.Experiences.Edges[0].Node; node.ValueNumber != 9 || node.Question.Label != "How likely are you to recommend us?" {
+		t.Errorf("unexpected first experience: %+v", node)
+	}
+
+	var second struct{ Experiences page }
+	if errs := query(experiences, map[string]any{"after": first.Experiences.PageInfo.EndCursor}, &second); errs != nil {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(second.Experiences.Edges) != 1 || second.Experiences.Edges[0].Node.ValueNumber != 3 || second.Experiences.PageInfo.HasNextPage {
+		t.Errorf("unexpected second page: %+v", second.Experiences)
+	}
+
+	t.Run("aggregate", func(t *testing.T) {
+		var data struct {
+			ExperienceAggregate []struct {
+				Group          string  `json:"group"`
+				Count          int     `json:"count"`
+				AvgValueNumber float64 `json:"avgValueNumber"`
+			} `json:"experienceAggregate"`
+		}
+		if errs := query(`{ experienceAggregate(where: {sourceId: "graphql-nps"}, groupBy: "nps_category") { group count avgValueNumber } }`, nil, &data); errs != nil {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		groups := data.ExperienceAggregate
+		if len(groups) != 2 || groups[0].Group != "promoter" || groups[0].Count != 2 || groups[0].AvgValueNumber != 9.5 {
+			t.Errorf("unexpected groups: %+v", groups)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		errs := query(`{ experiences(after: "not-a-cursor") { totalCount } }`, nil, nil)
+		if len(errs) != 1 || errs[0]["extensions"].(map[string]any)["code"] != "bad_request" {
+			t.Errorf("expected a bad_request error, got %v", errs)
+		}
+		errs = query(`{ experienceAggregate(groupBy: "secret") { count } }`, nil, nil)
+		if len(errs) != 1 {
+			t.Errorf("expected an error for an unknown group, got %v", errs)
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	entworker "github.com/formbricks/hub/apps/hub/internal/ent/worker"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+const (
+	// healthCheckTimeout bounds each dependency check of the deep health check
+	healthCheckTimeout = 5 * time.Second
+	// providerCheckTTL is how long the result of an AI provider check is reused, so
+	// monitoring systems polling the deep health check don't call the provider every time
+	providerCheckTTL = time.Minute
+)
+
+// Dependency check statuses
+const (
+	checkOK    = "ok"
+	checkError = "error"
+)
+
+// DependencyCheck is the status of one dependency in the deep health check
+type DependencyCheck struct {
+	Status    string         `json:"status"`
+	LatencyMS int64          `json:"latency_ms"`
+	Error     string         `json:"error,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+}
+
+// DeepHealth is the response of the deep health check. Status is ok if all checks pass,
+// degraded if an optional dependency fails, and down if the database fails.
+type DeepHealth struct {
+	Status string                     `json:"status"`
+	Checks map[string]DependencyCheck `json:"checks"`
+}
+
+// deepHealthChecker checks the dependencies of the server
+type deepHealthChecker struct {
+	cfg        *config.Config
+	client     *ent.Client
+	replica    *ent.Client // nil without a replica
+	dispatcher *webhook.Dispatcher
+	ping       func(ctx context.Context, provider string) error
+
+	mu        sync.Mutex
+	providers map[string]cachedCheck
+}
+
+// cachedCheck is an AI provider check result and when it was taken
+type cachedCheck struct {
+	check     DependencyCheck
+	checkedAt time.Time
+}
+
+func newDeepHealthChecker(cfg *config.Config, client, replica *ent.Client, dispatcher *webhook.Dispatcher) *deepHealthChecker {
+	return &deepHealthChecker{
+		cfg:        cfg,
+		client:     client,
+		replica:    replica,
+		dispatcher: dispatcher,
+		ping: func(ctx context.Context, provider string) error {
+			return ai.Ping(ctx, cfg, provider)
+		},
+		providers: make(map[string]cachedCheck),
+	}
+}
+
+// ServeHTTP runs all checks concurrently and responds with 503 if the database fails
+func (c *deepHealthChecker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	health := c.check(r.Context())
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if health.Status == "down" {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(health)
+}
+
+// check runs the checks of all configured dependencies
+func (c *deepHealthChecker) check(ctx context.Context) DeepHealth {
+	checks := map[string]func(context.Context) DependencyCheck{
+		"database": func(ctx context.Context) DependencyCheck { return checkDatabase(ctx, c.client) },
+	}
+	if c.replica != nil {
+		checks["database_replica"] = func(ctx context.Context) DependencyCheck { return checkDatabase(ctx, c.replica) }
+	}
+	if c.dispatcher != nil {
+		checks["webhooks"] = func(context.Context) DependencyCheck { return checkDispatcher(c.dispatcher.Status()) }
+	}
+	for _, provider := range ai.Providers(c.cfg) {
+		checks[provider] = func(ctx context.Context) DependencyCheck { return c.checkProvider(ctx, provider) }
+	}
+	if c.cfg.IsEnrichmentEnabled() || c.cfg.IsEmbeddingEnabled() {
+		checks["workers"] = func(ctx context.Context) DependencyCheck { return checkWorkers(ctx, c.client) }
+	}
+
+	health := DeepHealth{Status: "ok", Checks: make(map[string]DependencyCheck, len(checks))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, run := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+			result := run(ctx)
+			mu.Lock()
+			health.Checks[name] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	for name, result := range health.Checks {
+		if result.Status == checkOK {
+			continue
+		}
+		if name == "database" {
+			health.Status = "down"
+		} else if health.Status == "ok" {
+			health.Status = "degraded"
+		}
+	}
+	return health
+}
+
+// timed runs a check and records its latency
+func timed(run func() error) DependencyCheck {
+	start := time.Now()
+	err := run()
+	result := DependencyCheck{Status: checkOK, LatencyMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = checkError
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// checkDatabase measures the round trip of a query on a table with a few rows at most
+func checkDatabase(ctx context.Context, client *ent.Client) DependencyCheck {
+	return timed(func() error {
+		_, err := client.QueuePause.Query().Exist(ctx)
+		return err
+	})
+}
+
+// checkProvider pings an AI provider, reusing a recent result
+func (c *deepHealthChecker) checkProvider(ctx context.Context, provider string) DependencyCheck {
+	c.mu.Lock()
+	cached, ok := c.providers[provider]
+	c.mu.Unlock()
+	if ok && time.Since(cached.checkedAt) < providerCheckTTL {
+		return cached.check
+	}
+
+	result := timed(func() error { return c.ping(ctx, provider) })
+	c.mu.Lock()
+	c.providers[provider] = cachedCheck{check: result, checkedAt: time.Now()}
+	c.mu.Unlock()
+	return result
+}
+
+// checkDispatcher fails if webhook workers stopped or the delivery queue is full
+func checkDispatcher(status webhook.DispatcherStatus) DependencyCheck {
+	result := DependencyCheck{
+		Status: checkOK,
+		Details: map[string]any{
+			"workers":        status.Workers,
+			"alive_workers":  status.AliveWorkers,
+			"queue_length":   status.QueueLength,
+			"queue_capacity": status.QueueCapacity,
+		},
+	}
+	switch {
+	case status.AliveWorkers < status.Workers:
+		result.Status = checkError
+		result.Error = fmt.Sprintf("%d of %d webhook workers are running", status.AliveWorkers, status.Workers)
+	case status.QueueCapacity > 0 && status.QueueLength >= status.QueueCapacity:
+		result.Status = checkError
+		result.Error = "webhook queue is full; new deliveries are dropped"
+	}
+	return result
+}
+
+// checkWorkers fails if no AI job worker of any instance sent a recent heartbeat
+func checkWorkers(ctx context.Context, client *ent.Client) DependencyCheck {
+	var alive, stale int
+	result := timed(func() error {
+		since := time.Now().Add(-workerStaleAfter)
+		var err error
+		if alive, err = client.Worker.Query().Where(entworker.LastHeartbeatAtGTE(since)).Count(ctx); err != nil {
+			return err
+		}
+		stale, err = client.Worker.Query().Where(entworker.LastHeartbeatAtLT(since)).Count(ctx)
+		return err
+	})
+	if result.Status != checkOK {
+		return result
+	}
+	result.Details = map[string]any{"alive": alive, "stale": stale}
+	if alive == 0 {
+		result.Status = checkError
+		result.Error = fmt.Sprintf("no AI job worker sent a heartbeat in the last %s", workerStaleAfter)
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+func TestCheckDispatcher(t *testing.T) {
+	tests := []struct {
+		name   string
+		status webhook.DispatcherStatus
+		want   string
+	}{
+		{name: "healthy", status: webhook.DispatcherStatus{Workers: 10, AliveWorkers: 10, QueueLength: 3, QueueCapacity: 1000}, want: checkOK},
+		{name: "stopped workers", status: webhook.DispatcherStatus{Workers: 10, AliveWorkers: 7, QueueCapacity: 1000}, want: checkError},
+		{name: "full queue", status: webhook.DispatcherStatus{Workers: 10, AliveWorkers: 10, QueueLength: 1000, QueueCapacity: 1000}, want: checkError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := checkDispatcher(tt.status)
+			if result.Status != tt.want {
+				t.Errorf("expected %s, got %+v", tt.want, result)
+			}
+			if result.Details["alive_workers"] != tt.status.AliveWorkers {
+				t.Errorf("expected the worker counts in the details, got %v", result.Details)
+			}
+		})
+	}
+}
+
+func TestCheckProvider_ReusesRecentResults(t *testing.T) {
+	checker := newDeepHealthChecker(&config.Config{}, nil, nil, nil)
+	calls := 0
+	checker.ping = func(ctx context.Context, provider string) error {
+		calls++
+		return errors.New("invalid API key")
+	}
+
+	for range 3 {
+		result := checker.checkProvider(context.Background(), "openai")
+		if result.Status != checkError || result.Error != "invalid API key" {
+			t.Fatalf("expected the ping error, got %+v", result)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected the provider to be pinged once, got %d pings", calls)
+	}
+
+	checker.checkProvider(context.Background(), "gemini")
+	if calls != 2 {
+		t.Errorf("expected each provider to be pinged, got %d pings", calls)
+	}
+}
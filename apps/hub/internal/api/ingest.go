@@ -0,0 +1,428 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/connector/mapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// IngestMappingItem represents an ingest mapping in API responses
+type IngestMappingItem struct {
+	ID              uuid.UUID        `json:"id" doc:"Mapping ID"`
+	Name            string           `json:"name" doc:"Name of the mapping"`
+	SourceType      string           `json:"source_type" doc:"Source type of the experiences created from deliveries"`
+	Template        mapping.Template `json:"template" doc:"Template that maps a delivery to responses"`
+	Secret          string           `json:"secret,omitempty" doc:"Token or signing key of deliveries (only included when the mapping is created)"`
+	SignatureHeader string           `json:"signature_header,omitempty" doc:"Header with the HMAC-SHA256 signature of deliveries; deliveries are authenticated with the secret as token if empty"`
+	Enabled         bool             `json:"enabled" doc:"Whether deliveries are accepted"`
+	IngestPath      string           `json:"ingest_path" doc:"Path that the tool posts deliveries to" example:"/v1/ingest/01890a5d-ac96-774b-bcce-b302099a8057"`
+	CreatedAt       time.Time        `json:"created_at" doc:"When the mapping was created"`
+	UpdatedAt       time.Time        `json:"updated_at" doc:"When the mapping was last updated"`
+}
+
+// CreateIngestMappingInput defines the input for creating an ingest mapping
+type CreateIngestMappingInput struct {
+	Body struct {
+		Name            string           `json:"name" doc:"Name of the mapping" minLength:"1" maxLength:"255" example:"Hotjar surveys"`
+		SourceType      string           `json:"source_type" doc:"Source type of the experiences created from deliveries" minLength:"1" maxLength:"255" example:"hotjar"`
+		Template        mapping.Template `json:"template" doc:"Template that maps a delivery to responses"`
+		Secret          string           `json:"secret,omitempty" doc:"Token or signing key of deliveries; generated if omitted" minLength:"16" maxLength:"256"`
+		SignatureHeader string           `json:"signature_header,omitempty" doc:"Header in which the tool sends the HMAC-SHA256 signature of the body, hex or base64 encoded; omit to authenticate deliveries with the secret as bearer token" maxLength:"256" example:"X-Signature"`
+		Enabled         *bool            `json:"enabled,omitempty" doc:"Whether deliveries are accepted (default true)"`
+	}
+}
+
+// UpdateIngestMappingInput defines the input for updating an ingest mapping
+type UpdateIngestMappingInput struct {
+	ID   string `path:"id" doc:"Mapping ID (UUID)" format:"uuid"`
+	Body struct {
+		Name            *string           `json:"name,omitempty" doc:"Rename the mapping" minLength:"1" maxLength:"255"`
+		SourceType      *string           `json:"source_type,omitempty" doc:"Change the source type of future experiences" minLength:"1" maxLength:"255"`
+		Template        *mapping.Template `json:"template,omitempty" doc:"Replace the template"`
+		Secret          *string           `json:"secret,omitempty" doc:"Rotate the token or signing key" minLength:"16" maxLength:"256"`
+		SignatureHeader *string           `json:"signature_header,omitempty" doc:"Change the signature header; an empty string authenticates deliveries with the secret as bearer token" maxLength:"256"`
+		Enabled         *bool             `json:"enabled,omitempty" doc:"Enable or disable the mapping"`
+	}
+}
+
+// IngestMappingIDInput identifies a single ingest mapping
+type IngestMappingIDInput struct {
+	ID string `path:"id" doc:"Mapping ID (UUID)" format:"uuid"`
+}
+
+// IngestMappingOutput represents the output for a single ingest mapping
+type IngestMappingOutput struct {
+	Body IngestMappingItem
+}
+
+// ListIngestMappingsOutput represents the output for listing ingest mappings
+type ListIngestMappingsOutput struct {
+	Body struct {
+		Data []IngestMappingItem `json:"data" doc:"Mappings, oldest first"`
+	}
+}
+
+// PreviewIngestMappingInput defines the input for previewing a template
+type PreviewIngestMappingInput struct {
+	Body struct {
+		Template mapping.Template `json:"template" doc:"Template to map the payload with"`
+		Payload  any              `json:"payload" doc:"Sample delivery of the tool, as it would be posted to the mapping"`
+	}
+}
+
+// MappedExperience is an experience a template maps a delivery to
+type MappedExperience struct {
+	FieldID      string     `json:"field_id" doc:"Field ID"`
+	FieldLabel   string     `json:"field_label,omitempty" doc:"Question text"`
+	FieldType    string     `json:"field_type" doc:"Field type"`
+	ValueText    *string    `json:"value_text,omitempty" doc:"Text value"`
+	ValueNumber  *float64   `json:"value_number,omitempty" doc:"Numeric value"`
+	ValueBoolean *bool      `json:"value_boolean,omitempty" doc:"Boolean value"`
+	ValueDate    *time.Time `json:"value_date,omitempty" doc:"Date value"`
+}
+
+// MappedResponse is a response a template maps a delivery to
+type MappedResponse struct {
+	ResponseID     string             `json:"response_id" doc:"Identifies the response; redelivered responses are only stored once"`
+	SourceID       string             `json:"source_id,omitempty" doc:"Source ID of the experiences; the mapping ID if empty"`
+	SourceName     string             `json:"source_name,omitempty" doc:"Source name of the experiences"`
+	CollectedAt    time.Time          `json:"collected_at" doc:"When the response was submitted"`
+	UserIdentifier string             `json:"user_identifier,omitempty" doc:"Identifies the respondent"`
+	Metadata       map[string]any     `json:"metadata,omitempty" doc:"Metadata of the experiences"`
+	Experiences    []MappedExperience `json:"experiences" doc:"Experiences of the response"`
+}
+
+// PreviewIngestMappingOutput represents the responses a template maps a payload to
+type PreviewIngestMappingOutput struct {
+	Body struct {
+		Data []MappedResponse `json:"data" doc:"Responses of the payload; empty if no field has a value"`
+	}
+}
+
+// ingestDelivery is the response to a delivery posted to an ingest mapping
+type ingestDelivery struct {
+	Responses int `json:"responses"`
+	Created   int `json:"created"`
+}
+
+// ingestMappingToItem converts an Ent entity to the API response type. The secret is omitted.
+func ingestMappingToItem(m *ent.IngestMapping) IngestMappingItem {
+	return IngestMappingItem{
+		ID:              m.ID,
+		Name:            m.Name,
+		SourceType:      m.SourceType,
+		Template:        m.Template,
+		SignatureHeader: m.SignatureHeader,
+		Enabled:         m.Enabled,
+		IngestPath:      "/v1/ingest/" + m.ID.String(),
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+// mappedResponse converts a mapped response to the API response type
+func mappedResponse(response *connector.Response) MappedResponse {
+	item := MappedResponse{
+		ResponseID:     response.ID,
+		SourceID:       response.SourceID,
+		SourceName:     response.SourceName,
+		CollectedAt:    response.CollectedAt,
+		UserIdentifier: response.UserIdentifier,
+		Metadata:       response.Metadata,
+		Experiences:    make([]MappedExperience, len(response.Experiences)),
+	}
+	for i, exp := range response.Experiences {
+		item.Experiences[i] = MappedExperience{
+			FieldID:      exp.FieldID,
+			FieldLabel:   exp.FieldLabel,
+			FieldType:    exp.FieldType,
+			ValueText:    exp.ValueText,
+			ValueNumber:  exp.ValueNumber,
+			ValueBoolean: exp.ValueBoolean,
+			ValueDate:    exp.ValueDate,
+		}
+	}
+	return item
+}
+
+// validateTemplate rejects templates whose paths don't parse or whose field types are invalid
+func validateTemplate(template *mapping.Template) error {
+	if err := template.Validate(); err != nil {
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidMapping, ErrMsgInvalidInput+err.Error())
+	}
+	return nil
+}
+
+// authenticateIngest reports whether a delivery carries the mapping's signature or, without
+// a signature header, its secret as bearer token or token query parameter
+func authenticateIngest(m *ent.IngestMapping, r *http.Request, body []byte) bool {
+	if m.SignatureHeader != "" {
+		return mapping.VerifySignature(m.Secret, r.Header.Get(m.SignatureHeader), body)
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token = r.URL.Query().Get("token")
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(m.Secret)) == 1
+}
+
+// RegisterIngestRoutes registers routes for managing ingest mappings and the route that
+// receives their deliveries. Deliveries are served by the router rather than Huma, as tools
+// can't send the API key; each is authenticated with the secret of its mapping.
+func RegisterIngestRoutes(router chi.Router, api huma.API, cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger, enrichmentQueue queue.Queue) {
+	store := NewConnectorStore(cfg, client, dispatcher, logger, enrichmentQueue)
+
+	router.Post("/v1/ingest/{mapping_id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := uuid.Parse(chi.URLParam(r, "mapping_id"))
+		if err != nil {
+			problem.Write(w, http.StatusNotFound, problem.CodeMappingNotFound, "Ingest mapping not found")
+			return
+		}
+		body, ok := readConnectorBody(w, r)
+		if !ok {
+			return
+		}
+
+		m, err := client.IngestMapping.Get(r.Context(), id)
+		if ent.IsNotFound(err) {
+			problem.Write(w, http.StatusNotFound, problem.CodeMappingNotFound, "Ingest mapping not found")
+			return
+		}
+		if err != nil {
+			writeConnectorError(w, r, handleDatabaseError(logger, err, "get", id.String()))
+			return
+		}
+		if !authenticateIngest(m, r, body) {
+			logger.Warn("ingest delivery rejected", "mapping_id", id)
+			problem.Write(w, http.StatusUnauthorized, problem.CodeUnauthorized, "Missing or invalid token or signature")
+			return
+		}
+		if !m.Enabled {
+			problem.Write(w, http.StatusBadRequest, problem.CodeFeatureDisabled, "Ingest mapping is disabled. Enable it to accept deliveries.")
+			return
+		}
+
+		var payload any
+		if err := json.Unmarshal(body, &payload); err != nil {
+			problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, "Request body is not valid JSON")
+			return
+		}
+		responses, err := m.Template.Map(payload, time.Now())
+		if err != nil {
+			problem.Write(w, http.StatusUnprocessableEntity, problem.CodeMappingFailed, "Delivery doesn't match the mapping: "+err.Error())
+			return
+		}
+
+		delivery := ingestDelivery{Responses: len(responses)}
+		for _, response := range responses {
+			if response.SourceID == "" {
+				response.SourceID = m.ID.String()
+			}
+			created, _, err := store.store(r.Context(), m.SourceType, response, false, "")
+			if err != nil {
+				writeConnectorError(w, r, err)
+				return
+			}
+			delivery.Created += created
+		}
+		logger.Info("ingest delivery received", "mapping_id", id, "source_type", m.SourceType, "responses", delivery.Responses, "created", delivery.Created)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(delivery)
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-ingest-mapping",
+		Method:      "POST",
+		Path:        "/v1/ingest-mappings",
+		Summary:     "Create an ingest mapping",
+		Description: "Creates a mapping that turns the webhook deliveries of any tool into experiences, so a new source can be onboarded without a connector. The tool posts JSON to the ingest_path of the mapping, authenticated with the secret as bearer token (or token query parameter), or with an HMAC-SHA256 signature of the body in signature_header. The template's JSONPath expressions pick the response ID, source, timestamp, respondent, and fields out of each delivery. The secret is only returned in this response.",
+		Tags:        []string{"Ingest Mappings"},
+	}, func(ctx context.Context, input *CreateIngestMappingInput) (*IngestMappingOutput, error) {
+		if err := validateTemplate(&input.Body.Template); err != nil {
+			return nil, err
+		}
+
+		secret := input.Body.Secret
+		if secret == "" {
+			var err error
+			if secret, err = generateWebhookSecret(); err != nil {
+				logger.Error("failed to create ingest mapping", "error", err)
+				return nil, huma.Error500InternalServerError("Failed to generate ingest secret")
+			}
+		}
+
+		create := client.IngestMapping.Create().
+			SetName(input.Body.Name).
+			SetSourceType(input.Body.SourceType).
+			SetTemplate(input.Body.Template).
+			SetSecret(secret).
+			SetSignatureHeader(input.Body.SignatureHeader)
+		if input.Body.Enabled != nil {
+			create.SetEnabled(*input.Body.Enabled)
+		}
+
+		m, err := create.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "create", "ingest mapping")
+		}
+
+		logger.Info("ingest mapping created", "id", m.ID, "name", m.Name, "source_type", m.SourceType)
+
+		item := ingestMappingToItem(m)
+		item.Secret = secret
+		return &IngestMappingOutput{Body: item}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-ingest-mappings",
+		Method:      "GET",
+		Path:        "/v1/ingest-mappings",
+		Summary:     "List ingest mappings",
+		Description: "Lists all ingest mappings. Secrets are not included.",
+		Tags:        []string{"Ingest Mappings"},
+	}, func(ctx context.Context, input *struct{}) (*ListIngestMappingsOutput, error) {
+		mappings, err := client.IngestMapping.Query().
+			Order(ent.Asc(ingestmapping.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "ingest mappings")
+		}
+
+		output := &ListIngestMappingsOutput{}
+		output.Body.Data = make([]IngestMappingItem, len(mappings))
+		for i, m := range mappings {
+			output.Body.Data[i] = ingestMappingToItem(m)
+		}
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-ingest-mapping",
+		Method:      "GET",
+		Path:        "/v1/ingest-mappings/{id}",
+		Summary:     "Get an ingest mapping",
+		Description: "Retrieves a single ingest mapping. The secret is not included.",
+		Tags:        []string{"Ingest Mappings"},
+	}, func(ctx context.Context, input *IngestMappingIDInput) (*IngestMappingOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := client.IngestMapping.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		return &IngestMappingOutput{Body: ingestMappingToItem(m)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-ingest-mapping",
+		Method:      "PATCH",
+		Path:        "/v1/ingest-mappings/{id}",
+		Summary:     "Update an ingest mapping",
+		Description: "Updates the name, source type, template, secret, signature header, or enabled flag of an ingest mapping. Only provided fields are changed; changes apply to the next delivery. Experiences stored before are not changed.",
+		Tags:        []string{"Ingest Mappings"},
+	}, func(ctx context.Context, input *UpdateIngestMappingInput) (*IngestMappingOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		body := input.Body
+		update := client.IngestMapping.UpdateOneID(id)
+		if body.Name != nil {
+			update.SetName(*body.Name)
+		}
+		if body.SourceType != nil {
+			update.SetSourceType(*body.SourceType)
+		}
+		if body.Template != nil {
+			if err := validateTemplate(body.Template); err != nil {
+				return nil, err
+			}
+			update.SetTemplate(*body.Template)
+		}
+		if body.Secret != nil {
+			update.SetSecret(*body.Secret)
+		}
+		if body.SignatureHeader != nil {
+			update.SetSignatureHeader(*body.SignatureHeader)
+		}
+		if body.Enabled != nil {
+			update.SetEnabled(*body.Enabled)
+		}
+
+		m, err := update.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "update", id.String())
+		}
+
+		logger.Info("ingest mapping updated", "id", id)
+		return &IngestMappingOutput{Body: ingestMappingToItem(m)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-ingest-mapping",
+		Method:      "DELETE",
+		Path:        "/v1/ingest-mappings/{id}",
+		Summary:     "Delete an ingest mapping",
+		Description: "Deletes an ingest mapping; its ingest path rejects deliveries from then on. Experiences stored from its deliveries are kept.",
+		Tags:        []string{"Ingest Mappings"},
+	}, func(ctx context.Context, input *IngestMappingIDInput) (*struct{}, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := client.IngestMapping.DeleteOneID(id).Exec(ctx); err != nil {
+			return nil, handleDatabaseError(logger, err, "delete", id.String())
+		}
+
+		logger.Info("ingest mapping deleted", "id", id)
+		return &struct{}{}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "preview-ingest-mapping",
+		Method:      "POST",
+		Path:        "/v1/ingest-mappings/preview",
+		Summary:     "Preview an ingest mapping",
+		Description: "Maps a sample delivery with a template and returns the responses and experiences it would create, without storing anything, so a template can be tried out before it's saved.",
+		Tags:        []string{"Ingest Mappings"},
+	}, func(ctx context.Context, input *PreviewIngestMappingInput) (*PreviewIngestMappingOutput, error) {
+		if err := validateTemplate(&input.Body.Template); err != nil {
+			return nil, err
+		}
+
+		responses, err := input.Body.Template.Map(input.Body.Payload, time.Now())
+		if err != nil {
+			return nil, problem.New(http.StatusUnprocessableEntity, problem.CodeMappingFailed, "Payload doesn't match the template: "+err.Error())
+		}
+
+		output := &PreviewIngestMappingOutput{}
+		output.Body.Data = make([]MappedResponse, len(responses))
+		for i, response := range responses {
+			output.Body.Data[i] = mappedResponse(response)
+		}
+		return output, nil
+	})
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector/mapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+)
+
+func TestIngestMappings(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	template := map[string]interface{}{
+		"response_id": "$.response.id",
+		"source_id":   "$.survey.id",
+		"fields": []map[string]interface{}{{
+			"each":       "$.response.answers",
+			"field_id":   "@.question_id",
+			"field_type": "@.type",
+			"value":      "@.value",
+		}},
+	}
+	resp := api.Post("/v1/ingest-mappings", map[string]interface{}{
+		"name":        "Survey tool",
+		"source_type": "survey_tool",
+		"template":    template,
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var m IngestMappingItem
+	if err := json.Unmarshal(resp.Body.Bytes(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Secret == "" || m.IngestPath != "/v1/ingest/"+m.ID.String() || !m.Enabled {
+		t.Fatalf("unexpected mapping: %+v", m)
+	}
+
+	body := []byte(`{"survey": {"id": "srv_42"}, "response": {"id": "rsp_1", "answers": [
+		{"question_id": "q_nps", "type": "nps", "value": 9},
+		{"question_id": "q_why", "type": "text", "value": "Fast exports"}
+	]}}`)
+	auth := "Authorization: Bearer " + m.Secret
+	resp = api.Post(m.IngestPath, auth, bytes.NewReader(body))
+	if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"created":2`) {
+		t.Fatalf("expected both answers to be created, got %d: %s", resp.Code, resp.Body.String())
+	}
+	experiences := client.ExperienceData.Query().Where(experiencedata.SourceTypeEQ("survey_tool")).AllX(context.Background())
+	for _, exp := range experiences {
+		if exp.SourceID != "srv_42" {
+			t.Errorf("unexpected experience: %+v", exp)
+		}
+	}
+
+	t.Run("redelivery", func(t *testing.T) {
+		resp := api.Post(m.IngestPath+"?token="+m.Secret, bytes.NewReader(body))
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"created":0`) {
+			t.Errorf("expected the response to be stored already, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		resp := api.Post(m.IngestPath, "Authorization: Bearer wrong", bytes.NewReader(body))
+		if resp.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", resp.Code)
+		}
+	})
+
+	t.Run("signature", func(t *testing.T) {
+		resp := api.Patch("/v1/ingest-mappings/"+m.ID.String(), map[string]interface{}{"signature_header": "X-Signature"})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		resp = api.Post(m.IngestPath, auth, bytes.NewReader(body))
+		if resp.Code != http.StatusUnauthorized {
+			t.Errorf("expected the token to be rejected, got %d", resp.Code)
+		}
+		resp = api.Post(m.IngestPath, "X-Signature: sha256="+mapping.Sign(m.Secret, body), bytes.NewReader(body))
+		if resp.Code != http.StatusOK {
+			t.Errorf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("mismatched delivery", func(t *testing.T) {
+		body := []byte(`{"response": {"answers": [{"question_id": "q_nps", "type": "nps", "value": "great"}]}}`)
+		resp := api.Post(m.IngestPath, "X-Signature: "+mapping.Sign(m.Secret, body), bytes.NewReader(body))
+		if resp.Code != http.StatusUnprocessableEntity || !strings.Contains(resp.Body.String(), "mapping_failed") {
+			t.Errorf("expected mapping_failed, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		resp := api.Post("/v1/ingest-mappings", map[string]interface{}{
+			"name":        "Broken",
+			"source_type": "survey_tool",
+			"template": map[string]interface{}{
+				"fields": []map[string]interface{}{{"field_id": "q", "field_type": "essay", "value": "$.value"}},
+			},
+		})
+		if resp.Code != http.StatusBadRequest || !strings.Contains(resp.Body.String(), "invalid_mapping") {
+			t.Errorf("expected invalid_mapping, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("preview", func(t *testing.T) {
+		resp := api.Post("/v1/ingest-mappings/preview", map[string]interface{}{
+			"template": template,
+			"payload":  json.RawMessage(body),
+		})
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"response_id":"rsp_1"`) {
+			t.Errorf("expected the mapped response, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("unknown mapping", func(t *testing.T) {
+		resp := api.Post("/v1/ingest/"+uuid.New().String(), auth, bytes.NewReader(body))
+		if resp.Code != http.StatusNotFound || !strings.Contains(resp.Body.String(), "mapping_not_found") {
+			t.Errorf("expected mapping_not_found, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+}
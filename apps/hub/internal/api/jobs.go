@@ -0,0 +1,391 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+// JobItem represents an AI job in API responses
+type JobItem struct {
+	ID               uuid.UUID         `json:"id" doc:"Job ID"`
+	ExperienceID     uuid.UUID         `json:"experience_id" doc:"Experience the job belongs to"`
+	JobType          string            `json:"job_type" doc:"Job type (e.g., enrichment, embedding)"`
+	Status           string            `json:"status" doc:"Job status"`
+	Priority         int               `json:"priority" doc:"Queue priority (-10 low, 0 normal, 10 high)"`
+	Attempts         int               `json:"attempts" doc:"Number of processing attempts"`
+	Requeues         int               `json:"requeues" doc:"Times the job was put back in the queue without counting as an attempt, e.g. after a rate limit"`
+	Error            *string           `json:"error,omitempty" doc:"Error of the last failed attempt"`
+	ErrorHistory     []schema.JobError `json:"error_history,omitempty" doc:"Errors of all failed attempts, oldest first"`
+	Text             string            `json:"text,omitempty" doc:"Text sent to the AI provider (only included when getting a single job)"`
+	PromptTokens     *int              `json:"prompt_tokens,omitempty" doc:"Prompt (input) tokens used by the AI request"`
+	CompletionTokens *int              `json:"completion_tokens,omitempty" doc:"Completion (output) tokens used by the AI request"`
+	CostUSD          *float64          `json:"cost_usd,omitempty" doc:"Estimated cost of the AI request in USD"`
+	CreatedAt        time.Time         `json:"created_at" doc:"When the job was enqueued"`
+	LeaseExpiresAt   *time.Time        `json:"lease_expires_at,omitempty" doc:"When a processing job is returned to the queue if it isn't finished"`
+	ProcessedAt      *time.Time        `json:"processed_at,omitempty" doc:"When the job was completed, dead-lettered, or cancelled"`
+}
+
+// ListJobsInput defines the input for listing jobs
+type ListJobsInput struct {
+	Status       string `query:"status" enum:"pending,processing,completed,dead_letter,failed,cancelled" doc:"Filter by status"`
+	JobType      string `query:"job_type" doc:"Filter by job type (e.g., enrichment, embedding)"`
+	ExperienceID string `query:"experience_id" doc:"Filter by experience ID (UUID)"`
+	Limit        int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset       int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+}
+
+// ListJobsOutput represents the output for listing jobs
+type ListJobsOutput struct {
+	Body struct {
+		Data   []JobItem `json:"data" doc:"Jobs, newest first"`
+		Total  int       `json:"total" doc:"Total count of jobs matching filters"`
+		Limit  int       `json:"limit" doc:"Limit used in query"`
+		Offset int       `json:"offset" doc:"Offset used in query"`
+	}
+}
+
+// JobIDInput identifies a single job
+type JobIDInput struct {
+	ID string `path:"id" doc:"Job ID (UUID)" format:"uuid"`
+}
+
+// JobOutput represents the output for a single job
+type JobOutput struct {
+	Body JobItem
+}
+
+// ListDeadLetterJobsInput defines the input for listing dead-letter jobs
+type ListDeadLetterJobsInput struct {
+	JobType string `query:"job_type" doc:"Filter by job type (e.g., enrichment, embedding)"`
+	Limit   int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset  int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+}
+
+// ListDeadLetterJobsOutput represents the output for listing dead-letter jobs
+type ListDeadLetterJobsOutput struct {
+	Body struct {
+		Data   []JobItem `json:"data" doc:"Dead-letter jobs, newest first"`
+		Total  int       `json:"total" doc:"Total count of dead-letter jobs matching filters"`
+		Limit  int       `json:"limit" doc:"Limit used in query"`
+		Offset int       `json:"offset" doc:"Offset used in query"`
+	}
+}
+
+// DeadLetterActionInput selects dead-letter jobs for a bulk action
+type DeadLetterActionInput struct {
+	Body struct {
+		IDs     []string `json:"ids,omitempty" doc:"IDs of the jobs to act on" maxItems:"1000"`
+		All     bool     `json:"all,omitempty" doc:"Act on all dead-letter jobs (optionally filtered by job_type) instead of the given IDs"`
+		JobType string   `json:"job_type,omitempty" doc:"Only act on jobs of this type (e.g., enrichment, embedding)"`
+	}
+}
+
+// DeadLetterActionOutput reports the result of a bulk action
+type DeadLetterActionOutput struct {
+	Body struct {
+		Count int `json:"count" doc:"Number of jobs affected"`
+	}
+}
+
+// jobToItem converts an Ent entity to the API response type
+func jobToItem(job *ent.EnrichmentJob) JobItem {
+	return JobItem{
+		ID:               job.ID,
+		ExperienceID:     job.ExperienceID,
+		JobType:          job.JobType,
+		Status:           job.Status,
+		Priority:         job.Priority,
+		Attempts:         job.Attempts,
+		Requeues:         job.Requeues,
+		Error:            job.Error,
+		ErrorHistory:     job.ErrorHistory,
+		PromptTokens:     job.PromptTokens,
+		CompletionTokens: job.CompletionTokens,
+		CostUSD:          job.CostUsd,
+		CreatedAt:        job.CreatedAt,
+		LeaseExpiresAt:   job.LeaseExpiresAt,
+		ProcessedAt:      job.ProcessedAt,
+	}
+}
+
+// transitionJob moves a single job from one of the allowed statuses to a new state.
+// Returns 404 if the job doesn't exist and 409 if it isn't in an allowed status.
+func transitionJob(ctx context.Context, client *ent.Client, logger *slog.Logger, input *JobIDInput, from []string, apply func(*ent.EnrichmentJobUpdateOne)) (*JobOutput, error) {
+	id, err := parseUUID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := client.EnrichmentJob.Get(ctx, id)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "get", id.String())
+	}
+	if !slices.Contains(from, job.Status) {
+		return nil, problem.New(http.StatusConflict, problem.CodeInvalidJobStatus, fmt.Sprintf("Job is %s; only %s jobs can be changed by this action", job.Status, strings.Join(from, " or ")))
+	}
+
+	// The status check is repeated in the update in case a worker changed the job meanwhile
+	update := client.EnrichmentJob.UpdateOneID(id).Where(enrichmentjob.StatusIn(from...))
+	apply(update)
+	job, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, huma.Error409Conflict("Job status changed while processing the request; fetch it and try again")
+		}
+		return nil, handleDatabaseError(logger, err, "update", id.String())
+	}
+
+	return &JobOutput{Body: jobToItem(job)}, nil
+}
+
+// deadLetterPredicates builds the filter for a bulk action on dead-letter jobs
+func deadLetterPredicates(input *DeadLetterActionInput) ([]predicate.EnrichmentJob, error) {
+	if len(input.Body.IDs) == 0 && !input.Body.All {
+		return nil, huma.Error400BadRequest("Provide job IDs or set 'all' to true")
+	}
+
+	predicates := []predicate.EnrichmentJob{enrichmentjob.StatusIn(queue.DeadLetterStatuses...)}
+	if len(input.Body.IDs) > 0 {
+		ids := make([]uuid.UUID, len(input.Body.IDs))
+		for i, id := range input.Body.IDs {
+			parsed, err := parseUUID(id)
+			if err != nil {
+				return nil, err
+			}
+			ids[i] = parsed
+		}
+		predicates = append(predicates, enrichmentjob.IDIn(ids...))
+	}
+	if input.Body.JobType != "" {
+		predicates = append(predicates, enrichmentjob.JobType(input.Body.JobType))
+	}
+	return predicates, nil
+}
+
+// RegisterJobRoutes registers routes for inspecting and managing AI jobs
+func RegisterJobRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-jobs",
+		Method:      "GET",
+		Path:        "/v1/jobs",
+		Summary:     "List AI jobs",
+		Description: "Lists enrichment and embedding jobs with optional filters, newest first.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *ListJobsInput) (*ListJobsOutput, error) {
+		query := client.EnrichmentJob.Query()
+		if input.Status != "" {
+			query = query.Where(enrichmentjob.Status(input.Status))
+		}
+		if input.JobType != "" {
+			query = query.Where(enrichmentjob.JobType(input.JobType))
+		}
+		if input.ExperienceID != "" {
+			experienceID, err := parseUUID(input.ExperienceID)
+			if err != nil {
+				return nil, err
+			}
+			query = query.Where(enrichmentjob.ExperienceID(experienceID))
+		}
+
+		total, err := query.Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "jobs")
+		}
+
+		jobs, err := query.
+			Order(ent.Desc(enrichmentjob.FieldCreatedAt)).
+			Limit(input.Limit).
+			Offset(input.Offset).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "jobs")
+		}
+
+		output := &ListJobsOutput{}
+		output.Body.Data = make([]JobItem, len(jobs))
+		for i, job := range jobs {
+			output.Body.Data[i] = jobToItem(job)
+		}
+		output.Body.Total = total
+		output.Body.Limit = input.Limit
+		output.Body.Offset = input.Offset
+
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-job",
+		Method:      "GET",
+		Path:        "/v1/jobs/{id}",
+		Summary:     "Get an AI job",
+		Description: "Retrieves a single job including its text, attempts, and the errors of all failed attempts.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *JobIDInput) (*JobOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		job, err := client.EnrichmentJob.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+
+		item := jobToItem(job)
+		item.Text = job.Text
+		return &JobOutput{Body: item}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "cancel-job",
+		Method:      "POST",
+		Path:        "/v1/jobs/{id}/cancel",
+		Summary:     "Cancel a pending AI job",
+		Description: "Cancels a job that hasn't been picked up by a worker yet. The job is kept with status cancelled.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *JobIDInput) (*JobOutput, error) {
+		output, err := transitionJob(ctx, client, logger, input, []string{"pending"}, func(update *ent.EnrichmentJobUpdateOne) {
+			update.
+				SetStatus("cancelled").
+				SetProcessedAt(time.Now())
+		})
+		if err == nil {
+			logger.Info("job cancelled", "job_id", input.ID)
+		}
+		return output, err
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "retry-job",
+		Method:      "POST",
+		Path:        "/v1/jobs/{id}/retry",
+		Summary:     "Retry a failed or cancelled AI job",
+		Description: "Puts a dead-letter or cancelled job back in the queue with a fresh set of attempts. Its error history is kept.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *JobIDInput) (*JobOutput, error) {
+		output, err := transitionJob(ctx, client, logger, input, append(slices.Clone(queue.DeadLetterStatuses), "cancelled"), func(update *ent.EnrichmentJobUpdateOne) {
+			update.
+				SetStatus("pending").
+				SetAttempts(0).
+				SetRequeues(0).
+				ClearProcessedAt()
+		})
+		if err == nil {
+			logger.Info("job requeued for retry", "job_id", input.ID)
+		}
+		return output, err
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-dead-letter-jobs",
+		Method:      "GET",
+		Path:        "/v1/jobs/dead-letter",
+		Summary:     "List dead-letter jobs",
+		Description: "Lists enrichment and embedding jobs that failed on every attempt (see SERVICE_JOB_MAX_ATTEMPTS), together with the errors of all attempts.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *ListDeadLetterJobsInput) (*ListDeadLetterJobsOutput, error) {
+		query := client.EnrichmentJob.Query().
+			Where(enrichmentjob.StatusIn(queue.DeadLetterStatuses...))
+		if input.JobType != "" {
+			query = query.Where(enrichmentjob.JobType(input.JobType))
+		}
+
+		total, err := query.Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "dead-letter jobs")
+		}
+
+		jobs, err := query.
+			Order(ent.Desc(enrichmentjob.FieldCreatedAt)).
+			Limit(input.Limit).
+			Offset(input.Offset).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "dead-letter jobs")
+		}
+
+		output := &ListDeadLetterJobsOutput{}
+		output.Body.Data = make([]JobItem, len(jobs))
+		for i, job := range jobs {
+			output.Body.Data[i] = jobToItem(job)
+		}
+		output.Body.Total = total
+		output.Body.Limit = input.Limit
+		output.Body.Offset = input.Offset
+
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "requeue-dead-letter-jobs",
+		Method:      "POST",
+		Path:        "/v1/jobs/dead-letter/requeue",
+		Summary:     "Requeue dead-letter jobs",
+		Description: "Puts the selected dead-letter jobs back in the queue with a fresh set of attempts. Their error history is kept.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *DeadLetterActionInput) (*DeadLetterActionOutput, error) {
+		predicates, err := deadLetterPredicates(input)
+		if err != nil {
+			return nil, err
+		}
+
+		count, err := client.EnrichmentJob.Update().
+			Where(predicates...).
+			SetStatus("pending").
+			SetAttempts(0).
+			SetRequeues(0).
+			ClearProcessedAt().
+			Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "requeue", "dead-letter jobs")
+		}
+
+		logger.Info("requeued dead-letter jobs", "count", count)
+
+		output := &DeadLetterActionOutput{}
+		output.Body.Count = count
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "discard-dead-letter-jobs",
+		Method:      "POST",
+		Path:        "/v1/jobs/dead-letter/discard",
+		Summary:     "Discard dead-letter jobs",
+		Description: "Permanently deletes the selected dead-letter jobs. The experiences they belong to are not affected.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *DeadLetterActionInput) (*DeadLetterActionOutput, error) {
+		predicates, err := deadLetterPredicates(input)
+		if err != nil {
+			return nil, err
+		}
+
+		count, err := client.EnrichmentJob.Delete().
+			Where(predicates...).
+			Exec(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "discard", "dead-letter jobs")
+		}
+
+		logger.Info("discarded dead-letter jobs", "count", count)
+
+		output := &DeadLetterActionOutput{}
+		output.Body.Count = count
+		return output, nil
+	})
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+// listen opens the configured listeners, closing those already opened if one fails
+func listen(cfg *config.Config) ([]net.Listener, error) {
+	addresses, err := cfg.GetListeners()
+	if err != nil {
+		return nil, err
+	}
+	var mode os.FileMode
+	for _, address := range addresses {
+		if address.Network == "unix" {
+			if mode, err = cfg.GetUnixSocketMode(); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	listeners := make([]net.Listener, 0, len(addresses))
+	for _, address := range addresses {
+		ln, err := listenOn(address, mode)
+		if err != nil {
+			for _, opened := range listeners {
+				_ = opened.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, ln)
+	}
+	return listeners, nil
+}
+
+// listenOn opens a listener. Unix domain sockets get the mode, and a stale socket left by a
+// process that didn't shut down cleanly is replaced. Closing the listener removes the socket.
+func listenOn(address config.Listener, mode os.FileMode) (net.Listener, error) {
+	if address.Network == "unix" {
+		if err := removeStaleSocket(address.Address); err != nil {
+			return nil, err
+		}
+	}
+
+	ln, err := net.Listen(address.Network, address.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", address.Address, err)
+	}
+	if address.Network == "unix" {
+		if err := os.Chmod(address.Address, mode); err != nil {
+			_ = ln.Close()
+			return nil, fmt.Errorf("failed to set the permissions of %s: %w", address.Address, err)
+		}
+	}
+	return ln, nil
+}
+
+// removeStaleSocket removes a Unix domain socket nothing listens on anymore
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", path, err)
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("failed to listen on %s: the file exists and isn't a socket", path)
+	}
+
+	// A socket that accepts connections belongs to a running process
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to listen on %s: another process is listening on the socket", path)
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,482 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-chi/chi/v5"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+	custommiddleware "github.com/formbricks/hub/apps/hub/internal/middleware"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/usage"
+)
+
+// Limits of the MCP tools. They're lower than the REST API's, since every result ends up in
+// the context window of the assistant.
+const (
+	defaultMCPLimit    = 10
+	maxMCPLimit        = 50
+	maxMCPTopics       = 50
+	maxMCPQuotes       = 20
+	maxMCPKeywords     = 10
+	mcpQuoteCharacters = 500
+)
+
+// Modes of search_experiences
+const (
+	searchModeSemantic = "semantic"
+	searchModeKeyword  = "keyword"
+)
+
+// mcpSearchArgs are the arguments of the search_experiences tool
+type mcpSearchArgs struct {
+	Query      string `json:"query" jsonschema:"What to look for in natural language, e.g. complaints about pricing"`
+	Limit      int    `json:"limit,omitempty" jsonschema:"Maximum number of results, 1 to 50 (default 10)"`
+	SourceType string `json:"source_type,omitempty" jsonschema:"Only experiences from this source type, e.g. survey or review"`
+	Since      string `json:"since,omitempty" jsonschema:"Only experiences collected at or after this time (RFC 3339, e.g. 2024-01-01T00:00:00Z)"`
+	Until      string `json:"until,omitempty" jsonschema:"Only experiences collected at or before this time (RFC 3339)"`
+}
+
+// mcpSearchResult is an experience found by search_experiences
+type mcpSearchResult struct {
+	ExperienceData
+	SimilarityScore *float64 `json:"similarity_score,omitempty"`
+}
+
+// mcpSearchOutput is the result of the search_experiences tool
+type mcpSearchOutput struct {
+	Mode    string            `json:"mode"`
+	Results []mcpSearchResult `json:"results"`
+}
+
+// mcpListArgs are the arguments of the list_experiences tool
+type mcpListArgs struct {
+	SourceType     string  `json:"source_type,omitempty" jsonschema:"Only experiences from this source type, e.g. survey or review"`
+	SourceID       string  `json:"source_id,omitempty" jsonschema:"Only experiences from this source, e.g. the ID of a survey"`
+	FieldType      string  `json:"field_type,omitempty" jsonschema:"Only answers of this type: text, categorical, nps, csat, rating, number, boolean, or date"`
+	QuestionID     string  `json:"question_id,omitempty" jsonschema:"Only answers to this question (UUID)"`
+	UserIdentifier string  `json:"user_identifier,omitempty" jsonschema:"Only experiences of this user"`
+	NPSCategory    string  `json:"nps_category,omitempty" jsonschema:"Only nps answers of this category: promoter, passive, or detractor"`
+	Sentiment      string  `json:"sentiment,omitempty" jsonschema:"Only experiences with this sentiment: positive, neutral, or negative"`
+	MinUrgency     float64 `json:"min_urgency,omitempty" jsonschema:"Only experiences with an urgency score of at least this, 0 to 1"`
+	UrgencyReason  string  `json:"urgency_reason,omitempty" jsonschema:"Only experiences flagged for this reason: churn_risk, bug_report, legal_threat, security_issue, billing_issue, or outage"`
+	Country        string  `json:"country,omitempty" jsonschema:"Only experiences from this country"`
+	Since          string  `json:"since,omitempty" jsonschema:"Only experiences collected at or after this time (RFC 3339, e.g. 2024-01-01T00:00:00Z)"`
+	Until          string  `json:"until,omitempty" jsonschema:"Only experiences collected at or before this time (RFC 3339)"`
+	SegmentID      string  `json:"segment_id,omitempty" jsonschema:"Only experiences of this saved segment (UUID)"`
+	Limit          int     `json:"limit,omitempty" jsonschema:"Maximum number of experiences, 1 to 50 (default 10)"`
+	Offset         int     `json:"offset,omitempty" jsonschema:"Number of experiences to skip, for the next page"`
+}
+
+// mcpListOutput is the result of the list_experiences tool
+type mcpListOutput struct {
+	Total       int              `json:"total"`
+	Experiences []ExperienceData `json:"experiences"`
+}
+
+// mcpGetArgs are the arguments of the get_experience tool
+type mcpGetArgs struct {
+	ID string `json:"id" jsonschema:"ID of the experience (UUID)"`
+}
+
+// mcpSummaryArgs are the arguments of the summarize_feedback tool
+type mcpSummaryArgs struct {
+	SourceType string `json:"source_type,omitempty" jsonschema:"Only experiences from this source type, e.g. survey or review"`
+	SourceID   string `json:"source_id,omitempty" jsonschema:"Only experiences from this source, e.g. the ID of a survey"`
+	FieldID    string `json:"field_id,omitempty" jsonschema:"Only answers to this field of the source"`
+	QuestionID string `json:"question_id,omitempty" jsonschema:"Only answers to this question (UUID)"`
+	Since      string `json:"since,omitempty" jsonschema:"Only experiences collected at or after this time (RFC 3339, e.g. 2024-01-01T00:00:00Z)"`
+	Until      string `json:"until,omitempty" jsonschema:"Only experiences collected at or before this time (RFC 3339)"`
+	SegmentID  string `json:"segment_id,omitempty" jsonschema:"Only experiences of this saved segment (UUID)"`
+	Topics     int    `json:"topics,omitempty" jsonschema:"Number of most frequent topics, 1 to 50 (default 10)"`
+	Quotes     int    `json:"quotes,omitempty" jsonschema:"Number of quotes of each kind (most urgent, most negative, most positive), 1 to 20 (default 5)"`
+}
+
+// mcpQuote is a text answer quoted in a summary
+type mcpQuote struct {
+	ID           string    `json:"id"`
+	Text         string    `json:"text"`
+	SourceType   string    `json:"source_type"`
+	CollectedAt  time.Time `json:"collected_at"`
+	Sentiment    *string   `json:"sentiment,omitempty"`
+	UrgencyScore *float64  `json:"urgency_score,omitempty"`
+	Topics       []string  `json:"topics,omitempty"`
+}
+
+// mcpSummaryOutput is the result of the summarize_feedback tool
+type mcpSummaryOutput struct {
+	Responses int                `json:"responses"`
+	Urgent    int                `json:"urgent"`
+	NPS       NPSBreakdown       `json:"nps"`
+	Sentiment SentimentBreakdown `json:"sentiment"`
+	Topics    []TopicCount       `json:"topics"`
+	Quotes    struct {
+		Urgent   []mcpQuote `json:"urgent"`
+		Negative []mcpQuote `json:"negative"`
+		Positive []mcpQuote `json:"positive"`
+	} `json:"quotes"`
+	Excluded *Exclusions `json:"excluded,omitempty"`
+}
+
+// NewMCPServer returns the MCP (Model Context Protocol) server, whose read-only tools let AI
+// assistants and agents search and summarize the feedback. Experiences are read from reader,
+// which may be a read replica, and the AI usage of searches is recorded with client.
+func NewMCPServer(cfg *config.Config, client *ent.Client, reader *ent.Client, logger *slog.Logger) *mcp.Server {
+	usageRecorder := usage.NewRecorder(client, logger)
+	server := mcp.NewServer(&mcp.Implementation{Name: "formbricks-hub", Title: "Formbricks Hub", Version: "1.0.0"}, &mcp.ServerOptions{
+		Instructions: "Formbricks Hub stores customer feedback as experiences: survey answers, app reviews, " +
+			"support tickets and other responses, each an answer to one question (field). Text answers are " +
+			"enriched with sentiment, topics and an urgency score. Use summarize_feedback for an overview, " +
+			"search_experiences to find feedback about a subject, and list_experiences or get_experience " +
+			"for the details. Quote the feedback you base conclusions on.",
+		Logger: logger,
+	})
+	readOnly := &mcp.ToolAnnotations{ReadOnlyHint: true, OpenWorldHint: new(bool)}
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:  "search_experiences",
+		Title: "Search feedback",
+		Description: "Finds the feedback about a subject. Searches the text answers by meaning if embeddings are " +
+			"enabled (mode semantic, most similar first), otherwise for answers containing all words of the " +
+			"query (mode keyword, most recent first).",
+		Annotations: readOnly,
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args mcpSearchArgs) (*mcp.CallToolResult, any, error) {
+		limit, err := mcpLimit(args.Limit, defaultMCPLimit, maxMCPLimit)
+		if err != nil {
+			return nil, nil, err
+		}
+		if strings.TrimSpace(args.Query) == "" {
+			return nil, nil, problem.New(http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"query must not be empty")
+		}
+
+		output := mcpSearchOutput{Results: []mcpSearchResult{}}
+		if cfg.IsEmbeddingEnabled() {
+			output.Mode = searchModeSemantic
+			results, err := semanticSearch(ctx, cfg, reader, usageRecorder, logger, &SearchInput{
+				Query:      args.Query,
+				Limit:      limit,
+				SourceType: args.SourceType,
+				Since:      args.Since,
+				Until:      args.Until,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, result := range results {
+				output.Results = append(output.Results, mcpSearchResult{ExperienceData: result.ExperienceData, SimilarityScore: &result.SimilarityScore})
+			}
+			return nil, output, nil
+		}
+
+		output.Mode = searchModeKeyword
+		experiences, err := keywordSearch(ctx, reader, logger, args, limit)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, exp := range experiences {
+			output.Results = append(output.Results, mcpSearchResult{ExperienceData: entityToOutput(exp)})
+		}
+		return nil, output, nil
+	})
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "list_experiences",
+		Title:       "List feedback",
+		Description: "Lists the experiences matching the filters, most recently collected first, with the total number of matches.",
+		Annotations: readOnly,
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args mcpListArgs) (*mcp.CallToolResult, any, error) {
+		limit, err := mcpLimit(args.Limit, defaultMCPLimit, maxMCPLimit)
+		if err != nil {
+			return nil, nil, err
+		}
+		if args.Offset < 0 {
+			return nil, nil, problem.New(http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"offset must not be negative")
+		}
+		query, err := filterExperiences(reader.ExperienceData.Query(), experiencefilter.Filter{
+			SourceType:     args.SourceType,
+			SourceID:       args.SourceID,
+			FieldType:      args.FieldType,
+			QuestionID:     args.QuestionID,
+			UserIdentifier: args.UserIdentifier,
+			NPSCategory:    args.NPSCategory,
+			MinUrgency:     args.MinUrgency,
+			UrgencyReason:  args.UrgencyReason,
+			Country:        args.Country,
+			Since:          args.Since,
+			Until:          args.Until,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if args.Sentiment != "" {
+			query = query.Where(experiencedata.SentimentEQ(args.Sentiment))
+		}
+		if args.SegmentID != "" {
+			segmentFilter, err := loadSegment(ctx, reader, args.SegmentID, logger)
+			if err != nil {
+				return nil, nil, err
+			}
+			if query, err = filterExperiences(query, *segmentFilter); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		total, err := query.Clone().Count(ctx)
+		if err != nil {
+			return nil, nil, handleDatabaseError(logger, err, "count", "experiences")
+		}
+		experiences, err := query.
+			Order(ent.Desc(experiencedata.FieldCollectedAt), ent.Desc(experiencedata.FieldID)).
+			Limit(limit).
+			Offset(args.Offset).
+			All(ctx)
+		if err != nil {
+			return nil, nil, handleDatabaseError(logger, err, "list", "experiences")
+		}
+		output := mcpListOutput{Total: total, Experiences: make([]ExperienceData, len(experiences))}
+		for i, exp := range experiences {
+			output.Experiences[i] = entityToOutput(exp)
+		}
+		return nil, output, nil
+	})
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "get_experience",
+		Title:       "Get feedback",
+		Description: "Returns an experience with all its fields, including the enrichment and translations.",
+		Annotations: readOnly,
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args mcpGetArgs) (*mcp.CallToolResult, any, error) {
+		id, err := parseUUID(args.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		exp, err := reader.ExperienceData.Get(ctx, id)
+		if err != nil {
+			return nil, nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		return nil, entityToOutput(exp), nil
+	})
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:  "summarize_feedback",
+		Title: "Summarize feedback",
+		Description: "Summarizes the feedback matching the filters: the number of responses and of urgent ones, the NPS, " +
+			"the sentiment split, the most frequent topics, and the most urgent, most negative and most positive " +
+			"text answers to quote. Responses flagged as spam or as duplicates aren't counted.",
+		Annotations: readOnly,
+	}, func(ctx context.Context, req *mcp.CallToolRequest, args mcpSummaryArgs) (*mcp.CallToolResult, any, error) {
+		output, err := summarizeFeedback(ctx, cfg, reader, logger, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, output, nil
+	})
+
+	return server
+}
+
+// RegisterMCPRoutes serves the MCP server at /v1/mcp with the streamable HTTP transport,
+// behind the API key like the REST API
+func RegisterMCPRoutes(router chi.Router, cfg *config.Config, client *ent.Client, reader *ent.Client, logger *slog.Logger) {
+	server := NewMCPServer(cfg, client, reader, logger)
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return server }, &mcp.StreamableHTTPOptions{
+		// Each request stands alone, so any replica of the Hub can answer it
+		Stateless:    true,
+		JSONResponse: true,
+		Logger:       logger,
+		// The protection against DNS rebinding rejects requests forwarded by a reverse proxy on
+		// the same host. A web page can't send the API key, so it's only needed without one.
+		DisableLocalhostProtection: cfg.APIKey != "",
+	})
+	router.Group(func(r chi.Router) {
+		if cfg.APIKey != "" {
+			r.Use(custommiddleware.RequireAPIKey(cfg.APIKey))
+		}
+		r.Handle("/v1/mcp", handler)
+	})
+}
+
+// mcpLimit returns the limit argument of a tool, or fallback if it isn't set
+func mcpLimit(limit, fallback, maximum int) (int, error) {
+	if limit == 0 {
+		return fallback, nil
+	}
+	if limit < 0 || limit > maximum {
+		return 0, problem.New(http.StatusBadRequest, problem.CodeBadRequest, ErrMsgInvalidInput+"limits must be between 1 and "+strconv.Itoa(maximum))
+	}
+	return limit, nil
+}
+
+// keywordSearch returns the most recent text answers containing all words of the query,
+// ignoring case. It stands in for semantic search when embeddings aren't enabled.
+func keywordSearch(ctx context.Context, reader *ent.Client, logger *slog.Logger, args mcpSearchArgs, limit int) ([]*ent.ExperienceData, error) {
+	query, err := AnalyticsFilter{
+		SourceType:      args.SourceType,
+		Since:           args.Since,
+		Until:           args.Until,
+		IncludeExcluded: true,
+	}.filter(reader.ExperienceData.Query())
+	if err != nil {
+		return nil, err
+	}
+	query = query.Where(experiencedata.ValueTextNotNil())
+	words := strings.Fields(args.Query)
+	if len(words) > maxMCPKeywords {
+		words = words[:maxMCPKeywords]
+	}
+	for _, word := range words {
+		query = query.Where(experiencedata.ValueTextContainsFold(word))
+	}
+	experiences, err := query.
+		Order(ent.Desc(experiencedata.FieldCollectedAt), ent.Desc(experiencedata.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "keyword search", "query")
+	}
+	return experiences, nil
+}
+
+// summarizeFeedback computes the figures and picks the quotes of summarize_feedback
+func summarizeFeedback(ctx context.Context, cfg *config.Config, reader *ent.Client, logger *slog.Logger, args mcpSummaryArgs) (*mcpSummaryOutput, error) {
+	topics, err := mcpLimit(args.Topics, 10, maxMCPTopics)
+	if err != nil {
+		return nil, err
+	}
+	quotes, err := mcpLimit(args.Quotes, 5, maxMCPQuotes)
+	if err != nil {
+		return nil, err
+	}
+	filter := AnalyticsFilter{
+		SourceType: args.SourceType,
+		SourceID:   args.SourceID,
+		FieldID:    args.FieldID,
+		QuestionID: args.QuestionID,
+		Since:      args.Since,
+		Until:      args.Until,
+		SegmentID:  args.SegmentID,
+	}
+	if err := filter.resolveSegment(ctx, reader, logger); err != nil {
+		return nil, err
+	}
+	base := reader.ExperienceData.Query()
+	query, err := filter.filter(base.Clone())
+	if err != nil {
+		return nil, err
+	}
+
+	urgentScore := float64(cfg.UrgentThreshold) / 100
+	var totals []struct {
+		Responses  int `json:"responses"`
+		Urgent     int `json:"urgent"`
+		Promoters  int `json:"promoters"`
+		Passives   int `json:"passives"`
+		Detractors int `json:"detractors"`
+	}
+	err = query.Clone().
+		Aggregate(
+			ent.As(ent.Count(), "responses"),
+			func(s *sql.Selector) string {
+				return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s >= %g)", s.C(experiencedata.FieldUrgencyScore), urgentScore), "urgent")
+			},
+			countWhere(experiencedata.FieldNpsCategory, models.NPSPromoter, "promoters"),
+			countWhere(experiencedata.FieldNpsCategory, models.NPSPassive, "passives"),
+			countWhere(experiencedata.FieldNpsCategory, models.NPSDetractor, "detractors"),
+		).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "aggregate", "summary")
+	}
+
+	var sentiment []sentimentRow
+	err = query.Clone().
+		Where(experiencedata.SentimentNotNil()).
+		Aggregate(sentimentAggregates()...).
+		Scan(ctx, &sentiment)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "aggregate", "summary sentiment")
+	}
+
+	output := &mcpSummaryOutput{Topics: []TopicCount{}, NPS: npsBreakdown(0, 0, 0)}
+	err = query.Clone().
+		Aggregate(groupByTopic("topic", topics), ent.As(ent.Count(), "count")).
+		Scan(ctx, &output.Topics)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "aggregate", "summary topics")
+	}
+
+	texts := func() *ent.ExperienceDataQuery {
+		return query.Clone().Where(experiencedata.ValueTextNotNil(), experiencedata.ValueTextNEQ("")).Limit(quotes)
+	}
+	if output.Quotes.Urgent, err = mcpQuotes(ctx, logger, texts().
+		Where(experiencedata.UrgencyScoreGTE(urgentScore)).
+		Order(ent.Desc(experiencedata.FieldUrgencyScore), ent.Desc(experiencedata.FieldCollectedAt))); err != nil {
+		return nil, err
+	}
+	if output.Quotes.Negative, err = mcpQuotes(ctx, logger, texts().
+		Where(experiencedata.SentimentEQ("negative")).
+		Order(ent.Asc(experiencedata.FieldSentimentScore), ent.Desc(experiencedata.FieldCollectedAt))); err != nil {
+		return nil, err
+	}
+	if output.Quotes.Positive, err = mcpQuotes(ctx, logger, texts().
+		Where(experiencedata.SentimentEQ("positive")).
+		Order(ent.Desc(experiencedata.FieldSentimentScore), ent.Desc(experiencedata.FieldCollectedAt))); err != nil {
+		return nil, err
+	}
+
+	if output.Excluded, err = countExcluded(ctx, filter, base, logger); err != nil {
+		return nil, err
+	}
+	if len(totals) > 0 {
+		output.Responses = totals[0].Responses
+		output.Urgent = totals[0].Urgent
+		output.NPS = npsBreakdown(totals[0].Promoters, totals[0].Passives, totals[0].Detractors)
+	}
+	if len(sentiment) > 0 {
+		output.Sentiment = sentiment[0].breakdown()
+	}
+	return output, nil
+}
+
+// mcpQuotes returns the text answers of the query as quotes, shortening long ones
+func mcpQuotes(ctx context.Context, logger *slog.Logger, query *ent.ExperienceDataQuery) ([]mcpQuote, error) {
+	experiences, err := query.All(ctx)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "list", "summary quotes")
+	}
+	quotes := make([]mcpQuote, len(experiences))
+	for i, exp := range experiences {
+		quotes[i] = mcpQuote{
+			ID:           exp.ID.String(),
+			Text:         truncateQuote(stringValue(exp.ValueText), mcpQuoteCharacters),
+			SourceType:   exp.SourceType,
+			CollectedAt:  exp.CollectedAt,
+			Sentiment:    exp.Sentiment,
+			UrgencyScore: exp.UrgencyScore,
+			Topics:       exp.Topics,
+		}
+	}
+	return quotes, nil
+}
+
+// truncateQuote shortens text to at most n characters, marking the cut with an ellipsis
+func truncateQuote(text string, n int) string {
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	runes := []rune(text)
+	return strings.TrimSpace(string(runes[:n-1])) + "…"
+}
@@ -0,0 +1,237 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+// connectMCP connects a client to the MCP server
+func connectMCP(t *testing.T, server *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	if err != nil {
+		t.Fatalf("server.Connect() error = %v", err)
+	}
+	t.Cleanup(func() { _ = serverSession.Close() })
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client.Connect() error = %v", err)
+	}
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
+
+// callMCP calls a tool that must succeed and decodes its result into out
+func callMCP(t *testing.T, session *mcp.ClientSession, name string, args map[string]any, out any) {
+	t.Helper()
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: name, Arguments: args})
+	if err != nil {
+		t.Fatalf("CallTool(%s) error = %v", name, err)
+	}
+	if result.IsError {
+		t.Fatalf("%s failed: %+v", name, result.Content)
+	}
+	if err := json.Unmarshal([]byte(mustJSON(t, result.StructuredContent)), out); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMCPTools(t *testing.T) {
+	// Without a database, for the calls that fail before querying it
+	session := connectMCP(t, NewMCPServer(&config.Config{}, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil))))
+	ctx := context.Background()
+
+	tools, err := session.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListTools() error = %v", err)
+	}
+	var names []string
+	for _, tool := range tools.Tools {
+		names = append(names, tool.Name)
+		if tool.Annotations == nil || !tool.Annotations.ReadOnlyHint {
+			t.Errorf("expected %s to be read-only", tool.Name)
+		}
+	}
+	slices.Sort(names)
+	if want := []string{"get_experience", "list_experiences", "search_experiences", "summarize_feedback"}; !slices.Equal(names, want) {
+		t.Errorf("expected tools %v, got %v", want, names)
+	}
+
+	// Invalid arguments are tool errors the assistant can correct, not protocol errors
+	for _, call := range []mcp.CallToolParams{
+		{Name: "get_experience", Arguments: map[string]any{"id": "not-a-uuid"}},
+		{Name: "search_experiences", Arguments: map[string]any{"query": "pricing", "limit": 500}},
+		{Name: "search_experiences", Arguments: map[string]any{"query": "  "}},
+		{Name: "list_experiences", Arguments: map[string]any{"offset": -1}},
+		{Name: "summarize_feedback", Arguments: map[string]any{"quotes": 100}},
+	} {
+		result, err := session.CallTool(ctx, &call)
+		if err != nil {
+			t.Errorf("CallTool(%s) error = %v", call.Name, err)
+			continue
+		}
+		if !result.IsError {
+			t.Errorf("expected %s with %v to fail", call.Name, call.Arguments)
+		}
+	}
+
+	if _, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "get_experience", Arguments: map[string]any{}}); err == nil {
+		t.Error("expected an error without the required id")
+	}
+}
+
+func TestMCPRequests(t *testing.T) {
+	router := chi.NewRouter()
+	RegisterMCPRoutes(router, &config.Config{APIKey: "test-key"}, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	initialize := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"test","version":"1.0.0"}}}`
+	request := func(apiKey string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/mcp", strings.NewReader(initialize))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept", "application/json, text/event-stream")
+		if apiKey != "" {
+			req.Header.Set("X-API-Key", apiKey)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := request(""); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 without the API key, got %d", rec.Code)
+	}
+	rec := request("test-key")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"formbricks-hub"`) {
+		t.Errorf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestTruncateQuote(t *testing.T) {
+	if got := truncateQuote("short", 10); got != "short" {
+		t.Errorf("expected a short text to be kept, got %q", got)
+	}
+	if got := truncateQuote("the checkout keeps failing", 14); got != "the checkout…" {
+		t.Errorf("unexpected truncated text %q", got)
+	}
+	if got := truncateQuote("ääääää", 4); got != "äää…" {
+		t.Errorf("expected to cut at characters, got %q", got)
+	}
+}
+
+func TestMCP(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	collected := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	ids := map[string]string{}
+	for i, text := range []string{"The checkout keeps failing on my phone", "Pricing is too high for small teams", "Love the new dashboard"} {
+		resp := api.Post("/v1/experiences", map[string]interface{}{
+			"source_type":  "survey",
+			"source_id":    "mcp-survey",
+			"field_id":     "feedback",
+			"field_type":   "text",
+			"value_text":   text,
+			"collected_at": collected.Add(time.Duration(i) * time.Hour),
+		})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var exp ExperienceData
+		if err := json.Unmarshal(resp.Body.Bytes(), &exp); err != nil {
+			t.Fatal(err)
+		}
+		ids[text] = exp.ID.String()
+	}
+	resp := api.Post("/v1/experiences", map[string]interface{}{
+		"source_type":  "survey",
+		"source_id":    "mcp-survey",
+		"field_id":     "nps",
+		"field_type":   "nps",
+		"value_number": 10,
+		"collected_at": collected,
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+
+	// Enrich the text answers as the worker would
+	enrich := func(text, sentiment string, score, urgency float64, topics ...string) {
+		t.Helper()
+		err := client.ExperienceData.UpdateOneID(uuid.MustParse(ids[text])).
+			SetSentiment(sentiment).
+			SetSentimentScore(score).
+			SetUrgencyScore(urgency).
+			SetTopics(topics).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	enrich("The checkout keeps failing on my phone", "negative", -0.8, 0.9, "checkout", "mobile")
+	enrich("Pricing is too high for small teams", "negative", -0.4, 0.2, "pricing")
+	enrich("Love the new dashboard", "positive", 0.9, 0, "dashboard")
+
+	session := connectMCP(t, NewMCPServer(&config.Config{UrgentThreshold: 70}, client, client, slog.New(slog.NewTextHandler(io.Discard, nil))))
+
+	t.Run("search", func(t *testing.T) {
+		var out mcpSearchOutput
+		callMCP(t, session, "search_experiences", map[string]any{"query": "CHECKOUT failing"}, &out)
+		if out.Mode != searchModeKeyword || len(out.Results) != 1 || out.Results[0].ID.String() != ids["The checkout keeps failing on my phone"] {
+			t.Errorf("unexpected search result: %+v", out)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		var out mcpListOutput
+		callMCP(t, session, "list_experiences", map[string]any{"source_id": "mcp-survey", "sentiment": "negative", "limit": 1}, &out)
+		if out.Total != 2 || len(out.Experiences) != 1 || out.Experiences[0].ID.String() != ids["Pricing is too high for small teams"] {
+			t.Errorf("unexpected listing: %+v", out)
+		}
+	})
+
+	t.Run("get", func(t *testing.T) {
+		var out ExperienceData
+		callMCP(t, session, "get_experience", map[string]any{"id": ids["Love the new dashboard"]}, &out)
+		if out.ValueText == nil || *out.ValueText != "Love the new dashboard" {
+			t.Errorf("unexpected experience: %+v", out)
+		}
+
+		result, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "get_experience", Arguments: map[string]any{"id": uuid.NewString()}})
+		if err != nil || !result.IsError {
+			t.Errorf("expected a tool error for a missing experience, got %+v, %v", result, err)
+		}
+	})
+
+	t.Run("summarize", func(t *testing.T) {
+		var out mcpSummaryOutput
+		callMCP(t, session, "summarize_feedback", map[string]any{"source_id": "mcp-survey", "quotes": 1}, &out)
+		if out.Responses != 4 || out.Urgent != 1 || out.NPS.Promoters != 1 || out.Sentiment.Negative != 2 || out.Sentiment.Positive != 1 {
+			t.Errorf("unexpected figures: %+v", out)
+		}
+		if len(out.Topics) != 4 {
+			t.Errorf("expected 4 topics, got %+v", out.Topics)
+		}
+		if len(out.Quotes.Urgent) != 1 || out.Quotes.Urgent[0].ID != ids["The checkout keeps failing on my phone"] ||
+			len(out.Quotes.Negative) != 1 || out.Quotes.Negative[0].ID != ids["The checkout keeps failing on my phone"] ||
+			len(out.Quotes.Positive) != 1 || out.Quotes.Positive[0].Text != "Love the new dashboard" {
+			t.Errorf("unexpected quotes: %+v", out.Quotes)
+		}
+	})
+}
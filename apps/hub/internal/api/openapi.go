@@ -11,6 +11,7 @@ import (
 	"github.com/danielgtaylor/huma/v2/adapters/humachi"
 	"github.com/formbricks/hub/apps/hub/internal/config"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
 	"github.com/formbricks/hub/apps/hub/internal/queue"
 	"github.com/formbricks/hub/apps/hub/internal/webhook"
 	"github.com/go-chi/chi/v5"
@@ -52,6 +53,7 @@ func GenerateOpenAPISpec(cfg *config.Config, client *ent.Client, dispatcher *web
 	tempServer := &Server{
 		config:          cfg,
 		client:          client,
+		reader:          client,
 		dispatcher:      dispatcher,
 		enrichmentQueue: enrichmentQueue,
 		logger:          logger,
@@ -90,7 +92,7 @@ func ServeOpenAPISpec(cfg *config.Config, client *ent.Client, dispatcher *webhoo
 		w.Header().Set("Content-Type", "application/json")
 		if err := ExportOpenAPISpec(cfg, client, dispatcher, enrichmentQueue, logger, w); err != nil {
 			logger.Error("failed to serve OpenAPI spec", "error", err)
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			problem.Write(w, http.StatusInternalServerError, problem.CodeInternalError, "Failed to generate the OpenAPI spec")
 		}
 	}
 }
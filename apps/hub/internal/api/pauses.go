@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
+)
+
+// QueuePauseItem represents a paused job type in API responses
+type QueuePauseItem struct {
+	JobType  string    `json:"job_type,omitempty" doc:"Paused job type; omitted if all job types are paused"`
+	Reason   string    `json:"reason,omitempty" doc:"Why the queue was paused"`
+	PausedAt time.Time `json:"paused_at" doc:"When the queue was paused"`
+}
+
+// QueuePausesOutput lists the paused job types
+type QueuePausesOutput struct {
+	Body struct {
+		Data []QueuePauseItem `json:"data" doc:"Paused job types; empty if jobs of all types are dequeued"`
+	}
+}
+
+// PauseQueueBody selects the job type to pause
+type PauseQueueBody struct {
+	JobType string `json:"job_type,omitempty" doc:"Job type to pause (e.g., enrichment, embedding); omit to pause all job types" example:"enrichment"`
+	Reason  string `json:"reason,omitempty" doc:"Why the queue is paused, shown when listing pauses" maxLength:"500" example:"OpenAI outage"`
+}
+
+// PauseQueueInput defines the input for pausing the queue. Without a body, all job types
+// are paused.
+type PauseQueueInput struct {
+	Body *PauseQueueBody
+}
+
+// ResumeQueueBody selects the job type to resume
+type ResumeQueueBody struct {
+	JobType string `json:"job_type,omitempty" doc:"Job type to resume; omit to lift the pause of all job types" example:"enrichment"`
+}
+
+// ResumeQueueInput defines the input for resuming the queue. Without a body, the pause of
+// all job types is lifted.
+type ResumeQueueInput struct {
+	Body *ResumeQueueBody
+}
+
+// listQueuePauses returns all paused job types, oldest pause first
+func listQueuePauses(ctx context.Context, client *ent.Client, logger *slog.Logger) (*QueuePausesOutput, error) {
+	pauses, err := client.QueuePause.Query().
+		Order(ent.Asc(queuepause.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "list", "queue pauses")
+	}
+
+	output := &QueuePausesOutput{}
+	output.Body.Data = make([]QueuePauseItem, len(pauses))
+	for i, pause := range pauses {
+		output.Body.Data[i] = QueuePauseItem{
+			JobType:  pause.JobType,
+			Reason:   pause.Reason,
+			PausedAt: pause.CreatedAt,
+		}
+	}
+	return output, nil
+}
+
+// RegisterQueuePauseRoutes registers routes for pausing and resuming the job queue
+func RegisterQueuePauseRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-queue-pauses",
+		Method:      "GET",
+		Path:        "/v1/jobs/pauses",
+		Summary:     "List paused job types",
+		Description: "Lists the job types whose jobs are currently not dequeued.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *struct{}) (*QueuePausesOutput, error) {
+		return listQueuePauses(ctx, client, logger)
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "pause-queue",
+		Method:      "POST",
+		Path:        "/v1/jobs/pause",
+		Summary:     "Pause the job queue",
+		Description: "Stops workers of all Hub instances from dequeuing jobs of a type, or of all types, e.g. during an AI provider outage or after deploying a bad prompt. Jobs are still enqueued, and jobs already being processed finish. The pause is stored in the database, so it survives restarts, and takes effect within 5 seconds. Pausing a paused job type updates the reason.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *PauseQueueInput) (*QueuePausesOutput, error) {
+		body := input.Body
+		if body == nil {
+			body = &PauseQueueBody{}
+		}
+
+		err := client.QueuePause.Create().
+			SetJobType(body.JobType).
+			SetReason(body.Reason).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			// Already paused
+			err = client.QueuePause.Update().
+				Where(queuepause.JobType(body.JobType)).
+				SetReason(body.Reason).
+				Exec(ctx)
+		}
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "pause", "job queue")
+		}
+
+		logger.Warn("job queue paused", "job_type", body.JobType, "reason", body.Reason)
+		return listQueuePauses(ctx, client, logger)
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "resume-queue",
+		Method:      "POST",
+		Path:        "/v1/jobs/resume",
+		Summary:     "Resume the job queue",
+		Description: "Lifts the pause of a job type, or the pause of all job types if no job type is given. Pauses of individual job types stay in place when the pause of all job types is lifted. Takes effect within 5 seconds.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *ResumeQueueInput) (*QueuePausesOutput, error) {
+		body := input.Body
+		if body == nil {
+			body = &ResumeQueueBody{}
+		}
+
+		count, err := client.QueuePause.Delete().
+			Where(queuepause.JobType(body.JobType)).
+			Exec(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "resume", "job queue")
+		}
+
+		if count > 0 {
+			logger.Info("job queue resumed", "job_type", body.JobType)
+		}
+		return listQueuePauses(ctx, client, logger)
+	})
+}
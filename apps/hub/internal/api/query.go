@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/lib/pq"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// queryMaxConns bounds the queries running at once, so ad-hoc queries can't exhaust the
+// connections of the restricted role
+const queryMaxConns = 5
+
+// QueryInput defines the input for a read-only SQL query
+type QueryInput struct {
+	AdminKey string `header:"X-Admin-Key" doc:"Admin API key (SERVICE_ADMIN_API_KEY)"`
+	Body     struct {
+		SQL string `json:"sql" minLength:"1" maxLength:"10000" doc:"A single SELECT statement, optionally with WITH clauses, reading the query_experiences view" example:"SELECT source_type, count(*) FROM query_experiences GROUP BY source_type"`
+	}
+}
+
+// QueryColumn is a column of a query result
+type QueryColumn struct {
+	Name string `json:"name" doc:"Column name"`
+	Type string `json:"type" doc:"PostgreSQL type, e.g. TEXT, INT8, or JSONB"`
+}
+
+// QueryOutput defines the output for a read-only SQL query
+type QueryOutput struct {
+	Body struct {
+		Columns   []QueryColumn `json:"columns" doc:"Columns of the result"`
+		Rows      [][]any       `json:"rows" doc:"Rows as arrays of values in column order; numeric and UUID values are strings"`
+		Truncated bool          `json:"truncated" doc:"Whether the query returned more rows than SERVICE_QUERY_MAX_ROWS, which were cut off"`
+	}
+}
+
+// RegisterQueryRoutes registers the read-only SQL endpoint. db connects as the restricted
+// role of SERVICE_QUERY_DATABASE_URL; the endpoint is disabled if it is nil.
+func RegisterQueryRoutes(api huma.API, cfg *config.Config, db *sql.DB, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "run-query",
+		Method:      "POST",
+		Path:        "/v1/query",
+		Summary:     "Run a read-only SQL query",
+		Description: "Runs a single SELECT statement against the query_experiences view, in a read-only transaction as the restricted role of SERVICE_QUERY_DATABASE_URL, so analysts can answer ad-hoc questions without database credentials. Requires the admin API key in the X-Admin-Key header. Queries are canceled after SERVICE_QUERY_TIMEOUT seconds and return at most SERVICE_QUERY_MAX_ROWS rows.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *QueryInput) (*QueryOutput, error) {
+		if db == nil {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "SQL queries are not enabled. Configure SERVICE_QUERY_DATABASE_URL and SERVICE_ADMIN_API_KEY to enable.")
+		}
+		// Digests have the same length, so comparing them doesn't leak the key length
+		provided, expected := sha256.Sum256([]byte(input.AdminKey)), sha256.Sum256([]byte(cfg.AdminAPIKey))
+		if input.AdminKey == "" || subtle.ConstantTimeCompare(provided[:], expected[:]) != 1 {
+			return nil, problem.New(http.StatusForbidden, problem.CodeForbidden, "Invalid or missing admin API key")
+		}
+
+		tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "query", "")
+		}
+		defer func() { _ = tx.Rollback() }()
+		timeout := time.Duration(cfg.QueryTimeout) * time.Second
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds())); err != nil {
+			return nil, handleDatabaseError(logger, err, "query", "")
+		}
+
+		// The query becomes a subquery, so it must be a single SELECT, and only the rows up
+		// to the limit are read. Prepared statements reject several statements as well.
+		statement := strings.TrimRight(strings.TrimSpace(input.Body.SQL), "; \t\r\n")
+		stmt, err := tx.PrepareContext(ctx, fmt.Sprintf("SELECT * FROM (\n%s\n) AS query LIMIT %d", statement, cfg.QueryMaxRows+1))
+		if err != nil {
+			return nil, queryError(logger, err)
+		}
+		defer func() { _ = stmt.Close() }()
+		rows, err := stmt.QueryContext(ctx)
+		if err != nil {
+			return nil, queryError(logger, err)
+		}
+		defer func() { _ = rows.Close() }()
+
+		columnTypes, err := rows.ColumnTypes()
+		if err != nil {
+			return nil, queryError(logger, err)
+		}
+		output := &QueryOutput{}
+		output.Body.Columns = make([]QueryColumn, len(columnTypes))
+		for i, column := range columnTypes {
+			output.Body.Columns[i] = QueryColumn{Name: column.Name(), Type: column.DatabaseTypeName()}
+		}
+		output.Body.Rows = [][]any{}
+		for rows.Next() {
+			if len(output.Body.Rows) == cfg.QueryMaxRows {
+				output.Body.Truncated = true
+				break
+			}
+			values := make([]any, len(columnTypes))
+			pointers := make([]any, len(values))
+			for i := range values {
+				pointers[i] = &values[i]
+			}
+			if err := rows.Scan(pointers...); err != nil {
+				return nil, queryError(logger, err)
+			}
+			for i, value := range values {
+				// The driver returns types it doesn't decode, such as numeric and UUID, as text
+				if b, ok := value.([]byte); ok {
+					switch output.Body.Columns[i].Type {
+					case "JSON", "JSONB":
+						values[i] = json.RawMessage(b)
+					default:
+						values[i] = string(b)
+					}
+				}
+			}
+			output.Body.Rows = append(output.Body.Rows, values)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, queryError(logger, err)
+		}
+
+		return output, nil
+	})
+}
+
+// queryError returns the error of a failed query. PostgreSQL errors, such as syntax errors,
+// missing privileges, or the statement timeout, are caused by the query and returned to the
+// client; others are database errors.
+func queryError(logger *slog.Logger, err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidQuery, "Query failed: "+pqErr.Message)
+	}
+	return handleDatabaseError(logger, err, "query", "")
+}
+
+// openQueryDatabase connects to the database as the restricted role of read-only queries,
+// or returns nil if they aren't enabled
+func openQueryDatabase(cfg *config.Config, logger *slog.Logger) *sql.DB {
+	url, err := cfg.GetQueryDatabaseURL()
+	if err != nil {
+		logger.Error("SQL queries disabled", "error", err)
+		return nil
+	}
+	if url == "" {
+		return nil
+	}
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		logger.Error("SQL queries disabled", "error", err)
+		return nil
+	}
+	db.SetMaxOpenConns(queryMaxConns)
+	db.SetMaxIdleConns(queryMaxConns)
+	db.SetConnMaxIdleTime(5 * time.Minute)
+	logger.Info("SQL queries enabled", "timeout_seconds", cfg.QueryTimeout, "max_rows", cfg.QueryMaxRows)
+	return db
+}
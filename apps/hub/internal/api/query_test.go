@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for _, score := range []float64{3, 9, 10} {
+		_, err := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID("nps").
+			SetFieldType("nps").
+			SetValueNumber(score).
+			SetUserIdentifier("user-1").
+			SetMetadata(map[string]interface{}{"plan": "pro"}).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	t.Run("rows are limited", func(t *testing.T) {
+		resp := api.Post("/v1/query", "X-Admin-Key: test-admin-key", map[string]any{
+			"sql": "SELECT field_type, value_number, metadata FROM query_experiences ORDER BY value_number;",
+		})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var result struct {
+			Columns   []QueryColumn       `json:"columns"`
+			Rows      [][]json.RawMessage `json:"rows"`
+			Truncated bool                `json:"truncated"`
+		}
+		if err := json.Unmarshal(resp.Body.Bytes(), &result); err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Columns) != 3 || result.Columns[0].Name != "field_type" || result.Columns[2].Type != "JSONB" {
+			t.Errorf("unexpected columns: %+v", result.Columns)
+		}
+		if len(result.Rows) != 2 || !result.Truncated {
+			t.Fatalf("expected 2 rows of a truncated result, got %d (truncated: %v)", len(result.Rows), result.Truncated)
+		}
+		if string(result.Rows[0][1]) != "3" || string(result.Rows[0][2]) != `{"plan":"pro"}` {
+			t.Errorf("unexpected row: %s", result.Rows[0])
+		}
+	})
+
+	t.Run("admin key required", func(t *testing.T) {
+		for _, headers := range [][]any{{}, {"X-Admin-Key: wrong"}} {
+			resp := api.Post("/v1/query", append(headers, map[string]any{"sql": "SELECT 1"})...)
+			if resp.Code != http.StatusForbidden {
+				t.Errorf("expected status 403, got %d: %s", resp.Code, resp.Body.String())
+			}
+		}
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		for _, query := range []string{
+			"SELECT user_identifier FROM query_experiences",
+			"DELETE FROM experience_data",
+			"SELECT 1; SELECT 2",
+			"SELECT pg_sleep(5)",
+		} {
+			resp := api.Post("/v1/query", "X-Admin-Key: test-admin-key", map[string]any{"sql": query})
+			if resp.Code != http.StatusBadRequest || !strings.Contains(resp.Body.String(), "invalid_query") {
+				t.Errorf("expected %q to fail with invalid_query, got %d: %s", query, resp.Code, resp.Body.String())
+			}
+		}
+	})
+}
@@ -0,0 +1,251 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+)
+
+// QuestionItem represents a question of the question bank in API responses
+type QuestionItem struct {
+	ID         uuid.UUID         `json:"id" doc:"Question ID, referenced by the question_id of experiences"`
+	SourceType string            `json:"source_type" doc:"Type of feedback source"`
+	SourceID   string            `json:"source_id" doc:"Source ID of the experiences; empty if they have none"`
+	FieldID    string            `json:"field_id" doc:"Identifier of the question/field"`
+	FieldType  string            `json:"field_type,omitempty" doc:"Field type of the first response"`
+	Label      string            `json:"label,omitempty" doc:"Canonical label, used when there is none in a language"`
+	Labels     map[string]string `json:"labels" doc:"Canonical labels by ISO language code"`
+	Metadata   map[string]any    `json:"metadata,omitempty" doc:"Display metadata, such as the scale, choices, or help text"`
+	CreatedAt  time.Time         `json:"created_at" doc:"When the question was created"`
+	UpdatedAt  time.Time         `json:"updated_at" doc:"When the question was last updated"`
+}
+
+// CreateQuestionInput defines the input for creating a question
+type CreateQuestionInput struct {
+	Body struct {
+		SourceType string            `json:"source_type" example:"survey" doc:"Type of feedback source" minLength:"1" maxLength:"255"`
+		SourceID   string            `json:"source_id,omitempty" example:"survey-123" doc:"Source ID of the experiences; omit if they have none"`
+		FieldID    string            `json:"field_id" example:"q1" doc:"Identifier of the question/field" minLength:"1" maxLength:"255"`
+		FieldType  string            `json:"field_type,omitempty" doc:"Field type of the responses" enum:"text,categorical,nps,csat,rating,number,boolean,date"`
+		Label      string            `json:"label,omitempty" example:"How likely are you to recommend us?" doc:"Canonical label"`
+		Labels     map[string]string `json:"labels,omitempty" example:"{\"en\":\"How likely are you to recommend us?\",\"de\":\"Wie wahrscheinlich ist es, dass Sie uns empfehlen?\"}" doc:"Canonical labels by ISO language code"`
+		Metadata   map[string]any    `json:"metadata,omitempty" doc:"Display metadata, such as the scale, choices, or help text"`
+	}
+}
+
+// UpdateQuestionInput defines the input for updating a question
+type UpdateQuestionInput struct {
+	ID   string `path:"id" doc:"Question ID (UUID)" format:"uuid"`
+	Body struct {
+		Label    *string            `json:"label,omitempty" doc:"Update the canonical label"`
+		Labels   *map[string]string `json:"labels,omitempty" doc:"Replace the labels by language"`
+		Metadata *map[string]any    `json:"metadata,omitempty" doc:"Replace the display metadata"`
+	}
+}
+
+// QuestionIDInput identifies a single question
+type QuestionIDInput struct {
+	ID string `path:"id" doc:"Question ID (UUID)" format:"uuid"`
+}
+
+// ListQuestionsInput defines the input for listing questions
+type ListQuestionsInput struct {
+	SourceType string `query:"source_type" doc:"Filter by source type"`
+	SourceID   string `query:"source_id" doc:"Filter by source ID"`
+	FieldID    string `query:"field_id" doc:"Filter by field ID"`
+	Limit      int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset     int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+}
+
+// QuestionOutput represents the output for a single question
+type QuestionOutput struct {
+	Body QuestionItem
+}
+
+// ListQuestionsOutput represents the output for listing questions
+type ListQuestionsOutput struct {
+	Body struct {
+		Data   []QuestionItem `json:"data" doc:"Questions, oldest first"`
+		Total  int            `json:"total" doc:"Total count of questions matching filters"`
+		Limit  int            `json:"limit" doc:"Limit used in query"`
+		Offset int            `json:"offset" doc:"Offset used in query"`
+	}
+}
+
+// questionToItem converts an Ent entity to the API response type
+func questionToItem(q *ent.Question) QuestionItem {
+	labels := q.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return QuestionItem{
+		ID:         q.ID,
+		SourceType: q.SourceType,
+		SourceID:   q.SourceID,
+		FieldID:    q.FieldID,
+		FieldType:  q.FieldType,
+		Label:      q.Label,
+		Labels:     labels,
+		Metadata:   q.Metadata,
+		CreatedAt:  q.CreatedAt,
+		UpdatedAt:  q.UpdatedAt,
+	}
+}
+
+// RegisterQuestionRoutes registers the routes of the question bank. Listing reads from
+// reader, which may be a read replica; everything else uses client.
+func RegisterQuestionRoutes(api huma.API, client *ent.Client, reader *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-question",
+		Method:      "POST",
+		Path:        "/v1/questions",
+		Summary:     "Create a question",
+		Description: "Adds a question to the question bank before its first response arrives, e.g. to set its labels in all languages. Questions are also created automatically for new experiences, so this is optional.",
+		Tags:        []string{"Questions"},
+	}, func(ctx context.Context, input *CreateQuestionInput) (*QuestionOutput, error) {
+		create := client.Question.Create().
+			SetSourceType(input.Body.SourceType).
+			SetSourceID(input.Body.SourceID).
+			SetFieldID(input.Body.FieldID).
+			SetFieldType(input.Body.FieldType).
+			SetLabel(input.Body.Label)
+		if input.Body.Labels != nil {
+			create.SetLabels(input.Body.Labels)
+		}
+		if input.Body.Metadata != nil {
+			create.SetMetadata(input.Body.Metadata)
+		}
+
+		q, err := create.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "create", "question")
+		}
+
+		logger.Info("question created", "id", q.ID, "source_type", q.SourceType, "field_id", q.FieldID)
+		return &QuestionOutput{Body: questionToItem(q)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-questions",
+		Method:      "GET",
+		Path:        "/v1/questions",
+		Summary:     "List questions",
+		Description: "Lists the questions of the question bank with optional filters and pagination",
+		Tags:        []string{"Questions"},
+	}, func(ctx context.Context, input *ListQuestionsInput) (*ListQuestionsOutput, error) {
+		query := reader.Question.Query()
+		if input.SourceType != "" {
+			query = query.Where(question.SourceTypeEQ(input.SourceType))
+		}
+		if input.SourceID != "" {
+			query = query.Where(question.SourceIDEQ(input.SourceID))
+		}
+		if input.FieldID != "" {
+			query = query.Where(question.FieldIDEQ(input.FieldID))
+		}
+
+		total, err := query.Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "questions")
+		}
+
+		questions, err := query.
+			Limit(input.Limit).
+			Offset(input.Offset).
+			Order(ent.Asc(question.FieldCreatedAt), ent.Asc(question.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "questions")
+		}
+
+		output := &ListQuestionsOutput{}
+		output.Body.Data = make([]QuestionItem, len(questions))
+		for i, q := range questions {
+			output.Body.Data[i] = questionToItem(q)
+		}
+		output.Body.Total = total
+		output.Body.Limit = input.Limit
+		output.Body.Offset = input.Offset
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-question",
+		Method:      "GET",
+		Path:        "/v1/questions/{id}",
+		Summary:     "Get a question",
+		Description: "Retrieves a single question of the question bank",
+		Tags:        []string{"Questions"},
+	}, func(ctx context.Context, input *QuestionIDInput) (*QuestionOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		q, err := client.Question.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		return &QuestionOutput{Body: questionToItem(q)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-question",
+		Method:      "PATCH",
+		Path:        "/v1/questions/{id}",
+		Summary:     "Update a question",
+		Description: "Updates the canonical labels or display metadata of a question. Only provided fields are changed. The labels sent with responses are not changed.",
+		Tags:        []string{"Questions"},
+	}, func(ctx context.Context, input *UpdateQuestionInput) (*QuestionOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		update := client.Question.UpdateOneID(id)
+		if input.Body.Label != nil {
+			update.SetLabel(*input.Body.Label)
+		}
+		if input.Body.Labels != nil {
+			update.SetLabels(*input.Body.Labels)
+		}
+		if input.Body.Metadata != nil {
+			update.SetMetadata(*input.Body.Metadata)
+		}
+
+		q, err := update.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "update", id.String())
+		}
+
+		logger.Info("question updated", "id", id)
+		return &QuestionOutput{Body: questionToItem(q)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-question",
+		Method:      "DELETE",
+		Path:        "/v1/questions/{id}",
+		Summary:     "Delete a question",
+		Description: "Deletes a question from the question bank. Its experiences are kept but no longer reference it; the question is created again, with the label of the response, when the next response arrives.",
+		Tags:        []string{"Questions"},
+	}, func(ctx context.Context, input *QuestionIDInput) (*struct{}, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := client.Question.DeleteOneID(id).Exec(ctx); err != nil {
+			return nil, handleDatabaseError(logger, err, "delete", id.String())
+		}
+
+		logger.Info("question deleted", "id", id)
+		return &struct{}{}, nil
+	})
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestQuestionBank(t *testing.T) {
+	api, _, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	// The same question, relabelled upstream and answered in another language
+	var questionIDs []string
+	for _, response := range []struct{ label, language string }{
+		{"How likely are you to recommend us?", "en"},
+		{"How likely are you to recommend Acme?", "en"},
+		{"Wie wahrscheinlich ist es, dass Sie uns empfehlen?", "de"},
+	} {
+		resp := api.Post("/v1/experiences", map[string]interface{}{
+			"source_type":  "survey",
+			"source_id":    "survey-123",
+			"field_id":     "nps_score",
+			"field_label":  response.label,
+			"field_type":   "nps",
+			"value_number": 8.0,
+			"language":     response.language,
+		})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var exp ExperienceData
+		if err := json.Unmarshal(resp.Body.Bytes(), &exp); err != nil {
+			t.Fatal(err)
+		}
+		if exp.QuestionID == nil {
+			t.Fatal("expected the experience to reference a question")
+		}
+		questionIDs = append(questionIDs, exp.QuestionID.String())
+	}
+	if questionIDs[0] != questionIDs[1] || questionIDs[0] != questionIDs[2] {
+		t.Fatalf("expected all responses to reference the same question, got %v", questionIDs)
+	}
+
+	t.Run("keeps the first label per language", func(t *testing.T) {
+		resp := api.Get("/v1/questions/" + questionIDs[0])
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var q QuestionItem
+		if err := json.Unmarshal(resp.Body.Bytes(), &q); err != nil {
+			t.Fatal(err)
+		}
+		if q.Label != "How likely are you to recommend us?" || q.Labels["en"] != q.Label ||
+			q.Labels["de"] != "Wie wahrscheinlich ist es, dass Sie uns empfehlen?" {
+			t.Errorf("unexpected labels: %+v", q)
+		}
+	})
+
+	t.Run("filter experiences by question", func(t *testing.T) {
+		resp := api.Get("/v1/experiences?question_id=" + questionIDs[0])
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", resp.Code)
+		}
+		if !strings.Contains(resp.Body.String(), `"total":3`) {
+			t.Errorf("expected all 3 responses, got %s", resp.Body.String())
+		}
+	})
+
+	t.Run("update labels", func(t *testing.T) {
+		resp := api.Patch("/v1/questions/"+questionIDs[0], map[string]interface{}{
+			"label":  "How likely are you to recommend Acme?",
+			"labels": map[string]string{"en": "How likely are you to recommend Acme?"},
+		})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if strings.Contains(resp.Body.String(), `"de"`) {
+			t.Errorf("expected the labels to be replaced, got %s", resp.Body.String())
+		}
+	})
+
+	t.Run("duplicate question", func(t *testing.T) {
+		resp := api.Post("/v1/questions", map[string]interface{}{
+			"source_type": "survey",
+			"source_id":   "survey-123",
+			"field_id":    "nps_score",
+		})
+		if resp.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", resp.Code)
+		}
+	})
+
+	t.Run("get non-existing question", func(t *testing.T) {
+		resp := api.Get("/v1/questions/00000000-0000-0000-0000-000000000000")
+		if resp.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", resp.Code)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// ReloadFunc reloads the runtime-tunable settings and returns the names of those that changed
+type ReloadFunc func(ctx context.Context) ([]string, error)
+
+// errReloadUnavailable is returned when Hub wasn't started with a configuration file
+var errReloadUnavailable = errors.New("configuration reload requires a configuration file")
+
+// ReloadConfigOutput lists the settings changed by a reload
+type ReloadConfigOutput struct {
+	Body struct {
+		Changed    []string `json:"changed" doc:"Settings whose values changed; empty if the file's reloadable settings didn't change"`
+		Reloadable []string `json:"reloadable" doc:"Settings that can be reloaded; other settings require a restart"`
+	}
+}
+
+// RegisterConfigRoutes registers the route for reloading the configuration
+func RegisterConfigRoutes(api huma.API, reload ReloadFunc, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "reload-config",
+		Method:      "POST",
+		Path:        "/v1/config/reload",
+		Summary:     "Reload the configuration",
+		Description: "Re-reads the configuration file and applies the settings that can be changed at runtime: webhook URLs, rate limits, the log level, and the enrichment models and fallbacks. Flags and environment variables still take precedence over the file. Jobs being processed finish with the previous settings. Sending SIGHUP to the Hub process has the same effect. Only the instance that receives the request is reloaded.",
+		Tags:        []string{"Configuration"},
+	}, func(ctx context.Context, input *struct{}) (*ReloadConfigOutput, error) {
+		changed, err := reload(ctx)
+		if errors.Is(err, errReloadUnavailable) {
+			return nil, problem.New(http.StatusConflict, problem.CodeReloadUnavailable, "Hub was started without a configuration file (--config or SERVICE_CONFIG), so there is nothing to reload")
+		}
+		if err != nil {
+			logger.Warn("configuration reload failed", "error", err)
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidConfiguration, fmt.Sprintf("Invalid configuration: %v", err))
+		}
+
+		output := &ReloadConfigOutput{}
+		output.Body.Changed = changed
+		if output.Body.Changed == nil {
+			output.Body.Changed = []string{}
+		}
+		output.Body.Reloadable = config.ReloadableSettings()
+		return output, nil
+	})
+}
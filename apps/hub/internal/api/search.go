@@ -4,14 +4,18 @@ import (
 	"context"
 	"log/slog"
 	"math"
+	"net/http"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/formbricks/hub/apps/hub/internal/ai"
 	"github.com/formbricks/hub/apps/hub/internal/config"
 	"github.com/formbricks/hub/apps/hub/internal/embedding"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/usage"
 	entvec "github.com/pgvector/pgvector-go/ent"
 )
 
@@ -41,98 +45,22 @@ type SearchOutput struct {
 	}
 }
 
-// RegisterSearchRoutes registers semantic search routes
-func RegisterSearchRoutes(api huma.API, cfg *config.Config, client *ent.Client, logger *slog.Logger) {
+// RegisterSearchRoutes registers semantic search routes. Experiences are searched on reader,
+// which may be a read replica, and AI usage is recorded with client.
+func RegisterSearchRoutes(api huma.API, cfg *config.Config, client *ent.Client, reader *ent.Client, logger *slog.Logger) {
+	usageRecorder := usage.NewRecorder(client, logger)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "search-experiences",
 		Method:      "GET",
 		Path:        "/v1/experiences/search",
 		Summary:     "Search experiences using semantic search",
-		Description: "Performs vector similarity search on experience data using OpenAI embeddings. Only returns text experiences that have been embedded.",
+		Description: "Performs vector similarity search on experience data using the configured embedding provider. Only returns text experiences that have been embedded.",
 		Tags:        []string{"Experiences"},
 	}, func(ctx context.Context, input *SearchInput) (*SearchOutput, error) {
-		// Check if embeddings are enabled
-		if !cfg.IsEmbeddingEnabled() {
-			return nil, huma.Error400BadRequest("Semantic search is not enabled. Configure SERVICE_OPENAI_EMBEDDING_MODEL to enable.")
-		}
-
-		// Create embedding service
-		embeddingService := embedding.NewService(
-			cfg.OpenAIKey,
-			cfg.OpenAIEmbeddingModel,
-			cfg.EnrichmentTimeout,
-			logger,
-		)
-
-		// Generate embedding for the search query
-		queryVector, err := embeddingService.GenerateEmbedding(ctx, input.Query)
-		if err != nil {
-			// Use sanitized error handling for service errors
-			return nil, handleServiceError(logger, err, "embedding", "generate query embedding")
-		}
-
-		// Build query with filters and ordering by cosine distance
-		query := client.ExperienceData.Query().
-			Where(experiencedata.EmbeddingNotNil()) // Only return experiences with embeddings
-
-		// Apply optional filters
-		if input.SourceType != "" {
-			query = query.Where(experiencedata.SourceTypeEQ(input.SourceType))
-		}
-		if input.Since != "" {
-			sinceTime, err := time.Parse(time.RFC3339, input.Since)
-			if err != nil {
-				return nil, huma.Error400BadRequest("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
-			}
-			query = query.Where(experiencedata.CollectedAtGTE(sinceTime))
-		}
-		if input.Until != "" {
-			untilTime, err := time.Parse(time.RFC3339, input.Until)
-			if err != nil {
-				return nil, huma.Error400BadRequest("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
-			}
-			query = query.Where(experiencedata.CollectedAtLTE(untilTime))
-		}
-
-		// Execute the query
-		experiences, err := query.
-			Order(func(s *sql.Selector) {
-				s.OrderExpr(entvec.CosineDistance(experiencedata.FieldEmbedding, queryVector))
-			}).
-			Limit(input.Limit).
-			All(ctx)
-
+		results, err := semanticSearch(ctx, cfg, reader, usageRecorder, logger, input)
 		if err != nil {
-			return nil, handleDatabaseError(logger, err, "semantic search", "query")
-		}
-
-		// For each experience, compute the actual similarity
-		// Since we can't easily extract distance from Ent query, we recalculate it
-		var results []SearchResultItem
-		for _, exp := range experiences {
-			// Calculate cosine distance between query vector and experience embedding
-			var distance float64
-			if exp.Embedding != nil && queryVector.Slice() != nil {
-				distance = cosineDist(queryVector.Slice(), exp.Embedding.Slice())
-			} else {
-				distance = 1.0 // Maximum distance if no embedding
-			}
-
-			// Convert distance to similarity: similarity = 1 - distance
-			// Cosine distance ranges from 0 (identical) to 2 (opposite)
-			// Clamp to [0, 1] range
-			similarity := 1.0 - distance
-			if similarity < 0 {
-				similarity = 0
-			}
-			if similarity > 1 {
-				similarity = 1
-			}
-
-			results = append(results, SearchResultItem{
-				ExperienceData:  entityToOutput(exp),
-				SimilarityScore: similarity,
-			})
+			return nil, err
 		}
 
 		return &SearchOutput{
@@ -149,6 +77,101 @@ func RegisterSearchRoutes(api huma.API, cfg *config.Config, client *ent.Client,
 	})
 }
 
+// semanticSearch returns the embedded experiences closest in meaning to the query, most
+// similar first. It is shared by the REST and MCP APIs.
+func semanticSearch(ctx context.Context, cfg *config.Config, reader *ent.Client, usageRecorder *usage.Recorder, logger *slog.Logger, input *SearchInput) ([]SearchResultItem, error) {
+	// Check if embeddings are enabled
+	if !cfg.IsEmbeddingEnabled() {
+		return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Semantic search is not enabled. Configure SERVICE_OPENAI_EMBEDDING_MODEL or SERVICE_GEMINI_EMBEDDING_MODEL to enable.")
+	}
+
+	// Create embedding service
+	embeddingProvider, err := ai.NewEmbeddingProvider(cfg)
+	if err != nil {
+		return nil, handleServiceError(logger, err, "embedding", "create provider")
+	}
+	embeddingService := embedding.NewService(embeddingProvider, cfg.EnrichmentTimeout, logger)
+
+	// Generate embedding for the search query
+	queryVector, tokenUsage, err := embeddingService.GenerateEmbedding(ai.WithQuery(ctx), input.Query)
+	if err != nil {
+		// Use sanitized error handling for service errors
+		return nil, handleServiceError(logger, err, "embedding", "generate query embedding")
+	}
+	usageRecorder.RecordAsync(usage.Entry{
+		JobType:  usage.JobTypeSearch,
+		Provider: embeddingService.Provider(),
+		Model:    embeddingService.Model(),
+		Usage:    tokenUsage,
+	})
+
+	// Build query with filters and ordering by cosine distance, on the read replica if configured
+	query := reader.ExperienceData.Query().
+		Where(experiencedata.EmbeddingNotNil()) // Only return experiences with embeddings
+
+	// Apply optional filters
+	if input.SourceType != "" {
+		query = query.Where(experiencedata.SourceTypeEQ(input.SourceType))
+	}
+	if input.Since != "" {
+		sinceTime, err := time.Parse(time.RFC3339, input.Since)
+		if err != nil {
+			return nil, invalidTimestamp("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+		}
+		query = query.Where(experiencedata.CollectedAtGTE(sinceTime))
+	}
+	if input.Until != "" {
+		untilTime, err := time.Parse(time.RFC3339, input.Until)
+		if err != nil {
+			return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+		}
+		query = query.Where(experiencedata.CollectedAtLTE(untilTime))
+	}
+
+	// Execute the query
+	experiences, err := query.
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(entvec.CosineDistance(experiencedata.FieldEmbedding, queryVector))
+		}).
+		Limit(input.Limit).
+		All(ctx)
+
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "semantic search", "query")
+	}
+
+	// For each experience, compute the actual similarity
+	// Since we can't easily extract distance from Ent query, we recalculate it
+	var results []SearchResultItem
+	for _, exp := range experiences {
+		// Calculate cosine distance between query vector and experience embedding
+		var distance float64
+		if exp.Embedding != nil && queryVector.Slice() != nil {
+			distance = cosineDist(queryVector.Slice(), exp.Embedding.Slice())
+		} else {
+			distance = 1.0 // Maximum distance if no embedding
+		}
+
+		// Convert distance to similarity: similarity = 1 - distance
+		// Cosine distance ranges from 0 (identical) to 2 (opposite)
+		// Clamp to [0, 1] range
+		similarity := 1.0 - distance
+		if similarity < 0 {
+			similarity = 0
+		}
+		if similarity > 1 {
+			similarity = 1
+		}
+
+		results = append(results, SearchResultItem{
+			ExperienceData:  entityToOutput(exp),
+			SimilarityScore: similarity,
+		})
+	}
+
+	return results, nil
+}
+
 // cosineDist calculates the cosine distance between two vectors
 // Cosine distance = 1 - cosine similarity
 // Returns 0 for identical vectors, up to 2 for opposite vectors
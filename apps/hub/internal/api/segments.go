@@ -0,0 +1,214 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/segment"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+)
+
+// SegmentItem represents a saved segment in API responses
+type SegmentItem struct {
+	ID          uuid.UUID               `json:"id" doc:"Segment ID, referenced by segment_id"`
+	Name        string                  `json:"name" doc:"Unique name"`
+	Description string                  `json:"description,omitempty" doc:"What the segment is for"`
+	Filter      experiencefilter.Filter `json:"filter" doc:"Filter selecting the experiences of the segment, like the filters of GET /v1/experiences"`
+	CreatedAt   time.Time               `json:"created_at" doc:"When the segment was created"`
+	UpdatedAt   time.Time               `json:"updated_at" doc:"When the segment was last updated"`
+}
+
+// CreateSegmentInput defines the input for creating a segment
+type CreateSegmentInput struct {
+	Body struct {
+		Name        string                  `json:"name" example:"Enterprise detractors" doc:"Unique name" minLength:"1" maxLength:"255"`
+		Description string                  `json:"description,omitempty" doc:"What the segment is for"`
+		Filter      experiencefilter.Filter `json:"filter" example:"{\"nps_category\":\"detractor\",\"source_id\":\"enterprise-nps\"}" doc:"Filter selecting the experiences of the segment, like the filters of GET /v1/experiences"`
+	}
+}
+
+// UpdateSegmentInput defines the input for updating a segment
+type UpdateSegmentInput struct {
+	ID   string `path:"id" doc:"Segment ID (UUID)" format:"uuid"`
+	Body struct {
+		Name        *string                  `json:"name,omitempty" doc:"Rename the segment" minLength:"1" maxLength:"255"`
+		Description *string                  `json:"description,omitempty" doc:"Update the description"`
+		Filter      *experiencefilter.Filter `json:"filter,omitempty" doc:"Replace the filter"`
+	}
+}
+
+// SegmentIDInput identifies a single segment
+type SegmentIDInput struct {
+	ID string `path:"id" doc:"Segment ID (UUID)" format:"uuid"`
+}
+
+// SegmentOutput represents the output for a single segment
+type SegmentOutput struct {
+	Body SegmentItem
+}
+
+// ListSegmentsOutput represents the output for listing segments
+type ListSegmentsOutput struct {
+	Body struct {
+		Data []SegmentItem `json:"data" doc:"Segments, by name"`
+	}
+}
+
+// segmentToItem converts an Ent entity to the API response type
+func segmentToItem(s *ent.Segment) SegmentItem {
+	return SegmentItem{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		Filter:      s.Filter,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// loadSegment returns the filter of the segment with the ID
+func loadSegment(ctx context.Context, reader *ent.Client, id string, logger *slog.Logger) (*experiencefilter.Filter, error) {
+	segmentID, err := parseUUID(id)
+	if err != nil {
+		return nil, err
+	}
+	s, err := reader.Segment.Get(ctx, segmentID)
+	if err != nil {
+		return nil, handleDatabaseError(logger, err, "get", id)
+	}
+	return &s.Filter, nil
+}
+
+// RegisterSegmentRoutes registers the routes of saved segments
+func RegisterSegmentRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-segment",
+		Method:      "POST",
+		Path:        "/v1/segments",
+		Summary:     "Create a segment",
+		Description: "Saves a named filter of experiences, such as the detractors of enterprise customers, so analytics and listings can apply it with segment_id and every tool shares the same definition.",
+		Tags:        []string{"Segments"},
+	}, func(ctx context.Context, input *CreateSegmentInput) (*SegmentOutput, error) {
+		// The filter is applied to a query to validate it, as it would be when it's used
+		if _, err := filterExperiences(client.ExperienceData.Query(), input.Body.Filter); err != nil {
+			return nil, err
+		}
+
+		s, err := client.Segment.Create().
+			SetName(input.Body.Name).
+			SetDescription(input.Body.Description).
+			SetFilter(input.Body.Filter).
+			Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "create", "segment")
+		}
+
+		logger.Info("segment created", "id", s.ID, "name", s.Name)
+		return &SegmentOutput{Body: segmentToItem(s)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-segments",
+		Method:      "GET",
+		Path:        "/v1/segments",
+		Summary:     "List segments",
+		Description: "Lists all saved segments by name",
+		Tags:        []string{"Segments"},
+	}, func(ctx context.Context, input *struct{}) (*ListSegmentsOutput, error) {
+		segments, err := client.Segment.Query().
+			Order(ent.Asc(segment.FieldName)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "segments")
+		}
+
+		output := &ListSegmentsOutput{}
+		output.Body.Data = make([]SegmentItem, len(segments))
+		for i, s := range segments {
+			output.Body.Data[i] = segmentToItem(s)
+		}
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-segment",
+		Method:      "GET",
+		Path:        "/v1/segments/{id}",
+		Summary:     "Get a segment",
+		Description: "Retrieves a single saved segment",
+		Tags:        []string{"Segments"},
+	}, func(ctx context.Context, input *SegmentIDInput) (*SegmentOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := client.Segment.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		return &SegmentOutput{Body: segmentToItem(s)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-segment",
+		Method:      "PATCH",
+		Path:        "/v1/segments/{id}",
+		Summary:     "Update a segment",
+		Description: "Renames a segment or replaces its description or filter. Only provided fields are changed; analytics and listings use the new filter from then on.",
+		Tags:        []string{"Segments"},
+	}, func(ctx context.Context, input *UpdateSegmentInput) (*SegmentOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		update := client.Segment.UpdateOneID(id)
+		if input.Body.Name != nil {
+			update.SetName(*input.Body.Name)
+		}
+		if input.Body.Description != nil {
+			update.SetDescription(*input.Body.Description)
+		}
+		if input.Body.Filter != nil {
+			if _, err := filterExperiences(client.ExperienceData.Query(), *input.Body.Filter); err != nil {
+				return nil, err
+			}
+			update.SetFilter(*input.Body.Filter)
+		}
+
+		s, err := update.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "update", id.String())
+		}
+
+		logger.Info("segment updated", "id", id)
+		return &SegmentOutput{Body: segmentToItem(s)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-segment",
+		Method:      "DELETE",
+		Path:        "/v1/segments/{id}",
+		Summary:     "Delete a segment",
+		Description: "Deletes a saved segment. Requests that still reference it with segment_id fail with 404.",
+		Tags:        []string{"Segments"},
+	}, func(ctx context.Context, input *SegmentIDInput) (*struct{}, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := client.Segment.DeleteOneID(id).Exec(ctx); err != nil {
+			return nil, handleDatabaseError(logger, err, "delete", id.String())
+		}
+
+		logger.Info("segment deleted", "id", id)
+		return &struct{}{}, nil
+	})
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+func TestSegments(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	// Detractors and a promoter of enterprise and free customers
+	ctx := context.Background()
+	for _, response := range []struct {
+		score float64
+		plan  string
+	}{
+		{3, "enterprise"},
+		{5, "enterprise"},
+		{10, "enterprise"},
+		{2, "free"},
+	} {
+		score := response.score
+		_, err := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetSourceID(response.plan + "-nps").
+			SetFieldID("nps_score").
+			SetFieldType("nps").
+			SetValueNumber(score).
+			SetNillableNpsCategory(models.NPSCategory("nps", &score)).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	resp := api.Post("/v1/segments", map[string]interface{}{
+		"name":   "Enterprise detractors",
+		"filter": map[string]interface{}{"source_id": "enterprise-nps", "nps_category": "detractor"},
+	})
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var segment SegmentItem
+	if err := json.Unmarshal(resp.Body.Bytes(), &segment); err != nil {
+		t.Fatal(err)
+	}
+	if segment.Name != "Enterprise detractors" || segment.Filter.NPSCategory != "detractor" {
+		t.Errorf("unexpected segment: %+v", segment)
+	}
+
+	t.Run("list experiences", func(t *testing.T) {
+		resp := api.Get("/v1/experiences?segment_id=" + segment.ID.String())
+		if resp.Code != http.StatusOK || !strings.Contains(resp.Body.String(), `"total":2`) {
+			t.Errorf("expected the 2 enterprise detractors, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+
+	t.Run("analytics", func(t *testing.T) {
+		resp := api.Get("/v1/analytics/nps?segment_id=" + segment.ID.String())
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var report GetNPSOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &report.Body); err != nil {
+			t.Fatal(err)
+		}
+		if report.Body.Responses != 2 || report.Body.Detractors != 2 {
+			t.Errorf("expected the 2 enterprise detractors, got %+v", report.Body.NPSBreakdown)
+		}
+	})
+
+	t.Run("update filter", func(t *testing.T) {
+		resp := api.Patch("/v1/segments/"+segment.ID.String(), map[string]interface{}{
+			"filter": map[string]interface{}{"nps_category": "detractor"},
+		})
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		resp = api.Get("/v1/experiences?segment_id=" + segment.ID.String())
+		if !strings.Contains(resp.Body.String(), `"total":3`) {
+			t.Errorf("expected all 3 detractors, got %s", resp.Body.String())
+		}
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		resp := api.Post("/v1/segments", map[string]interface{}{"name": "Enterprise detractors", "filter": map[string]interface{}{}})
+		if resp.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", resp.Code)
+		}
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		resp := api.Post("/v1/segments", map[string]interface{}{
+			"name":   "Recent",
+			"filter": map[string]interface{}{"since": "yesterday"},
+		})
+		if resp.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", resp.Code)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if resp := api.Delete("/v1/segments/" + segment.ID.String()); resp.Code != http.StatusNoContent {
+			t.Fatalf("expected status 204, got %d", resp.Code)
+		}
+		resp := api.Get("/v1/analytics/nps?segment_id=" + segment.ID.String())
+		if resp.Code != http.StatusNotFound || !strings.Contains(resp.Body.String(), "segment_not_found") {
+			t.Errorf("expected segment_not_found, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+}
@@ -2,45 +2,90 @@ package api
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/adapters/humachi"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"github.com/formbricks/hub/apps/hub/internal/audit"
+	"github.com/formbricks/hub/apps/hub/internal/cache"
 	"github.com/formbricks/hub/apps/hub/internal/config"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/errorreport"
 	custommiddleware "github.com/formbricks/hub/apps/hub/internal/middleware"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
 	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
 	"github.com/formbricks/hub/apps/hub/internal/webhook"
 )
 
+// defaultMaxBodySize is the request body size limit if none is configured
+const defaultMaxBodySize = 10 * 1024 * 1024 // 10MB
+
+// cachedOperations are the read operations whose responses may be cached
+var cachedOperations = []string{"list-experiences", "get-experience", "search-experiences", "get-nps", "get-ratings", "get-sentiment", "get-topic-trends", "get-terms", "get-time-series", "get-field-stats", "get-crosstab", "get-overview"}
+
 // Server holds the HTTP server and dependencies
 type Server struct {
 	config          *config.Config
 	client          *ent.Client
+	reader          *ent.Client // Serves list, search, and analytics reads; the replica if configured
+	queryDB         *sql.DB     // Runs read-only SQL queries as the restricted role; nil if disabled
 	dispatcher      *webhook.Dispatcher
 	logger          *slog.Logger
 	api             huma.API
 	router          *chi.Mux
 	enrichmentQueue queue.Queue
+	rateLimiter     *custommiddleware.RateLimiter
+	reload          ReloadFunc // Reloads the configuration if set
+
+	// enrichmentConfig is the configuration of the enrichment routes, replaced on reload
+	enrichmentConfig atomic.Pointer[config.Config]
+
+	// Graceful shutdown
+	mu       sync.Mutex     // Guards servers
+	servers  []*http.Server // Listening HTTP servers, set by Start
+	stopped  chan struct{}  // Closed when shutdown begins
+	stopOnce sync.Once
+	draining atomic.Bool // Set while the server drains; /health reports 503
 }
 
-// NewServer creates a new API server
-func NewServer(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispatcher, enrichmentQueue queue.Queue, logger *slog.Logger) *Server {
-	// Create Chi router
+// NewServer creates a new API server. replica is optional and serves list, search, and
+// analytics reads instead of client. responseCache is optional and caches experience reads.
+// errorReporter is optional and receives panics and 5xx errors.
+func NewServer(cfg *config.Config, client *ent.Client, replica *ent.Client, dispatcher *webhook.Dispatcher, enrichmentQueue queue.Queue, responseCache *cache.Cache, errorReporter *errorreport.Reporter, logger *slog.Logger) *Server {
+	// Create Chi router, answering unknown routes with problem details like all other errors
 	router := chi.NewRouter()
+	router.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		problem.Write(w, http.StatusNotFound, problem.CodeNotFound, "No route matches "+r.URL.Path)
+	})
+	router.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		problem.Write(w, http.StatusMethodNotAllowed, problem.CodeMethodNotAllowed, r.Method+" is not allowed on "+r.URL.Path)
+	})
 
 	// Add Chi middleware (router-specific, runs first)
 	router.Use(middleware.RequestID)
 	router.Use(middleware.RealIP)
 	router.Use(middleware.Recoverer)
-	// Limit request body size to 10MB to prevent memory exhaustion attacks
+	router.Use(errorReporter.Middleware)
+	router.Use(traceRoute)
+	if cfg.SecurityHeaders {
+		router.Use(custommiddleware.SecurityHeaders(cfg.GetHSTSMaxAge()))
+	}
+	// Limit request body sizes per route to prevent memory exhaustion attacks
 	router.Use(middleware.Compress(5))
-	router.Use(custommiddleware.MaxBodySize(10 * 1024 * 1024)) // 10MB limit
+	maxBodySize, bodySizeLimits := bodySizeLimits(cfg, logger)
+	router.Use(custommiddleware.BodySizeLimits(maxBodySize, bodySizeLimits))
 
 	// Rate limiting - protects against DoS and excessive OpenAI API usage
 	rateLimiter := custommiddleware.NewRateLimiter(
@@ -50,20 +95,107 @@ func NewServer(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispa
 		cfg.RateLimitGlobalBurst,
 		logger,
 	)
+	rateLimiter.SetExemptPaths(cfg.GetRateLimitExemptPaths())
+	rateLimiter.SetRouteClasses(rateLimitClasses(cfg))
 	router.Use(rateLimiter.Middleware())
 	logger.Info("rate limiting enabled",
 		"per_ip_rate", cfg.RateLimitPerIP,
 		"per_ip_burst", cfg.RateLimitBurst,
 		"global_rate", cfg.RateLimitGlobal,
-		"global_burst", cfg.RateLimitGlobalBurst)
+		"global_burst", cfg.RateLimitGlobalBurst,
+		"search_per_ip_rate", cfg.RateLimitSearchPerIP,
+		"ai_per_ip_rate", cfg.RateLimitAIPerIP)
+
+	// Audit log of mutating calls and lockouts
+	var auditLog *audit.DBLog
+	if cfg.AuditLog {
+		auditLog = audit.NewDBLog(client, time.Duration(cfg.AuditRetentionDays)*24*time.Hour)
+	}
+
+	// Lock out clients that keep failing authentication, on all routes that check the API key
+	if cfg.APIKey != "" && cfg.AuthMaxFailures > 0 {
+		authThrottle := custommiddleware.NewAuthThrottle(
+			cfg.AuthMaxFailures,
+			cfg.AuthGlobalMaxFailures,
+			time.Duration(cfg.AuthFailureWindow)*time.Second,
+			time.Duration(cfg.AuthLockout)*time.Second,
+			time.Duration(cfg.AuthMaxLockout)*time.Second,
+			logger,
+		)
+		if auditLog != nil {
+			authThrottle.SetAuditLog(auditLog)
+		}
+		router.Use(authThrottle.Middleware())
+	}
+
+	// Request timeouts, so a slow database query or AI provider doesn't hold connections indefinitely
+	timeoutClasses := []custommiddleware.TimeoutClass{
+		{Name: "ai", Timeout: time.Duration(cfg.AIRequestTimeout) * time.Second, Patterns: cfg.GetRateLimitAIRoutes()},
+		{Name: "search", Timeout: time.Duration(cfg.SearchRequestTimeout) * time.Second, Patterns: cfg.GetRateLimitSearchRoutes()},
+	}
+	if cfg.DebugEndpoints {
+		timeoutClasses = append(timeoutClasses, debugTimeoutClass)
+	}
+	router.Use(custommiddleware.Timeouts(time.Duration(cfg.RequestTimeout)*time.Second, timeoutClasses))
+
+	reader := client
+	if replica != nil {
+		reader = replica
+	}
+
+	server := &Server{
+		config:          cfg,
+		client:          client,
+		reader:          reader,
+		queryDB:         openQueryDatabase(cfg, logger),
+		dispatcher:      dispatcher,
+		logger:          logger,
+		router:          router,
+		enrichmentQueue: enrichmentQueue,
+		rateLimiter:     rateLimiter,
+		stopped:         make(chan struct{}),
+	}
+	server.enrichmentConfig.Store(cfg)
 
-	// Health check endpoint (outside of Huma API and auth)
+	// Health check endpoint (outside of Huma API and auth). It fails while the server drains,
+	// so load balancers stop sending requests before the listener closes.
 	router.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
+		if server.draining.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprintf(w, `{"status":"shutting_down"}`)
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 		_, _ = fmt.Fprintf(w, `{"status":"ok"}`)
 	})
 
+	// Prometheus metrics (outside of Huma, so protected by its own API key check)
+	router.Group(func(r chi.Router) {
+		if cfg.APIKey != "" {
+			r.Use(custommiddleware.RequireAPIKey(cfg.APIKey))
+		}
+		r.Handle("/metrics", promhttp.Handler())
+	})
+
+	// Deep health check with the status of each dependency, opt-in since it calls the AI
+	// providers (protected by the API key like metrics)
+	if cfg.DeepHealthCheck {
+		router.Group(func(r chi.Router) {
+			if cfg.APIKey != "" {
+				r.Use(custommiddleware.RequireAPIKey(cfg.APIKey))
+			}
+			r.Handle("/health/deep", newDeepHealthChecker(cfg, client, replica, dispatcher))
+		})
+	}
+
+	// Runtime profiles and variables, opt-in and always protected by an API key
+	if debugAPIKey, err := cfg.GetDebugAPIKey(); err != nil {
+		logger.Error("debug endpoints disabled", "error", err)
+	} else if cfg.DebugEndpoints {
+		registerDebugRoutes(router, debugAPIKey, dispatcher)
+	}
+
 	// Create Huma API with Scalar docs
 	humaConfig := huma.DefaultConfig("Formbricks Hub API", "1.0.0")
 	humaConfig.Info.Description = `Experience data storage service for the Formbricks ecosystem.
@@ -88,6 +220,14 @@ func NewServer(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispa
 	}
 	// Disable default docs (we'll use Scalar instead)
 	humaConfig.DocsPath = ""
+	// Huma reads at most 1MB of a request body by default; apply the route's limit instead
+	humaConfig.OnAddOperation = append(humaConfig.OnAddOperation, func(_ *huma.OpenAPI, op *huma.Operation) {
+		route := &http.Request{Method: op.Method, URL: &url.URL{Path: op.Path}}
+		op.MaxBodyBytes = custommiddleware.MaxBodyBytes(maxBodySize, bodySizeLimits, route)
+	})
+
+	// Report the internal error behind 5xx responses instead of only their sanitized detail
+	humaConfig.Transformers = append(humaConfig.Transformers, reportServerError)
 
 	api := humachi.New(router, humaConfig)
 
@@ -95,12 +235,22 @@ func NewServer(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispa
 	// Logging middleware
 	api.UseMiddleware(custommiddleware.Logging(logger))
 
+	// Audit mutating calls, before authentication so rejected calls are recorded too
+	if auditLog != nil {
+		api.UseMiddleware(custommiddleware.Audit(auditLog, logger))
+	}
+
 	// Optional API key authentication
 	if cfg.APIKey != "" {
 		logger.Info("API key authentication enabled")
 		api.UseMiddleware(custommiddleware.APIKeyAuth(api, cfg.APIKey))
 	}
 
+	// Optional response caching for reads that dashboards poll, after authentication
+	if responseCache != nil {
+		api.UseMiddleware(custommiddleware.ResponseCache(responseCache, cachedOperations, logger))
+	}
+
 	// Custom /docs endpoint using Scalar with enhanced configuration
 	router.Get("/docs", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/html")
@@ -131,15 +281,7 @@ func NewServer(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispa
 		_, _ = w.Write([]byte(html))
 	})
 
-	server := &Server{
-		config:          cfg,
-		client:          client,
-		dispatcher:      dispatcher,
-		logger:          logger,
-		api:             api,
-		router:          router,
-		enrichmentQueue: enrichmentQueue,
-	}
+	server.api = api
 
 	// Register API routes
 	server.registerRoutes()
@@ -147,53 +289,320 @@ func NewServer(cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispa
 	return server
 }
 
+// rateLimitClasses returns the routes with their own per-IP limits. Search and AI routes are
+// limited more strictly than plain reads, since they cost provider calls.
+func rateLimitClasses(cfg *config.Config) []custommiddleware.RouteClass {
+	return []custommiddleware.RouteClass{
+		{Name: "ai", PerIP: cfg.RateLimitAIPerIP, Burst: cfg.RateLimitAIBurst, Patterns: cfg.GetRateLimitAIRoutes()},
+		{Name: "search", PerIP: cfg.RateLimitSearchPerIP, Burst: cfg.RateLimitSearchBurst, Patterns: cfg.GetRateLimitSearchRoutes()},
+	}
+}
+
+// SetRateLimits applies the rate limit settings of a reloaded configuration
+func (s *Server) SetRateLimits(cfg *config.Config) {
+	s.rateLimiter.SetLimits(cfg.RateLimitPerIP, cfg.RateLimitBurst, cfg.RateLimitGlobal, cfg.RateLimitGlobalBurst)
+	s.rateLimiter.SetExemptPaths(cfg.GetRateLimitExemptPaths())
+	s.rateLimiter.SetRouteClasses(rateLimitClasses(cfg))
+	s.logger.Info("rate limits updated",
+		"per_ip_rate", cfg.RateLimitPerIP,
+		"per_ip_burst", cfg.RateLimitBurst,
+		"global_rate", cfg.RateLimitGlobal,
+		"global_burst", cfg.RateLimitGlobalBurst,
+		"search_per_ip_rate", cfg.RateLimitSearchPerIP,
+		"ai_per_ip_rate", cfg.RateLimitAIPerIP)
+}
+
+// SetEnrichmentConfig applies the enrichment models and fallbacks of a reloaded
+// configuration to the enrichment preview and the stale enrichment listing
+func (s *Server) SetEnrichmentConfig(cfg *config.Config) {
+	s.enrichmentConfig.Store(cfg)
+}
+
+// SetReloadFunc enables reloading the configuration through POST /v1/config/reload. It must
+// be called before the server is started.
+func (s *Server) SetReloadFunc(reload ReloadFunc) {
+	s.reload = reload
+}
+
+// bodySizeLimits returns the default and per-route request body size limits. Invalid
+// settings are rejected at startup, so the 10MB default only applies to incomplete configs.
+func bodySizeLimits(cfg *config.Config, logger *slog.Logger) (int64, []custommiddleware.BodySizeLimit) {
+	maxBodySize, err := cfg.GetMaxBodySize()
+	if err != nil {
+		logger.Warn("using the default max body size", "error", err)
+		maxBodySize = defaultMaxBodySize
+	}
+	routes, err := cfg.GetBodySizeLimits()
+	if err != nil {
+		logger.Warn("ignoring per-route body size limits", "error", err)
+	}
+
+	limits := make([]custommiddleware.BodySizeLimit, len(routes))
+	for i, route := range routes {
+		limits[i] = custommiddleware.BodySizeLimit{Pattern: route.Route, MaxBytes: route.MaxBytes}
+	}
+	return maxBodySize, limits
+}
+
 // registerRoutes registers all API routes
 func (s *Server) registerRoutes() {
 	// Experience endpoints
-	RegisterExperienceRoutes(s.api, s.client, s.dispatcher, s.logger, s.enrichmentQueue)
+	RegisterExperienceRoutes(s.api, s.config, s.client, s.reader, s.dispatcher, s.logger, s.enrichmentQueue)
+
+	// Connectors of survey tools (webhooks are served outside of Huma)
+	RegisterConnectorRoutes(s.router, s.api, s.config, s.client, s.dispatcher, s.logger, s.enrichmentQueue)
+
+	// Webhooks of any tool, mapped to experiences with templates (deliveries are served outside of Huma)
+	RegisterIngestRoutes(s.router, s.api, s.config, s.client, s.dispatcher, s.logger, s.enrichmentQueue)
+
+	// Experience translation endpoints
+	RegisterTranslationRoutes(s.api, s.config, s.client, s.dispatcher, s.logger)
+
+	// Polling triggers of no-code automation platforms
+	RegisterTriggerRoutes(s.api, s.client, s.logger)
+
+	// Incremental reads of replication tools
+	RegisterSyncRoutes(s.api, s.client, s.logger)
+
+	// Question bank endpoints
+	RegisterQuestionRoutes(s.api, s.client, s.reader, s.logger)
+
+	// Saved segments
+	RegisterSegmentRoutes(s.api, s.client, s.logger)
 
 	// Search endpoints
-	RegisterSearchRoutes(s.api, s.config, s.client, s.logger)
+	RegisterSearchRoutes(s.api, s.config, s.client, s.reader, s.logger)
+
+	// Enrichment maintenance endpoints
+	RegisterEnrichmentRoutes(s.api, s.enrichmentConfig.Load, s.client, s.logger)
+
+	// Aggregated analytics endpoints
+	RegisterAnalyticsRoutes(s.api, s.config, s.reader, s.logger)
+
+	// Field statistics endpoints
+	RegisterFieldRoutes(s.api, s.config, s.reader, s.logger)
+
+	// Read-only SQL queries for BI
+	RegisterQueryRoutes(s.api, s.config, s.queryDB, s.logger)
+
+	// Source ingestion statistics
+	RegisterSourceRoutes(s.api, s.reader, s.logger)
+
+	// AI usage reporting endpoints
+	RegisterUsageRoutes(s.api, s.reader, s.logger)
+
+	// AI job management endpoints
+	RegisterJobRoutes(s.api, s.client, s.logger)
+	RegisterQueuePauseRoutes(s.api, s.client, s.logger)
+
+	// Audit log of mutating calls
+	RegisterAuditRoutes(s.api, s.reader, s.logger)
+
+	// Webhook endpoint management
+	RegisterWebhookRoutes(s.api, s.client, s.dispatcher, s.logger)
+
+	// Scheduled exports to object storage
+	RegisterExportRoutes(s.api, s.client, s.logger)
+
+	// AI job worker monitoring endpoints
+	RegisterWorkerRoutes(s.api, s.client, s.logger)
+
+	// Configuration reload, available once SetReloadFunc was called
+	RegisterConfigRoutes(s.api, func(ctx context.Context) ([]string, error) {
+		if s.reload == nil {
+			return nil, errReloadUnavailable
+		}
+		return s.reload(ctx)
+	}, s.logger)
+
+	// GraphQL read API (served outside of Huma)
+	RegisterGraphQLRoutes(s.router, s.config, s.reader, s.logger)
+
+	// MCP server for AI assistants and agents (served outside of Huma)
+	RegisterMCPRoutes(s.router, s.config, s.client, s.reader, s.logger)
+
+	// Real-time event stream (WebSocket, served outside of Huma)
+	RegisterEventStreamRoutes(s.router, s.config, s.dispatcher, s.logger)
 }
 
-// Router returns the underlying Chi router for serving
+// Router returns the underlying Chi router for serving, with request tracing. Health
+// checks, metrics scrapes, and long-lived event streams aren't traced.
 func (s *Server) Router() http.Handler {
-	return s.router
+	return tracing.Handler(s.router, "/health", "/metrics", "/v1/events")
+}
+
+// reportServerError records the cause of a 5xx problem for the error reporter
+func reportServerError(ctx huma.Context, _ string, v any) (any, error) {
+	if p, ok := v.(*problem.Error); ok && p.Status >= 500 {
+		if cause := p.Cause(); cause != nil {
+			errorreport.SetError(ctx.Context(), cause)
+		} else {
+			errorreport.SetError(ctx.Context(), p)
+		}
+	}
+	return v, nil
 }
 
-// Start starts the HTTP server
+// traceRoute names the request's span after the route it matched
+func traceRoute(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		if pattern := chi.RouteContext(r.Context()).RoutePattern(); pattern != "" {
+			tracing.SetRoute(r.Context(), r.Method, pattern)
+		}
+	})
+}
+
+// Start starts the HTTP server and blocks until it fails or is shut down, either by
+// canceling ctx or by calling Shutdown
 func (s *Server) Start(ctx context.Context) error {
-	addr := s.config.Address()
+	tlsSetup, err := newTLSSetup(s.config)
+	if err != nil {
+		return fmt.Errorf("invalid TLS configuration: %w", err)
+	}
+
+	listeners, err := listen(s.config)
+	if err != nil {
+		return err
+	}
+	addresses := make([]string, len(listeners))
+	for i, ln := range listeners {
+		addresses[i] = ln.Addr().String()
+	}
 	s.logger.Info("starting server",
-		"address", addr,
+		"addresses", addresses,
 		"environment", s.config.Environment)
 
 	server := &http.Server{
-		Addr:    addr,
 		Handler: s.Router(),
 	}
+	scheme := "http"
+	if tlsSetup != nil {
+		server.TLSConfig = tlsSetup.config
+		scheme = "https"
+	}
+
+	// Plain HTTP port that redirects to HTTPS
+	var httpServer *http.Server
+	if tlsSetup != nil && s.config.TLSRedirectPort > 0 {
+		httpServer = &http.Server{
+			Addr:              fmt.Sprintf("%s:%d", s.config.Host, s.config.TLSRedirectPort),
+			Handler:           tlsSetup.httpHandler,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+	}
 
-	// Start server in a goroutine
-	errChan := make(chan error, 1)
-	go func() {
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			errChan <- err
+	// Register the servers for Shutdown, unless shutdown already began
+	s.mu.Lock()
+	select {
+	case <-s.stopped:
+		s.mu.Unlock()
+		for _, ln := range listeners {
+			_ = ln.Close()
 		}
-	}()
+		return nil
+	default:
+	}
+	s.servers = append(s.servers, server)
+	if httpServer != nil {
+		s.servers = append(s.servers, httpServer)
+	}
+	s.mu.Unlock()
+
+	// Serve every listener in a goroutine
+	errChan := make(chan error, len(listeners)+1)
+	for _, ln := range listeners {
+		go func() {
+			var err error
+			// Unix domain sockets are only reachable on this host, so they serve plain HTTP
+			if tlsSetup != nil && ln.Addr().Network() == "tcp" {
+				err = server.ServeTLS(ln, tlsSetup.certFile, tlsSetup.keyFile)
+			} else {
+				err = server.Serve(ln)
+			}
+			if err != nil && err != http.ErrServerClosed {
+				errChan <- err
+			}
+		}()
+	}
 
-	s.logger.Info("server started successfully",
-		"address", addr,
-		"docs", fmt.Sprintf("http://%s/docs", addr),
-		"openapi", fmt.Sprintf("http://%s/openapi.json", addr))
+	if httpServer != nil {
+		go func() {
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				errChan <- err
+			}
+		}()
+		s.logger.Info("redirecting plain HTTP to HTTPS", "address", httpServer.Addr)
+	}
 
-	// Wait for context cancellation or error
+	for _, ln := range listeners {
+		addr := ln.Addr()
+		if addr.Network() != "tcp" {
+			s.logger.Info("server started successfully", "address", "unix:"+addr.String())
+			continue
+		}
+		s.logger.Info("server started successfully",
+			"address", addr.String(),
+			"tls", tlsSetup != nil,
+			"docs", fmt.Sprintf("%s://%s/docs", scheme, addr),
+			"openapi", fmt.Sprintf("%s://%s/openapi.json", scheme, addr))
+	}
+
+	// Wait for context cancellation, Shutdown, or an error
 	select {
 	case <-ctx.Done():
-		s.logger.Info("shutting down server gracefully...")
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.ShutdownTimeout())
 		defer cancel()
-		return server.Shutdown(shutdownCtx)
+		return s.Shutdown(shutdownCtx)
+	case <-s.stopped:
+		return nil
 	case err := <-errChan:
 		return err
 	}
 }
+
+// ShutdownTimeout returns how long Shutdown may take with the configured drain delay and
+// request timeout
+func (s *Server) ShutdownTimeout() time.Duration {
+	return time.Duration(s.config.ShutdownDrainDelay+s.config.ShutdownTimeout) * time.Second
+}
+
+// Shutdown stops the server gracefully. During the configured drain delay, requests are
+// still served while /health reports 503. Then the listeners are closed and in-flight
+// requests may finish until ctx is done, after which their connections are closed.
+// Event streams are closed when the webhook dispatcher shuts down.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.stopOnce.Do(func() { close(s.stopped) })
+	servers := s.servers
+	s.servers = nil
+	s.mu.Unlock()
+
+	s.draining.Store(true)
+	if delay := time.Duration(s.config.ShutdownDrainDelay) * time.Second; delay > 0 && len(servers) > 0 {
+		s.logger.Info("draining connections before shutdown", "delay", delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+		}
+	}
+
+	s.logger.Info("shutting down server gracefully...")
+	var shutdownErr error
+	for _, server := range servers {
+		if err := server.Shutdown(ctx); err != nil {
+			// Requests still running after the timeout are cut off
+			_ = server.Close()
+			if shutdownErr == nil {
+				shutdownErr = fmt.Errorf("server shutdown: %w", err)
+			}
+		}
+	}
+	if s.queryDB != nil {
+		_ = s.queryDB.Close()
+	}
+	if shutdownErr == nil {
+		s.logger.Info("server shut down successfully")
+	}
+	return shutdownErr
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+func TestServer_Shutdown(t *testing.T) {
+	// Find a free port
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := ln.Addr().(*net.TCPAddr).Port
+	_ = ln.Close()
+
+	router := chi.NewRouter()
+	router.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	router.Get("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+	s := &Server{
+		config:  &config.Config{Host: "127.0.0.1", Port: port, ShutdownTimeout: 5},
+		logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+		router:  router,
+		stopped: make(chan struct{}),
+	}
+
+	started := make(chan error, 1)
+	go func() { started <- s.Start(context.Background()) }()
+
+	baseURL := "http://" + s.config.Address()
+	client := &http.Client{Timeout: 5 * time.Second}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := client.Get(baseURL + "/ping")
+		if err == nil {
+			_ = resp.Body.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server didn't start: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// A request in flight when shutdown begins finishes
+	slow := make(chan int, 1)
+	go func() {
+		resp, err := client.Get(baseURL + "/slow")
+		if err != nil {
+			slow <- 0
+			return
+		}
+		_ = resp.Body.Close()
+		slow <- resp.StatusCode
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("expected a graceful shutdown, got %v", err)
+	}
+	if code := <-slow; code != http.StatusOK {
+		t.Errorf("expected the in-flight request to finish, got status %d", code)
+	}
+	if err := <-started; err != nil {
+		t.Errorf("expected Start to return without an error, got %v", err)
+	}
+	if _, err := client.Get(baseURL + "/ping"); err == nil {
+		t.Error("expected new connections to be refused after shutdown")
+	}
+}
+
+func TestServer_UnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "hub.sock")
+
+	// A socket left behind by a process that didn't shut down cleanly is replaced
+	stale, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	_ = stale.Close()
+
+	router := chi.NewRouter()
+	router.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	s := &Server{
+		config:  &config.Config{Listen: "unix:" + socket, UnixSocketMode: "0600", ShutdownTimeout: 5},
+		logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+		router:  router,
+		stopped: make(chan struct{}),
+	}
+
+	started := make(chan error, 1)
+	go func() { started <- s.Start(context.Background()) }()
+
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := client.Get("http://hub/ping")
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", resp.StatusCode)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server didn't start: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	info, err := os.Stat(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o600 {
+		t.Errorf("expected socket mode 0600, got %o", mode)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatalf("expected a graceful shutdown, got %v", err)
+	}
+	if err := <-started; err != nil {
+		t.Errorf("expected Start to return without an error, got %v", err)
+	}
+	if _, err := os.Stat(socket); !os.IsNotExist(err) {
+		t.Errorf("expected the socket to be removed on shutdown, got %v", err)
+	}
+}
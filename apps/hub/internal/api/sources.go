@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// GetSourceStatsInput defines the input for the ingestion statistics of a source
+type GetSourceStatsInput struct {
+	ID         string `path:"id" doc:"Source ID"`
+	SourceType string `query:"source_type" doc:"Source type, if the source ID is used with several"`
+	Days       int    `query:"days" default:"30" minimum:"1" maximum:"365" doc:"Time window in days, ending today (UTC)"`
+}
+
+// SourceDay is the number of experiences received on one day
+type SourceDay struct {
+	Day   string `json:"day" doc:"UTC day (YYYY-MM-DD)" example:"2024-01-15"`
+	Count int    `json:"count" doc:"Number of experiences received"`
+}
+
+// Coverage is the share of the experiences eligible for AI processing that were processed
+type Coverage struct {
+	Eligible   int      `json:"eligible" doc:"Text experiences with a value_text that aren't excluded from AI processing"`
+	Processed  int      `json:"processed" doc:"Eligible experiences that were processed"`
+	Percentage *float64 `json:"percentage" doc:"Share of the eligible experiences that were processed, rounded to one decimal; null if none are eligible"`
+}
+
+// GetSourceStatsOutput defines the output for the ingestion statistics of a source
+type GetSourceStatsOutput struct {
+	Body struct {
+		SourceID          string      `json:"source_id" doc:"Source ID"`
+		SourceType        string      `json:"source_type,omitempty" doc:"Source type, if set in the request"`
+		Since             time.Time   `json:"since" doc:"Start of the time window"`
+		Responses         int         `json:"responses" doc:"Experiences received in the window"`
+		ResponsesPerDay   float64     `json:"responses_per_day" doc:"Mean experiences received per day in the window, rounded to two decimals"`
+		Days              []SourceDay `json:"days" doc:"Experiences received per day (oldest first), including days without any"`
+		UniqueRespondents int         `json:"unique_respondents" doc:"Distinct user_identifier values in the window; experiences without one aren't counted"`
+		Duplicates        int         `json:"duplicates" doc:"Experiences in the window flagged as duplicates (see SERVICE_DUPLICATE_POLICY)"`
+		Enrichment        Coverage    `json:"enrichment" doc:"Enrichment coverage of the experiences in the window"`
+		Embedding         Coverage    `json:"embedding" doc:"Embedding coverage of the experiences in the window"`
+		LastReceivedAt    *time.Time  `json:"last_received_at" doc:"When the last experience of the source was received, regardless of the window"`
+	}
+}
+
+// RegisterSourceRoutes registers the routes that report on the experiences of a source
+func RegisterSourceRoutes(api huma.API, reader *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-source-stats",
+		Method:      "GET",
+		Path:        "/v1/sources/{id}/stats",
+		Summary:     "Get source ingestion statistics",
+		Description: "Reports the experiences a source delivered per day, its unique respondents, and the share of its experiences that were enriched and embedded, so broken connectors and stalled AI processing can be spotted quickly. Experiences are counted by when Hub received them (created_at), duplicates included.",
+		Tags:        []string{"Analytics"},
+	}, func(ctx context.Context, input *GetSourceStatsInput) (*GetSourceStatsOutput, error) {
+		source := reader.ExperienceData.Query().
+			Where(experiencedata.SourceIDEQ(input.ID))
+		if input.SourceType != "" {
+			source = source.Where(experiencedata.SourceTypeEQ(input.SourceType))
+		}
+
+		last, err := source.Clone().
+			Order(ent.Desc(experiencedata.FieldCreatedAt)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, problem.New(http.StatusNotFound, problem.CodeNotFound, fmt.Sprintf("No experiences of source %s", input.ID))
+			}
+			return nil, handleDatabaseError(logger, err, "get", "last experience of source")
+		}
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		since := today.AddDate(0, 0, 1-input.Days)
+		window := source.Clone().Where(experiencedata.CreatedAtGTE(since))
+
+		var totals []struct {
+			Responses         int `json:"responses"`
+			UniqueRespondents int `json:"unique_respondents"`
+			Duplicates        int `json:"duplicates"`
+			Eligible          int `json:"eligible"`
+			Enriched          int `json:"enriched"`
+			Embedded          int `json:"embedded"`
+		}
+		// eligible matches the experiences the AI job workers process
+		eligible := func(s *sql.Selector) string {
+			return fmt.Sprintf("%s = '%s' AND %s <> '' AND NOT %s",
+				s.C(experiencedata.FieldFieldType), models.FieldTypeText, s.C(experiencedata.FieldValueText), s.C(experiencedata.FieldSkipAiProcessing))
+		}
+		err = window.Clone().
+			Aggregate(
+				ent.As(ent.Count(), "responses"),
+				func(s *sql.Selector) string {
+					return sql.As(fmt.Sprintf("count(DISTINCT %s)", s.C(experiencedata.FieldUserIdentifier)), "unique_respondents")
+				},
+				func(s *sql.Selector) string {
+					return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s IS NOT NULL)", s.C(experiencedata.FieldDuplicateOf)), "duplicates")
+				},
+				func(s *sql.Selector) string {
+					return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s)", eligible(s)), "eligible")
+				},
+				func(s *sql.Selector) string {
+					return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s AND (%s IS NOT NULL OR %s IS NOT NULL))",
+						eligible(s), s.C(experiencedata.FieldEnrichmentVersion), s.C(experiencedata.FieldSentiment)), "enriched")
+				},
+				func(s *sql.Selector) string {
+					return sql.As(fmt.Sprintf("count(*) FILTER (WHERE %s AND %s IS NOT NULL)", eligible(s), s.C(experiencedata.FieldEmbedding)), "embedded")
+				},
+			).
+			Scan(ctx, &totals)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "source stats")
+		}
+
+		var days []SourceDay
+		err = window.Clone().
+			Aggregate(
+				func(s *sql.Selector) string {
+					expr := fmt.Sprintf("to_char(date_trunc('day', %s AT TIME ZONE 'UTC'), 'YYYY-MM-DD')", s.C(experiencedata.FieldCreatedAt))
+					s.GroupBy(expr).OrderBy(expr)
+					return sql.As(expr, "day")
+				},
+				ent.As(ent.Count(), "count"),
+			).
+			Scan(ctx, &days)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "source days")
+		}
+
+		output := &GetSourceStatsOutput{}
+		output.Body.SourceID = input.ID
+		output.Body.SourceType = input.SourceType
+		output.Body.Since = since
+		output.Body.LastReceivedAt = &last.CreatedAt
+		if len(totals) > 0 {
+			t := totals[0]
+			output.Body.Responses = t.Responses
+			output.Body.UniqueRespondents = t.UniqueRespondents
+			output.Body.Duplicates = t.Duplicates
+			output.Body.Enrichment = coverage(t.Eligible, t.Enriched)
+			output.Body.Embedding = coverage(t.Eligible, t.Embedded)
+		} else {
+			output.Body.Enrichment, output.Body.Embedding = coverage(0, 0), coverage(0, 0)
+		}
+		output.Body.ResponsesPerDay = math.Round(float64(output.Body.Responses)/float64(input.Days)*100) / 100
+
+		// Days without experiences are the ones that show a broken connector, so they're listed too
+		counts := make(map[string]int, len(days))
+		for _, day := range days {
+			counts[day.Day] = day.Count
+		}
+		output.Body.Days = make([]SourceDay, input.Days)
+		for i := range output.Body.Days {
+			day := since.AddDate(0, 0, i).Format(time.DateOnly)
+			output.Body.Days[i] = SourceDay{Day: day, Count: counts[day]}
+		}
+		return output, nil
+	})
+}
+
+// coverage returns the share of the eligible experiences that were processed
+func coverage(eligible, processed int) Coverage {
+	c := Coverage{Eligible: eligible, Processed: processed}
+	if eligible > 0 {
+		p := percentage(processed, eligible)
+		c.Percentage = &p
+	}
+	return c
+}
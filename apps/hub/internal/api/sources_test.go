@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSourceStats(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	for _, response := range []struct {
+		user      string
+		text      string
+		sentiment string
+	}{
+		{user: "user-1", text: "Great onboarding", sentiment: "positive"},
+		{user: "user-1", text: "Setup took too long"},
+		{user: "user-2", text: ""},
+	} {
+		create := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetSourceID("onboarding").
+			SetFieldID("feedback").
+			SetFieldType("text").
+			SetValueText(response.text).
+			SetUserIdentifier(response.user)
+		if response.sentiment != "" {
+			create = create.SetSentiment(response.sentiment)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			t.Fatalf("failed to create test experience: %v", err)
+		}
+	}
+
+	resp := api.Get("/v1/sources/onboarding/stats?days=7")
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+	}
+	var stats GetSourceStatsOutput
+	if err := json.Unmarshal(resp.Body.Bytes(), &stats.Body); err != nil {
+		t.Fatal(err)
+	}
+	if stats.Body.Responses != 3 || stats.Body.UniqueRespondents != 2 || stats.Body.ResponsesPerDay != 0.43 {
+		t.Errorf("unexpected stats: %+v", stats.Body)
+	}
+	if len(stats.Body.Days) != 7 || stats.Body.Days[6].Count != 3 || stats.Body.Days[0].Count != 0 {
+		t.Errorf("expected 7 days with today's responses last, got %+v", stats.Body.Days)
+	}
+	if c := stats.Body.Enrichment; c.Eligible != 2 || c.Processed != 1 || c.Percentage == nil || *c.Percentage != 50 {
+		t.Errorf("unexpected enrichment coverage: %+v", c)
+	}
+	if c := stats.Body.Embedding; c.Processed != 0 || c.Percentage == nil || *c.Percentage != 0 {
+		t.Errorf("unexpected embedding coverage: %+v", c)
+	}
+
+	resp = api.Get("/v1/sources/unknown/stats")
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", resp.Code)
+	}
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// syncSettleDelay holds back changes this recent, so a change whose transaction commits
+// after a read can't end up behind the state the read returned
+const syncSettleDelay = 5 * time.Second
+
+// SyncChangesInput defines the input for reading changes
+type SyncChangesInput struct {
+	State string `query:"state" doc:"state of the previous page; changes after it are returned. Without it, the sync starts from the first experience."`
+	Limit int    `query:"limit" default:"500" minimum:"1" maximum:"1000" doc:"Most records and most deletions to return"`
+}
+
+// SyncDeletion is an experience deleted since the previous page
+type SyncDeletion struct {
+	ID        uuid.UUID `json:"id" doc:"ID of the deleted experience"`
+	DeletedAt time.Time `json:"deleted_at" doc:"When the experience was deleted"`
+}
+
+// SyncChangesOutput defines the output of a page of changes
+type SyncChangesOutput struct {
+	Body struct {
+		Records   []ExperienceData `json:"records" doc:"Experiences created or changed since the state, by updated_at and then id; upsert them by id"`
+		Deletions []SyncDeletion   `json:"deletions" doc:"Experiences deleted since the state, oldest first; delete them by id after upserting the records"`
+		State     string           `json:"state" doc:"Opaque state to store once the page is written and pass to the next read; returned even if nothing changed"`
+		HasMore   bool             `json:"has_more" doc:"Whether more changes follow; read again right away to get them"`
+	}
+}
+
+// syncState is the position of a replica in both streams: the updated_at and ID of the last
+// record, and the time and ID of the last deletion
+type syncState struct {
+	UpdatedAt  time.Time `json:"u"`
+	RecordID   uuid.UUID `json:"r"`
+	DeletedAt  time.Time `json:"d"`
+	DeletionID uuid.UUID `json:"x"`
+}
+
+// encode returns the opaque form of the state
+func (s syncState) encode() string {
+	data, _ := json.Marshal(s)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parseSyncState parses a state returned by a read
+func parseSyncState(value string) (syncState, error) {
+	var s syncState
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err == nil {
+		err = json.Unmarshal(data, &s)
+	}
+	if err != nil {
+		return syncState{}, problem.New(http.StatusBadRequest, problem.CodeInvalidSyncState,
+			"Invalid 'state'. Pass the state of the previous page as is, or omit it to start a full sync")
+	}
+	return s, nil
+}
+
+// RegisterSyncRoutes registers the incremental read of experiences for replication tools
+// such as Airbyte and Fivetran. It reads from the primary database: on a lagging replica,
+// changes would show up behind the state of an earlier read and never be returned.
+func RegisterSyncRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "sync-changes",
+		Method:      "GET",
+		Path:        "/v1/sync/changes",
+		Summary:     "Read changed and deleted experiences",
+		Description: "Returns the experiences created or changed and the experiences deleted since the state of the previous page, for tools that replicate experiences incrementally. Records are ordered by updated_at and then id, so none are skipped however many share a timestamp; changes of the last five seconds are held back until the next read. Store the returned state once the page is written, and read again right away while has_more is true. An experience changed again after it was returned is returned again.",
+		Tags:        []string{"Experiences"},
+	}, func(ctx context.Context, input *SyncChangesInput) (*SyncChangesOutput, error) {
+		var state syncState
+		if input.State != "" {
+			var err error
+			if state, err = parseSyncState(input.State); err != nil {
+				return nil, err
+			}
+		}
+		settled := time.Now().UTC().Add(-syncSettleDelay)
+
+		records, err := client.ExperienceData.Query().
+			Where(func(s *sql.Selector) {
+				s.Where(sql.And(
+					sql.LTE(s.C(experiencedata.FieldUpdatedAt), settled),
+					sql.Or(
+						sql.GT(s.C(experiencedata.FieldUpdatedAt), state.UpdatedAt),
+						sql.And(sql.EQ(s.C(experiencedata.FieldUpdatedAt), state.UpdatedAt), sql.GT(s.C(experiencedata.FieldID), state.RecordID)),
+					),
+				))
+			}).
+			Order(ent.Asc(experiencedata.FieldUpdatedAt), ent.Asc(experiencedata.FieldID)).
+			Limit(input.Limit + 1).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "read", "changed experiences")
+		}
+
+		deletions, err := client.ExperienceDeletion.Query().
+			Where(func(s *sql.Selector) {
+				s.Where(sql.And(
+					sql.LTE(s.C(experiencedeletion.FieldCreatedAt), settled),
+					sql.Or(
+						sql.GT(s.C(experiencedeletion.FieldCreatedAt), state.DeletedAt),
+						sql.And(sql.EQ(s.C(experiencedeletion.FieldCreatedAt), state.DeletedAt), sql.GT(s.C(experiencedeletion.FieldID), state.DeletionID)),
+					),
+				))
+			}).
+			Order(ent.Asc(experiencedeletion.FieldCreatedAt), ent.Asc(experiencedeletion.FieldID)).
+			Limit(input.Limit + 1).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "read", "deleted experiences")
+		}
+
+		output := &SyncChangesOutput{}
+		if len(records) > input.Limit {
+			records = records[:input.Limit]
+			output.Body.HasMore = true
+		}
+		if len(deletions) > input.Limit {
+			deletions = deletions[:input.Limit]
+			output.Body.HasMore = true
+		}
+
+		output.Body.Records = make([]ExperienceData, len(records))
+		for i, exp := range records {
+			output.Body.Records[i] = entityToOutput(exp)
+		}
+		if len(records) > 0 {
+			last := records[len(records)-1]
+			state.UpdatedAt, state.RecordID = last.UpdatedAt, last.ID
+		}
+		output.Body.Deletions = make([]SyncDeletion, len(deletions))
+		for i, d := range deletions {
+			output.Body.Deletions[i] = SyncDeletion{ID: d.ExperienceID, DeletedAt: d.CreatedAt}
+		}
+		if len(deletions) > 0 {
+			last := deletions[len(deletions)-1]
+			state.DeletedAt, state.DeletionID = last.CreatedAt, last.ID
+		}
+		output.Body.State = state.encode()
+		return output, nil
+	})
+}
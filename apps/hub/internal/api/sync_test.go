@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSyncState(t *testing.T) {
+	s := syncState{
+		UpdatedAt:  time.Date(2024, 1, 15, 10, 30, 0, 123456000, time.UTC),
+		RecordID:   uuid.New(),
+		DeletedAt:  time.Date(2024, 1, 14, 9, 0, 0, 0, time.UTC),
+		DeletionID: uuid.New(),
+	}
+	parsed, err := parseSyncState(s.encode())
+	if err != nil {
+		t.Fatalf("parseSyncState() error = %v", err)
+	}
+	if !parsed.UpdatedAt.Equal(s.UpdatedAt) || parsed.RecordID != s.RecordID || !parsed.DeletedAt.Equal(s.DeletedAt) || parsed.DeletionID != s.DeletionID {
+		t.Errorf("got %+v, want %+v", parsed, s)
+	}
+
+	for _, invalid := range []string{"2024-01-15T10:30:00Z", "bm90LWEtc3RhdGU"} {
+		if _, err := parseSyncState(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestSyncChanges(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Experiences changed long enough ago to be settled, two of them at the same time
+	changed := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	var ids []uuid.UUID
+	for i, at := range []time.Time{changed, changed, changed.Add(time.Minute)} {
+		exp := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID(fmt.Sprintf("q%d", i)).
+			SetFieldType("text").
+			SetValueText("feedback").
+			SetUpdatedAt(at).
+			SaveX(ctx)
+		ids = append(ids, exp.ID)
+	}
+	slices.SortFunc(ids[:2], func(a, b uuid.UUID) int { return strings.Compare(a.String(), b.String()) })
+
+	read := func(state string, limit int) SyncChangesOutput {
+		t.Helper()
+		resp := api.Get(fmt.Sprintf("/v1/sync/changes?limit=%d&state=%s", limit, state))
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		var output SyncChangesOutput
+		if err := json.Unmarshal(resp.Body.Bytes(), &output.Body); err != nil {
+			t.Fatal(err)
+		}
+		return output
+	}
+
+	first := read("", 1)
+	if len(first.Body.Records) != 1 || first.Body.Records[0].ID != ids[0] || !first.Body.HasMore {
+		t.Fatalf("unexpected first page: %+v", first.Body)
+	}
+	second := read(first.Body.State, 2)
+	if len(second.Body.Records) != 2 || second.Body.Records[0].ID != ids[1] || second.Body.Records[1].ID != ids[2] || second.Body.HasMore {
+		t.Fatalf("expected the experience of the same time and the later one, got %+v", second.Body)
+	}
+	if again := read(second.Body.State, 10); len(again.Body.Records) != 0 || again.Body.State != second.Body.State {
+		t.Errorf("expected no changes and the same state, got %+v", again.Body)
+	}
+
+	t.Run("deletions", func(t *testing.T) {
+		resp := api.Delete("/v1/experiences/" + ids[0].String())
+		if resp.Code != http.StatusNoContent {
+			t.Fatalf("expected status 204, got %d: %s", resp.Code, resp.Body.String())
+		}
+		deletion := client.ExperienceDeletion.Query().OnlyX(ctx)
+		if deletion.ExperienceID != ids[0] {
+			t.Errorf("expected the deletion of %s, got %s", ids[0], deletion.ExperienceID)
+		}
+
+		// Deletions are returned once settled
+		client.ExperienceDeletion.DeleteOne(deletion).ExecX(ctx)
+		client.ExperienceDeletion.Create().SetExperienceID(ids[0]).SetCreatedAt(changed.Add(time.Hour)).SaveX(ctx)
+		page := read(second.Body.State, 10)
+		if len(page.Body.Deletions) != 1 || page.Body.Deletions[0].ID != ids[0] || len(page.Body.Records) != 0 {
+			t.Fatalf("expected the deletion, got %+v", page.Body)
+		}
+		if again := read(page.Body.State, 10); len(again.Body.Deletions) != 0 {
+			t.Errorf("expected the deletion only once, got %+v", again.Body)
+		}
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		resp := api.Get("/v1/sync/changes?state=not-a-state")
+		if resp.Code != http.StatusBadRequest || !strings.Contains(resp.Body.String(), "invalid_sync_state") {
+			t.Errorf("expected invalid_sync_state, got %d: %s", resp.Code, resp.Body.String())
+		}
+	})
+}
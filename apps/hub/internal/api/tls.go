@@ -0,0 +1,97 @@
+package api
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// tlsSetup is how the server serves HTTPS
+type tlsSetup struct {
+	config   *tls.Config
+	certFile string
+	keyFile  string
+	// httpHandler serves the plain HTTP port: ACME challenges and redirects to HTTPS
+	httpHandler http.Handler
+}
+
+// newTLSSetup returns the TLS setup of the configuration, or nil to serve plain HTTP.
+// Certificates come either from files or from Let's Encrypt.
+func newTLSSetup(cfg *config.Config) (*tlsSetup, error) {
+	domains := cfg.GetTLSAutocertDomains()
+	hasFiles := cfg.TLSCertFile != "" || cfg.TLSKeyFile != ""
+
+	switch {
+	case hasFiles && len(domains) > 0:
+		return nil, errors.New("TLS certificate files and autocert domains are mutually exclusive")
+	case hasFiles:
+		if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+			return nil, errors.New("both a TLS certificate file and a key file are required")
+		}
+		return &tlsSetup{
+			config:      &tls.Config{MinVersion: tls.VersionTLS12},
+			certFile:    cfg.TLSCertFile,
+			keyFile:     cfg.TLSKeyFile,
+			httpHandler: redirectToHTTPS(httpsPort(cfg)),
+		}, nil
+	case len(domains) > 0:
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(domains...),
+			Cache:      autocert.DirCache(cfg.TLSAutocertCacheDir),
+			Email:      cfg.TLSAutocertEmail,
+		}
+		tlsConfig := manager.TLSConfig()
+		tlsConfig.MinVersion = tls.VersionTLS12
+		return &tlsSetup{
+			config: tlsConfig,
+			// Answers HTTP-01 challenges and redirects everything else
+			httpHandler: manager.HTTPHandler(nil),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// httpsPort returns the port of the first TCP listener, which plain HTTP requests are
+// redirected to
+func httpsPort(cfg *config.Config) int {
+	listeners, _ := cfg.GetListeners()
+	for _, listener := range listeners {
+		if listener.Network != "tcp" {
+			continue
+		}
+		if _, port, err := net.SplitHostPort(listener.Address); err == nil {
+			if p, err := strconv.Atoi(port); err == nil {
+				return p
+			}
+		}
+	}
+	return cfg.Port
+}
+
+// redirectToHTTPS returns a handler that redirects plain HTTP requests to the same URL over
+// HTTPS on the port
+func redirectToHTTPS(port int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			problem.Write(w, http.StatusBadRequest, problem.CodeBadRequest, "Use HTTPS")
+			return
+		}
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if port != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(port))
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusFound)
+	})
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+)
+
+func TestNewTLSSetup(t *testing.T) {
+	setup, err := newTLSSetup(&config.Config{})
+	if err != nil || setup != nil {
+		t.Fatalf("expected plain HTTP without TLS settings, got %v, %v", setup, err)
+	}
+
+	if _, err := newTLSSetup(&config.Config{TLSCertFile: "cert.pem"}); err == nil {
+		t.Error("expected an error for a certificate without a key")
+	}
+	if _, err := newTLSSetup(&config.Config{TLSCertFile: "cert.pem", TLSKeyFile: "key.pem", TLSAutocertDomains: "hub.example.com"}); err == nil {
+		t.Error("expected an error for certificate files combined with autocert")
+	}
+
+	setup, err = newTLSSetup(&config.Config{TLSAutocertDomains: "hub.example.com", TLSAutocertCacheDir: t.TempDir()})
+	if err != nil || setup == nil || setup.config.GetCertificate == nil {
+		t.Fatalf("expected an autocert setup, got %v, %v", setup, err)
+	}
+}
+
+func TestRedirectToHTTPS(t *testing.T) {
+	tests := []struct {
+		port int
+		host string
+		want string
+	}{
+		{443, "hub.example.com", "https://hub.example.com/v1/experiences?limit=5"},
+		{8443, "hub.example.com:8080", "https://hub.example.com:8443/v1/experiences?limit=5"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "http://"+tt.host+"/v1/experiences?limit=5", nil)
+		rec := httptest.NewRecorder()
+		redirectToHTTPS(tt.port).ServeHTTP(rec, req)
+		if rec.Code != http.StatusFound || rec.Header().Get("Location") != tt.want {
+			t.Errorf("port %d: expected a redirect to %s, got %d %s", tt.port, tt.want, rec.Code, rec.Header().Get("Location"))
+		}
+	}
+}
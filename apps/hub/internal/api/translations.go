@@ -0,0 +1,201 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/usage"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// maxTranslationAttempts is how often storing a translation is retried when the
+// experience was updated while it was being translated
+const maxTranslationAttempts = 3
+
+// TranslateExperienceInput defines the input for translating an experience
+type TranslateExperienceInput struct {
+	ID   string `path:"id" doc:"Experience ID (UUID)" format:"uuid"`
+	Body struct {
+		Language string `json:"language" example:"de" doc:"ISO code of the language to translate into" minLength:"2" maxLength:"10" pattern:"^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$"`
+		Refresh  bool   `json:"refresh,omitempty" doc:"Translate again even if a translation into the language is stored"`
+	}
+}
+
+// DeleteTranslationInput identifies a stored translation of an experience
+type DeleteTranslationInput struct {
+	ID       string `path:"id" doc:"Experience ID (UUID)" format:"uuid"`
+	Language string `path:"language" example:"de" doc:"ISO code of the translation's language"`
+}
+
+// RegisterTranslationRoutes registers the routes that translate experiences with the
+// configured chat provider
+func RegisterTranslationRoutes(api huma.API, cfg *config.Config, client *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger) {
+	usageRecorder := usage.NewRecorder(client, logger)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "translate-experience",
+		Method:      "POST",
+		Path:        "/v1/experiences/{id}/translations",
+		Summary:     "Translate an experience",
+		Description: "Translates the experience's value_text and field_label into a language with the configured chat provider and its fallbacks, and stores the result under translations. The original values are kept. A stored translation is returned as is unless refresh is set. Translations are cleared when value_text changes. Token usage is recorded under the translation job type.",
+		Tags:        []string{"Experiences"},
+	}, func(ctx context.Context, input *TranslateExperienceInput) (*ExperienceOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+		language := input.Body.Language
+
+		exp, err := client.ExperienceData.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+
+		if _, ok := exp.Translations[language]; ok && !input.Body.Refresh {
+			return &ExperienceOutput{Body: entityToOutput(exp)}, nil
+		}
+		if (exp.ValueText == nil || *exp.ValueText == "") && exp.FieldLabel == "" {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidFieldType, "Only experiences with a text response or a field label can be translated")
+		}
+		if strings.EqualFold(exp.Language, language) {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeBadRequest, fmt.Sprintf("The experience is already in %s", exp.Language))
+		}
+		if exp.SkipAiProcessing || cfg.SkipsAIForSource(exp.SourceType, exp.SourceID) {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeAIProcessingDisabled, "AI processing is disabled for this experience")
+		}
+
+		// Custom enrichers can't translate, so only the chat providers are used
+		if !cfg.IsEnrichmentEnabled() {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Translation is not enabled. Configure an OpenAI or Gemini enrichment provider and its API key.")
+		}
+		providers, err := ai.NewChatProviders(cfg)
+		if err != nil {
+			return nil, handleServiceError(logger, err, "translation", "create service")
+		}
+		if len(providers) == 0 {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeFeatureDisabled, "Translation is not enabled. Configure an OpenAI or Gemini enrichment provider or fallback and its API key.")
+		}
+		svc := enrichment.NewService(providers, cfg.EnrichmentTimeout, logger)
+
+		text := ""
+		if exp.ValueText != nil {
+			text = *exp.ValueText
+		}
+		result, err := svc.Translate(ctx, text, exp.FieldLabel, exp.Language, language)
+		if err != nil {
+			return nil, handleServiceError(logger, err, "translation", "translate")
+		}
+		usageRecorder.RecordAsync(usage.Entry{
+			JobType:  usage.JobTypeTranslation,
+			Provider: result.Provider,
+			Model:    result.Model,
+			Usage:    result.Usage,
+		})
+
+		translation := schema.Translation{
+			ValueText:    result.ValueText,
+			FieldLabel:   result.FieldLabel,
+			Provider:     result.Provider,
+			Model:        result.Model,
+			TranslatedAt: time.Now().UTC(),
+		}
+
+		// Other translations may be stored concurrently, so the translation is merged into the
+		// stored ones and only written if the experience is unchanged since it was read
+		for attempt := 1; ; attempt++ {
+			translations := make(map[string]schema.Translation, len(exp.Translations)+1)
+			for l, t := range exp.Translations {
+				translations[l] = t
+			}
+			translations[language] = translation
+
+			n, err := client.ExperienceData.Update().
+				Where(experiencedata.ID(id), experiencedata.UpdatedAtEQ(exp.UpdatedAt)).
+				SetTranslations(translations).
+				Save(ctx)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "update", id.String())
+			}
+
+			previous := exp
+			exp, err = client.ExperienceData.Get(ctx, id)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "get", id.String())
+			}
+			if n > 0 {
+				break
+			}
+
+			// The translation is stale if the translated values changed in the meantime
+			textChanged := (previous.ValueText == nil) != (exp.ValueText == nil) ||
+				(exp.ValueText != nil && *previous.ValueText != *exp.ValueText)
+			if textChanged || previous.FieldLabel != exp.FieldLabel || attempt == maxTranslationAttempts {
+				return nil, problem.New(http.StatusConflict, problem.CodeConflict, "The experience changed while it was translated. Try again.")
+			}
+		}
+
+		logger.Info("experience translated", "id", id, "language", language, "provider", result.Provider, "model", result.Model)
+
+		output := entityToOutput(exp)
+		dispatcher.DispatchAsync(ctx, webhook.EventExperienceUpdated, output)
+		return &ExperienceOutput{Body: output}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-translation",
+		Method:      "DELETE",
+		Path:        "/v1/experiences/{id}/translations/{language}",
+		Summary:     "Delete a translation",
+		Description: "Deletes the stored translation of an experience into a language, e.g. to discard a poor translation. The original values are not changed.",
+		Tags:        []string{"Experiences"},
+	}, func(ctx context.Context, input *DeleteTranslationInput) (*ExperienceOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		exp, err := client.ExperienceData.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		if _, ok := exp.Translations[input.Language]; !ok {
+			return nil, problem.New(http.StatusNotFound, problem.CodeNotFound, fmt.Sprintf("The experience has no translation into %s", input.Language))
+		}
+
+		translations := make(map[string]schema.Translation, len(exp.Translations))
+		for l, t := range exp.Translations {
+			if l != input.Language {
+				translations[l] = t
+			}
+		}
+		update := client.ExperienceData.UpdateOneID(id)
+		if len(translations) == 0 {
+			update.ClearTranslations()
+		} else {
+			update.SetTranslations(translations)
+		}
+		exp, err = update.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "update", id.String())
+		}
+
+		logger.Info("experience translation deleted", "id", id, "language", input.Language)
+
+		output := entityToOutput(exp)
+		dispatcher.DispatchAsync(ctx, webhook.EventExperienceUpdated, output)
+		return &ExperienceOutput{Body: output}, nil
+	})
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+)
+
+func TestTranslations(t *testing.T) {
+	api, client, cleanup := setupTestAPI(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	exp, err := client.ExperienceData.Create().
+		SetSourceType("survey").
+		SetFieldID("q1").
+		SetFieldType("text").
+		SetValueText("Way too expensive").
+		SetLanguage("en").
+		SetTranslations(map[string]schema.Translation{
+			"de": {ValueText: "Viel zu teuer", Provider: "openai", Model: "gpt-4o-mini", TranslatedAt: time.Now()},
+			"fr": {ValueText: "Beaucoup trop cher", Provider: "openai", Model: "gpt-4o-mini", TranslatedAt: time.Now()},
+		}).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := "/v1/experiences/" + exp.ID.String() + "/translations"
+
+	t.Run("returns a stored translation", func(t *testing.T) {
+		resp := api.Post(path, map[string]interface{}{"language": "de"})
+
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if !strings.Contains(resp.Body.String(), `"value_text":"Viel zu teuer"`) {
+			t.Errorf("expected the stored translation, got %s", resp.Body.String())
+		}
+	})
+
+	t.Run("translation requires a chat provider", func(t *testing.T) {
+		resp := api.Post(path, map[string]interface{}{"language": "es"})
+
+		if resp.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if !strings.Contains(resp.Body.String(), `"code":"feature_disabled"`) {
+			t.Errorf("expected a feature_disabled problem, got %s", resp.Body.String())
+		}
+	})
+
+	t.Run("delete translation", func(t *testing.T) {
+		resp := api.Delete(path + "/fr")
+
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if strings.Contains(resp.Body.String(), `"fr"`) {
+			t.Errorf("expected the translation to be deleted, got %s", resp.Body.String())
+		}
+
+		resp = api.Delete(path + "/fr")
+		if resp.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", resp.Code)
+		}
+	})
+
+	t.Run("changing the text clears translations", func(t *testing.T) {
+		resp := api.Patch("/v1/experiences/"+exp.ID.String(), map[string]interface{}{
+			"value_text": "Too expensive for small teams",
+		})
+
+		if resp.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", resp.Code, resp.Body.String())
+		}
+		if strings.Contains(resp.Body.String(), `"translations"`) {
+			t.Errorf("expected translations to be cleared, got %s", resp.Body.String())
+		}
+	})
+}
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+)
+
+// triggerSettleDelay holds back experiences changed this recently, so one whose transaction
+// commits after a poll can't end up behind the cursor the poll returned
+const triggerSettleDelay = 2 * time.Second
+
+// ListTriggerExperiencesInput defines the input for polling experiences
+type ListTriggerExperiencesInput struct {
+	experiencefilter.Filter
+	Event        string `query:"event" default:"created" enum:"created,updated,enriched" doc:"Experiences to return: created, updated (created or changed in any way), or enriched by AI"`
+	UpdatedSince string `query:"updated_since" doc:"next_cursor of the previous poll, or an RFC 3339 timestamp; only experiences of the event after it are returned, oldest first, so none are skipped. Without it, the most recent experiences are returned."`
+	SegmentID    string `query:"segment_id" doc:"Only return the experiences of this saved segment (see /v1/segments), in addition to the other filters" format:"uuid"`
+	Limit        int    `query:"limit" default:"50" minimum:"1" maximum:"100" doc:"Number of experiences to return"`
+}
+
+// TriggerExperience is an experience in the flat form no-code platforms map fields from
+type TriggerExperience struct {
+	ID             string    `json:"id" doc:"Deduplication ID: the same for every poll that returns the experience for the same event, so platforms trigger once"`
+	ExperienceID   string    `json:"experience_id" doc:"Experience ID"`
+	Event          string    `json:"event" doc:"Event of the trigger" enum:"created,updated,enriched"`
+	OccurredAt     time.Time `json:"occurred_at" doc:"When the experience was created (created) or last changed (updated, enriched)"`
+	CollectedAt    time.Time `json:"collected_at" doc:"When the feedback was collected"`
+	SourceType     string    `json:"source_type" doc:"Type of feedback source"`
+	SourceID       string    `json:"source_id" doc:"Reference to survey/form/ticket ID"`
+	SourceName     string    `json:"source_name" doc:"Human-readable name of the source"`
+	FieldID        string    `json:"field_id" doc:"Identifier for the question/field"`
+	FieldLabel     string    `json:"field_label" doc:"The question text"`
+	FieldType      string    `json:"field_type" doc:"Type of field"`
+	Value          string    `json:"value" doc:"The response as text, whatever its field type; empty for value_json"`
+	ValueNumber    *float64  `json:"value_number" doc:"Numeric response"`
+	UserIdentifier string    `json:"user_identifier" doc:"User identifier"`
+	Language       string    `json:"language" doc:"ISO language code"`
+	Country        string    `json:"country" doc:"Country of the respondent"`
+	Sentiment      string    `json:"sentiment" doc:"AI-detected sentiment: positive, negative, neutral"`
+	SentimentScore *float64  `json:"sentiment_score" doc:"Sentiment intensity from -1 (negative) to +1 (positive)"`
+	Emotion        string    `json:"emotion" doc:"AI-detected emotion"`
+	Topics         string    `json:"topics" doc:"Comma-separated topics extracted by AI"`
+	UrgencyScore   *float64  `json:"urgency_score" doc:"AI-estimated triage urgency from 0 to 1"`
+	IsSpam         bool      `json:"is_spam" doc:"Whether AI flagged the response as spam"`
+}
+
+// ListTriggerExperiencesOutput defines the output of a poll
+type ListTriggerExperiencesOutput struct {
+	Body struct {
+		Data       []TriggerExperience `json:"data" doc:"Experiences, newest first"`
+		NextCursor string              `json:"next_cursor" doc:"Pass as updated_since in the next poll; returned even if no experience matched"`
+		More       bool                `json:"more" doc:"Whether more experiences follow the page; poll again right away to get them"`
+	}
+}
+
+// triggerCursor is the position of a poll: the time of the event of the last experience
+// returned, and its ID to order experiences of the same time
+type triggerCursor struct {
+	At time.Time
+	ID uuid.UUID
+}
+
+// encode returns the opaque form of the cursor
+func (c triggerCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.At.UTC().Format(time.RFC3339Nano) + "_" + c.ID.String()))
+}
+
+// parseTriggerCursor parses a cursor returned by a poll, or a timestamp
+func parseTriggerCursor(value string) (triggerCursor, error) {
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return triggerCursor{At: at}, nil
+	}
+	invalid := invalidTimestamp("Invalid 'updated_since'. Expected the next_cursor of a poll or an ISO 8601 (RFC3339) timestamp, e.g., 2024-01-01T00:00:00Z")
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return triggerCursor{}, invalid
+	}
+	at, id, ok := strings.Cut(string(decoded), "_")
+	if !ok {
+		return triggerCursor{}, invalid
+	}
+	var c triggerCursor
+	if c.At, err = time.Parse(time.RFC3339Nano, at); err != nil {
+		return triggerCursor{}, invalid
+	}
+	if c.ID, err = uuid.Parse(id); err != nil {
+		return triggerCursor{}, invalid
+	}
+	return c, nil
+}
+
+// RegisterTriggerRoutes registers the polling trigger of no-code automation platforms. It
+// reads from the primary database: on a lagging replica, experiences would show up behind
+// the cursor of an earlier poll and never be returned.
+func RegisterTriggerRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-trigger-experiences",
+		Method:      "GET",
+		Path:        "/v1/triggers/experiences",
+		Summary:     "Poll new, updated, or enriched experiences",
+		Description: "Returns experiences in a compact, flat form for the polling triggers of Zapier, Make, n8n, and similar platforms. Each experience has a deduplication id, which is the same whenever it's returned for the same event, and the response has a next_cursor to pass as updated_since next time. Experiences changed in the last two seconds are held back until the next poll, so none are skipped. Accepts the filters of GET /v1/experiences.",
+		Tags:        []string{"Experiences"},
+	}, func(ctx context.Context, input *ListTriggerExperiencesInput) (*ListTriggerExperiencesOutput, error) {
+		query, err := filterExperiences(client.ExperienceData.Query(), input.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if input.SegmentID != "" {
+			segmentFilter, err := loadSegment(ctx, client, input.SegmentID, logger)
+			if err != nil {
+				return nil, err
+			}
+			if query, err = filterExperiences(query, *segmentFilter); err != nil {
+				return nil, err
+			}
+		}
+
+		// The time of the event is when the experience was created or last changed
+		key := experiencedata.FieldCreatedAt
+		if input.Event != "created" {
+			key = experiencedata.FieldUpdatedAt
+		}
+		if input.Event == "enriched" {
+			query = query.Where(experiencedata.Or(experiencedata.EnrichmentVersionNotNil(), experiencedata.SentimentNotNil()))
+		}
+		settled := time.Now().UTC().Add(-triggerSettleDelay)
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sql.LTE(s.C(key), settled))
+		})
+
+		var cursor *triggerCursor
+		if input.UpdatedSince != "" {
+			c, err := parseTriggerCursor(input.UpdatedSince)
+			if err != nil {
+				return nil, err
+			}
+			cursor = &c
+			query = query.Where(func(s *sql.Selector) {
+				s.Where(sql.Or(
+					sql.GT(s.C(key), c.At),
+					sql.And(sql.EQ(s.C(key), c.At), sql.GT(s.C(experiencedata.FieldID), c.ID)),
+				))
+			})
+		}
+
+		// After a cursor, the oldest experiences come first so the next poll continues where
+		// this one ended; the page is then turned around like the latest experiences
+		order := ent.Desc
+		if cursor != nil {
+			order = ent.Asc
+		}
+		experiences, err := query.
+			Order(order(key), order(experiencedata.FieldID)).
+			Limit(input.Limit + 1).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "poll", "experiences")
+		}
+
+		output := &ListTriggerExperiencesOutput{}
+		if len(experiences) > input.Limit {
+			experiences = experiences[:input.Limit]
+			output.Body.More = cursor != nil
+		}
+		if cursor != nil {
+			slices.Reverse(experiences)
+		}
+
+		output.Body.Data = make([]TriggerExperience, len(experiences))
+		for i, exp := range experiences {
+			output.Body.Data[i] = toTriggerExperience(exp, input.Event)
+		}
+		switch {
+		case len(experiences) > 0:
+			newest := experiences[0]
+			output.Body.NextCursor = triggerCursor{At: eventTime(newest, input.Event), ID: newest.ID}.encode()
+		case cursor != nil:
+			output.Body.NextCursor = cursor.encode()
+		default:
+			output.Body.NextCursor = triggerCursor{At: settled}.encode()
+		}
+		return output, nil
+	})
+}
+
+// eventTime returns the time of the event of an experience
+func eventTime(exp *ent.ExperienceData, event string) time.Time {
+	if event == "created" {
+		return exp.CreatedAt
+	}
+	return exp.UpdatedAt
+}
+
+// toTriggerExperience converts an experience to its trigger form. The deduplication ID of
+// created experiences is their ID, of updated ones the ID and time of the change, and of
+// enriched ones the ID and enrichment version, so edits don't trigger the enriched event again.
+func toTriggerExperience(exp *ent.ExperienceData, event string) TriggerExperience {
+	t := TriggerExperience{
+		ID:             exp.ID.String(),
+		ExperienceID:   exp.ID.String(),
+		Event:          event,
+		OccurredAt:     eventTime(exp, event),
+		CollectedAt:    exp.CollectedAt,
+		SourceType:     exp.SourceType,
+		SourceID:       exp.SourceID,
+		SourceName:     exp.SourceName,
+		FieldID:        exp.FieldID,
+		FieldLabel:     exp.FieldLabel,
+		FieldType:      exp.FieldType,
+		ValueNumber:    exp.ValueNumber,
+		UserIdentifier: exp.UserIdentifier,
+		Language:       exp.Language,
+		Country:        stringValue(exp.Country),
+		Sentiment:      stringValue(exp.Sentiment),
+		SentimentScore: exp.SentimentScore,
+		Emotion:        stringValue(exp.Emotion),
+		Topics:         strings.Join(exp.Topics, ", "),
+		UrgencyScore:   exp.UrgencyScore,
+		IsSpam:         exp.IsSpam != nil && *exp.IsSpam,
+	}
+	switch event {
+	case "updated":
+		t.ID = fmt.Sprintf("%s:updated:%d", exp.ID, exp.UpdatedAt.UnixMicro())
+	case "enriched":
+		version := 0
+		if exp.EnrichmentVersion != nil {
+			version = *exp.EnrichmentVersion
+		}
+		t.ID = fmt.Sprintf("%s:enriched:%d", exp.ID, version)
+	}
+
+	switch {
+	case exp.ValueText != nil:
+		t.Value = *exp.ValueText
+	case exp.ValueNumber != nil:
+		t.Value = strconv.FormatFloat(*exp.ValueNumber, 'f', -1, 64)
+	case exp.ValueBoolean != nil:
+		t.Value = strconv.FormatBool(*exp.ValueBoolean)
+	case exp.ValueDate != nil:
+		t.Value = exp.ValueDate.UTC().Format(time.RFC3339)
+	}
+	return t
+}
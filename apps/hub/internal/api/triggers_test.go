@@ -0,0 +1,64 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+func TestTriggerCursor(t *testing.T) {
+	c := triggerCursor{At: time.Date(2024, 1, 15, 10, 30, 0, 123456000, time.UTC), ID: uuid.New()}
+	parsed, err := parseTriggerCursor(c.encode())
+	if err != nil {
+		t.Fatalf("parseTriggerCursor() error = %v", err)
+	}
+	if !parsed.At.Equal(c.At) || parsed.ID != c.ID {
+		t.Errorf("got %+v, want %+v", parsed, c)
+	}
+
+	parsed, err = parseTriggerCursor("2024-01-15T10:30:00Z")
+	if err != nil || !parsed.At.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) || parsed.ID != uuid.Nil {
+		t.Errorf("expected a timestamp to start at its time, got %+v, %v", parsed, err)
+	}
+
+	for _, invalid := range []string{"yesterday", "bm90LWEtY3Vyc29y"} {
+		if _, err := parseTriggerCursor(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestToTriggerExperience(t *testing.T) {
+	score, version, spam := 9.0, 3, false
+	exp := &ent.ExperienceData{
+		ID:                uuid.New(),
+		CreatedAt:         time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		UpdatedAt:         time.Date(2024, 1, 15, 10, 31, 0, 0, time.UTC),
+		FieldType:         "nps",
+		ValueNumber:       &score,
+		Topics:            []string{"exports", "pricing"},
+		EnrichmentVersion: &version,
+		IsSpam:            &spam,
+	}
+
+	created := toTriggerExperience(exp, "created")
+	if created.ID != exp.ID.String() || !created.OccurredAt.Equal(exp.CreatedAt) || created.Value != "9" || created.Topics != "exports, pricing" {
+		t.Errorf("unexpected created trigger: %+v", created)
+	}
+	if enriched := toTriggerExperience(exp, "enriched"); enriched.ID != exp.ID.String()+":enriched:3" || !enriched.OccurredAt.Equal(exp.UpdatedAt) {
+		t.Errorf("unexpected enriched trigger: %+v", enriched)
+	}
+
+	// Every change is a new update, but only a new enrichment is a new enriched event
+	changed := *exp
+	changed.UpdatedAt = changed.UpdatedAt.Add(time.Minute)
+	if toTriggerExperience(exp, "updated").ID == toTriggerExperience(&changed, "updated").ID {
+		t.Error("expected changes to have different deduplication IDs")
+	}
+	if toTriggerExperience(exp, "enriched").ID != toTriggerExperience(&changed, "enriched").ID {
+		t.Error("expected an edit to keep the deduplication ID of the enrichment")
+	}
+}
@@ -5,12 +5,14 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
 	"github.com/formbricks/hub/apps/hub/internal/models"
 )
 
 // CreateExperienceInput represents the input for creating an experience
 type CreateExperienceInput struct {
-	Body struct {
+	IdempotencyKey string `header:"Idempotency-Key" maxLength:"255" doc:"Unique key of the request, e.g. a UUID. Retrying with the same key returns the experience the first request created instead of creating another."`
+	Body           struct {
 		// Source tracking
 		SourceType string  `json:"source_type" example:"survey" doc:"Type of feedback source (e.g., survey, review, feedback_form)" minLength:"1" maxLength:"255"`
 		SourceID   *string `json:"source_id,omitempty" example:"survey-123" doc:"Reference to survey/form/ticket ID"`
@@ -33,6 +35,10 @@ type CreateExperienceInput struct {
 		Metadata       map[string]interface{} `json:"metadata,omitempty" doc:"User agent, device, location, referrer, tags, etc."`
 		Language       *string                `json:"language,omitempty" example:"en" doc:"ISO language code" maxLength:"10"`
 		UserIdentifier *string                `json:"user_identifier,omitempty" example:"user-abc-123" doc:"Anonymous ID or email hash"`
+
+		// AI processing
+		SkipAIProcessing bool   `json:"skip_ai_processing,omitempty" doc:"Skip AI enrichment and embeddings for this experience (e.g., sensitive data or historical backfills)"`
+		AIPriority       string `json:"ai_priority,omitempty" enum:"low,normal,high" doc:"Queue priority of the AI jobs (default: normal). Use low for backfills so they don't delay new feedback."`
 	}
 }
 
@@ -61,16 +67,26 @@ type DeleteExperienceInput struct {
 	ID string `path:"id" doc:"Experience ID (UUID)" format:"uuid"`
 }
 
+// ReprocessExperienceInput represents the input for re-running AI processing
+type ReprocessExperienceInput struct {
+	ID       string `path:"id" doc:"Experience ID (UUID)" format:"uuid"`
+	Priority string `query:"priority" default:"high" enum:"low,normal,high" doc:"Queue priority of the AI jobs"`
+}
+
+// ReprocessExperienceOutput represents the output for re-running AI processing
+type ReprocessExperienceOutput struct {
+	Body struct {
+		ID       string `json:"id" doc:"Experience ID"`
+		Priority string `json:"priority" doc:"Queue priority of the enqueued jobs"`
+	}
+}
+
 // ListExperiencesInput represents the input for listing experiences
 type ListExperiencesInput struct {
-	SourceType     string `query:"source_type" doc:"Filter by source type"`
-	SourceID       string `query:"source_id" doc:"Filter by source ID"`
-	FieldType      string `query:"field_type" doc:"Filter by field type"`
-	UserIdentifier string `query:"user_identifier" doc:"Filter by user identifier"`
-	Since          string `query:"since" doc:"Filter by collected_at >= since (ISO 8601 format)"`
-	Until          string `query:"until" doc:"Filter by collected_at <= until (ISO 8601 format)"`
-	Limit          int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
-	Offset         int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+	experiencefilter.Filter
+	SegmentID string `query:"segment_id" doc:"Only list the experiences of this saved segment (see /v1/segments), in addition to the other filters" format:"uuid"`
+	Limit     int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset    int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
 }
 
 // ExperienceData represents an experience data record for API responses
@@ -84,20 +100,48 @@ type ExperienceData struct {
 	SourceName     *string                `json:"source_name,omitempty" doc:"Human-readable name"`
 	FieldID        string                 `json:"field_id" doc:"Identifier for the question/field"`
 	FieldLabel     *string                `json:"field_label,omitempty" doc:"The actual question text"`
+	QuestionID     *uuid.UUID             `json:"question_id,omitempty" doc:"Question of the question bank this is a response to (see /v1/questions)"`
 	FieldType      string                 `json:"field_type" doc:"Type of field"`
 	ValueText      *string                `json:"value_text,omitempty" doc:"Text response"`
 	ValueNumber    *float64               `json:"value_number,omitempty" doc:"Numeric response"`
 	ValueBoolean   *bool                  `json:"value_boolean,omitempty" doc:"Boolean response"`
 	ValueDate      *time.Time             `json:"value_date,omitempty" doc:"Date response"`
 	ValueJSON      map[string]interface{} `json:"value_json,omitempty" doc:"Complex response"`
+	NPSCategory    *string                `json:"nps_category,omitempty" enum:"promoter,passive,detractor" doc:"NPS category of nps scores: promoter (9-10), passive (7-8), detractor (0-6)"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty" doc:"Additional context"`
+	Country        *string                `json:"country,omitempty" doc:"Country of the respondent, from metadata (see SERVICE_METADATA_COLUMNS)"`
+	Region         *string                `json:"region,omitempty" doc:"Region or state of the respondent, from metadata"`
+	Device         *string                `json:"device,omitempty" doc:"Device type, from metadata"`
+	Platform       *string                `json:"platform,omitempty" doc:"Platform or operating system, from metadata"`
+	AppVersion     *string                `json:"app_version,omitempty" doc:"App version, from metadata"`
 	Language       *string                `json:"language,omitempty" doc:"ISO language code"`
+	Translations   map[string]Translation `json:"translations,omitempty" doc:"Machine translations of value_text and field_label by ISO language code (see POST /v1/experiences/{id}/translations)"`
 	UserIdentifier *string                `json:"user_identifier,omitempty" doc:"User identifier"`
+	ContentHash    *string                `json:"content_hash,omitempty" doc:"SHA-256 of the normalized source, field, user, and value; equal for exact duplicates"`
+	DuplicateOf    *uuid.UUID             `json:"duplicate_of,omitempty" doc:"Earlier experience this one duplicates, set when SERVICE_DUPLICATE_POLICY is flag"`
 	// AI Enrichment (optional)
-	Sentiment      *string  `json:"sentiment,omitempty" doc:"AI-detected sentiment: positive, negative, neutral"`
-	SentimentScore *float64 `json:"sentiment_score,omitempty" doc:"Sentiment intensity from -1 (negative) to +1 (positive)"`
-	Emotion        *string  `json:"emotion,omitempty" doc:"AI-detected emotion: joy, anger, frustration, sadness, neutral"`
-	Topics         []string `json:"topics,omitempty" doc:"Key topics extracted by AI"`
+	SkipAIProcessing     bool           `json:"skip_ai_processing,omitempty" doc:"Whether the experience is excluded from AI enrichment and embeddings"`
+	Sentiment            *string        `json:"sentiment,omitempty" doc:"AI-detected sentiment: positive, negative, neutral"`
+	SentimentScore       *float64       `json:"sentiment_score,omitempty" doc:"Sentiment intensity from -1 (negative) to +1 (positive)"`
+	Emotion              *string        `json:"emotion,omitempty" doc:"AI-detected emotion: joy, anger, frustration, sadness, neutral"`
+	Topics               []string       `json:"topics,omitempty" doc:"Key topics extracted by AI"`
+	IsSpam               *bool          `json:"is_spam,omitempty" doc:"Whether AI flagged the response as spam (gibberish, keyboard mash, bot-like)"`
+	SpamConfidence       *float64       `json:"spam_confidence,omitempty" doc:"Confidence of the spam verdict from 0 to 1"`
+	UrgencyScore         *float64       `json:"urgency_score,omitempty" doc:"AI-estimated triage urgency from 0 (routine) to 1 (needs immediate attention)"`
+	UrgencyReasons       []string       `json:"urgency_reasons,omitempty" doc:"Reasons behind the urgency score: churn_risk, bug_report, legal_threat, security_issue, billing_issue, outage"`
+	EnrichmentProvider   *string        `json:"enrichment_provider,omitempty" doc:"AI provider that produced the enrichment (openai, gemini, custom)"`
+	EnrichmentModel      *string        `json:"enrichment_model,omitempty" doc:"AI model that produced the enrichment"`
+	EnrichmentVersion    *int           `json:"enrichment_version,omitempty" doc:"Enrichment prompt version used"`
+	EnrichmentAttributes map[string]any `json:"enrichment_attributes,omitempty" doc:"Additional attributes returned by a custom enrichment provider"`
+}
+
+// Translation represents a stored translation of an experience in API responses
+type Translation struct {
+	ValueText    string    `json:"value_text,omitempty" doc:"Translated response text"`
+	FieldLabel   string    `json:"field_label,omitempty" doc:"Translated question text"`
+	Provider     string    `json:"provider" doc:"AI provider that produced the translation"`
+	Model        string    `json:"model" doc:"AI model that produced the translation"`
+	TranslatedAt time.Time `json:"translated_at" doc:"When the translation was made"`
 }
 
 // ExperienceOutput represents the output for a single experience
@@ -126,18 +170,42 @@ func (e *ExperienceData) FromModel(m *models.Experience) {
 	e.SourceName = m.SourceName
 	e.FieldID = m.FieldID
 	e.FieldLabel = m.FieldLabel
+	e.QuestionID = m.QuestionID
 	e.FieldType = m.FieldType
 	e.ValueText = m.ValueText
 	e.ValueNumber = m.ValueNumber
 	e.ValueBoolean = m.ValueBoolean
 	e.ValueDate = m.ValueDate
 	e.ValueJSON = m.ValueJSON
+	e.NPSCategory = m.NPSCategory
 	e.Metadata = m.Metadata
+	e.Country = m.Country
+	e.Region = m.Region
+	e.Device = m.Device
+	e.Platform = m.Platform
+	e.AppVersion = m.AppVersion
 	e.Language = m.Language
+	if m.Translations != nil {
+		e.Translations = make(map[string]Translation, len(m.Translations))
+		for language, t := range m.Translations {
+			e.Translations[language] = Translation(t)
+		}
+	}
 	e.UserIdentifier = m.UserIdentifier
+	e.ContentHash = m.ContentHash
+	e.DuplicateOf = m.DuplicateOf
 	// Enrichment fields
+	e.SkipAIProcessing = m.SkipAIProcessing
 	e.Sentiment = m.Sentiment
 	e.SentimentScore = m.SentimentScore
 	e.Emotion = m.Emotion
 	e.Topics = m.Topics
+	e.IsSpam = m.IsSpam
+	e.SpamConfidence = m.SpamConfidence
+	e.UrgencyScore = m.UrgencyScore
+	e.UrgencyReasons = m.UrgencyReasons
+	e.EnrichmentProvider = m.EnrichmentProvider
+	e.EnrichmentModel = m.EnrichmentModel
+	e.EnrichmentVersion = m.EnrichmentVersion
+	e.EnrichmentAttributes = m.EnrichmentAttributes
 }
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+)
+
+// GetAIUsageInput defines the input for the AI usage report
+type GetAIUsageInput struct {
+	Since   string `query:"since" doc:"Start day (ISO 8601, inclusive)" example:"2024-01-01T00:00:00Z"`
+	Until   string `query:"until" doc:"End day (ISO 8601, inclusive)" example:"2024-12-31T23:59:59Z"`
+	JobType string `query:"job_type" enum:"enrichment,embedding,search,preview,translation" doc:"Filter by job type"`
+}
+
+// AIUsageItem is the usage of one model for one job type on one day
+type AIUsageItem struct {
+	Day              string  `json:"day" doc:"UTC day (YYYY-MM-DD)" example:"2024-01-15"`
+	JobType          string  `json:"job_type" doc:"Job type: enrichment, embedding, search (query embeddings), preview (enrichment previews), or translation"`
+	Provider         string  `json:"provider" doc:"AI provider"`
+	Model            string  `json:"model" doc:"AI model"`
+	Requests         int     `json:"requests" doc:"Number of successful AI requests"`
+	PromptTokens     int64   `json:"prompt_tokens" doc:"Prompt (input) tokens"`
+	CompletionTokens int64   `json:"completion_tokens" doc:"Completion (output) tokens"`
+	CostUSD          float64 `json:"cost_usd" doc:"Estimated cost in USD based on list prices"`
+}
+
+// AIUsageTotals sums usage over the reported period
+type AIUsageTotals struct {
+	Requests         int     `json:"requests" doc:"Number of successful AI requests"`
+	PromptTokens     int64   `json:"prompt_tokens" doc:"Prompt (input) tokens"`
+	CompletionTokens int64   `json:"completion_tokens" doc:"Completion (output) tokens"`
+	CostUSD          float64 `json:"cost_usd" doc:"Estimated cost in USD based on list prices"`
+}
+
+// GetAIUsageOutput defines the output for the AI usage report
+type GetAIUsageOutput struct {
+	Body struct {
+		Data   []AIUsageItem `json:"data" doc:"Usage by day, job type, provider, and model (newest first)"`
+		Totals AIUsageTotals `json:"totals" doc:"Totals over the reported period"`
+	}
+}
+
+// RegisterUsageRoutes registers AI usage reporting routes
+func RegisterUsageRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-ai-usage",
+		Method:      "GET",
+		Path:        "/v1/usage/ai",
+		Summary:     "Get AI token usage and cost",
+		Description: "Reports AI token usage and estimated cost per day and job type (enrichment, embedding, search, preview, translation). Costs are estimated from list prices; models without a known price are reported with zero cost.",
+		Tags:        []string{"Usage"},
+	}, func(ctx context.Context, input *GetAIUsageInput) (*GetAIUsageOutput, error) {
+		query := client.AIUsage.Query()
+
+		if input.Since != "" {
+			sinceTime, err := time.Parse(time.RFC3339, input.Since)
+			if err != nil {
+				return nil, invalidTimestamp("Invalid 'since' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-01-01T00:00:00Z")
+			}
+			query = query.Where(aiusage.DayGTE(sinceTime.UTC().Truncate(24 * time.Hour)))
+		}
+		if input.Until != "" {
+			untilTime, err := time.Parse(time.RFC3339, input.Until)
+			if err != nil {
+				return nil, invalidTimestamp("Invalid 'until' timestamp format. Expected ISO 8601 (RFC3339) format, e.g., 2024-12-31T23:59:59Z")
+			}
+			query = query.Where(aiusage.DayLTE(untilTime))
+		}
+		if input.JobType != "" {
+			query = query.Where(aiusage.JobType(input.JobType))
+		}
+
+		rows, err := query.
+			Order(ent.Desc(aiusage.FieldDay), ent.Asc(aiusage.FieldJobType), ent.Asc(aiusage.FieldModel)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "ai usage")
+		}
+
+		output := &GetAIUsageOutput{}
+		output.Body.Data = make([]AIUsageItem, len(rows))
+		for i, row := range rows {
+			output.Body.Data[i] = AIUsageItem{
+				Day:              row.Day.UTC().Format(time.DateOnly),
+				JobType:          row.JobType,
+				Provider:         row.Provider,
+				Model:            row.Model,
+				Requests:         row.Requests,
+				PromptTokens:     row.PromptTokens,
+				CompletionTokens: row.CompletionTokens,
+				CostUSD:          row.CostUsd,
+			}
+			output.Body.Totals.Requests += row.Requests
+			output.Body.Totals.PromptTokens += row.PromptTokens
+			output.Body.Totals.CompletionTokens += row.CompletionTokens
+			output.Body.Totals.CostUSD += row.CostUsd
+		}
+
+		return output, nil
+	})
+}
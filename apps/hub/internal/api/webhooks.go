@@ -0,0 +1,784 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
+)
+
+// WebhookItem represents a webhook endpoint in API responses
+type WebhookItem struct {
+	ID           uuid.UUID         `json:"id" doc:"Webhook endpoint ID"`
+	URL          string            `json:"url" doc:"URL that events are POSTed to"`
+	Secret       string            `json:"secret,omitempty" doc:"Key used to sign payloads in the X-Hub-Signature-256 header (only included when the endpoint is created)"`
+	EventTypes   []string          `json:"event_types" doc:"Event types sent to the endpoint; empty for all events"`
+	Conditions   []rules.Condition `json:"conditions" doc:"Conditions on the event data that must all match for an event to be sent; empty for all events"`
+	Enabled      bool              `json:"enabled" doc:"Whether events are sent to the endpoint"`
+	Format       string            `json:"format" doc:"Payload format: cloudevents, or slack for formatted Slack messages"`
+	SlackChannel string            `json:"slack_channel,omitempty" doc:"Slack channel that the bot token posts messages to"`
+	CreatedAt    time.Time         `json:"created_at" doc:"When the endpoint was created"`
+	UpdatedAt    time.Time         `json:"updated_at" doc:"When the endpoint was last updated"`
+}
+
+// CreateWebhookInput defines the input for creating a webhook endpoint
+type CreateWebhookInput struct {
+	Body struct {
+		URL          string            `json:"url,omitempty" doc:"URL that events are POSTed to: the receiver, or the incoming webhook URL of a Slack endpoint. Defaults to chat.postMessage for Slack endpoints with a bot token." format:"uri" maxLength:"2048" example:"https://api.example.com/webhooks/hub"`
+		Secret       string            `json:"secret,omitempty" doc:"Key used to sign payloads; generated if omitted" minLength:"16" maxLength:"256"`
+		EventTypes   []string          `json:"event_types,omitempty" doc:"Event types to send (e.g., experience.created, experience.enriched); omit for all events"`
+		Conditions   []rules.Condition `json:"conditions,omitempty" doc:"Only send events whose data matches all conditions (e.g., sentiment eq negative); omit for all events"`
+		Enabled      *bool             `json:"enabled,omitempty" doc:"Whether events are sent to the endpoint (default true)"`
+		Format       string            `json:"format,omitempty" doc:"Payload format: cloudevents (default), or slack to post formatted Slack messages" enum:"cloudevents,slack"`
+		SlackToken   string            `json:"slack_token,omitempty" doc:"Slack bot token (xoxb-...) with the chat:write scope, instead of an incoming webhook URL" maxLength:"256"`
+		SlackChannel string            `json:"slack_channel,omitempty" doc:"Slack channel ID or name that the bot token posts to; required with slack_token" maxLength:"256" example:"C0123456789"`
+	}
+}
+
+// UpdateWebhookInput defines the input for updating a webhook endpoint
+type UpdateWebhookInput struct {
+	ID   string `path:"id" doc:"Webhook endpoint ID (UUID)" format:"uuid"`
+	Body struct {
+		URL          *string            `json:"url,omitempty" doc:"Update the URL" format:"uri" maxLength:"2048"`
+		Secret       *string            `json:"secret,omitempty" doc:"Rotate the signing key" minLength:"16" maxLength:"256"`
+		EventTypes   *[]string          `json:"event_types,omitempty" doc:"Update the event types; an empty list sends all events"`
+		Conditions   *[]rules.Condition `json:"conditions,omitempty" doc:"Replace the conditions; an empty list sends all events"`
+		Enabled      *bool              `json:"enabled,omitempty" doc:"Enable or disable the endpoint"`
+		Format       *string            `json:"format,omitempty" doc:"Change the payload format" enum:"cloudevents,slack"`
+		SlackToken   *string            `json:"slack_token,omitempty" doc:"Rotate the Slack bot token; an empty string removes it" maxLength:"256"`
+		SlackChannel *string            `json:"slack_channel,omitempty" doc:"Update the Slack channel" maxLength:"256"`
+	}
+}
+
+// WebhookIDInput identifies a single webhook endpoint
+type WebhookIDInput struct {
+	ID string `path:"id" doc:"Webhook endpoint ID (UUID)" format:"uuid"`
+}
+
+// WebhookOutput represents the output for a single webhook endpoint
+type WebhookOutput struct {
+	Body WebhookItem
+}
+
+// ListWebhooksOutput represents the output for listing webhook endpoints
+type ListWebhooksOutput struct {
+	Body struct {
+		Data []WebhookItem `json:"data" doc:"Webhook endpoints, oldest first"`
+	}
+}
+
+// WebhookDeliveryItem represents a recorded webhook delivery in API responses
+type WebhookDeliveryItem struct {
+	ID             uuid.UUID  `json:"id" doc:"Delivery ID, sent in the X-Hub-Delivery header"`
+	EventType      string     `json:"event_type" doc:"Event type"`
+	Status         string     `json:"status" doc:"Delivery status: pending, succeeded, failed"`
+	Attempts       int        `json:"attempts" doc:"Number of attempts made"`
+	ResponseStatus *int       `json:"response_status,omitempty" doc:"HTTP status of the last attempt, if the endpoint responded"`
+	Error          *string    `json:"error,omitempty" doc:"Error of the last failed attempt"`
+	RedeliveryOf   *uuid.UUID `json:"redelivery_of,omitempty" doc:"Delivery this one redelivered"`
+	CreatedAt      time.Time  `json:"created_at" doc:"When the delivery was created"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty" doc:"When the delivery succeeded or ran out of attempts"`
+}
+
+// ListWebhookDeliveriesInput defines the input for listing an endpoint's deliveries
+type ListWebhookDeliveriesInput struct {
+	ID     string `path:"id" doc:"Webhook endpoint ID (UUID)" format:"uuid"`
+	Status string `query:"status" doc:"Filter by delivery status" enum:"pending,succeeded,failed"`
+	Limit  int    `query:"limit" default:"100" doc:"Number of results to return (max 1000)" minimum:"1" maximum:"1000"`
+	Offset int    `query:"offset" default:"0" doc:"Number of results to skip" minimum:"0"`
+}
+
+// ListWebhookDeliveriesOutput represents the output for listing an endpoint's deliveries
+type ListWebhookDeliveriesOutput struct {
+	Body struct {
+		Data   []WebhookDeliveryItem `json:"data" doc:"Deliveries, newest first"`
+		Total  int                   `json:"total" doc:"Total count of deliveries matching the filter"`
+		Limit  int                   `json:"limit" doc:"Limit used in query"`
+		Offset int                   `json:"offset" doc:"Offset used in query"`
+	}
+}
+
+// RedeliverWebhookInput identifies a delivery to send again
+type RedeliverWebhookInput struct {
+	ID         string `path:"id" doc:"Webhook endpoint ID (UUID)" format:"uuid"`
+	DeliveryID string `path:"deliveryId" doc:"Delivery ID (UUID)" format:"uuid"`
+}
+
+// ReplayWebhookInput defines the input for replaying an endpoint's deliveries
+type ReplayWebhookInput struct {
+	ID   string `path:"id" doc:"Webhook endpoint ID (UUID)" format:"uuid"`
+	Body struct {
+		Since      time.Time  `json:"since" doc:"Replay deliveries created at or after this time (RFC 3339)" example:"2026-01-15T08:00:00Z"`
+		Until      *time.Time `json:"until,omitempty" doc:"Replay deliveries created before this time (RFC 3339); defaults to now"`
+		EventTypes []string   `json:"event_types,omitempty" doc:"Only replay these event types; omit for all events"`
+		FailedOnly bool       `json:"failed_only,omitempty" doc:"Only replay deliveries that failed"`
+	}
+}
+
+// RedeliverWebhookOutput reports how many deliveries are sent again
+type RedeliverWebhookOutput struct {
+	Body struct {
+		Redelivered int `json:"redelivered" doc:"Number of deliveries queued to be sent again"`
+	}
+}
+
+// WebhookStatsInput defines the input for an endpoint's delivery statistics
+type WebhookStatsInput struct {
+	ID    string `path:"id" doc:"Webhook endpoint ID (UUID)" format:"uuid"`
+	Hours int    `query:"hours" default:"24" doc:"Time window in hours (max 720)" minimum:"1" maximum:"720"`
+}
+
+// WebhookStatsOutput represents an endpoint's delivery statistics
+type WebhookStatsOutput struct {
+	Body struct {
+		EndpointID          uuid.UUID  `json:"endpoint_id" doc:"Webhook endpoint ID"`
+		Since               time.Time  `json:"since" doc:"Start of the time window"`
+		Deliveries          int        `json:"deliveries" doc:"Deliveries created in the window"`
+		Succeeded           int        `json:"succeeded" doc:"Deliveries in the window that succeeded"`
+		Failed              int        `json:"failed" doc:"Deliveries in the window that failed after all attempts, were dropped, or were skipped while the endpoint was disabled"`
+		SuccessRate         *float64   `json:"success_rate" doc:"Share of finished deliveries in the window that succeeded, from 0 to 1; null if none finished"`
+		LatencyP50Ms        *float64   `json:"latency_p50_ms" doc:"Median response time in milliseconds in the window; null if the endpoint didn't respond"`
+		LatencyP95Ms        *float64   `json:"latency_p95_ms" doc:"95th percentile response time in milliseconds in the window; null if the endpoint didn't respond"`
+		ConsecutiveFailures int        `json:"consecutive_failures" doc:"Failed deliveries since the last successful one (the endpoint is disabled after 5)"`
+		Backlog             int        `json:"backlog" doc:"Deliveries that are queued or being sent"`
+		LastSuccessAt       *time.Time `json:"last_success_at,omitempty" doc:"When the last successful delivery was created"`
+		LastFailureAt       *time.Time `json:"last_failure_at,omitempty" doc:"When the last failed delivery was created"`
+	}
+}
+
+// maxReplayDeliveries caps the deliveries sent again by a single replay
+const maxReplayDeliveries = 1000
+
+// webhookToItem converts an Ent entity to the API response type. The secret is omitted.
+func webhookToItem(endpoint *ent.WebhookEndpoint) WebhookItem {
+	eventTypes := endpoint.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	conditions := endpoint.Conditions
+	if conditions == nil {
+		conditions = []rules.Condition{}
+	}
+	return WebhookItem{
+		ID:           endpoint.ID,
+		URL:          endpoint.URL,
+		EventTypes:   eventTypes,
+		Conditions:   conditions,
+		Enabled:      endpoint.Enabled,
+		Format:       endpoint.Format,
+		SlackChannel: endpoint.SlackChannel,
+		CreatedAt:    endpoint.CreatedAt,
+		UpdatedAt:    endpoint.UpdatedAt,
+	}
+}
+
+// webhookDeliveryToItem converts an Ent entity to the API response type
+func webhookDeliveryToItem(delivery *ent.WebhookDelivery) WebhookDeliveryItem {
+	return WebhookDeliveryItem{
+		ID:             delivery.ID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		RedeliveryOf:   delivery.RedeliveryOf,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+// latencyPercentiles returns the median and 95th percentile response time of the deliveries
+func latencyPercentiles(ctx context.Context, query *ent.WebhookDeliveryQuery) (p50, p95 *float64, err error) {
+	percentile := func(fraction float64, alias string) ent.AggregateFunc {
+		return func(s *sql.Selector) string {
+			return sql.As(fmt.Sprintf("percentile_cont(%g) WITHIN GROUP (ORDER BY %s)", fraction, s.C(webhookdelivery.FieldDurationMs)), alias)
+		}
+	}
+
+	var rows []struct {
+		P50 *float64 `json:"p50"`
+		P95 *float64 `json:"p95"`
+	}
+	err = query.
+		Where(webhookdelivery.DurationMsNotNil()).
+		Aggregate(percentile(0.5, "p50"), percentile(0.95, "p95")).
+		Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return nil, nil, err
+	}
+	return rows[0].P50, rows[0].P95, nil
+}
+
+// lastDeliveryAt returns when the endpoint's last delivery with the status was created
+func lastDeliveryAt(ctx context.Context, client *ent.Client, endpointID uuid.UUID, status string) (*time.Time, error) {
+	delivery, err := client.WebhookDelivery.Query().
+		Where(webhookdelivery.EndpointID(endpointID), webhookdelivery.Status(status)).
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &delivery.CreatedAt, nil
+}
+
+// redeliveryTarget returns the endpoint that recorded deliveries are sent to again
+func redeliveryTarget(endpoint *ent.WebhookEndpoint) (webhook.Endpoint, error) {
+	if !endpoint.Enabled {
+		return webhook.Endpoint{}, problem.New(http.StatusBadRequest, problem.CodeWebhookDisabled, "Webhook endpoint is disabled. Enable it before redelivering events.")
+	}
+	return webhook.Endpoint{
+		ID:           endpoint.ID.String(),
+		URL:          endpoint.URL,
+		Secret:       endpoint.Secret,
+		Format:       endpoint.Format,
+		SlackToken:   endpoint.SlackToken,
+		SlackChannel: endpoint.SlackChannel,
+	}, nil
+}
+
+// validateWebhookURL rejects URLs that events can't be POSTed to
+func validateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidWebhookURL, ErrMsgInvalidInput+"url must be an absolute http or https URL")
+	}
+	return nil
+}
+
+// checkWebhookTarget rejects URLs that resolve to private, loopback, or link-local
+// addresses, unless they are allowed with SERVICE_WEBHOOK_ALLOWED_HOSTS
+func checkWebhookTarget(ctx context.Context, dispatcher *webhook.Dispatcher, rawURL string) error {
+	if err := dispatcher.CheckTarget(ctx, rawURL); err != nil {
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidWebhookURL, ErrMsgInvalidInput+err.Error())
+	}
+	return nil
+}
+
+// validateEventTypes rejects unknown event types
+func validateEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		if err := webhook.EventType(eventType).Validate(); err != nil {
+			return problem.New(http.StatusBadRequest, problem.CodeInvalidEventType, ErrMsgInvalidInput+err.Error())
+		}
+	}
+	return nil
+}
+
+// validateConditions rejects conditions whose value doesn't fit the operator
+func validateConditions(conditions []rules.Condition) error {
+	if err := rules.Validate(conditions); err != nil {
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidCondition, ErrMsgInvalidInput+err.Error())
+	}
+	return nil
+}
+
+// validateSlackSettings rejects Slack settings of endpoints that don't post Slack messages,
+// and bot tokens without a channel
+func validateSlackSettings(format, token, channel string) error {
+	switch {
+	case format != webhook.FormatSlack && (token != "" || channel != ""):
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidDestination, ErrMsgInvalidInput+"slack_token and slack_channel require format slack")
+	case token != "" && channel == "":
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidDestination, ErrMsgInvalidInput+"slack_channel is required with slack_token")
+	case token == "" && channel != "":
+		return problem.New(http.StatusBadRequest, problem.CodeInvalidDestination, ErrMsgInvalidInput+"slack_channel requires slack_token; incoming webhooks post to the channel they were created for")
+	}
+	return nil
+}
+
+// generateWebhookSecret returns a random signing key
+func generateWebhookSecret() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(key), nil
+}
+
+// RegisterWebhookRoutes registers routes for managing webhook endpoints
+func RegisterWebhookRoutes(api huma.API, client *ent.Client, dispatcher *webhook.Dispatcher, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-webhook",
+		Method:      "POST",
+		Path:        "/v1/webhooks",
+		Summary:     "Create a webhook endpoint",
+		Description: "Subscribes a URL to webhook events. Events are signed with the endpoint's secret, which is only returned in this response. New endpoints receive events within 10 seconds on all Hub instances. URLs that resolve to private, loopback, or link-local addresses are rejected unless allowed with SERVICE_WEBHOOK_ALLOWED_HOSTS. With format slack, events are posted as formatted Slack messages to an incoming webhook URL, or with a bot token to a channel; event types and conditions route them like any other endpoint.",
+		Tags:        []string{"Webhooks"},
+	}, func(ctx context.Context, input *CreateWebhookInput) (*WebhookOutput, error) {
+		format := input.Body.Format
+		if format == "" {
+			format = webhook.FormatCloudEvents
+		}
+		if err := validateSlackSettings(format, input.Body.SlackToken, input.Body.SlackChannel); err != nil {
+			return nil, err
+		}
+		rawURL := input.Body.URL
+		if rawURL == "" && input.Body.SlackToken != "" {
+			rawURL = webhook.SlackPostMessageURL
+		}
+		if err := validateWebhookURL(rawURL); err != nil {
+			return nil, err
+		}
+		if err := checkWebhookTarget(ctx, dispatcher, rawURL); err != nil {
+			return nil, err
+		}
+		if err := validateEventTypes(input.Body.EventTypes); err != nil {
+			return nil, err
+		}
+		if err := validateConditions(input.Body.Conditions); err != nil {
+			return nil, err
+		}
+
+		secret := input.Body.Secret
+		if secret == "" {
+			var err error
+			if secret, err = generateWebhookSecret(); err != nil {
+				logger.Error("failed to create webhook endpoint", "error", err)
+				return nil, huma.Error500InternalServerError("Failed to generate webhook secret")
+			}
+		}
+
+		create := client.WebhookEndpoint.Create().
+			SetURL(rawURL).
+			SetSecret(secret).
+			SetEventTypes(input.Body.EventTypes).
+			SetConditions(input.Body.Conditions).
+			SetFormat(format).
+			SetSlackToken(input.Body.SlackToken).
+			SetSlackChannel(input.Body.SlackChannel)
+		if input.Body.Enabled != nil {
+			create.SetEnabled(*input.Body.Enabled)
+		}
+
+		endpoint, err := create.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "create", "webhook endpoint")
+		}
+		dispatcher.Invalidate()
+
+		logger.Info("webhook endpoint created", "id", endpoint.ID, "url", endpoint.URL)
+
+		item := webhookToItem(endpoint)
+		item.Secret = secret
+		return &WebhookOutput{Body: item}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-webhooks",
+		Method:      "GET",
+		Path:        "/v1/webhooks",
+		Summary:     "List webhook endpoints",
+		Description: "Lists all webhook endpoints. Secrets are not included. URLs configured with SERVICE_WEBHOOK_URLS are not listed.",
+		Tags:        []string{"Webhooks"},
+	}, func(ctx context.Context, input *struct{}) (*ListWebhooksOutput, error) {
+		endpoints, err := client.WebhookEndpoint.Query().
+			Order(ent.Asc(webhookendpoint.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "webhook endpoints")
+		}
+
+		output := &ListWebhooksOutput{}
+		output.Body.Data = make([]WebhookItem, len(endpoints))
+		for i, endpoint := range endpoints {
+			output.Body.Data[i] = webhookToItem(endpoint)
+		}
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-webhook",
+		Method:      "GET",
+		Path:        "/v1/webhooks/{id}",
+		Summary:     "Get a webhook endpoint",
+		Description: "Retrieves a single webhook endpoint. The secret is not included.",
+		Tags:        []string{"Webhooks"},
+	}, func(ctx context.Context, input *WebhookIDInput) (*WebhookOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint, err := client.WebhookEndpoint.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		return &WebhookOutput{Body: webhookToItem(endpoint)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-webhook",
+		Method:      "PATCH",
+		Path:        "/v1/webhooks/{id}",
+		Summary:     "Update a webhook endpoint",
+		Description: "Updates the URL, secret, event types, conditions, enabled flag, or Slack settings of a webhook endpoint. Only provided fields are changed.",
+		Tags:        []string{"Webhooks"},
+	}, func(ctx context.Context, input *UpdateWebhookInput) (*WebhookOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		update := client.WebhookEndpoint.UpdateOneID(id)
+		if input.Body.Format != nil || input.Body.SlackToken != nil || input.Body.SlackChannel != nil {
+			current, err := client.WebhookEndpoint.Get(ctx, id)
+			if err != nil {
+				return nil, handleDatabaseError(logger, err, "get", id.String())
+			}
+			format, token, channel := current.Format, current.SlackToken, current.SlackChannel
+			if input.Body.Format != nil {
+				format = *input.Body.Format
+				if format != webhook.FormatSlack {
+					// Switching away from Slack drops the Slack settings
+					token, channel = "", ""
+				}
+			}
+			if input.Body.SlackToken != nil {
+				token = *input.Body.SlackToken
+			}
+			if input.Body.SlackChannel != nil {
+				channel = *input.Body.SlackChannel
+			}
+			if err := validateSlackSettings(format, token, channel); err != nil {
+				return nil, err
+			}
+			update.SetFormat(format).SetSlackToken(token).SetSlackChannel(channel)
+		}
+		if input.Body.URL != nil {
+			if err := validateWebhookURL(*input.Body.URL); err != nil {
+				return nil, err
+			}
+			if err := checkWebhookTarget(ctx, dispatcher, *input.Body.URL); err != nil {
+				return nil, err
+			}
+			update.SetURL(*input.Body.URL)
+		}
+		if input.Body.Secret != nil {
+			update.SetSecret(*input.Body.Secret)
+		}
+		if input.Body.EventTypes != nil {
+			if err := validateEventTypes(*input.Body.EventTypes); err != nil {
+				return nil, err
+			}
+			update.SetEventTypes(*input.Body.EventTypes)
+		}
+		if input.Body.Conditions != nil {
+			if err := validateConditions(*input.Body.Conditions); err != nil {
+				return nil, err
+			}
+			update.SetConditions(*input.Body.Conditions)
+		}
+		if input.Body.Enabled != nil {
+			update.SetEnabled(*input.Body.Enabled)
+		}
+
+		endpoint, err := update.Save(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "update", id.String())
+		}
+		dispatcher.Invalidate()
+
+		logger.Info("webhook endpoint updated", "id", id)
+		return &WebhookOutput{Body: webhookToItem(endpoint)}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-webhook",
+		Method:      "DELETE",
+		Path:        "/v1/webhooks/{id}",
+		Summary:     "Delete a webhook endpoint",
+		Description: "Unsubscribes a webhook endpoint and deletes its recorded deliveries. Events that are already being delivered are still sent.",
+		Tags:        []string{"Webhooks"},
+	}, func(ctx context.Context, input *WebhookIDInput) (*struct{}, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := client.WebhookEndpoint.DeleteOneID(id).Exec(ctx); err != nil {
+			return nil, handleDatabaseError(logger, err, "delete", id.String())
+		}
+		dispatcher.Invalidate()
+
+		logger.Info("webhook endpoint deleted", "id", id)
+		return &struct{}{}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-webhook-deliveries",
+		Method:      "GET",
+		Path:        "/v1/webhooks/{id}/deliveries",
+		Summary:     "List webhook deliveries",
+		Description: "Lists the events sent to a webhook endpoint with their outcome, newest first. Deliveries are kept for SERVICE_WEBHOOK_DELIVERY_RETENTION_DAYS days.",
+		Tags:        []string{"Webhooks"},
+	}, func(ctx context.Context, input *ListWebhookDeliveriesInput) (*ListWebhookDeliveriesOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := client.WebhookEndpoint.Get(ctx, id); err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+
+		query := client.WebhookDelivery.Query().
+			Where(webhookdelivery.EndpointID(id))
+		if input.Status != "" {
+			query.Where(webhookdelivery.Status(input.Status))
+		}
+
+		total, err := query.Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "webhook deliveries")
+		}
+
+		deliveries, err := query.
+			Limit(input.Limit).
+			Offset(input.Offset).
+			Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "webhook deliveries")
+		}
+
+		output := &ListWebhookDeliveriesOutput{}
+		output.Body.Data = make([]WebhookDeliveryItem, len(deliveries))
+		for i, delivery := range deliveries {
+			output.Body.Data[i] = webhookDeliveryToItem(delivery)
+		}
+		output.Body.Total = total
+		output.Body.Limit = input.Limit
+		output.Body.Offset = input.Offset
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "redeliver-webhook-delivery",
+		Method:        "POST",
+		Path:          "/v1/webhooks/{id}/deliveries/{deliveryId}/redeliver",
+		Summary:       "Redeliver a webhook delivery",
+		Description:   "Sends the payload of a recorded delivery to the endpoint again, in the background. The redelivery is recorded as a new delivery that refers to the original one.",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: 202,
+	}, func(ctx context.Context, input *RedeliverWebhookInput) (*RedeliverWebhookOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+		deliveryID, err := parseUUID(input.DeliveryID)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint, err := client.WebhookEndpoint.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		target, err := redeliveryTarget(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery, err := client.WebhookDelivery.Query().
+			Where(webhookdelivery.ID(deliveryID), webhookdelivery.EndpointID(id)).
+			Only(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", deliveryID.String())
+		}
+
+		dispatcher.Redeliver(target, []webhook.Redelivery{{
+			DeliveryID: delivery.ID.String(),
+			EventType:  webhook.EventType(delivery.EventType),
+			Payload:    []byte(delivery.Payload),
+		}})
+
+		logger.Info("webhook delivery queued for redelivery", "id", id, "delivery_id", deliveryID)
+
+		output := &RedeliverWebhookOutput{}
+		output.Body.Redelivered = 1
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID:   "replay-webhook-deliveries",
+		Method:        "POST",
+		Path:          "/v1/webhooks/{id}/replay",
+		Summary:       "Replay webhook deliveries",
+		Description:   "Sends the events recorded for the endpoint in a time range again, oldest first and in the background, so a receiver that was down can catch up. Redeliveries themselves are not replayed. At most 1000 deliveries are replayed per request; narrow the time range to replay more.",
+		Tags:          []string{"Webhooks"},
+		DefaultStatus: 202,
+	}, func(ctx context.Context, input *ReplayWebhookInput) (*RedeliverWebhookOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		until := time.Now()
+		if input.Body.Until != nil {
+			until = *input.Body.Until
+		}
+		if !input.Body.Since.Before(until) {
+			return nil, problem.New(http.StatusBadRequest, problem.CodeInvalidTimeRange, ErrMsgInvalidInput+"since must be before until")
+		}
+		if err := validateEventTypes(input.Body.EventTypes); err != nil {
+			return nil, err
+		}
+
+		endpoint, err := client.WebhookEndpoint.Get(ctx, id)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+		target, err := redeliveryTarget(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		query := client.WebhookDelivery.Query().
+			Where(
+				webhookdelivery.EndpointID(id),
+				webhookdelivery.RedeliveryOfIsNil(),
+				webhookdelivery.CreatedAtGTE(input.Body.Since),
+				webhookdelivery.CreatedAtLT(until),
+			)
+		if len(input.Body.EventTypes) > 0 {
+			query.Where(webhookdelivery.EventTypeIn(input.Body.EventTypes...))
+		}
+		if input.Body.FailedOnly {
+			query.Where(webhookdelivery.Status(webhook.DeliveryFailed))
+		}
+
+		deliveries, err := query.
+			Order(ent.Asc(webhookdelivery.FieldCreatedAt)).
+			Limit(maxReplayDeliveries + 1).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "webhook deliveries")
+		}
+		if len(deliveries) > maxReplayDeliveries {
+			return nil, huma.Error400BadRequest(fmt.Sprintf("More than %d deliveries match. Narrow the time range and replay it in parts.", maxReplayDeliveries))
+		}
+
+		redeliveries := make([]webhook.Redelivery, len(deliveries))
+		for i, delivery := range deliveries {
+			redeliveries[i] = webhook.Redelivery{
+				DeliveryID: delivery.ID.String(),
+				EventType:  webhook.EventType(delivery.EventType),
+				Payload:    []byte(delivery.Payload),
+			}
+		}
+		if len(redeliveries) > 0 {
+			dispatcher.Redeliver(target, redeliveries)
+		}
+
+		logger.Info("webhook deliveries queued for replay",
+			"id", id,
+			"since", input.Body.Since,
+			"until", until,
+			"deliveries", len(redeliveries))
+
+		output := &RedeliverWebhookOutput{}
+		output.Body.Redelivered = len(redeliveries)
+		return output, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-webhook-stats",
+		Method:      "GET",
+		Path:        "/v1/webhooks/{id}/stats",
+		Summary:     "Get webhook delivery statistics",
+		Description: "Returns the success rate, response times, consecutive failures, and backlog of a webhook endpoint's deliveries, so subscribers that fall behind can be spotted early. Statistics cover deliveries created in the last 24 hours by default and are limited by SERVICE_WEBHOOK_DELIVERY_RETENTION_DAYS.",
+		Tags:        []string{"Webhooks"},
+	}, func(ctx context.Context, input *WebhookStatsInput) (*WebhookStatsOutput, error) {
+		id, err := parseUUID(input.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := client.WebhookEndpoint.Get(ctx, id); err != nil {
+			return nil, handleDatabaseError(logger, err, "get", id.String())
+		}
+
+		since := time.Now().Add(-time.Duration(input.Hours) * time.Hour)
+		window := func() *ent.WebhookDeliveryQuery {
+			return client.WebhookDelivery.Query().
+				Where(webhookdelivery.EndpointID(id), webhookdelivery.CreatedAtGTE(since))
+		}
+
+		output := &WebhookStatsOutput{}
+		output.Body.EndpointID = id
+		output.Body.Since = since
+
+		var counts []struct {
+			Status string `json:"status"`
+			Count  int    `json:"count"`
+		}
+		if err := window().
+			GroupBy(webhookdelivery.FieldStatus).
+			Aggregate(ent.Count()).
+			Scan(ctx, &counts); err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "webhook deliveries")
+		}
+		for _, c := range counts {
+			output.Body.Deliveries += c.Count
+			switch c.Status {
+			case webhook.DeliverySucceeded:
+				output.Body.Succeeded = c.Count
+			case webhook.DeliveryFailed:
+				output.Body.Failed = c.Count
+			}
+		}
+		if finished := output.Body.Succeeded + output.Body.Failed; finished > 0 {
+			rate := float64(output.Body.Succeeded) / float64(finished)
+			output.Body.SuccessRate = &rate
+		}
+
+		output.Body.LatencyP50Ms, output.Body.LatencyP95Ms, err = latencyPercentiles(ctx, window())
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "aggregate", "webhook delivery latency")
+		}
+
+		if output.Body.LastSuccessAt, err = lastDeliveryAt(ctx, client, id, webhook.DeliverySucceeded); err != nil {
+			return nil, handleDatabaseError(logger, err, "get", "last successful webhook delivery")
+		}
+		if output.Body.LastFailureAt, err = lastDeliveryAt(ctx, client, id, webhook.DeliveryFailed); err != nil {
+			return nil, handleDatabaseError(logger, err, "get", "last failed webhook delivery")
+		}
+
+		// Failures since the last success, regardless of the window
+		failures := client.WebhookDelivery.Query().
+			Where(webhookdelivery.EndpointID(id), webhookdelivery.Status(webhook.DeliveryFailed))
+		if output.Body.LastSuccessAt != nil {
+			failures.Where(webhookdelivery.CreatedAtGT(*output.Body.LastSuccessAt))
+		}
+		if output.Body.ConsecutiveFailures, err = failures.Count(ctx); err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "webhook deliveries")
+		}
+
+		output.Body.Backlog, err = client.WebhookDelivery.Query().
+			Where(webhookdelivery.EndpointID(id), webhookdelivery.Status(webhook.DeliveryPending)).
+			Count(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "count", "webhook deliveries")
+		}
+
+		return output, nil
+	})
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	entworker "github.com/formbricks/hub/apps/hub/internal/ent/worker"
+	"github.com/formbricks/hub/apps/hub/internal/worker"
+)
+
+// workerStaleAfter is how long after its last heartbeat a worker is reported as stale
+const workerStaleAfter = 3 * worker.HeartbeatInterval
+
+// WorkerItem represents an AI job worker in API responses
+type WorkerItem struct {
+	ID                  uuid.UUID  `json:"id" doc:"Worker status ID"`
+	Instance            string     `json:"instance" doc:"Hub instance running the worker (hostname and process ID)"`
+	WorkerID            int        `json:"worker_id" doc:"Worker ID, unique within the instance"`
+	JobType             string     `json:"job_type" doc:"Job type processed by the worker (e.g., enrichment, embedding), or all"`
+	Status              string     `json:"status" enum:"idle,processing,stale" doc:"idle, processing a job, or stale if the worker stopped sending heartbeats"`
+	CurrentJobID        *uuid.UUID `json:"current_job_id,omitempty" doc:"Job being processed"`
+	CurrentJobStartedAt *time.Time `json:"current_job_started_at,omitempty" doc:"When the worker started the current job"`
+	Processed           int64      `json:"processed" doc:"Jobs completed since the worker started"`
+	Failed              int64      `json:"failed" doc:"Failed job attempts since the worker started"`
+	StartedAt           time.Time  `json:"started_at" doc:"When the worker started"`
+	LastHeartbeatAt     time.Time  `json:"last_heartbeat_at" doc:"When the worker last reported its status"`
+}
+
+// ListWorkersOutput represents the output for listing workers
+type ListWorkersOutput struct {
+	Body struct {
+		Data []WorkerItem `json:"data" doc:"Workers, by instance and worker ID"`
+	}
+}
+
+// workerToItem converts an Ent entity to the API response type
+func workerToItem(w *ent.Worker, now time.Time) WorkerItem {
+	status := "idle"
+	switch {
+	case now.Sub(w.LastHeartbeatAt) > workerStaleAfter:
+		status = "stale"
+	case w.CurrentJobID != nil:
+		status = "processing"
+	}
+
+	return WorkerItem{
+		ID:                  w.ID,
+		Instance:            w.Instance,
+		WorkerID:            w.WorkerID,
+		JobType:             w.JobType,
+		Status:              status,
+		CurrentJobID:        w.CurrentJobID,
+		CurrentJobStartedAt: w.CurrentJobStartedAt,
+		Processed:           w.Processed,
+		Failed:              w.Failed,
+		StartedAt:           w.StartedAt,
+		LastHeartbeatAt:     w.LastHeartbeatAt,
+	}
+}
+
+// RegisterWorkerRoutes registers routes for monitoring AI job workers
+func RegisterWorkerRoutes(api huma.API, client *ent.Client, logger *slog.Logger) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-workers",
+		Method:      "GET",
+		Path:        "/v1/workers",
+		Summary:     "List AI job workers",
+		Description: "Lists the enrichment and embedding workers of all Hub instances with their last heartbeat, current job, and counters. A worker is stale if it hasn't sent a heartbeat for 45 seconds, e.g. because its instance crashed or hangs; stale workers are removed after 10 minutes. A processing worker whose current job started long ago is stuck on a slow AI request.",
+		Tags:        []string{"Jobs"},
+	}, func(ctx context.Context, input *struct{}) (*ListWorkersOutput, error) {
+		workers, err := client.Worker.Query().
+			Order(ent.Asc(entworker.FieldInstance), ent.Asc(entworker.FieldWorkerID)).
+			All(ctx)
+		if err != nil {
+			return nil, handleDatabaseError(logger, err, "list", "workers")
+		}
+
+		now := time.Now()
+		output := &ListWorkersOutput{}
+		output.Body.Data = make([]WorkerItem, len(workers))
+		for i, w := range workers {
+			output.Body.Data[i] = workerToItem(w, now)
+		}
+
+		return output, nil
+	})
+}
@@ -0,0 +1,146 @@
+// Package audit records who changed what through the API. Each mutating call is recorded
+// with its actor, request ID, and the webhook events it emitted, so an event received by
+// a subscriber can be traced back to the call that caused it.
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/auditlog"
+)
+
+const (
+	// AnonymousActor is the actor of calls made without credentials
+	AnonymousActor = "anonymous"
+	// pruneInterval is how often entries past the retention period are deleted
+	pruneInterval = time.Hour
+)
+
+// Entry is one mutating API call
+type Entry struct {
+	Actor      string
+	RequestID  string
+	Method     string
+	Path       string
+	Operation  string
+	ResourceID string
+	Status     int
+	Summary    string
+	EventIDs   []string
+}
+
+// Log stores audit entries
+type Log interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+// APIKeyActor identifies a caller by a fingerprint of its API key, so entries tell keys
+// apart (e.g., across a rotation) without storing them
+func APIKeyActor(key string) string {
+	if key == "" {
+		return AnonymousActor
+	}
+	sum := sha256.Sum256([]byte(key))
+	return "api_key:" + hex.EncodeToString(sum[:6])
+}
+
+// trailKey is the context key of the events emitted during a call
+type trailKey struct{}
+
+// trail collects the IDs of the webhook events emitted during a call
+type trail struct {
+	mu       sync.Mutex
+	eventIDs []string
+}
+
+// WithTrail returns a context that collects the webhook events emitted while handling a call
+func WithTrail(ctx context.Context) context.Context {
+	return context.WithValue(ctx, trailKey{}, &trail{})
+}
+
+// RecordEvent adds an emitted webhook event to the call in ctx, if any
+func RecordEvent(ctx context.Context, eventID string) {
+	t, ok := ctx.Value(trailKey{}).(*trail)
+	if !ok {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.eventIDs = append(t.eventIDs, eventID)
+}
+
+// Events returns the webhook events emitted during the call in ctx
+func Events(ctx context.Context) []string {
+	t, ok := ctx.Value(trailKey{}).(*trail)
+	if !ok {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.eventIDs...)
+}
+
+// DBLog stores entries in the audit_logs table
+type DBLog struct {
+	client    *ent.Client
+	retention time.Duration
+
+	mu       sync.Mutex
+	prunedAt time.Time
+}
+
+// NewDBLog creates an audit log that keeps entries for the retention period
+func NewDBLog(client *ent.Client, retention time.Duration) *DBLog {
+	return &DBLog{client: client, retention: retention}
+}
+
+// Record stores an entry
+func (l *DBLog) Record(ctx context.Context, entry Entry) error {
+	l.prune(ctx)
+
+	create := l.client.AuditLog.Create().
+		SetActor(entry.Actor).
+		SetMethod(entry.Method).
+		SetPath(entry.Path).
+		SetStatus(entry.Status).
+		SetSummary(entry.Summary)
+	if entry.RequestID != "" {
+		create.SetRequestID(entry.RequestID)
+	}
+	if entry.Operation != "" {
+		create.SetOperation(entry.Operation)
+	}
+	if entry.ResourceID != "" {
+		create.SetResourceID(entry.ResourceID)
+	}
+	if len(entry.EventIDs) > 0 {
+		create.SetEventIds(entry.EventIDs)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+	return nil
+}
+
+// prune deletes entries past the retention period, at most once per prune interval
+func (l *DBLog) prune(ctx context.Context) {
+	l.mu.Lock()
+	if time.Since(l.prunedAt) < pruneInterval {
+		l.mu.Unlock()
+		return
+	}
+	l.prunedAt = time.Now()
+	l.mu.Unlock()
+
+	// Failures are retried at the next prune interval
+	_, _ = l.client.AuditLog.Delete().
+		Where(auditlog.CreatedAtLT(time.Now().Add(-l.retention))).
+		Exec(ctx)
+}
@@ -0,0 +1,222 @@
+// Package backfill enqueues enrichment or embedding jobs for experiences that were
+// stored before AI processing was configured (or before a provider was switched). Jobs
+// are enqueued in batches at a controlled rate with low priority, so a backfill never
+// delays new feedback, and a backfill can be resumed from the last processed experience.
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/embedding"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+)
+
+// defaultPollInterval is how often the queue is checked while a backfill is paused
+const defaultPollInterval = 5 * time.Second
+
+// Options controls which experiences are backfilled and how fast
+type Options struct {
+	// JobType is queue.JobTypeEnrichment or queue.JobTypeEmbedding
+	JobType queue.JobType
+	// Since limits the backfill to experiences collected at or after it (all if zero)
+	Since time.Time
+	// All includes experiences that already have results, e.g. to re-process them with
+	// a new provider. By default only experiences without results are backfilled.
+	All bool
+	// After resumes a previous backfill after the experience with this ID
+	After uuid.UUID
+	// BatchSize is the number of experiences read per query
+	BatchSize int
+	// Rate is the maximum number of jobs enqueued per second (unlimited if zero)
+	Rate float64
+	// MaxPending pauses the backfill while more jobs of the type are waiting in the
+	// queue, so workers keep up (no limit if zero)
+	MaxPending int
+	// PollInterval is how often the queue is checked while paused (default 5 seconds)
+	PollInterval time.Duration
+}
+
+// Progress is reported after every batch
+type Progress struct {
+	// Total is the number of matching experiences when the backfill started
+	Total int
+	// Scanned is the number of experiences processed so far
+	Scanned int
+	// Enqueued is the number of jobs enqueued so far. Experiences that already have a
+	// pending job of the type are skipped.
+	Enqueued int
+	// Cursor is the ID of the last processed experience, to resume with Options.After
+	Cursor uuid.UUID
+}
+
+// Run enqueues the jobs and calls report after every batch. On error (including
+// cancellation) the returned progress has the cursor to resume from.
+func Run(ctx context.Context, client *ent.Client, q queue.Queue, opts Options, report func(Progress)) (Progress, error) {
+	if opts.JobType != queue.JobTypeEnrichment && opts.JobType != queue.JobTypeEmbedding {
+		return Progress{}, fmt.Errorf("unsupported job type %q", opts.JobType)
+	}
+	if opts.BatchSize <= 0 {
+		return Progress{}, fmt.Errorf("batch size must be positive")
+	}
+
+	progress := Progress{Cursor: opts.After}
+	where := predicates(opts)
+
+	total, err := client.ExperienceData.Query().
+		Where(append(where, experiencedata.IDGT(opts.After))...).
+		Count(ctx)
+	if err != nil {
+		return progress, fmt.Errorf("failed to count experiences: %w", err)
+	}
+	progress.Total = total
+
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if opts.Rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(opts.Rate), max(1, int(opts.Rate)))
+	}
+
+	for {
+		if err := waitForQueue(ctx, q, opts); err != nil {
+			return progress, err
+		}
+
+		batch, err := client.ExperienceData.Query().
+			Where(append(where, experiencedata.IDGT(progress.Cursor))...).
+			Order(ent.Asc(experiencedata.FieldID)).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return progress, fmt.Errorf("failed to query experiences: %w", err)
+		}
+		if len(batch) == 0 {
+			return progress, nil
+		}
+
+		queued, err := pendingJobs(ctx, client, opts.JobType, batch)
+		if err != nil {
+			return progress, err
+		}
+
+		for _, exp := range batch {
+			if !queued[exp.ID] {
+				if err := limiter.Wait(ctx); err != nil {
+					return progress, err
+				}
+				text := embedding.BuildEmbeddingText(exp.FieldLabel, *exp.ValueText)
+				// Backfills must not delay new feedback
+				if err := q.EnqueueJob(ctx, opts.JobType, exp.ID.String(), text, queue.PriorityLow); err != nil {
+					return progress, fmt.Errorf("failed to enqueue %s job: %w", opts.JobType, err)
+				}
+				progress.Enqueued++
+			}
+			// Advance the cursor only once the experience is handled, so resuming never skips one
+			progress.Scanned++
+			progress.Cursor = exp.ID
+		}
+		if report != nil {
+			report(progress)
+		}
+	}
+}
+
+// predicates selects the text experiences to backfill
+func predicates(opts Options) []predicate.ExperienceData {
+	where := []predicate.ExperienceData{
+		experiencedata.FieldType(string(models.FieldTypeText)),
+		experiencedata.ValueTextNotNil(),
+		experiencedata.ValueTextNEQ(""),
+		experiencedata.SkipAiProcessing(false),
+	}
+	if !opts.Since.IsZero() {
+		where = append(where, experiencedata.CollectedAtGTE(opts.Since))
+	}
+	if !opts.All {
+		switch opts.JobType {
+		case queue.JobTypeEnrichment:
+			where = append(where, experiencedata.SentimentIsNil(), experiencedata.EnrichmentVersionIsNil())
+		case queue.JobTypeEmbedding:
+			where = append(where, experiencedata.EmbeddingIsNil())
+		}
+	}
+	return where
+}
+
+// pendingJobs returns the experiences of the batch that already have a pending or
+// processing job of the type, so running a backfill twice doesn't duplicate jobs
+func pendingJobs(ctx context.Context, client *ent.Client, jobType queue.JobType, batch []*ent.ExperienceData) (map[uuid.UUID]bool, error) {
+	ids := make([]uuid.UUID, len(batch))
+	for i, exp := range batch {
+		ids[i] = exp.ID
+	}
+	jobs, err := client.EnrichmentJob.Query().
+		Where(
+			enrichmentjob.ExperienceIDIn(ids...),
+			enrichmentjob.JobType(string(jobType)),
+			enrichmentjob.StatusIn("pending", "processing"),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending jobs: %w", err)
+	}
+	queued := make(map[uuid.UUID]bool, len(jobs))
+	for _, job := range jobs {
+		queued[job.ExperienceID] = true
+	}
+	return queued, nil
+}
+
+// waitForQueue blocks while the queue has more than MaxPending jobs of the type
+func waitForQueue(ctx context.Context, q queue.Queue, opts Options) error {
+	if opts.MaxPending <= 0 {
+		return nil
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	for {
+		pending, err := q.Pending(ctx, opts.JobType)
+		if err != nil {
+			return fmt.Errorf("failed to check the queue: %w", err)
+		}
+		if pending <= opts.MaxPending {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// ParseSince parses a date (2006-01-02), an RFC 3339 timestamp, or a number of days
+// before now (e.g. 30d)
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	if n, ok := strings.CutSuffix(value, "d"); ok {
+		if days, err := strconv.Atoi(n); err == nil && days > 0 {
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid since %q: use a date (2006-01-02), an RFC 3339 timestamp, or a number of days (30d)", value)
+}
@@ -0,0 +1,90 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "", want: time.Time{}},
+		{value: "2026-01-15", want: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{value: "2026-01-15T08:30:00Z", want: time.Date(2026, 1, 15, 8, 30, 0, 0, time.UTC)},
+		{value: "30d", want: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{value: "0d", wantErr: true},
+		{value: "-5d", wantErr: true},
+		{value: "30", wantErr: true},
+		{value: "last month", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSince(tt.value, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRun_InvalidOptions(t *testing.T) {
+	if _, err := Run(context.Background(), nil, nil, Options{JobType: "summary", BatchSize: 10}, nil); err == nil {
+		t.Error("expected an error for an unsupported job type")
+	}
+	if _, err := Run(context.Background(), nil, nil, Options{JobType: queue.JobTypeEmbedding}, nil); err == nil {
+		t.Error("expected an error for a missing batch size")
+	}
+}
+
+// pendingQueue reports a decreasing number of pending jobs
+type pendingQueue struct {
+	queue.Queue
+	pending []int
+	calls   int
+}
+
+func (q *pendingQueue) Pending(ctx context.Context, jobType queue.JobType) (int, error) {
+	n := q.pending[min(q.calls, len(q.pending)-1)]
+	q.calls++
+	return n, nil
+}
+
+func TestWaitForQueue(t *testing.T) {
+	q := &pendingQueue{pending: []int{300, 150, 100}}
+	opts := Options{JobType: queue.JobTypeEnrichment, MaxPending: 100, PollInterval: time.Millisecond}
+	if err := waitForQueue(context.Background(), q, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.calls != 3 {
+		t.Errorf("expected to wait until 100 jobs are pending, checked %d times", q.calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	q = &pendingQueue{pending: []int{300}}
+	if err := waitForQueue(ctx, q, opts); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the wait to be canceled, got %v", err)
+	}
+
+	q = &pendingQueue{pending: []int{300}}
+	if err := waitForQueue(context.Background(), q, Options{JobType: queue.JobTypeEnrichment}); err != nil || q.calls != 0 {
+		t.Errorf("expected no wait without MaxPending, got %v after %d checks", err, q.calls)
+	}
+}
@@ -0,0 +1,303 @@
+// Package backup creates and restores logical backups of a Hub instance. A backup is a
+// dataset export (experiences, questions, webhook endpoints with their secrets, and AI
+// jobs) read from a single snapshot of the database, optionally encrypted with a
+// passphrase, and kept in a local directory or an S3-compatible or GCS bucket.
+package backup
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/dataset"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+const (
+	// namePrefix starts the names of backups, so other files of a location are left alone
+	namePrefix = "hub-backup-"
+	// nameTimeFormat is the UTC time in the names of backups, which sorts them by age
+	nameTimeFormat = "20060102T150405Z"
+	// encryptedSuffix ends the names of encrypted backups
+	encryptedSuffix = ".enc"
+)
+
+// Store is a location that backups are kept in
+type Store interface {
+	// Save stores size bytes of r as the backup name
+	Save(ctx context.Context, name string, r io.Reader, size int64) error
+	// Open returns the content of a backup
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+	// List returns the names of the backups, oldest first
+	List(ctx context.Context) ([]string, error)
+	// Delete deletes a backup
+	Delete(ctx context.Context, name string) error
+}
+
+// BucketOptions are the settings of the bucket of an s3:// or gs:// location
+type BucketOptions struct {
+	Region          string
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// NewStore returns the store of a location: a directory, or an s3://bucket/prefix or
+// gs://bucket/prefix URL
+func NewStore(location string, opts BucketOptions) (Store, error) {
+	provider := ""
+	switch {
+	case location == "":
+		return nil, errors.New("no backup location configured")
+	case strings.HasPrefix(location, "s3://"):
+		provider = sink.ProviderS3
+	case strings.HasPrefix(location, "gs://"):
+		provider = sink.ProviderGCS
+	default:
+		return &dirStore{dir: location}, nil
+	}
+
+	name, prefix, _ := strings.Cut(location[len("s3://"):], "/")
+	if err := sink.ValidateBucket(provider, name, opts.Endpoint); err != nil {
+		return nil, err
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &bucketStore{
+		bucket: &sink.Bucket{
+			Provider:        provider,
+			Name:            name,
+			Region:          opts.Region,
+			Endpoint:        opts.Endpoint,
+			AccessKeyID:     opts.AccessKeyID,
+			SecretAccessKey: opts.SecretAccessKey,
+		},
+		prefix: prefix,
+	}, nil
+}
+
+// isBackup reports whether name is the name of a backup
+func isBackup(name string) bool {
+	return strings.HasPrefix(name, namePrefix) && !strings.ContainsAny(name, `/\`)
+}
+
+// dirStore keeps backups in a local directory
+type dirStore struct {
+	dir string
+}
+
+func (s *dirStore) Save(_ context.Context, name string, r io.Reader, _ int64) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	// Write to a temporary file first, so an interrupted backup isn't mistaken for one
+	tmp, err := os.CreateTemp(s.dir, ".tmp-"+name)
+	if err != nil {
+		return fmt.Errorf("failed to create backup: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+}
+
+func (s *dirStore) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	if !isBackup(name) {
+		return nil, fmt.Errorf("invalid backup name %q", name)
+	}
+	return os.Open(filepath.Join(s.dir, name))
+}
+
+func (s *dirStore) List(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && isBackup(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *dirStore) Delete(_ context.Context, name string) error {
+	if !isBackup(name) {
+		return fmt.Errorf("invalid backup name %q", name)
+	}
+	return os.Remove(filepath.Join(s.dir, name))
+}
+
+// bucketStore keeps backups in a bucket, under a prefix
+type bucketStore struct {
+	bucket *sink.Bucket
+	prefix string
+}
+
+func (s *bucketStore) Save(ctx context.Context, name string, r io.Reader, size int64) error {
+	return s.bucket.Upload(ctx, s.prefix+name, "application/octet-stream", r, size)
+}
+
+func (s *bucketStore) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	if !isBackup(name) {
+		return nil, fmt.Errorf("invalid backup name %q", name)
+	}
+	return s.bucket.Get(ctx, s.prefix+name)
+}
+
+func (s *bucketStore) List(ctx context.Context) ([]string, error) {
+	keys, err := s.bucket.List(ctx, s.prefix+namePrefix)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, key := range keys {
+		if name := strings.TrimPrefix(key, s.prefix); isBackup(name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *bucketStore) Delete(ctx context.Context, name string) error {
+	if !isBackup(name) {
+		return fmt.Errorf("invalid backup name %q", name)
+	}
+	return s.bucket.Delete(ctx, s.prefix+name)
+}
+
+// Name returns the name of a backup created at, encrypted or not
+func Name(at time.Time, encrypted bool) string {
+	name := namePrefix + at.UTC().Format(nameTimeFormat) + ".jsonl.gz"
+	if encrypted {
+		name += encryptedSuffix
+	}
+	return name
+}
+
+// CreatedAt returns when the backup of a name was created
+func CreatedAt(name string) (time.Time, bool) {
+	stamp, _, _ := strings.Cut(strings.TrimPrefix(name, namePrefix), ".")
+	at, err := time.Parse(nameTimeFormat, stamp)
+	return at, err == nil && isBackup(name)
+}
+
+// Write writes a backup of the database of client to w, encrypted if passphrase isn't empty.
+// All records are read in one read-only, repeatable-read transaction, so the backup is a
+// consistent snapshot even while Hub keeps taking writes.
+func Write(ctx context.Context, client *ent.Client, w io.Writer, passphrase string) (dataset.Counts, error) {
+	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return dataset.Counts{}, fmt.Errorf("failed to start snapshot: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	out := io.WriteCloser(nopCloser{w})
+	if passphrase != "" {
+		if out, err = Encrypt(w, passphrase); err != nil {
+			return dataset.Counts{}, err
+		}
+	}
+	counts, err := dataset.Export(ctx, tx.Client(), out, dataset.ExportOptions{WebhookEndpoints: true, Jobs: true})
+	if err != nil {
+		return counts, err
+	}
+	return counts, out.Close()
+}
+
+// nopCloser adds a Close that does nothing to a writer
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// Create writes a backup of the database of client to a temporary file and saves it in
+// store, returning its name
+func Create(ctx context.Context, client *ent.Client, store Store, passphrase string) (string, dataset.Counts, error) {
+	name := Name(time.Now(), passphrase != "")
+	tmp, err := os.CreateTemp("", name)
+	if err != nil {
+		return "", dataset.Counts{}, fmt.Errorf("failed to create backup: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	counts, err := Write(ctx, client, tmp, passphrase)
+	if err != nil {
+		return "", counts, err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", counts, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return "", counts, err
+	}
+	if err := store.Save(ctx, name, tmp, size); err != nil {
+		return "", counts, err
+	}
+	return name, counts, nil
+}
+
+// Prune deletes the oldest backups of store beyond the newest keep, returning their names
+func Prune(ctx context.Context, store Store, keep int) ([]string, error) {
+	names, err := store.List(ctx)
+	if err != nil || keep <= 0 || len(names) <= keep {
+		return nil, err
+	}
+	deleted := names[:len(names)-keep]
+	for _, name := range deleted {
+		if err := store.Delete(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+	return deleted, nil
+}
+
+// Read returns the content of a backup read by r as a dataset export, decrypting it with
+// passphrase if it's encrypted
+func Read(r io.Reader, passphrase string) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	if !IsEncrypted(br) {
+		return br, nil
+	}
+	if passphrase == "" {
+		return nil, errors.New("the backup is encrypted; set the encryption key to restore it")
+	}
+	return Decrypt(br, passphrase)
+}
+
+// Restore loads a backup read by r into the database of client. Records that already
+// exist are skipped, so restores belong in a new, migrated database; an interrupted
+// restore can be run again.
+func Restore(ctx context.Context, client *ent.Client, r io.Reader, passphrase string, progress func(dataset.Counts)) (dataset.Counts, error) {
+	content, err := Read(r, passphrase)
+	if err != nil {
+		return dataset.Counts{}, err
+	}
+	return dataset.Import(ctx, client, content, progress)
+}
@@ -0,0 +1,162 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// encrypt encrypts content with passphrase, written in pieces of step bytes
+func encrypt(t *testing.T, content []byte, passphrase string, step int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := Encrypt(&buf, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for len(content) > 0 {
+		n := min(step, len(content))
+		if _, err := w.Write(content[:n]); err != nil {
+			t.Fatal(err)
+		}
+		content = content[n:]
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestEncryption(t *testing.T) {
+	large := make([]byte, 3*chunkSize+123)
+	_, _ = rand.Read(large)
+
+	for _, content := range [][]byte{nil, []byte("small"), large[:chunkSize], large} {
+		encrypted := encrypt(t, content, "passphrase", 10_000)
+		if bytes.Contains(encrypted, []byte("small")) {
+			t.Fatal("expected the content to be encrypted")
+		}
+
+		r, err := Read(bytes.NewReader(encrypted), "passphrase")
+		if err != nil {
+			t.Fatalf("Read() error = %v", err)
+		}
+		decrypted, err := io.ReadAll(r)
+		if err != nil || !bytes.Equal(decrypted, content) {
+			t.Errorf("decrypted %d bytes (error %v), want the %d bytes encrypted", len(decrypted), err, len(content))
+		}
+	}
+
+	encrypted := encrypt(t, large, "passphrase", chunkSize)
+	t.Run("wrong passphrase", func(t *testing.T) {
+		r, _ := Decrypt(bufio.NewReader(bytes.NewReader(encrypted)), "wrong")
+		if _, err := io.ReadAll(r); !errors.Is(err, ErrDecrypt) {
+			t.Errorf("error = %v, want ErrDecrypt", err)
+		}
+	})
+	t.Run("cut off at a chunk", func(t *testing.T) {
+		overhead := 16
+		cut := encrypted[:len(magic)+saltSize+2*(chunkSize+overhead)]
+		r, _ := Decrypt(bufio.NewReader(bytes.NewReader(cut)), "passphrase")
+		if _, err := io.ReadAll(r); !errors.Is(err, ErrDecrypt) {
+			t.Errorf("error = %v, want ErrDecrypt", err)
+		}
+	})
+	t.Run("no passphrase", func(t *testing.T) {
+		if _, err := Read(bytes.NewReader(encrypted), ""); err == nil || !strings.Contains(err.Error(), "encrypted") {
+			t.Errorf("Read() error = %v, want a missing key", err)
+		}
+	})
+	t.Run("not encrypted", func(t *testing.T) {
+		r, err := Read(strings.NewReader("plain export"), "passphrase")
+		if content, _ := io.ReadAll(r); err != nil || string(content) != "plain export" {
+			t.Errorf("Read() = %q, %v, want the backup as is", content, err)
+		}
+	})
+}
+
+func TestNames(t *testing.T) {
+	at := time.Date(2026, 10, 16, 2, 0, 0, 0, time.UTC)
+	name := Name(at, true)
+	if name != "hub-backup-20261016T020000Z.jsonl.gz.enc" {
+		t.Errorf("Name() = %q", name)
+	}
+	if created, ok := CreatedAt(name); !ok || !created.Equal(at) {
+		t.Errorf("CreatedAt() = %v, %v, want %v", created, ok, at)
+	}
+	if _, ok := CreatedAt("notes.txt"); ok {
+		t.Error("expected other files not to be backups")
+	}
+}
+
+func TestDirStore(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "backups")
+	store, err := NewStore(dir, BucketOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names, err := store.List(ctx); err != nil || len(names) != 0 {
+		t.Errorf("List() of a missing directory = %v, %v, want none", names, err)
+	}
+
+	start := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	for i := range 4 {
+		if err := store.Save(ctx, Name(start.Add(time.Duration(i)*time.Hour), false), strings.NewReader("backup"), 6); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := Prune(ctx, store, 2)
+	if err != nil || len(deleted) != 2 || deleted[0] != Name(start, false) {
+		t.Errorf("Prune() = %v, %v, want the two oldest backups", deleted, err)
+	}
+	names, _ := store.List(ctx)
+	if want := []string{Name(start.Add(2*time.Hour), false), Name(start.Add(3*time.Hour), false)}; !slices.Equal(names, want) {
+		t.Errorf("List() = %v, want %v", names, want)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "notes.txt")); err != nil {
+		t.Errorf("expected other files to be kept: %v", err)
+	}
+
+	r, err := store.Open(ctx, names[0])
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	content, _ := io.ReadAll(r)
+	_ = r.Close()
+	if string(content) != "backup" {
+		t.Errorf("Open() = %q", content)
+	}
+	if _, err := store.Open(ctx, "../etc/passwd"); err == nil {
+		t.Error("expected names outside the directory to be rejected")
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	store, err := NewStore("gs://feedback-backups/hub", BucketOptions{})
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	if bucket := store.(*bucketStore); bucket.bucket.Provider != "gcs" || bucket.bucket.Name != "feedback-backups" || bucket.prefix != "hub/" {
+		t.Errorf("unexpected store: %+v", bucket)
+	}
+	if _, err := NewStore("s3://", BucketOptions{}); err == nil {
+		t.Error("expected a location without bucket to be rejected")
+	}
+	if _, err := NewStore("", BucketOptions{}); err == nil {
+		t.Error("expected a missing location to be rejected")
+	}
+}
@@ -0,0 +1,185 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Encrypted backups start with magic and a random salt, followed by chunks of up to
+// chunkSize bytes, each sealed with AES-256-GCM under a key derived from the passphrase and
+// the salt with scrypt. The nonce of a chunk is its number and whether it is the last one,
+// so chunks can't be reordered, and a backup cut at a chunk boundary doesn't decrypt.
+const (
+	magic     = "HUBBAK1\n"
+	saltSize  = 16
+	chunkSize = 64 * 1024
+)
+
+// ErrDecrypt is returned for encrypted backups that the passphrase doesn't decrypt
+var ErrDecrypt = errors.New("failed to decrypt backup: wrong encryption key or corrupted backup")
+
+// deriveKey derives the AES-256 key of a backup from the passphrase and salt
+func deriveKey(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce of a chunk
+func chunkNonce(n uint64, last bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce, n)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// encrypter encrypts what's written to it in chunks
+type encrypter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	buf    []byte
+	chunk  uint64
+	closed bool
+}
+
+// Encrypt returns a writer that encrypts what's written to it with passphrase and writes it
+// to w. Close must be called to write the last chunk; it doesn't close w.
+func Encrypt(w io.Writer, passphrase string) (io.WriteCloser, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(append([]byte(magic), salt...)); err != nil {
+		return nil, err
+	}
+	return &encrypter{w: w, aead: aead, buf: make([]byte, 0, chunkSize)}, nil
+}
+
+// Write buffers p, sealing full chunks once more data follows them
+func (e *encrypter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed encrypter")
+	}
+	written := 0
+	for len(p) > 0 {
+		if len(e.buf) == chunkSize {
+			if err := e.seal(false); err != nil {
+				return written, err
+			}
+		}
+		n := min(chunkSize-len(e.buf), len(p))
+		e.buf = append(e.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+// Close seals the last chunk, which may be empty
+func (e *encrypter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.seal(true)
+}
+
+// seal encrypts and writes the buffered chunk
+func (e *encrypter) seal(last bool) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.chunk, last), e.buf, nil)
+	e.chunk++
+	e.buf = e.buf[:0]
+	_, err := e.w.Write(sealed)
+	return err
+}
+
+// decrypter decrypts the chunks of an encrypted backup
+type decrypter struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	buf   []byte
+	plain []byte
+	chunk uint64
+	done  bool
+}
+
+// IsEncrypted reports whether the backup read by r is encrypted, without consuming it
+func IsEncrypted(r *bufio.Reader) bool {
+	head, _ := r.Peek(len(magic))
+	return bytes.Equal(head, []byte(magic))
+}
+
+// Decrypt returns a reader of the decrypted content of an encrypted backup. Reads fail with
+// ErrDecrypt if the passphrase is wrong or the backup was changed or cut off.
+func Decrypt(r *bufio.Reader, passphrase string) (io.Reader, error) {
+	head := make([]byte, len(magic)+saltSize)
+	if _, err := io.ReadFull(r, head); err != nil || string(head[:len(magic)]) != magic {
+		return nil, errors.New("not an encrypted backup")
+	}
+	aead, err := deriveKey(passphrase, head[len(magic):])
+	if err != nil {
+		return nil, err
+	}
+	return &decrypter{r: r, aead: aead, buf: make([]byte, chunkSize+aead.Overhead())}, nil
+}
+
+// Read returns decrypted content, opening the next chunk when the current one is read
+func (d *decrypter) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+// open reads and decrypts the next chunk. A chunk is the last one if the backup ends after
+// it; its nonce then has to say so, or the backup was cut off.
+func (d *decrypter) open() error {
+	n, err := io.ReadFull(d.r, d.buf)
+	last := false
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF):
+		last = true
+	case err != nil:
+		return fmt.Errorf("failed to read backup: %w", err)
+	default:
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		}
+	}
+	plain, err := d.aead.Open(d.buf[:0], chunkNonce(d.chunk, last), d.buf[:n], nil)
+	if err != nil {
+		return ErrDecrypt
+	}
+	d.chunk++
+	d.plain = plain
+	d.done = last
+	return nil
+}
@@ -0,0 +1,133 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/leader"
+)
+
+const (
+	// lockKey is the PostgreSQL advisory lock held by the instance creating a backup, so a
+	// backup is only created once however many Hub instances run
+	lockKey = 7_241_905_006
+	// checkInterval is how often the scheduler checks whether a backup is due
+	checkInterval = time.Minute
+)
+
+// Scheduler creates a backup when the newest one in the store is older than the interval,
+// and deletes the oldest ones beyond the retention
+type Scheduler struct {
+	client     *ent.Client
+	db         *sql.DB
+	store      Store
+	passphrase string
+	interval   time.Duration
+	keep       int
+	logger     *slog.Logger
+
+	// next is when the next backup is due, known after the store was listed
+	next time.Time
+
+	stopChan  chan struct{}
+	stopOnce  sync.Once
+	completed chan struct{}
+}
+
+// NewScheduler creates a scheduler of the backups of client, whose database is db, to store,
+// encrypted with passphrase if it isn't empty. keep is the number of backups kept, 0 for all.
+func NewScheduler(client *ent.Client, db *sql.DB, store Store, passphrase string, interval time.Duration, keep int, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		client:     client,
+		db:         db,
+		store:      store,
+		passphrase: passphrase,
+		interval:   interval,
+		keep:       keep,
+		logger:     logger,
+		stopChan:   make(chan struct{}),
+		completed:  make(chan struct{}),
+	}
+}
+
+// Run checks whether a backup is due right away and then every minute until ctx is canceled
+// or Stop is called
+func (s *Scheduler) Run(ctx context.Context) {
+	defer close(s.completed)
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		s.round(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops scheduling backups and waits for the backup being created
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() { close(s.stopChan) })
+	<-s.completed
+}
+
+// round creates a backup if one is due, unless another instance is creating it
+func (s *Scheduler) round(ctx context.Context) {
+	if time.Now().Before(s.next) {
+		return
+	}
+
+	lock, err := leader.TryAcquire(ctx, s.db, lockKey)
+	if err != nil {
+		s.logger.Warn("failed to acquire backup lock", "error", err)
+	}
+	if lock == nil {
+		return
+	}
+	defer lock.Release()
+
+	// The newest backup tells when the next one is due, whichever instance created it
+	names, err := s.store.List(ctx)
+	if err != nil {
+		s.logger.Error("failed to list backups", "error", err)
+		return
+	}
+	if len(names) > 0 {
+		if at, ok := CreatedAt(names[len(names)-1]); ok && time.Since(at) < s.interval {
+			s.next = at.Add(s.interval)
+			return
+		}
+	}
+
+	started := time.Now()
+	name, counts, err := Create(ctx, s.client, s.store, s.passphrase)
+	if err != nil {
+		// Try again at the next check
+		s.logger.Error("backup failed", "error", err)
+		return
+	}
+	s.next = started.Add(s.interval)
+	s.logger.Info("backup created",
+		"name", name,
+		"experiences", counts.Experiences,
+		"duration", time.Since(started).Round(time.Millisecond))
+
+	deleted, err := Prune(ctx, s.store, s.keep)
+	if err != nil {
+		s.logger.Error("failed to delete old backups", "error", err)
+		return
+	}
+	if len(deleted) > 0 {
+		s.logger.Info("deleted old backups", "names", deleted)
+	}
+}
@@ -0,0 +1,111 @@
+// Package cache caches responses of read endpoints for a short time, in memory or in Redis.
+// Entries belong to a generation: invalidating the cache starts a new generation, so the
+// entries of earlier generations are no longer read and expire on their own.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+// keyPrefix namespaces Hub's keys in a shared store
+const keyPrefix = "hub:cache:"
+
+// generationKey holds the current generation
+const generationKey = keyPrefix + "generation"
+
+// Store holds cached values
+type Store interface {
+	// Get returns the value of the key and whether it exists
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value of the key for ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Counter returns the value of a counter, or 0 if it doesn't exist. Counters never expire.
+	Counter(ctx context.Context, key string) (int64, error)
+	// Incr increments a counter and returns its new value
+	Incr(ctx context.Context, key string) (int64, error)
+}
+
+// Cache stores values for a fixed TTL until they are invalidated
+type Cache struct {
+	store  Store
+	ttl    time.Duration
+	logger *slog.Logger
+}
+
+// New creates a cache that keeps values in store for ttl
+func New(store Store, ttl time.Duration, logger *slog.Logger) *Cache {
+	return &Cache{store: store, ttl: ttl, logger: logger}
+}
+
+// NewFromConfig creates the cache selected in the configuration, or returns nil if
+// caching is disabled
+func NewFromConfig(cfg *config.Config, logger *slog.Logger) (*Cache, error) {
+	ttl := time.Duration(cfg.CacheTTL) * time.Second
+
+	switch cfg.CacheBackend {
+	case "none", "":
+		return nil, nil
+	case "memory":
+		return New(NewMemoryStore(cfg.CacheMaxEntries), ttl, logger), nil
+	case "redis":
+		store, err := NewRedisStore(cfg.RedisURL)
+		if err != nil {
+			return nil, err
+		}
+		return New(store, ttl, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported cache backend: %s", cfg.CacheBackend)
+	}
+}
+
+// Generation returns the current generation. Read it before computing a value and store
+// the value with it, so a value computed before an invalidation is never read after it.
+func (c *Cache) Generation(ctx context.Context) (int64, error) {
+	return c.store.Counter(ctx, generationKey)
+}
+
+// Get returns the value of the key in the generation and whether it was found
+func (c *Cache) Get(ctx context.Context, generation int64, key string) ([]byte, bool, error) {
+	return c.store.Get(ctx, entryKey(generation, key))
+}
+
+// Set stores the value of the key in the generation
+func (c *Cache) Set(ctx context.Context, generation int64, key string, value []byte) error {
+	return c.store.Set(ctx, entryKey(generation, key), value, c.ttl)
+}
+
+// Invalidate starts a new generation, so all cached values are missed
+func (c *Cache) Invalidate(ctx context.Context) error {
+	_, err := c.store.Incr(ctx, generationKey)
+	return err
+}
+
+// Hook returns an Ent hook that invalidates the cache after every successful mutation,
+// whether it comes from the API or a worker
+func (c *Cache) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			if err == nil {
+				if err := c.Invalidate(ctx); err != nil {
+					// Cached responses stay stale until they expire
+					c.logger.Warn("failed to invalidate response cache",
+						"type", m.Type(),
+						"error", err)
+				}
+			}
+			return value, err
+		})
+	}
+}
+
+// entryKey returns the store key of a value in a generation
+func entryKey(generation int64, key string) string {
+	return fmt.Sprintf("%s%d:%s", keyPrefix, generation, key)
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryEntry is a value in the memory store
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// MemoryStore keeps values in process memory, evicting the least recently used value
+// when it is full. Each Hub process has its own store.
+type MemoryStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List // Most recently used first
+	counters   map[string]int64
+}
+
+// NewMemoryStore creates a memory store that holds up to maxEntries values
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{
+		maxEntries: max(1, maxEntries),
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		counters:   make(map[string]int64),
+	}
+}
+
+// Get returns the value of the key if it hasn't expired
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*memoryEntry)
+	if time.Now().After(entry.expiresAt) {
+		s.remove(element)
+		return nil, false, nil
+	}
+	s.lru.MoveToFront(element)
+	return entry.value, true, nil
+}
+
+// Set stores the value of the key for ttl, evicting the least recently used value if full
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := &memoryEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)}
+	if element, ok := s.entries[key]; ok {
+		element.Value = entry
+		s.lru.MoveToFront(element)
+		return nil
+	}
+
+	for s.lru.Len() >= s.maxEntries {
+		s.remove(s.lru.Back())
+	}
+	s.entries[key] = s.lru.PushFront(entry)
+	return nil
+}
+
+// Counter returns the value of a counter
+func (s *MemoryStore) Counter(_ context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counters[key], nil
+}
+
+// Incr increments a counter
+func (s *MemoryStore) Incr(_ context.Context, key string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+	return s.counters[key], nil
+}
+
+// remove deletes an entry; the caller must hold mu
+func (s *MemoryStore) remove(element *list.Element) {
+	s.lru.Remove(element)
+	delete(s.entries, element.Value.(*memoryEntry).key)
+}
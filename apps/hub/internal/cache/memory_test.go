@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(2)
+
+	_ = store.Set(ctx, "a", []byte("1"), time.Minute)
+	_ = store.Set(ctx, "b", []byte("2"), time.Minute)
+	// Reading a makes b the least recently used entry
+	if value, found, _ := store.Get(ctx, "a"); !found || string(value) != "1" {
+		t.Fatalf("expected a=1, got %q (found %v)", value, found)
+	}
+	_ = store.Set(ctx, "c", []byte("3"), time.Minute)
+	if _, found, _ := store.Get(ctx, "b"); found {
+		t.Error("expected b to be evicted")
+	}
+	if _, found, _ := store.Get(ctx, "a"); !found {
+		t.Error("expected a to be kept")
+	}
+
+	_ = store.Set(ctx, "d", []byte("4"), -time.Second)
+	if _, found, _ := store.Get(ctx, "d"); found {
+		t.Error("expected an expired entry to be missed")
+	}
+
+	// Counters are never evicted
+	for i := 0; i < 3; i++ {
+		_, _ = store.Incr(ctx, "gen")
+	}
+	if n, _ := store.Counter(ctx, "gen"); n != 3 {
+		t.Errorf("expected counter 3, got %d", n)
+	}
+}
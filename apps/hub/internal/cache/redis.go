@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore keeps values in Redis, so all Hub processes share the cache and its
+// invalidations
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore connects to the Redis server at the URL (e.g., redis://localhost:6379/0)
+func NewRedisStore(url string) (*RedisStore, error) {
+	if url == "" {
+		return nil, errors.New("the redis cache backend requires a redis URL")
+	}
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis URL: %w", err)
+	}
+	return &RedisStore{client: redis.NewClient(opts)}, nil
+}
+
+// Get returns the value of the key
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+// Set stores the value of the key for ttl
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, key, value, ttl).Err()
+}
+
+// Counter returns the value of a counter
+func (s *RedisStore) Counter(ctx context.Context, key string) (int64, error) {
+	value, err := s.client.Get(ctx, key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return value, err
+}
+
+// Incr increments a counter
+func (s *RedisStore) Incr(ctx context.Context, key string) (int64, error) {
+	return s.client.Incr(ctx, key).Result()
+}
+
+// Close closes the connection to Redis
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -4,6 +4,11 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -11,42 +16,232 @@ import (
 // Huma CLI automatically reads from environment variables with SERVICE_ prefix
 // or command-line arguments
 type Config struct {
+	// Configuration file, whose settings are overridden by environment variables and flags
+	ConfigFile string `name:"config" help:"Path to a YAML or TOML configuration file; environment variables and flags override its settings"`
+
 	// Database configuration
-	DatabaseURL       string `help:"PostgreSQL connection string" env:"DATABASE_URL" required:"true"`
-	DBMaxOpenConns    int    `help:"Maximum number of open database connections" default:"25"`
-	DBMaxIdleConns    int    `help:"Maximum number of idle database connections" default:"5"`
-	DBConnMaxLifetime int    `help:"Maximum connection lifetime in minutes" default:"5"`
-	DBConnMaxIdleTime int    `help:"Maximum connection idle time in minutes" default:"5"`
+	DatabaseURL        string `help:"PostgreSQL connection string" env:"DATABASE_URL" required:"true"`
+	DatabaseReplicaURL string `help:"Connection string of a read-only PostgreSQL replica that serves experience lists, search, AI usage, and audit log queries (optional)"`
+	DBMaxOpenConns     int    `help:"Maximum number of open database connections" default:"25"`
+	DBMaxIdleConns     int    `help:"Maximum number of idle database connections" default:"5"`
+	DBConnMaxLifetime  int    `help:"Maximum connection lifetime in minutes" default:"5"`
+	DBConnMaxIdleTime  int    `help:"Maximum connection idle time in minutes" default:"5"`
+	AutoMigrate        bool   `help:"Apply pending database migrations at startup; when disabled, Hub refuses to start until they are applied with 'hub migrate apply'" default:"true"`
+
+	// Run mode
+	Mode string `help:"Processes to run: all (HTTP API and AI job workers), api (HTTP API only), or worker (AI job workers only)" default:"all" enum:"all,api,worker"`
 
 	// Server configuration
 	Host string `help:"Host to bind to" default:"0.0.0.0"`
 	Port int    `help:"Port to listen on" short:"p" default:"8080"`
 
+	// Listeners, e.g. a Unix domain socket for a sidecar proxy instead of a TCP port
+	Listen         string `help:"Comma-separated addresses to listen on instead of Host and Port: host:port for TCP or unix:/path for a Unix domain socket"`
+	UnixSocketMode string `help:"File permissions of Unix domain sockets in octal" default:"0660"`
+
+	// Health checks
+	DeepHealthCheck bool `help:"Serve GET /health/deep with the status of the database, AI providers, webhook workers, and AI job workers (requires the API key if one is set)" default:"false"`
+
+	// Diagnostics
+	DebugEndpoints bool   `help:"Serve Go runtime profiles (/debug/pprof/) and variables (/debug/vars), protected by the debug API key" default:"false"`
+	DebugAPIKey    string `help:"API key required by the debug endpoints in the X-API-Key header; defaults to the admin API key"`
+
+	// Graceful shutdown (HTTP requests drain first, then AI job workers, then webhook deliveries)
+	ShutdownDrainDelay     int `help:"Seconds to keep serving after a shutdown signal while /health reports 503, so load balancers stop sending requests" default:"0"`
+	ShutdownTimeout        int `help:"Seconds to wait on shutdown for in-flight HTTP requests to finish before their connections are closed" default:"30"`
+	WebhookShutdownTimeout int `help:"Seconds to wait on shutdown for queued webhook deliveries to be sent" default:"30"`
+
+	// TLS configuration (HTTPS is served on the TCP listeners when certificate files or autocert domains are set)
+	TLSCertFile         string `help:"Path to the PEM certificate (chain) served over HTTPS"`
+	TLSKeyFile          string `help:"Path to the PEM private key of the TLS certificate"`
+	TLSAutocertDomains  string `help:"Comma-separated domains to obtain certificates for from Let's Encrypt (Port must be reachable on 443)"`
+	TLSAutocertEmail    string `help:"Contact email for the Let's Encrypt account (optional)"`
+	TLSAutocertCacheDir string `help:"Directory where Let's Encrypt certificates are stored across restarts" default:"certs"`
+	TLSRedirectPort     int    `help:"Plain HTTP port that redirects to HTTPS and answers Let's Encrypt HTTP challenges (0 = disabled)" default:"0"`
+
 	// Webhook configuration
-	WebhookUrls string `help:"Comma-separated webhook URLs"`
+	WebhookUrls                  string `help:"Comma-separated webhook URLs that receive all events (deprecated: manage endpoints with /v1/webhooks)"`
+	WebhookDeliveryRetentionDays int    `help:"Days that webhook deliveries are kept for redelivery and replay" default:"7"`
+	WebhookAllowedHosts          string `help:"Comma-separated hosts (*.example.com matches subdomains), IPs, or CIDR ranges that webhook endpoints may target even if they resolve to private, loopback, or link-local addresses"`
+
+	// Event stream configuration
+	WebsocketAllowedOrigins string `help:"Comma-separated origins (host patterns such as app.example.com or *.example.com) whose browser pages may open the /v1/events WebSocket"`
 
 	// Environment
 	Environment string `help:"Environment (development/production)" default:"development"`
 
 	// Security
-	APIKey string `help:"Optional API key for authentication" env:"API_KEY"`
+	SecurityHeaders       bool   `help:"Send hardened response headers (Content-Security-Policy, X-Frame-Options, X-Content-Type-Options, Referrer-Policy)" default:"true"`
+	HSTSMaxAge            int    `help:"Strict-Transport-Security max-age in seconds, sent outside of development (0 = no HSTS); only enable it when Hub is served over HTTPS" default:"31536000"`
+	APIKey                string `help:"Optional API key for authentication" env:"API_KEY"`
+	AdminAPIKey           string `help:"Admin API key required by administrative endpoints such as POST /v1/query in the X-Admin-Key header"`
+	AuthMaxFailures       int    `help:"Failed API key attempts from an IP within the failure window before the IP is locked out (0 = no lockouts)" default:"10"`
+	AuthGlobalMaxFailures int    `help:"Failed API key attempts from all IPs within the failure window after which every further failure locks out its IP (0 = no global budget)" default:"100"`
+	AuthFailureWindow     int    `help:"Seconds in which failed API key attempts are counted" default:"300"`
+	AuthLockout           int    `help:"Seconds of the first lockout; each further lockout of the IP doubles it" default:"60"`
+	AuthMaxLockout        int    `help:"Maximum lockout in seconds" default:"3600"`
+	AuditLog              bool   `help:"Record mutating API calls (actor, request ID, outcome, and emitted webhook events) in the audit log" default:"true"`
+	AuditRetentionDays    int    `help:"Days that audit log entries are kept" default:"90"`
 
 	// AI Enrichment configuration
-	OpenAIKey              string `help:"OpenAI API key for AI features (optional)"`
-	OpenAIEnrichmentModel  string `help:"OpenAI model for sentiment/topic enrichment" default:"gpt-4o-mini"`
-	OpenAIEmbeddingModel   string `help:"OpenAI model for embeddings (e.g., text-embedding-3-small)"`
-	EnrichmentTimeout      int    `help:"Enrichment timeout in seconds" default:"10"`
-	EnrichmentWorkers      int    `help:"Number of concurrent enrichment workers" default:"3"`
-	EnrichmentPollInterval int    `help:"Worker poll interval in seconds" default:"1"`
+	EnrichmentProvider         string `help:"AI provider for enrichment (openai/gemini/custom)" default:"openai" enum:"openai,gemini,custom"`
+	EmbeddingProvider          string `help:"AI provider for embeddings (openai/gemini)" default:"openai" enum:"openai,gemini"`
+	OpenAIKey                  string `help:"OpenAI API key for AI features (optional)"`
+	OpenAIEnrichmentModel      string `help:"OpenAI model for sentiment/topic enrichment" default:"gpt-4o-mini"`
+	OpenAIEmbeddingModel       string `help:"OpenAI model for embeddings (e.g., text-embedding-3-small)"`
+	GeminiKey                  string `help:"Google Gemini API key for AI features (optional)"`
+	GeminiEnrichmentModel      string `help:"Gemini model for sentiment/topic enrichment" default:"gemini-2.0-flash"`
+	GeminiEmbeddingModel       string `help:"Gemini model for embeddings (e.g., gemini-embedding-001)"`
+	CustomEnricherURL          string `help:"Endpoint that receives texts to enrich when the enrichment provider is custom"`
+	CustomEnricherToken        string `help:"Bearer token sent to the custom enricher endpoint (optional)"`
+	CustomEnricherModel        string `help:"Name recorded as the enrichment model of custom enrichments; change it to re-enrich with a new classifier version" default:"custom"`
+	CustomEnricherAttributes   string `help:"Comma-separated attributes accepted from the custom enricher as name:type (string, number, boolean, string_array)"`
+	EnrichmentFallbacks        string `help:"Comma-separated providers to try in order when the enrichment provider fails (e.g., gemini,openai:gpt-4o)"`
+	AISkipSources              string `help:"Comma-separated source types or source IDs whose experiences are never sent to AI providers"`
+	ReprocessOnUpdate          bool   `help:"Clear enrichment and embeddings and re-enqueue AI jobs when value_text is updated" default:"true"`
+	ReenrichStale              bool   `help:"Re-enqueue enrichment at startup for experiences enriched with an older prompt version or an unconfigured model" default:"false"`
+	EnrichmentTimeout          int    `help:"Enrichment timeout in seconds" default:"10"`
+	EnrichmentWorkers          int    `help:"Number of concurrent enrichment workers" default:"3"`
+	EnrichmentMaxWorkers       int    `help:"Maximum number of enrichment workers when scaling with the queue depth (0 = fixed pool)" default:"0"`
+	EnrichmentPollInterval     int    `help:"Worker poll interval in seconds" default:"1"`
+	EmbeddingWorkers           int    `help:"Number of workers dedicated to embedding jobs (0 = enrichment workers process both job types)" default:"0"`
+	EmbeddingMaxWorkers        int    `help:"Maximum number of embedding workers when scaling with the queue depth (0 = fixed pool)" default:"0"`
+	EmbeddingPollInterval      int    `help:"Embedding worker poll interval in seconds (0 = same as enrichment workers)" default:"0"`
+	AutoscaleJobsPerWorker     int    `help:"Pending jobs per worker that an autoscaled pool aims for" default:"20"`
+	JobMaxAttempts             int    `help:"Processing attempts before a failed AI job is moved to the dead-letter queue" default:"3"`
+	JobVisibilityTimeout       int    `help:"Seconds a job may stay in processing before it is considered stranded and returned to the queue" default:"300"`
+	WorkerShutdownTimeout      int    `help:"Seconds to wait on shutdown for in-flight AI jobs to finish before they are returned to the queue" default:"30"`
+	JobNotify                  bool   `help:"Wake workers with PostgreSQL LISTEN/NOTIFY when jobs are enqueued instead of waiting for the next poll" default:"true"`
+	EnrichmentBatchSize        int    `help:"Maximum number of short texts analyzed in a single enrichment request (1 = no batching)" default:"1"`
+	AIRequestsPerMinute        int    `help:"Client-side request budget per minute for each AI provider (0 = unlimited)" default:"0"`
+	AITokensPerMinute          int    `help:"Client-side token budget per minute for each AI provider (0 = unlimited)" default:"0"`
+	EmbeddingRequestsPerMinute int    `help:"Separate request budget per minute for embeddings and search (0 = share the provider budget)" default:"0"`
+	EmbeddingTokensPerMinute   int    `help:"Separate token budget per minute for embeddings and search (0 = share the provider budget)" default:"0"`
+	UrgentThreshold            int    `help:"Urgency score (0-100) at or above which an experience.urgent webhook is dispatched" default:"70"`
+
+	// Queue configuration
+	QueueBackend          string `help:"Backend that holds AI jobs (postgres/sqs)" default:"postgres" enum:"postgres,sqs"`
+	SQSQueueURL           string `help:"URL of the SQS queue for AI jobs when the queue backend is sqs"`
+	SQSDeadLetterQueueURL string `help:"URL of the SQS queue that receives AI jobs which exhausted their attempts"`
+	AWSRegion             string `help:"AWS region of the SQS queues (defaults to the region in the queue URL)"`
+	AWSAccessKeyID        string `help:"AWS access key ID for SQS (defaults to AWS_ACCESS_KEY_ID or the ECS task role)"`
+	AWSSecretAccessKey    string `help:"AWS secret access key for SQS"`
+	AWSSessionToken       string `help:"AWS session token for temporary SQS credentials (optional)"`
+
+	// Anomaly detection
+	AnomalyDetection       bool `help:"Compare the volume and negative sentiment of each source against a rolling baseline and dispatch alert.triggered events on spikes and drops; one instance checks at a time" default:"false"`
+	AnomalyWindow          int  `help:"Minutes per checked window of incoming experiences; each window is checked once it has ended" default:"60"`
+	AnomalyBaselineWindows int  `help:"Number of preceding windows that form the baseline" default:"24"`
+	AnomalyThreshold       int  `help:"Standard deviations from the baseline at which an alert is triggered" default:"3"`
+	AnomalyMinResponses    int  `help:"Experiences (enriched experiences for sentiment) a window or its baseline needs before it is alerted on" default:"10"`
+
+	// Read-only SQL queries (POST /v1/query)
+	QueryDatabaseURL string `help:"Connection string of a restricted PostgreSQL role that may only read the query_experiences view; enables POST /v1/query, which requires the admin API key"`
+	QueryTimeout     int    `help:"Seconds a query may run before it is canceled" default:"10"`
+	QueryMaxRows     int    `help:"Maximum number of rows returned by a query; further rows are cut off" default:"1000"`
+
+	// Response caching
+	CacheBackend    string `help:"Where responses of read endpoints are cached (none/memory/redis)" default:"none" enum:"none,memory,redis"`
+	CacheTTL        int    `help:"Seconds that cached responses are served before they are recomputed" default:"5"`
+	CacheMaxEntries int    `help:"Maximum number of responses held by the memory cache" default:"1000"`
+	RedisURL        string `help:"Redis connection URL for the redis cache backend (e.g., redis://localhost:6379/0)"`
+
+	// Tracing
+	TracingEndpoint    string `help:"OTLP/HTTP endpoint that receives OpenTelemetry traces (e.g., http://otel-collector:4318); tracing is disabled if empty"`
+	TracingServiceName string `help:"Service name reported with traces" default:"formbricks-hub"`
+	TracingSampleRatio string `help:"Share of traces to sample, from 0 to 1 (e.g., 0.1); requests that arrive with a sampled trace are always traced" default:"1"`
+
+	// Error reporting
+	ErrorReportingSentryDSN    string `help:"Sentry DSN that receives panics and 5xx errors of API requests; disabled if empty"`
+	ErrorReportingOTLPEndpoint string `help:"OTLP/HTTP endpoint that receives panics and 5xx errors of API requests as log records (e.g., http://otel-collector:4318); disabled if empty"`
+	ErrorReportingEnvironment  string `help:"Environment reported with errors (e.g., production)"`
 
 	// Logging
 	LogLevel string `help:"Log level (debug/info/warn/error)" default:"info" enum:"debug,info,warn,error"`
 
 	// Rate Limiting
-	RateLimitPerIP       int `help:"Max requests per second per IP address" default:"100"`
-	RateLimitBurst       int `help:"Burst size for rate limiter (allows temporary spikes)" default:"200"`
-	RateLimitGlobal      int `help:"Max requests per second globally (all IPs combined)" default:"1000"`
-	RateLimitGlobalBurst int `help:"Global burst size" default:"2000"`
+	RateLimitPerIP        int    `help:"Max requests per second per IP address" default:"100"`
+	RateLimitBurst        int    `help:"Burst size for rate limiter (allows temporary spikes)" default:"200"`
+	RateLimitGlobal       int    `help:"Max requests per second globally (all IPs combined)" default:"1000"`
+	RateLimitGlobalBurst  int    `help:"Global burst size" default:"2000"`
+	RateLimitExemptPaths  string `help:"Comma-separated routes ([METHOD ]/path, * matches one path segment) that are never rate limited" default:"/health,/metrics"`
+	RateLimitSearchPerIP  int    `help:"Max search requests per second per IP address (0 = default per-IP limit)" default:"10"`
+	RateLimitSearchBurst  int    `help:"Burst size for search requests" default:"20"`
+	RateLimitSearchRoutes string `help:"Comma-separated routes ([METHOD ]/path, * matches one path segment) limited with the search limit" default:"GET /v1/experiences/search"`
+	RateLimitAIPerIP      int    `help:"Max requests per second per IP address to routes that call AI providers (0 = default per-IP limit)" default:"2"`
+	RateLimitAIBurst      int    `help:"Burst size for requests to routes that call AI providers" default:"5"`
+	RateLimitAIRoutes     string `help:"Comma-separated routes ([METHOD ]/path, * matches one path segment) limited with the AI limit" default:"POST /v1/enrichment/preview,POST /v1/experiences/*/reprocess,POST /v1/experiences/*/translations"`
+
+	// Ingest
+	MetadataColumns string `help:"Comma-separated metadata keys that populate the typed columns at ingest as column=key (columns: country, region, device, platform, app_version; keys may be dotted paths such as geo.country); list a column again for fallback keys, which are tried in order" default:"country=country,region=region,device=device,platform=platform,app_version=app_version"`
+	CSATRange       string `help:"Inclusive min-max range of csat scores; others are rejected with 422" default:"1-7"`
+	RatingRange     string `help:"Inclusive min-max range of rating scores; others are rejected with 422" default:"0-10"`
+	DuplicatePolicy string `help:"What to do with an experience of a user whose content hash matches an earlier one: allow, flag (set duplicate_of), or reject (409 Conflict)" default:"allow" enum:"allow,flag,reject"`
+	DuplicateWindow int    `help:"Hours around collected_at in which an experience with the same content hash is a duplicate (0 = any time)" default:"24"`
+
+	// Connectors
+	TypeformWebhookSecret  string `help:"Secret of the Typeform webhooks that deliver responses to POST /v1/connectors/typeform, which is only served when set; deliveries are authenticated by their signature instead of the API key"`
+	TypeformToken          string `help:"Typeform personal access token with the forms:read and responses:read scopes, used by POST /v1/connectors/typeform/backfill to import historical responses"`
+	IntercomClientSecret   string `help:"Client secret of the Intercom app whose webhooks deliver conversations to POST /v1/connectors/intercom, which is only served when set; deliveries are authenticated by their signature instead of the API key"`
+	IntercomToken          string `help:"Intercom access token with read conversations permission, used by POST /v1/connectors/intercom/backfill to import earlier conversations"`
+	SegmentWriteKey        string `help:"Write key that Segment, its SDKs, and compatible tools send to POST /v1/connectors/segment (and its /v1/track and /v1/batch paths), which is only served when set; track events are stored as experiences"`
+	SegmentEvents          string `help:"Comma-separated names of the Segment track events stored as experiences (empty = all)"`
+	SegmentForwardWriteKey string `help:"Write key of the Segment source that enriched experiences are forwarded to as Feedback Enriched track events; forwarding runs in the processes that run workers"`
+	SegmentForwardURL      string `help:"Tracking API batch endpoint enriched experiences are forwarded to, e.g. of Segment's EU region or a compatible pipeline" default:"https://api.segment.io/v1/batch"`
+	ZendeskSubdomain       string `help:"Subdomain of the Zendesk account (<subdomain>.zendesk.com) synced by POST /v1/connectors/zendesk/sync, which is only enabled when set"`
+	ZendeskEmail           string `help:"Email of the Zendesk agent whose API token is used"`
+	ZendeskAPIToken        string `help:"Zendesk API token, required with SERVICE_ZENDESK_SUBDOMAIN"`
+	AppStoreApps           string `help:"Comma-separated Apple App Store app IDs whose reviews are fetched periodically"`
+	AppStoreCountries      string `help:"Comma-separated two-letter codes of the App Store countries whose reviews are fetched" default:"us"`
+	GooglePlayPackages     string `help:"Comma-separated package names of Google Play apps whose reviews are fetched periodically"`
+	GooglePlayKeyFile      string `help:"JSON key file of a Google Cloud service account with access to the Google Play apps, required with SERVICE_GOOGLE_PLAY_PACKAGES"`
+	AppReviewInterval      int    `help:"Minutes between fetches of App Store and Google Play reviews; one instance fetches at a time" default:"60"`
+
+	// Warehouse sync
+	WarehouseProvider       string `help:"Warehouse that new and updated experiences are synced to (none/bigquery/snowflake); one instance syncs at a time" default:"none" enum:"none,bigquery,snowflake"`
+	WarehouseTable          string `help:"Table experiences are synced to; created, and missing columns added, when Hub starts" default:"experiences"`
+	WarehouseSyncInterval   int    `help:"Minutes between syncs to the warehouse; a sync with more to write continues right away" default:"5"`
+	BigQueryProject         string `name:"bigquery-project" help:"Google Cloud project of the BigQuery dataset, required with SERVICE_WAREHOUSE_PROVIDER=bigquery"`
+	BigQueryDataset         string `name:"bigquery-dataset" help:"BigQuery dataset that holds the table; it must exist"`
+	BigQueryKeyFile         string `name:"bigquery-key-file" help:"JSON key file of a Google Cloud service account with the BigQuery Data Editor role on the dataset"`
+	SnowflakeAccount        string `help:"Snowflake account identifier (e.g., myorg-myaccount), required with SERVICE_WAREHOUSE_PROVIDER=snowflake"`
+	SnowflakeUser           string `help:"Snowflake user whose key pair authenticates the sync"`
+	SnowflakePrivateKeyFile string `help:"Unencrypted PEM private key of the key pair of the Snowflake user"`
+	SnowflakeDatabase       string `help:"Snowflake database that holds the table"`
+	SnowflakeSchema         string `help:"Snowflake schema that holds the table" default:"PUBLIC"`
+	SnowflakeWarehouse      string `help:"Snowflake virtual warehouse that runs the inserts (empty = the default warehouse of the user)"`
+	SnowflakeRole           string `help:"Snowflake role the inserts run as (empty = the default role of the user)"`
+
+	// Google Sheets export
+	GoogleSheetsSpreadsheetID string `help:"ID of the Google spreadsheet new experiences are appended to, from its URL (disabled if empty); one instance appends at a time"`
+	GoogleSheetsSheet         string `help:"Sheet (tab) of the spreadsheet that rows are appended to; added with a header row if missing" default:"Experiences"`
+	GoogleSheetsColumns       string `help:"Comma-separated experience fields appended as columns, in order (e.g., created_at,source_name,value_text,sentiment)" default:"created_at,source_type,source_name,field_label,value_text,value_number,user_identifier,sentiment,topics"`
+	GoogleSheetsKeyFile       string `help:"JSON key file of a Google Cloud service account that the spreadsheet is shared with as editor"`
+	GoogleSheetsSyncInterval  int    `help:"Minutes between appends to the spreadsheet" default:"5"`
+
+	// Analytics forwarding
+	AnalyticsForwardProvider string `help:"Product analytics tool that enriched experiences are forwarded to as Feedback Enriched events (none/amplitude/mixpanel); forwarding runs in the processes that run workers" default:"none" enum:"none,amplitude,mixpanel"`
+	AnalyticsForwardAPIKey   string `help:"API key of the Amplitude project, or API secret of the Mixpanel project, that events are sent to"`
+	AnalyticsForwardRegion   string `help:"Data region of the Amplitude or Mixpanel project (us/eu)" default:"us" enum:"us,eu"`
+
+	// Backups
+	BackupLocation        string `help:"Directory, or s3://bucket/prefix or gs://bucket/prefix URL, that hub backup and scheduled backups write to and hub restore reads from"`
+	BackupEncryptionKey   string `help:"Passphrase that backups are encrypted with (AES-256-GCM); required to restore them, so keep it apart from the backups (empty = unencrypted)"`
+	BackupInterval        int    `help:"Hours between scheduled backups to SERVICE_BACKUP_LOCATION (0 = none); one instance backs up at a time" default:"0"`
+	BackupRetention       int    `help:"Number of backups kept in SERVICE_BACKUP_LOCATION; older ones are deleted after each scheduled backup (0 = all)" default:"7"`
+	BackupRegion          string `help:"Region of the backup bucket (defaults to us-east-1 for s3://, auto for gs://)"`
+	BackupEndpoint        string `help:"URL of an S3-compatible service holding the backup bucket, e.g. MinIO or R2 (empty = AWS S3 or GCS)"`
+	BackupAccessKeyID     string `help:"Access key ID of the backup bucket, an HMAC key for gs:// (defaults to AWS_ACCESS_KEY_ID)"`
+	BackupSecretAccessKey string `help:"Secret access key of the backup bucket"`
+
+	// Request body size limits
+	MaxBodySize    string `help:"Maximum request body size (e.g., 10MB, 512KB, or bytes)" default:"10MB"`
+	BodySizeLimits string `help:"Comma-separated per-route body size limits as [METHOD ]/path=size (* matches one path segment); the first matching route applies" default:"POST /v1/experiences=256KB"`
+
+	// Request timeouts (search and AI routes are those of SERVICE_RATE_LIMIT_SEARCH_ROUTES and SERVICE_RATE_LIMIT_AI_ROUTES)
+	RequestTimeout       int `help:"Seconds a request may take before it is canceled with 504 Gateway Timeout (0 = no timeout)" default:"30"`
+	SearchRequestTimeout int `help:"Seconds a search request may take (0 = no timeout)" default:"15"`
+	AIRequestTimeout     int `help:"Seconds a request to a route that calls AI providers may take (0 = no timeout)" default:"60"`
 }
 
 // Address returns the server address in host:port format
@@ -54,19 +249,279 @@ func (c *Config) Address() string {
 	return fmt.Sprintf("%s:%d", c.Host, c.Port)
 }
 
+// Listener is an address the server listens on
+type Listener struct {
+	// Network is "tcp" or "unix"
+	Network string
+	// Address is host:port for TCP or the socket path for Unix domain sockets
+	Address string
+}
+
+// GetListeners returns the addresses to listen on: those of Listen, or Host and Port
+func (c *Config) GetListeners() ([]Listener, error) {
+	addresses := splitList(c.Listen)
+	if len(addresses) == 0 {
+		return []Listener{{Network: "tcp", Address: c.Address()}}, nil
+	}
+
+	listeners := make([]Listener, 0, len(addresses))
+	for _, address := range addresses {
+		if path, ok := strings.CutPrefix(address, "unix:"); ok {
+			if path == "" {
+				return nil, fmt.Errorf("invalid listen address %q: expected unix:/path/to/socket", address)
+			}
+			listeners = append(listeners, Listener{Network: "unix", Address: path})
+			continue
+		}
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return nil, fmt.Errorf("invalid listen address %q: expected host:port or unix:/path", address)
+		}
+		listeners = append(listeners, Listener{Network: "tcp", Address: address})
+	}
+	return listeners, nil
+}
+
+// GetUnixSocketMode returns the file permissions of Unix domain sockets
+func (c *Config) GetUnixSocketMode() (os.FileMode, error) {
+	mode, err := strconv.ParseUint(c.UnixSocketMode, 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0, fmt.Errorf("invalid Unix socket mode %q: expected octal permissions such as 0660", c.UnixSocketMode)
+	}
+	return os.FileMode(mode), nil
+}
+
+// GetDebugAPIKey returns the API key of the debug endpoints. Profiles expose the internals
+// of the process, including the command line, so the debug endpoints are never served
+// without a key, and never with the API key that every client holds.
+func (c *Config) GetDebugAPIKey() (string, error) {
+	key := c.DebugAPIKey
+	if key == "" {
+		key = c.AdminAPIKey
+	}
+	if c.DebugEndpoints && key == "" {
+		return "", fmt.Errorf("debug endpoints require SERVICE_DEBUG_API_KEY or SERVICE_ADMIN_API_KEY")
+	}
+	return key, nil
+}
+
+// GetQueryDatabaseURL returns the connection string of read-only SQL queries, or an empty
+// string if they are disabled. Queries are never served without the admin API key.
+func (c *Config) GetQueryDatabaseURL() (string, error) {
+	if c.QueryDatabaseURL == "" {
+		return "", nil
+	}
+	switch {
+	case c.AdminAPIKey == "":
+		return "", fmt.Errorf("SERVICE_QUERY_DATABASE_URL requires SERVICE_ADMIN_API_KEY")
+	case c.QueryTimeout < 1:
+		return "", fmt.Errorf("SERVICE_QUERY_TIMEOUT must be at least 1 second")
+	case c.QueryMaxRows < 1:
+		return "", fmt.Errorf("SERVICE_QUERY_MAX_ROWS must be at least 1")
+	}
+	return c.QueryDatabaseURL, nil
+}
+
+// zendeskSubdomainPattern matches the subdomains of Zendesk accounts
+var zendeskSubdomainPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// GetZendeskSubdomain returns the subdomain of the synced Zendesk account, or an empty
+// string if the Zendesk connector is disabled
+func (c *Config) GetZendeskSubdomain() (string, error) {
+	if c.ZendeskSubdomain == "" {
+		return "", nil
+	}
+	switch {
+	case !zendeskSubdomainPattern.MatchString(c.ZendeskSubdomain):
+		return "", fmt.Errorf("SERVICE_ZENDESK_SUBDOMAIN must be the subdomain of <subdomain>.zendesk.com, got %q", c.ZendeskSubdomain)
+	case c.ZendeskEmail == "" || c.ZendeskAPIToken == "":
+		return "", fmt.Errorf("SERVICE_ZENDESK_SUBDOMAIN requires SERVICE_ZENDESK_EMAIL and SERVICE_ZENDESK_API_TOKEN")
+	}
+	return c.ZendeskSubdomain, nil
+}
+
+// countryCodePattern matches two-letter country codes
+var countryCodePattern = regexp.MustCompile(`^[A-Za-z]{2}$`)
+
+// GetAppStoreApps returns the App Store app IDs and the countries whose reviews are fetched
+func (c *Config) GetAppStoreApps() ([]string, []string, error) {
+	appIDs := splitList(c.AppStoreApps)
+	if len(appIDs) == 0 {
+		return nil, nil, nil
+	}
+	for _, appID := range appIDs {
+		if _, err := strconv.ParseUint(appID, 10, 64); err != nil {
+			return nil, nil, fmt.Errorf("invalid App Store app ID %q, expected the number in the app's URL", appID)
+		}
+	}
+	countries := splitList(c.AppStoreCountries)
+	if len(countries) == 0 {
+		return nil, nil, fmt.Errorf("SERVICE_APP_STORE_APPS requires SERVICE_APP_STORE_COUNTRIES")
+	}
+	for _, country := range countries {
+		if !countryCodePattern.MatchString(country) {
+			return nil, nil, fmt.Errorf("invalid App Store country %q, expected a two-letter country code", country)
+		}
+	}
+	return appIDs, countries, nil
+}
+
+// GetSegmentEvents returns the names of the Segment track events stored as experiences, or
+// nil if all are
+func (c *Config) GetSegmentEvents() []string {
+	return splitList(c.SegmentEvents)
+}
+
+// GetGoogleSheetsColumns returns the experience fields appended to the spreadsheet
+func (c *Config) GetGoogleSheetsColumns() []string {
+	return splitList(c.GoogleSheetsColumns)
+}
+
+// GetGooglePlayPackages returns the package names of the Google Play apps whose reviews are
+// fetched
+func (c *Config) GetGooglePlayPackages() ([]string, error) {
+	packages := splitList(c.GooglePlayPackages)
+	if len(packages) > 0 && c.GooglePlayKeyFile == "" {
+		return nil, fmt.Errorf("SERVICE_GOOGLE_PLAY_PACKAGES requires SERVICE_GOOGLE_PLAY_KEY_FILE")
+	}
+	return packages, nil
+}
+
+// RunsAPI returns true if this process serves the HTTP API
+func (c *Config) RunsAPI() bool {
+	return c.Mode != "worker"
+}
+
+// RunsWorkers returns true if this process runs AI job workers
+func (c *Config) RunsWorkers() bool {
+	return c.Mode != "api"
+}
+
 // IsDevelopment returns true if the environment is development
 func (c *Config) IsDevelopment() bool {
 	return c.Environment == "development"
 }
 
-// IsEnrichmentEnabled returns true if OpenAI enrichment is configured
+// GetHSTSMaxAge returns the Strict-Transport-Security max-age to send, or 0 if HSTS is
+// disabled. HSTS is never sent in development, where Hub is usually served over plain HTTP.
+func (c *Config) GetHSTSMaxAge() int {
+	if c.IsDevelopment() {
+		return 0
+	}
+	return c.HSTSMaxAge
+}
+
+// IsEnrichmentEnabled returns true if the selected enrichment provider is configured
 func (c *Config) IsEnrichmentEnabled() bool {
-	return c.OpenAIKey != "" && c.OpenAIEnrichmentModel != ""
+	switch c.EnrichmentProvider {
+	case "gemini":
+		return c.GeminiKey != "" && c.GeminiEnrichmentModel != ""
+	case "custom":
+		return c.CustomEnricherURL != ""
+	default:
+		return c.OpenAIKey != "" && c.OpenAIEnrichmentModel != ""
+	}
 }
 
-// IsEmbeddingEnabled returns true if OpenAI embeddings are configured
+// IsEmbeddingEnabled returns true if the selected embedding provider is configured
 func (c *Config) IsEmbeddingEnabled() bool {
-	return c.OpenAIKey != "" && c.OpenAIEmbeddingModel != ""
+	switch c.EmbeddingProvider {
+	case "gemini":
+		return c.GeminiKey != "" && c.GeminiEmbeddingModel != ""
+	default:
+		return c.OpenAIKey != "" && c.OpenAIEmbeddingModel != ""
+	}
+}
+
+// EnrichmentModel returns the model of the selected enrichment provider
+func (c *Config) EnrichmentModel() string {
+	switch c.EnrichmentProvider {
+	case "gemini":
+		return c.GeminiEnrichmentModel
+	case "custom":
+		return c.CustomEnricherModel
+	default:
+		return c.OpenAIEnrichmentModel
+	}
+}
+
+// EmbeddingModel returns the model of the selected embedding provider
+func (c *Config) EmbeddingModel() string {
+	if c.EmbeddingProvider == "gemini" {
+		return c.GeminiEmbeddingModel
+	}
+	return c.OpenAIEmbeddingModel
+}
+
+// ProviderEnrichmentModel returns the configured enrichment model of a chat provider
+func (c *Config) ProviderEnrichmentModel(provider string) string {
+	switch provider {
+	case "gemini":
+		return c.GeminiEnrichmentModel
+	case "openai":
+		return c.OpenAIEnrichmentModel
+	default:
+		return ""
+	}
+}
+
+// ProviderModel identifies an AI provider and the model to use with it
+type ProviderModel struct {
+	Provider string
+	Model    string
+}
+
+// GetEnrichmentProviders returns the enrichment provider followed by the configured fallbacks.
+// Fallbacks are "provider" or "provider:model"; without a model, the provider's configured
+// enrichment model is used.
+func (c *Config) GetEnrichmentProviders() []ProviderModel {
+	result := []ProviderModel{{Provider: c.EnrichmentProvider, Model: c.EnrichmentModel()}}
+	if c.EnrichmentFallbacks == "" {
+		return result
+	}
+
+	for _, entry := range strings.Split(c.EnrichmentFallbacks, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		provider, model, _ := strings.Cut(entry, ":")
+		provider = strings.ToLower(strings.TrimSpace(provider))
+		model = strings.TrimSpace(model)
+		if model == "" {
+			model = c.ProviderEnrichmentModel(provider)
+		}
+		result = append(result, ProviderModel{Provider: provider, Model: model})
+	}
+	return result
+}
+
+// GetEnrichmentModels returns the models of the enrichment provider and its fallbacks
+func (c *Config) GetEnrichmentModels() []string {
+	providers := c.GetEnrichmentProviders()
+	result := make([]string, 0, len(providers))
+	for _, p := range providers {
+		if p.Model != "" {
+			result = append(result, p.Model)
+		}
+	}
+	return result
+}
+
+// SkipsAIForSource returns true if experiences from the given source must not be sent to AI providers.
+// AISkipSources entries match either the source type or the source ID.
+func (c *Config) SkipsAIForSource(sourceType, sourceID string) bool {
+	if c.AISkipSources == "" {
+		return false
+	}
+
+	for _, entry := range strings.Split(c.AISkipSources, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" && (entry == sourceType || entry == sourceID) {
+			return true
+		}
+	}
+	return false
 }
 
 // GetWebhookURLs parses and returns the webhook URLs as a slice
@@ -85,3 +540,175 @@ func (c *Config) GetWebhookURLs() []string {
 	}
 	return result
 }
+
+// GetWebsocketAllowedOrigins parses and returns the allowed WebSocket origins as a slice
+func (c *Config) GetWebsocketAllowedOrigins() []string {
+	return splitList(c.WebsocketAllowedOrigins)
+}
+
+// GetWebhookAllowedHosts parses and returns the webhook target allowlist as a slice
+func (c *Config) GetWebhookAllowedHosts() []string {
+	return splitList(c.WebhookAllowedHosts)
+}
+
+// GetTLSAutocertDomains parses and returns the domains to obtain certificates for as a slice
+func (c *Config) GetTLSAutocertDomains() []string {
+	return splitList(c.TLSAutocertDomains)
+}
+
+// GetRateLimitExemptPaths parses and returns the routes excluded from rate limiting as a slice
+func (c *Config) GetRateLimitExemptPaths() []string {
+	return splitList(c.RateLimitExemptPaths)
+}
+
+// GetRateLimitSearchRoutes parses and returns the routes limited with the search limit as a slice
+func (c *Config) GetRateLimitSearchRoutes() []string {
+	return splitList(c.RateLimitSearchRoutes)
+}
+
+// GetRateLimitAIRoutes parses and returns the routes limited with the AI limit as a slice
+func (c *Config) GetRateLimitAIRoutes() []string {
+	return splitList(c.RateLimitAIRoutes)
+}
+
+// splitList splits a comma-separated list, dropping empty entries
+func splitList(list string) []string {
+	var result []string
+	for _, entry := range strings.Split(list, ",") {
+		if trimmed := strings.TrimSpace(entry); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+	return result
+}
+
+// GetTracingSampleRatio returns the share of traces to sample. The CLI has no float options,
+// so the ratio is parsed here.
+func (c *Config) GetTracingSampleRatio() (float64, error) {
+	if c.TracingSampleRatio == "" {
+		return 1, nil
+	}
+	ratio, err := strconv.ParseFloat(c.TracingSampleRatio, 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return 0, fmt.Errorf("invalid tracing sample ratio %q: must be a number from 0 to 1", c.TracingSampleRatio)
+	}
+	return ratio, nil
+}
+
+// RouteBodySize is the maximum request body size of the routes matching a pattern
+type RouteBodySize struct {
+	Route    string // "[METHOD ]/path", where * matches one path segment
+	MaxBytes int64
+}
+
+// GetMaxBodySize returns the maximum request body size in bytes
+func (c *Config) GetMaxBodySize() (int64, error) {
+	size, err := parseByteSize(c.MaxBodySize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max body size: %w", err)
+	}
+	return size, nil
+}
+
+// GetBodySizeLimits parses and returns the per-route body size limits
+func (c *Config) GetBodySizeLimits() ([]RouteBodySize, error) {
+	var limits []RouteBodySize
+	for _, entry := range splitList(c.BodySizeLimits) {
+		route, size, found := strings.Cut(entry, "=")
+		if !found || strings.TrimSpace(route) == "" {
+			return nil, fmt.Errorf("invalid body size limit %q: expected [METHOD ]/path=size", entry)
+		}
+		maxBytes, err := parseByteSize(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid body size limit %q: %w", entry, err)
+		}
+		limits = append(limits, RouteBodySize{Route: strings.TrimSpace(route), MaxBytes: maxBytes})
+	}
+	return limits, nil
+}
+
+// MetadataColumnNames are the typed columns that can be populated from metadata keys
+var MetadataColumnNames = []string{"country", "region", "device", "platform", "app_version"}
+
+// GetMetadataColumns parses the metadata column mapping and returns the metadata keys of
+// each typed column, in the order they are tried
+func (c *Config) GetMetadataColumns() (map[string][]string, error) {
+	columns := make(map[string][]string)
+	for _, entry := range splitList(c.MetadataColumns) {
+		column, key, found := strings.Cut(entry, "=")
+		column, key = strings.TrimSpace(column), strings.TrimSpace(key)
+		if !found || key == "" || strings.HasPrefix(key, ".") || strings.HasSuffix(key, ".") {
+			return nil, fmt.Errorf("invalid metadata column %q: expected column=key", entry)
+		}
+		if !slices.Contains(MetadataColumnNames, column) {
+			return nil, fmt.Errorf("invalid metadata column %q: column must be one of %s", entry, strings.Join(MetadataColumnNames, ", "))
+		}
+		columns[column] = append(columns[column], key)
+	}
+	return columns, nil
+}
+
+// GetCSATRange returns the inclusive range of csat scores
+func (c *Config) GetCSATRange() (float64, float64, error) {
+	minScore, maxScore, err := parseRange(c.CSATRange)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid csat range: %w", err)
+	}
+	return minScore, maxScore, nil
+}
+
+// GetRatingRange returns the inclusive range of rating scores
+func (c *Config) GetRatingRange() (float64, float64, error) {
+	minScore, maxScore, err := parseRange(c.RatingRange)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid rating range: %w", err)
+	}
+	return minScore, maxScore, nil
+}
+
+// parseRange parses an inclusive range such as 1-5. The minimum may be negative, so the
+// separator is the first '-' after its first character.
+func parseRange(s string) (float64, float64, error) {
+	s = strings.TrimSpace(s)
+	i := -1
+	if len(s) > 1 {
+		i = strings.Index(s[1:], "-")
+	}
+	if i < 0 {
+		return 0, 0, fmt.Errorf("%q is not a range (e.g., 1-5)", s)
+	}
+	minScore, err := strconv.ParseFloat(strings.TrimSpace(s[:i+1]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%q is not a range (e.g., 1-5)", s)
+	}
+	maxScore, err := strconv.ParseFloat(strings.TrimSpace(s[i+2:]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%q is not a range (e.g., 1-5)", s)
+	}
+	if minScore > maxScore {
+		return 0, 0, fmt.Errorf("%q has a minimum above its maximum", s)
+	}
+	return minScore, maxScore, nil
+}
+
+// parseByteSize parses a size in bytes with an optional KB, MB, or GB suffix (powers of 1024)
+func parseByteSize(size string) (int64, error) {
+	size = strings.ToUpper(strings.TrimSpace(size))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix     string
+		multiplier int64
+	}{{"KB", 1 << 10}, {"MB", 1 << 20}, {"GB", 1 << 30}, {"B", 1}} {
+		if strings.HasSuffix(size, unit.suffix) {
+			size = strings.TrimSpace(strings.TrimSuffix(size, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%q is not a positive size (e.g., 256KB or 10MB)", size)
+	}
+	return n * multiplier, nil
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestGetListeners(t *testing.T) {
+	cfg := &Config{Host: "0.0.0.0", Port: 8080}
+	listeners, err := cfg.GetListeners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Listener{{Network: "tcp", Address: "0.0.0.0:8080"}}; !reflect.DeepEqual(listeners, want) {
+		t.Errorf("expected Host and Port without Listen, got %+v", listeners)
+	}
+
+	cfg.Listen = "127.0.0.1:8080, unix:/run/hub/hub.sock, [::1]:9090"
+	listeners, err = cfg.GetListeners()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Listener{
+		{Network: "tcp", Address: "127.0.0.1:8080"},
+		{Network: "unix", Address: "/run/hub/hub.sock"},
+		{Network: "tcp", Address: "[::1]:9090"},
+	}
+	if !reflect.DeepEqual(listeners, want) {
+		t.Errorf("expected %+v, got %+v", want, listeners)
+	}
+
+	for _, listen := range []string{"unix:", "localhost", "/run/hub/hub.sock"} {
+		cfg.Listen = listen
+		if _, err := cfg.GetListeners(); err == nil {
+			t.Errorf("expected an error for %q", listen)
+		}
+	}
+}
+
+func TestGetUnixSocketMode(t *testing.T) {
+	mode, err := (&Config{UnixSocketMode: "0660"}).GetUnixSocketMode()
+	if err != nil || mode != os.FileMode(0o660) {
+		t.Errorf("expected 0660, got %o (%v)", mode, err)
+	}
+	for _, value := range []string{"rw", "0999", "1777"} {
+		if _, err := (&Config{UnixSocketMode: value}).GetUnixSocketMode(); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
+
+func TestGetDebugAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    string
+		wantErr bool
+	}{
+		{name: "debug key", cfg: Config{DebugEndpoints: true, DebugAPIKey: "debug", APIKey: "api"}, want: "debug"},
+		{name: "falls back to the admin key", cfg: Config{DebugEndpoints: true, AdminAPIKey: "admin", APIKey: "api"}, want: "admin"},
+		{name: "API key only", cfg: Config{DebugEndpoints: true, APIKey: "api"}, wantErr: true},
+		{name: "no key", cfg: Config{DebugEndpoints: true}, wantErr: true},
+		{name: "disabled without key", cfg: Config{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := tt.cfg.GetDebugAPIKey()
+			if (err != nil) != tt.wantErr || key != tt.want {
+				t.Errorf("expected %q (error: %v), got %q (%v)", tt.want, tt.wantErr, key, err)
+			}
+		})
+	}
+}
+
+func TestGetQueryDatabaseURL(t *testing.T) {
+	valid := Config{QueryDatabaseURL: "postgres://analyst@localhost/hub", AdminAPIKey: "admin", QueryTimeout: 10, QueryMaxRows: 1000}
+	if url, err := valid.GetQueryDatabaseURL(); err != nil || url != valid.QueryDatabaseURL {
+		t.Errorf("expected %q, got %q (%v)", valid.QueryDatabaseURL, url, err)
+	}
+	if url, err := (&Config{}).GetQueryDatabaseURL(); err != nil || url != "" {
+		t.Errorf("expected queries to be disabled, got %q (%v)", url, err)
+	}
+
+	tests := map[string]func(*Config){
+		"no admin key": func(c *Config) { c.AdminAPIKey = "" },
+		"no timeout":   func(c *Config) { c.QueryTimeout = 0 },
+		"no rows":      func(c *Config) { c.QueryMaxRows = 0 },
+	}
+	for name, invalidate := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := valid
+			invalidate(&cfg)
+			if _, err := cfg.GetQueryDatabaseURL(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestGetZendeskSubdomain(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		want    string
+		wantErr bool
+	}{
+		{name: "configured", cfg: Config{ZendeskSubdomain: "acme", ZendeskEmail: "agent@acme.com", ZendeskAPIToken: "token"}, want: "acme"},
+		{name: "disabled", cfg: Config{}},
+		{name: "no token", cfg: Config{ZendeskSubdomain: "acme", ZendeskEmail: "agent@acme.com"}, wantErr: true},
+		{name: "no email", cfg: Config{ZendeskSubdomain: "acme", ZendeskAPIToken: "token"}, wantErr: true},
+		{name: "full host", cfg: Config{ZendeskSubdomain: "acme.zendesk.com", ZendeskEmail: "agent@acme.com", ZendeskAPIToken: "token"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subdomain, err := tt.cfg.GetZendeskSubdomain()
+			if (err != nil) != tt.wantErr || subdomain != tt.want {
+				t.Errorf("expected %q (error: %v), got %q (%v)", tt.want, tt.wantErr, subdomain, err)
+			}
+		})
+	}
+}
+
+func TestGetAppStoreApps(t *testing.T) {
+	cfg := Config{AppStoreApps: "284882215, 389801252", AppStoreCountries: "us,DE"}
+	appIDs, countries, err := cfg.GetAppStoreApps()
+	if err != nil || len(appIDs) != 2 || appIDs[1] != "389801252" || len(countries) != 2 {
+		t.Errorf("unexpected apps %v in %v (%v)", appIDs, countries, err)
+	}
+	if appIDs, _, err := (&Config{AppStoreCountries: "us"}).GetAppStoreApps(); err != nil || appIDs != nil {
+		t.Errorf("expected no apps, got %v (%v)", appIDs, err)
+	}
+
+	tests := map[string]Config{
+		"app name":     {AppStoreApps: "facebook", AppStoreCountries: "us"},
+		"no countries": {AppStoreApps: "284882215"},
+		"country name": {AppStoreApps: "284882215", AppStoreCountries: "germany"},
+	}
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := cfg.GetAppStoreApps(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestGetGooglePlayPackages(t *testing.T) {
+	cfg := Config{GooglePlayPackages: "com.example.app", GooglePlayKeyFile: "/etc/hub/play.json"}
+	if packages, err := cfg.GetGooglePlayPackages(); err != nil || len(packages) != 1 {
+		t.Errorf("expected one package, got %v (%v)", packages, err)
+	}
+	cfg.GooglePlayKeyFile = ""
+	if _, err := cfg.GetGooglePlayPackages(); err == nil {
+		t.Error("expected an error without a key file")
+	}
+}
+
+func TestGetMetadataColumns(t *testing.T) {
+	cfg := Config{MetadataColumns: "country=country, country=geo.country,device=client.device"}
+	columns, err := cfg.GetMetadataColumns()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string][]string{"country": {"country", "geo.country"}, "device": {"client.device"}}
+	if !reflect.DeepEqual(columns, want) {
+		t.Errorf("expected %v, got %v", want, columns)
+	}
+
+	for _, mapping := range []string{"country", "city=city", "country=", "country=geo."} {
+		cfg := Config{MetadataColumns: mapping}
+		if _, err := cfg.GetMetadataColumns(); err == nil {
+			t.Errorf("expected %q to be invalid", mapping)
+		}
+	}
+}
+
+func TestGetRatingRange(t *testing.T) {
+	for value, want := range map[string][2]float64{"0-10": {0, 10}, "1 - 5": {1, 5}, "-2-2": {-2, 2}, "0.5-5": {0.5, 5}} {
+		cfg := Config{RatingRange: value}
+		minScore, maxScore, err := cfg.GetRatingRange()
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", value, err)
+			continue
+		}
+		if minScore != want[0] || maxScore != want[1] {
+			t.Errorf("expected %q to be %v, got [%v %v]", value, want, minScore, maxScore)
+		}
+	}
+
+	for _, value := range []string{"", "5", "1-", "a-5", "5-1"} {
+		cfg := Config{RatingRange: value}
+		if _, _, err := cfg.GetRatingRange(); err == nil {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"github.com/danielgtaylor/huma/v2/casing"
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix is the prefix of the environment variables read by Huma CLI
+const envPrefix = "SERVICE_"
+
+var (
+	// fileEnv records the environment variables set by LoadFile, which a reload may change
+	fileEnvMu sync.Mutex
+	fileEnv   = make(map[string]bool)
+)
+
+// FilePath returns the configuration file given with the --config flag in args, or in the
+// SERVICE_CONFIG environment variable. It's read before Huma CLI parses the flags, since the
+// file's settings become the defaults of the flags.
+func FilePath(args []string) string {
+	if value, ok := flagValue(args, "config"); ok {
+		return value
+	}
+	return os.Getenv(envPrefix + "CONFIG")
+}
+
+// flagValue returns the value of the --name flag in args, and whether the flag was given
+func flagValue(args []string, name string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, found := strings.CutPrefix(arg, "--"+name+"="); found {
+			return value, true
+		}
+		if arg == "--"+name && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+	return "", false
+}
+
+// LoadFile applies the settings of a YAML (.yaml, .yml, or .json) or TOML (.toml) file by
+// setting their SERVICE_ environment variables, which Huma CLI reads. Variables that are
+// already set take precedence over the file, and flags take precedence over both.
+//
+// Settings are named like their environment variables without the SERVICE_ prefix, in
+// lowercase. Nested tables are joined with underscores (rate_limit: {per_ip: 10} sets
+// rate_limit_per_ip), and lists are joined with commas.
+func LoadFile(path string) error {
+	values, err := readFile(path)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fileEnvMu.Lock()
+	defer fileEnvMu.Unlock()
+	for _, key := range keys {
+		envName := envPrefix + strings.ToUpper(key)
+		if _, ok := os.LookupEnv(envName); ok {
+			continue
+		}
+		if err := os.Setenv(envName, values[key]); err != nil {
+			return fmt.Errorf("failed to apply setting %q: %w", key, err)
+		}
+		fileEnv[envName] = true
+	}
+	return nil
+}
+
+// readFile returns the settings of a configuration file by name
+func readFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var settings map[string]any
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".toml":
+		err = toml.Unmarshal(data, &settings)
+	case ".yaml", ".yml", ".json":
+		err = yaml.Unmarshal(data, &settings)
+	default:
+		return nil, fmt.Errorf("unsupported config file %q: use .yaml, .yml, .json, or .toml", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	if err := flattenSettings("", settings, values); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	known := settingNames()
+	for key := range values {
+		if !known[key] {
+			return nil, fmt.Errorf("invalid config file %s: unknown setting %q", path, key)
+		}
+	}
+	return values, nil
+}
+
+// flattenSettings collects the settings of a table as name/value pairs
+func flattenSettings(prefix string, table map[string]any, values map[string]string) error {
+	for key, value := range table {
+		name := strings.ToLower(strings.ReplaceAll(key, "-", "_"))
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		switch v := value.(type) {
+		case map[string]any:
+			if err := flattenSettings(name, v, values); err != nil {
+				return err
+			}
+		case []any:
+			items := make([]string, len(v))
+			for i, item := range v {
+				s, err := scalarSetting(name, item)
+				if err != nil {
+					return err
+				}
+				items[i] = s
+			}
+			values[name] = strings.Join(items, ",")
+		default:
+			s, err := scalarSetting(name, v)
+			if err != nil {
+				return err
+			}
+			values[name] = s
+		}
+	}
+	return nil
+}
+
+// scalarSetting formats a single value of a setting
+func scalarSetting(name string, value any) (string, error) {
+	switch v := value.(type) {
+	case string, bool, int, int64, uint64, float64:
+		return fmt.Sprint(v), nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("setting %q must be a string, number, boolean, or a list of them", name)
+	}
+}
+
+// settingNames returns the names of all settings, as Huma CLI derives their environment
+// variables from the Config fields
+func settingNames() map[string]bool {
+	names := make(map[string]bool)
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		names[settingName(t.Field(i))] = true
+	}
+	return names
+}
+
+// flagName returns the name of the flag of a Config field
+func flagName(field reflect.StructField) string {
+	if name := field.Tag.Get("name"); name != "" {
+		return name
+	}
+	return casing.Kebab(field.Name)
+}
+
+// settingName returns the name of the setting of a Config field, its environment variable
+// without the SERVICE_ prefix in lowercase
+func settingName(field reflect.StructField) string {
+	return strings.ToLower(casing.Snake(flagName(field)))
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadFile(t *testing.T) {
+	for _, name := range []string{"SERVICE_PORT", "SERVICE_LOG_LEVEL", "SERVICE_RATE_LIMIT_PER_IP", "SERVICE_WEBHOOK_URLS"} {
+		name := name
+		t.Cleanup(func() { _ = os.Unsetenv(name) })
+	}
+	// Environment variables take precedence over the file
+	t.Setenv("SERVICE_LOG_LEVEL", "warn")
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"hub.yaml": "port: 9090\nlog_level: debug\nrate_limit:\n  per_ip: 50\nwebhook_urls: [https://a.example.com, https://b.example.com]\n",
+		"hub.toml": "port = 9090\nlog_level = \"debug\"\nwebhook_urls = [\"https://a.example.com\", \"https://b.example.com\"]\n[rate_limit]\nper_ip = 50\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		_ = os.Unsetenv("SERVICE_PORT")
+
+		if err := LoadFile(path); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		want := map[string]string{
+			"SERVICE_PORT":              "9090",
+			"SERVICE_LOG_LEVEL":         "warn",
+			"SERVICE_RATE_LIMIT_PER_IP": "50",
+			"SERVICE_WEBHOOK_URLS":      "https://a.example.com,https://b.example.com",
+		}
+		for env, value := range want {
+			if got := os.Getenv(env); got != value {
+				t.Errorf("%s: expected %s=%q, got %q", name, env, value, got)
+			}
+		}
+	}
+
+	path := filepath.Join(dir, "typo.yaml")
+	if err := os.WriteFile(path, []byte("rate_limit_per_ipp: 5\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadFile(path); err == nil || !strings.Contains(err.Error(), "rate_limit_per_ipp") {
+		t.Errorf("expected an unknown setting error, got %v", err)
+	}
+}
+
+func TestFilePath(t *testing.T) {
+	t.Setenv("SERVICE_CONFIG", "env.yaml")
+	if got := FilePath([]string{"--port", "8080", "--config", "hub.yaml"}); got != "hub.yaml" {
+		t.Errorf("expected the flag's file, got %q", got)
+	}
+	if got := FilePath([]string{"--config=hub.toml"}); got != "hub.toml" {
+		t.Errorf("expected the flag's file, got %q", got)
+	}
+	if got := FilePath(nil); got != "env.yaml" {
+		t.Errorf("expected the environment's file, got %q", got)
+	}
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// reloadableSettings are the settings that can be changed without restarting Hub
+var reloadableSettings = map[string]bool{
+	"log_level":                true,
+	"webhook_urls":             true,
+	"rate_limit_per_ip":        true,
+	"rate_limit_burst":         true,
+	"rate_limit_global":        true,
+	"rate_limit_global_burst":  true,
+	"rate_limit_exempt_paths":  true,
+	"rate_limit_search_per_ip": true,
+	"rate_limit_search_burst":  true,
+	"rate_limit_search_routes": true,
+	"rate_limit_ai_per_ip":     true,
+	"rate_limit_ai_burst":      true,
+	"rate_limit_ai_routes":     true,
+	"open_ai_enrichment_model": true,
+	"gemini_enrichment_model":  true,
+	"custom_enricher_model":    true,
+	"enrichment_fallbacks":     true,
+}
+
+// ReloadableSettings returns the names of the settings that Reload updates
+func ReloadableSettings() []string {
+	names := make([]string, 0, len(reloadableSettings))
+	for name := range reloadableSettings {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Reload re-reads the configuration file and returns a copy of current with the reloadable
+// settings updated, along with the names of the settings that changed. As on startup, flags
+// (given in args) and environment variables not set by the file take precedence over the
+// file, so those settings keep their values. A setting removed from the file returns to its
+// default. Other settings of the file are ignored until Hub is restarted.
+func Reload(path string, args []string, current *Config) (*Config, []string, error) {
+	values, err := readFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fileEnvMu.Lock()
+	defer fileEnvMu.Unlock()
+
+	next := *current
+	v := reflect.ValueOf(&next).Elem()
+	t := v.Type()
+	var changed []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := settingName(field)
+		if !reloadableSettings[name] {
+			continue
+		}
+		if _, ok := flagValue(args, flagName(field)); ok {
+			continue
+		}
+
+		envName := envPrefix + strings.ToUpper(name)
+		value, ok := os.LookupEnv(envName)
+		if !ok || fileEnv[envName] {
+			if value, ok = values[name]; !ok {
+				value = field.Tag.Get("default")
+			}
+		}
+
+		if err := setField(v.Field(i), field, value); err != nil {
+			return nil, nil, fmt.Errorf("invalid config file %s: setting %q: %w", path, name, err)
+		}
+		if v.Field(i).Interface() != reflect.ValueOf(current).Elem().Field(i).Interface() {
+			changed = append(changed, name)
+		}
+	}
+	return &next, changed, nil
+}
+
+// setField parses value into a string or int Config field, checking the field's enum
+func setField(v reflect.Value, field reflect.StructField, value string) error {
+	switch v.Kind() {
+	case reflect.String:
+		if enum := field.Tag.Get("enum"); enum != "" && !slices.Contains(strings.Split(enum, ","), value) {
+			return fmt.Errorf("must be one of %s", enum)
+		}
+		v.SetString(value)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("must be an integer")
+		}
+		v.SetInt(int64(n))
+	default:
+		return fmt.Errorf("can't be reloaded")
+	}
+	return nil
+}
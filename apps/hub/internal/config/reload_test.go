@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestReload(t *testing.T) {
+	for _, name := range []string{"SERVICE_LOG_LEVEL", "SERVICE_RATE_LIMIT_PER_IP", "SERVICE_RATE_LIMIT_BURST", "SERVICE_WEBHOOK_URLS"} {
+		name := name
+		t.Cleanup(func() { _ = os.Unsetenv(name) })
+		_ = os.Unsetenv(name)
+	}
+	fileEnvMu.Lock()
+	fileEnv = make(map[string]bool)
+	fileEnvMu.Unlock()
+
+	// Environment variables not set by the file keep precedence over it
+	t.Setenv("SERVICE_RATE_LIMIT_BURST", "300")
+
+	path := filepath.Join(t.TempDir(), "hub.yaml")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("port: 9090\nlog_level: debug\nrate_limit:\n  per_ip: 50\n  burst: 60\nwebhook_urls: [https://a.example.com]\n")
+	if err := LoadFile(path); err != nil {
+		t.Fatal(err)
+	}
+	// The settings as on startup, with the --log-level flag given
+	args := []string{"--log-level", "error"}
+	current, _, err := Reload(path, args, &Config{Port: 9090, LogLevel: "error"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.RateLimitPerIP != 50 || current.WebhookUrls != "https://a.example.com" {
+		t.Fatalf("expected the file's settings, got %+v", current)
+	}
+
+	// Change the log level and rate limit, remove the webhook URL, and change the port,
+	// which can't be reloaded
+	write("port: 9191\nlog_level: warn\nrate_limit:\n  per_ip: 20\n  burst: 60\n")
+	next, changed, err := Reload(path, args, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.RateLimitPerIP != 20 {
+		t.Errorf("expected the file's per-IP rate limit, got %d", next.RateLimitPerIP)
+	}
+	if next.RateLimitBurst != 300 {
+		t.Errorf("expected the environment's burst, got %d", next.RateLimitBurst)
+	}
+	if next.LogLevel != "error" {
+		t.Errorf("expected the flag to keep the log level, got %q", next.LogLevel)
+	}
+	if next.WebhookUrls != "" {
+		t.Errorf("expected the removed webhook URLs to be cleared, got %q", next.WebhookUrls)
+	}
+	if next.Port != 9090 {
+		t.Errorf("expected the port to keep its value, got %d", next.Port)
+	}
+	if current.RateLimitPerIP != 50 {
+		t.Error("expected the current config to be left unchanged")
+	}
+	slices.Sort(changed)
+	if want := []string{"rate_limit_per_ip", "webhook_urls"}; !slices.Equal(changed, want) {
+		t.Errorf("expected changed settings %v, got %v", want, changed)
+	}
+
+	write("log_level: verbose\n")
+	if _, _, err := Reload(path, nil, current); err == nil {
+		t.Error("expected an invalid log level to be rejected")
+	}
+}
@@ -0,0 +1,172 @@
+// Package appreviews periodically fetches the reviews of apps from the App Store and
+// Google Play and stores them as experiences. Each app (and App Store country) is a source
+// with its own cursor, the time of the latest review fetched, so every round only stores
+// the reviews that were added since the previous one.
+package appreviews
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
+	"github.com/formbricks/hub/apps/hub/internal/leader"
+)
+
+// SourceType is the source_type of app reviews
+const SourceType = "app_review"
+
+// lockKey is the PostgreSQL advisory lock held by the instance fetching reviews, so each
+// round runs once however many Hub instances run
+const lockKey = 7_241_905_003
+
+// Source is an app in a store whose reviews are fetched
+type Source interface {
+	// Stream names the cursor of the source, e.g. appstore.284882215.us
+	Stream() string
+	// Reviews returns the reviews added or edited at or after since, or all reviews the
+	// store serves if since is zero
+	Reviews(ctx context.Context, since time.Time) ([]*connector.Response, error)
+}
+
+// Store stores the experiences of a response and returns how many were created; responses
+// stored before are skipped
+type Store interface {
+	Store(ctx context.Context, sourceType string, response *connector.Response) (int, error)
+}
+
+// Fetcher fetches the reviews of its sources in rounds
+type Fetcher struct {
+	client   *ent.Client
+	db       *sql.DB
+	store    Store
+	sources  []Source
+	interval time.Duration
+	logger   *slog.Logger
+
+	stopChan  chan struct{}
+	stopOnce  sync.Once
+	completed chan struct{}
+}
+
+// NewFetcher creates a fetcher of the sources that runs a round every interval; db must be
+// the database of client
+func NewFetcher(client *ent.Client, db *sql.DB, store Store, sources []Source, interval time.Duration, logger *slog.Logger) (*Fetcher, error) {
+	if interval < time.Minute {
+		return nil, fmt.Errorf("app review interval must be at least one minute")
+	}
+	return &Fetcher{
+		client:    client,
+		db:        db,
+		store:     store,
+		sources:   sources,
+		interval:  interval,
+		logger:    logger,
+		stopChan:  make(chan struct{}),
+		completed: make(chan struct{}),
+	}, nil
+}
+
+// Run fetches reviews right away and then every interval until ctx is canceled or Stop is
+// called
+func (f *Fetcher) Run(ctx context.Context) {
+	defer close(f.completed)
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		f.round(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-f.stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops fetching and waits for the current round to end
+func (f *Fetcher) Stop() {
+	f.stopOnce.Do(func() { close(f.stopChan) })
+	<-f.completed
+}
+
+// round fetches the reviews of every source, unless another instance is fetching them.
+// Sources that fail are retried in the next round from the same cursor.
+func (f *Fetcher) round(ctx context.Context) {
+	lock, err := leader.TryAcquire(ctx, f.db, lockKey)
+	if err != nil {
+		f.logger.Warn("failed to acquire app review lock", "error", err)
+	}
+	if lock == nil {
+		return
+	}
+	defer lock.Release()
+
+	for _, source := range f.sources {
+		reviews, created, err := f.fetch(ctx, source)
+		if err != nil {
+			f.logger.Error("failed to fetch app reviews", "stream", source.Stream(), "error", err)
+			continue
+		}
+		if reviews > 0 {
+			f.logger.Info("app reviews fetched", "stream", source.Stream(), "reviews", reviews, "created", created)
+		}
+	}
+}
+
+// fetch stores the reviews of a source added since its cursor and moves the cursor to the
+// latest of them. Reviews of the second of the cursor are read again; they are only stored
+// once.
+func (f *Fetcher) fetch(ctx context.Context, source Source) (reviews, created int, err error) {
+	stream := source.Stream()
+	var since time.Time
+	saved, err := f.client.ConnectorCursor.Query().Where(connectorcursor.StreamEQ(stream)).Only(ctx)
+	switch {
+	case err == nil:
+		if since, err = time.Parse(time.RFC3339Nano, saved.Cursor); err != nil {
+			return 0, 0, fmt.Errorf("invalid cursor %q: %w", saved.Cursor, err)
+		}
+	case !ent.IsNotFound(err):
+		return 0, 0, fmt.Errorf("failed to read cursor: %w", err)
+	}
+
+	responses, err := source.Reviews(ctx, since)
+	if err != nil {
+		return 0, 0, err
+	}
+	latest := since
+	for _, response := range responses {
+		n, err := f.store.Store(ctx, SourceType, response)
+		if err != nil {
+			return len(responses), created, err
+		}
+		created += n
+		if response.CollectedAt.After(latest) {
+			latest = response.CollectedAt
+		}
+	}
+
+	if !latest.Equal(since) {
+		cursor := latest.UTC().Format(time.RFC3339Nano)
+		updated, err := f.client.ConnectorCursor.Update().
+			Where(connectorcursor.StreamEQ(stream)).
+			SetCursor(cursor).
+			Save(ctx)
+		if err == nil && updated == 0 {
+			err = f.client.ConnectorCursor.Create().SetStream(stream).SetCursor(cursor).Exec(ctx)
+		}
+		if err != nil {
+			return len(responses), created, fmt.Errorf("failed to save cursor: %w", err)
+		}
+	}
+	return len(responses), created, nil
+}
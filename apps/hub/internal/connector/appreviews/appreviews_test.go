@@ -0,0 +1,16 @@
+package appreviews
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestNewFetcher_InvalidInterval(t *testing.T) {
+	if _, err := NewFetcher(nil, nil, nil, nil, 30*time.Second, slog.Default()); err == nil {
+		t.Error("expected an error for an interval below one minute")
+	}
+	if _, err := NewFetcher(nil, nil, nil, nil, time.Hour, slog.Default()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
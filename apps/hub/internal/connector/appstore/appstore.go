@@ -0,0 +1,219 @@
+// Package appstore reads the customer reviews of apps in the Apple App Store from the
+// public customer reviews feed of each storefront. The feed lists the 500 most recent
+// reviews of an app per country, newest first, and needs no credentials.
+package appstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+const (
+	// sourceName is the source_name of App Store reviews
+	sourceName = "App Store"
+	// defaultBaseURL serves the customer reviews feeds
+	defaultBaseURL = "https://itunes.apple.com"
+	// requestTimeout bounds a single feed request
+	requestTimeout = 30 * time.Second
+	// maxPages is the number of pages of 50 reviews the feed serves
+	maxPages = 10
+)
+
+// Field IDs and labels of review experiences
+const (
+	FieldReview = "review"
+	FieldRating = "rating"
+
+	labelReview = "Review"
+	labelRating = "Rating"
+)
+
+// label is a value of the feed, which wraps all values in objects
+type label struct {
+	Label string `json:"label"`
+}
+
+// Entry is a review in the feed
+type Entry struct {
+	ID      label `json:"id"`
+	Title   label `json:"title"`
+	Content label `json:"content"`
+	Rating  label `json:"im:rating"`
+	Version label `json:"im:version"`
+	Updated label `json:"updated"`
+	Author  struct {
+		Name label `json:"name"`
+	} `json:"author"`
+}
+
+// feed is a page of the customer reviews feed. A page with a single review has an object
+// instead of a list as entry.
+type feed struct {
+	Feed struct {
+		Entry json.RawMessage `json:"entry"`
+	} `json:"feed"`
+}
+
+// entries returns the reviews of a page
+func (f *feed) entries() ([]Entry, error) {
+	raw := bytes.TrimSpace(f.Feed.Entry)
+	switch {
+	case len(raw) == 0 || bytes.Equal(raw, []byte("null")):
+		return nil, nil
+	case raw[0] == '{':
+		var entry Entry
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return nil, err
+		}
+		return []Entry{entry}, nil
+	}
+	var entries []Entry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Source reads the reviews of an app in the storefront of a country
+type Source struct {
+	appID      string
+	country    string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewSource returns the source of the reviews of the app in the storefront of the country,
+// a two-letter country code
+func NewSource(appID, country string) *Source {
+	return &Source{
+		appID:      appID,
+		country:    strings.ToLower(country),
+		baseURL:    defaultBaseURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Stream names the cursor of the source
+func (s *Source) Stream() string {
+	return "appstore." + s.appID + "." + s.country
+}
+
+// Reviews returns the reviews updated at or after since, newest first, or all reviews the
+// feed serves if since is zero
+func (s *Source) Reviews(ctx context.Context, since time.Time) ([]*connector.Response, error) {
+	var responses []*connector.Response
+	for page := 1; page <= maxPages; page++ {
+		entries, err := s.page(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			response := s.ToResponse(entry)
+			if response == nil {
+				continue
+			}
+			if response.CollectedAt.Before(since) {
+				return responses, nil
+			}
+			responses = append(responses, response)
+		}
+		if len(entries) == 0 {
+			break
+		}
+	}
+	return responses, nil
+}
+
+// page returns a page of the feed, newest reviews first
+func (s *Source) page(ctx context.Context, page int) ([]Entry, error) {
+	endpoint := fmt.Sprintf("%s/%s/rss/customerreviews/page=%d/id=%s/sortby=mostrecent/json",
+		s.baseURL, url.PathEscape(s.country), page, url.PathEscape(s.appID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		return nil, &connector.APIError{Status: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	}
+	var f feed
+	if err := json.NewDecoder(resp.Body).Decode(&f); err != nil {
+		return nil, err
+	}
+	return f.entries()
+}
+
+// ToResponse maps a review to a response with the text of the review, its title and body,
+// and its star rating. Entries without an ID or rating, such as the app itself in older
+// feeds, return nil.
+func (s *Source) ToResponse(entry Entry) *connector.Response {
+	stars, err := strconv.ParseFloat(entry.Rating.Label, 64)
+	if entry.ID.Label == "" || err != nil {
+		return nil
+	}
+	updated, err := time.Parse(time.RFC3339, entry.Updated.Label)
+	if err != nil {
+		return nil
+	}
+
+	metadata := map[string]any{"country": strings.ToUpper(s.country)}
+	if entry.Version.Label != "" {
+		metadata["app_version"] = entry.Version.Label
+	}
+	if entry.Author.Name.Label != "" {
+		metadata["author"] = entry.Author.Name.Label
+	}
+	response := &connector.Response{
+		ID:          "appstore-" + entry.ID.Label,
+		SourceID:    s.appID,
+		SourceName:  sourceName,
+		CollectedAt: updated.UTC(),
+		Metadata:    metadata,
+	}
+	if text := reviewText(entry.Title.Label, entry.Content.Label); text != "" {
+		response.Experiences = append(response.Experiences, connector.Experience{
+			FieldID:    FieldReview,
+			FieldLabel: labelReview,
+			FieldType:  string(models.FieldTypeText),
+			ValueText:  &text,
+		})
+	}
+	response.Experiences = append(response.Experiences, connector.Experience{
+		FieldID:     FieldRating,
+		FieldLabel:  labelRating,
+		FieldType:   string(models.FieldTypeRating),
+		ValueNumber: &stars,
+	})
+	return response
+}
+
+// reviewText returns the text of a review: its title and body, separated by a blank line
+func reviewText(title, body string) string {
+	title, body = strings.TrimSpace(title), strings.TrimSpace(body)
+	switch {
+	case title == "":
+		return body
+	case body == "":
+		return title
+	}
+	return title + "\n\n" + body
+}
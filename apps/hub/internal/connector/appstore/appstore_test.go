@@ -0,0 +1,67 @@
+package appstore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// entry returns a review in the feed
+func entry(id, rating, updated string) string {
+	return `{"author": {"name": {"label": "jane"}}, "updated": {"label": "` + updated + `"}, "im:rating": {"label": "` + rating + `"}, "im:version": {"label": "4.2.0"}, "id": {"label": "` + id + `"}, "title": {"label": "Crashes on launch"}, "content": {"label": "Since the update the app crashes.", "attributes": {"type": "text"}}}`
+}
+
+func TestSourceReviews(t *testing.T) {
+	pages := map[string]string{
+		"/de/rss/customerreviews/page=1/id=284882215/sortby=mostrecent/json": `{"feed": {"entry": [` +
+			entry("103", "1", "2024-01-15T10:30:00-07:00") + `,` + entry("102", "4", "2024-01-14T10:30:00-07:00") + `]}}`,
+		"/de/rss/customerreviews/page=2/id=284882215/sortby=mostrecent/json": `{"feed": {"entry": ` + entry("101", "5", "2024-01-10T10:30:00-07:00") + `}}`,
+		"/de/rss/customerreviews/page=3/id=284882215/sortby=mostrecent/json": `{"feed": {"author": {"name": {"label": "iTunes Store"}}}}`,
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, ok := pages[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	source := NewSource("284882215", "DE")
+	source.baseURL = server.URL
+	if source.Stream() != "appstore.284882215.de" {
+		t.Errorf("Stream() = %q", source.Stream())
+	}
+
+	responses, err := source.Reviews(context.Background(), time.Time{})
+	if err != nil {
+		t.Fatalf("Reviews() error = %v", err)
+	}
+	if len(responses) != 3 || requests != 3 {
+		t.Fatalf("got %d reviews in %d requests, want all 3 reviews of the feed", len(responses), requests)
+	}
+	response := responses[0]
+	if response.ID != "appstore-103" || response.SourceID != "284882215" || !response.CollectedAt.Equal(time.Date(2024, 1, 15, 17, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected response: %+v", response)
+	}
+	if response.Metadata["country"] != "DE" || response.Metadata["app_version"] != "4.2.0" {
+		t.Errorf("Metadata = %v", response.Metadata)
+	}
+	if len(response.Experiences) != 2 || *response.Experiences[0].ValueText != "Crashes on launch\n\nSince the update the app crashes." || *response.Experiences[1].ValueNumber != 1 {
+		t.Errorf("unexpected experiences: %+v", response.Experiences)
+	}
+
+	t.Run("since", func(t *testing.T) {
+		requests = 0
+		responses, err := source.Reviews(context.Background(), time.Date(2024, 1, 14, 17, 30, 0, 0, time.UTC))
+		if err != nil || len(responses) != 2 || requests != 2 {
+			t.Errorf("got %d reviews in %d requests (%v), want the 2 reviews since then, stopping at the first older one", len(responses), requests, err)
+		}
+	})
+}
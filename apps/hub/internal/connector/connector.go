@@ -0,0 +1,65 @@
+// Package connector turns the responses of third-party survey and support tools into
+// experiences. Each tool has a subpackage that parses its webhook deliveries or reads its
+// API; Hub stores the experiences like any posted to the API.
+package connector
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Connector receives the webhook deliveries of a tool
+type Connector interface {
+	// Name is the source_type of the experiences and names the webhook route,
+	// POST /v1/connectors/{name}
+	Name() string
+	// Parse authenticates a delivery from its headers and raw body and returns its
+	// response. Deliveries that fail authentication return ErrUnauthorized.
+	Parse(header http.Header, body []byte) (*Response, error)
+}
+
+// Response is a submitted response of a form, or any other piece of feedback received
+// from a tool such as a support ticket comment
+type Response struct {
+	// ID identifies the response in the tool, so a response that is delivered again or
+	// backfilled after its webhook is only stored once
+	ID string
+	// SourceID and SourceName identify the form or account the response belongs to
+	SourceID    string
+	SourceName  string
+	CollectedAt time.Time
+	// UserIdentifier identifies the respondent, if the tool knows them
+	UserIdentifier string
+	// Metadata is stored with every experience of the response
+	Metadata    map[string]any
+	Experiences []Experience
+}
+
+// Experience is the answer to one field of a response
+type Experience struct {
+	FieldID    string
+	FieldLabel string
+	FieldType  string
+	// The value of the column of the field type, plus value_json for answers with more
+	// detail such as the other option of a choice
+	ValueText    *string
+	ValueNumber  *float64
+	ValueBoolean *bool
+	ValueDate    *time.Time
+	ValueJSON    map[string]any
+}
+
+// APIError is an error response of a tool's API
+type APIError struct {
+	Status  int
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API returned %d: %s", e.Status, e.Message)
+}
+
+// ErrUnauthorized is returned for deliveries with a missing or invalid signature
+var ErrUnauthorized = errors.New("missing or invalid signature")
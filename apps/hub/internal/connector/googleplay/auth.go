@@ -0,0 +1,149 @@
+package googleplay
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Scope grants access to the Google Play Developer API
+	Scope = "https://www.googleapis.com/auth/androidpublisher"
+	// defaultTokenURL exchanges signed assertions for access tokens
+	defaultTokenURL = "https://oauth2.googleapis.com/token"
+	// tokenLifetime is how long the requested access tokens are valid
+	tokenLifetime = time.Hour
+	// tokenRefresh is how long before they expire access tokens are renewed
+	tokenRefresh = time.Minute
+)
+
+// ServiceAccount obtains access tokens of a scope for a Google Cloud service account from
+// its JSON key
+type ServiceAccount struct {
+	email      string
+	key        *rsa.PrivateKey
+	scope      string
+	tokenURL   string
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewServiceAccount parses the JSON key of a service account whose tokens grant access to
+// scope, e.g. Scope
+func NewServiceAccount(keyJSON []byte, scope string) (*ServiceAccount, error) {
+	var key struct {
+		Type        string `json:"type"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+		TokenURI    string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(keyJSON, &key); err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	if key.Type != "service_account" || key.ClientEmail == "" {
+		return nil, errors.New("invalid service account key: not the key of a service account")
+	}
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return nil, errors.New("invalid service account key: no PEM private key")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service account key: %w", err)
+	}
+	rsaKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("invalid service account key: not an RSA key")
+	}
+	tokenURL := key.TokenURI
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+	return &ServiceAccount{
+		email:      key.ClientEmail,
+		key:        rsaKey,
+		scope:      scope,
+		tokenURL:   tokenURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// Token returns an access token, requesting a new one when the last one is about to expire
+func (a *ServiceAccount) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token != "" && time.Now().Add(tokenRefresh).Before(a.expires) {
+		return a.token, nil
+	}
+
+	assertion, err := a.assertion(time.Now())
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", apiError(resp)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	a.token = token.AccessToken
+	a.expires = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return a.token, nil
+}
+
+// assertion returns the signed JWT that requests an access token of the scope
+func (a *ServiceAccount) assertion(now time.Time) (string, error) {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]any{
+		"iss":   a.email,
+		"scope": a.scope,
+		"aud":   a.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(tokenLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(nil, a.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,239 @@
+// Package googleplay reads the reviews of apps in Google Play through the Google Play
+// Developer API, with a service account that has access to the apps in the Play Console.
+// The API only lists the reviews created or edited in the last week, so reviews have to be
+// fetched at least weekly.
+package googleplay
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+const (
+	// sourceName is the source_name of Google Play reviews
+	sourceName = "Google Play"
+	// defaultBaseURL is the Google Play Developer API
+	defaultBaseURL = "https://androidpublisher.googleapis.com"
+	// requestTimeout bounds a single API request
+	requestTimeout = 30 * time.Second
+	// pageSize is the largest page of reviews the API returns
+	pageSize = 100
+)
+
+// Field IDs and labels of review experiences, the same as of App Store reviews
+const (
+	FieldReview = "review"
+	FieldRating = "rating"
+
+	labelReview = "Review"
+	labelRating = "Rating"
+)
+
+// Timestamp is a time as the API returns it
+type Timestamp struct {
+	Seconds string `json:"seconds"`
+	Nanos   int    `json:"nanos"`
+}
+
+// Time returns the timestamp as a time
+func (t Timestamp) Time() time.Time {
+	seconds, _ := strconv.ParseInt(t.Seconds, 10, 64)
+	return time.Unix(seconds, int64(t.Nanos)).UTC()
+}
+
+// UserComment is the text and rating of a review as last edited by its author
+type UserComment struct {
+	Text             string    `json:"text"`
+	LastModified     Timestamp `json:"lastModified"`
+	StarRating       int       `json:"starRating"`
+	ReviewerLanguage string    `json:"reviewerLanguage"`
+	Device           string    `json:"device"`
+	AndroidOSVersion int       `json:"androidOsVersion"`
+	AppVersionName   string    `json:"appVersionName"`
+}
+
+// Review is a review of an app. Its comments are the user's review and the developer's
+// reply, if any.
+type Review struct {
+	ReviewID   string `json:"reviewId"`
+	AuthorName string `json:"authorName"`
+	Comments   []struct {
+		UserComment *UserComment `json:"userComment"`
+	} `json:"comments"`
+}
+
+// reviewsPage is a page of reviews
+type reviewsPage struct {
+	Reviews         []Review `json:"reviews"`
+	TokenPagination struct {
+		NextPageToken string `json:"nextPageToken"`
+	} `json:"tokenPagination"`
+}
+
+// Source reads the reviews of an app
+type Source struct {
+	packageName string
+	baseURL     string
+	auth        *ServiceAccount
+	httpClient  *http.Client
+}
+
+// NewSource returns the source of the reviews of the app with the package name, read with
+// the service account
+func NewSource(packageName string, auth *ServiceAccount) *Source {
+	return &Source{
+		packageName: packageName,
+		baseURL:     defaultBaseURL,
+		auth:        auth,
+		httpClient:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Stream names the cursor of the source
+func (s *Source) Stream() string {
+	return "googleplay." + s.packageName
+}
+
+// Reviews returns the reviews created or edited at or after since, of those the API lists
+func (s *Source) Reviews(ctx context.Context, since time.Time) ([]*connector.Response, error) {
+	var responses []*connector.Response
+	token := ""
+	for {
+		page, err := s.page(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range page.Reviews {
+			response := s.ToResponse(review)
+			if response != nil && !response.CollectedAt.Before(since) {
+				responses = append(responses, response)
+			}
+		}
+		token = page.TokenPagination.NextPageToken
+		if token == "" {
+			return responses, nil
+		}
+	}
+}
+
+// page returns a page of the reviews of the app
+func (s *Source) page(ctx context.Context, token string) (*reviewsPage, error) {
+	accessToken, err := s.auth.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{"maxResults": {strconv.Itoa(pageSize)}}
+	if token != "" {
+		params.Set("token", token)
+	}
+	endpoint := s.baseURL + "/androidpublisher/v3/applications/" + url.PathEscape(s.packageName) + "/reviews?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, apiError(resp)
+	}
+	var page reviewsPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// ToResponse maps a review to a response with its text and star rating. Reviews without a
+// user comment return nil.
+func (s *Source) ToResponse(review Review) *connector.Response {
+	var comment *UserComment
+	for _, c := range review.Comments {
+		if c.UserComment != nil {
+			comment = c.UserComment
+			break
+		}
+	}
+	if review.ReviewID == "" || comment == nil || comment.StarRating == 0 {
+		return nil
+	}
+
+	metadata := map[string]any{}
+	if comment.AppVersionName != "" {
+		metadata["app_version"] = comment.AppVersionName
+	}
+	if comment.Device != "" {
+		metadata["device"] = comment.Device
+	}
+	if comment.ReviewerLanguage != "" {
+		metadata["language"] = comment.ReviewerLanguage
+	}
+	if comment.AndroidOSVersion != 0 {
+		metadata["android_os_version"] = comment.AndroidOSVersion
+	}
+	if review.AuthorName != "" {
+		metadata["author"] = review.AuthorName
+	}
+	response := &connector.Response{
+		ID:          "googleplay-" + review.ReviewID,
+		SourceID:    s.packageName,
+		SourceName:  sourceName,
+		CollectedAt: comment.LastModified.Time(),
+		Metadata:    metadata,
+	}
+	// The text of reviews that were rated without text is a single tab
+	if text := strings.TrimSpace(comment.Text); text != "" {
+		response.Experiences = append(response.Experiences, connector.Experience{
+			FieldID:    FieldReview,
+			FieldLabel: labelReview,
+			FieldType:  string(models.FieldTypeText),
+			ValueText:  &text,
+		})
+	}
+	stars := float64(comment.StarRating)
+	response.Experiences = append(response.Experiences, connector.Experience{
+		FieldID:     FieldRating,
+		FieldLabel:  labelRating,
+		FieldType:   string(models.FieldTypeRating),
+		ValueNumber: &stars,
+	})
+	return response
+}
+
+// apiError returns the error of a failed Google API response
+func apiError(resp *http.Response) error {
+	var body struct {
+		Error json.RawMessage `json:"error"`
+		// Token errors describe the error separately
+		Description string `json:"error_description"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	message := http.StatusText(resp.StatusCode)
+	if json.Unmarshal(data, &body) == nil {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		switch {
+		case body.Description != "":
+			message = body.Description
+		case json.Unmarshal(body.Error, &apiErr) == nil && apiErr.Message != "":
+			message = apiErr.Message
+		}
+	}
+	return &connector.APIError{Status: resp.StatusCode, Message: message}
+}
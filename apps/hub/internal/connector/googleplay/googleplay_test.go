@@ -0,0 +1,136 @@
+package googleplay
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+// serviceAccountKey returns the JSON key of a service account whose tokens are requested
+// at tokenURL
+func serviceAccountKey(t *testing.T, tokenURL string) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "reviews@example.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":    tokenURL,
+	})
+	return data
+}
+
+func TestSourceReviews(t *testing.T) {
+	tokens := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			tokens++
+			if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || strings.Count(r.PostForm.Get("assertion"), ".") != 2 {
+				t.Errorf("unexpected token request: %v", r.PostForm)
+			}
+			_, _ = w.Write([]byte(`{"access_token": "access", "expires_in": 3599, "token_type": "Bearer"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/androidpublisher/v3/applications/com.example.app/reviews" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": 404, "message": "Package not found: com.example.other.", "status": "NOT_FOUND"}}`))
+			return
+		}
+		if r.URL.Query().Get("token") == "" {
+			_, _ = w.Write([]byte(`{"reviews": [
+				{"reviewId": "gp:AOqpTOE", "authorName": "Jane", "comments": [
+					{"userComment": {"text": "\tLove the new widgets", "lastModified": {"seconds": "1705314600", "nanos": 0}, "starRating": 5, "reviewerLanguage": "en", "device": "panther", "androidOsVersion": 34, "appVersionName": "4.2.0"}},
+					{"developerComment": {"text": "Thanks!", "lastModified": {"seconds": "1705318200"}}}
+				]}
+			], "tokenPagination": {"nextPageToken": "next"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"reviews": [
+			{"reviewId": "gp:AOqpTOF", "comments": [{"userComment": {"text": "\t", "lastModified": {"seconds": "1704067200"}, "starRating": 2}}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	account, err := NewServiceAccount(serviceAccountKey(t, server.URL+"/token"), Scope)
+	if err != nil {
+		t.Fatalf("NewServiceAccount() error = %v", err)
+	}
+	source := NewSource("com.example.app", account)
+	source.baseURL = server.URL
+	ctx := context.Background()
+
+	responses, err := source.Reviews(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("Reviews() error = %v", err)
+	}
+	if len(responses) != 2 || tokens != 1 {
+		t.Fatalf("got %d reviews with %d tokens, want 2 reviews with one token", len(responses), tokens)
+	}
+	response := responses[0]
+	if response.ID != "googleplay-gp:AOqpTOE" || response.SourceID != "com.example.app" || !response.CollectedAt.Equal(time.Unix(1705314600, 0)) {
+		t.Errorf("unexpected response: %+v", response)
+	}
+	if response.Metadata["app_version"] != "4.2.0" || response.Metadata["device"] != "panther" || response.Metadata["language"] != "en" {
+		t.Errorf("Metadata = %v", response.Metadata)
+	}
+	if len(response.Experiences) != 2 || *response.Experiences[0].ValueText != "Love the new widgets" || *response.Experiences[1].ValueNumber != 5 {
+		t.Errorf("unexpected experiences: %+v", response.Experiences)
+	}
+	if len(responses[1].Experiences) != 1 || responses[1].Experiences[0].FieldID != FieldRating {
+		t.Errorf("expected only the rating of a review without text, got %+v", responses[1].Experiences)
+	}
+
+	t.Run("since", func(t *testing.T) {
+		responses, err := source.Reviews(ctx, time.Unix(1705000000, 0))
+		if err != nil || len(responses) != 1 || tokens != 1 {
+			t.Errorf("got %d reviews with %d tokens (%v), want the newer review and the cached token", len(responses), tokens, err)
+		}
+	})
+
+	t.Run("unknown app", func(t *testing.T) {
+		other := NewSource("com.example.other", account)
+		other.baseURL = server.URL
+		_, err := other.Reviews(ctx, time.Time{})
+		var apiErr *connector.APIError
+		if !errors.As(err, &apiErr) || apiErr.Status != http.StatusNotFound || apiErr.Message != "Package not found: com.example.other." {
+			t.Errorf("Reviews() error = %v, want a 404 APIError", err)
+		}
+	})
+}
+
+func TestNewServiceAccount(t *testing.T) {
+	tests := map[string]string{
+		"not JSON":     "key",
+		"user account": `{"type": "authorized_user", "client_email": "jane@example.com"}`,
+		"no key":       `{"type": "service_account", "client_email": "reviews@example.iam.gserviceaccount.com", "private_key": ""}`,
+	}
+	for name, key := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewServiceAccount([]byte(key), Scope); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package intercom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+const (
+	// defaultBaseURL is the Intercom API of workspaces hosted in the US
+	defaultBaseURL = "https://api.intercom.io"
+	// apiVersion is the Intercom API version the client is written against
+	apiVersion = "2.11"
+	// requestTimeout bounds a single API request
+	requestTimeout = 30 * time.Second
+	// MaxPageSize is the largest page of conversations the search returns
+	MaxPageSize = 150
+)
+
+// Client reads conversations with an access token of the workspace
+type Client struct {
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the Intercom API
+func NewClient(token string) *Client {
+	return &Client{
+		token:      token,
+		baseURL:    defaultBaseURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// ConversationsQuery selects a page of the conversations updated in a time range
+type ConversationsQuery struct {
+	Since         *time.Time
+	Until         *time.Time
+	StartingAfter string
+	PageSize      int
+}
+
+// ConversationsPage is a page of conversations, without their parts
+type ConversationsPage struct {
+	TotalCount    int            `json:"total_count"`
+	Conversations []Conversation `json:"conversations"`
+	Pages         struct {
+		Next *struct {
+			StartingAfter string `json:"starting_after"`
+		} `json:"next"`
+	} `json:"pages"`
+}
+
+// NextStartingAfter returns the cursor of the next page, or an empty string on the last one
+func (p *ConversationsPage) NextStartingAfter() string {
+	if p.Pages.Next == nil {
+		return ""
+	}
+	return p.Pages.Next.StartingAfter
+}
+
+// Workspace returns the ID of the workspace the token belongs to, the app_id of its webhook
+// notifications
+func (c *Client) Workspace(ctx context.Context) (string, error) {
+	var me struct {
+		App struct {
+			IDCode string `json:"id_code"`
+		} `json:"app"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/me", nil, &me); err != nil {
+		return "", err
+	}
+	return me.App.IDCode, nil
+}
+
+// SearchConversations returns a page of the conversations updated in the time range of the
+// query, oldest first
+func (c *Client) SearchConversations(ctx context.Context, q ConversationsQuery) (*ConversationsPage, error) {
+	type condition struct {
+		Field    string `json:"field"`
+		Operator string `json:"operator"`
+		Value    int64  `json:"value"`
+	}
+	conditions := []condition{{Field: "updated_at", Operator: ">", Value: 0}}
+	if q.Since != nil {
+		conditions[0].Value = q.Since.Unix() - 1
+	}
+	if q.Until != nil {
+		conditions = append(conditions, condition{Field: "updated_at", Operator: "<", Value: q.Until.Unix() + 1})
+	}
+
+	search := map[string]any{
+		"query": map[string]any{"operator": "AND", "value": conditions},
+		"sort":  map[string]any{"field": "updated_at", "order": "ascending"},
+	}
+	pagination := map[string]any{}
+	if q.PageSize > 0 {
+		pagination["per_page"] = min(q.PageSize, MaxPageSize)
+	}
+	if q.StartingAfter != "" {
+		pagination["starting_after"] = q.StartingAfter
+	}
+	if len(pagination) > 0 {
+		search["pagination"] = pagination
+	}
+
+	var page ConversationsPage
+	if err := c.do(ctx, http.MethodPost, "/conversations/search", search, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Conversation returns a conversation with its parts
+func (c *Client) Conversation(ctx context.Context, id string) (*Conversation, error) {
+	var conversation Conversation
+	if err := c.do(ctx, http.MethodGet, "/conversations/"+url.PathEscape(id), nil, &conversation); err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+// do sends a request with an optional JSON body and decodes the JSON response
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Intercom-Version", apiVersion)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		var errs struct {
+			Errors []struct {
+				Message string `json:"message"`
+			} `json:"errors"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		message := http.StatusText(resp.StatusCode)
+		if json.Unmarshal(data, &errs) == nil && len(errs.Errors) > 0 && errs.Errors[0].Message != "" {
+			message = errs.Errors[0].Message
+		}
+		return &connector.APIError{Status: resp.StatusCode, Message: message}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,315 @@
+// Package intercom receives Intercom conversations through its webhooks and reads earlier
+// conversations through its Conversations API. The messages that customers send and the
+// ratings they give conversations become experiences; replies by teammates and bots
+// aren't feedback and are left out.
+package intercom
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+// SourceType is the source_type of experiences received from Intercom
+const SourceType = "intercom"
+
+// sourceName is the source_name of experiences received from Intercom
+const sourceName = "Intercom"
+
+// signatureHeader carries the HMAC-SHA1 of a webhook delivery as sha1=<hex>
+const signatureHeader = "X-Hub-Signature"
+
+// Field IDs and labels of the received experiences
+const (
+	FieldMessage      = "conversation_message"
+	FieldRating       = "conversation_rating"
+	FieldRatingRemark = "conversation_rating_remark"
+
+	labelMessage      = "Conversation message"
+	labelRating       = "How would you rate your conversation?"
+	labelRatingRemark = "Rating remark"
+)
+
+// Webhook topics that carry feedback
+const (
+	topicUserCreated = "conversation.user.created"
+	topicUserReplied = "conversation.user.replied"
+	topicRatingAdded = "conversation.rating.added"
+)
+
+// customerTypes are the author types of customers; admins, bots, and teams are teammates
+var customerTypes = map[string]bool{"user": true, "lead": true, "contact": true}
+
+// Author is the author of a message
+type Author struct {
+	Type  string `json:"type"`
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+// Contact is a customer taking part in a conversation. The external ID is the user ID the
+// product sends to Intercom.
+type Contact struct {
+	ID         string `json:"id"`
+	ExternalID string `json:"external_id"`
+}
+
+// Part is a message or action added to a conversation after its first message
+type Part struct {
+	ID        string `json:"id"`
+	PartType  string `json:"part_type"`
+	Body      string `json:"body"`
+	CreatedAt int64  `json:"created_at"`
+	Author    Author `json:"author"`
+}
+
+// Rating is the rating a customer gave a conversation, from 1 to 5
+type Rating struct {
+	Rating    int     `json:"rating"`
+	Remark    string  `json:"remark"`
+	CreatedAt int64   `json:"created_at"`
+	Contact   Contact `json:"contact"`
+	Teammate  struct {
+		ID string `json:"id"`
+	} `json:"teammate"`
+}
+
+// Conversation is a conversation with its first message, parts, and rating
+type Conversation struct {
+	ID        string `json:"id"`
+	CreatedAt int64  `json:"created_at"`
+	Source    struct {
+		Type        string `json:"type"`
+		ID          string `json:"id"`
+		DeliveredAs string `json:"delivered_as"`
+		Body        string `json:"body"`
+		Author      Author `json:"author"`
+	} `json:"source"`
+	Contacts struct {
+		Contacts []Contact `json:"contacts"`
+	} `json:"contacts"`
+	ConversationRating *Rating `json:"conversation_rating"`
+	ConversationParts  struct {
+		ConversationParts []Part `json:"conversation_parts"`
+	} `json:"conversation_parts"`
+}
+
+// notification is the body of a webhook delivery
+type notification struct {
+	Type  string `json:"type"`
+	Topic string `json:"topic"`
+	AppID string `json:"app_id"`
+	Data  struct {
+		Item json.RawMessage `json:"item"`
+	} `json:"data"`
+}
+
+// Webhook receives the conversations of Intercom webhooks, signed with the client secret
+// of the Intercom app
+type Webhook struct {
+	secret string
+}
+
+// NewWebhook returns the connector of webhooks signed with the client secret
+func NewWebhook(secret string) *Webhook {
+	return &Webhook{secret: secret}
+}
+
+// Name returns the source type of Intercom experiences
+func (w *Webhook) Name() string {
+	return SourceType
+}
+
+// Parse verifies the signature of a delivery and returns the feedback it carries: the
+// first message of a conversation a customer started, the latest message of a customer's
+// reply, or a conversation rating. Other topics, such as the ping sent when a webhook is
+// set up, return nil.
+func (w *Webhook) Parse(header http.Header, body []byte) (*connector.Response, error) {
+	if !w.verify(header.Get(signatureHeader), body) {
+		return nil, connector.ErrUnauthorized
+	}
+
+	var payload notification
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	if payload.Topic != topicUserCreated && payload.Topic != topicUserReplied && payload.Topic != topicRatingAdded {
+		return nil, nil
+	}
+	var conversation Conversation
+	if err := json.Unmarshal(payload.Data.Item, &conversation); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	if payload.AppID == "" || conversation.ID == "" {
+		return nil, fmt.Errorf("invalid payload: the notification has no app_id or conversation")
+	}
+
+	switch payload.Topic {
+	case topicUserCreated:
+		return sourceResponse(payload.AppID, &conversation), nil
+	case topicUserReplied:
+		parts := conversation.ConversationParts.ConversationParts
+		for i := len(parts) - 1; i >= 0; i-- {
+			if response := partResponse(payload.AppID, &conversation, parts[i]); response != nil {
+				return response, nil
+			}
+		}
+		return nil, nil
+	default:
+		return RatingResponse(payload.AppID, &conversation), nil
+	}
+}
+
+// verify reports whether the signature is the one of the body with the secret
+func (w *Webhook) verify(signature string, body []byte) bool {
+	return signature != "" && hmac.Equal([]byte(signature), []byte(Sign(w.secret, body)))
+}
+
+// Sign returns the X-Hub-Signature header of a body, as Intercom computes it
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ConversationResponses maps a conversation of the workspace to responses: one per message
+// of a customer, including the first one, and one for its rating
+func ConversationResponses(workspaceID string, c *Conversation) []*connector.Response {
+	var responses []*connector.Response
+	if response := sourceResponse(workspaceID, c); response != nil {
+		responses = append(responses, response)
+	}
+	for _, part := range c.ConversationParts.ConversationParts {
+		if response := partResponse(workspaceID, c, part); response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if response := RatingResponse(workspaceID, c); response != nil {
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// sourceResponse maps the first message of a conversation, if a customer sent it
+func sourceResponse(workspaceID string, c *Conversation) *connector.Response {
+	if c.Source.ID == "" {
+		return nil
+	}
+	response := messageResponse(workspaceID, c, "message-"+c.Source.ID, c.Source.Body, c.CreatedAt, c.Source.Author)
+	if response != nil && c.Source.DeliveredAs != "" {
+		response.Metadata["delivered_as"] = c.Source.DeliveredAs
+	}
+	return response
+}
+
+// partResponse maps a part of a conversation, if it is a message a customer sent
+func partResponse(workspaceID string, c *Conversation, part Part) *connector.Response {
+	if part.PartType != "comment" && part.PartType != "open" {
+		return nil
+	}
+	return messageResponse(workspaceID, c, "part-"+part.ID, part.Body, part.CreatedAt, part.Author)
+}
+
+// messageResponse maps a message of a customer to a text experience. Messages of teammates
+// and messages without text, such as attachments, return nil.
+func messageResponse(workspaceID string, c *Conversation, id, body string, createdAt int64, author Author) *connector.Response {
+	if !customerTypes[author.Type] {
+		return nil
+	}
+	text := PlainText(body)
+	if text == "" {
+		return nil
+	}
+	return &connector.Response{
+		ID:             id,
+		SourceID:       workspaceID,
+		SourceName:     sourceName,
+		CollectedAt:    time.Unix(createdAt, 0).UTC(),
+		UserIdentifier: c.userIdentifier(author.ID),
+		Metadata:       map[string]any{"conversation_id": c.ID},
+		Experiences: []connector.Experience{{
+			FieldID:    FieldMessage,
+			FieldLabel: labelMessage,
+			FieldType:  string(models.FieldTypeText),
+			ValueText:  &text,
+		}},
+	}
+}
+
+// RatingResponse maps the rating of a conversation to a rating experience, plus a text
+// experience for its remark. Conversations without a rating return nil.
+func RatingResponse(workspaceID string, c *Conversation) *connector.Response {
+	rating := c.ConversationRating
+	if rating == nil || rating.Rating == 0 {
+		return nil
+	}
+	score := float64(rating.Rating)
+	metadata := map[string]any{"conversation_id": c.ID}
+	if rating.Teammate.ID != "" {
+		metadata["teammate_id"] = rating.Teammate.ID
+	}
+	userIdentifier := rating.Contact.ExternalID
+	if userIdentifier == "" {
+		userIdentifier = c.userIdentifier(rating.Contact.ID)
+	}
+	response := &connector.Response{
+		ID:             "rating-" + c.ID,
+		SourceID:       workspaceID,
+		SourceName:     sourceName,
+		CollectedAt:    time.Unix(rating.CreatedAt, 0).UTC(),
+		UserIdentifier: userIdentifier,
+		Metadata:       metadata,
+		Experiences: []connector.Experience{{
+			FieldID:     FieldRating,
+			FieldLabel:  labelRating,
+			FieldType:   string(models.FieldTypeRating),
+			ValueNumber: &score,
+		}},
+	}
+	if remark := strings.TrimSpace(rating.Remark); remark != "" {
+		response.Experiences = append(response.Experiences, connector.Experience{
+			FieldID:    FieldRatingRemark,
+			FieldLabel: labelRatingRemark,
+			FieldType:  string(models.FieldTypeText),
+			ValueText:  &remark,
+		})
+	}
+	return response
+}
+
+// userIdentifier returns the external ID of a contact of the conversation, the user ID the
+// product knows them by, or else their Intercom contact ID
+func (c *Conversation) userIdentifier(contactID string) string {
+	for _, contact := range c.Contacts.Contacts {
+		if contact.ID == contactID && contact.ExternalID != "" {
+			return contact.ExternalID
+		}
+	}
+	return contactID
+}
+
+var (
+	// lineBreaks are the tags that end a line of a message body
+	lineBreaks = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>`)
+	// tags are the remaining tags of a message body
+	tags = regexp.MustCompile(`<[^>]*>`)
+	// blankLines are runs of empty lines
+	blankLines = regexp.MustCompile(`\n\s*\n\s*`)
+)
+
+// PlainText returns the text of an HTML message body
+func PlainText(body string) string {
+	text := lineBreaks.ReplaceAllString(body, "\n")
+	text = html.UnescapeString(tags.ReplaceAllString(text, ""))
+	return strings.TrimSpace(blankLines.ReplaceAllString(text, "\n\n"))
+}
@@ -0,0 +1,197 @@
+package intercom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+// conversation is a conversation with a customer, as included in webhook notifications
+const conversation = `{
+  "type": "conversation",
+  "id": "147",
+  "created_at": 1705314600,
+  "source": {
+    "type": "conversation", "id": "403918330", "delivered_as": "customer_initiated",
+    "body": "<p>Exports keep timing out &amp; I can&#39;t share reports.</p>",
+    "author": {"type": "user", "id": "5f7f0d217289f8d7"}
+  },
+  "contacts": {"type": "contact.list", "contacts": [{"type": "contact", "id": "5f7f0d217289f8d7", "external_id": "user-42"}]},
+  "conversation_rating": {"rating": 4, "remark": "Quick help", "created_at": 1705318200, "contact": {"id": "5f7f0d217289f8d7", "external_id": "user-42"}, "teammate": {"type": "admin", "id": "814860"}},
+  "conversation_parts": {"type": "conversation_part.list", "conversation_parts": [
+    {"id": "1", "part_type": "comment", "body": "<p>Sorry about that, we're on it.</p>", "created_at": 1705315000, "author": {"type": "admin", "id": "814860"}},
+    {"id": "2", "part_type": "assignment", "body": null, "created_at": 1705315100, "author": {"type": "admin", "id": "814860"}},
+    {"id": "3", "part_type": "comment", "body": "<p>Thanks!<br>It works now.</p>", "created_at": 1705316000, "author": {"type": "user", "id": "5f7f0d217289f8d7"}},
+    {"id": "4", "part_type": "comment", "body": "<p><img src=\"https://example.com/screenshot.png\"></p>", "created_at": 1705316100, "author": {"type": "user", "id": "5f7f0d217289f8d7"}}
+  ]}
+}`
+
+// notificationOf returns a webhook notification of the topic with the conversation
+func notificationOf(topic string) []byte {
+	return []byte(`{"type": "notification_event", "app_id": "ecahpwf5", "topic": "` + topic + `", "data": {"type": "notification_event_data", "item": ` + conversation + `}}`)
+}
+
+func TestWebhookParse(t *testing.T) {
+	w := NewWebhook("secret")
+	parse := func(topic string) *connector.Response {
+		t.Helper()
+		body := notificationOf(topic)
+		header := http.Header{}
+		header.Set("X-Hub-Signature", Sign("secret", body))
+		response, err := w.Parse(header, body)
+		if err != nil {
+			t.Fatalf("Parse(%s) error = %v", topic, err)
+		}
+		return response
+	}
+
+	created := parse("conversation.user.created")
+	if created.ID != "message-403918330" || created.SourceID != "ecahpwf5" || created.UserIdentifier != "user-42" {
+		t.Errorf("unexpected response: %+v", created)
+	}
+	if text := *created.Experiences[0].ValueText; text != "Exports keep timing out & I can't share reports." {
+		t.Errorf("message = %q, want the plain text of the body", text)
+	}
+	if created.Metadata["conversation_id"] != "147" || created.Metadata["delivered_as"] != "customer_initiated" {
+		t.Errorf("Metadata = %v", created.Metadata)
+	}
+
+	replied := parse("conversation.user.replied")
+	if replied.ID != "part-3" || *replied.Experiences[0].ValueText != "Thanks!\nIt works now." {
+		t.Errorf("expected the latest customer message with text, got %+v", replied)
+	}
+	if !replied.CollectedAt.Equal(time.Unix(1705316000, 0)) {
+		t.Errorf("CollectedAt = %v", replied.CollectedAt)
+	}
+
+	rated := parse("conversation.rating.added")
+	if rated.ID != "rating-147" || len(rated.Experiences) != 2 || *rated.Experiences[0].ValueNumber != 4 || *rated.Experiences[1].ValueText != "Quick help" {
+		t.Errorf("unexpected rating response: %+v", rated)
+	}
+	if rated.Metadata["teammate_id"] != "814860" {
+		t.Errorf("Metadata = %v", rated.Metadata)
+	}
+
+	if response := parse("conversation.admin.replied"); response != nil {
+		t.Errorf("expected no response for teammate replies, got %+v", response)
+	}
+}
+
+func TestWebhookParseSignature(t *testing.T) {
+	w := NewWebhook("secret")
+	body := notificationOf("conversation.user.created")
+	tests := map[string]string{
+		"missing":      "",
+		"wrong secret": Sign("other", body),
+		"other body":   Sign("secret", append(body, ' ')),
+	}
+	for name, signature := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if signature != "" {
+				header.Set("X-Hub-Signature", signature)
+			}
+			if _, err := w.Parse(header, body); !errors.Is(err, connector.ErrUnauthorized) {
+				t.Errorf("Parse() error = %v, want ErrUnauthorized", err)
+			}
+		})
+	}
+}
+
+func TestConversationResponses(t *testing.T) {
+	var c Conversation
+	if err := json.Unmarshal([]byte(conversation), &c); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, response := range ConversationResponses("ecahpwf5", &c) {
+		ids = append(ids, response.ID)
+	}
+	want := []string{"message-403918330", "part-3", "rating-147"}
+	if len(ids) != len(want) {
+		t.Fatalf("got responses %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("response %d = %s, want %s", i, ids[i], want[i])
+		}
+	}
+}
+
+func TestClientConversations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"type": "error.list", "errors": [{"code": "unauthorized", "message": "Access Token Invalid"}]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/me":
+			_, _ = w.Write([]byte(`{"type": "admin", "app": {"type": "app", "id_code": "ecahpwf5"}}`))
+		case "/conversations/search":
+			var search struct {
+				Query struct {
+					Value []struct {
+						Field    string `json:"field"`
+						Operator string `json:"operator"`
+						Value    int64  `json:"value"`
+					} `json:"value"`
+				} `json:"query"`
+				Pagination struct {
+					PerPage       int    `json:"per_page"`
+					StartingAfter string `json:"starting_after"`
+				} `json:"pagination"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&search); err != nil || len(search.Query.Value) != 1 || search.Query.Value[0].Value != 1704067199 || search.Pagination.PerPage != 2 || search.Pagination.StartingAfter != "WzE3" {
+				t.Errorf("unexpected search: %+v (%v)", search, err)
+			}
+			_, _ = w.Write([]byte(`{"type": "conversation.list", "total_count": 3, "conversations": [{"id": "147"}], "pages": {"next": {"starting_after": "WzE4"}}}`))
+		case "/conversations/147":
+			_, _ = w.Write([]byte(conversation))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type": "error.list", "errors": [{"code": "not_found", "message": "Resource Not Found"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("token")
+	client.baseURL = server.URL
+	ctx := context.Background()
+
+	workspaceID, err := client.Workspace(ctx)
+	if err != nil || workspaceID != "ecahpwf5" {
+		t.Fatalf("Workspace() = %q, %v", workspaceID, err)
+	}
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	page, err := client.SearchConversations(ctx, ConversationsQuery{Since: &since, StartingAfter: "WzE3", PageSize: 2})
+	if err != nil {
+		t.Fatalf("SearchConversations() error = %v", err)
+	}
+	if len(page.Conversations) != 1 || page.NextStartingAfter() != "WzE4" {
+		t.Errorf("unexpected page: %+v", page)
+	}
+	c, err := client.Conversation(ctx, page.Conversations[0].ID)
+	if err != nil {
+		t.Fatalf("Conversation() error = %v", err)
+	}
+	if len(c.ConversationParts.ConversationParts) != 4 {
+		t.Errorf("expected the parts of the conversation, got %+v", c.ConversationParts)
+	}
+
+	t.Run("invalid token", func(t *testing.T) {
+		wrong := NewClient("wrong")
+		wrong.baseURL = server.URL
+		_, err := wrong.Workspace(ctx)
+		var apiErr *connector.APIError
+		if !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized || apiErr.Message != "Access Token Invalid" {
+			t.Errorf("Workspace() error = %v, want a 401 APIError", err)
+		}
+	})
+}
@@ -0,0 +1,416 @@
+// Package mapping turns the JSON deliveries of arbitrary tools into responses with a
+// template of JSONPath expressions, so a new source can be onboarded by configuring a
+// mapping instead of writing a connector. It only depends on the connector package, so
+// templates can be stored with their mapping in the database.
+package mapping
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+// fieldTypes are the field types of experiences, and the value columns they're stored in
+var fieldTypes = []string{"text", "categorical", "nps", "csat", "rating", "number", "boolean", "date"}
+
+// Template maps a delivery to responses. Every setting is an expression: a JSONPath into
+// the delivery, starting with $ (or @ for the current element of an each), or any other
+// string as a literal value.
+type Template struct {
+	Records        string            `json:"records,omitempty" doc:"Path of the array of responses in deliveries that hold several, e.g. $.events; $ then refers to each of them" example:"$.events"`
+	ResponseID     string            `json:"response_id,omitempty" doc:"Identifies the response, so redelivered responses are only stored once; a hash of the response if omitted or missing" example:"$.id"`
+	SourceID       string            `json:"source_id,omitempty" doc:"Form, survey, or account the response belongs to; the mapping ID if omitted" example:"$.form.id"`
+	SourceName     string            `json:"source_name,omitempty" doc:"Name of the form, survey, or account" example:"$.form.title"`
+	CollectedAt    string            `json:"collected_at,omitempty" doc:"When the response was submitted, as an ISO 8601 timestamp or Unix time; the time of the delivery if omitted" example:"$.submitted_at"`
+	UserIdentifier string            `json:"user_identifier,omitempty" doc:"Identifies the respondent" example:"$.user.email"`
+	Metadata       map[string]string `json:"metadata,omitempty" doc:"Metadata keys and the expressions of their values, stored with every experience of the response"`
+	Fields         []Field           `json:"fields" doc:"Fields of the response; each becomes an experience when its value is present" minItems:"1" maxItems:"100"`
+}
+
+// Field maps a value of the delivery, or each element of an array, to an experience
+type Field struct {
+	Each       string `json:"each,omitempty" doc:"Path of an array, e.g. $.answers; the field is mapped once per element, which @ refers to" example:"$.answers"`
+	FieldID    string `json:"field_id" doc:"Expression of the field ID" minLength:"1" example:"@.question_id"`
+	FieldLabel string `json:"field_label,omitempty" doc:"Expression of the question text" example:"@.question"`
+	FieldType  string `json:"field_type" doc:"Field type (text, categorical, nps, csat, rating, number, boolean, date), or an expression of it" minLength:"1" example:"text"`
+	Value      string `json:"value" doc:"Expression of the value, converted to the value column of the field type; fields without a value are skipped" minLength:"1" example:"@.answer"`
+}
+
+// isPath reports whether an expression is a JSONPath rather than a literal
+func isPath(expr string) bool {
+	return strings.HasPrefix(expr, "$") || strings.HasPrefix(expr, "@")
+}
+
+// Validate checks that the template's paths parse and its literal field types are valid
+func (t *Template) Validate() error {
+	if len(t.Fields) == 0 {
+		return errors.New("a template needs at least one field")
+	}
+	check := func(name, expr string, relative bool) error {
+		if !isPath(expr) {
+			return nil
+		}
+		p, err := ParsePath(expr)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if p.relative && !relative {
+			return fmt.Errorf("%s: @ only refers to the element of an each", name)
+		}
+		return nil
+	}
+	if t.Records != "" && !strings.HasPrefix(t.Records, "$") {
+		return errors.New("records must be a path starting with $")
+	}
+	settings := [][2]string{
+		{"records", t.Records},
+		{"response_id", t.ResponseID},
+		{"source_id", t.SourceID},
+		{"source_name", t.SourceName},
+		{"collected_at", t.CollectedAt},
+		{"user_identifier", t.UserIdentifier},
+	}
+	for _, key := range slices.Sorted(maps.Keys(t.Metadata)) {
+		settings = append(settings, [2]string{"metadata." + key, t.Metadata[key]})
+	}
+	for _, setting := range settings {
+		if err := check(setting[0], setting[1], false); err != nil {
+			return err
+		}
+	}
+	for i, f := range t.Fields {
+		name := fmt.Sprintf("fields[%d]", i)
+		if f.FieldID == "" || f.FieldType == "" || f.Value == "" {
+			return fmt.Errorf("%s: field_id, field_type, and value are required", name)
+		}
+		if f.Each != "" && !strings.HasPrefix(f.Each, "$") {
+			return fmt.Errorf("%s.each must be a path starting with $", name)
+		}
+		if !isPath(f.FieldType) && !slices.Contains(fieldTypes, f.FieldType) {
+			return fmt.Errorf("%s: invalid field_type %q, expected one of %s", name, f.FieldType, strings.Join(fieldTypes, ", "))
+		}
+		if err := check(name+".each", f.Each, false); err != nil {
+			return err
+		}
+		for _, attr := range [][2]string{{"field_id", f.FieldID}, {"field_label", f.FieldLabel}, {"field_type", f.FieldType}, {"value", f.Value}} {
+			if err := check(name+"."+attr[0], attr[1], f.Each != ""); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Map returns the responses of a decoded delivery that have a value for any field, e.g.
+// none for a test event. A delivery whose values can't be converted to their field types
+// is an error.
+func (t *Template) Map(delivery any, now time.Time) ([]*connector.Response, error) {
+	records := []any{delivery}
+	if t.Records != "" {
+		p, err := ParsePath(t.Records)
+		if err != nil {
+			return nil, err
+		}
+		records = elements(p.Select(delivery, delivery))
+	}
+
+	var responses []*connector.Response
+	for i, record := range records {
+		response, err := t.mapRecord(record, now)
+		if err != nil {
+			if len(records) > 1 {
+				return nil, fmt.Errorf("record %d: %w", i, err)
+			}
+			return nil, err
+		}
+		if len(response.Experiences) > 0 {
+			responses = append(responses, response)
+		}
+	}
+	return responses, nil
+}
+
+// mapRecord returns the response of a record
+func (t *Template) mapRecord(record any, now time.Time) (*connector.Response, error) {
+	response := &connector.Response{CollectedAt: now}
+
+	text := func(name, expr string) (string, error) {
+		value, found, err := evaluate(expr, record, record)
+		if err != nil || !found {
+			return "", err
+		}
+		s, ok := scalarText(value)
+		if !ok {
+			return "", fmt.Errorf("%s: expected a string or number, got %s", name, describe(value))
+		}
+		return s, nil
+	}
+	var err error
+	if response.ID, err = text("response_id", t.ResponseID); err != nil {
+		return nil, err
+	}
+	if response.ID == "" {
+		data, _ := json.Marshal(record)
+		digest := sha256.Sum256(data)
+		response.ID = hex.EncodeToString(digest[:])
+	}
+	if response.SourceID, err = text("source_id", t.SourceID); err != nil {
+		return nil, err
+	}
+	if response.SourceName, err = text("source_name", t.SourceName); err != nil {
+		return nil, err
+	}
+	if response.UserIdentifier, err = text("user_identifier", t.UserIdentifier); err != nil {
+		return nil, err
+	}
+	if value, found, err := evaluate(t.CollectedAt, record, record); err != nil {
+		return nil, err
+	} else if found {
+		if response.CollectedAt, err = toTime(value); err != nil {
+			return nil, fmt.Errorf("collected_at: %w", err)
+		}
+	}
+	if len(t.Metadata) > 0 {
+		response.Metadata = make(map[string]any, len(t.Metadata))
+		for key, expr := range t.Metadata {
+			value, found, err := evaluate(expr, record, record)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				response.Metadata[key] = value
+			}
+		}
+	}
+
+	for i, f := range t.Fields {
+		elems := []any{record}
+		if f.Each != "" {
+			p, err := ParsePath(f.Each)
+			if err != nil {
+				return nil, err
+			}
+			elems = elements(p.Select(record, record))
+		}
+		for _, elem := range elems {
+			exp, ok, err := f.experience(record, elem)
+			if err != nil {
+				return nil, fmt.Errorf("fields[%d]: %w", i, err)
+			}
+			if ok {
+				response.Experiences = append(response.Experiences, exp)
+			}
+		}
+	}
+	return response, nil
+}
+
+// experience returns the experience of a field for the current element, and false if the
+// field has no value
+func (f Field) experience(root, current any) (connector.Experience, bool, error) {
+	exp := connector.Experience{}
+	value, found, err := evaluate(f.Value, root, current)
+	if err != nil || !found {
+		return exp, false, err
+	}
+
+	attrs := []struct {
+		name string
+		expr string
+		dest *string
+	}{
+		{"field_id", f.FieldID, &exp.FieldID},
+		{"field_label", f.FieldLabel, &exp.FieldLabel},
+		{"field_type", f.FieldType, &exp.FieldType},
+	}
+	for _, attr := range attrs {
+		v, found, err := evaluate(attr.expr, root, current)
+		if err != nil {
+			return exp, false, err
+		}
+		if !found {
+			continue
+		}
+		s, ok := scalarText(v)
+		if !ok {
+			return exp, false, fmt.Errorf("%s: expected a string or number, got %s", attr.name, describe(v))
+		}
+		*attr.dest = s
+	}
+	if exp.FieldID == "" {
+		return exp, false, errors.New("field_id is missing")
+	}
+	if !slices.Contains(fieldTypes, exp.FieldType) {
+		return exp, false, fmt.Errorf("field %s: invalid field_type %q", exp.FieldID, exp.FieldType)
+	}
+
+	if err := setValue(&exp, value); err != nil {
+		return exp, false, fmt.Errorf("field %s: %w", exp.FieldID, err)
+	}
+	if exp.ValueText != nil && *exp.ValueText == "" {
+		return exp, false, nil
+	}
+	return exp, true, nil
+}
+
+// evaluate returns the value of an expression: the first value its path selects, or the
+// literal. Paths that select nothing or null are not found.
+func evaluate(expr string, root, current any) (any, bool, error) {
+	if expr == "" {
+		return nil, false, nil
+	}
+	if !isPath(expr) {
+		return expr, true, nil
+	}
+	p, err := ParsePath(expr)
+	if err != nil {
+		return nil, false, err
+	}
+	value, found := p.Get(root, current)
+	return value, found, nil
+}
+
+// elements returns the selected values, or the elements of the only one if it's an array
+func elements(values []any) []any {
+	if len(values) == 1 {
+		if array, ok := values[0].([]any); ok {
+			return array
+		}
+	}
+	return values
+}
+
+// setValue converts a value to the value column of the experience's field type
+func setValue(exp *connector.Experience, value any) error {
+	switch exp.FieldType {
+	case "text", "categorical":
+		var text string
+		if array, ok := value.([]any); ok {
+			// Multiple choices are joined
+			parts := make([]string, 0, len(array))
+			for _, element := range array {
+				s, ok := scalarText(element)
+				if !ok {
+					return fmt.Errorf("expected a list of strings, got %s", describe(element))
+				}
+				parts = append(parts, s)
+			}
+			text = strings.Join(parts, ", ")
+		} else if s, ok := scalarText(value); ok {
+			text = s
+		} else {
+			return fmt.Errorf("expected a string, got %s", describe(value))
+		}
+		exp.ValueText = &text
+	case "boolean":
+		b, err := toBool(value)
+		if err != nil {
+			return err
+		}
+		exp.ValueBoolean = &b
+	case "date":
+		t, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		exp.ValueDate = &t
+	default:
+		n, err := toNumber(value)
+		if err != nil {
+			return err
+		}
+		exp.ValueNumber = &n
+	}
+	return nil
+}
+
+// scalarText returns a string, number, or boolean as text
+func scalarText(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// toNumber converts a number or numeric string
+func toNumber(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		if n, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("expected a number, got %s", describe(value))
+}
+
+// toBool converts a boolean, 0 or 1, or true/false, yes/no, 1/0 strings
+func toBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case float64:
+		if v == 0 || v == 1 {
+			return v == 1, nil
+		}
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "true", "yes", "1":
+			return true, nil
+		case "false", "no", "0":
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("expected a boolean, got %s", describe(value))
+}
+
+// timeLayouts are the layouts of timestamps, tried in order
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", time.DateOnly}
+
+// toTime converts an ISO 8601 timestamp or date, or Unix time in seconds or milliseconds
+func toTime(value any) (time.Time, error) {
+	switch v := value.(type) {
+	case float64:
+		// Unix times in milliseconds are beyond the year 5000 in seconds
+		if v > 1e11 {
+			return time.UnixMilli(int64(v)).UTC(), nil
+		}
+		return time.Unix(int64(v), 0).UTC(), nil
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected an ISO 8601 timestamp or Unix time, got %s", describe(value))
+}
+
+// describe names a value in errors
+func describe(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "a list"
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,238 @@
+package mapping
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// delivery is a webhook delivery of a survey tool without a connector
+const delivery = `{
+  "event": "response.completed",
+  "submitted_at": "2024-01-15T10:30:00Z",
+  "survey": {"id": "srv_42", "title": "Onboarding"},
+  "respondent": {"email": "jane@example.com", "plan": "pro"},
+  "response": {
+    "id": "rsp_1001",
+    "answers": [
+      {"question_id": "q_nps", "question": "How likely are you to recommend us?", "type": "nps", "value": 9},
+      {"question_id": "q_why", "question": "Why?", "type": "text", "value": "Fast exports"},
+      {"question_id": "q_features", "question": "Which features do you use?", "type": "categorical", "value": ["Exports", "Search"]},
+      {"question_id": "q_skipped", "question": "Anything else?", "type": "text", "value": null}
+    ]
+  }
+}`
+
+// decode decodes a JSON document like the ingest route does
+func decode(t *testing.T, document string) any {
+	t.Helper()
+	var value any
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	return value
+}
+
+func TestParsePath(t *testing.T) {
+	root := decode(t, delivery)
+	tests := []struct {
+		path string
+		want []any
+	}{
+		{"$.survey.id", []any{"srv_42"}},
+		{"$['survey']['title']", []any{"Onboarding"}},
+		{"$.response.answers[0].question_id", []any{"q_nps"}},
+		{"$.response.answers[-1].question_id", []any{"q_skipped"}},
+		{"$.response.answers[*].type", []any{"nps", "text", "categorical", "text"}},
+		{"$.response.answers[?(@.type == 'nps')].value", []any{float64(9)}},
+		{"$.response.answers[?(@.type != 'text')].question_id", []any{"q_nps", "q_features"}},
+		{"$.response.answers[?(@.value)].question_id", []any{"q_nps", "q_why", "q_features"}},
+		{"$.respondent.*", []any{"jane@example.com", "pro"}},
+		{"$.missing.child", nil},
+		{"$.response.answers[9]", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := ParsePath(tt.path)
+			if err != nil {
+				t.Fatalf("ParsePath() error = %v", err)
+			}
+			got, _ := json.Marshal(p.Select(root, nil))
+			want, _ := json.Marshal(tt.want)
+			if string(got) != string(want) {
+				t.Errorf("Select() = %s, want %s", got, want)
+			}
+		})
+	}
+
+	for _, path := range []string{"", "survey.id", "$..id", "$.", "$[abc]", "$['id'", "$[?(@.type > 1)]", "$[?(type == 'nps')]"} {
+		if _, err := ParsePath(path); err == nil {
+			t.Errorf("ParsePath(%q) should fail", path)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	template := Template{
+		ResponseID:     "$.response.id",
+		SourceID:       "$.survey.id",
+		SourceName:     "$.survey.title",
+		CollectedAt:    "$.submitted_at",
+		UserIdentifier: "$.respondent.email",
+		Metadata:       map[string]string{"plan": "$.respondent.plan", "channel": "in-app"},
+		Fields: []Field{{
+			Each:       "$.response.answers",
+			FieldID:    "@.question_id",
+			FieldLabel: "@.question",
+			FieldType:  "@.type",
+			Value:      "@.value",
+		}},
+	}
+	if err := template.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	responses, err := template.Map(decode(t, delivery), time.Now())
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if len(responses) != 1 {
+		t.Fatalf("got %d responses, want 1", len(responses))
+	}
+	response := responses[0]
+	if response.ID != "rsp_1001" || response.SourceID != "srv_42" || response.SourceName != "Onboarding" || response.UserIdentifier != "jane@example.com" {
+		t.Errorf("unexpected response: %+v", response)
+	}
+	if !response.CollectedAt.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("CollectedAt = %v, want the submission time", response.CollectedAt)
+	}
+	if response.Metadata["plan"] != "pro" || response.Metadata["channel"] != "in-app" {
+		t.Errorf("unexpected metadata: %v", response.Metadata)
+	}
+
+	// The unanswered question is skipped
+	if len(response.Experiences) != 3 {
+		t.Fatalf("got %d experiences, want 3: %+v", len(response.Experiences), response.Experiences)
+	}
+	nps, text, choices := response.Experiences[0], response.Experiences[1], response.Experiences[2]
+	if nps.FieldID != "q_nps" || nps.FieldType != "nps" || nps.ValueNumber == nil || *nps.ValueNumber != 9 {
+		t.Errorf("unexpected NPS experience: %+v", nps)
+	}
+	if text.FieldLabel != "Why?" || text.ValueText == nil || *text.ValueText != "Fast exports" {
+		t.Errorf("unexpected text experience: %+v", text)
+	}
+	if choices.ValueText == nil || *choices.ValueText != "Exports, Search" {
+		t.Errorf("unexpected categorical experience: %+v", choices)
+	}
+}
+
+func TestMapRecords(t *testing.T) {
+	template := Template{
+		Records:     "$.events",
+		CollectedAt: "$.ts",
+		Fields: []Field{
+			{FieldID: "score", FieldType: "rating", Value: "$.score"},
+			{FieldID: "resolved", FieldType: "boolean", Value: "$.resolved"},
+		},
+	}
+	payload := decode(t, `{"events": [
+		{"ts": 1705314600, "score": "4", "resolved": "yes"},
+		{"ts": 1705314600000, "score": 2},
+		{"ts": 1705314600}
+	]}`)
+
+	responses, err := template.Map(payload, time.Now())
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	// The event without values is skipped
+	if len(responses) != 2 {
+		t.Fatalf("got %d responses, want 2", len(responses))
+	}
+	first, second := responses[0], responses[1]
+	if len(first.Experiences) != 2 || *first.Experiences[0].ValueNumber != 4 || !*first.Experiences[1].ValueBoolean {
+		t.Errorf("unexpected experiences: %+v", first.Experiences)
+	}
+	collectedAt := time.Unix(1705314600, 0)
+	if !first.CollectedAt.Equal(collectedAt) || !second.CollectedAt.Equal(collectedAt) {
+		t.Errorf("CollectedAt = %v and %v, want %v from Unix seconds and milliseconds", first.CollectedAt, second.CollectedAt, collectedAt)
+	}
+
+	// Responses without ID are identified by their content, so redeliveries are only stored once
+	if len(first.ID) != 64 || first.ID == second.ID {
+		t.Errorf("IDs = %q and %q, want distinct content hashes", first.ID, second.ID)
+	}
+	again, _ := template.Map(payload, time.Now())
+	if again[0].ID != first.ID {
+		t.Errorf("ID of a redelivered response = %q, want %q", again[0].ID, first.ID)
+	}
+}
+
+func TestMapErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   Field
+		payload string
+		want    string
+	}{
+		{"number", Field{FieldID: "score", FieldType: "nps", Value: "$.score"}, `{"score": "great"}`, `expected a number, got "great"`},
+		{"boolean", Field{FieldID: "ok", FieldType: "boolean", Value: "$.ok"}, `{"ok": "maybe"}`, `expected a boolean`},
+		{"date", Field{FieldID: "due", FieldType: "date", Value: "$.due"}, `{"due": "tomorrow"}`, `expected an ISO 8601 timestamp`},
+		{"text", Field{FieldID: "comment", FieldType: "text", Value: "$.comment"}, `{"comment": {"body": "hi"}}`, `expected a string, got an object`},
+		{"field type", Field{FieldID: "q", FieldType: "$.type", Value: "$.value"}, `{"type": "matrix", "value": 1}`, `invalid field_type "matrix"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := Template{Fields: []Field{tt.field}}
+			_, err := template.Map(decode(t, tt.payload), time.Now())
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Map() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	field := Field{FieldID: "comment", FieldType: "text", Value: "$.comment"}
+	tests := []struct {
+		name     string
+		template Template
+		want     string
+	}{
+		{"no fields", Template{}, "at least one field"},
+		{"missing value", Template{Fields: []Field{{FieldID: "comment", FieldType: "text"}}}, "field_id, field_type, and value are required"},
+		{"field type", Template{Fields: []Field{{FieldID: "comment", FieldType: "essay", Value: "$.comment"}}}, `invalid field_type "essay"`},
+		{"path", Template{ResponseID: "$..id", Fields: []Field{field}}, "response_id: invalid path"},
+		{"relative outside each", Template{Fields: []Field{{FieldID: "@.id", FieldType: "text", Value: "$.comment"}}}, "fields[0].field_id: @ only refers to the element of an each"},
+		{"relative records", Template{Records: "@.events", Fields: []Field{field}}, "records must be a path starting with $"},
+		{"metadata", Template{Metadata: map[string]string{"plan": "$.user[plan"}, Fields: []Field{field}}, "metadata.plan: invalid path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(delivery)
+	signature := Sign("secret", body)
+	raw, _ := hex.DecodeString(signature)
+
+	for _, valid := range []string{signature, "sha256=" + signature, base64.StdEncoding.EncodeToString(raw), "sha256=" + base64.RawURLEncoding.EncodeToString(raw)} {
+		if !VerifySignature("secret", valid, body) {
+			t.Errorf("VerifySignature(%q) = false, want true", valid)
+		}
+	}
+	for _, invalid := range []string{"", "sha256=", Sign("wrong", body), "not a signature"} {
+		if VerifySignature("secret", invalid, body) {
+			t.Errorf("VerifySignature(%q) = true, want false", invalid)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package mapping
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Kinds of path segments
+const (
+	segmentName     = iota // .name or ['name']
+	segmentIndex           // [0], or [-1] for the last element
+	segmentWildcard        // [*] or .*
+	segmentFilter          // [?(@.type == 'nps')]
+)
+
+// Path is a parsed JSONPath expression. Paths start at the root of the delivery ($) or at
+// the current element of an each (@), and support child names, indexes, wildcards, and
+// filters that compare a child with a literal; recursive descent, slices, and functions
+// are not supported.
+type Path struct {
+	relative bool
+	segments []segment
+}
+
+type segment struct {
+	kind   int
+	name   string
+	index  int
+	filter *filter
+}
+
+// filter selects the elements whose child at path equals (==) or doesn't equal (!=) value,
+// or has any value other than null if op is empty
+type filter struct {
+	path  []string
+	op    string
+	value any
+}
+
+// ParsePath parses a JSONPath expression
+func ParsePath(expr string) (*Path, error) {
+	if expr == "" || (expr[0] != '$' && expr[0] != '@') {
+		return nil, fmt.Errorf("invalid path %q: expected it to start with $ or @", expr)
+	}
+	p := &Path{relative: expr[0] == '@'}
+	for i := 1; i < len(expr); {
+		var seg segment
+		var err error
+		switch expr[i] {
+		case '.':
+			seg, i, err = parseDot(expr, i+1)
+		case '[':
+			seg, i, err = parseBracket(expr, i+1)
+		default:
+			err = fmt.Errorf("unexpected %q at position %d", expr[i], i)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %q: %w", expr, err)
+		}
+		p.segments = append(p.segments, seg)
+	}
+	return p, nil
+}
+
+// parseDot parses the segment after a dot, starting at i, and returns the position after it
+func parseDot(expr string, i int) (segment, int, error) {
+	if i < len(expr) && expr[i] == '.' {
+		return segment{}, 0, errors.New("recursive descent (..) is not supported")
+	}
+	if i < len(expr) && expr[i] == '*' {
+		return segment{kind: segmentWildcard}, i + 1, nil
+	}
+	end := i
+	for end < len(expr) && expr[end] != '.' && expr[end] != '[' {
+		end++
+	}
+	if end == i {
+		return segment{}, 0, fmt.Errorf("missing name at position %d", i)
+	}
+	return segment{kind: segmentName, name: expr[i:end]}, end, nil
+}
+
+// parseBracket parses the segment in brackets, starting after the [ at i, and returns the
+// position after the ]
+func parseBracket(expr string, i int) (segment, int, error) {
+	rest := expr[i:]
+	switch {
+	case strings.HasPrefix(rest, "*]"):
+		return segment{kind: segmentWildcard}, i + 2, nil
+	case strings.HasPrefix(rest, "'") || strings.HasPrefix(rest, `"`):
+		name, n, err := parseQuoted(rest)
+		if err != nil {
+			return segment{}, 0, err
+		}
+		if !strings.HasPrefix(rest[n:], "]") {
+			return segment{}, 0, fmt.Errorf("missing ] at position %d", i+n)
+		}
+		return segment{kind: segmentName, name: name}, i + n + 1, nil
+	case strings.HasPrefix(rest, "?("):
+		end := strings.Index(rest, ")]")
+		if end < 0 {
+			return segment{}, 0, fmt.Errorf("missing )] of the filter at position %d", i)
+		}
+		f, err := parseFilter(rest[2:end])
+		if err != nil {
+			return segment{}, 0, err
+		}
+		return segment{kind: segmentFilter, filter: f}, i + end + 2, nil
+	default:
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return segment{}, 0, fmt.Errorf("missing ] at position %d", i)
+		}
+		index, err := strconv.Atoi(rest[:end])
+		if err != nil {
+			return segment{}, 0, fmt.Errorf("invalid index %q", rest[:end])
+		}
+		return segment{kind: segmentIndex, index: index}, i + end + 1, nil
+	}
+}
+
+// parseQuoted parses the quoted string at the start of s and returns it and its length;
+// a backslash escapes the next character
+func parseQuoted(s string) (string, int, error) {
+	quote := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, errors.New("unterminated string")
+}
+
+// parseFilter parses the expression of a filter: @.child, @.child == literal, or
+// @.child != literal, where the literal is a quoted string, a number, true, false, or null
+func parseFilter(expr string) (*filter, error) {
+	expr = strings.TrimSpace(expr)
+	left, right, op := expr, "", ""
+	if i := strings.IndexAny(expr, "=!"); i >= 0 {
+		op = expr[i:min(i+2, len(expr))]
+		if op != "==" && op != "!=" {
+			return nil, fmt.Errorf("unsupported operator in filter %q: expected == or !=", expr)
+		}
+		left, right = strings.TrimSpace(expr[:i]), strings.TrimSpace(expr[i+2:])
+	}
+	if !strings.HasPrefix(left, "@.") {
+		return nil, fmt.Errorf("invalid filter %q: expected a child of @, e.g. @.type == 'nps'", expr)
+	}
+	f := &filter{path: strings.Split(left[2:], "."), op: op}
+	if slices.Contains(f.path, "") || strings.ContainsAny(left, " \t<>") {
+		return nil, fmt.Errorf("invalid filter %q: expected == or != between the child and a value", expr)
+	}
+	if op == "" {
+		return f, nil
+	}
+
+	switch {
+	case right == "":
+		return nil, fmt.Errorf("missing value in filter %q", expr)
+	case right[0] == '\'' || right[0] == '"':
+		value, n, err := parseQuoted(right)
+		if err != nil || n != len(right) {
+			return nil, fmt.Errorf("invalid string in filter %q", expr)
+		}
+		f.value = value
+	case right == "true" || right == "false":
+		f.value = right == "true"
+	case right == "null":
+		f.value = nil
+	default:
+		number, err := strconv.ParseFloat(right, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q in filter %q", right, expr)
+		}
+		f.value = number
+	}
+	return f, nil
+}
+
+// Select returns the values the path selects from root ($) or current (@). Wildcards and
+// filters can select several values; children that don't exist select none.
+func (p *Path) Select(root, current any) []any {
+	nodes := []any{root}
+	if p.relative {
+		nodes = []any{current}
+	}
+	for _, seg := range p.segments {
+		var next []any
+		for _, node := range nodes {
+			switch seg.kind {
+			case segmentName:
+				if object, ok := node.(map[string]any); ok {
+					if value, ok := object[seg.name]; ok {
+						next = append(next, value)
+					}
+				}
+			case segmentIndex:
+				if array, ok := node.([]any); ok {
+					index := seg.index
+					if index < 0 {
+						index += len(array)
+					}
+					if index >= 0 && index < len(array) {
+						next = append(next, array[index])
+					}
+				}
+			case segmentWildcard, segmentFilter:
+				for _, child := range children(node) {
+					if seg.kind == segmentWildcard || seg.filter.matches(child) {
+						next = append(next, child)
+					}
+				}
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// Get returns the first value the path selects, and false if it selects none or null
+func (p *Path) Get(root, current any) (any, bool) {
+	values := p.Select(root, current)
+	if len(values) == 0 || values[0] == nil {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// children returns the elements of an array, or the values of an object ordered by key
+func children(node any) []any {
+	switch v := node.(type) {
+	case []any:
+		return v
+	case map[string]any:
+		values := make([]any, 0, len(v))
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			values = append(values, v[key])
+		}
+		return values
+	}
+	return nil
+}
+
+// matches reports whether an element satisfies the filter. Values are compared as decoded
+// from JSON, so numbers are float64.
+func (f *filter) matches(element any) bool {
+	var value any = element
+	found := true
+	for _, key := range f.path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			found = false
+			break
+		}
+		if value, ok = object[key]; !ok {
+			found = false
+			break
+		}
+	}
+	if !found {
+		value = nil
+	}
+	switch f.op {
+	case "==":
+		return value == f.value
+	case "!=":
+		return value != f.value
+	default:
+		return value != nil
+	}
+}
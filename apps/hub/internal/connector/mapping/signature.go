@@ -0,0 +1,40 @@
+package mapping
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// Sign returns the hex-encoded HMAC-SHA256 signature of a delivery's body
+func Sign(secret string, body []byte) string {
+	return hex.EncodeToString(digest(secret, body))
+}
+
+// VerifySignature reports whether a signature is the HMAC-SHA256 of the body with the
+// secret. Tools encode signatures differently, so hex and base64 are accepted, with or
+// without a sha256= prefix.
+func VerifySignature(secret, signature string, body []byte) bool {
+	signature = strings.TrimPrefix(strings.TrimSpace(signature), "sha256=")
+	if signature == "" {
+		return false
+	}
+	expected := digest(secret, body)
+	if decoded, err := hex.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+		return true
+	}
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(signature); err == nil && hmac.Equal(decoded, expected) {
+			return true
+		}
+	}
+	return false
+}
+
+func digest(secret string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,181 @@
+// Package segment connects Hub to customer data pipelines that speak the Segment Tracking
+// API. Track events posted by Segment, its SDKs, or compatible tools are stored as
+// experiences; enriched experiences are sent back to a Segment source by the analytics
+// forwarder.
+package segment
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/analytics"
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+// SourceType is the source_type of experiences received from Segment
+const SourceType = "segment"
+
+// EnrichedEvent is the name of the track events the analytics forwarder sends. They are
+// never stored, so forwarded experiences don't come back when a pipeline routes them to Hub
+// again.
+const EnrichedEvent = analytics.EnrichedEvent
+
+// Properties with numbers whose field type isn't number
+var numberFieldTypes = map[string]models.FieldType{
+	"nps":       models.FieldTypeNPS,
+	"nps_score": models.FieldTypeNPS,
+	"rating":    models.FieldTypeRating,
+	"stars":     models.FieldTypeRating,
+	"csat":      models.FieldTypeCSAT,
+}
+
+// Event is a call of the Tracking API. Only track calls are stored.
+type Event struct {
+	Type        string         `json:"type"`
+	Event       string         `json:"event"`
+	MessageID   string         `json:"messageId"`
+	UserID      string         `json:"userId"`
+	AnonymousID string         `json:"anonymousId"`
+	Timestamp   *time.Time     `json:"timestamp"`
+	Properties  map[string]any `json:"properties"`
+	Context     struct {
+		Locale string `json:"locale"`
+		Page   struct {
+			URL string `json:"url"`
+		} `json:"page"`
+		App struct {
+			Version string `json:"version"`
+		} `json:"app"`
+	} `json:"context"`
+}
+
+// Source receives Tracking API calls authenticated with a write key
+type Source struct {
+	writeKey string
+	events   []string
+}
+
+// NewSource returns a source that accepts calls with the write key and stores the track
+// events with the given names, or all track events if events is empty
+func NewSource(writeKey string, events []string) *Source {
+	return &Source{writeKey: writeKey, events: events}
+}
+
+// Parse authenticates a request from its Authorization header, which carries the write key
+// as the Basic auth user name, and returns the responses of the track events of its body:
+// a single call, as posted to /v1/track, or a batch, as posted to /v1/batch. Requests with
+// a missing or wrong write key return connector.ErrUnauthorized.
+func (s *Source) Parse(header http.Header, body []byte) ([]*connector.Response, error) {
+	req := http.Request{Header: header}
+	writeKey, _, ok := req.BasicAuth()
+	if !ok || subtle.ConstantTimeCompare([]byte(writeKey), []byte(s.writeKey)) != 1 {
+		return nil, connector.ErrUnauthorized
+	}
+
+	var call struct {
+		Event
+		Batch []Event `json:"batch"`
+	}
+	if err := json.Unmarshal(body, &call); err != nil {
+		return nil, fmt.Errorf("invalid request body: %w", err)
+	}
+	events := call.Batch
+	if events == nil {
+		if call.Type == "" {
+			// Calls posted to /v1/track may leave out their type
+			call.Type = "track"
+		}
+		events = []Event{call.Event}
+	}
+
+	var responses []*connector.Response
+	for _, event := range events {
+		if event.Type != "track" || event.Event == EnrichedEvent || (len(s.events) > 0 && !slices.Contains(s.events, event.Event)) {
+			continue
+		}
+		response, err := ToResponse(event)
+		if err != nil {
+			return nil, err
+		}
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	return responses, nil
+}
+
+// ToResponse maps a track event to a response with an experience per property: text for
+// strings, number for numbers, and boolean for booleans. Numbers of properties named after
+// a score, such as nps, rating, or csat, get its field type. Events without properties with
+// a value return nil.
+func ToResponse(event Event) (*connector.Response, error) {
+	if event.MessageID == "" || event.Event == "" {
+		return nil, errors.New("track events require a messageId and event")
+	}
+
+	response := &connector.Response{
+		ID:             event.MessageID,
+		SourceID:       event.Event,
+		SourceName:     event.Event,
+		CollectedAt:    time.Now().UTC(),
+		UserIdentifier: event.UserID,
+		Metadata:       map[string]any{"event": event.Event},
+	}
+	if event.Timestamp != nil {
+		response.CollectedAt = event.Timestamp.UTC()
+	}
+	if response.UserIdentifier == "" {
+		response.UserIdentifier = event.AnonymousID
+	} else if event.AnonymousID != "" {
+		response.Metadata["anonymous_id"] = event.AnonymousID
+	}
+	if event.Context.Locale != "" {
+		response.Metadata["locale"] = event.Context.Locale
+	}
+	if event.Context.Page.URL != "" {
+		response.Metadata["page_url"] = event.Context.Page.URL
+	}
+	if event.Context.App.Version != "" {
+		response.Metadata["app_version"] = event.Context.App.Version
+	}
+
+	names := make([]string, 0, len(event.Properties))
+	for name := range event.Properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		exp := connector.Experience{FieldID: name, FieldLabel: name}
+		switch value := event.Properties[name].(type) {
+		case string:
+			text := strings.TrimSpace(value)
+			if text == "" {
+				continue
+			}
+			exp.FieldType, exp.ValueText = string(models.FieldTypeText), &text
+		case float64:
+			fieldType, ok := numberFieldTypes[strings.ToLower(name)]
+			if !ok {
+				fieldType = models.FieldTypeNumber
+			}
+			exp.FieldType, exp.ValueNumber = string(fieldType), &value
+		case bool:
+			exp.FieldType, exp.ValueBoolean = string(models.FieldTypeBoolean), &value
+		default:
+			// Objects, lists, and nulls aren't answers
+			continue
+		}
+		response.Experiences = append(response.Experiences, exp)
+	}
+	if len(response.Experiences) == 0 {
+		return nil, nil
+	}
+	return response, nil
+}
@@ -0,0 +1,108 @@
+package segment
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+// track is a track call as posted to /v1/track
+const track = `{
+  "event": "Survey Answered",
+  "messageId": "ajs-next-1705314600-1b2c",
+  "userId": "user-42",
+  "anonymousId": "507f191e810c19729de860ea",
+  "timestamp": "2024-01-15T10:30:00Z",
+  "properties": {"nps": 9, "comment": " Love the new exports ", "recommend": true, "plan": "", "tags": ["beta"]},
+  "context": {"locale": "en-US", "page": {"url": "https://app.example.com/reports"}}
+}`
+
+// writeKeyHeader returns the Authorization header Segment sends with the write key
+func writeKeyHeader(writeKey string) http.Header {
+	req := http.Request{Header: http.Header{}}
+	req.SetBasicAuth(writeKey, "")
+	return req.Header
+}
+
+func TestSourceParse(t *testing.T) {
+	source := NewSource("write-key", nil)
+
+	responses, err := source.Parse(writeKeyHeader("write-key"), []byte(track))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(responses) != 1 {
+		t.Fatalf("got %d responses, want 1", len(responses))
+	}
+	response := responses[0]
+	if response.ID != "ajs-next-1705314600-1b2c" || response.SourceID != "Survey Answered" || response.UserIdentifier != "user-42" {
+		t.Errorf("unexpected response: %+v", response)
+	}
+	if !response.CollectedAt.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("CollectedAt = %v, want the timestamp of the event", response.CollectedAt)
+	}
+	if response.Metadata["anonymous_id"] != "507f191e810c19729de860ea" || response.Metadata["page_url"] != "https://app.example.com/reports" {
+		t.Errorf("unexpected metadata: %v", response.Metadata)
+	}
+
+	// Properties are sorted; empty strings and lists are skipped
+	want := []struct {
+		fieldID, fieldType string
+	}{{"comment", "text"}, {"nps", "nps"}, {"recommend", "boolean"}}
+	if len(response.Experiences) != len(want) {
+		t.Fatalf("got %d experiences, want %d: %+v", len(response.Experiences), len(want), response.Experiences)
+	}
+	for i, exp := range response.Experiences {
+		if exp.FieldID != want[i].fieldID || exp.FieldType != want[i].fieldType {
+			t.Errorf("experience %d = %s (%s), want %s (%s)", i, exp.FieldID, exp.FieldType, want[i].fieldID, want[i].fieldType)
+		}
+	}
+	if text := *response.Experiences[0].ValueText; text != "Love the new exports" {
+		t.Errorf("comment = %q, want it trimmed", text)
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		body := `{"batch": [
+			{"type": "identify", "userId": "user-42", "messageId": "m-1"},
+			` + strings.Replace(track, "{", `{"type": "track",`, 1) + `,
+			{"type": "track", "event": "Page Viewed", "messageId": "m-2", "properties": {"path": "/reports"}},
+			{"type": "track", "event": "` + EnrichedEvent + `", "messageId": "m-3", "properties": {"value_text": "forwarded"}}
+		]}`
+		responses, err := source.Parse(writeKeyHeader("write-key"), []byte(body))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if len(responses) != 2 {
+			t.Errorf("got %d responses, want the two track events that weren't forwarded by Hub", len(responses))
+		}
+
+		filtered, err := NewSource("write-key", []string{"Survey Answered"}).Parse(writeKeyHeader("write-key"), []byte(body))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if len(filtered) != 1 || filtered[0].SourceID != "Survey Answered" {
+			t.Errorf("expected only the configured event, got %+v", filtered)
+		}
+	})
+
+	t.Run("write key", func(t *testing.T) {
+		for _, header := range []http.Header{{}, writeKeyHeader("wrong")} {
+			if _, err := source.Parse(header, []byte(track)); !errors.Is(err, connector.ErrUnauthorized) {
+				t.Errorf("Parse() error = %v, want ErrUnauthorized", err)
+			}
+		}
+	})
+
+	t.Run("invalid events", func(t *testing.T) {
+		if _, err := source.Parse(writeKeyHeader("write-key"), []byte(`{"event": "Survey Answered"}`)); err == nil {
+			t.Error("expected an error for an event without messageId")
+		}
+		if _, err := source.Parse(writeKeyHeader("write-key"), []byte(`not json`)); err == nil {
+			t.Error("expected an error for an invalid body")
+		}
+	})
+}
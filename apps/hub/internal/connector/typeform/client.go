@@ -0,0 +1,121 @@
+package typeform
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+const (
+	// defaultBaseURL is the Typeform API
+	defaultBaseURL = "https://api.typeform.com"
+	// requestTimeout bounds a single API request
+	requestTimeout = 30 * time.Second
+	// MaxPageSize is the largest page of responses the API returns
+	MaxPageSize = 1000
+)
+
+// Client reads forms and responses with a personal access token that has the forms:read and
+// responses:read scopes
+type Client struct {
+	token      string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the Typeform API
+func NewClient(token string) *Client {
+	return &Client{
+		token:      token,
+		baseURL:    defaultBaseURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// ResponsesQuery selects a page of responses. Responses are listed newest first, so the
+// next page is the one before the token of the last response.
+type ResponsesQuery struct {
+	Since    *time.Time
+	Until    *time.Time
+	Before   string
+	PageSize int
+}
+
+// ResponsesPage is a page of completed responses, newest first
+type ResponsesPage struct {
+	TotalItems int            `json:"total_items"`
+	Items      []FormResponse `json:"items"`
+}
+
+// Form returns the definition of a form
+func (c *Client) Form(ctx context.Context, formID string) (*Definition, error) {
+	var definition Definition
+	if err := c.get(ctx, "/forms/"+url.PathEscape(formID), nil, &definition); err != nil {
+		return nil, err
+	}
+	return &definition, nil
+}
+
+// Responses returns a page of the completed responses of a form
+func (c *Client) Responses(ctx context.Context, formID string, q ResponsesQuery) (*ResponsesPage, error) {
+	params := url.Values{"completed": {"true"}}
+	if q.PageSize > 0 {
+		params.Set("page_size", strconv.Itoa(min(q.PageSize, MaxPageSize)))
+	}
+	if q.Since != nil {
+		params.Set("since", q.Since.UTC().Format(time.RFC3339))
+	}
+	if q.Until != nil {
+		params.Set("until", q.Until.UTC().Format(time.RFC3339))
+	}
+	if q.Before != "" {
+		params.Set("before", q.Before)
+	}
+
+	var page ResponsesPage
+	if err := c.get(ctx, "/forms/"+url.PathEscape(formID)+"/responses", params, &page); err != nil {
+		return nil, err
+	}
+	for i := range page.Items {
+		page.Items[i].FormID = formID
+	}
+	return &page, nil
+}
+
+// get decodes the JSON response of a GET request
+func (c *Client) get(ctx context.Context, path string, params url.Values, v any) error {
+	endpoint := c.baseURL + path
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		var body struct {
+			Description string `json:"description"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		if json.Unmarshal(data, &body) != nil || body.Description == "" {
+			body.Description = http.StatusText(resp.StatusCode)
+		}
+		return &connector.APIError{Status: resp.StatusCode, Message: body.Description}
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
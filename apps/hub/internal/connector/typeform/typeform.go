@@ -0,0 +1,268 @@
+// Package typeform receives Typeform responses through its webhooks and reads historical
+// responses through its Responses API. Answers are mapped to field types by the type of
+// their field; contact details, file uploads, payments, and matrices aren't feedback and
+// are left out.
+package typeform
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+// SourceType is the source_type of experiences received from Typeform
+const SourceType = "typeform"
+
+// UserIdentifierField is the hidden field that identifies respondents
+const UserIdentifierField = "user_id"
+
+// signatureHeader carries the HMAC-SHA256 of a webhook delivery as sha256=<base64>
+const signatureHeader = "Typeform-Signature"
+
+// fieldTypes are the field types of the Typeform field types that are stored
+var fieldTypes = map[string]models.FieldType{
+	"short_text":      models.FieldTypeText,
+	"long_text":       models.FieldTypeText,
+	"multiple_choice": models.FieldTypeCategorical,
+	"dropdown":        models.FieldTypeCategorical,
+	"picture_choice":  models.FieldTypeCategorical,
+	"ranking":         models.FieldTypeCategorical,
+	"nps":             models.FieldTypeNPS,
+	"opinion_scale":   models.FieldTypeRating,
+	"rating":          models.FieldTypeRating,
+	"number":          models.FieldTypeNumber,
+	"yes_no":          models.FieldTypeBoolean,
+	"legal":           models.FieldTypeBoolean,
+	"date":            models.FieldTypeDate,
+}
+
+// Field is a field of a form. Groups hold further fields in their properties.
+type Field struct {
+	ID         string `json:"id"`
+	Ref        string `json:"ref"`
+	Type       string `json:"type"`
+	Title      string `json:"title"`
+	Properties struct {
+		Fields []Field `json:"fields"`
+	} `json:"properties"`
+}
+
+// Definition is the definition of a form: its title and fields
+type Definition struct {
+	ID     string  `json:"id"`
+	Title  string  `json:"title"`
+	Fields []Field `json:"fields"`
+}
+
+// labels returns the titles of all fields by ID, including the fields of groups
+func (d *Definition) labels() map[string]string {
+	labels := map[string]string{}
+	var add func(fields []Field)
+	add = func(fields []Field) {
+		for _, field := range fields {
+			labels[field.ID] = field.Title
+			add(field.Properties.Fields)
+		}
+	}
+	add(d.Fields)
+	return labels
+}
+
+// Answer is the answer to one field. The value is in the property named by its type.
+type Answer struct {
+	Type  string `json:"type"`
+	Field struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+		Ref  string `json:"ref"`
+	} `json:"field"`
+	Text    *string  `json:"text,omitempty"`
+	Number  *float64 `json:"number,omitempty"`
+	Boolean *bool    `json:"boolean,omitempty"`
+	Date    *string  `json:"date,omitempty"`
+	Choice  *struct {
+		Label string `json:"label"`
+		Other string `json:"other"`
+	} `json:"choice,omitempty"`
+	Choices *struct {
+		Labels []string `json:"labels"`
+		Other  string   `json:"other"`
+	} `json:"choices,omitempty"`
+}
+
+// FormResponse is a submitted response, as delivered by webhooks (with the form ID and
+// definition) or listed by the Responses API (with request metadata)
+type FormResponse struct {
+	FormID      string            `json:"form_id"`
+	Token       string            `json:"token"`
+	SubmittedAt time.Time         `json:"submitted_at"`
+	Hidden      map[string]string `json:"hidden"`
+	Metadata    map[string]string `json:"metadata"`
+	Definition  *Definition       `json:"definition"`
+	Answers     []Answer          `json:"answers"`
+}
+
+// webhookPayload is the body of a webhook delivery
+type webhookPayload struct {
+	EventID      string        `json:"event_id"`
+	EventType    string        `json:"event_type"`
+	FormResponse *FormResponse `json:"form_response"`
+}
+
+// Webhook receives the responses of Typeform webhooks signed with a secret
+type Webhook struct {
+	secret string
+}
+
+// NewWebhook returns the connector of webhooks signed with the secret
+func NewWebhook(secret string) *Webhook {
+	return &Webhook{secret: secret}
+}
+
+// Name returns the source type of Typeform experiences
+func (w *Webhook) Name() string {
+	return SourceType
+}
+
+// Parse verifies the signature of a delivery and returns its response. Only form_response
+// events carry a complete response; others return nil.
+func (w *Webhook) Parse(header http.Header, body []byte) (*connector.Response, error) {
+	if !w.verify(header.Get(signatureHeader), body) {
+		return nil, connector.ErrUnauthorized
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	if payload.EventType != "form_response" || payload.FormResponse == nil {
+		return nil, nil
+	}
+	if payload.FormResponse.FormID == "" || payload.FormResponse.Token == "" {
+		return nil, fmt.Errorf("invalid payload: the response has no form_id or token")
+	}
+	definition := payload.FormResponse.Definition
+	if definition == nil {
+		definition = &Definition{ID: payload.FormResponse.FormID}
+	}
+	return ToResponse(definition, payload.FormResponse), nil
+}
+
+// verify reports whether the signature is the one of the body with the secret
+func (w *Webhook) verify(signature string, body []byte) bool {
+	return signature != "" && hmac.Equal([]byte(signature), []byte(Sign(w.secret, body)))
+}
+
+// Sign returns the Typeform-Signature header of a body, as Typeform computes it
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ToResponse maps a response of the form to experiences, one per answer and one per
+// selected option of multiple choice and ranking answers. Hidden fields and request
+// metadata become the metadata of the experiences.
+func ToResponse(definition *Definition, r *FormResponse) *connector.Response {
+	response := &connector.Response{
+		ID:          r.Token,
+		SourceID:    definition.ID,
+		SourceName:  definition.Title,
+		CollectedAt: r.SubmittedAt,
+		Metadata:    map[string]any{},
+	}
+	if response.SourceID == "" {
+		response.SourceID = r.FormID
+	}
+	for key, value := range r.Metadata {
+		if value != "" {
+			response.Metadata[key] = value
+		}
+	}
+	for key, value := range r.Hidden {
+		if value == "" {
+			continue
+		}
+		if key == UserIdentifierField {
+			response.UserIdentifier = value
+			continue
+		}
+		response.Metadata[key] = value
+	}
+
+	labels := definition.labels()
+	for _, answer := range r.Answers {
+		fieldType, ok := fieldTypes[answer.Field.Type]
+		if !ok {
+			continue
+		}
+		exp := connector.Experience{
+			FieldID:    answer.Field.ID,
+			FieldLabel: labels[answer.Field.ID],
+			FieldType:  string(fieldType),
+		}
+
+		switch {
+		case answer.Text != nil && fieldType == models.FieldTypeText:
+			exp.ValueText = answer.Text
+		case answer.Number != nil && (fieldType == models.FieldTypeNPS || fieldType == models.FieldTypeRating || fieldType == models.FieldTypeNumber):
+			exp.ValueNumber = answer.Number
+		case answer.Boolean != nil && fieldType == models.FieldTypeBoolean:
+			exp.ValueBoolean = answer.Boolean
+		case answer.Date != nil && fieldType == models.FieldTypeDate:
+			date, err := parseDate(*answer.Date)
+			if err != nil {
+				continue
+			}
+			exp.ValueDate = &date
+		case answer.Choice != nil && fieldType == models.FieldTypeCategorical:
+			exp.ValueText = choice(answer.Choice.Label, answer.Choice.Other, &exp)
+		case answer.Choices != nil && fieldType == models.FieldTypeCategorical:
+			// Like other multiple choice answers, each selected option is an experience;
+			// rankings keep the position of each option
+			for i, label := range answer.Choices.Labels {
+				option := exp
+				option.ValueText = &label
+				if answer.Field.Type == "ranking" {
+					option.ValueJSON = map[string]any{"rank": i + 1}
+				}
+				response.Experiences = append(response.Experiences, option)
+			}
+			if answer.Choices.Other != "" {
+				option := exp
+				option.ValueText = choice("", answer.Choices.Other, &option)
+				response.Experiences = append(response.Experiences, option)
+			}
+			continue
+		default:
+			continue
+		}
+		response.Experiences = append(response.Experiences, exp)
+	}
+	return response
+}
+
+// choice returns the selected option of a choice, or the text of its other option, which
+// is marked in value_json
+func choice(label, other string, exp *connector.Experience) *string {
+	if label == "" && other != "" {
+		exp.ValueJSON = map[string]any{"other": true}
+		return &other
+	}
+	return &label
+}
+
+// parseDate parses a date answer, which is a day or, in older responses, a timestamp
+func parseDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, value); err == nil {
+		return date, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
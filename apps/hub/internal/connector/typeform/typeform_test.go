@@ -0,0 +1,218 @@
+package typeform
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+// delivery is a webhook delivery of a response, as sent by Typeform
+const delivery = `{
+  "event_id": "01HW6N8T3",
+  "event_type": "form_response",
+  "form_response": {
+    "form_id": "lT4Z3j",
+    "token": "a3a12ec67a1365927098a606107fac15",
+    "submitted_at": "2024-01-15T10:30:00Z",
+    "hidden": {"user_id": "user-42", "country": "DE", "utm_source": ""},
+    "definition": {
+      "id": "lT4Z3j",
+      "title": "Customer feedback",
+      "fields": [
+        {"id": "nps1", "type": "nps", "title": "How likely are you to recommend us?"},
+        {"id": "grp1", "type": "group", "title": "About you", "properties": {"fields": [
+          {"id": "txt1", "type": "long_text", "title": "What could we improve?"}
+        ]}},
+        {"id": "mc1", "type": "multiple_choice", "title": "Which features do you use?"},
+        {"id": "rk1", "type": "ranking", "title": "Rank the plans"},
+        {"id": "yn1", "type": "yes_no", "title": "Would you buy again?"},
+        {"id": "dt1", "type": "date", "title": "When did you sign up?"},
+        {"id": "em1", "type": "email", "title": "Your email"}
+      ]
+    },
+    "answers": [
+      {"type": "number", "number": 9, "field": {"id": "nps1", "type": "nps"}},
+      {"type": "text", "text": "Faster exports", "field": {"id": "txt1", "type": "long_text"}},
+      {"type": "choices", "choices": {"labels": ["Dashboards", "Alerts"], "other": "API"}, "field": {"id": "mc1", "type": "multiple_choice"}},
+      {"type": "choices", "choices": {"labels": ["Pro", "Free"]}, "field": {"id": "rk1", "type": "ranking"}},
+      {"type": "boolean", "boolean": true, "field": {"id": "yn1", "type": "yes_no"}},
+      {"type": "date", "date": "2023-06-01", "field": {"id": "dt1", "type": "date"}},
+      {"type": "email", "email": "jane@example.com", "field": {"id": "em1", "type": "email"}}
+    ]
+  }
+}`
+
+func TestWebhookParse(t *testing.T) {
+	w := NewWebhook("secret")
+	header := http.Header{}
+	header.Set("Typeform-Signature", Sign("secret", []byte(delivery)))
+
+	response, err := w.Parse(header, []byte(delivery))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if response.ID != "a3a12ec67a1365927098a606107fac15" || response.SourceID != "lT4Z3j" || response.SourceName != "Customer feedback" {
+		t.Errorf("unexpected response: %+v", response)
+	}
+	if !response.CollectedAt.Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("CollectedAt = %v", response.CollectedAt)
+	}
+	if response.UserIdentifier != "user-42" {
+		t.Errorf("UserIdentifier = %q, want the user_id hidden field", response.UserIdentifier)
+	}
+	if len(response.Metadata) != 1 || response.Metadata["country"] != "DE" {
+		t.Errorf("Metadata = %v, want the non-empty hidden fields other than user_id", response.Metadata)
+	}
+
+	type answer struct {
+		fieldID, fieldType, label, value string
+	}
+	var got []answer
+	for _, exp := range response.Experiences {
+		a := answer{fieldID: exp.FieldID, fieldType: exp.FieldType, label: exp.FieldLabel}
+		switch {
+		case exp.ValueText != nil:
+			a.value = *exp.ValueText
+		case exp.ValueNumber != nil:
+			a.value = "number"
+		case exp.ValueBoolean != nil:
+			a.value = "boolean"
+		case exp.ValueDate != nil:
+			a.value = exp.ValueDate.Format(time.DateOnly)
+		}
+		got = append(got, a)
+	}
+	want := []answer{
+		{"nps1", "nps", "How likely are you to recommend us?", "number"},
+		{"txt1", "text", "What could we improve?", "Faster exports"},
+		{"mc1", "categorical", "Which features do you use?", "Dashboards"},
+		{"mc1", "categorical", "Which features do you use?", "Alerts"},
+		{"mc1", "categorical", "Which features do you use?", "API"},
+		{"rk1", "categorical", "Rank the plans", "Pro"},
+		{"rk1", "categorical", "Rank the plans", "Free"},
+		{"yn1", "boolean", "Would you buy again?", "boolean"},
+		{"dt1", "date", "When did you sign up?", "2023-06-01"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d experiences, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("experience %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if other := response.Experiences[4].ValueJSON; other["other"] != true {
+		t.Errorf("other option value_json = %v, want other: true", other)
+	}
+	if rank := response.Experiences[6].ValueJSON; rank["rank"] != 2 {
+		t.Errorf("second ranked option value_json = %v, want rank: 2", rank)
+	}
+}
+
+func TestWebhookParseSignature(t *testing.T) {
+	w := NewWebhook("secret")
+	tests := map[string]string{
+		"missing":      "",
+		"wrong secret": Sign("other", []byte(delivery)),
+		"no prefix":    Sign("secret", []byte(delivery))[len("sha256="):],
+		"other body":   Sign("secret", []byte(delivery+" ")),
+	}
+	for name, signature := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if signature != "" {
+				header.Set("Typeform-Signature", signature)
+			}
+			if _, err := w.Parse(header, []byte(delivery)); !errors.Is(err, connector.ErrUnauthorized) {
+				t.Errorf("Parse() error = %v, want ErrUnauthorized", err)
+			}
+		})
+	}
+}
+
+func TestWebhookParseOtherEvents(t *testing.T) {
+	w := NewWebhook("secret")
+	body := []byte(`{"event_id": "1", "event_type": "form_response_partial", "form_response": {"form_id": "lT4Z3j", "token": "t"}}`)
+	header := http.Header{}
+	header.Set("Typeform-Signature", Sign("secret", body))
+
+	response, err := w.Parse(header, body)
+	if err != nil || response != nil {
+		t.Errorf("Parse() = %v, %v, want no response", response, err)
+	}
+}
+
+func TestClientResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code": "AUTHENTICATION_FAILED", "description": "Authentication credentials not found on the Request Headers"}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/forms/lT4Z3j":
+			_, _ = w.Write([]byte(`{"id": "lT4Z3j", "title": "Customer feedback", "fields": [{"id": "txt1", "type": "long_text", "title": "What could we improve?"}]}`))
+		case "/forms/lT4Z3j/responses":
+			q := r.URL.Query()
+			if q.Get("completed") != "true" || q.Get("page_size") != "2" || q.Get("before") != "t3" || q.Get("since") != "2024-01-01T00:00:00Z" {
+				t.Errorf("unexpected query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"total_items": 5, "page_count": 3, "items": [
+				{"token": "t2", "submitted_at": "2024-01-15T10:30:00Z", "metadata": {"platform": "mobile"}, "answers": [{"type": "text", "text": "Faster exports", "field": {"id": "txt1", "type": "long_text"}}]},
+				{"token": "t1", "submitted_at": "2024-01-14T10:30:00Z", "answers": []}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code": "FORM_NOT_FOUND", "description": "Non-existing form with uid unknown"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("token")
+	client.baseURL = server.URL
+	ctx := context.Background()
+
+	definition, err := client.Form(ctx, "lT4Z3j")
+	if err != nil {
+		t.Fatalf("Form() error = %v", err)
+	}
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	page, err := client.Responses(ctx, "lT4Z3j", ResponsesQuery{Since: &since, Before: "t3", PageSize: 2})
+	if err != nil {
+		t.Fatalf("Responses() error = %v", err)
+	}
+	if page.TotalItems != 5 || len(page.Items) != 2 {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+
+	response := ToResponse(definition, &page.Items[0])
+	if response.ID != "t2" || response.SourceID != "lT4Z3j" || response.Metadata["platform"] != "mobile" {
+		t.Errorf("unexpected response: %+v", response)
+	}
+	if len(response.Experiences) != 1 || response.Experiences[0].FieldLabel != "What could we improve?" {
+		t.Errorf("unexpected experiences: %+v", response.Experiences)
+	}
+
+	t.Run("unknown form", func(t *testing.T) {
+		_, err := client.Form(ctx, "unknown")
+		var apiErr *connector.APIError
+		if !errors.As(err, &apiErr) || apiErr.Status != http.StatusNotFound || apiErr.Message != "Non-existing form with uid unknown" {
+			t.Errorf("Form() error = %v, want a 404 APIError", err)
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		wrong := NewClient("wrong")
+		wrong.baseURL = server.URL
+		_, err := wrong.Form(ctx, "lT4Z3j")
+		var apiErr *connector.APIError
+		if !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized {
+			t.Errorf("Form() error = %v, want a 401 APIError", err)
+		}
+	})
+}
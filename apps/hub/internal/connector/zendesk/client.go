@@ -0,0 +1,218 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+// Streams synced from Zendesk, which name their cursors
+const (
+	StreamTicketComments      = "zendesk.ticket_comments"
+	StreamSatisfactionRatings = "zendesk.satisfaction_ratings"
+)
+
+const (
+	// requestTimeout bounds a single API request
+	requestTimeout = 30 * time.Second
+	// exportDelay is how far in the past incremental exports must start, as Zendesk
+	// rejects start times within the last minute
+	exportDelay = time.Minute
+	// ratingsPageSize is the number of satisfaction ratings read per page
+	ratingsPageSize = 100
+	// usersPerRequest is the number of users that can be read in one request
+	usersPerRequest = 100
+)
+
+// Client syncs ticket comments and satisfaction ratings with the API token of an agent
+type Client struct {
+	baseURL    string
+	email      string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the Zendesk account at subdomain.zendesk.com
+func NewClient(subdomain, email, token string) *Client {
+	return &Client{
+		baseURL:    "https://" + subdomain + ".zendesk.com",
+		email:      email,
+		token:      token,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Page is the result of reading the next page of a stream
+type Page struct {
+	// Read is the number of ticket events or ratings read
+	Read      int
+	Responses []*connector.Response
+	// Cursor is where the next page starts
+	Cursor string
+	// More reports whether further pages are waiting
+	More bool
+}
+
+// SyncComments reads the ticket events after the cursor, or after since if the stream
+// wasn't synced before, and returns the public comments of customers among them
+func (c *Client) SyncComments(ctx context.Context, cursor string, since time.Time) (*Page, error) {
+	start := since.Unix()
+	if cursor != "" {
+		var err error
+		if start, err = strconv.ParseInt(cursor, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid ticket comments cursor %q", cursor)
+		}
+	}
+	if time.Unix(start, 0).After(time.Now().Add(-exportDelay)) {
+		return &Page{Cursor: strconv.FormatInt(start, 10)}, nil
+	}
+
+	var export struct {
+		TicketEvents []TicketEvent `json:"ticket_events"`
+		EndTime      int64         `json:"end_time"`
+		EndOfStream  bool          `json:"end_of_stream"`
+	}
+	params := url.Values{
+		"start_time": {strconv.FormatInt(start, 10)},
+		"include":    {"comment_events"},
+	}
+	if err := c.get(ctx, "/api/v2/incremental/ticket_events.json", params, &export); err != nil {
+		return nil, err
+	}
+
+	roles, err := c.roles(ctx, commentAuthors(export.TicketEvents))
+	if err != nil {
+		return nil, err
+	}
+	page := &Page{
+		Read:      len(export.TicketEvents),
+		Responses: CommentResponses(export.TicketEvents, roles),
+		Cursor:    strconv.FormatInt(start, 10),
+		More:      !export.EndOfStream,
+	}
+	if export.EndTime > start {
+		page.Cursor = strconv.FormatInt(export.EndTime, 10)
+	}
+	return page, nil
+}
+
+// SyncRatings reads a page of the received satisfaction ratings after the cursor, or
+// created after since if the stream wasn't synced before. The cursor holds the creation
+// time of the latest rating read, and the position within the ratings created since then
+// while they are paged through.
+func (c *Client) SyncRatings(ctx context.Context, cursor string, since time.Time) (*Page, error) {
+	start, after := since.Unix(), ""
+	if cursor != "" {
+		startCursor, afterCursor, _ := strings.Cut(cursor, ":")
+		var err error
+		if start, err = strconv.ParseInt(startCursor, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid satisfaction ratings cursor %q", cursor)
+		}
+		after = afterCursor
+	}
+
+	var list struct {
+		SatisfactionRatings []SatisfactionRating `json:"satisfaction_ratings"`
+		Meta                struct {
+			HasMore     bool   `json:"has_more"`
+			AfterCursor string `json:"after_cursor"`
+		} `json:"meta"`
+	}
+	params := url.Values{
+		"score":      {"received"},
+		"start_time": {strconv.FormatInt(start, 10)},
+		"page[size]": {strconv.Itoa(ratingsPageSize)},
+	}
+	if after != "" {
+		params.Set("page[after]", after)
+	}
+	if err := c.get(ctx, "/api/v2/satisfaction_ratings.json", params, &list); err != nil {
+		return nil, err
+	}
+
+	page := &Page{Read: len(list.SatisfactionRatings), More: list.Meta.HasMore}
+	latest := start
+	for _, rating := range list.SatisfactionRatings {
+		if response := RatingResponse(rating); response != nil {
+			page.Responses = append(page.Responses, response)
+		}
+		latest = max(latest, rating.CreatedAt.Unix())
+	}
+	if list.Meta.HasMore {
+		page.Cursor = strconv.FormatInt(start, 10) + ":" + list.Meta.AfterCursor
+	} else {
+		// Ratings created in the second of the latest one are read again; they are only
+		// stored once
+		page.Cursor = strconv.FormatInt(latest, 10)
+	}
+	return page, nil
+}
+
+// roles returns the roles of users by ID
+func (c *Client) roles(ctx context.Context, ids []int64) (map[int64]string, error) {
+	roles := make(map[int64]string, len(ids))
+	for i := 0; i < len(ids); i += usersPerRequest {
+		batch := ids[i:min(i+usersPerRequest, len(ids))]
+		values := make([]string, len(batch))
+		for j, id := range batch {
+			values[j] = strconv.FormatInt(id, 10)
+		}
+
+		var users struct {
+			Users []User `json:"users"`
+		}
+		if err := c.get(ctx, "/api/v2/users/show_many.json", url.Values{"ids": {strings.Join(values, ",")}}, &users); err != nil {
+			return nil, err
+		}
+		for _, user := range users.Users {
+			roles[user.ID] = user.Role
+		}
+	}
+	return roles, nil
+}
+
+// get decodes the JSON response of a GET request
+func (c *Client) get(ctx context.Context, path string, params url.Values, v any) error {
+	endpoint := c.baseURL + path
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.email+"/token", c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		var body struct {
+			Error       any    `json:"error"`
+			Description string `json:"description"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		message := http.StatusText(resp.StatusCode)
+		if json.Unmarshal(data, &body) == nil {
+			if body.Description != "" {
+				message = body.Description
+			} else if e, ok := body.Error.(string); ok && e != "" {
+				message = e
+			}
+		}
+		return &connector.APIError{Status: resp.StatusCode, Message: message}
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
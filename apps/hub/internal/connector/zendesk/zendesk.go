@@ -0,0 +1,182 @@
+// Package zendesk syncs support feedback from Zendesk: the public comments that customers
+// add to tickets, read from the incremental ticket event export, and the satisfaction
+// ratings of solved tickets. Both are read incrementally from a cursor, so each sync only
+// reads what changed since the previous one.
+package zendesk
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+const (
+	// SourceType is the source_type of experiences synced from Zendesk
+	SourceType = "support"
+	// SourceID is the source_id of experiences synced from Zendesk
+	SourceID = "zendesk"
+	// sourceName is the source_name of experiences synced from Zendesk
+	sourceName = "Zendesk"
+)
+
+// Field IDs and labels of the synced experiences
+const (
+	FieldTicketComment       = "ticket_comment"
+	FieldSatisfaction        = "satisfaction"
+	FieldSatisfactionComment = "satisfaction_comment"
+
+	labelTicketComment       = "Ticket comment"
+	labelSatisfaction        = "How would you rate the support you received?"
+	labelSatisfactionComment = "Satisfaction comment"
+)
+
+// roleEndUser is the role of customers; agents' and admins' comments aren't feedback
+const roleEndUser = "end-user"
+
+// TicketEvent is an update of a ticket, with the comment added in it among its child events
+type TicketEvent struct {
+	ID          int64        `json:"id"`
+	TicketID    int64        `json:"ticket_id"`
+	CreatedAt   time.Time    `json:"created_at"`
+	ChildEvents []ChildEvent `json:"child_events"`
+}
+
+// ChildEvent is a change made in a ticket update. Comment events carry the comment.
+type ChildEvent struct {
+	ID        int64  `json:"id"`
+	EventType string `json:"event_type"`
+	AuthorID  int64  `json:"author_id"`
+	Body      string `json:"body"`
+	PlainBody string `json:"plain_body"`
+	Public    bool   `json:"public"`
+	Via       struct {
+		Channel string `json:"channel"`
+	} `json:"via"`
+}
+
+// SatisfactionRating is a customer's rating of the support they received on a ticket
+type SatisfactionRating struct {
+	ID          int64     `json:"id"`
+	TicketID    int64     `json:"ticket_id"`
+	RequesterID int64     `json:"requester_id"`
+	AssigneeID  int64     `json:"assignee_id"`
+	GroupID     int64     `json:"group_id"`
+	Score       string    `json:"score"`
+	Comment     string    `json:"comment"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// User is a Zendesk user
+type User struct {
+	ID   int64  `json:"id"`
+	Role string `json:"role"`
+}
+
+// CommentResponses maps the public comments of customers in ticket events to responses, one
+// per comment. roles are the roles of the comment authors by ID; comments by authors of
+// unknown role are skipped like those of agents.
+func CommentResponses(events []TicketEvent, roles map[int64]string) []*connector.Response {
+	var responses []*connector.Response
+	for _, event := range events {
+		for _, child := range event.ChildEvents {
+			if child.EventType != "Comment" || !child.Public || roles[child.AuthorID] != roleEndUser {
+				continue
+			}
+			text := child.PlainBody
+			if text == "" {
+				text = child.Body
+			}
+			if text == "" {
+				continue
+			}
+
+			metadata := map[string]any{"ticket_id": event.TicketID}
+			if child.Via.Channel != "" {
+				metadata["channel"] = child.Via.Channel
+			}
+			responses = append(responses, &connector.Response{
+				ID:             "comment-" + strconv.FormatInt(child.ID, 10),
+				SourceID:       SourceID,
+				SourceName:     sourceName,
+				CollectedAt:    event.CreatedAt,
+				UserIdentifier: strconv.FormatInt(child.AuthorID, 10),
+				Metadata:       metadata,
+				Experiences: []connector.Experience{{
+					FieldID:    FieldTicketComment,
+					FieldLabel: labelTicketComment,
+					FieldType:  string(models.FieldTypeText),
+					ValueText:  &text,
+				}},
+			})
+		}
+	}
+	return responses
+}
+
+// commentAuthors returns the IDs of the authors of public comments in ticket events
+func commentAuthors(events []TicketEvent) []int64 {
+	seen := map[int64]bool{}
+	var ids []int64
+	for _, event := range events {
+		for _, child := range event.ChildEvents {
+			if child.EventType == "Comment" && child.Public && !seen[child.AuthorID] {
+				seen[child.AuthorID] = true
+				ids = append(ids, child.AuthorID)
+			}
+		}
+	}
+	return ids
+}
+
+// RatingResponse maps a satisfaction rating to a response: whether the customer rated the
+// support good, as a boolean, and their comment, if any. Ratings that were offered but
+// not given return nil.
+func RatingResponse(rating SatisfactionRating) *connector.Response {
+	var good bool
+	switch rating.Score {
+	case "good", "good_with_comment":
+		good = true
+	case "bad", "bad_with_comment":
+	default:
+		return nil
+	}
+
+	metadata := map[string]any{"ticket_id": rating.TicketID}
+	if rating.AssigneeID != 0 {
+		metadata["assignee_id"] = rating.AssigneeID
+	}
+	if rating.GroupID != 0 {
+		metadata["group_id"] = rating.GroupID
+	}
+	if rating.Reason != "" {
+		metadata["reason"] = rating.Reason
+	}
+	response := &connector.Response{
+		ID:             "satisfaction-" + strconv.FormatInt(rating.ID, 10),
+		SourceID:       SourceID,
+		SourceName:     sourceName,
+		CollectedAt:    rating.UpdatedAt,
+		UserIdentifier: strconv.FormatInt(rating.RequesterID, 10),
+		Metadata:       metadata,
+		Experiences: []connector.Experience{{
+			FieldID:      FieldSatisfaction,
+			FieldLabel:   labelSatisfaction,
+			FieldType:    string(models.FieldTypeBoolean),
+			ValueBoolean: &good,
+		}},
+	}
+	if rating.Comment != "" {
+		comment := rating.Comment
+		response.Experiences = append(response.Experiences, connector.Experience{
+			FieldID:    FieldSatisfactionComment,
+			FieldLabel: labelSatisfactionComment,
+			FieldType:  string(models.FieldTypeText),
+			ValueText:  &comment,
+		})
+	}
+	return response
+}
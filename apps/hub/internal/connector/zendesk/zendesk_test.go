@@ -0,0 +1,171 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector"
+)
+
+func TestCommentResponses(t *testing.T) {
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	events := []TicketEvent{{
+		ID: 1, TicketID: 35, CreatedAt: at,
+		ChildEvents: []ChildEvent{
+			{ID: 11, EventType: "Comment", AuthorID: 7, Body: "<p>The export <b>times out</b></p>", PlainBody: "The export times out", Public: true},
+			{ID: 12, EventType: "Comment", AuthorID: 8, Body: "We are looking into it", Public: true},
+			{ID: 13, EventType: "Comment", AuthorID: 7, Body: "Internal note", Public: false},
+			{ID: 14, EventType: "Change", AuthorID: 7},
+		},
+	}}
+	events[0].ChildEvents[0].Via.Channel = "email"
+
+	responses := CommentResponses(events, map[int64]string{7: "end-user", 8: "agent"})
+	if len(responses) != 1 {
+		t.Fatalf("got %d responses, want only the public comment of the customer: %+v", len(responses), responses)
+	}
+	response := responses[0]
+	if response.ID != "comment-11" || response.SourceID != "zendesk" || response.UserIdentifier != "7" || !response.CollectedAt.Equal(at) {
+		t.Errorf("unexpected response: %+v", response)
+	}
+	if response.Metadata["ticket_id"] != int64(35) || response.Metadata["channel"] != "email" {
+		t.Errorf("Metadata = %v", response.Metadata)
+	}
+	exp := response.Experiences[0]
+	if exp.FieldID != FieldTicketComment || exp.FieldType != "text" || exp.ValueText == nil || *exp.ValueText != "The export times out" {
+		t.Errorf("unexpected experience: %+v", exp)
+	}
+	if authors := commentAuthors(events); len(authors) != 2 {
+		t.Errorf("commentAuthors() = %v, want the authors of the public comments", authors)
+	}
+}
+
+func TestRatingResponse(t *testing.T) {
+	tests := []struct {
+		score       string
+		comment     string
+		good        bool
+		experiences int
+	}{
+		{score: "good", good: true, experiences: 1},
+		{score: "bad_with_comment", comment: "Took a week", experiences: 2},
+		{score: "offered"},
+		{score: "unoffered"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.score, func(t *testing.T) {
+			response := RatingResponse(SatisfactionRating{ID: 5, TicketID: 35, RequesterID: 7, AssigneeID: 9, Score: tt.score, Comment: tt.comment})
+			if tt.experiences == 0 {
+				if response != nil {
+					t.Errorf("RatingResponse() = %+v, want nil", response)
+				}
+				return
+			}
+			if response == nil || len(response.Experiences) != tt.experiences {
+				t.Fatalf("RatingResponse() = %+v, want %d experiences", response, tt.experiences)
+			}
+			if response.ID != "satisfaction-5" || response.Metadata["assignee_id"] != int64(9) {
+				t.Errorf("unexpected response: %+v", response)
+			}
+			if got := response.Experiences[0].ValueBoolean; got == nil || *got != tt.good {
+				t.Errorf("satisfaction = %v, want %v", got, tt.good)
+			}
+			if tt.comment != "" && *response.Experiences[1].ValueText != tt.comment {
+				t.Errorf("comment = %q, want %q", *response.Experiences[1].ValueText, tt.comment)
+			}
+		})
+	}
+}
+
+func TestClientSync(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, token, ok := r.BasicAuth(); !ok || user != "agent@acme.com/token" || token != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error": "Couldn't authenticate you"}`))
+			return
+		}
+		q := r.URL.Query()
+		switch r.URL.Path {
+		case "/api/v2/incremental/ticket_events.json":
+			if q.Get("start_time") != "1704067200" || q.Get("include") != "comment_events" {
+				t.Errorf("unexpected query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"end_time": 1705314600, "end_of_stream": false, "ticket_events": [
+				{"id": 1, "ticket_id": 35, "created_at": "2024-01-15T10:30:00Z", "child_events": [
+					{"id": 11, "event_type": "Comment", "author_id": 7, "plain_body": "The export times out", "public": true}
+				]}
+			]}`))
+		case "/api/v2/users/show_many.json":
+			if q.Get("ids") != "7" {
+				t.Errorf("unexpected users: %s", q.Get("ids"))
+			}
+			_, _ = w.Write([]byte(`{"users": [{"id": 7, "role": "end-user"}]}`))
+		case "/api/v2/satisfaction_ratings.json":
+			if q.Get("score") != "received" {
+				t.Errorf("unexpected query: %s", r.URL.RawQuery)
+			}
+			if q.Get("page[after]") == "" {
+				_, _ = w.Write([]byte(`{"meta": {"has_more": true, "after_cursor": "abc"}, "satisfaction_ratings": [
+					{"id": 5, "ticket_id": 35, "requester_id": 7, "score": "good", "created_at": "2024-01-10T00:00:00Z", "updated_at": "2024-01-10T00:00:00Z"}
+				]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"meta": {"has_more": false}, "satisfaction_ratings": [
+				{"id": 6, "ticket_id": 36, "requester_id": 8, "score": "bad", "created_at": "2024-01-12T00:00:00Z", "updated_at": "2024-01-12T00:00:00Z"}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("acme", "agent@acme.com", "token")
+	client.baseURL = server.URL
+	ctx := context.Background()
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	comments, err := client.SyncComments(ctx, "", since)
+	if err != nil {
+		t.Fatalf("SyncComments() error = %v", err)
+	}
+	if len(comments.Responses) != 1 || comments.Cursor != "1705314600" || !comments.More {
+		t.Errorf("unexpected page: %+v", comments)
+	}
+
+	ratings, err := client.SyncRatings(ctx, "", since)
+	if err != nil {
+		t.Fatalf("SyncRatings() error = %v", err)
+	}
+	if len(ratings.Responses) != 1 || ratings.Cursor != "1704067200:abc" || !ratings.More {
+		t.Errorf("unexpected first page: %+v", ratings)
+	}
+	ratings, err = client.SyncRatings(ctx, ratings.Cursor, since)
+	if err != nil {
+		t.Fatalf("SyncRatings() error = %v", err)
+	}
+	if len(ratings.Responses) != 1 || ratings.Cursor != "1705017600" || ratings.More {
+		t.Errorf("unexpected last page: %+v", ratings)
+	}
+
+	t.Run("recent cursor", func(t *testing.T) {
+		page, err := client.SyncComments(ctx, strconv.FormatInt(time.Now().Unix(), 10), since)
+		if err != nil || page.More || len(page.Responses) != 0 {
+			t.Errorf("SyncComments() = %+v, %v, want no export within the last minute", page, err)
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		wrong := NewClient("acme", "agent@acme.com", "wrong")
+		wrong.baseURL = server.URL
+		_, err := wrong.SyncRatings(ctx, "", since)
+		var apiErr *connector.APIError
+		if !errors.As(err, &apiErr) || apiErr.Status != http.StatusUnauthorized || apiErr.Message != "Couldn't authenticate you" {
+			t.Errorf("SyncRatings() error = %v, want a 401 APIError", err)
+		}
+	})
+}
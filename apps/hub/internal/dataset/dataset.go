@@ -0,0 +1,86 @@
+// Package dataset exports and imports the data of a Hub instance as gzip-compressed JSON
+// Lines, to move it between instances or PostgreSQL providers without pg_dump. The first
+// line is a header; every other line is a record with its kind and data:
+//
+//	{"kind":"header","format_version":1,"exported_at":"2026-10-16T12:00:00Z"}
+//	{"kind":"webhook_endpoint","data":{"id":"...","url":"...","secret":"..."}}
+//	{"kind":"question","data":{"id":"...","source_type":"survey","field_id":"q1","labels":{...}}}
+//	{"kind":"experience","data":{"id":"...","field_type":"text","value_text":"..."}}
+//	{"kind":"job","data":{"id":"...","experience_id":"...","job_type":"enrichment"}}
+//
+// Experiences include their enrichment and embedding, so nothing is re-sent to AI
+// providers. Operational data (audit logs, AI usage, webhook deliveries, worker
+// heartbeats, queue pauses) is not exported.
+package dataset
+
+import (
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/google/uuid"
+)
+
+// FormatVersion is increased when the export format changes incompatibly
+const FormatVersion = 1
+
+// batchSize is the number of records read or written per query
+const batchSize = 500
+
+// Record kinds
+const (
+	KindHeader          = "header"
+	KindWebhookEndpoint = "webhook_endpoint"
+	KindQuestion        = "question"
+	KindExperience      = "experience"
+	KindJob             = "job"
+)
+
+// header is the first line of an export
+type header struct {
+	Kind          string    `json:"kind"`
+	FormatVersion int       `json:"format_version"`
+	ExportedAt    time.Time `json:"exported_at"`
+}
+
+// record is a line of an export
+type record[T any] struct {
+	Kind string `json:"kind"`
+	Data T      `json:"data"`
+}
+
+// webhookEndpoint includes the secret and Slack bot token, which Ent omits from JSON, so
+// imported endpoints keep signing payloads with the same key and posting to Slack
+type webhookEndpoint struct {
+	*ent.WebhookEndpoint
+	Secret     string `json:"secret"`
+	SlackToken string `json:"slack_token,omitempty"`
+}
+
+// job is a queued or processed AI job
+type job struct {
+	ID               uuid.UUID         `json:"id"`
+	ExperienceID     uuid.UUID         `json:"experience_id"`
+	JobType          string            `json:"job_type"`
+	Status           string            `json:"status"`
+	Text             string            `json:"text"`
+	Error            *string           `json:"error,omitempty"`
+	ErrorHistory     []schema.JobError `json:"error_history,omitempty"`
+	Priority         int               `json:"priority"`
+	Attempts         int               `json:"attempts"`
+	CreatedAt        time.Time         `json:"created_at"`
+	ProcessedAt      *time.Time        `json:"processed_at,omitempty"`
+	PromptTokens     *int              `json:"prompt_tokens,omitempty"`
+	CompletionTokens *int              `json:"completion_tokens,omitempty"`
+	CostUsd          *float64          `json:"cost_usd,omitempty"`
+}
+
+// Counts are the number of records per kind
+type Counts struct {
+	WebhookEndpoints int
+	Questions        int
+	Experiences      int
+	Jobs             int
+	// Skipped records already existed in the database during an import
+	Skipped int
+}
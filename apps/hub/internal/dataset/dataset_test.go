@@ -0,0 +1,93 @@
+package dataset
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
+
+// gzipLines compresses JSON lines like an export
+func gzipLines(t *testing.T, lines ...string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(strings.Join(lines, "\n"))); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestImport_InvalidExports(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *bytes.Buffer
+		wantErr string
+	}{
+		{name: "not compressed", input: bytes.NewBufferString(`{"kind":"header","format_version":1}`), wantErr: "not a Hub export"},
+		{name: "missing header", input: gzipLines(t, `{"kind":"experience","data":{}}`), wantErr: "missing header"},
+		{name: "newer format", input: gzipLines(t, `{"kind":"header","format_version":99}`), wantErr: "upgrade Hub"},
+		{name: "unknown kind", input: gzipLines(t, `{"kind":"header","format_version":1}`, `{"kind":"survey","data":{}}`), wantErr: "unknown record kind"},
+		{name: "invalid record", input: gzipLines(t, `{"kind":"header","format_version":1}`, `{"kind":"job","data":{"id":"nope"}}`), wantErr: "invalid job record"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Import(context.Background(), nil, tt.input, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestImport_EmptyExport(t *testing.T) {
+	counts, err := Import(context.Background(), nil, gzipLines(t, `{"kind":"header","format_version":1}`), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if counts != (Counts{}) {
+		t.Errorf("expected no records, got %+v", counts)
+	}
+}
+
+func TestRecords_KeepSecretsAndEmbeddings(t *testing.T) {
+	endpoint := webhookEndpoint{
+		WebhookEndpoint: &ent.WebhookEndpoint{ID: uuid.New(), URL: "https://example.com/hook", Secret: "s3cret", Enabled: true},
+		Secret:          "s3cret",
+	}
+	data, err := json.Marshal(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded webhookEndpoint
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Secret != "s3cret" || decoded.URL != endpoint.URL || !decoded.Enabled {
+		t.Errorf("expected the endpoint with its secret, got %s", data)
+	}
+
+	vector := pgvector.NewVector([]float32{0.1, 0.2, 0.3})
+	text := "Great product"
+	exp := &ent.ExperienceData{ID: uuid.New(), FieldType: "text", ValueText: &text, Embedding: &vector}
+	data, err = json.Marshal(exp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decodedExp *ent.ExperienceData
+	if err := json.Unmarshal(data, &decodedExp); err != nil {
+		t.Fatal(err)
+	}
+	if decodedExp.Embedding == nil || len(decodedExp.Embedding.Slice()) != 3 || *decodedExp.ValueText != text {
+		t.Errorf("expected the experience with its embedding, got %s", data)
+	}
+}
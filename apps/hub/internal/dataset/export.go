@@ -0,0 +1,151 @@
+package dataset
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/google/uuid"
+)
+
+// ExportOptions selects the data to export in addition to the experiences
+type ExportOptions struct {
+	WebhookEndpoints bool
+	Jobs             bool
+}
+
+// Export writes the questions and experiences, and optionally the webhook endpoints and AI
+// jobs, to w. Questions are written before the experiences that reference them.
+// Records are read in ID order in batches, so exports of large datasets don't need much
+// memory; rows written while the export runs may or may not be included.
+func Export(ctx context.Context, client *ent.Client, w io.Writer, opts ExportOptions) (Counts, error) {
+	var counts Counts
+	zw := gzip.NewWriter(w)
+	enc := json.NewEncoder(zw)
+
+	if err := enc.Encode(header{Kind: KindHeader, FormatVersion: FormatVersion, ExportedAt: time.Now().UTC()}); err != nil {
+		return counts, err
+	}
+
+	var err error
+	if opts.WebhookEndpoints {
+		counts.WebhookEndpoints, err = exportAll(ctx, enc, KindWebhookEndpoint,
+			func(ctx context.Context, after uuid.UUID) ([]*ent.WebhookEndpoint, error) {
+				return client.WebhookEndpoint.Query().
+					Where(webhookendpoint.IDGT(after)).
+					Order(ent.Asc(webhookendpoint.FieldID)).
+					Limit(batchSize).
+					All(ctx)
+			},
+			func(e *ent.WebhookEndpoint) (uuid.UUID, webhookEndpoint) {
+				return e.ID, webhookEndpoint{WebhookEndpoint: e, Secret: e.Secret, SlackToken: e.SlackToken}
+			})
+		if err != nil {
+			return counts, err
+		}
+	}
+
+	counts.Questions, err = exportAll(ctx, enc, KindQuestion,
+		func(ctx context.Context, after uuid.UUID) ([]*ent.Question, error) {
+			return client.Question.Query().
+				Where(question.IDGT(after)).
+				Order(ent.Asc(question.FieldID)).
+				Limit(batchSize).
+				All(ctx)
+		},
+		func(q *ent.Question) (uuid.UUID, *ent.Question) {
+			return q.ID, q
+		})
+	if err != nil {
+		return counts, err
+	}
+
+	counts.Experiences, err = exportAll(ctx, enc, KindExperience,
+		func(ctx context.Context, after uuid.UUID) ([]*ent.ExperienceData, error) {
+			return client.ExperienceData.Query().
+				Where(experiencedata.IDGT(after)).
+				Order(ent.Asc(experiencedata.FieldID)).
+				Limit(batchSize).
+				All(ctx)
+		},
+		func(e *ent.ExperienceData) (uuid.UUID, *ent.ExperienceData) {
+			return e.ID, e
+		})
+	if err != nil {
+		return counts, err
+	}
+
+	if opts.Jobs {
+		counts.Jobs, err = exportAll(ctx, enc, KindJob,
+			func(ctx context.Context, after uuid.UUID) ([]*ent.EnrichmentJob, error) {
+				return client.EnrichmentJob.Query().
+					Where(enrichmentjob.IDGT(after)).
+					Order(ent.Asc(enrichmentjob.FieldID)).
+					Limit(batchSize).
+					All(ctx)
+			},
+			func(e *ent.EnrichmentJob) (uuid.UUID, job) {
+				return e.ID, job{
+					ID:               e.ID,
+					ExperienceID:     e.ExperienceID,
+					JobType:          e.JobType,
+					Status:           e.Status,
+					Text:             e.Text,
+					Error:            e.Error,
+					ErrorHistory:     e.ErrorHistory,
+					Priority:         e.Priority,
+					Attempts:         e.Attempts,
+					CreatedAt:        e.CreatedAt,
+					ProcessedAt:      e.ProcessedAt,
+					PromptTokens:     e.PromptTokens,
+					CompletionTokens: e.CompletionTokens,
+					CostUsd:          e.CostUsd,
+				}
+			})
+		if err != nil {
+			return counts, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return counts, fmt.Errorf("failed to write export: %w", err)
+	}
+	return counts, nil
+}
+
+// exportAll writes all records of a kind, reading them in batches after the last ID
+func exportAll[E any, T any](
+	ctx context.Context,
+	enc *json.Encoder,
+	kind string,
+	next func(ctx context.Context, after uuid.UUID) ([]E, error),
+	convert func(E) (uuid.UUID, T),
+) (int, error) {
+	count := 0
+	var after uuid.UUID
+	for {
+		batch, err := next(ctx, after)
+		if err != nil {
+			return count, fmt.Errorf("failed to read %s records: %w", kind, err)
+		}
+		if len(batch) == 0 {
+			return count, nil
+		}
+		for _, entity := range batch {
+			id, data := convert(entity)
+			if err := enc.Encode(record[T]{Kind: kind, Data: data}); err != nil {
+				return count, fmt.Errorf("failed to write export: %w", err)
+			}
+			after = id
+			count++
+		}
+	}
+}
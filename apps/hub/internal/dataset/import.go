@@ -0,0 +1,425 @@
+package dataset
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/questionbank"
+	"github.com/google/uuid"
+)
+
+// line is a record of any kind, decoded once its kind is known
+type line struct {
+	Kind string          `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Import reads an export from r and creates its records, calling report after every
+// batch. Records whose ID already exists are skipped, so an interrupted import can be
+// run again, and imports into an instance with its own data don't overwrite anything.
+func Import(ctx context.Context, client *ent.Client, r io.Reader, report func(Counts)) (Counts, error) {
+	var counts Counts
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return counts, fmt.Errorf("not a Hub export: %w", err)
+	}
+	defer func() { _ = zr.Close() }()
+	dec := json.NewDecoder(zr)
+
+	var h header
+	if err := dec.Decode(&h); err != nil || h.Kind != KindHeader {
+		return counts, errors.New("not a Hub export: missing header")
+	}
+	if h.FormatVersion > FormatVersion {
+		return counts, fmt.Errorf("export format version %d is newer than supported version %d; upgrade Hub first", h.FormatVersion, FormatVersion)
+	}
+
+	b := &batch{
+		client:      client,
+		counts:      &counts,
+		questionIDs: make(map[uuid.UUID]uuid.UUID),
+		resolver:    questionbank.NewResolver(client),
+	}
+	for {
+		var l line
+		err := dec.Decode(&l)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return counts, fmt.Errorf("failed to read export: %w", err)
+		}
+		if l.Kind != b.kind || b.size() >= batchSize {
+			if err := b.flush(ctx); err != nil {
+				return counts, err
+			}
+			if report != nil && b.kind != "" {
+				report(counts)
+			}
+			b.kind = l.Kind
+		}
+		if err := b.add(l); err != nil {
+			return counts, err
+		}
+	}
+	if err := b.flush(ctx); err != nil {
+		return counts, err
+	}
+	if report != nil {
+		report(counts)
+	}
+	return counts, nil
+}
+
+// batch collects records of one kind to create them with a single statement
+type batch struct {
+	client      *ent.Client
+	counts      *Counts
+	kind        string
+	endpoints   []webhookEndpoint
+	questions   []*ent.Question
+	experiences []*ent.ExperienceData
+	jobs        []job
+	// questionIDs maps the IDs of imported questions to their IDs in the database, which
+	// differ if the database already had a question with the same source and field
+	questionIDs map[uuid.UUID]uuid.UUID
+	// resolver links experiences whose question isn't in the export (e.g., older exports)
+	resolver *questionbank.Resolver
+}
+
+func (b *batch) size() int {
+	return len(b.endpoints) + len(b.questions) + len(b.experiences) + len(b.jobs)
+}
+
+// add decodes a record of the batch's kind
+func (b *batch) add(l line) error {
+	var err error
+	switch l.Kind {
+	case KindWebhookEndpoint:
+		var e webhookEndpoint
+		if err = json.Unmarshal(l.Data, &e); err == nil && e.WebhookEndpoint != nil {
+			b.endpoints = append(b.endpoints, e)
+		}
+	case KindQuestion:
+		var q *ent.Question
+		if err = json.Unmarshal(l.Data, &q); err == nil && q != nil {
+			b.questions = append(b.questions, q)
+		}
+	case KindExperience:
+		var e *ent.ExperienceData
+		if err = json.Unmarshal(l.Data, &e); err == nil && e != nil {
+			b.experiences = append(b.experiences, e)
+		}
+	case KindJob:
+		var j job
+		if err = json.Unmarshal(l.Data, &j); err == nil {
+			b.jobs = append(b.jobs, j)
+		}
+	default:
+		return fmt.Errorf("unknown record kind %q", l.Kind)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s record: %w", l.Kind, err)
+	}
+	return nil
+}
+
+// flush creates the records of the batch that don't exist yet
+func (b *batch) flush(ctx context.Context) error {
+	defer func() {
+		b.endpoints, b.questions, b.experiences, b.jobs = nil, nil, nil, nil
+	}()
+
+	switch {
+	case len(b.endpoints) > 0:
+		ids := make([]uuid.UUID, len(b.endpoints))
+		for i, e := range b.endpoints {
+			ids[i] = e.ID
+		}
+		existing, err := b.client.WebhookEndpoint.Query().Where(webhookendpoint.IDIn(ids...)).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check existing webhook endpoints: %w", err)
+		}
+		skip := idSet(existing)
+		var builders []*ent.WebhookEndpointCreate
+		for _, e := range b.endpoints {
+			if skip[e.ID] {
+				continue
+			}
+			create := b.client.WebhookEndpoint.Create().
+				SetID(e.ID).
+				SetURL(e.URL).
+				SetSecret(e.Secret).
+				SetEnabled(e.Enabled).
+				SetSlackToken(e.SlackToken).
+				SetSlackChannel(e.SlackChannel).
+				SetCreatedAt(e.CreatedAt).
+				SetUpdatedAt(e.UpdatedAt)
+			if e.Format != "" {
+				create.SetFormat(e.Format)
+			}
+			if e.EventTypes != nil {
+				create.SetEventTypes(e.EventTypes)
+			}
+			if e.Conditions != nil {
+				create.SetConditions(e.Conditions)
+			}
+			builders = append(builders, create)
+		}
+		if err := b.client.WebhookEndpoint.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to import webhook endpoints: %w", err)
+		}
+		b.counts.WebhookEndpoints += len(builders)
+		b.counts.Skipped += len(existing)
+
+	case len(b.questions) > 0:
+		if err := b.flushQuestions(ctx); err != nil {
+			return err
+		}
+
+	case len(b.experiences) > 0:
+		ids := make([]uuid.UUID, len(b.experiences))
+		for i, e := range b.experiences {
+			ids[i] = e.ID
+		}
+		existing, err := b.client.ExperienceData.Query().Where(experiencedata.IDIn(ids...)).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check existing experiences: %w", err)
+		}
+		skip := idSet(existing)
+		var builders []*ent.ExperienceDataCreate
+		for _, e := range b.experiences {
+			if skip[e.ID] {
+				continue
+			}
+			if err := b.linkQuestion(ctx, e); err != nil {
+				return err
+			}
+			builders = append(builders, createExperience(b.client, e))
+		}
+		if err := b.client.ExperienceData.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to import experiences: %w", err)
+		}
+		b.counts.Experiences += len(builders)
+		b.counts.Skipped += len(existing)
+
+	case len(b.jobs) > 0:
+		ids := make([]uuid.UUID, len(b.jobs))
+		for i, j := range b.jobs {
+			ids[i] = j.ID
+		}
+		existing, err := b.client.EnrichmentJob.Query().Where(enrichmentjob.IDIn(ids...)).IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check existing jobs: %w", err)
+		}
+		skip := idSet(existing)
+		var builders []*ent.EnrichmentJobCreate
+		for _, j := range b.jobs {
+			if skip[j.ID] {
+				continue
+			}
+			// No worker of this instance holds the job, so it goes back to the queue
+			status := j.Status
+			if status == "processing" {
+				status = "pending"
+			}
+			create := b.client.EnrichmentJob.Create().
+				SetID(j.ID).
+				SetExperienceID(j.ExperienceID).
+				SetJobType(j.JobType).
+				SetStatus(status).
+				SetText(j.Text).
+				SetNillableError(j.Error).
+				SetPriority(j.Priority).
+				SetAttempts(j.Attempts).
+				SetCreatedAt(j.CreatedAt).
+				SetNillableProcessedAt(j.ProcessedAt).
+				SetNillablePromptTokens(j.PromptTokens).
+				SetNillableCompletionTokens(j.CompletionTokens).
+				SetNillableCostUsd(j.CostUsd)
+			if j.ErrorHistory != nil {
+				create.SetErrorHistory(j.ErrorHistory)
+			}
+			builders = append(builders, create)
+		}
+		if err := b.client.EnrichmentJob.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to import jobs: %w", err)
+		}
+		b.counts.Jobs += len(builders)
+		b.counts.Skipped += len(existing)
+	}
+	return nil
+}
+
+// flushQuestions creates the questions of the batch that don't exist yet. A question whose
+// source and field already have a question in the database is merged into it.
+func (b *batch) flushQuestions(ctx context.Context) error {
+	ids := make([]uuid.UUID, len(b.questions))
+	for i, q := range b.questions {
+		ids[i] = q.ID
+	}
+	existing, err := b.client.Question.Query().Where(question.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check existing questions: %w", err)
+	}
+	skip := idSet(existing)
+	var builders []*ent.QuestionCreate
+	for _, q := range b.questions {
+		if skip[q.ID] {
+			b.questionIDs[q.ID] = q.ID
+			b.counts.Skipped++
+			continue
+		}
+		id, err := b.client.Question.Query().
+			Where(
+				question.SourceTypeEQ(q.SourceType),
+				question.SourceIDEQ(q.SourceID),
+				question.FieldIDEQ(q.FieldID),
+			).
+			OnlyID(ctx)
+		switch {
+		case err == nil:
+			b.questionIDs[q.ID] = id
+			b.counts.Skipped++
+			continue
+		case !ent.IsNotFound(err):
+			return fmt.Errorf("failed to check existing questions: %w", err)
+		}
+		b.questionIDs[q.ID] = q.ID
+		create := b.client.Question.Create().
+			SetID(q.ID).
+			SetSourceType(q.SourceType).
+			SetSourceID(q.SourceID).
+			SetFieldID(q.FieldID).
+			SetFieldType(q.FieldType).
+			SetLabel(q.Label).
+			SetCreatedAt(q.CreatedAt).
+			SetUpdatedAt(q.UpdatedAt)
+		if q.Labels != nil {
+			create.SetLabels(q.Labels)
+		}
+		if q.Metadata != nil {
+			create.SetMetadata(q.Metadata)
+		}
+		builders = append(builders, create)
+	}
+	if err := b.client.Question.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to import questions: %w", err)
+	}
+	b.counts.Questions += len(builders)
+	return nil
+}
+
+// linkQuestion sets the question of an experience to the imported question, or resolves
+// it by source and field if the export doesn't include it
+func (b *batch) linkQuestion(ctx context.Context, e *ent.ExperienceData) error {
+	if e.QuestionID != nil {
+		if id, ok := b.questionIDs[*e.QuestionID]; ok {
+			e.QuestionID = &id
+			return nil
+		}
+	}
+	id, err := b.resolver.Resolve(ctx, questionbank.Response{
+		Key:       questionbank.Key{SourceType: e.SourceType, SourceID: e.SourceID, FieldID: e.FieldID},
+		FieldType: e.FieldType,
+		Label:     e.FieldLabel,
+		Language:  e.Language,
+	})
+	if err != nil {
+		return err
+	}
+	e.QuestionID = &id
+	return nil
+}
+
+// createExperience returns a builder that creates the experience with all its fields
+func createExperience(client *ent.Client, e *ent.ExperienceData) *ent.ExperienceDataCreate {
+	create := client.ExperienceData.Create().
+		SetID(e.ID).
+		SetCollectedAt(e.CollectedAt).
+		SetCreatedAt(e.CreatedAt).
+		SetUpdatedAt(e.UpdatedAt).
+		SetSourceType(e.SourceType).
+		SetFieldID(e.FieldID).
+		SetFieldType(e.FieldType).
+		SetNillableQuestionID(e.QuestionID).
+		SetNillableValueText(e.ValueText).
+		SetNillableValueNumber(e.ValueNumber).
+		SetNillableValueBoolean(e.ValueBoolean).
+		SetNillableValueDate(e.ValueDate).
+		SetNillableNpsCategory(models.NPSCategory(e.FieldType, e.ValueNumber)).
+		SetNillableCountry(e.Country).
+		SetNillableRegion(e.Region).
+		SetNillableDevice(e.Device).
+		SetNillablePlatform(e.Platform).
+		SetNillableAppVersion(e.AppVersion).
+		SetNillableSentiment(e.Sentiment).
+		SetNillableSentimentScore(e.SentimentScore).
+		SetNillableEmotion(e.Emotion).
+		SetNillableIsSpam(e.IsSpam).
+		SetNillableSpamConfidence(e.SpamConfidence).
+		SetNillableUrgencyScore(e.UrgencyScore).
+		SetNillableEnrichmentProvider(e.EnrichmentProvider).
+		SetNillableEnrichmentModel(e.EnrichmentModel).
+		SetNillableEnrichmentVersion(e.EnrichmentVersion).
+		SetSkipAiProcessing(e.SkipAiProcessing).
+		SetNillableContentHash(e.ContentHash).
+		SetNillableDuplicateOf(e.DuplicateOf).
+		SetNillableIdempotencyKey(e.IdempotencyKey).
+		SetNillableAiInputHash(e.AiInputHash).
+		SetNillableEmbedding(e.Embedding).
+		SetNillableEmbeddingModel(e.EmbeddingModel)
+	// Unset optional strings stay NULL instead of becoming empty strings
+	if e.SourceID != "" {
+		create.SetSourceID(e.SourceID)
+	}
+	if e.SourceName != "" {
+		create.SetSourceName(e.SourceName)
+	}
+	if e.FieldLabel != "" {
+		create.SetFieldLabel(e.FieldLabel)
+	}
+	if e.Language != "" {
+		create.SetLanguage(e.Language)
+	}
+	if e.UserIdentifier != "" {
+		create.SetUserIdentifier(e.UserIdentifier)
+	}
+	if e.ValueJSON != nil {
+		create.SetValueJSON(e.ValueJSON)
+	}
+	if e.Metadata != nil {
+		create.SetMetadata(e.Metadata)
+	}
+	if e.Translations != nil {
+		create.SetTranslations(e.Translations)
+	}
+	if e.Topics != nil {
+		create.SetTopics(e.Topics)
+	}
+	if e.UrgencyReasons != nil {
+		create.SetUrgencyReasons(e.UrgencyReasons)
+	}
+	if e.EnrichmentAttributes != nil {
+		create.SetEnrichmentAttributes(e.EnrichmentAttributes)
+	}
+	return create
+}
+
+func idSet(ids []uuid.UUID) map[uuid.UUID]bool {
+	set := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
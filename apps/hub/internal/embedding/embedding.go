@@ -1,4 +1,4 @@
-// Package embedding provides vector embedding generation using OpenAI's embedding models.
+// Package embedding provides vector embedding generation using a configurable embedding provider (OpenAI or Gemini).
 // Embeddings are used for semantic search and are stored in PostgreSQL using pgvector.
 // All operations are designed to be called asynchronously by background workers.
 package embedding
@@ -9,9 +9,10 @@ import (
 	"log/slog"
 	"time"
 
-	"github.com/openai/openai-go/v3"
-	"github.com/openai/openai-go/v3/option"
 	"github.com/pgvector/pgvector-go"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
 )
 
 const (
@@ -21,25 +22,23 @@ const (
 
 // Service handles AI-powered text embedding generation
 type Service struct {
-	client  openai.Client
-	model   string
-	timeout time.Duration
-	logger  *slog.Logger
+	provider ai.EmbeddingProvider
+	timeout  time.Duration
+	logger   *slog.Logger
 }
 
-// NewService creates a new embedding service
-func NewService(apiKey string, model string, timeoutSeconds int, logger *slog.Logger) *Service {
+// NewService creates a new embedding service using the given embedding provider
+func NewService(provider ai.EmbeddingProvider, timeoutSeconds int, logger *slog.Logger) *Service {
 	return &Service{
-		client:  openai.NewClient(option.WithAPIKey(apiKey)),
-		model:   model,
-		timeout: time.Duration(timeoutSeconds) * time.Second,
-		logger:  logger,
+		provider: provider,
+		timeout:  time.Duration(timeoutSeconds) * time.Second,
+		logger:   logger,
 	}
 }
 
 // GenerateEmbedding creates an embedding vector for the given text
-// Returns a pgvector.Vector suitable for storage in PostgreSQL
-func (s *Service) GenerateEmbedding(ctx context.Context, text string) (pgvector.Vector, error) {
+// Returns a pgvector.Vector suitable for storage in PostgreSQL, along with the token usage
+func (s *Service) GenerateEmbedding(ctx context.Context, text string) (pgvector.Vector, ai.Usage, error) {
 	// Apply timeout
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
@@ -49,30 +48,18 @@ func (s *Service) GenerateEmbedding(ctx context.Context, text string) (pgvector.
 		text = text[:maxTextLength] + "..."
 	}
 
-	// Call OpenAI embeddings API
-	resp, err := s.client.Embeddings.New(ctx, openai.EmbeddingNewParams{
-		Input: openai.EmbeddingNewParamsInputUnion{
-			OfArrayOfStrings: []string{text},
-		},
-		Model: s.model,
-	})
-
+	// Request vectors sized for the embedding column so every provider's output fits
+	result, err := s.provider.Embed(ctx, text, schema.EmbeddingDimensions)
 	if err != nil {
-		return pgvector.Vector{}, fmt.Errorf("openai embeddings api error: %w", err)
-	}
-
-	if len(resp.Data) == 0 {
-		return pgvector.Vector{}, fmt.Errorf("no embeddings returned from openai")
+		return pgvector.Vector{}, ai.Usage{}, err
 	}
 
-	// Convert float64 slice to float32 for pgvector
-	embeddingData := resp.Data[0].Embedding
-	float32Slice := make([]float32, len(embeddingData))
-	for i, v := range embeddingData {
-		float32Slice[i] = float32(v)
+	if len(result.Values) != schema.EmbeddingDimensions {
+		return pgvector.Vector{}, result.Usage, fmt.Errorf("%s model %s returned %d dimensions, expected %d",
+			s.provider.Name(), s.provider.Model(), len(result.Values), schema.EmbeddingDimensions)
 	}
 
-	return pgvector.NewVector(float32Slice), nil
+	return pgvector.NewVector(result.Values), result.Usage, nil
 }
 
 // BuildEmbeddingText combines field label and value text for contextual embedding
@@ -86,5 +73,10 @@ func BuildEmbeddingText(fieldLabel, valueText string) string {
 
 // Model returns the model name being used
 func (s *Service) Model() string {
-	return s.model
+	return s.provider.Model()
+}
+
+// Provider returns the name of the embedding provider being used
+func (s *Service) Provider() string {
+	return s.provider.Name()
 }
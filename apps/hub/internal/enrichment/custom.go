@@ -0,0 +1,197 @@
+package enrichment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+)
+
+const (
+	// maxCustomResponseSize limits how much of a custom enricher response is read
+	maxCustomResponseSize = 1 << 20
+	// maxCustomErrorBodySize limits how much of an error response body is included in errors
+	maxCustomErrorBodySize = 1024
+)
+
+// Attribute types accepted in a custom enricher attribute schema
+const (
+	AttributeTypeString      = "string"
+	AttributeTypeNumber      = "number"
+	AttributeTypeBoolean     = "boolean"
+	AttributeTypeStringArray = "string_array"
+)
+
+// CustomEnricher sends texts to a team-provided HTTP endpoint instead of an LLM.
+// The endpoint receives {"text": "..."} and responds with any of the standard enrichment
+// keys (sentiment, topics, is_spam, ...) plus an "attributes" object. Attributes are only
+// stored if they are declared in the attribute schema with a matching type.
+type CustomEnricher struct {
+	url        string
+	token      string
+	model      string
+	attributes map[string]string
+	httpClient *http.Client
+	logger     *slog.Logger
+}
+
+// NewCustomEnricher creates a custom enricher for the endpoint. attributes is the schema of
+// accepted attributes as comma-separated name:type pairs (e.g., "intent:string,is_lead:boolean").
+func NewCustomEnricher(url, token, model, attributes string, logger *slog.Logger) (*CustomEnricher, error) {
+	if url == "" {
+		return nil, fmt.Errorf("custom enrichment provider requires an endpoint URL")
+	}
+
+	schema, err := ParseAttributeSchema(attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CustomEnricher{
+		url:        url,
+		token:      token,
+		model:      model,
+		attributes: schema,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}, nil
+}
+
+// ParseAttributeSchema parses comma-separated name:type pairs into a map of attribute types
+func ParseAttributeSchema(schema string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, entry := range strings.Split(schema, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, attrType, ok := strings.Cut(entry, ":")
+		name = strings.TrimSpace(name)
+		attrType = strings.ToLower(strings.TrimSpace(attrType))
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid custom attribute %q: expected name:type", entry)
+		}
+
+		switch attrType {
+		case AttributeTypeString, AttributeTypeNumber, AttributeTypeBoolean, AttributeTypeStringArray:
+			result[name] = attrType
+		default:
+			return nil, fmt.Errorf("invalid type %q for custom attribute %s (supported: string, number, boolean, string_array)", attrType, name)
+		}
+	}
+	return result, nil
+}
+
+// Name returns the provider name
+func (c *CustomEnricher) Name() string {
+	return ai.ProviderCustom
+}
+
+// Model returns the name recorded as the enrichment model
+func (c *CustomEnricher) Model() string {
+	return c.model
+}
+
+// Enrich sends the text to the endpoint and returns the parsed and validated result.
+// Standard keys are not yet normalized.
+func (c *CustomEnricher) Enrich(ctx context.Context, text string) (*Enrichment, error) {
+	payload, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal custom enricher request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom enricher request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("custom enricher error: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxCustomErrorBodySize))
+		err := fmt.Errorf("custom enricher error: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &ai.RateLimitError{Provider: ai.ProviderCustom, RetryAfter: ai.ParseRetryAfter(resp.Header), Err: err}
+		}
+		return nil, err
+	}
+
+	var body struct {
+		Enrichment
+		Attributes map[string]any `json:"attributes"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxCustomResponseSize)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode custom enricher response: %w", err)
+	}
+
+	result := body.Enrichment
+	result.Attributes = c.validateAttributes(body.Attributes)
+	return &result, nil
+}
+
+// validateAttributes keeps the attributes that are declared in the schema with a matching type
+func (c *CustomEnricher) validateAttributes(attributes map[string]any) map[string]any {
+	valid := make(map[string]any)
+	for name, value := range attributes {
+		attrType, ok := c.attributes[name]
+		if !ok {
+			c.logger.Debug("dropping undeclared custom attribute", "attribute", name)
+			continue
+		}
+		if !matchesAttributeType(value, attrType) {
+			c.logger.Warn("dropping custom attribute with invalid type",
+				"attribute", name,
+				"expected_type", attrType)
+			continue
+		}
+		valid[name] = value
+	}
+
+	if len(valid) == 0 {
+		return nil
+	}
+	return valid
+}
+
+// matchesAttributeType reports whether a decoded JSON value has the given attribute type
+func matchesAttributeType(value any, attrType string) bool {
+	switch attrType {
+	case AttributeTypeString:
+		_, ok := value.(string)
+		return ok
+	case AttributeTypeNumber:
+		_, ok := value.(float64)
+		return ok
+	case AttributeTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case AttributeTypeStringArray:
+		items, ok := value.([]any)
+		if !ok {
+			return false
+		}
+		for _, item := range items {
+			if _, ok := item.(string); !ok {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
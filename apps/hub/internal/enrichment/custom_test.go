@@ -0,0 +1,130 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+)
+
+func TestParseAttributeSchema(t *testing.T) {
+	schema, err := ParseAttributeSchema("intent:string, priority:number,is_lead:BOOLEAN,labels:string_array")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{
+		"intent":   AttributeTypeString,
+		"priority": AttributeTypeNumber,
+		"is_lead":  AttributeTypeBoolean,
+		"labels":   AttributeTypeStringArray,
+	}
+	for name, attrType := range want {
+		if schema[name] != attrType {
+			t.Errorf("expected %s to be %s, got %q", name, attrType, schema[name])
+		}
+	}
+
+	for _, invalid := range []string{"intent", ":string", "intent:object"} {
+		if _, err := ParseAttributeSchema(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestCustomEnricher(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("stores declared attributes with valid types", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				t.Errorf("expected bearer token, got %q", r.Header.Get("Authorization"))
+			}
+			var req map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req["text"] != "Please cancel my plan" {
+				t.Errorf("unexpected text %q", req["text"])
+			}
+			_, _ = w.Write([]byte(`{
+				"sentiment": "negative",
+				"topics": ["cancellation"],
+				"attributes": {"intent": "cancel", "priority": "high", "labels": ["billing"], "internal": true}
+			}`))
+		}))
+		defer server.Close()
+
+		custom, err := NewCustomEnricher(server.URL, "secret", "churn-classifier-v2", "intent:string,priority:number,labels:string_array", logger)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		svc := NewCustomService(custom, nil, 5, logger)
+
+		result, err := svc.EnrichText(context.Background(), "Please cancel my plan")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Provider != ai.ProviderCustom || result.Model != "churn-classifier-v2" {
+			t.Errorf("unexpected provider/model %s/%s", result.Provider, result.Model)
+		}
+		if result.Sentiment != "negative" {
+			t.Errorf("expected negative sentiment, got %s", result.Sentiment)
+		}
+		if result.Attributes["intent"] != "cancel" {
+			t.Errorf("expected intent attribute, got %v", result.Attributes)
+		}
+		if _, ok := result.Attributes["priority"]; ok {
+			t.Error("expected priority with invalid type to be dropped")
+		}
+		if _, ok := result.Attributes["internal"]; ok {
+			t.Error("expected undeclared attribute to be dropped")
+		}
+		if _, ok := result.Attributes["labels"]; !ok {
+			t.Error("expected labels attribute to be kept")
+		}
+	})
+
+	t.Run("falls back to chat provider on error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		custom, err := NewCustomEnricher(server.URL, "", "custom", "", logger)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		fallback := &fakeChat{name: "openai", content: validResponse}
+		svc := NewCustomService(custom, []ai.ChatProvider{fallback}, 5, logger)
+
+		result, err := svc.EnrichText(context.Background(), "Way too expensive")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Provider != "openai" {
+			t.Errorf("expected provider openai, got %s", result.Provider)
+		}
+	})
+
+	t.Run("reports rate limits", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		custom, err := NewCustomEnricher(server.URL, "", "custom", "", logger)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		svc := NewCustomService(custom, nil, 5, logger)
+
+		_, err = svc.EnrichText(context.Background(), "Way too expensive")
+		if _, ok := ai.IsRateLimitError(err); !ok {
+			t.Errorf("expected rate limit error, got %v", err)
+		}
+	})
+}
@@ -1,4 +1,4 @@
-// Package enrichment provides AI-powered text analysis using OpenAI's API.
+// Package enrichment provides AI-powered text analysis using a configurable chat provider (OpenAI or Gemini).
 // It extracts sentiment, emotion, and topics from open-ended text feedback.
 // All operations are designed to be called asynchronously by background workers.
 package enrichment
@@ -8,123 +8,352 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
-	"github.com/openai/openai-go/v3"
-	"github.com/openai/openai-go/v3/option"
-	"github.com/openai/openai-go/v3/shared"
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/config"
 )
 
 const (
 	// maxTextLength is the maximum text length before truncation (1000 chars ≈ 250 tokens)
 	maxTextLength = 1000
-	// maxTopics is the maximum number of topics to return
-	maxTopics = 5
-	// defaultTemperature is the default temperature for OpenAI models that support it
-	defaultTemperature = 0.0
+	// MaxTopics is the maximum number of topics to return
+	MaxTopics = 5
+	// MaxBatchTextLength is the maximum length of a text that is batched with others;
+	// longer texts are enriched in a request of their own
+	MaxBatchTextLength = 280
 )
 
+// Urgency reasons returned by the model to explain an urgency score
+const (
+	UrgencyReasonChurnRisk     = "churn_risk"
+	UrgencyReasonBugReport     = "bug_report"
+	UrgencyReasonLegalThreat   = "legal_threat"
+	UrgencyReasonSecurityIssue = "security_issue"
+	UrgencyReasonBillingIssue  = "billing_issue"
+	UrgencyReasonOutage        = "outage"
+)
+
+// validUrgencyReasons is the set of urgency reasons accepted from the model
+var validUrgencyReasons = map[string]bool{
+	UrgencyReasonChurnRisk:     true,
+	UrgencyReasonBugReport:     true,
+	UrgencyReasonLegalThreat:   true,
+	UrgencyReasonSecurityIssue: true,
+	UrgencyReasonBillingIssue:  true,
+	UrgencyReasonOutage:        true,
+}
+
 // Enrichment holds the structured AI analysis results
 type Enrichment struct {
-	Sentiment      string   `json:"sentiment"`       // positive, negative, neutral
-	SentimentScore float64  `json:"sentiment_score"` // -1 to +1
-	Emotion        string   `json:"emotion"`         // joy, anger, frustration, sadness, neutral
-	Topics         []string `json:"topics"`          // key themes
+	Sentiment      string         `json:"sentiment"`       // positive, negative, neutral
+	SentimentScore float64        `json:"sentiment_score"` // -1 to +1
+	Emotion        string         `json:"emotion"`         // joy, anger, frustration, sadness, neutral
+	Topics         []string       `json:"topics"`          // key themes
+	IsSpam         bool           `json:"is_spam"`         // gibberish, keyboard mash, or bot-like response
+	SpamConfidence float64        `json:"spam_confidence"` // 0 to 1
+	UrgencyScore   float64        `json:"urgency_score"`   // 0 (routine) to 1 (needs immediate attention)
+	UrgencyReasons []string       `json:"urgency_reasons"` // churn_risk, bug_report, legal_threat, ...
+	Attributes     map[string]any `json:"-"`               // schema-validated attributes from a custom enricher
+	Provider       string         `json:"-"`               // provider that produced the result
+	Model          string         `json:"-"`               // model that produced the result
+	Usage          ai.Usage       `json:"-"`               // token usage of the successful request
 }
 
 // Service handles AI-powered text enrichment
 type Service struct {
-	client  openai.Client
-	model   string
-	timeout time.Duration
-	logger  *slog.Logger
+	custom    *CustomEnricher
+	providers []ai.ChatProvider
+	timeout   time.Duration
+	logger    *slog.Logger
+}
+
+// NewService creates a new enrichment service. Providers are tried in order: the first is
+// the primary provider and the rest are fallbacks used when a previous provider fails.
+func NewService(providers []ai.ChatProvider, timeoutSeconds int, logger *slog.Logger) *Service {
+	return &Service{
+		providers: providers,
+		timeout:   time.Duration(timeoutSeconds) * time.Second,
+		logger:    logger,
+	}
 }
 
-// NewService creates a new enrichment service
-func NewService(apiKey string, model string, timeoutSeconds int, logger *slog.Logger) *Service {
+// NewCustomService creates an enrichment service backed by a custom enricher endpoint.
+// The chat providers, if any, are used as fallbacks when the endpoint fails.
+func NewCustomService(custom *CustomEnricher, fallbacks []ai.ChatProvider, timeoutSeconds int, logger *slog.Logger) *Service {
 	return &Service{
-		client:  openai.NewClient(option.WithAPIKey(apiKey)),
-		model:   model,
-		timeout: time.Duration(timeoutSeconds) * time.Second,
-		logger:  logger,
+		custom:    custom,
+		providers: fallbacks,
+		timeout:   time.Duration(timeoutSeconds) * time.Second,
+		logger:    logger,
+	}
+}
+
+// NewServiceFromConfig creates the enrichment service for the configured provider and fallbacks
+func NewServiceFromConfig(cfg *config.Config, logger *slog.Logger) (*Service, error) {
+	chatProviders, err := ai.NewChatProviders(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.EnrichmentProvider != ai.ProviderCustom {
+		return NewService(chatProviders, cfg.EnrichmentTimeout, logger), nil
+	}
+
+	custom, err := NewCustomEnricher(
+		cfg.CustomEnricherURL,
+		cfg.CustomEnricherToken,
+		cfg.CustomEnricherModel,
+		cfg.CustomEnricherAttributes,
+		logger,
+	)
+	if err != nil {
+		return nil, err
 	}
+	return NewCustomService(custom, chatProviders, cfg.EnrichmentTimeout, logger), nil
 }
 
-// EnrichText analyzes text and extracts structured insights
+// EnrichText analyzes text and extracts structured insights.
+// If a provider errors (e.g., outage or rate limit) or returns an unparseable response,
+// the next configured provider is tried. The provider that produced the result is
+// recorded in Enrichment.Provider.
 func (s *Service) EnrichText(ctx context.Context, text string) (*Enrichment, error) {
-	// Apply timeout
-	ctx, cancel := context.WithTimeout(ctx, s.timeout)
-	defer cancel()
+	if s.custom != nil {
+		result, err := s.enrichCustom(ctx, text)
+		if err == nil || len(s.providers) == 0 {
+			return result, err
+		}
+		s.logger.Warn("custom enricher failed, trying fallback",
+			"fallback_provider", s.providers[0].Name(),
+			"fallback_model", s.providers[0].Model(),
+			"error", err)
+	}
 
 	prompt := s.buildPrompt(text)
 
-	params := openai.ChatCompletionNewParams{
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			{
-				OfUser: &openai.ChatCompletionUserMessageParam{
-					Content: openai.ChatCompletionUserMessageParamContentUnion{
-						OfString: openai.String(prompt),
-					},
-				},
-			},
-		},
-		Model: shared.ChatModel(s.model),
+	var result *Enrichment
+	err := s.withFallback(ctx, func(provider ai.ChatProvider) error {
+		resp, err := s.complete(ctx, provider, prompt)
+		if err != nil {
+			return err
+		}
+
+		var enrichment Enrichment
+		if err := json.Unmarshal([]byte(resp.Content), &enrichment); err != nil {
+			s.logger.Warn("failed to parse enrichment response", "error", err, "content", resp.Content, "provider", provider.Name())
+			return fmt.Errorf("failed to parse response from %s: %w", provider.Name(), err)
+		}
+
+		result = s.finalize(text, enrichment, provider.Name(), provider.Model())
+		result.Usage = resp.Usage
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Only set temperature for models that support it (gpt-5-mini requires default temperature=1)
-	if s.model != "gpt-5-mini" {
-		params.Temperature = openai.Float(defaultTemperature)
+	return result, nil
+}
+
+// BatchResult holds the enrichments of a batch of texts produced by a single request
+type BatchResult struct {
+	// Results are in the order of the input texts; nil where the model returned no usable result
+	Results  []*Enrichment
+	Provider string
+	Model    string
+	Usage    ai.Usage
+}
+
+// EnrichTexts analyzes several short texts in a single request, which is much cheaper
+// than one request per text for one-line survey answers. Providers are tried in order
+// like in EnrichText. Items the model skipped are left nil so the caller can enrich them
+// individually.
+func (s *Service) EnrichTexts(ctx context.Context, texts []string) (*BatchResult, error) {
+	prompt := s.buildBatchPrompt(texts)
+
+	var result *BatchResult
+	err := s.withFallback(ctx, func(provider ai.ChatProvider) error {
+		resp, err := s.complete(ctx, provider, prompt)
+		if err != nil {
+			return err
+		}
+
+		var parsed struct {
+			Results []struct {
+				ID int `json:"id"`
+				Enrichment
+			} `json:"results"`
+		}
+		if err := json.Unmarshal([]byte(resp.Content), &parsed); err != nil {
+			s.logger.Warn("failed to parse batch enrichment response", "error", err, "content", resp.Content, "provider", provider.Name())
+			return fmt.Errorf("failed to parse batch response from %s: %w", provider.Name(), err)
+		}
+
+		result = &BatchResult{
+			Results:  make([]*Enrichment, len(texts)),
+			Provider: provider.Name(),
+			Model:    provider.Model(),
+			Usage:    resp.Usage,
+		}
+		for _, item := range parsed.Results {
+			// Items are numbered from 1 in the prompt
+			index := item.ID - 1
+			if index < 0 || index >= len(texts) || result.Results[index] != nil {
+				continue
+			}
+			result.Results[index] = s.finalize(texts[index], item.Enrichment, provider.Name(), provider.Model())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := s.client.Chat.Completions.New(ctx, params)
+	return result, nil
+}
+
+// enrichCustom sends the text to the custom enricher endpoint
+func (s *Service) enrichCustom(ctx context.Context, text string) (*Enrichment, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
 
+	result, err := s.custom.Enrich(ctx, text)
 	if err != nil {
-		return nil, fmt.Errorf("openai api error: %w", err)
+		return nil, err
 	}
 
-	if len(resp.Choices) == 0 {
-		return nil, fmt.Errorf("no response from openai")
+	return s.finalize(text, *result, s.custom.Name(), s.custom.Model()), nil
+}
+
+// SupportsBatch reports whether several texts can be analyzed in a single request.
+// Custom enricher endpoints receive one text at a time.
+func (s *Service) SupportsBatch() bool {
+	return s.custom == nil
+}
+
+// withFallback calls fn with each provider in order until one succeeds
+func (s *Service) withFallback(ctx context.Context, fn func(provider ai.ChatProvider) error) error {
+	if len(s.providers) == 0 {
+		return fmt.Errorf("no enrichment provider configured")
 	}
 
-	content := resp.Choices[0].Message.Content
+	var lastErr error
+	for i, provider := range s.providers {
+		if i > 0 {
+			if ctx.Err() != nil {
+				break
+			}
+			s.logger.Warn("enrichment provider failed, trying fallback",
+				"failed_provider", s.providers[i-1].Name(),
+				"fallback_provider", provider.Name(),
+				"fallback_model", provider.Model(),
+				"error", lastErr)
+		}
 
-	var enrichment Enrichment
-	if err := json.Unmarshal([]byte(content), &enrichment); err != nil {
-		s.logger.Warn("failed to parse enrichment response", "error", err, "content", content)
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		if err := fn(provider); err != nil {
+			lastErr = err
+			continue
+		}
+		return nil
 	}
 
-	// Validate and normalize
-	enrichment = s.normalizeEnrichment(enrichment)
+	return lastErr
+}
+
+// complete runs the prompt against a single provider and strips any code fence from the response
+func (s *Service) complete(ctx context.Context, provider ai.ChatProvider, prompt string) (*ai.Completion, error) {
+	// Apply timeout per provider so a slow primary doesn't starve the fallbacks
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	resp, err := provider.Complete(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
 
-	return &enrichment, nil
+	resp.Content = stripCodeFence(resp.Content)
+	return resp, nil
 }
 
-// buildPrompt creates the LLM prompt for text analysis
-func (s *Service) buildPrompt(text string) string {
-	// Truncate very long text to avoid token limits
-	if len(text) > maxTextLength {
-		text = text[:maxTextLength] + "..."
+// finalize validates a parsed enrichment and fills in what the model left out
+func (s *Service) finalize(text string, e Enrichment, provider, model string) *Enrichment {
+	// Validate and normalize
+	e = s.normalizeEnrichment(e)
+	e.Provider = provider
+	e.Model = model
+
+	// Never leave topics empty just because the model returned none
+	if len(e.Topics) == 0 {
+		e.Topics = ExtractKeywords(text, MaxTopics)
 	}
 
-	return fmt.Sprintf(`You are a feedback analysis assistant. Analyze the following feedback and output JSON with these exact keys:
+	// Combine the model's spam verdict with local heuristics, which are more
+	// reliable for obvious keyboard mashes the model sometimes tries to interpret
+	e = applySpamHeuristics(text, e)
+	return &e
+}
 
-{
+// enrichmentKeys describes the JSON keys of a single enrichment result
+const enrichmentKeys = `{
   "sentiment": "positive" | "negative" | "neutral",
   "sentiment_score": number between -1.0 (very negative) and 1.0 (very positive),
   "emotion": "joy" | "anger" | "frustration" | "sadness" | "neutral",
-  "topics": array of 2-4 short topic keywords (e.g., ["pricing", "UI", "performance"])
-}
+  "topics": array of 2-4 short topic keywords (e.g., ["pricing", "UI", "performance"]),
+  "is_spam": true if the response is gibberish, keyboard mashing, random characters, advertising, or otherwise bot-like and not genuine feedback,
+  "spam_confidence": number between 0.0 and 1.0 indicating how certain you are about is_spam,
+  "urgency_score": number between 0.0 (routine) and 1.0 (needs immediate attention from the support team),
+  "urgency_reasons": array of zero or more of "churn_risk", "bug_report", "legal_threat", "security_issue", "billing_issue", "outage"
+}`
 
-Rules:
+// enrichmentRules are the analysis rules shared by the single and batch prompts
+const enrichmentRules = `Rules:
 - Output ONLY valid JSON, no additional text
 - Use lowercase for sentiment and emotion
 - Topics should be concise keywords, not full sentences
 - If unclear, default to "neutral" sentiment and 0.0 score
 - If a question is provided, use it as context for topic extraction
+- Short but genuine answers (e.g., "ok", "no", "N/A") are not spam
+- Raise urgency for cancellation intent, broken functionality, legal or security concerns, and payment problems`
+
+// buildPrompt creates the LLM prompt for text analysis
+func (s *Service) buildPrompt(text string) string {
+	// Truncate very long text to avoid token limits
+	if len(text) > maxTextLength {
+		text = text[:maxTextLength] + "..."
+	}
+
+	return fmt.Sprintf(`You are a feedback analysis assistant. Analyze the following feedback and output JSON with these exact keys:
+
+%s
+
+%s
 
 Feedback:
-"%s"`, text)
+"%s"`, enrichmentKeys, enrichmentRules, text)
+}
+
+// buildBatchPrompt creates the LLM prompt for analyzing several texts in one request
+func (s *Service) buildBatchPrompt(texts []string) string {
+	var items strings.Builder
+	for i, text := range texts {
+		if len(text) > maxTextLength {
+			text = text[:maxTextLength] + "..."
+		}
+		// JSON-encode each item so quotes and newlines can't break the list
+		encoded, _ := json.Marshal(text)
+		fmt.Fprintf(&items, "%d. %s\n", i+1, encoded)
+	}
+
+	return fmt.Sprintf(`You are a feedback analysis assistant. Analyze each of the following %d feedback items independently and output JSON of the form {"results": [...]} with exactly one object per item. Each object has an "id" key with the item's number and these exact keys:
+
+%s
+
+%s
+- Analyze each item on its own; other items must not influence the result
+
+Feedback items:
+%s`, len(texts), enrichmentKeys, enrichmentRules, items.String())
 }
 
 // normalizeEnrichment validates and normalizes the enrichment data
@@ -154,14 +383,78 @@ func (s *Service) normalizeEnrichment(e Enrichment) Enrichment {
 	}
 
 	// Limit topics to maximum allowed
-	if len(e.Topics) > maxTopics {
-		e.Topics = e.Topics[:maxTopics]
+	if len(e.Topics) > MaxTopics {
+		e.Topics = e.Topics[:MaxTopics]
+	}
+
+	// Clamp spam confidence
+	if e.SpamConfidence < 0.0 {
+		e.SpamConfidence = 0.0
+	} else if e.SpamConfidence > 1.0 {
+		e.SpamConfidence = 1.0
 	}
 
+	// Clamp urgency score
+	if e.UrgencyScore < 0.0 {
+		e.UrgencyScore = 0.0
+	} else if e.UrgencyScore > 1.0 {
+		e.UrgencyScore = 1.0
+	}
+
+	// Keep only known urgency reasons
+	reasons := make([]string, 0, len(e.UrgencyReasons))
+	for _, reason := range e.UrgencyReasons {
+		if validUrgencyReasons[reason] {
+			reasons = append(reasons, reason)
+		}
+	}
+	e.UrgencyReasons = reasons
+
 	return e
 }
 
-// Model returns the model name being used
+// Model returns the model of the primary provider
 func (s *Service) Model() string {
-	return s.model
+	if s.custom != nil {
+		return s.custom.Model()
+	}
+	if len(s.providers) == 0 {
+		return ""
+	}
+	return s.providers[0].Model()
+}
+
+// Models returns the models of the primary provider and its fallbacks
+func (s *Service) Models() []string {
+	models := make([]string, 0, len(s.providers)+1)
+	if s.custom != nil {
+		models = append(models, s.custom.Model())
+	}
+	for _, provider := range s.providers {
+		models = append(models, provider.Model())
+	}
+	return models
+}
+
+// Provider returns the name of the primary provider
+func (s *Service) Provider() string {
+	if s.custom != nil {
+		return s.custom.Name()
+	}
+	if len(s.providers) == 0 {
+		return ""
+	}
+	return s.providers[0].Name()
+}
+
+// stripCodeFence removes a Markdown code fence some models wrap around JSON output
+func stripCodeFence(content string) string {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, "```") {
+		return content
+	}
+	content = strings.TrimPrefix(content, "```json")
+	content = strings.TrimPrefix(content, "```")
+	content = strings.TrimSuffix(content, "```")
+	return strings.TrimSpace(content)
 }
@@ -0,0 +1,157 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+)
+
+// fakeChat is a ChatProvider returning a fixed response or error
+type fakeChat struct {
+	name    string
+	content string
+	err     error
+	calls   int
+}
+
+func (f *fakeChat) Name() string  { return f.name }
+func (f *fakeChat) Model() string { return f.name + "-model" }
+
+func (f *fakeChat) Complete(_ context.Context, _ string) (*ai.Completion, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ai.Completion{Content: f.content}, nil
+}
+
+const validResponse = `{"sentiment":"negative","sentiment_score":-0.6,"emotion":"frustration","topics":["pricing"]}`
+
+func TestEnrichTextFallback(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("uses primary when it succeeds", func(t *testing.T) {
+		primary := &fakeChat{name: "openai", content: validResponse}
+		fallback := &fakeChat{name: "gemini", content: validResponse}
+		svc := NewService([]ai.ChatProvider{primary, fallback}, 5, logger)
+
+		result, err := svc.EnrichText(context.Background(), "Way too expensive")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Provider != "openai" {
+			t.Errorf("expected provider openai, got %s", result.Provider)
+		}
+		if fallback.calls != 0 {
+			t.Errorf("expected fallback not to be called, got %d calls", fallback.calls)
+		}
+	})
+
+	t.Run("falls back on provider error", func(t *testing.T) {
+		primary := &fakeChat{name: "openai", err: errors.New("429 too many requests")}
+		fallback := &fakeChat{name: "gemini", content: validResponse}
+		svc := NewService([]ai.ChatProvider{primary, fallback}, 5, logger)
+
+		result, err := svc.EnrichText(context.Background(), "Way too expensive")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Provider != "gemini" {
+			t.Errorf("expected provider gemini, got %s", result.Provider)
+		}
+		if result.Sentiment != "negative" {
+			t.Errorf("expected negative sentiment, got %s", result.Sentiment)
+		}
+	})
+
+	t.Run("falls back on unparseable response", func(t *testing.T) {
+		primary := &fakeChat{name: "openai", content: "not json"}
+		fallback := &fakeChat{name: "gemini", content: "```json\n" + validResponse + "\n```"}
+		svc := NewService([]ai.ChatProvider{primary, fallback}, 5, logger)
+
+		result, err := svc.EnrichText(context.Background(), "Way too expensive")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Provider != "gemini" {
+			t.Errorf("expected provider gemini, got %s", result.Provider)
+		}
+	})
+
+	t.Run("returns last error when all providers fail", func(t *testing.T) {
+		primary := &fakeChat{name: "openai", err: errors.New("outage")}
+		fallback := &fakeChat{name: "gemini", err: errors.New("quota exceeded")}
+		svc := NewService([]ai.ChatProvider{primary, fallback}, 5, logger)
+
+		_, err := svc.EnrichText(context.Background(), "Way too expensive")
+		if err == nil || err.Error() != "quota exceeded" {
+			t.Errorf("expected last provider error, got %v", err)
+		}
+	})
+}
+
+func TestEnrichTexts(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("maps results to texts by id", func(t *testing.T) {
+		provider := &fakeChat{name: "openai", content: `{"results":[
+			{"id":2,"sentiment":"positive","sentiment_score":0.8,"emotion":"joy","topics":["support"]},
+			{"id":1,"sentiment":"negative","sentiment_score":-0.6,"emotion":"frustration","topics":["pricing"]}
+		]}`}
+		svc := NewService([]ai.ChatProvider{provider}, 5, logger)
+
+		result, err := svc.EnrichTexts(context.Background(), []string{"Way too expensive", "Support was great"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if provider.calls != 1 {
+			t.Errorf("expected a single request, got %d", provider.calls)
+		}
+		if len(result.Results) != 2 {
+			t.Fatalf("expected 2 results, got %d", len(result.Results))
+		}
+		if result.Results[0].Sentiment != "negative" || result.Results[1].Sentiment != "positive" {
+			t.Errorf("results not matched to texts: %+v, %+v", result.Results[0], result.Results[1])
+		}
+		if result.Results[0].Provider != "openai" {
+			t.Errorf("expected provider openai, got %s", result.Results[0].Provider)
+		}
+	})
+
+	t.Run("leaves missing and invalid ids empty", func(t *testing.T) {
+		provider := &fakeChat{name: "openai", content: `{"results":[
+			{"id":1,"sentiment":"neutral","topics":["onboarding"]},
+			{"id":7,"sentiment":"positive"}
+		]}`}
+		svc := NewService([]ai.ChatProvider{provider}, 5, logger)
+
+		result, err := svc.EnrichTexts(context.Background(), []string{"It was ok", "Love it", "Meh"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Results[0] == nil {
+			t.Fatal("expected a result for the first text")
+		}
+		if result.Results[1] != nil || result.Results[2] != nil {
+			t.Errorf("expected no results for missing ids, got %+v, %+v", result.Results[1], result.Results[2])
+		}
+	})
+
+	t.Run("falls back on unparseable response", func(t *testing.T) {
+		primary := &fakeChat{name: "openai", content: "not json"}
+		fallback := &fakeChat{name: "gemini", content: `{"results":[{"id":1,"sentiment":"negative"}]}`}
+		svc := NewService([]ai.ChatProvider{primary, fallback}, 5, logger)
+
+		result, err := svc.EnrichTexts(context.Background(), []string{"Way too expensive"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Provider != "gemini" || result.Results[0] == nil {
+			t.Errorf("expected result from gemini, got %+v", result)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package enrichment
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxKeywordPhraseWords is the maximum number of words in an extracted keyword phrase
+	maxKeywordPhraseWords = 3
+	// minKeywordLength is the minimum length of a single-word keyword
+	minKeywordLength = 3
+)
+
+// stopwords are common English function words that separate candidate keyword phrases
+var stopwords = map[string]bool{
+	"a": true, "about": true, "above": true, "after": true, "again": true, "against": true, "all": true,
+	"also": true, "am": true, "an": true, "and": true, "any": true, "are": true, "as": true, "at": true,
+	"be": true, "because": true, "been": true, "before": true, "being": true, "below": true, "between": true,
+	"both": true, "but": true, "by": true, "can": true, "could": true, "did": true, "do": true, "does": true,
+	"doing": true, "don't": true, "down": true, "during": true, "each": true, "even": true, "ever": true,
+	"every": true, "few": true, "for": true, "from": true, "further": true, "get": true, "got": true,
+	"had": true, "has": true, "have": true, "having": true, "he": true, "her": true, "here": true,
+	"hers": true, "him": true, "his": true, "how": true, "i": true, "i'm": true, "if": true, "in": true,
+	"into": true, "is": true, "it": true, "it's": true, "its": true, "just": true, "like": true, "make": true,
+	"me": true, "more": true, "most": true, "much": true, "my": true, "no": true, "nor": true, "not": true,
+	"now": true, "of": true, "off": true, "on": true, "once": true, "only": true, "or": true, "other": true,
+	"our": true, "ours": true, "out": true, "over": true, "own": true, "please": true, "quite": true,
+	"really": true, "same": true, "she": true, "should": true, "so": true, "some": true, "still": true,
+	"such": true, "than": true, "that": true, "the": true, "their": true, "them": true, "then": true,
+	"there": true, "these": true, "they": true, "thing": true, "things": true, "this": true, "those": true,
+	"through": true, "to": true, "too": true, "under": true, "until": true, "up": true, "us": true,
+	"use": true, "using": true, "very": true, "was": true, "we": true, "well": true, "were": true,
+	"what": true, "when": true, "where": true, "which": true, "while": true, "who": true, "whom": true,
+	"why": true, "will": true, "with": true, "would": true, "yes": true, "you": true, "your": true,
+	"yours": true, "bit": true, "lot": true, "lots": true, "great": true, "good": true, "bad": true,
+	"love": true, "hate": true, "think": true, "feel": true, "want": true, "need": true, "way": true,
+}
+
+// ExtractKeywords extracts up to maxKeywords topic keywords from text without calling an
+// AI provider. It uses a RAKE-style approach: candidate phrases are the runs of words
+// between stopwords and punctuation, each word is scored by degree/frequency, and phrases
+// are ranked by the sum of their word scores. Question context is ignored.
+func ExtractKeywords(text string, maxKeywords int) []string {
+	phrases := candidatePhrases(strings.ToLower(ResponseText(text)))
+	if len(phrases) == 0 || maxKeywords <= 0 {
+		return []string{}
+	}
+
+	// Word frequency and degree (co-occurrence within phrases)
+	freq := make(map[string]int)
+	degree := make(map[string]int)
+	for _, phrase := range phrases {
+		for _, word := range phrase {
+			freq[word]++
+			degree[word] += len(phrase) - 1
+		}
+	}
+
+	type scoredPhrase struct {
+		text  string
+		score float64
+		order int
+	}
+
+	seen := make(map[string]bool)
+	scored := make([]scoredPhrase, 0, len(phrases))
+	for i, phrase := range phrases {
+		joined := strings.Join(phrase, " ")
+		if seen[joined] {
+			continue
+		}
+		seen[joined] = true
+
+		score := 0.0
+		for _, word := range phrase {
+			score += float64(degree[word]+freq[word]) / float64(freq[word])
+		}
+		// Repeated phrases are more likely to be the topic of the response
+		score *= float64(countPhrase(phrases, joined))
+
+		scored = append(scored, scoredPhrase{text: joined, score: score, order: i})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return scored[i].order < scored[j].order
+	})
+
+	if len(scored) > maxKeywords {
+		scored = scored[:maxKeywords]
+	}
+
+	keywords := make([]string, len(scored))
+	for i, p := range scored {
+		keywords[i] = p.text
+	}
+	return keywords
+}
+
+// candidatePhrases splits text into runs of content words separated by stopwords or punctuation
+func candidatePhrases(text string) [][]string {
+	var phrases [][]string
+	var current []string
+
+	flush := func() {
+		if len(current) > 0 {
+			phrases = append(phrases, current)
+			current = nil
+		}
+	}
+
+	for _, token := range tokenize(text) {
+		if token == "" {
+			// Punctuation boundary
+			flush()
+			continue
+		}
+		if stopwords[token] || isNumeric(token) || (len([]rune(token)) < minKeywordLength && len(current) == 0) {
+			flush()
+			continue
+		}
+		current = append(current, token)
+		if len(current) == maxKeywordPhraseWords {
+			flush()
+		}
+	}
+	flush()
+
+	return phrases
+}
+
+// tokenize splits text into lowercase word tokens. An empty token marks a phrase boundary
+// (sentence or clause punctuation).
+func tokenize(text string) []string {
+	var tokens []string
+	var word strings.Builder
+
+	emit := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, strings.Trim(word.String(), "'-"))
+			word.Reset()
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' || r == '-':
+			word.WriteRune(r)
+		case unicode.IsSpace(r):
+			emit()
+		default:
+			emit()
+			tokens = append(tokens, "")
+		}
+	}
+	emit()
+
+	return tokens
+}
+
+// countPhrase returns how often a phrase occurs among the candidates
+func countPhrase(phrases [][]string, phrase string) int {
+	count := 0
+	for _, p := range phrases {
+		if strings.Join(p, " ") == phrase {
+			count++
+		}
+	}
+	return count
+}
+
+// isNumeric reports whether the token consists only of digits
+func isNumeric(token string) bool {
+	for _, r := range token {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return token != ""
+}
@@ -0,0 +1,52 @@
+package enrichment
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExtractKeywords(t *testing.T) {
+	t.Run("extracts content phrases", func(t *testing.T) {
+		keywords := ExtractKeywords("The new dashboard is confusing and the export feature is slow.", 5)
+		if len(keywords) == 0 {
+			t.Fatal("expected keywords to be extracted")
+		}
+		if !slices.Contains(keywords, "new dashboard") {
+			t.Errorf("expected 'new dashboard' in %v", keywords)
+		}
+		if !slices.Contains(keywords, "export feature") {
+			t.Errorf("expected 'export feature' in %v", keywords)
+		}
+		for _, k := range keywords {
+			if k == "the" || k == "is" {
+				t.Errorf("stopword %q returned as keyword", k)
+			}
+		}
+	})
+
+	t.Run("ignores question context", func(t *testing.T) {
+		keywords := ExtractKeywords("Question: What do you think about pricing?\nResponse: Onboarding was smooth", 5)
+		if slices.Contains(keywords, "pricing") {
+			t.Errorf("expected question text to be ignored, got %v", keywords)
+		}
+		if !slices.Contains(keywords, "onboarding") {
+			t.Errorf("expected 'onboarding' in %v", keywords)
+		}
+	})
+
+	t.Run("respects the maximum", func(t *testing.T) {
+		keywords := ExtractKeywords("Pricing, support, onboarding, documentation, integrations, performance, mobile app", 3)
+		if len(keywords) != 3 {
+			t.Errorf("expected 3 keywords, got %d: %v", len(keywords), keywords)
+		}
+	})
+
+	t.Run("empty and stopword-only text", func(t *testing.T) {
+		if keywords := ExtractKeywords("", 5); len(keywords) != 0 {
+			t.Errorf("expected no keywords, got %v", keywords)
+		}
+		if keywords := ExtractKeywords("it is what it is", 5); len(keywords) != 0 {
+			t.Errorf("expected no keywords, got %v", keywords)
+		}
+	})
+}
@@ -0,0 +1,232 @@
+package enrichment
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// spamThreshold is the confidence at or above which a response is flagged as spam
+	spamThreshold = 0.7
+	// minSpamCheckLength is the minimum number of letters before gibberish heuristics apply.
+	// Very short answers ("ok", "no", "N/A") are legitimate and must not be flagged.
+	minSpamCheckLength = 6
+)
+
+// keyboardRows are the QWERTY rows used to detect keyboard-mash input like "asdfgh"
+var keyboardRows = []string{
+	"qwertyuiop",
+	"asdfghjkl",
+	"zxcvbnm",
+	"1234567890",
+}
+
+// linkMarkers start links; a link counts as one signal however it is written
+var linkMarkers = []string{"http://", "https://", "www."}
+
+// spamPhrases are phrases commonly found in bot-generated or promotional submissions. They
+// are matched as whole words, so "cryptographic" doesn't match "crypto".
+var spamPhrases = []string{
+	"buy now", "click here", "free money", "crypto", "casino", "viagra",
+	"lorem ipsum",
+}
+
+// DetectSpam applies local heuristics to detect gibberish, keyboard mashing and
+// bot-like responses. It returns whether the text looks like spam and a confidence
+// between 0 and 1. The check is cheap and runs without calling an AI provider.
+func DetectSpam(text string) (bool, float64) {
+	text = strings.ToLower(strings.TrimSpace(ResponseText(text)))
+	if text == "" {
+		return false, 0
+	}
+
+	confidence := 0.0
+	raise := func(c float64) {
+		if c > confidence {
+			confidence = c
+		}
+	}
+
+	// Promotional content and links are a strong bot signal, but a link alone is common in
+	// bug reports
+	matches := 0
+	for _, marker := range linkMarkers {
+		if strings.Contains(text, marker) {
+			matches++
+			break
+		}
+	}
+	for _, phrase := range spamPhrases {
+		if containsWord(text, phrase) {
+			matches++
+		}
+	}
+	if matches >= 2 {
+		raise(0.85)
+	} else if matches == 1 {
+		raise(0.4)
+	}
+
+	letters, vowels, longestRun, run := 0, 0, 0, 0
+	var prev rune
+	uniqueLetters := make(map[rune]struct{})
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			// Indentation and punctuation ("!!!!!!") repeat in genuine answers
+			prev = 0
+			continue
+		}
+		if r == prev {
+			run++
+		} else {
+			run = 1
+			prev = r
+		}
+		longestRun = max(longestRun, run)
+		letters++
+		uniqueLetters[r] = struct{}{}
+		if strings.ContainsRune("aeiouy", r) {
+			vowels++
+		}
+	}
+
+	// Long runs of the same letter ("aaaaaaa")
+	if longestRun >= 6 {
+		raise(0.8)
+	}
+
+	if letters < minSpamCheckLength {
+		// Non-empty text that contains no letters at all (e.g., "12345 ### ...")
+		if letters == 0 && len([]rune(text)) >= minSpamCheckLength {
+			raise(0.75)
+		}
+		return confidence >= spamThreshold, confidence
+	}
+
+	// Keyboard mash: a large share of the text consists of adjacent-key sequences
+	if ratio := keyboardSequenceRatio(text); ratio >= 0.6 {
+		raise(0.9)
+	} else if ratio >= 0.4 {
+		raise(0.6)
+	}
+
+	// Only applies to Latin-script text where vowel frequency is meaningful
+	if isMostlyASCII(text) {
+		vowelRatio := float64(vowels) / float64(letters)
+		if vowelRatio < 0.1 {
+			raise(0.85)
+		} else if vowelRatio < 0.2 {
+			raise(0.5)
+		}
+
+		// Very low letter variety in a long text ("abababababab")
+		if letters >= 12 && len(uniqueLetters) <= 3 {
+			raise(0.8)
+		}
+	}
+
+	return confidence >= spamThreshold, confidence
+}
+
+// ResponseText extracts the response portion of enrichment text built with
+// question context ("Question: ...\nResponse: ..."). Plain text is returned unchanged.
+func ResponseText(text string) string {
+	if idx := strings.Index(text, "\nResponse: "); idx >= 0 && strings.HasPrefix(text, "Question: ") {
+		return text[idx+len("\nResponse: "):]
+	}
+	return text
+}
+
+// applySpamHeuristics merges the local spam heuristics into an AI enrichment result.
+// The more confident verdict wins.
+func applySpamHeuristics(text string, e Enrichment) Enrichment {
+	isSpam, confidence := DetectSpam(text)
+	if isSpam && confidence > e.SpamConfidence {
+		e.IsSpam = true
+		e.SpamConfidence = confidence
+	}
+	return e
+}
+
+// containsWord reports whether text contains phrase with no letter or digit right before
+// or after it
+func containsWord(text, phrase string) bool {
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], phrase)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(phrase)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+// isWordRune reports whether r is part of a word
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// keyboardSequenceRatio returns the share of letters that are part of a run of
+// at least three horizontally adjacent keys (in either direction)
+func keyboardSequenceRatio(text string) float64 {
+	chars := []rune(text)
+	inSequence := make([]bool, len(chars))
+	total := 0
+
+	for i := range chars {
+		if unicode.IsLetter(chars[i]) || unicode.IsDigit(chars[i]) {
+			total++
+		}
+		if i < 2 {
+			continue
+		}
+		if adjacentKeys(chars[i-2], chars[i-1]) && adjacentKeys(chars[i-1], chars[i]) {
+			inSequence[i-2], inSequence[i-1], inSequence[i] = true, true, true
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	count := 0
+	for _, in := range inSequence {
+		if in {
+			count++
+		}
+	}
+	return float64(count) / float64(total)
+}
+
+// adjacentKeys reports whether two characters sit next to each other on the same keyboard row
+func adjacentKeys(a, b rune) bool {
+	for _, row := range keyboardRows {
+		i := strings.IndexRune(row, a)
+		j := strings.IndexRune(row, b)
+		if i >= 0 && j >= 0 && (i-j == 1 || j-i == 1) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMostlyASCII reports whether at least 90% of the letters are ASCII
+func isMostlyASCII(text string) bool {
+	ascii, letters := 0, 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if r < unicode.MaxASCII {
+			ascii++
+		}
+	}
+	return letters == 0 || float64(ascii)/float64(letters) >= 0.9
+}
@@ -0,0 +1,53 @@
+package enrichment
+
+import "testing"
+
+func TestDetectSpam(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		wantSpam bool
+	}{
+		{name: "genuine feedback", text: "The UI is intuitive but the docs could be better", wantSpam: false},
+		{name: "short answer", text: "ok", wantSpam: false},
+		{name: "not applicable", text: "N/A", wantSpam: false},
+		{name: "non-latin text", text: "Die Benutzeroberfläche ist sehr übersichtlich", wantSpam: false},
+		{name: "keyboard mash", text: "asdfghjkl", wantSpam: true},
+		{name: "keyboard mash with question context", text: "Question: What can we improve?\nResponse: qwertyuiop", wantSpam: true},
+		{name: "consonant soup", text: "xkcdplmnbvrtz", wantSpam: true},
+		{name: "repeated characters", text: "aaaaaaaaaaaa", wantSpam: true},
+		{name: "promotional links", text: "Click here https://example.com buy now", wantSpam: true},
+		{name: "crypto promotion", text: "Best crypto casino, click here", wantSpam: true},
+		{name: "bug report with a link", text: "The pricing page at https://www.example.com/pricing returns a 500", wantSpam: false},
+		{name: "enthusiastic punctuation", text: "Love it!!!!!! Best tool we use", wantSpam: false},
+		{name: "stack trace", text: "Export fails with:\npanic: runtime error: index out of range\n      at exportRows (export.go:42)\n      at handleExport (api.go:118)", wantSpam: false},
+		{name: "word containing a phrase", text: "Please support cryptographic signatures on webhooks, see www.example.com/docs", wantSpam: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isSpam, confidence := DetectSpam(tt.text)
+			if isSpam != tt.wantSpam {
+				t.Errorf("DetectSpam(%q) = %v (confidence %.2f), want %v", tt.text, isSpam, confidence, tt.wantSpam)
+			}
+			if confidence < 0 || confidence > 1 {
+				t.Errorf("confidence out of range: %f", confidence)
+			}
+		})
+	}
+}
+
+func TestApplySpamHeuristics(t *testing.T) {
+	e := applySpamHeuristics("asdfasdfasdf", Enrichment{IsSpam: false, SpamConfidence: 0.2})
+	if !e.IsSpam {
+		t.Fatal("expected heuristics to flag keyboard mash as spam")
+	}
+	if e.SpamConfidence <= 0.2 {
+		t.Errorf("expected confidence to be raised, got %f", e.SpamConfidence)
+	}
+
+	e = applySpamHeuristics("Great product, love it", Enrichment{IsSpam: true, SpamConfidence: 0.9})
+	if !e.IsSpam || e.SpamConfidence != 0.9 {
+		t.Error("expected model verdict to be preserved when heuristics find nothing")
+	}
+}
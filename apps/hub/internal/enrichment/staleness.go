@@ -0,0 +1,34 @@
+package enrichment
+
+import (
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// PromptVersion identifies the enrichment prompt and output schema.
+// Bump it whenever the prompt or the set of extracted fields changes so existing
+// enrichments can be found as stale and re-enriched.
+const PromptVersion = 1
+
+// StalePredicate matches experiences whose enrichment was produced by an older prompt
+// version or by a model that is no longer configured. Experiences enriched before
+// versioning was tracked (no version recorded) are considered stale.
+func StalePredicate(currentModels []string) predicate.ExperienceData {
+	outdated := []predicate.ExperienceData{
+		experiencedata.EnrichmentVersionIsNil(),
+		experiencedata.EnrichmentVersionLT(PromptVersion),
+		experiencedata.EnrichmentModelIsNil(),
+	}
+	if len(currentModels) > 0 {
+		outdated = append(outdated, experiencedata.EnrichmentModelNotIn(currentModels...))
+	}
+
+	return experiencedata.And(
+		// Only experiences that have been enriched can be stale
+		experiencedata.Or(
+			experiencedata.SentimentNotNil(),
+			experiencedata.EnrichmentVersionNotNil(),
+		),
+		experiencedata.Or(outdated...),
+	)
+}
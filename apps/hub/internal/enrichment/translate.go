@@ -0,0 +1,97 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+)
+
+// Translation holds the translated response text and question label of an experience
+type Translation struct {
+	ValueText  string   `json:"value_text"`
+	FieldLabel string   `json:"field_label"`
+	Provider   string   `json:"-"` // provider that produced the translation
+	Model      string   `json:"-"` // model that produced the translation
+	Usage      ai.Usage `json:"-"` // token usage of the successful request
+}
+
+// Translate translates the response text and question label into the target language.
+// Either may be empty and is then left empty in the result. The source language may be
+// empty to let the model detect it. Only the chat providers are used; a custom enricher
+// can't translate. Providers are tried in order like in EnrichText.
+func (s *Service) Translate(ctx context.Context, text, fieldLabel, sourceLanguage, targetLanguage string) (*Translation, error) {
+	if text == "" && fieldLabel == "" {
+		return nil, errors.New("nothing to translate")
+	}
+	prompt, err := buildTranslationPrompt(text, fieldLabel, sourceLanguage, targetLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *Translation
+	err = s.withFallback(ctx, func(provider ai.ChatProvider) error {
+		resp, err := s.complete(ctx, provider, prompt)
+		if err != nil {
+			return err
+		}
+
+		var translation Translation
+		if err := json.Unmarshal([]byte(resp.Content), &translation); err != nil {
+			s.logger.Warn("failed to parse translation response", "error", err, "content", resp.Content, "provider", provider.Name())
+			return fmt.Errorf("failed to parse response from %s: %w", provider.Name(), err)
+		}
+		translation.ValueText = strings.TrimSpace(translation.ValueText)
+		translation.FieldLabel = strings.TrimSpace(translation.FieldLabel)
+		if text != "" && translation.ValueText == "" {
+			return fmt.Errorf("empty translation from %s", provider.Name())
+		}
+
+		// Don't store what the model made up for an input that was empty
+		if text == "" {
+			translation.ValueText = ""
+		}
+		if fieldLabel == "" {
+			translation.FieldLabel = ""
+		}
+		translation.Provider = provider.Name()
+		translation.Model = provider.Model()
+		translation.Usage = resp.Usage
+		result = &translation
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// buildTranslationPrompt creates the LLM prompt for translating a response. The texts are
+// embedded as a JSON object, so quotes and newlines in feedback can't break the prompt.
+func buildTranslationPrompt(text, fieldLabel, sourceLanguage, targetLanguage string) (string, error) {
+	input, err := json.Marshal(map[string]string{"value_text": text, "field_label": fieldLabel})
+	if err != nil {
+		return "", err
+	}
+
+	source := "Detect the source language."
+	if sourceLanguage != "" {
+		source = fmt.Sprintf("The source language is %q.", sourceLanguage)
+	}
+
+	return fmt.Sprintf(`You are a translator for customer feedback. Translate the values of the following JSON object into the language with the ISO code %q. %s
+
+Rules:
+- Output ONLY valid JSON with the same keys ("value_text" and "field_label"), no additional text
+- Translate faithfully: keep the tone and meaning, and don't summarize, correct, or answer the feedback
+- Keep product names, URLs, email addresses, and code unchanged
+- Leave empty values empty
+- If a value is already in the target language, return it unchanged
+
+Input:
+%s`, targetLanguage, source, input), nil
+}
@@ -0,0 +1,81 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+)
+
+func TestTranslate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("parses the translation", func(t *testing.T) {
+		provider := &fakeChat{name: "openai", content: "```json\n" + `{"value_text":" Viel zu teuer ","field_label":"Was können wir verbessern?"}` + "\n```"}
+		svc := NewService([]ai.ChatProvider{provider}, 5, logger)
+
+		result, err := svc.Translate(context.Background(), "Way too expensive", "What can we improve?", "en", "de")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.ValueText != "Viel zu teuer" || result.FieldLabel != "Was können wir verbessern?" {
+			t.Errorf("unexpected translation: %+v", result)
+		}
+		if result.Provider != "openai" || result.Model != "openai-model" {
+			t.Errorf("expected provider openai and its model, got %s %s", result.Provider, result.Model)
+		}
+	})
+
+	t.Run("drops values for empty inputs", func(t *testing.T) {
+		provider := &fakeChat{name: "openai", content: `{"value_text":"Viel zu teuer","field_label":"Frage"}`}
+		svc := NewService([]ai.ChatProvider{provider}, 5, logger)
+
+		result, err := svc.Translate(context.Background(), "Way too expensive", "", "", "de")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.FieldLabel != "" {
+			t.Errorf("expected no field label, got %q", result.FieldLabel)
+		}
+	})
+
+	t.Run("falls back on an empty translation", func(t *testing.T) {
+		primary := &fakeChat{name: "openai", content: `{"value_text":""}`}
+		fallback := &fakeChat{name: "gemini", content: `{"value_text":"Viel zu teuer"}`}
+		svc := NewService([]ai.ChatProvider{primary, fallback}, 5, logger)
+
+		result, err := svc.Translate(context.Background(), "Way too expensive", "", "en", "de")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Provider != "gemini" {
+			t.Errorf("expected provider gemini, got %s", result.Provider)
+		}
+	})
+
+	t.Run("returns last error when all providers fail", func(t *testing.T) {
+		provider := &fakeChat{name: "openai", err: errors.New("outage")}
+		svc := NewService([]ai.ChatProvider{provider}, 5, logger)
+
+		if _, err := svc.Translate(context.Background(), "Way too expensive", "", "en", "de"); err == nil || err.Error() != "outage" {
+			t.Errorf("expected provider error, got %v", err)
+		}
+	})
+}
+
+func TestBuildTranslationPrompt(t *testing.T) {
+	prompt, err := buildTranslationPrompt("He said \"no\"\nand left", "Why?", "", "fr")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(prompt, `"value_text":"He said \"no\"\nand left"`) {
+		t.Errorf("expected the text to be JSON-encoded, got %s", prompt)
+	}
+	if !strings.Contains(prompt, `ISO code "fr"`) || !strings.Contains(prompt, "Detect the source language") {
+		t.Errorf("expected target language and source detection in prompt, got %s", prompt)
+	}
+}
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+	"github.com/google/uuid"
+)
+
+// AIUsage is the model entity for the AIUsage schema.
+type AIUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UTC day the usage was recorded (midnight)
+	Day time.Time `json:"day,omitempty"`
+	// Job type: enrichment, embedding, or search (query embeddings)
+	JobType string `json:"job_type,omitempty"`
+	// AI provider (e.g., openai, gemini)
+	Provider string `json:"provider,omitempty"`
+	// AI model (e.g., gpt-4o-mini)
+	Model string `json:"model,omitempty"`
+	// Number of successful AI requests
+	Requests int `json:"requests,omitempty"`
+	// Total prompt (input) tokens
+	PromptTokens int64 `json:"prompt_tokens,omitempty"`
+	// Total completion (output) tokens
+	CompletionTokens int64 `json:"completion_tokens,omitempty"`
+	// Total estimated cost in USD
+	CostUsd      float64 `json:"cost_usd,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AIUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case aiusage.FieldCostUsd:
+			values[i] = new(sql.NullFloat64)
+		case aiusage.FieldRequests, aiusage.FieldPromptTokens, aiusage.FieldCompletionTokens:
+			values[i] = new(sql.NullInt64)
+		case aiusage.FieldJobType, aiusage.FieldProvider, aiusage.FieldModel:
+			values[i] = new(sql.NullString)
+		case aiusage.FieldUpdatedAt, aiusage.FieldDay:
+			values[i] = new(sql.NullTime)
+		case aiusage.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AIUsage fields.
+func (_m *AIUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case aiusage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case aiusage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case aiusage.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				_m.Day = value.Time
+			}
+		case aiusage.FieldJobType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_type", values[i])
+			} else if value.Valid {
+				_m.JobType = value.String
+			}
+		case aiusage.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case aiusage.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case aiusage.FieldRequests:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requests", values[i])
+			} else if value.Valid {
+				_m.Requests = int(value.Int64)
+			}
+		case aiusage.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = value.Int64
+			}
+		case aiusage.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionTokens = value.Int64
+			}
+		case aiusage.FieldCostUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_usd", values[i])
+			} else if value.Valid {
+				_m.CostUsd = value.Float64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AIUsage.
+// This includes values selected through modifiers, order, etc.
+func (_m *AIUsage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AIUsage.
+// Note that you need to call AIUsage.Unwrap() before calling this method if this AIUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AIUsage) Update() *AIUsageUpdateOne {
+	return NewAIUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AIUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AIUsage) Unwrap() *AIUsage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AIUsage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AIUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("AIUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(_m.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("job_type=")
+	builder.WriteString(_m.JobType)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("requests=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Requests))
+	builder.WriteString(", ")
+	builder.WriteString("prompt_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PromptTokens))
+	builder.WriteString(", ")
+	builder.WriteString("completion_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompletionTokens))
+	builder.WriteString(", ")
+	builder.WriteString("cost_usd=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostUsd))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AIUsages is a parsable slice of AIUsage.
+type AIUsages []*AIUsage
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package aiusage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldID, id))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// JobType applies equality check predicate on the "job_type" field. It's identical to JobTypeEQ.
+func JobType(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldJobType, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldProvider, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldModel, v))
+}
+
+// Requests applies equality check predicate on the "requests" field. It's identical to RequestsEQ.
+func Requests(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldRequests, v))
+}
+
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completion_tokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CostUsd applies equality check predicate on the "cost_usd" field. It's identical to CostUsdEQ.
+func CostUsd(v float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldDay, v))
+}
+
+// JobTypeEQ applies the EQ predicate on the "job_type" field.
+func JobTypeEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldJobType, v))
+}
+
+// JobTypeNEQ applies the NEQ predicate on the "job_type" field.
+func JobTypeNEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldJobType, v))
+}
+
+// JobTypeIn applies the In predicate on the "job_type" field.
+func JobTypeIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldJobType, vs...))
+}
+
+// JobTypeNotIn applies the NotIn predicate on the "job_type" field.
+func JobTypeNotIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldJobType, vs...))
+}
+
+// JobTypeGT applies the GT predicate on the "job_type" field.
+func JobTypeGT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldJobType, v))
+}
+
+// JobTypeGTE applies the GTE predicate on the "job_type" field.
+func JobTypeGTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldJobType, v))
+}
+
+// JobTypeLT applies the LT predicate on the "job_type" field.
+func JobTypeLT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldJobType, v))
+}
+
+// JobTypeLTE applies the LTE predicate on the "job_type" field.
+func JobTypeLTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldJobType, v))
+}
+
+// JobTypeContains applies the Contains predicate on the "job_type" field.
+func JobTypeContains(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContains(FieldJobType, v))
+}
+
+// JobTypeHasPrefix applies the HasPrefix predicate on the "job_type" field.
+func JobTypeHasPrefix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasPrefix(FieldJobType, v))
+}
+
+// JobTypeHasSuffix applies the HasSuffix predicate on the "job_type" field.
+func JobTypeHasSuffix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasSuffix(FieldJobType, v))
+}
+
+// JobTypeEqualFold applies the EqualFold predicate on the "job_type" field.
+func JobTypeEqualFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEqualFold(FieldJobType, v))
+}
+
+// JobTypeContainsFold applies the ContainsFold predicate on the "job_type" field.
+func JobTypeContainsFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContainsFold(FieldJobType, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldContainsFold(FieldModel, v))
+}
+
+// RequestsEQ applies the EQ predicate on the "requests" field.
+func RequestsEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldRequests, v))
+}
+
+// RequestsNEQ applies the NEQ predicate on the "requests" field.
+func RequestsNEQ(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldRequests, v))
+}
+
+// RequestsIn applies the In predicate on the "requests" field.
+func RequestsIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldRequests, vs...))
+}
+
+// RequestsNotIn applies the NotIn predicate on the "requests" field.
+func RequestsNotIn(vs ...int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldRequests, vs...))
+}
+
+// RequestsGT applies the GT predicate on the "requests" field.
+func RequestsGT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldRequests, v))
+}
+
+// RequestsGTE applies the GTE predicate on the "requests" field.
+func RequestsGTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldRequests, v))
+}
+
+// RequestsLT applies the LT predicate on the "requests" field.
+func RequestsLT(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldRequests, v))
+}
+
+// RequestsLTE applies the LTE predicate on the "requests" field.
+func RequestsLTE(v int) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldRequests, v))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completion_tokens" field.
+func CompletionTokensEQ(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completion_tokens" field.
+func CompletionTokensNEQ(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completion_tokens" field.
+func CompletionTokensIn(vs ...int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completion_tokens" field.
+func CompletionTokensNotIn(vs ...int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completion_tokens" field.
+func CompletionTokensGT(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completion_tokens" field.
+func CompletionTokensGTE(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completion_tokens" field.
+func CompletionTokensLT(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completion_tokens" field.
+func CompletionTokensLTE(v int64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// CostUsdEQ applies the EQ predicate on the "cost_usd" field.
+func CostUsdEQ(v float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// CostUsdNEQ applies the NEQ predicate on the "cost_usd" field.
+func CostUsdNEQ(v float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNEQ(FieldCostUsd, v))
+}
+
+// CostUsdIn applies the In predicate on the "cost_usd" field.
+func CostUsdIn(vs ...float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldIn(FieldCostUsd, vs...))
+}
+
+// CostUsdNotIn applies the NotIn predicate on the "cost_usd" field.
+func CostUsdNotIn(vs ...float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldNotIn(FieldCostUsd, vs...))
+}
+
+// CostUsdGT applies the GT predicate on the "cost_usd" field.
+func CostUsdGT(v float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGT(FieldCostUsd, v))
+}
+
+// CostUsdGTE applies the GTE predicate on the "cost_usd" field.
+func CostUsdGTE(v float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldGTE(FieldCostUsd, v))
+}
+
+// CostUsdLT applies the LT predicate on the "cost_usd" field.
+func CostUsdLT(v float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLT(FieldCostUsd, v))
+}
+
+// CostUsdLTE applies the LTE predicate on the "cost_usd" field.
+func CostUsdLTE(v float64) predicate.AIUsage {
+	return predicate.AIUsage(sql.FieldLTE(FieldCostUsd, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AIUsage) predicate.AIUsage {
+	return predicate.AIUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AIUsage) predicate.AIUsage {
+	return predicate.AIUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AIUsage) predicate.AIUsage {
+	return predicate.AIUsage(sql.NotPredicates(p))
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+	"github.com/google/uuid"
+)
+
+// AIUsageCreate is the builder for creating a AIUsage entity.
+type AIUsageCreate struct {
+	config
+	mutation *AIUsageMutation
+	hooks    []Hook
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AIUsageCreate) SetUpdatedAt(v time.Time) *AIUsageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableUpdatedAt(v *time.Time) *AIUsageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDay sets the "day" field.
+func (_c *AIUsageCreate) SetDay(v time.Time) *AIUsageCreate {
+	_c.mutation.SetDay(v)
+	return _c
+}
+
+// SetJobType sets the "job_type" field.
+func (_c *AIUsageCreate) SetJobType(v string) *AIUsageCreate {
+	_c.mutation.SetJobType(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *AIUsageCreate) SetProvider(v string) *AIUsageCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *AIUsageCreate) SetModel(v string) *AIUsageCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetRequests sets the "requests" field.
+func (_c *AIUsageCreate) SetRequests(v int) *AIUsageCreate {
+	_c.mutation.SetRequests(v)
+	return _c
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableRequests(v *int) *AIUsageCreate {
+	if v != nil {
+		_c.SetRequests(*v)
+	}
+	return _c
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_c *AIUsageCreate) SetPromptTokens(v int64) *AIUsageCreate {
+	_c.mutation.SetPromptTokens(v)
+	return _c
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillablePromptTokens(v *int64) *AIUsageCreate {
+	if v != nil {
+		_c.SetPromptTokens(*v)
+	}
+	return _c
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_c *AIUsageCreate) SetCompletionTokens(v int64) *AIUsageCreate {
+	_c.mutation.SetCompletionTokens(v)
+	return _c
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableCompletionTokens(v *int64) *AIUsageCreate {
+	if v != nil {
+		_c.SetCompletionTokens(*v)
+	}
+	return _c
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_c *AIUsageCreate) SetCostUsd(v float64) *AIUsageCreate {
+	_c.mutation.SetCostUsd(v)
+	return _c
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableCostUsd(v *float64) *AIUsageCreate {
+	if v != nil {
+		_c.SetCostUsd(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AIUsageCreate) SetID(v uuid.UUID) *AIUsageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AIUsageCreate) SetNillableID(v *uuid.UUID) *AIUsageCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AIUsageMutation object of the builder.
+func (_c *AIUsageCreate) Mutation() *AIUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the AIUsage in the database.
+func (_c *AIUsageCreate) Save(ctx context.Context) (*AIUsage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AIUsageCreate) SaveX(ctx context.Context) *AIUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AIUsageCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := aiusage.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Requests(); !ok {
+		v := aiusage.DefaultRequests
+		_c.mutation.SetRequests(v)
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		v := aiusage.DefaultPromptTokens
+		_c.mutation.SetPromptTokens(v)
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		v := aiusage.DefaultCompletionTokens
+		_c.mutation.SetCompletionTokens(v)
+	}
+	if _, ok := _c.mutation.CostUsd(); !ok {
+		v := aiusage.DefaultCostUsd
+		_c.mutation.SetCostUsd(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := aiusage.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AIUsageCreate) check() error {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AIUsage.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "AIUsage.day"`)}
+	}
+	if _, ok := _c.mutation.JobType(); !ok {
+		return &ValidationError{Name: "job_type", err: errors.New(`ent: missing required field "AIUsage.job_type"`)}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "AIUsage.provider"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "AIUsage.model"`)}
+	}
+	if _, ok := _c.mutation.Requests(); !ok {
+		return &ValidationError{Name: "requests", err: errors.New(`ent: missing required field "AIUsage.requests"`)}
+	}
+	if _, ok := _c.mutation.PromptTokens(); !ok {
+		return &ValidationError{Name: "prompt_tokens", err: errors.New(`ent: missing required field "AIUsage.prompt_tokens"`)}
+	}
+	if _, ok := _c.mutation.CompletionTokens(); !ok {
+		return &ValidationError{Name: "completion_tokens", err: errors.New(`ent: missing required field "AIUsage.completion_tokens"`)}
+	}
+	if _, ok := _c.mutation.CostUsd(); !ok {
+		return &ValidationError{Name: "cost_usd", err: errors.New(`ent: missing required field "AIUsage.cost_usd"`)}
+	}
+	return nil
+}
+
+func (_c *AIUsageCreate) sqlSave(ctx context.Context) (*AIUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AIUsageCreate) createSpec() (*AIUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AIUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(aiusage.Table, sqlgraph.NewFieldSpec(aiusage.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(aiusage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Day(); ok {
+		_spec.SetField(aiusage.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := _c.mutation.JobType(); ok {
+		_spec.SetField(aiusage.FieldJobType, field.TypeString, value)
+		_node.JobType = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(aiusage.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(aiusage.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Requests(); ok {
+		_spec.SetField(aiusage.FieldRequests, field.TypeInt, value)
+		_node.Requests = value
+	}
+	if value, ok := _c.mutation.PromptTokens(); ok {
+		_spec.SetField(aiusage.FieldPromptTokens, field.TypeInt64, value)
+		_node.PromptTokens = value
+	}
+	if value, ok := _c.mutation.CompletionTokens(); ok {
+		_spec.SetField(aiusage.FieldCompletionTokens, field.TypeInt64, value)
+		_node.CompletionTokens = value
+	}
+	if value, ok := _c.mutation.CostUsd(); ok {
+		_spec.SetField(aiusage.FieldCostUsd, field.TypeFloat64, value)
+		_node.CostUsd = value
+	}
+	return _node, _spec
+}
+
+// AIUsageCreateBulk is the builder for creating many AIUsage entities in bulk.
+type AIUsageCreateBulk struct {
+	config
+	err      error
+	builders []*AIUsageCreate
+}
+
+// Save creates the AIUsage entities in the database.
+func (_c *AIUsageCreateBulk) Save(ctx context.Context) ([]*AIUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AIUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AIUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AIUsageCreateBulk) SaveX(ctx context.Context) []*AIUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AIUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AIUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// AIUsageUpdate is the builder for updating AIUsage entities.
+type AIUsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AIUsageMutation
+}
+
+// Where appends a list predicates to the AIUsageUpdate builder.
+func (_u *AIUsageUpdate) Where(ps ...predicate.AIUsage) *AIUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AIUsageUpdate) SetUpdatedAt(v time.Time) *AIUsageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRequests sets the "requests" field.
+func (_u *AIUsageUpdate) SetRequests(v int) *AIUsageUpdate {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillableRequests(v *int) *AIUsageUpdate {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *AIUsageUpdate) AddRequests(v int) *AIUsageUpdate {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIUsageUpdate) SetPromptTokens(v int64) *AIUsageUpdate {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillablePromptTokens(v *int64) *AIUsageUpdate {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIUsageUpdate) AddPromptTokens(v int64) *AIUsageUpdate {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *AIUsageUpdate) SetCompletionTokens(v int64) *AIUsageUpdate {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillableCompletionTokens(v *int64) *AIUsageUpdate {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *AIUsageUpdate) AddCompletionTokens(v int64) *AIUsageUpdate {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_u *AIUsageUpdate) SetCostUsd(v float64) *AIUsageUpdate {
+	_u.mutation.ResetCostUsd()
+	_u.mutation.SetCostUsd(v)
+	return _u
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_u *AIUsageUpdate) SetNillableCostUsd(v *float64) *AIUsageUpdate {
+	if v != nil {
+		_u.SetCostUsd(*v)
+	}
+	return _u
+}
+
+// AddCostUsd adds value to the "cost_usd" field.
+func (_u *AIUsageUpdate) AddCostUsd(v float64) *AIUsageUpdate {
+	_u.mutation.AddCostUsd(v)
+	return _u
+}
+
+// Mutation returns the AIUsageMutation object of the builder.
+func (_u *AIUsageUpdate) Mutation() *AIUsageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AIUsageUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AIUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AIUsageUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := aiusage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *AIUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(aiusage.Table, aiusage.Columns, sqlgraph.NewFieldSpec(aiusage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(aiusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(aiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(aiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aiusage.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aiusage.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(aiusage.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(aiusage.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CostUsd(); ok {
+		_spec.SetField(aiusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCostUsd(); ok {
+		_spec.AddField(aiusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aiusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AIUsageUpdateOne is the builder for updating a single AIUsage entity.
+type AIUsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AIUsageMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AIUsageUpdateOne) SetUpdatedAt(v time.Time) *AIUsageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRequests sets the "requests" field.
+func (_u *AIUsageUpdateOne) SetRequests(v int) *AIUsageUpdateOne {
+	_u.mutation.ResetRequests()
+	_u.mutation.SetRequests(v)
+	return _u
+}
+
+// SetNillableRequests sets the "requests" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillableRequests(v *int) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetRequests(*v)
+	}
+	return _u
+}
+
+// AddRequests adds value to the "requests" field.
+func (_u *AIUsageUpdateOne) AddRequests(v int) *AIUsageUpdateOne {
+	_u.mutation.AddRequests(v)
+	return _u
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (_u *AIUsageUpdateOne) SetPromptTokens(v int64) *AIUsageUpdateOne {
+	_u.mutation.ResetPromptTokens()
+	_u.mutation.SetPromptTokens(v)
+	return _u
+}
+
+// SetNillablePromptTokens sets the "prompt_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillablePromptTokens(v *int64) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetPromptTokens(*v)
+	}
+	return _u
+}
+
+// AddPromptTokens adds value to the "prompt_tokens" field.
+func (_u *AIUsageUpdateOne) AddPromptTokens(v int64) *AIUsageUpdateOne {
+	_u.mutation.AddPromptTokens(v)
+	return _u
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (_u *AIUsageUpdateOne) SetCompletionTokens(v int64) *AIUsageUpdateOne {
+	_u.mutation.ResetCompletionTokens()
+	_u.mutation.SetCompletionTokens(v)
+	return _u
+}
+
+// SetNillableCompletionTokens sets the "completion_tokens" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillableCompletionTokens(v *int64) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetCompletionTokens(*v)
+	}
+	return _u
+}
+
+// AddCompletionTokens adds value to the "completion_tokens" field.
+func (_u *AIUsageUpdateOne) AddCompletionTokens(v int64) *AIUsageUpdateOne {
+	_u.mutation.AddCompletionTokens(v)
+	return _u
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_u *AIUsageUpdateOne) SetCostUsd(v float64) *AIUsageUpdateOne {
+	_u.mutation.ResetCostUsd()
+	_u.mutation.SetCostUsd(v)
+	return _u
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_u *AIUsageUpdateOne) SetNillableCostUsd(v *float64) *AIUsageUpdateOne {
+	if v != nil {
+		_u.SetCostUsd(*v)
+	}
+	return _u
+}
+
+// AddCostUsd adds value to the "cost_usd" field.
+func (_u *AIUsageUpdateOne) AddCostUsd(v float64) *AIUsageUpdateOne {
+	_u.mutation.AddCostUsd(v)
+	return _u
+}
+
+// Mutation returns the AIUsageMutation object of the builder.
+func (_u *AIUsageUpdateOne) Mutation() *AIUsageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AIUsageUpdate builder.
+func (_u *AIUsageUpdateOne) Where(ps ...predicate.AIUsage) *AIUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AIUsageUpdateOne) Select(field string, fields ...string) *AIUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AIUsage entity.
+func (_u *AIUsageUpdateOne) Save(ctx context.Context) (*AIUsage, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AIUsageUpdateOne) SaveX(ctx context.Context) *AIUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AIUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AIUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AIUsageUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := aiusage.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *AIUsageUpdateOne) sqlSave(ctx context.Context) (_node *AIUsage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(aiusage.Table, aiusage.Columns, sqlgraph.NewFieldSpec(aiusage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AIUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, aiusage.FieldID)
+		for _, f := range fields {
+			if !aiusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != aiusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(aiusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Requests(); ok {
+		_spec.SetField(aiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRequests(); ok {
+		_spec.AddField(aiusage.FieldRequests, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PromptTokens(); ok {
+		_spec.SetField(aiusage.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPromptTokens(); ok {
+		_spec.AddField(aiusage.FieldPromptTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CompletionTokens(); ok {
+		_spec.SetField(aiusage.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCompletionTokens(); ok {
+		_spec.AddField(aiusage.FieldCompletionTokens, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CostUsd(); ok {
+		_spec.SetField(aiusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCostUsd(); ok {
+		_spec.AddField(aiusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	_node = &AIUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{aiusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
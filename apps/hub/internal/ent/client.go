@@ -16,8 +16,21 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+	"github.com/formbricks/hub/apps/hub/internal/ent/auditlog"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
 	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
+	"github.com/formbricks/hub/apps/hub/internal/ent/segment"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/ent/worker"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,10 +38,36 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AIUsage is the client for interacting with the AIUsage builders.
+	AIUsage *AIUsageClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// ConnectorCursor is the client for interacting with the ConnectorCursor builders.
+	ConnectorCursor *ConnectorCursorClient
 	// EnrichmentJob is the client for interacting with the EnrichmentJob builders.
 	EnrichmentJob *EnrichmentJobClient
 	// ExperienceData is the client for interacting with the ExperienceData builders.
 	ExperienceData *ExperienceDataClient
+	// ExperienceDeletion is the client for interacting with the ExperienceDeletion builders.
+	ExperienceDeletion *ExperienceDeletionClient
+	// Export is the client for interacting with the Export builders.
+	Export *ExportClient
+	// ExportRun is the client for interacting with the ExportRun builders.
+	ExportRun *ExportRunClient
+	// IngestMapping is the client for interacting with the IngestMapping builders.
+	IngestMapping *IngestMappingClient
+	// Question is the client for interacting with the Question builders.
+	Question *QuestionClient
+	// QueuePause is the client for interacting with the QueuePause builders.
+	QueuePause *QueuePauseClient
+	// Segment is the client for interacting with the Segment builders.
+	Segment *SegmentClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
+	// Worker is the client for interacting with the Worker builders.
+	Worker *WorkerClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -40,8 +79,21 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AIUsage = NewAIUsageClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.ConnectorCursor = NewConnectorCursorClient(c.config)
 	c.EnrichmentJob = NewEnrichmentJobClient(c.config)
 	c.ExperienceData = NewExperienceDataClient(c.config)
+	c.ExperienceDeletion = NewExperienceDeletionClient(c.config)
+	c.Export = NewExportClient(c.config)
+	c.ExportRun = NewExportRunClient(c.config)
+	c.IngestMapping = NewIngestMappingClient(c.config)
+	c.Question = NewQuestionClient(c.config)
+	c.QueuePause = NewQueuePauseClient(c.config)
+	c.Segment = NewSegmentClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
+	c.Worker = NewWorkerClient(c.config)
 }
 
 type (
@@ -132,10 +184,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		EnrichmentJob:  NewEnrichmentJobClient(cfg),
-		ExperienceData: NewExperienceDataClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AIUsage:            NewAIUsageClient(cfg),
+		AuditLog:           NewAuditLogClient(cfg),
+		ConnectorCursor:    NewConnectorCursorClient(cfg),
+		EnrichmentJob:      NewEnrichmentJobClient(cfg),
+		ExperienceData:     NewExperienceDataClient(cfg),
+		ExperienceDeletion: NewExperienceDeletionClient(cfg),
+		Export:             NewExportClient(cfg),
+		ExportRun:          NewExportRunClient(cfg),
+		IngestMapping:      NewIngestMappingClient(cfg),
+		Question:           NewQuestionClient(cfg),
+		QueuePause:         NewQueuePauseClient(cfg),
+		Segment:            NewSegmentClient(cfg),
+		WebhookDelivery:    NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:    NewWebhookEndpointClient(cfg),
+		Worker:             NewWorkerClient(cfg),
 	}, nil
 }
 
@@ -153,17 +218,30 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		EnrichmentJob:  NewEnrichmentJobClient(cfg),
-		ExperienceData: NewExperienceDataClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		AIUsage:            NewAIUsageClient(cfg),
+		AuditLog:           NewAuditLogClient(cfg),
+		ConnectorCursor:    NewConnectorCursorClient(cfg),
+		EnrichmentJob:      NewEnrichmentJobClient(cfg),
+		ExperienceData:     NewExperienceDataClient(cfg),
+		ExperienceDeletion: NewExperienceDeletionClient(cfg),
+		Export:             NewExportClient(cfg),
+		ExportRun:          NewExportRunClient(cfg),
+		IngestMapping:      NewIngestMappingClient(cfg),
+		Question:           NewQuestionClient(cfg),
+		QueuePause:         NewQueuePauseClient(cfg),
+		Segment:            NewSegmentClient(cfg),
+		WebhookDelivery:    NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:    NewWebhookEndpointClient(cfg),
+		Worker:             NewWorkerClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		EnrichmentJob.
+//		AIUsage.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -185,29 +263,464 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.EnrichmentJob.Use(hooks...)
-	c.ExperienceData.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AIUsage, c.AuditLog, c.ConnectorCursor, c.EnrichmentJob, c.ExperienceData,
+		c.ExperienceDeletion, c.Export, c.ExportRun, c.IngestMapping, c.Question,
+		c.QueuePause, c.Segment, c.WebhookDelivery, c.WebhookEndpoint, c.Worker,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.EnrichmentJob.Intercept(interceptors...)
-	c.ExperienceData.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AIUsage, c.AuditLog, c.ConnectorCursor, c.EnrichmentJob, c.ExperienceData,
+		c.ExperienceDeletion, c.Export, c.ExportRun, c.IngestMapping, c.Question,
+		c.QueuePause, c.Segment, c.WebhookDelivery, c.WebhookEndpoint, c.Worker,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AIUsageMutation:
+		return c.AIUsage.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *ConnectorCursorMutation:
+		return c.ConnectorCursor.mutate(ctx, m)
 	case *EnrichmentJobMutation:
 		return c.EnrichmentJob.mutate(ctx, m)
 	case *ExperienceDataMutation:
 		return c.ExperienceData.mutate(ctx, m)
+	case *ExperienceDeletionMutation:
+		return c.ExperienceDeletion.mutate(ctx, m)
+	case *ExportMutation:
+		return c.Export.mutate(ctx, m)
+	case *ExportRunMutation:
+		return c.ExportRun.mutate(ctx, m)
+	case *IngestMappingMutation:
+		return c.IngestMapping.mutate(ctx, m)
+	case *QuestionMutation:
+		return c.Question.mutate(ctx, m)
+	case *QueuePauseMutation:
+		return c.QueuePause.mutate(ctx, m)
+	case *SegmentMutation:
+		return c.Segment.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookEndpointMutation:
+		return c.WebhookEndpoint.mutate(ctx, m)
+	case *WorkerMutation:
+		return c.Worker.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AIUsageClient is a client for the AIUsage schema.
+type AIUsageClient struct {
+	config
+}
+
+// NewAIUsageClient returns a client for the AIUsage from the given config.
+func NewAIUsageClient(c config) *AIUsageClient {
+	return &AIUsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `aiusage.Hooks(f(g(h())))`.
+func (c *AIUsageClient) Use(hooks ...Hook) {
+	c.hooks.AIUsage = append(c.hooks.AIUsage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `aiusage.Intercept(f(g(h())))`.
+func (c *AIUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AIUsage = append(c.inters.AIUsage, interceptors...)
+}
+
+// Create returns a builder for creating a AIUsage entity.
+func (c *AIUsageClient) Create() *AIUsageCreate {
+	mutation := newAIUsageMutation(c.config, OpCreate)
+	return &AIUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AIUsage entities.
+func (c *AIUsageClient) CreateBulk(builders ...*AIUsageCreate) *AIUsageCreateBulk {
+	return &AIUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AIUsageClient) MapCreateBulk(slice any, setFunc func(*AIUsageCreate, int)) *AIUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AIUsageCreateBulk{err: fmt.Errorf("calling to AIUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AIUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AIUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AIUsage.
+func (c *AIUsageClient) Update() *AIUsageUpdate {
+	mutation := newAIUsageMutation(c.config, OpUpdate)
+	return &AIUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AIUsageClient) UpdateOne(_m *AIUsage) *AIUsageUpdateOne {
+	mutation := newAIUsageMutation(c.config, OpUpdateOne, withAIUsage(_m))
+	return &AIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AIUsageClient) UpdateOneID(id uuid.UUID) *AIUsageUpdateOne {
+	mutation := newAIUsageMutation(c.config, OpUpdateOne, withAIUsageID(id))
+	return &AIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AIUsage.
+func (c *AIUsageClient) Delete() *AIUsageDelete {
+	mutation := newAIUsageMutation(c.config, OpDelete)
+	return &AIUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AIUsageClient) DeleteOne(_m *AIUsage) *AIUsageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AIUsageClient) DeleteOneID(id uuid.UUID) *AIUsageDeleteOne {
+	builder := c.Delete().Where(aiusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AIUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for AIUsage.
+func (c *AIUsageClient) Query() *AIUsageQuery {
+	return &AIUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAIUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AIUsage entity by its id.
+func (c *AIUsageClient) Get(ctx context.Context, id uuid.UUID) (*AIUsage, error) {
+	return c.Query().Where(aiusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AIUsageClient) GetX(ctx context.Context, id uuid.UUID) *AIUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AIUsageClient) Hooks() []Hook {
+	return c.hooks.AIUsage
+}
+
+// Interceptors returns the client interceptors.
+func (c *AIUsageClient) Interceptors() []Interceptor {
+	return c.inters.AIUsage
+}
+
+func (c *AIUsageClient) mutate(ctx context.Context, m *AIUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AIUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AIUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AIUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AIUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AIUsage mutation op: %q", m.Op())
+	}
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(_m *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(_m))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id uuid.UUID) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(_m *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id uuid.UUID) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id uuid.UUID) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id uuid.UUID) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
+// ConnectorCursorClient is a client for the ConnectorCursor schema.
+type ConnectorCursorClient struct {
+	config
+}
+
+// NewConnectorCursorClient returns a client for the ConnectorCursor from the given config.
+func NewConnectorCursorClient(c config) *ConnectorCursorClient {
+	return &ConnectorCursorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `connectorcursor.Hooks(f(g(h())))`.
+func (c *ConnectorCursorClient) Use(hooks ...Hook) {
+	c.hooks.ConnectorCursor = append(c.hooks.ConnectorCursor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `connectorcursor.Intercept(f(g(h())))`.
+func (c *ConnectorCursorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConnectorCursor = append(c.inters.ConnectorCursor, interceptors...)
+}
+
+// Create returns a builder for creating a ConnectorCursor entity.
+func (c *ConnectorCursorClient) Create() *ConnectorCursorCreate {
+	mutation := newConnectorCursorMutation(c.config, OpCreate)
+	return &ConnectorCursorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConnectorCursor entities.
+func (c *ConnectorCursorClient) CreateBulk(builders ...*ConnectorCursorCreate) *ConnectorCursorCreateBulk {
+	return &ConnectorCursorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConnectorCursorClient) MapCreateBulk(slice any, setFunc func(*ConnectorCursorCreate, int)) *ConnectorCursorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConnectorCursorCreateBulk{err: fmt.Errorf("calling to ConnectorCursorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConnectorCursorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConnectorCursorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConnectorCursor.
+func (c *ConnectorCursorClient) Update() *ConnectorCursorUpdate {
+	mutation := newConnectorCursorMutation(c.config, OpUpdate)
+	return &ConnectorCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConnectorCursorClient) UpdateOne(_m *ConnectorCursor) *ConnectorCursorUpdateOne {
+	mutation := newConnectorCursorMutation(c.config, OpUpdateOne, withConnectorCursor(_m))
+	return &ConnectorCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConnectorCursorClient) UpdateOneID(id uuid.UUID) *ConnectorCursorUpdateOne {
+	mutation := newConnectorCursorMutation(c.config, OpUpdateOne, withConnectorCursorID(id))
+	return &ConnectorCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConnectorCursor.
+func (c *ConnectorCursorClient) Delete() *ConnectorCursorDelete {
+	mutation := newConnectorCursorMutation(c.config, OpDelete)
+	return &ConnectorCursorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConnectorCursorClient) DeleteOne(_m *ConnectorCursor) *ConnectorCursorDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConnectorCursorClient) DeleteOneID(id uuid.UUID) *ConnectorCursorDeleteOne {
+	builder := c.Delete().Where(connectorcursor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConnectorCursorDeleteOne{builder}
+}
+
+// Query returns a query builder for ConnectorCursor.
+func (c *ConnectorCursorClient) Query() *ConnectorCursorQuery {
+	return &ConnectorCursorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConnectorCursor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConnectorCursor entity by its id.
+func (c *ConnectorCursorClient) Get(ctx context.Context, id uuid.UUID) (*ConnectorCursor, error) {
+	return c.Query().Where(connectorcursor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConnectorCursorClient) GetX(ctx context.Context, id uuid.UUID) *ConnectorCursor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConnectorCursorClient) Hooks() []Hook {
+	return c.hooks.ConnectorCursor
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConnectorCursorClient) Interceptors() []Interceptor {
+	return c.inters.ConnectorCursor
+}
+
+func (c *ConnectorCursorClient) mutate(ctx context.Context, m *ConnectorCursorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConnectorCursorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConnectorCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConnectorCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConnectorCursorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConnectorCursor mutation op: %q", m.Op())
+	}
+}
+
 // EnrichmentJobClient is a client for the EnrichmentJob schema.
 type EnrichmentJobClient struct {
 	config
@@ -263,8 +776,8 @@ func (c *EnrichmentJobClient) Update() *EnrichmentJobUpdate {
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EnrichmentJobClient) UpdateOne(ej *EnrichmentJob) *EnrichmentJobUpdateOne {
-	mutation := newEnrichmentJobMutation(c.config, OpUpdateOne, withEnrichmentJob(ej))
+func (c *EnrichmentJobClient) UpdateOne(_m *EnrichmentJob) *EnrichmentJobUpdateOne {
+	mutation := newEnrichmentJobMutation(c.config, OpUpdateOne, withEnrichmentJob(_m))
 	return &EnrichmentJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -281,8 +794,8 @@ func (c *EnrichmentJobClient) Delete() *EnrichmentJobDelete {
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EnrichmentJobClient) DeleteOne(ej *EnrichmentJob) *EnrichmentJobDeleteOne {
-	return c.DeleteOneID(ej.ID)
+func (c *EnrichmentJobClient) DeleteOne(_m *EnrichmentJob) *EnrichmentJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
@@ -317,16 +830,16 @@ func (c *EnrichmentJobClient) GetX(ctx context.Context, id uuid.UUID) *Enrichmen
 }
 
 // QueryExperience queries the experience edge of a EnrichmentJob.
-func (c *EnrichmentJobClient) QueryExperience(ej *EnrichmentJob) *ExperienceDataQuery {
+func (c *EnrichmentJobClient) QueryExperience(_m *EnrichmentJob) *ExperienceDataQuery {
 	query := (&ExperienceDataClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ej.ID
+		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(enrichmentjob.Table, enrichmentjob.FieldID, id),
 			sqlgraph.To(experiencedata.Table, experiencedata.FieldID),
 			sqlgraph.Edge(sqlgraph.M2O, false, enrichmentjob.ExperienceTable, enrichmentjob.ExperienceColumn),
 		)
-		fromV = sqlgraph.Neighbors(ej.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
@@ -412,8 +925,8 @@ func (c *ExperienceDataClient) Update() *ExperienceDataUpdate {
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ExperienceDataClient) UpdateOne(ed *ExperienceData) *ExperienceDataUpdateOne {
-	mutation := newExperienceDataMutation(c.config, OpUpdateOne, withExperienceData(ed))
+func (c *ExperienceDataClient) UpdateOne(_m *ExperienceData) *ExperienceDataUpdateOne {
+	mutation := newExperienceDataMutation(c.config, OpUpdateOne, withExperienceData(_m))
 	return &ExperienceDataUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -430,8 +943,8 @@ func (c *ExperienceDataClient) Delete() *ExperienceDataDelete {
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ExperienceDataClient) DeleteOne(ed *ExperienceData) *ExperienceDataDeleteOne {
-	return c.DeleteOneID(ed.ID)
+func (c *ExperienceDataClient) DeleteOne(_m *ExperienceData) *ExperienceDataDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
@@ -465,6 +978,22 @@ func (c *ExperienceDataClient) GetX(ctx context.Context, id uuid.UUID) *Experien
 	return obj
 }
 
+// QueryQuestion queries the question edge of a ExperienceData.
+func (c *ExperienceDataClient) QueryQuestion(_m *ExperienceData) *QuestionQuery {
+	query := (&QuestionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(experiencedata.Table, experiencedata.FieldID, id),
+			sqlgraph.To(question.Table, question.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, experiencedata.QuestionTable, experiencedata.QuestionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ExperienceDataClient) Hooks() []Hook {
 	return c.hooks.ExperienceData
@@ -490,12 +1019,1378 @@ func (c *ExperienceDataClient) mutate(ctx context.Context, m *ExperienceDataMuta
 	}
 }
 
+// ExperienceDeletionClient is a client for the ExperienceDeletion schema.
+type ExperienceDeletionClient struct {
+	config
+}
+
+// NewExperienceDeletionClient returns a client for the ExperienceDeletion from the given config.
+func NewExperienceDeletionClient(c config) *ExperienceDeletionClient {
+	return &ExperienceDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `experiencedeletion.Hooks(f(g(h())))`.
+func (c *ExperienceDeletionClient) Use(hooks ...Hook) {
+	c.hooks.ExperienceDeletion = append(c.hooks.ExperienceDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `experiencedeletion.Intercept(f(g(h())))`.
+func (c *ExperienceDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExperienceDeletion = append(c.inters.ExperienceDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a ExperienceDeletion entity.
+func (c *ExperienceDeletionClient) Create() *ExperienceDeletionCreate {
+	mutation := newExperienceDeletionMutation(c.config, OpCreate)
+	return &ExperienceDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExperienceDeletion entities.
+func (c *ExperienceDeletionClient) CreateBulk(builders ...*ExperienceDeletionCreate) *ExperienceDeletionCreateBulk {
+	return &ExperienceDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExperienceDeletionClient) MapCreateBulk(slice any, setFunc func(*ExperienceDeletionCreate, int)) *ExperienceDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExperienceDeletionCreateBulk{err: fmt.Errorf("calling to ExperienceDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExperienceDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExperienceDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExperienceDeletion.
+func (c *ExperienceDeletionClient) Update() *ExperienceDeletionUpdate {
+	mutation := newExperienceDeletionMutation(c.config, OpUpdate)
+	return &ExperienceDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExperienceDeletionClient) UpdateOne(_m *ExperienceDeletion) *ExperienceDeletionUpdateOne {
+	mutation := newExperienceDeletionMutation(c.config, OpUpdateOne, withExperienceDeletion(_m))
+	return &ExperienceDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExperienceDeletionClient) UpdateOneID(id uuid.UUID) *ExperienceDeletionUpdateOne {
+	mutation := newExperienceDeletionMutation(c.config, OpUpdateOne, withExperienceDeletionID(id))
+	return &ExperienceDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExperienceDeletion.
+func (c *ExperienceDeletionClient) Delete() *ExperienceDeletionDelete {
+	mutation := newExperienceDeletionMutation(c.config, OpDelete)
+	return &ExperienceDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExperienceDeletionClient) DeleteOne(_m *ExperienceDeletion) *ExperienceDeletionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExperienceDeletionClient) DeleteOneID(id uuid.UUID) *ExperienceDeletionDeleteOne {
+	builder := c.Delete().Where(experiencedeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExperienceDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for ExperienceDeletion.
+func (c *ExperienceDeletionClient) Query() *ExperienceDeletionQuery {
+	return &ExperienceDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExperienceDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExperienceDeletion entity by its id.
+func (c *ExperienceDeletionClient) Get(ctx context.Context, id uuid.UUID) (*ExperienceDeletion, error) {
+	return c.Query().Where(experiencedeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExperienceDeletionClient) GetX(ctx context.Context, id uuid.UUID) *ExperienceDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExperienceDeletionClient) Hooks() []Hook {
+	return c.hooks.ExperienceDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExperienceDeletionClient) Interceptors() []Interceptor {
+	return c.inters.ExperienceDeletion
+}
+
+func (c *ExperienceDeletionClient) mutate(ctx context.Context, m *ExperienceDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExperienceDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExperienceDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExperienceDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExperienceDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExperienceDeletion mutation op: %q", m.Op())
+	}
+}
+
+// ExportClient is a client for the Export schema.
+type ExportClient struct {
+	config
+}
+
+// NewExportClient returns a client for the Export from the given config.
+func NewExportClient(c config) *ExportClient {
+	return &ExportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `export.Hooks(f(g(h())))`.
+func (c *ExportClient) Use(hooks ...Hook) {
+	c.hooks.Export = append(c.hooks.Export, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `export.Intercept(f(g(h())))`.
+func (c *ExportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Export = append(c.inters.Export, interceptors...)
+}
+
+// Create returns a builder for creating a Export entity.
+func (c *ExportClient) Create() *ExportCreate {
+	mutation := newExportMutation(c.config, OpCreate)
+	return &ExportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Export entities.
+func (c *ExportClient) CreateBulk(builders ...*ExportCreate) *ExportCreateBulk {
+	return &ExportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExportClient) MapCreateBulk(slice any, setFunc func(*ExportCreate, int)) *ExportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExportCreateBulk{err: fmt.Errorf("calling to ExportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Export.
+func (c *ExportClient) Update() *ExportUpdate {
+	mutation := newExportMutation(c.config, OpUpdate)
+	return &ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExportClient) UpdateOne(_m *Export) *ExportUpdateOne {
+	mutation := newExportMutation(c.config, OpUpdateOne, withExport(_m))
+	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExportClient) UpdateOneID(id uuid.UUID) *ExportUpdateOne {
+	mutation := newExportMutation(c.config, OpUpdateOne, withExportID(id))
+	return &ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Export.
+func (c *ExportClient) Delete() *ExportDelete {
+	mutation := newExportMutation(c.config, OpDelete)
+	return &ExportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExportClient) DeleteOne(_m *Export) *ExportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExportClient) DeleteOneID(id uuid.UUID) *ExportDeleteOne {
+	builder := c.Delete().Where(export.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExportDeleteOne{builder}
+}
+
+// Query returns a query builder for Export.
+func (c *ExportClient) Query() *ExportQuery {
+	return &ExportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Export entity by its id.
+func (c *ExportClient) Get(ctx context.Context, id uuid.UUID) (*Export, error) {
+	return c.Query().Where(export.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExportClient) GetX(ctx context.Context, id uuid.UUID) *Export {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExportClient) Hooks() []Hook {
+	return c.hooks.Export
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExportClient) Interceptors() []Interceptor {
+	return c.inters.Export
+}
+
+func (c *ExportClient) mutate(ctx context.Context, m *ExportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Export mutation op: %q", m.Op())
+	}
+}
+
+// ExportRunClient is a client for the ExportRun schema.
+type ExportRunClient struct {
+	config
+}
+
+// NewExportRunClient returns a client for the ExportRun from the given config.
+func NewExportRunClient(c config) *ExportRunClient {
+	return &ExportRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exportrun.Hooks(f(g(h())))`.
+func (c *ExportRunClient) Use(hooks ...Hook) {
+	c.hooks.ExportRun = append(c.hooks.ExportRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exportrun.Intercept(f(g(h())))`.
+func (c *ExportRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExportRun = append(c.inters.ExportRun, interceptors...)
+}
+
+// Create returns a builder for creating a ExportRun entity.
+func (c *ExportRunClient) Create() *ExportRunCreate {
+	mutation := newExportRunMutation(c.config, OpCreate)
+	return &ExportRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExportRun entities.
+func (c *ExportRunClient) CreateBulk(builders ...*ExportRunCreate) *ExportRunCreateBulk {
+	return &ExportRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExportRunClient) MapCreateBulk(slice any, setFunc func(*ExportRunCreate, int)) *ExportRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExportRunCreateBulk{err: fmt.Errorf("calling to ExportRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExportRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExportRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExportRun.
+func (c *ExportRunClient) Update() *ExportRunUpdate {
+	mutation := newExportRunMutation(c.config, OpUpdate)
+	return &ExportRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExportRunClient) UpdateOne(_m *ExportRun) *ExportRunUpdateOne {
+	mutation := newExportRunMutation(c.config, OpUpdateOne, withExportRun(_m))
+	return &ExportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExportRunClient) UpdateOneID(id uuid.UUID) *ExportRunUpdateOne {
+	mutation := newExportRunMutation(c.config, OpUpdateOne, withExportRunID(id))
+	return &ExportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExportRun.
+func (c *ExportRunClient) Delete() *ExportRunDelete {
+	mutation := newExportRunMutation(c.config, OpDelete)
+	return &ExportRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExportRunClient) DeleteOne(_m *ExportRun) *ExportRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExportRunClient) DeleteOneID(id uuid.UUID) *ExportRunDeleteOne {
+	builder := c.Delete().Where(exportrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExportRunDeleteOne{builder}
+}
+
+// Query returns a query builder for ExportRun.
+func (c *ExportRunClient) Query() *ExportRunQuery {
+	return &ExportRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExportRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExportRun entity by its id.
+func (c *ExportRunClient) Get(ctx context.Context, id uuid.UUID) (*ExportRun, error) {
+	return c.Query().Where(exportrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExportRunClient) GetX(ctx context.Context, id uuid.UUID) *ExportRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryExport queries the export edge of a ExportRun.
+func (c *ExportRunClient) QueryExport(_m *ExportRun) *ExportQuery {
+	query := (&ExportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exportrun.Table, exportrun.FieldID, id),
+			sqlgraph.To(export.Table, export.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, exportrun.ExportTable, exportrun.ExportColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExportRunClient) Hooks() []Hook {
+	return c.hooks.ExportRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExportRunClient) Interceptors() []Interceptor {
+	return c.inters.ExportRun
+}
+
+func (c *ExportRunClient) mutate(ctx context.Context, m *ExportRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExportRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExportRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExportRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExportRun mutation op: %q", m.Op())
+	}
+}
+
+// IngestMappingClient is a client for the IngestMapping schema.
+type IngestMappingClient struct {
+	config
+}
+
+// NewIngestMappingClient returns a client for the IngestMapping from the given config.
+func NewIngestMappingClient(c config) *IngestMappingClient {
+	return &IngestMappingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ingestmapping.Hooks(f(g(h())))`.
+func (c *IngestMappingClient) Use(hooks ...Hook) {
+	c.hooks.IngestMapping = append(c.hooks.IngestMapping, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ingestmapping.Intercept(f(g(h())))`.
+func (c *IngestMappingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IngestMapping = append(c.inters.IngestMapping, interceptors...)
+}
+
+// Create returns a builder for creating a IngestMapping entity.
+func (c *IngestMappingClient) Create() *IngestMappingCreate {
+	mutation := newIngestMappingMutation(c.config, OpCreate)
+	return &IngestMappingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IngestMapping entities.
+func (c *IngestMappingClient) CreateBulk(builders ...*IngestMappingCreate) *IngestMappingCreateBulk {
+	return &IngestMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IngestMappingClient) MapCreateBulk(slice any, setFunc func(*IngestMappingCreate, int)) *IngestMappingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IngestMappingCreateBulk{err: fmt.Errorf("calling to IngestMappingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IngestMappingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IngestMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IngestMapping.
+func (c *IngestMappingClient) Update() *IngestMappingUpdate {
+	mutation := newIngestMappingMutation(c.config, OpUpdate)
+	return &IngestMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IngestMappingClient) UpdateOne(_m *IngestMapping) *IngestMappingUpdateOne {
+	mutation := newIngestMappingMutation(c.config, OpUpdateOne, withIngestMapping(_m))
+	return &IngestMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IngestMappingClient) UpdateOneID(id uuid.UUID) *IngestMappingUpdateOne {
+	mutation := newIngestMappingMutation(c.config, OpUpdateOne, withIngestMappingID(id))
+	return &IngestMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IngestMapping.
+func (c *IngestMappingClient) Delete() *IngestMappingDelete {
+	mutation := newIngestMappingMutation(c.config, OpDelete)
+	return &IngestMappingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IngestMappingClient) DeleteOne(_m *IngestMapping) *IngestMappingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IngestMappingClient) DeleteOneID(id uuid.UUID) *IngestMappingDeleteOne {
+	builder := c.Delete().Where(ingestmapping.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IngestMappingDeleteOne{builder}
+}
+
+// Query returns a query builder for IngestMapping.
+func (c *IngestMappingClient) Query() *IngestMappingQuery {
+	return &IngestMappingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIngestMapping},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IngestMapping entity by its id.
+func (c *IngestMappingClient) Get(ctx context.Context, id uuid.UUID) (*IngestMapping, error) {
+	return c.Query().Where(ingestmapping.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IngestMappingClient) GetX(ctx context.Context, id uuid.UUID) *IngestMapping {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IngestMappingClient) Hooks() []Hook {
+	return c.hooks.IngestMapping
+}
+
+// Interceptors returns the client interceptors.
+func (c *IngestMappingClient) Interceptors() []Interceptor {
+	return c.inters.IngestMapping
+}
+
+func (c *IngestMappingClient) mutate(ctx context.Context, m *IngestMappingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IngestMappingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IngestMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IngestMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IngestMappingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IngestMapping mutation op: %q", m.Op())
+	}
+}
+
+// QuestionClient is a client for the Question schema.
+type QuestionClient struct {
+	config
+}
+
+// NewQuestionClient returns a client for the Question from the given config.
+func NewQuestionClient(c config) *QuestionClient {
+	return &QuestionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `question.Hooks(f(g(h())))`.
+func (c *QuestionClient) Use(hooks ...Hook) {
+	c.hooks.Question = append(c.hooks.Question, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `question.Intercept(f(g(h())))`.
+func (c *QuestionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Question = append(c.inters.Question, interceptors...)
+}
+
+// Create returns a builder for creating a Question entity.
+func (c *QuestionClient) Create() *QuestionCreate {
+	mutation := newQuestionMutation(c.config, OpCreate)
+	return &QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Question entities.
+func (c *QuestionClient) CreateBulk(builders ...*QuestionCreate) *QuestionCreateBulk {
+	return &QuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestionClient) MapCreateBulk(slice any, setFunc func(*QuestionCreate, int)) *QuestionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestionCreateBulk{err: fmt.Errorf("calling to QuestionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Question.
+func (c *QuestionClient) Update() *QuestionUpdate {
+	mutation := newQuestionMutation(c.config, OpUpdate)
+	return &QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestionClient) UpdateOne(_m *Question) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestion(_m))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestionClient) UpdateOneID(id uuid.UUID) *QuestionUpdateOne {
+	mutation := newQuestionMutation(c.config, OpUpdateOne, withQuestionID(id))
+	return &QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Question.
+func (c *QuestionClient) Delete() *QuestionDelete {
+	mutation := newQuestionMutation(c.config, OpDelete)
+	return &QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestionClient) DeleteOne(_m *Question) *QuestionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionClient) DeleteOneID(id uuid.UUID) *QuestionDeleteOne {
+	builder := c.Delete().Where(question.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionDeleteOne{builder}
+}
+
+// Query returns a query builder for Question.
+func (c *QuestionClient) Query() *QuestionQuery {
+	return &QuestionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Question entity by its id.
+func (c *QuestionClient) Get(ctx context.Context, id uuid.UUID) (*Question, error) {
+	return c.Query().Where(question.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionClient) GetX(ctx context.Context, id uuid.UUID) *Question {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionClient) Hooks() []Hook {
+	return c.hooks.Question
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionClient) Interceptors() []Interceptor {
+	return c.inters.Question
+}
+
+func (c *QuestionClient) mutate(ctx context.Context, m *QuestionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Question mutation op: %q", m.Op())
+	}
+}
+
+// QueuePauseClient is a client for the QueuePause schema.
+type QueuePauseClient struct {
+	config
+}
+
+// NewQueuePauseClient returns a client for the QueuePause from the given config.
+func NewQueuePauseClient(c config) *QueuePauseClient {
+	return &QueuePauseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `queuepause.Hooks(f(g(h())))`.
+func (c *QueuePauseClient) Use(hooks ...Hook) {
+	c.hooks.QueuePause = append(c.hooks.QueuePause, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `queuepause.Intercept(f(g(h())))`.
+func (c *QueuePauseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QueuePause = append(c.inters.QueuePause, interceptors...)
+}
+
+// Create returns a builder for creating a QueuePause entity.
+func (c *QueuePauseClient) Create() *QueuePauseCreate {
+	mutation := newQueuePauseMutation(c.config, OpCreate)
+	return &QueuePauseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QueuePause entities.
+func (c *QueuePauseClient) CreateBulk(builders ...*QueuePauseCreate) *QueuePauseCreateBulk {
+	return &QueuePauseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QueuePauseClient) MapCreateBulk(slice any, setFunc func(*QueuePauseCreate, int)) *QueuePauseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QueuePauseCreateBulk{err: fmt.Errorf("calling to QueuePauseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QueuePauseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QueuePauseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QueuePause.
+func (c *QueuePauseClient) Update() *QueuePauseUpdate {
+	mutation := newQueuePauseMutation(c.config, OpUpdate)
+	return &QueuePauseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QueuePauseClient) UpdateOne(_m *QueuePause) *QueuePauseUpdateOne {
+	mutation := newQueuePauseMutation(c.config, OpUpdateOne, withQueuePause(_m))
+	return &QueuePauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QueuePauseClient) UpdateOneID(id uuid.UUID) *QueuePauseUpdateOne {
+	mutation := newQueuePauseMutation(c.config, OpUpdateOne, withQueuePauseID(id))
+	return &QueuePauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QueuePause.
+func (c *QueuePauseClient) Delete() *QueuePauseDelete {
+	mutation := newQueuePauseMutation(c.config, OpDelete)
+	return &QueuePauseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QueuePauseClient) DeleteOne(_m *QueuePause) *QueuePauseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QueuePauseClient) DeleteOneID(id uuid.UUID) *QueuePauseDeleteOne {
+	builder := c.Delete().Where(queuepause.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QueuePauseDeleteOne{builder}
+}
+
+// Query returns a query builder for QueuePause.
+func (c *QueuePauseClient) Query() *QueuePauseQuery {
+	return &QueuePauseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQueuePause},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QueuePause entity by its id.
+func (c *QueuePauseClient) Get(ctx context.Context, id uuid.UUID) (*QueuePause, error) {
+	return c.Query().Where(queuepause.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QueuePauseClient) GetX(ctx context.Context, id uuid.UUID) *QueuePause {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *QueuePauseClient) Hooks() []Hook {
+	return c.hooks.QueuePause
+}
+
+// Interceptors returns the client interceptors.
+func (c *QueuePauseClient) Interceptors() []Interceptor {
+	return c.inters.QueuePause
+}
+
+func (c *QueuePauseClient) mutate(ctx context.Context, m *QueuePauseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QueuePauseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QueuePauseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QueuePauseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QueuePauseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QueuePause mutation op: %q", m.Op())
+	}
+}
+
+// SegmentClient is a client for the Segment schema.
+type SegmentClient struct {
+	config
+}
+
+// NewSegmentClient returns a client for the Segment from the given config.
+func NewSegmentClient(c config) *SegmentClient {
+	return &SegmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `segment.Hooks(f(g(h())))`.
+func (c *SegmentClient) Use(hooks ...Hook) {
+	c.hooks.Segment = append(c.hooks.Segment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `segment.Intercept(f(g(h())))`.
+func (c *SegmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Segment = append(c.inters.Segment, interceptors...)
+}
+
+// Create returns a builder for creating a Segment entity.
+func (c *SegmentClient) Create() *SegmentCreate {
+	mutation := newSegmentMutation(c.config, OpCreate)
+	return &SegmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Segment entities.
+func (c *SegmentClient) CreateBulk(builders ...*SegmentCreate) *SegmentCreateBulk {
+	return &SegmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SegmentClient) MapCreateBulk(slice any, setFunc func(*SegmentCreate, int)) *SegmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SegmentCreateBulk{err: fmt.Errorf("calling to SegmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SegmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SegmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Segment.
+func (c *SegmentClient) Update() *SegmentUpdate {
+	mutation := newSegmentMutation(c.config, OpUpdate)
+	return &SegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SegmentClient) UpdateOne(_m *Segment) *SegmentUpdateOne {
+	mutation := newSegmentMutation(c.config, OpUpdateOne, withSegment(_m))
+	return &SegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SegmentClient) UpdateOneID(id uuid.UUID) *SegmentUpdateOne {
+	mutation := newSegmentMutation(c.config, OpUpdateOne, withSegmentID(id))
+	return &SegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Segment.
+func (c *SegmentClient) Delete() *SegmentDelete {
+	mutation := newSegmentMutation(c.config, OpDelete)
+	return &SegmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SegmentClient) DeleteOne(_m *Segment) *SegmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SegmentClient) DeleteOneID(id uuid.UUID) *SegmentDeleteOne {
+	builder := c.Delete().Where(segment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SegmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Segment.
+func (c *SegmentClient) Query() *SegmentQuery {
+	return &SegmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSegment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Segment entity by its id.
+func (c *SegmentClient) Get(ctx context.Context, id uuid.UUID) (*Segment, error) {
+	return c.Query().Where(segment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SegmentClient) GetX(ctx context.Context, id uuid.UUID) *Segment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SegmentClient) Hooks() []Hook {
+	return c.hooks.Segment
+}
+
+// Interceptors returns the client interceptors.
+func (c *SegmentClient) Interceptors() []Interceptor {
+	return c.inters.Segment
+}
+
+func (c *SegmentClient) mutate(ctx context.Context, m *SegmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SegmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SegmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Segment mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEndpoint queries the endpoint edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryEndpoint(_m *WebhookDelivery) *WebhookEndpointQuery {
+	query := (&WebhookEndpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhookendpoint.Table, webhookendpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, webhookdelivery.EndpointTable, webhookdelivery.EndpointColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEndpointClient is a client for the WebhookEndpoint schema.
+type WebhookEndpointClient struct {
+	config
+}
+
+// NewWebhookEndpointClient returns a client for the WebhookEndpoint from the given config.
+func NewWebhookEndpointClient(c config) *WebhookEndpointClient {
+	return &WebhookEndpointClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookendpoint.Hooks(f(g(h())))`.
+func (c *WebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEndpoint = append(c.hooks.WebhookEndpoint, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookendpoint.Intercept(f(g(h())))`.
+func (c *WebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEndpoint = append(c.inters.WebhookEndpoint, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEndpoint entity.
+func (c *WebhookEndpointClient) Create() *WebhookEndpointCreate {
+	mutation := newWebhookEndpointMutation(c.config, OpCreate)
+	return &WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEndpoint entities.
+func (c *WebhookEndpointClient) CreateBulk(builders ...*WebhookEndpointCreate) *WebhookEndpointCreateBulk {
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*WebhookEndpointCreate, int)) *WebhookEndpointCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEndpointCreateBulk{err: fmt.Errorf("calling to WebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEndpointCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Update() *WebhookEndpointUpdate {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdate)
+	return &WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEndpointClient) UpdateOne(_m *WebhookEndpoint) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpoint(_m))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEndpointClient) UpdateOneID(id uuid.UUID) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpointID(id))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Delete() *WebhookEndpointDelete {
+	mutation := newWebhookEndpointMutation(c.config, OpDelete)
+	return &WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEndpointClient) DeleteOne(_m *WebhookEndpoint) *WebhookEndpointDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEndpointClient) DeleteOneID(id uuid.UUID) *WebhookEndpointDeleteOne {
+	builder := c.Delete().Where(webhookendpoint.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEndpointDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Query() *WebhookEndpointQuery {
+	return &WebhookEndpointQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEndpoint},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEndpoint entity by its id.
+func (c *WebhookEndpointClient) Get(ctx context.Context, id uuid.UUID) (*WebhookEndpoint, error) {
+	return c.Query().Where(webhookendpoint.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEndpointClient) GetX(ctx context.Context, id uuid.UUID) *WebhookEndpoint {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEndpointClient) Hooks() []Hook {
+	return c.hooks.WebhookEndpoint
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEndpoint
+}
+
+func (c *WebhookEndpointClient) mutate(ctx context.Context, m *WebhookEndpointMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookEndpoint mutation op: %q", m.Op())
+	}
+}
+
+// WorkerClient is a client for the Worker schema.
+type WorkerClient struct {
+	config
+}
+
+// NewWorkerClient returns a client for the Worker from the given config.
+func NewWorkerClient(c config) *WorkerClient {
+	return &WorkerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `worker.Hooks(f(g(h())))`.
+func (c *WorkerClient) Use(hooks ...Hook) {
+	c.hooks.Worker = append(c.hooks.Worker, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `worker.Intercept(f(g(h())))`.
+func (c *WorkerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Worker = append(c.inters.Worker, interceptors...)
+}
+
+// Create returns a builder for creating a Worker entity.
+func (c *WorkerClient) Create() *WorkerCreate {
+	mutation := newWorkerMutation(c.config, OpCreate)
+	return &WorkerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Worker entities.
+func (c *WorkerClient) CreateBulk(builders ...*WorkerCreate) *WorkerCreateBulk {
+	return &WorkerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkerClient) MapCreateBulk(slice any, setFunc func(*WorkerCreate, int)) *WorkerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkerCreateBulk{err: fmt.Errorf("calling to WorkerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Worker.
+func (c *WorkerClient) Update() *WorkerUpdate {
+	mutation := newWorkerMutation(c.config, OpUpdate)
+	return &WorkerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkerClient) UpdateOne(_m *Worker) *WorkerUpdateOne {
+	mutation := newWorkerMutation(c.config, OpUpdateOne, withWorker(_m))
+	return &WorkerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkerClient) UpdateOneID(id uuid.UUID) *WorkerUpdateOne {
+	mutation := newWorkerMutation(c.config, OpUpdateOne, withWorkerID(id))
+	return &WorkerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Worker.
+func (c *WorkerClient) Delete() *WorkerDelete {
+	mutation := newWorkerMutation(c.config, OpDelete)
+	return &WorkerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkerClient) DeleteOne(_m *Worker) *WorkerDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkerClient) DeleteOneID(id uuid.UUID) *WorkerDeleteOne {
+	builder := c.Delete().Where(worker.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkerDeleteOne{builder}
+}
+
+// Query returns a query builder for Worker.
+func (c *WorkerClient) Query() *WorkerQuery {
+	return &WorkerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorker},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Worker entity by its id.
+func (c *WorkerClient) Get(ctx context.Context, id uuid.UUID) (*Worker, error) {
+	return c.Query().Where(worker.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkerClient) GetX(ctx context.Context, id uuid.UUID) *Worker {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WorkerClient) Hooks() []Hook {
+	return c.hooks.Worker
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkerClient) Interceptors() []Interceptor {
+	return c.inters.Worker
+}
+
+func (c *WorkerClient) mutate(ctx context.Context, m *WorkerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Worker mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		EnrichmentJob, ExperienceData []ent.Hook
+		AIUsage, AuditLog, ConnectorCursor, EnrichmentJob, ExperienceData,
+		ExperienceDeletion, Export, ExportRun, IngestMapping, Question, QueuePause,
+		Segment, WebhookDelivery, WebhookEndpoint, Worker []ent.Hook
 	}
 	inters struct {
-		EnrichmentJob, ExperienceData []ent.Interceptor
+		AIUsage, AuditLog, ConnectorCursor, EnrichmentJob, ExperienceData,
+		ExperienceDeletion, Export, ExportRun, IngestMapping, Question, QueuePause,
+		Segment, WebhookDelivery, WebhookEndpoint, Worker []ent.Interceptor
 	}
 )
@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
+	"github.com/google/uuid"
+)
+
+// ConnectorCursor is the model entity for the ConnectorCursor schema.
+type ConnectorCursor struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Synced stream, e.g. zendesk.ticket_comments
+	Stream string `json:"stream,omitempty"`
+	// Position in the stream, as understood by the connector
+	Cursor       string `json:"cursor,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ConnectorCursor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case connectorcursor.FieldStream, connectorcursor.FieldCursor:
+			values[i] = new(sql.NullString)
+		case connectorcursor.FieldCreatedAt, connectorcursor.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case connectorcursor.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ConnectorCursor fields.
+func (_m *ConnectorCursor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case connectorcursor.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case connectorcursor.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case connectorcursor.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case connectorcursor.FieldStream:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stream", values[i])
+			} else if value.Valid {
+				_m.Stream = value.String
+			}
+		case connectorcursor.FieldCursor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cursor", values[i])
+			} else if value.Valid {
+				_m.Cursor = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ConnectorCursor.
+// This includes values selected through modifiers, order, etc.
+func (_m *ConnectorCursor) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ConnectorCursor.
+// Note that you need to call ConnectorCursor.Unwrap() before calling this method if this ConnectorCursor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ConnectorCursor) Update() *ConnectorCursorUpdateOne {
+	return NewConnectorCursorClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ConnectorCursor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ConnectorCursor) Unwrap() *ConnectorCursor {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ConnectorCursor is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ConnectorCursor) String() string {
+	var builder strings.Builder
+	builder.WriteString("ConnectorCursor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("stream=")
+	builder.WriteString(_m.Stream)
+	builder.WriteString(", ")
+	builder.WriteString("cursor=")
+	builder.WriteString(_m.Cursor)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ConnectorCursors is a parsable slice of ConnectorCursor.
+type ConnectorCursors []*ConnectorCursor
@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package connectorcursor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Stream applies equality check predicate on the "stream" field. It's identical to StreamEQ.
+func Stream(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldStream, v))
+}
+
+// Cursor applies equality check predicate on the "cursor" field. It's identical to CursorEQ.
+func Cursor(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldCursor, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StreamEQ applies the EQ predicate on the "stream" field.
+func StreamEQ(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldStream, v))
+}
+
+// StreamNEQ applies the NEQ predicate on the "stream" field.
+func StreamNEQ(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNEQ(FieldStream, v))
+}
+
+// StreamIn applies the In predicate on the "stream" field.
+func StreamIn(vs ...string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldIn(FieldStream, vs...))
+}
+
+// StreamNotIn applies the NotIn predicate on the "stream" field.
+func StreamNotIn(vs ...string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNotIn(FieldStream, vs...))
+}
+
+// StreamGT applies the GT predicate on the "stream" field.
+func StreamGT(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGT(FieldStream, v))
+}
+
+// StreamGTE applies the GTE predicate on the "stream" field.
+func StreamGTE(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGTE(FieldStream, v))
+}
+
+// StreamLT applies the LT predicate on the "stream" field.
+func StreamLT(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLT(FieldStream, v))
+}
+
+// StreamLTE applies the LTE predicate on the "stream" field.
+func StreamLTE(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLTE(FieldStream, v))
+}
+
+// StreamContains applies the Contains predicate on the "stream" field.
+func StreamContains(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldContains(FieldStream, v))
+}
+
+// StreamHasPrefix applies the HasPrefix predicate on the "stream" field.
+func StreamHasPrefix(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldHasPrefix(FieldStream, v))
+}
+
+// StreamHasSuffix applies the HasSuffix predicate on the "stream" field.
+func StreamHasSuffix(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldHasSuffix(FieldStream, v))
+}
+
+// StreamEqualFold applies the EqualFold predicate on the "stream" field.
+func StreamEqualFold(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEqualFold(FieldStream, v))
+}
+
+// StreamContainsFold applies the ContainsFold predicate on the "stream" field.
+func StreamContainsFold(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldContainsFold(FieldStream, v))
+}
+
+// CursorEQ applies the EQ predicate on the "cursor" field.
+func CursorEQ(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEQ(FieldCursor, v))
+}
+
+// CursorNEQ applies the NEQ predicate on the "cursor" field.
+func CursorNEQ(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNEQ(FieldCursor, v))
+}
+
+// CursorIn applies the In predicate on the "cursor" field.
+func CursorIn(vs ...string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldIn(FieldCursor, vs...))
+}
+
+// CursorNotIn applies the NotIn predicate on the "cursor" field.
+func CursorNotIn(vs ...string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldNotIn(FieldCursor, vs...))
+}
+
+// CursorGT applies the GT predicate on the "cursor" field.
+func CursorGT(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGT(FieldCursor, v))
+}
+
+// CursorGTE applies the GTE predicate on the "cursor" field.
+func CursorGTE(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldGTE(FieldCursor, v))
+}
+
+// CursorLT applies the LT predicate on the "cursor" field.
+func CursorLT(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLT(FieldCursor, v))
+}
+
+// CursorLTE applies the LTE predicate on the "cursor" field.
+func CursorLTE(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldLTE(FieldCursor, v))
+}
+
+// CursorContains applies the Contains predicate on the "cursor" field.
+func CursorContains(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldContains(FieldCursor, v))
+}
+
+// CursorHasPrefix applies the HasPrefix predicate on the "cursor" field.
+func CursorHasPrefix(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldHasPrefix(FieldCursor, v))
+}
+
+// CursorHasSuffix applies the HasSuffix predicate on the "cursor" field.
+func CursorHasSuffix(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldHasSuffix(FieldCursor, v))
+}
+
+// CursorEqualFold applies the EqualFold predicate on the "cursor" field.
+func CursorEqualFold(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldEqualFold(FieldCursor, v))
+}
+
+// CursorContainsFold applies the ContainsFold predicate on the "cursor" field.
+func CursorContainsFold(v string) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.FieldContainsFold(FieldCursor, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConnectorCursor) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConnectorCursor) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConnectorCursor) predicate.ConnectorCursor {
+	return predicate.ConnectorCursor(sql.NotPredicates(p))
+}
@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
+	"github.com/google/uuid"
+)
+
+// ConnectorCursorCreate is the builder for creating a ConnectorCursor entity.
+type ConnectorCursorCreate struct {
+	config
+	mutation *ConnectorCursorMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConnectorCursorCreate) SetCreatedAt(v time.Time) *ConnectorCursorCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConnectorCursorCreate) SetNillableCreatedAt(v *time.Time) *ConnectorCursorCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConnectorCursorCreate) SetUpdatedAt(v time.Time) *ConnectorCursorCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConnectorCursorCreate) SetNillableUpdatedAt(v *time.Time) *ConnectorCursorCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStream sets the "stream" field.
+func (_c *ConnectorCursorCreate) SetStream(v string) *ConnectorCursorCreate {
+	_c.mutation.SetStream(v)
+	return _c
+}
+
+// SetCursor sets the "cursor" field.
+func (_c *ConnectorCursorCreate) SetCursor(v string) *ConnectorCursorCreate {
+	_c.mutation.SetCursor(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConnectorCursorCreate) SetID(v uuid.UUID) *ConnectorCursorCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ConnectorCursorCreate) SetNillableID(v *uuid.UUID) *ConnectorCursorCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ConnectorCursorMutation object of the builder.
+func (_c *ConnectorCursorCreate) Mutation() *ConnectorCursorMutation {
+	return _c.mutation
+}
+
+// Save creates the ConnectorCursor in the database.
+func (_c *ConnectorCursorCreate) Save(ctx context.Context) (*ConnectorCursor, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConnectorCursorCreate) SaveX(ctx context.Context) *ConnectorCursor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConnectorCursorCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConnectorCursorCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConnectorCursorCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := connectorcursor.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := connectorcursor.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := connectorcursor.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConnectorCursorCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConnectorCursor.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ConnectorCursor.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Stream(); !ok {
+		return &ValidationError{Name: "stream", err: errors.New(`ent: missing required field "ConnectorCursor.stream"`)}
+	}
+	if v, ok := _c.mutation.Stream(); ok {
+		if err := connectorcursor.StreamValidator(v); err != nil {
+			return &ValidationError{Name: "stream", err: fmt.Errorf(`ent: validator failed for field "ConnectorCursor.stream": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Cursor(); !ok {
+		return &ValidationError{Name: "cursor", err: errors.New(`ent: missing required field "ConnectorCursor.cursor"`)}
+	}
+	return nil
+}
+
+func (_c *ConnectorCursorCreate) sqlSave(ctx context.Context) (*ConnectorCursor, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConnectorCursorCreate) createSpec() (*ConnectorCursor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConnectorCursor{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(connectorcursor.Table, sqlgraph.NewFieldSpec(connectorcursor.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(connectorcursor.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(connectorcursor.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Stream(); ok {
+		_spec.SetField(connectorcursor.FieldStream, field.TypeString, value)
+		_node.Stream = value
+	}
+	if value, ok := _c.mutation.Cursor(); ok {
+		_spec.SetField(connectorcursor.FieldCursor, field.TypeString, value)
+		_node.Cursor = value
+	}
+	return _node, _spec
+}
+
+// ConnectorCursorCreateBulk is the builder for creating many ConnectorCursor entities in bulk.
+type ConnectorCursorCreateBulk struct {
+	config
+	err      error
+	builders []*ConnectorCursorCreate
+}
+
+// Save creates the ConnectorCursor entities in the database.
+func (_c *ConnectorCursorCreateBulk) Save(ctx context.Context) ([]*ConnectorCursor, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConnectorCursor, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConnectorCursorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConnectorCursorCreateBulk) SaveX(ctx context.Context) []*ConnectorCursor {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConnectorCursorCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConnectorCursorCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
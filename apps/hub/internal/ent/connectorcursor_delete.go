@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// ConnectorCursorDelete is the builder for deleting a ConnectorCursor entity.
+type ConnectorCursorDelete struct {
+	config
+	hooks    []Hook
+	mutation *ConnectorCursorMutation
+}
+
+// Where appends a list predicates to the ConnectorCursorDelete builder.
+func (_d *ConnectorCursorDelete) Where(ps ...predicate.ConnectorCursor) *ConnectorCursorDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ConnectorCursorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConnectorCursorDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ConnectorCursorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(connectorcursor.Table, sqlgraph.NewFieldSpec(connectorcursor.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ConnectorCursorDeleteOne is the builder for deleting a single ConnectorCursor entity.
+type ConnectorCursorDeleteOne struct {
+	_d *ConnectorCursorDelete
+}
+
+// Where appends a list predicates to the ConnectorCursorDelete builder.
+func (_d *ConnectorCursorDeleteOne) Where(ps ...predicate.ConnectorCursor) *ConnectorCursorDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ConnectorCursorDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{connectorcursor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ConnectorCursorDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
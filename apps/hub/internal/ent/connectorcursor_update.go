@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// ConnectorCursorUpdate is the builder for updating ConnectorCursor entities.
+type ConnectorCursorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConnectorCursorMutation
+}
+
+// Where appends a list predicates to the ConnectorCursorUpdate builder.
+func (_u *ConnectorCursorUpdate) Where(ps ...predicate.ConnectorCursor) *ConnectorCursorUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConnectorCursorUpdate) SetUpdatedAt(v time.Time) *ConnectorCursorUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCursor sets the "cursor" field.
+func (_u *ConnectorCursorUpdate) SetCursor(v string) *ConnectorCursorUpdate {
+	_u.mutation.SetCursor(v)
+	return _u
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (_u *ConnectorCursorUpdate) SetNillableCursor(v *string) *ConnectorCursorUpdate {
+	if v != nil {
+		_u.SetCursor(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ConnectorCursorMutation object of the builder.
+func (_u *ConnectorCursorUpdate) Mutation() *ConnectorCursorMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConnectorCursorUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConnectorCursorUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConnectorCursorUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConnectorCursorUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConnectorCursorUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := connectorcursor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ConnectorCursorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(connectorcursor.Table, connectorcursor.Columns, sqlgraph.NewFieldSpec(connectorcursor.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(connectorcursor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Cursor(); ok {
+		_spec.SetField(connectorcursor.FieldCursor, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectorcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConnectorCursorUpdateOne is the builder for updating a single ConnectorCursor entity.
+type ConnectorCursorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConnectorCursorMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConnectorCursorUpdateOne) SetUpdatedAt(v time.Time) *ConnectorCursorUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCursor sets the "cursor" field.
+func (_u *ConnectorCursorUpdateOne) SetCursor(v string) *ConnectorCursorUpdateOne {
+	_u.mutation.SetCursor(v)
+	return _u
+}
+
+// SetNillableCursor sets the "cursor" field if the given value is not nil.
+func (_u *ConnectorCursorUpdateOne) SetNillableCursor(v *string) *ConnectorCursorUpdateOne {
+	if v != nil {
+		_u.SetCursor(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ConnectorCursorMutation object of the builder.
+func (_u *ConnectorCursorUpdateOne) Mutation() *ConnectorCursorMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConnectorCursorUpdate builder.
+func (_u *ConnectorCursorUpdateOne) Where(ps ...predicate.ConnectorCursor) *ConnectorCursorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConnectorCursorUpdateOne) Select(field string, fields ...string) *ConnectorCursorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConnectorCursor entity.
+func (_u *ConnectorCursorUpdateOne) Save(ctx context.Context) (*ConnectorCursor, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConnectorCursorUpdateOne) SaveX(ctx context.Context) *ConnectorCursor {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConnectorCursorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConnectorCursorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ConnectorCursorUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := connectorcursor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *ConnectorCursorUpdateOne) sqlSave(ctx context.Context) (_node *ConnectorCursor, err error) {
+	_spec := sqlgraph.NewUpdateSpec(connectorcursor.Table, connectorcursor.Columns, sqlgraph.NewFieldSpec(connectorcursor.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConnectorCursor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, connectorcursor.FieldID)
+		for _, f := range fields {
+			if !connectorcursor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != connectorcursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(connectorcursor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Cursor(); ok {
+		_spec.SetField(connectorcursor.FieldCursor, field.TypeString, value)
+	}
+	_node = &ConnectorCursor{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{connectorcursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
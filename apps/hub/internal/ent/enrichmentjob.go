@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
 	"github.com/google/uuid"
 )
 
@@ -18,23 +20,40 @@ import (
 type EnrichmentJob struct {
 	config `json:"-"`
 	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
 	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// ExperienceID holds the value of the "experience_id" field.
 	ExperienceID uuid.UUID `json:"experience_id,omitempty"`
-	// Job type: enrichment (sentiment/topics) or embedding (vector generation)
+	// Job type: enrichment (sentiment/topics), embedding (vector generation), or a type with a registered handler
 	JobType string `json:"job_type,omitempty"`
-	// Job status: pending, processing, completed, failed
+	// Job status: pending, processing, completed, dead_letter (failed after max attempts), cancelled
 	Status string `json:"status,omitempty"`
 	// Text content to be enriched or embedded
 	Text string `json:"text,omitempty"`
-	// Error message if job failed
+	// Error message of the last failed attempt
 	Error *string `json:"error,omitempty"`
+	// Errors of all failed attempts, oldest first
+	ErrorHistory []schema.JobError `json:"error_history,omitempty"`
+	// Jobs with a higher priority are processed first (-10 backfill, 0 normal, 10 interactive)
+	Priority int `json:"priority,omitempty"`
 	// Number of processing attempts
 	Attempts int `json:"attempts,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Times the job was put back in the queue without counting as an attempt, e.g. after a rate limit
+	Requeues int `json:"requeues,omitempty"`
 	// ProcessedAt holds the value of the "processed_at" field.
 	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+	// Deadline for a processing job; after it passes the job is returned to the queue
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+	// Prompt (input) tokens used by the AI request
+	PromptTokens *int `json:"prompt_tokens,omitempty"`
+	// Completion (output) tokens used by the AI request
+	CompletionTokens *int `json:"completion_tokens,omitempty"`
+	// Estimated cost of the AI request in USD
+	CostUsd *float64 `json:"cost_usd,omitempty"`
+	// W3C traceparent of the request that enqueued the job, so its processing joins the same trace
+	TraceContext string `json:"trace_context,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnrichmentJobQuery when eager-loading is set.
 	Edges        EnrichmentJobEdges `json:"edges"`
@@ -66,11 +85,15 @@ func (*EnrichmentJob) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case enrichmentjob.FieldAttempts:
+		case enrichmentjob.FieldErrorHistory:
+			values[i] = new([]byte)
+		case enrichmentjob.FieldCostUsd:
+			values[i] = new(sql.NullFloat64)
+		case enrichmentjob.FieldPriority, enrichmentjob.FieldAttempts, enrichmentjob.FieldRequeues, enrichmentjob.FieldPromptTokens, enrichmentjob.FieldCompletionTokens:
 			values[i] = new(sql.NullInt64)
-		case enrichmentjob.FieldJobType, enrichmentjob.FieldStatus, enrichmentjob.FieldText, enrichmentjob.FieldError:
+		case enrichmentjob.FieldJobType, enrichmentjob.FieldStatus, enrichmentjob.FieldText, enrichmentjob.FieldError, enrichmentjob.FieldTraceContext:
 			values[i] = new(sql.NullString)
-		case enrichmentjob.FieldCreatedAt, enrichmentjob.FieldProcessedAt:
+		case enrichmentjob.FieldCreatedAt, enrichmentjob.FieldProcessedAt, enrichmentjob.FieldLeaseExpiresAt:
 			values[i] = new(sql.NullTime)
 		case enrichmentjob.FieldID, enrichmentjob.FieldExperienceID:
 			values[i] = new(uuid.UUID)
@@ -83,7 +106,7 @@ func (*EnrichmentJob) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the EnrichmentJob fields.
-func (ej *EnrichmentJob) assignValues(columns []string, values []any) error {
+func (_m *EnrichmentJob) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -93,60 +116,114 @@ func (ej *EnrichmentJob) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field id", values[i])
 			} else if value != nil {
-				ej.ID = *value
+				_m.ID = *value
+			}
+		case enrichmentjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
 			}
 		case enrichmentjob.FieldExperienceID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field experience_id", values[i])
 			} else if value != nil {
-				ej.ExperienceID = *value
+				_m.ExperienceID = *value
 			}
 		case enrichmentjob.FieldJobType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field job_type", values[i])
 			} else if value.Valid {
-				ej.JobType = value.String
+				_m.JobType = value.String
 			}
 		case enrichmentjob.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
-				ej.Status = value.String
+				_m.Status = value.String
 			}
 		case enrichmentjob.FieldText:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field text", values[i])
 			} else if value.Valid {
-				ej.Text = value.String
+				_m.Text = value.String
 			}
 		case enrichmentjob.FieldError:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field error", values[i])
 			} else if value.Valid {
-				ej.Error = new(string)
-				*ej.Error = value.String
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		case enrichmentjob.FieldErrorHistory:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field error_history", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ErrorHistory); err != nil {
+					return fmt.Errorf("unmarshal field error_history: %w", err)
+				}
+			}
+		case enrichmentjob.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
 			}
 		case enrichmentjob.FieldAttempts:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field attempts", values[i])
 			} else if value.Valid {
-				ej.Attempts = int(value.Int64)
+				_m.Attempts = int(value.Int64)
 			}
-		case enrichmentjob.FieldCreatedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+		case enrichmentjob.FieldRequeues:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field requeues", values[i])
 			} else if value.Valid {
-				ej.CreatedAt = value.Time
+				_m.Requeues = int(value.Int64)
 			}
 		case enrichmentjob.FieldProcessedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
 			} else if value.Valid {
-				ej.ProcessedAt = new(time.Time)
-				*ej.ProcessedAt = value.Time
+				_m.ProcessedAt = new(time.Time)
+				*_m.ProcessedAt = value.Time
+			}
+		case enrichmentjob.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expires_at", values[i])
+			} else if value.Valid {
+				_m.LeaseExpiresAt = new(time.Time)
+				*_m.LeaseExpiresAt = value.Time
+			}
+		case enrichmentjob.FieldPromptTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt_tokens", values[i])
+			} else if value.Valid {
+				_m.PromptTokens = new(int)
+				*_m.PromptTokens = int(value.Int64)
+			}
+		case enrichmentjob.FieldCompletionTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field completion_tokens", values[i])
+			} else if value.Valid {
+				_m.CompletionTokens = new(int)
+				*_m.CompletionTokens = int(value.Int64)
+			}
+		case enrichmentjob.FieldCostUsd:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_usd", values[i])
+			} else if value.Valid {
+				_m.CostUsd = new(float64)
+				*_m.CostUsd = value.Float64
+			}
+		case enrichmentjob.FieldTraceContext:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_context", values[i])
+			} else if value.Valid {
+				_m.TraceContext = value.String
 			}
 		default:
-			ej.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -154,65 +231,97 @@ func (ej *EnrichmentJob) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the EnrichmentJob.
 // This includes values selected through modifiers, order, etc.
-func (ej *EnrichmentJob) Value(name string) (ent.Value, error) {
-	return ej.selectValues.Get(name)
+func (_m *EnrichmentJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
 }
 
 // QueryExperience queries the "experience" edge of the EnrichmentJob entity.
-func (ej *EnrichmentJob) QueryExperience() *ExperienceDataQuery {
-	return NewEnrichmentJobClient(ej.config).QueryExperience(ej)
+func (_m *EnrichmentJob) QueryExperience() *ExperienceDataQuery {
+	return NewEnrichmentJobClient(_m.config).QueryExperience(_m)
 }
 
 // Update returns a builder for updating this EnrichmentJob.
 // Note that you need to call EnrichmentJob.Unwrap() before calling this method if this EnrichmentJob
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (ej *EnrichmentJob) Update() *EnrichmentJobUpdateOne {
-	return NewEnrichmentJobClient(ej.config).UpdateOne(ej)
+func (_m *EnrichmentJob) Update() *EnrichmentJobUpdateOne {
+	return NewEnrichmentJobClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the EnrichmentJob entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (ej *EnrichmentJob) Unwrap() *EnrichmentJob {
-	_tx, ok := ej.config.driver.(*txDriver)
+func (_m *EnrichmentJob) Unwrap() *EnrichmentJob {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: EnrichmentJob is not a transactional entity")
 	}
-	ej.config.driver = _tx.drv
-	return ej
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (ej *EnrichmentJob) String() string {
+func (_m *EnrichmentJob) String() string {
 	var builder strings.Builder
 	builder.WriteString("EnrichmentJob(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", ej.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("experience_id=")
-	builder.WriteString(fmt.Sprintf("%v", ej.ExperienceID))
+	builder.WriteString(fmt.Sprintf("%v", _m.ExperienceID))
 	builder.WriteString(", ")
 	builder.WriteString("job_type=")
-	builder.WriteString(ej.JobType)
+	builder.WriteString(_m.JobType)
 	builder.WriteString(", ")
 	builder.WriteString("status=")
-	builder.WriteString(ej.Status)
+	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
 	builder.WriteString("text=")
-	builder.WriteString(ej.Text)
+	builder.WriteString(_m.Text)
 	builder.WriteString(", ")
-	if v := ej.Error; v != nil {
+	if v := _m.Error; v != nil {
 		builder.WriteString("error=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("error_history=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ErrorHistory))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
 	builder.WriteString("attempts=")
-	builder.WriteString(fmt.Sprintf("%v", ej.Attempts))
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
 	builder.WriteString(", ")
-	builder.WriteString("created_at=")
-	builder.WriteString(ej.CreatedAt.Format(time.ANSIC))
+	builder.WriteString("requeues=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Requeues))
 	builder.WriteString(", ")
-	if v := ej.ProcessedAt; v != nil {
+	if v := _m.ProcessedAt; v != nil {
 		builder.WriteString("processed_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	if v := _m.LeaseExpiresAt; v != nil {
+		builder.WriteString("lease_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PromptTokens; v != nil {
+		builder.WriteString("prompt_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletionTokens; v != nil {
+		builder.WriteString("completion_tokens=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CostUsd; v != nil {
+		builder.WriteString("cost_usd=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trace_context=")
+	builder.WriteString(_m.TraceContext)
 	builder.WriteByte(')')
 	return builder.String()
 }
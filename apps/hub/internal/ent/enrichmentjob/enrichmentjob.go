@@ -15,6 +15,8 @@ const (
 	Label = "enrichment_job"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldExperienceID holds the string denoting the experience_id field in the database.
 	FieldExperienceID = "experience_id"
 	// FieldJobType holds the string denoting the job_type field in the database.
@@ -25,12 +27,26 @@ const (
 	FieldText = "text"
 	// FieldError holds the string denoting the error field in the database.
 	FieldError = "error"
+	// FieldErrorHistory holds the string denoting the error_history field in the database.
+	FieldErrorHistory = "error_history"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
 	// FieldAttempts holds the string denoting the attempts field in the database.
 	FieldAttempts = "attempts"
-	// FieldCreatedAt holds the string denoting the created_at field in the database.
-	FieldCreatedAt = "created_at"
+	// FieldRequeues holds the string denoting the requeues field in the database.
+	FieldRequeues = "requeues"
 	// FieldProcessedAt holds the string denoting the processed_at field in the database.
 	FieldProcessedAt = "processed_at"
+	// FieldLeaseExpiresAt holds the string denoting the lease_expires_at field in the database.
+	FieldLeaseExpiresAt = "lease_expires_at"
+	// FieldPromptTokens holds the string denoting the prompt_tokens field in the database.
+	FieldPromptTokens = "prompt_tokens"
+	// FieldCompletionTokens holds the string denoting the completion_tokens field in the database.
+	FieldCompletionTokens = "completion_tokens"
+	// FieldCostUsd holds the string denoting the cost_usd field in the database.
+	FieldCostUsd = "cost_usd"
+	// FieldTraceContext holds the string denoting the trace_context field in the database.
+	FieldTraceContext = "trace_context"
 	// EdgeExperience holds the string denoting the experience edge name in mutations.
 	EdgeExperience = "experience"
 	// Table holds the table name of the enrichmentjob in the database.
@@ -47,14 +63,22 @@ const (
 // Columns holds all SQL columns for enrichmentjob fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
 	FieldExperienceID,
 	FieldJobType,
 	FieldStatus,
 	FieldText,
 	FieldError,
+	FieldErrorHistory,
+	FieldPriority,
 	FieldAttempts,
-	FieldCreatedAt,
+	FieldRequeues,
 	FieldProcessedAt,
+	FieldLeaseExpiresAt,
+	FieldPromptTokens,
+	FieldCompletionTokens,
+	FieldCostUsd,
+	FieldTraceContext,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -68,14 +92,18 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
 	// DefaultJobType holds the default value on creation for the "job_type" field.
 	DefaultJobType string
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
 	// DefaultAttempts holds the default value on creation for the "attempts" field.
 	DefaultAttempts int
-	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
-	DefaultCreatedAt func() time.Time
+	// DefaultRequeues holds the default value on creation for the "requeues" field.
+	DefaultRequeues int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -88,6 +116,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
 // ByExperienceID orders the results by the experience_id field.
 func ByExperienceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExperienceID, opts...).ToFunc()
@@ -113,14 +146,19 @@ func ByError(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldError, opts...).ToFunc()
 }
 
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
 // ByAttempts orders the results by the attempts field.
 func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
 }
 
-// ByCreatedAt orders the results by the created_at field.
-func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+// ByRequeues orders the results by the requeues field.
+func ByRequeues(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequeues, opts...).ToFunc()
 }
 
 // ByProcessedAt orders the results by the processed_at field.
@@ -128,6 +166,31 @@ func ByProcessedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProcessedAt, opts...).ToFunc()
 }
 
+// ByLeaseExpiresAt orders the results by the lease_expires_at field.
+func ByLeaseExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseExpiresAt, opts...).ToFunc()
+}
+
+// ByPromptTokens orders the results by the prompt_tokens field.
+func ByPromptTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPromptTokens, opts...).ToFunc()
+}
+
+// ByCompletionTokens orders the results by the completion_tokens field.
+func ByCompletionTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletionTokens, opts...).ToFunc()
+}
+
+// ByCostUsd orders the results by the cost_usd field.
+func ByCostUsd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostUsd, opts...).ToFunc()
+}
+
+// ByTraceContext orders the results by the trace_context field.
+func ByTraceContext(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTraceContext, opts...).ToFunc()
+}
+
 // ByExperienceField orders the results by experience field.
 func ByExperienceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -56,6 +56,11 @@ func IDLTE(id uuid.UUID) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldLTE(FieldID, id))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // ExperienceID applies equality check predicate on the "experience_id" field. It's identical to ExperienceIDEQ.
 func ExperienceID(v uuid.UUID) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldEQ(FieldExperienceID, v))
@@ -81,14 +86,19 @@ func Error(v string) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldEQ(FieldError, v))
 }
 
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldPriority, v))
+}
+
 // Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
 func Attempts(v int) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldEQ(FieldAttempts, v))
 }
 
-// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
-func CreatedAt(v time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldEQ(FieldCreatedAt, v))
+// Requeues applies equality check predicate on the "requeues" field. It's identical to RequeuesEQ.
+func Requeues(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldRequeues, v))
 }
 
 // ProcessedAt applies equality check predicate on the "processed_at" field. It's identical to ProcessedAtEQ.
@@ -96,6 +106,71 @@ func ProcessedAt(v time.Time) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldEQ(FieldProcessedAt, v))
 }
 
+// LeaseExpiresAt applies equality check predicate on the "lease_expires_at" field. It's identical to LeaseExpiresAtEQ.
+func LeaseExpiresAt(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
+// PromptTokens applies equality check predicate on the "prompt_tokens" field. It's identical to PromptTokensEQ.
+func PromptTokens(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// CompletionTokens applies equality check predicate on the "completion_tokens" field. It's identical to CompletionTokensEQ.
+func CompletionTokens(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CostUsd applies equality check predicate on the "cost_usd" field. It's identical to CostUsdEQ.
+func CostUsd(v float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// TraceContext applies equality check predicate on the "trace_context" field. It's identical to TraceContextEQ.
+func TraceContext(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldTraceContext, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
 // ExperienceIDEQ applies the EQ predicate on the "experience_id" field.
 func ExperienceIDEQ(v uuid.UUID) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldEQ(FieldExperienceID, v))
@@ -386,6 +461,56 @@ func ErrorContainsFold(v string) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldContainsFold(FieldError, v))
 }
 
+// ErrorHistoryIsNil applies the IsNil predicate on the "error_history" field.
+func ErrorHistoryIsNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIsNull(FieldErrorHistory))
+}
+
+// ErrorHistoryNotNil applies the NotNil predicate on the "error_history" field.
+func ErrorHistoryNotNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotNull(FieldErrorHistory))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldPriority, v))
+}
+
 // AttemptsEQ applies the EQ predicate on the "attempts" field.
 func AttemptsEQ(v int) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldEQ(FieldAttempts, v))
@@ -426,44 +551,44 @@ func AttemptsLTE(v int) predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldLTE(FieldAttempts, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldEQ(FieldCreatedAt, v))
+// RequeuesEQ applies the EQ predicate on the "requeues" field.
+func RequeuesEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldRequeues, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldNEQ(FieldCreatedAt, v))
+// RequeuesNEQ applies the NEQ predicate on the "requeues" field.
+func RequeuesNEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldRequeues, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldIn(FieldCreatedAt, vs...))
+// RequeuesIn applies the In predicate on the "requeues" field.
+func RequeuesIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldRequeues, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+// RequeuesNotIn applies the NotIn predicate on the "requeues" field.
+func RequeuesNotIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldRequeues, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldGT(FieldCreatedAt, v))
+// RequeuesGT applies the GT predicate on the "requeues" field.
+func RequeuesGT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldRequeues, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldGTE(FieldCreatedAt, v))
+// RequeuesGTE applies the GTE predicate on the "requeues" field.
+func RequeuesGTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldRequeues, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldLT(FieldCreatedAt, v))
+// RequeuesLT applies the LT predicate on the "requeues" field.
+func RequeuesLT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldRequeues, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.EnrichmentJob {
-	return predicate.EnrichmentJob(sql.FieldLTE(FieldCreatedAt, v))
+// RequeuesLTE applies the LTE predicate on the "requeues" field.
+func RequeuesLTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldRequeues, v))
 }
 
 // ProcessedAtEQ applies the EQ predicate on the "processed_at" field.
@@ -516,6 +641,281 @@ func ProcessedAtNotNil() predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(sql.FieldNotNull(FieldProcessedAt))
 }
 
+// LeaseExpiresAtEQ applies the EQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtEQ(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtNEQ applies the NEQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNEQ(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIn applies the In predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIn(vs ...time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtNotIn applies the NotIn predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotIn(vs ...time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtGT applies the GT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGT(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtGTE applies the GTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGTE(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLT applies the LT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLT(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLTE applies the LTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLTE(v time.Time) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIsNil applies the IsNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIsNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIsNull(FieldLeaseExpiresAt))
+}
+
+// LeaseExpiresAtNotNil applies the NotNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotNull(FieldLeaseExpiresAt))
+}
+
+// PromptTokensEQ applies the EQ predicate on the "prompt_tokens" field.
+func PromptTokensEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensNEQ applies the NEQ predicate on the "prompt_tokens" field.
+func PromptTokensNEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldPromptTokens, v))
+}
+
+// PromptTokensIn applies the In predicate on the "prompt_tokens" field.
+func PromptTokensIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensNotIn applies the NotIn predicate on the "prompt_tokens" field.
+func PromptTokensNotIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldPromptTokens, vs...))
+}
+
+// PromptTokensGT applies the GT predicate on the "prompt_tokens" field.
+func PromptTokensGT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldPromptTokens, v))
+}
+
+// PromptTokensGTE applies the GTE predicate on the "prompt_tokens" field.
+func PromptTokensGTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldPromptTokens, v))
+}
+
+// PromptTokensLT applies the LT predicate on the "prompt_tokens" field.
+func PromptTokensLT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldPromptTokens, v))
+}
+
+// PromptTokensLTE applies the LTE predicate on the "prompt_tokens" field.
+func PromptTokensLTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldPromptTokens, v))
+}
+
+// PromptTokensIsNil applies the IsNil predicate on the "prompt_tokens" field.
+func PromptTokensIsNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIsNull(FieldPromptTokens))
+}
+
+// PromptTokensNotNil applies the NotNil predicate on the "prompt_tokens" field.
+func PromptTokensNotNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotNull(FieldPromptTokens))
+}
+
+// CompletionTokensEQ applies the EQ predicate on the "completion_tokens" field.
+func CompletionTokensEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensNEQ applies the NEQ predicate on the "completion_tokens" field.
+func CompletionTokensNEQ(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIn applies the In predicate on the "completion_tokens" field.
+func CompletionTokensIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensNotIn applies the NotIn predicate on the "completion_tokens" field.
+func CompletionTokensNotIn(vs ...int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldCompletionTokens, vs...))
+}
+
+// CompletionTokensGT applies the GT predicate on the "completion_tokens" field.
+func CompletionTokensGT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensGTE applies the GTE predicate on the "completion_tokens" field.
+func CompletionTokensGTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLT applies the LT predicate on the "completion_tokens" field.
+func CompletionTokensLT(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldCompletionTokens, v))
+}
+
+// CompletionTokensLTE applies the LTE predicate on the "completion_tokens" field.
+func CompletionTokensLTE(v int) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldCompletionTokens, v))
+}
+
+// CompletionTokensIsNil applies the IsNil predicate on the "completion_tokens" field.
+func CompletionTokensIsNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIsNull(FieldCompletionTokens))
+}
+
+// CompletionTokensNotNil applies the NotNil predicate on the "completion_tokens" field.
+func CompletionTokensNotNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotNull(FieldCompletionTokens))
+}
+
+// CostUsdEQ applies the EQ predicate on the "cost_usd" field.
+func CostUsdEQ(v float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// CostUsdNEQ applies the NEQ predicate on the "cost_usd" field.
+func CostUsdNEQ(v float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldCostUsd, v))
+}
+
+// CostUsdIn applies the In predicate on the "cost_usd" field.
+func CostUsdIn(vs ...float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldCostUsd, vs...))
+}
+
+// CostUsdNotIn applies the NotIn predicate on the "cost_usd" field.
+func CostUsdNotIn(vs ...float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldCostUsd, vs...))
+}
+
+// CostUsdGT applies the GT predicate on the "cost_usd" field.
+func CostUsdGT(v float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldCostUsd, v))
+}
+
+// CostUsdGTE applies the GTE predicate on the "cost_usd" field.
+func CostUsdGTE(v float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldCostUsd, v))
+}
+
+// CostUsdLT applies the LT predicate on the "cost_usd" field.
+func CostUsdLT(v float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldCostUsd, v))
+}
+
+// CostUsdLTE applies the LTE predicate on the "cost_usd" field.
+func CostUsdLTE(v float64) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldCostUsd, v))
+}
+
+// CostUsdIsNil applies the IsNil predicate on the "cost_usd" field.
+func CostUsdIsNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIsNull(FieldCostUsd))
+}
+
+// CostUsdNotNil applies the NotNil predicate on the "cost_usd" field.
+func CostUsdNotNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotNull(FieldCostUsd))
+}
+
+// TraceContextEQ applies the EQ predicate on the "trace_context" field.
+func TraceContextEQ(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEQ(FieldTraceContext, v))
+}
+
+// TraceContextNEQ applies the NEQ predicate on the "trace_context" field.
+func TraceContextNEQ(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNEQ(FieldTraceContext, v))
+}
+
+// TraceContextIn applies the In predicate on the "trace_context" field.
+func TraceContextIn(vs ...string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIn(FieldTraceContext, vs...))
+}
+
+// TraceContextNotIn applies the NotIn predicate on the "trace_context" field.
+func TraceContextNotIn(vs ...string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotIn(FieldTraceContext, vs...))
+}
+
+// TraceContextGT applies the GT predicate on the "trace_context" field.
+func TraceContextGT(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGT(FieldTraceContext, v))
+}
+
+// TraceContextGTE applies the GTE predicate on the "trace_context" field.
+func TraceContextGTE(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldGTE(FieldTraceContext, v))
+}
+
+// TraceContextLT applies the LT predicate on the "trace_context" field.
+func TraceContextLT(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLT(FieldTraceContext, v))
+}
+
+// TraceContextLTE applies the LTE predicate on the "trace_context" field.
+func TraceContextLTE(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldLTE(FieldTraceContext, v))
+}
+
+// TraceContextContains applies the Contains predicate on the "trace_context" field.
+func TraceContextContains(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldContains(FieldTraceContext, v))
+}
+
+// TraceContextHasPrefix applies the HasPrefix predicate on the "trace_context" field.
+func TraceContextHasPrefix(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldHasPrefix(FieldTraceContext, v))
+}
+
+// TraceContextHasSuffix applies the HasSuffix predicate on the "trace_context" field.
+func TraceContextHasSuffix(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldHasSuffix(FieldTraceContext, v))
+}
+
+// TraceContextIsNil applies the IsNil predicate on the "trace_context" field.
+func TraceContextIsNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldIsNull(FieldTraceContext))
+}
+
+// TraceContextNotNil applies the NotNil predicate on the "trace_context" field.
+func TraceContextNotNil() predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldNotNull(FieldTraceContext))
+}
+
+// TraceContextEqualFold applies the EqualFold predicate on the "trace_context" field.
+func TraceContextEqualFold(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldEqualFold(FieldTraceContext, v))
+}
+
+// TraceContextContainsFold applies the ContainsFold predicate on the "trace_context" field.
+func TraceContextContainsFold(v string) predicate.EnrichmentJob {
+	return predicate.EnrichmentJob(sql.FieldContainsFold(FieldTraceContext, v))
+}
+
 // HasExperience applies the HasEdge predicate on the "experience" edge.
 func HasExperience() predicate.EnrichmentJob {
 	return predicate.EnrichmentJob(func(s *sql.Selector) {
@@ -12,8 +12,21 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+	"github.com/formbricks/hub/apps/hub/internal/ent/auditlog"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
 	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
+	"github.com/formbricks/hub/apps/hub/internal/ent/segment"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/ent/worker"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -71,14 +84,27 @@ var (
 )
 
 // checkColumn checks if the column exists in the given table.
-func checkColumn(table, column string) error {
+func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			enrichmentjob.Table:  enrichmentjob.ValidColumn,
-			experiencedata.Table: experiencedata.ValidColumn,
+			aiusage.Table:            aiusage.ValidColumn,
+			auditlog.Table:           auditlog.ValidColumn,
+			connectorcursor.Table:    connectorcursor.ValidColumn,
+			enrichmentjob.Table:      enrichmentjob.ValidColumn,
+			experiencedata.Table:     experiencedata.ValidColumn,
+			experiencedeletion.Table: experiencedeletion.ValidColumn,
+			export.Table:             export.ValidColumn,
+			exportrun.Table:          exportrun.ValidColumn,
+			ingestmapping.Table:      ingestmapping.ValidColumn,
+			question.Table:           question.ValidColumn,
+			queuepause.Table:         queuepause.ValidColumn,
+			segment.Table:            segment.ValidColumn,
+			webhookdelivery.Table:    webhookdelivery.ValidColumn,
+			webhookendpoint.Table:    webhookendpoint.ValidColumn,
+			worker.Table:             worker.ValidColumn,
 		})
 	})
-	return columnCheck(table, column)
+	return columnCheck(t, c)
 }
 
 // Asc applies the given fields in ASC order.
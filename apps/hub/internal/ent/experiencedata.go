@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
 )
@@ -21,12 +23,12 @@ type ExperienceData struct {
 	// ID of the ent.
 	// UUIDv7 primary key (time-ordered)
 	ID uuid.UUID `json:"id,omitempty"`
-	// When the feedback was collected
-	CollectedAt time.Time `json:"collected_at,omitempty"`
-	// When this record was created in the database
+	// When the row was created
 	CreatedAt time.Time `json:"created_at,omitempty"`
-	// When this record was last updated
+	// When the row was last updated
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// When the feedback was collected
+	CollectedAt time.Time `json:"collected_at,omitempty"`
 	// Type of feedback source (e.g., survey, review, feedback_form, support, social)
 	SourceType string `json:"source_type,omitempty"`
 	// Reference to survey/form/ticket ID
@@ -37,6 +39,8 @@ type ExperienceData struct {
 	FieldID string `json:"field_id,omitempty"`
 	// The actual question text (e.g., 'How satisfied are you?')
 	FieldLabel string `json:"field_label,omitempty"`
+	// Question of the question bank this is a response to, matched by source and field ID
+	QuestionID *uuid.UUID `json:"question_id,omitempty"`
 	// Type of field: text (enrichable), categorical, nps, csat, rating, number, boolean, date
 	FieldType string `json:"field_type,omitempty"`
 	// For open-ended text responses
@@ -49,10 +53,24 @@ type ExperienceData struct {
 	ValueDate *time.Time `json:"value_date,omitempty"`
 	// For complex responses like multiple choice arrays
 	ValueJSON map[string]interface{} `json:"value_json,omitempty"`
+	// NPS category of nps scores (promoter 9-10, passive 7-8, detractor 0-6), derived from value_number on write
+	NpsCategory *string `json:"nps_category,omitempty"`
 	// User agent, device, location, referrer, tags, custom fields, etc.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Country of the respondent, from metadata
+	Country *string `json:"country,omitempty"`
+	// Region or state of the respondent, from metadata
+	Region *string `json:"region,omitempty"`
+	// Device type (e.g., mobile, desktop), from metadata
+	Device *string `json:"device,omitempty"`
+	// Platform or operating system (e.g., ios, web), from metadata
+	Platform *string `json:"platform,omitempty"`
+	// Version of the app the feedback was given in, from metadata
+	AppVersion *string `json:"app_version,omitempty"`
 	// ISO language code (e.g., 'en', 'de')
 	Language string `json:"language,omitempty"`
+	// Translations of value_text and field_label by ISO language code; cleared when value_text changes
+	Translations map[string]schema.Translation `json:"translations,omitempty"`
 	// AI-detected sentiment (positive, negative, neutral)
 	Sentiment *string `json:"sentiment,omitempty"`
 	// Sentiment score from -1 (negative) to +1 (positive)
@@ -61,13 +79,62 @@ type ExperienceData struct {
 	Emotion *string `json:"emotion,omitempty"`
 	// AI-extracted topics/themes from text
 	Topics []string `json:"topics,omitempty"`
+	// AI-detected spam flag (gibberish, keyboard mash, bot-like responses)
+	IsSpam *bool `json:"is_spam,omitempty"`
+	// Confidence of the spam verdict from 0 to 1
+	SpamConfidence *float64 `json:"spam_confidence,omitempty"`
+	// AI-estimated triage urgency from 0 (routine) to 1 (needs immediate attention)
+	UrgencyScore *float64 `json:"urgency_score,omitempty"`
+	// Reasons behind the urgency score (churn_risk, bug_report, legal_threat, etc.)
+	UrgencyReasons []string `json:"urgency_reasons,omitempty"`
+	// AI provider that produced the enrichment (e.g., openai, gemini)
+	EnrichmentProvider *string `json:"enrichment_provider,omitempty"`
+	// Model that produced the enrichment (e.g., gpt-4o-mini)
+	EnrichmentModel *string `json:"enrichment_model,omitempty"`
+	// Enrichment prompt/output schema version used (see enrichment.PromptVersion)
+	EnrichmentVersion *int `json:"enrichment_version,omitempty"`
+	// Additional attributes returned by a custom enrichment provider (see SERVICE_CUSTOM_ENRICHER_ATTRIBUTES)
+	EnrichmentAttributes map[string]interface{} `json:"enrichment_attributes,omitempty"`
+	// Excluded from AI enrichment and embeddings (no data is sent to AI providers)
+	SkipAiProcessing bool `json:"skip_ai_processing,omitempty"`
+	// SHA-256 of the text sent to AI providers, used to reuse results for identical text
+	AiInputHash *string `json:"ai_input_hash,omitempty"`
 	// Anonymous ID or email hash for grouping responses
 	UserIdentifier string `json:"user_identifier,omitempty"`
-	// OpenAI embedding vector for semantic search (1536 dimensions for text-embedding-3-small)
+	// SHA-256 of the normalized source, field, user, and value, for exact-duplicate detection (see models.ContentHash)
+	ContentHash *string `json:"content_hash,omitempty"`
+	// Earlier experience with the same content hash, set when SERVICE_DUPLICATE_POLICY is flag
+	DuplicateOf *uuid.UUID `json:"duplicate_of,omitempty"`
+	// Idempotency-Key header of the request that created the experience, so a retried request returns it instead of creating another
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+	// Embedding vector for semantic search (1536 dimensions, e.g. text-embedding-3-small or gemini-embedding-001)
 	Embedding *pgvector.Vector `json:"embedding,omitempty"`
 	// Name of the embedding model used (e.g., text-embedding-3-small)
 	EmbeddingModel *string `json:"embedding_model,omitempty"`
-	selectValues   sql.SelectValues
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExperienceDataQuery when eager-loading is set.
+	Edges        ExperienceDataEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExperienceDataEdges holds the relations/edges for other nodes in the graph.
+type ExperienceDataEdges struct {
+	// Question holds the value of the question edge.
+	Question *Question `json:"question,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// QuestionOrErr returns the Question value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExperienceDataEdges) QuestionOrErr() (*Question, error) {
+	if e.Question != nil {
+		return e.Question, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: question.Label}
+	}
+	return nil, &NotLoadedError{edge: "question"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -77,15 +144,19 @@ func (*ExperienceData) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case experiencedata.FieldEmbedding:
 			values[i] = &sql.NullScanner{S: new(pgvector.Vector)}
-		case experiencedata.FieldValueJSON, experiencedata.FieldMetadata, experiencedata.FieldTopics:
+		case experiencedata.FieldQuestionID, experiencedata.FieldDuplicateOf:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case experiencedata.FieldValueJSON, experiencedata.FieldMetadata, experiencedata.FieldTranslations, experiencedata.FieldTopics, experiencedata.FieldUrgencyReasons, experiencedata.FieldEnrichmentAttributes:
 			values[i] = new([]byte)
-		case experiencedata.FieldValueBoolean:
+		case experiencedata.FieldValueBoolean, experiencedata.FieldIsSpam, experiencedata.FieldSkipAiProcessing:
 			values[i] = new(sql.NullBool)
-		case experiencedata.FieldValueNumber, experiencedata.FieldSentimentScore:
+		case experiencedata.FieldValueNumber, experiencedata.FieldSentimentScore, experiencedata.FieldSpamConfidence, experiencedata.FieldUrgencyScore:
 			values[i] = new(sql.NullFloat64)
-		case experiencedata.FieldSourceType, experiencedata.FieldSourceID, experiencedata.FieldSourceName, experiencedata.FieldFieldID, experiencedata.FieldFieldLabel, experiencedata.FieldFieldType, experiencedata.FieldValueText, experiencedata.FieldLanguage, experiencedata.FieldSentiment, experiencedata.FieldEmotion, experiencedata.FieldUserIdentifier, experiencedata.FieldEmbeddingModel:
+		case experiencedata.FieldEnrichmentVersion:
+			values[i] = new(sql.NullInt64)
+		case experiencedata.FieldSourceType, experiencedata.FieldSourceID, experiencedata.FieldSourceName, experiencedata.FieldFieldID, experiencedata.FieldFieldLabel, experiencedata.FieldFieldType, experiencedata.FieldValueText, experiencedata.FieldNpsCategory, experiencedata.FieldCountry, experiencedata.FieldRegion, experiencedata.FieldDevice, experiencedata.FieldPlatform, experiencedata.FieldAppVersion, experiencedata.FieldLanguage, experiencedata.FieldSentiment, experiencedata.FieldEmotion, experiencedata.FieldEnrichmentProvider, experiencedata.FieldEnrichmentModel, experiencedata.FieldAiInputHash, experiencedata.FieldUserIdentifier, experiencedata.FieldContentHash, experiencedata.FieldIdempotencyKey, experiencedata.FieldEmbeddingModel:
 			values[i] = new(sql.NullString)
-		case experiencedata.FieldCollectedAt, experiencedata.FieldCreatedAt, experiencedata.FieldUpdatedAt, experiencedata.FieldValueDate:
+		case experiencedata.FieldCreatedAt, experiencedata.FieldUpdatedAt, experiencedata.FieldCollectedAt, experiencedata.FieldValueDate:
 			values[i] = new(sql.NullTime)
 		case experiencedata.FieldID:
 			values[i] = new(uuid.UUID)
@@ -98,7 +169,7 @@ func (*ExperienceData) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the ExperienceData fields.
-func (ed *ExperienceData) assignValues(columns []string, values []any) error {
+func (_m *ExperienceData) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -108,163 +179,312 @@ func (ed *ExperienceData) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field id", values[i])
 			} else if value != nil {
-				ed.ID = *value
-			}
-		case experiencedata.FieldCollectedAt:
-			if value, ok := values[i].(*sql.NullTime); !ok {
-				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
-			} else if value.Valid {
-				ed.CollectedAt = value.Time
+				_m.ID = *value
 			}
 		case experiencedata.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
-				ed.CreatedAt = value.Time
+				_m.CreatedAt = value.Time
 			}
 		case experiencedata.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
 			} else if value.Valid {
-				ed.UpdatedAt = value.Time
+				_m.UpdatedAt = value.Time
+			}
+		case experiencedata.FieldCollectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field collected_at", values[i])
+			} else if value.Valid {
+				_m.CollectedAt = value.Time
 			}
 		case experiencedata.FieldSourceType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field source_type", values[i])
 			} else if value.Valid {
-				ed.SourceType = value.String
+				_m.SourceType = value.String
 			}
 		case experiencedata.FieldSourceID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field source_id", values[i])
 			} else if value.Valid {
-				ed.SourceID = value.String
+				_m.SourceID = value.String
 			}
 		case experiencedata.FieldSourceName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field source_name", values[i])
 			} else if value.Valid {
-				ed.SourceName = value.String
+				_m.SourceName = value.String
 			}
 		case experiencedata.FieldFieldID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field field_id", values[i])
 			} else if value.Valid {
-				ed.FieldID = value.String
+				_m.FieldID = value.String
 			}
 		case experiencedata.FieldFieldLabel:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field field_label", values[i])
 			} else if value.Valid {
-				ed.FieldLabel = value.String
+				_m.FieldLabel = value.String
+			}
+		case experiencedata.FieldQuestionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field question_id", values[i])
+			} else if value.Valid {
+				_m.QuestionID = new(uuid.UUID)
+				*_m.QuestionID = *value.S.(*uuid.UUID)
 			}
 		case experiencedata.FieldFieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field field_type", values[i])
 			} else if value.Valid {
-				ed.FieldType = value.String
+				_m.FieldType = value.String
 			}
 		case experiencedata.FieldValueText:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field value_text", values[i])
 			} else if value.Valid {
-				ed.ValueText = new(string)
-				*ed.ValueText = value.String
+				_m.ValueText = new(string)
+				*_m.ValueText = value.String
 			}
 		case experiencedata.FieldValueNumber:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field value_number", values[i])
 			} else if value.Valid {
-				ed.ValueNumber = new(float64)
-				*ed.ValueNumber = value.Float64
+				_m.ValueNumber = new(float64)
+				*_m.ValueNumber = value.Float64
 			}
 		case experiencedata.FieldValueBoolean:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field value_boolean", values[i])
 			} else if value.Valid {
-				ed.ValueBoolean = new(bool)
-				*ed.ValueBoolean = value.Bool
+				_m.ValueBoolean = new(bool)
+				*_m.ValueBoolean = value.Bool
 			}
 		case experiencedata.FieldValueDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field value_date", values[i])
 			} else if value.Valid {
-				ed.ValueDate = new(time.Time)
-				*ed.ValueDate = value.Time
+				_m.ValueDate = new(time.Time)
+				*_m.ValueDate = value.Time
 			}
 		case experiencedata.FieldValueJSON:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field value_json", values[i])
 			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &ed.ValueJSON); err != nil {
+				if err := json.Unmarshal(*value, &_m.ValueJSON); err != nil {
 					return fmt.Errorf("unmarshal field value_json: %w", err)
 				}
 			}
+		case experiencedata.FieldNpsCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nps_category", values[i])
+			} else if value.Valid {
+				_m.NpsCategory = new(string)
+				*_m.NpsCategory = value.String
+			}
 		case experiencedata.FieldMetadata:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field metadata", values[i])
 			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &ed.Metadata); err != nil {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case experiencedata.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = new(string)
+				*_m.Country = value.String
+			}
+		case experiencedata.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = new(string)
+				*_m.Region = value.String
+			}
+		case experiencedata.FieldDevice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device", values[i])
+			} else if value.Valid {
+				_m.Device = new(string)
+				*_m.Device = value.String
+			}
+		case experiencedata.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				_m.Platform = new(string)
+				*_m.Platform = value.String
+			}
+		case experiencedata.FieldAppVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_version", values[i])
+			} else if value.Valid {
+				_m.AppVersion = new(string)
+				*_m.AppVersion = value.String
+			}
 		case experiencedata.FieldLanguage:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field language", values[i])
 			} else if value.Valid {
-				ed.Language = value.String
+				_m.Language = value.String
+			}
+		case experiencedata.FieldTranslations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field translations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Translations); err != nil {
+					return fmt.Errorf("unmarshal field translations: %w", err)
+				}
 			}
 		case experiencedata.FieldSentiment:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field sentiment", values[i])
 			} else if value.Valid {
-				ed.Sentiment = new(string)
-				*ed.Sentiment = value.String
+				_m.Sentiment = new(string)
+				*_m.Sentiment = value.String
 			}
 		case experiencedata.FieldSentimentScore:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field sentiment_score", values[i])
 			} else if value.Valid {
-				ed.SentimentScore = new(float64)
-				*ed.SentimentScore = value.Float64
+				_m.SentimentScore = new(float64)
+				*_m.SentimentScore = value.Float64
 			}
 		case experiencedata.FieldEmotion:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field emotion", values[i])
 			} else if value.Valid {
-				ed.Emotion = new(string)
-				*ed.Emotion = value.String
+				_m.Emotion = new(string)
+				*_m.Emotion = value.String
 			}
 		case experiencedata.FieldTopics:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field topics", values[i])
 			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &ed.Topics); err != nil {
+				if err := json.Unmarshal(*value, &_m.Topics); err != nil {
 					return fmt.Errorf("unmarshal field topics: %w", err)
 				}
 			}
+		case experiencedata.FieldIsSpam:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_spam", values[i])
+			} else if value.Valid {
+				_m.IsSpam = new(bool)
+				*_m.IsSpam = value.Bool
+			}
+		case experiencedata.FieldSpamConfidence:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_confidence", values[i])
+			} else if value.Valid {
+				_m.SpamConfidence = new(float64)
+				*_m.SpamConfidence = value.Float64
+			}
+		case experiencedata.FieldUrgencyScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field urgency_score", values[i])
+			} else if value.Valid {
+				_m.UrgencyScore = new(float64)
+				*_m.UrgencyScore = value.Float64
+			}
+		case experiencedata.FieldUrgencyReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field urgency_reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UrgencyReasons); err != nil {
+					return fmt.Errorf("unmarshal field urgency_reasons: %w", err)
+				}
+			}
+		case experiencedata.FieldEnrichmentProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enrichment_provider", values[i])
+			} else if value.Valid {
+				_m.EnrichmentProvider = new(string)
+				*_m.EnrichmentProvider = value.String
+			}
+		case experiencedata.FieldEnrichmentModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enrichment_model", values[i])
+			} else if value.Valid {
+				_m.EnrichmentModel = new(string)
+				*_m.EnrichmentModel = value.String
+			}
+		case experiencedata.FieldEnrichmentVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field enrichment_version", values[i])
+			} else if value.Valid {
+				_m.EnrichmentVersion = new(int)
+				*_m.EnrichmentVersion = int(value.Int64)
+			}
+		case experiencedata.FieldEnrichmentAttributes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enrichment_attributes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EnrichmentAttributes); err != nil {
+					return fmt.Errorf("unmarshal field enrichment_attributes: %w", err)
+				}
+			}
+		case experiencedata.FieldSkipAiProcessing:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_ai_processing", values[i])
+			} else if value.Valid {
+				_m.SkipAiProcessing = value.Bool
+			}
+		case experiencedata.FieldAiInputHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ai_input_hash", values[i])
+			} else if value.Valid {
+				_m.AiInputHash = new(string)
+				*_m.AiInputHash = value.String
+			}
 		case experiencedata.FieldUserIdentifier:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_identifier", values[i])
 			} else if value.Valid {
-				ed.UserIdentifier = value.String
+				_m.UserIdentifier = value.String
+			}
+		case experiencedata.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = new(string)
+				*_m.ContentHash = value.String
+			}
+		case experiencedata.FieldDuplicateOf:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field duplicate_of", values[i])
+			} else if value.Valid {
+				_m.DuplicateOf = new(uuid.UUID)
+				*_m.DuplicateOf = *value.S.(*uuid.UUID)
+			}
+		case experiencedata.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = new(string)
+				*_m.IdempotencyKey = value.String
 			}
 		case experiencedata.FieldEmbedding:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field embedding", values[i])
 			} else if value.Valid {
-				ed.Embedding = new(pgvector.Vector)
-				*ed.Embedding = *value.S.(*pgvector.Vector)
+				_m.Embedding = new(pgvector.Vector)
+				*_m.Embedding = *value.S.(*pgvector.Vector)
 			}
 		case experiencedata.FieldEmbeddingModel:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field embedding_model", values[i])
 			} else if value.Valid {
-				ed.EmbeddingModel = new(string)
-				*ed.EmbeddingModel = value.String
+				_m.EmbeddingModel = new(string)
+				*_m.EmbeddingModel = value.String
 			}
 		default:
-			ed.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -272,116 +492,218 @@ func (ed *ExperienceData) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the ExperienceData.
 // This includes values selected through modifiers, order, etc.
-func (ed *ExperienceData) Value(name string) (ent.Value, error) {
-	return ed.selectValues.Get(name)
+func (_m *ExperienceData) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryQuestion queries the "question" edge of the ExperienceData entity.
+func (_m *ExperienceData) QueryQuestion() *QuestionQuery {
+	return NewExperienceDataClient(_m.config).QueryQuestion(_m)
 }
 
 // Update returns a builder for updating this ExperienceData.
 // Note that you need to call ExperienceData.Unwrap() before calling this method if this ExperienceData
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (ed *ExperienceData) Update() *ExperienceDataUpdateOne {
-	return NewExperienceDataClient(ed.config).UpdateOne(ed)
+func (_m *ExperienceData) Update() *ExperienceDataUpdateOne {
+	return NewExperienceDataClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the ExperienceData entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (ed *ExperienceData) Unwrap() *ExperienceData {
-	_tx, ok := ed.config.driver.(*txDriver)
+func (_m *ExperienceData) Unwrap() *ExperienceData {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: ExperienceData is not a transactional entity")
 	}
-	ed.config.driver = _tx.drv
-	return ed
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (ed *ExperienceData) String() string {
+func (_m *ExperienceData) String() string {
 	var builder strings.Builder
 	builder.WriteString("ExperienceData(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", ed.ID))
-	builder.WriteString("collected_at=")
-	builder.WriteString(ed.CollectedAt.Format(time.ANSIC))
-	builder.WriteString(", ")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("created_at=")
-	builder.WriteString(ed.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
-	builder.WriteString(ed.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("collected_at=")
+	builder.WriteString(_m.CollectedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("source_type=")
-	builder.WriteString(ed.SourceType)
+	builder.WriteString(_m.SourceType)
 	builder.WriteString(", ")
 	builder.WriteString("source_id=")
-	builder.WriteString(ed.SourceID)
+	builder.WriteString(_m.SourceID)
 	builder.WriteString(", ")
 	builder.WriteString("source_name=")
-	builder.WriteString(ed.SourceName)
+	builder.WriteString(_m.SourceName)
 	builder.WriteString(", ")
 	builder.WriteString("field_id=")
-	builder.WriteString(ed.FieldID)
+	builder.WriteString(_m.FieldID)
 	builder.WriteString(", ")
 	builder.WriteString("field_label=")
-	builder.WriteString(ed.FieldLabel)
+	builder.WriteString(_m.FieldLabel)
+	builder.WriteString(", ")
+	if v := _m.QuestionID; v != nil {
+		builder.WriteString("question_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("field_type=")
-	builder.WriteString(ed.FieldType)
+	builder.WriteString(_m.FieldType)
 	builder.WriteString(", ")
-	if v := ed.ValueText; v != nil {
+	if v := _m.ValueText; v != nil {
 		builder.WriteString("value_text=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	if v := ed.ValueNumber; v != nil {
+	if v := _m.ValueNumber; v != nil {
 		builder.WriteString("value_number=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
-	if v := ed.ValueBoolean; v != nil {
+	if v := _m.ValueBoolean; v != nil {
 		builder.WriteString("value_boolean=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
-	if v := ed.ValueDate; v != nil {
+	if v := _m.ValueDate; v != nil {
 		builder.WriteString("value_date=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
 	builder.WriteString("value_json=")
-	builder.WriteString(fmt.Sprintf("%v", ed.ValueJSON))
+	builder.WriteString(fmt.Sprintf("%v", _m.ValueJSON))
+	builder.WriteString(", ")
+	if v := _m.NpsCategory; v != nil {
+		builder.WriteString("nps_category=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
-	builder.WriteString(fmt.Sprintf("%v", ed.Metadata))
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	if v := _m.Country; v != nil {
+		builder.WriteString("country=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Region; v != nil {
+		builder.WriteString("region=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Device; v != nil {
+		builder.WriteString("device=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Platform; v != nil {
+		builder.WriteString("platform=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AppVersion; v != nil {
+		builder.WriteString("app_version=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
 	builder.WriteString("language=")
-	builder.WriteString(ed.Language)
+	builder.WriteString(_m.Language)
+	builder.WriteString(", ")
+	builder.WriteString("translations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Translations))
 	builder.WriteString(", ")
-	if v := ed.Sentiment; v != nil {
+	if v := _m.Sentiment; v != nil {
 		builder.WriteString("sentiment=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
-	if v := ed.SentimentScore; v != nil {
+	if v := _m.SentimentScore; v != nil {
 		builder.WriteString("sentiment_score=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
-	if v := ed.Emotion; v != nil {
+	if v := _m.Emotion; v != nil {
 		builder.WriteString("emotion=")
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
 	builder.WriteString("topics=")
-	builder.WriteString(fmt.Sprintf("%v", ed.Topics))
+	builder.WriteString(fmt.Sprintf("%v", _m.Topics))
+	builder.WriteString(", ")
+	if v := _m.IsSpam; v != nil {
+		builder.WriteString("is_spam=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SpamConfidence; v != nil {
+		builder.WriteString("spam_confidence=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UrgencyScore; v != nil {
+		builder.WriteString("urgency_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("urgency_reasons=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UrgencyReasons))
+	builder.WriteString(", ")
+	if v := _m.EnrichmentProvider; v != nil {
+		builder.WriteString("enrichment_provider=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EnrichmentModel; v != nil {
+		builder.WriteString("enrichment_model=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.EnrichmentVersion; v != nil {
+		builder.WriteString("enrichment_version=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enrichment_attributes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnrichmentAttributes))
+	builder.WriteString(", ")
+	builder.WriteString("skip_ai_processing=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkipAiProcessing))
+	builder.WriteString(", ")
+	if v := _m.AiInputHash; v != nil {
+		builder.WriteString("ai_input_hash=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
 	builder.WriteString("user_identifier=")
-	builder.WriteString(ed.UserIdentifier)
+	builder.WriteString(_m.UserIdentifier)
+	builder.WriteString(", ")
+	if v := _m.ContentHash; v != nil {
+		builder.WriteString("content_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DuplicateOf; v != nil {
+		builder.WriteString("duplicate_of=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
-	if v := ed.Embedding; v != nil {
+	if v := _m.Embedding; v != nil {
 		builder.WriteString("embedding=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
-	if v := ed.EmbeddingModel; v != nil {
+	if v := _m.EmbeddingModel; v != nil {
 		builder.WriteString("embedding_model=")
 		builder.WriteString(*v)
 	}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -14,12 +15,12 @@ const (
 	Label = "experience_data"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
-	// FieldCollectedAt holds the string denoting the collected_at field in the database.
-	FieldCollectedAt = "collected_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldCollectedAt holds the string denoting the collected_at field in the database.
+	FieldCollectedAt = "collected_at"
 	// FieldSourceType holds the string denoting the source_type field in the database.
 	FieldSourceType = "source_type"
 	// FieldSourceID holds the string denoting the source_id field in the database.
@@ -30,6 +31,8 @@ const (
 	FieldFieldID = "field_id"
 	// FieldFieldLabel holds the string denoting the field_label field in the database.
 	FieldFieldLabel = "field_label"
+	// FieldQuestionID holds the string denoting the question_id field in the database.
+	FieldQuestionID = "question_id"
 	// FieldFieldType holds the string denoting the field_type field in the database.
 	FieldFieldType = "field_type"
 	// FieldValueText holds the string denoting the value_text field in the database.
@@ -42,10 +45,24 @@ const (
 	FieldValueDate = "value_date"
 	// FieldValueJSON holds the string denoting the value_json field in the database.
 	FieldValueJSON = "value_json"
+	// FieldNpsCategory holds the string denoting the nps_category field in the database.
+	FieldNpsCategory = "nps_category"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldDevice holds the string denoting the device field in the database.
+	FieldDevice = "device"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
+	// FieldAppVersion holds the string denoting the app_version field in the database.
+	FieldAppVersion = "app_version"
 	// FieldLanguage holds the string denoting the language field in the database.
 	FieldLanguage = "language"
+	// FieldTranslations holds the string denoting the translations field in the database.
+	FieldTranslations = "translations"
 	// FieldSentiment holds the string denoting the sentiment field in the database.
 	FieldSentiment = "sentiment"
 	// FieldSentimentScore holds the string denoting the sentiment_score field in the database.
@@ -54,40 +71,96 @@ const (
 	FieldEmotion = "emotion"
 	// FieldTopics holds the string denoting the topics field in the database.
 	FieldTopics = "topics"
+	// FieldIsSpam holds the string denoting the is_spam field in the database.
+	FieldIsSpam = "is_spam"
+	// FieldSpamConfidence holds the string denoting the spam_confidence field in the database.
+	FieldSpamConfidence = "spam_confidence"
+	// FieldUrgencyScore holds the string denoting the urgency_score field in the database.
+	FieldUrgencyScore = "urgency_score"
+	// FieldUrgencyReasons holds the string denoting the urgency_reasons field in the database.
+	FieldUrgencyReasons = "urgency_reasons"
+	// FieldEnrichmentProvider holds the string denoting the enrichment_provider field in the database.
+	FieldEnrichmentProvider = "enrichment_provider"
+	// FieldEnrichmentModel holds the string denoting the enrichment_model field in the database.
+	FieldEnrichmentModel = "enrichment_model"
+	// FieldEnrichmentVersion holds the string denoting the enrichment_version field in the database.
+	FieldEnrichmentVersion = "enrichment_version"
+	// FieldEnrichmentAttributes holds the string denoting the enrichment_attributes field in the database.
+	FieldEnrichmentAttributes = "enrichment_attributes"
+	// FieldSkipAiProcessing holds the string denoting the skip_ai_processing field in the database.
+	FieldSkipAiProcessing = "skip_ai_processing"
+	// FieldAiInputHash holds the string denoting the ai_input_hash field in the database.
+	FieldAiInputHash = "ai_input_hash"
 	// FieldUserIdentifier holds the string denoting the user_identifier field in the database.
 	FieldUserIdentifier = "user_identifier"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldDuplicateOf holds the string denoting the duplicate_of field in the database.
+	FieldDuplicateOf = "duplicate_of"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
 	// FieldEmbedding holds the string denoting the embedding field in the database.
 	FieldEmbedding = "embedding"
 	// FieldEmbeddingModel holds the string denoting the embedding_model field in the database.
 	FieldEmbeddingModel = "embedding_model"
+	// EdgeQuestion holds the string denoting the question edge name in mutations.
+	EdgeQuestion = "question"
 	// Table holds the table name of the experiencedata in the database.
 	Table = "experience_data"
+	// QuestionTable is the table that holds the question relation/edge.
+	QuestionTable = "experience_data"
+	// QuestionInverseTable is the table name for the Question entity.
+	// It exists in this package in order to avoid circular dependency with the "question" package.
+	QuestionInverseTable = "questions"
+	// QuestionColumn is the table column denoting the question relation/edge.
+	QuestionColumn = "question_id"
 )
 
 // Columns holds all SQL columns for experiencedata fields.
 var Columns = []string{
 	FieldID,
-	FieldCollectedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldCollectedAt,
 	FieldSourceType,
 	FieldSourceID,
 	FieldSourceName,
 	FieldFieldID,
 	FieldFieldLabel,
+	FieldQuestionID,
 	FieldFieldType,
 	FieldValueText,
 	FieldValueNumber,
 	FieldValueBoolean,
 	FieldValueDate,
 	FieldValueJSON,
+	FieldNpsCategory,
 	FieldMetadata,
+	FieldCountry,
+	FieldRegion,
+	FieldDevice,
+	FieldPlatform,
+	FieldAppVersion,
 	FieldLanguage,
+	FieldTranslations,
 	FieldSentiment,
 	FieldSentimentScore,
 	FieldEmotion,
 	FieldTopics,
+	FieldIsSpam,
+	FieldSpamConfidence,
+	FieldUrgencyScore,
+	FieldUrgencyReasons,
+	FieldEnrichmentProvider,
+	FieldEnrichmentModel,
+	FieldEnrichmentVersion,
+	FieldEnrichmentAttributes,
+	FieldSkipAiProcessing,
+	FieldAiInputHash,
 	FieldUserIdentifier,
+	FieldContentHash,
+	FieldDuplicateOf,
+	FieldIdempotencyKey,
 	FieldEmbedding,
 	FieldEmbeddingModel,
 }
@@ -103,14 +176,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// DefaultCollectedAt holds the default value on creation for the "collected_at" field.
-	DefaultCollectedAt func() time.Time
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCollectedAt holds the default value on creation for the "collected_at" field.
+	DefaultCollectedAt func() time.Time
 	// SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
 	SourceTypeValidator func(string) error
 	// FieldIDValidator is a validator for the "field_id" field. It is called by the builders before save.
@@ -119,6 +192,8 @@ var (
 	FieldTypeValidator func(string) error
 	// LanguageValidator is a validator for the "language" field. It is called by the builders before save.
 	LanguageValidator func(string) error
+	// DefaultSkipAiProcessing holds the default value on creation for the "skip_ai_processing" field.
+	DefaultSkipAiProcessing bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -131,11 +206,6 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
-// ByCollectedAt orders the results by the collected_at field.
-func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
-}
-
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -146,6 +216,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByCollectedAt orders the results by the collected_at field.
+func ByCollectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectedAt, opts...).ToFunc()
+}
+
 // BySourceType orders the results by the source_type field.
 func BySourceType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSourceType, opts...).ToFunc()
@@ -171,6 +246,11 @@ func ByFieldLabel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFieldLabel, opts...).ToFunc()
 }
 
+// ByQuestionID orders the results by the question_id field.
+func ByQuestionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionID, opts...).ToFunc()
+}
+
 // ByFieldType orders the results by the field_type field.
 func ByFieldType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFieldType, opts...).ToFunc()
@@ -196,6 +276,36 @@ func ByValueDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldValueDate, opts...).ToFunc()
 }
 
+// ByNpsCategory orders the results by the nps_category field.
+func ByNpsCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNpsCategory, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByDevice orders the results by the device field.
+func ByDevice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDevice, opts...).ToFunc()
+}
+
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
+// ByAppVersion orders the results by the app_version field.
+func ByAppVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppVersion, opts...).ToFunc()
+}
+
 // ByLanguage orders the results by the language field.
 func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
@@ -216,11 +326,66 @@ func ByEmotion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmotion, opts...).ToFunc()
 }
 
+// ByIsSpam orders the results by the is_spam field.
+func ByIsSpam(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsSpam, opts...).ToFunc()
+}
+
+// BySpamConfidence orders the results by the spam_confidence field.
+func BySpamConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpamConfidence, opts...).ToFunc()
+}
+
+// ByUrgencyScore orders the results by the urgency_score field.
+func ByUrgencyScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUrgencyScore, opts...).ToFunc()
+}
+
+// ByEnrichmentProvider orders the results by the enrichment_provider field.
+func ByEnrichmentProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrichmentProvider, opts...).ToFunc()
+}
+
+// ByEnrichmentModel orders the results by the enrichment_model field.
+func ByEnrichmentModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrichmentModel, opts...).ToFunc()
+}
+
+// ByEnrichmentVersion orders the results by the enrichment_version field.
+func ByEnrichmentVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnrichmentVersion, opts...).ToFunc()
+}
+
+// BySkipAiProcessing orders the results by the skip_ai_processing field.
+func BySkipAiProcessing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipAiProcessing, opts...).ToFunc()
+}
+
+// ByAiInputHash orders the results by the ai_input_hash field.
+func ByAiInputHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAiInputHash, opts...).ToFunc()
+}
+
 // ByUserIdentifier orders the results by the user_identifier field.
 func ByUserIdentifier(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserIdentifier, opts...).ToFunc()
 }
 
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByDuplicateOf orders the results by the duplicate_of field.
+func ByDuplicateOf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuplicateOf, opts...).ToFunc()
+}
+
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
 // ByEmbedding orders the results by the embedding field.
 func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmbedding, opts...).ToFunc()
@@ -230,3 +395,17 @@ func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
 func ByEmbeddingModel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmbeddingModel, opts...).ToFunc()
 }
+
+// ByQuestionField orders the results by question field.
+func ByQuestionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newQuestionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, QuestionTable, QuestionColumn),
+	)
+}
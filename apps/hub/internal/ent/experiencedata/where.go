@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
@@ -56,11 +57,6 @@ func IDLTE(id uuid.UUID) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldLTE(FieldID, id))
 }
 
-// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
-func CollectedAt(v time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldCollectedAt, v))
-}
-
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldCreatedAt, v))
@@ -71,6 +67,11 @@ func UpdatedAt(v time.Time) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// CollectedAt applies equality check predicate on the "collected_at" field. It's identical to CollectedAtEQ.
+func CollectedAt(v time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldCollectedAt, v))
+}
+
 // SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
 func SourceType(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldSourceType, v))
@@ -91,6 +92,11 @@ func FieldLabel(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldFieldLabel, v))
 }
 
+// QuestionID applies equality check predicate on the "question_id" field. It's identical to QuestionIDEQ.
+func QuestionID(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldQuestionID, v))
+}
+
 // FieldType applies equality check predicate on the "field_type" field. It's identical to FieldTypeEQ.
 func FieldType(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldFieldType, v))
@@ -116,6 +122,36 @@ func ValueDate(v time.Time) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldValueDate, v))
 }
 
+// NpsCategory applies equality check predicate on the "nps_category" field. It's identical to NpsCategoryEQ.
+func NpsCategory(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldNpsCategory, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldCountry, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldRegion, v))
+}
+
+// Device applies equality check predicate on the "device" field. It's identical to DeviceEQ.
+func Device(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldDevice, v))
+}
+
+// Platform applies equality check predicate on the "platform" field. It's identical to PlatformEQ.
+func Platform(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldPlatform, v))
+}
+
+// AppVersion applies equality check predicate on the "app_version" field. It's identical to AppVersionEQ.
+func AppVersion(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldAppVersion, v))
+}
+
 // Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
 func Language(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldLanguage, v))
@@ -136,59 +172,74 @@ func Emotion(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldEmotion, v))
 }
 
-// UserIdentifier applies equality check predicate on the "user_identifier" field. It's identical to UserIdentifierEQ.
-func UserIdentifier(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldUserIdentifier, v))
+// IsSpam applies equality check predicate on the "is_spam" field. It's identical to IsSpamEQ.
+func IsSpam(v bool) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldIsSpam, v))
 }
 
-// Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
-func Embedding(v pgvector.Vector) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldEmbedding, v))
+// SpamConfidence applies equality check predicate on the "spam_confidence" field. It's identical to SpamConfidenceEQ.
+func SpamConfidence(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldSpamConfidence, v))
 }
 
-// EmbeddingModel applies equality check predicate on the "embedding_model" field. It's identical to EmbeddingModelEQ.
-func EmbeddingModel(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldEmbeddingModel, v))
+// UrgencyScore applies equality check predicate on the "urgency_score" field. It's identical to UrgencyScoreEQ.
+func UrgencyScore(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldUrgencyScore, v))
 }
 
-// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
-func CollectedAtEQ(v time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldCollectedAt, v))
+// EnrichmentProvider applies equality check predicate on the "enrichment_provider" field. It's identical to EnrichmentProviderEQ.
+func EnrichmentProvider(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEnrichmentProvider, v))
 }
 
-// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
-func CollectedAtNEQ(v time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNEQ(FieldCollectedAt, v))
+// EnrichmentModel applies equality check predicate on the "enrichment_model" field. It's identical to EnrichmentModelEQ.
+func EnrichmentModel(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEnrichmentModel, v))
 }
 
-// CollectedAtIn applies the In predicate on the "collected_at" field.
-func CollectedAtIn(vs ...time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIn(FieldCollectedAt, vs...))
+// EnrichmentVersion applies equality check predicate on the "enrichment_version" field. It's identical to EnrichmentVersionEQ.
+func EnrichmentVersion(v int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEnrichmentVersion, v))
 }
 
-// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
-func CollectedAtNotIn(vs ...time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotIn(FieldCollectedAt, vs...))
+// SkipAiProcessing applies equality check predicate on the "skip_ai_processing" field. It's identical to SkipAiProcessingEQ.
+func SkipAiProcessing(v bool) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldSkipAiProcessing, v))
 }
 
-// CollectedAtGT applies the GT predicate on the "collected_at" field.
-func CollectedAtGT(v time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGT(FieldCollectedAt, v))
+// AiInputHash applies equality check predicate on the "ai_input_hash" field. It's identical to AiInputHashEQ.
+func AiInputHash(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldAiInputHash, v))
 }
 
-// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
-func CollectedAtGTE(v time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGTE(FieldCollectedAt, v))
+// UserIdentifier applies equality check predicate on the "user_identifier" field. It's identical to UserIdentifierEQ.
+func UserIdentifier(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldUserIdentifier, v))
 }
 
-// CollectedAtLT applies the LT predicate on the "collected_at" field.
-func CollectedAtLT(v time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLT(FieldCollectedAt, v))
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldContentHash, v))
 }
 
-// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
-func CollectedAtLTE(v time.Time) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLTE(FieldCollectedAt, v))
+// DuplicateOf applies equality check predicate on the "duplicate_of" field. It's identical to DuplicateOfEQ.
+func DuplicateOf(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldDuplicateOf, v))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
+func Embedding(v pgvector.Vector) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// EmbeddingModel applies equality check predicate on the "embedding_model" field. It's identical to EmbeddingModelEQ.
+func EmbeddingModel(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEmbeddingModel, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -271,6 +322,46 @@ func UpdatedAtLTE(v time.Time) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// CollectedAtEQ applies the EQ predicate on the "collected_at" field.
+func CollectedAtEQ(v time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtNEQ applies the NEQ predicate on the "collected_at" field.
+func CollectedAtNEQ(v time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldCollectedAt, v))
+}
+
+// CollectedAtIn applies the In predicate on the "collected_at" field.
+func CollectedAtIn(vs ...time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtNotIn applies the NotIn predicate on the "collected_at" field.
+func CollectedAtNotIn(vs ...time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldCollectedAt, vs...))
+}
+
+// CollectedAtGT applies the GT predicate on the "collected_at" field.
+func CollectedAtGT(v time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldCollectedAt, v))
+}
+
+// CollectedAtGTE applies the GTE predicate on the "collected_at" field.
+func CollectedAtGTE(v time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldCollectedAt, v))
+}
+
+// CollectedAtLT applies the LT predicate on the "collected_at" field.
+func CollectedAtLT(v time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldCollectedAt, v))
+}
+
+// CollectedAtLTE applies the LTE predicate on the "collected_at" field.
+func CollectedAtLTE(v time.Time) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldCollectedAt, v))
+}
+
 // SourceTypeEQ applies the EQ predicate on the "source_type" field.
 func SourceTypeEQ(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldSourceType, v))
@@ -626,6 +717,36 @@ func FieldLabelContainsFold(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldContainsFold(FieldFieldLabel, v))
 }
 
+// QuestionIDEQ applies the EQ predicate on the "question_id" field.
+func QuestionIDEQ(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// QuestionIDNEQ applies the NEQ predicate on the "question_id" field.
+func QuestionIDNEQ(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldQuestionID, v))
+}
+
+// QuestionIDIn applies the In predicate on the "question_id" field.
+func QuestionIDIn(vs ...uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDNotIn applies the NotIn predicate on the "question_id" field.
+func QuestionIDNotIn(vs ...uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDIsNil applies the IsNil predicate on the "question_id" field.
+func QuestionIDIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldQuestionID))
+}
+
+// QuestionIDNotNil applies the NotNil predicate on the "question_id" field.
+func QuestionIDNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldQuestionID))
+}
+
 // FieldTypeEQ applies the EQ predicate on the "field_type" field.
 func FieldTypeEQ(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldFieldType, v))
@@ -896,214 +1017,674 @@ func ValueJSONNotNil() predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldNotNull(FieldValueJSON))
 }
 
-// MetadataIsNil applies the IsNil predicate on the "metadata" field.
-func MetadataIsNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIsNull(FieldMetadata))
+// NpsCategoryEQ applies the EQ predicate on the "nps_category" field.
+func NpsCategoryEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldNpsCategory, v))
 }
 
-// MetadataNotNil applies the NotNil predicate on the "metadata" field.
-func MetadataNotNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotNull(FieldMetadata))
+// NpsCategoryNEQ applies the NEQ predicate on the "nps_category" field.
+func NpsCategoryNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldNpsCategory, v))
 }
 
-// LanguageEQ applies the EQ predicate on the "language" field.
-func LanguageEQ(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldLanguage, v))
+// NpsCategoryIn applies the In predicate on the "nps_category" field.
+func NpsCategoryIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldNpsCategory, vs...))
 }
 
-// LanguageNEQ applies the NEQ predicate on the "language" field.
-func LanguageNEQ(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNEQ(FieldLanguage, v))
+// NpsCategoryNotIn applies the NotIn predicate on the "nps_category" field.
+func NpsCategoryNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldNpsCategory, vs...))
 }
 
-// LanguageIn applies the In predicate on the "language" field.
-func LanguageIn(vs ...string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIn(FieldLanguage, vs...))
+// NpsCategoryGT applies the GT predicate on the "nps_category" field.
+func NpsCategoryGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldNpsCategory, v))
 }
 
-// LanguageNotIn applies the NotIn predicate on the "language" field.
-func LanguageNotIn(vs ...string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotIn(FieldLanguage, vs...))
+// NpsCategoryGTE applies the GTE predicate on the "nps_category" field.
+func NpsCategoryGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldNpsCategory, v))
 }
 
-// LanguageGT applies the GT predicate on the "language" field.
-func LanguageGT(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGT(FieldLanguage, v))
+// NpsCategoryLT applies the LT predicate on the "nps_category" field.
+func NpsCategoryLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldNpsCategory, v))
 }
 
-// LanguageGTE applies the GTE predicate on the "language" field.
-func LanguageGTE(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGTE(FieldLanguage, v))
+// NpsCategoryLTE applies the LTE predicate on the "nps_category" field.
+func NpsCategoryLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldNpsCategory, v))
 }
 
-// LanguageLT applies the LT predicate on the "language" field.
-func LanguageLT(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLT(FieldLanguage, v))
+// NpsCategoryContains applies the Contains predicate on the "nps_category" field.
+func NpsCategoryContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldNpsCategory, v))
 }
 
-// LanguageLTE applies the LTE predicate on the "language" field.
-func LanguageLTE(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLTE(FieldLanguage, v))
+// NpsCategoryHasPrefix applies the HasPrefix predicate on the "nps_category" field.
+func NpsCategoryHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldNpsCategory, v))
 }
 
-// LanguageContains applies the Contains predicate on the "language" field.
-func LanguageContains(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldContains(FieldLanguage, v))
+// NpsCategoryHasSuffix applies the HasSuffix predicate on the "nps_category" field.
+func NpsCategoryHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldNpsCategory, v))
 }
 
-// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
-func LanguageHasPrefix(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldHasPrefix(FieldLanguage, v))
+// NpsCategoryIsNil applies the IsNil predicate on the "nps_category" field.
+func NpsCategoryIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldNpsCategory))
 }
 
-// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
-func LanguageHasSuffix(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldHasSuffix(FieldLanguage, v))
+// NpsCategoryNotNil applies the NotNil predicate on the "nps_category" field.
+func NpsCategoryNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldNpsCategory))
 }
 
-// LanguageIsNil applies the IsNil predicate on the "language" field.
-func LanguageIsNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIsNull(FieldLanguage))
+// NpsCategoryEqualFold applies the EqualFold predicate on the "nps_category" field.
+func NpsCategoryEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldNpsCategory, v))
 }
 
-// LanguageNotNil applies the NotNil predicate on the "language" field.
-func LanguageNotNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotNull(FieldLanguage))
+// NpsCategoryContainsFold applies the ContainsFold predicate on the "nps_category" field.
+func NpsCategoryContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldNpsCategory, v))
 }
 
-// LanguageEqualFold applies the EqualFold predicate on the "language" field.
-func LanguageEqualFold(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEqualFold(FieldLanguage, v))
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldMetadata))
 }
 
-// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
-func LanguageContainsFold(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldContainsFold(FieldLanguage, v))
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldMetadata))
 }
 
-// SentimentEQ applies the EQ predicate on the "sentiment" field.
-func SentimentEQ(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldSentiment, v))
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldCountry, v))
 }
 
-// SentimentNEQ applies the NEQ predicate on the "sentiment" field.
-func SentimentNEQ(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNEQ(FieldSentiment, v))
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldCountry, v))
 }
 
-// SentimentIn applies the In predicate on the "sentiment" field.
-func SentimentIn(vs ...string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIn(FieldSentiment, vs...))
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldCountry, vs...))
 }
 
-// SentimentNotIn applies the NotIn predicate on the "sentiment" field.
-func SentimentNotIn(vs ...string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotIn(FieldSentiment, vs...))
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldCountry, vs...))
 }
 
-// SentimentGT applies the GT predicate on the "sentiment" field.
-func SentimentGT(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGT(FieldSentiment, v))
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldCountry, v))
 }
 
-// SentimentGTE applies the GTE predicate on the "sentiment" field.
-func SentimentGTE(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGTE(FieldSentiment, v))
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldCountry, v))
 }
 
-// SentimentLT applies the LT predicate on the "sentiment" field.
-func SentimentLT(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLT(FieldSentiment, v))
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldCountry, v))
 }
 
-// SentimentLTE applies the LTE predicate on the "sentiment" field.
-func SentimentLTE(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLTE(FieldSentiment, v))
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldCountry, v))
 }
 
-// SentimentContains applies the Contains predicate on the "sentiment" field.
-func SentimentContains(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldContains(FieldSentiment, v))
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldCountry, v))
 }
 
-// SentimentHasPrefix applies the HasPrefix predicate on the "sentiment" field.
-func SentimentHasPrefix(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldHasPrefix(FieldSentiment, v))
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldCountry, v))
 }
 
-// SentimentHasSuffix applies the HasSuffix predicate on the "sentiment" field.
-func SentimentHasSuffix(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldHasSuffix(FieldSentiment, v))
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldCountry, v))
 }
 
-// SentimentIsNil applies the IsNil predicate on the "sentiment" field.
-func SentimentIsNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIsNull(FieldSentiment))
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldCountry))
 }
 
-// SentimentNotNil applies the NotNil predicate on the "sentiment" field.
-func SentimentNotNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotNull(FieldSentiment))
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldCountry))
 }
 
-// SentimentEqualFold applies the EqualFold predicate on the "sentiment" field.
-func SentimentEqualFold(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEqualFold(FieldSentiment, v))
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldCountry, v))
 }
 
-// SentimentContainsFold applies the ContainsFold predicate on the "sentiment" field.
-func SentimentContainsFold(v string) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldContainsFold(FieldSentiment, v))
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldCountry, v))
 }
 
-// SentimentScoreEQ applies the EQ predicate on the "sentiment_score" field.
-func SentimentScoreEQ(v float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldEQ(FieldSentimentScore, v))
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldRegion, v))
 }
 
-// SentimentScoreNEQ applies the NEQ predicate on the "sentiment_score" field.
-func SentimentScoreNEQ(v float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNEQ(FieldSentimentScore, v))
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldRegion, v))
 }
 
-// SentimentScoreIn applies the In predicate on the "sentiment_score" field.
-func SentimentScoreIn(vs ...float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIn(FieldSentimentScore, vs...))
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldRegion, vs...))
 }
 
-// SentimentScoreNotIn applies the NotIn predicate on the "sentiment_score" field.
-func SentimentScoreNotIn(vs ...float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotIn(FieldSentimentScore, vs...))
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldRegion, vs...))
 }
 
-// SentimentScoreGT applies the GT predicate on the "sentiment_score" field.
-func SentimentScoreGT(v float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGT(FieldSentimentScore, v))
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldRegion, v))
 }
 
-// SentimentScoreGTE applies the GTE predicate on the "sentiment_score" field.
-func SentimentScoreGTE(v float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldGTE(FieldSentimentScore, v))
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldRegion, v))
 }
 
-// SentimentScoreLT applies the LT predicate on the "sentiment_score" field.
-func SentimentScoreLT(v float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLT(FieldSentimentScore, v))
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldRegion, v))
 }
 
-// SentimentScoreLTE applies the LTE predicate on the "sentiment_score" field.
-func SentimentScoreLTE(v float64) predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldLTE(FieldSentimentScore, v))
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldRegion, v))
 }
 
-// SentimentScoreIsNil applies the IsNil predicate on the "sentiment_score" field.
-func SentimentScoreIsNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldIsNull(FieldSentimentScore))
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldRegion, v))
 }
 
-// SentimentScoreNotNil applies the NotNil predicate on the "sentiment_score" field.
-func SentimentScoreNotNil() predicate.ExperienceData {
-	return predicate.ExperienceData(sql.FieldNotNull(FieldSentimentScore))
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// DeviceEQ applies the EQ predicate on the "device" field.
+func DeviceEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldDevice, v))
+}
+
+// DeviceNEQ applies the NEQ predicate on the "device" field.
+func DeviceNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldDevice, v))
+}
+
+// DeviceIn applies the In predicate on the "device" field.
+func DeviceIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldDevice, vs...))
+}
+
+// DeviceNotIn applies the NotIn predicate on the "device" field.
+func DeviceNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldDevice, vs...))
+}
+
+// DeviceGT applies the GT predicate on the "device" field.
+func DeviceGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldDevice, v))
+}
+
+// DeviceGTE applies the GTE predicate on the "device" field.
+func DeviceGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldDevice, v))
+}
+
+// DeviceLT applies the LT predicate on the "device" field.
+func DeviceLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldDevice, v))
+}
+
+// DeviceLTE applies the LTE predicate on the "device" field.
+func DeviceLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldDevice, v))
+}
+
+// DeviceContains applies the Contains predicate on the "device" field.
+func DeviceContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldDevice, v))
+}
+
+// DeviceHasPrefix applies the HasPrefix predicate on the "device" field.
+func DeviceHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldDevice, v))
+}
+
+// DeviceHasSuffix applies the HasSuffix predicate on the "device" field.
+func DeviceHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldDevice, v))
+}
+
+// DeviceIsNil applies the IsNil predicate on the "device" field.
+func DeviceIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldDevice))
+}
+
+// DeviceNotNil applies the NotNil predicate on the "device" field.
+func DeviceNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldDevice))
+}
+
+// DeviceEqualFold applies the EqualFold predicate on the "device" field.
+func DeviceEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldDevice, v))
+}
+
+// DeviceContainsFold applies the ContainsFold predicate on the "device" field.
+func DeviceContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldDevice, v))
+}
+
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldPlatform, v))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldPlatform, v))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldPlatform, vs...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldPlatform, vs...))
+}
+
+// PlatformGT applies the GT predicate on the "platform" field.
+func PlatformGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldPlatform, v))
+}
+
+// PlatformGTE applies the GTE predicate on the "platform" field.
+func PlatformGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldPlatform, v))
+}
+
+// PlatformLT applies the LT predicate on the "platform" field.
+func PlatformLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldPlatform, v))
+}
+
+// PlatformLTE applies the LTE predicate on the "platform" field.
+func PlatformLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldPlatform, v))
+}
+
+// PlatformContains applies the Contains predicate on the "platform" field.
+func PlatformContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldPlatform, v))
+}
+
+// PlatformHasPrefix applies the HasPrefix predicate on the "platform" field.
+func PlatformHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldPlatform, v))
+}
+
+// PlatformHasSuffix applies the HasSuffix predicate on the "platform" field.
+func PlatformHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldPlatform, v))
+}
+
+// PlatformIsNil applies the IsNil predicate on the "platform" field.
+func PlatformIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldPlatform))
+}
+
+// PlatformNotNil applies the NotNil predicate on the "platform" field.
+func PlatformNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldPlatform))
+}
+
+// PlatformEqualFold applies the EqualFold predicate on the "platform" field.
+func PlatformEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldPlatform, v))
+}
+
+// PlatformContainsFold applies the ContainsFold predicate on the "platform" field.
+func PlatformContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldPlatform, v))
+}
+
+// AppVersionEQ applies the EQ predicate on the "app_version" field.
+func AppVersionEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldAppVersion, v))
+}
+
+// AppVersionNEQ applies the NEQ predicate on the "app_version" field.
+func AppVersionNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldAppVersion, v))
+}
+
+// AppVersionIn applies the In predicate on the "app_version" field.
+func AppVersionIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldAppVersion, vs...))
+}
+
+// AppVersionNotIn applies the NotIn predicate on the "app_version" field.
+func AppVersionNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldAppVersion, vs...))
+}
+
+// AppVersionGT applies the GT predicate on the "app_version" field.
+func AppVersionGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldAppVersion, v))
+}
+
+// AppVersionGTE applies the GTE predicate on the "app_version" field.
+func AppVersionGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldAppVersion, v))
+}
+
+// AppVersionLT applies the LT predicate on the "app_version" field.
+func AppVersionLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldAppVersion, v))
+}
+
+// AppVersionLTE applies the LTE predicate on the "app_version" field.
+func AppVersionLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldAppVersion, v))
+}
+
+// AppVersionContains applies the Contains predicate on the "app_version" field.
+func AppVersionContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldAppVersion, v))
+}
+
+// AppVersionHasPrefix applies the HasPrefix predicate on the "app_version" field.
+func AppVersionHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldAppVersion, v))
+}
+
+// AppVersionHasSuffix applies the HasSuffix predicate on the "app_version" field.
+func AppVersionHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldAppVersion, v))
+}
+
+// AppVersionIsNil applies the IsNil predicate on the "app_version" field.
+func AppVersionIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldAppVersion))
+}
+
+// AppVersionNotNil applies the NotNil predicate on the "app_version" field.
+func AppVersionNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldAppVersion))
+}
+
+// AppVersionEqualFold applies the EqualFold predicate on the "app_version" field.
+func AppVersionEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldAppVersion, v))
+}
+
+// AppVersionContainsFold applies the ContainsFold predicate on the "app_version" field.
+func AppVersionContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldAppVersion, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// TranslationsIsNil applies the IsNil predicate on the "translations" field.
+func TranslationsIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldTranslations))
+}
+
+// TranslationsNotNil applies the NotNil predicate on the "translations" field.
+func TranslationsNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldTranslations))
+}
+
+// SentimentEQ applies the EQ predicate on the "sentiment" field.
+func SentimentEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldSentiment, v))
+}
+
+// SentimentNEQ applies the NEQ predicate on the "sentiment" field.
+func SentimentNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldSentiment, v))
+}
+
+// SentimentIn applies the In predicate on the "sentiment" field.
+func SentimentIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldSentiment, vs...))
+}
+
+// SentimentNotIn applies the NotIn predicate on the "sentiment" field.
+func SentimentNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldSentiment, vs...))
+}
+
+// SentimentGT applies the GT predicate on the "sentiment" field.
+func SentimentGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldSentiment, v))
+}
+
+// SentimentGTE applies the GTE predicate on the "sentiment" field.
+func SentimentGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldSentiment, v))
+}
+
+// SentimentLT applies the LT predicate on the "sentiment" field.
+func SentimentLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldSentiment, v))
+}
+
+// SentimentLTE applies the LTE predicate on the "sentiment" field.
+func SentimentLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldSentiment, v))
+}
+
+// SentimentContains applies the Contains predicate on the "sentiment" field.
+func SentimentContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldSentiment, v))
+}
+
+// SentimentHasPrefix applies the HasPrefix predicate on the "sentiment" field.
+func SentimentHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldSentiment, v))
+}
+
+// SentimentHasSuffix applies the HasSuffix predicate on the "sentiment" field.
+func SentimentHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldSentiment, v))
+}
+
+// SentimentIsNil applies the IsNil predicate on the "sentiment" field.
+func SentimentIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldSentiment))
+}
+
+// SentimentNotNil applies the NotNil predicate on the "sentiment" field.
+func SentimentNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldSentiment))
+}
+
+// SentimentEqualFold applies the EqualFold predicate on the "sentiment" field.
+func SentimentEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldSentiment, v))
+}
+
+// SentimentContainsFold applies the ContainsFold predicate on the "sentiment" field.
+func SentimentContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldSentiment, v))
+}
+
+// SentimentScoreEQ applies the EQ predicate on the "sentiment_score" field.
+func SentimentScoreEQ(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldSentimentScore, v))
+}
+
+// SentimentScoreNEQ applies the NEQ predicate on the "sentiment_score" field.
+func SentimentScoreNEQ(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldSentimentScore, v))
+}
+
+// SentimentScoreIn applies the In predicate on the "sentiment_score" field.
+func SentimentScoreIn(vs ...float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldSentimentScore, vs...))
+}
+
+// SentimentScoreNotIn applies the NotIn predicate on the "sentiment_score" field.
+func SentimentScoreNotIn(vs ...float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldSentimentScore, vs...))
+}
+
+// SentimentScoreGT applies the GT predicate on the "sentiment_score" field.
+func SentimentScoreGT(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldSentimentScore, v))
+}
+
+// SentimentScoreGTE applies the GTE predicate on the "sentiment_score" field.
+func SentimentScoreGTE(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldSentimentScore, v))
+}
+
+// SentimentScoreLT applies the LT predicate on the "sentiment_score" field.
+func SentimentScoreLT(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldSentimentScore, v))
+}
+
+// SentimentScoreLTE applies the LTE predicate on the "sentiment_score" field.
+func SentimentScoreLTE(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldSentimentScore, v))
+}
+
+// SentimentScoreIsNil applies the IsNil predicate on the "sentiment_score" field.
+func SentimentScoreIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldSentimentScore))
+}
+
+// SentimentScoreNotNil applies the NotNil predicate on the "sentiment_score" field.
+func SentimentScoreNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldSentimentScore))
 }
 
 // EmotionEQ applies the EQ predicate on the "emotion" field.
@@ -1191,6 +1772,431 @@ func TopicsNotNil() predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldNotNull(FieldTopics))
 }
 
+// IsSpamEQ applies the EQ predicate on the "is_spam" field.
+func IsSpamEQ(v bool) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldIsSpam, v))
+}
+
+// IsSpamNEQ applies the NEQ predicate on the "is_spam" field.
+func IsSpamNEQ(v bool) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldIsSpam, v))
+}
+
+// IsSpamIsNil applies the IsNil predicate on the "is_spam" field.
+func IsSpamIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldIsSpam))
+}
+
+// IsSpamNotNil applies the NotNil predicate on the "is_spam" field.
+func IsSpamNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldIsSpam))
+}
+
+// SpamConfidenceEQ applies the EQ predicate on the "spam_confidence" field.
+func SpamConfidenceEQ(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldSpamConfidence, v))
+}
+
+// SpamConfidenceNEQ applies the NEQ predicate on the "spam_confidence" field.
+func SpamConfidenceNEQ(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldSpamConfidence, v))
+}
+
+// SpamConfidenceIn applies the In predicate on the "spam_confidence" field.
+func SpamConfidenceIn(vs ...float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldSpamConfidence, vs...))
+}
+
+// SpamConfidenceNotIn applies the NotIn predicate on the "spam_confidence" field.
+func SpamConfidenceNotIn(vs ...float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldSpamConfidence, vs...))
+}
+
+// SpamConfidenceGT applies the GT predicate on the "spam_confidence" field.
+func SpamConfidenceGT(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldSpamConfidence, v))
+}
+
+// SpamConfidenceGTE applies the GTE predicate on the "spam_confidence" field.
+func SpamConfidenceGTE(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldSpamConfidence, v))
+}
+
+// SpamConfidenceLT applies the LT predicate on the "spam_confidence" field.
+func SpamConfidenceLT(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldSpamConfidence, v))
+}
+
+// SpamConfidenceLTE applies the LTE predicate on the "spam_confidence" field.
+func SpamConfidenceLTE(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldSpamConfidence, v))
+}
+
+// SpamConfidenceIsNil applies the IsNil predicate on the "spam_confidence" field.
+func SpamConfidenceIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldSpamConfidence))
+}
+
+// SpamConfidenceNotNil applies the NotNil predicate on the "spam_confidence" field.
+func SpamConfidenceNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldSpamConfidence))
+}
+
+// UrgencyScoreEQ applies the EQ predicate on the "urgency_score" field.
+func UrgencyScoreEQ(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldUrgencyScore, v))
+}
+
+// UrgencyScoreNEQ applies the NEQ predicate on the "urgency_score" field.
+func UrgencyScoreNEQ(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldUrgencyScore, v))
+}
+
+// UrgencyScoreIn applies the In predicate on the "urgency_score" field.
+func UrgencyScoreIn(vs ...float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldUrgencyScore, vs...))
+}
+
+// UrgencyScoreNotIn applies the NotIn predicate on the "urgency_score" field.
+func UrgencyScoreNotIn(vs ...float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldUrgencyScore, vs...))
+}
+
+// UrgencyScoreGT applies the GT predicate on the "urgency_score" field.
+func UrgencyScoreGT(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldUrgencyScore, v))
+}
+
+// UrgencyScoreGTE applies the GTE predicate on the "urgency_score" field.
+func UrgencyScoreGTE(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldUrgencyScore, v))
+}
+
+// UrgencyScoreLT applies the LT predicate on the "urgency_score" field.
+func UrgencyScoreLT(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldUrgencyScore, v))
+}
+
+// UrgencyScoreLTE applies the LTE predicate on the "urgency_score" field.
+func UrgencyScoreLTE(v float64) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldUrgencyScore, v))
+}
+
+// UrgencyScoreIsNil applies the IsNil predicate on the "urgency_score" field.
+func UrgencyScoreIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldUrgencyScore))
+}
+
+// UrgencyScoreNotNil applies the NotNil predicate on the "urgency_score" field.
+func UrgencyScoreNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldUrgencyScore))
+}
+
+// UrgencyReasonsIsNil applies the IsNil predicate on the "urgency_reasons" field.
+func UrgencyReasonsIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldUrgencyReasons))
+}
+
+// UrgencyReasonsNotNil applies the NotNil predicate on the "urgency_reasons" field.
+func UrgencyReasonsNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldUrgencyReasons))
+}
+
+// EnrichmentProviderEQ applies the EQ predicate on the "enrichment_provider" field.
+func EnrichmentProviderEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderNEQ applies the NEQ predicate on the "enrichment_provider" field.
+func EnrichmentProviderNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderIn applies the In predicate on the "enrichment_provider" field.
+func EnrichmentProviderIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldEnrichmentProvider, vs...))
+}
+
+// EnrichmentProviderNotIn applies the NotIn predicate on the "enrichment_provider" field.
+func EnrichmentProviderNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldEnrichmentProvider, vs...))
+}
+
+// EnrichmentProviderGT applies the GT predicate on the "enrichment_provider" field.
+func EnrichmentProviderGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderGTE applies the GTE predicate on the "enrichment_provider" field.
+func EnrichmentProviderGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderLT applies the LT predicate on the "enrichment_provider" field.
+func EnrichmentProviderLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderLTE applies the LTE predicate on the "enrichment_provider" field.
+func EnrichmentProviderLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderContains applies the Contains predicate on the "enrichment_provider" field.
+func EnrichmentProviderContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderHasPrefix applies the HasPrefix predicate on the "enrichment_provider" field.
+func EnrichmentProviderHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderHasSuffix applies the HasSuffix predicate on the "enrichment_provider" field.
+func EnrichmentProviderHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderIsNil applies the IsNil predicate on the "enrichment_provider" field.
+func EnrichmentProviderIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldEnrichmentProvider))
+}
+
+// EnrichmentProviderNotNil applies the NotNil predicate on the "enrichment_provider" field.
+func EnrichmentProviderNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldEnrichmentProvider))
+}
+
+// EnrichmentProviderEqualFold applies the EqualFold predicate on the "enrichment_provider" field.
+func EnrichmentProviderEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentProviderContainsFold applies the ContainsFold predicate on the "enrichment_provider" field.
+func EnrichmentProviderContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldEnrichmentProvider, v))
+}
+
+// EnrichmentModelEQ applies the EQ predicate on the "enrichment_model" field.
+func EnrichmentModelEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelNEQ applies the NEQ predicate on the "enrichment_model" field.
+func EnrichmentModelNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelIn applies the In predicate on the "enrichment_model" field.
+func EnrichmentModelIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldEnrichmentModel, vs...))
+}
+
+// EnrichmentModelNotIn applies the NotIn predicate on the "enrichment_model" field.
+func EnrichmentModelNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldEnrichmentModel, vs...))
+}
+
+// EnrichmentModelGT applies the GT predicate on the "enrichment_model" field.
+func EnrichmentModelGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelGTE applies the GTE predicate on the "enrichment_model" field.
+func EnrichmentModelGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelLT applies the LT predicate on the "enrichment_model" field.
+func EnrichmentModelLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelLTE applies the LTE predicate on the "enrichment_model" field.
+func EnrichmentModelLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelContains applies the Contains predicate on the "enrichment_model" field.
+func EnrichmentModelContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelHasPrefix applies the HasPrefix predicate on the "enrichment_model" field.
+func EnrichmentModelHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelHasSuffix applies the HasSuffix predicate on the "enrichment_model" field.
+func EnrichmentModelHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelIsNil applies the IsNil predicate on the "enrichment_model" field.
+func EnrichmentModelIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldEnrichmentModel))
+}
+
+// EnrichmentModelNotNil applies the NotNil predicate on the "enrichment_model" field.
+func EnrichmentModelNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldEnrichmentModel))
+}
+
+// EnrichmentModelEqualFold applies the EqualFold predicate on the "enrichment_model" field.
+func EnrichmentModelEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldEnrichmentModel, v))
+}
+
+// EnrichmentModelContainsFold applies the ContainsFold predicate on the "enrichment_model" field.
+func EnrichmentModelContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldEnrichmentModel, v))
+}
+
+// EnrichmentVersionEQ applies the EQ predicate on the "enrichment_version" field.
+func EnrichmentVersionEQ(v int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldEnrichmentVersion, v))
+}
+
+// EnrichmentVersionNEQ applies the NEQ predicate on the "enrichment_version" field.
+func EnrichmentVersionNEQ(v int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldEnrichmentVersion, v))
+}
+
+// EnrichmentVersionIn applies the In predicate on the "enrichment_version" field.
+func EnrichmentVersionIn(vs ...int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldEnrichmentVersion, vs...))
+}
+
+// EnrichmentVersionNotIn applies the NotIn predicate on the "enrichment_version" field.
+func EnrichmentVersionNotIn(vs ...int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldEnrichmentVersion, vs...))
+}
+
+// EnrichmentVersionGT applies the GT predicate on the "enrichment_version" field.
+func EnrichmentVersionGT(v int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldEnrichmentVersion, v))
+}
+
+// EnrichmentVersionGTE applies the GTE predicate on the "enrichment_version" field.
+func EnrichmentVersionGTE(v int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldEnrichmentVersion, v))
+}
+
+// EnrichmentVersionLT applies the LT predicate on the "enrichment_version" field.
+func EnrichmentVersionLT(v int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldEnrichmentVersion, v))
+}
+
+// EnrichmentVersionLTE applies the LTE predicate on the "enrichment_version" field.
+func EnrichmentVersionLTE(v int) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldEnrichmentVersion, v))
+}
+
+// EnrichmentVersionIsNil applies the IsNil predicate on the "enrichment_version" field.
+func EnrichmentVersionIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldEnrichmentVersion))
+}
+
+// EnrichmentVersionNotNil applies the NotNil predicate on the "enrichment_version" field.
+func EnrichmentVersionNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldEnrichmentVersion))
+}
+
+// EnrichmentAttributesIsNil applies the IsNil predicate on the "enrichment_attributes" field.
+func EnrichmentAttributesIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldEnrichmentAttributes))
+}
+
+// EnrichmentAttributesNotNil applies the NotNil predicate on the "enrichment_attributes" field.
+func EnrichmentAttributesNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldEnrichmentAttributes))
+}
+
+// SkipAiProcessingEQ applies the EQ predicate on the "skip_ai_processing" field.
+func SkipAiProcessingEQ(v bool) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldSkipAiProcessing, v))
+}
+
+// SkipAiProcessingNEQ applies the NEQ predicate on the "skip_ai_processing" field.
+func SkipAiProcessingNEQ(v bool) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldSkipAiProcessing, v))
+}
+
+// AiInputHashEQ applies the EQ predicate on the "ai_input_hash" field.
+func AiInputHashEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldAiInputHash, v))
+}
+
+// AiInputHashNEQ applies the NEQ predicate on the "ai_input_hash" field.
+func AiInputHashNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldAiInputHash, v))
+}
+
+// AiInputHashIn applies the In predicate on the "ai_input_hash" field.
+func AiInputHashIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldAiInputHash, vs...))
+}
+
+// AiInputHashNotIn applies the NotIn predicate on the "ai_input_hash" field.
+func AiInputHashNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldAiInputHash, vs...))
+}
+
+// AiInputHashGT applies the GT predicate on the "ai_input_hash" field.
+func AiInputHashGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldAiInputHash, v))
+}
+
+// AiInputHashGTE applies the GTE predicate on the "ai_input_hash" field.
+func AiInputHashGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldAiInputHash, v))
+}
+
+// AiInputHashLT applies the LT predicate on the "ai_input_hash" field.
+func AiInputHashLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldAiInputHash, v))
+}
+
+// AiInputHashLTE applies the LTE predicate on the "ai_input_hash" field.
+func AiInputHashLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldAiInputHash, v))
+}
+
+// AiInputHashContains applies the Contains predicate on the "ai_input_hash" field.
+func AiInputHashContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldAiInputHash, v))
+}
+
+// AiInputHashHasPrefix applies the HasPrefix predicate on the "ai_input_hash" field.
+func AiInputHashHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldAiInputHash, v))
+}
+
+// AiInputHashHasSuffix applies the HasSuffix predicate on the "ai_input_hash" field.
+func AiInputHashHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldAiInputHash, v))
+}
+
+// AiInputHashIsNil applies the IsNil predicate on the "ai_input_hash" field.
+func AiInputHashIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldAiInputHash))
+}
+
+// AiInputHashNotNil applies the NotNil predicate on the "ai_input_hash" field.
+func AiInputHashNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldAiInputHash))
+}
+
+// AiInputHashEqualFold applies the EqualFold predicate on the "ai_input_hash" field.
+func AiInputHashEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldAiInputHash, v))
+}
+
+// AiInputHashContainsFold applies the ContainsFold predicate on the "ai_input_hash" field.
+func AiInputHashContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldAiInputHash, v))
+}
+
 // UserIdentifierEQ applies the EQ predicate on the "user_identifier" field.
 func UserIdentifierEQ(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldUserIdentifier, v))
@@ -1266,6 +2272,206 @@ func UserIdentifierContainsFold(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldContainsFold(FieldUserIdentifier, v))
 }
 
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// DuplicateOfEQ applies the EQ predicate on the "duplicate_of" field.
+func DuplicateOfEQ(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldDuplicateOf, v))
+}
+
+// DuplicateOfNEQ applies the NEQ predicate on the "duplicate_of" field.
+func DuplicateOfNEQ(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldDuplicateOf, v))
+}
+
+// DuplicateOfIn applies the In predicate on the "duplicate_of" field.
+func DuplicateOfIn(vs ...uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldDuplicateOf, vs...))
+}
+
+// DuplicateOfNotIn applies the NotIn predicate on the "duplicate_of" field.
+func DuplicateOfNotIn(vs ...uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldDuplicateOf, vs...))
+}
+
+// DuplicateOfGT applies the GT predicate on the "duplicate_of" field.
+func DuplicateOfGT(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldDuplicateOf, v))
+}
+
+// DuplicateOfGTE applies the GTE predicate on the "duplicate_of" field.
+func DuplicateOfGTE(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldDuplicateOf, v))
+}
+
+// DuplicateOfLT applies the LT predicate on the "duplicate_of" field.
+func DuplicateOfLT(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldDuplicateOf, v))
+}
+
+// DuplicateOfLTE applies the LTE predicate on the "duplicate_of" field.
+func DuplicateOfLTE(v uuid.UUID) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldDuplicateOf, v))
+}
+
+// DuplicateOfIsNil applies the IsNil predicate on the "duplicate_of" field.
+func DuplicateOfIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldDuplicateOf))
+}
+
+// DuplicateOfNotNil applies the NotNil predicate on the "duplicate_of" field.
+func DuplicateOfNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldDuplicateOf))
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.ExperienceData {
+	return predicate.ExperienceData(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
 // EmbeddingEQ applies the EQ predicate on the "embedding" field.
 func EmbeddingEQ(v pgvector.Vector) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldEQ(FieldEmbedding, v))
@@ -1391,6 +2597,29 @@ func EmbeddingModelContainsFold(v string) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.FieldContainsFold(FieldEmbeddingModel, v))
 }
 
+// HasQuestion applies the HasEdge predicate on the "question" edge.
+func HasQuestion() predicate.ExperienceData {
+	return predicate.ExperienceData(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, QuestionTable, QuestionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionWith applies the HasEdge predicate on the "question" edge with a given conditions (other predicates).
+func HasQuestionWith(preds ...predicate.Question) predicate.ExperienceData {
+	return predicate.ExperienceData(func(s *sql.Selector) {
+		step := newQuestionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ExperienceData) predicate.ExperienceData {
 	return predicate.ExperienceData(sql.AndPredicates(predicates...))
@@ -20,56 +20,56 @@ type ExperienceDataDelete struct {
 }
 
 // Where appends a list predicates to the ExperienceDataDelete builder.
-func (edd *ExperienceDataDelete) Where(ps ...predicate.ExperienceData) *ExperienceDataDelete {
-	edd.mutation.Where(ps...)
-	return edd
+func (_d *ExperienceDataDelete) Where(ps ...predicate.ExperienceData) *ExperienceDataDelete {
+	_d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (edd *ExperienceDataDelete) Exec(ctx context.Context) (int, error) {
-	return withHooks(ctx, edd.sqlExec, edd.mutation, edd.hooks)
+func (_d *ExperienceDataDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (edd *ExperienceDataDelete) ExecX(ctx context.Context) int {
-	n, err := edd.Exec(ctx)
+func (_d *ExperienceDataDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
 	if err != nil {
 		panic(err)
 	}
 	return n
 }
 
-func (edd *ExperienceDataDelete) sqlExec(ctx context.Context) (int, error) {
+func (_d *ExperienceDataDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(experiencedata.Table, sqlgraph.NewFieldSpec(experiencedata.FieldID, field.TypeUUID))
-	if ps := edd.mutation.predicates; len(ps) > 0 {
+	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	affected, err := sqlgraph.DeleteNodes(ctx, edd.driver, _spec)
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
 		err = &ConstraintError{msg: err.Error(), wrap: err}
 	}
-	edd.mutation.done = true
+	_d.mutation.done = true
 	return affected, err
 }
 
 // ExperienceDataDeleteOne is the builder for deleting a single ExperienceData entity.
 type ExperienceDataDeleteOne struct {
-	edd *ExperienceDataDelete
+	_d *ExperienceDataDelete
 }
 
 // Where appends a list predicates to the ExperienceDataDelete builder.
-func (eddo *ExperienceDataDeleteOne) Where(ps ...predicate.ExperienceData) *ExperienceDataDeleteOne {
-	eddo.edd.mutation.Where(ps...)
-	return eddo
+func (_d *ExperienceDataDeleteOne) Where(ps ...predicate.ExperienceData) *ExperienceDataDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query.
-func (eddo *ExperienceDataDeleteOne) Exec(ctx context.Context) error {
-	n, err := eddo.edd.Exec(ctx)
+func (_d *ExperienceDataDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
 	switch {
 	case err != nil:
 		return err
@@ -81,8 +81,8 @@ func (eddo *ExperienceDataDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (eddo *ExperienceDataDeleteOne) ExecX(ctx context.Context) {
-	if err := eddo.Exec(ctx); err != nil {
+func (_d *ExperienceDataDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/google/uuid"
+)
+
+// ExperienceDeletion is the model entity for the ExperienceDeletion schema.
+type ExperienceDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ID of the deleted experience
+	ExperienceID uuid.UUID `json:"experience_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExperienceDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case experiencedeletion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case experiencedeletion.FieldID, experiencedeletion.FieldExperienceID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExperienceDeletion fields.
+func (_m *ExperienceDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case experiencedeletion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case experiencedeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case experiencedeletion.FieldExperienceID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field experience_id", values[i])
+			} else if value != nil {
+				_m.ExperienceID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExperienceDeletion.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExperienceDeletion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ExperienceDeletion.
+// Note that you need to call ExperienceDeletion.Unwrap() before calling this method if this ExperienceDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExperienceDeletion) Update() *ExperienceDeletionUpdateOne {
+	return NewExperienceDeletionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExperienceDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExperienceDeletion) Unwrap() *ExperienceDeletion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExperienceDeletion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExperienceDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExperienceDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("experience_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExperienceID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExperienceDeletions is a parsable slice of ExperienceDeletion.
+type ExperienceDeletions []*ExperienceDeletion
@@ -0,0 +1,65 @@
+// Code generated by ent, DO NOT EDIT.
+
+package experiencedeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the experiencedeletion type in the database.
+	Label = "experience_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExperienceID holds the string denoting the experience_id field in the database.
+	FieldExperienceID = "experience_id"
+	// Table holds the table name of the experiencedeletion in the database.
+	Table = "experience_deletions"
+)
+
+// Columns holds all SQL columns for experiencedeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldExperienceID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ExperienceDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExperienceID orders the results by the experience_id field.
+func ByExperienceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExperienceID, opts...).ToFunc()
+}
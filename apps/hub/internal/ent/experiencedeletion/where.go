@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package experiencedeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExperienceID applies equality check predicate on the "experience_id" field. It's identical to ExperienceIDEQ.
+func ExperienceID(v uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldEQ(FieldExperienceID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExperienceIDEQ applies the EQ predicate on the "experience_id" field.
+func ExperienceIDEQ(v uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldEQ(FieldExperienceID, v))
+}
+
+// ExperienceIDNEQ applies the NEQ predicate on the "experience_id" field.
+func ExperienceIDNEQ(v uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldNEQ(FieldExperienceID, v))
+}
+
+// ExperienceIDIn applies the In predicate on the "experience_id" field.
+func ExperienceIDIn(vs ...uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldIn(FieldExperienceID, vs...))
+}
+
+// ExperienceIDNotIn applies the NotIn predicate on the "experience_id" field.
+func ExperienceIDNotIn(vs ...uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldNotIn(FieldExperienceID, vs...))
+}
+
+// ExperienceIDGT applies the GT predicate on the "experience_id" field.
+func ExperienceIDGT(v uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldGT(FieldExperienceID, v))
+}
+
+// ExperienceIDGTE applies the GTE predicate on the "experience_id" field.
+func ExperienceIDGTE(v uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldGTE(FieldExperienceID, v))
+}
+
+// ExperienceIDLT applies the LT predicate on the "experience_id" field.
+func ExperienceIDLT(v uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldLT(FieldExperienceID, v))
+}
+
+// ExperienceIDLTE applies the LTE predicate on the "experience_id" field.
+func ExperienceIDLTE(v uuid.UUID) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.FieldLTE(FieldExperienceID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExperienceDeletion) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExperienceDeletion) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExperienceDeletion) predicate.ExperienceDeletion {
+	return predicate.ExperienceDeletion(sql.NotPredicates(p))
+}
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/google/uuid"
+)
+
+// ExperienceDeletionCreate is the builder for creating a ExperienceDeletion entity.
+type ExperienceDeletionCreate struct {
+	config
+	mutation *ExperienceDeletionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExperienceDeletionCreate) SetCreatedAt(v time.Time) *ExperienceDeletionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExperienceDeletionCreate) SetNillableCreatedAt(v *time.Time) *ExperienceDeletionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExperienceID sets the "experience_id" field.
+func (_c *ExperienceDeletionCreate) SetExperienceID(v uuid.UUID) *ExperienceDeletionCreate {
+	_c.mutation.SetExperienceID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExperienceDeletionCreate) SetID(v uuid.UUID) *ExperienceDeletionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ExperienceDeletionCreate) SetNillableID(v *uuid.UUID) *ExperienceDeletionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ExperienceDeletionMutation object of the builder.
+func (_c *ExperienceDeletionCreate) Mutation() *ExperienceDeletionMutation {
+	return _c.mutation
+}
+
+// Save creates the ExperienceDeletion in the database.
+func (_c *ExperienceDeletionCreate) Save(ctx context.Context) (*ExperienceDeletion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExperienceDeletionCreate) SaveX(ctx context.Context) *ExperienceDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExperienceDeletionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExperienceDeletionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExperienceDeletionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := experiencedeletion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := experiencedeletion.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExperienceDeletionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExperienceDeletion.created_at"`)}
+	}
+	if _, ok := _c.mutation.ExperienceID(); !ok {
+		return &ValidationError{Name: "experience_id", err: errors.New(`ent: missing required field "ExperienceDeletion.experience_id"`)}
+	}
+	return nil
+}
+
+func (_c *ExperienceDeletionCreate) sqlSave(ctx context.Context) (*ExperienceDeletion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExperienceDeletionCreate) createSpec() (*ExperienceDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExperienceDeletion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(experiencedeletion.Table, sqlgraph.NewFieldSpec(experiencedeletion.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(experiencedeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ExperienceID(); ok {
+		_spec.SetField(experiencedeletion.FieldExperienceID, field.TypeUUID, value)
+		_node.ExperienceID = value
+	}
+	return _node, _spec
+}
+
+// ExperienceDeletionCreateBulk is the builder for creating many ExperienceDeletion entities in bulk.
+type ExperienceDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*ExperienceDeletionCreate
+}
+
+// Save creates the ExperienceDeletion entities in the database.
+func (_c *ExperienceDeletionCreateBulk) Save(ctx context.Context) ([]*ExperienceDeletion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExperienceDeletion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExperienceDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExperienceDeletionCreateBulk) SaveX(ctx context.Context) []*ExperienceDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExperienceDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExperienceDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// ExperienceDeletionDelete is the builder for deleting a ExperienceDeletion entity.
+type ExperienceDeletionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExperienceDeletionMutation
+}
+
+// Where appends a list predicates to the ExperienceDeletionDelete builder.
+func (_d *ExperienceDeletionDelete) Where(ps ...predicate.ExperienceDeletion) *ExperienceDeletionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ExperienceDeletionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExperienceDeletionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ExperienceDeletionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(experiencedeletion.Table, sqlgraph.NewFieldSpec(experiencedeletion.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ExperienceDeletionDeleteOne is the builder for deleting a single ExperienceDeletion entity.
+type ExperienceDeletionDeleteOne struct {
+	_d *ExperienceDeletionDelete
+}
+
+// Where appends a list predicates to the ExperienceDeletionDelete builder.
+func (_d *ExperienceDeletionDeleteOne) Where(ps ...predicate.ExperienceDeletion) *ExperienceDeletionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ExperienceDeletionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{experiencedeletion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExperienceDeletionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
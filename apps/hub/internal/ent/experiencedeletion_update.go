@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// ExperienceDeletionUpdate is the builder for updating ExperienceDeletion entities.
+type ExperienceDeletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExperienceDeletionMutation
+}
+
+// Where appends a list predicates to the ExperienceDeletionUpdate builder.
+func (_u *ExperienceDeletionUpdate) Where(ps ...predicate.ExperienceDeletion) *ExperienceDeletionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ExperienceDeletionMutation object of the builder.
+func (_u *ExperienceDeletionUpdate) Mutation() *ExperienceDeletionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExperienceDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExperienceDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExperienceDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExperienceDeletionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ExperienceDeletionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(experiencedeletion.Table, experiencedeletion.Columns, sqlgraph.NewFieldSpec(experiencedeletion.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experiencedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExperienceDeletionUpdateOne is the builder for updating a single ExperienceDeletion entity.
+type ExperienceDeletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExperienceDeletionMutation
+}
+
+// Mutation returns the ExperienceDeletionMutation object of the builder.
+func (_u *ExperienceDeletionUpdateOne) Mutation() *ExperienceDeletionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExperienceDeletionUpdate builder.
+func (_u *ExperienceDeletionUpdateOne) Where(ps ...predicate.ExperienceDeletion) *ExperienceDeletionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExperienceDeletionUpdateOne) Select(field string, fields ...string) *ExperienceDeletionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExperienceDeletion entity.
+func (_u *ExperienceDeletionUpdateOne) Save(ctx context.Context) (*ExperienceDeletion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExperienceDeletionUpdateOne) SaveX(ctx context.Context) *ExperienceDeletion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExperienceDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExperienceDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ExperienceDeletionUpdateOne) sqlSave(ctx context.Context) (_node *ExperienceDeletion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(experiencedeletion.Table, experiencedeletion.Columns, sqlgraph.NewFieldSpec(experiencedeletion.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExperienceDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, experiencedeletion.FieldID)
+		for _, f := range fields {
+			if !experiencedeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != experiencedeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ExperienceDeletion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{experiencedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
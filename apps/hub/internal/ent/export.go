@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/google/uuid"
+)
+
+// Export is the model entity for the Export schema.
+type Export struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name shown in tools, e.g. Warehouse raw feedback
+	Name string `json:"name,omitempty"`
+	// File format: jsonl (gzip-compressed JSON Lines) or parquet
+	Format string `json:"format,omitempty"`
+	// Storage provider: s3 (AWS or S3-compatible) or gcs
+	Provider string `json:"provider,omitempty"`
+	// Bucket holds the value of the "bucket" field.
+	Bucket string `json:"bucket,omitempty"`
+	// Region of the bucket; us-east-1 if empty
+	Region string `json:"region,omitempty"`
+	// URL of an S3-compatible service, e.g. MinIO or R2; empty for the provider's
+	Endpoint string `json:"endpoint,omitempty"`
+	// Object key prefix, with {date}, {year}, {month}, {day}, and {hour} placeholders
+	Prefix string `json:"prefix,omitempty"`
+	// Access key (HMAC key for gcs); the AWS_* environment variables if empty
+	AccessKeyID string `json:"access_key_id,omitempty"`
+	// SecretAccessKey holds the value of the "secret_access_key" field.
+	SecretAccessKey string `json:"-"`
+	// Minutes between runs
+	IntervalMinutes int `json:"interval_minutes,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// updated_at of the last experience exported
+	CursorAt *time.Time `json:"cursor_at,omitempty"`
+	// ID of the last experience exported, to order experiences of the same time
+	CursorID *uuid.UUID `json:"cursor_id,omitempty"`
+	// When the export runs next
+	NextRunAt    time.Time `json:"next_run_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Export) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case export.FieldCursorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case export.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case export.FieldIntervalMinutes:
+			values[i] = new(sql.NullInt64)
+		case export.FieldName, export.FieldFormat, export.FieldProvider, export.FieldBucket, export.FieldRegion, export.FieldEndpoint, export.FieldPrefix, export.FieldAccessKeyID, export.FieldSecretAccessKey:
+			values[i] = new(sql.NullString)
+		case export.FieldCreatedAt, export.FieldUpdatedAt, export.FieldCursorAt, export.FieldNextRunAt:
+			values[i] = new(sql.NullTime)
+		case export.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Export fields.
+func (_m *Export) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case export.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case export.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case export.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case export.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case export.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case export.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case export.FieldBucket:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket", values[i])
+			} else if value.Valid {
+				_m.Bucket = value.String
+			}
+		case export.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		case export.FieldEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint", values[i])
+			} else if value.Valid {
+				_m.Endpoint = value.String
+			}
+		case export.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				_m.Prefix = value.String
+			}
+		case export.FieldAccessKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_key_id", values[i])
+			} else if value.Valid {
+				_m.AccessKeyID = value.String
+			}
+		case export.FieldSecretAccessKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_access_key", values[i])
+			} else if value.Valid {
+				_m.SecretAccessKey = value.String
+			}
+		case export.FieldIntervalMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval_minutes", values[i])
+			} else if value.Valid {
+				_m.IntervalMinutes = int(value.Int64)
+			}
+		case export.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case export.FieldCursorAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cursor_at", values[i])
+			} else if value.Valid {
+				_m.CursorAt = new(time.Time)
+				*_m.CursorAt = value.Time
+			}
+		case export.FieldCursorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field cursor_id", values[i])
+			} else if value.Valid {
+				_m.CursorID = new(uuid.UUID)
+				*_m.CursorID = *value.S.(*uuid.UUID)
+			}
+		case export.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Export.
+// This includes values selected through modifiers, order, etc.
+func (_m *Export) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Export.
+// Note that you need to call Export.Unwrap() before calling this method if this Export
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Export) Update() *ExportUpdateOne {
+	return NewExportClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Export entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Export) Unwrap() *Export {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Export is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Export) String() string {
+	var builder strings.Builder
+	builder.WriteString("Export(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("bucket=")
+	builder.WriteString(_m.Bucket)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteString(", ")
+	builder.WriteString("endpoint=")
+	builder.WriteString(_m.Endpoint)
+	builder.WriteString(", ")
+	builder.WriteString("prefix=")
+	builder.WriteString(_m.Prefix)
+	builder.WriteString(", ")
+	builder.WriteString("access_key_id=")
+	builder.WriteString(_m.AccessKeyID)
+	builder.WriteString(", ")
+	builder.WriteString("secret_access_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("interval_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IntervalMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.CursorAt; v != nil {
+		builder.WriteString("cursor_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CursorID; v != nil {
+		builder.WriteString("cursor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(_m.NextRunAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Exports is a parsable slice of Export.
+type Exports []*Export
@@ -0,0 +1,1056 @@
+// Code generated by ent, DO NOT EDIT.
+
+package export
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldName, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldFormat, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldProvider, v))
+}
+
+// Bucket applies equality check predicate on the "bucket" field. It's identical to BucketEQ.
+func Bucket(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldBucket, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldRegion, v))
+}
+
+// Endpoint applies equality check predicate on the "endpoint" field. It's identical to EndpointEQ.
+func Endpoint(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// Prefix applies equality check predicate on the "prefix" field. It's identical to PrefixEQ.
+func Prefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldPrefix, v))
+}
+
+// AccessKeyID applies equality check predicate on the "access_key_id" field. It's identical to AccessKeyIDEQ.
+func AccessKeyID(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// SecretAccessKey applies equality check predicate on the "secret_access_key" field. It's identical to SecretAccessKeyEQ.
+func SecretAccessKey(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSecretAccessKey, v))
+}
+
+// IntervalMinutes applies equality check predicate on the "interval_minutes" field. It's identical to IntervalMinutesEQ.
+func IntervalMinutes(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldIntervalMinutes, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CursorAt applies equality check predicate on the "cursor_at" field. It's identical to CursorAtEQ.
+func CursorAt(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCursorAt, v))
+}
+
+// CursorID applies equality check predicate on the "cursor_id" field. It's identical to CursorIDEQ.
+func CursorID(v uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCursorID, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldName, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// BucketEQ applies the EQ predicate on the "bucket" field.
+func BucketEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldBucket, v))
+}
+
+// BucketNEQ applies the NEQ predicate on the "bucket" field.
+func BucketNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldBucket, v))
+}
+
+// BucketIn applies the In predicate on the "bucket" field.
+func BucketIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldBucket, vs...))
+}
+
+// BucketNotIn applies the NotIn predicate on the "bucket" field.
+func BucketNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldBucket, vs...))
+}
+
+// BucketGT applies the GT predicate on the "bucket" field.
+func BucketGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldBucket, v))
+}
+
+// BucketGTE applies the GTE predicate on the "bucket" field.
+func BucketGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldBucket, v))
+}
+
+// BucketLT applies the LT predicate on the "bucket" field.
+func BucketLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldBucket, v))
+}
+
+// BucketLTE applies the LTE predicate on the "bucket" field.
+func BucketLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldBucket, v))
+}
+
+// BucketContains applies the Contains predicate on the "bucket" field.
+func BucketContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldBucket, v))
+}
+
+// BucketHasPrefix applies the HasPrefix predicate on the "bucket" field.
+func BucketHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldBucket, v))
+}
+
+// BucketHasSuffix applies the HasSuffix predicate on the "bucket" field.
+func BucketHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldBucket, v))
+}
+
+// BucketEqualFold applies the EqualFold predicate on the "bucket" field.
+func BucketEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldBucket, v))
+}
+
+// BucketContainsFold applies the ContainsFold predicate on the "bucket" field.
+func BucketContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldBucket, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionIsNil applies the IsNil predicate on the "region" field.
+func RegionIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldRegion))
+}
+
+// RegionNotNil applies the NotNil predicate on the "region" field.
+func RegionNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldRegion))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// EndpointEQ applies the EQ predicate on the "endpoint" field.
+func EndpointEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldEndpoint, v))
+}
+
+// EndpointNEQ applies the NEQ predicate on the "endpoint" field.
+func EndpointNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldEndpoint, v))
+}
+
+// EndpointIn applies the In predicate on the "endpoint" field.
+func EndpointIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldEndpoint, vs...))
+}
+
+// EndpointNotIn applies the NotIn predicate on the "endpoint" field.
+func EndpointNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldEndpoint, vs...))
+}
+
+// EndpointGT applies the GT predicate on the "endpoint" field.
+func EndpointGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldEndpoint, v))
+}
+
+// EndpointGTE applies the GTE predicate on the "endpoint" field.
+func EndpointGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldEndpoint, v))
+}
+
+// EndpointLT applies the LT predicate on the "endpoint" field.
+func EndpointLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldEndpoint, v))
+}
+
+// EndpointLTE applies the LTE predicate on the "endpoint" field.
+func EndpointLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldEndpoint, v))
+}
+
+// EndpointContains applies the Contains predicate on the "endpoint" field.
+func EndpointContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldEndpoint, v))
+}
+
+// EndpointHasPrefix applies the HasPrefix predicate on the "endpoint" field.
+func EndpointHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldEndpoint, v))
+}
+
+// EndpointHasSuffix applies the HasSuffix predicate on the "endpoint" field.
+func EndpointHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldEndpoint, v))
+}
+
+// EndpointIsNil applies the IsNil predicate on the "endpoint" field.
+func EndpointIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldEndpoint))
+}
+
+// EndpointNotNil applies the NotNil predicate on the "endpoint" field.
+func EndpointNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldEndpoint))
+}
+
+// EndpointEqualFold applies the EqualFold predicate on the "endpoint" field.
+func EndpointEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldEndpoint, v))
+}
+
+// EndpointContainsFold applies the ContainsFold predicate on the "endpoint" field.
+func EndpointContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldEndpoint, v))
+}
+
+// PrefixEQ applies the EQ predicate on the "prefix" field.
+func PrefixEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldPrefix, v))
+}
+
+// PrefixNEQ applies the NEQ predicate on the "prefix" field.
+func PrefixNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldPrefix, v))
+}
+
+// PrefixIn applies the In predicate on the "prefix" field.
+func PrefixIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldPrefix, vs...))
+}
+
+// PrefixNotIn applies the NotIn predicate on the "prefix" field.
+func PrefixNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldPrefix, vs...))
+}
+
+// PrefixGT applies the GT predicate on the "prefix" field.
+func PrefixGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldPrefix, v))
+}
+
+// PrefixGTE applies the GTE predicate on the "prefix" field.
+func PrefixGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldPrefix, v))
+}
+
+// PrefixLT applies the LT predicate on the "prefix" field.
+func PrefixLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldPrefix, v))
+}
+
+// PrefixLTE applies the LTE predicate on the "prefix" field.
+func PrefixLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldPrefix, v))
+}
+
+// PrefixContains applies the Contains predicate on the "prefix" field.
+func PrefixContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldPrefix, v))
+}
+
+// PrefixHasPrefix applies the HasPrefix predicate on the "prefix" field.
+func PrefixHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldPrefix, v))
+}
+
+// PrefixHasSuffix applies the HasSuffix predicate on the "prefix" field.
+func PrefixHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldPrefix, v))
+}
+
+// PrefixIsNil applies the IsNil predicate on the "prefix" field.
+func PrefixIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldPrefix))
+}
+
+// PrefixNotNil applies the NotNil predicate on the "prefix" field.
+func PrefixNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldPrefix))
+}
+
+// PrefixEqualFold applies the EqualFold predicate on the "prefix" field.
+func PrefixEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldPrefix, v))
+}
+
+// PrefixContainsFold applies the ContainsFold predicate on the "prefix" field.
+func PrefixContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldPrefix, v))
+}
+
+// AccessKeyIDEQ applies the EQ predicate on the "access_key_id" field.
+func AccessKeyIDEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDNEQ applies the NEQ predicate on the "access_key_id" field.
+func AccessKeyIDNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDIn applies the In predicate on the "access_key_id" field.
+func AccessKeyIDIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDNotIn applies the NotIn predicate on the "access_key_id" field.
+func AccessKeyIDNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldAccessKeyID, vs...))
+}
+
+// AccessKeyIDGT applies the GT predicate on the "access_key_id" field.
+func AccessKeyIDGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDGTE applies the GTE predicate on the "access_key_id" field.
+func AccessKeyIDGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLT applies the LT predicate on the "access_key_id" field.
+func AccessKeyIDLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDLTE applies the LTE predicate on the "access_key_id" field.
+func AccessKeyIDLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDContains applies the Contains predicate on the "access_key_id" field.
+func AccessKeyIDContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDHasPrefix applies the HasPrefix predicate on the "access_key_id" field.
+func AccessKeyIDHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDHasSuffix applies the HasSuffix predicate on the "access_key_id" field.
+func AccessKeyIDHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDIsNil applies the IsNil predicate on the "access_key_id" field.
+func AccessKeyIDIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldAccessKeyID))
+}
+
+// AccessKeyIDNotNil applies the NotNil predicate on the "access_key_id" field.
+func AccessKeyIDNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldAccessKeyID))
+}
+
+// AccessKeyIDEqualFold applies the EqualFold predicate on the "access_key_id" field.
+func AccessKeyIDEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldAccessKeyID, v))
+}
+
+// AccessKeyIDContainsFold applies the ContainsFold predicate on the "access_key_id" field.
+func AccessKeyIDContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldAccessKeyID, v))
+}
+
+// SecretAccessKeyEQ applies the EQ predicate on the "secret_access_key" field.
+func SecretAccessKeyEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyNEQ applies the NEQ predicate on the "secret_access_key" field.
+func SecretAccessKeyNEQ(v string) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyIn applies the In predicate on the "secret_access_key" field.
+func SecretAccessKeyIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldSecretAccessKey, vs...))
+}
+
+// SecretAccessKeyNotIn applies the NotIn predicate on the "secret_access_key" field.
+func SecretAccessKeyNotIn(vs ...string) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldSecretAccessKey, vs...))
+}
+
+// SecretAccessKeyGT applies the GT predicate on the "secret_access_key" field.
+func SecretAccessKeyGT(v string) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyGTE applies the GTE predicate on the "secret_access_key" field.
+func SecretAccessKeyGTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyLT applies the LT predicate on the "secret_access_key" field.
+func SecretAccessKeyLT(v string) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyLTE applies the LTE predicate on the "secret_access_key" field.
+func SecretAccessKeyLTE(v string) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyContains applies the Contains predicate on the "secret_access_key" field.
+func SecretAccessKeyContains(v string) predicate.Export {
+	return predicate.Export(sql.FieldContains(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyHasPrefix applies the HasPrefix predicate on the "secret_access_key" field.
+func SecretAccessKeyHasPrefix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasPrefix(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyHasSuffix applies the HasSuffix predicate on the "secret_access_key" field.
+func SecretAccessKeyHasSuffix(v string) predicate.Export {
+	return predicate.Export(sql.FieldHasSuffix(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyIsNil applies the IsNil predicate on the "secret_access_key" field.
+func SecretAccessKeyIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldSecretAccessKey))
+}
+
+// SecretAccessKeyNotNil applies the NotNil predicate on the "secret_access_key" field.
+func SecretAccessKeyNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldSecretAccessKey))
+}
+
+// SecretAccessKeyEqualFold applies the EqualFold predicate on the "secret_access_key" field.
+func SecretAccessKeyEqualFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldEqualFold(FieldSecretAccessKey, v))
+}
+
+// SecretAccessKeyContainsFold applies the ContainsFold predicate on the "secret_access_key" field.
+func SecretAccessKeyContainsFold(v string) predicate.Export {
+	return predicate.Export(sql.FieldContainsFold(FieldSecretAccessKey, v))
+}
+
+// IntervalMinutesEQ applies the EQ predicate on the "interval_minutes" field.
+func IntervalMinutesEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldIntervalMinutes, v))
+}
+
+// IntervalMinutesNEQ applies the NEQ predicate on the "interval_minutes" field.
+func IntervalMinutesNEQ(v int) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldIntervalMinutes, v))
+}
+
+// IntervalMinutesIn applies the In predicate on the "interval_minutes" field.
+func IntervalMinutesIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldIntervalMinutes, vs...))
+}
+
+// IntervalMinutesNotIn applies the NotIn predicate on the "interval_minutes" field.
+func IntervalMinutesNotIn(vs ...int) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldIntervalMinutes, vs...))
+}
+
+// IntervalMinutesGT applies the GT predicate on the "interval_minutes" field.
+func IntervalMinutesGT(v int) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldIntervalMinutes, v))
+}
+
+// IntervalMinutesGTE applies the GTE predicate on the "interval_minutes" field.
+func IntervalMinutesGTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldIntervalMinutes, v))
+}
+
+// IntervalMinutesLT applies the LT predicate on the "interval_minutes" field.
+func IntervalMinutesLT(v int) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldIntervalMinutes, v))
+}
+
+// IntervalMinutesLTE applies the LTE predicate on the "interval_minutes" field.
+func IntervalMinutesLTE(v int) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldIntervalMinutes, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CursorAtEQ applies the EQ predicate on the "cursor_at" field.
+func CursorAtEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCursorAt, v))
+}
+
+// CursorAtNEQ applies the NEQ predicate on the "cursor_at" field.
+func CursorAtNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldCursorAt, v))
+}
+
+// CursorAtIn applies the In predicate on the "cursor_at" field.
+func CursorAtIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldCursorAt, vs...))
+}
+
+// CursorAtNotIn applies the NotIn predicate on the "cursor_at" field.
+func CursorAtNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldCursorAt, vs...))
+}
+
+// CursorAtGT applies the GT predicate on the "cursor_at" field.
+func CursorAtGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldCursorAt, v))
+}
+
+// CursorAtGTE applies the GTE predicate on the "cursor_at" field.
+func CursorAtGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldCursorAt, v))
+}
+
+// CursorAtLT applies the LT predicate on the "cursor_at" field.
+func CursorAtLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldCursorAt, v))
+}
+
+// CursorAtLTE applies the LTE predicate on the "cursor_at" field.
+func CursorAtLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldCursorAt, v))
+}
+
+// CursorAtIsNil applies the IsNil predicate on the "cursor_at" field.
+func CursorAtIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldCursorAt))
+}
+
+// CursorAtNotNil applies the NotNil predicate on the "cursor_at" field.
+func CursorAtNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldCursorAt))
+}
+
+// CursorIDEQ applies the EQ predicate on the "cursor_id" field.
+func CursorIDEQ(v uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldCursorID, v))
+}
+
+// CursorIDNEQ applies the NEQ predicate on the "cursor_id" field.
+func CursorIDNEQ(v uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldCursorID, v))
+}
+
+// CursorIDIn applies the In predicate on the "cursor_id" field.
+func CursorIDIn(vs ...uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldCursorID, vs...))
+}
+
+// CursorIDNotIn applies the NotIn predicate on the "cursor_id" field.
+func CursorIDNotIn(vs ...uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldCursorID, vs...))
+}
+
+// CursorIDGT applies the GT predicate on the "cursor_id" field.
+func CursorIDGT(v uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldCursorID, v))
+}
+
+// CursorIDGTE applies the GTE predicate on the "cursor_id" field.
+func CursorIDGTE(v uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldCursorID, v))
+}
+
+// CursorIDLT applies the LT predicate on the "cursor_id" field.
+func CursorIDLT(v uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldCursorID, v))
+}
+
+// CursorIDLTE applies the LTE predicate on the "cursor_id" field.
+func CursorIDLTE(v uuid.UUID) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldCursorID, v))
+}
+
+// CursorIDIsNil applies the IsNil predicate on the "cursor_id" field.
+func CursorIDIsNil() predicate.Export {
+	return predicate.Export(sql.FieldIsNull(FieldCursorID))
+}
+
+// CursorIDNotNil applies the NotNil predicate on the "cursor_id" field.
+func CursorIDNotNil() predicate.Export {
+	return predicate.Export(sql.FieldNotNull(FieldCursorID))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.Export {
+	return predicate.Export(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.Export {
+	return predicate.Export(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Export) predicate.Export {
+	return predicate.Export(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Export) predicate.Export {
+	return predicate.Export(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Export) predicate.Export {
+	return predicate.Export(sql.NotPredicates(p))
+}
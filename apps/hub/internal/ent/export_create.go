@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/google/uuid"
+)
+
+// ExportCreate is the builder for creating a Export entity.
+type ExportCreate struct {
+	config
+	mutation *ExportMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExportCreate) SetCreatedAt(v time.Time) *ExportCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableCreatedAt(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExportCreate) SetUpdatedAt(v time.Time) *ExportCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableUpdatedAt(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ExportCreate) SetName(v string) *ExportCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *ExportCreate) SetFormat(v string) *ExportCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableFormat(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *ExportCreate) SetProvider(v string) *ExportCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableProvider(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetBucket sets the "bucket" field.
+func (_c *ExportCreate) SetBucket(v string) *ExportCreate {
+	_c.mutation.SetBucket(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *ExportCreate) SetRegion(v string) *ExportCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableRegion(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetRegion(*v)
+	}
+	return _c
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_c *ExportCreate) SetEndpoint(v string) *ExportCreate {
+	_c.mutation.SetEndpoint(v)
+	return _c
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableEndpoint(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetEndpoint(*v)
+	}
+	return _c
+}
+
+// SetPrefix sets the "prefix" field.
+func (_c *ExportCreate) SetPrefix(v string) *ExportCreate {
+	_c.mutation.SetPrefix(v)
+	return _c
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_c *ExportCreate) SetNillablePrefix(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetPrefix(*v)
+	}
+	return _c
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (_c *ExportCreate) SetAccessKeyID(v string) *ExportCreate {
+	_c.mutation.SetAccessKeyID(v)
+	return _c
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableAccessKeyID(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetAccessKeyID(*v)
+	}
+	return _c
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (_c *ExportCreate) SetSecretAccessKey(v string) *ExportCreate {
+	_c.mutation.SetSecretAccessKey(v)
+	return _c
+}
+
+// SetNillableSecretAccessKey sets the "secret_access_key" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableSecretAccessKey(v *string) *ExportCreate {
+	if v != nil {
+		_c.SetSecretAccessKey(*v)
+	}
+	return _c
+}
+
+// SetIntervalMinutes sets the "interval_minutes" field.
+func (_c *ExportCreate) SetIntervalMinutes(v int) *ExportCreate {
+	_c.mutation.SetIntervalMinutes(v)
+	return _c
+}
+
+// SetNillableIntervalMinutes sets the "interval_minutes" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableIntervalMinutes(v *int) *ExportCreate {
+	if v != nil {
+		_c.SetIntervalMinutes(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ExportCreate) SetEnabled(v bool) *ExportCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableEnabled(v *bool) *ExportCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCursorAt sets the "cursor_at" field.
+func (_c *ExportCreate) SetCursorAt(v time.Time) *ExportCreate {
+	_c.mutation.SetCursorAt(v)
+	return _c
+}
+
+// SetNillableCursorAt sets the "cursor_at" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableCursorAt(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetCursorAt(*v)
+	}
+	return _c
+}
+
+// SetCursorID sets the "cursor_id" field.
+func (_c *ExportCreate) SetCursorID(v uuid.UUID) *ExportCreate {
+	_c.mutation.SetCursorID(v)
+	return _c
+}
+
+// SetNillableCursorID sets the "cursor_id" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableCursorID(v *uuid.UUID) *ExportCreate {
+	if v != nil {
+		_c.SetCursorID(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *ExportCreate) SetNextRunAt(v time.Time) *ExportCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableNextRunAt(v *time.Time) *ExportCreate {
+	if v != nil {
+		_c.SetNextRunAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExportCreate) SetID(v uuid.UUID) *ExportCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ExportCreate) SetNillableID(v *uuid.UUID) *ExportCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (_c *ExportCreate) Mutation() *ExportMutation {
+	return _c.mutation
+}
+
+// Save creates the Export in the database.
+func (_c *ExportCreate) Save(ctx context.Context) (*Export, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExportCreate) SaveX(ctx context.Context) *Export {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExportCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := export.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := export.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		v := export.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		v := export.DefaultProvider
+		_c.mutation.SetProvider(v)
+	}
+	if _, ok := _c.mutation.IntervalMinutes(); !ok {
+		v := export.DefaultIntervalMinutes
+		_c.mutation.SetIntervalMinutes(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := export.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		v := export.DefaultNextRunAt()
+		_c.mutation.SetNextRunAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := export.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExportCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Export.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Export.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Export.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := export.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Export.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Export.format"`)}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Export.provider"`)}
+	}
+	if _, ok := _c.mutation.Bucket(); !ok {
+		return &ValidationError{Name: "bucket", err: errors.New(`ent: missing required field "Export.bucket"`)}
+	}
+	if v, ok := _c.mutation.Bucket(); ok {
+		if err := export.BucketValidator(v); err != nil {
+			return &ValidationError{Name: "bucket", err: fmt.Errorf(`ent: validator failed for field "Export.bucket": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IntervalMinutes(); !ok {
+		return &ValidationError{Name: "interval_minutes", err: errors.New(`ent: missing required field "Export.interval_minutes"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Export.enabled"`)}
+	}
+	if _, ok := _c.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "Export.next_run_at"`)}
+	}
+	return nil
+}
+
+func (_c *ExportCreate) sqlSave(ctx context.Context) (*Export, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExportCreate) createSpec() (*Export, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Export{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(export.Table, sqlgraph.NewFieldSpec(export.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(export.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(export.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(export.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(export.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(export.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Bucket(); ok {
+		_spec.SetField(export.FieldBucket, field.TypeString, value)
+		_node.Bucket = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(export.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := _c.mutation.Endpoint(); ok {
+		_spec.SetField(export.FieldEndpoint, field.TypeString, value)
+		_node.Endpoint = value
+	}
+	if value, ok := _c.mutation.Prefix(); ok {
+		_spec.SetField(export.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := _c.mutation.AccessKeyID(); ok {
+		_spec.SetField(export.FieldAccessKeyID, field.TypeString, value)
+		_node.AccessKeyID = value
+	}
+	if value, ok := _c.mutation.SecretAccessKey(); ok {
+		_spec.SetField(export.FieldSecretAccessKey, field.TypeString, value)
+		_node.SecretAccessKey = value
+	}
+	if value, ok := _c.mutation.IntervalMinutes(); ok {
+		_spec.SetField(export.FieldIntervalMinutes, field.TypeInt, value)
+		_node.IntervalMinutes = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(export.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CursorAt(); ok {
+		_spec.SetField(export.FieldCursorAt, field.TypeTime, value)
+		_node.CursorAt = &value
+	}
+	if value, ok := _c.mutation.CursorID(); ok {
+		_spec.SetField(export.FieldCursorID, field.TypeUUID, value)
+		_node.CursorID = &value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(export.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = value
+	}
+	return _node, _spec
+}
+
+// ExportCreateBulk is the builder for creating many Export entities in bulk.
+type ExportCreateBulk struct {
+	config
+	err      error
+	builders []*ExportCreate
+}
+
+// Save creates the Export entities in the database.
+func (_c *ExportCreateBulk) Save(ctx context.Context) ([]*Export, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Export, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExportCreateBulk) SaveX(ctx context.Context) []*Export {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
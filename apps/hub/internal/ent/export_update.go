@@ -0,0 +1,871 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ExportUpdate is the builder for updating Export entities.
+type ExportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExportMutation
+}
+
+// Where appends a list predicates to the ExportUpdate builder.
+func (_u *ExportUpdate) Where(ps ...predicate.Export) *ExportUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExportUpdate) SetUpdatedAt(v time.Time) *ExportUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ExportUpdate) SetName(v string) *ExportUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableName(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ExportUpdate) SetFormat(v string) *ExportUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableFormat(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ExportUpdate) SetProvider(v string) *ExportUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableProvider(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetBucket sets the "bucket" field.
+func (_u *ExportUpdate) SetBucket(v string) *ExportUpdate {
+	_u.mutation.SetBucket(v)
+	return _u
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableBucket(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetBucket(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *ExportUpdate) SetRegion(v string) *ExportUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableRegion(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *ExportUpdate) ClearRegion() *ExportUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *ExportUpdate) SetEndpoint(v string) *ExportUpdate {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableEndpoint(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (_u *ExportUpdate) ClearEndpoint() *ExportUpdate {
+	_u.mutation.ClearEndpoint()
+	return _u
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *ExportUpdate) SetPrefix(v string) *ExportUpdate {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillablePrefix(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// ClearPrefix clears the value of the "prefix" field.
+func (_u *ExportUpdate) ClearPrefix() *ExportUpdate {
+	_u.mutation.ClearPrefix()
+	return _u
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (_u *ExportUpdate) SetAccessKeyID(v string) *ExportUpdate {
+	_u.mutation.SetAccessKeyID(v)
+	return _u
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableAccessKeyID(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetAccessKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (_u *ExportUpdate) ClearAccessKeyID() *ExportUpdate {
+	_u.mutation.ClearAccessKeyID()
+	return _u
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (_u *ExportUpdate) SetSecretAccessKey(v string) *ExportUpdate {
+	_u.mutation.SetSecretAccessKey(v)
+	return _u
+}
+
+// SetNillableSecretAccessKey sets the "secret_access_key" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableSecretAccessKey(v *string) *ExportUpdate {
+	if v != nil {
+		_u.SetSecretAccessKey(*v)
+	}
+	return _u
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (_u *ExportUpdate) ClearSecretAccessKey() *ExportUpdate {
+	_u.mutation.ClearSecretAccessKey()
+	return _u
+}
+
+// SetIntervalMinutes sets the "interval_minutes" field.
+func (_u *ExportUpdate) SetIntervalMinutes(v int) *ExportUpdate {
+	_u.mutation.ResetIntervalMinutes()
+	_u.mutation.SetIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableIntervalMinutes sets the "interval_minutes" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableIntervalMinutes(v *int) *ExportUpdate {
+	if v != nil {
+		_u.SetIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddIntervalMinutes adds value to the "interval_minutes" field.
+func (_u *ExportUpdate) AddIntervalMinutes(v int) *ExportUpdate {
+	_u.mutation.AddIntervalMinutes(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ExportUpdate) SetEnabled(v bool) *ExportUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableEnabled(v *bool) *ExportUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCursorAt sets the "cursor_at" field.
+func (_u *ExportUpdate) SetCursorAt(v time.Time) *ExportUpdate {
+	_u.mutation.SetCursorAt(v)
+	return _u
+}
+
+// SetNillableCursorAt sets the "cursor_at" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableCursorAt(v *time.Time) *ExportUpdate {
+	if v != nil {
+		_u.SetCursorAt(*v)
+	}
+	return _u
+}
+
+// ClearCursorAt clears the value of the "cursor_at" field.
+func (_u *ExportUpdate) ClearCursorAt() *ExportUpdate {
+	_u.mutation.ClearCursorAt()
+	return _u
+}
+
+// SetCursorID sets the "cursor_id" field.
+func (_u *ExportUpdate) SetCursorID(v uuid.UUID) *ExportUpdate {
+	_u.mutation.SetCursorID(v)
+	return _u
+}
+
+// SetNillableCursorID sets the "cursor_id" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableCursorID(v *uuid.UUID) *ExportUpdate {
+	if v != nil {
+		_u.SetCursorID(*v)
+	}
+	return _u
+}
+
+// ClearCursorID clears the value of the "cursor_id" field.
+func (_u *ExportUpdate) ClearCursorID() *ExportUpdate {
+	_u.mutation.ClearCursorID()
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ExportUpdate) SetNextRunAt(v time.Time) *ExportUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ExportUpdate) SetNillableNextRunAt(v *time.Time) *ExportUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (_u *ExportUpdate) Mutation() *ExportMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExportUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExportUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExportUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := export.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExportUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := export.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Export.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bucket(); ok {
+		if err := export.BucketValidator(v); err != nil {
+			return &ValidationError{Name: "bucket", err: fmt.Errorf(`ent: validator failed for field "Export.bucket": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExportUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(export.Table, export.Columns, sqlgraph.NewFieldSpec(export.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(export.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(export.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(export.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(export.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bucket(); ok {
+		_spec.SetField(export.FieldBucket, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(export.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(export.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(export.FieldEndpoint, field.TypeString, value)
+	}
+	if _u.mutation.EndpointCleared() {
+		_spec.ClearField(export.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(export.FieldPrefix, field.TypeString, value)
+	}
+	if _u.mutation.PrefixCleared() {
+		_spec.ClearField(export.FieldPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKeyID(); ok {
+		_spec.SetField(export.FieldAccessKeyID, field.TypeString, value)
+	}
+	if _u.mutation.AccessKeyIDCleared() {
+		_spec.ClearField(export.FieldAccessKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretAccessKey(); ok {
+		_spec.SetField(export.FieldSecretAccessKey, field.TypeString, value)
+	}
+	if _u.mutation.SecretAccessKeyCleared() {
+		_spec.ClearField(export.FieldSecretAccessKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntervalMinutes(); ok {
+		_spec.SetField(export.FieldIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalMinutes(); ok {
+		_spec.AddField(export.FieldIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(export.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CursorAt(); ok {
+		_spec.SetField(export.FieldCursorAt, field.TypeTime, value)
+	}
+	if _u.mutation.CursorAtCleared() {
+		_spec.ClearField(export.FieldCursorAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CursorID(); ok {
+		_spec.SetField(export.FieldCursorID, field.TypeUUID, value)
+	}
+	if _u.mutation.CursorIDCleared() {
+		_spec.ClearField(export.FieldCursorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(export.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{export.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExportUpdateOne is the builder for updating a single Export entity.
+type ExportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExportMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExportUpdateOne) SetUpdatedAt(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ExportUpdateOne) SetName(v string) *ExportUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableName(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *ExportUpdateOne) SetFormat(v string) *ExportUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableFormat(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *ExportUpdateOne) SetProvider(v string) *ExportUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableProvider(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetBucket sets the "bucket" field.
+func (_u *ExportUpdateOne) SetBucket(v string) *ExportUpdateOne {
+	_u.mutation.SetBucket(v)
+	return _u
+}
+
+// SetNillableBucket sets the "bucket" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableBucket(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetBucket(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *ExportUpdateOne) SetRegion(v string) *ExportUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableRegion(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *ExportUpdateOne) ClearRegion() *ExportUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (_u *ExportUpdateOne) SetEndpoint(v string) *ExportUpdateOne {
+	_u.mutation.SetEndpoint(v)
+	return _u
+}
+
+// SetNillableEndpoint sets the "endpoint" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableEndpoint(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetEndpoint(*v)
+	}
+	return _u
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (_u *ExportUpdateOne) ClearEndpoint() *ExportUpdateOne {
+	_u.mutation.ClearEndpoint()
+	return _u
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *ExportUpdateOne) SetPrefix(v string) *ExportUpdateOne {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillablePrefix(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// ClearPrefix clears the value of the "prefix" field.
+func (_u *ExportUpdateOne) ClearPrefix() *ExportUpdateOne {
+	_u.mutation.ClearPrefix()
+	return _u
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (_u *ExportUpdateOne) SetAccessKeyID(v string) *ExportUpdateOne {
+	_u.mutation.SetAccessKeyID(v)
+	return _u
+}
+
+// SetNillableAccessKeyID sets the "access_key_id" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableAccessKeyID(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetAccessKeyID(*v)
+	}
+	return _u
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (_u *ExportUpdateOne) ClearAccessKeyID() *ExportUpdateOne {
+	_u.mutation.ClearAccessKeyID()
+	return _u
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (_u *ExportUpdateOne) SetSecretAccessKey(v string) *ExportUpdateOne {
+	_u.mutation.SetSecretAccessKey(v)
+	return _u
+}
+
+// SetNillableSecretAccessKey sets the "secret_access_key" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableSecretAccessKey(v *string) *ExportUpdateOne {
+	if v != nil {
+		_u.SetSecretAccessKey(*v)
+	}
+	return _u
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (_u *ExportUpdateOne) ClearSecretAccessKey() *ExportUpdateOne {
+	_u.mutation.ClearSecretAccessKey()
+	return _u
+}
+
+// SetIntervalMinutes sets the "interval_minutes" field.
+func (_u *ExportUpdateOne) SetIntervalMinutes(v int) *ExportUpdateOne {
+	_u.mutation.ResetIntervalMinutes()
+	_u.mutation.SetIntervalMinutes(v)
+	return _u
+}
+
+// SetNillableIntervalMinutes sets the "interval_minutes" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableIntervalMinutes(v *int) *ExportUpdateOne {
+	if v != nil {
+		_u.SetIntervalMinutes(*v)
+	}
+	return _u
+}
+
+// AddIntervalMinutes adds value to the "interval_minutes" field.
+func (_u *ExportUpdateOne) AddIntervalMinutes(v int) *ExportUpdateOne {
+	_u.mutation.AddIntervalMinutes(v)
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ExportUpdateOne) SetEnabled(v bool) *ExportUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableEnabled(v *bool) *ExportUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetCursorAt sets the "cursor_at" field.
+func (_u *ExportUpdateOne) SetCursorAt(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetCursorAt(v)
+	return _u
+}
+
+// SetNillableCursorAt sets the "cursor_at" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableCursorAt(v *time.Time) *ExportUpdateOne {
+	if v != nil {
+		_u.SetCursorAt(*v)
+	}
+	return _u
+}
+
+// ClearCursorAt clears the value of the "cursor_at" field.
+func (_u *ExportUpdateOne) ClearCursorAt() *ExportUpdateOne {
+	_u.mutation.ClearCursorAt()
+	return _u
+}
+
+// SetCursorID sets the "cursor_id" field.
+func (_u *ExportUpdateOne) SetCursorID(v uuid.UUID) *ExportUpdateOne {
+	_u.mutation.SetCursorID(v)
+	return _u
+}
+
+// SetNillableCursorID sets the "cursor_id" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableCursorID(v *uuid.UUID) *ExportUpdateOne {
+	if v != nil {
+		_u.SetCursorID(*v)
+	}
+	return _u
+}
+
+// ClearCursorID clears the value of the "cursor_id" field.
+func (_u *ExportUpdateOne) ClearCursorID() *ExportUpdateOne {
+	_u.mutation.ClearCursorID()
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ExportUpdateOne) SetNextRunAt(v time.Time) *ExportUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ExportUpdateOne) SetNillableNextRunAt(v *time.Time) *ExportUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (_u *ExportUpdateOne) Mutation() *ExportMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExportUpdate builder.
+func (_u *ExportUpdateOne) Where(ps ...predicate.Export) *ExportUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExportUpdateOne) Select(field string, fields ...string) *ExportUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Export entity.
+func (_u *ExportUpdateOne) Save(ctx context.Context) (*Export, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportUpdateOne) SaveX(ctx context.Context) *Export {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExportUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := export.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExportUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := export.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Export.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Bucket(); ok {
+		if err := export.BucketValidator(v); err != nil {
+			return &ValidationError{Name: "bucket", err: fmt.Errorf(`ent: validator failed for field "Export.bucket": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ExportUpdateOne) sqlSave(ctx context.Context) (_node *Export, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(export.Table, export.Columns, sqlgraph.NewFieldSpec(export.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Export.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, export.FieldID)
+		for _, f := range fields {
+			if !export.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != export.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(export.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(export.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(export.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(export.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Bucket(); ok {
+		_spec.SetField(export.FieldBucket, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(export.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(export.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Endpoint(); ok {
+		_spec.SetField(export.FieldEndpoint, field.TypeString, value)
+	}
+	if _u.mutation.EndpointCleared() {
+		_spec.ClearField(export.FieldEndpoint, field.TypeString)
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(export.FieldPrefix, field.TypeString, value)
+	}
+	if _u.mutation.PrefixCleared() {
+		_spec.ClearField(export.FieldPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.AccessKeyID(); ok {
+		_spec.SetField(export.FieldAccessKeyID, field.TypeString, value)
+	}
+	if _u.mutation.AccessKeyIDCleared() {
+		_spec.ClearField(export.FieldAccessKeyID, field.TypeString)
+	}
+	if value, ok := _u.mutation.SecretAccessKey(); ok {
+		_spec.SetField(export.FieldSecretAccessKey, field.TypeString, value)
+	}
+	if _u.mutation.SecretAccessKeyCleared() {
+		_spec.ClearField(export.FieldSecretAccessKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntervalMinutes(); ok {
+		_spec.SetField(export.FieldIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIntervalMinutes(); ok {
+		_spec.AddField(export.FieldIntervalMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(export.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CursorAt(); ok {
+		_spec.SetField(export.FieldCursorAt, field.TypeTime, value)
+	}
+	if _u.mutation.CursorAtCleared() {
+		_spec.ClearField(export.FieldCursorAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CursorID(); ok {
+		_spec.SetField(export.FieldCursorID, field.TypeUUID, value)
+	}
+	if _u.mutation.CursorIDCleared() {
+		_spec.ClearField(export.FieldCursorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(export.FieldNextRunAt, field.TypeTime, value)
+	}
+	_node = &Export{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{export.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
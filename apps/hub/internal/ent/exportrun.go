@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/google/uuid"
+)
+
+// ExportRun is the model entity for the ExportRun schema.
+type ExportRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExportID holds the value of the "export_id" field.
+	ExportID uuid.UUID `json:"export_id,omitempty"`
+	// Run status: running, succeeded, failed
+	Status string `json:"status,omitempty"`
+	// Number of experiences written
+	Experiences int `json:"experiences,omitempty"`
+	// Size of the objects written
+	Bytes int64 `json:"bytes,omitempty"`
+	// Keys of the objects written
+	Objects []string `json:"objects,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExportRunQuery when eager-loading is set.
+	Edges        ExportRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExportRunEdges holds the relations/edges for other nodes in the graph.
+type ExportRunEdges struct {
+	// Export holds the value of the export edge.
+	Export *Export `json:"export,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ExportOrErr returns the Export value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExportRunEdges) ExportOrErr() (*Export, error) {
+	if e.Export != nil {
+		return e.Export, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: export.Label}
+	}
+	return nil, &NotLoadedError{edge: "export"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExportRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exportrun.FieldObjects:
+			values[i] = new([]byte)
+		case exportrun.FieldExperiences, exportrun.FieldBytes:
+			values[i] = new(sql.NullInt64)
+		case exportrun.FieldStatus, exportrun.FieldError:
+			values[i] = new(sql.NullString)
+		case exportrun.FieldCreatedAt, exportrun.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case exportrun.FieldID, exportrun.FieldExportID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExportRun fields.
+func (_m *ExportRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exportrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case exportrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case exportrun.FieldExportID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field export_id", values[i])
+			} else if value != nil {
+				_m.ExportID = *value
+			}
+		case exportrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case exportrun.FieldExperiences:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field experiences", values[i])
+			} else if value.Valid {
+				_m.Experiences = int(value.Int64)
+			}
+		case exportrun.FieldBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bytes", values[i])
+			} else if value.Valid {
+				_m.Bytes = value.Int64
+			}
+		case exportrun.FieldObjects:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field objects", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Objects); err != nil {
+					return fmt.Errorf("unmarshal field objects: %w", err)
+				}
+			}
+		case exportrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		case exportrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExportRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExportRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryExport queries the "export" edge of the ExportRun entity.
+func (_m *ExportRun) QueryExport() *ExportQuery {
+	return NewExportRunClient(_m.config).QueryExport(_m)
+}
+
+// Update returns a builder for updating this ExportRun.
+// Note that you need to call ExportRun.Unwrap() before calling this method if this ExportRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExportRun) Update() *ExportRunUpdateOne {
+	return NewExportRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExportRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExportRun) Unwrap() *ExportRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExportRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExportRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExportRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("export_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExportID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("experiences=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Experiences))
+	builder.WriteString(", ")
+	builder.WriteString("bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Bytes))
+	builder.WriteString(", ")
+	builder.WriteString("objects=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Objects))
+	builder.WriteString(", ")
+	if v := _m.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExportRuns is a parsable slice of ExportRun.
+type ExportRuns []*ExportRun
@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exportrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the exportrun type in the database.
+	Label = "export_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExportID holds the string denoting the export_id field in the database.
+	FieldExportID = "export_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldExperiences holds the string denoting the experiences field in the database.
+	FieldExperiences = "experiences"
+	// FieldBytes holds the string denoting the bytes field in the database.
+	FieldBytes = "bytes"
+	// FieldObjects holds the string denoting the objects field in the database.
+	FieldObjects = "objects"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// EdgeExport holds the string denoting the export edge name in mutations.
+	EdgeExport = "export"
+	// Table holds the table name of the exportrun in the database.
+	Table = "export_runs"
+	// ExportTable is the table that holds the export relation/edge.
+	ExportTable = "export_runs"
+	// ExportInverseTable is the table name for the Export entity.
+	// It exists in this package in order to avoid circular dependency with the "export" package.
+	ExportInverseTable = "exports"
+	// ExportColumn is the table column denoting the export relation/edge.
+	ExportColumn = "export_id"
+)
+
+// Columns holds all SQL columns for exportrun fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldExportID,
+	FieldStatus,
+	FieldExperiences,
+	FieldBytes,
+	FieldObjects,
+	FieldError,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultExperiences holds the default value on creation for the "experiences" field.
+	DefaultExperiences int
+	// DefaultBytes holds the default value on creation for the "bytes" field.
+	DefaultBytes int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ExportRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExportID orders the results by the export_id field.
+func ByExportID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExportID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByExperiences orders the results by the experiences field.
+func ByExperiences(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExperiences, opts...).ToFunc()
+}
+
+// ByBytes orders the results by the bytes field.
+func ByBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBytes, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByExportField orders the results by export field.
+func ByExportField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExportStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newExportStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExportInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ExportTable, ExportColumn),
+	)
+}
@@ -0,0 +1,470 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exportrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExportID applies equality check predicate on the "export_id" field. It's identical to ExportIDEQ.
+func ExportID(v uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldExportID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// Experiences applies equality check predicate on the "experiences" field. It's identical to ExperiencesEQ.
+func Experiences(v int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldExperiences, v))
+}
+
+// Bytes applies equality check predicate on the "bytes" field. It's identical to BytesEQ.
+func Bytes(v int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldBytes, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldError, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExportIDEQ applies the EQ predicate on the "export_id" field.
+func ExportIDEQ(v uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldExportID, v))
+}
+
+// ExportIDNEQ applies the NEQ predicate on the "export_id" field.
+func ExportIDNEQ(v uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldExportID, v))
+}
+
+// ExportIDIn applies the In predicate on the "export_id" field.
+func ExportIDIn(vs ...uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldExportID, vs...))
+}
+
+// ExportIDNotIn applies the NotIn predicate on the "export_id" field.
+func ExportIDNotIn(vs ...uuid.UUID) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldExportID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ExperiencesEQ applies the EQ predicate on the "experiences" field.
+func ExperiencesEQ(v int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldExperiences, v))
+}
+
+// ExperiencesNEQ applies the NEQ predicate on the "experiences" field.
+func ExperiencesNEQ(v int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldExperiences, v))
+}
+
+// ExperiencesIn applies the In predicate on the "experiences" field.
+func ExperiencesIn(vs ...int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldExperiences, vs...))
+}
+
+// ExperiencesNotIn applies the NotIn predicate on the "experiences" field.
+func ExperiencesNotIn(vs ...int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldExperiences, vs...))
+}
+
+// ExperiencesGT applies the GT predicate on the "experiences" field.
+func ExperiencesGT(v int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGT(FieldExperiences, v))
+}
+
+// ExperiencesGTE applies the GTE predicate on the "experiences" field.
+func ExperiencesGTE(v int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGTE(FieldExperiences, v))
+}
+
+// ExperiencesLT applies the LT predicate on the "experiences" field.
+func ExperiencesLT(v int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLT(FieldExperiences, v))
+}
+
+// ExperiencesLTE applies the LTE predicate on the "experiences" field.
+func ExperiencesLTE(v int) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLTE(FieldExperiences, v))
+}
+
+// BytesEQ applies the EQ predicate on the "bytes" field.
+func BytesEQ(v int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldBytes, v))
+}
+
+// BytesNEQ applies the NEQ predicate on the "bytes" field.
+func BytesNEQ(v int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldBytes, v))
+}
+
+// BytesIn applies the In predicate on the "bytes" field.
+func BytesIn(vs ...int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldBytes, vs...))
+}
+
+// BytesNotIn applies the NotIn predicate on the "bytes" field.
+func BytesNotIn(vs ...int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldBytes, vs...))
+}
+
+// BytesGT applies the GT predicate on the "bytes" field.
+func BytesGT(v int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGT(FieldBytes, v))
+}
+
+// BytesGTE applies the GTE predicate on the "bytes" field.
+func BytesGTE(v int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGTE(FieldBytes, v))
+}
+
+// BytesLT applies the LT predicate on the "bytes" field.
+func BytesLT(v int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLT(FieldBytes, v))
+}
+
+// BytesLTE applies the LTE predicate on the "bytes" field.
+func BytesLTE(v int64) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLTE(FieldBytes, v))
+}
+
+// ObjectsIsNil applies the IsNil predicate on the "objects" field.
+func ObjectsIsNil() predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIsNull(FieldObjects))
+}
+
+// ObjectsNotNil applies the NotNil predicate on the "objects" field.
+func ObjectsNotNil() predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotNull(FieldObjects))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldContainsFold(FieldError, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.ExportRun {
+	return predicate.ExportRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// HasExport applies the HasEdge predicate on the "export" edge.
+func HasExport() predicate.ExportRun {
+	return predicate.ExportRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ExportTable, ExportColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExportWith applies the HasEdge predicate on the "export" edge with a given conditions (other predicates).
+func HasExportWith(preds ...predicate.Export) predicate.ExportRun {
+	return predicate.ExportRun(func(s *sql.Selector) {
+		step := newExportStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExportRun) predicate.ExportRun {
+	return predicate.ExportRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExportRun) predicate.ExportRun {
+	return predicate.ExportRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExportRun) predicate.ExportRun {
+	return predicate.ExportRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/google/uuid"
+)
+
+// ExportRunCreate is the builder for creating a ExportRun entity.
+type ExportRunCreate struct {
+	config
+	mutation *ExportRunMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExportRunCreate) SetCreatedAt(v time.Time) *ExportRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExportRunCreate) SetNillableCreatedAt(v *time.Time) *ExportRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExportID sets the "export_id" field.
+func (_c *ExportRunCreate) SetExportID(v uuid.UUID) *ExportRunCreate {
+	_c.mutation.SetExportID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ExportRunCreate) SetStatus(v string) *ExportRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ExportRunCreate) SetNillableStatus(v *string) *ExportRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExperiences sets the "experiences" field.
+func (_c *ExportRunCreate) SetExperiences(v int) *ExportRunCreate {
+	_c.mutation.SetExperiences(v)
+	return _c
+}
+
+// SetNillableExperiences sets the "experiences" field if the given value is not nil.
+func (_c *ExportRunCreate) SetNillableExperiences(v *int) *ExportRunCreate {
+	if v != nil {
+		_c.SetExperiences(*v)
+	}
+	return _c
+}
+
+// SetBytes sets the "bytes" field.
+func (_c *ExportRunCreate) SetBytes(v int64) *ExportRunCreate {
+	_c.mutation.SetBytes(v)
+	return _c
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_c *ExportRunCreate) SetNillableBytes(v *int64) *ExportRunCreate {
+	if v != nil {
+		_c.SetBytes(*v)
+	}
+	return _c
+}
+
+// SetObjects sets the "objects" field.
+func (_c *ExportRunCreate) SetObjects(v []string) *ExportRunCreate {
+	_c.mutation.SetObjects(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ExportRunCreate) SetError(v string) *ExportRunCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ExportRunCreate) SetNillableError(v *string) *ExportRunCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ExportRunCreate) SetFinishedAt(v time.Time) *ExportRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ExportRunCreate) SetNillableFinishedAt(v *time.Time) *ExportRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExportRunCreate) SetID(v uuid.UUID) *ExportRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ExportRunCreate) SetNillableID(v *uuid.UUID) *ExportRunCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetExport sets the "export" edge to the Export entity.
+func (_c *ExportRunCreate) SetExport(v *Export) *ExportRunCreate {
+	return _c.SetExportID(v.ID)
+}
+
+// Mutation returns the ExportRunMutation object of the builder.
+func (_c *ExportRunCreate) Mutation() *ExportRunMutation {
+	return _c.mutation
+}
+
+// Save creates the ExportRun in the database.
+func (_c *ExportRunCreate) Save(ctx context.Context) (*ExportRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExportRunCreate) SaveX(ctx context.Context) *ExportRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExportRunCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := exportrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := exportrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Experiences(); !ok {
+		v := exportrun.DefaultExperiences
+		_c.mutation.SetExperiences(v)
+	}
+	if _, ok := _c.mutation.Bytes(); !ok {
+		v := exportrun.DefaultBytes
+		_c.mutation.SetBytes(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := exportrun.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExportRunCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExportRun.created_at"`)}
+	}
+	if _, ok := _c.mutation.ExportID(); !ok {
+		return &ValidationError{Name: "export_id", err: errors.New(`ent: missing required field "ExportRun.export_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExportRun.status"`)}
+	}
+	if _, ok := _c.mutation.Experiences(); !ok {
+		return &ValidationError{Name: "experiences", err: errors.New(`ent: missing required field "ExportRun.experiences"`)}
+	}
+	if _, ok := _c.mutation.Bytes(); !ok {
+		return &ValidationError{Name: "bytes", err: errors.New(`ent: missing required field "ExportRun.bytes"`)}
+	}
+	if len(_c.mutation.ExportIDs()) == 0 {
+		return &ValidationError{Name: "export", err: errors.New(`ent: missing required edge "ExportRun.export"`)}
+	}
+	return nil
+}
+
+func (_c *ExportRunCreate) sqlSave(ctx context.Context) (*ExportRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExportRunCreate) createSpec() (*ExportRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExportRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exportrun.Table, sqlgraph.NewFieldSpec(exportrun.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(exportrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(exportrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Experiences(); ok {
+		_spec.SetField(exportrun.FieldExperiences, field.TypeInt, value)
+		_node.Experiences = value
+	}
+	if value, ok := _c.mutation.Bytes(); ok {
+		_spec.SetField(exportrun.FieldBytes, field.TypeInt64, value)
+		_node.Bytes = value
+	}
+	if value, ok := _c.mutation.Objects(); ok {
+		_spec.SetField(exportrun.FieldObjects, field.TypeJSON, value)
+		_node.Objects = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(exportrun.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(exportrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := _c.mutation.ExportIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   exportrun.ExportTable,
+			Columns: []string{exportrun.ExportColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(export.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ExportID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExportRunCreateBulk is the builder for creating many ExportRun entities in bulk.
+type ExportRunCreateBulk struct {
+	config
+	err      error
+	builders []*ExportRunCreate
+}
+
+// Save creates the ExportRun entities in the database.
+func (_c *ExportRunCreateBulk) Save(ctx context.Context) ([]*ExportRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExportRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExportRunCreateBulk) SaveX(ctx context.Context) []*ExportRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExportRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExportRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// ExportRunDelete is the builder for deleting a ExportRun entity.
+type ExportRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExportRunMutation
+}
+
+// Where appends a list predicates to the ExportRunDelete builder.
+func (_d *ExportRunDelete) Where(ps ...predicate.ExportRun) *ExportRunDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ExportRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExportRunDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ExportRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(exportrun.Table, sqlgraph.NewFieldSpec(exportrun.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ExportRunDeleteOne is the builder for deleting a single ExportRun entity.
+type ExportRunDeleteOne struct {
+	_d *ExportRunDelete
+}
+
+// Where appends a list predicates to the ExportRunDelete builder.
+func (_d *ExportRunDeleteOne) Where(ps ...predicate.ExportRun) *ExportRunDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ExportRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{exportrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ExportRunDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// ExportRunUpdate is the builder for updating ExportRun entities.
+type ExportRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExportRunMutation
+}
+
+// Where appends a list predicates to the ExportRunUpdate builder.
+func (_u *ExportRunUpdate) Where(ps ...predicate.ExportRun) *ExportRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExportRunUpdate) SetStatus(v string) *ExportRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExportRunUpdate) SetNillableStatus(v *string) *ExportRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExperiences sets the "experiences" field.
+func (_u *ExportRunUpdate) SetExperiences(v int) *ExportRunUpdate {
+	_u.mutation.ResetExperiences()
+	_u.mutation.SetExperiences(v)
+	return _u
+}
+
+// SetNillableExperiences sets the "experiences" field if the given value is not nil.
+func (_u *ExportRunUpdate) SetNillableExperiences(v *int) *ExportRunUpdate {
+	if v != nil {
+		_u.SetExperiences(*v)
+	}
+	return _u
+}
+
+// AddExperiences adds value to the "experiences" field.
+func (_u *ExportRunUpdate) AddExperiences(v int) *ExportRunUpdate {
+	_u.mutation.AddExperiences(v)
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *ExportRunUpdate) SetBytes(v int64) *ExportRunUpdate {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *ExportRunUpdate) SetNillableBytes(v *int64) *ExportRunUpdate {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *ExportRunUpdate) AddBytes(v int64) *ExportRunUpdate {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// SetObjects sets the "objects" field.
+func (_u *ExportRunUpdate) SetObjects(v []string) *ExportRunUpdate {
+	_u.mutation.SetObjects(v)
+	return _u
+}
+
+// AppendObjects appends value to the "objects" field.
+func (_u *ExportRunUpdate) AppendObjects(v []string) *ExportRunUpdate {
+	_u.mutation.AppendObjects(v)
+	return _u
+}
+
+// ClearObjects clears the value of the "objects" field.
+func (_u *ExportRunUpdate) ClearObjects() *ExportRunUpdate {
+	_u.mutation.ClearObjects()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ExportRunUpdate) SetError(v string) *ExportRunUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ExportRunUpdate) SetNillableError(v *string) *ExportRunUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ExportRunUpdate) ClearError() *ExportRunUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ExportRunUpdate) SetFinishedAt(v time.Time) *ExportRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ExportRunUpdate) SetNillableFinishedAt(v *time.Time) *ExportRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ExportRunUpdate) ClearFinishedAt() *ExportRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the ExportRunMutation object of the builder.
+func (_u *ExportRunUpdate) Mutation() *ExportRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExportRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExportRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExportRunUpdate) check() error {
+	if _u.mutation.ExportCleared() && len(_u.mutation.ExportIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExportRun.export"`)
+	}
+	return nil
+}
+
+func (_u *ExportRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exportrun.Table, exportrun.Columns, sqlgraph.NewFieldSpec(exportrun.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(exportrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Experiences(); ok {
+		_spec.SetField(exportrun.FieldExperiences, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExperiences(); ok {
+		_spec.AddField(exportrun.FieldExperiences, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(exportrun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(exportrun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Objects(); ok {
+		_spec.SetField(exportrun.FieldObjects, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedObjects(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, exportrun.FieldObjects, value)
+		})
+	}
+	if _u.mutation.ObjectsCleared() {
+		_spec.ClearField(exportrun.FieldObjects, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(exportrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(exportrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(exportrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(exportrun.FieldFinishedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExportRunUpdateOne is the builder for updating a single ExportRun entity.
+type ExportRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExportRunMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *ExportRunUpdateOne) SetStatus(v string) *ExportRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ExportRunUpdateOne) SetNillableStatus(v *string) *ExportRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExperiences sets the "experiences" field.
+func (_u *ExportRunUpdateOne) SetExperiences(v int) *ExportRunUpdateOne {
+	_u.mutation.ResetExperiences()
+	_u.mutation.SetExperiences(v)
+	return _u
+}
+
+// SetNillableExperiences sets the "experiences" field if the given value is not nil.
+func (_u *ExportRunUpdateOne) SetNillableExperiences(v *int) *ExportRunUpdateOne {
+	if v != nil {
+		_u.SetExperiences(*v)
+	}
+	return _u
+}
+
+// AddExperiences adds value to the "experiences" field.
+func (_u *ExportRunUpdateOne) AddExperiences(v int) *ExportRunUpdateOne {
+	_u.mutation.AddExperiences(v)
+	return _u
+}
+
+// SetBytes sets the "bytes" field.
+func (_u *ExportRunUpdateOne) SetBytes(v int64) *ExportRunUpdateOne {
+	_u.mutation.ResetBytes()
+	_u.mutation.SetBytes(v)
+	return _u
+}
+
+// SetNillableBytes sets the "bytes" field if the given value is not nil.
+func (_u *ExportRunUpdateOne) SetNillableBytes(v *int64) *ExportRunUpdateOne {
+	if v != nil {
+		_u.SetBytes(*v)
+	}
+	return _u
+}
+
+// AddBytes adds value to the "bytes" field.
+func (_u *ExportRunUpdateOne) AddBytes(v int64) *ExportRunUpdateOne {
+	_u.mutation.AddBytes(v)
+	return _u
+}
+
+// SetObjects sets the "objects" field.
+func (_u *ExportRunUpdateOne) SetObjects(v []string) *ExportRunUpdateOne {
+	_u.mutation.SetObjects(v)
+	return _u
+}
+
+// AppendObjects appends value to the "objects" field.
+func (_u *ExportRunUpdateOne) AppendObjects(v []string) *ExportRunUpdateOne {
+	_u.mutation.AppendObjects(v)
+	return _u
+}
+
+// ClearObjects clears the value of the "objects" field.
+func (_u *ExportRunUpdateOne) ClearObjects() *ExportRunUpdateOne {
+	_u.mutation.ClearObjects()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ExportRunUpdateOne) SetError(v string) *ExportRunUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ExportRunUpdateOne) SetNillableError(v *string) *ExportRunUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ExportRunUpdateOne) ClearError() *ExportRunUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ExportRunUpdateOne) SetFinishedAt(v time.Time) *ExportRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ExportRunUpdateOne) SetNillableFinishedAt(v *time.Time) *ExportRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ExportRunUpdateOne) ClearFinishedAt() *ExportRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the ExportRunMutation object of the builder.
+func (_u *ExportRunUpdateOne) Mutation() *ExportRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ExportRunUpdate builder.
+func (_u *ExportRunUpdateOne) Where(ps ...predicate.ExportRun) *ExportRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExportRunUpdateOne) Select(field string, fields ...string) *ExportRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExportRun entity.
+func (_u *ExportRunUpdateOne) Save(ctx context.Context) (*ExportRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExportRunUpdateOne) SaveX(ctx context.Context) *ExportRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExportRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExportRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExportRunUpdateOne) check() error {
+	if _u.mutation.ExportCleared() && len(_u.mutation.ExportIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExportRun.export"`)
+	}
+	return nil
+}
+
+func (_u *ExportRunUpdateOne) sqlSave(ctx context.Context) (_node *ExportRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exportrun.Table, exportrun.Columns, sqlgraph.NewFieldSpec(exportrun.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExportRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exportrun.FieldID)
+		for _, f := range fields {
+			if !exportrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exportrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(exportrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Experiences(); ok {
+		_spec.SetField(exportrun.FieldExperiences, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExperiences(); ok {
+		_spec.AddField(exportrun.FieldExperiences, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Bytes(); ok {
+		_spec.SetField(exportrun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedBytes(); ok {
+		_spec.AddField(exportrun.FieldBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Objects(); ok {
+		_spec.SetField(exportrun.FieldObjects, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedObjects(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, exportrun.FieldObjects, value)
+		})
+	}
+	if _u.mutation.ObjectsCleared() {
+		_spec.ClearField(exportrun.FieldObjects, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(exportrun.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(exportrun.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(exportrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(exportrun.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &ExportRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exportrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/connector/mapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
+	"github.com/google/uuid"
+)
+
+// IngestMapping is the model entity for the IngestMapping schema.
+type IngestMapping struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name shown in tools, e.g. Hotjar surveys
+	Name string `json:"name,omitempty"`
+	// Source type of the experiences created from deliveries
+	SourceType string `json:"source_type,omitempty"`
+	// JSONPath expressions that map a delivery to responses
+	Template mapping.Template `json:"template,omitempty"`
+	// Token that authenticates deliveries, or the key of their signature
+	Secret string `json:"-"`
+	// Header with the HMAC-SHA256 signature of the body; empty to authenticate with the secret as token
+	SignatureHeader string `json:"signature_header,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled      bool `json:"enabled,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IngestMapping) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ingestmapping.FieldTemplate:
+			values[i] = new([]byte)
+		case ingestmapping.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case ingestmapping.FieldName, ingestmapping.FieldSourceType, ingestmapping.FieldSecret, ingestmapping.FieldSignatureHeader:
+			values[i] = new(sql.NullString)
+		case ingestmapping.FieldCreatedAt, ingestmapping.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case ingestmapping.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IngestMapping fields.
+func (_m *IngestMapping) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ingestmapping.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ingestmapping.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ingestmapping.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ingestmapping.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case ingestmapping.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				_m.SourceType = value.String
+			}
+		case ingestmapping.FieldTemplate:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Template); err != nil {
+					return fmt.Errorf("unmarshal field template: %w", err)
+				}
+			}
+		case ingestmapping.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				_m.Secret = value.String
+			}
+		case ingestmapping.FieldSignatureHeader:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signature_header", values[i])
+			} else if value.Valid {
+				_m.SignatureHeader = value.String
+			}
+		case ingestmapping.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IngestMapping.
+// This includes values selected through modifiers, order, etc.
+func (_m *IngestMapping) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IngestMapping.
+// Note that you need to call IngestMapping.Unwrap() before calling this method if this IngestMapping
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *IngestMapping) Update() *IngestMappingUpdateOne {
+	return NewIngestMappingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the IngestMapping entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *IngestMapping) Unwrap() *IngestMapping {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IngestMapping is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *IngestMapping) String() string {
+	var builder strings.Builder
+	builder.WriteString("IngestMapping(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(_m.SourceType)
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Template))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("signature_header=")
+	builder.WriteString(_m.SignatureHeader)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IngestMappings is a parsable slice of IngestMapping.
+type IngestMappings []*IngestMapping
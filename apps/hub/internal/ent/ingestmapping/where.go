@@ -0,0 +1,466 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ingestmapping
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldName, v))
+}
+
+// SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
+func SourceType(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldSourceType, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldSecret, v))
+}
+
+// SignatureHeader applies equality check predicate on the "signature_header" field. It's identical to SignatureHeaderEQ.
+func SignatureHeader(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldSignatureHeader, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContainsFold(FieldName, v))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldSourceType, v))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIn(FieldSourceType, vs...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotIn(FieldSourceType, vs...))
+}
+
+// SourceTypeGT applies the GT predicate on the "source_type" field.
+func SourceTypeGT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGT(FieldSourceType, v))
+}
+
+// SourceTypeGTE applies the GTE predicate on the "source_type" field.
+func SourceTypeGTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGTE(FieldSourceType, v))
+}
+
+// SourceTypeLT applies the LT predicate on the "source_type" field.
+func SourceTypeLT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLT(FieldSourceType, v))
+}
+
+// SourceTypeLTE applies the LTE predicate on the "source_type" field.
+func SourceTypeLTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLTE(FieldSourceType, v))
+}
+
+// SourceTypeContains applies the Contains predicate on the "source_type" field.
+func SourceTypeContains(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContains(FieldSourceType, v))
+}
+
+// SourceTypeHasPrefix applies the HasPrefix predicate on the "source_type" field.
+func SourceTypeHasPrefix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasPrefix(FieldSourceType, v))
+}
+
+// SourceTypeHasSuffix applies the HasSuffix predicate on the "source_type" field.
+func SourceTypeHasSuffix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasSuffix(FieldSourceType, v))
+}
+
+// SourceTypeEqualFold applies the EqualFold predicate on the "source_type" field.
+func SourceTypeEqualFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEqualFold(FieldSourceType, v))
+}
+
+// SourceTypeContainsFold applies the ContainsFold predicate on the "source_type" field.
+func SourceTypeContainsFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContainsFold(FieldSourceType, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLTE(FieldSecret, v))
+}
+
+// SecretContains applies the Contains predicate on the "secret" field.
+func SecretContains(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContains(FieldSecret, v))
+}
+
+// SecretHasPrefix applies the HasPrefix predicate on the "secret" field.
+func SecretHasPrefix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasPrefix(FieldSecret, v))
+}
+
+// SecretHasSuffix applies the HasSuffix predicate on the "secret" field.
+func SecretHasSuffix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasSuffix(FieldSecret, v))
+}
+
+// SecretEqualFold applies the EqualFold predicate on the "secret" field.
+func SecretEqualFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEqualFold(FieldSecret, v))
+}
+
+// SecretContainsFold applies the ContainsFold predicate on the "secret" field.
+func SecretContainsFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContainsFold(FieldSecret, v))
+}
+
+// SignatureHeaderEQ applies the EQ predicate on the "signature_header" field.
+func SignatureHeaderEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderNEQ applies the NEQ predicate on the "signature_header" field.
+func SignatureHeaderNEQ(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderIn applies the In predicate on the "signature_header" field.
+func SignatureHeaderIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIn(FieldSignatureHeader, vs...))
+}
+
+// SignatureHeaderNotIn applies the NotIn predicate on the "signature_header" field.
+func SignatureHeaderNotIn(vs ...string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotIn(FieldSignatureHeader, vs...))
+}
+
+// SignatureHeaderGT applies the GT predicate on the "signature_header" field.
+func SignatureHeaderGT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGT(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderGTE applies the GTE predicate on the "signature_header" field.
+func SignatureHeaderGTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldGTE(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderLT applies the LT predicate on the "signature_header" field.
+func SignatureHeaderLT(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLT(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderLTE applies the LTE predicate on the "signature_header" field.
+func SignatureHeaderLTE(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldLTE(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderContains applies the Contains predicate on the "signature_header" field.
+func SignatureHeaderContains(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContains(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderHasPrefix applies the HasPrefix predicate on the "signature_header" field.
+func SignatureHeaderHasPrefix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasPrefix(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderHasSuffix applies the HasSuffix predicate on the "signature_header" field.
+func SignatureHeaderHasSuffix(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldHasSuffix(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderIsNil applies the IsNil predicate on the "signature_header" field.
+func SignatureHeaderIsNil() predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldIsNull(FieldSignatureHeader))
+}
+
+// SignatureHeaderNotNil applies the NotNil predicate on the "signature_header" field.
+func SignatureHeaderNotNil() predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNotNull(FieldSignatureHeader))
+}
+
+// SignatureHeaderEqualFold applies the EqualFold predicate on the "signature_header" field.
+func SignatureHeaderEqualFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEqualFold(FieldSignatureHeader, v))
+}
+
+// SignatureHeaderContainsFold applies the ContainsFold predicate on the "signature_header" field.
+func SignatureHeaderContainsFold(v string) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldContainsFold(FieldSignatureHeader, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IngestMapping) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IngestMapping) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IngestMapping) predicate.IngestMapping {
+	return predicate.IngestMapping(sql.NotPredicates(p))
+}
@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/connector/mapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
+	"github.com/google/uuid"
+)
+
+// IngestMappingCreate is the builder for creating a IngestMapping entity.
+type IngestMappingCreate struct {
+	config
+	mutation *IngestMappingMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *IngestMappingCreate) SetCreatedAt(v time.Time) *IngestMappingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *IngestMappingCreate) SetNillableCreatedAt(v *time.Time) *IngestMappingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *IngestMappingCreate) SetUpdatedAt(v time.Time) *IngestMappingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *IngestMappingCreate) SetNillableUpdatedAt(v *time.Time) *IngestMappingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *IngestMappingCreate) SetName(v string) *IngestMappingCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetSourceType sets the "source_type" field.
+func (_c *IngestMappingCreate) SetSourceType(v string) *IngestMappingCreate {
+	_c.mutation.SetSourceType(v)
+	return _c
+}
+
+// SetTemplate sets the "template" field.
+func (_c *IngestMappingCreate) SetTemplate(v mapping.Template) *IngestMappingCreate {
+	_c.mutation.SetTemplate(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *IngestMappingCreate) SetSecret(v string) *IngestMappingCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetSignatureHeader sets the "signature_header" field.
+func (_c *IngestMappingCreate) SetSignatureHeader(v string) *IngestMappingCreate {
+	_c.mutation.SetSignatureHeader(v)
+	return _c
+}
+
+// SetNillableSignatureHeader sets the "signature_header" field if the given value is not nil.
+func (_c *IngestMappingCreate) SetNillableSignatureHeader(v *string) *IngestMappingCreate {
+	if v != nil {
+		_c.SetSignatureHeader(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *IngestMappingCreate) SetEnabled(v bool) *IngestMappingCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *IngestMappingCreate) SetNillableEnabled(v *bool) *IngestMappingCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *IngestMappingCreate) SetID(v uuid.UUID) *IngestMappingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *IngestMappingCreate) SetNillableID(v *uuid.UUID) *IngestMappingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the IngestMappingMutation object of the builder.
+func (_c *IngestMappingCreate) Mutation() *IngestMappingMutation {
+	return _c.mutation
+}
+
+// Save creates the IngestMapping in the database.
+func (_c *IngestMappingCreate) Save(ctx context.Context) (*IngestMapping, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *IngestMappingCreate) SaveX(ctx context.Context) *IngestMapping {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IngestMappingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IngestMappingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *IngestMappingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ingestmapping.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ingestmapping.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := ingestmapping.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ingestmapping.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *IngestMappingCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IngestMapping.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IngestMapping.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "IngestMapping.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ingestmapping.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "IngestMapping.source_type"`)}
+	}
+	if v, ok := _c.mutation.SourceType(); ok {
+		if err := ingestmapping.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.source_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Template(); !ok {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required field "IngestMapping.template"`)}
+	}
+	if v, ok := _c.mutation.Template(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.template": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "IngestMapping.secret"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "IngestMapping.enabled"`)}
+	}
+	return nil
+}
+
+func (_c *IngestMappingCreate) sqlSave(ctx context.Context) (*IngestMapping, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *IngestMappingCreate) createSpec() (*IngestMapping, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IngestMapping{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ingestmapping.Table, sqlgraph.NewFieldSpec(ingestmapping.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ingestmapping.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ingestmapping.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ingestmapping.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.SourceType(); ok {
+		_spec.SetField(ingestmapping.FieldSourceType, field.TypeString, value)
+		_node.SourceType = value
+	}
+	if value, ok := _c.mutation.Template(); ok {
+		_spec.SetField(ingestmapping.FieldTemplate, field.TypeJSON, value)
+		_node.Template = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(ingestmapping.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.SignatureHeader(); ok {
+		_spec.SetField(ingestmapping.FieldSignatureHeader, field.TypeString, value)
+		_node.SignatureHeader = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(ingestmapping.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	return _node, _spec
+}
+
+// IngestMappingCreateBulk is the builder for creating many IngestMapping entities in bulk.
+type IngestMappingCreateBulk struct {
+	config
+	err      error
+	builders []*IngestMappingCreate
+}
+
+// Save creates the IngestMapping entities in the database.
+func (_c *IngestMappingCreateBulk) Save(ctx context.Context) ([]*IngestMapping, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*IngestMapping, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IngestMappingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *IngestMappingCreateBulk) SaveX(ctx context.Context) []*IngestMapping {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IngestMappingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IngestMappingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/connector/mapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// IngestMappingUpdate is the builder for updating IngestMapping entities.
+type IngestMappingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IngestMappingMutation
+}
+
+// Where appends a list predicates to the IngestMappingUpdate builder.
+func (_u *IngestMappingUpdate) Where(ps ...predicate.IngestMapping) *IngestMappingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IngestMappingUpdate) SetUpdatedAt(v time.Time) *IngestMappingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *IngestMappingUpdate) SetName(v string) *IngestMappingUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *IngestMappingUpdate) SetNillableName(v *string) *IngestMappingUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *IngestMappingUpdate) SetSourceType(v string) *IngestMappingUpdate {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *IngestMappingUpdate) SetNillableSourceType(v *string) *IngestMappingUpdate {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *IngestMappingUpdate) SetTemplate(v mapping.Template) *IngestMappingUpdate {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *IngestMappingUpdate) SetNillableTemplate(v *mapping.Template) *IngestMappingUpdate {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *IngestMappingUpdate) SetSecret(v string) *IngestMappingUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *IngestMappingUpdate) SetNillableSecret(v *string) *IngestMappingUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetSignatureHeader sets the "signature_header" field.
+func (_u *IngestMappingUpdate) SetSignatureHeader(v string) *IngestMappingUpdate {
+	_u.mutation.SetSignatureHeader(v)
+	return _u
+}
+
+// SetNillableSignatureHeader sets the "signature_header" field if the given value is not nil.
+func (_u *IngestMappingUpdate) SetNillableSignatureHeader(v *string) *IngestMappingUpdate {
+	if v != nil {
+		_u.SetSignatureHeader(*v)
+	}
+	return _u
+}
+
+// ClearSignatureHeader clears the value of the "signature_header" field.
+func (_u *IngestMappingUpdate) ClearSignatureHeader() *IngestMappingUpdate {
+	_u.mutation.ClearSignatureHeader()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *IngestMappingUpdate) SetEnabled(v bool) *IngestMappingUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *IngestMappingUpdate) SetNillableEnabled(v *bool) *IngestMappingUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the IngestMappingMutation object of the builder.
+func (_u *IngestMappingUpdate) Mutation() *IngestMappingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *IngestMappingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IngestMappingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *IngestMappingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IngestMappingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IngestMappingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ingestmapping.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IngestMappingUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ingestmapping.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := ingestmapping.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Template(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.template": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *IngestMappingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ingestmapping.Table, ingestmapping.Columns, sqlgraph.NewFieldSpec(ingestmapping.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ingestmapping.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ingestmapping.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(ingestmapping.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(ingestmapping.FieldTemplate, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(ingestmapping.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SignatureHeader(); ok {
+		_spec.SetField(ingestmapping.FieldSignatureHeader, field.TypeString, value)
+	}
+	if _u.mutation.SignatureHeaderCleared() {
+		_spec.ClearField(ingestmapping.FieldSignatureHeader, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ingestmapping.FieldEnabled, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ingestmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// IngestMappingUpdateOne is the builder for updating a single IngestMapping entity.
+type IngestMappingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IngestMappingMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IngestMappingUpdateOne) SetUpdatedAt(v time.Time) *IngestMappingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *IngestMappingUpdateOne) SetName(v string) *IngestMappingUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *IngestMappingUpdateOne) SetNillableName(v *string) *IngestMappingUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *IngestMappingUpdateOne) SetSourceType(v string) *IngestMappingUpdateOne {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *IngestMappingUpdateOne) SetNillableSourceType(v *string) *IngestMappingUpdateOne {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetTemplate sets the "template" field.
+func (_u *IngestMappingUpdateOne) SetTemplate(v mapping.Template) *IngestMappingUpdateOne {
+	_u.mutation.SetTemplate(v)
+	return _u
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (_u *IngestMappingUpdateOne) SetNillableTemplate(v *mapping.Template) *IngestMappingUpdateOne {
+	if v != nil {
+		_u.SetTemplate(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *IngestMappingUpdateOne) SetSecret(v string) *IngestMappingUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *IngestMappingUpdateOne) SetNillableSecret(v *string) *IngestMappingUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetSignatureHeader sets the "signature_header" field.
+func (_u *IngestMappingUpdateOne) SetSignatureHeader(v string) *IngestMappingUpdateOne {
+	_u.mutation.SetSignatureHeader(v)
+	return _u
+}
+
+// SetNillableSignatureHeader sets the "signature_header" field if the given value is not nil.
+func (_u *IngestMappingUpdateOne) SetNillableSignatureHeader(v *string) *IngestMappingUpdateOne {
+	if v != nil {
+		_u.SetSignatureHeader(*v)
+	}
+	return _u
+}
+
+// ClearSignatureHeader clears the value of the "signature_header" field.
+func (_u *IngestMappingUpdateOne) ClearSignatureHeader() *IngestMappingUpdateOne {
+	_u.mutation.ClearSignatureHeader()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *IngestMappingUpdateOne) SetEnabled(v bool) *IngestMappingUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *IngestMappingUpdateOne) SetNillableEnabled(v *bool) *IngestMappingUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// Mutation returns the IngestMappingMutation object of the builder.
+func (_u *IngestMappingUpdateOne) Mutation() *IngestMappingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the IngestMappingUpdate builder.
+func (_u *IngestMappingUpdateOne) Where(ps ...predicate.IngestMapping) *IngestMappingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *IngestMappingUpdateOne) Select(field string, fields ...string) *IngestMappingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated IngestMapping entity.
+func (_u *IngestMappingUpdateOne) Save(ctx context.Context) (*IngestMapping, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IngestMappingUpdateOne) SaveX(ctx context.Context) *IngestMapping {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *IngestMappingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IngestMappingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IngestMappingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ingestmapping.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IngestMappingUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ingestmapping.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := ingestmapping.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Template(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "template", err: fmt.Errorf(`ent: validator failed for field "IngestMapping.template": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *IngestMappingUpdateOne) sqlSave(ctx context.Context) (_node *IngestMapping, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ingestmapping.Table, ingestmapping.Columns, sqlgraph.NewFieldSpec(ingestmapping.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IngestMapping.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ingestmapping.FieldID)
+		for _, f := range fields {
+			if !ingestmapping.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ingestmapping.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ingestmapping.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ingestmapping.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(ingestmapping.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Template(); ok {
+		_spec.SetField(ingestmapping.FieldTemplate, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(ingestmapping.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SignatureHeader(); ok {
+		_spec.SetField(ingestmapping.FieldSignatureHeader, field.TypeString, value)
+	}
+	if _u.mutation.SignatureHeaderCleared() {
+		_spec.ClearField(ingestmapping.FieldSignatureHeader, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ingestmapping.FieldEnabled, field.TypeBool, value)
+	}
+	_node = &IngestMapping{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ingestmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,16 +9,106 @@ import (
 )
 
 var (
+	// AiUsagesColumns holds the columns for the "ai_usages" table.
+	AiUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "job_type", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString},
+		{Name: "requests", Type: field.TypeInt, Default: 0},
+		{Name: "prompt_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "completion_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "cost_usd", Type: field.TypeFloat64, Default: 0},
+	}
+	// AiUsagesTable holds the schema information for the "ai_usages" table.
+	AiUsagesTable = &schema.Table{
+		Name:       "ai_usages",
+		Columns:    AiUsagesColumns,
+		PrimaryKey: []*schema.Column{AiUsagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "aiusage_day_job_type_provider_model",
+				Unique:  true,
+				Columns: []*schema.Column{AiUsagesColumns[2], AiUsagesColumns[3], AiUsagesColumns[4], AiUsagesColumns[5]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "method", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString, Nullable: true},
+		{Name: "resource_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "summary", Type: field.TypeString, Size: 2147483647},
+		{Name: "event_ids", Type: field.TypeJSON, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_actor_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_resource_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7], AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3]},
+			},
+		},
+	}
+	// ConnectorCursorsColumns holds the columns for the "connector_cursors" table.
+	ConnectorCursorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "stream", Type: field.TypeString, Unique: true},
+		{Name: "cursor", Type: field.TypeString},
+	}
+	// ConnectorCursorsTable holds the schema information for the "connector_cursors" table.
+	ConnectorCursorsTable = &schema.Table{
+		Name:       "connector_cursors",
+		Columns:    ConnectorCursorsColumns,
+		PrimaryKey: []*schema.Column{ConnectorCursorsColumns[0]},
+	}
 	// EnrichmentJobsColumns holds the columns for the "enrichment_jobs" table.
 	EnrichmentJobsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
 		{Name: "job_type", Type: field.TypeString, Default: "enrichment"},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "text", Type: field.TypeString, Size: 2147483647},
 		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "error_history", Type: field.TypeJSON, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
 		{Name: "attempts", Type: field.TypeInt, Default: 0},
-		{Name: "created_at", Type: field.TypeTime},
+		{Name: "requeues", Type: field.TypeInt, Default: 0},
 		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "prompt_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "completion_tokens", Type: field.TypeInt, Nullable: true},
+		{Name: "cost_usd", Type: field.TypeFloat64, Nullable: true},
+		{Name: "trace_context", Type: field.TypeString, Nullable: true},
 		{Name: "experience_id", Type: field.TypeUUID},
 	}
 	// EnrichmentJobsTable holds the schema information for the "enrichment_jobs" table.
@@ -29,7 +119,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "enrichment_jobs_experience_data_experience",
-				Columns:    []*schema.Column{EnrichmentJobsColumns[8]},
+				Columns:    []*schema.Column{EnrichmentJobsColumns[16]},
 				RefColumns: []*schema.Column{ExperienceDataColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -38,21 +128,31 @@ var (
 			{
 				Name:    "enrichmentjob_job_type_status_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{EnrichmentJobsColumns[1], EnrichmentJobsColumns[2], EnrichmentJobsColumns[6]},
+				Columns: []*schema.Column{EnrichmentJobsColumns[2], EnrichmentJobsColumns[3], EnrichmentJobsColumns[1]},
+			},
+			{
+				Name:    "enrichmentjob_status_priority_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EnrichmentJobsColumns[3], EnrichmentJobsColumns[7], EnrichmentJobsColumns[1]},
 			},
 			{
 				Name:    "enrichmentjob_experience_id",
 				Unique:  false,
-				Columns: []*schema.Column{EnrichmentJobsColumns[8]},
+				Columns: []*schema.Column{EnrichmentJobsColumns[16]},
+			},
+			{
+				Name:    "enrichmentjob_status_lease_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{EnrichmentJobsColumns[3], EnrichmentJobsColumns[11]},
 			},
 		},
 	}
 	// ExperienceDataColumns holds the columns for the "experience_data" table.
 	ExperienceDataColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "collected_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "collected_at", Type: field.TypeTime},
 		{Name: "source_type", Type: field.TypeString},
 		{Name: "source_id", Type: field.TypeString, Nullable: true},
 		{Name: "source_name", Type: field.TypeString, Nullable: true},
@@ -64,36 +164,69 @@ var (
 		{Name: "value_boolean", Type: field.TypeBool, Nullable: true},
 		{Name: "value_date", Type: field.TypeTime, Nullable: true},
 		{Name: "value_json", Type: field.TypeJSON, Nullable: true},
+		{Name: "nps_category", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "device", Type: field.TypeString, Nullable: true},
+		{Name: "platform", Type: field.TypeString, Nullable: true},
+		{Name: "app_version", Type: field.TypeString, Nullable: true},
 		{Name: "language", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "translations", Type: field.TypeJSON, Nullable: true},
 		{Name: "sentiment", Type: field.TypeString, Nullable: true},
 		{Name: "sentiment_score", Type: field.TypeFloat64, Nullable: true},
 		{Name: "emotion", Type: field.TypeString, Nullable: true},
 		{Name: "topics", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_spam", Type: field.TypeBool, Nullable: true},
+		{Name: "spam_confidence", Type: field.TypeFloat64, Nullable: true},
+		{Name: "urgency_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "urgency_reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "enrichment_provider", Type: field.TypeString, Nullable: true},
+		{Name: "enrichment_model", Type: field.TypeString, Nullable: true},
+		{Name: "enrichment_version", Type: field.TypeInt, Nullable: true},
+		{Name: "enrichment_attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "skip_ai_processing", Type: field.TypeBool, Default: false},
+		{Name: "ai_input_hash", Type: field.TypeString, Nullable: true},
 		{Name: "user_identifier", Type: field.TypeString, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Nullable: true},
+		{Name: "duplicate_of", Type: field.TypeUUID, Nullable: true},
+		{Name: "idempotency_key", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1536)"}},
 		{Name: "embedding_model", Type: field.TypeString, Nullable: true},
+		{Name: "question_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// ExperienceDataTable holds the schema information for the "experience_data" table.
 	ExperienceDataTable = &schema.Table{
 		Name:       "experience_data",
 		Columns:    ExperienceDataColumns,
 		PrimaryKey: []*schema.Column{ExperienceDataColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "experience_data_questions_question",
+				Columns:    []*schema.Column{ExperienceDataColumns[44]},
+				RefColumns: []*schema.Column{QuestionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "experiencedata_source_type_source_id_collected_at",
 				Unique:  false,
-				Columns: []*schema.Column{ExperienceDataColumns[4], ExperienceDataColumns[5], ExperienceDataColumns[1]},
+				Columns: []*schema.Column{ExperienceDataColumns[4], ExperienceDataColumns[5], ExperienceDataColumns[3]},
+			},
+			{
+				Name:    "experiencedata_metadata",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[16]},
 				Annotation: &entsql.IndexAnnotation{
-					Types: map[string]string{
-						"metadata": "GIN",
-					},
+					OpClass: "jsonb_path_ops",
+					Type:    "GIN",
 				},
 			},
 			{
 				Name:    "experiencedata_field_type_collected_at",
 				Unique:  false,
-				Columns: []*schema.Column{ExperienceDataColumns[9], ExperienceDataColumns[1]},
+				Columns: []*schema.Column{ExperienceDataColumns[9], ExperienceDataColumns[3]},
 			},
 			{
 				Name:    "experiencedata_value_number",
@@ -101,29 +234,99 @@ var (
 				Columns: []*schema.Column{ExperienceDataColumns[11]},
 			},
 			{
-				Name:    "experiencedata_user_identifier",
+				Name:    "experiencedata_nps_category_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[15], ExperienceDataColumns[3]},
+			},
+			{
+				Name:    "experiencedata_question_id_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[44], ExperienceDataColumns[3]},
+			},
+			{
+				Name:    "experiencedata_country",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[17]},
+			},
+			{
+				Name:    "experiencedata_region",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[18]},
+			},
+			{
+				Name:    "experiencedata_device",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[19]},
+			},
+			{
+				Name:    "experiencedata_platform",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[20]},
+			},
+			{
+				Name:    "experiencedata_app_version",
 				Unique:  false,
 				Columns: []*schema.Column{ExperienceDataColumns[21]},
 			},
+			{
+				Name:    "experiencedata_user_identifier",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[38]},
+			},
+			{
+				Name:    "experiencedata_content_hash_collected_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[39], ExperienceDataColumns[3]},
+			},
 			{
 				Name:    "experiencedata_collected_at",
 				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[3]},
+			},
+			{
+				Name:    "experiencedata_created_at",
+				Unique:  false,
 				Columns: []*schema.Column{ExperienceDataColumns[1]},
 			},
+			{
+				Name:    "experiencedata_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[2]},
+			},
 			{
 				Name:    "experiencedata_sentiment",
 				Unique:  false,
-				Columns: []*schema.Column{ExperienceDataColumns[17]},
+				Columns: []*schema.Column{ExperienceDataColumns[24]},
 			},
 			{
 				Name:    "experiencedata_emotion",
 				Unique:  false,
-				Columns: []*schema.Column{ExperienceDataColumns[19]},
+				Columns: []*schema.Column{ExperienceDataColumns[26]},
+			},
+			{
+				Name:    "experiencedata_is_spam",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[28]},
+			},
+			{
+				Name:    "experiencedata_urgency_score",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[30]},
+			},
+			{
+				Name:    "experiencedata_enrichment_version",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[34]},
+			},
+			{
+				Name:    "experiencedata_ai_input_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDataColumns[37]},
 			},
 			{
 				Name:    "experiencedata_embedding",
 				Unique:  false,
-				Columns: []*schema.Column{ExperienceDataColumns[22]},
+				Columns: []*schema.Column{ExperienceDataColumns[42]},
 				Annotation: &entsql.IndexAnnotation{
 					OpClass: "vector_cosine_ops",
 					Type:    "hnsw",
@@ -131,13 +334,266 @@ var (
 			},
 		},
 	}
+	// ExperienceDeletionsColumns holds the columns for the "experience_deletions" table.
+	ExperienceDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "experience_id", Type: field.TypeUUID},
+	}
+	// ExperienceDeletionsTable holds the schema information for the "experience_deletions" table.
+	ExperienceDeletionsTable = &schema.Table{
+		Name:       "experience_deletions",
+		Columns:    ExperienceDeletionsColumns,
+		PrimaryKey: []*schema.Column{ExperienceDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "experiencedeletion_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExperienceDeletionsColumns[1], ExperienceDeletionsColumns[0]},
+			},
+		},
+	}
+	// ExportsColumns holds the columns for the "exports" table.
+	ExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "format", Type: field.TypeString, Default: "jsonl"},
+		{Name: "provider", Type: field.TypeString, Default: "s3"},
+		{Name: "bucket", Type: field.TypeString},
+		{Name: "region", Type: field.TypeString, Nullable: true},
+		{Name: "endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "prefix", Type: field.TypeString, Nullable: true},
+		{Name: "access_key_id", Type: field.TypeString, Nullable: true},
+		{Name: "secret_access_key", Type: field.TypeString, Nullable: true},
+		{Name: "interval_minutes", Type: field.TypeInt, Default: 60},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "cursor_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cursor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "next_run_at", Type: field.TypeTime},
+	}
+	// ExportsTable holds the schema information for the "exports" table.
+	ExportsTable = &schema.Table{
+		Name:       "exports",
+		Columns:    ExportsColumns,
+		PrimaryKey: []*schema.Column{ExportsColumns[0]},
+	}
+	// ExportRunsColumns holds the columns for the "export_runs" table.
+	ExportRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "experiences", Type: field.TypeInt, Default: 0},
+		{Name: "bytes", Type: field.TypeInt64, Default: 0},
+		{Name: "objects", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "export_id", Type: field.TypeUUID},
+	}
+	// ExportRunsTable holds the schema information for the "export_runs" table.
+	ExportRunsTable = &schema.Table{
+		Name:       "export_runs",
+		Columns:    ExportRunsColumns,
+		PrimaryKey: []*schema.Column{ExportRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "export_runs_exports_export",
+				Columns:    []*schema.Column{ExportRunsColumns[8]},
+				RefColumns: []*schema.Column{ExportsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exportrun_export_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExportRunsColumns[8], ExportRunsColumns[1]},
+			},
+		},
+	}
+	// IngestMappingsColumns holds the columns for the "ingest_mappings" table.
+	IngestMappingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "source_type", Type: field.TypeString},
+		{Name: "template", Type: field.TypeJSON},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "signature_header", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+	}
+	// IngestMappingsTable holds the schema information for the "ingest_mappings" table.
+	IngestMappingsTable = &schema.Table{
+		Name:       "ingest_mappings",
+		Columns:    IngestMappingsColumns,
+		PrimaryKey: []*schema.Column{IngestMappingsColumns[0]},
+	}
+	// QuestionsColumns holds the columns for the "questions" table.
+	QuestionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "source_type", Type: field.TypeString},
+		{Name: "source_id", Type: field.TypeString, Default: ""},
+		{Name: "field_id", Type: field.TypeString},
+		{Name: "field_type", Type: field.TypeString, Nullable: true},
+		{Name: "label", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// QuestionsTable holds the schema information for the "questions" table.
+	QuestionsTable = &schema.Table{
+		Name:       "questions",
+		Columns:    QuestionsColumns,
+		PrimaryKey: []*schema.Column{QuestionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "question_source_type_source_id_field_id",
+				Unique:  true,
+				Columns: []*schema.Column{QuestionsColumns[3], QuestionsColumns[4], QuestionsColumns[5]},
+			},
+		},
+	}
+	// QueuePausesColumns holds the columns for the "queue_pauses" table.
+	QueuePausesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "job_type", Type: field.TypeString, Unique: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+	}
+	// QueuePausesTable holds the schema information for the "queue_pauses" table.
+	QueuePausesTable = &schema.Table{
+		Name:       "queue_pauses",
+		Columns:    QueuePausesColumns,
+		PrimaryKey: []*schema.Column{QueuePausesColumns[0]},
+	}
+	// SegmentsColumns holds the columns for the "segments" table.
+	SegmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "filter", Type: field.TypeJSON},
+	}
+	// SegmentsTable holds the schema information for the "segments" table.
+	SegmentsTable = &schema.Table{
+		Name:       "segments",
+		Columns:    SegmentsColumns,
+		PrimaryKey: []*schema.Column{SegmentsColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "redelivery_of", Type: field.TypeUUID, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "endpoint_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_endpoints_endpoint",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{WebhookEndpointsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1]},
+			},
+		},
+	}
+	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
+	WebhookEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "conditions", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "format", Type: field.TypeString, Default: "cloudevents"},
+		{Name: "slack_token", Type: field.TypeString, Nullable: true},
+		{Name: "slack_channel", Type: field.TypeString, Nullable: true},
+	}
+	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
+	WebhookEndpointsTable = &schema.Table{
+		Name:       "webhook_endpoints",
+		Columns:    WebhookEndpointsColumns,
+		PrimaryKey: []*schema.Column{WebhookEndpointsColumns[0]},
+	}
+	// WorkersColumns holds the columns for the "workers" table.
+	WorkersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "instance", Type: field.TypeString},
+		{Name: "worker_id", Type: field.TypeInt},
+		{Name: "job_type", Type: field.TypeString},
+		{Name: "current_job_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "current_job_started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "processed", Type: field.TypeInt64, Default: 0},
+		{Name: "failed", Type: field.TypeInt64, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "last_heartbeat_at", Type: field.TypeTime},
+	}
+	// WorkersTable holds the schema information for the "workers" table.
+	WorkersTable = &schema.Table{
+		Name:       "workers",
+		Columns:    WorkersColumns,
+		PrimaryKey: []*schema.Column{WorkersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "worker_last_heartbeat_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkersColumns[9]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AiUsagesTable,
+		AuditLogsTable,
+		ConnectorCursorsTable,
 		EnrichmentJobsTable,
 		ExperienceDataTable,
+		ExperienceDeletionsTable,
+		ExportsTable,
+		ExportRunsTable,
+		IngestMappingsTable,
+		QuestionsTable,
+		QueuePausesTable,
+		SegmentsTable,
+		WebhookDeliveriesTable,
+		WebhookEndpointsTable,
+		WorkersTable,
 	}
 )
 
 func init() {
 	EnrichmentJobsTable.ForeignKeys[0].RefTable = ExperienceDataTable
+	ExperienceDataTable.ForeignKeys[0].RefTable = QuestionsTable
+	ExportRunsTable.ForeignKeys[0].RefTable = ExportsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookEndpointsTable
 }
@@ -11,9 +11,26 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/connector/mapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+	"github.com/formbricks/hub/apps/hub/internal/ent/auditlog"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
 	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
 	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/formbricks/hub/apps/hub/internal/ent/segment"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/ent/worker"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
 	"github.com/google/uuid"
 	pgvector "github.com/pgvector/pgvector-go"
 )
@@ -27,43 +44,59 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeEnrichmentJob  = "EnrichmentJob"
-	TypeExperienceData = "ExperienceData"
+	TypeAIUsage            = "AIUsage"
+	TypeAuditLog           = "AuditLog"
+	TypeConnectorCursor    = "ConnectorCursor"
+	TypeEnrichmentJob      = "EnrichmentJob"
+	TypeExperienceData     = "ExperienceData"
+	TypeExperienceDeletion = "ExperienceDeletion"
+	TypeExport             = "Export"
+	TypeExportRun          = "ExportRun"
+	TypeIngestMapping      = "IngestMapping"
+	TypeQuestion           = "Question"
+	TypeQueuePause         = "QueuePause"
+	TypeSegment            = "Segment"
+	TypeWebhookDelivery    = "WebhookDelivery"
+	TypeWebhookEndpoint    = "WebhookEndpoint"
+	TypeWorker             = "Worker"
 )
 
-// EnrichmentJobMutation represents an operation that mutates the EnrichmentJob nodes in the graph.
-type EnrichmentJobMutation struct {
+// AIUsageMutation represents an operation that mutates the AIUsage nodes in the graph.
+type AIUsageMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	job_type          *string
-	status            *string
-	text              *string
-	error             *string
-	attempts          *int
-	addattempts       *int
-	created_at        *time.Time
-	processed_at      *time.Time
-	clearedFields     map[string]struct{}
-	experience        *uuid.UUID
-	clearedexperience bool
-	done              bool
-	oldValue          func(context.Context) (*EnrichmentJob, error)
-	predicates        []predicate.EnrichmentJob
-}
-
-var _ ent.Mutation = (*EnrichmentJobMutation)(nil)
-
-// enrichmentjobOption allows management of the mutation configuration using functional options.
-type enrichmentjobOption func(*EnrichmentJobMutation)
-
-// newEnrichmentJobMutation creates new mutation for the EnrichmentJob entity.
-func newEnrichmentJobMutation(c config, op Op, opts ...enrichmentjobOption) *EnrichmentJobMutation {
-	m := &EnrichmentJobMutation{
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	updated_at           *time.Time
+	day                  *time.Time
+	job_type             *string
+	provider             *string
+	model                *string
+	requests             *int
+	addrequests          *int
+	prompt_tokens        *int64
+	addprompt_tokens     *int64
+	completion_tokens    *int64
+	addcompletion_tokens *int64
+	cost_usd             *float64
+	addcost_usd          *float64
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*AIUsage, error)
+	predicates           []predicate.AIUsage
+}
+
+var _ ent.Mutation = (*AIUsageMutation)(nil)
+
+// aiusageOption allows management of the mutation configuration using functional options.
+type aiusageOption func(*AIUsageMutation)
+
+// newAIUsageMutation creates new mutation for the AIUsage entity.
+func newAIUsageMutation(c config, op Op, opts ...aiusageOption) *AIUsageMutation {
+	m := &AIUsageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnrichmentJob,
+		typ:           TypeAIUsage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -72,20 +105,20 @@ func newEnrichmentJobMutation(c config, op Op, opts ...enrichmentjobOption) *Enr
 	return m
 }
 
-// withEnrichmentJobID sets the ID field of the mutation.
-func withEnrichmentJobID(id uuid.UUID) enrichmentjobOption {
-	return func(m *EnrichmentJobMutation) {
+// withAIUsageID sets the ID field of the mutation.
+func withAIUsageID(id uuid.UUID) aiusageOption {
+	return func(m *AIUsageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EnrichmentJob
+			value *AIUsage
 		)
-		m.oldValue = func(ctx context.Context) (*EnrichmentJob, error) {
+		m.oldValue = func(ctx context.Context) (*AIUsage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EnrichmentJob.Get(ctx, id)
+					value, err = m.Client().AIUsage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -94,10 +127,10 @@ func withEnrichmentJobID(id uuid.UUID) enrichmentjobOption {
 	}
 }
 
-// withEnrichmentJob sets the old EnrichmentJob of the mutation.
-func withEnrichmentJob(node *EnrichmentJob) enrichmentjobOption {
-	return func(m *EnrichmentJobMutation) {
-		m.oldValue = func(context.Context) (*EnrichmentJob, error) {
+// withAIUsage sets the old AIUsage of the mutation.
+func withAIUsage(node *AIUsage) aiusageOption {
+	return func(m *AIUsageMutation) {
+		m.oldValue = func(context.Context) (*AIUsage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -106,7 +139,7 @@ func withEnrichmentJob(node *EnrichmentJob) enrichmentjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnrichmentJobMutation) Client() *Client {
+func (m AIUsageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -114,7 +147,7 @@ func (m EnrichmentJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnrichmentJobMutation) Tx() (*Tx, error) {
+func (m AIUsageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -124,14 +157,14 @@ func (m EnrichmentJobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EnrichmentJob entities.
-func (m *EnrichmentJobMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AIUsage entities.
+func (m *AIUsageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnrichmentJobMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AIUsageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -142,7 +175,7 @@ func (m *EnrichmentJobMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnrichmentJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AIUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -151,55 +184,91 @@ func (m *EnrichmentJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EnrichmentJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AIUsage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetExperienceID sets the "experience_id" field.
-func (m *EnrichmentJobMutation) SetExperienceID(u uuid.UUID) {
-	m.experience = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AIUsageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ExperienceID returns the value of the "experience_id" field in the mutation.
-func (m *EnrichmentJobMutation) ExperienceID() (r uuid.UUID, exists bool) {
-	v := m.experience
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AIUsageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExperienceID returns the old "experience_id" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldExperienceID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AIUsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExperienceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExperienceID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExperienceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ExperienceID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetExperienceID resets all changes to the "experience_id" field.
-func (m *EnrichmentJobMutation) ResetExperienceID() {
-	m.experience = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AIUsageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDay sets the "day" field.
+func (m *AIUsageMutation) SetDay(t time.Time) {
+	m.day = &t
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *AIUsageMutation) Day() (r time.Time, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AIUsageMutation) OldDay(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *AIUsageMutation) ResetDay() {
+	m.day = nil
 }
 
 // SetJobType sets the "job_type" field.
-func (m *EnrichmentJobMutation) SetJobType(s string) {
+func (m *AIUsageMutation) SetJobType(s string) {
 	m.job_type = &s
 }
 
 // JobType returns the value of the "job_type" field in the mutation.
-func (m *EnrichmentJobMutation) JobType() (r string, exists bool) {
+func (m *AIUsageMutation) JobType() (r string, exists bool) {
 	v := m.job_type
 	if v == nil {
 		return
@@ -207,10 +276,10 @@ func (m *EnrichmentJobMutation) JobType() (r string, exists bool) {
 	return *v, true
 }
 
-// OldJobType returns the old "job_type" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldJobType returns the old "job_type" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldJobType(ctx context.Context) (v string, err error) {
+func (m *AIUsageMutation) OldJobType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
 	}
@@ -225,357 +294,367 @@ func (m *EnrichmentJobMutation) OldJobType(ctx context.Context) (v string, err e
 }
 
 // ResetJobType resets all changes to the "job_type" field.
-func (m *EnrichmentJobMutation) ResetJobType() {
+func (m *AIUsageMutation) ResetJobType() {
 	m.job_type = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *EnrichmentJobMutation) SetStatus(s string) {
-	m.status = &s
+// SetProvider sets the "provider" field.
+func (m *AIUsageMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EnrichmentJobMutation) Status() (r string, exists bool) {
-	v := m.status
+// Provider returns the value of the "provider" field in the mutation.
+func (m *AIUsageMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *AIUsageMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Provider, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *EnrichmentJobMutation) ResetStatus() {
-	m.status = nil
+// ResetProvider resets all changes to the "provider" field.
+func (m *AIUsageMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetText sets the "text" field.
-func (m *EnrichmentJobMutation) SetText(s string) {
-	m.text = &s
+// SetModel sets the "model" field.
+func (m *AIUsageMutation) SetModel(s string) {
+	m.model = &s
 }
 
-// Text returns the value of the "text" field in the mutation.
-func (m *EnrichmentJobMutation) Text() (r string, exists bool) {
-	v := m.text
+// Model returns the value of the "model" field in the mutation.
+func (m *AIUsageMutation) Model() (r string, exists bool) {
+	v := m.model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldText returns the old "text" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldModel returns the old "model" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldText(ctx context.Context) (v string, err error) {
+func (m *AIUsageMutation) OldModel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldText is only allowed on UpdateOne operations")
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldText requires an ID field in the mutation")
+		return v, errors.New("OldModel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldText: %w", err)
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
-	return oldValue.Text, nil
+	return oldValue.Model, nil
 }
 
-// ResetText resets all changes to the "text" field.
-func (m *EnrichmentJobMutation) ResetText() {
-	m.text = nil
+// ResetModel resets all changes to the "model" field.
+func (m *AIUsageMutation) ResetModel() {
+	m.model = nil
 }
 
-// SetError sets the "error" field.
-func (m *EnrichmentJobMutation) SetError(s string) {
-	m.error = &s
+// SetRequests sets the "requests" field.
+func (m *AIUsageMutation) SetRequests(i int) {
+	m.requests = &i
+	m.addrequests = nil
 }
 
-// Error returns the value of the "error" field in the mutation.
-func (m *EnrichmentJobMutation) Error() (r string, exists bool) {
-	v := m.error
+// Requests returns the value of the "requests" field in the mutation.
+func (m *AIUsageMutation) Requests() (r int, exists bool) {
+	v := m.requests
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldError returns the old "error" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldRequests returns the old "requests" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldError(ctx context.Context) (v *string, err error) {
+func (m *AIUsageMutation) OldRequests(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldError is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequests is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldError requires an ID field in the mutation")
+		return v, errors.New("OldRequests requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldError: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequests: %w", err)
 	}
-	return oldValue.Error, nil
+	return oldValue.Requests, nil
 }
 
-// ClearError clears the value of the "error" field.
-func (m *EnrichmentJobMutation) ClearError() {
-	m.error = nil
-	m.clearedFields[enrichmentjob.FieldError] = struct{}{}
+// AddRequests adds i to the "requests" field.
+func (m *AIUsageMutation) AddRequests(i int) {
+	if m.addrequests != nil {
+		*m.addrequests += i
+	} else {
+		m.addrequests = &i
+	}
 }
 
-// ErrorCleared returns if the "error" field was cleared in this mutation.
-func (m *EnrichmentJobMutation) ErrorCleared() bool {
-	_, ok := m.clearedFields[enrichmentjob.FieldError]
-	return ok
+// AddedRequests returns the value that was added to the "requests" field in this mutation.
+func (m *AIUsageMutation) AddedRequests() (r int, exists bool) {
+	v := m.addrequests
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetError resets all changes to the "error" field.
-func (m *EnrichmentJobMutation) ResetError() {
-	m.error = nil
-	delete(m.clearedFields, enrichmentjob.FieldError)
+// ResetRequests resets all changes to the "requests" field.
+func (m *AIUsageMutation) ResetRequests() {
+	m.requests = nil
+	m.addrequests = nil
 }
 
-// SetAttempts sets the "attempts" field.
-func (m *EnrichmentJobMutation) SetAttempts(i int) {
-	m.attempts = &i
-	m.addattempts = nil
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *AIUsageMutation) SetPromptTokens(i int64) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
 }
 
-// Attempts returns the value of the "attempts" field in the mutation.
-func (m *EnrichmentJobMutation) Attempts() (r int, exists bool) {
-	v := m.attempts
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *AIUsageMutation) PromptTokens() (r int64, exists bool) {
+	v := m.prompt_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAttempts returns the old "attempts" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldPromptTokens returns the old "prompt_tokens" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+func (m *AIUsageMutation) OldPromptTokens(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttempts requires an ID field in the mutation")
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
 	}
-	return oldValue.Attempts, nil
+	return oldValue.PromptTokens, nil
 }
 
-// AddAttempts adds i to the "attempts" field.
-func (m *EnrichmentJobMutation) AddAttempts(i int) {
-	if m.addattempts != nil {
-		*m.addattempts += i
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *AIUsageMutation) AddPromptTokens(i int64) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
 	} else {
-		m.addattempts = &i
+		m.addprompt_tokens = &i
 	}
 }
 
-// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
-func (m *EnrichmentJobMutation) AddedAttempts() (r int, exists bool) {
-	v := m.addattempts
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *AIUsageMutation) AddedPromptTokens() (r int64, exists bool) {
+	v := m.addprompt_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAttempts resets all changes to the "attempts" field.
-func (m *EnrichmentJobMutation) ResetAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *AIUsageMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EnrichmentJobMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *AIUsageMutation) SetCompletionTokens(i int64) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnrichmentJobMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *AIUsageMutation) CompletionTokens() (r int64, exists bool) {
+	v := m.completion_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCompletionTokens returns the old "completion_tokens" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AIUsageMutation) OldCompletionTokens(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.CompletionTokens, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnrichmentJobMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *AIUsageMutation) AddCompletionTokens(i int64) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
 }
 
-// SetProcessedAt sets the "processed_at" field.
-func (m *EnrichmentJobMutation) SetProcessedAt(t time.Time) {
-	m.processed_at = &t
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *AIUsageMutation) AddedCompletionTokens() (r int64, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProcessedAt returns the value of the "processed_at" field in the mutation.
-func (m *EnrichmentJobMutation) ProcessedAt() (r time.Time, exists bool) {
-	v := m.processed_at
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *AIUsageMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (m *AIUsageMutation) SetCostUsd(f float64) {
+	m.cost_usd = &f
+	m.addcost_usd = nil
+}
+
+// CostUsd returns the value of the "cost_usd" field in the mutation.
+func (m *AIUsageMutation) CostUsd() (r float64, exists bool) {
+	v := m.cost_usd
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessedAt returns the old "processed_at" field's value of the EnrichmentJob entity.
-// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCostUsd returns the old "cost_usd" field's value of the AIUsage entity.
+// If the AIUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnrichmentJobMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *AIUsageMutation) OldCostUsd(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostUsd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+		return v, errors.New("OldCostUsd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostUsd: %w", err)
 	}
-	return oldValue.ProcessedAt, nil
+	return oldValue.CostUsd, nil
 }
 
-// ClearProcessedAt clears the value of the "processed_at" field.
-func (m *EnrichmentJobMutation) ClearProcessedAt() {
-	m.processed_at = nil
-	m.clearedFields[enrichmentjob.FieldProcessedAt] = struct{}{}
-}
-
-// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
-func (m *EnrichmentJobMutation) ProcessedAtCleared() bool {
-	_, ok := m.clearedFields[enrichmentjob.FieldProcessedAt]
-	return ok
+// AddCostUsd adds f to the "cost_usd" field.
+func (m *AIUsageMutation) AddCostUsd(f float64) {
+	if m.addcost_usd != nil {
+		*m.addcost_usd += f
+	} else {
+		m.addcost_usd = &f
+	}
 }
 
-// ResetProcessedAt resets all changes to the "processed_at" field.
-func (m *EnrichmentJobMutation) ResetProcessedAt() {
-	m.processed_at = nil
-	delete(m.clearedFields, enrichmentjob.FieldProcessedAt)
+// AddedCostUsd returns the value that was added to the "cost_usd" field in this mutation.
+func (m *AIUsageMutation) AddedCostUsd() (r float64, exists bool) {
+	v := m.addcost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearExperience clears the "experience" edge to the ExperienceData entity.
-func (m *EnrichmentJobMutation) ClearExperience() {
-	m.clearedexperience = true
-	m.clearedFields[enrichmentjob.FieldExperienceID] = struct{}{}
+// ResetCostUsd resets all changes to the "cost_usd" field.
+func (m *AIUsageMutation) ResetCostUsd() {
+	m.cost_usd = nil
+	m.addcost_usd = nil
 }
 
-// ExperienceCleared reports if the "experience" edge to the ExperienceData entity was cleared.
-func (m *EnrichmentJobMutation) ExperienceCleared() bool {
-	return m.clearedexperience
+// Where appends a list predicates to the AIUsageMutation builder.
+func (m *AIUsageMutation) Where(ps ...predicate.AIUsage) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ExperienceIDs returns the "experience" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ExperienceID instead. It exists only for internal usage by the builders.
-func (m *EnrichmentJobMutation) ExperienceIDs() (ids []uuid.UUID) {
-	if id := m.experience; id != nil {
-		ids = append(ids, *id)
+// WhereP appends storage-level predicates to the AIUsageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AIUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AIUsage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return
-}
-
-// ResetExperience resets all changes to the "experience" edge.
-func (m *EnrichmentJobMutation) ResetExperience() {
-	m.experience = nil
-	m.clearedexperience = false
-}
-
-// Where appends a list predicates to the EnrichmentJobMutation builder.
-func (m *EnrichmentJobMutation) Where(ps ...predicate.EnrichmentJob) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the EnrichmentJobMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnrichmentJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EnrichmentJob, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *EnrichmentJobMutation) Op() Op {
+func (m *AIUsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnrichmentJobMutation) SetOp(op Op) {
+func (m *AIUsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EnrichmentJob).
-func (m *EnrichmentJobMutation) Type() string {
+// Type returns the node type of this mutation (AIUsage).
+func (m *AIUsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnrichmentJobMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.experience != nil {
-		fields = append(fields, enrichmentjob.FieldExperienceID)
+func (m *AIUsageMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.updated_at != nil {
+		fields = append(fields, aiusage.FieldUpdatedAt)
+	}
+	if m.day != nil {
+		fields = append(fields, aiusage.FieldDay)
 	}
 	if m.job_type != nil {
-		fields = append(fields, enrichmentjob.FieldJobType)
+		fields = append(fields, aiusage.FieldJobType)
 	}
-	if m.status != nil {
-		fields = append(fields, enrichmentjob.FieldStatus)
+	if m.provider != nil {
+		fields = append(fields, aiusage.FieldProvider)
 	}
-	if m.text != nil {
-		fields = append(fields, enrichmentjob.FieldText)
+	if m.model != nil {
+		fields = append(fields, aiusage.FieldModel)
 	}
-	if m.error != nil {
-		fields = append(fields, enrichmentjob.FieldError)
+	if m.requests != nil {
+		fields = append(fields, aiusage.FieldRequests)
 	}
-	if m.attempts != nil {
-		fields = append(fields, enrichmentjob.FieldAttempts)
+	if m.prompt_tokens != nil {
+		fields = append(fields, aiusage.FieldPromptTokens)
 	}
-	if m.created_at != nil {
-		fields = append(fields, enrichmentjob.FieldCreatedAt)
+	if m.completion_tokens != nil {
+		fields = append(fields, aiusage.FieldCompletionTokens)
 	}
-	if m.processed_at != nil {
-		fields = append(fields, enrichmentjob.FieldProcessedAt)
+	if m.cost_usd != nil {
+		fields = append(fields, aiusage.FieldCostUsd)
 	}
 	return fields
 }
@@ -583,24 +662,26 @@ func (m *EnrichmentJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnrichmentJobMutation) Field(name string) (ent.Value, bool) {
+func (m *AIUsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case enrichmentjob.FieldExperienceID:
-		return m.ExperienceID()
-	case enrichmentjob.FieldJobType:
+	case aiusage.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case aiusage.FieldDay:
+		return m.Day()
+	case aiusage.FieldJobType:
 		return m.JobType()
-	case enrichmentjob.FieldStatus:
-		return m.Status()
-	case enrichmentjob.FieldText:
-		return m.Text()
-	case enrichmentjob.FieldError:
-		return m.Error()
-	case enrichmentjob.FieldAttempts:
-		return m.Attempts()
-	case enrichmentjob.FieldCreatedAt:
-		return m.CreatedAt()
-	case enrichmentjob.FieldProcessedAt:
-		return m.ProcessedAt()
+	case aiusage.FieldProvider:
+		return m.Provider()
+	case aiusage.FieldModel:
+		return m.Model()
+	case aiusage.FieldRequests:
+		return m.Requests()
+	case aiusage.FieldPromptTokens:
+		return m.PromptTokens()
+	case aiusage.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case aiusage.FieldCostUsd:
+		return m.CostUsd()
 	}
 	return nil, false
 }
@@ -608,99 +689,117 @@ func (m *EnrichmentJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnrichmentJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AIUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case enrichmentjob.FieldExperienceID:
-		return m.OldExperienceID(ctx)
-	case enrichmentjob.FieldJobType:
+	case aiusage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case aiusage.FieldDay:
+		return m.OldDay(ctx)
+	case aiusage.FieldJobType:
 		return m.OldJobType(ctx)
-	case enrichmentjob.FieldStatus:
-		return m.OldStatus(ctx)
-	case enrichmentjob.FieldText:
-		return m.OldText(ctx)
-	case enrichmentjob.FieldError:
-		return m.OldError(ctx)
-	case enrichmentjob.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case enrichmentjob.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case enrichmentjob.FieldProcessedAt:
-		return m.OldProcessedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown EnrichmentJob field %s", name)
+	case aiusage.FieldProvider:
+		return m.OldProvider(ctx)
+	case aiusage.FieldModel:
+		return m.OldModel(ctx)
+	case aiusage.FieldRequests:
+		return m.OldRequests(ctx)
+	case aiusage.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case aiusage.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case aiusage.FieldCostUsd:
+		return m.OldCostUsd(ctx)
+	}
+	return nil, fmt.Errorf("unknown AIUsage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnrichmentJobMutation) SetField(name string, value ent.Value) error {
+func (m *AIUsageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case enrichmentjob.FieldExperienceID:
-		v, ok := value.(uuid.UUID)
+	case aiusage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExperienceID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case enrichmentjob.FieldJobType:
-		v, ok := value.(string)
+	case aiusage.FieldDay:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJobType(v)
+		m.SetDay(v)
 		return nil
-	case enrichmentjob.FieldStatus:
+	case aiusage.FieldJobType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetJobType(v)
 		return nil
-	case enrichmentjob.FieldText:
+	case aiusage.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetProvider(v)
 		return nil
-	case enrichmentjob.FieldError:
+	case aiusage.FieldModel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetError(v)
+		m.SetModel(v)
 		return nil
-	case enrichmentjob.FieldAttempts:
+	case aiusage.FieldRequests:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttempts(v)
+		m.SetRequests(v)
 		return nil
-	case enrichmentjob.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case aiusage.FieldPromptTokens:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPromptTokens(v)
 		return nil
-	case enrichmentjob.FieldProcessedAt:
-		v, ok := value.(time.Time)
+	case aiusage.FieldCompletionTokens:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessedAt(v)
+		m.SetCompletionTokens(v)
+		return nil
+	case aiusage.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostUsd(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnrichmentJob field %s", name)
+	return fmt.Errorf("unknown AIUsage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnrichmentJobMutation) AddedFields() []string {
+func (m *AIUsageMutation) AddedFields() []string {
 	var fields []string
-	if m.addattempts != nil {
-		fields = append(fields, enrichmentjob.FieldAttempts)
+	if m.addrequests != nil {
+		fields = append(fields, aiusage.FieldRequests)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, aiusage.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, aiusage.FieldCompletionTokens)
+	}
+	if m.addcost_usd != nil {
+		fields = append(fields, aiusage.FieldCostUsd)
 	}
 	return fields
 }
@@ -708,10 +807,16 @@ func (m *EnrichmentJobMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnrichmentJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AIUsageMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case enrichmentjob.FieldAttempts:
-		return m.AddedAttempts()
+	case aiusage.FieldRequests:
+		return m.AddedRequests()
+	case aiusage.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case aiusage.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case aiusage.FieldCostUsd:
+		return m.AddedCostUsd()
 	}
 	return nil, false
 }
@@ -719,208 +824,177 @@ func (m *EnrichmentJobMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnrichmentJobMutation) AddField(name string, value ent.Value) error {
+func (m *AIUsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case enrichmentjob.FieldAttempts:
+	case aiusage.FieldRequests:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAttempts(v)
+		m.AddRequests(v)
+		return nil
+	case aiusage.FieldPromptTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case aiusage.FieldCompletionTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case aiusage.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostUsd(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EnrichmentJob numeric field %s", name)
+	return fmt.Errorf("unknown AIUsage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnrichmentJobMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(enrichmentjob.FieldError) {
-		fields = append(fields, enrichmentjob.FieldError)
-	}
-	if m.FieldCleared(enrichmentjob.FieldProcessedAt) {
-		fields = append(fields, enrichmentjob.FieldProcessedAt)
-	}
-	return fields
+func (m *AIUsageMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnrichmentJobMutation) FieldCleared(name string) bool {
+func (m *AIUsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnrichmentJobMutation) ClearField(name string) error {
-	switch name {
-	case enrichmentjob.FieldError:
-		m.ClearError()
-		return nil
-	case enrichmentjob.FieldProcessedAt:
-		m.ClearProcessedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown EnrichmentJob nullable field %s", name)
+func (m *AIUsageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AIUsage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnrichmentJobMutation) ResetField(name string) error {
+func (m *AIUsageMutation) ResetField(name string) error {
 	switch name {
-	case enrichmentjob.FieldExperienceID:
-		m.ResetExperienceID()
+	case aiusage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case enrichmentjob.FieldJobType:
+	case aiusage.FieldDay:
+		m.ResetDay()
+		return nil
+	case aiusage.FieldJobType:
 		m.ResetJobType()
 		return nil
-	case enrichmentjob.FieldStatus:
-		m.ResetStatus()
+	case aiusage.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case enrichmentjob.FieldText:
-		m.ResetText()
+	case aiusage.FieldModel:
+		m.ResetModel()
 		return nil
-	case enrichmentjob.FieldError:
-		m.ResetError()
+	case aiusage.FieldRequests:
+		m.ResetRequests()
 		return nil
-	case enrichmentjob.FieldAttempts:
-		m.ResetAttempts()
+	case aiusage.FieldPromptTokens:
+		m.ResetPromptTokens()
 		return nil
-	case enrichmentjob.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case aiusage.FieldCompletionTokens:
+		m.ResetCompletionTokens()
 		return nil
-	case enrichmentjob.FieldProcessedAt:
-		m.ResetProcessedAt()
+	case aiusage.FieldCostUsd:
+		m.ResetCostUsd()
 		return nil
 	}
-	return fmt.Errorf("unknown EnrichmentJob field %s", name)
+	return fmt.Errorf("unknown AIUsage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnrichmentJobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.experience != nil {
-		edges = append(edges, enrichmentjob.EdgeExperience)
-	}
+func (m *AIUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnrichmentJobMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case enrichmentjob.EdgeExperience:
-		if id := m.experience; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AIUsageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnrichmentJobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AIUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnrichmentJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *AIUsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnrichmentJobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedexperience {
-		edges = append(edges, enrichmentjob.EdgeExperience)
-	}
+func (m *AIUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnrichmentJobMutation) EdgeCleared(name string) bool {
-	switch name {
-	case enrichmentjob.EdgeExperience:
-		return m.clearedexperience
-	}
+func (m *AIUsageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnrichmentJobMutation) ClearEdge(name string) error {
-	switch name {
-	case enrichmentjob.EdgeExperience:
-		m.ClearExperience()
-		return nil
-	}
-	return fmt.Errorf("unknown EnrichmentJob unique edge %s", name)
+func (m *AIUsageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AIUsage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnrichmentJobMutation) ResetEdge(name string) error {
-	switch name {
-	case enrichmentjob.EdgeExperience:
-		m.ResetExperience()
-		return nil
-	}
-	return fmt.Errorf("unknown EnrichmentJob edge %s", name)
+func (m *AIUsageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AIUsage edge %s", name)
 }
 
-// ExperienceDataMutation represents an operation that mutates the ExperienceData nodes in the graph.
-type ExperienceDataMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	collected_at       *time.Time
-	created_at         *time.Time
-	updated_at         *time.Time
-	source_type        *string
-	source_id          *string
-	source_name        *string
-	field_id           *string
-	field_label        *string
-	field_type         *string
-	value_text         *string
-	value_number       *float64
-	addvalue_number    *float64
-	value_boolean      *bool
-	value_date         *time.Time
-	value_json         *map[string]interface{}
-	metadata           *map[string]interface{}
-	language           *string
-	sentiment          *string
-	sentiment_score    *float64
-	addsentiment_score *float64
-	emotion            *string
-	topics             *[]string
-	appendtopics       []string
-	user_identifier    *string
-	embedding          *pgvector.Vector
-	embedding_model    *string
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*ExperienceData, error)
-	predicates         []predicate.ExperienceData
-}
-
-var _ ent.Mutation = (*ExperienceDataMutation)(nil)
-
-// experiencedataOption allows management of the mutation configuration using functional options.
-type experiencedataOption func(*ExperienceDataMutation)
-
-// newExperienceDataMutation creates new mutation for the ExperienceData entity.
-func newExperienceDataMutation(c config, op Op, opts ...experiencedataOption) *ExperienceDataMutation {
-	m := &ExperienceDataMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	actor           *string
+	request_id      *string
+	method          *string
+	_path           *string
+	operation       *string
+	resource_id     *string
+	status          *int
+	addstatus       *int
+	summary         *string
+	event_ids       *[]string
+	appendevent_ids []string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*AuditLog, error)
+	predicates      []predicate.AuditLog
+}
+
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExperienceData,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -929,20 +1003,20 @@ func newExperienceDataMutation(c config, op Op, opts ...experiencedataOption) *E
 	return m
 }
 
-// withExperienceDataID sets the ID field of the mutation.
-func withExperienceDataID(id uuid.UUID) experiencedataOption {
-	return func(m *ExperienceDataMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id uuid.UUID) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExperienceData
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*ExperienceData, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExperienceData.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -951,10 +1025,10 @@ func withExperienceDataID(id uuid.UUID) experiencedataOption {
 	}
 }
 
-// withExperienceData sets the old ExperienceData of the mutation.
-func withExperienceData(node *ExperienceData) experiencedataOption {
-	return func(m *ExperienceDataMutation) {
-		m.oldValue = func(context.Context) (*ExperienceData, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -963,7 +1037,7 @@ func withExperienceData(node *ExperienceData) experiencedataOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExperienceDataMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -971,7 +1045,7 @@ func (m ExperienceDataMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExperienceDataMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -981,14 +1055,14 @@ func (m ExperienceDataMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ExperienceData entities.
-func (m *ExperienceDataMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExperienceDataMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditLogMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -999,7 +1073,7 @@ func (m *ExperienceDataMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExperienceDataMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1008,1515 +1082,14538 @@ func (m *ExperienceDataMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExperienceData.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCollectedAt sets the "collected_at" field.
-func (m *ExperienceDataMutation) SetCollectedAt(t time.Time) {
-	m.collected_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CollectedAt returns the value of the "collected_at" field in the mutation.
-func (m *ExperienceDataMutation) CollectedAt() (r time.Time, exists bool) {
-	v := m.collected_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCollectedAt returns the old "collected_at" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.CollectedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCollectedAt resets all changes to the "collected_at" field.
-func (m *ExperienceDataMutation) ResetCollectedAt() {
-	m.collected_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ExperienceDataMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ExperienceDataMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Actor, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ExperienceDataMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ExperienceDataMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetRequestID sets the "request_id" field.
+func (m *AuditLogMutation) SetRequestID(s string) {
+	m.request_id = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ExperienceDataMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditLogMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestID returns the old "request_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldRequestID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.RequestID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ExperienceDataMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearRequestID clears the value of the "request_id" field.
+func (m *AuditLogMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
 }
 
-// SetSourceType sets the "source_type" field.
-func (m *ExperienceDataMutation) SetSourceType(s string) {
-	m.source_type = &s
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *AuditLogMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldRequestID]
+	return ok
 }
 
-// SourceType returns the value of the "source_type" field in the mutation.
-func (m *ExperienceDataMutation) SourceType() (r string, exists bool) {
-	v := m.source_type
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditLogMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, auditlog.FieldRequestID)
+}
+
+// SetMethod sets the "method" field.
+func (m *AuditLogMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *AuditLogMutation) Method() (r string, exists bool) {
+	v := m.method
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceType returns the old "source_type" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldMethod returns the old "method" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldSourceType(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldMethod(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceType requires an ID field in the mutation")
+		return v, errors.New("OldMethod requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
 	}
-	return oldValue.SourceType, nil
+	return oldValue.Method, nil
 }
 
-// ResetSourceType resets all changes to the "source_type" field.
-func (m *ExperienceDataMutation) ResetSourceType() {
-	m.source_type = nil
+// ResetMethod resets all changes to the "method" field.
+func (m *AuditLogMutation) ResetMethod() {
+	m.method = nil
 }
 
-// SetSourceID sets the "source_id" field.
-func (m *ExperienceDataMutation) SetSourceID(s string) {
-	m.source_id = &s
+// SetPath sets the "path" field.
+func (m *AuditLogMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// SourceID returns the value of the "source_id" field in the mutation.
-func (m *ExperienceDataMutation) SourceID() (r string, exists bool) {
-	v := m.source_id
+// Path returns the value of the "path" field in the mutation.
+func (m *AuditLogMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceID returns the old "source_id" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldSourceID(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceID requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.SourceID, nil
-}
-
-// ClearSourceID clears the value of the "source_id" field.
-func (m *ExperienceDataMutation) ClearSourceID() {
-	m.source_id = nil
-	m.clearedFields[experiencedata.FieldSourceID] = struct{}{}
+	return oldValue.Path, nil
 }
 
-// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
-func (m *ExperienceDataMutation) SourceIDCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldSourceID]
-	return ok
-}
-
-// ResetSourceID resets all changes to the "source_id" field.
-func (m *ExperienceDataMutation) ResetSourceID() {
-	m.source_id = nil
-	delete(m.clearedFields, experiencedata.FieldSourceID)
+// ResetPath resets all changes to the "path" field.
+func (m *AuditLogMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetSourceName sets the "source_name" field.
-func (m *ExperienceDataMutation) SetSourceName(s string) {
-	m.source_name = &s
+// SetOperation sets the "operation" field.
+func (m *AuditLogMutation) SetOperation(s string) {
+	m.operation = &s
 }
 
-// SourceName returns the value of the "source_name" field in the mutation.
-func (m *ExperienceDataMutation) SourceName() (r string, exists bool) {
-	v := m.source_name
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AuditLogMutation) Operation() (r string, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceName returns the old "source_name" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldSourceName(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldOperation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceName requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.SourceName, nil
+	return oldValue.Operation, nil
 }
 
-// ClearSourceName clears the value of the "source_name" field.
-func (m *ExperienceDataMutation) ClearSourceName() {
-	m.source_name = nil
-	m.clearedFields[experiencedata.FieldSourceName] = struct{}{}
+// ClearOperation clears the value of the "operation" field.
+func (m *AuditLogMutation) ClearOperation() {
+	m.operation = nil
+	m.clearedFields[auditlog.FieldOperation] = struct{}{}
 }
 
-// SourceNameCleared returns if the "source_name" field was cleared in this mutation.
-func (m *ExperienceDataMutation) SourceNameCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldSourceName]
+// OperationCleared returns if the "operation" field was cleared in this mutation.
+func (m *AuditLogMutation) OperationCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldOperation]
 	return ok
 }
 
-// ResetSourceName resets all changes to the "source_name" field.
-func (m *ExperienceDataMutation) ResetSourceName() {
-	m.source_name = nil
-	delete(m.clearedFields, experiencedata.FieldSourceName)
+// ResetOperation resets all changes to the "operation" field.
+func (m *AuditLogMutation) ResetOperation() {
+	m.operation = nil
+	delete(m.clearedFields, auditlog.FieldOperation)
 }
 
-// SetFieldID sets the "field_id" field.
-func (m *ExperienceDataMutation) SetFieldID(s string) {
-	m.field_id = &s
+// SetResourceID sets the "resource_id" field.
+func (m *AuditLogMutation) SetResourceID(s string) {
+	m.resource_id = &s
 }
 
-// FieldID returns the value of the "field_id" field in the mutation.
-func (m *ExperienceDataMutation) FieldID() (r string, exists bool) {
-	v := m.field_id
+// ResourceID returns the value of the "resource_id" field in the mutation.
+func (m *AuditLogMutation) ResourceID() (r string, exists bool) {
+	v := m.resource_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFieldID returns the old "field_id" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceID returns the old "resource_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldFieldID(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldResourceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFieldID is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFieldID requires an ID field in the mutation")
+		return v, errors.New("OldResourceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFieldID: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceID: %w", err)
 	}
-	return oldValue.FieldID, nil
+	return oldValue.ResourceID, nil
 }
 
-// ResetFieldID resets all changes to the "field_id" field.
-func (m *ExperienceDataMutation) ResetFieldID() {
-	m.field_id = nil
+// ClearResourceID clears the value of the "resource_id" field.
+func (m *AuditLogMutation) ClearResourceID() {
+	m.resource_id = nil
+	m.clearedFields[auditlog.FieldResourceID] = struct{}{}
 }
 
-// SetFieldLabel sets the "field_label" field.
-func (m *ExperienceDataMutation) SetFieldLabel(s string) {
-	m.field_label = &s
+// ResourceIDCleared returns if the "resource_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ResourceIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldResourceID]
+	return ok
 }
 
-// FieldLabel returns the value of the "field_label" field in the mutation.
-func (m *ExperienceDataMutation) FieldLabel() (r string, exists bool) {
-	v := m.field_label
+// ResetResourceID resets all changes to the "resource_id" field.
+func (m *AuditLogMutation) ResetResourceID() {
+	m.resource_id = nil
+	delete(m.clearedFields, auditlog.FieldResourceID)
+}
+
+// SetStatus sets the "status" field.
+func (m *AuditLogMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AuditLogMutation) Status() (r int, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFieldLabel returns the old "field_label" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldFieldLabel(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldStatus(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFieldLabel is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFieldLabel requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFieldLabel: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.FieldLabel, nil
+	return oldValue.Status, nil
 }
 
-// ClearFieldLabel clears the value of the "field_label" field.
-func (m *ExperienceDataMutation) ClearFieldLabel() {
-	m.field_label = nil
-	m.clearedFields[experiencedata.FieldFieldLabel] = struct{}{}
+// AddStatus adds i to the "status" field.
+func (m *AuditLogMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
 }
 
-// FieldLabelCleared returns if the "field_label" field was cleared in this mutation.
-func (m *ExperienceDataMutation) FieldLabelCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldFieldLabel]
-	return ok
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *AuditLogMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetFieldLabel resets all changes to the "field_label" field.
-func (m *ExperienceDataMutation) ResetFieldLabel() {
-	m.field_label = nil
-	delete(m.clearedFields, experiencedata.FieldFieldLabel)
+// ResetStatus resets all changes to the "status" field.
+func (m *AuditLogMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
 }
 
-// SetFieldType sets the "field_type" field.
-func (m *ExperienceDataMutation) SetFieldType(s string) {
-	m.field_type = &s
+// SetSummary sets the "summary" field.
+func (m *AuditLogMutation) SetSummary(s string) {
+	m.summary = &s
 }
 
-// FieldType returns the value of the "field_type" field in the mutation.
-func (m *ExperienceDataMutation) FieldType() (r string, exists bool) {
-	v := m.field_type
+// Summary returns the value of the "summary" field in the mutation.
+func (m *AuditLogMutation) Summary() (r string, exists bool) {
+	v := m.summary
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFieldType returns the old "field_type" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldSummary returns the old "summary" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldFieldType(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldSummary(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFieldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFieldType requires an ID field in the mutation")
+		return v, errors.New("OldSummary requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFieldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
 	}
-	return oldValue.FieldType, nil
+	return oldValue.Summary, nil
 }
 
-// ResetFieldType resets all changes to the "field_type" field.
-func (m *ExperienceDataMutation) ResetFieldType() {
-	m.field_type = nil
+// ResetSummary resets all changes to the "summary" field.
+func (m *AuditLogMutation) ResetSummary() {
+	m.summary = nil
 }
 
-// SetValueText sets the "value_text" field.
-func (m *ExperienceDataMutation) SetValueText(s string) {
-	m.value_text = &s
+// SetEventIds sets the "event_ids" field.
+func (m *AuditLogMutation) SetEventIds(s []string) {
+	m.event_ids = &s
+	m.appendevent_ids = nil
 }
 
-// ValueText returns the value of the "value_text" field in the mutation.
-func (m *ExperienceDataMutation) ValueText() (r string, exists bool) {
-	v := m.value_text
+// EventIds returns the value of the "event_ids" field in the mutation.
+func (m *AuditLogMutation) EventIds() (r []string, exists bool) {
+	v := m.event_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValueText returns the old "value_text" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldEventIds returns the old "event_ids" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldValueText(ctx context.Context) (v *string, err error) {
+func (m *AuditLogMutation) OldEventIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValueText is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValueText requires an ID field in the mutation")
+		return v, errors.New("OldEventIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValueText: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventIds: %w", err)
 	}
-	return oldValue.ValueText, nil
+	return oldValue.EventIds, nil
 }
 
-// ClearValueText clears the value of the "value_text" field.
-func (m *ExperienceDataMutation) ClearValueText() {
-	m.value_text = nil
-	m.clearedFields[experiencedata.FieldValueText] = struct{}{}
+// AppendEventIds adds s to the "event_ids" field.
+func (m *AuditLogMutation) AppendEventIds(s []string) {
+	m.appendevent_ids = append(m.appendevent_ids, s...)
 }
 
-// ValueTextCleared returns if the "value_text" field was cleared in this mutation.
-func (m *ExperienceDataMutation) ValueTextCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldValueText]
+// AppendedEventIds returns the list of values that were appended to the "event_ids" field in this mutation.
+func (m *AuditLogMutation) AppendedEventIds() ([]string, bool) {
+	if len(m.appendevent_ids) == 0 {
+		return nil, false
+	}
+	return m.appendevent_ids, true
+}
+
+// ClearEventIds clears the value of the "event_ids" field.
+func (m *AuditLogMutation) ClearEventIds() {
+	m.event_ids = nil
+	m.appendevent_ids = nil
+	m.clearedFields[auditlog.FieldEventIds] = struct{}{}
+}
+
+// EventIdsCleared returns if the "event_ids" field was cleared in this mutation.
+func (m *AuditLogMutation) EventIdsCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldEventIds]
 	return ok
 }
 
-// ResetValueText resets all changes to the "value_text" field.
-func (m *ExperienceDataMutation) ResetValueText() {
-	m.value_text = nil
-	delete(m.clearedFields, experiencedata.FieldValueText)
+// ResetEventIds resets all changes to the "event_ids" field.
+func (m *AuditLogMutation) ResetEventIds() {
+	m.event_ids = nil
+	m.appendevent_ids = nil
+	delete(m.clearedFields, auditlog.FieldEventIds)
 }
 
-// SetValueNumber sets the "value_number" field.
-func (m *ExperienceDataMutation) SetValueNumber(f float64) {
-	m.value_number = &f
-	m.addvalue_number = nil
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ValueNumber returns the value of the "value_number" field in the mutation.
-func (m *ExperienceDataMutation) ValueNumber() (r float64, exists bool) {
-	v := m.value_number
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldValueNumber returns the old "value_number" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldValueNumber(ctx context.Context) (v *float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValueNumber is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValueNumber requires an ID field in the mutation")
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValueNumber: %w", err)
+	if m.request_id != nil {
+		fields = append(fields, auditlog.FieldRequestID)
 	}
-	return oldValue.ValueNumber, nil
-}
-
-// AddValueNumber adds f to the "value_number" field.
-func (m *ExperienceDataMutation) AddValueNumber(f float64) {
-	if m.addvalue_number != nil {
-		*m.addvalue_number += f
-	} else {
-		m.addvalue_number = &f
+	if m.method != nil {
+		fields = append(fields, auditlog.FieldMethod)
+	}
+	if m._path != nil {
+		fields = append(fields, auditlog.FieldPath)
 	}
+	if m.operation != nil {
+		fields = append(fields, auditlog.FieldOperation)
+	}
+	if m.resource_id != nil {
+		fields = append(fields, auditlog.FieldResourceID)
+	}
+	if m.status != nil {
+		fields = append(fields, auditlog.FieldStatus)
+	}
+	if m.summary != nil {
+		fields = append(fields, auditlog.FieldSummary)
+	}
+	if m.event_ids != nil {
+		fields = append(fields, auditlog.FieldEventIds)
+	}
+	return fields
 }
 
-// AddedValueNumber returns the value that was added to the "value_number" field in this mutation.
-func (m *ExperienceDataMutation) AddedValueNumber() (r float64, exists bool) {
-	v := m.addvalue_number
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldRequestID:
+		return m.RequestID()
+	case auditlog.FieldMethod:
+		return m.Method()
+	case auditlog.FieldPath:
+		return m.Path()
+	case auditlog.FieldOperation:
+		return m.Operation()
+	case auditlog.FieldResourceID:
+		return m.ResourceID()
+	case auditlog.FieldStatus:
+		return m.Status()
+	case auditlog.FieldSummary:
+		return m.Summary()
+	case auditlog.FieldEventIds:
+		return m.EventIds()
 	}
-	return *v, true
+	return nil, false
 }
 
-// ClearValueNumber clears the value of the "value_number" field.
-func (m *ExperienceDataMutation) ClearValueNumber() {
-	m.value_number = nil
-	m.addvalue_number = nil
-	m.clearedFields[experiencedata.FieldValueNumber] = struct{}{}
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditlog.FieldMethod:
+		return m.OldMethod(ctx)
+	case auditlog.FieldPath:
+		return m.OldPath(ctx)
+	case auditlog.FieldOperation:
+		return m.OldOperation(ctx)
+	case auditlog.FieldResourceID:
+		return m.OldResourceID(ctx)
+	case auditlog.FieldStatus:
+		return m.OldStatus(ctx)
+	case auditlog.FieldSummary:
+		return m.OldSummary(ctx)
+	case auditlog.FieldEventIds:
+		return m.OldEventIds(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// ValueNumberCleared returns if the "value_number" field was cleared in this mutation.
-func (m *ExperienceDataMutation) ValueNumberCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldValueNumber]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditlog.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case auditlog.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case auditlog.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case auditlog.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case auditlog.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case auditlog.FieldResourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceID(v)
+		return nil
+	case auditlog.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case auditlog.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case auditlog.FieldEventIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventIds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// ResetValueNumber resets all changes to the "value_number" field.
-func (m *ExperienceDataMutation) ResetValueNumber() {
-	m.value_number = nil
-	m.addvalue_number = nil
-	delete(m.clearedFields, experiencedata.FieldValueNumber)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, auditlog.FieldStatus)
+	}
+	return fields
 }
 
-// SetValueBoolean sets the "value_boolean" field.
-func (m *ExperienceDataMutation) SetValueBoolean(b bool) {
-	m.value_boolean = &b
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditlog.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
 }
 
-// ValueBoolean returns the value of the "value_boolean" field in the mutation.
-func (m *ExperienceDataMutation) ValueBoolean() (r bool, exists bool) {
-	v := m.value_boolean
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditlog.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
-// OldValueBoolean returns the old "value_boolean" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldValueBoolean(ctx context.Context) (v *bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValueBoolean is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldRequestID) {
+		fields = append(fields, auditlog.FieldRequestID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValueBoolean requires an ID field in the mutation")
+	if m.FieldCleared(auditlog.FieldOperation) {
+		fields = append(fields, auditlog.FieldOperation)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValueBoolean: %w", err)
+	if m.FieldCleared(auditlog.FieldResourceID) {
+		fields = append(fields, auditlog.FieldResourceID)
 	}
-	return oldValue.ValueBoolean, nil
-}
-
-// ClearValueBoolean clears the value of the "value_boolean" field.
-func (m *ExperienceDataMutation) ClearValueBoolean() {
-	m.value_boolean = nil
-	m.clearedFields[experiencedata.FieldValueBoolean] = struct{}{}
+	if m.FieldCleared(auditlog.FieldEventIds) {
+		fields = append(fields, auditlog.FieldEventIds)
+	}
+	return fields
 }
 
-// ValueBooleanCleared returns if the "value_boolean" field was cleared in this mutation.
-func (m *ExperienceDataMutation) ValueBooleanCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldValueBoolean]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetValueBoolean resets all changes to the "value_boolean" field.
-func (m *ExperienceDataMutation) ResetValueBoolean() {
-	m.value_boolean = nil
-	delete(m.clearedFields, experiencedata.FieldValueBoolean)
-}
-
-// SetValueDate sets the "value_date" field.
-func (m *ExperienceDataMutation) SetValueDate(t time.Time) {
-	m.value_date = &t
-}
-
-// ValueDate returns the value of the "value_date" field in the mutation.
-func (m *ExperienceDataMutation) ValueDate() (r time.Time, exists bool) {
-	v := m.value_date
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	case auditlog.FieldOperation:
+		m.ClearOperation()
+		return nil
+	case auditlog.FieldResourceID:
+		m.ClearResourceID()
+		return nil
+	case auditlog.FieldEventIds:
+		m.ClearEventIds()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
-// OldValueDate returns the old "value_date" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldValueDate(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValueDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValueDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValueDate: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditlog.FieldActor:
+		m.ResetActor()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditlog.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case auditlog.FieldPath:
+		m.ResetPath()
+		return nil
+	case auditlog.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case auditlog.FieldResourceID:
+		m.ResetResourceID()
+		return nil
+	case auditlog.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case auditlog.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case auditlog.FieldEventIds:
+		m.ResetEventIds()
+		return nil
 	}
-	return oldValue.ValueDate, nil
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
-// ClearValueDate clears the value of the "value_date" field.
-func (m *ExperienceDataMutation) ClearValueDate() {
-	m.value_date = nil
-	m.clearedFields[experiencedata.FieldValueDate] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ValueDateCleared returns if the "value_date" field was cleared in this mutation.
-func (m *ExperienceDataMutation) ValueDateCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldValueDate]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetValueDate resets all changes to the "value_date" field.
-func (m *ExperienceDataMutation) ResetValueDate() {
-	m.value_date = nil
-	delete(m.clearedFields, experiencedata.FieldValueDate)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetValueJSON sets the "value_json" field.
-func (m *ExperienceDataMutation) SetValueJSON(value map[string]interface{}) {
-	m.value_json = &value
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ValueJSON returns the value of the "value_json" field in the mutation.
-func (m *ExperienceDataMutation) ValueJSON() (r map[string]interface{}, exists bool) {
-	v := m.value_json
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// ConnectorCursorMutation represents an operation that mutates the ConnectorCursor nodes in the graph.
+type ConnectorCursorMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	stream        *string
+	cursor        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ConnectorCursor, error)
+	predicates    []predicate.ConnectorCursor
+}
+
+var _ ent.Mutation = (*ConnectorCursorMutation)(nil)
+
+// connectorcursorOption allows management of the mutation configuration using functional options.
+type connectorcursorOption func(*ConnectorCursorMutation)
+
+// newConnectorCursorMutation creates new mutation for the ConnectorCursor entity.
+func newConnectorCursorMutation(c config, op Op, opts ...connectorcursorOption) *ConnectorCursorMutation {
+	m := &ConnectorCursorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConnectorCursor,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldValueJSON returns the old "value_json" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldValueJSON(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValueJSON is only allowed on UpdateOne operations")
+// withConnectorCursorID sets the ID field of the mutation.
+func withConnectorCursorID(id uuid.UUID) connectorcursorOption {
+	return func(m *ConnectorCursorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ConnectorCursor
+		)
+		m.oldValue = func(ctx context.Context) (*ConnectorCursor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ConnectorCursor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValueJSON requires an ID field in the mutation")
+}
+
+// withConnectorCursor sets the old ConnectorCursor of the mutation.
+func withConnectorCursor(node *ConnectorCursor) connectorcursorOption {
+	return func(m *ConnectorCursorMutation) {
+		m.oldValue = func(context.Context) (*ConnectorCursor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValueJSON: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConnectorCursorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConnectorCursorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.ValueJSON, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearValueJSON clears the value of the "value_json" field.
-func (m *ExperienceDataMutation) ClearValueJSON() {
-	m.value_json = nil
-	m.clearedFields[experiencedata.FieldValueJSON] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ConnectorCursor entities.
+func (m *ConnectorCursorMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// ValueJSONCleared returns if the "value_json" field was cleared in this mutation.
-func (m *ExperienceDataMutation) ValueJSONCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldValueJSON]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConnectorCursorMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetValueJSON resets all changes to the "value_json" field.
-func (m *ExperienceDataMutation) ResetValueJSON() {
-	m.value_json = nil
-	delete(m.clearedFields, experiencedata.FieldValueJSON)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConnectorCursorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ConnectorCursor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *ExperienceDataMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetCreatedAt sets the "created_at" field.
+func (m *ConnectorCursorMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *ExperienceDataMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConnectorCursorMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ConnectorCursor entity.
+// If the ConnectorCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ConnectorCursorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Metadata, nil
-}
-
-// ClearMetadata clears the value of the "metadata" field.
-func (m *ExperienceDataMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[experiencedata.FieldMetadata] = struct{}{}
-}
-
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *ExperienceDataMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldMetadata]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *ExperienceDataMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, experiencedata.FieldMetadata)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConnectorCursorMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetLanguage sets the "language" field.
-func (m *ExperienceDataMutation) SetLanguage(s string) {
-	m.language = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConnectorCursorMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Language returns the value of the "language" field in the mutation.
-func (m *ExperienceDataMutation) Language() (r string, exists bool) {
-	v := m.language
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConnectorCursorMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLanguage returns the old "language" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ConnectorCursor entity.
+// If the ConnectorCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldLanguage(ctx context.Context) (v string, err error) {
+func (m *ConnectorCursorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguage requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Language, nil
-}
-
-// ClearLanguage clears the value of the "language" field.
-func (m *ExperienceDataMutation) ClearLanguage() {
-	m.language = nil
-	m.clearedFields[experiencedata.FieldLanguage] = struct{}{}
-}
-
-// LanguageCleared returns if the "language" field was cleared in this mutation.
-func (m *ExperienceDataMutation) LanguageCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldLanguage]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetLanguage resets all changes to the "language" field.
-func (m *ExperienceDataMutation) ResetLanguage() {
-	m.language = nil
-	delete(m.clearedFields, experiencedata.FieldLanguage)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConnectorCursorMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetSentiment sets the "sentiment" field.
-func (m *ExperienceDataMutation) SetSentiment(s string) {
-	m.sentiment = &s
+// SetStream sets the "stream" field.
+func (m *ConnectorCursorMutation) SetStream(s string) {
+	m.stream = &s
 }
 
-// Sentiment returns the value of the "sentiment" field in the mutation.
-func (m *ExperienceDataMutation) Sentiment() (r string, exists bool) {
-	v := m.sentiment
+// Stream returns the value of the "stream" field in the mutation.
+func (m *ConnectorCursorMutation) Stream() (r string, exists bool) {
+	v := m.stream
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSentiment returns the old "sentiment" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldStream returns the old "stream" field's value of the ConnectorCursor entity.
+// If the ConnectorCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldSentiment(ctx context.Context) (v *string, err error) {
+func (m *ConnectorCursorMutation) OldStream(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSentiment is only allowed on UpdateOne operations")
+		return v, errors.New("OldStream is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSentiment requires an ID field in the mutation")
+		return v, errors.New("OldStream requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSentiment: %w", err)
+		return v, fmt.Errorf("querying old value for OldStream: %w", err)
 	}
-	return oldValue.Sentiment, nil
-}
-
-// ClearSentiment clears the value of the "sentiment" field.
-func (m *ExperienceDataMutation) ClearSentiment() {
-	m.sentiment = nil
-	m.clearedFields[experiencedata.FieldSentiment] = struct{}{}
-}
-
-// SentimentCleared returns if the "sentiment" field was cleared in this mutation.
-func (m *ExperienceDataMutation) SentimentCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldSentiment]
-	return ok
+	return oldValue.Stream, nil
 }
 
-// ResetSentiment resets all changes to the "sentiment" field.
-func (m *ExperienceDataMutation) ResetSentiment() {
-	m.sentiment = nil
-	delete(m.clearedFields, experiencedata.FieldSentiment)
+// ResetStream resets all changes to the "stream" field.
+func (m *ConnectorCursorMutation) ResetStream() {
+	m.stream = nil
 }
 
-// SetSentimentScore sets the "sentiment_score" field.
-func (m *ExperienceDataMutation) SetSentimentScore(f float64) {
-	m.sentiment_score = &f
-	m.addsentiment_score = nil
+// SetCursor sets the "cursor" field.
+func (m *ConnectorCursorMutation) SetCursor(s string) {
+	m.cursor = &s
 }
 
-// SentimentScore returns the value of the "sentiment_score" field in the mutation.
-func (m *ExperienceDataMutation) SentimentScore() (r float64, exists bool) {
-	v := m.sentiment_score
+// Cursor returns the value of the "cursor" field in the mutation.
+func (m *ConnectorCursorMutation) Cursor() (r string, exists bool) {
+	v := m.cursor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSentimentScore returns the old "sentiment_score" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldCursor returns the old "cursor" field's value of the ConnectorCursor entity.
+// If the ConnectorCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldSentimentScore(ctx context.Context) (v *float64, err error) {
+func (m *ConnectorCursorMutation) OldCursor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSentimentScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSentimentScore requires an ID field in the mutation")
+		return v, errors.New("OldCursor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSentimentScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
 	}
-	return oldValue.SentimentScore, nil
+	return oldValue.Cursor, nil
 }
 
-// AddSentimentScore adds f to the "sentiment_score" field.
-func (m *ExperienceDataMutation) AddSentimentScore(f float64) {
-	if m.addsentiment_score != nil {
-		*m.addsentiment_score += f
-	} else {
-		m.addsentiment_score = &f
-	}
+// ResetCursor resets all changes to the "cursor" field.
+func (m *ConnectorCursorMutation) ResetCursor() {
+	m.cursor = nil
 }
 
-// AddedSentimentScore returns the value that was added to the "sentiment_score" field in this mutation.
-func (m *ExperienceDataMutation) AddedSentimentScore() (r float64, exists bool) {
-	v := m.addsentiment_score
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the ConnectorCursorMutation builder.
+func (m *ConnectorCursorMutation) Where(ps ...predicate.ConnectorCursor) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ClearSentimentScore clears the value of the "sentiment_score" field.
-func (m *ExperienceDataMutation) ClearSentimentScore() {
-	m.sentiment_score = nil
-	m.addsentiment_score = nil
-	m.clearedFields[experiencedata.FieldSentimentScore] = struct{}{}
+// WhereP appends storage-level predicates to the ConnectorCursorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConnectorCursorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConnectorCursor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SentimentScoreCleared returns if the "sentiment_score" field was cleared in this mutation.
-func (m *ExperienceDataMutation) SentimentScoreCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldSentimentScore]
-	return ok
+// Op returns the operation name.
+func (m *ConnectorCursorMutation) Op() Op {
+	return m.op
 }
 
-// ResetSentimentScore resets all changes to the "sentiment_score" field.
-func (m *ExperienceDataMutation) ResetSentimentScore() {
-	m.sentiment_score = nil
-	m.addsentiment_score = nil
-	delete(m.clearedFields, experiencedata.FieldSentimentScore)
+// SetOp allows setting the mutation operation.
+func (m *ConnectorCursorMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetEmotion sets the "emotion" field.
-func (m *ExperienceDataMutation) SetEmotion(s string) {
-	m.emotion = &s
+// Type returns the node type of this mutation (ConnectorCursor).
+func (m *ConnectorCursorMutation) Type() string {
+	return m.typ
 }
 
-// Emotion returns the value of the "emotion" field in the mutation.
-func (m *ExperienceDataMutation) Emotion() (r string, exists bool) {
-	v := m.emotion
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConnectorCursorMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, connectorcursor.FieldCreatedAt)
 	}
-	return *v, true
-}
-
-// OldEmotion returns the old "emotion" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldEmotion(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmotion is only allowed on UpdateOne operations")
+	if m.updated_at != nil {
+		fields = append(fields, connectorcursor.FieldUpdatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmotion requires an ID field in the mutation")
+	if m.stream != nil {
+		fields = append(fields, connectorcursor.FieldStream)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmotion: %w", err)
+	if m.cursor != nil {
+		fields = append(fields, connectorcursor.FieldCursor)
 	}
-	return oldValue.Emotion, nil
+	return fields
 }
 
-// ClearEmotion clears the value of the "emotion" field.
-func (m *ExperienceDataMutation) ClearEmotion() {
-	m.emotion = nil
-	m.clearedFields[experiencedata.FieldEmotion] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConnectorCursorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case connectorcursor.FieldCreatedAt:
+		return m.CreatedAt()
+	case connectorcursor.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case connectorcursor.FieldStream:
+		return m.Stream()
+	case connectorcursor.FieldCursor:
+		return m.Cursor()
+	}
+	return nil, false
 }
 
-// EmotionCleared returns if the "emotion" field was cleared in this mutation.
-func (m *ExperienceDataMutation) EmotionCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldEmotion]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConnectorCursorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case connectorcursor.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case connectorcursor.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case connectorcursor.FieldStream:
+		return m.OldStream(ctx)
+	case connectorcursor.FieldCursor:
+		return m.OldCursor(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConnectorCursor field %s", name)
 }
 
-// ResetEmotion resets all changes to the "emotion" field.
-func (m *ExperienceDataMutation) ResetEmotion() {
-	m.emotion = nil
-	delete(m.clearedFields, experiencedata.FieldEmotion)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectorCursorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case connectorcursor.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case connectorcursor.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case connectorcursor.FieldStream:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStream(v)
+		return nil
+	case connectorcursor.FieldCursor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCursor(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectorCursor field %s", name)
 }
 
-// SetTopics sets the "topics" field.
-func (m *ExperienceDataMutation) SetTopics(s []string) {
-	m.topics = &s
-	m.appendtopics = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConnectorCursorMutation) AddedFields() []string {
+	return nil
 }
 
-// Topics returns the value of the "topics" field in the mutation.
-func (m *ExperienceDataMutation) Topics() (r []string, exists bool) {
-	v := m.topics
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConnectorCursorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldTopics returns the old "topics" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldTopics(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTopics is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTopics requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTopics: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConnectorCursorMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Topics, nil
+	return fmt.Errorf("unknown ConnectorCursor numeric field %s", name)
 }
 
-// AppendTopics adds s to the "topics" field.
-func (m *ExperienceDataMutation) AppendTopics(s []string) {
-	m.appendtopics = append(m.appendtopics, s...)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConnectorCursorMutation) ClearedFields() []string {
+	return nil
 }
 
-// AppendedTopics returns the list of values that were appended to the "topics" field in this mutation.
-func (m *ExperienceDataMutation) AppendedTopics() ([]string, bool) {
-	if len(m.appendtopics) == 0 {
-		return nil, false
-	}
-	return m.appendtopics, true
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConnectorCursorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ClearTopics clears the value of the "topics" field.
-func (m *ExperienceDataMutation) ClearTopics() {
-	m.topics = nil
-	m.appendtopics = nil
-	m.clearedFields[experiencedata.FieldTopics] = struct{}{}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConnectorCursorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConnectorCursor nullable field %s", name)
 }
 
-// TopicsCleared returns if the "topics" field was cleared in this mutation.
-func (m *ExperienceDataMutation) TopicsCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldTopics]
-	return ok
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConnectorCursorMutation) ResetField(name string) error {
+	switch name {
+	case connectorcursor.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case connectorcursor.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case connectorcursor.FieldStream:
+		m.ResetStream()
+		return nil
+	case connectorcursor.FieldCursor:
+		m.ResetCursor()
+		return nil
+	}
+	return fmt.Errorf("unknown ConnectorCursor field %s", name)
 }
 
-// ResetTopics resets all changes to the "topics" field.
-func (m *ExperienceDataMutation) ResetTopics() {
-	m.topics = nil
-	m.appendtopics = nil
-	delete(m.clearedFields, experiencedata.FieldTopics)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConnectorCursorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetUserIdentifier sets the "user_identifier" field.
-func (m *ExperienceDataMutation) SetUserIdentifier(s string) {
-	m.user_identifier = &s
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConnectorCursorMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// UserIdentifier returns the value of the "user_identifier" field in the mutation.
-func (m *ExperienceDataMutation) UserIdentifier() (r string, exists bool) {
-	v := m.user_identifier
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConnectorCursorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldUserIdentifier returns the old "user_identifier" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldUserIdentifier(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserIdentifier is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserIdentifier requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserIdentifier: %w", err)
-	}
-	return oldValue.UserIdentifier, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConnectorCursorMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearUserIdentifier clears the value of the "user_identifier" field.
-func (m *ExperienceDataMutation) ClearUserIdentifier() {
-	m.user_identifier = nil
-	m.clearedFields[experiencedata.FieldUserIdentifier] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConnectorCursorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// UserIdentifierCleared returns if the "user_identifier" field was cleared in this mutation.
-func (m *ExperienceDataMutation) UserIdentifierCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldUserIdentifier]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConnectorCursorMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetUserIdentifier resets all changes to the "user_identifier" field.
-func (m *ExperienceDataMutation) ResetUserIdentifier() {
-	m.user_identifier = nil
-	delete(m.clearedFields, experiencedata.FieldUserIdentifier)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConnectorCursorMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConnectorCursor unique edge %s", name)
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *ExperienceDataMutation) SetEmbedding(pg pgvector.Vector) {
-	m.embedding = &pg
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConnectorCursorMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConnectorCursor edge %s", name)
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *ExperienceDataMutation) Embedding() (r pgvector.Vector, exists bool) {
-	v := m.embedding
-	if v == nil {
-		return
+// EnrichmentJobMutation represents an operation that mutates the EnrichmentJob nodes in the graph.
+type EnrichmentJobMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *time.Time
+	job_type             *string
+	status               *string
+	text                 *string
+	error                *string
+	error_history        *[]schema.JobError
+	appenderror_history  []schema.JobError
+	priority             *int
+	addpriority          *int
+	attempts             *int
+	addattempts          *int
+	requeues             *int
+	addrequeues          *int
+	processed_at         *time.Time
+	lease_expires_at     *time.Time
+	prompt_tokens        *int
+	addprompt_tokens     *int
+	completion_tokens    *int
+	addcompletion_tokens *int
+	cost_usd             *float64
+	addcost_usd          *float64
+	trace_context        *string
+	clearedFields        map[string]struct{}
+	experience           *uuid.UUID
+	clearedexperience    bool
+	done                 bool
+	oldValue             func(context.Context) (*EnrichmentJob, error)
+	predicates           []predicate.EnrichmentJob
+}
+
+var _ ent.Mutation = (*EnrichmentJobMutation)(nil)
+
+// enrichmentjobOption allows management of the mutation configuration using functional options.
+type enrichmentjobOption func(*EnrichmentJobMutation)
+
+// newEnrichmentJobMutation creates new mutation for the EnrichmentJob entity.
+func newEnrichmentJobMutation(c config, op Op, opts ...enrichmentjobOption) *EnrichmentJobMutation {
+	m := &EnrichmentJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnrichmentJob,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldEmbedding returns the old "embedding" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldEmbedding(ctx context.Context) (v *pgvector.Vector, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+// withEnrichmentJobID sets the ID field of the mutation.
+func withEnrichmentJobID(id uuid.UUID) enrichmentjobOption {
+	return func(m *EnrichmentJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnrichmentJob
+		)
+		m.oldValue = func(ctx context.Context) (*EnrichmentJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnrichmentJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+}
+
+// withEnrichmentJob sets the old EnrichmentJob of the mutation.
+func withEnrichmentJob(node *EnrichmentJob) enrichmentjobOption {
+	return func(m *EnrichmentJobMutation) {
+		m.oldValue = func(context.Context) (*EnrichmentJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnrichmentJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnrichmentJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Embedding, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearEmbedding clears the value of the "embedding" field.
-func (m *ExperienceDataMutation) ClearEmbedding() {
-	m.embedding = nil
-	m.clearedFields[experiencedata.FieldEmbedding] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EnrichmentJob entities.
+func (m *EnrichmentJobMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
-func (m *ExperienceDataMutation) EmbeddingCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldEmbedding]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnrichmentJobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *ExperienceDataMutation) ResetEmbedding() {
-	m.embedding = nil
-	delete(m.clearedFields, experiencedata.FieldEmbedding)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnrichmentJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnrichmentJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetEmbeddingModel sets the "embedding_model" field.
-func (m *ExperienceDataMutation) SetEmbeddingModel(s string) {
-	m.embedding_model = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *EnrichmentJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
-func (m *ExperienceDataMutation) EmbeddingModel() (r string, exists bool) {
-	v := m.embedding_model
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EnrichmentJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbeddingModel returns the old "embedding_model" field's value of the ExperienceData entity.
-// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExperienceDataMutation) OldEmbeddingModel(ctx context.Context) (v *string, err error) {
+func (m *EnrichmentJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.EmbeddingModel, nil
-}
-
-// ClearEmbeddingModel clears the value of the "embedding_model" field.
-func (m *ExperienceDataMutation) ClearEmbeddingModel() {
-	m.embedding_model = nil
-	m.clearedFields[experiencedata.FieldEmbeddingModel] = struct{}{}
+	return oldValue.CreatedAt, nil
 }
 
-// EmbeddingModelCleared returns if the "embedding_model" field was cleared in this mutation.
-func (m *ExperienceDataMutation) EmbeddingModelCleared() bool {
-	_, ok := m.clearedFields[experiencedata.FieldEmbeddingModel]
-	return ok
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EnrichmentJobMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetEmbeddingModel resets all changes to the "embedding_model" field.
-func (m *ExperienceDataMutation) ResetEmbeddingModel() {
-	m.embedding_model = nil
-	delete(m.clearedFields, experiencedata.FieldEmbeddingModel)
+// SetExperienceID sets the "experience_id" field.
+func (m *EnrichmentJobMutation) SetExperienceID(u uuid.UUID) {
+	m.experience = &u
 }
 
-// Where appends a list predicates to the ExperienceDataMutation builder.
-func (m *ExperienceDataMutation) Where(ps ...predicate.ExperienceData) {
-	m.predicates = append(m.predicates, ps...)
+// ExperienceID returns the value of the "experience_id" field in the mutation.
+func (m *EnrichmentJobMutation) ExperienceID() (r uuid.UUID, exists bool) {
+	v := m.experience
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the ExperienceDataMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExperienceDataMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExperienceData, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldExperienceID returns the old "experience_id" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldExperienceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExperienceID is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExperienceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperienceID: %w", err)
+	}
+	return oldValue.ExperienceID, nil
 }
 
-// Op returns the operation name.
-func (m *ExperienceDataMutation) Op() Op {
-	return m.op
+// ResetExperienceID resets all changes to the "experience_id" field.
+func (m *EnrichmentJobMutation) ResetExperienceID() {
+	m.experience = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ExperienceDataMutation) SetOp(op Op) {
-	m.op = op
+// SetJobType sets the "job_type" field.
+func (m *EnrichmentJobMutation) SetJobType(s string) {
+	m.job_type = &s
 }
 
-// Type returns the node type of this mutation (ExperienceData).
-func (m *ExperienceDataMutation) Type() string {
-	return m.typ
+// JobType returns the value of the "job_type" field in the mutation.
+func (m *EnrichmentJobMutation) JobType() (r string, exists bool) {
+	v := m.job_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ExperienceDataMutation) Fields() []string {
-	fields := make([]string, 0, 23)
-	if m.collected_at != nil {
-		fields = append(fields, experiencedata.FieldCollectedAt)
-	}
-	if m.created_at != nil {
-		fields = append(fields, experiencedata.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, experiencedata.FieldUpdatedAt)
-	}
-	if m.source_type != nil {
-		fields = append(fields, experiencedata.FieldSourceType)
-	}
-	if m.source_id != nil {
-		fields = append(fields, experiencedata.FieldSourceID)
-	}
-	if m.source_name != nil {
-		fields = append(fields, experiencedata.FieldSourceName)
+// OldJobType returns the old "job_type" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldJobType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
 	}
-	if m.field_id != nil {
-		fields = append(fields, experiencedata.FieldFieldID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobType requires an ID field in the mutation")
 	}
-	if m.field_label != nil {
-		fields = append(fields, experiencedata.FieldFieldLabel)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
 	}
-	if m.field_type != nil {
-		fields = append(fields, experiencedata.FieldFieldType)
+	return oldValue.JobType, nil
+}
+
+// ResetJobType resets all changes to the "job_type" field.
+func (m *EnrichmentJobMutation) ResetJobType() {
+	m.job_type = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *EnrichmentJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EnrichmentJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	if m.value_text != nil {
-		fields = append(fields, experiencedata.FieldValueText)
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	if m.value_number != nil {
-		fields = append(fields, experiencedata.FieldValueNumber)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
-	if m.value_boolean != nil {
-		fields = append(fields, experiencedata.FieldValueBoolean)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	if m.value_date != nil {
-		fields = append(fields, experiencedata.FieldValueDate)
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EnrichmentJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetText sets the "text" field.
+func (m *EnrichmentJobMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *EnrichmentJobMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
 	}
-	if m.value_json != nil {
-		fields = append(fields, experiencedata.FieldValueJSON)
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
-	if m.metadata != nil {
-		fields = append(fields, experiencedata.FieldMetadata)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
-	if m.language != nil {
-		fields = append(fields, experiencedata.FieldLanguage)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	if m.sentiment != nil {
-		fields = append(fields, experiencedata.FieldSentiment)
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *EnrichmentJobMutation) ResetText() {
+	m.text = nil
+}
+
+// SetError sets the "error" field.
+func (m *EnrichmentJobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *EnrichmentJobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	if m.sentiment_score != nil {
-		fields = append(fields, experiencedata.FieldSentimentScore)
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	if m.emotion != nil {
-		fields = append(fields, experiencedata.FieldEmotion)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
-	if m.topics != nil {
-		fields = append(fields, experiencedata.FieldTopics)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	if m.user_identifier != nil {
-		fields = append(fields, experiencedata.FieldUserIdentifier)
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *EnrichmentJobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[enrichmentjob.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *EnrichmentJobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, enrichmentjob.FieldError)
+}
+
+// SetErrorHistory sets the "error_history" field.
+func (m *EnrichmentJobMutation) SetErrorHistory(se []schema.JobError) {
+	m.error_history = &se
+	m.appenderror_history = nil
+}
+
+// ErrorHistory returns the value of the "error_history" field in the mutation.
+func (m *EnrichmentJobMutation) ErrorHistory() (r []schema.JobError, exists bool) {
+	v := m.error_history
+	if v == nil {
+		return
 	}
-	if m.embedding != nil {
-		fields = append(fields, experiencedata.FieldEmbedding)
+	return *v, true
+}
+
+// OldErrorHistory returns the old "error_history" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldErrorHistory(ctx context.Context) (v []schema.JobError, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorHistory is only allowed on UpdateOne operations")
 	}
-	if m.embedding_model != nil {
-		fields = append(fields, experiencedata.FieldEmbeddingModel)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorHistory requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorHistory: %w", err)
+	}
+	return oldValue.ErrorHistory, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ExperienceDataMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case experiencedata.FieldCollectedAt:
-		return m.CollectedAt()
-	case experiencedata.FieldCreatedAt:
-		return m.CreatedAt()
-	case experiencedata.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case experiencedata.FieldSourceType:
-		return m.SourceType()
-	case experiencedata.FieldSourceID:
-		return m.SourceID()
-	case experiencedata.FieldSourceName:
-		return m.SourceName()
-	case experiencedata.FieldFieldID:
-		return m.FieldID()
-	case experiencedata.FieldFieldLabel:
-		return m.FieldLabel()
-	case experiencedata.FieldFieldType:
-		return m.FieldType()
-	case experiencedata.FieldValueText:
-		return m.ValueText()
-	case experiencedata.FieldValueNumber:
-		return m.ValueNumber()
-	case experiencedata.FieldValueBoolean:
-		return m.ValueBoolean()
-	case experiencedata.FieldValueDate:
-		return m.ValueDate()
-	case experiencedata.FieldValueJSON:
-		return m.ValueJSON()
-	case experiencedata.FieldMetadata:
-		return m.Metadata()
-	case experiencedata.FieldLanguage:
-		return m.Language()
-	case experiencedata.FieldSentiment:
-		return m.Sentiment()
-	case experiencedata.FieldSentimentScore:
-		return m.SentimentScore()
-	case experiencedata.FieldEmotion:
-		return m.Emotion()
-	case experiencedata.FieldTopics:
-		return m.Topics()
-	case experiencedata.FieldUserIdentifier:
-		return m.UserIdentifier()
-	case experiencedata.FieldEmbedding:
-		return m.Embedding()
-	case experiencedata.FieldEmbeddingModel:
-		return m.EmbeddingModel()
+// AppendErrorHistory adds se to the "error_history" field.
+func (m *EnrichmentJobMutation) AppendErrorHistory(se []schema.JobError) {
+	m.appenderror_history = append(m.appenderror_history, se...)
+}
+
+// AppendedErrorHistory returns the list of values that were appended to the "error_history" field in this mutation.
+func (m *EnrichmentJobMutation) AppendedErrorHistory() ([]schema.JobError, bool) {
+	if len(m.appenderror_history) == 0 {
+		return nil, false
 	}
-	return nil, false
+	return m.appenderror_history, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ExperienceDataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case experiencedata.FieldCollectedAt:
-		return m.OldCollectedAt(ctx)
-	case experiencedata.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case experiencedata.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case experiencedata.FieldSourceType:
-		return m.OldSourceType(ctx)
-	case experiencedata.FieldSourceID:
-		return m.OldSourceID(ctx)
-	case experiencedata.FieldSourceName:
-		return m.OldSourceName(ctx)
-	case experiencedata.FieldFieldID:
-		return m.OldFieldID(ctx)
-	case experiencedata.FieldFieldLabel:
-		return m.OldFieldLabel(ctx)
-	case experiencedata.FieldFieldType:
-		return m.OldFieldType(ctx)
-	case experiencedata.FieldValueText:
-		return m.OldValueText(ctx)
-	case experiencedata.FieldValueNumber:
-		return m.OldValueNumber(ctx)
-	case experiencedata.FieldValueBoolean:
-		return m.OldValueBoolean(ctx)
-	case experiencedata.FieldValueDate:
-		return m.OldValueDate(ctx)
-	case experiencedata.FieldValueJSON:
-		return m.OldValueJSON(ctx)
-	case experiencedata.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case experiencedata.FieldLanguage:
-		return m.OldLanguage(ctx)
-	case experiencedata.FieldSentiment:
-		return m.OldSentiment(ctx)
+// ClearErrorHistory clears the value of the "error_history" field.
+func (m *EnrichmentJobMutation) ClearErrorHistory() {
+	m.error_history = nil
+	m.appenderror_history = nil
+	m.clearedFields[enrichmentjob.FieldErrorHistory] = struct{}{}
+}
+
+// ErrorHistoryCleared returns if the "error_history" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) ErrorHistoryCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldErrorHistory]
+	return ok
+}
+
+// ResetErrorHistory resets all changes to the "error_history" field.
+func (m *EnrichmentJobMutation) ResetErrorHistory() {
+	m.error_history = nil
+	m.appenderror_history = nil
+	delete(m.clearedFields, enrichmentjob.FieldErrorHistory)
+}
+
+// SetPriority sets the "priority" field.
+func (m *EnrichmentJobMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *EnrichmentJobMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *EnrichmentJobMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *EnrichmentJobMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *EnrichmentJobMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *EnrichmentJobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *EnrichmentJobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *EnrichmentJobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *EnrichmentJobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *EnrichmentJobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetRequeues sets the "requeues" field.
+func (m *EnrichmentJobMutation) SetRequeues(i int) {
+	m.requeues = &i
+	m.addrequeues = nil
+}
+
+// Requeues returns the value of the "requeues" field in the mutation.
+func (m *EnrichmentJobMutation) Requeues() (r int, exists bool) {
+	v := m.requeues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequeues returns the old "requeues" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldRequeues(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequeues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequeues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequeues: %w", err)
+	}
+	return oldValue.Requeues, nil
+}
+
+// AddRequeues adds i to the "requeues" field.
+func (m *EnrichmentJobMutation) AddRequeues(i int) {
+	if m.addrequeues != nil {
+		*m.addrequeues += i
+	} else {
+		m.addrequeues = &i
+	}
+}
+
+// AddedRequeues returns the value that was added to the "requeues" field in this mutation.
+func (m *EnrichmentJobMutation) AddedRequeues() (r int, exists bool) {
+	v := m.addrequeues
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequeues resets all changes to the "requeues" field.
+func (m *EnrichmentJobMutation) ResetRequeues() {
+	m.requeues = nil
+	m.addrequeues = nil
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (m *EnrichmentJobMutation) SetProcessedAt(t time.Time) {
+	m.processed_at = &t
+}
+
+// ProcessedAt returns the value of the "processed_at" field in the mutation.
+func (m *EnrichmentJobMutation) ProcessedAt() (r time.Time, exists bool) {
+	v := m.processed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedAt returns the old "processed_at" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+	}
+	return oldValue.ProcessedAt, nil
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (m *EnrichmentJobMutation) ClearProcessedAt() {
+	m.processed_at = nil
+	m.clearedFields[enrichmentjob.FieldProcessedAt] = struct{}{}
+}
+
+// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) ProcessedAtCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldProcessedAt]
+	return ok
+}
+
+// ResetProcessedAt resets all changes to the "processed_at" field.
+func (m *EnrichmentJobMutation) ResetProcessedAt() {
+	m.processed_at = nil
+	delete(m.clearedFields, enrichmentjob.FieldProcessedAt)
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *EnrichmentJobMutation) SetLeaseExpiresAt(t time.Time) {
+	m.lease_expires_at = &t
+}
+
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *EnrichmentJobMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.lease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldLeaseExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *EnrichmentJobMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.clearedFields[enrichmentjob.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *EnrichmentJobMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	delete(m.clearedFields, enrichmentjob.FieldLeaseExpiresAt)
+}
+
+// SetPromptTokens sets the "prompt_tokens" field.
+func (m *EnrichmentJobMutation) SetPromptTokens(i int) {
+	m.prompt_tokens = &i
+	m.addprompt_tokens = nil
+}
+
+// PromptTokens returns the value of the "prompt_tokens" field in the mutation.
+func (m *EnrichmentJobMutation) PromptTokens() (r int, exists bool) {
+	v := m.prompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPromptTokens returns the old "prompt_tokens" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldPromptTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPromptTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPromptTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPromptTokens: %w", err)
+	}
+	return oldValue.PromptTokens, nil
+}
+
+// AddPromptTokens adds i to the "prompt_tokens" field.
+func (m *EnrichmentJobMutation) AddPromptTokens(i int) {
+	if m.addprompt_tokens != nil {
+		*m.addprompt_tokens += i
+	} else {
+		m.addprompt_tokens = &i
+	}
+}
+
+// AddedPromptTokens returns the value that was added to the "prompt_tokens" field in this mutation.
+func (m *EnrichmentJobMutation) AddedPromptTokens() (r int, exists bool) {
+	v := m.addprompt_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPromptTokens clears the value of the "prompt_tokens" field.
+func (m *EnrichmentJobMutation) ClearPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	m.clearedFields[enrichmentjob.FieldPromptTokens] = struct{}{}
+}
+
+// PromptTokensCleared returns if the "prompt_tokens" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) PromptTokensCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldPromptTokens]
+	return ok
+}
+
+// ResetPromptTokens resets all changes to the "prompt_tokens" field.
+func (m *EnrichmentJobMutation) ResetPromptTokens() {
+	m.prompt_tokens = nil
+	m.addprompt_tokens = nil
+	delete(m.clearedFields, enrichmentjob.FieldPromptTokens)
+}
+
+// SetCompletionTokens sets the "completion_tokens" field.
+func (m *EnrichmentJobMutation) SetCompletionTokens(i int) {
+	m.completion_tokens = &i
+	m.addcompletion_tokens = nil
+}
+
+// CompletionTokens returns the value of the "completion_tokens" field in the mutation.
+func (m *EnrichmentJobMutation) CompletionTokens() (r int, exists bool) {
+	v := m.completion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletionTokens returns the old "completion_tokens" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldCompletionTokens(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTokens: %w", err)
+	}
+	return oldValue.CompletionTokens, nil
+}
+
+// AddCompletionTokens adds i to the "completion_tokens" field.
+func (m *EnrichmentJobMutation) AddCompletionTokens(i int) {
+	if m.addcompletion_tokens != nil {
+		*m.addcompletion_tokens += i
+	} else {
+		m.addcompletion_tokens = &i
+	}
+}
+
+// AddedCompletionTokens returns the value that was added to the "completion_tokens" field in this mutation.
+func (m *EnrichmentJobMutation) AddedCompletionTokens() (r int, exists bool) {
+	v := m.addcompletion_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompletionTokens clears the value of the "completion_tokens" field.
+func (m *EnrichmentJobMutation) ClearCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+	m.clearedFields[enrichmentjob.FieldCompletionTokens] = struct{}{}
+}
+
+// CompletionTokensCleared returns if the "completion_tokens" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) CompletionTokensCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldCompletionTokens]
+	return ok
+}
+
+// ResetCompletionTokens resets all changes to the "completion_tokens" field.
+func (m *EnrichmentJobMutation) ResetCompletionTokens() {
+	m.completion_tokens = nil
+	m.addcompletion_tokens = nil
+	delete(m.clearedFields, enrichmentjob.FieldCompletionTokens)
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (m *EnrichmentJobMutation) SetCostUsd(f float64) {
+	m.cost_usd = &f
+	m.addcost_usd = nil
+}
+
+// CostUsd returns the value of the "cost_usd" field in the mutation.
+func (m *EnrichmentJobMutation) CostUsd() (r float64, exists bool) {
+	v := m.cost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCostUsd returns the old "cost_usd" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldCostUsd(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostUsd: %w", err)
+	}
+	return oldValue.CostUsd, nil
+}
+
+// AddCostUsd adds f to the "cost_usd" field.
+func (m *EnrichmentJobMutation) AddCostUsd(f float64) {
+	if m.addcost_usd != nil {
+		*m.addcost_usd += f
+	} else {
+		m.addcost_usd = &f
+	}
+}
+
+// AddedCostUsd returns the value that was added to the "cost_usd" field in this mutation.
+func (m *EnrichmentJobMutation) AddedCostUsd() (r float64, exists bool) {
+	v := m.addcost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCostUsd clears the value of the "cost_usd" field.
+func (m *EnrichmentJobMutation) ClearCostUsd() {
+	m.cost_usd = nil
+	m.addcost_usd = nil
+	m.clearedFields[enrichmentjob.FieldCostUsd] = struct{}{}
+}
+
+// CostUsdCleared returns if the "cost_usd" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) CostUsdCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldCostUsd]
+	return ok
+}
+
+// ResetCostUsd resets all changes to the "cost_usd" field.
+func (m *EnrichmentJobMutation) ResetCostUsd() {
+	m.cost_usd = nil
+	m.addcost_usd = nil
+	delete(m.clearedFields, enrichmentjob.FieldCostUsd)
+}
+
+// SetTraceContext sets the "trace_context" field.
+func (m *EnrichmentJobMutation) SetTraceContext(s string) {
+	m.trace_context = &s
+}
+
+// TraceContext returns the value of the "trace_context" field in the mutation.
+func (m *EnrichmentJobMutation) TraceContext() (r string, exists bool) {
+	v := m.trace_context
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTraceContext returns the old "trace_context" field's value of the EnrichmentJob entity.
+// If the EnrichmentJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrichmentJobMutation) OldTraceContext(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTraceContext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTraceContext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTraceContext: %w", err)
+	}
+	return oldValue.TraceContext, nil
+}
+
+// ClearTraceContext clears the value of the "trace_context" field.
+func (m *EnrichmentJobMutation) ClearTraceContext() {
+	m.trace_context = nil
+	m.clearedFields[enrichmentjob.FieldTraceContext] = struct{}{}
+}
+
+// TraceContextCleared returns if the "trace_context" field was cleared in this mutation.
+func (m *EnrichmentJobMutation) TraceContextCleared() bool {
+	_, ok := m.clearedFields[enrichmentjob.FieldTraceContext]
+	return ok
+}
+
+// ResetTraceContext resets all changes to the "trace_context" field.
+func (m *EnrichmentJobMutation) ResetTraceContext() {
+	m.trace_context = nil
+	delete(m.clearedFields, enrichmentjob.FieldTraceContext)
+}
+
+// ClearExperience clears the "experience" edge to the ExperienceData entity.
+func (m *EnrichmentJobMutation) ClearExperience() {
+	m.clearedexperience = true
+	m.clearedFields[enrichmentjob.FieldExperienceID] = struct{}{}
+}
+
+// ExperienceCleared reports if the "experience" edge to the ExperienceData entity was cleared.
+func (m *EnrichmentJobMutation) ExperienceCleared() bool {
+	return m.clearedexperience
+}
+
+// ExperienceIDs returns the "experience" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExperienceID instead. It exists only for internal usage by the builders.
+func (m *EnrichmentJobMutation) ExperienceIDs() (ids []uuid.UUID) {
+	if id := m.experience; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExperience resets all changes to the "experience" edge.
+func (m *EnrichmentJobMutation) ResetExperience() {
+	m.experience = nil
+	m.clearedexperience = false
+}
+
+// Where appends a list predicates to the EnrichmentJobMutation builder.
+func (m *EnrichmentJobMutation) Where(ps ...predicate.EnrichmentJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnrichmentJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnrichmentJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnrichmentJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnrichmentJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnrichmentJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnrichmentJob).
+func (m *EnrichmentJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnrichmentJobMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.created_at != nil {
+		fields = append(fields, enrichmentjob.FieldCreatedAt)
+	}
+	if m.experience != nil {
+		fields = append(fields, enrichmentjob.FieldExperienceID)
+	}
+	if m.job_type != nil {
+		fields = append(fields, enrichmentjob.FieldJobType)
+	}
+	if m.status != nil {
+		fields = append(fields, enrichmentjob.FieldStatus)
+	}
+	if m.text != nil {
+		fields = append(fields, enrichmentjob.FieldText)
+	}
+	if m.error != nil {
+		fields = append(fields, enrichmentjob.FieldError)
+	}
+	if m.error_history != nil {
+		fields = append(fields, enrichmentjob.FieldErrorHistory)
+	}
+	if m.priority != nil {
+		fields = append(fields, enrichmentjob.FieldPriority)
+	}
+	if m.attempts != nil {
+		fields = append(fields, enrichmentjob.FieldAttempts)
+	}
+	if m.requeues != nil {
+		fields = append(fields, enrichmentjob.FieldRequeues)
+	}
+	if m.processed_at != nil {
+		fields = append(fields, enrichmentjob.FieldProcessedAt)
+	}
+	if m.lease_expires_at != nil {
+		fields = append(fields, enrichmentjob.FieldLeaseExpiresAt)
+	}
+	if m.prompt_tokens != nil {
+		fields = append(fields, enrichmentjob.FieldPromptTokens)
+	}
+	if m.completion_tokens != nil {
+		fields = append(fields, enrichmentjob.FieldCompletionTokens)
+	}
+	if m.cost_usd != nil {
+		fields = append(fields, enrichmentjob.FieldCostUsd)
+	}
+	if m.trace_context != nil {
+		fields = append(fields, enrichmentjob.FieldTraceContext)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnrichmentJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case enrichmentjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case enrichmentjob.FieldExperienceID:
+		return m.ExperienceID()
+	case enrichmentjob.FieldJobType:
+		return m.JobType()
+	case enrichmentjob.FieldStatus:
+		return m.Status()
+	case enrichmentjob.FieldText:
+		return m.Text()
+	case enrichmentjob.FieldError:
+		return m.Error()
+	case enrichmentjob.FieldErrorHistory:
+		return m.ErrorHistory()
+	case enrichmentjob.FieldPriority:
+		return m.Priority()
+	case enrichmentjob.FieldAttempts:
+		return m.Attempts()
+	case enrichmentjob.FieldRequeues:
+		return m.Requeues()
+	case enrichmentjob.FieldProcessedAt:
+		return m.ProcessedAt()
+	case enrichmentjob.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	case enrichmentjob.FieldPromptTokens:
+		return m.PromptTokens()
+	case enrichmentjob.FieldCompletionTokens:
+		return m.CompletionTokens()
+	case enrichmentjob.FieldCostUsd:
+		return m.CostUsd()
+	case enrichmentjob.FieldTraceContext:
+		return m.TraceContext()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnrichmentJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case enrichmentjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case enrichmentjob.FieldExperienceID:
+		return m.OldExperienceID(ctx)
+	case enrichmentjob.FieldJobType:
+		return m.OldJobType(ctx)
+	case enrichmentjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case enrichmentjob.FieldText:
+		return m.OldText(ctx)
+	case enrichmentjob.FieldError:
+		return m.OldError(ctx)
+	case enrichmentjob.FieldErrorHistory:
+		return m.OldErrorHistory(ctx)
+	case enrichmentjob.FieldPriority:
+		return m.OldPriority(ctx)
+	case enrichmentjob.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case enrichmentjob.FieldRequeues:
+		return m.OldRequeues(ctx)
+	case enrichmentjob.FieldProcessedAt:
+		return m.OldProcessedAt(ctx)
+	case enrichmentjob.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	case enrichmentjob.FieldPromptTokens:
+		return m.OldPromptTokens(ctx)
+	case enrichmentjob.FieldCompletionTokens:
+		return m.OldCompletionTokens(ctx)
+	case enrichmentjob.FieldCostUsd:
+		return m.OldCostUsd(ctx)
+	case enrichmentjob.FieldTraceContext:
+		return m.OldTraceContext(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnrichmentJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrichmentJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case enrichmentjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case enrichmentjob.FieldExperienceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperienceID(v)
+		return nil
+	case enrichmentjob.FieldJobType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobType(v)
+		return nil
+	case enrichmentjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case enrichmentjob.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case enrichmentjob.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case enrichmentjob.FieldErrorHistory:
+		v, ok := value.([]schema.JobError)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorHistory(v)
+		return nil
+	case enrichmentjob.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case enrichmentjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case enrichmentjob.FieldRequeues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequeues(v)
+		return nil
+	case enrichmentjob.FieldProcessedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedAt(v)
+		return nil
+	case enrichmentjob.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	case enrichmentjob.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPromptTokens(v)
+		return nil
+	case enrichmentjob.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTokens(v)
+		return nil
+	case enrichmentjob.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostUsd(v)
+		return nil
+	case enrichmentjob.FieldTraceContext:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTraceContext(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnrichmentJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnrichmentJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, enrichmentjob.FieldPriority)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, enrichmentjob.FieldAttempts)
+	}
+	if m.addrequeues != nil {
+		fields = append(fields, enrichmentjob.FieldRequeues)
+	}
+	if m.addprompt_tokens != nil {
+		fields = append(fields, enrichmentjob.FieldPromptTokens)
+	}
+	if m.addcompletion_tokens != nil {
+		fields = append(fields, enrichmentjob.FieldCompletionTokens)
+	}
+	if m.addcost_usd != nil {
+		fields = append(fields, enrichmentjob.FieldCostUsd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnrichmentJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case enrichmentjob.FieldPriority:
+		return m.AddedPriority()
+	case enrichmentjob.FieldAttempts:
+		return m.AddedAttempts()
+	case enrichmentjob.FieldRequeues:
+		return m.AddedRequeues()
+	case enrichmentjob.FieldPromptTokens:
+		return m.AddedPromptTokens()
+	case enrichmentjob.FieldCompletionTokens:
+		return m.AddedCompletionTokens()
+	case enrichmentjob.FieldCostUsd:
+		return m.AddedCostUsd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrichmentJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case enrichmentjob.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case enrichmentjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case enrichmentjob.FieldRequeues:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequeues(v)
+		return nil
+	case enrichmentjob.FieldPromptTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPromptTokens(v)
+		return nil
+	case enrichmentjob.FieldCompletionTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompletionTokens(v)
+		return nil
+	case enrichmentjob.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostUsd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnrichmentJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnrichmentJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(enrichmentjob.FieldError) {
+		fields = append(fields, enrichmentjob.FieldError)
+	}
+	if m.FieldCleared(enrichmentjob.FieldErrorHistory) {
+		fields = append(fields, enrichmentjob.FieldErrorHistory)
+	}
+	if m.FieldCleared(enrichmentjob.FieldProcessedAt) {
+		fields = append(fields, enrichmentjob.FieldProcessedAt)
+	}
+	if m.FieldCleared(enrichmentjob.FieldLeaseExpiresAt) {
+		fields = append(fields, enrichmentjob.FieldLeaseExpiresAt)
+	}
+	if m.FieldCleared(enrichmentjob.FieldPromptTokens) {
+		fields = append(fields, enrichmentjob.FieldPromptTokens)
+	}
+	if m.FieldCleared(enrichmentjob.FieldCompletionTokens) {
+		fields = append(fields, enrichmentjob.FieldCompletionTokens)
+	}
+	if m.FieldCleared(enrichmentjob.FieldCostUsd) {
+		fields = append(fields, enrichmentjob.FieldCostUsd)
+	}
+	if m.FieldCleared(enrichmentjob.FieldTraceContext) {
+		fields = append(fields, enrichmentjob.FieldTraceContext)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnrichmentJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnrichmentJobMutation) ClearField(name string) error {
+	switch name {
+	case enrichmentjob.FieldError:
+		m.ClearError()
+		return nil
+	case enrichmentjob.FieldErrorHistory:
+		m.ClearErrorHistory()
+		return nil
+	case enrichmentjob.FieldProcessedAt:
+		m.ClearProcessedAt()
+		return nil
+	case enrichmentjob.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	case enrichmentjob.FieldPromptTokens:
+		m.ClearPromptTokens()
+		return nil
+	case enrichmentjob.FieldCompletionTokens:
+		m.ClearCompletionTokens()
+		return nil
+	case enrichmentjob.FieldCostUsd:
+		m.ClearCostUsd()
+		return nil
+	case enrichmentjob.FieldTraceContext:
+		m.ClearTraceContext()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrichmentJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnrichmentJobMutation) ResetField(name string) error {
+	switch name {
+	case enrichmentjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case enrichmentjob.FieldExperienceID:
+		m.ResetExperienceID()
+		return nil
+	case enrichmentjob.FieldJobType:
+		m.ResetJobType()
+		return nil
+	case enrichmentjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case enrichmentjob.FieldText:
+		m.ResetText()
+		return nil
+	case enrichmentjob.FieldError:
+		m.ResetError()
+		return nil
+	case enrichmentjob.FieldErrorHistory:
+		m.ResetErrorHistory()
+		return nil
+	case enrichmentjob.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case enrichmentjob.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case enrichmentjob.FieldRequeues:
+		m.ResetRequeues()
+		return nil
+	case enrichmentjob.FieldProcessedAt:
+		m.ResetProcessedAt()
+		return nil
+	case enrichmentjob.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	case enrichmentjob.FieldPromptTokens:
+		m.ResetPromptTokens()
+		return nil
+	case enrichmentjob.FieldCompletionTokens:
+		m.ResetCompletionTokens()
+		return nil
+	case enrichmentjob.FieldCostUsd:
+		m.ResetCostUsd()
+		return nil
+	case enrichmentjob.FieldTraceContext:
+		m.ResetTraceContext()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrichmentJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnrichmentJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.experience != nil {
+		edges = append(edges, enrichmentjob.EdgeExperience)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnrichmentJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case enrichmentjob.EdgeExperience:
+		if id := m.experience; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnrichmentJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnrichmentJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnrichmentJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedexperience {
+		edges = append(edges, enrichmentjob.EdgeExperience)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnrichmentJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case enrichmentjob.EdgeExperience:
+		return m.clearedexperience
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnrichmentJobMutation) ClearEdge(name string) error {
+	switch name {
+	case enrichmentjob.EdgeExperience:
+		m.ClearExperience()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrichmentJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnrichmentJobMutation) ResetEdge(name string) error {
+	switch name {
+	case enrichmentjob.EdgeExperience:
+		m.ResetExperience()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrichmentJob edge %s", name)
+}
+
+// ExperienceDataMutation represents an operation that mutates the ExperienceData nodes in the graph.
+type ExperienceDataMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	created_at            *time.Time
+	updated_at            *time.Time
+	collected_at          *time.Time
+	source_type           *string
+	source_id             *string
+	source_name           *string
+	field_id              *string
+	field_label           *string
+	field_type            *string
+	value_text            *string
+	value_number          *float64
+	addvalue_number       *float64
+	value_boolean         *bool
+	value_date            *time.Time
+	value_json            *map[string]interface{}
+	nps_category          *string
+	metadata              *map[string]interface{}
+	country               *string
+	region                *string
+	device                *string
+	platform              *string
+	app_version           *string
+	language              *string
+	translations          *map[string]schema.Translation
+	sentiment             *string
+	sentiment_score       *float64
+	addsentiment_score    *float64
+	emotion               *string
+	topics                *[]string
+	appendtopics          []string
+	is_spam               *bool
+	spam_confidence       *float64
+	addspam_confidence    *float64
+	urgency_score         *float64
+	addurgency_score      *float64
+	urgency_reasons       *[]string
+	appendurgency_reasons []string
+	enrichment_provider   *string
+	enrichment_model      *string
+	enrichment_version    *int
+	addenrichment_version *int
+	enrichment_attributes *map[string]interface{}
+	skip_ai_processing    *bool
+	ai_input_hash         *string
+	user_identifier       *string
+	content_hash          *string
+	duplicate_of          *uuid.UUID
+	idempotency_key       *string
+	embedding             *pgvector.Vector
+	embedding_model       *string
+	clearedFields         map[string]struct{}
+	question              *uuid.UUID
+	clearedquestion       bool
+	done                  bool
+	oldValue              func(context.Context) (*ExperienceData, error)
+	predicates            []predicate.ExperienceData
+}
+
+var _ ent.Mutation = (*ExperienceDataMutation)(nil)
+
+// experiencedataOption allows management of the mutation configuration using functional options.
+type experiencedataOption func(*ExperienceDataMutation)
+
+// newExperienceDataMutation creates new mutation for the ExperienceData entity.
+func newExperienceDataMutation(c config, op Op, opts ...experiencedataOption) *ExperienceDataMutation {
+	m := &ExperienceDataMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExperienceData,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExperienceDataID sets the ID field of the mutation.
+func withExperienceDataID(id uuid.UUID) experiencedataOption {
+	return func(m *ExperienceDataMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExperienceData
+		)
+		m.oldValue = func(ctx context.Context) (*ExperienceData, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExperienceData.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExperienceData sets the old ExperienceData of the mutation.
+func withExperienceData(node *ExperienceData) experiencedataOption {
+	return func(m *ExperienceDataMutation) {
+		m.oldValue = func(context.Context) (*ExperienceData, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExperienceDataMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExperienceDataMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExperienceData entities.
+func (m *ExperienceDataMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExperienceDataMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExperienceDataMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExperienceData.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExperienceDataMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExperienceDataMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExperienceDataMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExperienceDataMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExperienceDataMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExperienceDataMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCollectedAt sets the "collected_at" field.
+func (m *ExperienceDataMutation) SetCollectedAt(t time.Time) {
+	m.collected_at = &t
+}
+
+// CollectedAt returns the value of the "collected_at" field in the mutation.
+func (m *ExperienceDataMutation) CollectedAt() (r time.Time, exists bool) {
+	v := m.collected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectedAt returns the old "collected_at" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldCollectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectedAt: %w", err)
+	}
+	return oldValue.CollectedAt, nil
+}
+
+// ResetCollectedAt resets all changes to the "collected_at" field.
+func (m *ExperienceDataMutation) ResetCollectedAt() {
+	m.collected_at = nil
+}
+
+// SetSourceType sets the "source_type" field.
+func (m *ExperienceDataMutation) SetSourceType(s string) {
+	m.source_type = &s
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *ExperienceDataMutation) SourceType() (r string, exists bool) {
+	v := m.source_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceType returns the old "source_type" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldSourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+	}
+	return oldValue.SourceType, nil
+}
+
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *ExperienceDataMutation) ResetSourceType() {
+	m.source_type = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *ExperienceDataMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *ExperienceDataMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ClearSourceID clears the value of the "source_id" field.
+func (m *ExperienceDataMutation) ClearSourceID() {
+	m.source_id = nil
+	m.clearedFields[experiencedata.FieldSourceID] = struct{}{}
+}
+
+// SourceIDCleared returns if the "source_id" field was cleared in this mutation.
+func (m *ExperienceDataMutation) SourceIDCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldSourceID]
+	return ok
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *ExperienceDataMutation) ResetSourceID() {
+	m.source_id = nil
+	delete(m.clearedFields, experiencedata.FieldSourceID)
+}
+
+// SetSourceName sets the "source_name" field.
+func (m *ExperienceDataMutation) SetSourceName(s string) {
+	m.source_name = &s
+}
+
+// SourceName returns the value of the "source_name" field in the mutation.
+func (m *ExperienceDataMutation) SourceName() (r string, exists bool) {
+	v := m.source_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceName returns the old "source_name" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldSourceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceName: %w", err)
+	}
+	return oldValue.SourceName, nil
+}
+
+// ClearSourceName clears the value of the "source_name" field.
+func (m *ExperienceDataMutation) ClearSourceName() {
+	m.source_name = nil
+	m.clearedFields[experiencedata.FieldSourceName] = struct{}{}
+}
+
+// SourceNameCleared returns if the "source_name" field was cleared in this mutation.
+func (m *ExperienceDataMutation) SourceNameCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldSourceName]
+	return ok
+}
+
+// ResetSourceName resets all changes to the "source_name" field.
+func (m *ExperienceDataMutation) ResetSourceName() {
+	m.source_name = nil
+	delete(m.clearedFields, experiencedata.FieldSourceName)
+}
+
+// SetFieldID sets the "field_id" field.
+func (m *ExperienceDataMutation) SetFieldID(s string) {
+	m.field_id = &s
+}
+
+// FieldID returns the value of the "field_id" field in the mutation.
+func (m *ExperienceDataMutation) FieldID() (r string, exists bool) {
+	v := m.field_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldID returns the old "field_id" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldFieldID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldID: %w", err)
+	}
+	return oldValue.FieldID, nil
+}
+
+// ResetFieldID resets all changes to the "field_id" field.
+func (m *ExperienceDataMutation) ResetFieldID() {
+	m.field_id = nil
+}
+
+// SetFieldLabel sets the "field_label" field.
+func (m *ExperienceDataMutation) SetFieldLabel(s string) {
+	m.field_label = &s
+}
+
+// FieldLabel returns the value of the "field_label" field in the mutation.
+func (m *ExperienceDataMutation) FieldLabel() (r string, exists bool) {
+	v := m.field_label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldLabel returns the old "field_label" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldFieldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldLabel: %w", err)
+	}
+	return oldValue.FieldLabel, nil
+}
+
+// ClearFieldLabel clears the value of the "field_label" field.
+func (m *ExperienceDataMutation) ClearFieldLabel() {
+	m.field_label = nil
+	m.clearedFields[experiencedata.FieldFieldLabel] = struct{}{}
+}
+
+// FieldLabelCleared returns if the "field_label" field was cleared in this mutation.
+func (m *ExperienceDataMutation) FieldLabelCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldFieldLabel]
+	return ok
+}
+
+// ResetFieldLabel resets all changes to the "field_label" field.
+func (m *ExperienceDataMutation) ResetFieldLabel() {
+	m.field_label = nil
+	delete(m.clearedFields, experiencedata.FieldFieldLabel)
+}
+
+// SetQuestionID sets the "question_id" field.
+func (m *ExperienceDataMutation) SetQuestionID(u uuid.UUID) {
+	m.question = &u
+}
+
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *ExperienceDataMutation) QuestionID() (r uuid.UUID, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionID returns the old "question_id" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldQuestionID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+	}
+	return oldValue.QuestionID, nil
+}
+
+// ClearQuestionID clears the value of the "question_id" field.
+func (m *ExperienceDataMutation) ClearQuestionID() {
+	m.question = nil
+	m.clearedFields[experiencedata.FieldQuestionID] = struct{}{}
+}
+
+// QuestionIDCleared returns if the "question_id" field was cleared in this mutation.
+func (m *ExperienceDataMutation) QuestionIDCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldQuestionID]
+	return ok
+}
+
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *ExperienceDataMutation) ResetQuestionID() {
+	m.question = nil
+	delete(m.clearedFields, experiencedata.FieldQuestionID)
+}
+
+// SetFieldType sets the "field_type" field.
+func (m *ExperienceDataMutation) SetFieldType(s string) {
+	m.field_type = &s
+}
+
+// FieldType returns the value of the "field_type" field in the mutation.
+func (m *ExperienceDataMutation) FieldType() (r string, exists bool) {
+	v := m.field_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldType returns the old "field_type" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldFieldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldType: %w", err)
+	}
+	return oldValue.FieldType, nil
+}
+
+// ResetFieldType resets all changes to the "field_type" field.
+func (m *ExperienceDataMutation) ResetFieldType() {
+	m.field_type = nil
+}
+
+// SetValueText sets the "value_text" field.
+func (m *ExperienceDataMutation) SetValueText(s string) {
+	m.value_text = &s
+}
+
+// ValueText returns the value of the "value_text" field in the mutation.
+func (m *ExperienceDataMutation) ValueText() (r string, exists bool) {
+	v := m.value_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValueText returns the old "value_text" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldValueText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValueText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValueText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValueText: %w", err)
+	}
+	return oldValue.ValueText, nil
+}
+
+// ClearValueText clears the value of the "value_text" field.
+func (m *ExperienceDataMutation) ClearValueText() {
+	m.value_text = nil
+	m.clearedFields[experiencedata.FieldValueText] = struct{}{}
+}
+
+// ValueTextCleared returns if the "value_text" field was cleared in this mutation.
+func (m *ExperienceDataMutation) ValueTextCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldValueText]
+	return ok
+}
+
+// ResetValueText resets all changes to the "value_text" field.
+func (m *ExperienceDataMutation) ResetValueText() {
+	m.value_text = nil
+	delete(m.clearedFields, experiencedata.FieldValueText)
+}
+
+// SetValueNumber sets the "value_number" field.
+func (m *ExperienceDataMutation) SetValueNumber(f float64) {
+	m.value_number = &f
+	m.addvalue_number = nil
+}
+
+// ValueNumber returns the value of the "value_number" field in the mutation.
+func (m *ExperienceDataMutation) ValueNumber() (r float64, exists bool) {
+	v := m.value_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValueNumber returns the old "value_number" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldValueNumber(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValueNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValueNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValueNumber: %w", err)
+	}
+	return oldValue.ValueNumber, nil
+}
+
+// AddValueNumber adds f to the "value_number" field.
+func (m *ExperienceDataMutation) AddValueNumber(f float64) {
+	if m.addvalue_number != nil {
+		*m.addvalue_number += f
+	} else {
+		m.addvalue_number = &f
+	}
+}
+
+// AddedValueNumber returns the value that was added to the "value_number" field in this mutation.
+func (m *ExperienceDataMutation) AddedValueNumber() (r float64, exists bool) {
+	v := m.addvalue_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearValueNumber clears the value of the "value_number" field.
+func (m *ExperienceDataMutation) ClearValueNumber() {
+	m.value_number = nil
+	m.addvalue_number = nil
+	m.clearedFields[experiencedata.FieldValueNumber] = struct{}{}
+}
+
+// ValueNumberCleared returns if the "value_number" field was cleared in this mutation.
+func (m *ExperienceDataMutation) ValueNumberCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldValueNumber]
+	return ok
+}
+
+// ResetValueNumber resets all changes to the "value_number" field.
+func (m *ExperienceDataMutation) ResetValueNumber() {
+	m.value_number = nil
+	m.addvalue_number = nil
+	delete(m.clearedFields, experiencedata.FieldValueNumber)
+}
+
+// SetValueBoolean sets the "value_boolean" field.
+func (m *ExperienceDataMutation) SetValueBoolean(b bool) {
+	m.value_boolean = &b
+}
+
+// ValueBoolean returns the value of the "value_boolean" field in the mutation.
+func (m *ExperienceDataMutation) ValueBoolean() (r bool, exists bool) {
+	v := m.value_boolean
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValueBoolean returns the old "value_boolean" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldValueBoolean(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValueBoolean is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValueBoolean requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValueBoolean: %w", err)
+	}
+	return oldValue.ValueBoolean, nil
+}
+
+// ClearValueBoolean clears the value of the "value_boolean" field.
+func (m *ExperienceDataMutation) ClearValueBoolean() {
+	m.value_boolean = nil
+	m.clearedFields[experiencedata.FieldValueBoolean] = struct{}{}
+}
+
+// ValueBooleanCleared returns if the "value_boolean" field was cleared in this mutation.
+func (m *ExperienceDataMutation) ValueBooleanCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldValueBoolean]
+	return ok
+}
+
+// ResetValueBoolean resets all changes to the "value_boolean" field.
+func (m *ExperienceDataMutation) ResetValueBoolean() {
+	m.value_boolean = nil
+	delete(m.clearedFields, experiencedata.FieldValueBoolean)
+}
+
+// SetValueDate sets the "value_date" field.
+func (m *ExperienceDataMutation) SetValueDate(t time.Time) {
+	m.value_date = &t
+}
+
+// ValueDate returns the value of the "value_date" field in the mutation.
+func (m *ExperienceDataMutation) ValueDate() (r time.Time, exists bool) {
+	v := m.value_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValueDate returns the old "value_date" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldValueDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValueDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValueDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValueDate: %w", err)
+	}
+	return oldValue.ValueDate, nil
+}
+
+// ClearValueDate clears the value of the "value_date" field.
+func (m *ExperienceDataMutation) ClearValueDate() {
+	m.value_date = nil
+	m.clearedFields[experiencedata.FieldValueDate] = struct{}{}
+}
+
+// ValueDateCleared returns if the "value_date" field was cleared in this mutation.
+func (m *ExperienceDataMutation) ValueDateCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldValueDate]
+	return ok
+}
+
+// ResetValueDate resets all changes to the "value_date" field.
+func (m *ExperienceDataMutation) ResetValueDate() {
+	m.value_date = nil
+	delete(m.clearedFields, experiencedata.FieldValueDate)
+}
+
+// SetValueJSON sets the "value_json" field.
+func (m *ExperienceDataMutation) SetValueJSON(value map[string]interface{}) {
+	m.value_json = &value
+}
+
+// ValueJSON returns the value of the "value_json" field in the mutation.
+func (m *ExperienceDataMutation) ValueJSON() (r map[string]interface{}, exists bool) {
+	v := m.value_json
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValueJSON returns the old "value_json" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldValueJSON(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValueJSON is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValueJSON requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValueJSON: %w", err)
+	}
+	return oldValue.ValueJSON, nil
+}
+
+// ClearValueJSON clears the value of the "value_json" field.
+func (m *ExperienceDataMutation) ClearValueJSON() {
+	m.value_json = nil
+	m.clearedFields[experiencedata.FieldValueJSON] = struct{}{}
+}
+
+// ValueJSONCleared returns if the "value_json" field was cleared in this mutation.
+func (m *ExperienceDataMutation) ValueJSONCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldValueJSON]
+	return ok
+}
+
+// ResetValueJSON resets all changes to the "value_json" field.
+func (m *ExperienceDataMutation) ResetValueJSON() {
+	m.value_json = nil
+	delete(m.clearedFields, experiencedata.FieldValueJSON)
+}
+
+// SetNpsCategory sets the "nps_category" field.
+func (m *ExperienceDataMutation) SetNpsCategory(s string) {
+	m.nps_category = &s
+}
+
+// NpsCategory returns the value of the "nps_category" field in the mutation.
+func (m *ExperienceDataMutation) NpsCategory() (r string, exists bool) {
+	v := m.nps_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNpsCategory returns the old "nps_category" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldNpsCategory(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNpsCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNpsCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNpsCategory: %w", err)
+	}
+	return oldValue.NpsCategory, nil
+}
+
+// ClearNpsCategory clears the value of the "nps_category" field.
+func (m *ExperienceDataMutation) ClearNpsCategory() {
+	m.nps_category = nil
+	m.clearedFields[experiencedata.FieldNpsCategory] = struct{}{}
+}
+
+// NpsCategoryCleared returns if the "nps_category" field was cleared in this mutation.
+func (m *ExperienceDataMutation) NpsCategoryCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldNpsCategory]
+	return ok
+}
+
+// ResetNpsCategory resets all changes to the "nps_category" field.
+func (m *ExperienceDataMutation) ResetNpsCategory() {
+	m.nps_category = nil
+	delete(m.clearedFields, experiencedata.FieldNpsCategory)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ExperienceDataMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ExperienceDataMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ExperienceDataMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[experiencedata.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ExperienceDataMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ExperienceDataMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, experiencedata.FieldMetadata)
+}
+
+// SetCountry sets the "country" field.
+func (m *ExperienceDataMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *ExperienceDataMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldCountry(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ClearCountry clears the value of the "country" field.
+func (m *ExperienceDataMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[experiencedata.FieldCountry] = struct{}{}
+}
+
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *ExperienceDataMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldCountry]
+	return ok
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *ExperienceDataMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, experiencedata.FieldCountry)
+}
+
+// SetRegion sets the "region" field.
+func (m *ExperienceDataMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *ExperienceDataMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldRegion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ClearRegion clears the value of the "region" field.
+func (m *ExperienceDataMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[experiencedata.FieldRegion] = struct{}{}
+}
+
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *ExperienceDataMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldRegion]
+	return ok
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *ExperienceDataMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, experiencedata.FieldRegion)
+}
+
+// SetDevice sets the "device" field.
+func (m *ExperienceDataMutation) SetDevice(s string) {
+	m.device = &s
+}
+
+// Device returns the value of the "device" field in the mutation.
+func (m *ExperienceDataMutation) Device() (r string, exists bool) {
+	v := m.device
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDevice returns the old "device" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldDevice(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDevice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
+	}
+	return oldValue.Device, nil
+}
+
+// ClearDevice clears the value of the "device" field.
+func (m *ExperienceDataMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[experiencedata.FieldDevice] = struct{}{}
+}
+
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *ExperienceDataMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldDevice]
+	return ok
+}
+
+// ResetDevice resets all changes to the "device" field.
+func (m *ExperienceDataMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, experiencedata.FieldDevice)
+}
+
+// SetPlatform sets the "platform" field.
+func (m *ExperienceDataMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *ExperienceDataMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldPlatform(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ClearPlatform clears the value of the "platform" field.
+func (m *ExperienceDataMutation) ClearPlatform() {
+	m.platform = nil
+	m.clearedFields[experiencedata.FieldPlatform] = struct{}{}
+}
+
+// PlatformCleared returns if the "platform" field was cleared in this mutation.
+func (m *ExperienceDataMutation) PlatformCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldPlatform]
+	return ok
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *ExperienceDataMutation) ResetPlatform() {
+	m.platform = nil
+	delete(m.clearedFields, experiencedata.FieldPlatform)
+}
+
+// SetAppVersion sets the "app_version" field.
+func (m *ExperienceDataMutation) SetAppVersion(s string) {
+	m.app_version = &s
+}
+
+// AppVersion returns the value of the "app_version" field in the mutation.
+func (m *ExperienceDataMutation) AppVersion() (r string, exists bool) {
+	v := m.app_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppVersion returns the old "app_version" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldAppVersion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppVersion: %w", err)
+	}
+	return oldValue.AppVersion, nil
+}
+
+// ClearAppVersion clears the value of the "app_version" field.
+func (m *ExperienceDataMutation) ClearAppVersion() {
+	m.app_version = nil
+	m.clearedFields[experiencedata.FieldAppVersion] = struct{}{}
+}
+
+// AppVersionCleared returns if the "app_version" field was cleared in this mutation.
+func (m *ExperienceDataMutation) AppVersionCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldAppVersion]
+	return ok
+}
+
+// ResetAppVersion resets all changes to the "app_version" field.
+func (m *ExperienceDataMutation) ResetAppVersion() {
+	m.app_version = nil
+	delete(m.clearedFields, experiencedata.FieldAppVersion)
+}
+
+// SetLanguage sets the "language" field.
+func (m *ExperienceDataMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *ExperienceDataMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *ExperienceDataMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[experiencedata.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *ExperienceDataMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *ExperienceDataMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, experiencedata.FieldLanguage)
+}
+
+// SetTranslations sets the "translations" field.
+func (m *ExperienceDataMutation) SetTranslations(value map[string]schema.Translation) {
+	m.translations = &value
+}
+
+// Translations returns the value of the "translations" field in the mutation.
+func (m *ExperienceDataMutation) Translations() (r map[string]schema.Translation, exists bool) {
+	v := m.translations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTranslations returns the old "translations" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldTranslations(ctx context.Context) (v map[string]schema.Translation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTranslations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTranslations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTranslations: %w", err)
+	}
+	return oldValue.Translations, nil
+}
+
+// ClearTranslations clears the value of the "translations" field.
+func (m *ExperienceDataMutation) ClearTranslations() {
+	m.translations = nil
+	m.clearedFields[experiencedata.FieldTranslations] = struct{}{}
+}
+
+// TranslationsCleared returns if the "translations" field was cleared in this mutation.
+func (m *ExperienceDataMutation) TranslationsCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldTranslations]
+	return ok
+}
+
+// ResetTranslations resets all changes to the "translations" field.
+func (m *ExperienceDataMutation) ResetTranslations() {
+	m.translations = nil
+	delete(m.clearedFields, experiencedata.FieldTranslations)
+}
+
+// SetSentiment sets the "sentiment" field.
+func (m *ExperienceDataMutation) SetSentiment(s string) {
+	m.sentiment = &s
+}
+
+// Sentiment returns the value of the "sentiment" field in the mutation.
+func (m *ExperienceDataMutation) Sentiment() (r string, exists bool) {
+	v := m.sentiment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentiment returns the old "sentiment" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldSentiment(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentiment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentiment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentiment: %w", err)
+	}
+	return oldValue.Sentiment, nil
+}
+
+// ClearSentiment clears the value of the "sentiment" field.
+func (m *ExperienceDataMutation) ClearSentiment() {
+	m.sentiment = nil
+	m.clearedFields[experiencedata.FieldSentiment] = struct{}{}
+}
+
+// SentimentCleared returns if the "sentiment" field was cleared in this mutation.
+func (m *ExperienceDataMutation) SentimentCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldSentiment]
+	return ok
+}
+
+// ResetSentiment resets all changes to the "sentiment" field.
+func (m *ExperienceDataMutation) ResetSentiment() {
+	m.sentiment = nil
+	delete(m.clearedFields, experiencedata.FieldSentiment)
+}
+
+// SetSentimentScore sets the "sentiment_score" field.
+func (m *ExperienceDataMutation) SetSentimentScore(f float64) {
+	m.sentiment_score = &f
+	m.addsentiment_score = nil
+}
+
+// SentimentScore returns the value of the "sentiment_score" field in the mutation.
+func (m *ExperienceDataMutation) SentimentScore() (r float64, exists bool) {
+	v := m.sentiment_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentimentScore returns the old "sentiment_score" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldSentimentScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentimentScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentimentScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentimentScore: %w", err)
+	}
+	return oldValue.SentimentScore, nil
+}
+
+// AddSentimentScore adds f to the "sentiment_score" field.
+func (m *ExperienceDataMutation) AddSentimentScore(f float64) {
+	if m.addsentiment_score != nil {
+		*m.addsentiment_score += f
+	} else {
+		m.addsentiment_score = &f
+	}
+}
+
+// AddedSentimentScore returns the value that was added to the "sentiment_score" field in this mutation.
+func (m *ExperienceDataMutation) AddedSentimentScore() (r float64, exists bool) {
+	v := m.addsentiment_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSentimentScore clears the value of the "sentiment_score" field.
+func (m *ExperienceDataMutation) ClearSentimentScore() {
+	m.sentiment_score = nil
+	m.addsentiment_score = nil
+	m.clearedFields[experiencedata.FieldSentimentScore] = struct{}{}
+}
+
+// SentimentScoreCleared returns if the "sentiment_score" field was cleared in this mutation.
+func (m *ExperienceDataMutation) SentimentScoreCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldSentimentScore]
+	return ok
+}
+
+// ResetSentimentScore resets all changes to the "sentiment_score" field.
+func (m *ExperienceDataMutation) ResetSentimentScore() {
+	m.sentiment_score = nil
+	m.addsentiment_score = nil
+	delete(m.clearedFields, experiencedata.FieldSentimentScore)
+}
+
+// SetEmotion sets the "emotion" field.
+func (m *ExperienceDataMutation) SetEmotion(s string) {
+	m.emotion = &s
+}
+
+// Emotion returns the value of the "emotion" field in the mutation.
+func (m *ExperienceDataMutation) Emotion() (r string, exists bool) {
+	v := m.emotion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmotion returns the old "emotion" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldEmotion(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmotion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmotion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmotion: %w", err)
+	}
+	return oldValue.Emotion, nil
+}
+
+// ClearEmotion clears the value of the "emotion" field.
+func (m *ExperienceDataMutation) ClearEmotion() {
+	m.emotion = nil
+	m.clearedFields[experiencedata.FieldEmotion] = struct{}{}
+}
+
+// EmotionCleared returns if the "emotion" field was cleared in this mutation.
+func (m *ExperienceDataMutation) EmotionCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldEmotion]
+	return ok
+}
+
+// ResetEmotion resets all changes to the "emotion" field.
+func (m *ExperienceDataMutation) ResetEmotion() {
+	m.emotion = nil
+	delete(m.clearedFields, experiencedata.FieldEmotion)
+}
+
+// SetTopics sets the "topics" field.
+func (m *ExperienceDataMutation) SetTopics(s []string) {
+	m.topics = &s
+	m.appendtopics = nil
+}
+
+// Topics returns the value of the "topics" field in the mutation.
+func (m *ExperienceDataMutation) Topics() (r []string, exists bool) {
+	v := m.topics
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopics returns the old "topics" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldTopics(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopics is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopics requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopics: %w", err)
+	}
+	return oldValue.Topics, nil
+}
+
+// AppendTopics adds s to the "topics" field.
+func (m *ExperienceDataMutation) AppendTopics(s []string) {
+	m.appendtopics = append(m.appendtopics, s...)
+}
+
+// AppendedTopics returns the list of values that were appended to the "topics" field in this mutation.
+func (m *ExperienceDataMutation) AppendedTopics() ([]string, bool) {
+	if len(m.appendtopics) == 0 {
+		return nil, false
+	}
+	return m.appendtopics, true
+}
+
+// ClearTopics clears the value of the "topics" field.
+func (m *ExperienceDataMutation) ClearTopics() {
+	m.topics = nil
+	m.appendtopics = nil
+	m.clearedFields[experiencedata.FieldTopics] = struct{}{}
+}
+
+// TopicsCleared returns if the "topics" field was cleared in this mutation.
+func (m *ExperienceDataMutation) TopicsCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldTopics]
+	return ok
+}
+
+// ResetTopics resets all changes to the "topics" field.
+func (m *ExperienceDataMutation) ResetTopics() {
+	m.topics = nil
+	m.appendtopics = nil
+	delete(m.clearedFields, experiencedata.FieldTopics)
+}
+
+// SetIsSpam sets the "is_spam" field.
+func (m *ExperienceDataMutation) SetIsSpam(b bool) {
+	m.is_spam = &b
+}
+
+// IsSpam returns the value of the "is_spam" field in the mutation.
+func (m *ExperienceDataMutation) IsSpam() (r bool, exists bool) {
+	v := m.is_spam
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSpam returns the old "is_spam" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldIsSpam(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSpam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSpam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSpam: %w", err)
+	}
+	return oldValue.IsSpam, nil
+}
+
+// ClearIsSpam clears the value of the "is_spam" field.
+func (m *ExperienceDataMutation) ClearIsSpam() {
+	m.is_spam = nil
+	m.clearedFields[experiencedata.FieldIsSpam] = struct{}{}
+}
+
+// IsSpamCleared returns if the "is_spam" field was cleared in this mutation.
+func (m *ExperienceDataMutation) IsSpamCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldIsSpam]
+	return ok
+}
+
+// ResetIsSpam resets all changes to the "is_spam" field.
+func (m *ExperienceDataMutation) ResetIsSpam() {
+	m.is_spam = nil
+	delete(m.clearedFields, experiencedata.FieldIsSpam)
+}
+
+// SetSpamConfidence sets the "spam_confidence" field.
+func (m *ExperienceDataMutation) SetSpamConfidence(f float64) {
+	m.spam_confidence = &f
+	m.addspam_confidence = nil
+}
+
+// SpamConfidence returns the value of the "spam_confidence" field in the mutation.
+func (m *ExperienceDataMutation) SpamConfidence() (r float64, exists bool) {
+	v := m.spam_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpamConfidence returns the old "spam_confidence" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldSpamConfidence(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpamConfidence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpamConfidence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpamConfidence: %w", err)
+	}
+	return oldValue.SpamConfidence, nil
+}
+
+// AddSpamConfidence adds f to the "spam_confidence" field.
+func (m *ExperienceDataMutation) AddSpamConfidence(f float64) {
+	if m.addspam_confidence != nil {
+		*m.addspam_confidence += f
+	} else {
+		m.addspam_confidence = &f
+	}
+}
+
+// AddedSpamConfidence returns the value that was added to the "spam_confidence" field in this mutation.
+func (m *ExperienceDataMutation) AddedSpamConfidence() (r float64, exists bool) {
+	v := m.addspam_confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSpamConfidence clears the value of the "spam_confidence" field.
+func (m *ExperienceDataMutation) ClearSpamConfidence() {
+	m.spam_confidence = nil
+	m.addspam_confidence = nil
+	m.clearedFields[experiencedata.FieldSpamConfidence] = struct{}{}
+}
+
+// SpamConfidenceCleared returns if the "spam_confidence" field was cleared in this mutation.
+func (m *ExperienceDataMutation) SpamConfidenceCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldSpamConfidence]
+	return ok
+}
+
+// ResetSpamConfidence resets all changes to the "spam_confidence" field.
+func (m *ExperienceDataMutation) ResetSpamConfidence() {
+	m.spam_confidence = nil
+	m.addspam_confidence = nil
+	delete(m.clearedFields, experiencedata.FieldSpamConfidence)
+}
+
+// SetUrgencyScore sets the "urgency_score" field.
+func (m *ExperienceDataMutation) SetUrgencyScore(f float64) {
+	m.urgency_score = &f
+	m.addurgency_score = nil
+}
+
+// UrgencyScore returns the value of the "urgency_score" field in the mutation.
+func (m *ExperienceDataMutation) UrgencyScore() (r float64, exists bool) {
+	v := m.urgency_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUrgencyScore returns the old "urgency_score" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldUrgencyScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUrgencyScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUrgencyScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUrgencyScore: %w", err)
+	}
+	return oldValue.UrgencyScore, nil
+}
+
+// AddUrgencyScore adds f to the "urgency_score" field.
+func (m *ExperienceDataMutation) AddUrgencyScore(f float64) {
+	if m.addurgency_score != nil {
+		*m.addurgency_score += f
+	} else {
+		m.addurgency_score = &f
+	}
+}
+
+// AddedUrgencyScore returns the value that was added to the "urgency_score" field in this mutation.
+func (m *ExperienceDataMutation) AddedUrgencyScore() (r float64, exists bool) {
+	v := m.addurgency_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUrgencyScore clears the value of the "urgency_score" field.
+func (m *ExperienceDataMutation) ClearUrgencyScore() {
+	m.urgency_score = nil
+	m.addurgency_score = nil
+	m.clearedFields[experiencedata.FieldUrgencyScore] = struct{}{}
+}
+
+// UrgencyScoreCleared returns if the "urgency_score" field was cleared in this mutation.
+func (m *ExperienceDataMutation) UrgencyScoreCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldUrgencyScore]
+	return ok
+}
+
+// ResetUrgencyScore resets all changes to the "urgency_score" field.
+func (m *ExperienceDataMutation) ResetUrgencyScore() {
+	m.urgency_score = nil
+	m.addurgency_score = nil
+	delete(m.clearedFields, experiencedata.FieldUrgencyScore)
+}
+
+// SetUrgencyReasons sets the "urgency_reasons" field.
+func (m *ExperienceDataMutation) SetUrgencyReasons(s []string) {
+	m.urgency_reasons = &s
+	m.appendurgency_reasons = nil
+}
+
+// UrgencyReasons returns the value of the "urgency_reasons" field in the mutation.
+func (m *ExperienceDataMutation) UrgencyReasons() (r []string, exists bool) {
+	v := m.urgency_reasons
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUrgencyReasons returns the old "urgency_reasons" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldUrgencyReasons(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUrgencyReasons is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUrgencyReasons requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUrgencyReasons: %w", err)
+	}
+	return oldValue.UrgencyReasons, nil
+}
+
+// AppendUrgencyReasons adds s to the "urgency_reasons" field.
+func (m *ExperienceDataMutation) AppendUrgencyReasons(s []string) {
+	m.appendurgency_reasons = append(m.appendurgency_reasons, s...)
+}
+
+// AppendedUrgencyReasons returns the list of values that were appended to the "urgency_reasons" field in this mutation.
+func (m *ExperienceDataMutation) AppendedUrgencyReasons() ([]string, bool) {
+	if len(m.appendurgency_reasons) == 0 {
+		return nil, false
+	}
+	return m.appendurgency_reasons, true
+}
+
+// ClearUrgencyReasons clears the value of the "urgency_reasons" field.
+func (m *ExperienceDataMutation) ClearUrgencyReasons() {
+	m.urgency_reasons = nil
+	m.appendurgency_reasons = nil
+	m.clearedFields[experiencedata.FieldUrgencyReasons] = struct{}{}
+}
+
+// UrgencyReasonsCleared returns if the "urgency_reasons" field was cleared in this mutation.
+func (m *ExperienceDataMutation) UrgencyReasonsCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldUrgencyReasons]
+	return ok
+}
+
+// ResetUrgencyReasons resets all changes to the "urgency_reasons" field.
+func (m *ExperienceDataMutation) ResetUrgencyReasons() {
+	m.urgency_reasons = nil
+	m.appendurgency_reasons = nil
+	delete(m.clearedFields, experiencedata.FieldUrgencyReasons)
+}
+
+// SetEnrichmentProvider sets the "enrichment_provider" field.
+func (m *ExperienceDataMutation) SetEnrichmentProvider(s string) {
+	m.enrichment_provider = &s
+}
+
+// EnrichmentProvider returns the value of the "enrichment_provider" field in the mutation.
+func (m *ExperienceDataMutation) EnrichmentProvider() (r string, exists bool) {
+	v := m.enrichment_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnrichmentProvider returns the old "enrichment_provider" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldEnrichmentProvider(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnrichmentProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnrichmentProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnrichmentProvider: %w", err)
+	}
+	return oldValue.EnrichmentProvider, nil
+}
+
+// ClearEnrichmentProvider clears the value of the "enrichment_provider" field.
+func (m *ExperienceDataMutation) ClearEnrichmentProvider() {
+	m.enrichment_provider = nil
+	m.clearedFields[experiencedata.FieldEnrichmentProvider] = struct{}{}
+}
+
+// EnrichmentProviderCleared returns if the "enrichment_provider" field was cleared in this mutation.
+func (m *ExperienceDataMutation) EnrichmentProviderCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldEnrichmentProvider]
+	return ok
+}
+
+// ResetEnrichmentProvider resets all changes to the "enrichment_provider" field.
+func (m *ExperienceDataMutation) ResetEnrichmentProvider() {
+	m.enrichment_provider = nil
+	delete(m.clearedFields, experiencedata.FieldEnrichmentProvider)
+}
+
+// SetEnrichmentModel sets the "enrichment_model" field.
+func (m *ExperienceDataMutation) SetEnrichmentModel(s string) {
+	m.enrichment_model = &s
+}
+
+// EnrichmentModel returns the value of the "enrichment_model" field in the mutation.
+func (m *ExperienceDataMutation) EnrichmentModel() (r string, exists bool) {
+	v := m.enrichment_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnrichmentModel returns the old "enrichment_model" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldEnrichmentModel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnrichmentModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnrichmentModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnrichmentModel: %w", err)
+	}
+	return oldValue.EnrichmentModel, nil
+}
+
+// ClearEnrichmentModel clears the value of the "enrichment_model" field.
+func (m *ExperienceDataMutation) ClearEnrichmentModel() {
+	m.enrichment_model = nil
+	m.clearedFields[experiencedata.FieldEnrichmentModel] = struct{}{}
+}
+
+// EnrichmentModelCleared returns if the "enrichment_model" field was cleared in this mutation.
+func (m *ExperienceDataMutation) EnrichmentModelCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldEnrichmentModel]
+	return ok
+}
+
+// ResetEnrichmentModel resets all changes to the "enrichment_model" field.
+func (m *ExperienceDataMutation) ResetEnrichmentModel() {
+	m.enrichment_model = nil
+	delete(m.clearedFields, experiencedata.FieldEnrichmentModel)
+}
+
+// SetEnrichmentVersion sets the "enrichment_version" field.
+func (m *ExperienceDataMutation) SetEnrichmentVersion(i int) {
+	m.enrichment_version = &i
+	m.addenrichment_version = nil
+}
+
+// EnrichmentVersion returns the value of the "enrichment_version" field in the mutation.
+func (m *ExperienceDataMutation) EnrichmentVersion() (r int, exists bool) {
+	v := m.enrichment_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnrichmentVersion returns the old "enrichment_version" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldEnrichmentVersion(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnrichmentVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnrichmentVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnrichmentVersion: %w", err)
+	}
+	return oldValue.EnrichmentVersion, nil
+}
+
+// AddEnrichmentVersion adds i to the "enrichment_version" field.
+func (m *ExperienceDataMutation) AddEnrichmentVersion(i int) {
+	if m.addenrichment_version != nil {
+		*m.addenrichment_version += i
+	} else {
+		m.addenrichment_version = &i
+	}
+}
+
+// AddedEnrichmentVersion returns the value that was added to the "enrichment_version" field in this mutation.
+func (m *ExperienceDataMutation) AddedEnrichmentVersion() (r int, exists bool) {
+	v := m.addenrichment_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEnrichmentVersion clears the value of the "enrichment_version" field.
+func (m *ExperienceDataMutation) ClearEnrichmentVersion() {
+	m.enrichment_version = nil
+	m.addenrichment_version = nil
+	m.clearedFields[experiencedata.FieldEnrichmentVersion] = struct{}{}
+}
+
+// EnrichmentVersionCleared returns if the "enrichment_version" field was cleared in this mutation.
+func (m *ExperienceDataMutation) EnrichmentVersionCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldEnrichmentVersion]
+	return ok
+}
+
+// ResetEnrichmentVersion resets all changes to the "enrichment_version" field.
+func (m *ExperienceDataMutation) ResetEnrichmentVersion() {
+	m.enrichment_version = nil
+	m.addenrichment_version = nil
+	delete(m.clearedFields, experiencedata.FieldEnrichmentVersion)
+}
+
+// SetEnrichmentAttributes sets the "enrichment_attributes" field.
+func (m *ExperienceDataMutation) SetEnrichmentAttributes(value map[string]interface{}) {
+	m.enrichment_attributes = &value
+}
+
+// EnrichmentAttributes returns the value of the "enrichment_attributes" field in the mutation.
+func (m *ExperienceDataMutation) EnrichmentAttributes() (r map[string]interface{}, exists bool) {
+	v := m.enrichment_attributes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnrichmentAttributes returns the old "enrichment_attributes" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldEnrichmentAttributes(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnrichmentAttributes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnrichmentAttributes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnrichmentAttributes: %w", err)
+	}
+	return oldValue.EnrichmentAttributes, nil
+}
+
+// ClearEnrichmentAttributes clears the value of the "enrichment_attributes" field.
+func (m *ExperienceDataMutation) ClearEnrichmentAttributes() {
+	m.enrichment_attributes = nil
+	m.clearedFields[experiencedata.FieldEnrichmentAttributes] = struct{}{}
+}
+
+// EnrichmentAttributesCleared returns if the "enrichment_attributes" field was cleared in this mutation.
+func (m *ExperienceDataMutation) EnrichmentAttributesCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldEnrichmentAttributes]
+	return ok
+}
+
+// ResetEnrichmentAttributes resets all changes to the "enrichment_attributes" field.
+func (m *ExperienceDataMutation) ResetEnrichmentAttributes() {
+	m.enrichment_attributes = nil
+	delete(m.clearedFields, experiencedata.FieldEnrichmentAttributes)
+}
+
+// SetSkipAiProcessing sets the "skip_ai_processing" field.
+func (m *ExperienceDataMutation) SetSkipAiProcessing(b bool) {
+	m.skip_ai_processing = &b
+}
+
+// SkipAiProcessing returns the value of the "skip_ai_processing" field in the mutation.
+func (m *ExperienceDataMutation) SkipAiProcessing() (r bool, exists bool) {
+	v := m.skip_ai_processing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkipAiProcessing returns the old "skip_ai_processing" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldSkipAiProcessing(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipAiProcessing is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipAiProcessing requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipAiProcessing: %w", err)
+	}
+	return oldValue.SkipAiProcessing, nil
+}
+
+// ResetSkipAiProcessing resets all changes to the "skip_ai_processing" field.
+func (m *ExperienceDataMutation) ResetSkipAiProcessing() {
+	m.skip_ai_processing = nil
+}
+
+// SetAiInputHash sets the "ai_input_hash" field.
+func (m *ExperienceDataMutation) SetAiInputHash(s string) {
+	m.ai_input_hash = &s
+}
+
+// AiInputHash returns the value of the "ai_input_hash" field in the mutation.
+func (m *ExperienceDataMutation) AiInputHash() (r string, exists bool) {
+	v := m.ai_input_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAiInputHash returns the old "ai_input_hash" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldAiInputHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAiInputHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAiInputHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAiInputHash: %w", err)
+	}
+	return oldValue.AiInputHash, nil
+}
+
+// ClearAiInputHash clears the value of the "ai_input_hash" field.
+func (m *ExperienceDataMutation) ClearAiInputHash() {
+	m.ai_input_hash = nil
+	m.clearedFields[experiencedata.FieldAiInputHash] = struct{}{}
+}
+
+// AiInputHashCleared returns if the "ai_input_hash" field was cleared in this mutation.
+func (m *ExperienceDataMutation) AiInputHashCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldAiInputHash]
+	return ok
+}
+
+// ResetAiInputHash resets all changes to the "ai_input_hash" field.
+func (m *ExperienceDataMutation) ResetAiInputHash() {
+	m.ai_input_hash = nil
+	delete(m.clearedFields, experiencedata.FieldAiInputHash)
+}
+
+// SetUserIdentifier sets the "user_identifier" field.
+func (m *ExperienceDataMutation) SetUserIdentifier(s string) {
+	m.user_identifier = &s
+}
+
+// UserIdentifier returns the value of the "user_identifier" field in the mutation.
+func (m *ExperienceDataMutation) UserIdentifier() (r string, exists bool) {
+	v := m.user_identifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserIdentifier returns the old "user_identifier" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldUserIdentifier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserIdentifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserIdentifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserIdentifier: %w", err)
+	}
+	return oldValue.UserIdentifier, nil
+}
+
+// ClearUserIdentifier clears the value of the "user_identifier" field.
+func (m *ExperienceDataMutation) ClearUserIdentifier() {
+	m.user_identifier = nil
+	m.clearedFields[experiencedata.FieldUserIdentifier] = struct{}{}
+}
+
+// UserIdentifierCleared returns if the "user_identifier" field was cleared in this mutation.
+func (m *ExperienceDataMutation) UserIdentifierCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldUserIdentifier]
+	return ok
+}
+
+// ResetUserIdentifier resets all changes to the "user_identifier" field.
+func (m *ExperienceDataMutation) ResetUserIdentifier() {
+	m.user_identifier = nil
+	delete(m.clearedFields, experiencedata.FieldUserIdentifier)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *ExperienceDataMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *ExperienceDataMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldContentHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *ExperienceDataMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[experiencedata.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *ExperienceDataMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *ExperienceDataMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, experiencedata.FieldContentHash)
+}
+
+// SetDuplicateOf sets the "duplicate_of" field.
+func (m *ExperienceDataMutation) SetDuplicateOf(u uuid.UUID) {
+	m.duplicate_of = &u
+}
+
+// DuplicateOf returns the value of the "duplicate_of" field in the mutation.
+func (m *ExperienceDataMutation) DuplicateOf() (r uuid.UUID, exists bool) {
+	v := m.duplicate_of
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuplicateOf returns the old "duplicate_of" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldDuplicateOf(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuplicateOf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuplicateOf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuplicateOf: %w", err)
+	}
+	return oldValue.DuplicateOf, nil
+}
+
+// ClearDuplicateOf clears the value of the "duplicate_of" field.
+func (m *ExperienceDataMutation) ClearDuplicateOf() {
+	m.duplicate_of = nil
+	m.clearedFields[experiencedata.FieldDuplicateOf] = struct{}{}
+}
+
+// DuplicateOfCleared returns if the "duplicate_of" field was cleared in this mutation.
+func (m *ExperienceDataMutation) DuplicateOfCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldDuplicateOf]
+	return ok
+}
+
+// ResetDuplicateOf resets all changes to the "duplicate_of" field.
+func (m *ExperienceDataMutation) ResetDuplicateOf() {
+	m.duplicate_of = nil
+	delete(m.clearedFields, experiencedata.FieldDuplicateOf)
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *ExperienceDataMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *ExperienceDataMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldIdempotencyKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *ExperienceDataMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[experiencedata.FieldIdempotencyKey] = struct{}{}
+}
+
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *ExperienceDataMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldIdempotencyKey]
+	return ok
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *ExperienceDataMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, experiencedata.FieldIdempotencyKey)
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *ExperienceDataMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *ExperienceDataMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldEmbedding(ctx context.Context) (v *pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *ExperienceDataMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.clearedFields[experiencedata.FieldEmbedding] = struct{}{}
+}
+
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *ExperienceDataMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldEmbedding]
+	return ok
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *ExperienceDataMutation) ResetEmbedding() {
+	m.embedding = nil
+	delete(m.clearedFields, experiencedata.FieldEmbedding)
+}
+
+// SetEmbeddingModel sets the "embedding_model" field.
+func (m *ExperienceDataMutation) SetEmbeddingModel(s string) {
+	m.embedding_model = &s
+}
+
+// EmbeddingModel returns the value of the "embedding_model" field in the mutation.
+func (m *ExperienceDataMutation) EmbeddingModel() (r string, exists bool) {
+	v := m.embedding_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingModel returns the old "embedding_model" field's value of the ExperienceData entity.
+// If the ExperienceData object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDataMutation) OldEmbeddingModel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingModel: %w", err)
+	}
+	return oldValue.EmbeddingModel, nil
+}
+
+// ClearEmbeddingModel clears the value of the "embedding_model" field.
+func (m *ExperienceDataMutation) ClearEmbeddingModel() {
+	m.embedding_model = nil
+	m.clearedFields[experiencedata.FieldEmbeddingModel] = struct{}{}
+}
+
+// EmbeddingModelCleared returns if the "embedding_model" field was cleared in this mutation.
+func (m *ExperienceDataMutation) EmbeddingModelCleared() bool {
+	_, ok := m.clearedFields[experiencedata.FieldEmbeddingModel]
+	return ok
+}
+
+// ResetEmbeddingModel resets all changes to the "embedding_model" field.
+func (m *ExperienceDataMutation) ResetEmbeddingModel() {
+	m.embedding_model = nil
+	delete(m.clearedFields, experiencedata.FieldEmbeddingModel)
+}
+
+// ClearQuestion clears the "question" edge to the Question entity.
+func (m *ExperienceDataMutation) ClearQuestion() {
+	m.clearedquestion = true
+	m.clearedFields[experiencedata.FieldQuestionID] = struct{}{}
+}
+
+// QuestionCleared reports if the "question" edge to the Question entity was cleared.
+func (m *ExperienceDataMutation) QuestionCleared() bool {
+	return m.QuestionIDCleared() || m.clearedquestion
+}
+
+// QuestionIDs returns the "question" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuestionID instead. It exists only for internal usage by the builders.
+func (m *ExperienceDataMutation) QuestionIDs() (ids []uuid.UUID) {
+	if id := m.question; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuestion resets all changes to the "question" edge.
+func (m *ExperienceDataMutation) ResetQuestion() {
+	m.question = nil
+	m.clearedquestion = false
+}
+
+// Where appends a list predicates to the ExperienceDataMutation builder.
+func (m *ExperienceDataMutation) Where(ps ...predicate.ExperienceData) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExperienceDataMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExperienceDataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExperienceData, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExperienceDataMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExperienceDataMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExperienceData).
+func (m *ExperienceDataMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExperienceDataMutation) Fields() []string {
+	fields := make([]string, 0, 44)
+	if m.created_at != nil {
+		fields = append(fields, experiencedata.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, experiencedata.FieldUpdatedAt)
+	}
+	if m.collected_at != nil {
+		fields = append(fields, experiencedata.FieldCollectedAt)
+	}
+	if m.source_type != nil {
+		fields = append(fields, experiencedata.FieldSourceType)
+	}
+	if m.source_id != nil {
+		fields = append(fields, experiencedata.FieldSourceID)
+	}
+	if m.source_name != nil {
+		fields = append(fields, experiencedata.FieldSourceName)
+	}
+	if m.field_id != nil {
+		fields = append(fields, experiencedata.FieldFieldID)
+	}
+	if m.field_label != nil {
+		fields = append(fields, experiencedata.FieldFieldLabel)
+	}
+	if m.question != nil {
+		fields = append(fields, experiencedata.FieldQuestionID)
+	}
+	if m.field_type != nil {
+		fields = append(fields, experiencedata.FieldFieldType)
+	}
+	if m.value_text != nil {
+		fields = append(fields, experiencedata.FieldValueText)
+	}
+	if m.value_number != nil {
+		fields = append(fields, experiencedata.FieldValueNumber)
+	}
+	if m.value_boolean != nil {
+		fields = append(fields, experiencedata.FieldValueBoolean)
+	}
+	if m.value_date != nil {
+		fields = append(fields, experiencedata.FieldValueDate)
+	}
+	if m.value_json != nil {
+		fields = append(fields, experiencedata.FieldValueJSON)
+	}
+	if m.nps_category != nil {
+		fields = append(fields, experiencedata.FieldNpsCategory)
+	}
+	if m.metadata != nil {
+		fields = append(fields, experiencedata.FieldMetadata)
+	}
+	if m.country != nil {
+		fields = append(fields, experiencedata.FieldCountry)
+	}
+	if m.region != nil {
+		fields = append(fields, experiencedata.FieldRegion)
+	}
+	if m.device != nil {
+		fields = append(fields, experiencedata.FieldDevice)
+	}
+	if m.platform != nil {
+		fields = append(fields, experiencedata.FieldPlatform)
+	}
+	if m.app_version != nil {
+		fields = append(fields, experiencedata.FieldAppVersion)
+	}
+	if m.language != nil {
+		fields = append(fields, experiencedata.FieldLanguage)
+	}
+	if m.translations != nil {
+		fields = append(fields, experiencedata.FieldTranslations)
+	}
+	if m.sentiment != nil {
+		fields = append(fields, experiencedata.FieldSentiment)
+	}
+	if m.sentiment_score != nil {
+		fields = append(fields, experiencedata.FieldSentimentScore)
+	}
+	if m.emotion != nil {
+		fields = append(fields, experiencedata.FieldEmotion)
+	}
+	if m.topics != nil {
+		fields = append(fields, experiencedata.FieldTopics)
+	}
+	if m.is_spam != nil {
+		fields = append(fields, experiencedata.FieldIsSpam)
+	}
+	if m.spam_confidence != nil {
+		fields = append(fields, experiencedata.FieldSpamConfidence)
+	}
+	if m.urgency_score != nil {
+		fields = append(fields, experiencedata.FieldUrgencyScore)
+	}
+	if m.urgency_reasons != nil {
+		fields = append(fields, experiencedata.FieldUrgencyReasons)
+	}
+	if m.enrichment_provider != nil {
+		fields = append(fields, experiencedata.FieldEnrichmentProvider)
+	}
+	if m.enrichment_model != nil {
+		fields = append(fields, experiencedata.FieldEnrichmentModel)
+	}
+	if m.enrichment_version != nil {
+		fields = append(fields, experiencedata.FieldEnrichmentVersion)
+	}
+	if m.enrichment_attributes != nil {
+		fields = append(fields, experiencedata.FieldEnrichmentAttributes)
+	}
+	if m.skip_ai_processing != nil {
+		fields = append(fields, experiencedata.FieldSkipAiProcessing)
+	}
+	if m.ai_input_hash != nil {
+		fields = append(fields, experiencedata.FieldAiInputHash)
+	}
+	if m.user_identifier != nil {
+		fields = append(fields, experiencedata.FieldUserIdentifier)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, experiencedata.FieldContentHash)
+	}
+	if m.duplicate_of != nil {
+		fields = append(fields, experiencedata.FieldDuplicateOf)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, experiencedata.FieldIdempotencyKey)
+	}
+	if m.embedding != nil {
+		fields = append(fields, experiencedata.FieldEmbedding)
+	}
+	if m.embedding_model != nil {
+		fields = append(fields, experiencedata.FieldEmbeddingModel)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExperienceDataMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case experiencedata.FieldCreatedAt:
+		return m.CreatedAt()
+	case experiencedata.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case experiencedata.FieldCollectedAt:
+		return m.CollectedAt()
+	case experiencedata.FieldSourceType:
+		return m.SourceType()
+	case experiencedata.FieldSourceID:
+		return m.SourceID()
+	case experiencedata.FieldSourceName:
+		return m.SourceName()
+	case experiencedata.FieldFieldID:
+		return m.FieldID()
+	case experiencedata.FieldFieldLabel:
+		return m.FieldLabel()
+	case experiencedata.FieldQuestionID:
+		return m.QuestionID()
+	case experiencedata.FieldFieldType:
+		return m.FieldType()
+	case experiencedata.FieldValueText:
+		return m.ValueText()
+	case experiencedata.FieldValueNumber:
+		return m.ValueNumber()
+	case experiencedata.FieldValueBoolean:
+		return m.ValueBoolean()
+	case experiencedata.FieldValueDate:
+		return m.ValueDate()
+	case experiencedata.FieldValueJSON:
+		return m.ValueJSON()
+	case experiencedata.FieldNpsCategory:
+		return m.NpsCategory()
+	case experiencedata.FieldMetadata:
+		return m.Metadata()
+	case experiencedata.FieldCountry:
+		return m.Country()
+	case experiencedata.FieldRegion:
+		return m.Region()
+	case experiencedata.FieldDevice:
+		return m.Device()
+	case experiencedata.FieldPlatform:
+		return m.Platform()
+	case experiencedata.FieldAppVersion:
+		return m.AppVersion()
+	case experiencedata.FieldLanguage:
+		return m.Language()
+	case experiencedata.FieldTranslations:
+		return m.Translations()
+	case experiencedata.FieldSentiment:
+		return m.Sentiment()
+	case experiencedata.FieldSentimentScore:
+		return m.SentimentScore()
+	case experiencedata.FieldEmotion:
+		return m.Emotion()
+	case experiencedata.FieldTopics:
+		return m.Topics()
+	case experiencedata.FieldIsSpam:
+		return m.IsSpam()
+	case experiencedata.FieldSpamConfidence:
+		return m.SpamConfidence()
+	case experiencedata.FieldUrgencyScore:
+		return m.UrgencyScore()
+	case experiencedata.FieldUrgencyReasons:
+		return m.UrgencyReasons()
+	case experiencedata.FieldEnrichmentProvider:
+		return m.EnrichmentProvider()
+	case experiencedata.FieldEnrichmentModel:
+		return m.EnrichmentModel()
+	case experiencedata.FieldEnrichmentVersion:
+		return m.EnrichmentVersion()
+	case experiencedata.FieldEnrichmentAttributes:
+		return m.EnrichmentAttributes()
+	case experiencedata.FieldSkipAiProcessing:
+		return m.SkipAiProcessing()
+	case experiencedata.FieldAiInputHash:
+		return m.AiInputHash()
+	case experiencedata.FieldUserIdentifier:
+		return m.UserIdentifier()
+	case experiencedata.FieldContentHash:
+		return m.ContentHash()
+	case experiencedata.FieldDuplicateOf:
+		return m.DuplicateOf()
+	case experiencedata.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case experiencedata.FieldEmbedding:
+		return m.Embedding()
+	case experiencedata.FieldEmbeddingModel:
+		return m.EmbeddingModel()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExperienceDataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case experiencedata.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case experiencedata.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case experiencedata.FieldCollectedAt:
+		return m.OldCollectedAt(ctx)
+	case experiencedata.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case experiencedata.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case experiencedata.FieldSourceName:
+		return m.OldSourceName(ctx)
+	case experiencedata.FieldFieldID:
+		return m.OldFieldID(ctx)
+	case experiencedata.FieldFieldLabel:
+		return m.OldFieldLabel(ctx)
+	case experiencedata.FieldQuestionID:
+		return m.OldQuestionID(ctx)
+	case experiencedata.FieldFieldType:
+		return m.OldFieldType(ctx)
+	case experiencedata.FieldValueText:
+		return m.OldValueText(ctx)
+	case experiencedata.FieldValueNumber:
+		return m.OldValueNumber(ctx)
+	case experiencedata.FieldValueBoolean:
+		return m.OldValueBoolean(ctx)
+	case experiencedata.FieldValueDate:
+		return m.OldValueDate(ctx)
+	case experiencedata.FieldValueJSON:
+		return m.OldValueJSON(ctx)
+	case experiencedata.FieldNpsCategory:
+		return m.OldNpsCategory(ctx)
+	case experiencedata.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case experiencedata.FieldCountry:
+		return m.OldCountry(ctx)
+	case experiencedata.FieldRegion:
+		return m.OldRegion(ctx)
+	case experiencedata.FieldDevice:
+		return m.OldDevice(ctx)
+	case experiencedata.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case experiencedata.FieldAppVersion:
+		return m.OldAppVersion(ctx)
+	case experiencedata.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case experiencedata.FieldTranslations:
+		return m.OldTranslations(ctx)
+	case experiencedata.FieldSentiment:
+		return m.OldSentiment(ctx)
+	case experiencedata.FieldSentimentScore:
+		return m.OldSentimentScore(ctx)
+	case experiencedata.FieldEmotion:
+		return m.OldEmotion(ctx)
+	case experiencedata.FieldTopics:
+		return m.OldTopics(ctx)
+	case experiencedata.FieldIsSpam:
+		return m.OldIsSpam(ctx)
+	case experiencedata.FieldSpamConfidence:
+		return m.OldSpamConfidence(ctx)
+	case experiencedata.FieldUrgencyScore:
+		return m.OldUrgencyScore(ctx)
+	case experiencedata.FieldUrgencyReasons:
+		return m.OldUrgencyReasons(ctx)
+	case experiencedata.FieldEnrichmentProvider:
+		return m.OldEnrichmentProvider(ctx)
+	case experiencedata.FieldEnrichmentModel:
+		return m.OldEnrichmentModel(ctx)
+	case experiencedata.FieldEnrichmentVersion:
+		return m.OldEnrichmentVersion(ctx)
+	case experiencedata.FieldEnrichmentAttributes:
+		return m.OldEnrichmentAttributes(ctx)
+	case experiencedata.FieldSkipAiProcessing:
+		return m.OldSkipAiProcessing(ctx)
+	case experiencedata.FieldAiInputHash:
+		return m.OldAiInputHash(ctx)
+	case experiencedata.FieldUserIdentifier:
+		return m.OldUserIdentifier(ctx)
+	case experiencedata.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case experiencedata.FieldDuplicateOf:
+		return m.OldDuplicateOf(ctx)
+	case experiencedata.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case experiencedata.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case experiencedata.FieldEmbeddingModel:
+		return m.OldEmbeddingModel(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExperienceData field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExperienceDataMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case experiencedata.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case experiencedata.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case experiencedata.FieldCollectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectedAt(v)
+		return nil
+	case experiencedata.FieldSourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case experiencedata.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case experiencedata.FieldSourceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceName(v)
+		return nil
+	case experiencedata.FieldFieldID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldID(v)
+		return nil
+	case experiencedata.FieldFieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldLabel(v)
+		return nil
+	case experiencedata.FieldQuestionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionID(v)
+		return nil
+	case experiencedata.FieldFieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldType(v)
+		return nil
+	case experiencedata.FieldValueText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueText(v)
+		return nil
+	case experiencedata.FieldValueNumber:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueNumber(v)
+		return nil
+	case experiencedata.FieldValueBoolean:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueBoolean(v)
+		return nil
+	case experiencedata.FieldValueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueDate(v)
+		return nil
+	case experiencedata.FieldValueJSON:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValueJSON(v)
+		return nil
+	case experiencedata.FieldNpsCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNpsCategory(v)
+		return nil
+	case experiencedata.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case experiencedata.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case experiencedata.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case experiencedata.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case experiencedata.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case experiencedata.FieldAppVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppVersion(v)
+		return nil
+	case experiencedata.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case experiencedata.FieldTranslations:
+		v, ok := value.(map[string]schema.Translation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTranslations(v)
+		return nil
+	case experiencedata.FieldSentiment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentiment(v)
+		return nil
+	case experiencedata.FieldSentimentScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentimentScore(v)
+		return nil
+	case experiencedata.FieldEmotion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmotion(v)
+		return nil
+	case experiencedata.FieldTopics:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopics(v)
+		return nil
+	case experiencedata.FieldIsSpam:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSpam(v)
+		return nil
+	case experiencedata.FieldSpamConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpamConfidence(v)
+		return nil
+	case experiencedata.FieldUrgencyScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUrgencyScore(v)
+		return nil
+	case experiencedata.FieldUrgencyReasons:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUrgencyReasons(v)
+		return nil
+	case experiencedata.FieldEnrichmentProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnrichmentProvider(v)
+		return nil
+	case experiencedata.FieldEnrichmentModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnrichmentModel(v)
+		return nil
+	case experiencedata.FieldEnrichmentVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnrichmentVersion(v)
+		return nil
+	case experiencedata.FieldEnrichmentAttributes:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnrichmentAttributes(v)
+		return nil
+	case experiencedata.FieldSkipAiProcessing:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipAiProcessing(v)
+		return nil
+	case experiencedata.FieldAiInputHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAiInputHash(v)
+		return nil
+	case experiencedata.FieldUserIdentifier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserIdentifier(v)
+		return nil
+	case experiencedata.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case experiencedata.FieldDuplicateOf:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuplicateOf(v)
+		return nil
+	case experiencedata.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	case experiencedata.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case experiencedata.FieldEmbeddingModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingModel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceData field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExperienceDataMutation) AddedFields() []string {
+	var fields []string
+	if m.addvalue_number != nil {
+		fields = append(fields, experiencedata.FieldValueNumber)
+	}
+	if m.addsentiment_score != nil {
+		fields = append(fields, experiencedata.FieldSentimentScore)
+	}
+	if m.addspam_confidence != nil {
+		fields = append(fields, experiencedata.FieldSpamConfidence)
+	}
+	if m.addurgency_score != nil {
+		fields = append(fields, experiencedata.FieldUrgencyScore)
+	}
+	if m.addenrichment_version != nil {
+		fields = append(fields, experiencedata.FieldEnrichmentVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExperienceDataMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case experiencedata.FieldValueNumber:
+		return m.AddedValueNumber()
+	case experiencedata.FieldSentimentScore:
+		return m.AddedSentimentScore()
+	case experiencedata.FieldSpamConfidence:
+		return m.AddedSpamConfidence()
+	case experiencedata.FieldUrgencyScore:
+		return m.AddedUrgencyScore()
+	case experiencedata.FieldEnrichmentVersion:
+		return m.AddedEnrichmentVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExperienceDataMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case experiencedata.FieldValueNumber:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValueNumber(v)
+		return nil
+	case experiencedata.FieldSentimentScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSentimentScore(v)
+		return nil
+	case experiencedata.FieldSpamConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpamConfidence(v)
+		return nil
+	case experiencedata.FieldUrgencyScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUrgencyScore(v)
+		return nil
+	case experiencedata.FieldEnrichmentVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnrichmentVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceData numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExperienceDataMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(experiencedata.FieldSourceID) {
+		fields = append(fields, experiencedata.FieldSourceID)
+	}
+	if m.FieldCleared(experiencedata.FieldSourceName) {
+		fields = append(fields, experiencedata.FieldSourceName)
+	}
+	if m.FieldCleared(experiencedata.FieldFieldLabel) {
+		fields = append(fields, experiencedata.FieldFieldLabel)
+	}
+	if m.FieldCleared(experiencedata.FieldQuestionID) {
+		fields = append(fields, experiencedata.FieldQuestionID)
+	}
+	if m.FieldCleared(experiencedata.FieldValueText) {
+		fields = append(fields, experiencedata.FieldValueText)
+	}
+	if m.FieldCleared(experiencedata.FieldValueNumber) {
+		fields = append(fields, experiencedata.FieldValueNumber)
+	}
+	if m.FieldCleared(experiencedata.FieldValueBoolean) {
+		fields = append(fields, experiencedata.FieldValueBoolean)
+	}
+	if m.FieldCleared(experiencedata.FieldValueDate) {
+		fields = append(fields, experiencedata.FieldValueDate)
+	}
+	if m.FieldCleared(experiencedata.FieldValueJSON) {
+		fields = append(fields, experiencedata.FieldValueJSON)
+	}
+	if m.FieldCleared(experiencedata.FieldNpsCategory) {
+		fields = append(fields, experiencedata.FieldNpsCategory)
+	}
+	if m.FieldCleared(experiencedata.FieldMetadata) {
+		fields = append(fields, experiencedata.FieldMetadata)
+	}
+	if m.FieldCleared(experiencedata.FieldCountry) {
+		fields = append(fields, experiencedata.FieldCountry)
+	}
+	if m.FieldCleared(experiencedata.FieldRegion) {
+		fields = append(fields, experiencedata.FieldRegion)
+	}
+	if m.FieldCleared(experiencedata.FieldDevice) {
+		fields = append(fields, experiencedata.FieldDevice)
+	}
+	if m.FieldCleared(experiencedata.FieldPlatform) {
+		fields = append(fields, experiencedata.FieldPlatform)
+	}
+	if m.FieldCleared(experiencedata.FieldAppVersion) {
+		fields = append(fields, experiencedata.FieldAppVersion)
+	}
+	if m.FieldCleared(experiencedata.FieldLanguage) {
+		fields = append(fields, experiencedata.FieldLanguage)
+	}
+	if m.FieldCleared(experiencedata.FieldTranslations) {
+		fields = append(fields, experiencedata.FieldTranslations)
+	}
+	if m.FieldCleared(experiencedata.FieldSentiment) {
+		fields = append(fields, experiencedata.FieldSentiment)
+	}
+	if m.FieldCleared(experiencedata.FieldSentimentScore) {
+		fields = append(fields, experiencedata.FieldSentimentScore)
+	}
+	if m.FieldCleared(experiencedata.FieldEmotion) {
+		fields = append(fields, experiencedata.FieldEmotion)
+	}
+	if m.FieldCleared(experiencedata.FieldTopics) {
+		fields = append(fields, experiencedata.FieldTopics)
+	}
+	if m.FieldCleared(experiencedata.FieldIsSpam) {
+		fields = append(fields, experiencedata.FieldIsSpam)
+	}
+	if m.FieldCleared(experiencedata.FieldSpamConfidence) {
+		fields = append(fields, experiencedata.FieldSpamConfidence)
+	}
+	if m.FieldCleared(experiencedata.FieldUrgencyScore) {
+		fields = append(fields, experiencedata.FieldUrgencyScore)
+	}
+	if m.FieldCleared(experiencedata.FieldUrgencyReasons) {
+		fields = append(fields, experiencedata.FieldUrgencyReasons)
+	}
+	if m.FieldCleared(experiencedata.FieldEnrichmentProvider) {
+		fields = append(fields, experiencedata.FieldEnrichmentProvider)
+	}
+	if m.FieldCleared(experiencedata.FieldEnrichmentModel) {
+		fields = append(fields, experiencedata.FieldEnrichmentModel)
+	}
+	if m.FieldCleared(experiencedata.FieldEnrichmentVersion) {
+		fields = append(fields, experiencedata.FieldEnrichmentVersion)
+	}
+	if m.FieldCleared(experiencedata.FieldEnrichmentAttributes) {
+		fields = append(fields, experiencedata.FieldEnrichmentAttributes)
+	}
+	if m.FieldCleared(experiencedata.FieldAiInputHash) {
+		fields = append(fields, experiencedata.FieldAiInputHash)
+	}
+	if m.FieldCleared(experiencedata.FieldUserIdentifier) {
+		fields = append(fields, experiencedata.FieldUserIdentifier)
+	}
+	if m.FieldCleared(experiencedata.FieldContentHash) {
+		fields = append(fields, experiencedata.FieldContentHash)
+	}
+	if m.FieldCleared(experiencedata.FieldDuplicateOf) {
+		fields = append(fields, experiencedata.FieldDuplicateOf)
+	}
+	if m.FieldCleared(experiencedata.FieldIdempotencyKey) {
+		fields = append(fields, experiencedata.FieldIdempotencyKey)
+	}
+	if m.FieldCleared(experiencedata.FieldEmbedding) {
+		fields = append(fields, experiencedata.FieldEmbedding)
+	}
+	if m.FieldCleared(experiencedata.FieldEmbeddingModel) {
+		fields = append(fields, experiencedata.FieldEmbeddingModel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExperienceDataMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExperienceDataMutation) ClearField(name string) error {
+	switch name {
+	case experiencedata.FieldSourceID:
+		m.ClearSourceID()
+		return nil
+	case experiencedata.FieldSourceName:
+		m.ClearSourceName()
+		return nil
+	case experiencedata.FieldFieldLabel:
+		m.ClearFieldLabel()
+		return nil
+	case experiencedata.FieldQuestionID:
+		m.ClearQuestionID()
+		return nil
+	case experiencedata.FieldValueText:
+		m.ClearValueText()
+		return nil
+	case experiencedata.FieldValueNumber:
+		m.ClearValueNumber()
+		return nil
+	case experiencedata.FieldValueBoolean:
+		m.ClearValueBoolean()
+		return nil
+	case experiencedata.FieldValueDate:
+		m.ClearValueDate()
+		return nil
+	case experiencedata.FieldValueJSON:
+		m.ClearValueJSON()
+		return nil
+	case experiencedata.FieldNpsCategory:
+		m.ClearNpsCategory()
+		return nil
+	case experiencedata.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case experiencedata.FieldCountry:
+		m.ClearCountry()
+		return nil
+	case experiencedata.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case experiencedata.FieldDevice:
+		m.ClearDevice()
+		return nil
+	case experiencedata.FieldPlatform:
+		m.ClearPlatform()
+		return nil
+	case experiencedata.FieldAppVersion:
+		m.ClearAppVersion()
+		return nil
+	case experiencedata.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	case experiencedata.FieldTranslations:
+		m.ClearTranslations()
+		return nil
+	case experiencedata.FieldSentiment:
+		m.ClearSentiment()
+		return nil
 	case experiencedata.FieldSentimentScore:
-		return m.OldSentimentScore(ctx)
+		m.ClearSentimentScore()
+		return nil
 	case experiencedata.FieldEmotion:
-		return m.OldEmotion(ctx)
+		m.ClearEmotion()
+		return nil
 	case experiencedata.FieldTopics:
-		return m.OldTopics(ctx)
+		m.ClearTopics()
+		return nil
+	case experiencedata.FieldIsSpam:
+		m.ClearIsSpam()
+		return nil
+	case experiencedata.FieldSpamConfidence:
+		m.ClearSpamConfidence()
+		return nil
+	case experiencedata.FieldUrgencyScore:
+		m.ClearUrgencyScore()
+		return nil
+	case experiencedata.FieldUrgencyReasons:
+		m.ClearUrgencyReasons()
+		return nil
+	case experiencedata.FieldEnrichmentProvider:
+		m.ClearEnrichmentProvider()
+		return nil
+	case experiencedata.FieldEnrichmentModel:
+		m.ClearEnrichmentModel()
+		return nil
+	case experiencedata.FieldEnrichmentVersion:
+		m.ClearEnrichmentVersion()
+		return nil
+	case experiencedata.FieldEnrichmentAttributes:
+		m.ClearEnrichmentAttributes()
+		return nil
+	case experiencedata.FieldAiInputHash:
+		m.ClearAiInputHash()
+		return nil
 	case experiencedata.FieldUserIdentifier:
-		return m.OldUserIdentifier(ctx)
+		m.ClearUserIdentifier()
+		return nil
+	case experiencedata.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	case experiencedata.FieldDuplicateOf:
+		m.ClearDuplicateOf()
+		return nil
+	case experiencedata.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
 	case experiencedata.FieldEmbedding:
-		return m.OldEmbedding(ctx)
+		m.ClearEmbedding()
+		return nil
 	case experiencedata.FieldEmbeddingModel:
-		return m.OldEmbeddingModel(ctx)
+		m.ClearEmbeddingModel()
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceData nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExperienceDataMutation) ResetField(name string) error {
+	switch name {
+	case experiencedata.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case experiencedata.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case experiencedata.FieldCollectedAt:
+		m.ResetCollectedAt()
+		return nil
+	case experiencedata.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case experiencedata.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case experiencedata.FieldSourceName:
+		m.ResetSourceName()
+		return nil
+	case experiencedata.FieldFieldID:
+		m.ResetFieldID()
+		return nil
+	case experiencedata.FieldFieldLabel:
+		m.ResetFieldLabel()
+		return nil
+	case experiencedata.FieldQuestionID:
+		m.ResetQuestionID()
+		return nil
+	case experiencedata.FieldFieldType:
+		m.ResetFieldType()
+		return nil
+	case experiencedata.FieldValueText:
+		m.ResetValueText()
+		return nil
+	case experiencedata.FieldValueNumber:
+		m.ResetValueNumber()
+		return nil
+	case experiencedata.FieldValueBoolean:
+		m.ResetValueBoolean()
+		return nil
+	case experiencedata.FieldValueDate:
+		m.ResetValueDate()
+		return nil
+	case experiencedata.FieldValueJSON:
+		m.ResetValueJSON()
+		return nil
+	case experiencedata.FieldNpsCategory:
+		m.ResetNpsCategory()
+		return nil
+	case experiencedata.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case experiencedata.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case experiencedata.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case experiencedata.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case experiencedata.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case experiencedata.FieldAppVersion:
+		m.ResetAppVersion()
+		return nil
+	case experiencedata.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case experiencedata.FieldTranslations:
+		m.ResetTranslations()
+		return nil
+	case experiencedata.FieldSentiment:
+		m.ResetSentiment()
+		return nil
+	case experiencedata.FieldSentimentScore:
+		m.ResetSentimentScore()
+		return nil
+	case experiencedata.FieldEmotion:
+		m.ResetEmotion()
+		return nil
+	case experiencedata.FieldTopics:
+		m.ResetTopics()
+		return nil
+	case experiencedata.FieldIsSpam:
+		m.ResetIsSpam()
+		return nil
+	case experiencedata.FieldSpamConfidence:
+		m.ResetSpamConfidence()
+		return nil
+	case experiencedata.FieldUrgencyScore:
+		m.ResetUrgencyScore()
+		return nil
+	case experiencedata.FieldUrgencyReasons:
+		m.ResetUrgencyReasons()
+		return nil
+	case experiencedata.FieldEnrichmentProvider:
+		m.ResetEnrichmentProvider()
+		return nil
+	case experiencedata.FieldEnrichmentModel:
+		m.ResetEnrichmentModel()
+		return nil
+	case experiencedata.FieldEnrichmentVersion:
+		m.ResetEnrichmentVersion()
+		return nil
+	case experiencedata.FieldEnrichmentAttributes:
+		m.ResetEnrichmentAttributes()
+		return nil
+	case experiencedata.FieldSkipAiProcessing:
+		m.ResetSkipAiProcessing()
+		return nil
+	case experiencedata.FieldAiInputHash:
+		m.ResetAiInputHash()
+		return nil
+	case experiencedata.FieldUserIdentifier:
+		m.ResetUserIdentifier()
+		return nil
+	case experiencedata.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case experiencedata.FieldDuplicateOf:
+		m.ResetDuplicateOf()
+		return nil
+	case experiencedata.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case experiencedata.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case experiencedata.FieldEmbeddingModel:
+		m.ResetEmbeddingModel()
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceData field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExperienceDataMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.question != nil {
+		edges = append(edges, experiencedata.EdgeQuestion)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExperienceDataMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case experiencedata.EdgeQuestion:
+		if id := m.question; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExperienceDataMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExperienceDataMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExperienceDataMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedquestion {
+		edges = append(edges, experiencedata.EdgeQuestion)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExperienceDataMutation) EdgeCleared(name string) bool {
+	switch name {
+	case experiencedata.EdgeQuestion:
+		return m.clearedquestion
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExperienceDataMutation) ClearEdge(name string) error {
+	switch name {
+	case experiencedata.EdgeQuestion:
+		m.ClearQuestion()
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceData unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExperienceDataMutation) ResetEdge(name string) error {
+	switch name {
+	case experiencedata.EdgeQuestion:
+		m.ResetQuestion()
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceData edge %s", name)
+}
+
+// ExperienceDeletionMutation represents an operation that mutates the ExperienceDeletion nodes in the graph.
+type ExperienceDeletionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	experience_id *uuid.UUID
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExperienceDeletion, error)
+	predicates    []predicate.ExperienceDeletion
+}
+
+var _ ent.Mutation = (*ExperienceDeletionMutation)(nil)
+
+// experiencedeletionOption allows management of the mutation configuration using functional options.
+type experiencedeletionOption func(*ExperienceDeletionMutation)
+
+// newExperienceDeletionMutation creates new mutation for the ExperienceDeletion entity.
+func newExperienceDeletionMutation(c config, op Op, opts ...experiencedeletionOption) *ExperienceDeletionMutation {
+	m := &ExperienceDeletionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExperienceDeletion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExperienceDeletionID sets the ID field of the mutation.
+func withExperienceDeletionID(id uuid.UUID) experiencedeletionOption {
+	return func(m *ExperienceDeletionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExperienceDeletion
+		)
+		m.oldValue = func(ctx context.Context) (*ExperienceDeletion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExperienceDeletion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExperienceDeletion sets the old ExperienceDeletion of the mutation.
+func withExperienceDeletion(node *ExperienceDeletion) experiencedeletionOption {
+	return func(m *ExperienceDeletionMutation) {
+		m.oldValue = func(context.Context) (*ExperienceDeletion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExperienceDeletionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExperienceDeletionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExperienceDeletion entities.
+func (m *ExperienceDeletionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExperienceDeletionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExperienceDeletionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExperienceDeletion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExperienceDeletionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExperienceDeletionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExperienceDeletion entity.
+// If the ExperienceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDeletionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExperienceDeletionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExperienceID sets the "experience_id" field.
+func (m *ExperienceDeletionMutation) SetExperienceID(u uuid.UUID) {
+	m.experience_id = &u
+}
+
+// ExperienceID returns the value of the "experience_id" field in the mutation.
+func (m *ExperienceDeletionMutation) ExperienceID() (r uuid.UUID, exists bool) {
+	v := m.experience_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExperienceID returns the old "experience_id" field's value of the ExperienceDeletion entity.
+// If the ExperienceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExperienceDeletionMutation) OldExperienceID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExperienceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExperienceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperienceID: %w", err)
+	}
+	return oldValue.ExperienceID, nil
+}
+
+// ResetExperienceID resets all changes to the "experience_id" field.
+func (m *ExperienceDeletionMutation) ResetExperienceID() {
+	m.experience_id = nil
+}
+
+// Where appends a list predicates to the ExperienceDeletionMutation builder.
+func (m *ExperienceDeletionMutation) Where(ps ...predicate.ExperienceDeletion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExperienceDeletionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExperienceDeletionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExperienceDeletion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExperienceDeletionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExperienceDeletionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExperienceDeletion).
+func (m *ExperienceDeletionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExperienceDeletionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.created_at != nil {
+		fields = append(fields, experiencedeletion.FieldCreatedAt)
+	}
+	if m.experience_id != nil {
+		fields = append(fields, experiencedeletion.FieldExperienceID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExperienceDeletionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case experiencedeletion.FieldCreatedAt:
+		return m.CreatedAt()
+	case experiencedeletion.FieldExperienceID:
+		return m.ExperienceID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExperienceDeletionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case experiencedeletion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case experiencedeletion.FieldExperienceID:
+		return m.OldExperienceID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExperienceDeletion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExperienceDeletionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case experiencedeletion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case experiencedeletion.FieldExperienceID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperienceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceDeletion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExperienceDeletionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExperienceDeletionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExperienceDeletionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExperienceDeletion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExperienceDeletionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExperienceDeletionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExperienceDeletionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExperienceDeletion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExperienceDeletionMutation) ResetField(name string) error {
+	switch name {
+	case experiencedeletion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case experiencedeletion.FieldExperienceID:
+		m.ResetExperienceID()
+		return nil
+	}
+	return fmt.Errorf("unknown ExperienceDeletion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExperienceDeletionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExperienceDeletionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExperienceDeletionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExperienceDeletionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExperienceDeletionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExperienceDeletionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExperienceDeletionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExperienceDeletion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExperienceDeletionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExperienceDeletion edge %s", name)
+}
+
+// ExportMutation represents an operation that mutates the Export nodes in the graph.
+type ExportMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	name                *string
+	format              *string
+	provider            *string
+	bucket              *string
+	region              *string
+	endpoint            *string
+	prefix              *string
+	access_key_id       *string
+	secret_access_key   *string
+	interval_minutes    *int
+	addinterval_minutes *int
+	enabled             *bool
+	cursor_at           *time.Time
+	cursor_id           *uuid.UUID
+	next_run_at         *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Export, error)
+	predicates          []predicate.Export
+}
+
+var _ ent.Mutation = (*ExportMutation)(nil)
+
+// exportOption allows management of the mutation configuration using functional options.
+type exportOption func(*ExportMutation)
+
+// newExportMutation creates new mutation for the Export entity.
+func newExportMutation(c config, op Op, opts ...exportOption) *ExportMutation {
+	m := &ExportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExportID sets the ID field of the mutation.
+func withExportID(id uuid.UUID) exportOption {
+	return func(m *ExportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Export
+		)
+		m.oldValue = func(ctx context.Context) (*Export, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Export.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExport sets the old Export of the mutation.
+func withExport(node *Export) exportOption {
+	return func(m *ExportMutation) {
+		m.oldValue = func(context.Context) (*Export, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Export entities.
+func (m *ExportMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExportMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Export.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExportMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExportMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExportMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *ExportMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ExportMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ExportMutation) ResetName() {
+	m.name = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *ExportMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *ExportMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *ExportMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *ExportMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ExportMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *ExportMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetBucket sets the "bucket" field.
+func (m *ExportMutation) SetBucket(s string) {
+	m.bucket = &s
+}
+
+// Bucket returns the value of the "bucket" field in the mutation.
+func (m *ExportMutation) Bucket() (r string, exists bool) {
+	v := m.bucket
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBucket returns the old "bucket" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldBucket(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBucket is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBucket requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBucket: %w", err)
+	}
+	return oldValue.Bucket, nil
+}
+
+// ResetBucket resets all changes to the "bucket" field.
+func (m *ExportMutation) ResetBucket() {
+	m.bucket = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *ExportMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *ExportMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ClearRegion clears the value of the "region" field.
+func (m *ExportMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[export.FieldRegion] = struct{}{}
+}
+
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *ExportMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[export.FieldRegion]
+	return ok
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *ExportMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, export.FieldRegion)
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (m *ExportMutation) SetEndpoint(s string) {
+	m.endpoint = &s
+}
+
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *ExportMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpoint returns the old "endpoint" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+	}
+	return oldValue.Endpoint, nil
+}
+
+// ClearEndpoint clears the value of the "endpoint" field.
+func (m *ExportMutation) ClearEndpoint() {
+	m.endpoint = nil
+	m.clearedFields[export.FieldEndpoint] = struct{}{}
+}
+
+// EndpointCleared returns if the "endpoint" field was cleared in this mutation.
+func (m *ExportMutation) EndpointCleared() bool {
+	_, ok := m.clearedFields[export.FieldEndpoint]
+	return ok
+}
+
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *ExportMutation) ResetEndpoint() {
+	m.endpoint = nil
+	delete(m.clearedFields, export.FieldEndpoint)
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *ExportMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *ExportMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ClearPrefix clears the value of the "prefix" field.
+func (m *ExportMutation) ClearPrefix() {
+	m.prefix = nil
+	m.clearedFields[export.FieldPrefix] = struct{}{}
+}
+
+// PrefixCleared returns if the "prefix" field was cleared in this mutation.
+func (m *ExportMutation) PrefixCleared() bool {
+	_, ok := m.clearedFields[export.FieldPrefix]
+	return ok
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *ExportMutation) ResetPrefix() {
+	m.prefix = nil
+	delete(m.clearedFields, export.FieldPrefix)
+}
+
+// SetAccessKeyID sets the "access_key_id" field.
+func (m *ExportMutation) SetAccessKeyID(s string) {
+	m.access_key_id = &s
+}
+
+// AccessKeyID returns the value of the "access_key_id" field in the mutation.
+func (m *ExportMutation) AccessKeyID() (r string, exists bool) {
+	v := m.access_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessKeyID returns the old "access_key_id" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldAccessKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessKeyID: %w", err)
+	}
+	return oldValue.AccessKeyID, nil
+}
+
+// ClearAccessKeyID clears the value of the "access_key_id" field.
+func (m *ExportMutation) ClearAccessKeyID() {
+	m.access_key_id = nil
+	m.clearedFields[export.FieldAccessKeyID] = struct{}{}
+}
+
+// AccessKeyIDCleared returns if the "access_key_id" field was cleared in this mutation.
+func (m *ExportMutation) AccessKeyIDCleared() bool {
+	_, ok := m.clearedFields[export.FieldAccessKeyID]
+	return ok
+}
+
+// ResetAccessKeyID resets all changes to the "access_key_id" field.
+func (m *ExportMutation) ResetAccessKeyID() {
+	m.access_key_id = nil
+	delete(m.clearedFields, export.FieldAccessKeyID)
+}
+
+// SetSecretAccessKey sets the "secret_access_key" field.
+func (m *ExportMutation) SetSecretAccessKey(s string) {
+	m.secret_access_key = &s
+}
+
+// SecretAccessKey returns the value of the "secret_access_key" field in the mutation.
+func (m *ExportMutation) SecretAccessKey() (r string, exists bool) {
+	v := m.secret_access_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecretAccessKey returns the old "secret_access_key" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldSecretAccessKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretAccessKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretAccessKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretAccessKey: %w", err)
+	}
+	return oldValue.SecretAccessKey, nil
+}
+
+// ClearSecretAccessKey clears the value of the "secret_access_key" field.
+func (m *ExportMutation) ClearSecretAccessKey() {
+	m.secret_access_key = nil
+	m.clearedFields[export.FieldSecretAccessKey] = struct{}{}
+}
+
+// SecretAccessKeyCleared returns if the "secret_access_key" field was cleared in this mutation.
+func (m *ExportMutation) SecretAccessKeyCleared() bool {
+	_, ok := m.clearedFields[export.FieldSecretAccessKey]
+	return ok
+}
+
+// ResetSecretAccessKey resets all changes to the "secret_access_key" field.
+func (m *ExportMutation) ResetSecretAccessKey() {
+	m.secret_access_key = nil
+	delete(m.clearedFields, export.FieldSecretAccessKey)
+}
+
+// SetIntervalMinutes sets the "interval_minutes" field.
+func (m *ExportMutation) SetIntervalMinutes(i int) {
+	m.interval_minutes = &i
+	m.addinterval_minutes = nil
+}
+
+// IntervalMinutes returns the value of the "interval_minutes" field in the mutation.
+func (m *ExportMutation) IntervalMinutes() (r int, exists bool) {
+	v := m.interval_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntervalMinutes returns the old "interval_minutes" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldIntervalMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntervalMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntervalMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntervalMinutes: %w", err)
+	}
+	return oldValue.IntervalMinutes, nil
+}
+
+// AddIntervalMinutes adds i to the "interval_minutes" field.
+func (m *ExportMutation) AddIntervalMinutes(i int) {
+	if m.addinterval_minutes != nil {
+		*m.addinterval_minutes += i
+	} else {
+		m.addinterval_minutes = &i
+	}
+}
+
+// AddedIntervalMinutes returns the value that was added to the "interval_minutes" field in this mutation.
+func (m *ExportMutation) AddedIntervalMinutes() (r int, exists bool) {
+	v := m.addinterval_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIntervalMinutes resets all changes to the "interval_minutes" field.
+func (m *ExportMutation) ResetIntervalMinutes() {
+	m.interval_minutes = nil
+	m.addinterval_minutes = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ExportMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ExportMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ExportMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCursorAt sets the "cursor_at" field.
+func (m *ExportMutation) SetCursorAt(t time.Time) {
+	m.cursor_at = &t
+}
+
+// CursorAt returns the value of the "cursor_at" field in the mutation.
+func (m *ExportMutation) CursorAt() (r time.Time, exists bool) {
+	v := m.cursor_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCursorAt returns the old "cursor_at" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldCursorAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCursorAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCursorAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCursorAt: %w", err)
+	}
+	return oldValue.CursorAt, nil
+}
+
+// ClearCursorAt clears the value of the "cursor_at" field.
+func (m *ExportMutation) ClearCursorAt() {
+	m.cursor_at = nil
+	m.clearedFields[export.FieldCursorAt] = struct{}{}
+}
+
+// CursorAtCleared returns if the "cursor_at" field was cleared in this mutation.
+func (m *ExportMutation) CursorAtCleared() bool {
+	_, ok := m.clearedFields[export.FieldCursorAt]
+	return ok
+}
+
+// ResetCursorAt resets all changes to the "cursor_at" field.
+func (m *ExportMutation) ResetCursorAt() {
+	m.cursor_at = nil
+	delete(m.clearedFields, export.FieldCursorAt)
+}
+
+// SetCursorID sets the "cursor_id" field.
+func (m *ExportMutation) SetCursorID(u uuid.UUID) {
+	m.cursor_id = &u
+}
+
+// CursorID returns the value of the "cursor_id" field in the mutation.
+func (m *ExportMutation) CursorID() (r uuid.UUID, exists bool) {
+	v := m.cursor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCursorID returns the old "cursor_id" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldCursorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCursorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCursorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCursorID: %w", err)
+	}
+	return oldValue.CursorID, nil
+}
+
+// ClearCursorID clears the value of the "cursor_id" field.
+func (m *ExportMutation) ClearCursorID() {
+	m.cursor_id = nil
+	m.clearedFields[export.FieldCursorID] = struct{}{}
+}
+
+// CursorIDCleared returns if the "cursor_id" field was cleared in this mutation.
+func (m *ExportMutation) CursorIDCleared() bool {
+	_, ok := m.clearedFields[export.FieldCursorID]
+	return ok
+}
+
+// ResetCursorID resets all changes to the "cursor_id" field.
+func (m *ExportMutation) ResetCursorID() {
+	m.cursor_id = nil
+	delete(m.clearedFields, export.FieldCursorID)
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *ExportMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *ExportMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the Export entity.
+// If the Export object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportMutation) OldNextRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *ExportMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+}
+
+// Where appends a list predicates to the ExportMutation builder.
+func (m *ExportMutation) Where(ps ...predicate.Export) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Export, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Export).
+func (m *ExportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExportMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.created_at != nil {
+		fields = append(fields, export.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, export.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, export.FieldName)
+	}
+	if m.format != nil {
+		fields = append(fields, export.FieldFormat)
+	}
+	if m.provider != nil {
+		fields = append(fields, export.FieldProvider)
+	}
+	if m.bucket != nil {
+		fields = append(fields, export.FieldBucket)
+	}
+	if m.region != nil {
+		fields = append(fields, export.FieldRegion)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, export.FieldEndpoint)
+	}
+	if m.prefix != nil {
+		fields = append(fields, export.FieldPrefix)
+	}
+	if m.access_key_id != nil {
+		fields = append(fields, export.FieldAccessKeyID)
+	}
+	if m.secret_access_key != nil {
+		fields = append(fields, export.FieldSecretAccessKey)
+	}
+	if m.interval_minutes != nil {
+		fields = append(fields, export.FieldIntervalMinutes)
+	}
+	if m.enabled != nil {
+		fields = append(fields, export.FieldEnabled)
+	}
+	if m.cursor_at != nil {
+		fields = append(fields, export.FieldCursorAt)
+	}
+	if m.cursor_id != nil {
+		fields = append(fields, export.FieldCursorID)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, export.FieldNextRunAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case export.FieldCreatedAt:
+		return m.CreatedAt()
+	case export.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case export.FieldName:
+		return m.Name()
+	case export.FieldFormat:
+		return m.Format()
+	case export.FieldProvider:
+		return m.Provider()
+	case export.FieldBucket:
+		return m.Bucket()
+	case export.FieldRegion:
+		return m.Region()
+	case export.FieldEndpoint:
+		return m.Endpoint()
+	case export.FieldPrefix:
+		return m.Prefix()
+	case export.FieldAccessKeyID:
+		return m.AccessKeyID()
+	case export.FieldSecretAccessKey:
+		return m.SecretAccessKey()
+	case export.FieldIntervalMinutes:
+		return m.IntervalMinutes()
+	case export.FieldEnabled:
+		return m.Enabled()
+	case export.FieldCursorAt:
+		return m.CursorAt()
+	case export.FieldCursorID:
+		return m.CursorID()
+	case export.FieldNextRunAt:
+		return m.NextRunAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case export.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case export.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case export.FieldName:
+		return m.OldName(ctx)
+	case export.FieldFormat:
+		return m.OldFormat(ctx)
+	case export.FieldProvider:
+		return m.OldProvider(ctx)
+	case export.FieldBucket:
+		return m.OldBucket(ctx)
+	case export.FieldRegion:
+		return m.OldRegion(ctx)
+	case export.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case export.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case export.FieldAccessKeyID:
+		return m.OldAccessKeyID(ctx)
+	case export.FieldSecretAccessKey:
+		return m.OldSecretAccessKey(ctx)
+	case export.FieldIntervalMinutes:
+		return m.OldIntervalMinutes(ctx)
+	case export.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case export.FieldCursorAt:
+		return m.OldCursorAt(ctx)
+	case export.FieldCursorID:
+		return m.OldCursorID(ctx)
+	case export.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Export field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case export.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case export.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case export.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case export.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case export.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case export.FieldBucket:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBucket(v)
+		return nil
+	case export.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case export.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case export.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case export.FieldAccessKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessKeyID(v)
+		return nil
+	case export.FieldSecretAccessKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretAccessKey(v)
+		return nil
+	case export.FieldIntervalMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntervalMinutes(v)
+		return nil
+	case export.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case export.FieldCursorAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCursorAt(v)
+		return nil
+	case export.FieldCursorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCursorID(v)
+		return nil
+	case export.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Export field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExportMutation) AddedFields() []string {
+	var fields []string
+	if m.addinterval_minutes != nil {
+		fields = append(fields, export.FieldIntervalMinutes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case export.FieldIntervalMinutes:
+		return m.AddedIntervalMinutes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case export.FieldIntervalMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntervalMinutes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Export numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(export.FieldRegion) {
+		fields = append(fields, export.FieldRegion)
+	}
+	if m.FieldCleared(export.FieldEndpoint) {
+		fields = append(fields, export.FieldEndpoint)
+	}
+	if m.FieldCleared(export.FieldPrefix) {
+		fields = append(fields, export.FieldPrefix)
+	}
+	if m.FieldCleared(export.FieldAccessKeyID) {
+		fields = append(fields, export.FieldAccessKeyID)
+	}
+	if m.FieldCleared(export.FieldSecretAccessKey) {
+		fields = append(fields, export.FieldSecretAccessKey)
+	}
+	if m.FieldCleared(export.FieldCursorAt) {
+		fields = append(fields, export.FieldCursorAt)
+	}
+	if m.FieldCleared(export.FieldCursorID) {
+		fields = append(fields, export.FieldCursorID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExportMutation) ClearField(name string) error {
+	switch name {
+	case export.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case export.FieldEndpoint:
+		m.ClearEndpoint()
+		return nil
+	case export.FieldPrefix:
+		m.ClearPrefix()
+		return nil
+	case export.FieldAccessKeyID:
+		m.ClearAccessKeyID()
+		return nil
+	case export.FieldSecretAccessKey:
+		m.ClearSecretAccessKey()
+		return nil
+	case export.FieldCursorAt:
+		m.ClearCursorAt()
+		return nil
+	case export.FieldCursorID:
+		m.ClearCursorID()
+		return nil
+	}
+	return fmt.Errorf("unknown Export nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExportMutation) ResetField(name string) error {
+	switch name {
+	case export.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case export.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case export.FieldName:
+		m.ResetName()
+		return nil
+	case export.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case export.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case export.FieldBucket:
+		m.ResetBucket()
+		return nil
+	case export.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case export.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case export.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case export.FieldAccessKeyID:
+		m.ResetAccessKeyID()
+		return nil
+	case export.FieldSecretAccessKey:
+		m.ResetSecretAccessKey()
+		return nil
+	case export.FieldIntervalMinutes:
+		m.ResetIntervalMinutes()
+		return nil
+	case export.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case export.FieldCursorAt:
+		m.ResetCursorAt()
+		return nil
+	case export.FieldCursorID:
+		m.ResetCursorID()
+		return nil
+	case export.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Export field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExportMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExportMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExportMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Export unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExportMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Export edge %s", name)
+}
+
+// ExportRunMutation represents an operation that mutates the ExportRun nodes in the graph.
+type ExportRunMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	status         *string
+	experiences    *int
+	addexperiences *int
+	bytes          *int64
+	addbytes       *int64
+	objects        *[]string
+	appendobjects  []string
+	error          *string
+	finished_at    *time.Time
+	clearedFields  map[string]struct{}
+	export         *uuid.UUID
+	clearedexport  bool
+	done           bool
+	oldValue       func(context.Context) (*ExportRun, error)
+	predicates     []predicate.ExportRun
+}
+
+var _ ent.Mutation = (*ExportRunMutation)(nil)
+
+// exportrunOption allows management of the mutation configuration using functional options.
+type exportrunOption func(*ExportRunMutation)
+
+// newExportRunMutation creates new mutation for the ExportRun entity.
+func newExportRunMutation(c config, op Op, opts ...exportrunOption) *ExportRunMutation {
+	m := &ExportRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExportRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExportRunID sets the ID field of the mutation.
+func withExportRunID(id uuid.UUID) exportrunOption {
+	return func(m *ExportRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExportRun
+		)
+		m.oldValue = func(ctx context.Context) (*ExportRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExportRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExportRun sets the old ExportRun of the mutation.
+func withExportRun(node *ExportRun) exportrunOption {
+	return func(m *ExportRunMutation) {
+		m.oldValue = func(context.Context) (*ExportRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExportRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExportRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExportRun entities.
+func (m *ExportRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExportRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExportRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExportRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExportRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExportRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExportRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExportID sets the "export_id" field.
+func (m *ExportRunMutation) SetExportID(u uuid.UUID) {
+	m.export = &u
+}
+
+// ExportID returns the value of the "export_id" field in the mutation.
+func (m *ExportRunMutation) ExportID() (r uuid.UUID, exists bool) {
+	v := m.export
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExportID returns the old "export_id" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldExportID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExportID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExportID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExportID: %w", err)
+	}
+	return oldValue.ExportID, nil
+}
+
+// ResetExportID resets all changes to the "export_id" field.
+func (m *ExportRunMutation) ResetExportID() {
+	m.export = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ExportRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ExportRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ExportRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExperiences sets the "experiences" field.
+func (m *ExportRunMutation) SetExperiences(i int) {
+	m.experiences = &i
+	m.addexperiences = nil
+}
+
+// Experiences returns the value of the "experiences" field in the mutation.
+func (m *ExportRunMutation) Experiences() (r int, exists bool) {
+	v := m.experiences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExperiences returns the old "experiences" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldExperiences(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExperiences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExperiences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperiences: %w", err)
+	}
+	return oldValue.Experiences, nil
+}
+
+// AddExperiences adds i to the "experiences" field.
+func (m *ExportRunMutation) AddExperiences(i int) {
+	if m.addexperiences != nil {
+		*m.addexperiences += i
+	} else {
+		m.addexperiences = &i
+	}
+}
+
+// AddedExperiences returns the value that was added to the "experiences" field in this mutation.
+func (m *ExportRunMutation) AddedExperiences() (r int, exists bool) {
+	v := m.addexperiences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExperiences resets all changes to the "experiences" field.
+func (m *ExportRunMutation) ResetExperiences() {
+	m.experiences = nil
+	m.addexperiences = nil
+}
+
+// SetBytes sets the "bytes" field.
+func (m *ExportRunMutation) SetBytes(i int64) {
+	m.bytes = &i
+	m.addbytes = nil
+}
+
+// Bytes returns the value of the "bytes" field in the mutation.
+func (m *ExportRunMutation) Bytes() (r int64, exists bool) {
+	v := m.bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBytes returns the old "bytes" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBytes: %w", err)
+	}
+	return oldValue.Bytes, nil
+}
+
+// AddBytes adds i to the "bytes" field.
+func (m *ExportRunMutation) AddBytes(i int64) {
+	if m.addbytes != nil {
+		*m.addbytes += i
+	} else {
+		m.addbytes = &i
+	}
+}
+
+// AddedBytes returns the value that was added to the "bytes" field in this mutation.
+func (m *ExportRunMutation) AddedBytes() (r int64, exists bool) {
+	v := m.addbytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBytes resets all changes to the "bytes" field.
+func (m *ExportRunMutation) ResetBytes() {
+	m.bytes = nil
+	m.addbytes = nil
+}
+
+// SetObjects sets the "objects" field.
+func (m *ExportRunMutation) SetObjects(s []string) {
+	m.objects = &s
+	m.appendobjects = nil
+}
+
+// Objects returns the value of the "objects" field in the mutation.
+func (m *ExportRunMutation) Objects() (r []string, exists bool) {
+	v := m.objects
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjects returns the old "objects" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldObjects(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjects is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjects requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjects: %w", err)
+	}
+	return oldValue.Objects, nil
+}
+
+// AppendObjects adds s to the "objects" field.
+func (m *ExportRunMutation) AppendObjects(s []string) {
+	m.appendobjects = append(m.appendobjects, s...)
+}
+
+// AppendedObjects returns the list of values that were appended to the "objects" field in this mutation.
+func (m *ExportRunMutation) AppendedObjects() ([]string, bool) {
+	if len(m.appendobjects) == 0 {
+		return nil, false
+	}
+	return m.appendobjects, true
+}
+
+// ClearObjects clears the value of the "objects" field.
+func (m *ExportRunMutation) ClearObjects() {
+	m.objects = nil
+	m.appendobjects = nil
+	m.clearedFields[exportrun.FieldObjects] = struct{}{}
+}
+
+// ObjectsCleared returns if the "objects" field was cleared in this mutation.
+func (m *ExportRunMutation) ObjectsCleared() bool {
+	_, ok := m.clearedFields[exportrun.FieldObjects]
+	return ok
+}
+
+// ResetObjects resets all changes to the "objects" field.
+func (m *ExportRunMutation) ResetObjects() {
+	m.objects = nil
+	m.appendobjects = nil
+	delete(m.clearedFields, exportrun.FieldObjects)
+}
+
+// SetError sets the "error" field.
+func (m *ExportRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ExportRunMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ExportRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[exportrun.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ExportRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[exportrun.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ExportRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, exportrun.FieldError)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *ExportRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ExportRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the ExportRun entity.
+// If the ExportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExportRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ExportRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[exportrun.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ExportRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[exportrun.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ExportRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, exportrun.FieldFinishedAt)
+}
+
+// ClearExport clears the "export" edge to the Export entity.
+func (m *ExportRunMutation) ClearExport() {
+	m.clearedexport = true
+	m.clearedFields[exportrun.FieldExportID] = struct{}{}
+}
+
+// ExportCleared reports if the "export" edge to the Export entity was cleared.
+func (m *ExportRunMutation) ExportCleared() bool {
+	return m.clearedexport
+}
+
+// ExportIDs returns the "export" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExportID instead. It exists only for internal usage by the builders.
+func (m *ExportRunMutation) ExportIDs() (ids []uuid.UUID) {
+	if id := m.export; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetExport resets all changes to the "export" edge.
+func (m *ExportRunMutation) ResetExport() {
+	m.export = nil
+	m.clearedexport = false
+}
+
+// Where appends a list predicates to the ExportRunMutation builder.
+func (m *ExportRunMutation) Where(ps ...predicate.ExportRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExportRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExportRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExportRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExportRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExportRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExportRun).
+func (m *ExportRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExportRunMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, exportrun.FieldCreatedAt)
+	}
+	if m.export != nil {
+		fields = append(fields, exportrun.FieldExportID)
+	}
+	if m.status != nil {
+		fields = append(fields, exportrun.FieldStatus)
+	}
+	if m.experiences != nil {
+		fields = append(fields, exportrun.FieldExperiences)
+	}
+	if m.bytes != nil {
+		fields = append(fields, exportrun.FieldBytes)
+	}
+	if m.objects != nil {
+		fields = append(fields, exportrun.FieldObjects)
+	}
+	if m.error != nil {
+		fields = append(fields, exportrun.FieldError)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, exportrun.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExportRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exportrun.FieldCreatedAt:
+		return m.CreatedAt()
+	case exportrun.FieldExportID:
+		return m.ExportID()
+	case exportrun.FieldStatus:
+		return m.Status()
+	case exportrun.FieldExperiences:
+		return m.Experiences()
+	case exportrun.FieldBytes:
+		return m.Bytes()
+	case exportrun.FieldObjects:
+		return m.Objects()
+	case exportrun.FieldError:
+		return m.Error()
+	case exportrun.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExportRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exportrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case exportrun.FieldExportID:
+		return m.OldExportID(ctx)
+	case exportrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case exportrun.FieldExperiences:
+		return m.OldExperiences(ctx)
+	case exportrun.FieldBytes:
+		return m.OldBytes(ctx)
+	case exportrun.FieldObjects:
+		return m.OldObjects(ctx)
+	case exportrun.FieldError:
+		return m.OldError(ctx)
+	case exportrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExportRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exportrun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case exportrun.FieldExportID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExportID(v)
+		return nil
+	case exportrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case exportrun.FieldExperiences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperiences(v)
+		return nil
+	case exportrun.FieldBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBytes(v)
+		return nil
+	case exportrun.FieldObjects:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjects(v)
+		return nil
+	case exportrun.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case exportrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExportRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExportRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addexperiences != nil {
+		fields = append(fields, exportrun.FieldExperiences)
+	}
+	if m.addbytes != nil {
+		fields = append(fields, exportrun.FieldBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExportRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exportrun.FieldExperiences:
+		return m.AddedExperiences()
+	case exportrun.FieldBytes:
+		return m.AddedBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExportRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exportrun.FieldExperiences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExperiences(v)
+		return nil
+	case exportrun.FieldBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExportRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExportRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(exportrun.FieldObjects) {
+		fields = append(fields, exportrun.FieldObjects)
+	}
+	if m.FieldCleared(exportrun.FieldError) {
+		fields = append(fields, exportrun.FieldError)
+	}
+	if m.FieldCleared(exportrun.FieldFinishedAt) {
+		fields = append(fields, exportrun.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExportRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExportRunMutation) ClearField(name string) error {
+	switch name {
+	case exportrun.FieldObjects:
+		m.ClearObjects()
+		return nil
+	case exportrun.FieldError:
+		m.ClearError()
+		return nil
+	case exportrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExportRunMutation) ResetField(name string) error {
+	switch name {
+	case exportrun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case exportrun.FieldExportID:
+		m.ResetExportID()
+		return nil
+	case exportrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case exportrun.FieldExperiences:
+		m.ResetExperiences()
+		return nil
+	case exportrun.FieldBytes:
+		m.ResetBytes()
+		return nil
+	case exportrun.FieldObjects:
+		m.ResetObjects()
+		return nil
+	case exportrun.FieldError:
+		m.ResetError()
+		return nil
+	case exportrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExportRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.export != nil {
+		edges = append(edges, exportrun.EdgeExport)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExportRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case exportrun.EdgeExport:
+		if id := m.export; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExportRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExportRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExportRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedexport {
+		edges = append(edges, exportrun.EdgeExport)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExportRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case exportrun.EdgeExport:
+		return m.clearedexport
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExportRunMutation) ClearEdge(name string) error {
+	switch name {
+	case exportrun.EdgeExport:
+		m.ClearExport()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExportRunMutation) ResetEdge(name string) error {
+	switch name {
+	case exportrun.EdgeExport:
+		m.ResetExport()
+		return nil
+	}
+	return fmt.Errorf("unknown ExportRun edge %s", name)
+}
+
+// IngestMappingMutation represents an operation that mutates the IngestMapping nodes in the graph.
+type IngestMappingMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	name             *string
+	source_type      *string
+	template         *mapping.Template
+	secret           *string
+	signature_header *string
+	enabled          *bool
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*IngestMapping, error)
+	predicates       []predicate.IngestMapping
+}
+
+var _ ent.Mutation = (*IngestMappingMutation)(nil)
+
+// ingestmappingOption allows management of the mutation configuration using functional options.
+type ingestmappingOption func(*IngestMappingMutation)
+
+// newIngestMappingMutation creates new mutation for the IngestMapping entity.
+func newIngestMappingMutation(c config, op Op, opts ...ingestmappingOption) *IngestMappingMutation {
+	m := &IngestMappingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIngestMapping,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIngestMappingID sets the ID field of the mutation.
+func withIngestMappingID(id uuid.UUID) ingestmappingOption {
+	return func(m *IngestMappingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IngestMapping
+		)
+		m.oldValue = func(ctx context.Context) (*IngestMapping, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IngestMapping.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIngestMapping sets the old IngestMapping of the mutation.
+func withIngestMapping(node *IngestMapping) ingestmappingOption {
+	return func(m *IngestMappingMutation) {
+		m.oldValue = func(context.Context) (*IngestMapping, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IngestMappingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IngestMappingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IngestMapping entities.
+func (m *IngestMappingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IngestMappingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IngestMappingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IngestMapping.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IngestMappingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IngestMappingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IngestMappingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IngestMappingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IngestMappingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IngestMappingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *IngestMappingMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *IngestMappingMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *IngestMappingMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSourceType sets the "source_type" field.
+func (m *IngestMappingMutation) SetSourceType(s string) {
+	m.source_type = &s
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *IngestMappingMutation) SourceType() (r string, exists bool) {
+	v := m.source_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceType returns the old "source_type" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldSourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+	}
+	return oldValue.SourceType, nil
+}
+
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *IngestMappingMutation) ResetSourceType() {
+	m.source_type = nil
+}
+
+// SetTemplate sets the "template" field.
+func (m *IngestMappingMutation) SetTemplate(value mapping.Template) {
+	m.template = &value
+}
+
+// Template returns the value of the "template" field in the mutation.
+func (m *IngestMappingMutation) Template() (r mapping.Template, exists bool) {
+	v := m.template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplate returns the old "template" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldTemplate(ctx context.Context) (v mapping.Template, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplate: %w", err)
+	}
+	return oldValue.Template, nil
+}
+
+// ResetTemplate resets all changes to the "template" field.
+func (m *IngestMappingMutation) ResetTemplate() {
+	m.template = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *IngestMappingMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *IngestMappingMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *IngestMappingMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetSignatureHeader sets the "signature_header" field.
+func (m *IngestMappingMutation) SetSignatureHeader(s string) {
+	m.signature_header = &s
+}
+
+// SignatureHeader returns the value of the "signature_header" field in the mutation.
+func (m *IngestMappingMutation) SignatureHeader() (r string, exists bool) {
+	v := m.signature_header
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignatureHeader returns the old "signature_header" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldSignatureHeader(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignatureHeader is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignatureHeader requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignatureHeader: %w", err)
+	}
+	return oldValue.SignatureHeader, nil
+}
+
+// ClearSignatureHeader clears the value of the "signature_header" field.
+func (m *IngestMappingMutation) ClearSignatureHeader() {
+	m.signature_header = nil
+	m.clearedFields[ingestmapping.FieldSignatureHeader] = struct{}{}
+}
+
+// SignatureHeaderCleared returns if the "signature_header" field was cleared in this mutation.
+func (m *IngestMappingMutation) SignatureHeaderCleared() bool {
+	_, ok := m.clearedFields[ingestmapping.FieldSignatureHeader]
+	return ok
+}
+
+// ResetSignatureHeader resets all changes to the "signature_header" field.
+func (m *IngestMappingMutation) ResetSignatureHeader() {
+	m.signature_header = nil
+	delete(m.clearedFields, ingestmapping.FieldSignatureHeader)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *IngestMappingMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *IngestMappingMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the IngestMapping entity.
+// If the IngestMapping object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IngestMappingMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *IngestMappingMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// Where appends a list predicates to the IngestMappingMutation builder.
+func (m *IngestMappingMutation) Where(ps ...predicate.IngestMapping) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IngestMappingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IngestMappingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IngestMapping, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IngestMappingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IngestMappingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IngestMapping).
+func (m *IngestMappingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IngestMappingMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, ingestmapping.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, ingestmapping.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, ingestmapping.FieldName)
+	}
+	if m.source_type != nil {
+		fields = append(fields, ingestmapping.FieldSourceType)
+	}
+	if m.template != nil {
+		fields = append(fields, ingestmapping.FieldTemplate)
+	}
+	if m.secret != nil {
+		fields = append(fields, ingestmapping.FieldSecret)
+	}
+	if m.signature_header != nil {
+		fields = append(fields, ingestmapping.FieldSignatureHeader)
+	}
+	if m.enabled != nil {
+		fields = append(fields, ingestmapping.FieldEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IngestMappingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ingestmapping.FieldCreatedAt:
+		return m.CreatedAt()
+	case ingestmapping.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case ingestmapping.FieldName:
+		return m.Name()
+	case ingestmapping.FieldSourceType:
+		return m.SourceType()
+	case ingestmapping.FieldTemplate:
+		return m.Template()
+	case ingestmapping.FieldSecret:
+		return m.Secret()
+	case ingestmapping.FieldSignatureHeader:
+		return m.SignatureHeader()
+	case ingestmapping.FieldEnabled:
+		return m.Enabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IngestMappingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ingestmapping.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ingestmapping.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case ingestmapping.FieldName:
+		return m.OldName(ctx)
+	case ingestmapping.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case ingestmapping.FieldTemplate:
+		return m.OldTemplate(ctx)
+	case ingestmapping.FieldSecret:
+		return m.OldSecret(ctx)
+	case ingestmapping.FieldSignatureHeader:
+		return m.OldSignatureHeader(ctx)
+	case ingestmapping.FieldEnabled:
+		return m.OldEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown IngestMapping field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngestMappingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ingestmapping.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ingestmapping.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case ingestmapping.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case ingestmapping.FieldSourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case ingestmapping.FieldTemplate:
+		v, ok := value.(mapping.Template)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplate(v)
+		return nil
+	case ingestmapping.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case ingestmapping.FieldSignatureHeader:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignatureHeader(v)
+		return nil
+	case ingestmapping.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IngestMapping field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IngestMappingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IngestMappingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IngestMappingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IngestMapping numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IngestMappingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ingestmapping.FieldSignatureHeader) {
+		fields = append(fields, ingestmapping.FieldSignatureHeader)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IngestMappingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IngestMappingMutation) ClearField(name string) error {
+	switch name {
+	case ingestmapping.FieldSignatureHeader:
+		m.ClearSignatureHeader()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestMapping nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IngestMappingMutation) ResetField(name string) error {
+	switch name {
+	case ingestmapping.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ingestmapping.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case ingestmapping.FieldName:
+		m.ResetName()
+		return nil
+	case ingestmapping.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case ingestmapping.FieldTemplate:
+		m.ResetTemplate()
+		return nil
+	case ingestmapping.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case ingestmapping.FieldSignatureHeader:
+		m.ResetSignatureHeader()
+		return nil
+	case ingestmapping.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown IngestMapping field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IngestMappingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IngestMappingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IngestMappingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IngestMappingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IngestMappingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IngestMappingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IngestMappingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IngestMapping unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IngestMappingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IngestMapping edge %s", name)
+}
+
+// QuestionMutation represents an operation that mutates the Question nodes in the graph.
+type QuestionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	source_type   *string
+	source_id     *string
+	field_id      *string
+	field_type    *string
+	label         *string
+	labels        *map[string]string
+	metadata      *map[string]interface{}
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Question, error)
+	predicates    []predicate.Question
+}
+
+var _ ent.Mutation = (*QuestionMutation)(nil)
+
+// questionOption allows management of the mutation configuration using functional options.
+type questionOption func(*QuestionMutation)
+
+// newQuestionMutation creates new mutation for the Question entity.
+func newQuestionMutation(c config, op Op, opts ...questionOption) *QuestionMutation {
+	m := &QuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionID sets the ID field of the mutation.
+func withQuestionID(id uuid.UUID) questionOption {
+	return func(m *QuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Question
+		)
+		m.oldValue = func(ctx context.Context) (*Question, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Question.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestion sets the old Question of the mutation.
+func withQuestion(node *Question) questionOption {
+	return func(m *QuestionMutation) {
+		m.oldValue = func(context.Context) (*Question, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Question entities.
+func (m *QuestionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Question.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuestionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuestionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetSourceType sets the "source_type" field.
+func (m *QuestionMutation) SetSourceType(s string) {
+	m.source_type = &s
+}
+
+// SourceType returns the value of the "source_type" field in the mutation.
+func (m *QuestionMutation) SourceType() (r string, exists bool) {
+	v := m.source_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceType returns the old "source_type" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldSourceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceType: %w", err)
+	}
+	return oldValue.SourceType, nil
+}
+
+// ResetSourceType resets all changes to the "source_type" field.
+func (m *QuestionMutation) ResetSourceType() {
+	m.source_type = nil
+}
+
+// SetSourceID sets the "source_id" field.
+func (m *QuestionMutation) SetSourceID(s string) {
+	m.source_id = &s
+}
+
+// SourceID returns the value of the "source_id" field in the mutation.
+func (m *QuestionMutation) SourceID() (r string, exists bool) {
+	v := m.source_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceID returns the old "source_id" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldSourceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceID: %w", err)
+	}
+	return oldValue.SourceID, nil
+}
+
+// ResetSourceID resets all changes to the "source_id" field.
+func (m *QuestionMutation) ResetSourceID() {
+	m.source_id = nil
+}
+
+// SetFieldID sets the "field_id" field.
+func (m *QuestionMutation) SetFieldID(s string) {
+	m.field_id = &s
+}
+
+// FieldID returns the value of the "field_id" field in the mutation.
+func (m *QuestionMutation) FieldID() (r string, exists bool) {
+	v := m.field_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldID returns the old "field_id" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldFieldID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldID: %w", err)
+	}
+	return oldValue.FieldID, nil
+}
+
+// ResetFieldID resets all changes to the "field_id" field.
+func (m *QuestionMutation) ResetFieldID() {
+	m.field_id = nil
+}
+
+// SetFieldType sets the "field_type" field.
+func (m *QuestionMutation) SetFieldType(s string) {
+	m.field_type = &s
+}
+
+// FieldType returns the value of the "field_type" field in the mutation.
+func (m *QuestionMutation) FieldType() (r string, exists bool) {
+	v := m.field_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFieldType returns the old "field_type" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldFieldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldType: %w", err)
+	}
+	return oldValue.FieldType, nil
+}
+
+// ClearFieldType clears the value of the "field_type" field.
+func (m *QuestionMutation) ClearFieldType() {
+	m.field_type = nil
+	m.clearedFields[question.FieldFieldType] = struct{}{}
+}
+
+// FieldTypeCleared returns if the "field_type" field was cleared in this mutation.
+func (m *QuestionMutation) FieldTypeCleared() bool {
+	_, ok := m.clearedFields[question.FieldFieldType]
+	return ok
+}
+
+// ResetFieldType resets all changes to the "field_type" field.
+func (m *QuestionMutation) ResetFieldType() {
+	m.field_type = nil
+	delete(m.clearedFields, question.FieldFieldType)
+}
+
+// SetLabel sets the "label" field.
+func (m *QuestionMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *QuestionMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *QuestionMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[question.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *QuestionMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[question.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *QuestionMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, question.FieldLabel)
+}
+
+// SetLabels sets the "labels" field.
+func (m *QuestionMutation) SetLabels(value map[string]string) {
+	m.labels = &value
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *QuestionMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *QuestionMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[question.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *QuestionMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[question.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *QuestionMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, question.FieldLabels)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *QuestionMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *QuestionMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Question entity.
+// If the Question object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *QuestionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[question.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *QuestionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[question.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *QuestionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, question.FieldMetadata)
+}
+
+// Where appends a list predicates to the QuestionMutation builder.
+func (m *QuestionMutation) Where(ps ...predicate.Question) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Question, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Question).
+func (m *QuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, question.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, question.FieldUpdatedAt)
+	}
+	if m.source_type != nil {
+		fields = append(fields, question.FieldSourceType)
+	}
+	if m.source_id != nil {
+		fields = append(fields, question.FieldSourceID)
+	}
+	if m.field_id != nil {
+		fields = append(fields, question.FieldFieldID)
+	}
+	if m.field_type != nil {
+		fields = append(fields, question.FieldFieldType)
+	}
+	if m.label != nil {
+		fields = append(fields, question.FieldLabel)
+	}
+	if m.labels != nil {
+		fields = append(fields, question.FieldLabels)
+	}
+	if m.metadata != nil {
+		fields = append(fields, question.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case question.FieldCreatedAt:
+		return m.CreatedAt()
+	case question.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case question.FieldSourceType:
+		return m.SourceType()
+	case question.FieldSourceID:
+		return m.SourceID()
+	case question.FieldFieldID:
+		return m.FieldID()
+	case question.FieldFieldType:
+		return m.FieldType()
+	case question.FieldLabel:
+		return m.Label()
+	case question.FieldLabels:
+		return m.Labels()
+	case question.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case question.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case question.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case question.FieldSourceType:
+		return m.OldSourceType(ctx)
+	case question.FieldSourceID:
+		return m.OldSourceID(ctx)
+	case question.FieldFieldID:
+		return m.OldFieldID(ctx)
+	case question.FieldFieldType:
+		return m.OldFieldType(ctx)
+	case question.FieldLabel:
+		return m.OldLabel(ctx)
+	case question.FieldLabels:
+		return m.OldLabels(ctx)
+	case question.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown Question field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case question.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case question.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case question.FieldSourceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceType(v)
+		return nil
+	case question.FieldSourceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceID(v)
+		return nil
+	case question.FieldFieldID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldID(v)
+		return nil
+	case question.FieldFieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldType(v)
+		return nil
+	case question.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case question.FieldLabels:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case question.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Question numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(question.FieldFieldType) {
+		fields = append(fields, question.FieldFieldType)
+	}
+	if m.FieldCleared(question.FieldLabel) {
+		fields = append(fields, question.FieldLabel)
+	}
+	if m.FieldCleared(question.FieldLabels) {
+		fields = append(fields, question.FieldLabels)
+	}
+	if m.FieldCleared(question.FieldMetadata) {
+		fields = append(fields, question.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionMutation) ClearField(name string) error {
+	switch name {
+	case question.FieldFieldType:
+		m.ClearFieldType()
+		return nil
+	case question.FieldLabel:
+		m.ClearLabel()
+		return nil
+	case question.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case question.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Question nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionMutation) ResetField(name string) error {
+	switch name {
+	case question.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case question.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case question.FieldSourceType:
+		m.ResetSourceType()
+		return nil
+	case question.FieldSourceID:
+		m.ResetSourceID()
+		return nil
+	case question.FieldFieldID:
+		m.ResetFieldID()
+		return nil
+	case question.FieldFieldType:
+		m.ResetFieldType()
+		return nil
+	case question.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case question.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case question.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Question field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Question unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Question edge %s", name)
+}
+
+// QueuePauseMutation represents an operation that mutates the QueuePause nodes in the graph.
+type QueuePauseMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	job_type      *string
+	reason        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*QueuePause, error)
+	predicates    []predicate.QueuePause
+}
+
+var _ ent.Mutation = (*QueuePauseMutation)(nil)
+
+// queuepauseOption allows management of the mutation configuration using functional options.
+type queuepauseOption func(*QueuePauseMutation)
+
+// newQueuePauseMutation creates new mutation for the QueuePause entity.
+func newQueuePauseMutation(c config, op Op, opts ...queuepauseOption) *QueuePauseMutation {
+	m := &QueuePauseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQueuePause,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQueuePauseID sets the ID field of the mutation.
+func withQueuePauseID(id uuid.UUID) queuepauseOption {
+	return func(m *QueuePauseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QueuePause
+		)
+		m.oldValue = func(ctx context.Context) (*QueuePause, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QueuePause.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQueuePause sets the old QueuePause of the mutation.
+func withQueuePause(node *QueuePause) queuepauseOption {
+	return func(m *QueuePauseMutation) {
+		m.oldValue = func(context.Context) (*QueuePause, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QueuePauseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QueuePauseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of QueuePause entities.
+func (m *QueuePauseMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QueuePauseMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QueuePauseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QueuePause.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QueuePauseMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QueuePauseMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QueuePause entity.
+// If the QueuePause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuePauseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QueuePauseMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetJobType sets the "job_type" field.
+func (m *QueuePauseMutation) SetJobType(s string) {
+	m.job_type = &s
+}
+
+// JobType returns the value of the "job_type" field in the mutation.
+func (m *QueuePauseMutation) JobType() (r string, exists bool) {
+	v := m.job_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobType returns the old "job_type" field's value of the QueuePause entity.
+// If the QueuePause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuePauseMutation) OldJobType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
+	}
+	return oldValue.JobType, nil
+}
+
+// ResetJobType resets all changes to the "job_type" field.
+func (m *QueuePauseMutation) ResetJobType() {
+	m.job_type = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *QueuePauseMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *QueuePauseMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the QueuePause entity.
+// If the QueuePause object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QueuePauseMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *QueuePauseMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[queuepause.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *QueuePauseMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[queuepause.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *QueuePauseMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, queuepause.FieldReason)
+}
+
+// Where appends a list predicates to the QueuePauseMutation builder.
+func (m *QueuePauseMutation) Where(ps ...predicate.QueuePause) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QueuePauseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QueuePauseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QueuePause, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QueuePauseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QueuePauseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QueuePause).
+func (m *QueuePauseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QueuePauseMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, queuepause.FieldCreatedAt)
+	}
+	if m.job_type != nil {
+		fields = append(fields, queuepause.FieldJobType)
+	}
+	if m.reason != nil {
+		fields = append(fields, queuepause.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QueuePauseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case queuepause.FieldCreatedAt:
+		return m.CreatedAt()
+	case queuepause.FieldJobType:
+		return m.JobType()
+	case queuepause.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QueuePauseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case queuepause.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case queuepause.FieldJobType:
+		return m.OldJobType(ctx)
+	case queuepause.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown QueuePause field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueuePauseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case queuepause.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case queuepause.FieldJobType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobType(v)
+		return nil
+	case queuepause.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QueuePause field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QueuePauseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QueuePauseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QueuePauseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown QueuePause numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QueuePauseMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(queuepause.FieldReason) {
+		fields = append(fields, queuepause.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QueuePauseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QueuePauseMutation) ClearField(name string) error {
+	switch name {
+	case queuepause.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown QueuePause nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QueuePauseMutation) ResetField(name string) error {
+	switch name {
+	case queuepause.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case queuepause.FieldJobType:
+		m.ResetJobType()
+		return nil
+	case queuepause.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown QueuePause field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QueuePauseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QueuePauseMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QueuePauseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QueuePauseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QueuePauseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QueuePauseMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QueuePauseMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown QueuePause unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QueuePauseMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown QueuePause edge %s", name)
+}
+
+// SegmentMutation represents an operation that mutates the Segment nodes in the graph.
+type SegmentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	updated_at    *time.Time
+	name          *string
+	description   *string
+	filter        *experiencefilter.Filter
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Segment, error)
+	predicates    []predicate.Segment
+}
+
+var _ ent.Mutation = (*SegmentMutation)(nil)
+
+// segmentOption allows management of the mutation configuration using functional options.
+type segmentOption func(*SegmentMutation)
+
+// newSegmentMutation creates new mutation for the Segment entity.
+func newSegmentMutation(c config, op Op, opts ...segmentOption) *SegmentMutation {
+	m := &SegmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSegment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSegmentID sets the ID field of the mutation.
+func withSegmentID(id uuid.UUID) segmentOption {
+	return func(m *SegmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Segment
+		)
+		m.oldValue = func(ctx context.Context) (*Segment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Segment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSegment sets the old Segment of the mutation.
+func withSegment(node *Segment) segmentOption {
+	return func(m *SegmentMutation) {
+		m.oldValue = func(context.Context) (*Segment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SegmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SegmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Segment entities.
+func (m *SegmentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SegmentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SegmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Segment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SegmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SegmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SegmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SegmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SegmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SegmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *SegmentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SegmentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SegmentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *SegmentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *SegmentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *SegmentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[segment.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *SegmentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[segment.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *SegmentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, segment.FieldDescription)
+}
+
+// SetFilter sets the "filter" field.
+func (m *SegmentMutation) SetFilter(e experiencefilter.Filter) {
+	m.filter = &e
+}
+
+// Filter returns the value of the "filter" field in the mutation.
+func (m *SegmentMutation) Filter() (r experiencefilter.Filter, exists bool) {
+	v := m.filter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilter returns the old "filter" field's value of the Segment entity.
+// If the Segment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SegmentMutation) OldFilter(ctx context.Context) (v experiencefilter.Filter, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilter: %w", err)
+	}
+	return oldValue.Filter, nil
+}
+
+// ResetFilter resets all changes to the "filter" field.
+func (m *SegmentMutation) ResetFilter() {
+	m.filter = nil
+}
+
+// Where appends a list predicates to the SegmentMutation builder.
+func (m *SegmentMutation) Where(ps ...predicate.Segment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SegmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SegmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Segment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SegmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SegmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Segment).
+func (m *SegmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SegmentMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, segment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, segment.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, segment.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, segment.FieldDescription)
+	}
+	if m.filter != nil {
+		fields = append(fields, segment.FieldFilter)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SegmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case segment.FieldCreatedAt:
+		return m.CreatedAt()
+	case segment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case segment.FieldName:
+		return m.Name()
+	case segment.FieldDescription:
+		return m.Description()
+	case segment.FieldFilter:
+		return m.Filter()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SegmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case segment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case segment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case segment.FieldName:
+		return m.OldName(ctx)
+	case segment.FieldDescription:
+		return m.OldDescription(ctx)
+	case segment.FieldFilter:
+		return m.OldFilter(ctx)
+	}
+	return nil, fmt.Errorf("unknown Segment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SegmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case segment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case segment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case segment.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case segment.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case segment.FieldFilter:
+		v, ok := value.(experiencefilter.Filter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Segment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SegmentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SegmentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SegmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Segment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SegmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(segment.FieldDescription) {
+		fields = append(fields, segment.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SegmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SegmentMutation) ClearField(name string) error {
+	switch name {
+	case segment.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Segment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SegmentMutation) ResetField(name string) error {
+	switch name {
+	case segment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case segment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case segment.FieldName:
+		m.ResetName()
+		return nil
+	case segment.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case segment.FieldFilter:
+		m.ResetFilter()
+		return nil
+	}
+	return fmt.Errorf("unknown Segment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SegmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SegmentMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SegmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SegmentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SegmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SegmentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SegmentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Segment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SegmentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Segment edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	event_type         *string
+	payload            *string
+	status             *string
+	attempts           *int
+	addattempts        *int
+	response_status    *int
+	addresponse_status *int
+	duration_ms        *int
+	addduration_ms     *int
+	error              *string
+	redelivery_of      *uuid.UUID
+	delivered_at       *time.Time
+	clearedFields      map[string]struct{}
+	endpoint           *uuid.UUID
+	clearedendpoint    bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookDelivery
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetEndpointID sets the "endpoint_id" field.
+func (m *WebhookDeliveryMutation) SetEndpointID(u uuid.UUID) {
+	m.endpoint = &u
+}
+
+// EndpointID returns the value of the "endpoint_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EndpointID() (r uuid.UUID, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpointID returns the old "endpoint_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEndpointID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpointID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpointID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpointID: %w", err)
+	}
+	return oldValue.EndpointID, nil
+}
+
+// ResetEndpointID resets all changes to the "endpoint_id" field.
+func (m *WebhookDeliveryMutation) ResetEndpointID() {
+	m.endpoint = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
+	}
+	return oldValue.ResponseStatus, nil
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
+}
+
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *WebhookDeliveryMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *WebhookDeliveryMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDurationMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[webhookdelivery.FieldDurationMs] = struct{}{}
+}
+
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDurationMs]
+	return ok
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *WebhookDeliveryMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, webhookdelivery.FieldDurationMs)
+}
+
+// SetError sets the "error" field.
+func (m *WebhookDeliveryMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WebhookDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *WebhookDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[webhookdelivery.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *WebhookDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, webhookdelivery.FieldError)
+}
+
+// SetRedeliveryOf sets the "redelivery_of" field.
+func (m *WebhookDeliveryMutation) SetRedeliveryOf(u uuid.UUID) {
+	m.redelivery_of = &u
+}
+
+// RedeliveryOf returns the value of the "redelivery_of" field in the mutation.
+func (m *WebhookDeliveryMutation) RedeliveryOf() (r uuid.UUID, exists bool) {
+	v := m.redelivery_of
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedeliveryOf returns the old "redelivery_of" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldRedeliveryOf(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedeliveryOf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedeliveryOf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedeliveryOf: %w", err)
+	}
+	return oldValue.RedeliveryOf, nil
+}
+
+// ClearRedeliveryOf clears the value of the "redelivery_of" field.
+func (m *WebhookDeliveryMutation) ClearRedeliveryOf() {
+	m.redelivery_of = nil
+	m.clearedFields[webhookdelivery.FieldRedeliveryOf] = struct{}{}
+}
+
+// RedeliveryOfCleared returns if the "redelivery_of" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) RedeliveryOfCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldRedeliveryOf]
+	return ok
+}
+
+// ResetRedeliveryOf resets all changes to the "redelivery_of" field.
+func (m *WebhookDeliveryMutation) ResetRedeliveryOf() {
+	m.redelivery_of = nil
+	delete(m.clearedFields, webhookdelivery.FieldRedeliveryOf)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
+}
+
+// ClearEndpoint clears the "endpoint" edge to the WebhookEndpoint entity.
+func (m *WebhookDeliveryMutation) ClearEndpoint() {
+	m.clearedendpoint = true
+	m.clearedFields[webhookdelivery.FieldEndpointID] = struct{}{}
+}
+
+// EndpointCleared reports if the "endpoint" edge to the WebhookEndpoint entity was cleared.
+func (m *WebhookDeliveryMutation) EndpointCleared() bool {
+	return m.clearedendpoint
+}
+
+// EndpointIDs returns the "endpoint" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EndpointID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) EndpointIDs() (ids []uuid.UUID) {
+	if id := m.endpoint; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEndpoint resets all changes to the "endpoint" edge.
+func (m *WebhookDeliveryMutation) ResetEndpoint() {
+	m.endpoint = nil
+	m.clearedendpoint = false
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookDeliveryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, webhookdelivery.FieldEndpointID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	if m.error != nil {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.redelivery_of != nil {
+		fields = append(fields, webhookdelivery.FieldRedeliveryOf)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookdelivery.FieldEndpointID:
+		return m.EndpointID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldDurationMs:
+		return m.DurationMs()
+	case webhookdelivery.FieldError:
+		return m.Error()
+	case webhookdelivery.FieldRedeliveryOf:
+		return m.RedeliveryOf()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookdelivery.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookdelivery.FieldEndpointID:
+		return m.OldEndpointID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case webhookdelivery.FieldError:
+		return m.OldError(ctx)
+	case webhookdelivery.FieldRedeliveryOf:
+		return m.OldRedeliveryOf(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhookdelivery.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldEndpointID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpointID(v)
+		return nil
+	case webhookdelivery.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case webhookdelivery.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case webhookdelivery.FieldRedeliveryOf:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedeliveryOf(v)
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	case webhookdelivery.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDurationMs) {
+		fields = append(fields, webhookdelivery.FieldDurationMs)
+	}
+	if m.FieldCleared(webhookdelivery.FieldError) {
+		fields = append(fields, webhookdelivery.FieldError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldRedeliveryOf) {
+		fields = append(fields, webhookdelivery.FieldRedeliveryOf)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		m.ClearDurationMs()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ClearError()
+		return nil
+	case webhookdelivery.FieldRedeliveryOf:
+		m.ClearRedeliveryOf()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
+	switch name {
+	case webhookdelivery.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhookdelivery.FieldEndpointID:
+		m.ResetEndpointID()
+		return nil
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case webhookdelivery.FieldError:
+		m.ResetError()
+		return nil
+	case webhookdelivery.FieldRedeliveryOf:
+		m.ResetRedeliveryOf()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.endpoint != nil {
+		edges = append(edges, webhookdelivery.EdgeEndpoint)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdelivery.EdgeEndpoint:
+		if id := m.endpoint; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedendpoint {
+		edges = append(edges, webhookdelivery.EdgeEndpoint)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookdelivery.EdgeEndpoint:
+		return m.clearedendpoint
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeEndpoint:
+		m.ClearEndpoint()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeEndpoint:
+		m.ResetEndpoint()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
+}
+
+// WebhookEndpointMutation represents an operation that mutates the WebhookEndpoint nodes in the graph.
+type WebhookEndpointMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	url               *string
+	secret            *string
+	event_types       *[]string
+	appendevent_types []string
+	conditions        *[]rules.Condition
+	appendconditions  []rules.Condition
+	enabled           *bool
+	format            *string
+	slack_token       *string
+	slack_channel     *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*WebhookEndpoint, error)
+	predicates        []predicate.WebhookEndpoint
+}
+
+var _ ent.Mutation = (*WebhookEndpointMutation)(nil)
+
+// webhookendpointOption allows management of the mutation configuration using functional options.
+type webhookendpointOption func(*WebhookEndpointMutation)
+
+// newWebhookEndpointMutation creates new mutation for the WebhookEndpoint entity.
+func newWebhookEndpointMutation(c config, op Op, opts ...webhookendpointOption) *WebhookEndpointMutation {
+	m := &WebhookEndpointMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookEndpoint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWebhookEndpointID sets the ID field of the mutation.
+func withWebhookEndpointID(id uuid.UUID) webhookendpointOption {
+	return func(m *WebhookEndpointMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WebhookEndpoint
+		)
+		m.oldValue = func(ctx context.Context) (*WebhookEndpoint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WebhookEndpoint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWebhookEndpoint sets the old WebhookEndpoint of the mutation.
+func withWebhookEndpoint(node *WebhookEndpoint) webhookendpointOption {
+	return func(m *WebhookEndpointMutation) {
+		m.oldValue = func(context.Context) (*WebhookEndpoint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookEndpointMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookEndpointMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookEndpoint entities.
+func (m *WebhookEndpointMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookEndpointMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookEndpointMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookEndpoint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookEndpointMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookEndpointMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookEndpointMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookEndpointMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookEndpointMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookEndpointMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookEndpointMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookEndpointMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookEndpointMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookEndpointMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookEndpointMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookEndpointMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookEndpointMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
+}
+
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookEndpointMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventTypes returns the old "event_types" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
+	}
+	return oldValue.EventTypes, nil
+}
+
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookEndpointMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
+}
+
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookEndpointMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
+	}
+	return m.appendevent_types, true
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (m *WebhookEndpointMutation) ClearEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	m.clearedFields[webhookendpoint.FieldEventTypes] = struct{}{}
+}
+
+// EventTypesCleared returns if the "event_types" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) EventTypesCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldEventTypes]
+	return ok
+}
+
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookEndpointMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	delete(m.clearedFields, webhookendpoint.FieldEventTypes)
+}
+
+// SetConditions sets the "conditions" field.
+func (m *WebhookEndpointMutation) SetConditions(r []rules.Condition) {
+	m.conditions = &r
+	m.appendconditions = nil
+}
+
+// Conditions returns the value of the "conditions" field in the mutation.
+func (m *WebhookEndpointMutation) Conditions() (r []rules.Condition, exists bool) {
+	v := m.conditions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConditions returns the old "conditions" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldConditions(ctx context.Context) (v []rules.Condition, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConditions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConditions: %w", err)
+	}
+	return oldValue.Conditions, nil
+}
+
+// AppendConditions adds r to the "conditions" field.
+func (m *WebhookEndpointMutation) AppendConditions(r []rules.Condition) {
+	m.appendconditions = append(m.appendconditions, r...)
+}
+
+// AppendedConditions returns the list of values that were appended to the "conditions" field in this mutation.
+func (m *WebhookEndpointMutation) AppendedConditions() ([]rules.Condition, bool) {
+	if len(m.appendconditions) == 0 {
+		return nil, false
+	}
+	return m.appendconditions, true
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (m *WebhookEndpointMutation) ClearConditions() {
+	m.conditions = nil
+	m.appendconditions = nil
+	m.clearedFields[webhookendpoint.FieldConditions] = struct{}{}
+}
+
+// ConditionsCleared returns if the "conditions" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) ConditionsCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldConditions]
+	return ok
+}
+
+// ResetConditions resets all changes to the "conditions" field.
+func (m *WebhookEndpointMutation) ResetConditions() {
+	m.conditions = nil
+	m.appendconditions = nil
+	delete(m.clearedFields, webhookendpoint.FieldConditions)
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *WebhookEndpointMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookEndpointMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookEndpointMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *WebhookEndpointMutation) SetFormat(s string) {
+	m.format = &s
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *WebhookEndpointMutation) Format() (r string, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *WebhookEndpointMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetSlackToken sets the "slack_token" field.
+func (m *WebhookEndpointMutation) SetSlackToken(s string) {
+	m.slack_token = &s
+}
+
+// SlackToken returns the value of the "slack_token" field in the mutation.
+func (m *WebhookEndpointMutation) SlackToken() (r string, exists bool) {
+	v := m.slack_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlackToken returns the old "slack_token" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldSlackToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlackToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlackToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlackToken: %w", err)
+	}
+	return oldValue.SlackToken, nil
+}
+
+// ClearSlackToken clears the value of the "slack_token" field.
+func (m *WebhookEndpointMutation) ClearSlackToken() {
+	m.slack_token = nil
+	m.clearedFields[webhookendpoint.FieldSlackToken] = struct{}{}
+}
+
+// SlackTokenCleared returns if the "slack_token" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) SlackTokenCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldSlackToken]
+	return ok
+}
+
+// ResetSlackToken resets all changes to the "slack_token" field.
+func (m *WebhookEndpointMutation) ResetSlackToken() {
+	m.slack_token = nil
+	delete(m.clearedFields, webhookendpoint.FieldSlackToken)
+}
+
+// SetSlackChannel sets the "slack_channel" field.
+func (m *WebhookEndpointMutation) SetSlackChannel(s string) {
+	m.slack_channel = &s
+}
+
+// SlackChannel returns the value of the "slack_channel" field in the mutation.
+func (m *WebhookEndpointMutation) SlackChannel() (r string, exists bool) {
+	v := m.slack_channel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlackChannel returns the old "slack_channel" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldSlackChannel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlackChannel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlackChannel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlackChannel: %w", err)
+	}
+	return oldValue.SlackChannel, nil
+}
+
+// ClearSlackChannel clears the value of the "slack_channel" field.
+func (m *WebhookEndpointMutation) ClearSlackChannel() {
+	m.slack_channel = nil
+	m.clearedFields[webhookendpoint.FieldSlackChannel] = struct{}{}
+}
+
+// SlackChannelCleared returns if the "slack_channel" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) SlackChannelCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldSlackChannel]
+	return ok
+}
+
+// ResetSlackChannel resets all changes to the "slack_channel" field.
+func (m *WebhookEndpointMutation) ResetSlackChannel() {
+	m.slack_channel = nil
+	delete(m.clearedFields, webhookendpoint.FieldSlackChannel)
+}
+
+// Where appends a list predicates to the WebhookEndpointMutation builder.
+func (m *WebhookEndpointMutation) Where(ps ...predicate.WebhookEndpoint) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WebhookEndpointMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WebhookEndpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookEndpoint, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WebhookEndpointMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WebhookEndpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookEndpoint).
+func (m *WebhookEndpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookEndpointMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, webhookendpoint.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, webhookendpoint.FieldUpdatedAt)
+	}
+	if m.url != nil {
+		fields = append(fields, webhookendpoint.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhookendpoint.FieldSecret)
+	}
+	if m.event_types != nil {
+		fields = append(fields, webhookendpoint.FieldEventTypes)
+	}
+	if m.conditions != nil {
+		fields = append(fields, webhookendpoint.FieldConditions)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhookendpoint.FieldEnabled)
+	}
+	if m.format != nil {
+		fields = append(fields, webhookendpoint.FieldFormat)
+	}
+	if m.slack_token != nil {
+		fields = append(fields, webhookendpoint.FieldSlackToken)
+	}
+	if m.slack_channel != nil {
+		fields = append(fields, webhookendpoint.FieldSlackChannel)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WebhookEndpointMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhookendpoint.FieldCreatedAt:
+		return m.CreatedAt()
+	case webhookendpoint.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case webhookendpoint.FieldURL:
+		return m.URL()
+	case webhookendpoint.FieldSecret:
+		return m.Secret()
+	case webhookendpoint.FieldEventTypes:
+		return m.EventTypes()
+	case webhookendpoint.FieldConditions:
+		return m.Conditions()
+	case webhookendpoint.FieldEnabled:
+		return m.Enabled()
+	case webhookendpoint.FieldFormat:
+		return m.Format()
+	case webhookendpoint.FieldSlackToken:
+		return m.SlackToken()
+	case webhookendpoint.FieldSlackChannel:
+		return m.SlackChannel()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookEndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhookendpoint.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case webhookendpoint.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case webhookendpoint.FieldURL:
+		return m.OldURL(ctx)
+	case webhookendpoint.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhookendpoint.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhookendpoint.FieldConditions:
+		return m.OldConditions(ctx)
+	case webhookendpoint.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhookendpoint.FieldFormat:
+		return m.OldFormat(ctx)
+	case webhookendpoint.FieldSlackToken:
+		return m.OldSlackToken(ctx)
+	case webhookendpoint.FieldSlackChannel:
+		return m.OldSlackChannel(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookEndpoint field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookEndpointMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhookendpoint.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case webhookendpoint.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case webhookendpoint.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhookendpoint.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhookendpoint.FieldEventTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTypes(v)
+		return nil
+	case webhookendpoint.FieldConditions:
+		v, ok := value.([]rules.Condition)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConditions(v)
+		return nil
+	case webhookendpoint.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case webhookendpoint.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case webhookendpoint.FieldSlackToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlackToken(v)
+		return nil
+	case webhookendpoint.FieldSlackChannel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlackChannel(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookEndpoint field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WebhookEndpointMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WebhookEndpointMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WebhookEndpointMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WebhookEndpoint numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WebhookEndpointMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookendpoint.FieldEventTypes) {
+		fields = append(fields, webhookendpoint.FieldEventTypes)
+	}
+	if m.FieldCleared(webhookendpoint.FieldConditions) {
+		fields = append(fields, webhookendpoint.FieldConditions)
+	}
+	if m.FieldCleared(webhookendpoint.FieldSlackToken) {
+		fields = append(fields, webhookendpoint.FieldSlackToken)
+	}
+	if m.FieldCleared(webhookendpoint.FieldSlackChannel) {
+		fields = append(fields, webhookendpoint.FieldSlackChannel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WebhookEndpointMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookEndpointMutation) ClearField(name string) error {
+	switch name {
+	case webhookendpoint.FieldEventTypes:
+		m.ClearEventTypes()
+		return nil
+	case webhookendpoint.FieldConditions:
+		m.ClearConditions()
+		return nil
+	case webhookendpoint.FieldSlackToken:
+		m.ClearSlackToken()
+		return nil
+	case webhookendpoint.FieldSlackChannel:
+		m.ClearSlackChannel()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookEndpoint nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WebhookEndpointMutation) ResetField(name string) error {
+	switch name {
+	case webhookendpoint.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case webhookendpoint.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case webhookendpoint.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhookendpoint.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhookendpoint.FieldEventTypes:
+		m.ResetEventTypes()
+		return nil
+	case webhookendpoint.FieldConditions:
+		m.ResetConditions()
+		return nil
+	case webhookendpoint.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case webhookendpoint.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case webhookendpoint.FieldSlackToken:
+		m.ResetSlackToken()
+		return nil
+	case webhookendpoint.FieldSlackChannel:
+		m.ResetSlackChannel()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookEndpoint field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookEndpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookEndpointMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookEndpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookEndpointMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WebhookEndpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WebhookEndpointMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WebhookEndpointMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookEndpoint unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WebhookEndpointMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookEndpoint edge %s", name)
+}
+
+// WorkerMutation represents an operation that mutates the Worker nodes in the graph.
+type WorkerMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	instance               *string
+	worker_id              *int
+	addworker_id           *int
+	job_type               *string
+	current_job_id         *uuid.UUID
+	current_job_started_at *time.Time
+	processed              *int64
+	addprocessed           *int64
+	failed                 *int64
+	addfailed              *int64
+	started_at             *time.Time
+	last_heartbeat_at      *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Worker, error)
+	predicates             []predicate.Worker
+}
+
+var _ ent.Mutation = (*WorkerMutation)(nil)
+
+// workerOption allows management of the mutation configuration using functional options.
+type workerOption func(*WorkerMutation)
+
+// newWorkerMutation creates new mutation for the Worker entity.
+func newWorkerMutation(c config, op Op, opts ...workerOption) *WorkerMutation {
+	m := &WorkerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorker,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkerID sets the ID field of the mutation.
+func withWorkerID(id uuid.UUID) workerOption {
+	return func(m *WorkerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Worker
+		)
+		m.oldValue = func(ctx context.Context) (*Worker, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Worker.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorker sets the old Worker of the mutation.
+func withWorker(node *Worker) workerOption {
+	return func(m *WorkerMutation) {
+		m.oldValue = func(context.Context) (*Worker, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Worker entities.
+func (m *WorkerMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WorkerMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WorkerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Worker.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetInstance sets the "instance" field.
+func (m *WorkerMutation) SetInstance(s string) {
+	m.instance = &s
+}
+
+// Instance returns the value of the "instance" field in the mutation.
+func (m *WorkerMutation) Instance() (r string, exists bool) {
+	v := m.instance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstance returns the old "instance" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldInstance(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstance: %w", err)
+	}
+	return oldValue.Instance, nil
+}
+
+// ResetInstance resets all changes to the "instance" field.
+func (m *WorkerMutation) ResetInstance() {
+	m.instance = nil
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (m *WorkerMutation) SetWorkerID(i int) {
+	m.worker_id = &i
+	m.addworker_id = nil
+}
+
+// WorkerID returns the value of the "worker_id" field in the mutation.
+func (m *WorkerMutation) WorkerID() (r int, exists bool) {
+	v := m.worker_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkerID returns the old "worker_id" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldWorkerID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkerID: %w", err)
+	}
+	return oldValue.WorkerID, nil
+}
+
+// AddWorkerID adds i to the "worker_id" field.
+func (m *WorkerMutation) AddWorkerID(i int) {
+	if m.addworker_id != nil {
+		*m.addworker_id += i
+	} else {
+		m.addworker_id = &i
+	}
+}
+
+// AddedWorkerID returns the value that was added to the "worker_id" field in this mutation.
+func (m *WorkerMutation) AddedWorkerID() (r int, exists bool) {
+	v := m.addworker_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWorkerID resets all changes to the "worker_id" field.
+func (m *WorkerMutation) ResetWorkerID() {
+	m.worker_id = nil
+	m.addworker_id = nil
+}
+
+// SetJobType sets the "job_type" field.
+func (m *WorkerMutation) SetJobType(s string) {
+	m.job_type = &s
+}
+
+// JobType returns the value of the "job_type" field in the mutation.
+func (m *WorkerMutation) JobType() (r string, exists bool) {
+	v := m.job_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobType returns the old "job_type" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldJobType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
+	}
+	return oldValue.JobType, nil
+}
+
+// ResetJobType resets all changes to the "job_type" field.
+func (m *WorkerMutation) ResetJobType() {
+	m.job_type = nil
+}
+
+// SetCurrentJobID sets the "current_job_id" field.
+func (m *WorkerMutation) SetCurrentJobID(u uuid.UUID) {
+	m.current_job_id = &u
+}
+
+// CurrentJobID returns the value of the "current_job_id" field in the mutation.
+func (m *WorkerMutation) CurrentJobID() (r uuid.UUID, exists bool) {
+	v := m.current_job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentJobID returns the old "current_job_id" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldCurrentJobID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentJobID: %w", err)
+	}
+	return oldValue.CurrentJobID, nil
+}
+
+// ClearCurrentJobID clears the value of the "current_job_id" field.
+func (m *WorkerMutation) ClearCurrentJobID() {
+	m.current_job_id = nil
+	m.clearedFields[worker.FieldCurrentJobID] = struct{}{}
+}
+
+// CurrentJobIDCleared returns if the "current_job_id" field was cleared in this mutation.
+func (m *WorkerMutation) CurrentJobIDCleared() bool {
+	_, ok := m.clearedFields[worker.FieldCurrentJobID]
+	return ok
+}
+
+// ResetCurrentJobID resets all changes to the "current_job_id" field.
+func (m *WorkerMutation) ResetCurrentJobID() {
+	m.current_job_id = nil
+	delete(m.clearedFields, worker.FieldCurrentJobID)
+}
+
+// SetCurrentJobStartedAt sets the "current_job_started_at" field.
+func (m *WorkerMutation) SetCurrentJobStartedAt(t time.Time) {
+	m.current_job_started_at = &t
+}
+
+// CurrentJobStartedAt returns the value of the "current_job_started_at" field in the mutation.
+func (m *WorkerMutation) CurrentJobStartedAt() (r time.Time, exists bool) {
+	v := m.current_job_started_at
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown ExperienceData field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ExperienceDataMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case experiencedata.FieldCollectedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCollectedAt(v)
-		return nil
-	case experiencedata.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case experiencedata.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case experiencedata.FieldSourceType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceType(v)
-		return nil
-	case experiencedata.FieldSourceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceID(v)
-		return nil
-	case experiencedata.FieldSourceName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceName(v)
-		return nil
-	case experiencedata.FieldFieldID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFieldID(v)
-		return nil
-	case experiencedata.FieldFieldLabel:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFieldLabel(v)
-		return nil
-	case experiencedata.FieldFieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFieldType(v)
-		return nil
-	case experiencedata.FieldValueText:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValueText(v)
-		return nil
-	case experiencedata.FieldValueNumber:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValueNumber(v)
-		return nil
-	case experiencedata.FieldValueBoolean:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValueBoolean(v)
-		return nil
-	case experiencedata.FieldValueDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValueDate(v)
-		return nil
-	case experiencedata.FieldValueJSON:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValueJSON(v)
-		return nil
-	case experiencedata.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+// OldCurrentJobStartedAt returns the old "current_job_started_at" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldCurrentJobStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentJobStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentJobStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentJobStartedAt: %w", err)
+	}
+	return oldValue.CurrentJobStartedAt, nil
+}
+
+// ClearCurrentJobStartedAt clears the value of the "current_job_started_at" field.
+func (m *WorkerMutation) ClearCurrentJobStartedAt() {
+	m.current_job_started_at = nil
+	m.clearedFields[worker.FieldCurrentJobStartedAt] = struct{}{}
+}
+
+// CurrentJobStartedAtCleared returns if the "current_job_started_at" field was cleared in this mutation.
+func (m *WorkerMutation) CurrentJobStartedAtCleared() bool {
+	_, ok := m.clearedFields[worker.FieldCurrentJobStartedAt]
+	return ok
+}
+
+// ResetCurrentJobStartedAt resets all changes to the "current_job_started_at" field.
+func (m *WorkerMutation) ResetCurrentJobStartedAt() {
+	m.current_job_started_at = nil
+	delete(m.clearedFields, worker.FieldCurrentJobStartedAt)
+}
+
+// SetProcessed sets the "processed" field.
+func (m *WorkerMutation) SetProcessed(i int64) {
+	m.processed = &i
+	m.addprocessed = nil
+}
+
+// Processed returns the value of the "processed" field in the mutation.
+func (m *WorkerMutation) Processed() (r int64, exists bool) {
+	v := m.processed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessed returns the old "processed" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldProcessed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessed: %w", err)
+	}
+	return oldValue.Processed, nil
+}
+
+// AddProcessed adds i to the "processed" field.
+func (m *WorkerMutation) AddProcessed(i int64) {
+	if m.addprocessed != nil {
+		*m.addprocessed += i
+	} else {
+		m.addprocessed = &i
+	}
+}
+
+// AddedProcessed returns the value that was added to the "processed" field in this mutation.
+func (m *WorkerMutation) AddedProcessed() (r int64, exists bool) {
+	v := m.addprocessed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessed resets all changes to the "processed" field.
+func (m *WorkerMutation) ResetProcessed() {
+	m.processed = nil
+	m.addprocessed = nil
+}
+
+// SetFailed sets the "failed" field.
+func (m *WorkerMutation) SetFailed(i int64) {
+	m.failed = &i
+	m.addfailed = nil
+}
+
+// Failed returns the value of the "failed" field in the mutation.
+func (m *WorkerMutation) Failed() (r int64, exists bool) {
+	v := m.failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailed returns the old "failed" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldFailed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailed: %w", err)
+	}
+	return oldValue.Failed, nil
+}
+
+// AddFailed adds i to the "failed" field.
+func (m *WorkerMutation) AddFailed(i int64) {
+	if m.addfailed != nil {
+		*m.addfailed += i
+	} else {
+		m.addfailed = &i
+	}
+}
+
+// AddedFailed returns the value that was added to the "failed" field in this mutation.
+func (m *WorkerMutation) AddedFailed() (r int64, exists bool) {
+	v := m.addfailed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailed resets all changes to the "failed" field.
+func (m *WorkerMutation) ResetFailed() {
+	m.failed = nil
+	m.addfailed = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *WorkerMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *WorkerMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *WorkerMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (m *WorkerMutation) SetLastHeartbeatAt(t time.Time) {
+	m.last_heartbeat_at = &t
+}
+
+// LastHeartbeatAt returns the value of the "last_heartbeat_at" field in the mutation.
+func (m *WorkerMutation) LastHeartbeatAt() (r time.Time, exists bool) {
+	v := m.last_heartbeat_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastHeartbeatAt returns the old "last_heartbeat_at" field's value of the Worker entity.
+// If the Worker object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkerMutation) OldLastHeartbeatAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastHeartbeatAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastHeartbeatAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastHeartbeatAt: %w", err)
+	}
+	return oldValue.LastHeartbeatAt, nil
+}
+
+// ResetLastHeartbeatAt resets all changes to the "last_heartbeat_at" field.
+func (m *WorkerMutation) ResetLastHeartbeatAt() {
+	m.last_heartbeat_at = nil
+}
+
+// Where appends a list predicates to the WorkerMutation builder.
+func (m *WorkerMutation) Where(ps ...predicate.Worker) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WorkerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WorkerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Worker, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WorkerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WorkerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Worker).
+func (m *WorkerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkerMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.instance != nil {
+		fields = append(fields, worker.FieldInstance)
+	}
+	if m.worker_id != nil {
+		fields = append(fields, worker.FieldWorkerID)
+	}
+	if m.job_type != nil {
+		fields = append(fields, worker.FieldJobType)
+	}
+	if m.current_job_id != nil {
+		fields = append(fields, worker.FieldCurrentJobID)
+	}
+	if m.current_job_started_at != nil {
+		fields = append(fields, worker.FieldCurrentJobStartedAt)
+	}
+	if m.processed != nil {
+		fields = append(fields, worker.FieldProcessed)
+	}
+	if m.failed != nil {
+		fields = append(fields, worker.FieldFailed)
+	}
+	if m.started_at != nil {
+		fields = append(fields, worker.FieldStartedAt)
+	}
+	if m.last_heartbeat_at != nil {
+		fields = append(fields, worker.FieldLastHeartbeatAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case worker.FieldInstance:
+		return m.Instance()
+	case worker.FieldWorkerID:
+		return m.WorkerID()
+	case worker.FieldJobType:
+		return m.JobType()
+	case worker.FieldCurrentJobID:
+		return m.CurrentJobID()
+	case worker.FieldCurrentJobStartedAt:
+		return m.CurrentJobStartedAt()
+	case worker.FieldProcessed:
+		return m.Processed()
+	case worker.FieldFailed:
+		return m.Failed()
+	case worker.FieldStartedAt:
+		return m.StartedAt()
+	case worker.FieldLastHeartbeatAt:
+		return m.LastHeartbeatAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case worker.FieldInstance:
+		return m.OldInstance(ctx)
+	case worker.FieldWorkerID:
+		return m.OldWorkerID(ctx)
+	case worker.FieldJobType:
+		return m.OldJobType(ctx)
+	case worker.FieldCurrentJobID:
+		return m.OldCurrentJobID(ctx)
+	case worker.FieldCurrentJobStartedAt:
+		return m.OldCurrentJobStartedAt(ctx)
+	case worker.FieldProcessed:
+		return m.OldProcessed(ctx)
+	case worker.FieldFailed:
+		return m.OldFailed(ctx)
+	case worker.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case worker.FieldLastHeartbeatAt:
+		return m.OldLastHeartbeatAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Worker field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case worker.FieldInstance:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetInstance(v)
 		return nil
-	case experiencedata.FieldLanguage:
-		v, ok := value.(string)
+	case worker.FieldWorkerID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguage(v)
+		m.SetWorkerID(v)
 		return nil
-	case experiencedata.FieldSentiment:
+	case worker.FieldJobType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSentiment(v)
+		m.SetJobType(v)
 		return nil
-	case experiencedata.FieldSentimentScore:
-		v, ok := value.(float64)
+	case worker.FieldCurrentJobID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSentimentScore(v)
+		m.SetCurrentJobID(v)
 		return nil
-	case experiencedata.FieldEmotion:
-		v, ok := value.(string)
+	case worker.FieldCurrentJobStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmotion(v)
+		m.SetCurrentJobStartedAt(v)
 		return nil
-	case experiencedata.FieldTopics:
-		v, ok := value.([]string)
+	case worker.FieldProcessed:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTopics(v)
+		m.SetProcessed(v)
 		return nil
-	case experiencedata.FieldUserIdentifier:
-		v, ok := value.(string)
+	case worker.FieldFailed:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserIdentifier(v)
+		m.SetFailed(v)
 		return nil
-	case experiencedata.FieldEmbedding:
-		v, ok := value.(pgvector.Vector)
+	case worker.FieldStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbedding(v)
+		m.SetStartedAt(v)
 		return nil
-	case experiencedata.FieldEmbeddingModel:
-		v, ok := value.(string)
+	case worker.FieldLastHeartbeatAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbeddingModel(v)
+		m.SetLastHeartbeatAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExperienceData field %s", name)
+	return fmt.Errorf("unknown Worker field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExperienceDataMutation) AddedFields() []string {
+func (m *WorkerMutation) AddedFields() []string {
 	var fields []string
-	if m.addvalue_number != nil {
-		fields = append(fields, experiencedata.FieldValueNumber)
+	if m.addworker_id != nil {
+		fields = append(fields, worker.FieldWorkerID)
 	}
-	if m.addsentiment_score != nil {
-		fields = append(fields, experiencedata.FieldSentimentScore)
+	if m.addprocessed != nil {
+		fields = append(fields, worker.FieldProcessed)
+	}
+	if m.addfailed != nil {
+		fields = append(fields, worker.FieldFailed)
 	}
 	return fields
 }
@@ -2524,12 +15621,14 @@ func (m *ExperienceDataMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExperienceDataMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WorkerMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case experiencedata.FieldValueNumber:
-		return m.AddedValueNumber()
-	case experiencedata.FieldSentimentScore:
-		return m.AddedSentimentScore()
+	case worker.FieldWorkerID:
+		return m.AddedWorkerID()
+	case worker.FieldProcessed:
+		return m.AddedProcessed()
+	case worker.FieldFailed:
+		return m.AddedFailed()
 	}
 	return nil, false
 }
@@ -2537,271 +15636,146 @@ func (m *ExperienceDataMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExperienceDataMutation) AddField(name string, value ent.Value) error {
+func (m *WorkerMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case experiencedata.FieldValueNumber:
-		v, ok := value.(float64)
+	case worker.FieldWorkerID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddValueNumber(v)
+		m.AddWorkerID(v)
 		return nil
-	case experiencedata.FieldSentimentScore:
-		v, ok := value.(float64)
+	case worker.FieldProcessed:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSentimentScore(v)
+		m.AddProcessed(v)
+		return nil
+	case worker.FieldFailed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExperienceData numeric field %s", name)
+	return fmt.Errorf("unknown Worker numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExperienceDataMutation) ClearedFields() []string {
+func (m *WorkerMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(experiencedata.FieldSourceID) {
-		fields = append(fields, experiencedata.FieldSourceID)
-	}
-	if m.FieldCleared(experiencedata.FieldSourceName) {
-		fields = append(fields, experiencedata.FieldSourceName)
-	}
-	if m.FieldCleared(experiencedata.FieldFieldLabel) {
-		fields = append(fields, experiencedata.FieldFieldLabel)
-	}
-	if m.FieldCleared(experiencedata.FieldValueText) {
-		fields = append(fields, experiencedata.FieldValueText)
-	}
-	if m.FieldCleared(experiencedata.FieldValueNumber) {
-		fields = append(fields, experiencedata.FieldValueNumber)
-	}
-	if m.FieldCleared(experiencedata.FieldValueBoolean) {
-		fields = append(fields, experiencedata.FieldValueBoolean)
-	}
-	if m.FieldCleared(experiencedata.FieldValueDate) {
-		fields = append(fields, experiencedata.FieldValueDate)
-	}
-	if m.FieldCleared(experiencedata.FieldValueJSON) {
-		fields = append(fields, experiencedata.FieldValueJSON)
-	}
-	if m.FieldCleared(experiencedata.FieldMetadata) {
-		fields = append(fields, experiencedata.FieldMetadata)
-	}
-	if m.FieldCleared(experiencedata.FieldLanguage) {
-		fields = append(fields, experiencedata.FieldLanguage)
-	}
-	if m.FieldCleared(experiencedata.FieldSentiment) {
-		fields = append(fields, experiencedata.FieldSentiment)
-	}
-	if m.FieldCleared(experiencedata.FieldSentimentScore) {
-		fields = append(fields, experiencedata.FieldSentimentScore)
-	}
-	if m.FieldCleared(experiencedata.FieldEmotion) {
-		fields = append(fields, experiencedata.FieldEmotion)
-	}
-	if m.FieldCleared(experiencedata.FieldTopics) {
-		fields = append(fields, experiencedata.FieldTopics)
-	}
-	if m.FieldCleared(experiencedata.FieldUserIdentifier) {
-		fields = append(fields, experiencedata.FieldUserIdentifier)
-	}
-	if m.FieldCleared(experiencedata.FieldEmbedding) {
-		fields = append(fields, experiencedata.FieldEmbedding)
+	if m.FieldCleared(worker.FieldCurrentJobID) {
+		fields = append(fields, worker.FieldCurrentJobID)
 	}
-	if m.FieldCleared(experiencedata.FieldEmbeddingModel) {
-		fields = append(fields, experiencedata.FieldEmbeddingModel)
+	if m.FieldCleared(worker.FieldCurrentJobStartedAt) {
+		fields = append(fields, worker.FieldCurrentJobStartedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExperienceDataMutation) FieldCleared(name string) bool {
+func (m *WorkerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExperienceDataMutation) ClearField(name string) error {
+func (m *WorkerMutation) ClearField(name string) error {
 	switch name {
-	case experiencedata.FieldSourceID:
-		m.ClearSourceID()
-		return nil
-	case experiencedata.FieldSourceName:
-		m.ClearSourceName()
-		return nil
-	case experiencedata.FieldFieldLabel:
-		m.ClearFieldLabel()
-		return nil
-	case experiencedata.FieldValueText:
-		m.ClearValueText()
-		return nil
-	case experiencedata.FieldValueNumber:
-		m.ClearValueNumber()
-		return nil
-	case experiencedata.FieldValueBoolean:
-		m.ClearValueBoolean()
-		return nil
-	case experiencedata.FieldValueDate:
-		m.ClearValueDate()
-		return nil
-	case experiencedata.FieldValueJSON:
-		m.ClearValueJSON()
-		return nil
-	case experiencedata.FieldMetadata:
-		m.ClearMetadata()
-		return nil
-	case experiencedata.FieldLanguage:
-		m.ClearLanguage()
-		return nil
-	case experiencedata.FieldSentiment:
-		m.ClearSentiment()
-		return nil
-	case experiencedata.FieldSentimentScore:
-		m.ClearSentimentScore()
-		return nil
-	case experiencedata.FieldEmotion:
-		m.ClearEmotion()
-		return nil
-	case experiencedata.FieldTopics:
-		m.ClearTopics()
-		return nil
-	case experiencedata.FieldUserIdentifier:
-		m.ClearUserIdentifier()
-		return nil
-	case experiencedata.FieldEmbedding:
-		m.ClearEmbedding()
+	case worker.FieldCurrentJobID:
+		m.ClearCurrentJobID()
 		return nil
-	case experiencedata.FieldEmbeddingModel:
-		m.ClearEmbeddingModel()
+	case worker.FieldCurrentJobStartedAt:
+		m.ClearCurrentJobStartedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ExperienceData nullable field %s", name)
+	return fmt.Errorf("unknown Worker nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExperienceDataMutation) ResetField(name string) error {
+func (m *WorkerMutation) ResetField(name string) error {
 	switch name {
-	case experiencedata.FieldCollectedAt:
-		m.ResetCollectedAt()
-		return nil
-	case experiencedata.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case experiencedata.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case worker.FieldInstance:
+		m.ResetInstance()
 		return nil
-	case experiencedata.FieldSourceType:
-		m.ResetSourceType()
-		return nil
-	case experiencedata.FieldSourceID:
-		m.ResetSourceID()
-		return nil
-	case experiencedata.FieldSourceName:
-		m.ResetSourceName()
-		return nil
-	case experiencedata.FieldFieldID:
-		m.ResetFieldID()
-		return nil
-	case experiencedata.FieldFieldLabel:
-		m.ResetFieldLabel()
-		return nil
-	case experiencedata.FieldFieldType:
-		m.ResetFieldType()
-		return nil
-	case experiencedata.FieldValueText:
-		m.ResetValueText()
-		return nil
-	case experiencedata.FieldValueNumber:
-		m.ResetValueNumber()
-		return nil
-	case experiencedata.FieldValueBoolean:
-		m.ResetValueBoolean()
-		return nil
-	case experiencedata.FieldValueDate:
-		m.ResetValueDate()
-		return nil
-	case experiencedata.FieldValueJSON:
-		m.ResetValueJSON()
+	case worker.FieldWorkerID:
+		m.ResetWorkerID()
 		return nil
-	case experiencedata.FieldMetadata:
-		m.ResetMetadata()
-		return nil
-	case experiencedata.FieldLanguage:
-		m.ResetLanguage()
-		return nil
-	case experiencedata.FieldSentiment:
-		m.ResetSentiment()
+	case worker.FieldJobType:
+		m.ResetJobType()
 		return nil
-	case experiencedata.FieldSentimentScore:
-		m.ResetSentimentScore()
+	case worker.FieldCurrentJobID:
+		m.ResetCurrentJobID()
 		return nil
-	case experiencedata.FieldEmotion:
-		m.ResetEmotion()
+	case worker.FieldCurrentJobStartedAt:
+		m.ResetCurrentJobStartedAt()
 		return nil
-	case experiencedata.FieldTopics:
-		m.ResetTopics()
+	case worker.FieldProcessed:
+		m.ResetProcessed()
 		return nil
-	case experiencedata.FieldUserIdentifier:
-		m.ResetUserIdentifier()
+	case worker.FieldFailed:
+		m.ResetFailed()
 		return nil
-	case experiencedata.FieldEmbedding:
-		m.ResetEmbedding()
+	case worker.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case experiencedata.FieldEmbeddingModel:
-		m.ResetEmbeddingModel()
+	case worker.FieldLastHeartbeatAt:
+		m.ResetLastHeartbeatAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ExperienceData field %s", name)
+	return fmt.Errorf("unknown Worker field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExperienceDataMutation) AddedEdges() []string {
+func (m *WorkerMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExperienceDataMutation) AddedIDs(name string) []ent.Value {
+func (m *WorkerMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExperienceDataMutation) RemovedEdges() []string {
+func (m *WorkerMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExperienceDataMutation) RemovedIDs(name string) []ent.Value {
+func (m *WorkerMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExperienceDataMutation) ClearedEdges() []string {
+func (m *WorkerMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExperienceDataMutation) EdgeCleared(name string) bool {
+func (m *WorkerMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExperienceDataMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ExperienceData unique edge %s", name)
+func (m *WorkerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Worker unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExperienceDataMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ExperienceData edge %s", name)
+func (m *WorkerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Worker edge %s", name)
 }
@@ -6,8 +6,47 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AIUsage is the predicate function for aiusage builders.
+type AIUsage func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// ConnectorCursor is the predicate function for connectorcursor builders.
+type ConnectorCursor func(*sql.Selector)
+
 // EnrichmentJob is the predicate function for enrichmentjob builders.
 type EnrichmentJob func(*sql.Selector)
 
 // ExperienceData is the predicate function for experiencedata builders.
 type ExperienceData func(*sql.Selector)
+
+// ExperienceDeletion is the predicate function for experiencedeletion builders.
+type ExperienceDeletion func(*sql.Selector)
+
+// Export is the predicate function for export builders.
+type Export func(*sql.Selector)
+
+// ExportRun is the predicate function for exportrun builders.
+type ExportRun func(*sql.Selector)
+
+// IngestMapping is the predicate function for ingestmapping builders.
+type IngestMapping func(*sql.Selector)
+
+// Question is the predicate function for question builders.
+type Question func(*sql.Selector)
+
+// QueuePause is the predicate function for queuepause builders.
+type QueuePause func(*sql.Selector)
+
+// Segment is the predicate function for segment builders.
+type Segment func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
+
+// Worker is the predicate function for worker builders.
+type Worker func(*sql.Selector)
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/google/uuid"
+)
+
+// Question is the model entity for the Question schema.
+type Question struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// SourceType holds the value of the "source_type" field.
+	SourceType string `json:"source_type,omitempty"`
+	// Source ID of the experiences, empty if they have none
+	SourceID string `json:"source_id,omitempty"`
+	// FieldID holds the value of the "field_id" field.
+	FieldID string `json:"field_id,omitempty"`
+	// Field type of the first experience that answered the question
+	FieldType string `json:"field_type,omitempty"`
+	// Canonical label, used when there is none in the requested language
+	Label string `json:"label,omitempty"`
+	// Canonical labels by ISO language code
+	Labels map[string]string `json:"labels,omitempty"`
+	// Display metadata, such as the scale, choices, or help text
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Question) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case question.FieldLabels, question.FieldMetadata:
+			values[i] = new([]byte)
+		case question.FieldSourceType, question.FieldSourceID, question.FieldFieldID, question.FieldFieldType, question.FieldLabel:
+			values[i] = new(sql.NullString)
+		case question.FieldCreatedAt, question.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case question.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Question fields.
+func (_m *Question) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case question.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case question.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case question.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case question.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				_m.SourceType = value.String
+			}
+		case question.FieldSourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				_m.SourceID = value.String
+			}
+		case question.FieldFieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field_id", values[i])
+			} else if value.Valid {
+				_m.FieldID = value.String
+			}
+		case question.FieldFieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field field_type", values[i])
+			} else if value.Valid {
+				_m.FieldType = value.String
+			}
+		case question.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case question.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case question.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Question.
+// This includes values selected through modifiers, order, etc.
+func (_m *Question) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Question.
+// Note that you need to call Question.Unwrap() before calling this method if this Question
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Question) Update() *QuestionUpdateOne {
+	return NewQuestionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Question entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Question) Unwrap() *Question {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Question is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Question) String() string {
+	var builder strings.Builder
+	builder.WriteString("Question(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(_m.SourceType)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(_m.SourceID)
+	builder.WriteString(", ")
+	builder.WriteString("field_id=")
+	builder.WriteString(_m.FieldID)
+	builder.WriteString(", ")
+	builder.WriteString("field_type=")
+	builder.WriteString(_m.FieldType)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Questions is a parsable slice of Question.
+type Questions []*Question
@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package question
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
+func SourceType(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSourceID, v))
+}
+
+// FieldType applies equality check predicate on the "field_type" field. It's identical to FieldTypeEQ.
+func FieldType(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldFieldType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldSourceType, v))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldSourceType, vs...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldSourceType, vs...))
+}
+
+// SourceTypeGT applies the GT predicate on the "source_type" field.
+func SourceTypeGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldSourceType, v))
+}
+
+// SourceTypeGTE applies the GTE predicate on the "source_type" field.
+func SourceTypeGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldSourceType, v))
+}
+
+// SourceTypeLT applies the LT predicate on the "source_type" field.
+func SourceTypeLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldSourceType, v))
+}
+
+// SourceTypeLTE applies the LTE predicate on the "source_type" field.
+func SourceTypeLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldSourceType, v))
+}
+
+// SourceTypeContains applies the Contains predicate on the "source_type" field.
+func SourceTypeContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldSourceType, v))
+}
+
+// SourceTypeHasPrefix applies the HasPrefix predicate on the "source_type" field.
+func SourceTypeHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldSourceType, v))
+}
+
+// SourceTypeHasSuffix applies the HasSuffix predicate on the "source_type" field.
+func SourceTypeHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldSourceType, v))
+}
+
+// SourceTypeEqualFold applies the EqualFold predicate on the "source_type" field.
+func SourceTypeEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldSourceType, v))
+}
+
+// SourceTypeContainsFold applies the ContainsFold predicate on the "source_type" field.
+func SourceTypeContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldSourceType, v))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceIDContains applies the Contains predicate on the "source_id" field.
+func SourceIDContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldSourceID, v))
+}
+
+// SourceIDHasPrefix applies the HasPrefix predicate on the "source_id" field.
+func SourceIDHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldSourceID, v))
+}
+
+// SourceIDHasSuffix applies the HasSuffix predicate on the "source_id" field.
+func SourceIDHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldSourceID, v))
+}
+
+// SourceIDEqualFold applies the EqualFold predicate on the "source_id" field.
+func SourceIDEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldSourceID, v))
+}
+
+// SourceIDContainsFold applies the ContainsFold predicate on the "source_id" field.
+func SourceIDContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldSourceID, v))
+}
+
+// FieldIDEQ applies the EQ predicate on the "field_id" field.
+func FieldIDEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldFieldID, v))
+}
+
+// FieldIDNEQ applies the NEQ predicate on the "field_id" field.
+func FieldIDNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldFieldID, v))
+}
+
+// FieldIDIn applies the In predicate on the "field_id" field.
+func FieldIDIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldFieldID, vs...))
+}
+
+// FieldIDNotIn applies the NotIn predicate on the "field_id" field.
+func FieldIDNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldFieldID, vs...))
+}
+
+// FieldIDGT applies the GT predicate on the "field_id" field.
+func FieldIDGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldFieldID, v))
+}
+
+// FieldIDGTE applies the GTE predicate on the "field_id" field.
+func FieldIDGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldFieldID, v))
+}
+
+// FieldIDLT applies the LT predicate on the "field_id" field.
+func FieldIDLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldFieldID, v))
+}
+
+// FieldIDLTE applies the LTE predicate on the "field_id" field.
+func FieldIDLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldFieldID, v))
+}
+
+// FieldIDContains applies the Contains predicate on the "field_id" field.
+func FieldIDContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldFieldID, v))
+}
+
+// FieldIDHasPrefix applies the HasPrefix predicate on the "field_id" field.
+func FieldIDHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldFieldID, v))
+}
+
+// FieldIDHasSuffix applies the HasSuffix predicate on the "field_id" field.
+func FieldIDHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldFieldID, v))
+}
+
+// FieldIDEqualFold applies the EqualFold predicate on the "field_id" field.
+func FieldIDEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldFieldID, v))
+}
+
+// FieldIDContainsFold applies the ContainsFold predicate on the "field_id" field.
+func FieldIDContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldFieldID, v))
+}
+
+// FieldTypeEQ applies the EQ predicate on the "field_type" field.
+func FieldTypeEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldFieldType, v))
+}
+
+// FieldTypeNEQ applies the NEQ predicate on the "field_type" field.
+func FieldTypeNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldFieldType, v))
+}
+
+// FieldTypeIn applies the In predicate on the "field_type" field.
+func FieldTypeIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldFieldType, vs...))
+}
+
+// FieldTypeNotIn applies the NotIn predicate on the "field_type" field.
+func FieldTypeNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldFieldType, vs...))
+}
+
+// FieldTypeGT applies the GT predicate on the "field_type" field.
+func FieldTypeGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldFieldType, v))
+}
+
+// FieldTypeGTE applies the GTE predicate on the "field_type" field.
+func FieldTypeGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldFieldType, v))
+}
+
+// FieldTypeLT applies the LT predicate on the "field_type" field.
+func FieldTypeLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldFieldType, v))
+}
+
+// FieldTypeLTE applies the LTE predicate on the "field_type" field.
+func FieldTypeLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldFieldType, v))
+}
+
+// FieldTypeContains applies the Contains predicate on the "field_type" field.
+func FieldTypeContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldFieldType, v))
+}
+
+// FieldTypeHasPrefix applies the HasPrefix predicate on the "field_type" field.
+func FieldTypeHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldFieldType, v))
+}
+
+// FieldTypeHasSuffix applies the HasSuffix predicate on the "field_type" field.
+func FieldTypeHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldFieldType, v))
+}
+
+// FieldTypeIsNil applies the IsNil predicate on the "field_type" field.
+func FieldTypeIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldFieldType))
+}
+
+// FieldTypeNotNil applies the NotNil predicate on the "field_type" field.
+func FieldTypeNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldFieldType))
+}
+
+// FieldTypeEqualFold applies the EqualFold predicate on the "field_type" field.
+func FieldTypeEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldFieldType, v))
+}
+
+// FieldTypeContainsFold applies the ContainsFold predicate on the "field_type" field.
+func FieldTypeContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldFieldType, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.Question {
+	return predicate.Question(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.Question {
+	return predicate.Question(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.Question {
+	return predicate.Question(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.Question {
+	return predicate.Question(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.Question {
+	return predicate.Question(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.Question {
+	return predicate.Question(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.Question {
+	return predicate.Question(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelIsNil applies the IsNil predicate on the "label" field.
+func LabelIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldLabel))
+}
+
+// LabelNotNil applies the NotNil predicate on the "label" field.
+func LabelNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldLabel))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.Question {
+	return predicate.Question(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldLabels))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Question {
+	return predicate.Question(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Question {
+	return predicate.Question(sql.FieldNotNull(FieldMetadata))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Question) predicate.Question {
+	return predicate.Question(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Question) predicate.Question {
+	return predicate.Question(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Question) predicate.Question {
+	return predicate.Question(sql.NotPredicates(p))
+}
@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/google/uuid"
+)
+
+// QuestionCreate is the builder for creating a Question entity.
+type QuestionCreate struct {
+	config
+	mutation *QuestionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuestionCreate) SetCreatedAt(v time.Time) *QuestionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableCreatedAt(v *time.Time) *QuestionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuestionCreate) SetUpdatedAt(v time.Time) *QuestionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableUpdatedAt(v *time.Time) *QuestionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetSourceType sets the "source_type" field.
+func (_c *QuestionCreate) SetSourceType(v string) *QuestionCreate {
+	_c.mutation.SetSourceType(v)
+	return _c
+}
+
+// SetSourceID sets the "source_id" field.
+func (_c *QuestionCreate) SetSourceID(v string) *QuestionCreate {
+	_c.mutation.SetSourceID(v)
+	return _c
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableSourceID(v *string) *QuestionCreate {
+	if v != nil {
+		_c.SetSourceID(*v)
+	}
+	return _c
+}
+
+// SetFieldID sets the "field_id" field.
+func (_c *QuestionCreate) SetFieldID(v string) *QuestionCreate {
+	_c.mutation.SetFieldID(v)
+	return _c
+}
+
+// SetFieldType sets the "field_type" field.
+func (_c *QuestionCreate) SetFieldType(v string) *QuestionCreate {
+	_c.mutation.SetFieldType(v)
+	return _c
+}
+
+// SetNillableFieldType sets the "field_type" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableFieldType(v *string) *QuestionCreate {
+	if v != nil {
+		_c.SetFieldType(*v)
+	}
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *QuestionCreate) SetLabel(v string) *QuestionCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableLabel(v *string) *QuestionCreate {
+	if v != nil {
+		_c.SetLabel(*v)
+	}
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *QuestionCreate) SetLabels(v map[string]string) *QuestionCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *QuestionCreate) SetMetadata(v map[string]interface{}) *QuestionCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuestionCreate) SetID(v uuid.UUID) *QuestionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuestionCreate) SetNillableID(v *uuid.UUID) *QuestionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the QuestionMutation object of the builder.
+func (_c *QuestionCreate) Mutation() *QuestionMutation {
+	return _c.mutation
+}
+
+// Save creates the Question in the database.
+func (_c *QuestionCreate) Save(ctx context.Context) (*Question, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuestionCreate) SaveX(ctx context.Context) *Question {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuestionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := question.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := question.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SourceID(); !ok {
+		v := question.DefaultSourceID
+		_c.mutation.SetSourceID(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := question.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuestionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Question.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Question.updated_at"`)}
+	}
+	if _, ok := _c.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "Question.source_type"`)}
+	}
+	if v, ok := _c.mutation.SourceType(); ok {
+		if err := question.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "Question.source_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceID(); !ok {
+		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "Question.source_id"`)}
+	}
+	if _, ok := _c.mutation.FieldID(); !ok {
+		return &ValidationError{Name: "field_id", err: errors.New(`ent: missing required field "Question.field_id"`)}
+	}
+	if v, ok := _c.mutation.FieldID(); ok {
+		if err := question.FieldIDValidator(v); err != nil {
+			return &ValidationError{Name: "field_id", err: fmt.Errorf(`ent: validator failed for field "Question.field_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.FieldType(); ok {
+		if err := question.FieldTypeValidator(v); err != nil {
+			return &ValidationError{Name: "field_type", err: fmt.Errorf(`ent: validator failed for field "Question.field_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *QuestionCreate) sqlSave(ctx context.Context) (*Question, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuestionCreate) createSpec() (*Question, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Question{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(question.Table, sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(question.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(question.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.SourceType(); ok {
+		_spec.SetField(question.FieldSourceType, field.TypeString, value)
+		_node.SourceType = value
+	}
+	if value, ok := _c.mutation.SourceID(); ok {
+		_spec.SetField(question.FieldSourceID, field.TypeString, value)
+		_node.SourceID = value
+	}
+	if value, ok := _c.mutation.FieldID(); ok {
+		_spec.SetField(question.FieldFieldID, field.TypeString, value)
+		_node.FieldID = value
+	}
+	if value, ok := _c.mutation.FieldType(); ok {
+		_spec.SetField(question.FieldFieldType, field.TypeString, value)
+		_node.FieldType = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(question.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(question.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(question.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// QuestionCreateBulk is the builder for creating many Question entities in bulk.
+type QuestionCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionCreate
+}
+
+// Save creates the Question entities in the database.
+func (_c *QuestionCreateBulk) Save(ctx context.Context) ([]*Question, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Question, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuestionCreateBulk) SaveX(ctx context.Context) []*Question {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+)
+
+// QuestionUpdate is the builder for updating Question entities.
+type QuestionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionMutation
+}
+
+// Where appends a list predicates to the QuestionUpdate builder.
+func (_u *QuestionUpdate) Where(ps ...predicate.Question) *QuestionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionUpdate) SetUpdatedAt(v time.Time) *QuestionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFieldType sets the "field_type" field.
+func (_u *QuestionUpdate) SetFieldType(v string) *QuestionUpdate {
+	_u.mutation.SetFieldType(v)
+	return _u
+}
+
+// SetNillableFieldType sets the "field_type" field if the given value is not nil.
+func (_u *QuestionUpdate) SetNillableFieldType(v *string) *QuestionUpdate {
+	if v != nil {
+		_u.SetFieldType(*v)
+	}
+	return _u
+}
+
+// ClearFieldType clears the value of the "field_type" field.
+func (_u *QuestionUpdate) ClearFieldType() *QuestionUpdate {
+	_u.mutation.ClearFieldType()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *QuestionUpdate) SetLabel(v string) *QuestionUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *QuestionUpdate) SetNillableLabel(v *string) *QuestionUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *QuestionUpdate) ClearLabel() *QuestionUpdate {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *QuestionUpdate) SetLabels(v map[string]string) *QuestionUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *QuestionUpdate) ClearLabels() *QuestionUpdate {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *QuestionUpdate) SetMetadata(v map[string]interface{}) *QuestionUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *QuestionUpdate) ClearMetadata() *QuestionUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// Mutation returns the QuestionMutation object of the builder.
+func (_u *QuestionUpdate) Mutation() *QuestionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuestionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuestionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := question.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionUpdate) check() error {
+	if v, ok := _u.mutation.FieldType(); ok {
+		if err := question.FieldTypeValidator(v); err != nil {
+			return &ValidationError{Name: "field_type", err: fmt.Errorf(`ent: validator failed for field "Question.field_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(question.Table, question.Columns, sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(question.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FieldType(); ok {
+		_spec.SetField(question.FieldFieldType, field.TypeString, value)
+	}
+	if _u.mutation.FieldTypeCleared() {
+		_spec.ClearField(question.FieldFieldType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(question.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(question.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(question.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(question.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(question.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(question.FieldMetadata, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{question.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuestionUpdateOne is the builder for updating a single Question entity.
+type QuestionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionUpdateOne) SetUpdatedAt(v time.Time) *QuestionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFieldType sets the "field_type" field.
+func (_u *QuestionUpdateOne) SetFieldType(v string) *QuestionUpdateOne {
+	_u.mutation.SetFieldType(v)
+	return _u
+}
+
+// SetNillableFieldType sets the "field_type" field if the given value is not nil.
+func (_u *QuestionUpdateOne) SetNillableFieldType(v *string) *QuestionUpdateOne {
+	if v != nil {
+		_u.SetFieldType(*v)
+	}
+	return _u
+}
+
+// ClearFieldType clears the value of the "field_type" field.
+func (_u *QuestionUpdateOne) ClearFieldType() *QuestionUpdateOne {
+	_u.mutation.ClearFieldType()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *QuestionUpdateOne) SetLabel(v string) *QuestionUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *QuestionUpdateOne) SetNillableLabel(v *string) *QuestionUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *QuestionUpdateOne) ClearLabel() *QuestionUpdateOne {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *QuestionUpdateOne) SetLabels(v map[string]string) *QuestionUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (_u *QuestionUpdateOne) ClearLabels() *QuestionUpdateOne {
+	_u.mutation.ClearLabels()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *QuestionUpdateOne) SetMetadata(v map[string]interface{}) *QuestionUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *QuestionUpdateOne) ClearMetadata() *QuestionUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// Mutation returns the QuestionMutation object of the builder.
+func (_u *QuestionUpdateOne) Mutation() *QuestionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QuestionUpdate builder.
+func (_u *QuestionUpdateOne) Where(ps ...predicate.Question) *QuestionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuestionUpdateOne) Select(field string, fields ...string) *QuestionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Question entity.
+func (_u *QuestionUpdateOne) Save(ctx context.Context) (*Question, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionUpdateOne) SaveX(ctx context.Context) *Question {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuestionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := question.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionUpdateOne) check() error {
+	if v, ok := _u.mutation.FieldType(); ok {
+		if err := question.FieldTypeValidator(v); err != nil {
+			return &ValidationError{Name: "field_type", err: fmt.Errorf(`ent: validator failed for field "Question.field_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionUpdateOne) sqlSave(ctx context.Context) (_node *Question, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(question.Table, question.Columns, sqlgraph.NewFieldSpec(question.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Question.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, question.FieldID)
+		for _, f := range fields {
+			if !question.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != question.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(question.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FieldType(); ok {
+		_spec.SetField(question.FieldFieldType, field.TypeString, value)
+	}
+	if _u.mutation.FieldTypeCleared() {
+		_spec.ClearField(question.FieldFieldType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(question.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(question.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(question.FieldLabels, field.TypeJSON, value)
+	}
+	if _u.mutation.LabelsCleared() {
+		_spec.ClearField(question.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(question.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(question.FieldMetadata, field.TypeJSON)
+	}
+	_node = &Question{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{question.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
+	"github.com/google/uuid"
+)
+
+// QueuePause is the model entity for the QueuePause schema.
+type QueuePause struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Paused job type, or empty if all job types are paused
+	JobType string `json:"job_type,omitempty"`
+	// Why the queue was paused
+	Reason       string `json:"reason,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QueuePause) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case queuepause.FieldJobType, queuepause.FieldReason:
+			values[i] = new(sql.NullString)
+		case queuepause.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case queuepause.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QueuePause fields.
+func (_m *QueuePause) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case queuepause.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case queuepause.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case queuepause.FieldJobType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_type", values[i])
+			} else if value.Valid {
+				_m.JobType = value.String
+			}
+		case queuepause.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QueuePause.
+// This includes values selected through modifiers, order, etc.
+func (_m *QueuePause) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this QueuePause.
+// Note that you need to call QueuePause.Unwrap() before calling this method if this QueuePause
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QueuePause) Update() *QueuePauseUpdateOne {
+	return NewQueuePauseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QueuePause entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QueuePause) Unwrap() *QueuePause {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QueuePause is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QueuePause) String() string {
+	var builder strings.Builder
+	builder.WriteString("QueuePause(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("job_type=")
+	builder.WriteString(_m.JobType)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QueuePauses is a parsable slice of QueuePause.
+type QueuePauses []*QueuePause
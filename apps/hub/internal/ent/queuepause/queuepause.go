@@ -0,0 +1,73 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queuepause
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the queuepause type in the database.
+	Label = "queue_pause"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldJobType holds the string denoting the job_type field in the database.
+	FieldJobType = "job_type"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// Table holds the table name of the queuepause in the database.
+	Table = "queue_pauses"
+)
+
+// Columns holds all SQL columns for queuepause fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldJobType,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the QueuePause queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByJobType orders the results by the job_type field.
+func ByJobType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobType, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
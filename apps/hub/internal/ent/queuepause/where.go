@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package queuepause
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// JobType applies equality check predicate on the "job_type" field. It's identical to JobTypeEQ.
+func JobType(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldJobType, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// JobTypeEQ applies the EQ predicate on the "job_type" field.
+func JobTypeEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldJobType, v))
+}
+
+// JobTypeNEQ applies the NEQ predicate on the "job_type" field.
+func JobTypeNEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldJobType, v))
+}
+
+// JobTypeIn applies the In predicate on the "job_type" field.
+func JobTypeIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldJobType, vs...))
+}
+
+// JobTypeNotIn applies the NotIn predicate on the "job_type" field.
+func JobTypeNotIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldJobType, vs...))
+}
+
+// JobTypeGT applies the GT predicate on the "job_type" field.
+func JobTypeGT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldJobType, v))
+}
+
+// JobTypeGTE applies the GTE predicate on the "job_type" field.
+func JobTypeGTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldJobType, v))
+}
+
+// JobTypeLT applies the LT predicate on the "job_type" field.
+func JobTypeLT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldJobType, v))
+}
+
+// JobTypeLTE applies the LTE predicate on the "job_type" field.
+func JobTypeLTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldJobType, v))
+}
+
+// JobTypeContains applies the Contains predicate on the "job_type" field.
+func JobTypeContains(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContains(FieldJobType, v))
+}
+
+// JobTypeHasPrefix applies the HasPrefix predicate on the "job_type" field.
+func JobTypeHasPrefix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasPrefix(FieldJobType, v))
+}
+
+// JobTypeHasSuffix applies the HasSuffix predicate on the "job_type" field.
+func JobTypeHasSuffix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasSuffix(FieldJobType, v))
+}
+
+// JobTypeEqualFold applies the EqualFold predicate on the "job_type" field.
+func JobTypeEqualFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEqualFold(FieldJobType, v))
+}
+
+// JobTypeContainsFold applies the ContainsFold predicate on the "job_type" field.
+func JobTypeContainsFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContainsFold(FieldJobType, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.QueuePause {
+	return predicate.QueuePause(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QueuePause) predicate.QueuePause {
+	return predicate.QueuePause(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QueuePause) predicate.QueuePause {
+	return predicate.QueuePause(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QueuePause) predicate.QueuePause {
+	return predicate.QueuePause(sql.NotPredicates(p))
+}
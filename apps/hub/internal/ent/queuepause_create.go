@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
+	"github.com/google/uuid"
+)
+
+// QueuePauseCreate is the builder for creating a QueuePause entity.
+type QueuePauseCreate struct {
+	config
+	mutation *QueuePauseMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QueuePauseCreate) SetCreatedAt(v time.Time) *QueuePauseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillableCreatedAt(v *time.Time) *QueuePauseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetJobType sets the "job_type" field.
+func (_c *QueuePauseCreate) SetJobType(v string) *QueuePauseCreate {
+	_c.mutation.SetJobType(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *QueuePauseCreate) SetReason(v string) *QueuePauseCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillableReason(v *string) *QueuePauseCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QueuePauseCreate) SetID(v uuid.UUID) *QueuePauseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QueuePauseCreate) SetNillableID(v *uuid.UUID) *QueuePauseCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the QueuePauseMutation object of the builder.
+func (_c *QueuePauseCreate) Mutation() *QueuePauseMutation {
+	return _c.mutation
+}
+
+// Save creates the QueuePause in the database.
+func (_c *QueuePauseCreate) Save(ctx context.Context) (*QueuePause, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QueuePauseCreate) SaveX(ctx context.Context) *QueuePause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuePauseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuePauseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QueuePauseCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := queuepause.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := queuepause.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QueuePauseCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QueuePause.created_at"`)}
+	}
+	if _, ok := _c.mutation.JobType(); !ok {
+		return &ValidationError{Name: "job_type", err: errors.New(`ent: missing required field "QueuePause.job_type"`)}
+	}
+	return nil
+}
+
+func (_c *QueuePauseCreate) sqlSave(ctx context.Context) (*QueuePause, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QueuePauseCreate) createSpec() (*QueuePause, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QueuePause{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(queuepause.Table, sqlgraph.NewFieldSpec(queuepause.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(queuepause.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.JobType(); ok {
+		_spec.SetField(queuepause.FieldJobType, field.TypeString, value)
+		_node.JobType = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(queuepause.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	return _node, _spec
+}
+
+// QueuePauseCreateBulk is the builder for creating many QueuePause entities in bulk.
+type QueuePauseCreateBulk struct {
+	config
+	err      error
+	builders []*QueuePauseCreate
+}
+
+// Save creates the QueuePause entities in the database.
+func (_c *QueuePauseCreateBulk) Save(ctx context.Context) ([]*QueuePause, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QueuePause, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QueuePauseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QueuePauseCreateBulk) SaveX(ctx context.Context) []*QueuePause {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QueuePauseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QueuePauseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
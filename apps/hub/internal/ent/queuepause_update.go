@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
+)
+
+// QueuePauseUpdate is the builder for updating QueuePause entities.
+type QueuePauseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QueuePauseMutation
+}
+
+// Where appends a list predicates to the QueuePauseUpdate builder.
+func (_u *QueuePauseUpdate) Where(ps ...predicate.QueuePause) *QueuePauseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *QueuePauseUpdate) SetReason(v string) *QueuePauseUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *QueuePauseUpdate) SetNillableReason(v *string) *QueuePauseUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *QueuePauseUpdate) ClearReason() *QueuePauseUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the QueuePauseMutation object of the builder.
+func (_u *QueuePauseUpdate) Mutation() *QueuePauseMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QueuePauseUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QueuePauseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QueuePauseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QueuePauseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *QueuePauseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(queuepause.Table, queuepause.Columns, sqlgraph.NewFieldSpec(queuepause.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(queuepause.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(queuepause.FieldReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuepause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QueuePauseUpdateOne is the builder for updating a single QueuePause entity.
+type QueuePauseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QueuePauseMutation
+}
+
+// SetReason sets the "reason" field.
+func (_u *QueuePauseUpdateOne) SetReason(v string) *QueuePauseUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *QueuePauseUpdateOne) SetNillableReason(v *string) *QueuePauseUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *QueuePauseUpdateOne) ClearReason() *QueuePauseUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// Mutation returns the QueuePauseMutation object of the builder.
+func (_u *QueuePauseUpdateOne) Mutation() *QueuePauseMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QueuePauseUpdate builder.
+func (_u *QueuePauseUpdateOne) Where(ps ...predicate.QueuePause) *QueuePauseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QueuePauseUpdateOne) Select(field string, fields ...string) *QueuePauseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QueuePause entity.
+func (_u *QueuePauseUpdateOne) Save(ctx context.Context) (*QueuePause, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QueuePauseUpdateOne) SaveX(ctx context.Context) *QueuePause {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QueuePauseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QueuePauseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *QueuePauseUpdateOne) sqlSave(ctx context.Context) (_node *QueuePause, err error) {
+	_spec := sqlgraph.NewUpdateSpec(queuepause.Table, queuepause.Columns, sqlgraph.NewFieldSpec(queuepause.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QueuePause.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, queuepause.FieldID)
+		for _, f := range fields {
+			if !queuepause.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != queuepause.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(queuepause.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(queuepause.FieldReason, field.TypeString)
+	}
+	_node = &QueuePause{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{queuepause.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,9 +5,22 @@ package ent
 import (
 	"time"
 
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+	"github.com/formbricks/hub/apps/hub/internal/ent/auditlog"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
 	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
 	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedeletion"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/ent/ingestmapping"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+	"github.com/formbricks/hub/apps/hub/internal/ent/queuepause"
 	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/formbricks/hub/apps/hub/internal/ent/segment"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/ent/worker"
 	"github.com/google/uuid"
 )
 
@@ -15,54 +28,145 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	aiusageMixin := schema.AIUsage{}.Mixin()
+	aiusageMixinFields0 := aiusageMixin[0].Fields()
+	_ = aiusageMixinFields0
+	aiusageMixinFields1 := aiusageMixin[1].Fields()
+	_ = aiusageMixinFields1
+	aiusageFields := schema.AIUsage{}.Fields()
+	_ = aiusageFields
+	// aiusageDescUpdatedAt is the schema descriptor for updated_at field.
+	aiusageDescUpdatedAt := aiusageMixinFields1[0].Descriptor()
+	// aiusage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	aiusage.DefaultUpdatedAt = aiusageDescUpdatedAt.Default.(func() time.Time)
+	// aiusage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	aiusage.UpdateDefaultUpdatedAt = aiusageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// aiusageDescRequests is the schema descriptor for requests field.
+	aiusageDescRequests := aiusageFields[4].Descriptor()
+	// aiusage.DefaultRequests holds the default value on creation for the requests field.
+	aiusage.DefaultRequests = aiusageDescRequests.Default.(int)
+	// aiusageDescPromptTokens is the schema descriptor for prompt_tokens field.
+	aiusageDescPromptTokens := aiusageFields[5].Descriptor()
+	// aiusage.DefaultPromptTokens holds the default value on creation for the prompt_tokens field.
+	aiusage.DefaultPromptTokens = aiusageDescPromptTokens.Default.(int64)
+	// aiusageDescCompletionTokens is the schema descriptor for completion_tokens field.
+	aiusageDescCompletionTokens := aiusageFields[6].Descriptor()
+	// aiusage.DefaultCompletionTokens holds the default value on creation for the completion_tokens field.
+	aiusage.DefaultCompletionTokens = aiusageDescCompletionTokens.Default.(int64)
+	// aiusageDescCostUsd is the schema descriptor for cost_usd field.
+	aiusageDescCostUsd := aiusageFields[7].Descriptor()
+	// aiusage.DefaultCostUsd holds the default value on creation for the cost_usd field.
+	aiusage.DefaultCostUsd = aiusageDescCostUsd.Default.(float64)
+	// aiusageDescID is the schema descriptor for id field.
+	aiusageDescID := aiusageMixinFields0[0].Descriptor()
+	// aiusage.DefaultID holds the default value on creation for the id field.
+	aiusage.DefaultID = aiusageDescID.Default.(func() uuid.UUID)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinFields0 := auditlogMixin[0].Fields()
+	_ = auditlogMixinFields0
+	auditlogMixinFields1 := auditlogMixin[1].Fields()
+	_ = auditlogMixinFields1
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogMixinFields1[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogMixinFields0[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
+	connectorcursorMixin := schema.ConnectorCursor{}.Mixin()
+	connectorcursorMixinFields0 := connectorcursorMixin[0].Fields()
+	_ = connectorcursorMixinFields0
+	connectorcursorMixinFields1 := connectorcursorMixin[1].Fields()
+	_ = connectorcursorMixinFields1
+	connectorcursorFields := schema.ConnectorCursor{}.Fields()
+	_ = connectorcursorFields
+	// connectorcursorDescCreatedAt is the schema descriptor for created_at field.
+	connectorcursorDescCreatedAt := connectorcursorMixinFields1[0].Descriptor()
+	// connectorcursor.DefaultCreatedAt holds the default value on creation for the created_at field.
+	connectorcursor.DefaultCreatedAt = connectorcursorDescCreatedAt.Default.(func() time.Time)
+	// connectorcursorDescUpdatedAt is the schema descriptor for updated_at field.
+	connectorcursorDescUpdatedAt := connectorcursorMixinFields1[1].Descriptor()
+	// connectorcursor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	connectorcursor.DefaultUpdatedAt = connectorcursorDescUpdatedAt.Default.(func() time.Time)
+	// connectorcursor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	connectorcursor.UpdateDefaultUpdatedAt = connectorcursorDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// connectorcursorDescStream is the schema descriptor for stream field.
+	connectorcursorDescStream := connectorcursorFields[0].Descriptor()
+	// connectorcursor.StreamValidator is a validator for the "stream" field. It is called by the builders before save.
+	connectorcursor.StreamValidator = connectorcursorDescStream.Validators[0].(func(string) error)
+	// connectorcursorDescID is the schema descriptor for id field.
+	connectorcursorDescID := connectorcursorMixinFields0[0].Descriptor()
+	// connectorcursor.DefaultID holds the default value on creation for the id field.
+	connectorcursor.DefaultID = connectorcursorDescID.Default.(func() uuid.UUID)
+	enrichmentjobMixin := schema.EnrichmentJob{}.Mixin()
+	enrichmentjobMixinFields0 := enrichmentjobMixin[0].Fields()
+	_ = enrichmentjobMixinFields0
+	enrichmentjobMixinFields1 := enrichmentjobMixin[1].Fields()
+	_ = enrichmentjobMixinFields1
 	enrichmentjobFields := schema.EnrichmentJob{}.Fields()
 	_ = enrichmentjobFields
+	// enrichmentjobDescCreatedAt is the schema descriptor for created_at field.
+	enrichmentjobDescCreatedAt := enrichmentjobMixinFields1[0].Descriptor()
+	// enrichmentjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	enrichmentjob.DefaultCreatedAt = enrichmentjobDescCreatedAt.Default.(func() time.Time)
 	// enrichmentjobDescJobType is the schema descriptor for job_type field.
-	enrichmentjobDescJobType := enrichmentjobFields[2].Descriptor()
+	enrichmentjobDescJobType := enrichmentjobFields[1].Descriptor()
 	// enrichmentjob.DefaultJobType holds the default value on creation for the job_type field.
 	enrichmentjob.DefaultJobType = enrichmentjobDescJobType.Default.(string)
 	// enrichmentjobDescStatus is the schema descriptor for status field.
-	enrichmentjobDescStatus := enrichmentjobFields[3].Descriptor()
+	enrichmentjobDescStatus := enrichmentjobFields[2].Descriptor()
 	// enrichmentjob.DefaultStatus holds the default value on creation for the status field.
 	enrichmentjob.DefaultStatus = enrichmentjobDescStatus.Default.(string)
+	// enrichmentjobDescPriority is the schema descriptor for priority field.
+	enrichmentjobDescPriority := enrichmentjobFields[6].Descriptor()
+	// enrichmentjob.DefaultPriority holds the default value on creation for the priority field.
+	enrichmentjob.DefaultPriority = enrichmentjobDescPriority.Default.(int)
 	// enrichmentjobDescAttempts is the schema descriptor for attempts field.
-	enrichmentjobDescAttempts := enrichmentjobFields[6].Descriptor()
+	enrichmentjobDescAttempts := enrichmentjobFields[7].Descriptor()
 	// enrichmentjob.DefaultAttempts holds the default value on creation for the attempts field.
 	enrichmentjob.DefaultAttempts = enrichmentjobDescAttempts.Default.(int)
-	// enrichmentjobDescCreatedAt is the schema descriptor for created_at field.
-	enrichmentjobDescCreatedAt := enrichmentjobFields[7].Descriptor()
-	// enrichmentjob.DefaultCreatedAt holds the default value on creation for the created_at field.
-	enrichmentjob.DefaultCreatedAt = enrichmentjobDescCreatedAt.Default.(func() time.Time)
+	// enrichmentjobDescRequeues is the schema descriptor for requeues field.
+	enrichmentjobDescRequeues := enrichmentjobFields[8].Descriptor()
+	// enrichmentjob.DefaultRequeues holds the default value on creation for the requeues field.
+	enrichmentjob.DefaultRequeues = enrichmentjobDescRequeues.Default.(int)
 	// enrichmentjobDescID is the schema descriptor for id field.
-	enrichmentjobDescID := enrichmentjobFields[0].Descriptor()
+	enrichmentjobDescID := enrichmentjobMixinFields0[0].Descriptor()
 	// enrichmentjob.DefaultID holds the default value on creation for the id field.
 	enrichmentjob.DefaultID = enrichmentjobDescID.Default.(func() uuid.UUID)
+	experiencedataMixin := schema.ExperienceData{}.Mixin()
+	experiencedataMixinFields0 := experiencedataMixin[0].Fields()
+	_ = experiencedataMixinFields0
+	experiencedataMixinFields1 := experiencedataMixin[1].Fields()
+	_ = experiencedataMixinFields1
 	experiencedataFields := schema.ExperienceData{}.Fields()
 	_ = experiencedataFields
-	// experiencedataDescCollectedAt is the schema descriptor for collected_at field.
-	experiencedataDescCollectedAt := experiencedataFields[1].Descriptor()
-	// experiencedata.DefaultCollectedAt holds the default value on creation for the collected_at field.
-	experiencedata.DefaultCollectedAt = experiencedataDescCollectedAt.Default.(func() time.Time)
 	// experiencedataDescCreatedAt is the schema descriptor for created_at field.
-	experiencedataDescCreatedAt := experiencedataFields[2].Descriptor()
+	experiencedataDescCreatedAt := experiencedataMixinFields1[0].Descriptor()
 	// experiencedata.DefaultCreatedAt holds the default value on creation for the created_at field.
 	experiencedata.DefaultCreatedAt = experiencedataDescCreatedAt.Default.(func() time.Time)
 	// experiencedataDescUpdatedAt is the schema descriptor for updated_at field.
-	experiencedataDescUpdatedAt := experiencedataFields[3].Descriptor()
+	experiencedataDescUpdatedAt := experiencedataMixinFields1[1].Descriptor()
 	// experiencedata.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	experiencedata.DefaultUpdatedAt = experiencedataDescUpdatedAt.Default.(func() time.Time)
 	// experiencedata.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	experiencedata.UpdateDefaultUpdatedAt = experiencedataDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// experiencedataDescCollectedAt is the schema descriptor for collected_at field.
+	experiencedataDescCollectedAt := experiencedataFields[0].Descriptor()
+	// experiencedata.DefaultCollectedAt holds the default value on creation for the collected_at field.
+	experiencedata.DefaultCollectedAt = experiencedataDescCollectedAt.Default.(func() time.Time)
 	// experiencedataDescSourceType is the schema descriptor for source_type field.
-	experiencedataDescSourceType := experiencedataFields[4].Descriptor()
+	experiencedataDescSourceType := experiencedataFields[1].Descriptor()
 	// experiencedata.SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
 	experiencedata.SourceTypeValidator = experiencedataDescSourceType.Validators[0].(func(string) error)
 	// experiencedataDescFieldID is the schema descriptor for field_id field.
-	experiencedataDescFieldID := experiencedataFields[7].Descriptor()
+	experiencedataDescFieldID := experiencedataFields[4].Descriptor()
 	// experiencedata.FieldIDValidator is a validator for the "field_id" field. It is called by the builders before save.
 	experiencedata.FieldIDValidator = experiencedataDescFieldID.Validators[0].(func(string) error)
 	// experiencedataDescFieldType is the schema descriptor for field_type field.
-	experiencedataDescFieldType := experiencedataFields[9].Descriptor()
+	experiencedataDescFieldType := experiencedataFields[7].Descriptor()
 	// experiencedata.FieldTypeValidator is a validator for the "field_type" field. It is called by the builders before save.
 	experiencedata.FieldTypeValidator = func() func(string) error {
 		validators := experiencedataDescFieldType.Validators
@@ -80,11 +184,290 @@ func init() {
 		}
 	}()
 	// experiencedataDescLanguage is the schema descriptor for language field.
-	experiencedataDescLanguage := experiencedataFields[16].Descriptor()
+	experiencedataDescLanguage := experiencedataFields[20].Descriptor()
 	// experiencedata.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
 	experiencedata.LanguageValidator = experiencedataDescLanguage.Validators[0].(func(string) error)
+	// experiencedataDescSkipAiProcessing is the schema descriptor for skip_ai_processing field.
+	experiencedataDescSkipAiProcessing := experiencedataFields[34].Descriptor()
+	// experiencedata.DefaultSkipAiProcessing holds the default value on creation for the skip_ai_processing field.
+	experiencedata.DefaultSkipAiProcessing = experiencedataDescSkipAiProcessing.Default.(bool)
 	// experiencedataDescID is the schema descriptor for id field.
-	experiencedataDescID := experiencedataFields[0].Descriptor()
+	experiencedataDescID := experiencedataMixinFields0[0].Descriptor()
 	// experiencedata.DefaultID holds the default value on creation for the id field.
 	experiencedata.DefaultID = experiencedataDescID.Default.(func() uuid.UUID)
+	experiencedeletionMixin := schema.ExperienceDeletion{}.Mixin()
+	experiencedeletionMixinFields0 := experiencedeletionMixin[0].Fields()
+	_ = experiencedeletionMixinFields0
+	experiencedeletionMixinFields1 := experiencedeletionMixin[1].Fields()
+	_ = experiencedeletionMixinFields1
+	experiencedeletionFields := schema.ExperienceDeletion{}.Fields()
+	_ = experiencedeletionFields
+	// experiencedeletionDescCreatedAt is the schema descriptor for created_at field.
+	experiencedeletionDescCreatedAt := experiencedeletionMixinFields1[0].Descriptor()
+	// experiencedeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	experiencedeletion.DefaultCreatedAt = experiencedeletionDescCreatedAt.Default.(func() time.Time)
+	// experiencedeletionDescID is the schema descriptor for id field.
+	experiencedeletionDescID := experiencedeletionMixinFields0[0].Descriptor()
+	// experiencedeletion.DefaultID holds the default value on creation for the id field.
+	experiencedeletion.DefaultID = experiencedeletionDescID.Default.(func() uuid.UUID)
+	exportMixin := schema.Export{}.Mixin()
+	exportMixinFields0 := exportMixin[0].Fields()
+	_ = exportMixinFields0
+	exportMixinFields1 := exportMixin[1].Fields()
+	_ = exportMixinFields1
+	exportFields := schema.Export{}.Fields()
+	_ = exportFields
+	// exportDescCreatedAt is the schema descriptor for created_at field.
+	exportDescCreatedAt := exportMixinFields1[0].Descriptor()
+	// export.DefaultCreatedAt holds the default value on creation for the created_at field.
+	export.DefaultCreatedAt = exportDescCreatedAt.Default.(func() time.Time)
+	// exportDescUpdatedAt is the schema descriptor for updated_at field.
+	exportDescUpdatedAt := exportMixinFields1[1].Descriptor()
+	// export.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	export.DefaultUpdatedAt = exportDescUpdatedAt.Default.(func() time.Time)
+	// export.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	export.UpdateDefaultUpdatedAt = exportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// exportDescName is the schema descriptor for name field.
+	exportDescName := exportFields[0].Descriptor()
+	// export.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	export.NameValidator = exportDescName.Validators[0].(func(string) error)
+	// exportDescFormat is the schema descriptor for format field.
+	exportDescFormat := exportFields[1].Descriptor()
+	// export.DefaultFormat holds the default value on creation for the format field.
+	export.DefaultFormat = exportDescFormat.Default.(string)
+	// exportDescProvider is the schema descriptor for provider field.
+	exportDescProvider := exportFields[2].Descriptor()
+	// export.DefaultProvider holds the default value on creation for the provider field.
+	export.DefaultProvider = exportDescProvider.Default.(string)
+	// exportDescBucket is the schema descriptor for bucket field.
+	exportDescBucket := exportFields[3].Descriptor()
+	// export.BucketValidator is a validator for the "bucket" field. It is called by the builders before save.
+	export.BucketValidator = exportDescBucket.Validators[0].(func(string) error)
+	// exportDescIntervalMinutes is the schema descriptor for interval_minutes field.
+	exportDescIntervalMinutes := exportFields[9].Descriptor()
+	// export.DefaultIntervalMinutes holds the default value on creation for the interval_minutes field.
+	export.DefaultIntervalMinutes = exportDescIntervalMinutes.Default.(int)
+	// exportDescEnabled is the schema descriptor for enabled field.
+	exportDescEnabled := exportFields[10].Descriptor()
+	// export.DefaultEnabled holds the default value on creation for the enabled field.
+	export.DefaultEnabled = exportDescEnabled.Default.(bool)
+	// exportDescNextRunAt is the schema descriptor for next_run_at field.
+	exportDescNextRunAt := exportFields[13].Descriptor()
+	// export.DefaultNextRunAt holds the default value on creation for the next_run_at field.
+	export.DefaultNextRunAt = exportDescNextRunAt.Default.(func() time.Time)
+	// exportDescID is the schema descriptor for id field.
+	exportDescID := exportMixinFields0[0].Descriptor()
+	// export.DefaultID holds the default value on creation for the id field.
+	export.DefaultID = exportDescID.Default.(func() uuid.UUID)
+	exportrunMixin := schema.ExportRun{}.Mixin()
+	exportrunMixinFields0 := exportrunMixin[0].Fields()
+	_ = exportrunMixinFields0
+	exportrunMixinFields1 := exportrunMixin[1].Fields()
+	_ = exportrunMixinFields1
+	exportrunFields := schema.ExportRun{}.Fields()
+	_ = exportrunFields
+	// exportrunDescCreatedAt is the schema descriptor for created_at field.
+	exportrunDescCreatedAt := exportrunMixinFields1[0].Descriptor()
+	// exportrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exportrun.DefaultCreatedAt = exportrunDescCreatedAt.Default.(func() time.Time)
+	// exportrunDescStatus is the schema descriptor for status field.
+	exportrunDescStatus := exportrunFields[1].Descriptor()
+	// exportrun.DefaultStatus holds the default value on creation for the status field.
+	exportrun.DefaultStatus = exportrunDescStatus.Default.(string)
+	// exportrunDescExperiences is the schema descriptor for experiences field.
+	exportrunDescExperiences := exportrunFields[2].Descriptor()
+	// exportrun.DefaultExperiences holds the default value on creation for the experiences field.
+	exportrun.DefaultExperiences = exportrunDescExperiences.Default.(int)
+	// exportrunDescBytes is the schema descriptor for bytes field.
+	exportrunDescBytes := exportrunFields[3].Descriptor()
+	// exportrun.DefaultBytes holds the default value on creation for the bytes field.
+	exportrun.DefaultBytes = exportrunDescBytes.Default.(int64)
+	// exportrunDescID is the schema descriptor for id field.
+	exportrunDescID := exportrunMixinFields0[0].Descriptor()
+	// exportrun.DefaultID holds the default value on creation for the id field.
+	exportrun.DefaultID = exportrunDescID.Default.(func() uuid.UUID)
+	ingestmappingMixin := schema.IngestMapping{}.Mixin()
+	ingestmappingMixinFields0 := ingestmappingMixin[0].Fields()
+	_ = ingestmappingMixinFields0
+	ingestmappingMixinFields1 := ingestmappingMixin[1].Fields()
+	_ = ingestmappingMixinFields1
+	ingestmappingFields := schema.IngestMapping{}.Fields()
+	_ = ingestmappingFields
+	// ingestmappingDescCreatedAt is the schema descriptor for created_at field.
+	ingestmappingDescCreatedAt := ingestmappingMixinFields1[0].Descriptor()
+	// ingestmapping.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ingestmapping.DefaultCreatedAt = ingestmappingDescCreatedAt.Default.(func() time.Time)
+	// ingestmappingDescUpdatedAt is the schema descriptor for updated_at field.
+	ingestmappingDescUpdatedAt := ingestmappingMixinFields1[1].Descriptor()
+	// ingestmapping.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ingestmapping.DefaultUpdatedAt = ingestmappingDescUpdatedAt.Default.(func() time.Time)
+	// ingestmapping.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ingestmapping.UpdateDefaultUpdatedAt = ingestmappingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ingestmappingDescName is the schema descriptor for name field.
+	ingestmappingDescName := ingestmappingFields[0].Descriptor()
+	// ingestmapping.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ingestmapping.NameValidator = ingestmappingDescName.Validators[0].(func(string) error)
+	// ingestmappingDescSourceType is the schema descriptor for source_type field.
+	ingestmappingDescSourceType := ingestmappingFields[1].Descriptor()
+	// ingestmapping.SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
+	ingestmapping.SourceTypeValidator = ingestmappingDescSourceType.Validators[0].(func(string) error)
+	// ingestmappingDescEnabled is the schema descriptor for enabled field.
+	ingestmappingDescEnabled := ingestmappingFields[5].Descriptor()
+	// ingestmapping.DefaultEnabled holds the default value on creation for the enabled field.
+	ingestmapping.DefaultEnabled = ingestmappingDescEnabled.Default.(bool)
+	// ingestmappingDescID is the schema descriptor for id field.
+	ingestmappingDescID := ingestmappingMixinFields0[0].Descriptor()
+	// ingestmapping.DefaultID holds the default value on creation for the id field.
+	ingestmapping.DefaultID = ingestmappingDescID.Default.(func() uuid.UUID)
+	questionMixin := schema.Question{}.Mixin()
+	questionMixinFields0 := questionMixin[0].Fields()
+	_ = questionMixinFields0
+	questionMixinFields1 := questionMixin[1].Fields()
+	_ = questionMixinFields1
+	questionFields := schema.Question{}.Fields()
+	_ = questionFields
+	// questionDescCreatedAt is the schema descriptor for created_at field.
+	questionDescCreatedAt := questionMixinFields1[0].Descriptor()
+	// question.DefaultCreatedAt holds the default value on creation for the created_at field.
+	question.DefaultCreatedAt = questionDescCreatedAt.Default.(func() time.Time)
+	// questionDescUpdatedAt is the schema descriptor for updated_at field.
+	questionDescUpdatedAt := questionMixinFields1[1].Descriptor()
+	// question.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	question.DefaultUpdatedAt = questionDescUpdatedAt.Default.(func() time.Time)
+	// question.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	question.UpdateDefaultUpdatedAt = questionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// questionDescSourceType is the schema descriptor for source_type field.
+	questionDescSourceType := questionFields[0].Descriptor()
+	// question.SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
+	question.SourceTypeValidator = questionDescSourceType.Validators[0].(func(string) error)
+	// questionDescSourceID is the schema descriptor for source_id field.
+	questionDescSourceID := questionFields[1].Descriptor()
+	// question.DefaultSourceID holds the default value on creation for the source_id field.
+	question.DefaultSourceID = questionDescSourceID.Default.(string)
+	// questionDescFieldID is the schema descriptor for field_id field.
+	questionDescFieldID := questionFields[2].Descriptor()
+	// question.FieldIDValidator is a validator for the "field_id" field. It is called by the builders before save.
+	question.FieldIDValidator = questionDescFieldID.Validators[0].(func(string) error)
+	// questionDescFieldType is the schema descriptor for field_type field.
+	questionDescFieldType := questionFields[3].Descriptor()
+	// question.FieldTypeValidator is a validator for the "field_type" field. It is called by the builders before save.
+	question.FieldTypeValidator = questionDescFieldType.Validators[0].(func(string) error)
+	// questionDescID is the schema descriptor for id field.
+	questionDescID := questionMixinFields0[0].Descriptor()
+	// question.DefaultID holds the default value on creation for the id field.
+	question.DefaultID = questionDescID.Default.(func() uuid.UUID)
+	queuepauseMixin := schema.QueuePause{}.Mixin()
+	queuepauseMixinFields0 := queuepauseMixin[0].Fields()
+	_ = queuepauseMixinFields0
+	queuepauseMixinFields1 := queuepauseMixin[1].Fields()
+	_ = queuepauseMixinFields1
+	queuepauseFields := schema.QueuePause{}.Fields()
+	_ = queuepauseFields
+	// queuepauseDescCreatedAt is the schema descriptor for created_at field.
+	queuepauseDescCreatedAt := queuepauseMixinFields1[0].Descriptor()
+	// queuepause.DefaultCreatedAt holds the default value on creation for the created_at field.
+	queuepause.DefaultCreatedAt = queuepauseDescCreatedAt.Default.(func() time.Time)
+	// queuepauseDescID is the schema descriptor for id field.
+	queuepauseDescID := queuepauseMixinFields0[0].Descriptor()
+	// queuepause.DefaultID holds the default value on creation for the id field.
+	queuepause.DefaultID = queuepauseDescID.Default.(func() uuid.UUID)
+	segmentMixin := schema.Segment{}.Mixin()
+	segmentMixinFields0 := segmentMixin[0].Fields()
+	_ = segmentMixinFields0
+	segmentMixinFields1 := segmentMixin[1].Fields()
+	_ = segmentMixinFields1
+	segmentFields := schema.Segment{}.Fields()
+	_ = segmentFields
+	// segmentDescCreatedAt is the schema descriptor for created_at field.
+	segmentDescCreatedAt := segmentMixinFields1[0].Descriptor()
+	// segment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	segment.DefaultCreatedAt = segmentDescCreatedAt.Default.(func() time.Time)
+	// segmentDescUpdatedAt is the schema descriptor for updated_at field.
+	segmentDescUpdatedAt := segmentMixinFields1[1].Descriptor()
+	// segment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	segment.DefaultUpdatedAt = segmentDescUpdatedAt.Default.(func() time.Time)
+	// segment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	segment.UpdateDefaultUpdatedAt = segmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// segmentDescName is the schema descriptor for name field.
+	segmentDescName := segmentFields[0].Descriptor()
+	// segment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	segment.NameValidator = segmentDescName.Validators[0].(func(string) error)
+	// segmentDescID is the schema descriptor for id field.
+	segmentDescID := segmentMixinFields0[0].Descriptor()
+	// segment.DefaultID holds the default value on creation for the id field.
+	segment.DefaultID = segmentDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhookendpointMixin := schema.WebhookEndpoint{}.Mixin()
+	webhookendpointMixinFields0 := webhookendpointMixin[0].Fields()
+	_ = webhookendpointMixinFields0
+	webhookendpointMixinFields1 := webhookendpointMixin[1].Fields()
+	_ = webhookendpointMixinFields1
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescCreatedAt is the schema descriptor for created_at field.
+	webhookendpointDescCreatedAt := webhookendpointMixinFields1[0].Descriptor()
+	// webhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookendpoint.DefaultCreatedAt = webhookendpointDescCreatedAt.Default.(func() time.Time)
+	// webhookendpointDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookendpointDescUpdatedAt := webhookendpointMixinFields1[1].Descriptor()
+	// webhookendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookendpoint.DefaultUpdatedAt = webhookendpointDescUpdatedAt.Default.(func() time.Time)
+	// webhookendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookendpoint.UpdateDefaultUpdatedAt = webhookendpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookendpointDescURL is the schema descriptor for url field.
+	webhookendpointDescURL := webhookendpointFields[0].Descriptor()
+	// webhookendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookendpoint.URLValidator = webhookendpointDescURL.Validators[0].(func(string) error)
+	// webhookendpointDescEnabled is the schema descriptor for enabled field.
+	webhookendpointDescEnabled := webhookendpointFields[4].Descriptor()
+	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
+	// webhookendpointDescFormat is the schema descriptor for format field.
+	webhookendpointDescFormat := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.DefaultFormat holds the default value on creation for the format field.
+	webhookendpoint.DefaultFormat = webhookendpointDescFormat.Default.(string)
+	// webhookendpointDescID is the schema descriptor for id field.
+	webhookendpointDescID := webhookendpointMixinFields0[0].Descriptor()
+	// webhookendpoint.DefaultID holds the default value on creation for the id field.
+	webhookendpoint.DefaultID = webhookendpointDescID.Default.(func() uuid.UUID)
+	workerFields := schema.Worker{}.Fields()
+	_ = workerFields
+	// workerDescProcessed is the schema descriptor for processed field.
+	workerDescProcessed := workerFields[6].Descriptor()
+	// worker.DefaultProcessed holds the default value on creation for the processed field.
+	worker.DefaultProcessed = workerDescProcessed.Default.(int64)
+	// workerDescFailed is the schema descriptor for failed field.
+	workerDescFailed := workerFields[7].Descriptor()
+	// worker.DefaultFailed holds the default value on creation for the failed field.
+	worker.DefaultFailed = workerDescFailed.Default.(int64)
+	// workerDescStartedAt is the schema descriptor for started_at field.
+	workerDescStartedAt := workerFields[8].Descriptor()
+	// worker.DefaultStartedAt holds the default value on creation for the started_at field.
+	worker.DefaultStartedAt = workerDescStartedAt.Default.(func() time.Time)
+	// workerDescLastHeartbeatAt is the schema descriptor for last_heartbeat_at field.
+	workerDescLastHeartbeatAt := workerFields[9].Descriptor()
+	// worker.DefaultLastHeartbeatAt holds the default value on creation for the last_heartbeat_at field.
+	worker.DefaultLastHeartbeatAt = workerDescLastHeartbeatAt.Default.(func() time.Time)
 }
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AIUsage holds the schema definition for the AIUsage entity.
+// Each row aggregates AI token usage and estimated cost for one day, job type,
+// provider, and model, so spend can be attributed without scanning all jobs.
+type AIUsage struct {
+	ent.Schema
+}
+
+// Mixin of the AIUsage.
+func (AIUsage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		UpdateTimeMixin{},
+	}
+}
+
+// Fields of the AIUsage.
+func (AIUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("day").
+			Immutable().
+			Comment("UTC day the usage was recorded (midnight)"),
+		field.String("job_type").
+			Immutable().
+			Comment("Job type: enrichment, embedding, or search (query embeddings)"),
+		field.String("provider").
+			Immutable().
+			Comment("AI provider (e.g., openai, gemini)"),
+		field.String("model").
+			Immutable().
+			Comment("AI model (e.g., gpt-4o-mini)"),
+		field.Int("requests").
+			Default(0).
+			Comment("Number of successful AI requests"),
+		field.Int64("prompt_tokens").
+			Default(0).
+			Comment("Total prompt (input) tokens"),
+		field.Int64("completion_tokens").
+			Default(0).
+			Comment("Total completion (output) tokens"),
+		field.Float("cost_usd").
+			Default(0).
+			Comment("Total estimated cost in USD"),
+	}
+}
+
+// Indexes of the AIUsage.
+func (AIUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("day", "job_type", "provider", "model").
+			Unique(),
+	}
+}
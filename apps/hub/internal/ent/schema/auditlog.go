@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// Each row is one mutating API call: who made it, what it did, and which webhook
+// events it emitted. Entries are deleted after the retention period.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Mixin of the AuditLog.
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("actor").
+			Immutable().
+			Comment("Who made the call: api_key:<fingerprint> or anonymous"),
+		field.String("request_id").
+			Optional().
+			Immutable().
+			Comment("Request ID, also logged with the request"),
+		field.String("method").
+			Immutable(),
+		field.String("path").
+			Immutable(),
+		field.String("operation").
+			Optional().
+			Immutable().
+			Comment("API operation ID (e.g., create-experience)"),
+		field.String("resource_id").
+			Optional().
+			Immutable().
+			Comment("ID of the resource in the path, if any"),
+		field.Int("status").
+			Immutable().
+			Comment("HTTP status of the response"),
+		field.Text("summary").
+			Immutable().
+			Comment("Human-readable description of the call"),
+		field.Strings("event_ids").
+			Optional().
+			Immutable().
+			Comment("IDs of the webhook events emitted by the call"),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for listing entries by time and deleting them after the retention period
+		index.Fields("created_at"),
+		// Index for listing an actor's calls
+		index.Fields("actor", "created_at"),
+		// Index for listing the calls on a resource
+		index.Fields("resource_id", "created_at"),
+		// Index for finding the entry of a request
+		index.Fields("request_id"),
+	}
+}
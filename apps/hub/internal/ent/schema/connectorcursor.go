@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ConnectorCursor holds the schema definition for the ConnectorCursor entity.
+// Each row is the position up to which a connector has synced a stream of a tool's API,
+// so the next sync continues where the last one ended.
+type ConnectorCursor struct {
+	ent.Schema
+}
+
+// Mixin of the ConnectorCursor.
+func (ConnectorCursor) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the ConnectorCursor.
+func (ConnectorCursor) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("stream").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Synced stream, e.g. zendesk.ticket_comments"),
+		field.String("cursor").
+			Comment("Position in the stream, as understood by the connector"),
+	}
+}
@@ -10,40 +10,77 @@ import (
 	"github.com/google/uuid"
 )
 
+// JobError is a single failed processing attempt of a job
+type JobError struct {
+	Attempt int       `json:"attempt"`
+	Error   string    `json:"error"`
+	At      time.Time `json:"at"`
+}
+
 // EnrichmentJob holds the schema definition for the EnrichmentJob entity.
 type EnrichmentJob struct {
 	ent.Schema
 }
 
+// Mixin of the EnrichmentJob.
+func (EnrichmentJob) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		CreateTimeMixin{},
+	}
+}
+
 // Fields of the EnrichmentJob.
 func (EnrichmentJob) Fields() []ent.Field {
 	return []ent.Field{
-		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
-			Immutable(),
 		field.UUID("experience_id", uuid.UUID{}).
 			Immutable(),
 		field.String("job_type").
 			Default("enrichment").
-			Comment("Job type: enrichment (sentiment/topics) or embedding (vector generation)"),
+			Comment("Job type: enrichment (sentiment/topics), embedding (vector generation), or a type with a registered handler"),
 		field.String("status").
 			Default("pending").
-			Comment("Job status: pending, processing, completed, failed"),
+			Comment("Job status: pending, processing, completed, dead_letter (failed after max attempts), cancelled"),
 		field.Text("text").
 			Comment("Text content to be enriched or embedded"),
 		field.Text("error").
 			Optional().
 			Nillable().
-			Comment("Error message if job failed"),
+			Comment("Error message of the last failed attempt"),
+		field.JSON("error_history", []JobError{}).
+			Optional().
+			Comment("Errors of all failed attempts, oldest first"),
+		field.Int("priority").
+			Default(0).
+			Comment("Jobs with a higher priority are processed first (-10 backfill, 0 normal, 10 interactive)"),
 		field.Int("attempts").
 			Default(0).
 			Comment("Number of processing attempts"),
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable(),
+		field.Int("requeues").
+			Default(0).
+			Comment("Times the job was put back in the queue without counting as an attempt, e.g. after a rate limit"),
 		field.Time("processed_at").
 			Optional().
 			Nillable(),
+		field.Time("lease_expires_at").
+			Optional().
+			Nillable().
+			Comment("Deadline for a processing job; after it passes the job is returned to the queue"),
+		field.Int("prompt_tokens").
+			Optional().
+			Nillable().
+			Comment("Prompt (input) tokens used by the AI request"),
+		field.Int("completion_tokens").
+			Optional().
+			Nillable().
+			Comment("Completion (output) tokens used by the AI request"),
+		field.Float("cost_usd").
+			Optional().
+			Nillable().
+			Comment("Estimated cost of the AI request in USD"),
+		field.String("trace_context").
+			Optional().
+			Comment("W3C traceparent of the request that enqueued the job, so its processing joins the same trace"),
 	}
 }
 
@@ -63,7 +100,11 @@ func (EnrichmentJob) Indexes() []ent.Index {
 	return []ent.Index{
 		// Index for efficient queue polling: find pending jobs by type, ordered by creation time
 		index.Fields("job_type", "status", "created_at"),
+		// Index for queue polling in priority order
+		index.Fields("status", "priority", "created_at"),
 		// Index for looking up jobs by experience
 		index.Fields("experience_id"),
+		// Index for finding processing jobs with an expired lease
+		index.Fields("status", "lease_expires_at"),
 	}
 }
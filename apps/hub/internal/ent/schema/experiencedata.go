@@ -7,22 +7,25 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 )
 
-// Valid field types for experience data
-var validFieldTypes = map[string]bool{
-	"text":        true,
-	"categorical": true,
-	"nps":         true,
-	"csat":        true,
-	"rating":      true,
-	"number":      true,
-	"boolean":     true,
-	"date":        true,
+// EmbeddingDimensions is the size of the embedding vector column.
+// Embedding providers are asked to produce vectors of exactly this size.
+const EmbeddingDimensions = 1536
+
+// Translation is a machine translation of the response text and question label of an
+// experience into another language. The original values are never changed.
+type Translation struct {
+	ValueText    string    `json:"value_text,omitempty"`
+	FieldLabel   string    `json:"field_label,omitempty"`
+	Provider     string    `json:"provider"`
+	Model        string    `json:"model"`
+	TranslatedAt time.Time `json:"translated_at"`
 }
 
 // ExperienceData holds the schema definition for the ExperienceData entity.
@@ -32,32 +35,21 @@ type ExperienceData struct {
 	ent.Schema
 }
 
+// Mixin of the ExperienceData.
+func (ExperienceData) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
 // Fields of the ExperienceData.
 func (ExperienceData) Fields() []ent.Field {
 	return []ent.Field{
-		// Core identification
-		field.UUID("id", uuid.UUID{}).
-			Default(func() uuid.UUID {
-				id, _ := uuid.NewV7()
-				return id
-			}).
-			Immutable().
-			Comment("UUIDv7 primary key (time-ordered)"),
-
 		field.Time("collected_at").
 			Default(time.Now).
 			Comment("When the feedback was collected"),
 
-		field.Time("created_at").
-			Default(time.Now).
-			Immutable().
-			Comment("When this record was created in the database"),
-
-		field.Time("updated_at").
-			Default(time.Now).
-			UpdateDefault(time.Now).
-			Comment("When this record was last updated"),
-
 		// Source tracking
 		field.String("source_type").
 			NotEmpty().
@@ -80,14 +72,14 @@ func (ExperienceData) Fields() []ent.Field {
 			Optional().
 			Comment("The actual question text (e.g., 'How satisfied are you?')"),
 
+		field.UUID("question_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Question of the question bank this is a response to, matched by source and field ID"),
+
 		field.String("field_type").
 			NotEmpty().
-			Validate(func(s string) error {
-				if !validFieldTypes[s] {
-					return fmt.Errorf("invalid field_type: %s (must be one of: text, categorical, nps, csat, rating, number, boolean, date)", s)
-				}
-				return nil
-			}).
+			Validate(validateFieldType).
 			Comment("Type of field: text (enrichable), categorical, nps, csat, rating, number, boolean, date"),
 
 		// Response values (typed for analytics)
@@ -115,16 +107,51 @@ func (ExperienceData) Fields() []ent.Field {
 			Optional().
 			Comment("For complex responses like multiple choice arrays"),
 
+		field.String("nps_category").
+			Optional().
+			Nillable().
+			Comment("NPS category of nps scores (promoter 9-10, passive 7-8, detractor 0-6), derived from value_number on write"),
+
 		// Context & enrichment
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("User agent, device, location, referrer, tags, custom fields, etc."),
 
+		// Typed copies of common metadata keys, populated at ingest (see SERVICE_METADATA_COLUMNS)
+		field.String("country").
+			Optional().
+			Nillable().
+			Comment("Country of the respondent, from metadata"),
+
+		field.String("region").
+			Optional().
+			Nillable().
+			Comment("Region or state of the respondent, from metadata"),
+
+		field.String("device").
+			Optional().
+			Nillable().
+			Comment("Device type (e.g., mobile, desktop), from metadata"),
+
+		field.String("platform").
+			Optional().
+			Nillable().
+			Comment("Platform or operating system (e.g., ios, web), from metadata"),
+
+		field.String("app_version").
+			Optional().
+			Nillable().
+			Comment("Version of the app the feedback was given in, from metadata"),
+
 		field.String("language").
 			Optional().
 			MaxLen(10).
 			Comment("ISO language code (e.g., 'en', 'de')"),
 
+		field.JSON("translations", map[string]Translation{}).
+			Optional().
+			Comment("Translations of value_text and field_label by ISO language code; cleared when value_text changes"),
+
 		// AI Enrichment fields
 		field.String("sentiment").
 			Optional().
@@ -145,18 +172,82 @@ func (ExperienceData) Fields() []ent.Field {
 			Optional().
 			Comment("AI-extracted topics/themes from text"),
 
+		field.Bool("is_spam").
+			Optional().
+			Nillable().
+			Comment("AI-detected spam flag (gibberish, keyboard mash, bot-like responses)"),
+
+		field.Float("spam_confidence").
+			Optional().
+			Nillable().
+			Comment("Confidence of the spam verdict from 0 to 1"),
+
+		field.Float("urgency_score").
+			Optional().
+			Nillable().
+			Comment("AI-estimated triage urgency from 0 (routine) to 1 (needs immediate attention)"),
+
+		field.JSON("urgency_reasons", []string{}).
+			Optional().
+			Comment("Reasons behind the urgency score (churn_risk, bug_report, legal_threat, etc.)"),
+
+		field.String("enrichment_provider").
+			Optional().
+			Nillable().
+			Comment("AI provider that produced the enrichment (e.g., openai, gemini)"),
+
+		field.String("enrichment_model").
+			Optional().
+			Nillable().
+			Comment("Model that produced the enrichment (e.g., gpt-4o-mini)"),
+
+		field.Int("enrichment_version").
+			Optional().
+			Nillable().
+			Comment("Enrichment prompt/output schema version used (see enrichment.PromptVersion)"),
+
+		field.JSON("enrichment_attributes", map[string]any{}).
+			Optional().
+			Comment("Additional attributes returned by a custom enrichment provider (see SERVICE_CUSTOM_ENRICHER_ATTRIBUTES)"),
+
+		field.Bool("skip_ai_processing").
+			Default(false).
+			Comment("Excluded from AI enrichment and embeddings (no data is sent to AI providers)"),
+
+		field.String("ai_input_hash").
+			Optional().
+			Nillable().
+			Comment("SHA-256 of the text sent to AI providers, used to reuse results for identical text"),
+
 		field.String("user_identifier").
 			Optional().
 			Comment("Anonymous ID or email hash for grouping responses"),
 
+		field.String("content_hash").
+			Optional().
+			Nillable().
+			Comment("SHA-256 of the normalized source, field, user, and value, for exact-duplicate detection (see models.ContentHash)"),
+
+		field.UUID("duplicate_of", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Earlier experience with the same content hash, set when SERVICE_DUPLICATE_POLICY is flag"),
+
+		field.String("idempotency_key").
+			Optional().
+			Nillable().
+			Immutable().
+			Unique().
+			Comment("Idempotency-Key header of the request that created the experience, so a retried request returns it instead of creating another"),
+
 		// Embedding fields for semantic search
 		field.Other("embedding", pgvector.Vector{}).
 			Optional().
 			Nillable().
 			SchemaType(map[string]string{
-				dialect.Postgres: "vector(1536)",
+				dialect.Postgres: fmt.Sprintf("vector(%d)", EmbeddingDimensions),
 			}).
-			Comment("OpenAI embedding vector for semantic search (1536 dimensions for text-embedding-3-small)"),
+			Comment("Embedding vector for semantic search (1536 dimensions, e.g. text-embedding-3-small or gemini-embedding-001)"),
 
 		field.String("embedding_model").
 			Optional().
@@ -167,17 +258,28 @@ func (ExperienceData) Fields() []ent.Field {
 
 // Edges of the ExperienceData.
 func (ExperienceData) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("question", Question.Type).
+			Unique().
+			Field("question_id").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
 }
 
 // Indexes of the ExperienceData.
 func (ExperienceData) Indexes() []ent.Index {
 	return []ent.Index{
 		// Composite index for querying by source
-		index.Fields("source_type", "source_id", "collected_at").
-			Annotations(entsql.IndexTypes(map[string]string{
-				"metadata": "GIN",
-			})),
+		index.Fields("source_type", "source_id", "collected_at"),
+
+		// GIN index for metadata containment queries (metadata @> '{"plan": "pro"}').
+		// jsonb_path_ops is smaller and faster than the default operator class, but
+		// doesn't support the key-exists operators (?, ?|, ?&).
+		index.Fields("metadata").
+			Annotations(
+				entsql.IndexType("GIN"),
+				entsql.OpClass("jsonb_path_ops"),
+			),
 
 		// Composite index for querying by field type and time
 		index.Fields("field_type", "collected_at"),
@@ -185,15 +287,41 @@ func (ExperienceData) Indexes() []ent.Index {
 		// Index for numeric aggregations (AVG, SUM, etc.)
 		index.Fields("value_number"),
 
+		// Index for NPS breakdowns (GROUP BY nps_category)
+		index.Fields("nps_category", "collected_at"),
+
+		// Index for breakdowns by question
+		index.Fields("question_id", "collected_at"),
+
+		// Indexes for filtering and grouping by metadata columns
+		index.Fields("country"),
+		index.Fields("region"),
+		index.Fields("device"),
+		index.Fields("platform"),
+		index.Fields("app_version"),
+
 		// Index for user grouping
 		index.Fields("user_identifier"),
 
+		// Index for exact-duplicate lookups within a time window
+		index.Fields("content_hash", "collected_at"),
+
 		// Index for time-based queries
 		index.Fields("collected_at"),
 
+		// Index for the experiences arriving per window (anomaly detection)
+		index.Fields("created_at"),
+
+		// Index for polling the experiences changed since a cursor (trigger endpoints)
+		index.Fields("updated_at"),
+
 		// Indexes for AI enrichment fields
 		index.Fields("sentiment"),
 		index.Fields("emotion"),
+		index.Fields("is_spam"),
+		index.Fields("urgency_score"),
+		index.Fields("enrichment_version"),
+		index.Fields("ai_input_hash"),
 
 		// HNSW index for fast vector similarity search (cosine distance)
 		index.Fields("embedding").
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ExperienceDeletion holds the schema definition for the ExperienceDeletion entity.
+// Each row records that an experience was deleted, so tools that replicate experiences
+// through /v1/sync/changes can delete their copy. created_at is when it was deleted.
+type ExperienceDeletion struct {
+	ent.Schema
+}
+
+// Mixin of the ExperienceDeletion.
+func (ExperienceDeletion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the ExperienceDeletion.
+func (ExperienceDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("experience_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the deleted experience"),
+	}
+}
+
+// Indexes of the ExperienceDeletion.
+func (ExperienceDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for reading deletions in order after a cursor
+		index.Fields("created_at", "id"),
+	}
+}
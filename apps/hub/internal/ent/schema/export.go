@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Export holds the schema definition for the Export entity.
+// Each row is a scheduled export, managed through /v1/exports, that writes the experiences
+// created or changed since its cursor to an S3-compatible or GCS bucket.
+type Export struct {
+	ent.Schema
+}
+
+// Mixin of the Export.
+func (Export) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the Export.
+func (Export) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("Name shown in tools, e.g. Warehouse raw feedback"),
+		field.String("format").
+			Default("jsonl").
+			Comment("File format: jsonl (gzip-compressed JSON Lines) or parquet"),
+		field.String("provider").
+			Default("s3").
+			Comment("Storage provider: s3 (AWS or S3-compatible) or gcs"),
+		field.String("bucket").
+			NotEmpty(),
+		field.String("region").
+			Optional().
+			Comment("Region of the bucket; us-east-1 if empty"),
+		field.String("endpoint").
+			Optional().
+			Comment("URL of an S3-compatible service, e.g. MinIO or R2; empty for the provider's"),
+		field.String("prefix").
+			Optional().
+			Comment("Object key prefix, with {date}, {year}, {month}, {day}, and {hour} placeholders"),
+		field.String("access_key_id").
+			Optional().
+			Comment("Access key (HMAC key for gcs); the AWS_* environment variables if empty"),
+		field.String("secret_access_key").
+			Optional().
+			Sensitive(),
+		field.Int("interval_minutes").
+			Default(60).
+			Comment("Minutes between runs"),
+		field.Bool("enabled").
+			Default(true),
+		field.Time("cursor_at").
+			Optional().
+			Nillable().
+			Comment("updated_at of the last experience exported"),
+		field.UUID("cursor_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("ID of the last experience exported, to order experiences of the same time"),
+		field.Time("next_run_at").
+			Default(time.Now).
+			Comment("When the export runs next"),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ExportRun holds the schema definition for the ExportRun entity.
+// Each row is one run of a scheduled export, kept as its history. Runs are deleted with
+// their export.
+type ExportRun struct {
+	ent.Schema
+}
+
+// Mixin of the ExportRun.
+func (ExportRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the ExportRun.
+func (ExportRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("export_id", uuid.UUID{}).
+			Immutable(),
+		field.String("status").
+			Default("running").
+			Comment("Run status: running, succeeded, failed"),
+		field.Int("experiences").
+			Default(0).
+			Comment("Number of experiences written"),
+		field.Int64("bytes").
+			Default(0).
+			Comment("Size of the objects written"),
+		field.JSON("objects", []string{}).
+			Optional().
+			Comment("Keys of the objects written"),
+		field.Text("error").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ExportRun.
+func (ExportRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("export", Export.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("export_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the ExportRun.
+func (ExportRun) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for listing an export's runs by time
+		index.Fields("export_id", "created_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/formbricks/hub/apps/hub/internal/connector/mapping"
+)
+
+// IngestMapping holds the schema definition for the IngestMapping entity.
+// Each row maps the webhook deliveries of a third-party tool, posted to /v1/ingest/{id}, to
+// experiences with a template of JSONPath expressions; managed through /v1/ingest-mappings.
+type IngestMapping struct {
+	ent.Schema
+}
+
+// Mixin of the IngestMapping.
+func (IngestMapping) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the IngestMapping.
+func (IngestMapping) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("Name shown in tools, e.g. Hotjar surveys"),
+		field.String("source_type").
+			NotEmpty().
+			Comment("Source type of the experiences created from deliveries"),
+		field.JSON("template", mapping.Template{}).
+			Comment("JSONPath expressions that map a delivery to responses"),
+		field.String("secret").
+			Sensitive().
+			Comment("Token that authenticates deliveries, or the key of their signature"),
+		field.String("signature_header").
+			Optional().
+			Comment("Header with the HMAC-SHA256 signature of the body; empty to authenticate with the secret as token"),
+		field.Bool("enabled").
+			Default(true),
+	}
+}
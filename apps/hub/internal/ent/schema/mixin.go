@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// IDMixin adds a time-ordered UUIDv7 primary key. IDs of rows created together sort
+// together, which keeps inserts into the primary key index local.
+type IDMixin struct {
+	mixin.Schema
+}
+
+// Fields of the IDMixin.
+func (IDMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(newID).
+			Immutable().
+			Comment("UUIDv7 primary key (time-ordered)"),
+	}
+}
+
+// newID returns a UUIDv7, falling back to a random UUID if the clock can't be read
+func newID() uuid.UUID {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.New()
+	}
+	return id
+}
+
+// CreateTimeMixin adds an immutable created_at timestamp
+type CreateTimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the CreateTimeMixin.
+func (CreateTimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When the row was created"),
+	}
+}
+
+// UpdateTimeMixin adds an updated_at timestamp that every update through Ent refreshes
+type UpdateTimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the UpdateTimeMixin.
+func (UpdateTimeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("When the row was last updated"),
+	}
+}
+
+// TimeMixin adds created_at and updated_at timestamps
+type TimeMixin struct {
+	mixin.Schema
+}
+
+// Fields of the TimeMixin.
+func (TimeMixin) Fields() []ent.Field {
+	return append(CreateTimeMixin{}.Fields(), UpdateTimeMixin{}.Fields()...)
+}
+
+// fieldTypes are the field types of experiences and questions
+var fieldTypes = []string{"text", "categorical", "nps", "csat", "rating", "number", "boolean", "date"}
+
+// validateFieldType rejects field types other than the standardized ones
+func validateFieldType(s string) error {
+	if !slices.Contains(fieldTypes, s) {
+		return fmt.Errorf("invalid field_type: %s (must be one of: %s)", s, strings.Join(fieldTypes, ", "))
+	}
+	return nil
+}
+
+// validateOptionalFieldType is validateFieldType for fields where empty means unknown
+func validateOptionalFieldType(s string) error {
+	if s == "" {
+		return nil
+	}
+	return validateFieldType(s)
+}
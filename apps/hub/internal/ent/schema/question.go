@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Question holds the schema definition for the Question entity.
+// Each row is a question of a source, identified by (source_type, source_id, field_id),
+// with canonical labels that don't change when the question is relabelled upstream.
+// Experiences reference the question they answer, so analytics can group by question
+// instead of by the label that was sent with each response.
+type Question struct {
+	ent.Schema
+}
+
+// Mixin of the Question.
+func (Question) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the Question.
+func (Question) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("source_type").
+			NotEmpty().
+			Immutable(),
+		field.String("source_id").
+			Default("").
+			Immutable().
+			Comment("Source ID of the experiences, empty if they have none"),
+		field.String("field_id").
+			NotEmpty().
+			Immutable(),
+		field.String("field_type").
+			Optional().
+			Validate(validateOptionalFieldType).
+			Comment("Field type of the first experience that answered the question"),
+		field.String("label").
+			Optional().
+			Comment("Canonical label, used when there is none in the requested language"),
+		field.JSON("labels", map[string]string{}).
+			Optional().
+			Comment("Canonical labels by ISO language code"),
+		field.JSON("metadata", map[string]any{}).
+			Optional().
+			Comment("Display metadata, such as the scale, choices, or help text"),
+	}
+}
+
+// Indexes of the Question.
+func (Question) Indexes() []ent.Index {
+	return []ent.Index{
+		// A question is identified by its source and field
+		index.Fields("source_type", "source_id", "field_id").
+			Unique(),
+	}
+}
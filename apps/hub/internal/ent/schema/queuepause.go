@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// QueuePause holds the schema definition for the QueuePause entity.
+// Each row pauses dequeuing of one job type (or of all job types) across all Hub
+// instances until it is deleted, e.g. during an AI provider outage.
+type QueuePause struct {
+	ent.Schema
+}
+
+// Mixin of the QueuePause.
+func (QueuePause) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the QueuePause.
+func (QueuePause) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("job_type").
+			Unique().
+			Immutable().
+			Comment("Paused job type, or empty if all job types are paused"),
+		field.String("reason").
+			Optional().
+			Comment("Why the queue was paused"),
+	}
+}
@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+)
+
+// Segment holds the schema definition for the Segment entity.
+// Each row is a named filter of experiences, managed through /v1/segments, that analytics
+// and listings apply with segment_id.
+type Segment struct {
+	ent.Schema
+}
+
+// Mixin of the Segment.
+func (Segment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the Segment.
+func (Segment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("Name shown in tools, e.g. Enterprise detractors"),
+		field.String("description").
+			Optional(),
+		field.JSON("filter", experiencefilter.Filter{}).
+			Comment("Filter selecting the experiences of the segment"),
+	}
+}
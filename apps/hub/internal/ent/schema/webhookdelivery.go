@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Each row is one event sent to a webhook endpoint, kept so missed events can be
+// redelivered. Deliveries are deleted with their endpoint and after the retention period.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookDelivery.
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		CreateTimeMixin{},
+	}
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("endpoint_id", uuid.UUID{}).
+			Immutable(),
+		field.String("event_type").
+			Immutable(),
+		field.Text("payload").
+			Immutable().
+			Comment("Request body as sent"),
+		field.String("status").
+			Default("pending").
+			Comment("Delivery status: pending, succeeded, failed"),
+		field.Int("attempts").
+			Default(0),
+		field.Int("response_status").
+			Optional().
+			Nillable().
+			Comment("HTTP status of the last attempt, if the endpoint responded"),
+		field.Int("duration_ms").
+			Optional().
+			Nillable().
+			Comment("Response time of the last attempt in milliseconds, if the endpoint responded"),
+		field.Text("error").
+			Optional().
+			Nillable().
+			Comment("Error of the last failed attempt"),
+		field.UUID("redelivery_of", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Delivery this one was redelivered from"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the delivery succeeded or ran out of attempts"),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("endpoint", WebhookEndpoint.Type).
+			Unique().
+			Required().
+			Immutable().
+			Field("endpoint_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for listing and replaying an endpoint's deliveries by time
+		index.Fields("endpoint_id", "created_at"),
+		// Index for deleting deliveries past the retention period
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
+)
+
+// WebhookEndpoint holds the schema definition for the WebhookEndpoint entity.
+// Each row is a subscriber that receives webhook events, managed through /v1/webhooks.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Mixin of the WebhookEndpoint.
+func (WebhookEndpoint) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		IDMixin{},
+		TimeMixin{},
+	}
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("url").
+			NotEmpty().
+			Comment("URL that events are POSTed to"),
+		field.String("secret").
+			Sensitive().
+			Comment("Key used to sign payloads (X-Hub-Signature-256 header)"),
+		field.JSON("event_types", []string{}).
+			Optional().
+			Comment("Event types sent to the endpoint; empty for all events"),
+		field.JSON("conditions", []rules.Condition{}).
+			Optional().
+			Comment("Conditions on the event data that must all match for an event to be sent"),
+		field.Bool("enabled").
+			Default(true),
+		field.String("format").
+			Default("cloudevents").
+			Comment("Payload format: cloudevents, or slack for Slack messages"),
+		field.String("slack_token").
+			Optional().
+			Sensitive().
+			Comment("Slack bot token that posts messages with chat.postMessage; empty for incoming webhooks"),
+		field.String("slack_channel").
+			Optional().
+			Comment("Slack channel that the bot token posts messages to"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Worker holds the schema definition for the Worker entity.
+// Each row is the last heartbeat of a running AI job worker. Workers of all Hub instances
+// report here, so operators can see whether job processing is stalled or just slow.
+type Worker struct {
+	ent.Schema
+}
+
+// Fields of the Worker.
+func (Worker) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable(),
+		field.String("instance").
+			Immutable().
+			Comment("Hub instance running the worker (hostname and process ID)"),
+		field.Int("worker_id").
+			Immutable().
+			Comment("Worker ID, unique within the instance"),
+		field.String("job_type").
+			Immutable().
+			Comment("Job type processed by the worker's pool, or all"),
+		field.UUID("current_job_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Job being processed, if any"),
+		field.Time("current_job_started_at").
+			Optional().
+			Nillable().
+			Comment("When the worker started the current job"),
+		field.Int64("processed").
+			Default(0).
+			Comment("Jobs completed since the worker started"),
+		field.Int64("failed").
+			Default(0).
+			Comment("Failed job attempts since the worker started"),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_heartbeat_at").
+			Default(time.Now),
+	}
+}
+
+// Indexes of the Worker.
+func (Worker) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_heartbeat_at"),
+	}
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/segment"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+	"github.com/google/uuid"
+)
+
+// Segment is the model entity for the Segment schema.
+type Segment struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name shown in tools, e.g. Enterprise detractors
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Filter selecting the experiences of the segment
+	Filter       experiencefilter.Filter `json:"filter,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Segment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case segment.FieldFilter:
+			values[i] = new([]byte)
+		case segment.FieldName, segment.FieldDescription:
+			values[i] = new(sql.NullString)
+		case segment.FieldCreatedAt, segment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case segment.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Segment fields.
+func (_m *Segment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case segment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case segment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case segment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case segment.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case segment.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case segment.FieldFilter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filter", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Filter); err != nil {
+					return fmt.Errorf("unmarshal field filter: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Segment.
+// This includes values selected through modifiers, order, etc.
+func (_m *Segment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Segment.
+// Note that you need to call Segment.Unwrap() before calling this method if this Segment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Segment) Update() *SegmentUpdateOne {
+	return NewSegmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Segment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Segment) Unwrap() *Segment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Segment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Segment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Segment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("filter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Filter))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Segments is a parsable slice of Segment.
+type Segments []*Segment
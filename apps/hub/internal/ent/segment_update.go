@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/segment"
+	"github.com/formbricks/hub/apps/hub/internal/experiencefilter"
+)
+
+// SegmentUpdate is the builder for updating Segment entities.
+type SegmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SegmentMutation
+}
+
+// Where appends a list predicates to the SegmentUpdate builder.
+func (_u *SegmentUpdate) Where(ps ...predicate.Segment) *SegmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SegmentUpdate) SetUpdatedAt(v time.Time) *SegmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SegmentUpdate) SetName(v string) *SegmentUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SegmentUpdate) SetNillableName(v *string) *SegmentUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SegmentUpdate) SetDescription(v string) *SegmentUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SegmentUpdate) SetNillableDescription(v *string) *SegmentUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *SegmentUpdate) ClearDescription() *SegmentUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *SegmentUpdate) SetFilter(v experiencefilter.Filter) *SegmentUpdate {
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *SegmentUpdate) SetNillableFilter(v *experiencefilter.Filter) *SegmentUpdate {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SegmentMutation object of the builder.
+func (_u *SegmentUpdate) Mutation() *SegmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SegmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SegmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SegmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SegmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SegmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := segment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SegmentUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := segment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Segment.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SegmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(segment.Table, segment.Columns, sqlgraph.NewFieldSpec(segment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(segment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(segment.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(segment.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(segment.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(segment.FieldFilter, field.TypeJSON, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{segment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SegmentUpdateOne is the builder for updating a single Segment entity.
+type SegmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SegmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SegmentUpdateOne) SetUpdatedAt(v time.Time) *SegmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SegmentUpdateOne) SetName(v string) *SegmentUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SegmentUpdateOne) SetNillableName(v *string) *SegmentUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *SegmentUpdateOne) SetDescription(v string) *SegmentUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *SegmentUpdateOne) SetNillableDescription(v *string) *SegmentUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *SegmentUpdateOne) ClearDescription() *SegmentUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFilter sets the "filter" field.
+func (_u *SegmentUpdateOne) SetFilter(v experiencefilter.Filter) *SegmentUpdateOne {
+	_u.mutation.SetFilter(v)
+	return _u
+}
+
+// SetNillableFilter sets the "filter" field if the given value is not nil.
+func (_u *SegmentUpdateOne) SetNillableFilter(v *experiencefilter.Filter) *SegmentUpdateOne {
+	if v != nil {
+		_u.SetFilter(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SegmentMutation object of the builder.
+func (_u *SegmentUpdateOne) Mutation() *SegmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SegmentUpdate builder.
+func (_u *SegmentUpdateOne) Where(ps ...predicate.Segment) *SegmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SegmentUpdateOne) Select(field string, fields ...string) *SegmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Segment entity.
+func (_u *SegmentUpdateOne) Save(ctx context.Context) (*Segment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SegmentUpdateOne) SaveX(ctx context.Context) *Segment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SegmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SegmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SegmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := segment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SegmentUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := segment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Segment.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SegmentUpdateOne) sqlSave(ctx context.Context) (_node *Segment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(segment.Table, segment.Columns, sqlgraph.NewFieldSpec(segment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Segment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, segment.FieldID)
+		for _, f := range fields {
+			if !segment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != segment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(segment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(segment.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(segment.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(segment.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Filter(); ok {
+		_spec.SetField(segment.FieldFilter, field.TypeJSON, value)
+	}
+	_node = &Segment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{segment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
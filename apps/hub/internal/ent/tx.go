@@ -12,10 +12,36 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AIUsage is the client for interacting with the AIUsage builders.
+	AIUsage *AIUsageClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// ConnectorCursor is the client for interacting with the ConnectorCursor builders.
+	ConnectorCursor *ConnectorCursorClient
 	// EnrichmentJob is the client for interacting with the EnrichmentJob builders.
 	EnrichmentJob *EnrichmentJobClient
 	// ExperienceData is the client for interacting with the ExperienceData builders.
 	ExperienceData *ExperienceDataClient
+	// ExperienceDeletion is the client for interacting with the ExperienceDeletion builders.
+	ExperienceDeletion *ExperienceDeletionClient
+	// Export is the client for interacting with the Export builders.
+	Export *ExportClient
+	// ExportRun is the client for interacting with the ExportRun builders.
+	ExportRun *ExportRunClient
+	// IngestMapping is the client for interacting with the IngestMapping builders.
+	IngestMapping *IngestMappingClient
+	// Question is the client for interacting with the Question builders.
+	Question *QuestionClient
+	// QueuePause is the client for interacting with the QueuePause builders.
+	QueuePause *QueuePauseClient
+	// Segment is the client for interacting with the Segment builders.
+	Segment *SegmentClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
+	// Worker is the client for interacting with the Worker builders.
+	Worker *WorkerClient
 
 	// lazily loaded.
 	client     *Client
@@ -147,8 +173,21 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AIUsage = NewAIUsageClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.ConnectorCursor = NewConnectorCursorClient(tx.config)
 	tx.EnrichmentJob = NewEnrichmentJobClient(tx.config)
 	tx.ExperienceData = NewExperienceDataClient(tx.config)
+	tx.ExperienceDeletion = NewExperienceDeletionClient(tx.config)
+	tx.Export = NewExportClient(tx.config)
+	tx.ExportRun = NewExportRunClient(tx.config)
+	tx.IngestMapping = NewIngestMappingClient(tx.config)
+	tx.Question = NewQuestionClient(tx.config)
+	tx.QueuePause = NewQueuePauseClient(tx.config)
+	tx.Segment = NewSegmentClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookEndpoint = NewWebhookEndpointClient(tx.config)
+	tx.Worker = NewWorkerClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -158,7 +197,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: EnrichmentJob.QueryXXX(), the query will be executed
+// applies a query, for example: AIUsage.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// EndpointID holds the value of the "endpoint_id" field.
+	EndpointID uuid.UUID `json:"endpoint_id,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType string `json:"event_type,omitempty"`
+	// Request body as sent
+	Payload string `json:"payload,omitempty"`
+	// Delivery status: pending, succeeded, failed
+	Status string `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// HTTP status of the last attempt, if the endpoint responded
+	ResponseStatus *int `json:"response_status,omitempty"`
+	// Response time of the last attempt in milliseconds, if the endpoint responded
+	DurationMs *int `json:"duration_ms,omitempty"`
+	// Error of the last failed attempt
+	Error *string `json:"error,omitempty"`
+	// Delivery this one was redelivered from
+	RedeliveryOf *uuid.UUID `json:"redelivery_of,omitempty"`
+	// When the delivery succeeded or ran out of attempts
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliveryQuery when eager-loading is set.
+	Edges        WebhookDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookDeliveryEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliveryEdges struct {
+	// Endpoint holds the value of the endpoint edge.
+	Endpoint *WebhookEndpoint `json:"endpoint,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EndpointOrErr returns the Endpoint value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) EndpointOrErr() (*WebhookEndpoint, error) {
+	if e.Endpoint != nil {
+		return e.Endpoint, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: webhookendpoint.Label}
+	}
+	return nil, &NotLoadedError{edge: "endpoint"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldRedeliveryOf:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case webhookdelivery.FieldAttempts, webhookdelivery.FieldResponseStatus, webhookdelivery.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case webhookdelivery.FieldEventType, webhookdelivery.FieldPayload, webhookdelivery.FieldStatus, webhookdelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case webhookdelivery.FieldCreatedAt, webhookdelivery.FieldDeliveredAt:
+			values[i] = new(sql.NullTime)
+		case webhookdelivery.FieldID, webhookdelivery.FieldEndpointID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (_m *WebhookDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case webhookdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case webhookdelivery.FieldEndpointID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field endpoint_id", values[i])
+			} else if value != nil {
+				_m.EndpointID = *value
+			}
+		case webhookdelivery.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case webhookdelivery.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				_m.Payload = value.String
+			}
+		case webhookdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case webhookdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case webhookdelivery.FieldResponseStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status", values[i])
+			} else if value.Valid {
+				_m.ResponseStatus = new(int)
+				*_m.ResponseStatus = int(value.Int64)
+			}
+		case webhookdelivery.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = new(int)
+				*_m.DurationMs = int(value.Int64)
+			}
+		case webhookdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		case webhookdelivery.FieldRedeliveryOf:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field redelivery_of", values[i])
+			} else if value.Valid {
+				_m.RedeliveryOf = new(uuid.UUID)
+				*_m.RedeliveryOf = *value.S.(*uuid.UUID)
+			}
+		case webhookdelivery.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				_m.DeliveredAt = new(time.Time)
+				*_m.DeliveredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *WebhookDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEndpoint queries the "endpoint" edge of the WebhookDelivery entity.
+func (_m *WebhookDelivery) QueryEndpoint() *WebhookEndpointQuery {
+	return NewWebhookDeliveryClient(_m.config).QueryEndpoint(_m)
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that you need to call WebhookDelivery.Unwrap() before calling this method if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return NewWebhookDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WebhookDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WebhookDelivery) Unwrap() *WebhookDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("endpoint_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndpointID))
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(_m.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	if v := _m.ResponseStatus; v != nil {
+		builder.WriteString("response_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DurationMs; v != nil {
+		builder.WriteString("duration_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.RedeliveryOf; v != nil {
+		builder.WriteString("redelivery_of=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (_u *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WebhookDeliveryUpdate) SetStatus(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableStatus(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *WebhookDeliveryUpdate) SetAttempts(v int) *WebhookDeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableAttempts(v *int) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *WebhookDeliveryUpdate) AddAttempts(v int) *WebhookDeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (_u *WebhookDeliveryUpdate) SetResponseStatus(v int) *WebhookDeliveryUpdate {
+	_u.mutation.ResetResponseStatus()
+	_u.mutation.SetResponseStatus(v)
+	return _u
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableResponseStatus(v *int) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetResponseStatus(*v)
+	}
+	return _u
+}
+
+// AddResponseStatus adds value to the "response_status" field.
+func (_u *WebhookDeliveryUpdate) AddResponseStatus(v int) *WebhookDeliveryUpdate {
+	_u.mutation.AddResponseStatus(v)
+	return _u
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (_u *WebhookDeliveryUpdate) ClearResponseStatus() *WebhookDeliveryUpdate {
+	_u.mutation.ClearResponseStatus()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *WebhookDeliveryUpdate) SetDurationMs(v int) *WebhookDeliveryUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableDurationMs(v *int) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *WebhookDeliveryUpdate) AddDurationMs(v int) *WebhookDeliveryUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *WebhookDeliveryUpdate) ClearDurationMs() *WebhookDeliveryUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *WebhookDeliveryUpdate) SetError(v string) *WebhookDeliveryUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableError(v *string) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *WebhookDeliveryUpdate) ClearError() *WebhookDeliveryUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *WebhookDeliveryUpdate) SetDeliveredAt(v time.Time) *WebhookDeliveryUpdate {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdate) SetNillableDeliveredAt(v *time.Time) *WebhookDeliveryUpdate {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *WebhookDeliveryUpdate) ClearDeliveredAt() *WebhookDeliveryUpdate {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (_u *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookDeliveryUpdate) check() error {
+	if _u.mutation.EndpointCleared() && len(_u.mutation.EndpointIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.endpoint"`)
+	}
+	return nil
+}
+
+func (_u *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if _u.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(webhookdelivery.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(webhookdelivery.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(webhookdelivery.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if _u.mutation.RedeliveryOfCleared() {
+		_spec.ClearField(webhookdelivery.FieldRedeliveryOf, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *WebhookDeliveryUpdateOne) SetStatus(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableStatus(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *WebhookDeliveryUpdateOne) SetAttempts(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableAttempts(v *int) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *WebhookDeliveryUpdateOne) AddAttempts(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (_u *WebhookDeliveryUpdateOne) SetResponseStatus(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.ResetResponseStatus()
+	_u.mutation.SetResponseStatus(v)
+	return _u
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableResponseStatus(v *int) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetResponseStatus(*v)
+	}
+	return _u
+}
+
+// AddResponseStatus adds value to the "response_status" field.
+func (_u *WebhookDeliveryUpdateOne) AddResponseStatus(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.AddResponseStatus(v)
+	return _u
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (_u *WebhookDeliveryUpdateOne) ClearResponseStatus() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearResponseStatus()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *WebhookDeliveryUpdateOne) SetDurationMs(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableDurationMs(v *int) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *WebhookDeliveryUpdateOne) AddDurationMs(v int) *WebhookDeliveryUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *WebhookDeliveryUpdateOne) ClearDurationMs() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *WebhookDeliveryUpdateOne) SetError(v string) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableError(v *string) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *WebhookDeliveryUpdateOne) ClearError() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (_u *WebhookDeliveryUpdateOne) SetDeliveredAt(v time.Time) *WebhookDeliveryUpdateOne {
+	_u.mutation.SetDeliveredAt(v)
+	return _u
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (_u *WebhookDeliveryUpdateOne) SetNillableDeliveredAt(v *time.Time) *WebhookDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDeliveredAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (_u *WebhookDeliveryUpdateOne) ClearDeliveredAt() *WebhookDeliveryUpdateOne {
+	_u.mutation.ClearDeliveredAt()
+	return _u
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (_u *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (_u *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (_u *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookDeliveryUpdateOne) check() error {
+	if _u.mutation.EndpointCleared() && len(_u.mutation.EndpointIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookDelivery.endpoint"`)
+	}
+	return nil
+}
+
+func (_u *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if _u.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(webhookdelivery.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(webhookdelivery.FieldDurationMs, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(webhookdelivery.FieldDurationMs, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if _u.mutation.RedeliveryOfCleared() {
+		_spec.ClearField(webhookdelivery.FieldRedeliveryOf, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.DeliveredAt(); ok {
+		_spec.SetField(webhookdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveredAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	_node = &WebhookDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,215 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
+	"github.com/google/uuid"
+)
+
+// WebhookEndpoint is the model entity for the WebhookEndpoint schema.
+type WebhookEndpoint struct {
+	config `json:"-"`
+	// ID of the ent.
+	// UUIDv7 primary key (time-ordered)
+	ID uuid.UUID `json:"id,omitempty"`
+	// When the row was created
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When the row was last updated
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// URL that events are POSTed to
+	URL string `json:"url,omitempty"`
+	// Key used to sign payloads (X-Hub-Signature-256 header)
+	Secret string `json:"-"`
+	// Event types sent to the endpoint; empty for all events
+	EventTypes []string `json:"event_types,omitempty"`
+	// Conditions on the event data that must all match for an event to be sent
+	Conditions []rules.Condition `json:"conditions,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Payload format: cloudevents, or slack for Slack messages
+	Format string `json:"format,omitempty"`
+	// Slack bot token that posts messages with chat.postMessage; empty for incoming webhooks
+	SlackToken string `json:"-"`
+	// Slack channel that the bot token posts messages to
+	SlackChannel string `json:"slack_channel,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookEndpoint) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookendpoint.FieldEventTypes, webhookendpoint.FieldConditions:
+			values[i] = new([]byte)
+		case webhookendpoint.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case webhookendpoint.FieldURL, webhookendpoint.FieldSecret, webhookendpoint.FieldFormat, webhookendpoint.FieldSlackToken, webhookendpoint.FieldSlackChannel:
+			values[i] = new(sql.NullString)
+		case webhookendpoint.FieldCreatedAt, webhookendpoint.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case webhookendpoint.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookEndpoint fields.
+func (_m *WebhookEndpoint) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookendpoint.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case webhookendpoint.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case webhookendpoint.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case webhookendpoint.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case webhookendpoint.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				_m.Secret = value.String
+			}
+		case webhookendpoint.FieldEventTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EventTypes); err != nil {
+					return fmt.Errorf("unmarshal field event_types: %w", err)
+				}
+			}
+		case webhookendpoint.FieldConditions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field conditions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Conditions); err != nil {
+					return fmt.Errorf("unmarshal field conditions: %w", err)
+				}
+			}
+		case webhookendpoint.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case webhookendpoint.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = value.String
+			}
+		case webhookendpoint.FieldSlackToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slack_token", values[i])
+			} else if value.Valid {
+				_m.SlackToken = value.String
+			}
+		case webhookendpoint.FieldSlackChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slack_channel", values[i])
+			} else if value.Valid {
+				_m.SlackChannel = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookEndpoint.
+// This includes values selected through modifiers, order, etc.
+func (_m *WebhookEndpoint) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebhookEndpoint.
+// Note that you need to call WebhookEndpoint.Unwrap() before calling this method if this WebhookEndpoint
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WebhookEndpoint) Update() *WebhookEndpointUpdateOne {
+	return NewWebhookEndpointClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WebhookEndpoint entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WebhookEndpoint) Unwrap() *WebhookEndpoint {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookEndpoint is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WebhookEndpoint) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookEndpoint(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("event_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventTypes))
+	builder.WriteString(", ")
+	builder.WriteString("conditions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Conditions))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(_m.Format)
+	builder.WriteString(", ")
+	builder.WriteString("slack_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("slack_channel=")
+	builder.WriteString(_m.SlackChannel)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookEndpoints is a parsable slice of WebhookEndpoint.
+type WebhookEndpoints []*WebhookEndpoint
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
+	"github.com/google/uuid"
+)
+
+// WebhookEndpointCreate is the builder for creating a WebhookEndpoint entity.
+type WebhookEndpointCreate struct {
+	config
+	mutation *WebhookEndpointMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WebhookEndpointCreate) SetCreatedAt(v time.Time) *WebhookEndpointCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableCreatedAt(v *time.Time) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WebhookEndpointCreate) SetUpdatedAt(v time.Time) *WebhookEndpointCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableUpdatedAt(v *time.Time) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *WebhookEndpointCreate) SetURL(v string) *WebhookEndpointCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetSecret sets the "secret" field.
+func (_c *WebhookEndpointCreate) SetSecret(v string) *WebhookEndpointCreate {
+	_c.mutation.SetSecret(v)
+	return _c
+}
+
+// SetEventTypes sets the "event_types" field.
+func (_c *WebhookEndpointCreate) SetEventTypes(v []string) *WebhookEndpointCreate {
+	_c.mutation.SetEventTypes(v)
+	return _c
+}
+
+// SetConditions sets the "conditions" field.
+func (_c *WebhookEndpointCreate) SetConditions(v []rules.Condition) *WebhookEndpointCreate {
+	_c.mutation.SetConditions(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *WebhookEndpointCreate) SetEnabled(v bool) *WebhookEndpointCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableEnabled(v *bool) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *WebhookEndpointCreate) SetFormat(v string) *WebhookEndpointCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableFormat(v *string) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetSlackToken sets the "slack_token" field.
+func (_c *WebhookEndpointCreate) SetSlackToken(v string) *WebhookEndpointCreate {
+	_c.mutation.SetSlackToken(v)
+	return _c
+}
+
+// SetNillableSlackToken sets the "slack_token" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableSlackToken(v *string) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetSlackToken(*v)
+	}
+	return _c
+}
+
+// SetSlackChannel sets the "slack_channel" field.
+func (_c *WebhookEndpointCreate) SetSlackChannel(v string) *WebhookEndpointCreate {
+	_c.mutation.SetSlackChannel(v)
+	return _c
+}
+
+// SetNillableSlackChannel sets the "slack_channel" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableSlackChannel(v *string) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetSlackChannel(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WebhookEndpointCreate) SetID(v uuid.UUID) *WebhookEndpointCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WebhookEndpointCreate) SetNillableID(v *uuid.UUID) *WebhookEndpointCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (_c *WebhookEndpointCreate) Mutation() *WebhookEndpointMutation {
+	return _c.mutation
+}
+
+// Save creates the WebhookEndpoint in the database.
+func (_c *WebhookEndpointCreate) Save(ctx context.Context) (*WebhookEndpoint, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebhookEndpointCreate) SaveX(ctx context.Context) *WebhookEndpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookEndpointCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookEndpointCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebhookEndpointCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := webhookendpoint.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := webhookendpoint.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		v := webhookendpoint.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := webhookendpoint.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebhookEndpointCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookEndpoint.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebhookEndpoint.updated_at"`)}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "WebhookEndpoint.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "WebhookEndpoint.secret"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "WebhookEndpoint.enabled"`)}
+	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "WebhookEndpoint.format"`)}
+	}
+	return nil
+}
+
+func (_c *WebhookEndpointCreate) sqlSave(ctx context.Context) (*WebhookEndpoint, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebhookEndpointCreate) createSpec() (*WebhookEndpoint, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEndpoint{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webhookendpoint.Table, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Secret(); ok {
+		_spec.SetField(webhookendpoint.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := _c.mutation.EventTypes(); ok {
+		_spec.SetField(webhookendpoint.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := _c.mutation.Conditions(); ok {
+		_spec.SetField(webhookendpoint.FieldConditions, field.TypeJSON, value)
+		_node.Conditions = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(webhookendpoint.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.SlackToken(); ok {
+		_spec.SetField(webhookendpoint.FieldSlackToken, field.TypeString, value)
+		_node.SlackToken = value
+	}
+	if value, ok := _c.mutation.SlackChannel(); ok {
+		_spec.SetField(webhookendpoint.FieldSlackChannel, field.TypeString, value)
+		_node.SlackChannel = value
+	}
+	return _node, _spec
+}
+
+// WebhookEndpointCreateBulk is the builder for creating many WebhookEndpoint entities in bulk.
+type WebhookEndpointCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookEndpointCreate
+}
+
+// Save creates the WebhookEndpoint entities in the database.
+func (_c *WebhookEndpointCreateBulk) Save(ctx context.Context) ([]*WebhookEndpoint, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebhookEndpoint, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEndpointMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebhookEndpointCreateBulk) SaveX(ctx context.Context) []*WebhookEndpoint {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookEndpointCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookEndpointCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,596 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
+)
+
+// WebhookEndpointUpdate is the builder for updating WebhookEndpoint entities.
+type WebhookEndpointUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// Where appends a list predicates to the WebhookEndpointUpdate builder.
+func (_u *WebhookEndpointUpdate) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebhookEndpointUpdate) SetUpdatedAt(v time.Time) *WebhookEndpointUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *WebhookEndpointUpdate) SetURL(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableURL(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *WebhookEndpointUpdate) SetSecret(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableSecret(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetEventTypes sets the "event_types" field.
+func (_u *WebhookEndpointUpdate) SetEventTypes(v []string) *WebhookEndpointUpdate {
+	_u.mutation.SetEventTypes(v)
+	return _u
+}
+
+// AppendEventTypes appends value to the "event_types" field.
+func (_u *WebhookEndpointUpdate) AppendEventTypes(v []string) *WebhookEndpointUpdate {
+	_u.mutation.AppendEventTypes(v)
+	return _u
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (_u *WebhookEndpointUpdate) ClearEventTypes() *WebhookEndpointUpdate {
+	_u.mutation.ClearEventTypes()
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *WebhookEndpointUpdate) SetConditions(v []rules.Condition) *WebhookEndpointUpdate {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// AppendConditions appends value to the "conditions" field.
+func (_u *WebhookEndpointUpdate) AppendConditions(v []rules.Condition) *WebhookEndpointUpdate {
+	_u.mutation.AppendConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *WebhookEndpointUpdate) ClearConditions() *WebhookEndpointUpdate {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *WebhookEndpointUpdate) SetEnabled(v bool) *WebhookEndpointUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableEnabled(v *bool) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *WebhookEndpointUpdate) SetFormat(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableFormat(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetSlackToken sets the "slack_token" field.
+func (_u *WebhookEndpointUpdate) SetSlackToken(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetSlackToken(v)
+	return _u
+}
+
+// SetNillableSlackToken sets the "slack_token" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableSlackToken(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetSlackToken(*v)
+	}
+	return _u
+}
+
+// ClearSlackToken clears the value of the "slack_token" field.
+func (_u *WebhookEndpointUpdate) ClearSlackToken() *WebhookEndpointUpdate {
+	_u.mutation.ClearSlackToken()
+	return _u
+}
+
+// SetSlackChannel sets the "slack_channel" field.
+func (_u *WebhookEndpointUpdate) SetSlackChannel(v string) *WebhookEndpointUpdate {
+	_u.mutation.SetSlackChannel(v)
+	return _u
+}
+
+// SetNillableSlackChannel sets the "slack_channel" field if the given value is not nil.
+func (_u *WebhookEndpointUpdate) SetNillableSlackChannel(v *string) *WebhookEndpointUpdate {
+	if v != nil {
+		_u.SetSlackChannel(*v)
+	}
+	return _u
+}
+
+// ClearSlackChannel clears the value of the "slack_channel" field.
+func (_u *WebhookEndpointUpdate) ClearSlackChannel() *WebhookEndpointUpdate {
+	_u.mutation.ClearSlackChannel()
+	return _u
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (_u *WebhookEndpointUpdate) Mutation() *WebhookEndpointMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebhookEndpointUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookEndpointUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebhookEndpointUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookEndpointUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebhookEndpointUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookEndpointUpdate) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WebhookEndpointUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookendpoint.Table, webhookendpoint.Columns, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(webhookendpoint.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventTypes(); ok {
+		_spec.SetField(webhookendpoint.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldEventTypes, value)
+		})
+	}
+	if _u.mutation.EventTypesCleared() {
+		_spec.ClearField(webhookendpoint.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(webhookendpoint.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConditions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldConditions, value)
+		})
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(webhookendpoint.FieldConditions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(webhookendpoint.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SlackToken(); ok {
+		_spec.SetField(webhookendpoint.FieldSlackToken, field.TypeString, value)
+	}
+	if _u.mutation.SlackTokenCleared() {
+		_spec.ClearField(webhookendpoint.FieldSlackToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.SlackChannel(); ok {
+		_spec.SetField(webhookendpoint.FieldSlackChannel, field.TypeString, value)
+	}
+	if _u.mutation.SlackChannelCleared() {
+		_spec.ClearField(webhookendpoint.FieldSlackChannel, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebhookEndpointUpdateOne is the builder for updating a single WebhookEndpoint entity.
+type WebhookEndpointUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookEndpointMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WebhookEndpointUpdateOne) SetUpdatedAt(v time.Time) *WebhookEndpointUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *WebhookEndpointUpdateOne) SetURL(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableURL(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *WebhookEndpointUpdateOne) SetSecret(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableSecret(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetEventTypes sets the "event_types" field.
+func (_u *WebhookEndpointUpdateOne) SetEventTypes(v []string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetEventTypes(v)
+	return _u
+}
+
+// AppendEventTypes appends value to the "event_types" field.
+func (_u *WebhookEndpointUpdateOne) AppendEventTypes(v []string) *WebhookEndpointUpdateOne {
+	_u.mutation.AppendEventTypes(v)
+	return _u
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (_u *WebhookEndpointUpdateOne) ClearEventTypes() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearEventTypes()
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *WebhookEndpointUpdateOne) SetConditions(v []rules.Condition) *WebhookEndpointUpdateOne {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// AppendConditions appends value to the "conditions" field.
+func (_u *WebhookEndpointUpdateOne) AppendConditions(v []rules.Condition) *WebhookEndpointUpdateOne {
+	_u.mutation.AppendConditions(v)
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *WebhookEndpointUpdateOne) ClearConditions() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *WebhookEndpointUpdateOne) SetEnabled(v bool) *WebhookEndpointUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableEnabled(v *bool) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *WebhookEndpointUpdateOne) SetFormat(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableFormat(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetSlackToken sets the "slack_token" field.
+func (_u *WebhookEndpointUpdateOne) SetSlackToken(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetSlackToken(v)
+	return _u
+}
+
+// SetNillableSlackToken sets the "slack_token" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableSlackToken(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetSlackToken(*v)
+	}
+	return _u
+}
+
+// ClearSlackToken clears the value of the "slack_token" field.
+func (_u *WebhookEndpointUpdateOne) ClearSlackToken() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearSlackToken()
+	return _u
+}
+
+// SetSlackChannel sets the "slack_channel" field.
+func (_u *WebhookEndpointUpdateOne) SetSlackChannel(v string) *WebhookEndpointUpdateOne {
+	_u.mutation.SetSlackChannel(v)
+	return _u
+}
+
+// SetNillableSlackChannel sets the "slack_channel" field if the given value is not nil.
+func (_u *WebhookEndpointUpdateOne) SetNillableSlackChannel(v *string) *WebhookEndpointUpdateOne {
+	if v != nil {
+		_u.SetSlackChannel(*v)
+	}
+	return _u
+}
+
+// ClearSlackChannel clears the value of the "slack_channel" field.
+func (_u *WebhookEndpointUpdateOne) ClearSlackChannel() *WebhookEndpointUpdateOne {
+	_u.mutation.ClearSlackChannel()
+	return _u
+}
+
+// Mutation returns the WebhookEndpointMutation object of the builder.
+func (_u *WebhookEndpointUpdateOne) Mutation() *WebhookEndpointMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WebhookEndpointUpdate builder.
+func (_u *WebhookEndpointUpdateOne) Where(ps ...predicate.WebhookEndpoint) *WebhookEndpointUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebhookEndpointUpdateOne) Select(field string, fields ...string) *WebhookEndpointUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebhookEndpoint entity.
+func (_u *WebhookEndpointUpdateOne) Save(ctx context.Context) (*WebhookEndpoint, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookEndpointUpdateOne) SaveX(ctx context.Context) *WebhookEndpoint {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebhookEndpointUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookEndpointUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WebhookEndpointUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := webhookendpoint.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookEndpointUpdateOne) check() error {
+	if v, ok := _u.mutation.URL(); ok {
+		if err := webhookendpoint.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "WebhookEndpoint.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WebhookEndpointUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEndpoint, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookendpoint.Table, webhookendpoint.Columns, sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookEndpoint.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookendpoint.FieldID)
+		for _, f := range fields {
+			if !webhookendpoint.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookendpoint.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookendpoint.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(webhookendpoint.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(webhookendpoint.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventTypes(); ok {
+		_spec.SetField(webhookendpoint.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldEventTypes, value)
+		})
+	}
+	if _u.mutation.EventTypesCleared() {
+		_spec.ClearField(webhookendpoint.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(webhookendpoint.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedConditions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookendpoint.FieldConditions, value)
+		})
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(webhookendpoint.FieldConditions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(webhookendpoint.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(webhookendpoint.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SlackToken(); ok {
+		_spec.SetField(webhookendpoint.FieldSlackToken, field.TypeString, value)
+	}
+	if _u.mutation.SlackTokenCleared() {
+		_spec.ClearField(webhookendpoint.FieldSlackToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.SlackChannel(); ok {
+		_spec.SetField(webhookendpoint.FieldSlackChannel, field.TypeString, value)
+	}
+	if _u.mutation.SlackChannelCleared() {
+		_spec.ClearField(webhookendpoint.FieldSlackChannel, field.TypeString)
+	}
+	_node = &WebhookEndpoint{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookendpoint.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/worker"
+	"github.com/google/uuid"
+)
+
+// Worker is the model entity for the Worker schema.
+type Worker struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Hub instance running the worker (hostname and process ID)
+	Instance string `json:"instance,omitempty"`
+	// Worker ID, unique within the instance
+	WorkerID int `json:"worker_id,omitempty"`
+	// Job type processed by the worker's pool, or all
+	JobType string `json:"job_type,omitempty"`
+	// Job being processed, if any
+	CurrentJobID *uuid.UUID `json:"current_job_id,omitempty"`
+	// When the worker started the current job
+	CurrentJobStartedAt *time.Time `json:"current_job_started_at,omitempty"`
+	// Jobs completed since the worker started
+	Processed int64 `json:"processed,omitempty"`
+	// Failed job attempts since the worker started
+	Failed int64 `json:"failed,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// LastHeartbeatAt holds the value of the "last_heartbeat_at" field.
+	LastHeartbeatAt time.Time `json:"last_heartbeat_at,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Worker) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case worker.FieldCurrentJobID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case worker.FieldWorkerID, worker.FieldProcessed, worker.FieldFailed:
+			values[i] = new(sql.NullInt64)
+		case worker.FieldInstance, worker.FieldJobType:
+			values[i] = new(sql.NullString)
+		case worker.FieldCurrentJobStartedAt, worker.FieldStartedAt, worker.FieldLastHeartbeatAt:
+			values[i] = new(sql.NullTime)
+		case worker.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Worker fields.
+func (_m *Worker) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case worker.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case worker.FieldInstance:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instance", values[i])
+			} else if value.Valid {
+				_m.Instance = value.String
+			}
+		case worker.FieldWorkerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field worker_id", values[i])
+			} else if value.Valid {
+				_m.WorkerID = int(value.Int64)
+			}
+		case worker.FieldJobType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_type", values[i])
+			} else if value.Valid {
+				_m.JobType = value.String
+			}
+		case worker.FieldCurrentJobID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field current_job_id", values[i])
+			} else if value.Valid {
+				_m.CurrentJobID = new(uuid.UUID)
+				*_m.CurrentJobID = *value.S.(*uuid.UUID)
+			}
+		case worker.FieldCurrentJobStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field current_job_started_at", values[i])
+			} else if value.Valid {
+				_m.CurrentJobStartedAt = new(time.Time)
+				*_m.CurrentJobStartedAt = value.Time
+			}
+		case worker.FieldProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field processed", values[i])
+			} else if value.Valid {
+				_m.Processed = value.Int64
+			}
+		case worker.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				_m.Failed = value.Int64
+			}
+		case worker.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case worker.FieldLastHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_heartbeat_at", values[i])
+			} else if value.Valid {
+				_m.LastHeartbeatAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Worker.
+// This includes values selected through modifiers, order, etc.
+func (_m *Worker) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Worker.
+// Note that you need to call Worker.Unwrap() before calling this method if this Worker
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Worker) Update() *WorkerUpdateOne {
+	return NewWorkerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Worker entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Worker) Unwrap() *Worker {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Worker is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Worker) String() string {
+	var builder strings.Builder
+	builder.WriteString("Worker(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("instance=")
+	builder.WriteString(_m.Instance)
+	builder.WriteString(", ")
+	builder.WriteString("worker_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkerID))
+	builder.WriteString(", ")
+	builder.WriteString("job_type=")
+	builder.WriteString(_m.JobType)
+	builder.WriteString(", ")
+	if v := _m.CurrentJobID; v != nil {
+		builder.WriteString("current_job_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CurrentJobStartedAt; v != nil {
+		builder.WriteString("current_job_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("processed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Processed))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Failed))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_heartbeat_at=")
+	builder.WriteString(_m.LastHeartbeatAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Workers is a parsable slice of Worker.
+type Workers []*Worker
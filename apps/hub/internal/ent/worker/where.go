@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package worker
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldID, id))
+}
+
+// Instance applies equality check predicate on the "instance" field. It's identical to InstanceEQ.
+func Instance(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldInstance, v))
+}
+
+// WorkerID applies equality check predicate on the "worker_id" field. It's identical to WorkerIDEQ.
+func WorkerID(v int) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// JobType applies equality check predicate on the "job_type" field. It's identical to JobTypeEQ.
+func JobType(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldJobType, v))
+}
+
+// CurrentJobID applies equality check predicate on the "current_job_id" field. It's identical to CurrentJobIDEQ.
+func CurrentJobID(v uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldCurrentJobID, v))
+}
+
+// CurrentJobStartedAt applies equality check predicate on the "current_job_started_at" field. It's identical to CurrentJobStartedAtEQ.
+func CurrentJobStartedAt(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldCurrentJobStartedAt, v))
+}
+
+// Processed applies equality check predicate on the "processed" field. It's identical to ProcessedEQ.
+func Processed(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldProcessed, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldFailed, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// LastHeartbeatAt applies equality check predicate on the "last_heartbeat_at" field. It's identical to LastHeartbeatAtEQ.
+func LastHeartbeatAt(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// InstanceEQ applies the EQ predicate on the "instance" field.
+func InstanceEQ(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldInstance, v))
+}
+
+// InstanceNEQ applies the NEQ predicate on the "instance" field.
+func InstanceNEQ(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldInstance, v))
+}
+
+// InstanceIn applies the In predicate on the "instance" field.
+func InstanceIn(vs ...string) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldInstance, vs...))
+}
+
+// InstanceNotIn applies the NotIn predicate on the "instance" field.
+func InstanceNotIn(vs ...string) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldInstance, vs...))
+}
+
+// InstanceGT applies the GT predicate on the "instance" field.
+func InstanceGT(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldInstance, v))
+}
+
+// InstanceGTE applies the GTE predicate on the "instance" field.
+func InstanceGTE(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldInstance, v))
+}
+
+// InstanceLT applies the LT predicate on the "instance" field.
+func InstanceLT(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldInstance, v))
+}
+
+// InstanceLTE applies the LTE predicate on the "instance" field.
+func InstanceLTE(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldInstance, v))
+}
+
+// InstanceContains applies the Contains predicate on the "instance" field.
+func InstanceContains(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldContains(FieldInstance, v))
+}
+
+// InstanceHasPrefix applies the HasPrefix predicate on the "instance" field.
+func InstanceHasPrefix(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldHasPrefix(FieldInstance, v))
+}
+
+// InstanceHasSuffix applies the HasSuffix predicate on the "instance" field.
+func InstanceHasSuffix(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldHasSuffix(FieldInstance, v))
+}
+
+// InstanceEqualFold applies the EqualFold predicate on the "instance" field.
+func InstanceEqualFold(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldEqualFold(FieldInstance, v))
+}
+
+// InstanceContainsFold applies the ContainsFold predicate on the "instance" field.
+func InstanceContainsFold(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldContainsFold(FieldInstance, v))
+}
+
+// WorkerIDEQ applies the EQ predicate on the "worker_id" field.
+func WorkerIDEQ(v int) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// WorkerIDNEQ applies the NEQ predicate on the "worker_id" field.
+func WorkerIDNEQ(v int) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldWorkerID, v))
+}
+
+// WorkerIDIn applies the In predicate on the "worker_id" field.
+func WorkerIDIn(vs ...int) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDNotIn applies the NotIn predicate on the "worker_id" field.
+func WorkerIDNotIn(vs ...int) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDGT applies the GT predicate on the "worker_id" field.
+func WorkerIDGT(v int) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldWorkerID, v))
+}
+
+// WorkerIDGTE applies the GTE predicate on the "worker_id" field.
+func WorkerIDGTE(v int) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldWorkerID, v))
+}
+
+// WorkerIDLT applies the LT predicate on the "worker_id" field.
+func WorkerIDLT(v int) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldWorkerID, v))
+}
+
+// WorkerIDLTE applies the LTE predicate on the "worker_id" field.
+func WorkerIDLTE(v int) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldWorkerID, v))
+}
+
+// JobTypeEQ applies the EQ predicate on the "job_type" field.
+func JobTypeEQ(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldJobType, v))
+}
+
+// JobTypeNEQ applies the NEQ predicate on the "job_type" field.
+func JobTypeNEQ(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldJobType, v))
+}
+
+// JobTypeIn applies the In predicate on the "job_type" field.
+func JobTypeIn(vs ...string) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldJobType, vs...))
+}
+
+// JobTypeNotIn applies the NotIn predicate on the "job_type" field.
+func JobTypeNotIn(vs ...string) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldJobType, vs...))
+}
+
+// JobTypeGT applies the GT predicate on the "job_type" field.
+func JobTypeGT(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldJobType, v))
+}
+
+// JobTypeGTE applies the GTE predicate on the "job_type" field.
+func JobTypeGTE(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldJobType, v))
+}
+
+// JobTypeLT applies the LT predicate on the "job_type" field.
+func JobTypeLT(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldJobType, v))
+}
+
+// JobTypeLTE applies the LTE predicate on the "job_type" field.
+func JobTypeLTE(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldJobType, v))
+}
+
+// JobTypeContains applies the Contains predicate on the "job_type" field.
+func JobTypeContains(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldContains(FieldJobType, v))
+}
+
+// JobTypeHasPrefix applies the HasPrefix predicate on the "job_type" field.
+func JobTypeHasPrefix(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldHasPrefix(FieldJobType, v))
+}
+
+// JobTypeHasSuffix applies the HasSuffix predicate on the "job_type" field.
+func JobTypeHasSuffix(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldHasSuffix(FieldJobType, v))
+}
+
+// JobTypeEqualFold applies the EqualFold predicate on the "job_type" field.
+func JobTypeEqualFold(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldEqualFold(FieldJobType, v))
+}
+
+// JobTypeContainsFold applies the ContainsFold predicate on the "job_type" field.
+func JobTypeContainsFold(v string) predicate.Worker {
+	return predicate.Worker(sql.FieldContainsFold(FieldJobType, v))
+}
+
+// CurrentJobIDEQ applies the EQ predicate on the "current_job_id" field.
+func CurrentJobIDEQ(v uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldCurrentJobID, v))
+}
+
+// CurrentJobIDNEQ applies the NEQ predicate on the "current_job_id" field.
+func CurrentJobIDNEQ(v uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldCurrentJobID, v))
+}
+
+// CurrentJobIDIn applies the In predicate on the "current_job_id" field.
+func CurrentJobIDIn(vs ...uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldCurrentJobID, vs...))
+}
+
+// CurrentJobIDNotIn applies the NotIn predicate on the "current_job_id" field.
+func CurrentJobIDNotIn(vs ...uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldCurrentJobID, vs...))
+}
+
+// CurrentJobIDGT applies the GT predicate on the "current_job_id" field.
+func CurrentJobIDGT(v uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldCurrentJobID, v))
+}
+
+// CurrentJobIDGTE applies the GTE predicate on the "current_job_id" field.
+func CurrentJobIDGTE(v uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldCurrentJobID, v))
+}
+
+// CurrentJobIDLT applies the LT predicate on the "current_job_id" field.
+func CurrentJobIDLT(v uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldCurrentJobID, v))
+}
+
+// CurrentJobIDLTE applies the LTE predicate on the "current_job_id" field.
+func CurrentJobIDLTE(v uuid.UUID) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldCurrentJobID, v))
+}
+
+// CurrentJobIDIsNil applies the IsNil predicate on the "current_job_id" field.
+func CurrentJobIDIsNil() predicate.Worker {
+	return predicate.Worker(sql.FieldIsNull(FieldCurrentJobID))
+}
+
+// CurrentJobIDNotNil applies the NotNil predicate on the "current_job_id" field.
+func CurrentJobIDNotNil() predicate.Worker {
+	return predicate.Worker(sql.FieldNotNull(FieldCurrentJobID))
+}
+
+// CurrentJobStartedAtEQ applies the EQ predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtEQ(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldCurrentJobStartedAt, v))
+}
+
+// CurrentJobStartedAtNEQ applies the NEQ predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtNEQ(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldCurrentJobStartedAt, v))
+}
+
+// CurrentJobStartedAtIn applies the In predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtIn(vs ...time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldCurrentJobStartedAt, vs...))
+}
+
+// CurrentJobStartedAtNotIn applies the NotIn predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtNotIn(vs ...time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldCurrentJobStartedAt, vs...))
+}
+
+// CurrentJobStartedAtGT applies the GT predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtGT(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldCurrentJobStartedAt, v))
+}
+
+// CurrentJobStartedAtGTE applies the GTE predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtGTE(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldCurrentJobStartedAt, v))
+}
+
+// CurrentJobStartedAtLT applies the LT predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtLT(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldCurrentJobStartedAt, v))
+}
+
+// CurrentJobStartedAtLTE applies the LTE predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtLTE(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldCurrentJobStartedAt, v))
+}
+
+// CurrentJobStartedAtIsNil applies the IsNil predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtIsNil() predicate.Worker {
+	return predicate.Worker(sql.FieldIsNull(FieldCurrentJobStartedAt))
+}
+
+// CurrentJobStartedAtNotNil applies the NotNil predicate on the "current_job_started_at" field.
+func CurrentJobStartedAtNotNil() predicate.Worker {
+	return predicate.Worker(sql.FieldNotNull(FieldCurrentJobStartedAt))
+}
+
+// ProcessedEQ applies the EQ predicate on the "processed" field.
+func ProcessedEQ(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldProcessed, v))
+}
+
+// ProcessedNEQ applies the NEQ predicate on the "processed" field.
+func ProcessedNEQ(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldProcessed, v))
+}
+
+// ProcessedIn applies the In predicate on the "processed" field.
+func ProcessedIn(vs ...int64) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldProcessed, vs...))
+}
+
+// ProcessedNotIn applies the NotIn predicate on the "processed" field.
+func ProcessedNotIn(vs ...int64) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldProcessed, vs...))
+}
+
+// ProcessedGT applies the GT predicate on the "processed" field.
+func ProcessedGT(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldProcessed, v))
+}
+
+// ProcessedGTE applies the GTE predicate on the "processed" field.
+func ProcessedGTE(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldProcessed, v))
+}
+
+// ProcessedLT applies the LT predicate on the "processed" field.
+func ProcessedLT(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldProcessed, v))
+}
+
+// ProcessedLTE applies the LTE predicate on the "processed" field.
+func ProcessedLTE(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldProcessed, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...int64) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...int64) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v int64) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldFailed, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// LastHeartbeatAtEQ applies the EQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtEQ(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtNEQ applies the NEQ predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNEQ(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldNEQ(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtIn applies the In predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtIn(vs ...time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtNotIn applies the NotIn predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtNotIn(vs ...time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldNotIn(FieldLastHeartbeatAt, vs...))
+}
+
+// LastHeartbeatAtGT applies the GT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGT(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldGT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtGTE applies the GTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtGTE(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldGTE(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLT applies the LT predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLT(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldLT(FieldLastHeartbeatAt, v))
+}
+
+// LastHeartbeatAtLTE applies the LTE predicate on the "last_heartbeat_at" field.
+func LastHeartbeatAtLTE(v time.Time) predicate.Worker {
+	return predicate.Worker(sql.FieldLTE(FieldLastHeartbeatAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Worker) predicate.Worker {
+	return predicate.Worker(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Worker) predicate.Worker {
+	return predicate.Worker(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Worker) predicate.Worker {
+	return predicate.Worker(sql.NotPredicates(p))
+}
@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package worker
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the worker type in the database.
+	Label = "worker"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInstance holds the string denoting the instance field in the database.
+	FieldInstance = "instance"
+	// FieldWorkerID holds the string denoting the worker_id field in the database.
+	FieldWorkerID = "worker_id"
+	// FieldJobType holds the string denoting the job_type field in the database.
+	FieldJobType = "job_type"
+	// FieldCurrentJobID holds the string denoting the current_job_id field in the database.
+	FieldCurrentJobID = "current_job_id"
+	// FieldCurrentJobStartedAt holds the string denoting the current_job_started_at field in the database.
+	FieldCurrentJobStartedAt = "current_job_started_at"
+	// FieldProcessed holds the string denoting the processed field in the database.
+	FieldProcessed = "processed"
+	// FieldFailed holds the string denoting the failed field in the database.
+	FieldFailed = "failed"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldLastHeartbeatAt holds the string denoting the last_heartbeat_at field in the database.
+	FieldLastHeartbeatAt = "last_heartbeat_at"
+	// Table holds the table name of the worker in the database.
+	Table = "workers"
+)
+
+// Columns holds all SQL columns for worker fields.
+var Columns = []string{
+	FieldID,
+	FieldInstance,
+	FieldWorkerID,
+	FieldJobType,
+	FieldCurrentJobID,
+	FieldCurrentJobStartedAt,
+	FieldProcessed,
+	FieldFailed,
+	FieldStartedAt,
+	FieldLastHeartbeatAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultProcessed holds the default value on creation for the "processed" field.
+	DefaultProcessed int64
+	// DefaultFailed holds the default value on creation for the "failed" field.
+	DefaultFailed int64
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultLastHeartbeatAt holds the default value on creation for the "last_heartbeat_at" field.
+	DefaultLastHeartbeatAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Worker queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByInstance orders the results by the instance field.
+func ByInstance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstance, opts...).ToFunc()
+}
+
+// ByWorkerID orders the results by the worker_id field.
+func ByWorkerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkerID, opts...).ToFunc()
+}
+
+// ByJobType orders the results by the job_type field.
+func ByJobType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJobType, opts...).ToFunc()
+}
+
+// ByCurrentJobID orders the results by the current_job_id field.
+func ByCurrentJobID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentJobID, opts...).ToFunc()
+}
+
+// ByCurrentJobStartedAt orders the results by the current_job_started_at field.
+func ByCurrentJobStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentJobStartedAt, opts...).ToFunc()
+}
+
+// ByProcessed orders the results by the processed field.
+func ByProcessed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessed, opts...).ToFunc()
+}
+
+// ByFailed orders the results by the failed field.
+func ByFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailed, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByLastHeartbeatAt orders the results by the last_heartbeat_at field.
+func ByLastHeartbeatAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastHeartbeatAt, opts...).ToFunc()
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/worker"
+	"github.com/google/uuid"
+)
+
+// WorkerCreate is the builder for creating a Worker entity.
+type WorkerCreate struct {
+	config
+	mutation *WorkerMutation
+	hooks    []Hook
+}
+
+// SetInstance sets the "instance" field.
+func (_c *WorkerCreate) SetInstance(v string) *WorkerCreate {
+	_c.mutation.SetInstance(v)
+	return _c
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (_c *WorkerCreate) SetWorkerID(v int) *WorkerCreate {
+	_c.mutation.SetWorkerID(v)
+	return _c
+}
+
+// SetJobType sets the "job_type" field.
+func (_c *WorkerCreate) SetJobType(v string) *WorkerCreate {
+	_c.mutation.SetJobType(v)
+	return _c
+}
+
+// SetCurrentJobID sets the "current_job_id" field.
+func (_c *WorkerCreate) SetCurrentJobID(v uuid.UUID) *WorkerCreate {
+	_c.mutation.SetCurrentJobID(v)
+	return _c
+}
+
+// SetNillableCurrentJobID sets the "current_job_id" field if the given value is not nil.
+func (_c *WorkerCreate) SetNillableCurrentJobID(v *uuid.UUID) *WorkerCreate {
+	if v != nil {
+		_c.SetCurrentJobID(*v)
+	}
+	return _c
+}
+
+// SetCurrentJobStartedAt sets the "current_job_started_at" field.
+func (_c *WorkerCreate) SetCurrentJobStartedAt(v time.Time) *WorkerCreate {
+	_c.mutation.SetCurrentJobStartedAt(v)
+	return _c
+}
+
+// SetNillableCurrentJobStartedAt sets the "current_job_started_at" field if the given value is not nil.
+func (_c *WorkerCreate) SetNillableCurrentJobStartedAt(v *time.Time) *WorkerCreate {
+	if v != nil {
+		_c.SetCurrentJobStartedAt(*v)
+	}
+	return _c
+}
+
+// SetProcessed sets the "processed" field.
+func (_c *WorkerCreate) SetProcessed(v int64) *WorkerCreate {
+	_c.mutation.SetProcessed(v)
+	return _c
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_c *WorkerCreate) SetNillableProcessed(v *int64) *WorkerCreate {
+	if v != nil {
+		_c.SetProcessed(*v)
+	}
+	return _c
+}
+
+// SetFailed sets the "failed" field.
+func (_c *WorkerCreate) SetFailed(v int64) *WorkerCreate {
+	_c.mutation.SetFailed(v)
+	return _c
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_c *WorkerCreate) SetNillableFailed(v *int64) *WorkerCreate {
+	if v != nil {
+		_c.SetFailed(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *WorkerCreate) SetStartedAt(v time.Time) *WorkerCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *WorkerCreate) SetNillableStartedAt(v *time.Time) *WorkerCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_c *WorkerCreate) SetLastHeartbeatAt(v time.Time) *WorkerCreate {
+	_c.mutation.SetLastHeartbeatAt(v)
+	return _c
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_c *WorkerCreate) SetNillableLastHeartbeatAt(v *time.Time) *WorkerCreate {
+	if v != nil {
+		_c.SetLastHeartbeatAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WorkerCreate) SetID(v uuid.UUID) *WorkerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the WorkerMutation object of the builder.
+func (_c *WorkerCreate) Mutation() *WorkerMutation {
+	return _c.mutation
+}
+
+// Save creates the Worker in the database.
+func (_c *WorkerCreate) Save(ctx context.Context) (*Worker, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkerCreate) SaveX(ctx context.Context) *Worker {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkerCreate) defaults() {
+	if _, ok := _c.mutation.Processed(); !ok {
+		v := worker.DefaultProcessed
+		_c.mutation.SetProcessed(v)
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		v := worker.DefaultFailed
+		_c.mutation.SetFailed(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := worker.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.LastHeartbeatAt(); !ok {
+		v := worker.DefaultLastHeartbeatAt()
+		_c.mutation.SetLastHeartbeatAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkerCreate) check() error {
+	if _, ok := _c.mutation.Instance(); !ok {
+		return &ValidationError{Name: "instance", err: errors.New(`ent: missing required field "Worker.instance"`)}
+	}
+	if _, ok := _c.mutation.WorkerID(); !ok {
+		return &ValidationError{Name: "worker_id", err: errors.New(`ent: missing required field "Worker.worker_id"`)}
+	}
+	if _, ok := _c.mutation.JobType(); !ok {
+		return &ValidationError{Name: "job_type", err: errors.New(`ent: missing required field "Worker.job_type"`)}
+	}
+	if _, ok := _c.mutation.Processed(); !ok {
+		return &ValidationError{Name: "processed", err: errors.New(`ent: missing required field "Worker.processed"`)}
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "Worker.failed"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "Worker.started_at"`)}
+	}
+	if _, ok := _c.mutation.LastHeartbeatAt(); !ok {
+		return &ValidationError{Name: "last_heartbeat_at", err: errors.New(`ent: missing required field "Worker.last_heartbeat_at"`)}
+	}
+	return nil
+}
+
+func (_c *WorkerCreate) sqlSave(ctx context.Context) (*Worker, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkerCreate) createSpec() (*Worker, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Worker{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(worker.Table, sqlgraph.NewFieldSpec(worker.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Instance(); ok {
+		_spec.SetField(worker.FieldInstance, field.TypeString, value)
+		_node.Instance = value
+	}
+	if value, ok := _c.mutation.WorkerID(); ok {
+		_spec.SetField(worker.FieldWorkerID, field.TypeInt, value)
+		_node.WorkerID = value
+	}
+	if value, ok := _c.mutation.JobType(); ok {
+		_spec.SetField(worker.FieldJobType, field.TypeString, value)
+		_node.JobType = value
+	}
+	if value, ok := _c.mutation.CurrentJobID(); ok {
+		_spec.SetField(worker.FieldCurrentJobID, field.TypeUUID, value)
+		_node.CurrentJobID = &value
+	}
+	if value, ok := _c.mutation.CurrentJobStartedAt(); ok {
+		_spec.SetField(worker.FieldCurrentJobStartedAt, field.TypeTime, value)
+		_node.CurrentJobStartedAt = &value
+	}
+	if value, ok := _c.mutation.Processed(); ok {
+		_spec.SetField(worker.FieldProcessed, field.TypeInt64, value)
+		_node.Processed = value
+	}
+	if value, ok := _c.mutation.Failed(); ok {
+		_spec.SetField(worker.FieldFailed, field.TypeInt64, value)
+		_node.Failed = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(worker.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(worker.FieldLastHeartbeatAt, field.TypeTime, value)
+		_node.LastHeartbeatAt = value
+	}
+	return _node, _spec
+}
+
+// WorkerCreateBulk is the builder for creating many Worker entities in bulk.
+type WorkerCreateBulk struct {
+	config
+	err      error
+	builders []*WorkerCreate
+}
+
+// Save creates the Worker entities in the database.
+func (_c *WorkerCreateBulk) Save(ctx context.Context) ([]*Worker, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Worker, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkerCreateBulk) SaveX(ctx context.Context) []*Worker {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
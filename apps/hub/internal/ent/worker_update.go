@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/worker"
+	"github.com/google/uuid"
+)
+
+// WorkerUpdate is the builder for updating Worker entities.
+type WorkerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkerMutation
+}
+
+// Where appends a list predicates to the WorkerUpdate builder.
+func (_u *WorkerUpdate) Where(ps ...predicate.Worker) *WorkerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCurrentJobID sets the "current_job_id" field.
+func (_u *WorkerUpdate) SetCurrentJobID(v uuid.UUID) *WorkerUpdate {
+	_u.mutation.SetCurrentJobID(v)
+	return _u
+}
+
+// SetNillableCurrentJobID sets the "current_job_id" field if the given value is not nil.
+func (_u *WorkerUpdate) SetNillableCurrentJobID(v *uuid.UUID) *WorkerUpdate {
+	if v != nil {
+		_u.SetCurrentJobID(*v)
+	}
+	return _u
+}
+
+// ClearCurrentJobID clears the value of the "current_job_id" field.
+func (_u *WorkerUpdate) ClearCurrentJobID() *WorkerUpdate {
+	_u.mutation.ClearCurrentJobID()
+	return _u
+}
+
+// SetCurrentJobStartedAt sets the "current_job_started_at" field.
+func (_u *WorkerUpdate) SetCurrentJobStartedAt(v time.Time) *WorkerUpdate {
+	_u.mutation.SetCurrentJobStartedAt(v)
+	return _u
+}
+
+// SetNillableCurrentJobStartedAt sets the "current_job_started_at" field if the given value is not nil.
+func (_u *WorkerUpdate) SetNillableCurrentJobStartedAt(v *time.Time) *WorkerUpdate {
+	if v != nil {
+		_u.SetCurrentJobStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearCurrentJobStartedAt clears the value of the "current_job_started_at" field.
+func (_u *WorkerUpdate) ClearCurrentJobStartedAt() *WorkerUpdate {
+	_u.mutation.ClearCurrentJobStartedAt()
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *WorkerUpdate) SetProcessed(v int64) *WorkerUpdate {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *WorkerUpdate) SetNillableProcessed(v *int64) *WorkerUpdate {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *WorkerUpdate) AddProcessed(v int64) *WorkerUpdate {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *WorkerUpdate) SetFailed(v int64) *WorkerUpdate {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *WorkerUpdate) SetNillableFailed(v *int64) *WorkerUpdate {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *WorkerUpdate) AddFailed(v int64) *WorkerUpdate {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *WorkerUpdate) SetLastHeartbeatAt(v time.Time) *WorkerUpdate {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *WorkerUpdate) SetNillableLastHeartbeatAt(v *time.Time) *WorkerUpdate {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WorkerMutation object of the builder.
+func (_u *WorkerUpdate) Mutation() *WorkerMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WorkerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(worker.Table, worker.Columns, sqlgraph.NewFieldSpec(worker.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CurrentJobID(); ok {
+		_spec.SetField(worker.FieldCurrentJobID, field.TypeUUID, value)
+	}
+	if _u.mutation.CurrentJobIDCleared() {
+		_spec.ClearField(worker.FieldCurrentJobID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CurrentJobStartedAt(); ok {
+		_spec.SetField(worker.FieldCurrentJobStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentJobStartedAtCleared() {
+		_spec.ClearField(worker.FieldCurrentJobStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(worker.FieldProcessed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(worker.FieldProcessed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(worker.FieldFailed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(worker.FieldFailed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(worker.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worker.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkerUpdateOne is the builder for updating a single Worker entity.
+type WorkerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkerMutation
+}
+
+// SetCurrentJobID sets the "current_job_id" field.
+func (_u *WorkerUpdateOne) SetCurrentJobID(v uuid.UUID) *WorkerUpdateOne {
+	_u.mutation.SetCurrentJobID(v)
+	return _u
+}
+
+// SetNillableCurrentJobID sets the "current_job_id" field if the given value is not nil.
+func (_u *WorkerUpdateOne) SetNillableCurrentJobID(v *uuid.UUID) *WorkerUpdateOne {
+	if v != nil {
+		_u.SetCurrentJobID(*v)
+	}
+	return _u
+}
+
+// ClearCurrentJobID clears the value of the "current_job_id" field.
+func (_u *WorkerUpdateOne) ClearCurrentJobID() *WorkerUpdateOne {
+	_u.mutation.ClearCurrentJobID()
+	return _u
+}
+
+// SetCurrentJobStartedAt sets the "current_job_started_at" field.
+func (_u *WorkerUpdateOne) SetCurrentJobStartedAt(v time.Time) *WorkerUpdateOne {
+	_u.mutation.SetCurrentJobStartedAt(v)
+	return _u
+}
+
+// SetNillableCurrentJobStartedAt sets the "current_job_started_at" field if the given value is not nil.
+func (_u *WorkerUpdateOne) SetNillableCurrentJobStartedAt(v *time.Time) *WorkerUpdateOne {
+	if v != nil {
+		_u.SetCurrentJobStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearCurrentJobStartedAt clears the value of the "current_job_started_at" field.
+func (_u *WorkerUpdateOne) ClearCurrentJobStartedAt() *WorkerUpdateOne {
+	_u.mutation.ClearCurrentJobStartedAt()
+	return _u
+}
+
+// SetProcessed sets the "processed" field.
+func (_u *WorkerUpdateOne) SetProcessed(v int64) *WorkerUpdateOne {
+	_u.mutation.ResetProcessed()
+	_u.mutation.SetProcessed(v)
+	return _u
+}
+
+// SetNillableProcessed sets the "processed" field if the given value is not nil.
+func (_u *WorkerUpdateOne) SetNillableProcessed(v *int64) *WorkerUpdateOne {
+	if v != nil {
+		_u.SetProcessed(*v)
+	}
+	return _u
+}
+
+// AddProcessed adds value to the "processed" field.
+func (_u *WorkerUpdateOne) AddProcessed(v int64) *WorkerUpdateOne {
+	_u.mutation.AddProcessed(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *WorkerUpdateOne) SetFailed(v int64) *WorkerUpdateOne {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *WorkerUpdateOne) SetNillableFailed(v *int64) *WorkerUpdateOne {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *WorkerUpdateOne) AddFailed(v int64) *WorkerUpdateOne {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetLastHeartbeatAt sets the "last_heartbeat_at" field.
+func (_u *WorkerUpdateOne) SetLastHeartbeatAt(v time.Time) *WorkerUpdateOne {
+	_u.mutation.SetLastHeartbeatAt(v)
+	return _u
+}
+
+// SetNillableLastHeartbeatAt sets the "last_heartbeat_at" field if the given value is not nil.
+func (_u *WorkerUpdateOne) SetNillableLastHeartbeatAt(v *time.Time) *WorkerUpdateOne {
+	if v != nil {
+		_u.SetLastHeartbeatAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the WorkerMutation object of the builder.
+func (_u *WorkerUpdateOne) Mutation() *WorkerMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WorkerUpdate builder.
+func (_u *WorkerUpdateOne) Where(ps ...predicate.Worker) *WorkerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkerUpdateOne) Select(field string, fields ...string) *WorkerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Worker entity.
+func (_u *WorkerUpdateOne) Save(ctx context.Context) (*Worker, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkerUpdateOne) SaveX(ctx context.Context) *Worker {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *WorkerUpdateOne) sqlSave(ctx context.Context) (_node *Worker, err error) {
+	_spec := sqlgraph.NewUpdateSpec(worker.Table, worker.Columns, sqlgraph.NewFieldSpec(worker.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Worker.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, worker.FieldID)
+		for _, f := range fields {
+			if !worker.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != worker.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CurrentJobID(); ok {
+		_spec.SetField(worker.FieldCurrentJobID, field.TypeUUID, value)
+	}
+	if _u.mutation.CurrentJobIDCleared() {
+		_spec.ClearField(worker.FieldCurrentJobID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CurrentJobStartedAt(); ok {
+		_spec.SetField(worker.FieldCurrentJobStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentJobStartedAtCleared() {
+		_spec.ClearField(worker.FieldCurrentJobStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Processed(); ok {
+		_spec.SetField(worker.FieldProcessed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProcessed(); ok {
+		_spec.AddField(worker.FieldProcessed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(worker.FieldFailed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(worker.FieldFailed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastHeartbeatAt(); ok {
+		_spec.SetField(worker.FieldLastHeartbeatAt, field.TypeTime, value)
+	}
+	_node = &Worker{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{worker.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
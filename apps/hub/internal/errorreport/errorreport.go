@@ -0,0 +1,186 @@
+// Package errorreport forwards panics and 5xx errors of API requests to Sentry or an OTLP
+// logs endpoint. Events carry the request's method, route pattern, status, request ID, and
+// trace ID, but never its path, query, headers, body, or client address, which may contain
+// personal data.
+package errorreport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// queueSize is the number of events waiting to be sent; more are dropped
+	queueSize = 100
+	// sendTimeout bounds a single request to an error reporting backend
+	sendTimeout = 10 * time.Second
+	// maxMessageLength truncates long error messages and panic values
+	maxMessageLength = 2048
+)
+
+// Reports per second and burst, so an outage (e.g., of the database) that fails every
+// request doesn't flood the backend
+const (
+	reportRate  = 10
+	reportBurst = 50
+)
+
+// Event is an error to report
+type Event struct {
+	Time      time.Time
+	Message   string // Error message or panic value
+	Panic     bool
+	Stack     string // Stack trace of panics
+	Method    string
+	Route     string // Route pattern (e.g., /v1/experiences/{id}), not the requested path
+	Status    int
+	RequestID string
+	TraceID   string
+	SpanID    string
+}
+
+// Options configures where errors are reported
+type Options struct {
+	SentryDSN    string
+	OTLPEndpoint string // OTLP/HTTP endpoint; /v1/logs is used if it has no path
+	ServiceName  string
+	Environment  string
+}
+
+// sender delivers events to a backend
+type sender interface {
+	send(ctx context.Context, e Event) error
+}
+
+// Reporter sends events in the background, so reporting never slows down requests
+type Reporter struct {
+	senders []sender
+	events  chan Event
+	limiter *rate.Limiter
+	logger  *slog.Logger
+	dropped atomic.Int64
+	done    chan struct{}
+
+	mu     sync.RWMutex // Guards closed, so no event is queued after Close
+	closed bool
+}
+
+// Setup returns a reporter for the configured backends, or nil if none is configured.
+// A nil reporter is valid and reports nothing.
+func Setup(opts Options, logger *slog.Logger) (*Reporter, error) {
+	client := &http.Client{Timeout: sendTimeout}
+	var senders []sender
+	if opts.SentryDSN != "" {
+		s, err := newSentrySender(client, opts)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, s)
+	}
+	if opts.OTLPEndpoint != "" {
+		s, err := newOTLPSender(client, opts)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, s)
+	}
+	if len(senders) == 0 {
+		return nil, nil
+	}
+	return newReporter(senders, logger), nil
+}
+
+func newReporter(senders []sender, logger *slog.Logger) *Reporter {
+	r := &Reporter{
+		senders: senders,
+		events:  make(chan Event, queueSize),
+		limiter: rate.NewLimiter(reportRate, reportBurst),
+		logger:  logger,
+		done:    make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Report queues an event. Events are dropped if the reporter falls behind.
+func (r *Reporter) Report(e Event) {
+	if r == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if len(e.Message) > maxMessageLength {
+		e.Message = e.Message[:maxMessageLength] + "…"
+	}
+	if !r.limiter.Allow() {
+		r.dropped.Add(1)
+		return
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.events <- e:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+// Close sends the queued events and stops the reporter, giving up when ctx is done
+func (r *Reporter) Close(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.events)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send all error reports: %w", ctx.Err())
+	}
+	if dropped := r.dropped.Load(); dropped > 0 {
+		r.logger.Warn("error reports were dropped", "count", dropped)
+	}
+	return nil
+}
+
+func (r *Reporter) run() {
+	defer close(r.done)
+	for e := range r.events {
+		for _, s := range r.senders {
+			ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+			if err := s.send(ctx, e); err != nil {
+				r.logger.Warn("failed to report error", "error", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// post sends a request to a backend and checks the response status
+func post(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with status %d", req.URL.Host, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package errorreport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// fakeSender collects the events it is sent
+type fakeSender struct {
+	events chan Event
+}
+
+func (s *fakeSender) send(_ context.Context, e Event) error {
+	s.events <- e
+	return nil
+}
+
+func newTestRouter(t *testing.T) (*chi.Mux, *fakeSender) {
+	t.Helper()
+	fake := &fakeSender{events: make(chan Event, 10)}
+	reporter := newReporter([]sender{fake}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	t.Cleanup(func() { _ = reporter.Close(context.Background()) })
+
+	router := chi.NewRouter()
+	router.Use(middleware.RequestID)
+	router.Use(middleware.Recoverer)
+	router.Use(reporter.Middleware)
+	router.Get("/panic/{id}", func(w http.ResponseWriter, r *http.Request) {
+		panic("something broke")
+	})
+	router.Get("/fail/{id}", func(w http.ResponseWriter, r *http.Request) {
+		SetError(r.Context(), errors.New("connection refused"))
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	router.Get("/unavailable", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	router.Get("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	return router, fake
+}
+
+func nextEvent(t *testing.T, sender *fakeSender) Event {
+	t.Helper()
+	select {
+	case e := <-sender.events:
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("expected an event to be reported")
+		return Event{}
+	}
+}
+
+func expectNoEvent(t *testing.T, sender *fakeSender) {
+	t.Helper()
+	select {
+	case e := <-sender.events:
+		t.Fatalf("expected no event, got %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMiddleware_ReportsPanics(t *testing.T) {
+	router, sender := newTestRouter(t)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic/jane@example.com?q=secret", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected the recoverer to respond with 500, got %d", rec.Code)
+	}
+	e := nextEvent(t, sender)
+	if !e.Panic || e.Message != "something broke" || !strings.Contains(e.Stack, "errorreport_test.go") {
+		t.Errorf("expected the panic with its stack, got %+v", e)
+	}
+	if e.Method != http.MethodGet || e.Route != "/panic/{id}" || e.Status != http.StatusInternalServerError || e.RequestID == "" {
+		t.Errorf("expected the request context, got %+v", e)
+	}
+	expectNoEvent(t, sender)
+}
+
+func TestMiddleware_ReportsServerErrors(t *testing.T) {
+	router, sender := newTestRouter(t)
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/fail/123", nil))
+
+	e := nextEvent(t, sender)
+	if e.Panic || e.Message != "connection refused" || e.Route != "/fail/{id}" || e.Status != http.StatusInternalServerError {
+		t.Errorf("expected the recorded error, got %+v", e)
+	}
+
+	for _, path := range []string{"/unavailable", "/missing"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+		expectNoEvent(t, sender)
+	}
+}
+
+func TestSetup(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	reporter, err := Setup(Options{}, logger)
+	if err != nil || reporter != nil {
+		t.Errorf("expected no reporter without backends, got %v, %v", reporter, err)
+	}
+	// A nil reporter is usable
+	reporter.Report(Event{Message: "ignored"})
+	if err := reporter.Close(context.Background()); err != nil {
+		t.Errorf("expected closing a nil reporter to succeed, got %v", err)
+	}
+
+	for _, opts := range []Options{
+		{SentryDSN: "https://sentry.example.com/42"},
+		{SentryDSN: "https://key@sentry.example.com"},
+		{OTLPEndpoint: "otel-collector"},
+	} {
+		if _, err := Setup(opts, logger); err == nil {
+			t.Errorf("expected %+v to be invalid", opts)
+		}
+	}
+}
+
+func TestSentrySender(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	var lines []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		received <- r
+	}))
+	defer server.Close()
+
+	dsn := strings.Replace(server.URL, "http://", "http://public-key@", 1) + "/sentry/42"
+	s, err := newSentrySender(server.Client(), Options{SentryDSN: dsn, Environment: "production"})
+	if err != nil {
+		t.Fatalf("failed to parse DSN: %v", err)
+	}
+	err = s.send(context.Background(), Event{
+		Time: time.Now(), Message: "something broke", Panic: true, Stack: "goroutine 1",
+		Method: http.MethodPost, Route: "/v1/experiences", Status: 500, RequestID: "req-1",
+		TraceID: "4bf92f3577b34da6a3ce929d0e0e4736", SpanID: "00f067aa0ba902b7",
+	})
+	if err != nil {
+		t.Fatalf("failed to send: %v", err)
+	}
+
+	r := <-received
+	if r.URL.Path != "/sentry/api/42/envelope/" {
+		t.Errorf("expected the envelope endpoint of the project, got %s", r.URL.Path)
+	}
+	if auth := r.Header.Get("X-Sentry-Auth"); !strings.Contains(auth, "sentry_key=public-key") {
+		t.Errorf("expected the DSN key in the auth header, got %q", auth)
+	}
+	if len(lines) != 3 {
+		t.Fatalf("expected an envelope header, item header, and event, got %d lines", len(lines))
+	}
+	var event sentryEvent
+	if err := json.Unmarshal([]byte(lines[2]), &event); err != nil {
+		t.Fatalf("invalid event: %v", err)
+	}
+	if event.Level != "fatal" || event.Environment != "production" || event.Transaction != "POST /v1/experiences" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if event.Exception.Values[0].Value != "something broke" || event.Extra["stack"] != "goroutine 1" {
+		t.Errorf("expected the panic and stack, got %+v", event)
+	}
+	if event.Tags["request_id"] != "req-1" || event.Tags["http.status_code"] != "500" {
+		t.Errorf("expected the request tags, got %v", event.Tags)
+	}
+}
+
+func TestOTLPSender(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_HEADERS", "Authorization=Bearer%20token")
+	received := make(chan *http.Request, 1)
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		received <- r
+	}))
+	defer server.Close()
+
+	s, err := newOTLPSender(server.Client(), Options{OTLPEndpoint: server.URL, ServiceName: "formbricks-hub"})
+	if err != nil {
+		t.Fatalf("failed to create sender: %v", err)
+	}
+	err = s.send(context.Background(), Event{
+		Time: time.Now(), Message: "connection refused", Method: http.MethodGet,
+		Route: "/v1/experiences/{id}", Status: 500, TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+	})
+	if err != nil {
+		t.Fatalf("failed to send: %v", err)
+	}
+
+	r := <-received
+	if r.URL.Path != defaultLogsPath {
+		t.Errorf("expected the default logs path, got %s", r.URL.Path)
+	}
+	if auth := r.Header.Get("Authorization"); auth != "Bearer token" {
+		t.Errorf("expected the headers from OTEL_EXPORTER_OTLP_HEADERS, got %q", auth)
+	}
+	for _, want := range []string{
+		`"service.name"`, `"formbricks-hub"`, `"connection refused"`, `"severityText":"ERROR"`,
+		`"http.route"`, `"/v1/experiences/{id}"`, `"traceId":"4bf92f3577b34da6a3ce929d0e0e4736"`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected %s in the log record, got %s", want, body)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package errorreport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// requestState holds the error behind a request's 5xx response, recorded by SetError
+type requestState struct {
+	mu  sync.Mutex
+	err error
+}
+
+type stateKey struct{}
+
+// SetError records the internal error behind the 5xx response of the request in ctx, so
+// its report includes the cause instead of only the status. It does nothing outside of a
+// request served by Middleware.
+func SetError(ctx context.Context, err error) {
+	state, ok := ctx.Value(stateKey{}).(*requestState)
+	if !ok || err == nil {
+		return
+	}
+	state.mu.Lock()
+	state.err = err
+	state.mu.Unlock()
+}
+
+// Middleware reports panics and 5xx responses. It must run inside a recoverer, since it
+// re-panics after reporting. 503 responses without a recorded error (e.g., from /health
+// while draining) aren't reported.
+func (r *Reporter) Middleware(next http.Handler) http.Handler {
+	if r == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		state := &requestState{}
+		req = req.WithContext(context.WithValue(req.Context(), stateKey{}, state))
+		ww := middleware.NewWrapResponseWriter(w, req.ProtoMajor)
+
+		defer func() {
+			if p := recover(); p != nil {
+				// http.ErrAbortHandler aborts the response on purpose
+				if p != http.ErrAbortHandler {
+					e := requestEvent(req, http.StatusInternalServerError)
+					e.Panic = true
+					e.Message = fmt.Sprint(p)
+					e.Stack = string(debug.Stack())
+					r.Report(e)
+				}
+				panic(p)
+			}
+		}()
+		next.ServeHTTP(ww, req)
+
+		status := ww.Status()
+		if status < 500 {
+			return
+		}
+		state.mu.Lock()
+		err := state.err
+		state.mu.Unlock()
+		if err == nil && status == http.StatusServiceUnavailable {
+			return
+		}
+		e := requestEvent(req, status)
+		if err != nil {
+			e.Message = err.Error()
+		} else {
+			e.Message = fmt.Sprintf("%d %s", status, http.StatusText(status))
+		}
+		r.Report(e)
+	})
+}
+
+// requestEvent returns an event with the request context that is safe to report
+func requestEvent(req *http.Request, status int) Event {
+	e := Event{
+		Method:    req.Method,
+		Status:    status,
+		RequestID: middleware.GetReqID(req.Context()),
+	}
+	if rctx := chi.RouteContext(req.Context()); rctx != nil {
+		e.Route = rctx.RoutePattern()
+	}
+	if sc := trace.SpanContextFromContext(req.Context()); sc.IsValid() {
+		e.TraceID = sc.TraceID().String()
+		e.SpanID = sc.SpanID().String()
+	}
+	return e
+}
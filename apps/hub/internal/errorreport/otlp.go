@@ -0,0 +1,154 @@
+package errorreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// defaultLogsPath is the OTLP/HTTP path of log records
+const defaultLogsPath = "/v1/logs"
+
+// OTLP severity numbers
+const (
+	severityError = 17
+	severityFatal = 21
+)
+
+// otlpSender sends events as OTLP log records in the JSON encoding
+type otlpSender struct {
+	client   *http.Client
+	endpoint string
+	headers  map[string]string
+	resource []otlpAttribute
+}
+
+// newOTLPSender sends to the endpoint, adding the headers of the standard
+// OTEL_EXPORTER_OTLP_HEADERS variable (e.g., for authentication), like the trace exporter
+func newOTLPSender(client *http.Client, opts Options) (*otlpSender, error) {
+	endpointURL, err := url.Parse(opts.OTLPEndpoint)
+	if err != nil || endpointURL.Host == "" {
+		return nil, fmt.Errorf("invalid error reporting OTLP endpoint %q", opts.OTLPEndpoint)
+	}
+	if endpointURL.Path == "" || endpointURL.Path == "/" {
+		endpointURL.Path = defaultLogsPath
+	}
+
+	resource := []otlpAttribute{stringAttribute("service.name", opts.ServiceName)}
+	if opts.Environment != "" {
+		resource = append(resource, stringAttribute("deployment.environment.name", opts.Environment))
+	}
+	return &otlpSender{
+		client:   client,
+		endpoint: endpointURL.String(),
+		headers:  parseHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS")),
+		resource: resource,
+	}, nil
+}
+
+// parseHeaders parses a comma-separated list of URL-encoded key=value pairs
+func parseHeaders(s string) map[string]string {
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		key, keyErr := url.PathUnescape(strings.TrimSpace(key))
+		value, valueErr := url.PathUnescape(strings.TrimSpace(value))
+		if keyErr != nil || valueErr != nil || key == "" {
+			continue
+		}
+		headers[key] = value
+	}
+	return headers
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"` // int64 values are strings in OTLP/JSON
+}
+
+func stringAttribute(key, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func intAttribute(key string, value int) otlpAttribute {
+	s := strconv.Itoa(value)
+	return otlpAttribute{Key: key, Value: otlpValue{IntValue: &s}}
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           otlpValue       `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes"`
+	TraceID        string          `json:"traceId,omitempty"`
+	SpanID         string          `json:"spanId,omitempty"`
+}
+
+func (s *otlpSender) send(ctx context.Context, e Event) error {
+	record := otlpLogRecord{
+		TimeUnixNano:   strconv.FormatInt(e.Time.UnixNano(), 10),
+		SeverityNumber: severityError,
+		SeverityText:   "ERROR",
+		Body:           otlpValue{StringValue: &e.Message},
+		Attributes: []otlpAttribute{
+			stringAttribute("exception.type", "error"),
+			stringAttribute("exception.message", e.Message),
+			intAttribute("http.response.status_code", e.Status),
+		},
+		TraceID: e.TraceID,
+		SpanID:  e.SpanID,
+	}
+	if e.Panic {
+		record.SeverityNumber = severityFatal
+		record.SeverityText = "FATAL"
+		record.Attributes[0] = stringAttribute("exception.type", "panic")
+		record.Attributes = append(record.Attributes, stringAttribute("exception.stacktrace", e.Stack))
+	}
+	if e.Method != "" {
+		record.Attributes = append(record.Attributes, stringAttribute("http.request.method", e.Method))
+	}
+	if e.Route != "" {
+		record.Attributes = append(record.Attributes, stringAttribute("http.route", e.Route))
+	}
+	if e.RequestID != "" {
+		record.Attributes = append(record.Attributes, stringAttribute("request.id", e.RequestID))
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"resourceLogs": []any{map[string]any{
+			"resource": map[string]any{"attributes": s.resource},
+			"scopeLogs": []any{map[string]any{
+				"scope":      map[string]string{"name": "github.com/formbricks/hub/apps/hub/internal/errorreport"},
+				"logRecords": []otlpLogRecord{record},
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+	return post(s.client, req)
+}
@@ -0,0 +1,136 @@
+package errorreport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// sentrySender sends events to Sentry's envelope endpoint
+type sentrySender struct {
+	client      *http.Client
+	dsn         string
+	endpoint    string
+	auth        string
+	environment string
+}
+
+// newSentrySender parses a DSN of the form https://<key>@<host>[/<path>]/<project>
+func newSentrySender(client *http.Client, opts Options) (*sentrySender, error) {
+	u, err := url.Parse(opts.SentryDSN)
+	if err != nil || u.Host == "" || u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("invalid Sentry DSN: must be of the form https://<key>@<host>/<project>")
+	}
+	// The project is the last path segment; anything before it is a path prefix
+	path, project := "", strings.Trim(u.Path, "/")
+	if i := strings.LastIndex(project, "/"); i >= 0 {
+		path, project = "/"+project[:i], project[i+1:]
+	}
+	if project == "" {
+		return nil, fmt.Errorf("invalid Sentry DSN: missing project ID")
+	}
+
+	return &sentrySender{
+		client:   client,
+		dsn:      opts.SentryDSN,
+		endpoint: fmt.Sprintf("%s://%s%s/api/%s/envelope/", u.Scheme, u.Host, path, project),
+		auth: "Sentry sentry_version=7, sentry_client=formbricks-hub/1.0, sentry_key=" +
+			u.User.Username(),
+		environment: opts.Environment,
+	}, nil
+}
+
+// sentryEvent is the subset of Sentry's event payload that Hub sends
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	Logger      string            `json:"logger"`
+	Environment string            `json:"environment,omitempty"`
+	Transaction string            `json:"transaction,omitempty"`
+	Exception   sentryExceptions  `json:"exception"`
+	Tags        map[string]string `json:"tags"`
+	Contexts    map[string]any    `json:"contexts,omitempty"`
+	Extra       map[string]string `json:"extra,omitempty"`
+}
+
+type sentryExceptions struct {
+	Values []sentryException `json:"values"`
+}
+
+type sentryException struct {
+	Type      string          `json:"type"`
+	Value     string          `json:"value"`
+	Mechanism sentryMechanism `json:"mechanism"`
+}
+
+type sentryMechanism struct {
+	Type    string `json:"type"`
+	Handled bool   `json:"handled"`
+}
+
+func (s *sentrySender) send(ctx context.Context, e Event) error {
+	event := sentryEvent{
+		EventID:     strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Timestamp:   e.Time.UTC().Format(time.RFC3339Nano),
+		Platform:    "go",
+		Level:       "error",
+		Logger:      "hub",
+		Environment: s.environment,
+		Exception: sentryExceptions{Values: []sentryException{{
+			Type:      "error",
+			Value:     e.Message,
+			Mechanism: sentryMechanism{Type: "generic", Handled: true},
+		}}},
+		Tags: map[string]string{"http.status_code": strconv.Itoa(e.Status)},
+	}
+	if e.Panic {
+		event.Level = "fatal"
+		event.Exception.Values[0].Type = "panic"
+		event.Exception.Values[0].Mechanism = sentryMechanism{Type: "panic", Handled: false}
+		event.Extra = map[string]string{"stack": e.Stack}
+	}
+	if e.Route != "" {
+		event.Transaction = e.Method + " " + e.Route
+		event.Tags["http.route"] = e.Route
+	}
+	if e.Method != "" {
+		event.Tags["http.method"] = e.Method
+	}
+	if e.RequestID != "" {
+		event.Tags["request_id"] = e.RequestID
+	}
+	if e.TraceID != "" {
+		event.Contexts = map[string]any{"trace": map[string]string{"trace_id": e.TraceID, "span_id": e.SpanID}}
+	}
+
+	// An envelope is a header line followed by items, each with its own header line
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	if err := enc.Encode(map[string]string{"event_id": event.EventID, "dsn": s.dsn, "sent_at": time.Now().UTC().Format(time.RFC3339Nano)}); err != nil {
+		return err
+	}
+	if err := enc.Encode(map[string]string{"type": "event"}); err != nil {
+		return err
+	}
+	if err := enc.Encode(event); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", s.auth)
+	return post(s.client, req)
+}
@@ -0,0 +1,29 @@
+// Package experiencefilter defines the filter of the experience listing, which saved segments
+// store, so a named set of experiences such as enterprise detractors is defined once and
+// applied by analytics and listings alike. It has no dependencies so the filter can be stored
+// with the segment in the database.
+package experiencefilter
+
+// Filter selects experiences like the filters of GET /v1/experiences. Empty fields don't
+// filter. The query tags let listings accept the filter as query parameters.
+type Filter struct {
+	SourceType     string  `json:"source_type,omitempty" query:"source_type" doc:"Filter by source type"`
+	SourceID       string  `json:"source_id,omitempty" query:"source_id" doc:"Filter by source ID"`
+	FieldType      string  `json:"field_type,omitempty" query:"field_type" doc:"Filter by field type"`
+	QuestionID     string  `json:"question_id,omitempty" query:"question_id" doc:"Filter by question ID, including responses sent with other labels" format:"uuid"`
+	UserIdentifier string  `json:"user_identifier,omitempty" query:"user_identifier" doc:"Filter by user identifier"`
+	ContentHash    string  `json:"content_hash,omitempty" query:"content_hash" doc:"Filter by content hash, e.g. to find all copies of a response"`
+	Duplicate      string  `json:"duplicate,omitempty" query:"duplicate" enum:"true,false" doc:"Filter by whether the experience was flagged as a duplicate (see SERVICE_DUPLICATE_POLICY)"`
+	Country        string  `json:"country,omitempty" query:"country" doc:"Filter by country"`
+	Region         string  `json:"region,omitempty" query:"region" doc:"Filter by region"`
+	Device         string  `json:"device,omitempty" query:"device" doc:"Filter by device type"`
+	Platform       string  `json:"platform,omitempty" query:"platform" doc:"Filter by platform"`
+	AppVersion     string  `json:"app_version,omitempty" query:"app_version" doc:"Filter by app version"`
+	Metadata       string  `json:"metadata,omitempty" query:"metadata" doc:"Filter by metadata containing this JSON object, e.g. {\"plan\":\"pro\"} or {\"tags\":[\"beta\"]}"`
+	NPSCategory    string  `json:"nps_category,omitempty" query:"nps_category" enum:"promoter,passive,detractor" doc:"Filter nps responses by category"`
+	IsSpam         string  `json:"is_spam,omitempty" query:"is_spam" enum:"true,false" doc:"Filter by AI spam flag (true returns only flagged responses, false excludes them)"`
+	MinUrgency     float64 `json:"min_urgency,omitempty" query:"min_urgency" minimum:"0" maximum:"1" doc:"Filter by urgency_score >= min_urgency (0-1)"`
+	UrgencyReason  string  `json:"urgency_reason,omitempty" query:"urgency_reason" enum:"churn_risk,bug_report,legal_threat,security_issue,billing_issue,outage" doc:"Filter by urgency reason"`
+	Since          string  `json:"since,omitempty" query:"since" doc:"Filter by collected_at >= since (ISO 8601 format)"`
+	Until          string  `json:"until,omitempty" query:"until" doc:"Filter by collected_at <= until (ISO 8601 format)"`
+}
@@ -0,0 +1,55 @@
+// Package leader lets one of the Hub instances sharing a database run a background job,
+// such as the scheduled exports or backups, through a PostgreSQL advisory lock. The lock
+// is held on a connection taken out of the pool for as long as it is held, as advisory
+// locks belong to the session that took them.
+package leader
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Lock is an advisory lock held by this instance
+type Lock struct {
+	conn *sql.Conn
+	key  string // SQL expression of the lock key
+	args []any
+}
+
+// TryAcquire takes the advisory lock of key without waiting for it. It returns nil and no
+// error if another instance holds the lock.
+func TryAcquire(ctx context.Context, db *sql.DB, key int64) (*Lock, error) {
+	return tryAcquire(ctx, db, "$1", key)
+}
+
+// TryAcquireNamed is TryAcquire for one lock per name, such as a lock per destination,
+// keyed by the hash of the name seeded with seed
+func TryAcquireNamed(ctx context.Context, db *sql.DB, name string, seed int64) (*Lock, error) {
+	return tryAcquire(ctx, db, "hashtextextended($1, $2)", name, seed)
+}
+
+func tryAcquire(ctx context.Context, db *sql.DB, key string, args ...any) (*Lock, error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock("+key+")", args...).Scan(&locked); err != nil || !locked {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &Lock{conn: conn, key: key, args: args}, nil
+}
+
+// Held reports whether the lock is still held. It is lost when its connection breaks, and
+// then taken by the next instance trying to acquire it.
+func (l *Lock) Held(ctx context.Context) bool {
+	return l.conn.PingContext(ctx) == nil
+}
+
+// Release gives up the lock, so another instance takes over, and returns its connection
+// to the pool
+func (l *Lock) Release() {
+	_, _ = l.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock("+l.key+")", l.args...)
+	_ = l.conn.Close()
+}
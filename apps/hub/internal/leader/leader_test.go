@@ -0,0 +1,68 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/lib/pq"
+
+	"github.com/formbricks/hub/apps/hub/internal/testdb"
+)
+
+func TestLock(t *testing.T) {
+	_, connStr, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Each lock is held on a connection of its own, so one pool stands in for two instances
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = db.Close() }()
+
+	acquire := func(key int64) *Lock {
+		t.Helper()
+		lock, err := TryAcquire(ctx, db, key)
+		if err != nil {
+			t.Fatalf("TryAcquire() error = %v", err)
+		}
+		return lock
+	}
+
+	first := acquire(1)
+	if first == nil || !first.Held(ctx) {
+		t.Fatal("expected to acquire a free lock")
+	}
+	if other := acquire(1); other != nil {
+		t.Fatal("expected the lock to be held by the first instance")
+	}
+	if other := acquire(2); other == nil {
+		t.Error("expected other keys to be free")
+	} else {
+		other.Release()
+	}
+
+	first.Release()
+	second := acquire(1)
+	if second == nil {
+		t.Fatal("expected the lock to be free after Release")
+	}
+	second.Release()
+
+	// Named locks are held per name
+	named, err := TryAcquireNamed(ctx, db, "bigquery.experiences", 1)
+	if err != nil || named == nil {
+		t.Fatalf("TryAcquireNamed() = %v, %v; want a lock", named, err)
+	}
+	defer named.Release()
+	if lock, err := TryAcquireNamed(ctx, db, "bigquery.experiences", 1); err != nil || lock != nil {
+		t.Errorf("TryAcquireNamed() = %v, %v; want the lock to be held", lock, err)
+	}
+	if lock, err := TryAcquireNamed(ctx, db, "sheets.experiences", 1); err != nil || lock == nil {
+		t.Errorf("TryAcquireNamed() = %v, %v; want the lock of another name", lock, err)
+	} else {
+		lock.Release()
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/formbricks/hub/apps/hub/internal/audit"
+)
+
+// Audit creates a middleware that records every mutating call (POST, PUT, PATCH, DELETE)
+// in the audit log, including calls rejected by authentication or validation. Entries carry
+// the caller's actor, the request ID, and the IDs of the webhook events the call emitted.
+// Register it before authentication, so rejected calls are recorded too. Failing to record
+// an entry is logged but doesn't fail the call.
+func Audit(log audit.Log, logger *slog.Logger) func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		switch ctx.Method() {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			next(ctx)
+			return
+		}
+
+		ctx = huma.WithContext(ctx, audit.WithTrail(ctx.Context()))
+		next(ctx)
+
+		entry := audit.Entry{
+			Actor:     audit.APIKeyActor(ctx.Header("X-API-Key")),
+			RequestID: middleware.GetReqID(ctx.Context()),
+			Method:    ctx.Method(),
+			Path:      ctx.URL().Path,
+			Status:    ctx.Status(),
+			EventIDs:  audit.Events(ctx.Context()),
+		}
+		summary := entry.Method + " " + entry.Path
+		if op := ctx.Operation(); op != nil {
+			entry.Operation = op.OperationID
+			entry.ResourceID = ctx.Param("id")
+			if op.Summary != "" {
+				summary = op.Summary
+			}
+		}
+		if entry.ResourceID != "" {
+			summary += " " + entry.ResourceID
+		}
+		entry.Summary = fmt.Sprintf("%s: %d %s", summary, entry.Status, http.StatusText(entry.Status))
+
+		// Record the entry even if the client has gone away
+		if err := log.Record(context.WithoutCancel(ctx.Context()), entry); err != nil {
+			logger.Error("failed to record audit entry",
+				"request_id", entry.RequestID,
+				"operation", entry.Operation,
+				"error", err)
+		}
+	}
+}
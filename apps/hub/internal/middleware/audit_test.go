@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+
+	"github.com/formbricks/hub/apps/hub/internal/audit"
+)
+
+// memoryAuditLog collects audit entries
+type memoryAuditLog struct {
+	entries []audit.Entry
+}
+
+func (l *memoryAuditLog) Record(_ context.Context, entry audit.Entry) error {
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func TestAudit(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	log := &memoryAuditLog{}
+
+	_, api := humatest.New(t)
+	api.UseMiddleware(Audit(log, logger))
+
+	huma.Register(api, huma.Operation{OperationID: "update-item", Method: http.MethodPatch, Path: "/items/{id}", Summary: "Update item"},
+		func(ctx context.Context, input *struct {
+			ID string `path:"id"`
+		}) (*struct{}, error) {
+			audit.RecordEvent(ctx, "event-1")
+			return nil, nil
+		})
+	huma.Register(api, huma.Operation{OperationID: "get-item", Method: http.MethodGet, Path: "/items/{id}"},
+		func(ctx context.Context, input *struct {
+			ID string `path:"id"`
+		}) (*struct{}, error) {
+			return nil, nil
+		})
+
+	api.Get("/items/42")
+	if len(log.entries) != 0 {
+		t.Fatalf("expected reads not to be audited, got %d entries", len(log.entries))
+	}
+
+	api.Patch("/items/42", "X-API-Key: secret", map[string]any{})
+	if len(log.entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(log.entries))
+	}
+	entry := log.entries[0]
+	if entry.Actor != audit.APIKeyActor("secret") || !strings.HasPrefix(entry.Actor, "api_key:") {
+		t.Errorf("unexpected actor %q", entry.Actor)
+	}
+	if strings.Contains(entry.Actor, "secret") {
+		t.Error("expected the actor not to contain the API key")
+	}
+	if entry.Operation != "update-item" || entry.ResourceID != "42" || entry.Status != http.StatusNoContent {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+	if entry.Summary != "Update item 42: 204 No Content" {
+		t.Errorf("unexpected summary %q", entry.Summary)
+	}
+	if len(entry.EventIDs) != 1 || entry.EventIDs[0] != "event-1" {
+		t.Errorf("expected the emitted event to be correlated, got %v", entry.EventIDs)
+	}
+
+	api.Patch("/items/43", map[string]any{})
+	if actor := log.entries[1].Actor; actor != audit.AnonymousActor {
+		t.Errorf("expected an anonymous actor, got %q", actor)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/problem"
 )
 
 // APIKeyAuth creates a middleware that validates API key authentication.
@@ -36,6 +38,27 @@ func APIKeyAuth(api huma.API, apiKey string) func(ctx huma.Context, next func(hu
 	}
 }
 
+// RequireAPIKey creates a router middleware that validates API key authentication for
+// routes served outside of Huma, such as the WebSocket event stream. Clients that can't set
+// headers (browsers opening a WebSocket) may pass the key in the "api_key" query parameter.
+func RequireAPIKey(apiKey string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			providedKey := r.Header.Get("X-API-Key")
+			if providedKey == "" {
+				providedKey = r.URL.Query().Get("api_key")
+			}
+
+			if !secureCompare(providedKey, apiKey) {
+				problem.Write(w, http.StatusUnauthorized, problem.CodeUnauthorized, "Invalid or missing API key")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // secureCompare performs a constant-time comparison of two strings to prevent timing attacks.
 // Returns true if the strings are equal, false otherwise.
 // Pads inputs to equal length to avoid leaking information about the expected key length.
@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/formbricks/hub/apps/hub/internal/audit"
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// authClient tracks the failed authentication attempts of a client IP
+type authClient struct {
+	failures     int       // Failures since firstFailure
+	firstFailure time.Time // Start of the current failure window
+	lockouts     int       // Lockouts so far, which make the next one longer
+	lockedUntil  time.Time
+	lastFailure  time.Time
+}
+
+// AuthThrottle locks out client IPs that repeatedly fail API key authentication, to slow
+// down brute-force attempts. After maxFailures failures within the window, the IP is
+// blocked for the lockout duration, which doubles with every further lockout up to
+// maxLockout. A successful request resets the failure count.
+//
+// Guesses spread over many IPs are caught by a global budget: once more than
+// globalMaxFailures attempts of all clients failed within the window, every further
+// failure locks out its IP right away. Clients that present a valid key are never blocked
+// unless their own IP failed.
+type AuthThrottle struct {
+	maxFailures       int
+	globalMaxFailures int
+	window            time.Duration
+	lockout           time.Duration
+	maxLockout        time.Duration
+
+	mu      sync.Mutex
+	clients map[string]*authClient
+	global  authClient // Failures of all clients
+
+	auditLog audit.Log
+	logger   *slog.Logger
+}
+
+// NewAuthThrottle creates an auth throttle. A globalMaxFailures of 0 disables the global
+// budget.
+func NewAuthThrottle(maxFailures, globalMaxFailures int, window, lockout, maxLockout time.Duration, logger *slog.Logger) *AuthThrottle {
+	t := &AuthThrottle{
+		maxFailures:       maxFailures,
+		globalMaxFailures: globalMaxFailures,
+		window:            window,
+		lockout:           lockout,
+		maxLockout:        max(lockout, maxLockout),
+		clients:           make(map[string]*authClient),
+		logger:            logger,
+	}
+
+	// Start background cleanup goroutine to forget clients that stopped failing
+	go t.cleanupStaleClients()
+
+	return t
+}
+
+// SetAuditLog records every lockout in the audit log. It must be called before the
+// middleware serves requests.
+func (t *AuthThrottle) SetAuditLog(log audit.Log) {
+	t.auditLog = log
+}
+
+// Middleware returns an http.Handler middleware that rejects locked out IPs with 429 Too Many
+// Requests before their key is checked, and counts 401 responses as failed attempts. It must
+// wrap all routes that check API keys.
+func (t *AuthThrottle) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := getClientIP(r)
+
+			if retryAfter := t.lockedFor(ip); retryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				problem.Write(w, http.StatusTooManyRequests, problem.CodeAuthLockedOut, "Too many failed authentication attempts. Please try again later.")
+				return
+			}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			switch status := ww.Status(); {
+			case status == http.StatusUnauthorized:
+				if lockout := t.fail(ip); lockout > 0 {
+					t.recordLockout(r, ip, lockout)
+				}
+			case status > 0 && status < http.StatusBadRequest:
+				t.succeed(ip)
+			}
+		})
+	}
+}
+
+// lockedFor returns how long the IP remains locked out, or 0 if it isn't
+func (t *AuthThrottle) lockedFor(ip string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	client, ok := t.clients[ip]
+	if !ok {
+		return 0
+	}
+	return max(0, time.Until(client.lockedUntil))
+}
+
+// fail counts a failed attempt and returns the lockout it started, or 0 if it didn't start one
+func (t *AuthThrottle) fail(ip string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	client, ok := t.clients[ip]
+	if !ok {
+		client = &authClient{}
+		t.clients[ip] = client
+	}
+	for _, c := range []*authClient{client, &t.global} {
+		if now.Sub(c.firstFailure) > t.window {
+			c.failures = 0
+			c.firstFailure = now
+		}
+		c.failures++
+		c.lastFailure = now
+	}
+
+	// With the global budget used up, a single failure locks out the IP
+	if client.failures < t.maxFailures && (t.globalMaxFailures <= 0 || t.global.failures <= t.globalMaxFailures) {
+		return 0
+	}
+
+	// Double the lockout with every lockout of the client
+	lockout := t.lockout << min(client.lockouts, 30)
+	if lockout <= 0 || lockout > t.maxLockout {
+		lockout = t.maxLockout
+	}
+	client.lockouts++
+	client.failures = 0
+	client.lockedUntil = now.Add(lockout)
+	return lockout
+}
+
+// succeed resets the failure count of the IP; earlier lockouts still lengthen the next one
+func (t *AuthThrottle) succeed(ip string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if client, ok := t.clients[ip]; ok {
+		client.failures = 0
+	}
+}
+
+// recordLockout logs a lockout and records it in the audit log
+func (t *AuthThrottle) recordLockout(r *http.Request, ip string, lockout time.Duration) {
+	t.logger.Warn("client locked out after failed authentication attempts",
+		"ip", ip,
+		"failures", t.maxFailures,
+		"lockout", lockout.String(),
+		"path", r.URL.Path)
+
+	if t.auditLog == nil {
+		return
+	}
+	providedKey := r.Header.Get("X-API-Key")
+	if providedKey == "" {
+		providedKey = r.URL.Query().Get("api_key")
+	}
+	entry := audit.Entry{
+		Actor:     audit.APIKeyActor(providedKey),
+		RequestID: middleware.GetReqID(r.Context()),
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Operation: "auth-lockout",
+		Status:    http.StatusUnauthorized,
+		Summary:   fmt.Sprintf("Locked out %s for %s after %d failed authentication attempts", ip, lockout, t.maxFailures),
+	}
+	if err := t.auditLog.Record(context.WithoutCancel(r.Context()), entry); err != nil {
+		t.logger.Error("failed to record audit entry",
+			"operation", entry.Operation,
+			"error", err)
+	}
+}
+
+// cleanupStaleClients periodically forgets clients without recent failures or lockouts
+func (t *AuthThrottle) cleanupStaleClients() {
+	ticker := time.NewTicker(5 * time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		t.mu.Lock()
+		now := time.Now()
+		// Keep clients long enough that a returning attacker gets the escalated lockout
+		staleThreshold := max(t.window, 2*t.maxLockout)
+
+		for ip, client := range t.clients {
+			if now.After(client.lockedUntil) && now.Sub(client.lastFailure) > staleThreshold {
+				delete(t.clients, ip)
+			}
+		}
+		t.mu.Unlock()
+	}
+}
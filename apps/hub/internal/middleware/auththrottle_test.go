@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAuthThrottle(t *testing.T) {
+	throttle := NewAuthThrottle(3, 0, time.Minute, time.Minute, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	log := &memoryAuditLog{}
+	throttle.SetAuditLog(log)
+	handler := throttle.Middleware()(RequireAPIKey("secret")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	serve := func(ip, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set("X-API-Key", key)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// A success resets the failure count
+	serve("203.0.113.1", "wrong")
+	serve("203.0.113.1", "wrong")
+	if code := serve("203.0.113.1", "secret").Code; code != http.StatusOK {
+		t.Fatalf("expected the valid key to be accepted, got %d", code)
+	}
+
+	for i := 0; i < 3; i++ {
+		if code := serve("203.0.113.1", "wrong").Code; code != http.StatusUnauthorized {
+			t.Fatalf("attempt %d: expected 401, got %d", i+1, code)
+		}
+	}
+	rec := serve("203.0.113.1", "secret")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "60" {
+		t.Fatalf("expected a 60s lockout even with the valid key, got %d (Retry-After %q)", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	if code := serve("198.51.100.7", "secret").Code; code != http.StatusOK {
+		t.Errorf("expected other IPs not to be locked out, got %d", code)
+	}
+	if len(log.entries) != 1 || log.entries[0].Operation != "auth-lockout" {
+		t.Errorf("expected the lockout to be audited, got %+v", log.entries)
+	}
+
+	// The next lockout of the same IP is twice as long
+	throttle.clients["203.0.113.1"].lockedUntil = time.Now()
+	for i := 0; i < 3; i++ {
+		serve("203.0.113.1", "wrong")
+	}
+	if retryAfter := serve("203.0.113.1", "wrong").Header().Get("Retry-After"); retryAfter != "120" {
+		t.Errorf("expected an escalated lockout of 120s, got %q", retryAfter)
+	}
+}
+
+func TestAuthThrottleGlobalBudget(t *testing.T) {
+	throttle := NewAuthThrottle(3, 4, time.Minute, time.Minute, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	handler := throttle.Middleware()(RequireAPIKey("hub_live_secret")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	serve := func(ip, key string) int {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set("X-API-Key", key)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// Guesses at the real key's prefix, each from another IP, use up the global budget
+	for i := 1; i <= 4; i++ {
+		if code := serve(fmt.Sprintf("203.0.113.%d", i), fmt.Sprintf("hub_live_guess%d", i)); code != http.StatusUnauthorized {
+			t.Fatalf("guess %d: expected 401, got %d", i, code)
+		}
+	}
+
+	// Beyond the budget, a single failure locks out the IP
+	serve("203.0.113.50", "hub_live_guess50")
+	if code := serve("203.0.113.50", "hub_live_secret"); code != http.StatusTooManyRequests {
+		t.Errorf("expected the IP to be locked out after one failure, got %d", code)
+	}
+
+	// The valid key still works from other IPs, even with the prefix of the guesses
+	if code := serve("198.51.100.7", "hub_live_secret"); code != http.StatusOK {
+		t.Errorf("expected the valid key to be accepted, got %d", code)
+	}
+	if code := serve("203.0.113.2", "hub_live_secret"); code != http.StatusOK {
+		t.Errorf("expected the valid key to be accepted from an IP that isn't locked out, got %d", code)
+	}
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/formbricks/hub/apps/hub/internal/cache"
+)
+
+// maxCachedResponseSize is the largest response body that is cached
+const maxCachedResponseSize = 1024 * 1024 // 1MB
+
+// cachedResponse is a successful response stored in the cache
+type cachedResponse struct {
+	Headers [][2]string `json:"headers"`
+	Body    []byte      `json:"body"`
+}
+
+// ResponseCache creates a middleware that serves repeated requests to the given operations
+// from the cache. Only successful responses are cached, keyed by path, query, and the Accept
+// header, and the X-Cache header tells whether a response was a HIT or a MISS. Cache
+// failures never fail a request, they only skip the cache.
+// Register it after authentication, so cached responses are only served to authorized clients.
+func ResponseCache(c *cache.Cache, operations []string, logger *slog.Logger) func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		if ctx.Method() != http.MethodGet || ctx.Operation() == nil || !slices.Contains(operations, ctx.Operation().OperationID) {
+			next(ctx)
+			return
+		}
+
+		key := responseCacheKey(ctx)
+		generation, err := c.Generation(ctx.Context())
+		if err != nil {
+			logger.Warn("response cache unavailable", "error", err)
+			next(ctx)
+			return
+		}
+
+		if data, found, err := c.Get(ctx.Context(), generation, key); err != nil {
+			logger.Warn("failed to read cached response", "error", err)
+		} else if found {
+			var cached cachedResponse
+			if err := json.Unmarshal(data, &cached); err == nil {
+				for _, header := range cached.Headers {
+					ctx.AppendHeader(header[0], header[1])
+				}
+				ctx.SetHeader("X-Cache", "HIT")
+				ctx.SetStatus(http.StatusOK)
+				_, _ = ctx.BodyWriter().Write(cached.Body)
+				return
+			}
+		}
+
+		ctx.SetHeader("X-Cache", "MISS")
+		recorder := &responseRecorder{humaContext: ctx}
+		next(recorder)
+
+		if recorder.status != http.StatusOK || recorder.overflow {
+			return
+		}
+		data, err := json.Marshal(cachedResponse{Headers: recorder.headers, Body: recorder.body.Bytes()})
+		if err != nil {
+			return
+		}
+		if err := c.Set(ctx.Context(), generation, key, data); err != nil {
+			logger.Warn("failed to cache response", "error", err)
+		}
+	}
+}
+
+// responseCacheKey identifies a request by its path, sorted query, and accepted format
+func responseCacheKey(ctx huma.Context) string {
+	u := ctx.URL()
+	// Encode sorts the parameters, so their order doesn't matter
+	query := url.Values(u.Query()).Encode()
+	return ctx.Operation().OperationID + " " + u.Path + "?" + query + " " + ctx.Header("Accept")
+}
+
+// humaContext lets responseRecorder embed huma.Context, whose name clashes with its Context method
+type humaContext = huma.Context
+
+// responseRecorder captures the response written by a handler while passing it through
+type responseRecorder struct {
+	humaContext
+	status   int
+	headers  [][2]string
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (r *responseRecorder) SetStatus(code int) {
+	r.status = code
+	r.humaContext.SetStatus(code)
+}
+
+func (r *responseRecorder) SetHeader(name, value string) {
+	r.headers = slices.DeleteFunc(r.headers, func(header [2]string) bool {
+		return http.CanonicalHeaderKey(header[0]) == http.CanonicalHeaderKey(name)
+	})
+	r.headers = append(r.headers, [2]string{name, value})
+	r.humaContext.SetHeader(name, value)
+}
+
+func (r *responseRecorder) AppendHeader(name, value string) {
+	r.headers = append(r.headers, [2]string{name, value})
+	r.humaContext.AppendHeader(name, value)
+}
+
+func (r *responseRecorder) BodyWriter() io.Writer {
+	return recorderWriter{recorder: r, w: r.humaContext.BodyWriter()}
+}
+
+// recorderWriter writes the body to the client and records it until it's too large to cache
+type recorderWriter struct {
+	recorder *responseRecorder
+	w        io.Writer
+}
+
+func (w recorderWriter) Write(p []byte) (int, error) {
+	if !w.recorder.overflow {
+		if w.recorder.body.Len()+len(p) > maxCachedResponseSize {
+			w.recorder.overflow = true
+			w.recorder.body.Reset()
+		} else {
+			w.recorder.body.Write(p)
+		}
+	}
+	return w.w.Write(p)
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+
+	"github.com/formbricks/hub/apps/hub/internal/cache"
+)
+
+func TestResponseCache(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	c := cache.New(cache.NewMemoryStore(10), time.Minute, logger)
+
+	_, api := humatest.New(t)
+	api.UseMiddleware(ResponseCache(c, []string{"list-items"}, logger))
+
+	type output struct {
+		Body struct {
+			Calls int `json:"calls"`
+		}
+	}
+	calls := 0
+	huma.Register(api, huma.Operation{OperationID: "list-items", Method: http.MethodGet, Path: "/items"},
+		func(ctx context.Context, input *struct {
+			Limit int `query:"limit"`
+		}) (*output, error) {
+			calls++
+			out := &output{}
+			out.Body.Calls = calls
+			return out, nil
+		})
+
+	first := api.Get("/items?limit=5")
+	if first.Code != http.StatusOK || first.Header().Get("X-Cache") != "MISS" {
+		t.Fatalf("expected an uncached response, got %d %q", first.Code, first.Header().Get("X-Cache"))
+	}
+	second := api.Get("/items?limit=5")
+	if second.Header().Get("X-Cache") != "HIT" || second.Body.String() != first.Body.String() {
+		t.Fatalf("expected the cached response, got %q %s", second.Header().Get("X-Cache"), second.Body.String())
+	}
+	if second.Header().Get("Content-Type") != first.Header().Get("Content-Type") {
+		t.Errorf("expected the cached content type %q, got %q", first.Header().Get("Content-Type"), second.Header().Get("Content-Type"))
+	}
+	if calls != 1 {
+		t.Errorf("expected the handler to run once, ran %d times", calls)
+	}
+
+	// Other queries are cached separately
+	if resp := api.Get("/items?limit=6"); resp.Header().Get("X-Cache") != "MISS" {
+		t.Errorf("expected a different query to miss, got %q", resp.Header().Get("X-Cache"))
+	}
+
+	if err := c.Invalidate(context.Background()); err != nil {
+		t.Fatalf("invalidate: %v", err)
+	}
+	if resp := api.Get("/items?limit=5"); resp.Header().Get("X-Cache") != "MISS" {
+		t.Errorf("expected a miss after invalidation, got %q", resp.Header().Get("X-Cache"))
+	}
+	if calls != 3 {
+		t.Errorf("expected the handler to run 3 times, ran %d times", calls)
+	}
+}
@@ -4,9 +4,14 @@
 //
 // Available middleware:
 //   - APIKeyAuth: Optional API key authentication via X-API-Key header
+//   - RequireAPIKey: The same check for routes served outside of Huma
+//   - AuthThrottle: Escalating lockouts of IPs that repeatedly fail API key authentication
 //   - Logging: Structured request/response logging with slog
 //   - MaxBodySize: Limits request body size to prevent memory exhaustion
-//   - RateLimiter: Token bucket rate limiting per-IP and globally
+//   - BodySizeLimits: The same limit with per-route overrides
+//   - RateLimiter: Token bucket rate limiting per-IP (optionally per route class) and globally
+//   - Timeouts: Per-route-class request deadlines answered with 504 Gateway Timeout
+//   - SecurityHeaders: Hardened response headers (CSP, HSTS, framing, sniffing, referrer)
 package middleware
 
 import (
@@ -24,3 +29,31 @@ func MaxBodySize(maxBytes int64) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// BodySizeLimit is the maximum request body size of the routes matching a pattern
+type BodySizeLimit struct {
+	Pattern  string // "[METHOD ]/path", where * matches a single path segment
+	MaxBytes int64
+}
+
+// BodySizeLimits returns a middleware that limits request bodies to the size of the first
+// limit whose pattern matches the request, or to defaultMax.
+func BodySizeLimits(defaultMax int64, limits []BodySizeLimit) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, MaxBodyBytes(defaultMax, limits, r))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// MaxBodyBytes returns the body size limit of a request. Route templates such as
+// /v1/experiences/{id} can be passed as the request path, since * matches their parameters.
+func MaxBodyBytes(defaultMax int64, limits []BodySizeLimit, r *http.Request) int64 {
+	for _, limit := range limits {
+		if matchRoute(limit.Pattern, r) {
+			return limit.MaxBytes
+		}
+	}
+	return defaultMax
+}
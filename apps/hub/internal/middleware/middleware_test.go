@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestBodySizeLimits(t *testing.T) {
+	limits := []BodySizeLimit{
+		{Pattern: "POST /v1/experiences", MaxBytes: 8},
+		{Pattern: "/v1/experiences/*", MaxBytes: 16},
+	}
+	handler := BodySizeLimits(32, limits)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		method string
+		path   string
+		size   int
+		want   int
+	}{
+		{http.MethodPost, "/v1/experiences", 8, http.StatusOK},
+		{http.MethodPost, "/v1/experiences", 9, http.StatusRequestEntityTooLarge},
+		{http.MethodPatch, "/v1/experiences/abc", 16, http.StatusOK},
+		{http.MethodPatch, "/v1/experiences/abc", 17, http.StatusRequestEntityTooLarge},
+		{http.MethodPost, "/v1/webhooks", 32, http.StatusOK},
+		{http.MethodPost, "/v1/webhooks", 33, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(strings.Repeat("x", tt.size))))
+		if rec.Code != tt.want {
+			t.Errorf("%s %s with %d bytes: expected %d, got %d", tt.method, tt.path, tt.size, tt.want, rec.Code)
+		}
+	}
+
+	// Route templates match the patterns of their requests
+	route := &http.Request{Method: http.MethodPatch, URL: &url.URL{Path: "/v1/experiences/{id}"}}
+	if got := MaxBodyBytes(32, limits, route); got != 16 {
+		t.Errorf("expected the route template to get the 16 byte limit, got %d", got)
+	}
+}
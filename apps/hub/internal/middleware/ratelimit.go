@@ -4,10 +4,14 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"path"
+	"strings"
 	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
+
+	"github.com/formbricks/hub/apps/hub/internal/problem"
 )
 
 // ipLimiterEntry holds a rate limiter and its last access time for eviction
@@ -16,9 +20,19 @@ type ipLimiterEntry struct {
 	lastAccess time.Time
 }
 
+// RouteClass gives the routes matching one of its patterns their own per-IP limit, so that
+// expensive requests (search, AI) can be limited more strictly than plain reads.
+// Patterns are "[METHOD ]/path", where * matches a single path segment.
+type RouteClass struct {
+	Name     string
+	PerIP    int
+	Burst    int
+	Patterns []string
+}
+
 // RateLimiter implements per-IP and global rate limiting using token bucket algorithm
 type RateLimiter struct {
-	// Per-IP limiters with TTL tracking
+	// Per-IP limiters with TTL tracking, keyed by IP and route class
 	ipLimiters map[string]*ipLimiterEntry
 	mu         sync.RWMutex
 	perIPRate  rate.Limit
@@ -27,6 +41,10 @@ type RateLimiter struct {
 	// Global limiter
 	globalLimiter *rate.Limiter
 
+	// Paths that are never limited and routes with their own per-IP limits
+	exempt  []string
+	classes []RouteClass
+
 	logger *slog.Logger
 }
 
@@ -46,12 +64,96 @@ func NewRateLimiter(perIPRate, perIPBurst, globalRate, globalBurst int, logger *
 	return rl
 }
 
-// getLimiter returns the rate limiter for a specific IP, creating one if it doesn't exist
-func (rl *RateLimiter) getLimiter(ip string) *rate.Limiter {
+// SetLimits changes the default per-IP and global limits of a running rate limiter. Per-IP
+// limiters start over with the new limits.
+func (rl *RateLimiter) SetLimits(perIPRate, perIPBurst, globalRate, globalBurst int) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.perIPRate = rate.Limit(perIPRate)
+	rl.perIPBurst = perIPBurst
+	rl.globalLimiter.SetLimit(rate.Limit(globalRate))
+	rl.globalLimiter.SetBurst(globalBurst)
+	rl.ipLimiters = make(map[string]*ipLimiterEntry)
+}
+
+// SetExemptPaths excludes requests matching one of the patterns (e.g. /health) from rate limiting
+func (rl *RateLimiter) SetExemptPaths(patterns []string) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.exempt = patterns
+}
+
+// SetRouteClasses limits the routes of each class per IP with the class's limit instead of
+// the default one. Classes without a limit are ignored, and the first matching class applies.
+// Requests of all classes still count towards the global limit. Per-IP limiters start over
+// with the new classes.
+func (rl *RateLimiter) SetRouteClasses(classes []RouteClass) {
+	var active []RouteClass
+	for _, class := range classes {
+		if class.PerIP > 0 && len(class.Patterns) > 0 {
+			active = append(active, class)
+		}
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.classes = active
+	rl.ipLimiters = make(map[string]*ipLimiterEntry)
+}
+
+// routeClass returns the class of the request, or nil if the default per-IP limit applies
+func (rl *RateLimiter) routeClass(r *http.Request) *RouteClass {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	for i := range rl.classes {
+		for _, pattern := range rl.classes[i].Patterns {
+			if matchRoute(pattern, r) {
+				class := rl.classes[i]
+				return &class
+			}
+		}
+	}
+	return nil
+}
+
+// defaultLimit returns the default per-IP limit and burst
+func (rl *RateLimiter) defaultLimit() (rate.Limit, int) {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return rl.perIPRate, rl.perIPBurst
+}
+
+// isExempt reports whether the request is excluded from rate limiting
+func (rl *RateLimiter) isExempt(r *http.Request) bool {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	for _, pattern := range rl.exempt {
+		if matchRoute(pattern, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchRoute reports whether the request matches a "[METHOD ]/path" pattern
+func matchRoute(pattern string, r *http.Request) bool {
+	method, pathPattern, found := strings.Cut(strings.TrimSpace(pattern), " ")
+	if !found {
+		method, pathPattern = "", method
+	} else if !strings.EqualFold(method, r.Method) {
+		return false
+	}
+
+	matched, err := path.Match(strings.TrimSpace(pathPattern), r.URL.Path)
+	return err == nil && matched
+}
+
+// getLimiter returns the rate limiter for a specific key (IP and route class), creating one if it doesn't exist
+func (rl *RateLimiter) getLimiter(key string, limit rate.Limit, burst int) *rate.Limiter {
 	now := time.Now()
 
 	rl.mu.RLock()
-	entry, exists := rl.ipLimiters[ip]
+	entry, exists := rl.ipLimiters[key]
 	rl.mu.RUnlock()
 
 	if exists {
@@ -62,18 +164,18 @@ func (rl *RateLimiter) getLimiter(ip string) *rate.Limiter {
 		return entry.limiter
 	}
 
-	// Create new limiter for this IP
+	// Create new limiter for this key
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
 	// Double-check after acquiring write lock
-	if entry, exists := rl.ipLimiters[ip]; exists {
+	if entry, exists := rl.ipLimiters[key]; exists {
 		entry.lastAccess = now
 		return entry.limiter
 	}
 
-	limiter := rate.NewLimiter(rl.perIPRate, rl.perIPBurst)
-	rl.ipLimiters[ip] = &ipLimiterEntry{
+	limiter := rate.NewLimiter(limit, burst)
+	rl.ipLimiters[key] = &ipLimiterEntry{
 		limiter:    limiter,
 		lastAccess: now,
 	}
@@ -91,9 +193,9 @@ func (rl *RateLimiter) cleanupStaleIPs() {
 		now := time.Now()
 		staleThreshold := 10 * time.Minute
 
-		for ip, entry := range rl.ipLimiters {
+		for key, entry := range rl.ipLimiters {
 			if now.Sub(entry.lastAccess) > staleThreshold {
-				delete(rl.ipLimiters, ip)
+				delete(rl.ipLimiters, key)
 			}
 		}
 		rl.mu.Unlock()
@@ -104,6 +206,11 @@ func (rl *RateLimiter) cleanupStaleIPs() {
 func (rl *RateLimiter) Middleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if rl.isExempt(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			// Extract IP address
 			ip := getClientIP(r)
 
@@ -114,21 +221,28 @@ func (rl *RateLimiter) Middleware() func(http.Handler) http.Handler {
 					"path", r.URL.Path,
 					"method", r.Method)
 
-				w.Header().Set("Content-Type", "application/json")
-				http.Error(w, `{"error":"Rate limit exceeded. Too many requests globally. Please try again later."}`, http.StatusTooManyRequests)
+				problem.Write(w, http.StatusTooManyRequests, problem.CodeRateLimited, "Rate limit exceeded. Too many requests globally. Please try again later.")
 				return
 			}
 
-			// Check per-IP rate limit
-			limiter := rl.getLimiter(ip)
+			// Check per-IP rate limit, using the route class's limit if the route has one
+			class := "default"
+			var limiter *rate.Limiter
+			if rc := rl.routeClass(r); rc != nil {
+				class = rc.Name
+				limiter = rl.getLimiter(rc.Name+"|"+ip, rate.Limit(rc.PerIP), max(1, rc.Burst))
+			} else {
+				limit, burst := rl.defaultLimit()
+				limiter = rl.getLimiter(ip, limit, burst)
+			}
 			if !limiter.Allow() {
 				rl.logger.Warn("per-IP rate limit exceeded",
 					"ip", ip,
 					"path", r.URL.Path,
-					"method", r.Method)
+					"method", r.Method,
+					"route_class", class)
 
-				w.Header().Set("Content-Type", "application/json")
-				http.Error(w, `{"error":"Rate limit exceeded. Too many requests from your IP. Please try again later."}`, http.StatusTooManyRequests)
+				problem.Write(w, http.StatusTooManyRequests, problem.CodeRateLimited, "Rate limit exceeded. Too many requests from your IP. Please try again later.")
 				return
 			}
 
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRateLimiter_RouteClasses(t *testing.T) {
+	rl := NewRateLimiter(100, 100, 1000, 1000, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	rl.SetExemptPaths([]string{"/health"})
+	rl.SetRouteClasses([]RouteClass{
+		{Name: "ai", PerIP: 1, Burst: 2, Patterns: []string{"POST /v1/experiences/*/reprocess"}},
+		{Name: "disabled", PerIP: 0, Burst: 1, Patterns: []string{"GET /v1/experiences"}},
+	})
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := rl.Middleware()(ok)
+
+	serve := func(method, path string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = "203.0.113.1:1234"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	for i := 0; i < 2; i++ {
+		if code := serve(http.MethodPost, "/v1/experiences/abc/reprocess"); code != http.StatusOK {
+			t.Fatalf("request %d within the class burst: expected 200, got %d", i+1, code)
+		}
+	}
+	if code := serve(http.MethodPost, "/v1/experiences/abc/reprocess"); code != http.StatusTooManyRequests {
+		t.Errorf("expected the ai class limit to apply, got %d", code)
+	}
+
+	// Other routes and methods use the default limit, which the class doesn't consume
+	for i := 0; i < 10; i++ {
+		if code := serve(http.MethodGet, "/v1/experiences/abc/reprocess"); code != http.StatusOK {
+			t.Fatalf("expected the default limit for GET, got %d", code)
+		}
+		if code := serve(http.MethodGet, "/v1/experiences"); code != http.StatusOK {
+			t.Fatalf("expected a class without a limit to be ignored, got %d", code)
+		}
+	}
+
+	rl = NewRateLimiter(1, 1, 1, 1, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	rl.SetExemptPaths([]string{"/health"})
+	handler = rl.Middleware()(ok)
+	for i := 0; i < 5; i++ {
+		if code := serve(http.MethodGet, "/health"); code != http.StatusOK {
+			t.Fatalf("expected exempt path not to be limited, got %d", code)
+		}
+	}
+}
+
+func TestRateLimiter_SetLimits(t *testing.T) {
+	rl := NewRateLimiter(1, 1, 1000, 1000, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	handler := rl.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func() int {
+		req := httptest.NewRequest(http.MethodGet, "/v1/experiences", nil)
+		req.RemoteAddr = "203.0.113.1:1234"
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := serve(); code != http.StatusOK {
+		t.Fatalf("expected the first request to pass, got %d", code)
+	}
+	if code := serve(); code != http.StatusTooManyRequests {
+		t.Fatalf("expected the per-IP limit to apply, got %d", code)
+	}
+
+	// Raising the limit applies to clients that were already limited
+	rl.SetLimits(100, 5, 1000, 1000)
+	for i := 0; i < 5; i++ {
+		if code := serve(); code != http.StatusOK {
+			t.Fatalf("request %d within the new burst: expected 200, got %d", i+1, code)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSecurityHeaders(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serve := func(handler http.Handler, path string) http.Header {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Header()
+	}
+
+	header := serve(SecurityHeaders(0)(ok), "/v1/experiences")
+	if header.Get("X-Content-Type-Options") != "nosniff" || header.Get("X-Frame-Options") != "DENY" || header.Get("Referrer-Policy") != "no-referrer" {
+		t.Errorf("expected hardened headers, got %v", header)
+	}
+	if header.Get("Content-Security-Policy") != apiContentSecurityPolicy {
+		t.Errorf("expected the API policy, got %q", header.Get("Content-Security-Policy"))
+	}
+	if header.Get("Strict-Transport-Security") != "" {
+		t.Error("expected no HSTS header when disabled")
+	}
+
+	header = serve(SecurityHeaders(31536000)(ok), "/docs")
+	if !strings.Contains(header.Get("Content-Security-Policy"), "https://cdn.jsdelivr.net") {
+		t.Errorf("expected the docs policy to allow the Scalar bundle, got %q", header.Get("Content-Security-Policy"))
+	}
+	if header.Get("Strict-Transport-Security") != "max-age=31536000; includeSubDomains" {
+		t.Errorf("unexpected HSTS header %q", header.Get("Strict-Transport-Security"))
+	}
+}
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/problem"
+)
+
+// TimeoutClass is a set of routes with their own time budget, e.g., search requests that
+// wait for an embedding provider
+type TimeoutClass struct {
+	Name     string
+	Timeout  time.Duration // 0 disables the timeout for the class
+	Patterns []string      // Routes as "[METHOD ]/path", where the path may contain * wildcards
+}
+
+// Timeouts returns a middleware that cancels requests running longer than their budget and
+// answers them with 504 Gateway Timeout. A request uses the timeout of the first class with
+// a pattern matching it, or defaultTimeout (0 = no timeout). The handler's context is
+// canceled at the deadline, so database queries and AI calls it makes are stopped too.
+// WebSocket upgrades are never timed out.
+func Timeouts(defaultTimeout time.Duration, classes []TimeoutClass) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := defaultTimeout
+			for _, class := range classes {
+				if class.matches(r) {
+					timeout = class.Timeout
+					break
+				}
+			}
+			if timeout <= 0 || strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			tw := &timeoutWriter{header: make(http.Header)}
+			done := make(chan struct{})
+			panicChan := make(chan any, 1)
+			go func() {
+				defer func() {
+					if p := recover(); p != nil {
+						panicChan <- p
+					}
+				}()
+				next.ServeHTTP(tw, r.WithContext(ctx))
+				close(done)
+			}()
+
+			select {
+			case p := <-panicChan:
+				// Let the recoverer middleware handle it
+				panic(p)
+			case <-done:
+				tw.mu.Lock()
+				defer tw.mu.Unlock()
+				dst := w.Header()
+				for key, values := range tw.header {
+					dst[key] = values
+				}
+				if tw.status == 0 {
+					tw.status = http.StatusOK
+				}
+				w.WriteHeader(tw.status)
+				_, _ = w.Write(tw.body.Bytes())
+			case <-ctx.Done():
+				tw.mu.Lock()
+				defer tw.mu.Unlock()
+				tw.timedOut = true
+				if ctx.Err() != context.DeadlineExceeded {
+					// The client went away; there's no one to answer
+					return
+				}
+				problem.Write(w, http.StatusGatewayTimeout, problem.CodeTimeout, fmt.Sprintf("The request took longer than %s", timeout))
+			}
+		})
+	}
+}
+
+// matches reports whether the request belongs to the class
+func (c TimeoutClass) matches(r *http.Request) bool {
+	for _, pattern := range c.Patterns {
+		if matchRoute(pattern, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// timeoutWriter buffers a response until the handler finishes, so it can be discarded
+// if the handler runs out of time
+type timeoutWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	body     bytes.Buffer
+	status   int
+	timedOut bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) Write(p []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.status == 0 {
+		tw.status = http.StatusOK
+	}
+	return tw.body.Write(p)
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.status != 0 {
+		return
+	}
+	tw.status = status
+}
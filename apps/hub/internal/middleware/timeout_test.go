@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimeouts(t *testing.T) {
+	slow := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+			w.Header().Set("X-Done", "true")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte("done"))
+		}
+	})
+	handler := Timeouts(20*time.Millisecond, []TimeoutClass{
+		{Name: "search", Timeout: time.Second, Patterns: []string{"GET /v1/experiences/search"}},
+		{Name: "unlimited", Timeout: 0, Patterns: []string{"/v1/unlimited"}},
+	})(slow)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rec := serve("/v1/experiences")
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected 504 after the default timeout, got %d", rec.Code)
+	}
+	if rec.Header().Get("Content-Type") != "application/problem+json" || !strings.Contains(rec.Body.String(), `"status":504`) {
+		t.Errorf("expected a problem response, got %q %s", rec.Header().Get("Content-Type"), rec.Body.String())
+	}
+
+	for _, path := range []string{"/v1/experiences/search", "/v1/unlimited"} {
+		rec = serve(path)
+		if rec.Code != http.StatusCreated || rec.Header().Get("X-Done") != "true" || rec.Body.String() != "done" {
+			t.Errorf("%s: expected the handler's response within the class budget, got %d %q", path, rec.Code, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Package migrations applies the versioned database migrations embedded in the Hub binary.
+// The migration files in sql/ use the Atlas format and are generated from the Ent schema
+// with cmd/generate-migration. Applied versions are recorded in the schema_revisions table.
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/base64"
+	"fmt"
+	"io/fs"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+//go:embed sql/*.sql sql/atlas.sum
+var files embed.FS
+
+// lockKey is the PostgreSQL advisory lock held while migrating, so instances starting at
+// the same time don't apply the same migration twice
+const lockKey = 7_241_905_001
+
+// legacyTable is created by the first migration. If it exists while no migrations are
+// recorded, the schema was created by the automatic migration of earlier Hub versions.
+const legacyTable = "experience_data"
+
+// Migration is a versioned migration and its state in the database
+type Migration struct {
+	Version     string
+	Description string
+	Statements  []string
+	Hash        string
+	// AppliedAt is nil for pending migrations
+	AppliedAt *time.Time
+	// Baseline is true if the migration is (or will be) recorded without running its
+	// statements, because the schema already existed
+	Baseline bool
+}
+
+// Applied returns true if the migration was applied
+func (m Migration) Applied() bool {
+	return m.AppliedAt != nil
+}
+
+// querier is implemented by *sql.DB and *sql.Conn
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Migrator applies the embedded migrations to a database
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New creates a migrator, verifying the embedded migrations against atlas.sum
+func New(db *sql.DB) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// load reads the migration files in version order
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+	dir := &migrate.MemDir{}
+	for _, entry := range entries {
+		data, err := fs.ReadFile(fsys, "sql/"+entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+		if err := dir.WriteFile(entry.Name(), data); err != nil {
+			return nil, err
+		}
+	}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("migration files don't match atlas.sum: %w", err)
+	}
+
+	dirFiles, err := dir.Files()
+	if err != nil {
+		return nil, err
+	}
+	migrations := make([]Migration, 0, len(dirFiles))
+	for _, file := range dirFiles {
+		stmts, err := file.Stmts()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse migration %s: %w", file.Name(), err)
+		}
+		sum := sha256.Sum256(file.Bytes())
+		migrations = append(migrations, Migration{
+			Version:     file.Version(),
+			Description: file.Desc(),
+			Statements:  stmts,
+			Hash:        base64.StdEncoding.EncodeToString(sum[:]),
+		})
+	}
+	return migrations, nil
+}
+
+// Status returns all migrations in version order with their state in the database
+func (m *Migrator) Status(ctx context.Context) ([]Migration, error) {
+	return m.status(ctx, m.db)
+}
+
+// Pending returns the migrations that haven't been applied yet
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	migrations, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pending(migrations), nil
+}
+
+// Apply applies the pending migrations in version order, each in its own transaction,
+// and returns them
+func (m *Migrator) Apply(ctx context.Context) ([]Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return nil, fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	defer func() { _, _ = conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockKey) }()
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_revisions (
+		version character varying PRIMARY KEY,
+		description character varying NOT NULL,
+		hash character varying NOT NULL,
+		baseline boolean NOT NULL DEFAULT false,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`); err != nil {
+		return nil, fmt.Errorf("failed to create schema_revisions table: %w", err)
+	}
+
+	// Read the state after taking the lock, as another instance may have migrated meanwhile
+	migrations, err := m.status(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := pending(migrations)
+	for i := range applied {
+		if err := apply(ctx, conn, &applied[i]); err != nil {
+			return applied[:i], err
+		}
+	}
+	return applied, nil
+}
+
+// apply runs a migration and records it
+func apply(ctx context.Context, conn *sql.Conn, migration *Migration) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if !migration.Baseline {
+		for _, stmt := range migration.Statements {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("migration %s failed: %w", migration.Version, err)
+			}
+		}
+	}
+
+	var appliedAt time.Time
+	if err := tx.QueryRowContext(ctx,
+		"INSERT INTO schema_revisions (version, description, hash, baseline) VALUES ($1, $2, $3, $4) RETURNING applied_at",
+		migration.Version, migration.Description, migration.Hash, migration.Baseline,
+	).Scan(&appliedAt); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", migration.Version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration %s: %w", migration.Version, err)
+	}
+	migration.AppliedAt = &appliedAt
+	return nil
+}
+
+// status merges the recorded revisions into the migrations
+func (m *Migrator) status(ctx context.Context, q querier) ([]Migration, error) {
+	migrations := make([]Migration, len(m.migrations))
+	copy(migrations, m.migrations)
+
+	var exists bool
+	if err := q.QueryRowContext(ctx, "SELECT to_regclass('schema_revisions') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to read schema revisions: %w", err)
+	}
+
+	type revision struct {
+		hash      string
+		baseline  bool
+		appliedAt time.Time
+	}
+	revisions := make(map[string]revision)
+	if exists {
+		rows, err := q.QueryContext(ctx, "SELECT version, hash, baseline, applied_at FROM schema_revisions")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schema revisions: %w", err)
+		}
+		defer func() { _ = rows.Close() }()
+		for rows.Next() {
+			var version string
+			var r revision
+			if err := rows.Scan(&version, &r.hash, &r.baseline, &r.appliedAt); err != nil {
+				return nil, fmt.Errorf("failed to read schema revisions: %w", err)
+			}
+			revisions[version] = r
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read schema revisions: %w", err)
+		}
+	}
+
+	for i := range migrations {
+		r, ok := revisions[migrations[i].Version]
+		if !ok {
+			continue
+		}
+		if r.hash != migrations[i].Hash {
+			return nil, fmt.Errorf("migration %s was changed after it was applied", migrations[i].Version)
+		}
+		appliedAt := r.appliedAt
+		migrations[i].AppliedAt = &appliedAt
+		migrations[i].Baseline = r.baseline
+	}
+
+	// Record the first migration without running it if the schema already exists
+	if len(revisions) == 0 && len(migrations) > 0 {
+		var legacy bool
+		if err := q.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", legacyTable).Scan(&legacy); err != nil {
+			return nil, fmt.Errorf("failed to inspect schema: %w", err)
+		}
+		migrations[0].Baseline = legacy
+	}
+	return migrations, nil
+}
+
+// pending returns the migrations that haven't been applied yet
+func pending(migrations []Migration) []Migration {
+	var result []Migration
+	for _, migration := range migrations {
+		if !migration.Applied() {
+			result = append(result, migration)
+		}
+	}
+	return result
+}
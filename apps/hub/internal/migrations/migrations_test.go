@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatalf("expected the embedded migrations to load, got %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("expected at least one migration")
+	}
+	for i, migration := range migrations {
+		if i > 0 && migration.Version <= migrations[i-1].Version {
+			t.Errorf("expected migrations in version order, got %s after %s", migration.Version, migrations[i-1].Version)
+		}
+		if len(migration.Statements) == 0 {
+			t.Errorf("expected migration %s to have statements", migration.Version)
+		}
+		if migration.Applied() {
+			t.Errorf("expected migration %s to be pending before reading the database", migration.Version)
+		}
+	}
+	if migrations[0].Description != "init" {
+		t.Errorf("expected the first migration to be init, got %q", migrations[0].Description)
+	}
+}
+
+func TestLoad_ModifiedFile(t *testing.T) {
+	sum, err := files.ReadFile("sql/atlas.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"sql/atlas.sum":               {Data: sum},
+		"sql/20261016000000_init.sql": {Data: []byte("CREATE TABLE \"other\" (\"id\" uuid NOT NULL);\n")},
+	}
+
+	_, err = load(fsys)
+	if err == nil || !strings.Contains(err.Error(), "atlas.sum") {
+		t.Errorf("expected a checksum error, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+-- Enable pgvector for the embedding column
+CREATE EXTENSION IF NOT EXISTS "vector";
+-- Create "ai_usages" table
+CREATE TABLE "ai_usages" ("id" uuid NOT NULL, "day" timestamptz NOT NULL, "job_type" character varying NOT NULL, "provider" character varying NOT NULL, "model" character varying NOT NULL, "requests" bigint NOT NULL DEFAULT 0, "prompt_tokens" bigint NOT NULL DEFAULT 0, "completion_tokens" bigint NOT NULL DEFAULT 0, "cost_usd" double precision NOT NULL DEFAULT 0, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "aiusage_day_job_type_provider_model" to table: "ai_usages"
+CREATE UNIQUE INDEX "aiusage_day_job_type_provider_model" ON "ai_usages" ("day", "job_type", "provider", "model");
+-- Create "audit_logs" table
+CREATE TABLE "audit_logs" ("id" uuid NOT NULL, "actor" character varying NOT NULL, "request_id" character varying NULL, "method" character varying NOT NULL, "path" character varying NOT NULL, "operation" character varying NULL, "resource_id" character varying NULL, "status" bigint NOT NULL, "summary" text NOT NULL, "event_ids" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "auditlog_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_created_at" ON "audit_logs" ("created_at");
+-- Create index "auditlog_actor_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_actor_created_at" ON "audit_logs" ("actor", "created_at");
+-- Create index "auditlog_resource_id_created_at" to table: "audit_logs"
+CREATE INDEX "auditlog_resource_id_created_at" ON "audit_logs" ("resource_id", "created_at");
+-- Create index "auditlog_request_id" to table: "audit_logs"
+CREATE INDEX "auditlog_request_id" ON "audit_logs" ("request_id");
+-- Create "experience_data" table
+CREATE TABLE "experience_data" ("id" uuid NOT NULL, "collected_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "source_type" character varying NOT NULL, "source_id" character varying NULL, "source_name" character varying NULL, "field_id" character varying NOT NULL, "field_label" character varying NULL, "field_type" character varying NOT NULL, "value_text" text NULL, "value_number" double precision NULL, "value_boolean" boolean NULL, "value_date" timestamptz NULL, "value_json" jsonb NULL, "metadata" jsonb NULL, "language" character varying NULL, "sentiment" character varying NULL, "sentiment_score" double precision NULL, "emotion" character varying NULL, "topics" jsonb NULL, "is_spam" boolean NULL, "spam_confidence" double precision NULL, "urgency_score" double precision NULL, "urgency_reasons" jsonb NULL, "enrichment_provider" character varying NULL, "enrichment_model" character varying NULL, "enrichment_version" bigint NULL, "enrichment_attributes" jsonb NULL, "skip_ai_processing" boolean NOT NULL DEFAULT false, "ai_input_hash" character varying NULL, "user_identifier" character varying NULL, "embedding" vector(1536) NULL, "embedding_model" character varying NULL, PRIMARY KEY ("id"));
+-- Create index "experiencedata_source_type_source_id_collected_at" to table: "experience_data"
+CREATE INDEX "experiencedata_source_type_source_id_collected_at" ON "experience_data" ("source_type", "source_id", "collected_at");
+-- Create index "experiencedata_field_type_collected_at" to table: "experience_data"
+CREATE INDEX "experiencedata_field_type_collected_at" ON "experience_data" ("field_type", "collected_at");
+-- Create index "experiencedata_value_number" to table: "experience_data"
+CREATE INDEX "experiencedata_value_number" ON "experience_data" ("value_number");
+-- Create index "experiencedata_user_identifier" to table: "experience_data"
+CREATE INDEX "experiencedata_user_identifier" ON "experience_data" ("user_identifier");
+-- Create index "experiencedata_collected_at" to table: "experience_data"
+CREATE INDEX "experiencedata_collected_at" ON "experience_data" ("collected_at");
+-- Create index "experiencedata_sentiment" to table: "experience_data"
+CREATE INDEX "experiencedata_sentiment" ON "experience_data" ("sentiment");
+-- Create index "experiencedata_emotion" to table: "experience_data"
+CREATE INDEX "experiencedata_emotion" ON "experience_data" ("emotion");
+-- Create index "experiencedata_is_spam" to table: "experience_data"
+CREATE INDEX "experiencedata_is_spam" ON "experience_data" ("is_spam");
+-- Create index "experiencedata_urgency_score" to table: "experience_data"
+CREATE INDEX "experiencedata_urgency_score" ON "experience_data" ("urgency_score");
+-- Create index "experiencedata_enrichment_version" to table: "experience_data"
+CREATE INDEX "experiencedata_enrichment_version" ON "experience_data" ("enrichment_version");
+-- Create index "experiencedata_ai_input_hash" to table: "experience_data"
+CREATE INDEX "experiencedata_ai_input_hash" ON "experience_data" ("ai_input_hash");
+-- Create index "experiencedata_embedding" to table: "experience_data"
+CREATE INDEX "experiencedata_embedding" ON "experience_data" USING hnsw ("embedding" vector_cosine_ops);
+-- Create "queue_pauses" table
+CREATE TABLE "queue_pauses" ("id" uuid NOT NULL, "job_type" character varying NOT NULL, "reason" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "queue_pauses_job_type_key" to table: "queue_pauses"
+CREATE UNIQUE INDEX "queue_pauses_job_type_key" ON "queue_pauses" ("job_type");
+-- Create "workers" table
+CREATE TABLE "workers" ("id" uuid NOT NULL, "instance" character varying NOT NULL, "worker_id" bigint NOT NULL, "job_type" character varying NOT NULL, "current_job_id" uuid NULL, "current_job_started_at" timestamptz NULL, "processed" bigint NOT NULL DEFAULT 0, "failed" bigint NOT NULL DEFAULT 0, "started_at" timestamptz NOT NULL, "last_heartbeat_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "worker_last_heartbeat_at" to table: "workers"
+CREATE INDEX "worker_last_heartbeat_at" ON "workers" ("last_heartbeat_at");
+-- Create "enrichment_jobs" table
+CREATE TABLE "enrichment_jobs" ("id" uuid NOT NULL, "job_type" character varying NOT NULL DEFAULT 'enrichment', "status" character varying NOT NULL DEFAULT 'pending', "text" text NOT NULL, "error" text NULL, "error_history" jsonb NULL, "priority" bigint NOT NULL DEFAULT 0, "attempts" bigint NOT NULL DEFAULT 0, "created_at" timestamptz NOT NULL, "processed_at" timestamptz NULL, "lease_expires_at" timestamptz NULL, "prompt_tokens" bigint NULL, "completion_tokens" bigint NULL, "cost_usd" double precision NULL, "trace_context" character varying NULL, "experience_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "enrichment_jobs_experience_data_experience" FOREIGN KEY ("experience_id") REFERENCES "experience_data" ("id") ON DELETE NO ACTION);
+-- Create index "enrichmentjob_job_type_status_created_at" to table: "enrichment_jobs"
+CREATE INDEX "enrichmentjob_job_type_status_created_at" ON "enrichment_jobs" ("job_type", "status", "created_at");
+-- Create index "enrichmentjob_status_priority_created_at" to table: "enrichment_jobs"
+CREATE INDEX "enrichmentjob_status_priority_created_at" ON "enrichment_jobs" ("status", "priority", "created_at");
+-- Create index "enrichmentjob_experience_id" to table: "enrichment_jobs"
+CREATE INDEX "enrichmentjob_experience_id" ON "enrichment_jobs" ("experience_id");
+-- Create index "enrichmentjob_status_lease_expires_at" to table: "enrichment_jobs"
+CREATE INDEX "enrichmentjob_status_lease_expires_at" ON "enrichment_jobs" ("status", "lease_expires_at");
+-- Create "webhook_endpoints" table
+CREATE TABLE "webhook_endpoints" ("id" uuid NOT NULL, "url" character varying NOT NULL, "secret" character varying NOT NULL, "event_types" jsonb NULL, "conditions" jsonb NULL, "enabled" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" uuid NOT NULL, "event_type" character varying NOT NULL, "payload" text NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "response_status" bigint NULL, "duration_ms" bigint NULL, "error" text NULL, "redelivery_of" uuid NULL, "created_at" timestamptz NOT NULL, "delivered_at" timestamptz NULL, "endpoint_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_webhook_endpoints_endpoint" FOREIGN KEY ("endpoint_id") REFERENCES "webhook_endpoints" ("id") ON DELETE CASCADE);
+-- Create index "webhookdelivery_endpoint_id_created_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_endpoint_id_created_at" ON "webhook_deliveries" ("endpoint_id", "created_at");
+-- Create index "webhookdelivery_created_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_created_at" ON "webhook_deliveries" ("created_at");
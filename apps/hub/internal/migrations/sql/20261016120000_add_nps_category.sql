@@ -0,0 +1,6 @@
+-- Modify "experience_data" table
+ALTER TABLE "experience_data" ADD COLUMN "nps_category" character varying NULL;
+-- Derive the category of existing NPS scores
+UPDATE "experience_data" SET "nps_category" = CASE WHEN "value_number" >= 9 THEN 'promoter' WHEN "value_number" >= 7 THEN 'passive' ELSE 'detractor' END WHERE "field_type" = 'nps' AND "value_number" BETWEEN 0 AND 10;
+-- Create index "experiencedata_nps_category_collected_at" to table: "experience_data"
+CREATE INDEX "experiencedata_nps_category_collected_at" ON "experience_data" ("nps_category", "collected_at");
@@ -0,0 +1,28 @@
+-- Create "questions" table
+CREATE TABLE "questions" ("id" uuid NOT NULL, "source_type" character varying NOT NULL, "source_id" character varying NOT NULL DEFAULT '', "field_id" character varying NOT NULL, "field_type" character varying NULL, "label" character varying NULL, "labels" jsonb NULL, "metadata" jsonb NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "question_source_type_source_id_field_id" to table: "questions"
+CREATE UNIQUE INDEX "question_source_type_source_id_field_id" ON "questions" ("source_type", "source_id", "field_id");
+-- Modify "experience_data" table
+ALTER TABLE "experience_data" ADD COLUMN "question_id" uuid NULL, ADD CONSTRAINT "experience_data_questions_question" FOREIGN KEY ("question_id") REFERENCES "questions" ("id") ON DELETE SET NULL;
+-- Create a question for every source and field of existing experiences, labelled like their latest responses
+INSERT INTO "questions" ("id", "source_type", "source_id", "field_id", "field_type", "label", "labels", "created_at", "updated_at")
+SELECT gen_random_uuid(), "latest"."source_type", "latest"."source_id", "latest"."field_id", "latest"."field_type", NULLIF("latest"."field_label", ''), "localized"."labels", now(), now()
+FROM (
+  SELECT DISTINCT ON ("source_type", COALESCE("source_id", ''), "field_id") "source_type", COALESCE("source_id", '') AS "source_id", "field_id", "field_type", "field_label"
+  FROM "experience_data"
+  ORDER BY "source_type", COALESCE("source_id", ''), "field_id", COALESCE("field_label", '') = '', "collected_at" DESC
+) AS "latest"
+LEFT JOIN (
+  SELECT "source_type", "source_id", "field_id", jsonb_object_agg("language", "field_label") AS "labels"
+  FROM (
+    SELECT DISTINCT ON ("source_type", COALESCE("source_id", ''), "field_id", "language") "source_type", COALESCE("source_id", '') AS "source_id", "field_id", "language", "field_label"
+    FROM "experience_data"
+    WHERE "language" <> '' AND "field_label" <> ''
+    ORDER BY "source_type", COALESCE("source_id", ''), "field_id", "language", "collected_at" DESC
+  ) AS "by_language"
+  GROUP BY "source_type", "source_id", "field_id"
+) AS "localized" USING ("source_type", "source_id", "field_id");
+-- Link existing experiences to their question
+UPDATE "experience_data" SET "question_id" = "questions"."id" FROM "questions" WHERE "questions"."source_type" = "experience_data"."source_type" AND "questions"."source_id" = COALESCE("experience_data"."source_id", '') AND "questions"."field_id" = "experience_data"."field_id";
+-- Create index "experiencedata_question_id_collected_at" to table: "experience_data"
+CREATE INDEX "experiencedata_question_id_collected_at" ON "experience_data" ("question_id", "collected_at");
@@ -0,0 +1,14 @@
+-- Modify "experience_data" table
+ALTER TABLE "experience_data" ADD COLUMN "country" character varying NULL, ADD COLUMN "region" character varying NULL, ADD COLUMN "device" character varying NULL, ADD COLUMN "platform" character varying NULL, ADD COLUMN "app_version" character varying NULL;
+-- Populate the columns from the metadata keys of the default mapping
+UPDATE "experience_data" SET "country" = CASE WHEN jsonb_typeof("metadata"->'country') IN ('string', 'number', 'boolean') THEN NULLIF(TRIM("metadata"->>'country'), '') END, "region" = CASE WHEN jsonb_typeof("metadata"->'region') IN ('string', 'number', 'boolean') THEN NULLIF(TRIM("metadata"->>'region'), '') END, "device" = CASE WHEN jsonb_typeof("metadata"->'device') IN ('string', 'number', 'boolean') THEN NULLIF(TRIM("metadata"->>'device'), '') END, "platform" = CASE WHEN jsonb_typeof("metadata"->'platform') IN ('string', 'number', 'boolean') THEN NULLIF(TRIM("metadata"->>'platform'), '') END, "app_version" = CASE WHEN jsonb_typeof("metadata"->'app_version') IN ('string', 'number', 'boolean') THEN NULLIF(TRIM("metadata"->>'app_version'), '') END WHERE jsonb_typeof("metadata") = 'object' AND "metadata" ?| ARRAY['country', 'region', 'device', 'platform', 'app_version'];
+-- Create index "experiencedata_country" to table: "experience_data"
+CREATE INDEX "experiencedata_country" ON "experience_data" ("country");
+-- Create index "experiencedata_region" to table: "experience_data"
+CREATE INDEX "experiencedata_region" ON "experience_data" ("region");
+-- Create index "experiencedata_device" to table: "experience_data"
+CREATE INDEX "experiencedata_device" ON "experience_data" ("device");
+-- Create index "experiencedata_platform" to table: "experience_data"
+CREATE INDEX "experiencedata_platform" ON "experience_data" ("platform");
+-- Create index "experiencedata_app_version" to table: "experience_data"
+CREATE INDEX "experiencedata_app_version" ON "experience_data" ("app_version");
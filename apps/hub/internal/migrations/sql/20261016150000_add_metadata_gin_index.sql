@@ -0,0 +1,2 @@
+-- Create index "experiencedata_metadata" to table: "experience_data"
+CREATE INDEX "experiencedata_metadata" ON "experience_data" USING gin ("metadata" jsonb_path_ops);
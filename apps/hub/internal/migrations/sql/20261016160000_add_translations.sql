@@ -0,0 +1,2 @@
+-- Modify "experience_data" table
+ALTER TABLE "experience_data" ADD COLUMN "translations" jsonb NULL;
@@ -0,0 +1,18 @@
+-- Modify "experience_data" table
+ALTER TABLE "experience_data" ADD COLUMN "content_hash" character varying NULL, ADD COLUMN "duplicate_of" uuid NULL;
+-- Hash existing experiences like models.ContentHash. Numbers with more than 15 significant
+-- digits may hash differently from new writes, which only misses duplicates of them.
+UPDATE "experience_data" SET "content_hash" = encode(sha256(convert_to(concat_ws(E'\x1f',
+  "source_type",
+  COALESCE("source_id", ''),
+  "field_id",
+  COALESCE("user_identifier", ''),
+  CASE
+    WHEN "value_text" IS NOT NULL THEN 'text:' || lower(regexp_replace(btrim("value_text", E' \t\n\r\f\x0b'), '\s+', ' ', 'g'))
+    WHEN "value_number" IS NOT NULL THEN 'number:' || "value_number"::numeric::text
+    WHEN "value_boolean" IS NOT NULL THEN 'boolean:' || "value_boolean"::text
+    ELSE 'date:' || to_char("value_date" AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+  END), 'UTF8')), 'hex')
+WHERE "value_text" IS NOT NULL OR "value_number" IS NOT NULL OR "value_boolean" IS NOT NULL OR "value_date" IS NOT NULL;
+-- Create index "experiencedata_content_hash_collected_at" to table: "experience_data"
+CREATE INDEX "experiencedata_content_hash_collected_at" ON "experience_data" ("content_hash", "collected_at");
@@ -0,0 +1,2 @@
+-- Create index "experiencedata_created_at" to table: "experience_data"
+CREATE INDEX "experiencedata_created_at" ON "experience_data" ("created_at");
@@ -0,0 +1,41 @@
+-- Create view "query_experiences" read by POST /v1/query. It leaves out user identifiers,
+-- translations, embeddings, and enrichment bookkeeping; grant the restricted query role
+-- SELECT on this view only.
+CREATE VIEW "query_experiences" AS
+SELECT
+  "id",
+  "created_at",
+  "updated_at",
+  "collected_at",
+  "source_type",
+  "source_id",
+  "source_name",
+  "field_id",
+  "field_label",
+  "field_type",
+  "question_id",
+  "value_text",
+  "value_number",
+  "value_boolean",
+  "value_date",
+  "value_json",
+  "nps_category",
+  "metadata",
+  "language",
+  "country",
+  "region",
+  "device",
+  "platform",
+  "app_version",
+  "sentiment",
+  "sentiment_score",
+  "emotion",
+  "topics",
+  "summary",
+  "enrichment_attributes",
+  "is_spam",
+  "spam_confidence",
+  "urgency_score",
+  "urgency_reasons",
+  "duplicate_of"
+FROM "experience_data";
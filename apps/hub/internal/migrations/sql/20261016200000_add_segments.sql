@@ -0,0 +1,4 @@
+-- Create "segments" table
+CREATE TABLE "segments" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "description" character varying NULL, "filter" jsonb NOT NULL, PRIMARY KEY ("id"));
+-- Create index "segments_name_key" to table: "segments"
+CREATE UNIQUE INDEX "segments_name_key" ON "segments" ("name");
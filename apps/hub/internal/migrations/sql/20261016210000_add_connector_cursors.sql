@@ -0,0 +1,4 @@
+-- Create "connector_cursors" table
+CREATE TABLE "connector_cursors" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "stream" character varying NOT NULL, "cursor" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "connector_cursors_stream_key" to table: "connector_cursors"
+CREATE UNIQUE INDEX "connector_cursors_stream_key" ON "connector_cursors" ("stream");
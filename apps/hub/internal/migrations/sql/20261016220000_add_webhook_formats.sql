@@ -0,0 +1,2 @@
+-- Modify "webhook_endpoints" table
+ALTER TABLE "webhook_endpoints" ADD COLUMN "format" character varying NOT NULL DEFAULT 'cloudevents', ADD COLUMN "slack_token" character varying NULL, ADD COLUMN "slack_channel" character varying NULL;
@@ -0,0 +1,2 @@
+-- Create index "experiencedata_updated_at" to table: "experience_data"
+CREATE INDEX "experiencedata_updated_at" ON "experience_data" ("updated_at");
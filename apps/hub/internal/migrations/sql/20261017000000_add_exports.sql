@@ -0,0 +1,8 @@
+-- Create "exports" table
+CREATE TABLE "exports" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "format" character varying NOT NULL DEFAULT 'jsonl', "provider" character varying NOT NULL DEFAULT 's3', "bucket" character varying NOT NULL, "region" character varying NULL, "endpoint" character varying NULL, "prefix" character varying NULL, "access_key_id" character varying NULL, "secret_access_key" character varying NULL, "interval_minutes" bigint NOT NULL DEFAULT 60, "enabled" boolean NOT NULL DEFAULT true, "cursor_at" timestamptz NULL, "cursor_id" uuid NULL, "next_run_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "exports_name_key" to table: "exports"
+CREATE UNIQUE INDEX "exports_name_key" ON "exports" ("name");
+-- Create "export_runs" table
+CREATE TABLE "export_runs" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "status" character varying NOT NULL DEFAULT 'running', "experiences" bigint NOT NULL DEFAULT 0, "bytes" bigint NOT NULL DEFAULT 0, "objects" jsonb NULL, "error" text NULL, "finished_at" timestamptz NULL, "export_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "export_runs_exports_export" FOREIGN KEY ("export_id") REFERENCES "exports" ("id") ON DELETE CASCADE);
+-- Create index "exportrun_export_id_created_at" to table: "export_runs"
+CREATE INDEX "exportrun_export_id_created_at" ON "export_runs" ("export_id", "created_at");
@@ -0,0 +1,4 @@
+-- Create "ingest_mappings" table
+CREATE TABLE "ingest_mappings" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "source_type" character varying NOT NULL, "template" jsonb NOT NULL, "secret" character varying NOT NULL, "signature_header" character varying NULL, "enabled" boolean NOT NULL DEFAULT true, PRIMARY KEY ("id"));
+-- Create index "ingest_mappings_name_key" to table: "ingest_mappings"
+CREATE UNIQUE INDEX "ingest_mappings_name_key" ON "ingest_mappings" ("name");
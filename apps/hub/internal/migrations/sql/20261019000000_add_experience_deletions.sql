@@ -0,0 +1,4 @@
+-- Create "experience_deletions" table
+CREATE TABLE "experience_deletions" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "experience_id" uuid NOT NULL, PRIMARY KEY ("id"));
+-- Create index "experiencedeletion_created_at_id" to table: "experience_deletions"
+CREATE INDEX "experiencedeletion_created_at_id" ON "experience_deletions" ("created_at", "id");
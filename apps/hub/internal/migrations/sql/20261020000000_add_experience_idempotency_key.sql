@@ -0,0 +1,4 @@
+-- Modify "experience_data" table
+ALTER TABLE "experience_data" ADD COLUMN "idempotency_key" character varying NULL;
+-- Create index "experience_data_idempotency_key_key" to table: "experience_data"
+CREATE UNIQUE INDEX "experience_data_idempotency_key_key" ON "experience_data" ("idempotency_key");
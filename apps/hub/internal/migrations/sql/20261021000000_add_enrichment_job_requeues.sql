@@ -0,0 +1,2 @@
+-- Modify "enrichment_jobs" table
+ALTER TABLE "enrichment_jobs" ADD COLUMN "requeues" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duplicate policies applied to experiences whose content hash matches an earlier one
+const (
+	DuplicatePolicyAllow  = "allow"  // store duplicates without marking them
+	DuplicatePolicyFlag   = "flag"   // store duplicates with duplicate_of set to the earlier experience
+	DuplicatePolicyReject = "reject" // reject duplicates with 409 Conflict
+)
+
+// ContentKey identifies the content of an experience for exact-duplicate detection
+type ContentKey struct {
+	SourceType     string
+	SourceID       string
+	FieldID        string
+	UserIdentifier string
+	Values         Values
+}
+
+// ContentHash returns the hex SHA-256 of the normalized source, field, user, and value of
+// an experience, or nil if it has no text, number, boolean, or date value. Text is
+// compared case-insensitively with whitespace collapsed, dates at microsecond precision
+// in UTC. The backfill in the add_content_hash migration computes the same hash in SQL,
+// so the normalization must not change without a migration that recomputes it.
+func ContentHash(k ContentKey) *string {
+	var value string
+	switch v := k.Values; {
+	case v.Text != nil:
+		value = "text:" + strings.ToLower(strings.Join(strings.Fields(*v.Text), " "))
+	case v.Number != nil:
+		value = "number:" + strconv.FormatFloat(*v.Number, 'f', -1, 64)
+	case v.Boolean != nil:
+		value = "boolean:" + strconv.FormatBool(*v.Boolean)
+	case v.Date != nil:
+		value = "date:" + v.Date.UTC().Round(time.Microsecond).Format("2006-01-02T15:04:05.000000Z")
+	default:
+		return nil
+	}
+
+	// The unit separator can't be confused with content of the parts
+	content := strings.Join([]string{k.SourceType, k.SourceID, k.FieldID, k.UserIdentifier, value}, "\x1f")
+	sum := sha256.Sum256([]byte(content))
+	hash := hex.EncodeToString(sum[:])
+	return &hash
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestContentHash(t *testing.T) {
+	text := func(s string) *string { return &s }
+	score := func(f float64) *float64 { return &f }
+	key := func(userIdentifier string, values Values) ContentKey {
+		return ContentKey{SourceType: "survey", SourceID: "s1", FieldID: "q1", UserIdentifier: userIdentifier, Values: values}
+	}
+
+	base := ContentHash(key("u1", Values{Text: text("Exports keep failing")}))
+	if base == nil || len(*base) != 64 {
+		t.Fatalf("expected a hex SHA-256, got %v", base)
+	}
+	if got := ContentHash(key("u1", Values{Text: text("  exports  KEEP\nfailing ")})); got == nil || *got != *base {
+		t.Errorf("expected case and whitespace to be ignored")
+	}
+	if got := ContentHash(key("u2", Values{Text: text("Exports keep failing")})); *got == *base {
+		t.Errorf("expected other users to hash differently")
+	}
+	if got := ContentHash(key("u1", Values{Text: text("Exports keep failing!")})); *got == *base {
+		t.Errorf("expected other text to hash differently")
+	}
+	if *ContentHash(key("u1", Values{Number: score(9)})) == *ContentHash(key("u1", Values{Text: text("9")})) {
+		t.Errorf("expected a number and text to hash differently")
+	}
+	if ContentHash(key("u1", Values{})) != nil {
+		t.Errorf("expected no hash without a value")
+	}
+
+	// The backfill migration computes the same string in SQL: dates are in UTC at microsecond precision
+	date := time.Date(2026, 1, 15, 10, 0, 0, 123456789, time.FixedZone("CET", 3600))
+	got := ContentHash(ContentKey{SourceType: "survey", FieldID: "q1", Values: Values{Date: &date}})
+	// sha256("survey\x1f\x1fq1\x1f\x1fdate:2026-01-15T09:00:00.123457Z")
+	if want := "dec17ce8efdd754b0a4858d4456e73ce12f13920bab93baf553392397dd61bd0"; *got != want {
+		t.Errorf("expected %s, got %s", want, *got)
+	}
+}
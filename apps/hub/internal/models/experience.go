@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
 )
 
 // Experience represents an experience data record in the domain.
@@ -24,20 +25,48 @@ type Experience struct {
 	SourceName     *string                `json:"source_name,omitempty"`
 	FieldID        string                 `json:"field_id"`
 	FieldLabel     *string                `json:"field_label,omitempty"`
+	QuestionID     *uuid.UUID             `json:"question_id,omitempty"`
 	FieldType      string                 `json:"field_type"`
 	ValueText      *string                `json:"value_text,omitempty"`
 	ValueNumber    *float64               `json:"value_number,omitempty"`
 	ValueBoolean   *bool                  `json:"value_boolean,omitempty"`
 	ValueDate      *time.Time             `json:"value_date,omitempty"`
 	ValueJSON      map[string]interface{} `json:"value_json,omitempty"`
+	NPSCategory    *string                `json:"nps_category,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	Country        *string                `json:"country,omitempty"`
+	Region         *string                `json:"region,omitempty"`
+	Device         *string                `json:"device,omitempty"`
+	Platform       *string                `json:"platform,omitempty"`
+	AppVersion     *string                `json:"app_version,omitempty"`
 	Language       *string                `json:"language,omitempty"`
+	Translations   map[string]Translation `json:"translations,omitempty"`
 	UserIdentifier *string                `json:"user_identifier,omitempty"`
+	ContentHash    *string                `json:"content_hash,omitempty"`
+	DuplicateOf    *uuid.UUID             `json:"duplicate_of,omitempty"`
 	// AI Enrichment (optional)
-	Sentiment      *string  `json:"sentiment,omitempty"`
-	SentimentScore *float64 `json:"sentiment_score,omitempty"`
-	Emotion        *string  `json:"emotion,omitempty"`
-	Topics         []string `json:"topics,omitempty"`
+	SkipAIProcessing     bool           `json:"skip_ai_processing,omitempty"`
+	Sentiment            *string        `json:"sentiment,omitempty"`
+	SentimentScore       *float64       `json:"sentiment_score,omitempty"`
+	Emotion              *string        `json:"emotion,omitempty"`
+	Topics               []string       `json:"topics,omitempty"`
+	IsSpam               *bool          `json:"is_spam,omitempty"`
+	SpamConfidence       *float64       `json:"spam_confidence,omitempty"`
+	UrgencyScore         *float64       `json:"urgency_score,omitempty"`
+	UrgencyReasons       []string       `json:"urgency_reasons,omitempty"`
+	EnrichmentProvider   *string        `json:"enrichment_provider,omitempty"`
+	EnrichmentModel      *string        `json:"enrichment_model,omitempty"`
+	EnrichmentVersion    *int           `json:"enrichment_version,omitempty"`
+	EnrichmentAttributes map[string]any `json:"enrichment_attributes,omitempty"`
+}
+
+// Translation is a machine translation of the response text and question label
+type Translation struct {
+	ValueText    string    `json:"value_text,omitempty"`
+	FieldLabel   string    `json:"field_label,omitempty"`
+	Provider     string    `json:"provider"`
+	Model        string    `json:"model"`
+	TranslatedAt time.Time `json:"translated_at"`
 }
 
 // FromEnt converts an Ent entity to a domain model.
@@ -52,20 +81,39 @@ func FromEnt(e *ent.ExperienceData) *Experience {
 		SourceName:     stringToPtr(e.SourceName),
 		FieldID:        e.FieldID,
 		FieldLabel:     stringToPtr(e.FieldLabel),
+		QuestionID:     e.QuestionID,
 		FieldType:      e.FieldType,
 		ValueText:      e.ValueText,
 		ValueNumber:    e.ValueNumber,
 		ValueBoolean:   e.ValueBoolean,
 		ValueDate:      e.ValueDate,
 		ValueJSON:      e.ValueJSON,
+		NPSCategory:    e.NpsCategory,
 		Metadata:       e.Metadata,
+		Country:        e.Country,
+		Region:         e.Region,
+		Device:         e.Device,
+		Platform:       e.Platform,
+		AppVersion:     e.AppVersion,
 		Language:       stringToPtr(e.Language),
+		Translations:   translationsFromEnt(e.Translations),
 		UserIdentifier: stringToPtr(e.UserIdentifier),
+		ContentHash:    e.ContentHash,
+		DuplicateOf:    e.DuplicateOf,
 		// Enrichment fields
-		Sentiment:      e.Sentiment,
-		SentimentScore: e.SentimentScore,
-		Emotion:        e.Emotion,
-		Topics:         e.Topics,
+		SkipAIProcessing:     e.SkipAiProcessing,
+		Sentiment:            e.Sentiment,
+		SentimentScore:       e.SentimentScore,
+		Emotion:              e.Emotion,
+		Topics:               e.Topics,
+		IsSpam:               e.IsSpam,
+		SpamConfidence:       e.SpamConfidence,
+		UrgencyScore:         e.UrgencyScore,
+		UrgencyReasons:       e.UrgencyReasons,
+		EnrichmentProvider:   e.EnrichmentProvider,
+		EnrichmentModel:      e.EnrichmentModel,
+		EnrichmentVersion:    e.EnrichmentVersion,
+		EnrichmentAttributes: e.EnrichmentAttributes,
 	}
 }
 
@@ -81,15 +129,49 @@ func (e *Experience) ToEnt(entity *ent.ExperienceData) {
 	entity.SourceName = ptrToString(e.SourceName)
 	entity.FieldID = e.FieldID
 	entity.FieldLabel = ptrToString(e.FieldLabel)
+	entity.QuestionID = e.QuestionID
 	entity.FieldType = e.FieldType
 	entity.ValueText = e.ValueText
 	entity.ValueNumber = e.ValueNumber
 	entity.ValueBoolean = e.ValueBoolean
 	entity.ValueDate = e.ValueDate
 	entity.ValueJSON = e.ValueJSON
+	entity.NpsCategory = e.NPSCategory
 	entity.Metadata = e.Metadata
+	entity.Country = e.Country
+	entity.Region = e.Region
+	entity.Device = e.Device
+	entity.Platform = e.Platform
+	entity.AppVersion = e.AppVersion
 	entity.Language = ptrToString(e.Language)
+	entity.Translations = translationsToEnt(e.Translations)
 	entity.UserIdentifier = ptrToString(e.UserIdentifier)
+	entity.ContentHash = e.ContentHash
+	entity.DuplicateOf = e.DuplicateOf
+}
+
+// translationsFromEnt converts stored translations to the domain model
+func translationsFromEnt(translations map[string]schema.Translation) map[string]Translation {
+	if translations == nil {
+		return nil
+	}
+	result := make(map[string]Translation, len(translations))
+	for language, t := range translations {
+		result[language] = Translation(t)
+	}
+	return result
+}
+
+// translationsToEnt converts translations of the domain model for persistence
+func translationsToEnt(translations map[string]Translation) map[string]schema.Translation {
+	if translations == nil {
+		return nil
+	}
+	result := make(map[string]schema.Translation, len(translations))
+	for language, t := range translations {
+		result[language] = schema.Translation(t)
+	}
+	return result
 }
 
 // Helper functions for string pointer conversion
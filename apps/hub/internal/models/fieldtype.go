@@ -1,5 +1,11 @@
 package models
 
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
 // FieldType represents the standardized data types for experience data fields.
 // These types are optimized for analytics and map to specific use cases.
 type FieldType string
@@ -64,6 +70,100 @@ func (f FieldType) ShouldEnrich() bool {
 	return f == FieldTypeText
 }
 
+// NPS categories of scores from 0 to 10
+const (
+	NPSPromoter  = "promoter"  // 9-10
+	NPSPassive   = "passive"   // 7-8
+	NPSDetractor = "detractor" // 0-6
+)
+
+// NPSCategory returns the NPS category of a response, or nil if it isn't an NPS score
+// from 0 to 10. It is stored in nps_category on every write, so NPS breakdowns are a
+// GROUP BY instead of a CASE expression.
+func NPSCategory(fieldType string, value *float64) *string {
+	if FieldType(fieldType) != FieldTypeNPS || value == nil || *value < 0 || *value > 10 {
+		return nil
+	}
+	category := NPSDetractor
+	switch {
+	case *value >= 9:
+		category = NPSPromoter
+	case *value >= 7:
+		category = NPSPassive
+	}
+	return &category
+}
+
+// Range is an inclusive range of scores
+type Range struct {
+	Min, Max float64
+}
+
+// Values are the value columns of an experience; nil values are not set
+type Values struct {
+	Text    *string
+	Number  *float64
+	Boolean *bool
+	Date    *time.Time
+}
+
+// ValueRules validate the values of experiences against their field type
+type ValueRules struct {
+	CSAT   Range
+	Rating Range
+}
+
+// Validate returns an error describing the first value that doesn't fit the field type:
+// a value in a column of another field type, a score outside its range, or, when
+// requireValue is set, a missing nps, boolean or date value. value_json may accompany
+// any field type. Partial updates don't set requireValue, as the stored value remains.
+func (r ValueRules) Validate(fieldType FieldType, v Values, requireValue bool) error {
+	numeric := fieldType == FieldTypeNPS || fieldType == FieldTypeCSAT ||
+		fieldType == FieldTypeRating || fieldType == FieldTypeNumber
+	switch {
+	case v.Text != nil && fieldType != FieldTypeText && fieldType != FieldTypeCategorical:
+		return fmt.Errorf("value_text is not allowed for %s fields, use %s", fieldType, fieldType.valueColumn())
+	case v.Number != nil && !numeric:
+		return fmt.Errorf("value_number is not allowed for %s fields, use %s", fieldType, fieldType.valueColumn())
+	case v.Boolean != nil && fieldType != FieldTypeBoolean:
+		return fmt.Errorf("value_boolean is not allowed for %s fields, use %s", fieldType, fieldType.valueColumn())
+	case v.Date != nil && fieldType != FieldTypeDate:
+		return fmt.Errorf("value_date is not allowed for %s fields, use %s", fieldType, fieldType.valueColumn())
+	}
+
+	if requireValue {
+		switch {
+		case fieldType == FieldTypeNPS && v.Number == nil:
+			return errors.New("nps fields require value_number")
+		case fieldType == FieldTypeBoolean && v.Boolean == nil:
+			return errors.New("boolean fields require value_boolean")
+		case fieldType == FieldTypeDate && v.Date == nil:
+			return errors.New("date fields require value_date")
+		}
+	}
+
+	if v.Number != nil {
+		scores := map[FieldType]Range{FieldTypeNPS: {0, 10}, FieldTypeCSAT: r.CSAT, FieldTypeRating: r.Rating}
+		if scale, ok := scores[fieldType]; ok && (*v.Number < scale.Min || *v.Number > scale.Max) {
+			return fmt.Errorf("value_number of %s fields must be between %g and %g, got %g", fieldType, scale.Min, scale.Max, *v.Number)
+		}
+	}
+	return nil
+}
+
+// valueColumn returns the value column of the field type
+func (f FieldType) valueColumn() string {
+	switch f {
+	case FieldTypeText, FieldTypeCategorical:
+		return "value_text"
+	case FieldTypeBoolean:
+		return "value_boolean"
+	case FieldTypeDate:
+		return "value_date"
+	}
+	return "value_number"
+}
+
 // String returns the string representation of the FieldType.
 func (f FieldType) String() string {
 	return string(f)
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNPSCategory(t *testing.T) {
+	score := func(f float64) *float64 { return &f }
+	tests := []struct {
+		fieldType string
+		value     *float64
+		want      string
+	}{
+		{"nps", score(10), NPSPromoter},
+		{"nps", score(9), NPSPromoter},
+		{"nps", score(8), NPSPassive},
+		{"nps", score(7), NPSPassive},
+		{"nps", score(6.5), NPSDetractor},
+		{"nps", score(0), NPSDetractor},
+		{"nps", score(11), ""},
+		{"nps", score(-1), ""},
+		{"nps", nil, ""},
+		{"rating", score(10), ""},
+	}
+	for _, tt := range tests {
+		got := NPSCategory(tt.fieldType, tt.value)
+		if (got == nil) != (tt.want == "") || (got != nil && *got != tt.want) {
+			t.Errorf("NPSCategory(%s, %v): expected %q, got %v", tt.fieldType, tt.value, tt.want, got)
+		}
+	}
+}
+
+func TestValueRulesValidate(t *testing.T) {
+	score := func(f float64) *float64 { return &f }
+	text, yes, now := "Great", true, time.Now()
+	rules := ValueRules{CSAT: Range{1, 5}, Rating: Range{0, 10}}
+	tests := []struct {
+		fieldType    FieldType
+		values       Values
+		requireValue bool
+		valid        bool
+	}{
+		{FieldTypeNPS, Values{Number: score(10)}, true, true},
+		{FieldTypeNPS, Values{Number: score(11)}, true, false},
+		{FieldTypeNPS, Values{}, true, false},
+		{FieldTypeNPS, Values{}, false, true},
+		{FieldTypeNPS, Values{Text: &text, Number: score(9)}, true, false},
+		{FieldTypeCSAT, Values{Number: score(5)}, true, true},
+		{FieldTypeCSAT, Values{Number: score(0)}, true, false},
+		{FieldTypeCSAT, Values{}, true, true},
+		{FieldTypeRating, Values{Number: score(10.5)}, false, false},
+		{FieldTypeNumber, Values{Number: score(-1000)}, true, true},
+		{FieldTypeBoolean, Values{Boolean: &yes}, true, true},
+		{FieldTypeBoolean, Values{}, true, false},
+		{FieldTypeBoolean, Values{Number: score(1)}, false, false},
+		{FieldTypeDate, Values{Date: &now}, true, true},
+		{FieldTypeDate, Values{Text: &text}, false, false},
+		{FieldTypeDate, Values{}, true, false},
+		{FieldTypeText, Values{Text: &text}, true, true},
+		{FieldTypeText, Values{Number: score(8.5)}, false, false},
+		{FieldTypeCategorical, Values{Boolean: &yes}, true, false},
+	}
+	for _, tt := range tests {
+		err := rules.Validate(tt.fieldType, tt.values, tt.requireValue)
+		if (err == nil) != tt.valid {
+			t.Errorf("Validate(%s, %+v, %v): expected valid=%v, got %v", tt.fieldType, tt.values, tt.requireValue, tt.valid, err)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+// MetadataColumns are typed copies of common metadata keys. They are stored in indexed
+// columns at ingest, so dashboards filter and group on them instead of on the metadata JSON.
+type MetadataColumns struct {
+	Country    *string
+	Region     *string
+	Device     *string
+	Platform   *string
+	AppVersion *string
+}
+
+// ExtractMetadataColumns returns the typed columns of metadata. keys holds the metadata
+// keys of each column (see config.GetMetadataColumns), tried in order; dotted keys are
+// paths into nested objects. Only string, number, and boolean values are used.
+func ExtractMetadataColumns(metadata map[string]any, keys map[string][]string) MetadataColumns {
+	value := func(column string) *string {
+		for _, key := range keys[column] {
+			if s, ok := metadataString(metadata, key); ok {
+				return &s
+			}
+		}
+		return nil
+	}
+	return MetadataColumns{
+		Country:    value("country"),
+		Region:     value("region"),
+		Device:     value("device"),
+		Platform:   value("platform"),
+		AppVersion: value("app_version"),
+	}
+}
+
+// metadataString returns the non-empty value at a dotted path of metadata as a string
+func metadataString(metadata map[string]any, path string) (string, bool) {
+	var v any = metadata
+	for _, key := range strings.Split(path, ".") {
+		object, ok := v.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if v, ok = object[key]; !ok {
+			return "", false
+		}
+	}
+
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = strings.TrimSpace(v)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		s = strconv.FormatBool(v)
+	}
+	return s, s != ""
+}
+
+// Apply sets the columns of an experience create or update, clearing those without a value
+func (c MetadataColumns) Apply(m *ent.ExperienceDataMutation) {
+	for _, column := range []struct {
+		value *string
+		set   func(string)
+		clear func()
+	}{
+		{c.Country, m.SetCountry, m.ClearCountry},
+		{c.Region, m.SetRegion, m.ClearRegion},
+		{c.Device, m.SetDevice, m.ClearDevice},
+		{c.Platform, m.SetPlatform, m.ClearPlatform},
+		{c.AppVersion, m.SetAppVersion, m.ClearAppVersion},
+	} {
+		if column.value != nil {
+			column.set(*column.value)
+		} else {
+			column.clear()
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package models
+
+import "testing"
+
+func TestExtractMetadataColumns(t *testing.T) {
+	metadata := map[string]any{
+		"country":     " DE ",
+		"geo":         map[string]any{"country": "FR", "region": "Bavaria"},
+		"device":      "",
+		"client":      map[string]any{"device": "mobile"},
+		"app_version": 2.5,
+		"platform":    []any{"ios"},
+	}
+	keys := map[string][]string{
+		"country":     {"missing", "country", "geo.country"},
+		"region":      {"geo.region"},
+		"device":      {"device", "client.device"},
+		"platform":    {"platform"},
+		"app_version": {"app_version"},
+	}
+
+	columns := ExtractMetadataColumns(metadata, keys)
+	for _, tt := range []struct {
+		name string
+		got  *string
+		want string
+	}{
+		{"country", columns.Country, "DE"},
+		{"region", columns.Region, "Bavaria"},
+		{"device", columns.Device, "mobile"},
+		{"platform", columns.Platform, ""},
+		{"app_version", columns.AppVersion, "2.5"},
+	} {
+		if (tt.got == nil) != (tt.want == "") || (tt.got != nil && *tt.got != tt.want) {
+			t.Errorf("%s: expected %q, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+
+	if columns := ExtractMetadataColumns(nil, keys); columns != (MetadataColumns{}) {
+		t.Errorf("expected no columns without metadata, got %+v", columns)
+	}
+}
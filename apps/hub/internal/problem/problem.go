@@ -0,0 +1,174 @@
+// Package problem provides the RFC 7807 problem details returned by all error responses of
+// the Hub API. Each problem carries a stable, machine-readable code, so clients can branch
+// on the code instead of parsing the human-readable detail, which may change.
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// Code identifies the kind of problem. Codes are part of the API contract: existing codes
+// are never renamed or reused for a different problem.
+type Code string
+
+// Generic codes, used when no more specific code applies
+const (
+	CodeBadRequest         Code = "bad_request"
+	CodeUnauthorized       Code = "unauthorized"
+	CodeForbidden          Code = "forbidden"
+	CodeNotFound           Code = "not_found"
+	CodeMethodNotAllowed   Code = "method_not_allowed"
+	CodeNotAcceptable      Code = "not_acceptable"
+	CodeConflict           Code = "conflict"
+	CodeRequestTooLarge    Code = "request_too_large"
+	CodeUnsupportedMedia   Code = "unsupported_media_type"
+	CodeValidationFailed   Code = "validation_failed"
+	CodeRateLimited        Code = "rate_limited"
+	CodeInternalError      Code = "internal_error"
+	CodeServiceUnavailable Code = "service_unavailable"
+	CodeTimeout            Code = "timeout"
+)
+
+// Specific codes
+const (
+	CodeInvalidID            Code = "invalid_id"
+	CodeInvalidTimestamp     Code = "invalid_timestamp"
+	CodeInvalidTimeRange     Code = "invalid_time_range"
+	CodeInvalidFieldType     Code = "invalid_field_type"
+	CodeInvalidValue         Code = "invalid_value"
+	CodeInvalidWebhookURL    Code = "invalid_webhook_url"
+	CodeInvalidEventType     Code = "invalid_event_type"
+	CodeInvalidCondition     Code = "invalid_condition"
+	CodeInvalidDestination   Code = "invalid_destination"
+	CodeInvalidProvider      Code = "invalid_provider"
+	CodeInvalidQuery         Code = "invalid_query"
+	CodeInvalidConfiguration Code = "invalid_configuration"
+	CodeInvalidMapping       Code = "invalid_mapping"
+	CodeMappingFailed        Code = "mapping_failed"
+	CodeInvalidSyncState     Code = "invalid_sync_state"
+	CodeExperienceNotFound   Code = "experience_not_found"
+	CodeJobNotFound          Code = "job_not_found"
+	CodeWebhookNotFound      Code = "webhook_not_found"
+	CodeDeliveryNotFound     Code = "delivery_not_found"
+	CodeQuestionNotFound     Code = "question_not_found"
+	CodeSegmentNotFound      Code = "segment_not_found"
+	CodeExportNotFound       Code = "export_not_found"
+	CodeMappingNotFound      Code = "mapping_not_found"
+	CodeAlreadyExists        Code = "already_exists"
+	CodeDuplicateExperience  Code = "duplicate_experience"
+	CodeInvalidJobStatus     Code = "invalid_job_status"
+	CodeWebhookDisabled      Code = "webhook_disabled"
+	CodeFeatureDisabled      Code = "feature_disabled"
+	CodeAIProcessingDisabled Code = "ai_processing_disabled"
+	CodeReloadUnavailable    Code = "reload_unavailable"
+	CodeAuthLockedOut        Code = "auth_locked_out"
+	CodeDatabaseError        Code = "database_error"
+)
+
+// Codes are all codes, listed in the OpenAPI spec
+var Codes = []Code{
+	CodeBadRequest, CodeUnauthorized, CodeForbidden, CodeNotFound, CodeMethodNotAllowed,
+	CodeNotAcceptable, CodeConflict, CodeRequestTooLarge, CodeUnsupportedMedia,
+	CodeValidationFailed, CodeRateLimited, CodeInternalError, CodeServiceUnavailable, CodeTimeout,
+	CodeInvalidID, CodeInvalidTimestamp, CodeInvalidTimeRange, CodeInvalidFieldType, CodeInvalidValue,
+	CodeInvalidWebhookURL, CodeInvalidEventType, CodeInvalidCondition, CodeInvalidDestination, CodeInvalidProvider,
+	CodeInvalidQuery, CodeInvalidConfiguration, CodeInvalidMapping, CodeMappingFailed, CodeInvalidSyncState, CodeExperienceNotFound, CodeJobNotFound, CodeWebhookNotFound,
+	CodeDeliveryNotFound, CodeQuestionNotFound, CodeSegmentNotFound, CodeExportNotFound, CodeMappingNotFound, CodeAlreadyExists, CodeDuplicateExperience,
+	CodeInvalidJobStatus, CodeWebhookDisabled, CodeFeatureDisabled, CodeAIProcessingDisabled,
+	CodeReloadUnavailable, CodeAuthLockedOut, CodeDatabaseError,
+}
+
+// statusCodes are the generic codes of HTTP statuses
+var statusCodes = map[int]Code{
+	http.StatusBadRequest:            CodeBadRequest,
+	http.StatusUnauthorized:          CodeUnauthorized,
+	http.StatusForbidden:             CodeForbidden,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusMethodNotAllowed:      CodeMethodNotAllowed,
+	http.StatusNotAcceptable:         CodeNotAcceptable,
+	http.StatusConflict:              CodeConflict,
+	http.StatusRequestEntityTooLarge: CodeRequestTooLarge,
+	http.StatusUnsupportedMediaType:  CodeUnsupportedMedia,
+	http.StatusUnprocessableEntity:   CodeValidationFailed,
+	http.StatusTooManyRequests:       CodeRateLimited,
+	http.StatusInternalServerError:   CodeInternalError,
+	http.StatusServiceUnavailable:    CodeServiceUnavailable,
+	http.StatusGatewayTimeout:        CodeTimeout,
+}
+
+// StatusCode returns the generic code of an HTTP status
+func StatusCode(status int) Code {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	if status >= 500 {
+		return CodeInternalError
+	}
+	return CodeBadRequest
+}
+
+// Error is a problem details response with an error code
+type Error struct {
+	huma.ErrorModel
+	Code Code `json:"code" example:"experience_not_found" doc:"Stable, machine-readable error code. Branch on it instead of the detail message."`
+
+	cause error // Internal error behind the problem, reported but never sent to clients
+}
+
+// TransformSchema lists the error codes in the OpenAPI spec
+func (e *Error) TransformSchema(r huma.Registry, s *huma.Schema) *huma.Schema {
+	if prop, ok := s.Properties["code"]; ok {
+		prop.Enum = make([]any, len(Codes))
+		for i, code := range Codes {
+			prop.Enum[i] = string(code)
+		}
+	}
+	return s
+}
+
+// New returns a problem with a specific code
+func New(status int, code Code, detail string) *Error {
+	return &Error{
+		ErrorModel: huma.ErrorModel{
+			Status: status,
+			Title:  http.StatusText(status),
+			Detail: detail,
+		},
+		Code: code,
+	}
+}
+
+// WithCause records the internal error behind a problem, so error reporting can include it
+// while clients only see the sanitized detail
+func (e *Error) WithCause(err error) *Error {
+	e.cause = err
+	return e
+}
+
+// Cause returns the internal error behind the problem, or nil
+func (e *Error) Cause() error {
+	return e.cause
+}
+
+// NewError replaces huma.NewError, so errors created by Huma (e.g. validation errors) and
+// by huma.ErrorXXX helpers carry the generic code of their status
+func NewError(status int, msg string, errs ...error) huma.StatusError {
+	e := New(status, StatusCode(status), msg)
+	for _, err := range errs {
+		if err != nil {
+			e.Add(err)
+		}
+	}
+	return e
+}
+
+// Write writes a problem as application/problem+json, for handlers served outside of Huma
+func Write(w http.ResponseWriter, status int, code Code, detail string) {
+	body, _ := json.Marshal(New(status, code, detail))
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
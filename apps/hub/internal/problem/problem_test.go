@@ -0,0 +1,67 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+func TestNewError(t *testing.T) {
+	err := NewError(http.StatusUnprocessableEntity, "validation failed", &huma.ErrorDetail{
+		Message:  "expected value to be one of text, categorical, nps",
+		Location: "body.field_type",
+	})
+
+	var problem *Error
+	if !errors.As(err, &problem) {
+		t.Fatalf("expected a problem, got %T", err)
+	}
+	if problem.Code != CodeValidationFailed || problem.Status != http.StatusUnprocessableEntity {
+		t.Errorf("expected a validation_failed problem with status 422, got %q and %d", problem.Code, problem.Status)
+	}
+	if len(problem.Errors) != 1 || problem.Errors[0].Location != "body.field_type" {
+		t.Errorf("expected the error details to be kept, got %+v", problem.Errors)
+	}
+	if got := problem.ContentType("application/json"); got != "application/problem+json" {
+		t.Errorf("expected application/problem+json, got %q", got)
+	}
+
+	if code := StatusCode(http.StatusTeapot); code != CodeBadRequest {
+		t.Errorf("expected unknown client errors to be bad_request, got %q", code)
+	}
+	if code := StatusCode(http.StatusBadGateway); code != CodeInternalError {
+		t.Errorf("expected unknown server errors to be internal_error, got %q", code)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	rec := httptest.NewRecorder()
+	Write(rec, http.StatusTooManyRequests, CodeRateLimited, "Rate limit exceeded")
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("expected application/problem+json, got %q", ct)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"title":  "Too Many Requests",
+		"status": float64(429),
+		"detail": "Rate limit exceeded",
+		"code":   "rate_limited",
+	}
+	for key, value := range want {
+		if body[key] != value {
+			t.Errorf("expected %s %v, got %v", key, value, body[key])
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Package questionbank links experiences to the question they answer. Questions are
+// identified by their source and field ID and created when the first response arrives,
+// with the response's label as the canonical label in its language. Later responses only
+// add labels for new languages, so relabelling a question upstream doesn't change it.
+package questionbank
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/question"
+)
+
+// Key identifies a question. SourceID is empty for sources without IDs.
+type Key struct {
+	SourceType string
+	SourceID   string
+	FieldID    string
+}
+
+// Response is what an experience tells about the question it answers
+type Response struct {
+	Key
+	FieldType string
+	Label     string
+	Language  string
+}
+
+// Resolver finds or creates the questions of responses. It remembers the questions it
+// resolved, so a resolver should be used for one request or batch and then discarded.
+type Resolver struct {
+	client *ent.Client
+	ids    map[Key]uuid.UUID
+}
+
+// NewResolver returns a resolver that uses client
+func NewResolver(client *ent.Client) *Resolver {
+	return &Resolver{client: client, ids: make(map[Key]uuid.UUID)}
+}
+
+// Resolve returns the ID of the question that r answers, creating the question if it
+// doesn't exist yet or adding the label if the question has none in r's language
+func (res *Resolver) Resolve(ctx context.Context, r Response) (uuid.UUID, error) {
+	if id, ok := res.ids[r.Key]; ok {
+		return id, nil
+	}
+
+	q, err := res.find(ctx, r.Key)
+	if ent.IsNotFound(err) {
+		q, err = res.create(ctx, r)
+		// Another request created the question first
+		if ent.IsConstraintError(err) {
+			q, err = res.find(ctx, r.Key)
+		}
+	} else if err == nil && r.Label != "" && r.Language != "" && q.Labels[r.Language] == "" {
+		labels := maps.Clone(q.Labels)
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[r.Language] = r.Label
+		update := q.Update().SetLabels(labels)
+		if q.Label == "" {
+			update.SetLabel(r.Label)
+		}
+		q, err = update.Save(ctx)
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to resolve question %s/%s/%s: %w", r.SourceType, r.SourceID, r.FieldID, err)
+	}
+
+	res.ids[r.Key] = q.ID
+	return q.ID, nil
+}
+
+func (res *Resolver) find(ctx context.Context, k Key) (*ent.Question, error) {
+	return res.client.Question.Query().
+		Where(
+			question.SourceTypeEQ(k.SourceType),
+			question.SourceIDEQ(k.SourceID),
+			question.FieldIDEQ(k.FieldID),
+		).
+		Only(ctx)
+}
+
+func (res *Resolver) create(ctx context.Context, r Response) (*ent.Question, error) {
+	create := res.client.Question.Create().
+		SetSourceType(r.SourceType).
+		SetSourceID(r.SourceID).
+		SetFieldID(r.FieldID).
+		SetFieldType(r.FieldType)
+	if r.Label != "" {
+		create.SetLabel(r.Label)
+		if r.Language != "" {
+			create.SetLabels(map[string]string{r.Language: r.Label})
+		}
+	}
+	return create.Save(ctx)
+}
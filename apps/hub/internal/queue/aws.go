@@ -0,0 +1,204 @@
+package queue
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// awsTimeFormat is the timestamp format used by AWS Signature Version 4
+	awsTimeFormat = "20060102T150405Z"
+	// containerCredentialsHost serves task role credentials on Amazon ECS
+	containerCredentialsHost = "http://169.254.170.2"
+	// credentialsRefreshWindow is how long before expiry temporary credentials are refreshed
+	credentialsRefreshWindow = 5 * time.Minute
+)
+
+// awsCredentials holds the keys used to sign AWS requests
+type awsCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string    // Only set for temporary credentials
+	Expires         time.Time // Zero for static credentials
+}
+
+// awsCredentialsProvider resolves AWS credentials in the order used by AWS SDKs for
+// containers: explicitly configured keys, the standard AWS_* environment variables,
+// and the ECS container credentials endpoint (task roles). Temporary credentials are
+// cached until shortly before they expire.
+type awsCredentialsProvider struct {
+	static     *awsCredentials
+	httpClient *http.Client
+
+	mu     sync.Mutex
+	cached *awsCredentials
+}
+
+// newAWSCredentialsProvider creates a provider. Empty keys fall back to the environment.
+func newAWSCredentialsProvider(accessKeyID, secretAccessKey, sessionToken string) *awsCredentialsProvider {
+	if accessKeyID == "" && secretAccessKey == "" {
+		accessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+
+	provider := &awsCredentialsProvider{httpClient: &http.Client{Timeout: 5 * time.Second}}
+	if accessKeyID != "" && secretAccessKey != "" {
+		provider.static = &awsCredentials{
+			AccessKeyID:     accessKeyID,
+			SecretAccessKey: secretAccessKey,
+			SessionToken:    sessionToken,
+		}
+	}
+	return provider
+}
+
+// Retrieve returns credentials for signing a request
+func (p *awsCredentialsProvider) Retrieve(ctx context.Context) (awsCredentials, error) {
+	if p.static != nil {
+		return *p.static, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cached != nil && time.Until(p.cached.Expires) > credentialsRefreshWindow {
+		return *p.cached, nil
+	}
+
+	creds, err := p.fetchContainerCredentials(ctx)
+	if err != nil {
+		return awsCredentials{}, err
+	}
+	p.cached = creds
+	return *creds, nil
+}
+
+// fetchContainerCredentials requests task role credentials from the ECS credentials endpoint
+func (p *awsCredentialsProvider) fetchContainerCredentials(ctx context.Context) (*awsCredentials, error) {
+	endpoint := os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+	if endpoint == "" {
+		relative := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI")
+		if relative == "" {
+			return nil, fmt.Errorf("no AWS credentials configured (set an access key or run with an ECS task role)")
+		}
+		endpoint = containerCredentialsHost + relative
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create credentials request: %w", err)
+	}
+	if token := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN"); token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch container credentials: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch container credentials: status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		AccessKeyID     string    `json:"AccessKeyId"`
+		SecretAccessKey string    `json:"SecretAccessKey"`
+		Token           string    `json:"Token"`
+		Expiration      time.Time `json:"Expiration"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode container credentials: %w", err)
+	}
+
+	return &awsCredentials{
+		AccessKeyID:     body.AccessKeyID,
+		SecretAccessKey: body.SecretAccessKey,
+		SessionToken:    body.Token,
+		Expires:         body.Expiration,
+	}, nil
+}
+
+// signAWSRequest signs the request with AWS Signature Version 4. All headers already set
+// on the request are signed, along with the host and date. The request's query string, if
+// any, must already be in canonical (sorted, escaped) form.
+func signAWSRequest(req *http.Request, payload []byte, creds awsCredentials, region, service string, now time.Time) {
+	timestamp := now.UTC().Format(awsTimeFormat)
+	date := timestamp[:8]
+
+	req.Header.Set("X-Amz-Date", timestamp)
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		sha256Hex(payload),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		timestamp,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = io.WriteString(mac, data)
+	return mac.Sum(nil)
+}
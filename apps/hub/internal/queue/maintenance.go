@@ -0,0 +1,71 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+)
+
+// DeadLetterStatuses are the statuses of jobs in the dead-letter queue. "failed" is the
+// terminal status of jobs that failed before dead-lettering was introduced.
+var DeadLetterStatuses = []string{"dead_letter", "failed"}
+
+// FinishedStatuses are the statuses of jobs that are done and only kept for inspection
+var FinishedStatuses = []string{"completed", "cancelled"}
+
+// processedBefore matches jobs with one of the statuses that were processed before the
+// cutoff. Jobs finished by older versions have no processed_at and use created_at.
+func processedBefore(statuses []string, before time.Time) predicate.EnrichmentJob {
+	return enrichmentjob.And(
+		enrichmentjob.StatusIn(statuses...),
+		enrichmentjob.Or(
+			enrichmentjob.ProcessedAtLT(before),
+			enrichmentjob.And(enrichmentjob.ProcessedAtIsNil(), enrichmentjob.CreatedAtLT(before)),
+		),
+	)
+}
+
+// CountProcessedBefore returns the number of jobs with one of the statuses that were
+// processed before the cutoff
+func (q *PostgresQueue) CountProcessedBefore(ctx context.Context, statuses []string, before time.Time) (int, error) {
+	count, err := q.client.EnrichmentJob.Query().Where(processedBefore(statuses, before)).Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count jobs: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteProcessedBefore deletes jobs with one of the statuses that were processed before
+// the cutoff. Returns the number of deleted jobs.
+func (q *PostgresQueue) DeleteProcessedBefore(ctx context.Context, statuses []string, before time.Time) (int, error) {
+	deleted, err := q.client.EnrichmentJob.Delete().Where(processedBefore(statuses, before)).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete jobs: %w", err)
+	}
+	return deleted, nil
+}
+
+// CountExpired returns the number of processing jobs that ReclaimExpired would return to
+// the queue
+func (q *PostgresQueue) CountExpired(ctx context.Context) (int, error) {
+	count, err := q.client.EnrichmentJob.Query().Where(expired()).Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count expired jobs: %w", err)
+	}
+	return count, nil
+}
+
+// expired matches processing jobs whose lease has expired, or that were claimed by an
+// older version without a lease
+func expired() predicate.EnrichmentJob {
+	return enrichmentjob.And(
+		enrichmentjob.Status("processing"),
+		enrichmentjob.Or(
+			enrichmentjob.LeaseExpiresAtLT(time.Now()),
+			enrichmentjob.LeaseExpiresAtIsNil(),
+		),
+	)
+}
@@ -0,0 +1,130 @@
+package queue
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/testdb"
+)
+
+func TestMaintenance(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	exp, err := client.ExperienceData.Create().
+		SetSourceType("survey").
+		SetFieldID("q1").
+		SetFieldType("text").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	cutoff := now.AddDate(0, 0, -7)
+	old, recent := cutoff.Add(-time.Hour), cutoff.Add(time.Hour)
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	// Jobs by name, with the statuses and times that decide whether they are deleted
+	jobs := []struct {
+		name        string
+		status      string
+		createdAt   time.Time
+		processedAt *time.Time
+		leaseUntil  *time.Time
+	}{
+		{name: "completed long ago", status: "completed", createdAt: old, processedAt: &old},
+		{name: "cancelled long ago", status: "cancelled", createdAt: old, processedAt: &old},
+		{name: "completed recently", status: "completed", createdAt: old, processedAt: &recent},
+		{name: "legacy completed", status: "completed", createdAt: old},
+		{name: "legacy completed recently", status: "completed", createdAt: recent},
+		{name: "dead letter long ago", status: "dead_letter", createdAt: old, processedAt: &old},
+		{name: "dead letter recently", status: "dead_letter", createdAt: old, processedAt: &recent},
+		{name: "legacy failed", status: "failed", createdAt: old},
+		{name: "legacy failed recently", status: "failed", createdAt: recent},
+		{name: "pending", status: "pending", createdAt: old},
+		{name: "processing expired", status: "processing", createdAt: old, leaseUntil: &past},
+		{name: "processing without lease", status: "processing", createdAt: old},
+		{name: "processing", status: "processing", createdAt: old, leaseUntil: &future},
+	}
+	names := map[uuid.UUID]string{}
+	for _, job := range jobs {
+		created, err := client.EnrichmentJob.Create().
+			SetExperienceID(exp.ID).
+			SetText("The exports keep timing out").
+			SetStatus(job.status).
+			SetCreatedAt(job.createdAt).
+			SetNillableProcessedAt(job.processedAt).
+			SetNillableLeaseExpiresAt(job.leaseUntil).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create job %q: %v", job.name, err)
+		}
+		names[created.ID] = job.name
+	}
+
+	remaining := func() []string {
+		t.Helper()
+		all, err := client.EnrichmentJob.Query().All(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var left []string
+		for _, job := range all {
+			left = append(left, names[job.ID])
+		}
+		slices.Sort(left)
+		return left
+	}
+	without := func(from []string, deleted ...string) []string {
+		return slices.DeleteFunc(slices.Clone(from), func(name string) bool { return slices.Contains(deleted, name) })
+	}
+
+	q := NewPostgresQueue(client, 3, time.Minute)
+	prune := func(statuses []string, deleted ...string) {
+		t.Helper()
+		before := remaining()
+		count, err := q.CountProcessedBefore(ctx, statuses, cutoff)
+		if err != nil {
+			t.Fatalf("CountProcessedBefore(%v) error = %v", statuses, err)
+		}
+		if got := remaining(); !slices.Equal(got, before) {
+			t.Fatalf("expected counting to delete nothing, left %v", got)
+		}
+		n, err := q.DeleteProcessedBefore(ctx, statuses, cutoff)
+		if err != nil {
+			t.Fatalf("DeleteProcessedBefore(%v) error = %v", statuses, err)
+		}
+		if count != len(deleted) || n != count {
+			t.Errorf("%v: expected %d jobs to be counted and deleted, got %d counted and %d deleted", statuses, len(deleted), count, n)
+		}
+		if got, want := remaining(), without(before, deleted...); !slices.Equal(got, want) {
+			t.Errorf("%v: expected %v to be left, got %v", statuses, want, got)
+		}
+	}
+
+	// Jobs without processed_at were finished by older versions and go by created_at
+	prune(FinishedStatuses, "completed long ago", "cancelled long ago", "legacy completed")
+	// Jobs that failed before dead-lettering was introduced are purged with the dead letters
+	prune(DeadLetterStatuses, "dead letter long ago", "legacy failed")
+
+	count, err := q.CountExpired(ctx)
+	if err != nil {
+		t.Fatalf("CountExpired() error = %v", err)
+	}
+	reclaimed, err := q.ReclaimExpired(ctx)
+	if err != nil {
+		t.Fatalf("ReclaimExpired() error = %v", err)
+	}
+	if count != 2 || reclaimed != count {
+		t.Errorf("expected the expired and the lease-less processing job to be counted and reclaimed, got %d counted and %d reclaimed", count, reclaimed)
+	}
+	if count, err := q.CountExpired(ctx); err != nil || count != 0 {
+		t.Errorf("expected no expired jobs after reclaiming, got %d (%v)", count, err)
+	}
+}
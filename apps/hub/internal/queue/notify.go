@@ -0,0 +1,110 @@
+package queue
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// jobsChannel is the PostgreSQL notification channel signalled when jobs are enqueued
+	jobsChannel = "hub_jobs"
+	// listenerMinReconnect and listenerMaxReconnect bound the listener's reconnect backoff
+	listenerMinReconnect = time.Second
+	listenerMaxReconnect = time.Minute
+	// listenerPingInterval is how often an idle listener connection is checked
+	listenerPingInterval = 90 * time.Second
+)
+
+// Notifier is implemented by queues that signal when jobs are enqueued, so workers can
+// start them right away instead of waiting for the next poll
+type Notifier interface {
+	// Notifications returns a channel that receives a value when new jobs may be available.
+	// Notifications are coalesced; a receiver should dequeue until the queue is empty.
+	Notifications() <-chan struct{}
+}
+
+// SendNotifications makes Enqueue notify listening workers of all Hub instances through db
+func (q *PostgresQueue) SendNotifications(db *stdsql.DB) {
+	q.db = db
+}
+
+// Listen receives the notifications sent by all Hub instances on a dedicated connection to
+// databaseURL, so Notifications fires when jobs are enqueued. Workers still poll, so a lost
+// notification only delays a job until the next poll. Call Close to stop listening.
+func (q *PostgresQueue) Listen(databaseURL string, logger *slog.Logger) error {
+	listener := pq.NewListener(databaseURL, listenerMinReconnect, listenerMaxReconnect,
+		func(event pq.ListenerEventType, err error) {
+			switch event {
+			case pq.ListenerEventDisconnected, pq.ListenerEventConnectionAttemptFailed:
+				logger.Warn("job notification listener disconnected", "error", err)
+			case pq.ListenerEventReconnected:
+				logger.Info("job notification listener reconnected")
+			}
+		})
+
+	if err := listener.Listen(jobsChannel); err != nil {
+		_ = listener.Close()
+		return fmt.Errorf("failed to listen for job notifications: %w", err)
+	}
+
+	q.listener = listener
+	go q.forwardNotifications(listener)
+
+	return nil
+}
+
+// Notifications returns a channel that receives a value when jobs are enqueued.
+// The channel never fires unless Listen was called.
+func (q *PostgresQueue) Notifications() <-chan struct{} {
+	return q.notifications
+}
+
+// Close stops listening for job notifications
+func (q *PostgresQueue) Close() error {
+	if q.listener == nil {
+		return nil
+	}
+	return q.listener.Close()
+}
+
+// forwardNotifications relays notifications until the listener is closed
+func (q *PostgresQueue) forwardNotifications(listener *pq.Listener) {
+	ticker := time.NewTicker(listenerPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case _, ok := <-listener.Notify:
+			if !ok {
+				return
+			}
+			// A nil notification follows a reconnect, so jobs enqueued meanwhile are picked up too
+			q.signal()
+		case <-ticker.C:
+			go func() { _ = listener.Ping() }()
+		}
+	}
+}
+
+// signal wakes a waiting worker without blocking if one is already signalled
+func (q *PostgresQueue) signal() {
+	select {
+	case q.notifications <- struct{}{}:
+	default:
+	}
+}
+
+// notify tells listening workers that a job was enqueued, if SendNotifications was called.
+// Failures are ignored because the job is already stored and will be picked up by the next
+// poll.
+func (q *PostgresQueue) notify(ctx context.Context) {
+	if q.db == nil {
+		return
+	}
+	_, _ = q.db.ExecContext(ctx, "SELECT pg_notify($1, '')", jobsChannel)
+}
@@ -2,73 +2,156 @@ package queue
 
 import (
 	"context"
+	stdsql "database/sql"
 	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/ent/predicate"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 // PostgresQueue implements the Queue interface using PostgreSQL and Ent ORM
 type PostgresQueue struct {
-	client *ent.Client
+	client            *ent.Client
+	maxAttempts       int
+	visibilityTimeout time.Duration
+
+	// Set by Listen
+	db            *stdsql.DB
+	listener      *pq.Listener
+	notifications chan struct{}
 }
 
-// NewPostgresQueue creates a new PostgreSQL-backed queue
-func NewPostgresQueue(client *ent.Client) *PostgresQueue {
+// NewPostgresQueue creates a new PostgreSQL-backed queue. Failed jobs are retried until
+// they have been attempted maxAttempts times and are then moved to the dead-letter queue.
+// A dequeued job that isn't finished within visibilityTimeout is considered stranded
+// (e.g., its worker crashed) and can be reclaimed with ReclaimExpired.
+func NewPostgresQueue(client *ent.Client, maxAttempts int, visibilityTimeout time.Duration) *PostgresQueue {
 	return &PostgresQueue{
-		client: client,
+		client:            client,
+		maxAttempts:       maxAttempts,
+		visibilityTimeout: visibilityTimeout,
+		notifications:     make(chan struct{}, 1),
 	}
 }
 
 // Enqueue adds a new enrichment job to the queue
-func (q *PostgresQueue) Enqueue(ctx context.Context, experienceID, text string) error {
-	return q.enqueueJob(ctx, experienceID, text, JobTypeEnrichment)
+func (q *PostgresQueue) Enqueue(ctx context.Context, experienceID, text string, priority Priority) error {
+	return q.EnqueueJob(ctx, JobTypeEnrichment, experienceID, text, priority)
 }
 
 // EnqueueEmbedding adds a new embedding job to the queue
-func (q *PostgresQueue) EnqueueEmbedding(ctx context.Context, experienceID, text string) error {
-	return q.enqueueJob(ctx, experienceID, text, JobTypeEmbedding)
+func (q *PostgresQueue) EnqueueEmbedding(ctx context.Context, experienceID, text string, priority Priority) error {
+	return q.EnqueueJob(ctx, JobTypeEmbedding, experienceID, text, priority)
 }
 
-// enqueueJob is a helper to enqueue jobs of any type
-func (q *PostgresQueue) enqueueJob(ctx context.Context, experienceID, text string, jobType JobType) error {
+// EnqueueJob adds a new job of any type to the queue. If the experience already has a
+// pending job of the type, that job is updated with the new text instead, keeping the
+// higher priority, so repeated updates or retries don't pile up redundant work.
+func (q *PostgresQueue) EnqueueJob(ctx context.Context, jobType JobType, experienceID, text string, priority Priority) (err error) {
+	ctx, span := startEnqueueSpan(ctx, jobType, experienceID)
+	defer func() { endEnqueueSpan(span, err) }()
+
 	expID, err := uuid.Parse(experienceID)
 	if err != nil {
 		return fmt.Errorf("invalid experience ID: %w", err)
 	}
 
+	coalesced, err := q.coalescePending(ctx, jobType, expID, text, priority)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s job: %w", jobType, err)
+	}
+	if coalesced {
+		return nil
+	}
+
 	_, err = q.client.EnrichmentJob.
 		Create().
 		SetExperienceID(expID).
 		SetJobType(string(jobType)).
 		SetText(text).
+		SetPriority(int(priority)).
 		SetStatus("pending").
+		SetTraceContext(tracing.TraceParent(ctx)).
 		Save(ctx)
 
 	if err != nil {
 		return fmt.Errorf("failed to enqueue %s job: %w", jobType, err)
 	}
 
+	q.notify(ctx)
 	return nil
 }
 
-// Dequeue retrieves and locks the next pending job for processing.
-// Uses a query+update loop to prevent race conditions between workers.
-// Returns nil if no jobs are available.
-func (q *PostgresQueue) Dequeue(ctx context.Context) (*EnrichmentJob, error) {
+// coalescePending replaces the text of the experience's pending job of the given type, if
+// it has one, and reports whether it did. Only the latest text matters, so the job keeps
+// its place in the queue. Two concurrent enqueues may both find no pending job and create
+// one each; the duplicate costs one extra AI request but is otherwise harmless.
+func (q *PostgresQueue) coalescePending(ctx context.Context, jobType JobType, experienceID uuid.UUID, text string, priority Priority) (bool, error) {
+	existing, err := q.client.EnrichmentJob.
+		Query().
+		Where(
+			enrichmentjob.ExperienceID(experienceID),
+			enrichmentjob.JobType(string(jobType)),
+			enrichmentjob.Status("pending"),
+		).
+		Order(ent.Asc("created_at")).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	update := q.client.EnrichmentJob.
+		UpdateOneID(existing.ID).
+		Where(enrichmentjob.Status("pending")).
+		SetText(text).
+		SetTraceContext(tracing.TraceParent(ctx))
+	if int(priority) > existing.Priority {
+		update.SetPriority(int(priority))
+	}
+
+	err = update.Exec(ctx)
+	// A worker claimed the job meanwhile, so it may already be working on the old text
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Dequeue retrieves and locks the next pending job of the given type (any type if empty)
+// for processing, highest priority first. Uses a query+update loop to prevent race
+// conditions between workers. Returns nil if no jobs are available.
+func (q *PostgresQueue) Dequeue(ctx context.Context, jobType JobType) (*EnrichmentJob, error) {
+	start := time.Now()
+
 	// Try to find and claim a pending job using a query+update approach:
 	// 1. Query for pending jobs
 	// 2. Try to update the first one
 	// 3. If successful, return it; if it fails (race condition), return nil
 
-	jobs, err := q.client.EnrichmentJob.
+	query := q.client.EnrichmentJob.
 		Query().
 		Where(func(s *sql.Selector) {
 			s.Where(sql.EQ("status", "pending"))
-		}).
-		Order(ent.Asc("created_at")).
+		})
+	if jobType != "" {
+		query = query.Where(enrichmentjob.JobType(string(jobType)))
+	}
+
+	jobs, err := query.
+		Order(ent.Desc("priority"), ent.Asc("created_at")).
 		Limit(1).
 		All(ctx)
 
@@ -84,6 +167,7 @@ func (q *PostgresQueue) Dequeue(ctx context.Context) (*EnrichmentJob, error) {
 
 	// Try to claim the job by updating it
 	// This might fail if another worker claims it first (race condition)
+	lease := q.newLease()
 	updatedJob, err := q.client.EnrichmentJob.
 		UpdateOneID(job.ID).
 		Where(func(s *sql.Selector) {
@@ -91,6 +175,7 @@ func (q *PostgresQueue) Dequeue(ctx context.Context) (*EnrichmentJob, error) {
 		}).
 		SetStatus("processing").
 		SetAttempts(job.Attempts + 1).
+		SetLeaseExpiresAt(lease).
 		Save(ctx)
 
 	if err != nil {
@@ -101,27 +186,115 @@ func (q *PostgresQueue) Dequeue(ctx context.Context) (*EnrichmentJob, error) {
 		return nil, fmt.Errorf("failed to update job: %w", err)
 	}
 
-	return &EnrichmentJob{
+	claimed := &EnrichmentJob{
 		ID:           updatedJob.ID.String(),
 		ExperienceID: updatedJob.ExperienceID.String(),
 		JobType:      JobType(updatedJob.JobType),
 		Text:         updatedJob.Text,
-	}, nil
+		Attempts:     updatedJob.Attempts,
+		Requeues:     updatedJob.Requeues,
+		TraceContext: updatedJob.TraceContext,
+		LeaseExpires: lease,
+	}
+	traceDequeue(ctx, []*EnrichmentJob{claimed}, start)
+	return claimed, nil
+}
+
+// DequeueBatch retrieves and locks up to limit pending jobs of the given type with short text.
+// Jobs claimed by another worker in the meantime are skipped.
+func (q *PostgresQueue) DequeueBatch(ctx context.Context, jobType JobType, limit, maxTextLength int) ([]*EnrichmentJob, error) {
+	if limit <= 0 {
+		return []*EnrichmentJob{}, nil
+	}
+	start := time.Now()
+
+	jobs, err := q.client.EnrichmentJob.
+		Query().
+		Where(
+			enrichmentjob.Status("pending"),
+			enrichmentjob.JobType(string(jobType)),
+			func(s *sql.Selector) {
+				s.Where(sql.ExprP(fmt.Sprintf("char_length(%s) <= %d", s.C(enrichmentjob.FieldText), maxTextLength)))
+			},
+		).
+		Order(ent.Desc("priority"), ent.Asc("created_at")).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query jobs: %w", err)
+	}
+
+	claimed := make([]*EnrichmentJob, 0, len(jobs))
+	for _, job := range jobs {
+		lease := q.newLease()
+		updatedJob, err := q.client.EnrichmentJob.
+			UpdateOneID(job.ID).
+			Where(enrichmentjob.Status("pending")).
+			SetStatus("processing").
+			SetAttempts(job.Attempts + 1).
+			SetLeaseExpiresAt(lease).
+			Save(ctx)
+
+		if err != nil {
+			if ent.IsNotFound(err) {
+				// Another worker claimed it
+				continue
+			}
+			return claimed, fmt.Errorf("failed to update job: %w", err)
+		}
+
+		claimed = append(claimed, &EnrichmentJob{
+			ID:           updatedJob.ID.String(),
+			ExperienceID: updatedJob.ExperienceID.String(),
+			JobType:      JobType(updatedJob.JobType),
+			Text:         updatedJob.Text,
+			Attempts:     updatedJob.Attempts,
+			Requeues:     updatedJob.Requeues,
+			TraceContext: updatedJob.TraceContext,
+			LeaseExpires: lease,
+		})
+	}
+
+	traceDequeue(ctx, claimed, start)
+	return claimed, nil
 }
 
-// MarkComplete marks a job as successfully completed
-func (q *PostgresQueue) MarkComplete(ctx context.Context, jobID string) error {
-	id, err := uuid.Parse(jobID)
+// Pending returns the number of pending jobs of the given type (any type if empty)
+func (q *PostgresQueue) Pending(ctx context.Context, jobType JobType) (int, error) {
+	query := q.client.EnrichmentJob.
+		Query().
+		Where(enrichmentjob.Status("pending"))
+	if jobType != "" {
+		query = query.Where(enrichmentjob.JobType(string(jobType)))
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending jobs: %w", err)
+	}
+
+	return count, nil
+}
+
+// MarkComplete marks a job as successfully completed, unless its lease expired
+func (q *PostgresQueue) MarkComplete(ctx context.Context, job *EnrichmentJob) error {
+	id, err := uuid.Parse(job.ID)
 	if err != nil {
 		return fmt.Errorf("invalid job ID: %w", err)
 	}
 
 	err = q.client.EnrichmentJob.
 		UpdateOneID(id).
+		Where(held(job)...).
 		SetStatus("completed").
 		SetProcessedAt(time.Now()).
+		ClearLeaseExpiresAt().
 		Exec(ctx)
 
+	if ent.IsNotFound(err) {
+		return leaseLost(job)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to mark job as complete: %w", err)
 	}
@@ -129,9 +302,22 @@ func (q *PostgresQueue) MarkComplete(ctx context.Context, jobID string) error {
 	return nil
 }
 
-// MarkFailed marks a job as failed with an error message
-func (q *PostgresQueue) MarkFailed(ctx context.Context, jobID string, jobErr error) error {
-	id, err := uuid.Parse(jobID)
+// MarkFailed records a failed attempt. The job is put back in the queue if it has attempts
+// left, and moved to the dead-letter queue otherwise.
+func (q *PostgresQueue) MarkFailed(ctx context.Context, job *EnrichmentJob, jobErr error) error {
+	return q.recordFailure(ctx, job, jobErr, true)
+}
+
+// MarkDeadLetter records a failed attempt and moves the job to the dead-letter queue
+// without retrying it
+func (q *PostgresQueue) MarkDeadLetter(ctx context.Context, job *EnrichmentJob, jobErr error) error {
+	return q.recordFailure(ctx, job, jobErr, false)
+}
+
+// recordFailure appends the error to the job's error history and either retries the job
+// or moves it to the dead-letter queue, unless its lease expired
+func (q *PostgresQueue) recordFailure(ctx context.Context, claimed *EnrichmentJob, jobErr error, retry bool) error {
+	id, err := uuid.Parse(claimed.ID)
 	if err != nil {
 		return fmt.Errorf("invalid job ID: %w", err)
 	}
@@ -142,15 +328,151 @@ func (q *PostgresQueue) MarkFailed(ctx context.Context, jobID string, jobErr err
 		errorMsg = jobErr.Error()
 	}
 
+	job, err := q.client.EnrichmentJob.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get job: %w", err)
+	}
+
+	err = q.failureUpdate(job, errorMsg, retry).
+		Where(held(claimed)...).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return leaseLost(claimed)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to mark job as failed: %w", err)
+	}
+
+	return nil
+}
+
+// failureUpdate builds the update that records a failed attempt of the job
+func (q *PostgresQueue) failureUpdate(job *ent.EnrichmentJob, errorMsg string, retry bool) *ent.EnrichmentJobUpdateOne {
+	now := time.Now()
+	history := append(job.ErrorHistory, schema.JobError{
+		Attempt: job.Attempts,
+		Error:   errorMsg,
+		At:      now,
+	})
+
+	update := q.client.EnrichmentJob.
+		UpdateOneID(job.ID).
+		SetError(errorMsg).
+		SetErrorHistory(history).
+		ClearLeaseExpiresAt()
+
+	if retry && job.Attempts < q.maxAttempts {
+		update.SetStatus("pending")
+	} else {
+		update.
+			SetStatus("dead_letter").
+			SetProcessedAt(now)
+	}
+
+	return update
+}
+
+// ReclaimExpired returns processing jobs whose lease has expired to the queue, counting
+// the lost attempt as a failure. Jobs without a lease were claimed by an older version and
+// are reclaimed as well. Returns the number of reclaimed jobs.
+func (q *PostgresQueue) ReclaimExpired(ctx context.Context) (int, error) {
+	jobs, err := q.client.EnrichmentJob.
+		Query().
+		Where(expired()).
+		All(ctx)
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to query expired jobs: %w", err)
+	}
+
+	reclaimed := 0
+	for _, job := range jobs {
+		// Only reclaim the job if it is still in the state we found it in; its worker may
+		// have finished it in the meantime
+		update := q.failureUpdate(job, "processing lease expired (worker stopped or timed out)", true).
+			Where(enrichmentjob.Status("processing"))
+		if job.LeaseExpiresAt != nil {
+			update.Where(enrichmentjob.LeaseExpiresAt(*job.LeaseExpiresAt))
+		} else {
+			update.Where(enrichmentjob.LeaseExpiresAtIsNil())
+		}
+
+		err := update.Exec(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+			return reclaimed, fmt.Errorf("failed to reclaim job: %w", err)
+		}
+		reclaimed++
+	}
+
+	return reclaimed, nil
+}
+
+// Requeue puts a processing job back into the pending state to be retried later. The
+// attempt isn't counted, so rate limits don't use up the job's attempts; it is counted
+// as a requeue instead. Jobs whose lease expired (e.g., they were reclaimed meanwhile)
+// are left alone.
+func (q *PostgresQueue) Requeue(ctx context.Context, job *EnrichmentJob) error {
+	id, err := uuid.Parse(job.ID)
+	if err != nil {
+		return fmt.Errorf("invalid job ID: %w", err)
+	}
+
 	err = q.client.EnrichmentJob.
 		UpdateOneID(id).
-		SetStatus("failed").
-		SetError(errorMsg).
-		SetProcessedAt(time.Now()).
+		Where(held(job)...).
+		SetStatus("pending").
+		AddAttempts(-1).
+		AddRequeues(1).
+		ClearLeaseExpiresAt().
+		Exec(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to requeue job: %w", err)
+	}
+
+	return nil
+}
+
+// newLease returns when a job claimed now expires. Postgres stores timestamps with
+// microsecond precision, so the lease is truncated to compare equal to the stored one.
+func (q *PostgresQueue) newLease() time.Time {
+	return time.Now().Add(q.visibilityTimeout).Truncate(time.Microsecond)
+}
+
+// held matches a job that is still processing with the lease it was dequeued with, i.e.
+// it wasn't reclaimed (and possibly dequeued by another worker) since
+func held(job *EnrichmentJob) []predicate.EnrichmentJob {
+	return []predicate.EnrichmentJob{
+		enrichmentjob.Status("processing"),
+		enrichmentjob.LeaseExpiresAt(job.LeaseExpires),
+	}
+}
+
+// leaseLost is returned when a job is finished after its lease expired
+func leaseLost(job *EnrichmentJob) error {
+	return fmt.Errorf("job %s is not in flight (its lease may have expired)", job.ID)
+}
+
+// CancelPending removes pending jobs for an experience that have not been picked up yet
+func (q *PostgresQueue) CancelPending(ctx context.Context, experienceID string) error {
+	expID, err := uuid.Parse(experienceID)
+	if err != nil {
+		return fmt.Errorf("invalid experience ID: %w", err)
+	}
+
+	_, err = q.client.EnrichmentJob.
+		Delete().
+		Where(
+			enrichmentjob.ExperienceID(expID),
+			enrichmentjob.Status("pending"),
+		).
 		Exec(ctx)
 
 	if err != nil {
-		return fmt.Errorf("failed to mark job as failed: %w", err)
+		return fmt.Errorf("failed to cancel pending jobs: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,303 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/testdb"
+)
+
+// createExperience stores an experience for jobs to belong to
+func createExperience(t *testing.T, client *ent.Client) *ent.ExperienceData {
+	t.Helper()
+	exp, err := client.ExperienceData.Create().
+		SetSourceType("survey").
+		SetFieldID("q1").
+		SetFieldType("text").
+		Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return exp
+}
+
+func TestPostgresReclaimedLease(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	exp := createExperience(t, client)
+
+	// A worker whose lease runs out right away, e.g. because its job takes too long, and
+	// one that gets the job after it was reclaimed
+	slow := NewPostgresQueue(client, 3, -time.Minute)
+	q := NewPostgresQueue(client, 3, time.Minute)
+
+	if err := q.Enqueue(ctx, exp.ID.String(), "The exports keep timing out", PriorityNormal); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	first, err := slow.Dequeue(ctx, "")
+	if err != nil || first == nil {
+		t.Fatalf("Dequeue() = %v, %v; want a job", first, err)
+	}
+
+	if n, err := q.ReclaimExpired(ctx); err != nil || n != 1 {
+		t.Fatalf("ReclaimExpired() = %d, %v; want 1", n, err)
+	}
+	second, err := q.Dequeue(ctx, "")
+	if err != nil || second == nil || second.ID != first.ID {
+		t.Fatalf("Dequeue() = %v, %v; want the reclaimed job", second, err)
+	}
+	if second.Attempts != 2 {
+		t.Errorf("Attempts = %d, want 2", second.Attempts)
+	}
+
+	status := func() string {
+		t.Helper()
+		job, err := client.EnrichmentJob.Get(ctx, uuid.MustParse(first.ID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return job.Status
+	}
+
+	// The first worker finishes after its lease expired; the job belongs to the second one
+	if err := slow.MarkComplete(ctx, first); err == nil {
+		t.Error("MarkComplete() with an expired lease should fail")
+	}
+	if err := slow.MarkFailed(ctx, first, errors.New("provider unavailable")); err == nil {
+		t.Error("MarkFailed() with an expired lease should fail")
+	}
+	if err := slow.MarkDeadLetter(ctx, first, errors.New("invalid text")); err == nil {
+		t.Error("MarkDeadLetter() with an expired lease should fail")
+	}
+	if err := slow.Requeue(ctx, first); err != nil {
+		t.Errorf("Requeue() error = %v", err)
+	}
+	if got := status(); got != "processing" {
+		t.Fatalf("status = %q after the first worker finished, want processing", got)
+	}
+
+	if err := q.MarkComplete(ctx, second); err != nil {
+		t.Fatalf("MarkComplete() error = %v", err)
+	}
+	if got := status(); got != "completed" {
+		t.Errorf("status = %q, want completed", got)
+	}
+
+	job, err := client.EnrichmentJob.Get(ctx, uuid.MustParse(first.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(job.ErrorHistory) != 1 || job.ErrorHistory[0].Attempt != 1 {
+		t.Errorf("expected only the expired lease in the error history, got %+v", job.ErrorHistory)
+	}
+}
+
+func TestPostgresDeadLetter(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	exp := createExperience(t, client)
+
+	q := NewPostgresQueue(client, 2, time.Minute)
+	dequeue := func() *EnrichmentJob {
+		t.Helper()
+		job, err := q.Dequeue(ctx, "")
+		if err != nil || job == nil {
+			t.Fatalf("Dequeue() = %v, %v; want a job", job, err)
+		}
+		return job
+	}
+	stored := func(job *EnrichmentJob) *ent.EnrichmentJob {
+		t.Helper()
+		got, err := client.EnrichmentJob.Get(ctx, uuid.MustParse(job.ID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return got
+	}
+
+	t.Run("failed attempts", func(t *testing.T) {
+		if err := q.Enqueue(ctx, exp.ID.String(), "The exports keep timing out", PriorityNormal); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+
+		// Rate limits put the job back without using up its attempts
+		for i := 1; i <= 3; i++ {
+			job := dequeue()
+			if job.Attempts != 1 || job.Requeues != i-1 {
+				t.Fatalf("claim %d: Attempts = %d, Requeues = %d", i, job.Attempts, job.Requeues)
+			}
+			if err := q.Requeue(ctx, job); err != nil {
+				t.Fatalf("Requeue() error = %v", err)
+			}
+		}
+
+		job := dequeue()
+		if err := q.MarkFailed(ctx, job, errors.New("provider unavailable")); err != nil {
+			t.Fatalf("MarkFailed() error = %v", err)
+		}
+		if got := stored(job); got.Status != "pending" || got.ProcessedAt != nil {
+			t.Errorf("after the first failure: status = %q, want pending", got.Status)
+		}
+
+		job = dequeue()
+		if job.Attempts != 2 || job.Requeues != 3 {
+			t.Errorf("Attempts = %d, Requeues = %d; want 2 and 3", job.Attempts, job.Requeues)
+		}
+		if err := q.MarkFailed(ctx, job, errors.New("provider unavailable")); err != nil {
+			t.Fatalf("MarkFailed() error = %v", err)
+		}
+		got := stored(job)
+		if got.Status != "dead_letter" || got.ProcessedAt == nil || got.LeaseExpiresAt != nil {
+			t.Errorf("after the last attempt: status = %q, processed_at = %v, lease_expires_at = %v", got.Status, got.ProcessedAt, got.LeaseExpiresAt)
+		}
+		if len(got.ErrorHistory) != 2 || got.ErrorHistory[0].Attempt != 1 || got.ErrorHistory[1].Attempt != 2 {
+			t.Errorf("unexpected error history %+v", got.ErrorHistory)
+		}
+		if next, _ := q.Dequeue(ctx, ""); next != nil {
+			t.Errorf("Dequeue() returned a dead-letter job: %+v", next)
+		}
+	})
+
+	t.Run("permanent failure", func(t *testing.T) {
+		if err := q.Enqueue(ctx, exp.ID.String(), "Crashes on login", PriorityNormal); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+
+		job := dequeue()
+		if err := q.MarkDeadLetter(ctx, job, errors.New("invalid text")); err != nil {
+			t.Fatalf("MarkDeadLetter() error = %v", err)
+		}
+		if got := stored(job); got.Status != "dead_letter" || len(got.ErrorHistory) != 1 || got.Attempts != 1 {
+			t.Errorf("status = %q with %d errors after %d attempts, want dead_letter after the first", got.Status, len(got.ErrorHistory), got.Attempts)
+		}
+	})
+}
+
+func TestPostgresPriority(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	q := NewPostgresQueue(client, 3, time.Minute)
+
+	// Enqueued in this order, one experience each so the jobs aren't coalesced
+	enqueued := []struct {
+		text     string
+		priority Priority
+	}{
+		{"backfill", PriorityLow},
+		{"first update", PriorityNormal},
+		{"analyst", PriorityHigh},
+		{"second update", PriorityNormal},
+	}
+	for _, job := range enqueued {
+		if err := q.Enqueue(ctx, createExperience(t, client).ID.String(), job.text, job.priority); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+
+	if job, err := q.Dequeue(ctx, JobTypeEmbedding); err != nil || job != nil {
+		t.Errorf("Dequeue(embedding) = %v, %v; want no job", job, err)
+	}
+
+	// Highest priority first, oldest first within a priority
+	batch, err := q.DequeueBatch(ctx, JobTypeEnrichment, 2, 280)
+	if err != nil {
+		t.Fatalf("DequeueBatch() error = %v", err)
+	}
+	var order []string
+	for _, job := range batch {
+		order = append(order, job.Text)
+	}
+	for {
+		job, err := q.Dequeue(ctx, "")
+		if err != nil {
+			t.Fatalf("Dequeue() error = %v", err)
+		}
+		if job == nil {
+			break
+		}
+		order = append(order, job.Text)
+	}
+
+	want := []string{"analyst", "first update", "second update", "backfill"}
+	if !slices.Equal(order, want) {
+		t.Errorf("jobs dequeued in order %q, want %q", order, want)
+	}
+}
+
+func TestPostgresCoalescing(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	q := NewPostgresQueue(client, 3, time.Minute)
+	exp := createExperience(t, client)
+	pending := func() []*ent.EnrichmentJob {
+		t.Helper()
+		jobs, err := client.EnrichmentJob.Query().
+			Where(enrichmentjob.ExperienceID(exp.ID), enrichmentjob.Status("pending")).
+			Order(ent.Asc(enrichmentjob.FieldCreatedAt)).
+			All(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return jobs
+	}
+
+	// Updates of a pending job replace its text and keep the higher priority
+	for _, update := range []struct {
+		text     string
+		priority Priority
+	}{
+		{"The exports are slow", PriorityNormal},
+		{"The exports time out", PriorityLow},
+	} {
+		if err := q.Enqueue(ctx, exp.ID.String(), update.text, update.priority); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+	jobs := pending()
+	if len(jobs) != 1 || jobs[0].Text != "The exports time out" || jobs[0].Priority != int(PriorityNormal) {
+		t.Fatalf("pending jobs = %+v, want one with the latest text at normal priority", jobs)
+	}
+
+	if err := q.Enqueue(ctx, exp.ID.String(), "The exports time out", PriorityHigh); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if jobs := pending(); len(jobs) != 1 || jobs[0].Priority != int(PriorityHigh) {
+		t.Errorf("pending jobs = %+v, want one at high priority", jobs)
+	}
+
+	// Other job types are queued separately
+	if err := q.EnqueueEmbedding(ctx, exp.ID.String(), "The exports time out", PriorityNormal); err != nil {
+		t.Fatalf("EnqueueEmbedding() error = %v", err)
+	}
+	if jobs := pending(); len(jobs) != 2 {
+		t.Fatalf("got %d pending jobs, want the enrichment and embedding jobs", len(jobs))
+	}
+
+	// A job that is being processed works on the old text, so new text gets a new job
+	claimed, err := q.Dequeue(ctx, JobTypeEnrichment)
+	if err != nil || claimed == nil {
+		t.Fatalf("Dequeue() = %v, %v; want a job", claimed, err)
+	}
+	if err := q.Enqueue(ctx, exp.ID.String(), "The exports work again", PriorityNormal); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	jobs = pending()
+	if len(jobs) != 2 || jobs[1].Text != "The exports work again" || jobs[1].ID.String() == claimed.ID {
+		t.Errorf("pending jobs = %+v, want a new enrichment job next to the embedding job", jobs)
+	}
+}
@@ -1,10 +1,15 @@
 // Package queue provides job queue abstraction for asynchronous background processing.
-// The Queue interface allows swapping implementations (PostgreSQL, Redis, RabbitMQ, etc.)
+// The Queue interface allows swapping implementations (PostgreSQL, Amazon SQS, etc.)
 // without changing worker or API code.
 package queue
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/config"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
 )
 
 // JobType defines the type of job to process
@@ -15,12 +20,38 @@ const (
 	JobTypeEmbedding  JobType = "embedding"  // Vector embedding generation
 )
 
+// Priority controls the order in which pending jobs are processed; higher runs first
+type Priority int
+
+const (
+	PriorityLow    Priority = -10 // Backfills and bulk re-processing
+	PriorityNormal Priority = 0   // Newly created or updated experiences
+	PriorityHigh   Priority = 10  // Interactive requests, e.g. an analyst re-enriching a record
+)
+
+// ParsePriority converts a priority name (low, normal, high) to a Priority.
+// Empty and unknown names map to PriorityNormal.
+func ParsePriority(name string) Priority {
+	switch name {
+	case "low":
+		return PriorityLow
+	case "high":
+		return PriorityHigh
+	default:
+		return PriorityNormal
+	}
+}
+
 // EnrichmentJob represents a job to process text (enrichment or embedding)
 type EnrichmentJob struct {
 	ID           string
 	ExperienceID string
 	JobType      JobType
 	Text         string
+	Attempts     int       // Processing attempts, including the current one
+	Requeues     int       // Times the job was put back in the queue without counting as an attempt
+	TraceContext string    // W3C traceparent of the request that enqueued the job, if it was traced
+	LeaseExpires time.Time // When the job is returned to the queue if its worker doesn't finish it
 }
 
 // Queue defines the interface for job queue operations.
@@ -28,18 +59,74 @@ type EnrichmentJob struct {
 // without changing the worker or API code.
 type Queue interface {
 	// Enqueue adds a new enrichment job to the queue
-	Enqueue(ctx context.Context, experienceID, text string) error
+	Enqueue(ctx context.Context, experienceID, text string, priority Priority) error
 
 	// EnqueueEmbedding adds a new embedding job to the queue
-	EnqueueEmbedding(ctx context.Context, experienceID, text string) error
+	EnqueueEmbedding(ctx context.Context, experienceID, text string, priority Priority) error
 
-	// Dequeue retrieves and locks the next pending job for processing.
+	// EnqueueJob adds a new job of any type to the queue, e.g. for a handler registered
+	// with the worker. Backends may coalesce it with a pending job of the same type for
+	// the experience, since only the latest text needs processing.
+	EnqueueJob(ctx context.Context, jobType JobType, experienceID, text string, priority Priority) error
+
+	// Dequeue retrieves and locks the next pending job of the given type (any type if empty)
+	// for processing, highest priority first and oldest first within a priority.
 	// Returns nil if no jobs are available.
-	Dequeue(ctx context.Context) (*EnrichmentJob, error)
+	Dequeue(ctx context.Context, jobType JobType) (*EnrichmentJob, error)
+
+	// DequeueBatch retrieves and locks up to limit pending jobs of the given type whose
+	// text is at most maxTextLength characters long. Returns an empty slice if none are available.
+	DequeueBatch(ctx context.Context, jobType JobType, limit, maxTextLength int) ([]*EnrichmentJob, error)
+
+	// Pending returns the number of jobs of the given type (any type if empty) waiting to
+	// be processed
+	Pending(ctx context.Context, jobType JobType) (int, error)
+
+	// MarkComplete marks a dequeued job as successfully completed. Fails if the job's
+	// lease expired, since it may have been reclaimed and dequeued again since.
+	MarkComplete(ctx context.Context, job *EnrichmentJob) error
+
+	// MarkFailed records a failed attempt of a dequeued job. The job is retried if it has
+	// attempts left, and moved to the dead-letter queue otherwise. Fails if the job's
+	// lease expired.
+	MarkFailed(ctx context.Context, job *EnrichmentJob, err error) error
+
+	// MarkDeadLetter moves a dequeued job that can never succeed (e.g., invalid data) to
+	// the dead-letter queue without retrying it. Fails if the job's lease expired.
+	MarkDeadLetter(ctx context.Context, job *EnrichmentJob, err error) error
+
+	// Requeue puts a dequeued job back into the pending state to be retried later (e.g.,
+	// after a rate limit), without counting the attempt. Jobs whose lease expired are
+	// left alone.
+	Requeue(ctx context.Context, job *EnrichmentJob) error
+
+	// ReclaimExpired returns processing jobs whose lease has expired (e.g., because their
+	// worker crashed) to the queue. Returns the number of reclaimed jobs.
+	ReclaimExpired(ctx context.Context) (int, error)
+
+	// CancelPending removes pending jobs for an experience (e.g., when its text changed)
+	CancelPending(ctx context.Context, experienceID string) error
+}
 
-	// MarkComplete marks a job as successfully completed
-	MarkComplete(ctx context.Context, jobID string) error
+// NewFromConfig creates the queue backend selected in the configuration
+func NewFromConfig(client *ent.Client, cfg *config.Config) (Queue, error) {
+	visibilityTimeout := time.Duration(cfg.JobVisibilityTimeout) * time.Second
 
-	// MarkFailed marks a job as failed with an error message
-	MarkFailed(ctx context.Context, jobID string, err error) error
+	switch cfg.QueueBackend {
+	case "postgres", "":
+		return NewPostgresQueue(client, cfg.JobMaxAttempts, visibilityTimeout), nil
+	case "sqs":
+		return NewSQSQueue(SQSConfig{
+			QueueURL:           cfg.SQSQueueURL,
+			DeadLetterQueueURL: cfg.SQSDeadLetterQueueURL,
+			Region:             cfg.AWSRegion,
+			AccessKeyID:        cfg.AWSAccessKeyID,
+			SecretAccessKey:    cfg.AWSSecretAccessKey,
+			SessionToken:       cfg.AWSSessionToken,
+			MaxAttempts:        cfg.JobMaxAttempts,
+			VisibilityTimeout:  visibilityTimeout,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported queue backend: %s", cfg.QueueBackend)
+	}
 }
@@ -0,0 +1,520 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
+	"github.com/google/uuid"
+)
+
+const (
+	// sqsMaxMessages is the most messages a single ReceiveMessage call can return
+	sqsMaxMessages = 10
+	// maxSQSErrorBodySize limits how much of an error response body is included in errors
+	maxSQSErrorBodySize = 1024
+	// leaseExpiredError is recorded when a job's worker didn't finish it in time
+	leaseExpiredError = "processing lease expired (worker stopped or timed out)"
+)
+
+// SQSConfig holds the settings of an SQS-backed queue
+type SQSConfig struct {
+	QueueURL           string // Queue that holds pending jobs
+	DeadLetterQueueURL string // Queue that receives jobs which exhausted their attempts
+	Region             string // Defaults to the region in QueueURL
+	AccessKeyID        string // Optional; defaults to the AWS_* environment variables or the ECS task role
+	SecretAccessKey    string
+	SessionToken       string
+	MaxAttempts        int
+	VisibilityTimeout  time.Duration
+}
+
+// sqsMessage is the body of a job message
+type sqsMessage struct {
+	ID           string            `json:"id"`
+	ExperienceID string            `json:"experience_id"`
+	JobType      JobType           `json:"job_type"`
+	Text         string            `json:"text"`
+	Priority     Priority          `json:"priority"`
+	Attempts     int               `json:"attempts"` // Attempts made before the message was last sent
+	Requeues     int               `json:"requeues,omitempty"`
+	ErrorHistory []schema.JobError `json:"error_history,omitempty"`
+	EnqueuedAt   time.Time         `json:"enqueued_at"`
+	TraceContext string            `json:"trace_context,omitempty"`
+}
+
+// sqsInFlight is a received message that hasn't been completed, failed, or requeued yet
+type sqsInFlight struct {
+	receiptHandle  string
+	message        sqsMessage
+	attempts       int
+	leaseExpiresAt time.Time
+}
+
+// SQSQueue implements the Queue interface using Amazon SQS, so workers can scale
+// independently of the database.
+//
+// The processing state maps to SQS visibility: a received message is hidden for the
+// visibility timeout, which acts as the job's lease. If its worker doesn't finish it in
+// time, SQS makes it visible again and the next receive counts as a new attempt. A failed
+// job that has attempts left is sent again with its error history, and a job that
+// exhausted them is sent to the dead-letter queue.
+//
+// SQS has no priorities or selective deletes, so priorities are recorded on the message
+// but not used for ordering, queued jobs aren't coalesced, and CancelPending is a no-op.
+// Jobs only exist in SQS, so the /v1/jobs endpoints don't show them.
+type SQSQueue struct {
+	client            *sqsClient
+	queueURL          string
+	deadLetterURL     string
+	maxAttempts       int
+	visibilityTimeout time.Duration
+
+	mu       sync.Mutex
+	inFlight map[string]*sqsInFlight // Received jobs by job ID
+}
+
+// NewSQSQueue creates a new SQS-backed queue
+func NewSQSQueue(cfg SQSConfig) (*SQSQueue, error) {
+	if cfg.QueueURL == "" {
+		return nil, fmt.Errorf("sqs queue backend requires a queue URL")
+	}
+	if cfg.DeadLetterQueueURL == "" {
+		return nil, fmt.Errorf("sqs queue backend requires a dead-letter queue URL")
+	}
+
+	queueURL, err := url.Parse(cfg.QueueURL)
+	if err != nil || queueURL.Host == "" {
+		return nil, fmt.Errorf("invalid sqs queue URL: %s", cfg.QueueURL)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = regionFromSQSHost(queueURL.Hostname())
+		if region == "" {
+			return nil, fmt.Errorf("could not determine the AWS region from %s; set it explicitly", cfg.QueueURL)
+		}
+	}
+
+	return &SQSQueue{
+		client: &sqsClient{
+			endpoint:    queueURL.Scheme + "://" + queueURL.Host + "/",
+			region:      region,
+			credentials: newAWSCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, cfg.SessionToken),
+			httpClient:  &http.Client{Timeout: 30 * time.Second},
+		},
+		queueURL:          cfg.QueueURL,
+		deadLetterURL:     cfg.DeadLetterQueueURL,
+		maxAttempts:       cfg.MaxAttempts,
+		visibilityTimeout: cfg.VisibilityTimeout,
+		inFlight:          make(map[string]*sqsInFlight),
+	}, nil
+}
+
+// regionFromSQSHost extracts the region from an SQS endpoint such as
+// sqs.eu-central-1.amazonaws.com. Returns an empty string for other hosts.
+func regionFromSQSHost(host string) string {
+	parts := strings.Split(host, ".")
+	if len(parts) >= 4 && parts[0] == "sqs" {
+		return parts[1]
+	}
+	return ""
+}
+
+// Enqueue adds a new enrichment job to the queue
+func (q *SQSQueue) Enqueue(ctx context.Context, experienceID, text string, priority Priority) error {
+	return q.EnqueueJob(ctx, JobTypeEnrichment, experienceID, text, priority)
+}
+
+// EnqueueEmbedding adds a new embedding job to the queue
+func (q *SQSQueue) EnqueueEmbedding(ctx context.Context, experienceID, text string, priority Priority) error {
+	return q.EnqueueJob(ctx, JobTypeEmbedding, experienceID, text, priority)
+}
+
+// EnqueueJob adds a new job of any type to the queue
+func (q *SQSQueue) EnqueueJob(ctx context.Context, jobType JobType, experienceID, text string, priority Priority) (err error) {
+	ctx, span := startEnqueueSpan(ctx, jobType, experienceID)
+	defer func() { endEnqueueSpan(span, err) }()
+
+	if _, err := uuid.Parse(experienceID); err != nil {
+		return fmt.Errorf("invalid experience ID: %w", err)
+	}
+
+	err = q.send(ctx, q.queueURL, sqsMessage{
+		ID:           uuid.NewString(),
+		ExperienceID: experienceID,
+		JobType:      jobType,
+		Text:         text,
+		Priority:     priority,
+		EnqueuedAt:   time.Now(),
+		TraceContext: tracing.TraceParent(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s job: %w", jobType, err)
+	}
+
+	return nil
+}
+
+// Dequeue receives the next visible job and hides it for the visibility timeout. With a
+// job type, received jobs of other types are sent back to the queue unchanged.
+// Returns nil if no jobs are available.
+func (q *SQSQueue) Dequeue(ctx context.Context, jobType JobType) (*EnrichmentJob, error) {
+	var keep func(*sqsMessage) bool
+	if jobType != "" {
+		keep = func(msg *sqsMessage) bool { return msg.JobType == jobType }
+	}
+
+	jobs, err := q.receive(ctx, 1, keep)
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+// DequeueBatch receives up to limit jobs of the given type with short text. SQS can't
+// filter messages, so other received jobs are sent back to the queue unchanged.
+func (q *SQSQueue) DequeueBatch(ctx context.Context, jobType JobType, limit, maxTextLength int) ([]*EnrichmentJob, error) {
+	if limit <= 0 {
+		return []*EnrichmentJob{}, nil
+	}
+
+	return q.receive(ctx, min(limit, sqsMaxMessages), func(msg *sqsMessage) bool {
+		return msg.JobType == jobType && len([]rune(msg.Text)) <= maxTextLength
+	})
+}
+
+// receive claims up to maxMessages jobs. Messages rejected by keep are released, and
+// messages whose attempts ran out while they were hidden (their worker never finished
+// them) are moved to the dead-letter queue.
+func (q *SQSQueue) receive(ctx context.Context, maxMessages int, keep func(*sqsMessage) bool) (jobs []*EnrichmentJob, err error) {
+	start := time.Now()
+	defer func() { traceDequeue(ctx, jobs, start) }()
+
+	var resp struct {
+		Messages []struct {
+			ReceiptHandle string            `json:"ReceiptHandle"`
+			Body          string            `json:"Body"`
+			Attributes    map[string]string `json:"Attributes"`
+		} `json:"Messages"`
+	}
+	err = q.client.call(ctx, "ReceiveMessage", map[string]any{
+		"QueueUrl":                    q.queueURL,
+		"MaxNumberOfMessages":         maxMessages,
+		"VisibilityTimeout":           int(q.visibilityTimeout.Seconds()),
+		"MessageSystemAttributeNames": []string{"ApproximateReceiveCount"},
+	}, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive jobs: %w", err)
+	}
+
+	jobs = make([]*EnrichmentJob, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		var msg sqsMessage
+		if err := json.Unmarshal([]byte(m.Body), &msg); err != nil || msg.ID == "" {
+			// Not a job message; park it in the dead-letter queue as-is for inspection
+			if err := q.moveRaw(ctx, m.ReceiptHandle, m.Body); err != nil {
+				return jobs, err
+			}
+			continue
+		}
+
+		receiveCount, _ := strconv.Atoi(m.Attributes["ApproximateReceiveCount"])
+		entry := &sqsInFlight{
+			receiptHandle:  m.ReceiptHandle,
+			message:        msg,
+			attempts:       msg.Attempts + max(receiveCount, 1),
+			leaseExpiresAt: time.Now().Add(q.visibilityTimeout),
+		}
+
+		if entry.attempts > q.maxAttempts {
+			// Every earlier receive ended with an expired lease
+			entry.attempts--
+			if err := q.fail(ctx, entry, leaseExpiredError, false); err != nil {
+				return jobs, err
+			}
+			continue
+		}
+
+		if keep != nil && !keep(&msg) {
+			// Send it back without counting this receive as an attempt
+			msg.Attempts = entry.attempts - 1
+			if err := q.resend(ctx, entry.receiptHandle, q.queueURL, msg); err != nil {
+				return jobs, err
+			}
+			continue
+		}
+
+		q.mu.Lock()
+		q.inFlight[msg.ID] = entry
+		q.mu.Unlock()
+
+		jobs = append(jobs, &EnrichmentJob{
+			ID:           msg.ID,
+			ExperienceID: msg.ExperienceID,
+			JobType:      msg.JobType,
+			Text:         msg.Text,
+			Attempts:     entry.attempts,
+			Requeues:     msg.Requeues,
+			TraceContext: msg.TraceContext,
+			LeaseExpires: entry.leaseExpiresAt,
+		})
+	}
+
+	return jobs, nil
+}
+
+// Pending returns the approximate number of visible messages. SQS can't count messages
+// by content, so the count includes jobs of all types.
+func (q *SQSQueue) Pending(ctx context.Context, _ JobType) (int, error) {
+	var resp struct {
+		Attributes map[string]string `json:"Attributes"`
+	}
+	err := q.client.call(ctx, "GetQueueAttributes", map[string]any{
+		"QueueUrl":       q.queueURL,
+		"AttributeNames": []string{"ApproximateNumberOfMessages"},
+	}, &resp)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count pending jobs: %w", err)
+	}
+
+	count, err := strconv.Atoi(resp.Attributes["ApproximateNumberOfMessages"])
+	if err != nil {
+		return 0, fmt.Errorf("invalid sqs message count: %w", err)
+	}
+
+	return count, nil
+}
+
+// MarkComplete deletes a finished job from the queue
+func (q *SQSQueue) MarkComplete(ctx context.Context, job *EnrichmentJob) error {
+	entry, err := q.take(job.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := q.delete(ctx, entry.receiptHandle); err != nil {
+		return fmt.Errorf("failed to mark job as complete: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records a failed attempt. The job is sent again if it has attempts left,
+// and moved to the dead-letter queue otherwise.
+func (q *SQSQueue) MarkFailed(ctx context.Context, job *EnrichmentJob, jobErr error) error {
+	return q.recordFailure(ctx, job.ID, jobErr, true)
+}
+
+// MarkDeadLetter records a failed attempt and moves the job to the dead-letter queue
+// without retrying it
+func (q *SQSQueue) MarkDeadLetter(ctx context.Context, job *EnrichmentJob, jobErr error) error {
+	return q.recordFailure(ctx, job.ID, jobErr, false)
+}
+
+// recordFailure appends the error to the job's error history and either retries the job
+// or moves it to the dead-letter queue
+func (q *SQSQueue) recordFailure(ctx context.Context, jobID string, jobErr error, retry bool) error {
+	entry, err := q.take(jobID)
+	if err != nil {
+		return err
+	}
+
+	// Guard against nil errors
+	errorMsg := "unknown error"
+	if jobErr != nil {
+		errorMsg = jobErr.Error()
+	}
+
+	if err := q.fail(ctx, entry, errorMsg, retry); err != nil {
+		return fmt.Errorf("failed to mark job as failed: %w", err)
+	}
+
+	return nil
+}
+
+// fail records the error on the message and sends it to the main queue if it should be
+// retried, or to the dead-letter queue otherwise
+func (q *SQSQueue) fail(ctx context.Context, entry *sqsInFlight, errorMsg string, retry bool) error {
+	msg := entry.message
+	msg.Attempts = entry.attempts
+	msg.ErrorHistory = append(msg.ErrorHistory, schema.JobError{
+		Attempt: entry.attempts,
+		Error:   errorMsg,
+		At:      time.Now(),
+	})
+
+	target := q.deadLetterURL
+	if retry && entry.attempts < q.maxAttempts {
+		target = q.queueURL
+	}
+
+	return q.resend(ctx, entry.receiptHandle, target, msg)
+}
+
+// ReclaimExpired forgets received jobs whose lease has expired. SQS makes their messages
+// visible again by itself, and the next receive counts as a new attempt. Returns the
+// number of expired jobs.
+func (q *SQSQueue) ReclaimExpired(_ context.Context) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	expired := 0
+	for id, entry := range q.inFlight {
+		if entry.leaseExpiresAt.Before(now) {
+			delete(q.inFlight, id)
+			expired++
+		}
+	}
+
+	return expired, nil
+}
+
+// Requeue sends a job again right away, e.g. after a rate limit, without counting the
+// attempt. Jobs that are no longer in flight are left alone.
+func (q *SQSQueue) Requeue(ctx context.Context, job *EnrichmentJob) error {
+	entry, err := q.take(job.ID)
+	if err != nil {
+		// The job already finished or its lease expired
+		return nil
+	}
+
+	// Making the message visible again would count the receive as an attempt
+	msg := entry.message
+	msg.Attempts = entry.attempts - 1
+	msg.Requeues++
+	if err := q.resend(ctx, entry.receiptHandle, q.queueURL, msg); err != nil {
+		return fmt.Errorf("failed to requeue job: %w", err)
+	}
+
+	return nil
+}
+
+// CancelPending is a no-op: SQS can't delete messages by experience. A job for outdated
+// text is still processed; since queues deliver roughly in order, its result is usually
+// replaced by the job enqueued for the new text.
+func (q *SQSQueue) CancelPending(_ context.Context, _ string) error {
+	return nil
+}
+
+// take removes a received job from the in-flight jobs
+func (q *SQSQueue) take(jobID string) (*sqsInFlight, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entry, ok := q.inFlight[jobID]
+	if !ok {
+		return nil, fmt.Errorf("job %s is not in flight (its lease may have expired)", jobID)
+	}
+	delete(q.inFlight, jobID)
+	return entry, nil
+}
+
+// send adds a job message to a queue
+func (q *SQSQueue) send(ctx context.Context, queueURL string, msg sqsMessage) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job message: %w", err)
+	}
+
+	return q.client.call(ctx, "SendMessage", map[string]any{
+		"QueueUrl":    queueURL,
+		"MessageBody": string(body),
+	}, nil)
+}
+
+// resend sends an updated copy of a received message and deletes the original. The copy is
+// sent first, so a crash in between duplicates the job instead of losing it.
+func (q *SQSQueue) resend(ctx context.Context, receiptHandle, queueURL string, msg sqsMessage) error {
+	if err := q.send(ctx, queueURL, msg); err != nil {
+		return err
+	}
+	return q.delete(ctx, receiptHandle)
+}
+
+// moveRaw moves an unreadable message to the dead-letter queue unchanged
+func (q *SQSQueue) moveRaw(ctx context.Context, receiptHandle, body string) error {
+	err := q.client.call(ctx, "SendMessage", map[string]any{
+		"QueueUrl":    q.deadLetterURL,
+		"MessageBody": body,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to dead-letter invalid message: %w", err)
+	}
+	return q.delete(ctx, receiptHandle)
+}
+
+// delete removes a received message from the main queue
+func (q *SQSQueue) delete(ctx context.Context, receiptHandle string) error {
+	return q.client.call(ctx, "DeleteMessage", map[string]any{
+		"QueueUrl":      q.queueURL,
+		"ReceiptHandle": receiptHandle,
+	}, nil)
+}
+
+// sqsClient calls the SQS JSON API
+type sqsClient struct {
+	endpoint    string
+	region      string
+	credentials *awsCredentialsProvider
+	httpClient  *http.Client
+}
+
+// call sends a signed request for the action and decodes the response into out (if not nil)
+func (c *sqsClient) call(ctx context.Context, action string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sqs request: %w", err)
+	}
+
+	creds, err := c.credentials.Retrieve(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create sqs request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS."+action)
+	signAWSRequest(req, payload, creds, c.region, "sqs", time.Now())
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sqs %s error: %w", action, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxSQSErrorBodySize))
+		var apiErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(msg, &apiErr) == nil && apiErr.Type != "" {
+			return fmt.Errorf("sqs %s error: status %d: %s: %s", action, resp.StatusCode, apiErr.Type, apiErr.Message)
+		}
+		return fmt.Errorf("sqs %s error: status %d: %s", action, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode sqs %s response: %w", action, err)
+	}
+
+	return nil
+}
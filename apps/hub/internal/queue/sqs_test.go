@@ -0,0 +1,308 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSignAWSRequest(t *testing.T) {
+	// get-vanilla from the AWS Signature Version 4 test suite
+	req, _ := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	creds := awsCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	signAWSRequest(req, nil, creds, "us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, " +
+		"SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %q, want %q", got, want)
+	}
+}
+
+func TestRegionFromSQSHost(t *testing.T) {
+	tests := map[string]string{
+		"sqs.eu-central-1.amazonaws.com": "eu-central-1",
+		"sqs.us-east-1.amazonaws.com":    "us-east-1",
+		"localhost":                      "",
+		"queue.example.com":              "",
+	}
+	for host, want := range tests {
+		if got := regionFromSQSHost(host); got != want {
+			t.Errorf("regionFromSQSHost(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
+
+// fakeSQS is an in-memory SQS server for the actions used by SQSQueue
+type fakeSQS struct {
+	mu       sync.Mutex
+	queues   map[string][]*fakeMessage
+	handles  int
+	received map[string]int // Receive counts by message body
+}
+
+type fakeMessage struct {
+	body    string
+	handle  string
+	visible bool
+}
+
+func newFakeSQS() *fakeSQS {
+	return &fakeSQS{queues: make(map[string][]*fakeMessage), received: make(map[string]int)}
+}
+
+func (f *fakeSQS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=test/") {
+		http.Error(w, `{"__type":"com.amazonaws.sqs#InvalidClientTokenId","message":"unsigned"}`, http.StatusForbidden)
+		return
+	}
+
+	var req struct {
+		QueueURL            string `json:"QueueUrl"`
+		MessageBody         string `json:"MessageBody"`
+		ReceiptHandle       string `json:"ReceiptHandle"`
+		MaxNumberOfMessages int    `json:"MaxNumberOfMessages"`
+		VisibilityTimeout   int    `json:"VisibilityTimeout"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "AmazonSQS.") {
+	case "SendMessage":
+		f.queues[req.QueueURL] = append(f.queues[req.QueueURL], &fakeMessage{body: req.MessageBody, visible: true})
+		_, _ = w.Write([]byte(`{"MessageId":"1"}`))
+	case "ReceiveMessage":
+		var messages []map[string]any
+		for _, m := range f.queues[req.QueueURL] {
+			if !m.visible || len(messages) == req.MaxNumberOfMessages {
+				continue
+			}
+			f.handles++
+			m.handle = strconv.Itoa(f.handles)
+			m.visible = false
+			f.received[m.body]++
+			messages = append(messages, map[string]any{
+				"ReceiptHandle": m.handle,
+				"Body":          m.body,
+				"Attributes":    map[string]string{"ApproximateReceiveCount": strconv.Itoa(f.received[m.body])},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"Messages": messages})
+	case "DeleteMessage":
+		queue := f.queues[req.QueueURL]
+		for i, m := range queue {
+			if m.handle == req.ReceiptHandle {
+				f.queues[req.QueueURL] = append(queue[:i], queue[i+1:]...)
+				break
+			}
+		}
+		_, _ = w.Write([]byte(`{}`))
+	case "ChangeMessageVisibility":
+		for _, m := range f.queues[req.QueueURL] {
+			if m.handle == req.ReceiptHandle {
+				m.visible = req.VisibilityTimeout == 0
+			}
+		}
+		_, _ = w.Write([]byte(`{}`))
+	default:
+		http.Error(w, `{"__type":"com.amazonaws.sqs#InvalidAction","message":"unsupported"}`, http.StatusBadRequest)
+	}
+}
+
+// expire makes all hidden messages visible again, as if their visibility timeout passed
+func (f *fakeSQS) expire() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, queue := range f.queues {
+		for _, m := range queue {
+			m.visible = true
+		}
+	}
+}
+
+func (f *fakeSQS) messages(queueURL string) []sqsMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	result := make([]sqsMessage, 0, len(f.queues[queueURL]))
+	for _, m := range f.queues[queueURL] {
+		var msg sqsMessage
+		_ = json.Unmarshal([]byte(m.body), &msg)
+		result = append(result, msg)
+	}
+	return result
+}
+
+func newTestSQSQueue(t *testing.T, maxAttempts int) (*SQSQueue, *fakeSQS, string, string) {
+	t.Helper()
+
+	fake := newFakeSQS()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	queueURL := server.URL + "/123456789012/hub-jobs"
+	deadLetterURL := server.URL + "/123456789012/hub-jobs-dlq"
+	q, err := NewSQSQueue(SQSConfig{
+		QueueURL:           queueURL,
+		DeadLetterQueueURL: deadLetterURL,
+		Region:             "us-east-1",
+		AccessKeyID:        "test",
+		SecretAccessKey:    "secret",
+		MaxAttempts:        maxAttempts,
+		VisibilityTimeout:  time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("NewSQSQueue() error = %v", err)
+	}
+	return q, fake, queueURL, deadLetterURL
+}
+
+const testExperienceID = "0190a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a5b"
+
+func TestSQSQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("completes a job", func(t *testing.T) {
+		q, fake, queueURL, _ := newTestSQSQueue(t, 3)
+
+		if err := q.Enqueue(ctx, testExperienceID, "Great product", PriorityNormal); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+
+		job, err := q.Dequeue(ctx, "")
+		if err != nil || job == nil {
+			t.Fatalf("Dequeue() = %v, %v; want a job", job, err)
+		}
+		if job.ExperienceID != testExperienceID || job.JobType != JobTypeEnrichment || job.Text != "Great product" || job.Attempts != 1 {
+			t.Errorf("Dequeue() = %+v", job)
+		}
+
+		if next, _ := q.Dequeue(ctx, ""); next != nil {
+			t.Errorf("Dequeue() returned a job that is already in flight: %+v", next)
+		}
+
+		if err := q.MarkComplete(ctx, job); err != nil {
+			t.Fatalf("MarkComplete() error = %v", err)
+		}
+		if got := fake.messages(queueURL); len(got) != 0 {
+			t.Errorf("queue still holds %d messages", len(got))
+		}
+		if err := q.MarkComplete(ctx, job); err == nil {
+			t.Error("MarkComplete() of a finished job should fail")
+		}
+	})
+
+	t.Run("retries failed jobs and dead-letters them after max attempts", func(t *testing.T) {
+		q, fake, queueURL, deadLetterURL := newTestSQSQueue(t, 2)
+
+		if err := q.EnqueueEmbedding(ctx, testExperienceID, "Slow support", PriorityHigh); err != nil {
+			t.Fatalf("EnqueueEmbedding() error = %v", err)
+		}
+
+		for attempt := 1; attempt <= 2; attempt++ {
+			job, err := q.Dequeue(ctx, "")
+			if err != nil || job == nil {
+				t.Fatalf("attempt %d: Dequeue() = %v, %v; want a job", attempt, job, err)
+			}
+			if job.Attempts != attempt {
+				t.Errorf("attempt %d: Attempts = %d", attempt, job.Attempts)
+			}
+			if err := q.MarkFailed(ctx, job, errors.New("provider unavailable")); err != nil {
+				t.Fatalf("attempt %d: MarkFailed() error = %v", attempt, err)
+			}
+		}
+
+		if got := fake.messages(queueURL); len(got) != 0 {
+			t.Errorf("queue still holds %d messages", len(got))
+		}
+		dead := fake.messages(deadLetterURL)
+		if len(dead) != 1 {
+			t.Fatalf("dead-letter queue holds %d messages, want 1", len(dead))
+		}
+		if dead[0].Attempts != 2 || len(dead[0].ErrorHistory) != 2 || dead[0].Priority != PriorityHigh {
+			t.Errorf("dead-lettered message = %+v", dead[0])
+		}
+	})
+
+	t.Run("requeues rate-limited jobs without using up attempts", func(t *testing.T) {
+		q, fake, queueURL, _ := newTestSQSQueue(t, 1)
+
+		if err := q.Enqueue(ctx, testExperienceID, "Slow support", PriorityNormal); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+		for i := 0; i < 3; i++ {
+			job, err := q.Dequeue(ctx, "")
+			if err != nil || job == nil {
+				t.Fatalf("requeue %d: Dequeue() = %v, %v; want a job", i, job, err)
+			}
+			if job.Attempts != 1 || job.Requeues != i {
+				t.Errorf("requeue %d: Attempts = %d, Requeues = %d", i, job.Attempts, job.Requeues)
+			}
+			if err := q.Requeue(ctx, job); err != nil {
+				t.Fatalf("Requeue() error = %v", err)
+			}
+		}
+
+		if got := fake.messages(queueURL); len(got) != 1 || got[0].Attempts != 0 || got[0].Requeues != 3 {
+			t.Errorf("queue = %+v, want the job with 3 requeues and no attempts", got)
+		}
+	})
+
+	t.Run("dead-letters jobs whose lease expired on the last attempt", func(t *testing.T) {
+		q, fake, _, deadLetterURL := newTestSQSQueue(t, 1)
+
+		if err := q.Enqueue(ctx, testExperienceID, "Crashes on login", PriorityNormal); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+		if job, _ := q.Dequeue(ctx, ""); job == nil {
+			t.Fatal("Dequeue() returned no job")
+		}
+
+		fake.expire()
+		if job, err := q.Dequeue(ctx, ""); err != nil || job != nil {
+			t.Fatalf("Dequeue() = %v, %v; want no job", job, err)
+		}
+
+		dead := fake.messages(deadLetterURL)
+		if len(dead) != 1 || len(dead[0].ErrorHistory) != 1 || dead[0].ErrorHistory[0].Error != leaseExpiredError {
+			t.Errorf("dead-letter queue = %+v", dead)
+		}
+	})
+
+	t.Run("batch dequeue releases jobs that don't match", func(t *testing.T) {
+		q, fake, queueURL, _ := newTestSQSQueue(t, 3)
+
+		_ = q.Enqueue(ctx, testExperienceID, "short", PriorityNormal)
+		_ = q.Enqueue(ctx, testExperienceID, strings.Repeat("long ", 100), PriorityNormal)
+		_ = q.EnqueueEmbedding(ctx, testExperienceID, "short", PriorityNormal)
+
+		jobs, err := q.DequeueBatch(ctx, JobTypeEnrichment, 5, 280)
+		if err != nil {
+			t.Fatalf("DequeueBatch() error = %v", err)
+		}
+		if len(jobs) != 1 || jobs[0].Text != "short" {
+			t.Fatalf("DequeueBatch() = %+v, want the short enrichment job", jobs)
+		}
+
+		released := 0
+		for _, msg := range fake.messages(queueURL) {
+			if msg.ID != jobs[0].ID {
+				released++
+				if msg.Attempts != 0 {
+					t.Errorf("released job %s has %d attempts, want 0", msg.ID, msg.Attempts)
+				}
+			}
+		}
+		if released != 2 {
+			t.Errorf("released %d jobs, want 2", released)
+		}
+	})
+}
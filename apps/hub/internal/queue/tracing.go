@@ -0,0 +1,49 @@
+package queue
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
+)
+
+// startEnqueueSpan starts the span of an enqueue. Backends store the traceparent of the
+// returned context with the job, so its processing joins the trace of the enqueuing request.
+func startEnqueueSpan(ctx context.Context, jobType JobType, experienceID string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "queue.enqueue",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("job.type", string(jobType)),
+			attribute.String("experience.id", experienceID),
+		))
+}
+
+// endEnqueueSpan ends an enqueue span, marking it as failed if the enqueue failed
+func endEnqueueSpan(span trace.Span, err error) {
+	if err != nil {
+		tracing.RecordError(span, err)
+	}
+	span.End()
+}
+
+// traceDequeue records a dequeue span that started at start in the trace of each job.
+// Polls that find no job aren't traced.
+func traceDequeue(ctx context.Context, jobs []*EnrichmentJob, start time.Time) {
+	for _, job := range jobs {
+		if job.TraceContext == "" {
+			continue
+		}
+		_, span := tracing.Tracer().Start(tracing.ContextWithTraceParent(ctx, job.TraceContext), "queue.dequeue",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithTimestamp(start),
+			trace.WithAttributes(
+				attribute.String("job.id", job.ID),
+				attribute.String("job.type", string(job.JobType)),
+				attribute.Int("job.attempts", job.Attempts),
+			))
+		span.End()
+	}
+}
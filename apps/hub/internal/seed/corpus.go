@@ -0,0 +1,113 @@
+package seed
+
+import "github.com/formbricks/hub/apps/hub/internal/enrichment"
+
+// comment is a text response with the enrichment an AI provider would produce for it
+type comment struct {
+	text           string
+	topics         []string
+	emotion        string
+	urgencyScore   float64
+	urgencyReasons []string
+}
+
+// comments are the text responses by language and sentiment
+var comments = map[string]map[string][]comment{
+	"en": {
+		"positive": {
+			{text: "The new dashboard is fantastic, I find everything I need in seconds.", topics: []string{"dashboard", "usability"}, emotion: "joy"},
+			{text: "Support answered within minutes and solved my problem right away.", topics: []string{"support", "response time"}, emotion: "joy"},
+			{text: "Onboarding was smooth and the documentation is really clear.", topics: []string{"onboarding", "documentation"}, emotion: "joy"},
+			{text: "Love the Slack integration, our whole team uses it every day.", topics: []string{"integrations", "collaboration"}, emotion: "joy"},
+			{text: "CSV exports finally work perfectly, thanks for fixing that!", topics: []string{"export", "bug fixes"}, emotion: "joy"},
+			{text: "Setting up automated reports saved me hours every week.", topics: []string{"reporting", "automation"}, emotion: "joy"},
+		},
+		"neutral": {
+			{text: "It does what we need, but the reporting could be more flexible.", topics: []string{"reporting"}, emotion: "neutral"},
+			{text: "Pricing is okay for our team size, we'll see how it scales.", topics: []string{"pricing"}, emotion: "neutral"},
+			{text: "Works fine overall. The mobile app feels slower than the web version.", topics: []string{"mobile app", "performance"}, emotion: "neutral"},
+			{text: "Good product, though it took a while to figure out the permissions.", topics: []string{"permissions", "onboarding"}, emotion: "neutral"},
+		},
+		"negative": {
+			{text: "The app keeps crashing when I upload large files. Please fix this.", topics: []string{"file upload", "bugs"}, emotion: "frustration", urgencyScore: 0.7, urgencyReasons: []string{enrichment.UrgencyReasonBugReport}},
+			{text: "Way too expensive for what it offers. We're looking at alternatives.", topics: []string{"pricing"}, emotion: "frustration", urgencyScore: 0.8, urgencyReasons: []string{enrichment.UrgencyReasonChurnRisk}},
+			{text: "Search never finds the responses I'm looking for.", topics: []string{"search"}, emotion: "frustration", urgencyScore: 0.4},
+			{text: "I waited three days for a reply from support. Unacceptable.", topics: []string{"support", "response time"}, emotion: "anger", urgencyScore: 0.6, urgencyReasons: []string{enrichment.UrgencyReasonChurnRisk}},
+			{text: "Since the last update the dashboard loads very slowly.", topics: []string{"performance", "dashboard"}, emotion: "frustration", urgencyScore: 0.5, urgencyReasons: []string{enrichment.UrgencyReasonBugReport}},
+			{text: "I was charged twice this month and nobody has refunded me yet.", topics: []string{"billing"}, emotion: "anger", urgencyScore: 0.9, urgencyReasons: []string{enrichment.UrgencyReasonBillingIssue, enrichment.UrgencyReasonChurnRisk}},
+		},
+	},
+	"de": {
+		"positive": {
+			{text: "Die Einrichtung war super einfach und der Support ist sehr hilfsbereit.", topics: []string{"onboarding", "support"}, emotion: "joy"},
+			{text: "Das neue Dashboard gefällt mir sehr gut, alles ist übersichtlich.", topics: []string{"dashboard", "usability"}, emotion: "joy"},
+		},
+		"neutral": {
+			{text: "Funktioniert gut, aber die Berichte könnten flexibler sein.", topics: []string{"reporting"}, emotion: "neutral"},
+		},
+		"negative": {
+			{text: "Die App stürzt beim Hochladen großer Dateien ständig ab.", topics: []string{"file upload", "bugs"}, emotion: "frustration", urgencyScore: 0.7, urgencyReasons: []string{enrichment.UrgencyReasonBugReport}},
+			{text: "Viel zu teuer für unser kleines Team.", topics: []string{"pricing"}, emotion: "frustration", urgencyScore: 0.6, urgencyReasons: []string{enrichment.UrgencyReasonChurnRisk}},
+		},
+	},
+	"fr": {
+		"positive": {
+			{text: "Très bonne application, l'intégration avec Slack est parfaite.", topics: []string{"integrations"}, emotion: "joy"},
+		},
+		"neutral": {
+			{text: "Le produit est correct, mais l'application mobile est un peu lente.", topics: []string{"mobile app", "performance"}, emotion: "neutral"},
+		},
+		"negative": {
+			{text: "Le support met beaucoup trop de temps à répondre.", topics: []string{"support", "response time"}, emotion: "frustration", urgencyScore: 0.5, urgencyReasons: []string{enrichment.UrgencyReasonChurnRisk}},
+		},
+	},
+	"es": {
+		"positive": {
+			{text: "Me encanta lo fácil que es exportar los datos.", topics: []string{"export", "usability"}, emotion: "joy"},
+		},
+		"neutral": {
+			{text: "Está bien, aunque los precios podrían ser más claros.", topics: []string{"pricing"}, emotion: "neutral"},
+		},
+		"negative": {
+			{text: "La búsqueda no encuentra las respuestas que necesito.", topics: []string{"search"}, emotion: "frustration", urgencyScore: 0.4},
+		},
+	},
+	"pt": {
+		"positive": {
+			{text: "O painel é excelente e muito fácil de usar.", topics: []string{"dashboard", "usability"}, emotion: "joy"},
+		},
+		"neutral": {
+			{text: "Funciona bem, mas a configuração de permissões é confusa.", topics: []string{"permissions"}, emotion: "neutral"},
+		},
+		"negative": {
+			{text: "O aplicativo trava quando envio arquivos grandes.", topics: []string{"file upload", "bugs"}, emotion: "frustration", urgencyScore: 0.7, urgencyReasons: []string{enrichment.UrgencyReasonBugReport}},
+		},
+	},
+}
+
+// spam are text responses flagged as spam
+var spam = []string{
+	"asdfghjkl qwertz",
+	"Buy cheap followers now at www.example.com!!!",
+	"test test test",
+}
+
+// languages are the languages of the respondents with their weights
+var languages = []weighted[string]{
+	{"en", 60}, {"de", 15}, {"fr", 10}, {"es", 10}, {"pt", 5},
+}
+
+// features are the options of the categorical question
+var features = []string{"Dashboard", "Reports", "Integrations", "Mobile app", "API"}
+
+// devices and countries are recorded in the metadata of each response
+var (
+	devices   = []string{"desktop", "desktop", "desktop", "mobile", "tablet"}
+	countries = map[string][]string{
+		"en": {"US", "GB", "CA", "AU"},
+		"de": {"DE", "AT", "CH"},
+		"fr": {"FR", "BE", "CA"},
+		"es": {"ES", "MX", "AR"},
+		"pt": {"BR", "PT"},
+	}
+)
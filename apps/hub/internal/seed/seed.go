@@ -0,0 +1,408 @@
+// Package seed generates realistic demo experiences, so new users and end-to-end tests can
+// explore search and analytics without connecting a feedback source first. The demo data
+// mixes survey responses and app reviews in several languages and field types, and comes
+// with the enrichment (sentiment, emotion, topics, urgency) an AI provider would produce.
+package seed
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/models"
+	"github.com/formbricks/hub/apps/hub/internal/questionbank"
+)
+
+const (
+	// SourceIDPrefix starts the source ID of all demo experiences
+	SourceIDPrefix = "demo-"
+	// EnrichmentProvider and EnrichmentModel are recorded for the demo enrichment, which
+	// is re-enriched by real AI providers if SERVICE_REENRICH_STALE is enabled
+	EnrichmentProvider = "demo"
+	EnrichmentModel    = "demo"
+)
+
+// insertBatchSize is the number of experiences created per statement
+const insertBatchSize = 500
+
+// Options controls the generated data
+type Options struct {
+	// Responses is the number of survey responses and app reviews. Each produces one
+	// experience per answered question.
+	Responses int
+	// Days over which the responses are spread, ending at Now
+	Days int
+	Now  time.Time
+	// Seed makes the generated data reproducible
+	Seed uint64
+}
+
+// weighted is a value picked with a relative weight
+type weighted[T any] struct {
+	value  T
+	weight int
+}
+
+// pick returns a value of the weighted values
+func pick[T any](rng *rand.Rand, values []weighted[T]) T {
+	total := 0
+	for _, v := range values {
+		total += v.weight
+	}
+	n := rng.IntN(total)
+	for _, v := range values {
+		if n < v.weight {
+			return v.value
+		}
+		n -= v.weight
+	}
+	return values[len(values)-1].value
+}
+
+// generator generates the experiences of responses
+type generator struct {
+	rng   *rand.Rand
+	opts  Options
+	users int
+}
+
+// Generate returns demo experiences. The same options always produce the same data.
+func Generate(opts Options) []*models.Experience {
+	g := &generator{
+		rng:   rand.New(rand.NewPCG(opts.Seed, opts.Seed^0x5eed)),
+		opts:  opts,
+		users: max(opts.Responses/2, 1),
+	}
+
+	var experiences []*models.Experience
+	for range opts.Responses {
+		// One in five responses is an app review, the others answer the survey
+		if g.rng.IntN(5) == 0 {
+			experiences = append(experiences, g.review()...)
+		} else {
+			experiences = append(experiences, g.survey()...)
+		}
+	}
+	return experiences
+}
+
+// response holds what the experiences of one response share
+type response struct {
+	sourceType     string
+	sourceID       string
+	sourceName     string
+	collectedAt    time.Time
+	language       string
+	userIdentifier string
+	metadata       map[string]any
+}
+
+// newResponse returns a response of a random respondent
+func (g *generator) newResponse(sourceType, sourceID, sourceName string) *response {
+	language := pick(g.rng, languages)
+	countries := countries[language]
+
+	var age time.Duration
+	if g.opts.Days > 0 {
+		age = time.Duration(g.rng.Int64N(int64(time.Duration(g.opts.Days) * 24 * time.Hour)))
+	}
+	return &response{
+		sourceType:     sourceType,
+		sourceID:       sourceID,
+		sourceName:     sourceName,
+		collectedAt:    g.opts.Now.Add(-age).Truncate(time.Second),
+		language:       language,
+		userIdentifier: fmt.Sprintf("demo-user-%04d", g.rng.IntN(g.users)+1),
+		metadata: map[string]any{
+			"demo":        true,
+			"response_id": fmt.Sprintf("demo-%08x", g.rng.Uint32()),
+			"device":      devices[g.rng.IntN(len(devices))],
+			"country":     countries[g.rng.IntN(len(countries))],
+		},
+	}
+}
+
+// experience returns an experience of the response without a value
+func (r *response) experience(fieldID, fieldLabel string, fieldType models.FieldType) *models.Experience {
+	return &models.Experience{
+		CollectedAt:    r.collectedAt,
+		SourceType:     r.sourceType,
+		SourceID:       &r.sourceID,
+		SourceName:     &r.sourceName,
+		FieldID:        fieldID,
+		FieldLabel:     &fieldLabel,
+		FieldType:      string(fieldType),
+		Metadata:       r.metadata,
+		Language:       &r.language,
+		UserIdentifier: &r.userIdentifier,
+	}
+}
+
+// survey returns the answers of a product feedback survey response. The answers are
+// consistent: detractors leave negative comments and low satisfaction scores.
+func (g *generator) survey() []*models.Experience {
+	r := g.newResponse("survey", SourceIDPrefix+"product-feedback", "Product Feedback Survey (demo)")
+
+	nps := pick(g.rng, []weighted[int]{
+		{0, 2}, {1, 1}, {2, 2}, {3, 2}, {4, 3}, {5, 5}, {6, 8},
+		{7, 12}, {8, 18}, {9, 22}, {10, 23},
+	})
+	sentiment := "neutral"
+	switch {
+	case nps >= 9:
+		sentiment = "positive"
+	case nps <= 6:
+		sentiment = "negative"
+	}
+
+	exp := r.experience("nps", "How likely are you to recommend Acme to a friend or colleague?", models.FieldTypeNPS)
+	exp.ValueNumber = number(nps)
+	experiences := []*models.Experience{exp}
+
+	if g.chance(85) {
+		exp := r.experience("reason", "What is the main reason for your score?", models.FieldTypeText)
+		g.comment(exp, sentiment)
+		experiences = append(experiences, exp)
+	}
+
+	// Multiple choice questions produce one experience per selected option
+	for _, i := range g.rng.Perm(len(features))[:1+g.rng.IntN(2)] {
+		exp := r.experience("features", "Which features do you use the most?", models.FieldTypeCategorical)
+		exp.ValueText = &features[i]
+		experiences = append(experiences, exp)
+	}
+
+	if g.chance(70) {
+		exp := r.experience("support_csat", "How satisfied are you with our customer support?", models.FieldTypeCSAT)
+		exp.ValueNumber = number(g.score(sentiment))
+		experiences = append(experiences, exp)
+	}
+
+	if g.chance(50) {
+		exp := r.experience("onboarding_rating", "How would you rate the onboarding experience?", models.FieldTypeRating)
+		exp.ValueNumber = number(g.score(sentiment))
+		experiences = append(experiences, exp)
+	}
+
+	if g.chance(60) {
+		exp := r.experience("contact", "May we contact you about your feedback?", models.FieldTypeBoolean)
+		contact := g.chance(40)
+		if sentiment == "negative" {
+			contact = g.chance(70)
+		}
+		exp.ValueBoolean = &contact
+		experiences = append(experiences, exp)
+	}
+
+	if g.chance(40) {
+		exp := r.experience("team_size", "How many people on your team use Acme?", models.FieldTypeNumber)
+		exp.ValueNumber = number(1 + int(math.Exp(g.rng.Float64()*5.3)))
+		experiences = append(experiences, exp)
+	}
+
+	if g.chance(30) {
+		exp := r.experience("customer_since", "When did you start using Acme?", models.FieldTypeDate)
+		since := r.collectedAt.AddDate(0, 0, -30-g.rng.IntN(1065)).Truncate(24 * time.Hour)
+		exp.ValueDate = &since
+		experiences = append(experiences, exp)
+	}
+
+	return experiences
+}
+
+// review returns the star rating and text of an app review
+func (g *generator) review() []*models.Experience {
+	r := g.newResponse("review", SourceIDPrefix+"app-store", "App Store Reviews (demo)")
+
+	stars := pick(g.rng, []weighted[int]{{1, 12}, {2, 8}, {3, 15}, {4, 30}, {5, 35}})
+	sentiment := "neutral"
+	switch {
+	case stars >= 4:
+		sentiment = "positive"
+	case stars <= 2:
+		sentiment = "negative"
+	}
+
+	rating := r.experience("stars", "Rating", models.FieldTypeRating)
+	rating.ValueNumber = number(stars)
+	text := r.experience("review", "Review", models.FieldTypeText)
+	g.comment(text, sentiment)
+	return []*models.Experience{rating, text}
+}
+
+// comment sets a text response of the sentiment with its enrichment. A few responses
+// are spam.
+func (g *generator) comment(exp *models.Experience, sentiment string) {
+	provider, model, version := EnrichmentProvider, EnrichmentModel, enrichment.PromptVersion
+	exp.EnrichmentProvider = &provider
+	exp.EnrichmentModel = &model
+	exp.EnrichmentVersion = &version
+
+	if g.chance(3) {
+		text := spam[g.rng.IntN(len(spam))]
+		neutral := "neutral"
+		isSpam := true
+		confidence := g.between(0.9, 1)
+		var score, urgency float64
+		exp.ValueText = &text
+		exp.Sentiment = &neutral
+		exp.SentimentScore = &score
+		exp.Emotion = &neutral
+		exp.Topics = []string{}
+		exp.IsSpam = &isSpam
+		exp.SpamConfidence = &confidence
+		exp.UrgencyScore = &urgency
+		exp.UrgencyReasons = []string{}
+		return
+	}
+
+	options := comments[*exp.Language][sentiment]
+	c := options[g.rng.IntN(len(options))]
+
+	var score float64
+	switch sentiment {
+	case "positive":
+		score = g.between(0.6, 0.95)
+	case "negative":
+		score = g.between(-0.95, -0.5)
+	default:
+		score = g.between(-0.2, 0.3)
+	}
+	isSpam := false
+	confidence := g.between(0, 0.1)
+	urgency := math.Max(0, math.Min(1, c.urgencyScore+g.between(-0.05, 0.05)))
+	if c.urgencyScore == 0 {
+		urgency = g.between(0, 0.15)
+	}
+	reasons := c.urgencyReasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	exp.ValueText = &c.text
+	exp.Sentiment = &sentiment
+	exp.SentimentScore = &score
+	exp.Emotion = &c.emotion
+	exp.Topics = c.topics
+	exp.IsSpam = &isSpam
+	exp.SpamConfidence = &confidence
+	exp.UrgencyScore = &urgency
+	exp.UrgencyReasons = reasons
+}
+
+// score returns a 1 to 5 score matching the sentiment
+func (g *generator) score(sentiment string) int {
+	switch sentiment {
+	case "positive":
+		return 4 + g.rng.IntN(2)
+	case "negative":
+		return 1 + g.rng.IntN(3)
+	default:
+		return 3 + g.rng.IntN(2)
+	}
+}
+
+// chance returns true with the given percentage
+func (g *generator) chance(percent int) bool {
+	return g.rng.IntN(100) < percent
+}
+
+// between returns a number between min and max, rounded to two decimals
+func (g *generator) between(low, high float64) float64 {
+	return math.Round((low+g.rng.Float64()*(high-low))*100) / 100
+}
+
+// number returns a pointer to the number as a float
+func number(n int) *float64 {
+	f := float64(n)
+	return &f
+}
+
+// derefString returns the string, or an empty string if it is nil
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// Insert creates the experiences in batches and returns the created records. The typed
+// metadata columns are populated with the metadata keys of metadataColumns.
+func Insert(ctx context.Context, client *ent.Client, experiences []*models.Experience, metadataColumns map[string][]string) ([]*ent.ExperienceData, error) {
+	created := make([]*ent.ExperienceData, 0, len(experiences))
+	questions := questionbank.NewResolver(client)
+	for start := 0; start < len(experiences); start += insertBatchSize {
+		batch := experiences[start:min(start+insertBatchSize, len(experiences))]
+		builders := make([]*ent.ExperienceDataCreate, len(batch))
+		for i, exp := range batch {
+			questionID, err := questions.Resolve(ctx, questionbank.Response{
+				Key: questionbank.Key{
+					SourceType: exp.SourceType,
+					SourceID:   derefString(exp.SourceID),
+					FieldID:    exp.FieldID,
+				},
+				FieldType: exp.FieldType,
+				Label:     derefString(exp.FieldLabel),
+				Language:  derefString(exp.Language),
+			})
+			if err != nil {
+				return created, err
+			}
+			builders[i] = client.ExperienceData.Create().
+				SetCollectedAt(exp.CollectedAt).
+				SetSourceType(exp.SourceType).
+				SetNillableSourceID(exp.SourceID).
+				SetNillableSourceName(exp.SourceName).
+				SetFieldID(exp.FieldID).
+				SetNillableFieldLabel(exp.FieldLabel).
+				SetFieldType(exp.FieldType).
+				SetQuestionID(questionID).
+				SetNillableValueText(exp.ValueText).
+				SetNillableValueNumber(exp.ValueNumber).
+				SetNillableNpsCategory(models.NPSCategory(exp.FieldType, exp.ValueNumber)).
+				SetNillableValueBoolean(exp.ValueBoolean).
+				SetNillableValueDate(exp.ValueDate).
+				SetMetadata(exp.Metadata).
+				SetNillableLanguage(exp.Language).
+				SetNillableUserIdentifier(exp.UserIdentifier).
+				SetNillableContentHash(models.ContentHash(models.ContentKey{
+					SourceType:     exp.SourceType,
+					SourceID:       derefString(exp.SourceID),
+					FieldID:        exp.FieldID,
+					UserIdentifier: derefString(exp.UserIdentifier),
+					Values: models.Values{
+						Text:    exp.ValueText,
+						Number:  exp.ValueNumber,
+						Boolean: exp.ValueBoolean,
+						Date:    exp.ValueDate,
+					},
+				})).
+				SetNillableSentiment(exp.Sentiment).
+				SetNillableSentimentScore(exp.SentimentScore).
+				SetNillableEmotion(exp.Emotion).
+				SetNillableIsSpam(exp.IsSpam).
+				SetNillableSpamConfidence(exp.SpamConfidence).
+				SetNillableUrgencyScore(exp.UrgencyScore).
+				SetNillableEnrichmentProvider(exp.EnrichmentProvider).
+				SetNillableEnrichmentModel(exp.EnrichmentModel).
+				SetNillableEnrichmentVersion(exp.EnrichmentVersion)
+			models.ExtractMetadataColumns(exp.Metadata, metadataColumns).Apply(builders[i].Mutation())
+			if exp.Topics != nil {
+				builders[i].SetTopics(exp.Topics)
+			}
+			if exp.UrgencyReasons != nil {
+				builders[i].SetUrgencyReasons(exp.UrgencyReasons)
+			}
+		}
+		records, err := client.ExperienceData.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return created, fmt.Errorf("failed to create demo experiences: %w", err)
+		}
+		created = append(created, records...)
+	}
+	return created, nil
+}
@@ -0,0 +1,95 @@
+package seed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/models"
+)
+
+func TestGenerate(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	opts := Options{Responses: 300, Days: 30, Now: now, Seed: 42}
+	experiences := Generate(opts)
+
+	if !reflect.DeepEqual(experiences, Generate(opts)) {
+		t.Error("expected the same options to generate the same data")
+	}
+	if len(experiences) < opts.Responses {
+		t.Fatalf("expected at least one experience per response, got %d", len(experiences))
+	}
+
+	fieldTypes := make(map[string]bool)
+	languages := make(map[string]bool)
+	sentiments := make(map[string]bool)
+	for _, exp := range experiences {
+		fieldTypes[exp.FieldType] = true
+		if !models.FieldType(exp.FieldType).IsValid() {
+			t.Fatalf("invalid field type %q", exp.FieldType)
+		}
+		if exp.SourceID == nil || !strings.HasPrefix(*exp.SourceID, SourceIDPrefix) {
+			t.Errorf("expected a demo source ID, got %v", exp.SourceID)
+		}
+		if exp.CollectedAt.After(now) || exp.CollectedAt.Before(now.AddDate(0, 0, -opts.Days)) {
+			t.Errorf("expected collected_at within the last %d days, got %s", opts.Days, exp.CollectedAt)
+		}
+		if exp.Language != nil {
+			languages[*exp.Language] = true
+		}
+
+		switch models.FieldType(exp.FieldType) {
+		case models.FieldTypeText:
+			if exp.ValueText == nil || exp.Sentiment == nil || exp.Emotion == nil || exp.IsSpam == nil {
+				t.Fatalf("expected text responses to be enriched, got %+v", exp)
+			}
+			sentiments[*exp.Sentiment] = true
+		case models.FieldTypeCategorical:
+			if exp.ValueText == nil || exp.Sentiment != nil {
+				t.Errorf("expected categorical responses to have an unenriched text value, got %+v", exp)
+			}
+		case models.FieldTypeNPS:
+			if exp.ValueNumber == nil || *exp.ValueNumber < 0 || *exp.ValueNumber > 10 {
+				t.Errorf("expected NPS scores from 0 to 10, got %v", exp.ValueNumber)
+			}
+		case models.FieldTypeCSAT, models.FieldTypeRating:
+			if exp.ValueNumber == nil || *exp.ValueNumber < 1 || *exp.ValueNumber > 5 {
+				t.Errorf("expected scores from 1 to 5, got %v", exp.ValueNumber)
+			}
+		case models.FieldTypeNumber:
+			if exp.ValueNumber == nil {
+				t.Error("expected number responses to have a number value")
+			}
+		case models.FieldTypeBoolean:
+			if exp.ValueBoolean == nil {
+				t.Error("expected boolean responses to have a boolean value")
+			}
+		case models.FieldTypeDate:
+			if exp.ValueDate == nil || !exp.ValueDate.Before(exp.CollectedAt) {
+				t.Errorf("expected date responses before the response, got %v", exp.ValueDate)
+			}
+		}
+	}
+
+	if len(fieldTypes) != len(models.AllFieldTypes()) {
+		t.Errorf("expected all field types, got %v", fieldTypes)
+	}
+	if len(languages) < 3 {
+		t.Errorf("expected several languages, got %v", languages)
+	}
+	for _, sentiment := range []string{"positive", "neutral", "negative"} {
+		if !sentiments[sentiment] {
+			t.Errorf("expected %s text responses", sentiment)
+		}
+	}
+}
+
+func TestGenerate_Seed(t *testing.T) {
+	now := time.Now()
+	a := Generate(Options{Responses: 20, Days: 7, Now: now, Seed: 1})
+	b := Generate(Options{Responses: 20, Days: 7, Now: now, Seed: 2})
+	if reflect.DeepEqual(a, b) {
+		t.Error("expected different seeds to generate different data")
+	}
+}
@@ -0,0 +1,181 @@
+package sink
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+const (
+	// FormatJSONL writes gzip-compressed JSON Lines, one experience per line
+	FormatJSONL = "jsonl"
+	// FormatParquet writes Parquet files with one column per field
+	FormatParquet = "parquet"
+)
+
+// Record is an experience as written to exports. Timestamps are UTC; the JSON fields
+// (value_json, metadata, translations, enrichment_attributes) are JSON text in Parquet.
+type Record struct {
+	ID                   string     `json:"id" parquet:"id"`
+	CreatedAt            time.Time  `json:"created_at" parquet:"created_at,timestamp(microsecond)"`
+	UpdatedAt            time.Time  `json:"updated_at" parquet:"updated_at,timestamp(microsecond)"`
+	CollectedAt          time.Time  `json:"collected_at" parquet:"collected_at,timestamp(microsecond)"`
+	SourceType           string     `json:"source_type" parquet:"source_type,dict"`
+	SourceID             string     `json:"source_id" parquet:"source_id,dict"`
+	SourceName           string     `json:"source_name" parquet:"source_name,dict"`
+	FieldID              string     `json:"field_id" parquet:"field_id,dict"`
+	FieldLabel           string     `json:"field_label" parquet:"field_label,dict"`
+	FieldType            string     `json:"field_type" parquet:"field_type,dict"`
+	QuestionID           *string    `json:"question_id,omitempty" parquet:"question_id,optional"`
+	ValueText            *string    `json:"value_text,omitempty" parquet:"value_text,optional"`
+	ValueNumber          *float64   `json:"value_number,omitempty" parquet:"value_number,optional"`
+	ValueBoolean         *bool      `json:"value_boolean,omitempty" parquet:"value_boolean,optional"`
+	ValueDate            *time.Time `json:"value_date,omitempty" parquet:"value_date,optional"`
+	ValueJSON            *JSONText  `json:"value_json,omitempty" parquet:"value_json,optional"`
+	NPSCategory          *string    `json:"nps_category,omitempty" parquet:"nps_category,optional,dict"`
+	Metadata             *JSONText  `json:"metadata,omitempty" parquet:"metadata,optional"`
+	UserIdentifier       string     `json:"user_identifier" parquet:"user_identifier"`
+	Language             string     `json:"language" parquet:"language,dict"`
+	Country              *string    `json:"country,omitempty" parquet:"country,optional,dict"`
+	Region               *string    `json:"region,omitempty" parquet:"region,optional,dict"`
+	Device               *string    `json:"device,omitempty" parquet:"device,optional,dict"`
+	Platform             *string    `json:"platform,omitempty" parquet:"platform,optional,dict"`
+	AppVersion           *string    `json:"app_version,omitempty" parquet:"app_version,optional,dict"`
+	Translations         *JSONText  `json:"translations,omitempty" parquet:"translations,optional"`
+	Sentiment            *string    `json:"sentiment,omitempty" parquet:"sentiment,optional,dict"`
+	SentimentScore       *float64   `json:"sentiment_score,omitempty" parquet:"sentiment_score,optional"`
+	Emotion              *string    `json:"emotion,omitempty" parquet:"emotion,optional,dict"`
+	Topics               []string   `json:"topics,omitempty" parquet:"topics,list"`
+	IsSpam               *bool      `json:"is_spam,omitempty" parquet:"is_spam,optional"`
+	SpamConfidence       *float64   `json:"spam_confidence,omitempty" parquet:"spam_confidence,optional"`
+	UrgencyScore         *float64   `json:"urgency_score,omitempty" parquet:"urgency_score,optional"`
+	UrgencyReasons       []string   `json:"urgency_reasons,omitempty" parquet:"urgency_reasons,list"`
+	EnrichmentModel      *string    `json:"enrichment_model,omitempty" parquet:"enrichment_model,optional,dict"`
+	EnrichmentVersion    *int64     `json:"enrichment_version,omitempty" parquet:"enrichment_version,optional"`
+	EnrichmentAttributes *JSONText  `json:"enrichment_attributes,omitempty" parquet:"enrichment_attributes,optional"`
+	DuplicateOf          *string    `json:"duplicate_of,omitempty" parquet:"duplicate_of,optional"`
+}
+
+// ToRecord converts an experience to its exported form
+func ToRecord(exp *ent.ExperienceData) Record {
+	r := Record{
+		ID:                   exp.ID.String(),
+		CreatedAt:            exp.CreatedAt.UTC(),
+		UpdatedAt:            exp.UpdatedAt.UTC(),
+		CollectedAt:          exp.CollectedAt.UTC(),
+		SourceType:           exp.SourceType,
+		SourceID:             exp.SourceID,
+		SourceName:           exp.SourceName,
+		FieldID:              exp.FieldID,
+		FieldLabel:           exp.FieldLabel,
+		FieldType:            exp.FieldType,
+		ValueText:            exp.ValueText,
+		ValueNumber:          exp.ValueNumber,
+		ValueBoolean:         exp.ValueBoolean,
+		ValueJSON:            marshalObject(exp.ValueJSON),
+		NPSCategory:          exp.NpsCategory,
+		Metadata:             marshalObject(exp.Metadata),
+		UserIdentifier:       exp.UserIdentifier,
+		Language:             exp.Language,
+		Country:              exp.Country,
+		Region:               exp.Region,
+		Device:               exp.Device,
+		Platform:             exp.Platform,
+		AppVersion:           exp.AppVersion,
+		Sentiment:            exp.Sentiment,
+		SentimentScore:       exp.SentimentScore,
+		Emotion:              exp.Emotion,
+		Topics:               exp.Topics,
+		IsSpam:               exp.IsSpam,
+		SpamConfidence:       exp.SpamConfidence,
+		UrgencyScore:         exp.UrgencyScore,
+		UrgencyReasons:       exp.UrgencyReasons,
+		EnrichmentModel:      exp.EnrichmentModel,
+		EnrichmentAttributes: marshalObject(exp.EnrichmentAttributes),
+	}
+	if len(exp.Translations) > 0 {
+		r.Translations = marshalObject(exp.Translations)
+	}
+	if exp.QuestionID != nil {
+		id := exp.QuestionID.String()
+		r.QuestionID = &id
+	}
+	if exp.DuplicateOf != nil {
+		id := exp.DuplicateOf.String()
+		r.DuplicateOf = &id
+	}
+	if exp.ValueDate != nil {
+		date := exp.ValueDate.UTC()
+		r.ValueDate = &date
+	}
+	if exp.EnrichmentVersion != nil {
+		version := int64(*exp.EnrichmentVersion)
+		r.EnrichmentVersion = &version
+	}
+	return r
+}
+
+// JSONText is a JSON object, written as is to JSON Lines and as a string column to Parquet
+type JSONText string
+
+// MarshalJSON returns the object
+func (t JSONText) MarshalJSON() ([]byte, error) {
+	return []byte(t), nil
+}
+
+// marshalObject returns the JSON text of a map, or nil if it's empty
+func marshalObject[M ~map[string]V, V any](m M) *JSONText {
+	if len(m) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil
+	}
+	text := JSONText(data)
+	return &text
+}
+
+// Extension returns the file extension of a format
+func Extension(format string) string {
+	if format == FormatParquet {
+		return ".parquet"
+	}
+	return ".jsonl.gz"
+}
+
+// Encode writes records in a format and returns the file and its content type
+func Encode(format string, records []Record) ([]byte, string, error) {
+	var buf bytes.Buffer
+	switch format {
+	case FormatJSONL:
+		zw := gzip.NewWriter(&buf)
+		enc := json.NewEncoder(zw)
+		for i := range records {
+			if err := enc.Encode(&records[i]); err != nil {
+				return nil, "", fmt.Errorf("failed to encode record: %w", err)
+			}
+		}
+		if err := zw.Close(); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "application/gzip", nil
+	case FormatParquet:
+		w := parquet.NewGenericWriter[Record](&buf, parquet.Compression(&parquet.Zstd))
+		if _, err := w.Write(records); err != nil {
+			return nil, "", fmt.Errorf("failed to encode records: %w", err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), "application/vnd.apache.parquet", nil
+	default:
+		return nil, "", fmt.Errorf("unknown format %q", format)
+	}
+}
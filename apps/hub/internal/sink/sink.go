@@ -0,0 +1,312 @@
+// Package sink runs the scheduled exports of experiences to object storage. Each export
+// has a cursor, the updated_at and ID of the last experience it wrote, so every run writes
+// the experiences created or changed since the previous one as JSON Lines or Parquet
+// objects to an S3-compatible or GCS bucket, under a prefix templated by date.
+package sink
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/ent/export"
+	"github.com/formbricks/hub/apps/hub/internal/ent/exportrun"
+	"github.com/formbricks/hub/apps/hub/internal/leader"
+)
+
+const (
+	// lockKey is the PostgreSQL advisory lock held by the instance running exports, so each
+	// run writes its objects once however many Hub instances run
+	lockKey = 7_241_905_004
+	// checkInterval is how often the scheduler looks for exports that are due
+	checkInterval = time.Minute
+	// settleDelay holds back experiences changed this recently, so one whose transaction
+	// commits after a run can't end up behind its cursor
+	settleDelay = time.Minute
+	// readBatchSize is the number of experiences read at a time
+	readBatchSize = 1000
+	// objectSize is the most experiences written to one object
+	objectSize = 50_000
+	// maxObjectsPerRun bounds a run; an export with more to write runs again right away
+	maxObjectsPerRun = 20
+)
+
+// Run statuses
+const (
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Scheduler runs the exports that are due
+type Scheduler struct {
+	client *ent.Client
+	db     *sql.DB
+	logger *slog.Logger
+
+	stopChan  chan struct{}
+	stopOnce  sync.Once
+	completed chan struct{}
+}
+
+// NewScheduler creates a scheduler of the exports of client; db must be its database
+func NewScheduler(client *ent.Client, db *sql.DB, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		client:    client,
+		db:        db,
+		logger:    logger,
+		stopChan:  make(chan struct{}),
+		completed: make(chan struct{}),
+	}
+}
+
+// Run checks for exports that are due right away and then every minute until ctx is
+// canceled or Stop is called
+func (s *Scheduler) Run(ctx context.Context) {
+	defer close(s.completed)
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		s.round(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops running exports and waits for the current object to be written
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() { close(s.stopChan) })
+	<-s.completed
+}
+
+// stopping reports whether Stop was called
+func (s *Scheduler) stopping() bool {
+	select {
+	case <-s.stopChan:
+		return true
+	default:
+		return false
+	}
+}
+
+// round runs the exports that are due, unless another instance is running them
+func (s *Scheduler) round(ctx context.Context) {
+	lock, err := leader.TryAcquire(ctx, s.db, lockKey)
+	if err != nil {
+		s.logger.Warn("failed to acquire export lock", "error", err)
+	}
+	if lock == nil {
+		return
+	}
+	defer lock.Release()
+
+	// Runs still running were interrupted when the instance holding the lock stopped
+	now := time.Now()
+	err = s.client.ExportRun.Update().
+		Where(exportrun.StatusEQ(StatusRunning)).
+		SetStatus(StatusFailed).
+		SetError("interrupted").
+		SetFinishedAt(now).
+		Exec(ctx)
+	if err != nil {
+		s.logger.Warn("failed to close interrupted export runs", "error", err)
+	}
+
+	exports, err := s.client.Export.Query().
+		Where(export.Enabled(true), export.NextRunAtLTE(now)).
+		Order(ent.Asc(export.FieldNextRunAt)).
+		All(ctx)
+	if err != nil {
+		s.logger.Error("failed to list due exports", "error", err)
+		return
+	}
+	for _, e := range exports {
+		if s.stopping() {
+			return
+		}
+		s.run(ctx, e)
+	}
+}
+
+// run runs an export and records it in its history
+func (s *Scheduler) run(ctx context.Context, e *ent.Export) {
+	started := time.Now()
+	run, err := s.client.ExportRun.Create().SetExportID(e.ID).Save(ctx)
+	if err != nil {
+		s.logger.Error("failed to start export run", "export_id", e.ID, "error", err)
+		return
+	}
+
+	res, err := s.export(ctx, e, started)
+
+	update := s.client.ExportRun.UpdateOne(run).
+		SetExperiences(res.experiences).
+		SetBytes(res.bytes).
+		SetObjects(res.objects).
+		SetFinishedAt(time.Now())
+	if err != nil {
+		update.SetStatus(StatusFailed).SetError(err.Error())
+		s.logger.Error("export failed", "export_id", e.ID, "name", e.Name, "error", err)
+	} else {
+		update.SetStatus(StatusSucceeded)
+		if res.experiences > 0 {
+			s.logger.Info("export written", "export_id", e.ID, "name", e.Name, "experiences", res.experiences, "objects", len(res.objects))
+		}
+	}
+	if err := update.Exec(context.WithoutCancel(ctx)); err != nil {
+		s.logger.Error("failed to record export run", "export_id", e.ID, "error", err)
+	}
+
+	// Failed runs are retried at the next interval, from the last object written
+	next := started.Add(time.Duration(e.IntervalMinutes) * time.Minute)
+	if res.more {
+		next = started
+	}
+	err = s.client.Export.UpdateOneID(e.ID).
+		SetNextRunAt(next).
+		Exec(context.WithoutCancel(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		s.logger.Error("failed to schedule export", "export_id", e.ID, "error", err)
+	}
+}
+
+// result is what a run wrote
+type result struct {
+	experiences int
+	bytes       int64
+	objects     []string
+	more        bool // the run ended before writing all experiences
+}
+
+// export writes the experiences changed since the cursor of the export, one object at a
+// time. The cursor is saved after each object, so a failed run only writes the rest again.
+func (s *Scheduler) export(ctx context.Context, e *ent.Export, started time.Time) (result, error) {
+	var res result
+	bucket := &Bucket{
+		Provider:        e.Provider,
+		Name:            e.Bucket,
+		Region:          e.Region,
+		Endpoint:        e.Endpoint,
+		AccessKeyID:     e.AccessKeyID,
+		SecretAccessKey: e.SecretAccessKey,
+	}
+	prefix := ExpandPrefix(e.Prefix, started)
+	settled := started.Add(-settleDelay)
+
+	cursor := position{}
+	if e.CursorAt != nil {
+		cursor.At = *e.CursorAt
+	}
+	if e.CursorID != nil {
+		cursor.ID = *e.CursorID
+	}
+
+	for part := 1; ; part++ {
+		if part > maxObjectsPerRun || s.stopping() {
+			res.more = true
+			return res, nil
+		}
+		experiences, err := s.read(ctx, cursor, settled)
+		if err != nil {
+			return res, err
+		}
+		if len(experiences) == 0 {
+			return res, nil
+		}
+
+		records := make([]Record, len(experiences))
+		for i, exp := range experiences {
+			records[i] = ToRecord(exp)
+		}
+		body, contentType, err := Encode(e.Format, records)
+		if err != nil {
+			return res, err
+		}
+		key := ObjectKey(prefix, started, part, e.Format)
+		if err := bucket.Put(ctx, key, contentType, body); err != nil {
+			return res, err
+		}
+
+		last := experiences[len(experiences)-1]
+		cursor = position{At: last.UpdatedAt, ID: last.ID}
+		err = s.client.Export.UpdateOneID(e.ID).
+			SetCursorAt(cursor.At).
+			SetCursorID(cursor.ID).
+			Exec(ctx)
+		if err != nil {
+			return res, fmt.Errorf("failed to save cursor: %w", err)
+		}
+		res.experiences += len(experiences)
+		res.bytes += int64(len(body))
+		res.objects = append(res.objects, key)
+
+		if len(experiences) < objectSize {
+			return res, nil
+		}
+	}
+}
+
+// read returns up to objectSize experiences changed after the cursor and before settled,
+// in the order of the cursor
+func (s *Scheduler) read(ctx context.Context, cursor position, settled time.Time) ([]*ent.ExperienceData, error) {
+	var experiences []*ent.ExperienceData
+	for len(experiences) < objectSize {
+		c := cursor
+		batch, err := s.client.ExperienceData.Query().
+			Where(func(sel *entsql.Selector) {
+				sel.Where(entsql.And(
+					entsql.LTE(sel.C(experiencedata.FieldUpdatedAt), settled),
+					entsql.Or(
+						entsql.GT(sel.C(experiencedata.FieldUpdatedAt), c.At),
+						entsql.And(entsql.EQ(sel.C(experiencedata.FieldUpdatedAt), c.At), entsql.GT(sel.C(experiencedata.FieldID), c.ID)),
+					),
+				))
+			}).
+			Order(ent.Asc(experiencedata.FieldUpdatedAt), ent.Asc(experiencedata.FieldID)).
+			Limit(min(readBatchSize, objectSize-len(experiences))).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read experiences: %w", err)
+		}
+		experiences = append(experiences, batch...)
+		if len(batch) < readBatchSize {
+			break
+		}
+		last := batch[len(batch)-1]
+		cursor = position{At: last.UpdatedAt, ID: last.ID}
+	}
+	return experiences, nil
+}
+
+// position is the cursor of an export: the updated_at of the last experience written, and
+// its ID to order experiences of the same time
+type position struct {
+	At time.Time
+	ID uuid.UUID
+}
+
+// ObjectKey returns the key of an object of a run: in the folder of the expanded prefix,
+// named by the start of the run and the part
+func ObjectKey(prefix string, started time.Time, part int, format string) string {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return fmt.Sprintf("%sexperiences-%s-%04d%s", prefix, started.UTC().Format("20060102T150405Z"), part, Extension(format))
+}
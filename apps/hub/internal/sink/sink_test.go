@@ -0,0 +1,228 @@
+package sink
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+)
+
+func TestPrefix(t *testing.T) {
+	at := time.Date(2024, 1, 5, 9, 30, 0, 0, time.UTC)
+	if got := ExpandPrefix("hub/dt={date}/{year}/{month}/{day}/{hour}", at); got != "hub/dt=2024-01-05/2024/01/05/09" {
+		t.Errorf("ExpandPrefix() = %q", got)
+	}
+	if got := ObjectKey("exports/{date}", at, 2, FormatParquet); got != "exports/{date}/experiences-20240105T093000Z-0002.parquet" {
+		t.Errorf("ObjectKey() = %q", got)
+	}
+	if got := ObjectKey("", at, 1, FormatJSONL); got != "experiences-20240105T093000Z-0001.jsonl.gz" {
+		t.Errorf("ObjectKey() = %q", got)
+	}
+
+	if err := ValidatePrefix("hub/{date}/"); err != nil {
+		t.Errorf("ValidatePrefix() error = %v", err)
+	}
+	for _, invalid := range []string{"hub/{week}/", "/hub/"} {
+		if err := ValidatePrefix(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	text, version := "Exports keep timing out", 2
+	questionID := uuid.New()
+	exp := &ent.ExperienceData{
+		ID:                uuid.New(),
+		CreatedAt:         time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+		UpdatedAt:         time.Date(2024, 1, 15, 10, 31, 0, 0, time.UTC),
+		CollectedAt:       time.Date(2024, 1, 15, 10, 29, 0, 0, time.UTC),
+		SourceType:        "formbricks",
+		FieldID:           "feedback",
+		FieldType:         "text",
+		QuestionID:        &questionID,
+		ValueText:         &text,
+		Metadata:          map[string]interface{}{"plan": "pro"},
+		Topics:            []string{"exports"},
+		EnrichmentVersion: &version,
+	}
+	records := []Record{ToRecord(exp)}
+
+	t.Run("jsonl", func(t *testing.T) {
+		body, contentType, err := Encode(FormatJSONL, records)
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		if contentType != "application/gzip" {
+			t.Errorf("content type = %q", contentType)
+		}
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("invalid gzip: %v", err)
+		}
+		scanner := bufio.NewScanner(zr)
+		var lines []map[string]any
+		for scanner.Scan() {
+			var line map[string]any
+			if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+				t.Fatalf("invalid line: %v", err)
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) != 1 || lines[0]["id"] != exp.ID.String() || lines[0]["question_id"] != questionID.String() {
+			t.Fatalf("unexpected lines: %v", lines)
+		}
+		if metadata, ok := lines[0]["metadata"].(map[string]any); !ok || metadata["plan"] != "pro" {
+			t.Errorf("expected metadata as an object, got %v", lines[0]["metadata"])
+		}
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		body, _, err := Encode(FormatParquet, records)
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		rows, err := parquet.Read[Record](bytes.NewReader(body), int64(len(body)))
+		if err != nil {
+			t.Fatalf("invalid parquet: %v", err)
+		}
+		if len(rows) != 1 {
+			t.Fatalf("got %d rows, want 1", len(rows))
+		}
+		row := rows[0]
+		if row.ID != exp.ID.String() || *row.ValueText != text || !row.UpdatedAt.Equal(exp.UpdatedAt) || *row.EnrichmentVersion != 2 {
+			t.Errorf("unexpected row: %+v", row)
+		}
+		if row.Metadata == nil || *row.Metadata != `{"plan":"pro"}` || row.ValueNumber != nil || len(row.Topics) != 1 {
+			t.Errorf("unexpected row: %+v", row)
+		}
+	})
+}
+
+func TestBucketPut(t *testing.T) {
+	var request *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	bucket := &Bucket{Provider: ProviderS3, Name: "feedback", Endpoint: server.URL, AccessKeyID: "AKID", SecretAccessKey: "secret"}
+	if err := bucket.Put(context.Background(), "hub/dt=2024-01-05/experiences.jsonl.gz", "application/gzip", []byte("data")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if request.Method != http.MethodPut || request.URL.EscapedPath() != "/feedback/hub/dt%3D2024-01-05/experiences.jsonl.gz" || string(body) != "data" {
+		t.Errorf("unexpected request: %s %s %q", request.Method, request.URL.EscapedPath(), body)
+	}
+	authorization := request.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(authorization, "/us-east-1/s3/aws4_request") {
+		t.Errorf("unexpected signature: %s", authorization)
+	}
+	if request.Header.Get("X-Amz-Content-Sha256") != sha256Hex([]byte("data")) {
+		t.Errorf("expected the payload hash to be sent")
+	}
+
+	t.Run("gcs", func(t *testing.T) {
+		bucket := &Bucket{Provider: ProviderGCS, Name: "feedback"}
+		target, _, err := bucket.objectURL("hub/a b.parquet", "auto")
+		if err != nil || target != "https://storage.googleapis.com/feedback/hub/a%20b.parquet" {
+			t.Errorf("objectURL() = %q, %v", target, err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+		}))
+		defer server.Close()
+		bucket := &Bucket{Provider: ProviderS3, Name: "feedback", Endpoint: server.URL, AccessKeyID: "AKID", SecretAccessKey: "secret"}
+		if err := bucket.Put(context.Background(), "key", "application/gzip", nil); err == nil || !strings.Contains(err.Error(), "AccessDenied") {
+			t.Errorf("Put() error = %v, want the response of the service", err)
+		}
+	})
+}
+
+func TestBucketObjects(t *testing.T) {
+	objects := map[string][]byte{}
+	var uploadHash string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+			t.Errorf("unsigned %s request", r.Method)
+		}
+		key := strings.TrimPrefix(r.URL.Path, "/backups/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/backups":
+			prefix := r.URL.Query().Get("prefix")
+			if r.URL.Query().Get("list-type") != "2" {
+				t.Errorf("expected a ListObjectsV2 request, got %s", r.URL.RawQuery)
+			}
+			_, _ = io.WriteString(w, "<ListBucketResult>")
+			for k := range objects {
+				if strings.HasPrefix(k, prefix) {
+					_, _ = io.WriteString(w, "<Contents><Key>"+k+"</Key></Contents>")
+				}
+			}
+			_, _ = io.WriteString(w, "<IsTruncated>false</IsTruncated></ListBucketResult>")
+		case r.Method == http.MethodPut:
+			uploadHash = r.Header.Get("X-Amz-Content-Sha256")
+			objects[key], _ = io.ReadAll(r.Body)
+		case r.Method == http.MethodGet:
+			body, ok := objects[key]
+			if !ok {
+				http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		case r.Method == http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	bucket := &Bucket{Provider: ProviderS3, Name: "backups", Endpoint: server.URL, AccessKeyID: "AKID", SecretAccessKey: "secret"}
+	if err := bucket.Upload(ctx, "hub/a", "application/octet-stream", strings.NewReader("backup"), 6); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if uploadHash != unsignedPayload {
+		t.Errorf("payload hash = %q, want %q for a streamed upload", uploadHash, unsignedPayload)
+	}
+	if err := bucket.Put(ctx, "other/b", "text/plain", []byte("other")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	keys, err := bucket.List(ctx, "hub/")
+	if err != nil || len(keys) != 1 || keys[0] != "hub/a" {
+		t.Errorf("List() = %v, %v, want [hub/a]", keys, err)
+	}
+	body, err := bucket.Get(ctx, "hub/a")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	content, _ := io.ReadAll(body)
+	_ = body.Close()
+	if string(content) != "backup" {
+		t.Errorf("Get() = %q, want the uploaded content", content)
+	}
+
+	if err := bucket.Delete(ctx, "hub/a"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := bucket.Get(ctx, "hub/a"); err == nil || !strings.Contains(err.Error(), "NoSuchKey") {
+		t.Errorf("Get() of a deleted object error = %v, want NoSuchKey", err)
+	}
+}
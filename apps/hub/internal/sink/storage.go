@@ -0,0 +1,342 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// ProviderS3 writes to Amazon S3 or an S3-compatible service such as MinIO or R2
+	ProviderS3 = "s3"
+	// ProviderGCS writes to Google Cloud Storage through its S3-compatible XML API, with HMAC keys
+	ProviderGCS = "gcs"
+
+	// gcsEndpoint is the XML API of Google Cloud Storage
+	gcsEndpoint = "https://storage.googleapis.com"
+	// defaultRegion is the region of buckets that don't set one
+	defaultRegion = "us-east-1"
+	// awsTimeFormat is the timestamp format used by AWS Signature Version 4
+	awsTimeFormat = "20060102T150405Z"
+	// uploadTimeout is how long an object may take to upload
+	uploadTimeout = 5 * time.Minute
+	// transferTimeout is how long a streamed upload or download may take
+	transferTimeout = time.Hour
+	// unsignedPayload is the payload hash of uploads whose content isn't signed
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// placeholder matches the placeholders of a prefix
+var placeholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// Bucket is a bucket that objects are written to
+type Bucket struct {
+	Provider        string
+	Name            string
+	Region          string
+	Endpoint        string // URL of an S3-compatible service; empty for the provider's
+	AccessKeyID     string // HMAC key for gcs; the AWS_* environment variables if empty
+	SecretAccessKey string
+
+	client *http.Client
+}
+
+// ValidateBucket rejects storage settings that objects can't be written with
+func ValidateBucket(provider, name, endpoint string) error {
+	if provider != ProviderS3 && provider != ProviderGCS {
+		return fmt.Errorf("unknown provider %q", provider)
+	}
+	if name == "" || strings.ContainsAny(name, "/?#") {
+		return fmt.Errorf("invalid bucket name %q", name)
+	}
+	if endpoint != "" {
+		parsed, err := url.Parse(endpoint)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("endpoint must be an absolute http or https URL")
+		}
+	}
+	return nil
+}
+
+// ValidatePrefix rejects prefixes with unknown placeholders
+func ValidatePrefix(prefix string) error {
+	for _, match := range placeholder.FindAllString(prefix, -1) {
+		switch match {
+		case "{date}", "{year}", "{month}", "{day}", "{hour}":
+		default:
+			return fmt.Errorf("unknown placeholder %s in prefix; use {date}, {year}, {month}, {day}, or {hour}", match)
+		}
+	}
+	if strings.HasPrefix(prefix, "/") {
+		return fmt.Errorf("prefix must not start with /")
+	}
+	return nil
+}
+
+// ExpandPrefix replaces the placeholders of a prefix with the UTC date and hour of at
+func ExpandPrefix(prefix string, at time.Time) string {
+	at = at.UTC()
+	return strings.NewReplacer(
+		"{date}", at.Format("2006-01-02"),
+		"{year}", at.Format("2006"),
+		"{month}", at.Format("01"),
+		"{day}", at.Format("02"),
+		"{hour}", at.Format("15"),
+	).Replace(prefix)
+}
+
+// Put writes an object to the bucket, replacing any object of the same key
+func (b *Bucket) Put(ctx context.Context, key, contentType string, body []byte) error {
+	resp, err := b.send(ctx, http.MethodPut, key, nil, bytes.NewReader(body), int64(len(body)), sha256Hex(body), contentType, uploadTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// Upload streams size bytes of body to an object of the bucket, replacing any object of the
+// same key. Unlike Put, the object doesn't have to fit in memory; its content isn't signed,
+// so endpoints should use HTTPS. Objects are limited to the 5GB of a single upload.
+func (b *Bucket) Upload(ctx context.Context, key, contentType string, body io.Reader, size int64) error {
+	resp, err := b.send(ctx, http.MethodPut, key, nil, body, size, unsignedPayload, contentType, transferTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// Get returns the content of an object, which the caller must close
+func (b *Bucket) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := b.send(ctx, http.MethodGet, key, nil, nil, 0, sha256Hex(nil), "", transferTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", key, err)
+	}
+	return resp.Body, nil
+}
+
+// Delete deletes an object of the bucket
+func (b *Bucket) Delete(ctx context.Context, key string) error {
+	resp, err := b.send(ctx, http.MethodDelete, key, nil, nil, 0, sha256Hex(nil), "", uploadTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// List returns the keys of the objects whose key starts with prefix, in key order
+func (b *Bucket) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := b.send(ctx, http.MethodGet, "", query, nil, 0, sha256Hex(nil), "", uploadTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", prefix, err)
+		}
+		var page struct {
+			Contents []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&page)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", prefix, err)
+		}
+		for _, c := range page.Contents {
+			keys = append(keys, c.Key)
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = page.NextContinuationToken
+	}
+}
+
+// send sends a signed request for an object of the bucket, or for the bucket itself if key
+// is empty, and returns the response if it succeeded. The timeout includes reading the
+// response body.
+func (b *Bucket) send(ctx context.Context, method, key string, query url.Values, body io.Reader, size int64, payloadHash, contentType string, timeout time.Duration) (*http.Response, error) {
+	creds, err := b.credentials()
+	if err != nil {
+		return nil, err
+	}
+	region := b.Region
+	switch {
+	case region != "":
+	case b.Provider == ProviderGCS:
+		region = "auto"
+	default:
+		region = defaultRegion
+	}
+
+	target, path, err := b.objectURL(key, region)
+	if err != nil {
+		return nil, err
+	}
+	if query != nil {
+		// Signatures need spaces encoded as %20
+		target += "?" + strings.ReplaceAll(query.Encode(), "+", "%20")
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	signS3Request(req, path, payloadHash, creds, region, time.Now())
+
+	client := b.client
+	if client == nil {
+		client = &http.Client{Timeout: timeout}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return resp, nil
+}
+
+// objectURL returns the URL of an object and its escaped path. Buckets of S3 itself are
+// addressed by host; those of other services by path, which all of them support.
+func (b *Bucket) objectURL(key, region string) (string, string, error) {
+	endpoint := b.Endpoint
+	if endpoint == "" && b.Provider == ProviderGCS {
+		endpoint = gcsEndpoint
+	}
+	if endpoint == "" {
+		path := "/" + escapeS3Path(key)
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com%s", b.Name, region, path), path, nil
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return "", "", fmt.Errorf("invalid endpoint: %w", err)
+	}
+	path := base.EscapedPath() + "/" + escapeS3Path(b.Name)
+	if key != "" {
+		path += "/" + escapeS3Path(key)
+	}
+	return base.Scheme + "://" + base.Host + path, path, nil
+}
+
+// credentials returns the keys of the bucket, or those of the AWS_* environment variables
+func (b *Bucket) credentials() (s3Credentials, error) {
+	if b.AccessKeyID != "" {
+		return s3Credentials{AccessKeyID: b.AccessKeyID, SecretAccessKey: b.SecretAccessKey}, nil
+	}
+	creds := s3Credentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return s3Credentials{}, fmt.Errorf("no storage credentials configured (set an access key or the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables)")
+	}
+	return creds, nil
+}
+
+// s3Credentials holds the keys used to sign requests
+type s3Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// signS3Request signs the request with AWS Signature Version 4, which S3-compatible services
+// and the XML API of Google Cloud Storage accept. The host, date, payload hash, and session
+// token are signed; path is the escaped path of the request.
+func signS3Request(req *http.Request, path, payloadHash string, creds s3Credentials, region string, now time.Time) {
+	timestamp := now.UTC().Format(awsTimeFormat)
+	date := timestamp[:8]
+
+	req.Header.Set("X-Amz-Date", timestamp)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	headers := []string{"host:" + req.URL.Host, "x-amz-content-sha256:" + payloadHash, "x-amz-date:" + timestamp}
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+		headers = append(headers, "x-amz-security-token:"+creds.SessionToken)
+		signedHeaders += ";x-amz-security-token"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		strings.Join(headers, "\n") + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		timestamp,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// escapeS3Path escapes every byte of an object key but unreserved characters and slashes,
+// as the signature requires
+func escapeS3Path(key string) string {
+	var b strings.Builder
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || strings.IndexByte("-_.~/", c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = io.WriteString(mac, data)
+	return mac.Sum(nil)
+}
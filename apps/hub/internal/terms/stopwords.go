@@ -0,0 +1,81 @@
+package terms
+
+import "strings"
+
+// stopwordLists are the words that aren't counted, by language. They are common function
+// words; unlike the keyword stopwords of enrichment, opinion words such as "good" or "slow"
+// are kept, as they belong in a word cloud.
+var stopwordLists = map[string]string{
+	"en": `a about above after again against all also am an and any are aren't as at be because been
+		before being below between both but by can can't cannot could couldn't did didn't do does doesn't
+		doing don't down during each even ever every few for from further get gets got had hadn't has
+		hasn't have haven't having he he's her here hers herself him himself his how i i'd i'll i'm i've
+		if in into is isn't it it's its itself just let let's me more most much my myself no nor not now of
+		off on once only or other our ours ourselves out over own same she she's should shouldn't so
+		some still such than that that's the their theirs them themselves then there there's these they
+		they're this those through to too under until up us very was wasn't we we're we've were weren't
+		what what's when where which while who whom why will with won't would wouldn't you you're you've
+		your yours yourself yourselves`,
+	"de": `aber alle allem allen aller alles als also am an andere anderen auch auf aus bei beim bin bis
+		bist da damit dann das dass dein deine dem den denn der des dich die dies diese diesem diesen
+		dieser dieses dir doch dort du durch ein eine einem einen einer eines er es etwas euch euer für
+		gegen gewesen hab habe haben hat hatte hier hin hinter ich ihm ihn ihnen ihr ihre im in ist ja
+		jede jedem jeden jeder jetzt kann kein keine können man mein meine mich mir mit muss nach nicht
+		nichts noch nun nur ob oder ohne schon sehr sein seine sich sie sind so soll über um und uns
+		unser unter viel vom von vor war waren was weil welche wenn wer werden wie wieder wir wird wo
+		zu zum zur`,
+	"fr": `à afin ai aie alors au aucun aussi autre aux avec avoir avons ayant c ce ceci cela celle
+		celles celui cependant certain ces cet cette ceux chez ci comme comment d dans de des donc dont du
+		elle elles en encore est et étaient était été être eu eux fait il ils j je l la le les leur
+		leurs lui m ma mais me même mes moi mon n ne ni nos notre nous on ont ou où par pas peu peut
+		plus pour pourquoi qu quand que quel quelle quels qui s sa sans se ses si son sont sous sur ta te
+		tes toi ton tous tout toute toutes très tu un une vos votre vous y`,
+	"es": `a al algo algunos ante antes como con contra cual cuando de del desde donde durante e el él
+		ella ellas ellos en entre era es esa esas ese eso esos esta está están estas este esto estos
+		estoy fue fueron ha había han hasta hay la las le les lo los más me mi mis mucho muy nada ni no
+		nos nosotros o os otra otro para pero poco por porque que qué quien se sea ser si sí sin sobre
+		son su sus también tan te tengo tiene todo todos tu tus un una uno unos y ya yo`,
+	"pt": `a à ao aos as às até com como da das de dela dele deles depois do dos e é ela elas ele eles
+		em entre era essa essas esse esses esta está estão estas este estes eu foi foram há isso isto já
+		lhe mais mas me mesmo meu minha muito na nas nem no nos nós o os ou para pela pelas pelo pelos
+		por qual quando que quem se sem ser seu seus só sua suas também te tem tenho teu tu um uma você
+		vocês`,
+	"it": `a ad agli ai al alla alle allo anche avere c che chi ci come con contro cui da dal dalla dalle
+		degli dei del della delle dello di dove e è ed era essere gli ha hanno ho i il in io l la le lei
+		li lo loro lui ma mi mia mio molto ne nei nel nella nelle noi non nostro o per perché più poco
+		quale quando quella quelle quello questa queste questo se sei si sia siamo sono su sua sue suo
+		sul sulla tra tu tutti tutto un una uno voi`,
+	"nl": `aan al alles als altijd andere ben bij daar dan dat de der deze die dit doch doen door dus een
+		eens en er ge geen geweest haar had heb hebben heeft hem het hier hij hoe hun iemand iets ik in
+		is ja je kan kon kunnen maar me meer men met mij mijn moet na naar niet niets nog nu of om omdat
+		onder ons ook op over reeds te tegen toch toen tot u uit uw van veel voor want waren was wat
+		werd wezen wie wil worden wordt zal ze zelf zich zij zijn zo zonder zou`,
+}
+
+// elidingLanguages are the languages whose articles and pronouns are elided onto the next
+// word (l'application, qu'il), which is counted without them
+var elidingLanguages = map[string]bool{"fr": true, "it": true}
+
+// stopwords are the parsed stopword lists
+var stopwords = map[string]map[string]bool{}
+
+func init() {
+	for language, list := range stopwordLists {
+		words := map[string]bool{}
+		for _, word := range strings.Fields(list) {
+			words[word] = true
+		}
+		stopwords[language] = words
+	}
+}
+
+// baseLanguage returns the lowercase language of an ISO language code or tag such as
+// pt-BR, or en if it's empty
+func baseLanguage(language string) string {
+	if language == "" {
+		return "en"
+	}
+	base, _, _ := strings.Cut(strings.ToLower(language), "-")
+	base, _, _ = strings.Cut(base, "_")
+	return base
+}
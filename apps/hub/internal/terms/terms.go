@@ -0,0 +1,144 @@
+// Package terms counts the most frequent words (unigrams) and word pairs (bigrams) of
+// free-text responses, e.g. for word clouds. Stopwords are removed in the language of
+// each response, and pairs are only formed from words that follow each other directly.
+package terms
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// minTermLength is the minimum number of letters of a counted word
+const minTermLength = 2
+
+// Text is a response to count the terms of
+type Text struct {
+	Text string
+	// Language is an ISO language code such as en or de-AT, English if empty. Texts in
+	// languages without a stopword list keep all words.
+	Language string
+}
+
+// Term is a word or word pair and how often it occurs
+type Term struct {
+	Term      string
+	Count     int // Occurrences in all texts
+	Responses int // Texts it occurs in
+}
+
+// Counts are the most frequent terms of a set of texts
+type Counts struct {
+	Unigrams []Term
+	Bigrams  []Term
+}
+
+// Count returns up to limit of the most frequent words and word pairs of the texts, most
+// frequent first
+func Count(texts []Text, limit int) Counts {
+	unigrams, bigrams := map[string]*Term{}, map[string]*Term{}
+	for _, text := range texts {
+		seen := map[string]bool{}
+		add := func(counts map[string]*Term, term string) {
+			if counts[term] == nil {
+				counts[term] = &Term{Term: term}
+			}
+			counts[term].Count++
+			if !seen[term] {
+				seen[term] = true
+				counts[term].Responses++
+			}
+		}
+
+		language := baseLanguage(text.Language)
+		stop := stopwords[language]
+		for _, clause := range clauses(text.Text) {
+			previous := ""
+			for _, word := range clause {
+				if elidingLanguages[language] {
+					if i := strings.IndexByte(word, '\''); i > 0 && i <= 2 {
+						word = word[i+1:]
+					}
+				}
+				if stop[word] || !counted(word) {
+					previous = ""
+					continue
+				}
+				add(unigrams, word)
+				if previous != "" {
+					add(bigrams, previous+" "+word)
+				}
+				previous = word
+			}
+		}
+	}
+	return Counts{Unigrams: top(unigrams, limit), Bigrams: top(bigrams, limit)}
+}
+
+// top returns up to limit of the terms, most frequent first
+func top(counts map[string]*Term, limit int) []Term {
+	terms := make([]Term, 0, len(counts))
+	for _, term := range counts {
+		terms = append(terms, *term)
+	}
+	slices.SortFunc(terms, func(a, b Term) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(b.Responses, a.Responses), cmp.Compare(a.Term, b.Term))
+	})
+	return terms[:min(len(terms), limit)]
+}
+
+// clauses splits text into its clauses, the runs of lowercase words between punctuation
+func clauses(text string) [][]string {
+	var result [][]string
+	var clause []string
+	var word strings.Builder
+
+	endWord := func() {
+		if word.Len() == 0 {
+			return
+		}
+		w := strings.Trim(word.String(), "'-")
+		// Possessives count as the word itself
+		w = strings.TrimSuffix(w, "'s")
+		if w != "" {
+			clause = append(clause, w)
+		}
+		word.Reset()
+	}
+	endClause := func() {
+		endWord()
+		if len(clause) > 0 {
+			result = append(result, clause)
+			clause = nil
+		}
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r == '’':
+			word.WriteRune('\'')
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == '\'' || r == '-':
+			word.WriteRune(r)
+		case unicode.IsSpace(r):
+			endWord()
+		default:
+			endClause()
+		}
+	}
+	endClause()
+	return result
+}
+
+// counted reports whether a word is counted: it has at least minTermLength characters and
+// at least one letter, so numbers aren't counted
+func counted(word string) bool {
+	letters := false
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			letters = true
+			break
+		}
+	}
+	return letters && len([]rune(word)) >= minTermLength
+}
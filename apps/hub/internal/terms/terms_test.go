@@ -0,0 +1,39 @@
+package terms
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	counts := Count([]Text{
+		{Text: "The export is slow. Export is slow and the app's search is great!"},
+		{Text: "Slow export, again", Language: "en-US"},
+		{Text: "Der Export ist langsam. Langsamer Export", Language: "de"},
+		{Text: "L'application lente", Language: "fr"},
+	}, 3)
+
+	want := []Term{{Term: "export", Count: 5, Responses: 3}, {Term: "slow", Count: 3, Responses: 2}, {Term: "app", Count: 1, Responses: 1}}
+	if !slices.Equal(counts.Unigrams, want) {
+		t.Errorf("expected unigrams %+v, got %+v", want, counts.Unigrams)
+	}
+
+	// Pairs don't span stopwords ("export is slow") or punctuation ("slow, again")
+	want = []Term{{Term: "app search", Count: 1, Responses: 1}, {Term: "application lente", Count: 1, Responses: 1}, {Term: "langsamer export", Count: 1, Responses: 1}}
+	if !slices.Equal(counts.Bigrams, want) {
+		t.Errorf("expected bigrams %+v, got %+v", want, counts.Bigrams)
+	}
+}
+
+func TestClauses(t *testing.T) {
+	got := clauses("The app’s search - really fast; 100% worth-it.")
+	want := [][]string{{"the", "app", "search", "really", "fast"}, {"100"}, {"worth-it"}}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Package testdb starts real PostgreSQL 18 containers with pgvector for the integration
+// tests of packages that query the database, so Postgres-specific features like JSONB
+// columns with GIN indexes, vector embeddings, and row locks are tested as in production.
+//
+// Requirements:
+//   - Docker must be running
+//   - First run downloads pgvector/pgvector:pg18 image (~90MB)
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/migrations"
+)
+
+// New starts a fresh Postgres container with the migrations applied. It returns an Ent
+// client, the connection string, and a function that closes the client and terminates
+// the container.
+func New(t *testing.T) (*ent.Client, string, func()) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	// Start Postgres container with pgvector
+	postgresContainer, err := postgres.Run(ctx,
+		"pgvector/pgvector:pg18",
+		postgres.WithDatabase("test"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(60*time.Second),
+		),
+	)
+	if err != nil {
+		t.Fatalf("failed to start postgres container: %v", err)
+	}
+
+	// Get connection string
+	connStr, err := postgresContainer.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		t.Fatalf("failed to get connection string: %v", err)
+	}
+
+	// Apply the migrations, which also enable the pgvector extension
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		t.Fatalf("failed to open database connection: %v", err)
+	}
+	migrator, err := migrations.New(db)
+	if err != nil {
+		_ = db.Close()
+		t.Fatalf("failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Apply(ctx); err != nil {
+		_ = db.Close()
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+	_ = db.Close()
+
+	// Connect with Ent
+	client, err := ent.Open("postgres", connStr)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	cleanup := func() {
+		if err := client.Close(); err != nil {
+			t.Logf("failed to close database connection: %v", err)
+		}
+		if err := testcontainers.TerminateContainer(postgresContainer); err != nil {
+			t.Logf("failed to terminate container: %v", err)
+		}
+	}
+
+	return client, connStr, cleanup
+}
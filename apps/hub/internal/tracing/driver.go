@@ -0,0 +1,132 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps an Ent driver so every query runs in a span. Statements are recorded
+// without their arguments, which may contain feedback text.
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &tracedDriver{tracedQuerier: tracedQuerier{ExecQuerier: drv, dialect: drv.Dialect()}, drv: drv}
+}
+
+// tracedQuerier runs the queries of a driver or transaction in spans
+type tracedQuerier struct {
+	dialect.ExecQuerier
+	dialect string
+}
+
+// Exec executes a statement in a span
+func (q tracedQuerier) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := q.start(ctx, query)
+	defer span.End()
+
+	err := q.ExecQuerier.Exec(ctx, query, args, v)
+	if err != nil {
+		RecordError(span, err)
+	}
+	return err
+}
+
+// Query executes a query in a span. The span ends when the query returns its rows,
+// before they are scanned.
+func (q tracedQuerier) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := q.start(ctx, query)
+	defer span.End()
+
+	err := q.ExecQuerier.Query(ctx, query, args, v)
+	if err != nil {
+		RecordError(span, err)
+	}
+	return err
+}
+
+// start starts a span named after the statement's operation (e.g., SELECT). Queries outside
+// of a traced request or job, such as queue polling, aren't traced, since each would start
+// a trace of its own.
+func (q tracedQuerier) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	operation, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	operation = strings.ToUpper(operation)
+	return Tracer().Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", dbSystem(q.dialect)),
+			attribute.String("db.operation", operation),
+			attribute.String("db.statement", query),
+		))
+}
+
+// tracedDriver is a dialect.Driver whose queries, including those in transactions, are traced
+type tracedDriver struct {
+	tracedQuerier
+	drv dialect.Driver
+}
+
+// Tx starts a transaction whose queries are traced
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.drv.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{tracedQuerier: tracedQuerier{ExecQuerier: tx, dialect: d.dialect}, tx: tx}, nil
+}
+
+// BeginTx starts a transaction with options, if the wrapped driver supports them
+func (d *tracedDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.drv.(interface {
+		BeginTx(context.Context, *entsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver %T does not support transaction options", d.drv)
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{tracedQuerier: tracedQuerier{ExecQuerier: tx, dialect: d.dialect}, tx: tx}, nil
+}
+
+// Close closes the wrapped driver
+func (d *tracedDriver) Close() error {
+	return d.drv.Close()
+}
+
+// Dialect returns the dialect of the wrapped driver
+func (d *tracedDriver) Dialect() string {
+	return d.dialect
+}
+
+// tracedTx is a transaction whose queries are traced
+type tracedTx struct {
+	tracedQuerier
+	tx dialect.Tx
+}
+
+// Commit commits the transaction
+func (t *tracedTx) Commit() error {
+	return t.tx.Commit()
+}
+
+// Rollback rolls the transaction back
+func (t *tracedTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+// dbSystem maps an Ent dialect to the OpenTelemetry db.system name
+func dbSystem(name string) string {
+	if name == dialect.Postgres {
+		return "postgresql"
+	}
+	return name
+}
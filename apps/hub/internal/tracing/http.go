@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Handler traces incoming requests, continuing the caller's trace if the request carries
+// a traceparent header. Requests to the excluded paths (e.g., health checks) aren't traced.
+func Handler(next http.Handler, excluded ...string) http.Handler {
+	return otelhttp.NewHandler(next, "http.request",
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !slices.Contains(excluded, r.URL.Path)
+		}))
+}
+
+// SetRoute names the request span after the matched route (e.g., GET /v1/experiences/{id}),
+// which is only known once the router has matched the request
+func SetRoute(ctx context.Context, method, route string) {
+	span := trace.SpanFromContext(ctx)
+	span.SetName(method + " " + route)
+	span.SetAttributes(attribute.String("http.route", route))
+}
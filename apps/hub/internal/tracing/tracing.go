@@ -0,0 +1,101 @@
+// Package tracing sets up OpenTelemetry tracing for Hub. Spans are exported over OTLP/HTTP
+// when an endpoint is configured; otherwise the global no-op tracer is used and spans cost
+// next to nothing. Trace context is propagated over HTTP (W3C traceparent) and stored with
+// queued jobs, so a request, its AI jobs, and their webhook deliveries form one trace.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies Hub's spans
+const instrumentationName = "github.com/formbricks/hub/apps/hub"
+
+// defaultTracesPath is the OTLP/HTTP path for traces, used when the endpoint has no path
+const defaultTracesPath = "/v1/traces"
+
+// Tracer returns the tracer for Hub's spans
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Setup installs the W3C trace context propagator and, if endpoint is set, a tracer
+// provider that exports spans to the OTLP/HTTP endpoint (e.g., http://otel-collector:4318).
+// The standard OTEL_EXPORTER_OTLP_HEADERS and OTEL_RESOURCE_ATTRIBUTES variables are honored.
+// The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, endpoint, serviceName string, sampleRatio float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Host == "" {
+		return nil, fmt.Errorf("invalid tracing endpoint %q", endpoint)
+	}
+	if endpointURL.Path == "" || endpointURL.Path == "/" {
+		endpointURL.Path = defaultTracesPath
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpointURL.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attribute.String("service.name", serviceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// Follow the caller's sampling decision, so traces aren't cut in half
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// TraceParent returns the W3C traceparent of the span in ctx, or "" if there is none
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// ContextWithTraceParent returns a context whose remote parent span is the traceparent,
+// e.g. one stored with a job. An empty or invalid traceparent returns ctx unchanged.
+func ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceParent})
+}
+
+// InjectHeaders adds the trace context of ctx to outgoing request headers
+func InjectHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// RecordError marks the span as failed with the error
+func RecordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newRecorder installs a tracer provider that records ended spans in memory
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestTraceParent(t *testing.T) {
+	newRecorder(t)
+
+	if got := TraceParent(context.Background()); got != "" {
+		t.Errorf("expected no traceparent without a span, got %q", got)
+	}
+
+	ctx, span := Tracer().Start(context.Background(), "request")
+	defer span.End()
+	traceParent := TraceParent(ctx)
+	if traceParent == "" {
+		t.Fatal("expected a traceparent")
+	}
+
+	// A job processed later continues the trace
+	_, child := Tracer().Start(ContextWithTraceParent(context.Background(), traceParent), "job")
+	defer child.End()
+	if child.SpanContext().TraceID() != span.SpanContext().TraceID() {
+		t.Errorf("expected the job span in trace %s, got %s", span.SpanContext().TraceID(), child.SpanContext().TraceID())
+	}
+
+	if got := ContextWithTraceParent(ctx, ""); got != ctx {
+		t.Error("expected an empty traceparent to leave the context unchanged")
+	}
+}
+
+// fakeDriver is a dialect.Driver that runs no queries
+type fakeDriver struct {
+	queries []string
+}
+
+func (d *fakeDriver) Exec(_ context.Context, query string, _, _ any) error {
+	d.queries = append(d.queries, query)
+	return nil
+}
+
+func (d *fakeDriver) Query(_ context.Context, query string, _, _ any) error {
+	d.queries = append(d.queries, query)
+	return nil
+}
+
+func (d *fakeDriver) Tx(context.Context) (dialect.Tx, error) { return nil, nil }
+func (d *fakeDriver) Close() error                           { return nil }
+func (d *fakeDriver) Dialect() string                        { return dialect.Postgres }
+
+func TestDriver(t *testing.T) {
+	recorder := newRecorder(t)
+	inner := &fakeDriver{}
+	drv := Driver(inner)
+
+	// Queries outside of a trace, e.g. queue polling, don't start traces
+	if err := drv.Query(context.Background(), `SELECT "id" FROM "enrichment_jobs"`, []any{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(recorder.Ended()); got != 0 {
+		t.Fatalf("expected no spans outside of a trace, got %d", got)
+	}
+
+	ctx, span := Tracer().Start(context.Background(), "request")
+	if err := drv.Exec(ctx, `UPDATE "experiences" SET "value_text" = $1`, []any{"secret feedback"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	span.End()
+
+	if len(inner.queries) != 2 {
+		t.Fatalf("expected both queries to reach the driver, got %d", len(inner.queries))
+	}
+	spans := recorder.Ended()
+	if len(spans) != 2 || spans[0].Name() != "UPDATE" {
+		t.Fatalf("expected an UPDATE span in the request, got %d spans", len(spans))
+	}
+	if spans[0].Parent().SpanID() != span.SpanContext().SpanID() {
+		t.Error("expected the query span to be a child of the request span")
+	}
+	for _, attr := range spans[0].Attributes() {
+		if attr.Key == "db.statement" && attr.Value.AsString() != `UPDATE "experiences" SET "value_text" = $1` {
+			t.Errorf("unexpected statement %q", attr.Value.AsString())
+		}
+	}
+}
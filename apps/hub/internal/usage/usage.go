@@ -0,0 +1,159 @@
+// Package usage records AI token usage and estimated cost.
+// Usage is stored per job (on the enrichment job row) and aggregated per day, job type,
+// provider, and model in the ai_usages table for reporting.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/aiusage"
+)
+
+// Job types recorded in the usage table
+const (
+	JobTypeEnrichment  = "enrichment"
+	JobTypeEmbedding   = "embedding"
+	JobTypeSearch      = "search"
+	JobTypePreview     = "preview"
+	JobTypeTranslation = "translation"
+)
+
+// Entry describes the usage of a single successful AI request
+type Entry struct {
+	JobID    *uuid.UUID // Queue job that made the request, if any
+	JobType  string
+	Provider string
+	Model    string
+	Usage    ai.Usage
+}
+
+// Recorder stores AI usage in the database
+type Recorder struct {
+	db     *ent.Client
+	logger *slog.Logger
+}
+
+// NewRecorder creates a new usage recorder
+func NewRecorder(db *ent.Client, logger *slog.Logger) *Recorder {
+	return &Recorder{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Record stores the usage on the job (if any) and adds it to the daily aggregate.
+// Returns the estimated cost in USD.
+func (r *Recorder) Record(ctx context.Context, entry Entry) (float64, error) {
+	cost := ai.EstimateCost(entry.Model, entry.Usage)
+
+	if entry.JobID != nil {
+		err := r.db.EnrichmentJob.
+			UpdateOneID(*entry.JobID).
+			SetPromptTokens(entry.Usage.PromptTokens).
+			SetCompletionTokens(entry.Usage.CompletionTokens).
+			SetCostUsd(cost).
+			Exec(ctx)
+		// Jobs of queue backends other than PostgreSQL have no row to update
+		if err != nil && !ent.IsNotFound(err) {
+			return cost, fmt.Errorf("failed to record job usage: %w", err)
+		}
+	}
+
+	if err := r.addToDaily(ctx, entry, cost); err != nil {
+		return cost, err
+	}
+
+	return cost, nil
+}
+
+// RecordBatch records a single request made on behalf of several jobs. Tokens and cost
+// are split evenly across the jobs, while the daily aggregate counts one request.
+// Returns the estimated cost in USD.
+func (r *Recorder) RecordBatch(ctx context.Context, jobIDs []uuid.UUID, entry Entry) (float64, error) {
+	cost := ai.EstimateCost(entry.Model, entry.Usage)
+
+	if n := len(jobIDs); n > 0 {
+		for _, jobID := range jobIDs {
+			err := r.db.EnrichmentJob.
+				UpdateOneID(jobID).
+				SetPromptTokens(entry.Usage.PromptTokens / n).
+				SetCompletionTokens(entry.Usage.CompletionTokens / n).
+				SetCostUsd(cost / float64(n)).
+				Exec(ctx)
+			if err != nil && !ent.IsNotFound(err) {
+				return cost, fmt.Errorf("failed to record job usage: %w", err)
+			}
+		}
+	}
+
+	if err := r.addToDaily(ctx, entry, cost); err != nil {
+		return cost, err
+	}
+
+	return cost, nil
+}
+
+// RecordAsync records usage without blocking the caller; failures are logged
+func (r *Recorder) RecordAsync(entry Entry) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := r.Record(ctx, entry); err != nil {
+			r.logger.Warn("failed to record AI usage", "job_type", entry.JobType, "error", err)
+		}
+	}()
+}
+
+// addToDaily increments the aggregate row for the entry's day, job type, provider and model,
+// creating it on first use. A concurrent create is resolved by retrying the increment.
+func (r *Recorder) addToDaily(ctx context.Context, entry Entry, cost float64) error {
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for attempt := 0; attempt < 2; attempt++ {
+		updated, err := r.db.AIUsage.Update().
+			Where(
+				aiusage.Day(day),
+				aiusage.JobType(entry.JobType),
+				aiusage.Provider(entry.Provider),
+				aiusage.Model(entry.Model),
+			).
+			AddRequests(1).
+			AddPromptTokens(int64(entry.Usage.PromptTokens)).
+			AddCompletionTokens(int64(entry.Usage.CompletionTokens)).
+			AddCostUsd(cost).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update AI usage: %w", err)
+		}
+		if updated > 0 {
+			return nil
+		}
+
+		err = r.db.AIUsage.Create().
+			SetDay(day).
+			SetJobType(entry.JobType).
+			SetProvider(entry.Provider).
+			SetModel(entry.Model).
+			SetRequests(1).
+			SetPromptTokens(int64(entry.Usage.PromptTokens)).
+			SetCompletionTokens(int64(entry.Usage.CompletionTokens)).
+			SetCostUsd(cost).
+			Exec(ctx)
+		if err == nil {
+			return nil
+		}
+		if !ent.IsConstraintError(err) {
+			return fmt.Errorf("failed to create AI usage: %w", err)
+		}
+		// Another worker created the row first; increment it instead
+	}
+
+	return fmt.Errorf("failed to record AI usage after retry")
+}
@@ -0,0 +1,285 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+const (
+	// BigQueryScope grants access to BigQuery
+	BigQueryScope = "https://www.googleapis.com/auth/bigquery"
+	// defaultBigQueryURL is the base URL of the BigQuery API
+	defaultBigQueryURL = "https://bigquery.googleapis.com/bigquery/v2"
+	// requestTimeout bounds a single API request
+	requestTimeout = time.Minute
+)
+
+// TokenSource returns OAuth access tokens, e.g. of a Google Cloud service account
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// BigQuery is a table in BigQuery that experiences are streamed to with the insertAll API.
+// The table is partitioned by day of created_at and clustered by source_type.
+type BigQuery struct {
+	project string
+	dataset string
+	table   string
+	auth    TokenSource
+	baseURL string
+	client  *http.Client
+}
+
+// NewBigQuery returns the table of a dataset of a project, written with the tokens of auth,
+// which need the BigQueryScope. The dataset must exist.
+func NewBigQuery(project, dataset, table string, auth TokenSource) *BigQuery {
+	return &BigQuery{
+		project: project,
+		dataset: dataset,
+		table:   table,
+		auth:    auth,
+		baseURL: defaultBigQueryURL,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Stream names the cursor of the table
+func (b *BigQuery) Stream() string {
+	return fmt.Sprintf("warehouse.bigquery.%s.%s.%s", b.project, b.dataset, b.table)
+}
+
+// bigQueryField is a column in a BigQuery table schema
+type bigQueryField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Mode string `json:"mode,omitempty"`
+}
+
+// bigQueryTable is a BigQuery table resource, with the fields Hub sets
+type bigQueryTable struct {
+	TableReference   *bigQueryTableReference `json:"tableReference,omitempty"`
+	Schema           bigQuerySchema          `json:"schema"`
+	TimePartitioning *bigQueryPartitioning   `json:"timePartitioning,omitempty"`
+	Clustering       *bigQueryClustering     `json:"clustering,omitempty"`
+}
+
+type bigQueryTableReference struct {
+	ProjectID string `json:"projectId"`
+	DatasetID string `json:"datasetId"`
+	TableID   string `json:"tableId"`
+}
+
+type bigQuerySchema struct {
+	Fields []bigQueryField `json:"fields"`
+}
+
+type bigQueryPartitioning struct {
+	Type  string `json:"type"`
+	Field string `json:"field"`
+}
+
+type bigQueryClustering struct {
+	Fields []string `json:"fields"`
+}
+
+// bigQueryFields returns the schema of Columns
+func bigQueryFields() []bigQueryField {
+	fields := make([]bigQueryField, len(Columns))
+	for i, c := range Columns {
+		field := bigQueryField{Name: c.Name, Mode: "NULLABLE"}
+		switch c.Type {
+		case typeTimestamp:
+			field.Type = "TIMESTAMP"
+		case typeFloat:
+			field.Type = "FLOAT"
+		case typeInteger:
+			field.Type = "INTEGER"
+		case typeBoolean:
+			field.Type = "BOOLEAN"
+		case typeJSON:
+			field.Type = "JSON"
+		case typeList:
+			field.Type, field.Mode = "STRING", "REPEATED"
+		default:
+			field.Type = "STRING"
+		}
+		fields[i] = field
+	}
+	return fields
+}
+
+// Prepare creates the table if it doesn't exist and adds the columns it's missing
+func (b *BigQuery) Prepare(ctx context.Context) error {
+	tableURL := fmt.Sprintf("%s/projects/%s/datasets/%s/tables", b.baseURL, url.PathEscape(b.project), url.PathEscape(b.dataset))
+
+	// The schema is decoded as is, so the columns it has are patched back unchanged
+	var existing struct {
+		Schema struct {
+			Fields []json.RawMessage `json:"fields"`
+		} `json:"schema"`
+	}
+	err := b.do(ctx, http.MethodGet, tableURL+"/"+url.PathEscape(b.table), nil, &existing)
+	var apiErr *googleError
+	if errors.As(err, &apiErr) && apiErr.status == http.StatusNotFound {
+		table := bigQueryTable{
+			TableReference:   &bigQueryTableReference{ProjectID: b.project, DatasetID: b.dataset, TableID: b.table},
+			Schema:           bigQuerySchema{Fields: bigQueryFields()},
+			TimePartitioning: &bigQueryPartitioning{Type: "DAY", Field: "created_at"},
+			Clustering:       &bigQueryClustering{Fields: []string{"source_type"}},
+		}
+		return b.do(ctx, http.MethodPost, tableURL, table, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	have := make(map[string]bool, len(existing.Schema.Fields))
+	fields := make([]any, 0, len(existing.Schema.Fields))
+	for _, raw := range existing.Schema.Fields {
+		var field bigQueryField
+		if err := json.Unmarshal(raw, &field); err != nil {
+			return fmt.Errorf("invalid table schema: %w", err)
+		}
+		have[strings.ToLower(field.Name)] = true
+		fields = append(fields, raw)
+	}
+	added := 0
+	for _, field := range bigQueryFields() {
+		if !have[field.Name] {
+			fields = append(fields, field)
+			added++
+		}
+	}
+	if added == 0 {
+		return nil
+	}
+	patch := map[string]any{"schema": map[string]any{"fields": fields}}
+	return b.do(ctx, http.MethodPatch, tableURL+"/"+url.PathEscape(b.table), patch, nil)
+}
+
+// Append streams records to the table. Each row's insert ID is its ID and updated_at, so
+// BigQuery drops rows that are sent again after a failed request.
+func (b *BigQuery) Append(ctx context.Context, records []sink.Record) error {
+	type row struct {
+		InsertID string         `json:"insertId"`
+		JSON     map[string]any `json:"json"`
+	}
+	rows := make([]row, len(records))
+	for i := range records {
+		values := make(map[string]any, len(Columns))
+		for _, c := range Columns {
+			value := c.value(&records[i])
+			if t, ok := value.(time.Time); ok {
+				value = t.UTC().Format("2006-01-02T15:04:05.999999Z07:00")
+			}
+			if value != nil {
+				values[c.Name] = value
+			}
+		}
+		rows[i] = row{
+			InsertID: records[i].ID + "/" + records[i].UpdatedAt.Format(time.RFC3339Nano),
+			JSON:     values,
+		}
+	}
+
+	var resp struct {
+		InsertErrors []struct {
+			Index  int `json:"index"`
+			Errors []struct {
+				Reason  string `json:"reason"`
+				Message string `json:"message"`
+			} `json:"errors"`
+		} `json:"insertErrors"`
+	}
+	target := fmt.Sprintf("%s/projects/%s/datasets/%s/tables/%s/insertAll", b.baseURL, url.PathEscape(b.project), url.PathEscape(b.dataset), url.PathEscape(b.table))
+	if err := b.do(ctx, http.MethodPost, target, map[string]any{"rows": rows}, &resp); err != nil {
+		return err
+	}
+	// Rows of a request are written together; the errors of the others are "stopped"
+	for _, insertErr := range resp.InsertErrors {
+		for _, e := range insertErr.Errors {
+			if e.Reason != "stopped" {
+				return fmt.Errorf("failed to insert row of experience %s: %s: %s", records[insertErr.Index].ID, e.Reason, e.Message)
+			}
+		}
+	}
+	if len(resp.InsertErrors) > 0 {
+		return fmt.Errorf("failed to insert %d rows", len(resp.InsertErrors))
+	}
+	return nil
+}
+
+// googleError is an error response of a Google API
+type googleError struct {
+	api     string
+	status  int
+	message string
+}
+
+func (e *googleError) Error() string {
+	return fmt.Sprintf("%s API returned %d: %s", e.api, e.status, e.message)
+}
+
+// do sends a request to the BigQuery API
+func (b *BigQuery) do(ctx context.Context, method, target string, body, out any) error {
+	return callGoogle(ctx, b.client, b.auth, "BigQuery", method, target, body, out)
+}
+
+// callGoogle sends a request with a JSON body, if any, to a Google API and decodes the
+// response into out, if any
+func callGoogle(ctx context.Context, client *http.Client, auth TokenSource, api, method, target string, body, out any) error {
+	token, err := auth.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get %s token: %w", api, err)
+	}
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 300 {
+		var errBody struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		message := http.StatusText(resp.StatusCode)
+		if json.Unmarshal(data, &errBody) == nil && errBody.Error.Message != "" {
+			message = errBody.Error.Message
+		}
+		return &googleError{api: api, status: resp.StatusCode, message: message}
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,101 @@
+package warehouse
+
+import (
+	"encoding/json"
+
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+// Column types, mapped to the types of each warehouse
+const (
+	typeString    = "string"
+	typeTimestamp = "timestamp"
+	typeFloat     = "float"
+	typeInteger   = "integer"
+	typeBoolean   = "boolean"
+	typeJSON      = "json"
+	typeList      = "list" // list of strings
+)
+
+// Column is a column of the synced table
+type Column struct {
+	Name string
+	Type string
+	// value returns the value of the column in a record: a string, time.Time, float64,
+	// int64, bool, []string, or nil for NULL. JSON columns are JSON text.
+	value func(r *sink.Record) any
+}
+
+// Columns are the columns of the synced table, one per field of an experience (as in
+// exports). New columns are added to the table before the next sync; columns are never
+// dropped or changed.
+var Columns = []Column{
+	{"id", typeString, func(r *sink.Record) any { return r.ID }},
+	{"created_at", typeTimestamp, func(r *sink.Record) any { return r.CreatedAt }},
+	{"updated_at", typeTimestamp, func(r *sink.Record) any { return r.UpdatedAt }},
+	{"collected_at", typeTimestamp, func(r *sink.Record) any { return r.CollectedAt }},
+	{"source_type", typeString, func(r *sink.Record) any { return r.SourceType }},
+	{"source_id", typeString, func(r *sink.Record) any { return r.SourceID }},
+	{"source_name", typeString, func(r *sink.Record) any { return r.SourceName }},
+	{"field_id", typeString, func(r *sink.Record) any { return r.FieldID }},
+	{"field_label", typeString, func(r *sink.Record) any { return r.FieldLabel }},
+	{"field_type", typeString, func(r *sink.Record) any { return r.FieldType }},
+	{"question_id", typeString, func(r *sink.Record) any { return deref(r.QuestionID) }},
+	{"value_text", typeString, func(r *sink.Record) any { return deref(r.ValueText) }},
+	{"value_number", typeFloat, func(r *sink.Record) any { return deref(r.ValueNumber) }},
+	{"value_boolean", typeBoolean, func(r *sink.Record) any { return deref(r.ValueBoolean) }},
+	{"value_date", typeTimestamp, func(r *sink.Record) any { return deref(r.ValueDate) }},
+	{"value_json", typeJSON, func(r *sink.Record) any { return jsonText(r.ValueJSON) }},
+	{"nps_category", typeString, func(r *sink.Record) any { return deref(r.NPSCategory) }},
+	{"metadata", typeJSON, func(r *sink.Record) any { return jsonText(r.Metadata) }},
+	{"user_identifier", typeString, func(r *sink.Record) any { return r.UserIdentifier }},
+	{"language", typeString, func(r *sink.Record) any { return r.Language }},
+	{"country", typeString, func(r *sink.Record) any { return deref(r.Country) }},
+	{"region", typeString, func(r *sink.Record) any { return deref(r.Region) }},
+	{"device", typeString, func(r *sink.Record) any { return deref(r.Device) }},
+	{"platform", typeString, func(r *sink.Record) any { return deref(r.Platform) }},
+	{"app_version", typeString, func(r *sink.Record) any { return deref(r.AppVersion) }},
+	{"translations", typeJSON, func(r *sink.Record) any { return jsonText(r.Translations) }},
+	{"sentiment", typeString, func(r *sink.Record) any { return deref(r.Sentiment) }},
+	{"sentiment_score", typeFloat, func(r *sink.Record) any { return deref(r.SentimentScore) }},
+	{"emotion", typeString, func(r *sink.Record) any { return deref(r.Emotion) }},
+	{"topics", typeList, func(r *sink.Record) any { return list(r.Topics) }},
+	{"is_spam", typeBoolean, func(r *sink.Record) any { return deref(r.IsSpam) }},
+	{"spam_confidence", typeFloat, func(r *sink.Record) any { return deref(r.SpamConfidence) }},
+	{"urgency_score", typeFloat, func(r *sink.Record) any { return deref(r.UrgencyScore) }},
+	{"urgency_reasons", typeList, func(r *sink.Record) any { return list(r.UrgencyReasons) }},
+	{"enrichment_model", typeString, func(r *sink.Record) any { return deref(r.EnrichmentModel) }},
+	{"enrichment_version", typeInteger, func(r *sink.Record) any { return deref(r.EnrichmentVersion) }},
+	{"enrichment_attributes", typeJSON, func(r *sink.Record) any { return jsonText(r.EnrichmentAttributes) }},
+	{"duplicate_of", typeString, func(r *sink.Record) any { return deref(r.DuplicateOf) }},
+}
+
+// deref returns the value of a pointer, or nil if it's nil
+func deref[T any](p *T) any {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
+// jsonText returns JSON text as a string, or nil if there is none
+func jsonText(t *sink.JSONText) any {
+	if t == nil {
+		return nil
+	}
+	return string(*t)
+}
+
+// list returns a list of strings, or nil if it's empty
+func list(values []string) any {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+// listJSON returns a list of strings as JSON text
+func listJSON(values []string) string {
+	data, _ := json.Marshal(values)
+	return string(data)
+}
@@ -0,0 +1,193 @@
+package warehouse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+const (
+	// SheetsScope grants access to Google Sheets
+	SheetsScope = "https://www.googleapis.com/auth/spreadsheets"
+	// defaultSheetsURL is the base URL of the Google Sheets API
+	defaultSheetsURL = "https://sheets.googleapis.com/v4"
+	// maxCellLength is the most characters Google Sheets holds in a cell
+	maxCellLength = 50000
+)
+
+// DefaultSheetColumns are the columns of a sheet if none are selected
+var DefaultSheetColumns = []string{"created_at", "source_type", "source_name", "field_label", "value_text", "value_number", "user_identifier", "sentiment", "topics"}
+
+// GoogleSheet is a sheet of a Google spreadsheet that a row is appended to for each new
+// experience, with selected columns. Unlike a warehouse table, the sheet only gets each
+// experience once, when it's created, so changes such as AI enrichment that finish later
+// are not included, and experiences created before the sheet was set up are left out.
+type GoogleSheet struct {
+	spreadsheetID string
+	sheet         string
+	columns       []string
+	auth          TokenSource
+	baseURL       string
+	client        *http.Client
+
+	// header is the header row of the sheet, read by Prepare
+	header []string
+}
+
+// NewGoogleSheet returns a sheet of a spreadsheet, written with the tokens of auth, which
+// need the SheetsScope and edit access to the spreadsheet. Columns are names of Columns.
+func NewGoogleSheet(spreadsheetID, sheet string, columns []string, auth TokenSource) (*GoogleSheet, error) {
+	if len(columns) == 0 {
+		columns = DefaultSheetColumns
+	}
+	for _, name := range columns {
+		if !slices.ContainsFunc(Columns, func(c Column) bool { return c.Name == name }) {
+			return nil, fmt.Errorf("unknown column %q", name)
+		}
+	}
+	return &GoogleSheet{
+		spreadsheetID: spreadsheetID,
+		sheet:         sheet,
+		columns:       columns,
+		auth:          auth,
+		baseURL:       defaultSheetsURL,
+		client:        &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// Stream names the cursor of the sheet
+func (g *GoogleSheet) Stream() string {
+	return fmt.Sprintf("warehouse.sheets.%s.%s", g.spreadsheetID, g.sheet)
+}
+
+// CreatedOnly reports that the sheet only gets new experiences
+func (g *GoogleSheet) CreatedOnly() bool {
+	return true
+}
+
+// cellRange returns a range of the sheet in A1 notation, with the sheet name quoted
+func (g *GoogleSheet) cellRange(cells string) string {
+	return "'" + strings.ReplaceAll(g.sheet, "'", "''") + "'!" + cells
+}
+
+// Prepare adds the sheet if the spreadsheet doesn't have it, and adds the selected columns
+// that its header row is missing. Columns of the header row are kept, in their order, so
+// columns can be moved and added by hand.
+func (g *GoogleSheet) Prepare(ctx context.Context) error {
+	spreadsheetURL := g.baseURL + "/spreadsheets/" + url.PathEscape(g.spreadsheetID)
+
+	var spreadsheet struct {
+		Sheets []struct {
+			Properties struct {
+				Title string `json:"title"`
+			} `json:"properties"`
+		} `json:"sheets"`
+	}
+	if err := g.do(ctx, http.MethodGet, spreadsheetURL+"?fields=sheets.properties.title", nil, &spreadsheet); err != nil {
+		return err
+	}
+	exists := false
+	for _, s := range spreadsheet.Sheets {
+		exists = exists || s.Properties.Title == g.sheet
+	}
+	if !exists {
+		add := map[string]any{"requests": []any{
+			map[string]any{"addSheet": map[string]any{"properties": map[string]any{"title": g.sheet}}},
+		}}
+		if err := g.do(ctx, http.MethodPost, spreadsheetURL+":batchUpdate", add, nil); err != nil {
+			return err
+		}
+	}
+
+	var values struct {
+		Values [][]any `json:"values"`
+	}
+	if err := g.do(ctx, http.MethodGet, spreadsheetURL+"/values/"+url.PathEscape(g.cellRange("1:1")), nil, &values); err != nil {
+		return err
+	}
+	var header []string
+	if len(values.Values) > 0 {
+		for _, cell := range values.Values[0] {
+			header = append(header, fmt.Sprint(cell))
+		}
+	}
+	missing := 0
+	for _, name := range g.columns {
+		if !slices.Contains(header, name) {
+			header = append(header, name)
+			missing++
+		}
+	}
+	if missing > 0 {
+		row := map[string]any{"values": [][]string{header}}
+		if err := g.do(ctx, http.MethodPut, spreadsheetURL+"/values/"+url.PathEscape(g.cellRange("A1"))+"?valueInputOption=RAW", row, nil); err != nil {
+			return err
+		}
+	}
+	g.header = header
+	return nil
+}
+
+// Append adds a row per record below the last row of the sheet. Values are written as
+// they are rather than parsed like typed input, so feedback that starts with = isn't
+// turned into a formula. Columns of the header row that aren't fields are left empty.
+func (g *GoogleSheet) Append(ctx context.Context, records []sink.Record) error {
+	columns := make([]*Column, len(g.header))
+	for i, name := range g.header {
+		if j := slices.IndexFunc(Columns, func(c Column) bool { return c.Name == name }); j >= 0 {
+			columns[i] = &Columns[j]
+		}
+	}
+
+	rows := make([][]any, len(records))
+	for i := range records {
+		row := make([]any, len(columns))
+		for j, c := range columns {
+			row[j] = ""
+			if c != nil {
+				row[j] = sheetValue(c.value(&records[i]))
+			}
+		}
+		rows[i] = row
+	}
+
+	target := fmt.Sprintf("%s/spreadsheets/%s/values/%s:append?valueInputOption=RAW&insertDataOption=INSERT_ROWS", g.baseURL, url.PathEscape(g.spreadsheetID), url.PathEscape(g.cellRange("A1")))
+	return g.do(ctx, http.MethodPost, target, map[string]any{"values": rows}, nil)
+}
+
+// sheetValue converts the value of a column to a cell: timestamps as UTC date and time,
+// lists joined with commas, and text cut to the length a cell holds
+func sheetValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.UTC().Format(time.DateTime)
+	case []string:
+		return truncateCell(strings.Join(v, ", "))
+	case string:
+		return truncateCell(v)
+	default:
+		return v
+	}
+}
+
+// truncateCell cuts text to the length a cell holds
+func truncateCell(s string) string {
+	if utf8.RuneCountInString(s) <= maxCellLength {
+		return s
+	}
+	return string([]rune(s)[:maxCellLength])
+}
+
+// do sends a request to the Google Sheets API
+func (g *GoogleSheet) do(ctx context.Context, method, target string, body, out any) error {
+	return callGoogle(ctx, g.client, g.auth, "Google Sheets", method, target, body, out)
+}
@@ -0,0 +1,340 @@
+package warehouse
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+const (
+	// statementTimeout is how many seconds Snowflake may run a statement
+	statementTimeout = 60
+	// statementPollInterval is how often the status of a statement that is still running is
+	// checked
+	statementPollInterval = time.Second
+	// jwtLifetime is how long the key pair JWTs are valid; Snowflake accepts up to an hour
+	jwtLifetime = time.Hour
+	// jwtRefresh is how long before they expire JWTs are renewed
+	jwtRefresh = 5 * time.Minute
+)
+
+// identifierPattern matches the unquoted identifiers that table names are limited to
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// SnowflakeOptions configure a Snowflake table
+type SnowflakeOptions struct {
+	// Account is the account identifier, e.g. myorg-myaccount
+	Account string
+	// User is the user whose key pair signs requests
+	User string
+	// PrivateKey is the unencrypted PEM private key of the user's key pair
+	PrivateKey []byte
+	// Database and Schema hold the table
+	Database string
+	Schema   string
+	// Warehouse runs the statements; the default warehouse of the user if empty
+	Warehouse string
+	// Role runs the statements; the default role of the user if empty
+	Role string
+	// Table is the name of the table, an unquoted identifier
+	Table string
+}
+
+// Snowflake is a table in Snowflake that experiences are inserted into in micro-batches with
+// the SQL API, authenticated with key pair authentication
+type Snowflake struct {
+	opts        SnowflakeOptions
+	key         *rsa.PrivateKey
+	fingerprint string
+	baseURL     string
+	client      *http.Client
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewSnowflake returns the table of opts
+func NewSnowflake(opts SnowflakeOptions) (*Snowflake, error) {
+	if opts.Account == "" || opts.User == "" || opts.Database == "" || opts.Schema == "" {
+		return nil, errors.New("the Snowflake account, user, database, and schema are required")
+	}
+	if !identifierPattern.MatchString(opts.Table) {
+		return nil, fmt.Errorf("invalid Snowflake table name %q: expected letters, digits, underscores, and dollar signs", opts.Table)
+	}
+	key, err := parsePrivateKey(opts.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(publicKey)
+	return &Snowflake{
+		opts:        opts,
+		key:         key,
+		fingerprint: "SHA256:" + base64.StdEncoding.EncodeToString(digest[:]),
+		baseURL:     "https://" + strings.ToLower(opts.Account) + ".snowflakecomputing.com",
+		client:      &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// parsePrivateKey parses an unencrypted PKCS #8 or PKCS #1 PEM RSA private key
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid Snowflake private key: no PEM private key")
+	}
+	if block.Type == "ENCRYPTED PRIVATE KEY" {
+		return nil, errors.New("invalid Snowflake private key: encrypted keys are not supported")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Snowflake private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("invalid Snowflake private key: not an RSA key")
+	}
+	return key, nil
+}
+
+// Stream names the cursor of the table
+func (s *Snowflake) Stream() string {
+	return fmt.Sprintf("warehouse.snowflake.%s.%s.%s.%s", strings.ToLower(s.opts.Account), s.opts.Database, s.opts.Schema, s.opts.Table)
+}
+
+// snowflakeType returns the Snowflake type of a column
+func snowflakeType(c Column) string {
+	switch c.Type {
+	case typeTimestamp:
+		return "TIMESTAMP_TZ"
+	case typeFloat:
+		return "FLOAT"
+	case typeInteger:
+		return "NUMBER"
+	case typeBoolean:
+		return "BOOLEAN"
+	case typeJSON:
+		return "VARIANT"
+	case typeList:
+		return "ARRAY"
+	default:
+		return "VARCHAR"
+	}
+}
+
+// Prepare creates the table if it doesn't exist and adds the columns it's missing
+func (s *Snowflake) Prepare(ctx context.Context) error {
+	definitions := make([]string, len(Columns))
+	for i, c := range Columns {
+		definitions[i] = c.Name + " " + snowflakeType(c)
+	}
+	columns := strings.Join(definitions, ", ")
+	if err := s.execute(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s)", s.opts.Table, columns), nil); err != nil {
+		return err
+	}
+	return s.execute(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s", s.opts.Table, columns), nil)
+}
+
+// Append inserts records into the table in one statement. Values are bound as text and
+// converted to the types of their columns.
+func (s *Snowflake) Append(ctx context.Context, records []sink.Record) error {
+	names := make([]string, len(Columns))
+	selects := make([]string, len(Columns))
+	for i, c := range Columns {
+		names[i] = c.Name
+		column := "column" + strconv.Itoa(i+1)
+		switch c.Type {
+		case typeJSON:
+			selects[i] = "PARSE_JSON(" + column + ")"
+		case typeList:
+			selects[i] = "PARSE_JSON(" + column + ")::ARRAY"
+		default:
+			selects[i] = column + "::" + snowflakeType(c)
+		}
+	}
+
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(Columns)), ", ") + ")"
+	rows := make([]string, len(records))
+	bindings := make(map[string]snowflakeBinding, len(records)*len(Columns))
+	for i := range records {
+		rows[i] = placeholders
+		for j, c := range Columns {
+			bindings[strconv.Itoa(i*len(Columns)+j+1)] = snowflakeBinding{Type: "TEXT", Value: snowflakeText(c.value(&records[i]))}
+		}
+	}
+
+	statement := fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM VALUES %s",
+		s.opts.Table, strings.Join(names, ", "), strings.Join(selects, ", "), strings.Join(rows, ", "))
+	return s.execute(ctx, statement, bindings)
+}
+
+// snowflakeText returns a value as bound text, or nil for NULL
+func snowflakeText(value any) *string {
+	var text string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		text = v
+	case time.Time:
+		text = v.UTC().Format("2006-01-02T15:04:05.999999999-07:00")
+	case float64:
+		text = strconv.FormatFloat(v, 'g', -1, 64)
+	case int64:
+		text = strconv.FormatInt(v, 10)
+	case bool:
+		text = strconv.FormatBool(v)
+	case []string:
+		text = listJSON(v)
+	default:
+		text = fmt.Sprint(v)
+	}
+	return &text
+}
+
+// snowflakeBinding is the value of a bind variable
+type snowflakeBinding struct {
+	Type  string  `json:"type"`
+	Value *string `json:"value"`
+}
+
+// snowflakeResponse is the response of the SQL API to a statement
+type snowflakeResponse struct {
+	Code               string `json:"code"`
+	Message            string `json:"message"`
+	StatementHandle    string `json:"statementHandle"`
+	StatementStatusURL string `json:"statementStatusUrl"`
+}
+
+// execute runs a statement and waits for it to complete
+func (s *Snowflake) execute(ctx context.Context, statement string, bindings map[string]snowflakeBinding) error {
+	body := map[string]any{
+		"statement": statement,
+		"timeout":   statementTimeout,
+		"database":  s.opts.Database,
+		"schema":    s.opts.Schema,
+	}
+	if s.opts.Warehouse != "" {
+		body["warehouse"] = s.opts.Warehouse
+	}
+	if s.opts.Role != "" {
+		body["role"] = s.opts.Role
+	}
+	if len(bindings) > 0 {
+		body["bindings"] = bindings
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	status, resp, err := s.do(ctx, http.MethodPost, "/api/v2/statements", data)
+	for err == nil && status == http.StatusAccepted {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(statementPollInterval):
+		}
+		status, resp, err = s.do(ctx, http.MethodGet, resp.StatementStatusURL, nil)
+	}
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		message := resp.Message
+		if message == "" {
+			message = http.StatusText(status)
+		}
+		return fmt.Errorf("Snowflake SQL API returned %d: %s", status, message)
+	}
+	return nil
+}
+
+// do sends a request to a path of the SQL API and returns the status and response
+func (s *Snowflake) do(ctx context.Context, method, path string, body []byte) (int, *snowflakeResponse, error) {
+	token, err := s.jwt(time.Now())
+	if err != nil {
+		return 0, nil, err
+	}
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-Snowflake-Authorization-Token-Type", "KEYPAIR_JWT")
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var out snowflakeResponse
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	_ = json.Unmarshal(data, &out)
+	return resp.StatusCode, &out, nil
+}
+
+// jwt returns the key pair JWT of the user, signing a new one when the last one is about to
+// expire
+func (s *Snowflake) jwt(now time.Time) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && now.Add(jwtRefresh).Before(s.expires) {
+		return s.token, nil
+	}
+
+	// The account of the claims excludes the region of legacy account locators
+	account, _, _ := strings.Cut(strings.ToUpper(s.opts.Account), ".")
+	subject := account + "." + strings.ToUpper(s.opts.User)
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]any{
+		"iss": subject + "." + s.fingerprint,
+		"sub": subject,
+		"iat": now.Unix(),
+		"exp": now.Add(jwtLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(nil, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	s.token = unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+	s.expires = now.Add(jwtLifetime)
+	return s.token, nil
+}
@@ -0,0 +1,287 @@
+// Package warehouse syncs experiences to a table in BigQuery or Snowflake, or a Google
+// Sheet. A syncer keeps a cursor, the updated_at and ID of the last experience it wrote, so
+// every round appends the experiences created or changed since the previous one (or only
+// those created, from when a sheet was added). The table is created, and columns added to it, before the
+// first round.
+package warehouse
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/connectorcursor"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/leader"
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+const (
+	// lockKey seeds the PostgreSQL advisory lock of each destination, held by the instance
+	// syncing it, so each experience is appended once however many Hub instances run
+	lockKey = 7_241_905_005
+	// settleDelay holds back experiences changed this recently, so one whose transaction
+	// commits after a round can't end up behind the cursor
+	settleDelay = time.Minute
+	// batchSize is the most experiences read and written at a time
+	batchSize = 500
+	// maxBatchesPerRound bounds a round; a syncer with more to write continues right away
+	maxBatchesPerRound = 200
+)
+
+// Destination is a table in a warehouse
+type Destination interface {
+	// Stream names the cursor of the destination, e.g. warehouse.bigquery.acme.hub.experiences
+	Stream() string
+	// Prepare creates the table if it doesn't exist and adds the columns it's missing
+	Prepare(ctx context.Context) error
+	// Append writes records to the table
+	Append(ctx context.Context, records []sink.Record) error
+}
+
+// createdOnly is implemented by destinations that get each experience once, when it's
+// created, rather than a row per change, e.g. a spreadsheet. Their cursor follows
+// created_at.
+type createdOnly interface {
+	CreatedOnly() bool
+}
+
+// Syncer appends the experiences created or changed since its cursor to a destination
+type Syncer struct {
+	client      *ent.Client
+	db          *sql.DB
+	destination Destination
+	interval    time.Duration
+	logger      *slog.Logger
+
+	prepared  bool
+	stopChan  chan struct{}
+	stopOnce  sync.Once
+	completed chan struct{}
+}
+
+// NewSyncer creates a syncer to destination that runs a round every interval; db must be
+// the database of client
+func NewSyncer(client *ent.Client, db *sql.DB, destination Destination, interval time.Duration, logger *slog.Logger) (*Syncer, error) {
+	if interval < time.Minute {
+		return nil, fmt.Errorf("warehouse sync interval must be at least one minute")
+	}
+	return &Syncer{
+		client:      client,
+		db:          db,
+		destination: destination,
+		interval:    interval,
+		logger:      logger,
+		stopChan:    make(chan struct{}),
+		completed:   make(chan struct{}),
+	}, nil
+}
+
+// Run syncs right away and then every interval until ctx is canceled or Stop is called. A
+// round that ends with more to write is followed by the next one right away.
+func (s *Syncer) Run(ctx context.Context) {
+	defer close(s.completed)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopChan:
+			return
+		case <-timer.C:
+		}
+
+		if s.round(ctx) {
+			timer.Reset(0)
+		} else {
+			timer.Reset(s.interval)
+		}
+	}
+}
+
+// Stop stops syncing and waits for the current batch to be written
+func (s *Syncer) Stop() {
+	s.stopOnce.Do(func() { close(s.stopChan) })
+	<-s.completed
+}
+
+// stopping reports whether Stop was called
+func (s *Syncer) stopping() bool {
+	select {
+	case <-s.stopChan:
+		return true
+	default:
+		return false
+	}
+}
+
+// round syncs the warehouse, unless another instance is syncing it, and reports whether
+// there is more to write. Batches that fail are retried in the next round.
+func (s *Syncer) round(ctx context.Context) bool {
+	// Each destination has its own lock, so a warehouse and a sheet are synced side by side
+	stream := s.destination.Stream()
+	lock, err := leader.TryAcquireNamed(ctx, s.db, stream, lockKey)
+	if err != nil {
+		s.logger.Warn("failed to acquire warehouse sync lock", "error", err)
+	}
+	if lock == nil {
+		return false
+	}
+	defer lock.Release()
+
+	synced, more, err := s.sync(ctx, time.Now().Add(-settleDelay))
+	if synced > 0 {
+		s.logger.Info("experiences synced to warehouse", "stream", stream, "experiences", synced)
+	}
+	if err != nil {
+		s.logger.Error("warehouse sync failed", "stream", stream, "error", err)
+		return false
+	}
+	return more
+}
+
+// sync appends the experiences changed (or created) after the cursor and before settled,
+// one batch at a time, and returns how many it appended and whether the round ended with more to write.
+// The cursor is saved after each batch, so a failed round only writes the rest again.
+func (s *Syncer) sync(ctx context.Context, settled time.Time) (int, bool, error) {
+	if !s.prepared {
+		if err := s.destination.Prepare(ctx); err != nil {
+			return 0, false, fmt.Errorf("failed to prepare table: %w", err)
+		}
+		s.prepared = true
+	}
+
+	stream := s.destination.Stream()
+	cursor, err := s.cursor(ctx, stream)
+	if err != nil {
+		return 0, false, err
+	}
+	timeField := experiencedata.FieldUpdatedAt
+	if d, ok := s.destination.(createdOnly); ok && d.CreatedOnly() {
+		timeField = experiencedata.FieldCreatedAt
+		// Only the experiences created from now on are new to the destination
+		if cursor.At.IsZero() {
+			cursor = position{At: settled}
+			if err := s.saveCursor(ctx, stream, cursor); err != nil {
+				return 0, false, err
+			}
+		}
+	}
+
+	synced := 0
+	for batch := 0; ; batch++ {
+		if batch == maxBatchesPerRound || s.stopping() {
+			return synced, true, nil
+		}
+		c := cursor
+		experiences, err := s.client.ExperienceData.Query().
+			Where(func(sel *entsql.Selector) {
+				sel.Where(entsql.And(
+					entsql.LTE(sel.C(timeField), settled),
+					entsql.Or(
+						entsql.GT(sel.C(timeField), c.At),
+						entsql.And(entsql.EQ(sel.C(timeField), c.At), entsql.GT(sel.C(experiencedata.FieldID), c.ID)),
+					),
+				))
+			}).
+			Order(ent.Asc(timeField), ent.Asc(experiencedata.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return synced, false, fmt.Errorf("failed to read experiences: %w", err)
+		}
+		if len(experiences) == 0 {
+			return synced, false, nil
+		}
+
+		records := make([]sink.Record, len(experiences))
+		for i, exp := range experiences {
+			records[i] = sink.ToRecord(exp)
+		}
+		if err := s.destination.Append(ctx, records); err != nil {
+			return synced, false, err
+		}
+
+		last := experiences[len(experiences)-1]
+		cursor = position{At: last.UpdatedAt, ID: last.ID}
+		if timeField == experiencedata.FieldCreatedAt {
+			cursor.At = last.CreatedAt
+		}
+		if err := s.saveCursor(ctx, stream, cursor); err != nil {
+			return synced, false, err
+		}
+		synced += len(experiences)
+
+		if len(experiences) < batchSize {
+			return synced, false, nil
+		}
+	}
+}
+
+// position is the cursor of a syncer: the updated_at (or created_at) of the last experience
+// written, and its ID to order experiences of the same time
+type position struct {
+	At time.Time
+	ID uuid.UUID
+}
+
+// String returns the cursor as saved, e.g. 2024-01-15T10:30:00.123456Z/<id>
+func (p position) String() string {
+	return p.At.UTC().Format(time.RFC3339Nano) + "/" + p.ID.String()
+}
+
+// parsePosition parses a saved cursor
+func parsePosition(cursor string) (position, error) {
+	at, id, ok := strings.Cut(cursor, "/")
+	if !ok {
+		return position{}, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	t, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return position{}, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return position{}, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+	return position{At: t, ID: u}, nil
+}
+
+// cursor returns the saved cursor of a stream, or the zero position if there is none
+func (s *Syncer) cursor(ctx context.Context, stream string) (position, error) {
+	saved, err := s.client.ConnectorCursor.Query().Where(connectorcursor.StreamEQ(stream)).Only(ctx)
+	switch {
+	case err == nil:
+		return parsePosition(saved.Cursor)
+	case ent.IsNotFound(err):
+		return position{}, nil
+	default:
+		return position{}, fmt.Errorf("failed to read cursor: %w", err)
+	}
+}
+
+// saveCursor saves the cursor of a stream
+func (s *Syncer) saveCursor(ctx context.Context, stream string, cursor position) error {
+	updated, err := s.client.ConnectorCursor.Update().
+		Where(connectorcursor.StreamEQ(stream)).
+		SetCursor(cursor.String()).
+		Save(ctx)
+	if err == nil && updated == 0 {
+		err = s.client.ConnectorCursor.Create().SetStream(stream).SetCursor(cursor.String()).Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save cursor: %w", err)
+	}
+	return nil
+}
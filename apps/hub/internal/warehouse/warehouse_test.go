@@ -0,0 +1,371 @@
+package warehouse
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/sink"
+)
+
+// staticToken is a TokenSource of a fixed token
+type staticToken string
+
+func (t staticToken) Token(context.Context) (string, error) { return string(t), nil }
+
+// testRecords returns the records of two experiences
+func testRecords() []sink.Record {
+	text, score := "Sync is slow", 0.8
+	return []sink.Record{
+		sink.ToRecord(&ent.ExperienceData{
+			ID:           uuid.New(),
+			CreatedAt:    time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			UpdatedAt:    time.Date(2024, 1, 15, 10, 31, 0, 123456000, time.UTC),
+			CollectedAt:  time.Date(2024, 1, 15, 10, 29, 0, 0, time.UTC),
+			SourceType:   "formbricks",
+			FieldID:      "feedback",
+			FieldType:    "text",
+			ValueText:    &text,
+			Metadata:     map[string]interface{}{"plan": "pro"},
+			Topics:       []string{"performance"},
+			UrgencyScore: &score,
+		}),
+		sink.ToRecord(&ent.ExperienceData{
+			ID:         uuid.New(),
+			SourceType: "formbricks",
+			FieldID:    "nps",
+			FieldType:  "nps",
+		}),
+	}
+}
+
+func TestPosition(t *testing.T) {
+	p := position{At: time.Date(2024, 1, 15, 10, 31, 0, 123456000, time.UTC), ID: uuid.New()}
+	parsed, err := parsePosition(p.String())
+	if err != nil || !parsed.At.Equal(p.At) || parsed.ID != p.ID {
+		t.Errorf("parsePosition(%q) = %v, %v", p.String(), parsed, err)
+	}
+	for _, invalid := range []string{"", "2024-01-15T10:31:00Z", "yesterday/" + p.ID.String(), "2024-01-15T10:31:00Z/1"} {
+		if _, err := parsePosition(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestBigQuery(t *testing.T) {
+	var created, patched map[string]any
+	var inserted struct {
+		Rows []struct {
+			InsertID string         `json:"insertId"`
+			JSON     map[string]any `json:"json"`
+		} `json:"rows"`
+	}
+	tableExists := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		const table = "/projects/acme/datasets/hub/tables"
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == table+"/experiences":
+			if !tableExists {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Not found: Table acme:hub.experiences"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"schema":{"fields":[{"name":"id","type":"STRING","description":"Experience ID"},{"name":"custom","type":"STRING"}]}}`))
+		case r.Method == http.MethodPost && r.URL.Path == table:
+			_ = json.NewDecoder(r.Body).Decode(&created)
+		case r.Method == http.MethodPatch && r.URL.Path == table+"/experiences":
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+		case r.Method == http.MethodPost && r.URL.Path == table+"/experiences/insertAll":
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_, _ = w.Write([]byte(`{"kind":"bigquery#tableDataInsertAllResponse"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	bq := NewBigQuery("acme", "hub", "experiences", staticToken("token"))
+	bq.baseURL = server.URL
+	if bq.Stream() != "warehouse.bigquery.acme.hub.experiences" {
+		t.Errorf("Stream() = %q", bq.Stream())
+	}
+
+	if err := bq.Prepare(context.Background()); err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	fields, _ := created["schema"].(map[string]any)["fields"].([]any)
+	if len(fields) != len(Columns) || created["timePartitioning"].(map[string]any)["field"] != "created_at" {
+		t.Errorf("unexpected table: %v", created)
+	}
+
+	// Columns missing from an existing table are added after those it has
+	tableExists = true
+	if err := bq.Prepare(context.Background()); err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	fields, _ = patched["schema"].(map[string]any)["fields"].([]any)
+	if len(fields) != len(Columns)+1 || fields[0].(map[string]any)["description"] != "Experience ID" || fields[2].(map[string]any)["name"] != "created_at" {
+		t.Errorf("unexpected schema: %v", fields)
+	}
+
+	records := testRecords()
+	if err := bq.Append(context.Background(), records); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if len(inserted.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(inserted.Rows))
+	}
+	row := inserted.Rows[0]
+	if row.InsertID != records[0].ID+"/2024-01-15T10:31:00.123456Z" {
+		t.Errorf("insertId = %q", row.InsertID)
+	}
+	if row.JSON["updated_at"] != "2024-01-15T10:31:00.123456Z" || row.JSON["metadata"] != `{"plan":"pro"}` || row.JSON["urgency_score"] != 0.8 {
+		t.Errorf("unexpected row: %v", row.JSON)
+	}
+	if topics, ok := row.JSON["topics"].([]any); !ok || len(topics) != 1 {
+		t.Errorf("expected topics as a list, got %v", row.JSON["topics"])
+	}
+	if _, ok := inserted.Rows[1].JSON["value_text"]; ok {
+		t.Errorf("expected NULL values to be left out, got %v", inserted.Rows[1].JSON)
+	}
+
+	t.Run("insert errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"insertErrors":[{"index":1,"errors":[{"reason":"invalid","message":"no such field: extra"}]},{"index":0,"errors":[{"reason":"stopped"}]}]}`))
+		}))
+		defer server.Close()
+		bq := NewBigQuery("acme", "hub", "experiences", staticToken("token"))
+		bq.baseURL = server.URL
+		if err := bq.Append(context.Background(), records); err == nil || !strings.Contains(err.Error(), "no such field") {
+			t.Errorf("Append() error = %v, want the insert error", err)
+		}
+	})
+}
+
+func TestGoogleSheet(t *testing.T) {
+	var added, header map[string]any
+	var appended struct {
+		Values [][]any `json:"values"`
+	}
+	var appendQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		const spreadsheet = "/spreadsheets/sheet-1"
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == spreadsheet:
+			_, _ = w.Write([]byte(`{"sheets":[{"properties":{"title":"Sheet1"}}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == spreadsheet+":batchUpdate":
+			_ = json.NewDecoder(r.Body).Decode(&added)
+		case r.Method == http.MethodGet && r.URL.Path == spreadsheet+"/values/'Team''s feedback'!1:1":
+			_, _ = w.Write([]byte(`{"values":[["created_at","notes"]]}`))
+		case r.Method == http.MethodPut && r.URL.Path == spreadsheet+"/values/'Team''s feedback'!A1":
+			_ = json.NewDecoder(r.Body).Decode(&header)
+		case r.Method == http.MethodPost && r.URL.Path == spreadsheet+"/values/'Team''s feedback'!A1:append":
+			appendQuery = r.URL.RawQuery
+			_ = json.NewDecoder(r.Body).Decode(&appended)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	if _, err := NewGoogleSheet("sheet-1", "Feedback", []string{"created_at", "password"}, staticToken("token")); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+	sheet, err := NewGoogleSheet("sheet-1", "Team's feedback", []string{"created_at", "value_text", "topics"}, staticToken("token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sheet.baseURL = server.URL
+	if sheet.Stream() != "warehouse.sheets.sheet-1.Team's feedback" || !sheet.CreatedOnly() {
+		t.Errorf("Stream() = %q", sheet.Stream())
+	}
+
+	// The sheet is added, and the missing columns appended to the header row
+	if err := sheet.Prepare(context.Background()); err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	if added == nil || !strings.Contains(fmt.Sprint(added), "Team's feedback") {
+		t.Errorf("expected the sheet to be added, got %v", added)
+	}
+	if fmt.Sprint(header["values"]) != "[[created_at notes value_text topics]]" {
+		t.Errorf("unexpected header row: %v", header)
+	}
+
+	records := testRecords()
+	if err := sheet.Append(context.Background(), records); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if !strings.Contains(appendQuery, "valueInputOption=RAW") {
+		t.Errorf("expected values to be written as they are, got %q", appendQuery)
+	}
+	if len(appended.Values) != 2 {
+		t.Fatalf("got %d rows, want 2", len(appended.Values))
+	}
+	if fmt.Sprint(appended.Values[0]) != "[2024-01-15 10:30:00  Sync is slow performance]" {
+		t.Errorf("unexpected row: %q", appended.Values[0])
+	}
+	if fmt.Sprint(appended.Values[1][2]) != "" {
+		t.Errorf("expected an empty cell for NULL, got %q", appended.Values[1][2])
+	}
+
+	t.Run("API errors", func(t *testing.T) {
+		sheet, _ := NewGoogleSheet("sheet-1", "Feedback", nil, staticToken("wrong"))
+		sheet.baseURL = server.URL
+		if err := sheet.Prepare(context.Background()); err == nil || !strings.Contains(err.Error(), "Google Sheets API returned 401") {
+			t.Errorf("Prepare() error = %v", err)
+		}
+	})
+}
+
+// snowflakeKey returns a PEM private key
+func snowflakeKey(t *testing.T) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestSnowflake(t *testing.T) {
+	type request struct {
+		Statement string                      `json:"statement"`
+		Database  string                      `json:"database"`
+		Warehouse string                      `json:"warehouse"`
+		Bindings  map[string]snowflakeBinding `json:"bindings"`
+	}
+	var requests []request
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Snowflake-Authorization-Token-Type") != "KEYPAIR_JWT" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		parts := strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), ".")
+		var claims map[string]any
+		if payload, err := base64.RawURLEncoding.DecodeString(parts[1]); err == nil {
+			_ = json.Unmarshal(payload, &claims)
+		}
+		if claims["sub"] != "MYORG-ACCOUNT.HUB" || !strings.HasPrefix(claims["iss"].(string), "MYORG-ACCOUNT.HUB.SHA256:") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			polls++
+			_, _ = w.Write([]byte(`{"code":"090001","message":"Statement executed successfully."}`))
+			return
+		}
+		var req request
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+		if strings.HasPrefix(req.Statement, "INSERT") {
+			// Inserts are still running when the request times out
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"code":"333334","statementHandle":"h1","statementStatusUrl":"/api/v2/statements/h1"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"code":"090001","message":"Statement executed successfully."}`))
+	}))
+	defer server.Close()
+
+	sf, err := NewSnowflake(SnowflakeOptions{
+		Account:    "myorg-account",
+		User:       "hub",
+		PrivateKey: snowflakeKey(t),
+		Database:   "ANALYTICS",
+		Schema:     "PUBLIC",
+		Warehouse:  "LOADING",
+		Table:      "experiences",
+	})
+	if err != nil {
+		t.Fatalf("NewSnowflake() error = %v", err)
+	}
+	sf.baseURL = server.URL
+
+	if err := sf.Prepare(context.Background()); err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	if len(requests) != 2 || !strings.HasPrefix(requests[0].Statement, "CREATE TABLE IF NOT EXISTS experiences (id VARCHAR, created_at TIMESTAMP_TZ") ||
+		!strings.HasPrefix(requests[1].Statement, "ALTER TABLE experiences ADD COLUMN IF NOT EXISTS id VARCHAR") {
+		t.Fatalf("unexpected statements: %+v", requests)
+	}
+	if requests[0].Database != "ANALYTICS" || requests[0].Warehouse != "LOADING" {
+		t.Errorf("unexpected context: %+v", requests[0])
+	}
+
+	records := testRecords()
+	if err := sf.Append(context.Background(), records); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	insert := requests[2]
+	if polls != 1 || strings.Count(insert.Statement, "?") != 2*len(Columns) || len(insert.Bindings) != 2*len(Columns) {
+		t.Fatalf("unexpected insert: %d polls, %d bindings", polls, len(insert.Bindings))
+	}
+	if !strings.Contains(insert.Statement, "PARSE_JSON(column18)") || !strings.Contains(insert.Statement, "column3::TIMESTAMP_TZ") {
+		t.Errorf("unexpected statement: %s", insert.Statement)
+	}
+	if v := insert.Bindings["1"].Value; v == nil || *v != records[0].ID {
+		t.Errorf("binding 1 = %v", v)
+	}
+	if v := insert.Bindings["3"].Value; v == nil || *v != "2024-01-15T10:31:00.123456+00:00" {
+		t.Errorf("binding 3 = %v", v)
+	}
+	if v := insert.Bindings["30"].Value; v == nil || *v != `["performance"]` {
+		t.Errorf("binding 30 = %v", v)
+	}
+	if v := insert.Bindings[strconv.Itoa(len(Columns)+12)].Value; v != nil {
+		t.Errorf("expected value_text of the second record to be NULL, got %q", *v)
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"code":"002003","message":"SQL compilation error: Schema 'ANALYTICS.PUBLIC' does not exist or not authorized."}`))
+		}))
+		defer server.Close()
+		sf.baseURL = server.URL
+		if err := sf.Prepare(context.Background()); err == nil || !strings.Contains(err.Error(), "does not exist") {
+			t.Errorf("Prepare() error = %v, want the error of the statement", err)
+		}
+	})
+
+	t.Run("options", func(t *testing.T) {
+		key := snowflakeKey(t)
+		for _, opts := range []SnowflakeOptions{
+			{Account: "a", User: "u", Database: "d", Schema: "s", Table: "experiences; DROP TABLE x", PrivateKey: key},
+			{Account: "a", User: "u", Database: "d", Schema: "s", Table: "experiences", PrivateKey: []byte("not a key")},
+			{User: "u", Database: "d", Schema: "s", Table: "experiences", PrivateKey: key},
+		} {
+			if _, err := NewSnowflake(opts); err == nil {
+				t.Errorf("expected an error for %+v", opts)
+			}
+		}
+	})
+}
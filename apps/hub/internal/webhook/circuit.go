@@ -0,0 +1,174 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// circuitFailureThreshold is the number of consecutive failed deliveries after which an
+	// endpoint is disabled. Each delivery is already retried, so this takes a few minutes.
+	circuitFailureThreshold = 5
+	// circuitBaseCooldown is how long an endpoint stays disabled before it is probed. The
+	// cooldown doubles after each failed probe, up to circuitMaxCooldown.
+	circuitBaseCooldown = time.Minute
+	circuitMaxCooldown  = time.Hour
+	// circuitOpenError is recorded for deliveries skipped while an endpoint is disabled
+	circuitOpenError = "endpoint disabled after consecutive failures"
+)
+
+// EndpointHealth is the payload of webhook.disabled and webhook.recovered events
+type EndpointHealth struct {
+	EndpointID          string     `json:"endpoint_id,omitempty"`
+	URL                 string     `json:"url"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	RetryAt             *time.Time `json:"retry_at,omitempty"`
+}
+
+// circuit tracks the consecutive failures of one endpoint
+type circuit struct {
+	label     string // Metrics label of the endpoint
+	failures  int
+	cooldown  time.Duration
+	openUntil time.Time
+	probing   bool // A probe delivery is in flight
+}
+
+// open reports whether deliveries to the endpoint are disabled
+func (c *circuit) open() bool {
+	return c.failures >= circuitFailureThreshold
+}
+
+// circuitBreakers disables endpoints that fail consistently, so one dead subscriber doesn't
+// tie up the worker pool. A disabled endpoint gets a single probe delivery after its
+// cooldown; success enables it again.
+type circuitBreakers struct {
+	mu       sync.Mutex
+	circuits map[string]*circuit // By endpoint ID, or URL for configured URLs
+}
+
+func newCircuitBreakers() *circuitBreakers {
+	return &circuitBreakers{circuits: make(map[string]*circuit)}
+}
+
+// circuitKey identifies the endpoint's circuit
+func circuitKey(endpoint Endpoint) string {
+	if endpoint.ID != "" {
+		return endpoint.ID
+	}
+	return endpoint.URL
+}
+
+// allow reports whether an event may be sent to the endpoint. Once the cooldown of a
+// disabled endpoint has passed, one event is allowed through as a probe.
+func (b *circuitBreakers) allow(endpoint Endpoint, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[circuitKey(endpoint)]
+	if !ok || !c.open() {
+		return true
+	}
+	if c.probing || now.Before(c.openUntil) {
+		return false
+	}
+	c.probing = true
+	return true
+}
+
+// release allows another probe if an allowed event wasn't sent
+func (b *circuitBreakers) release(endpoint Endpoint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.circuits[circuitKey(endpoint)]; ok {
+		c.probing = false
+	}
+}
+
+// record tracks the outcome of a delivery. It returns the endpoint's health if the
+// delivery disabled the endpoint (opened) or enabled it again (recovered).
+func (b *circuitBreakers) record(endpoint Endpoint, succeeded bool, now time.Time) (health *EndpointHealth, opened, recovered bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := circuitKey(endpoint)
+	c, ok := b.circuits[key]
+	if succeeded {
+		if !ok {
+			return nil, false, false
+		}
+		delete(b.circuits, key)
+		if !c.open() {
+			return nil, false, false
+		}
+		return &EndpointHealth{EndpointID: endpoint.ID, URL: endpoint.URL, ConsecutiveFailures: c.failures}, false, true
+	}
+
+	if !ok {
+		c = &circuit{label: metricsEndpoint(endpoint)}
+		b.circuits[key] = c
+	}
+	wasOpen := c.open()
+	c.failures++
+	if !c.open() {
+		return nil, false, false
+	}
+
+	if wasOpen {
+		if !c.probing {
+			// A delivery that was in flight when the endpoint was disabled
+			return nil, false, false
+		}
+		// A failed probe keeps the endpoint disabled for longer
+		c.cooldown = min(2*c.cooldown, circuitMaxCooldown)
+	} else {
+		c.cooldown = circuitBaseCooldown
+	}
+	c.openUntil = now.Add(c.cooldown)
+	c.probing = false
+
+	retryAt := c.openUntil
+	return &EndpointHealth{EndpointID: endpoint.ID, URL: endpoint.URL, ConsecutiveFailures: c.failures, RetryAt: &retryAt}, !wasOpen, false
+}
+
+// failures returns the consecutive failures of all endpoints that have failed since their
+// last success, labeled like their metrics
+func (b *circuitBreakers) failures() map[string]int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	result := make(map[string]int, len(b.circuits))
+	for _, c := range b.circuits {
+		result[c.label] = c.failures
+	}
+	return result
+}
+
+// recordOutcome updates the endpoint's circuit after a delivery and alerts when the
+// endpoint is disabled or enabled again
+func (d *Dispatcher) recordOutcome(endpoint Endpoint, result DeliveryResult) {
+	health, opened, recovered := d.breakers.record(endpoint, result.Succeeded, time.Now())
+	switch {
+	case opened:
+		d.logger.Error("webhook endpoint disabled after consecutive failures",
+			"endpoint_id", endpoint.ID,
+			"url", endpoint.URL,
+			"failures", health.ConsecutiveFailures,
+			"retry_at", health.RetryAt)
+		d.Dispatch(context.Background(), EventWebhookDisabled, health)
+	case recovered:
+		d.logger.Info("webhook endpoint recovered",
+			"endpoint_id", endpoint.ID,
+			"url", endpoint.URL,
+			"failures", health.ConsecutiveFailures)
+		d.Dispatch(context.Background(), EventWebhookRecovered, health)
+	case health != nil:
+		d.logger.Warn("webhook endpoint probe failed",
+			"endpoint_id", endpoint.ID,
+			"url", endpoint.URL,
+			"failures", health.ConsecutiveFailures,
+			"retry_at", health.RetryAt)
+	}
+}
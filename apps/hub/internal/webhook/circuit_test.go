@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	breakers := newCircuitBreakers()
+	endpoint := Endpoint{ID: "endpoint-1", URL: "https://example.com/hook"}
+	now := time.Now()
+
+	for i := 1; i < circuitFailureThreshold; i++ {
+		if _, opened, _ := breakers.record(endpoint, false, now); opened {
+			t.Fatalf("endpoint disabled after %d failures", i)
+		}
+	}
+	if !breakers.allow(endpoint, now) {
+		t.Fatal("endpoint disabled before reaching the failure threshold")
+	}
+
+	health, opened, _ := breakers.record(endpoint, false, now)
+	if !opened || health.ConsecutiveFailures != circuitFailureThreshold || !health.RetryAt.Equal(now.Add(circuitBaseCooldown)) {
+		t.Fatalf("record() = %+v, %v; want the endpoint disabled", health, opened)
+	}
+	if breakers.allow(endpoint, now) {
+		t.Error("disabled endpoint allowed before its cooldown passed")
+	}
+	if other := (Endpoint{URL: "https://example.com/other"}); !breakers.allow(other, now) {
+		t.Error("other endpoints must not be affected")
+	}
+
+	// Deliveries that were in flight don't extend the cooldown
+	if health, _, _ := breakers.record(endpoint, false, now); health != nil {
+		t.Errorf("in-flight failure changed the circuit: %+v", health)
+	}
+
+	// A single probe is allowed once the cooldown has passed; a failed probe doubles it
+	later := now.Add(circuitBaseCooldown)
+	if !breakers.allow(endpoint, later) {
+		t.Fatal("probe not allowed after the cooldown")
+	}
+	if breakers.allow(endpoint, later) {
+		t.Error("second probe allowed while one is in flight")
+	}
+	health, opened, _ = breakers.record(endpoint, false, later)
+	if opened || health == nil || !health.RetryAt.Equal(later.Add(2*circuitBaseCooldown)) {
+		t.Fatalf("record() after failed probe = %+v, %v", health, opened)
+	}
+
+	// A successful probe enables the endpoint again
+	later = later.Add(2 * circuitBaseCooldown)
+	if !breakers.allow(endpoint, later) {
+		t.Fatal("probe not allowed after the doubled cooldown")
+	}
+	if _, _, recovered := breakers.record(endpoint, true, later); !recovered {
+		t.Error("successful probe didn't enable the endpoint")
+	}
+	if !breakers.allow(endpoint, later) || !breakers.allow(endpoint, later) {
+		t.Error("recovered endpoint still disabled")
+	}
+}
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookdelivery"
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
+)
+
+const (
+	// DeliveryHeader carries the ID of a recorded delivery, which can be redelivered through the API
+	DeliveryHeader = "X-Hub-Delivery"
+	// deliveryPruneInterval is how often deliveries past the retention period are deleted
+	deliveryPruneInterval = time.Hour
+	// queueFullError is recorded for deliveries dropped because the job queue was full
+	queueFullError = "webhook queue full"
+)
+
+// Delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// DeliveryResult is the outcome of delivering an event to an endpoint
+type DeliveryResult struct {
+	Succeeded  bool
+	Attempts   int
+	StatusCode int           // HTTP status of the last attempt; zero if the endpoint didn't respond
+	Duration   time.Duration // Response time of the last attempt, if the endpoint responded
+	Error      string        // Error of the last failed attempt
+}
+
+// DeliveryLog records deliveries to endpoints with an ID, so receivers that were down can
+// catch up on the events they missed
+type DeliveryLog interface {
+	// Started records a delivery before it is sent and returns its ID. redeliveryOf is the
+	// ID of the delivery that is sent again, if any.
+	Started(ctx context.Context, endpointID string, eventType EventType, payload []byte, redeliveryOf string) (string, error)
+	// Finished records the outcome of a delivery
+	Finished(ctx context.Context, deliveryID string, result DeliveryResult) error
+}
+
+// Redelivery is a recorded delivery to send again
+type Redelivery struct {
+	DeliveryID string
+	EventType  EventType
+	Payload    []byte
+}
+
+// DBDeliveries records deliveries in the webhook_deliveries table
+type DBDeliveries struct {
+	client    *ent.Client
+	retention time.Duration
+
+	mu       sync.Mutex
+	prunedAt time.Time
+}
+
+// NewDBDeliveries creates a delivery log that keeps deliveries for the retention period
+func NewDBDeliveries(client *ent.Client, retention time.Duration) *DBDeliveries {
+	return &DBDeliveries{client: client, retention: retention}
+}
+
+// Started records a pending delivery
+func (l *DBDeliveries) Started(ctx context.Context, endpointID string, eventType EventType, payload []byte, redeliveryOf string) (string, error) {
+	l.prune(ctx)
+
+	id, err := uuid.Parse(endpointID)
+	if err != nil {
+		return "", fmt.Errorf("invalid webhook endpoint ID: %w", err)
+	}
+
+	create := l.client.WebhookDelivery.Create().
+		SetEndpointID(id).
+		SetEventType(eventType.String()).
+		SetPayload(string(payload))
+	if redeliveryOf != "" {
+		original, err := uuid.Parse(redeliveryOf)
+		if err != nil {
+			return "", fmt.Errorf("invalid webhook delivery ID: %w", err)
+		}
+		create.SetRedeliveryOf(original)
+	}
+
+	delivery, err := create.Save(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	return delivery.ID.String(), nil
+}
+
+// Finished records whether the delivery succeeded
+func (l *DBDeliveries) Finished(ctx context.Context, deliveryID string, result DeliveryResult) error {
+	id, err := uuid.Parse(deliveryID)
+	if err != nil {
+		return fmt.Errorf("invalid webhook delivery ID: %w", err)
+	}
+
+	update := l.client.WebhookDelivery.UpdateOneID(id).
+		SetAttempts(result.Attempts).
+		SetDeliveredAt(time.Now())
+	if result.Succeeded {
+		update.SetStatus(DeliverySucceeded).ClearError()
+	} else {
+		update.SetStatus(DeliveryFailed).SetError(result.Error)
+	}
+	if result.StatusCode != 0 {
+		update.SetResponseStatus(result.StatusCode).
+			SetDurationMs(int(result.Duration.Milliseconds()))
+	}
+
+	// The delivery is gone if its endpoint was deleted meanwhile
+	if err := update.Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to record webhook delivery result: %w", err)
+	}
+	return nil
+}
+
+// prune deletes deliveries past the retention period, at most once per prune interval
+func (l *DBDeliveries) prune(ctx context.Context) {
+	l.mu.Lock()
+	if time.Since(l.prunedAt) < deliveryPruneInterval {
+		l.mu.Unlock()
+		return
+	}
+	l.prunedAt = time.Now()
+	l.mu.Unlock()
+
+	// Failures are retried at the next prune interval
+	_, _ = l.client.WebhookDelivery.Delete().
+		Where(webhookdelivery.CreatedAtLT(time.Now().Add(-l.retention))).
+		Exec(ctx)
+}
+
+// SetDeliveryLog records deliveries to endpoints with an ID in log
+func (d *Dispatcher) SetDeliveryLog(log DeliveryLog) {
+	d.deliveries = log
+}
+
+// Redeliver sends recorded deliveries to the endpoint again in the background, in the given
+// order. Each redelivery is recorded as a new delivery that refers to the original one.
+func (d *Dispatcher) Redeliver(endpoint Endpoint, redeliveries []Redelivery) {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		for _, redelivery := range redeliveries {
+			if d.ctx.Err() != nil {
+				return
+			}
+			d.deliver(webhookJob{
+				endpoint:     endpoint,
+				payload:      redelivery.Payload,
+				eventType:    redelivery.EventType,
+				redeliveryOf: redelivery.DeliveryID,
+				ctx:          d.ctx,
+			})
+		}
+	}()
+}
+
+// deliver sends a job and records the delivery if its endpoint has an ID
+func (d *Dispatcher) deliver(job webhookJob) {
+	ctx, span := tracing.Tracer().Start(job.ctx, "webhook.deliver",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("webhook.endpoint", metricsEndpoint(job.endpoint)),
+			attribute.String("webhook.event_type", string(job.eventType)),
+		))
+	defer span.End()
+	job.ctx = ctx
+
+	deliveryID := d.startDelivery(job)
+	result := d.sendWithRetry(job, deliveryID)
+	span.SetAttributes(
+		attribute.String("webhook.delivery_id", deliveryID),
+		attribute.Int("webhook.attempts", result.Attempts),
+		attribute.Int("http.response.status_code", result.StatusCode))
+	if !result.Succeeded {
+		span.SetStatus(codes.Error, result.Error)
+	}
+	d.finishDelivery(deliveryID, result)
+	d.recordOutcome(job.endpoint, result)
+	d.metrics.observe(job.endpoint, result)
+}
+
+// startDelivery records a delivery and returns its ID, or "" if it isn't recorded
+func (d *Dispatcher) startDelivery(job webhookJob) string {
+	if d.deliveries == nil || job.endpoint.ID == "" {
+		return ""
+	}
+
+	deliveryID, err := d.deliveries.Started(job.ctx, job.endpoint.ID, job.eventType, job.payload, job.redeliveryOf)
+	if err != nil {
+		// Send the event anyway; it just can't be redelivered
+		d.logger.Warn("failed to record webhook delivery",
+			"url", job.endpoint.URL,
+			"event", job.eventType,
+			"error", err)
+		return ""
+	}
+	return deliveryID
+}
+
+// finishDelivery records the outcome of a recorded delivery
+func (d *Dispatcher) finishDelivery(deliveryID string, result DeliveryResult) {
+	if deliveryID == "" {
+		return
+	}
+
+	// Record the outcome even if the job was cancelled during shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), defaultHTTPTimeout)
+	defer cancel()
+	if err := d.deliveries.Finished(ctx, deliveryID, result); err != nil {
+		d.logger.Warn("failed to record webhook delivery result",
+			"delivery_id", deliveryID,
+			"error", err)
+	}
+}
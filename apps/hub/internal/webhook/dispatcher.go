@@ -1,5 +1,6 @@
 // Package webhook provides reliable webhook event delivery using a worker pool pattern.
-// It dispatches events to configured URLs with retry logic and exponential backoff.
+// It dispatches events to configured URLs and to endpoints managed through the API, with
+// retry logic and exponential backoff.
 // The worker pool prevents goroutine leaks and provides graceful shutdown capabilities.
 package webhook
 
@@ -11,7 +12,13 @@ import (
 	"log/slog"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/audit"
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
 )
 
 const (
@@ -35,26 +42,63 @@ const (
 	EventExperienceUpdated  EventType = "experience.updated"
 	EventExperienceDeleted  EventType = "experience.deleted"
 	EventExperienceEnriched EventType = "experience.enriched"
+	EventExperienceUrgent   EventType = "experience.urgent"
+	// EventWebhookDisabled and EventWebhookRecovered alert other endpoints when an endpoint
+	// is disabled after consecutive failures and when it receives events again
+	EventWebhookDisabled  EventType = "webhook.disabled"
+	EventWebhookRecovered EventType = "webhook.recovered"
+	// EventAlertTriggered reports a spike or drop in the volume or sentiment of a source
+	EventAlertTriggered EventType = "alert.triggered"
 )
 
-// Event represents a webhook event payload
+// CloudEvents attributes of all events
+const (
+	// CloudEventsSpecVersion is the CloudEvents version of the event format
+	CloudEventsSpecVersion = "1.0"
+	// EventSource identifies Hub as the source of events
+	EventSource = "urn:formbricks:hub"
+	// ContentType is the content type of event payloads (CloudEvents structured mode)
+	ContentType = "application/cloudevents+json"
+)
+
+// Event represents a webhook event payload in the CloudEvents 1.0 JSON format. The ID is
+// the same for every delivery of the event, including retries and redeliveries, so
+// receivers can deduplicate.
 type Event struct {
-	Event     EventType   `json:"event"`
-	Timestamp time.Time   `json:"timestamp"`
-	Data      interface{} `json:"data"`
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            EventType   `json:"type"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            interface{} `json:"data"`
+}
+
+// NewEvent creates an event with a new ID
+func NewEvent(eventType EventType, data interface{}) Event {
+	return Event{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              uuid.Must(uuid.NewV7()).String(),
+		Source:          EventSource,
+		Type:            eventType,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
 }
 
 // webhookJob represents a single webhook delivery job
 type webhookJob struct {
-	url       string
-	payload   []byte
-	eventType EventType
-	ctx       context.Context
+	endpoint     Endpoint
+	payload      []byte
+	eventType    EventType
+	redeliveryOf string // ID of the delivery sent again, if any
+	ctx          context.Context
 }
 
 // Dispatcher handles webhook dispatching with a worker pool to prevent goroutine leaks
 type Dispatcher struct {
-	urls        []string
+	static      []Endpoint // Configured URLs, which receive all events
 	client      *http.Client
 	logger      *slog.Logger
 	jobQueue    chan webhookJob
@@ -62,6 +106,23 @@ type Dispatcher struct {
 	ctx         context.Context
 	cancel      context.CancelFunc
 	workerCount int
+	alive       atomic.Int32 // Running workers
+
+	endpointsMu sync.Mutex
+	source      EndpointSource
+	loaded      []Endpoint // Endpoints of the source
+	loadedAt    time.Time
+
+	deliveries DeliveryLog // Records deliveries if set
+	breakers   *circuitBreakers
+
+	targets          *TargetPolicy // Restricts endpoints of the source if set
+	restrictedClient *http.Client
+
+	metrics *dispatcherMetrics
+
+	subscribersMu sync.Mutex
+	subscribers   map[*Subscription]struct{}
 }
 
 // NewDispatcher creates a new webhook dispatcher with a worker pool using default settings
@@ -73,8 +134,13 @@ func NewDispatcher(urls []string, logger *slog.Logger) *Dispatcher {
 func NewDispatcherWithPool(urls []string, workerCount, queueSize int, logger *slog.Logger) *Dispatcher {
 	ctx, cancel := context.WithCancel(context.Background())
 
+	static := make([]Endpoint, len(urls))
+	for i, url := range urls {
+		static[i] = Endpoint{URL: url}
+	}
+
 	d := &Dispatcher{
-		urls: urls,
+		static: static,
 		client: &http.Client{
 			Timeout: defaultHTTPTimeout,
 		},
@@ -83,7 +149,10 @@ func NewDispatcherWithPool(urls []string, workerCount, queueSize int, logger *sl
 		ctx:         ctx,
 		cancel:      cancel,
 		workerCount: workerCount,
+		breakers:    newCircuitBreakers(),
+		subscribers: make(map[*Subscription]struct{}),
 	}
+	d.metrics = newDispatcherMetrics(d)
 
 	// Start worker pool
 	d.startWorkers()
@@ -107,6 +176,8 @@ func (d *Dispatcher) startWorkers() {
 // worker processes webhook jobs from the queue
 func (d *Dispatcher) worker(id int) {
 	defer d.wg.Done()
+	d.alive.Add(1)
+	defer d.alive.Add(-1)
 
 	d.logger.Debug("webhook worker started", "worker_id", id)
 
@@ -120,7 +191,7 @@ func (d *Dispatcher) worker(id int) {
 			}
 
 			// Process the webhook job
-			d.sendWithRetry(job.ctx, job.url, job.payload, job.eventType)
+			d.deliver(job)
 
 		case <-d.ctx.Done():
 			// Context cancelled, worker should exit
@@ -130,12 +201,31 @@ func (d *Dispatcher) worker(id int) {
 	}
 }
 
+// DispatcherStatus describes the delivery workers of a dispatcher
+type DispatcherStatus struct {
+	Workers       int // Configured workers
+	AliveWorkers  int // Workers that are running
+	QueueLength   int // Deliveries waiting for a worker
+	QueueCapacity int // Deliveries that can wait before new ones are dropped
+}
+
+// Status returns the state of the delivery workers and their queue
+func (d *Dispatcher) Status() DispatcherStatus {
+	return DispatcherStatus{
+		Workers:       d.workerCount,
+		AliveWorkers:  int(d.alive.Load()),
+		QueueLength:   len(d.jobQueue),
+		QueueCapacity: cap(d.jobQueue),
+	}
+}
+
 // Shutdown gracefully shuts down the dispatcher, waiting for pending jobs to complete
 func (d *Dispatcher) Shutdown(timeout time.Duration) error {
 	d.logger.Info("shutting down webhook dispatcher", "timeout", timeout)
 
-	// Stop accepting new jobs
+	// Stop accepting new jobs and disconnect subscribers
 	close(d.jobQueue)
+	d.closeSubscriptions()
 
 	// Wait for workers to finish with timeout
 	done := make(chan struct{})
@@ -155,18 +245,20 @@ func (d *Dispatcher) Shutdown(timeout time.Duration) error {
 	}
 }
 
-// Dispatch sends a webhook event to all configured URLs using the worker pool
+// Dispatch sends a webhook event to all endpoints subscribed to it using the worker pool,
+// and to all subscriptions
 func (d *Dispatcher) Dispatch(ctx context.Context, eventType EventType, data interface{}) {
-	if len(d.urls) == 0 {
-		return
+	var endpoints []Endpoint
+	for _, endpoint := range d.endpoints(ctx) {
+		if endpoint.Subscribes(eventType) {
+			endpoints = append(endpoints, endpoint)
+		}
 	}
-
-	event := Event{
-		Event:     eventType,
-		Timestamp: time.Now(),
-		Data:      data,
+	if len(endpoints) == 0 && !d.hasSubscribers() {
+		return
 	}
 
+	event := NewEvent(eventType, data)
 	payload, err := json.Marshal(event)
 	if err != nil {
 		d.logger.Error("failed to marshal webhook event",
@@ -174,31 +266,65 @@ func (d *Dispatcher) Dispatch(ctx context.Context, eventType EventType, data int
 			"error", err)
 		return
 	}
+	// Correlate the event with the audited API call that emitted it
+	audit.RecordEvent(ctx, event.ID)
+
+	d.publish(eventType, payload)
+	endpoints = matchConditions(endpoints, payload)
 
-	// Enqueue jobs for each URL (non-blocking with buffered channel)
-	for _, url := range d.urls {
+	// Enqueue jobs for each endpoint (non-blocking with buffered channel)
+	for _, endpoint := range endpoints {
 		job := webhookJob{
-			url:       url,
+			endpoint:  endpoint,
 			payload:   payload,
 			eventType: eventType,
 			ctx:       ctx,
 		}
 
+		if !d.breakers.allow(endpoint, time.Now()) {
+			// Skip disabled endpoints; the event is recorded so it can be replayed
+			d.finishDelivery(d.startDelivery(job), DeliveryResult{Error: circuitOpenError})
+			d.metrics.deliveries.WithLabelValues(metricsEndpoint(endpoint), resultSkipped).Inc()
+			continue
+		}
+
 		select {
 		case d.jobQueue <- job:
 			// Job enqueued successfully
 		default:
 			// Queue is full, log warning and drop the job
 			d.logger.Warn("webhook queue full, dropping job",
-				"url", url,
+				"url", endpoint.URL,
 				"event", eventType,
 				"queue_size", cap(d.jobQueue))
+			d.breakers.release(endpoint)
+			// Record the dropped event so it can be redelivered
+			d.finishDelivery(d.startDelivery(job), DeliveryResult{Error: queueFullError})
+			d.metrics.deliveries.WithLabelValues(metricsEndpoint(endpoint), resultDropped).Inc()
 		}
 	}
 }
 
-// sendWithRetry sends a webhook with retry logic
-func (d *Dispatcher) sendWithRetry(ctx context.Context, url string, payload []byte, eventType EventType) {
+// sendWithRetry sends a webhook with retry logic and returns the outcome
+func (d *Dispatcher) sendWithRetry(job webhookJob, deliveryID string) DeliveryResult {
+	ctx, url, payload, eventType := job.ctx, job.endpoint.URL, job.payload, job.eventType
+
+	var result DeliveryResult
+	contentType := ContentType
+	slack := job.endpoint.Format == FormatSlack
+	if slack {
+		message, err := SlackMessage(payload, job.endpoint.SlackChannel)
+		if err != nil {
+			d.logger.Error("failed to format Slack message",
+				"url", url,
+				"event", eventType,
+				"error", err)
+			result.Error = err.Error()
+			return result
+		}
+		payload, contentType = message, "application/json"
+	}
+
 	for attempt := 0; attempt < maxRetries; attempt++ {
 		if attempt > 0 {
 			// Exponential backoff
@@ -206,9 +332,11 @@ func (d *Dispatcher) sendWithRetry(ctx context.Context, url string, payload []by
 			select {
 			case <-time.After(delay):
 			case <-ctx.Done():
-				return
+				result.Error = ctx.Err().Error()
+				return result
 			}
 		}
+		result.Attempts = attempt + 1
 
 		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
 		if err != nil {
@@ -217,30 +345,66 @@ func (d *Dispatcher) sendWithRetry(ctx context.Context, url string, payload []by
 				"event", eventType,
 				"attempt", attempt+1,
 				"error", err)
+			result.Error = err.Error()
 			continue
 		}
 
-		req.Header.Set("Content-Type", "application/json")
+		tracing.InjectHeaders(ctx, req.Header)
+		req.Header.Set("Content-Type", contentType)
 		req.Header.Set("User-Agent", "Formbricks-Hub/1.0")
+		switch {
+		case slack && job.endpoint.SlackToken != "":
+			req.Header.Set("Authorization", "Bearer "+job.endpoint.SlackToken)
+		case !slack && job.endpoint.Secret != "":
+			req.Header.Set(SignatureHeader, Sign(job.endpoint.Secret, payload))
+		}
+		if deliveryID != "" {
+			req.Header.Set(DeliveryHeader, deliveryID)
+		}
 
-		resp, err := d.client.Do(req)
+		start := time.Now()
+		resp, err := d.clientFor(job.endpoint).Do(req)
 		if err != nil {
 			d.logger.Warn("failed to send webhook",
 				"url", url,
 				"event", eventType,
 				"attempt", attempt+1,
 				"error", err)
+			result.StatusCode = 0
+			result.Duration = 0
+			result.Error = err.Error()
+			if isBlockedTarget(err) {
+				// Retrying can't help until the allowlist or the endpoint changes
+				return result
+			}
 			continue
 		}
 
+		result.StatusCode = resp.StatusCode
+		result.Duration = time.Since(start)
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 && slack && job.endpoint.SlackToken != "" {
+			err = slackAPIError(resp)
+		}
 		_ = resp.Body.Close()
+		if err != nil {
+			d.logger.Warn("slack rejected webhook message",
+				"url", url,
+				"event", eventType,
+				"attempt", attempt+1,
+				"error", err)
+			result.Error = err.Error()
+			continue
+		}
 
 		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 			d.logger.Info("webhook delivered successfully",
 				"url", url,
 				"event", eventType,
 				"status", resp.StatusCode)
-			return
+			result.Succeeded = true
+			result.Error = ""
+			return result
 		}
 
 		d.logger.Warn("webhook failed with non-2xx status",
@@ -248,24 +412,25 @@ func (d *Dispatcher) sendWithRetry(ctx context.Context, url string, payload []by
 			"event", eventType,
 			"status", resp.StatusCode,
 			"attempt", attempt+1)
+		result.Error = fmt.Sprintf("endpoint responded with status %d", resp.StatusCode)
 	}
 
 	d.logger.Error("webhook failed after all retries",
 		"url", url,
 		"event", eventType,
 		"attempts", maxRetries)
+	return result
 }
 
 // DispatchAsync is a convenience method that dispatches webhooks asynchronously
 // Uses the worker pool internally, so no goroutine leak
-func (d *Dispatcher) DispatchAsync(eventType EventType, data interface{}) {
-	// Use background context for async operations since:
+func (d *Dispatcher) DispatchAsync(ctx context.Context, eventType EventType, data interface{}) {
+	// Detach from ctx's cancellation and deadline since:
 	// 1. HTTP client has its own timeout (defaultHTTPTimeout = 5s)
 	// 2. Workers have retry logic with exponential backoff
-	// 3. No need for request-scoped tracing in async webhooks
-	// This avoids leaving timeout timers active and is simpler than managing
-	// context lifecycle across async boundaries
-	d.Dispatch(context.Background(), eventType, data)
+	// 3. Deliveries outlive the request or job that triggered them
+	// ctx's trace is kept, so deliveries show up in the trace of the request or job.
+	d.Dispatch(context.WithoutCancel(ctx), eventType, data)
 }
 
 // String returns a string representation of the event type
@@ -276,7 +441,8 @@ func (e EventType) String() string {
 // Validate checks if the event type is valid
 func (e EventType) Validate() error {
 	switch e {
-	case EventExperienceCreated, EventExperienceUpdated, EventExperienceDeleted, EventExperienceEnriched:
+	case EventExperienceCreated, EventExperienceUpdated, EventExperienceDeleted, EventExperienceEnriched,
+		EventExperienceUrgent, EventWebhookDisabled, EventWebhookRecovered, EventAlertTriggered:
 		return nil
 	default:
 		return fmt.Errorf("invalid event type: %s", e)
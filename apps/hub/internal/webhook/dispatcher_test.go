@@ -4,15 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
 )
 
 type roundTripFunc func(*http.Request) (*http.Response, error)
@@ -31,8 +38,8 @@ func TestDispatcher_Dispatch_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		t.Helper()
 
-		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
-			t.Errorf("expected Content-Type application/json, got %s", ct)
+		if ct := r.Header.Get("Content-Type"); ct != ContentType {
+			t.Errorf("expected Content-Type %s, got %s", ContentType, ct)
 		}
 		if ua := r.Header.Get("User-Agent"); ua != "Formbricks-Hub/1.0" {
 			t.Errorf("expected User-Agent Formbricks-Hub/1.0, got %s", ua)
@@ -43,11 +50,17 @@ func TestDispatcher_Dispatch_Success(t *testing.T) {
 			t.Fatalf("failed to decode payload: %v", err)
 		}
 
-		if event.Event != EventExperienceCreated {
-			t.Errorf("expected event %q, got %q", EventExperienceCreated, event.Event)
+		if event.Type != EventExperienceCreated {
+			t.Errorf("expected event type %q, got %q", EventExperienceCreated, event.Type)
+		}
+		if event.SpecVersion != "1.0" || event.Source != EventSource || event.DataContentType != "application/json" {
+			t.Errorf("expected CloudEvents 1.0 attributes, got %+v", event)
 		}
-		if event.Timestamp.IsZero() {
-			t.Error("expected timestamp to be set")
+		if _, err := uuid.Parse(event.ID); err != nil {
+			t.Errorf("expected a UUID event ID, got %q", event.ID)
+		}
+		if event.Time.IsZero() {
+			t.Error("expected time to be set")
 		}
 
 		payload, ok := event.Data.(map[string]interface{})
@@ -137,3 +150,262 @@ func TestDispatcher_Dispatch_NoWebhooks(t *testing.T) {
 		t.Fatal("dispatcher did not return immediately with no webhooks configured")
 	}
 }
+
+func TestDispatcher_Status(t *testing.T) {
+	dispatcher := NewDispatcherWithPool(nil, 3, 50, newTestLogger())
+
+	// Workers start asynchronously
+	deadline := time.Now().Add(time.Second)
+	for dispatcher.Status().AliveWorkers < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	want := DispatcherStatus{Workers: 3, AliveWorkers: 3, QueueLength: 0, QueueCapacity: 50}
+	if status := dispatcher.Status(); status != want {
+		t.Errorf("expected %+v, got %+v", want, status)
+	}
+
+	if err := dispatcher.Shutdown(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if alive := dispatcher.Status().AliveWorkers; alive != 0 {
+		t.Errorf("expected no running workers after shutdown, got %d", alive)
+	}
+}
+
+type endpointSourceFunc func(context.Context) ([]Endpoint, error)
+
+func (f endpointSourceFunc) Endpoints(ctx context.Context) ([]Endpoint, error) {
+	return f(ctx)
+}
+
+func TestDispatcher_EndpointSource(t *testing.T) {
+	received := make(chan *http.Request, 4)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if got, want := r.Header.Get(SignatureHeader), Sign("s3cret", body); r.URL.Path == "/signed" && got != want {
+			t.Errorf("expected signature %q, got %q", want, got)
+		}
+		received <- r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var loads atomic.Int32
+	dispatcher := NewDispatcher(nil, newTestLogger())
+	dispatcher.client = server.Client()
+	dispatcher.SetEndpointSource(endpointSourceFunc(func(context.Context) ([]Endpoint, error) {
+		loads.Add(1)
+		return []Endpoint{
+			{URL: server.URL + "/signed", Secret: "s3cret"},
+			{URL: server.URL + "/urgent", EventTypes: []EventType{EventExperienceUrgent}},
+		}, nil
+	}))
+
+	dispatcher.Dispatch(context.Background(), EventExperienceCreated, map[string]any{"id": uuid.NewString()})
+
+	select {
+	case r := <-received:
+		if r.URL.Path != "/signed" {
+			t.Errorf("expected delivery to /signed, got %s", r.URL.Path)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for webhook dispatch")
+	}
+
+	select {
+	case r := <-received:
+		t.Errorf("unexpected delivery to %s of an event it didn't subscribe to", r.URL.Path)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// Endpoints are cached until they are invalidated
+	dispatcher.Dispatch(context.Background(), EventExperienceUrgent, map[string]any{"id": uuid.NewString()})
+	if got := loads.Load(); got != 1 {
+		t.Errorf("expected endpoints to be loaded once, got %d loads", got)
+	}
+	dispatcher.Invalidate()
+	dispatcher.Dispatch(context.Background(), EventExperienceUrgent, map[string]any{"id": uuid.NewString()})
+	if got := loads.Load(); got != 2 {
+		t.Errorf("expected endpoints to be reloaded after Invalidate, got %d loads", got)
+	}
+}
+
+func TestDispatcher_SetURLs(t *testing.T) {
+	dispatcher := NewDispatcher([]string{"https://old.example.com"}, newTestLogger())
+	defer func() { _ = dispatcher.Shutdown(time.Second) }()
+
+	dispatcher.SetURLs([]string{"https://a.example.com", "https://b.example.com"})
+	endpoints := dispatcher.endpoints(context.Background())
+	if len(endpoints) != 2 || endpoints[0].URL != "https://a.example.com" || endpoints[1].URL != "https://b.example.com" {
+		t.Errorf("expected the new URLs, got %+v", endpoints)
+	}
+
+	dispatcher.SetURLs(nil)
+	if endpoints := dispatcher.endpoints(context.Background()); len(endpoints) != 0 {
+		t.Errorf("expected no endpoints after removing the URLs, got %+v", endpoints)
+	}
+}
+
+// fakeDeliveryLog records deliveries in memory
+type fakeDeliveryLog struct {
+	mu       sync.Mutex
+	started  []webhookJob
+	finished chan DeliveryResult
+}
+
+func (l *fakeDeliveryLog) Started(_ context.Context, endpointID string, eventType EventType, payload []byte, redeliveryOf string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.started = append(l.started, webhookJob{
+		endpoint:     Endpoint{ID: endpointID},
+		payload:      payload,
+		eventType:    eventType,
+		redeliveryOf: redeliveryOf,
+	})
+	return fmt.Sprintf("delivery-%d", len(l.started)), nil
+}
+
+func (l *fakeDeliveryLog) Finished(_ context.Context, _ string, result DeliveryResult) error {
+	l.finished <- result
+	return nil
+}
+
+func TestDispatcher_DeliveryLog(t *testing.T) {
+	deliveryIDs := make(chan string, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveryIDs <- r.Header.Get(DeliveryHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	endpoint := Endpoint{ID: uuid.NewString(), URL: server.URL}
+	log := &fakeDeliveryLog{finished: make(chan DeliveryResult, 2)}
+
+	dispatcher := NewDispatcher(nil, newTestLogger())
+	dispatcher.client = server.Client()
+	dispatcher.SetDeliveryLog(log)
+	dispatcher.SetEndpointSource(endpointSourceFunc(func(context.Context) ([]Endpoint, error) {
+		return []Endpoint{endpoint}, nil
+	}))
+
+	waitForDelivery := func(wantID string) {
+		t.Helper()
+		select {
+		case id := <-deliveryIDs:
+			if id != wantID {
+				t.Errorf("expected %s header %q, got %q", DeliveryHeader, wantID, id)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout waiting for webhook delivery")
+		}
+		select {
+		case result := <-log.finished:
+			if !result.Succeeded || result.Attempts != 1 || result.StatusCode != http.StatusNoContent {
+				t.Errorf("unexpected delivery result %+v", result)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout waiting for the delivery result")
+		}
+	}
+
+	dispatcher.Dispatch(context.Background(), EventExperienceCreated, map[string]any{"id": uuid.NewString()})
+	waitForDelivery("delivery-1")
+
+	log.mu.Lock()
+	original := log.started[0]
+	log.mu.Unlock()
+
+	dispatcher.Redeliver(endpoint, []Redelivery{{DeliveryID: "delivery-1", EventType: original.eventType, Payload: original.payload}})
+	waitForDelivery("delivery-2")
+
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	redelivery := log.started[1]
+	if redelivery.redeliveryOf != "delivery-1" || redelivery.endpoint.ID != endpoint.ID || !bytes.Equal(redelivery.payload, original.payload) {
+		t.Errorf("unexpected redelivery %+v", redelivery)
+	}
+}
+
+func TestDispatcher_Conditions(t *testing.T) {
+	received := make(chan string, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		_ = json.NewDecoder(r.Body).Decode(&event)
+		received <- r.URL.Path + " " + event.Data.(map[string]any)["id"].(string)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher(nil, newTestLogger())
+	dispatcher.client = server.Client()
+	dispatcher.SetEndpointSource(endpointSourceFunc(func(context.Context) ([]Endpoint, error) {
+		return []Endpoint{{
+			URL: server.URL + "/detractors",
+			Conditions: []rules.Condition{
+				{Field: "sentiment", Operator: rules.OpEq, Value: "negative"},
+				{Field: "source_type", Operator: rules.OpEq, Value: "nps"},
+			},
+		}}, nil
+	}))
+
+	dispatcher.Dispatch(context.Background(), EventExperienceEnriched, map[string]any{"id": "promoter", "source_type": "nps", "sentiment": "positive"})
+	dispatcher.Dispatch(context.Background(), EventExperienceEnriched, map[string]any{"id": "detractor", "source_type": "nps", "sentiment": "negative"})
+
+	select {
+	case got := <-received:
+		if got != "/detractors detractor" {
+			t.Errorf("expected only the detractor event, got %s", got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for webhook dispatch")
+	}
+	select {
+	case got := <-received:
+		t.Errorf("unexpected delivery %s", got)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestDispatcher_Metrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	endpointID := uuid.NewString()
+	dispatcher := NewDispatcher(nil, newTestLogger())
+	dispatcher.client = server.Client()
+	dispatcher.SetEndpointSource(endpointSourceFunc(func(context.Context) ([]Endpoint, error) {
+		return []Endpoint{{ID: endpointID, URL: server.URL}}, nil
+	}))
+
+	registry := prometheus.NewRegistry()
+	if err := dispatcher.RegisterMetrics(registry); err != nil {
+		t.Fatalf("failed to register metrics: %v", err)
+	}
+
+	dispatcher.Dispatch(context.Background(), EventExperienceCreated, map[string]any{"id": uuid.NewString()})
+
+	succeeded := dispatcher.metrics.deliveries.WithLabelValues(endpointID, resultSucceeded)
+	deadline := time.Now().Add(2 * time.Second)
+	for testutil.ToFloat64(succeeded) != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for the delivery to be counted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if got := testutil.CollectAndCount(registry, "hub_webhook_delivery_duration_seconds"); got != 1 {
+		t.Errorf("expected latency of 1 endpoint, got %d", got)
+	}
+	expected := `
+# HELP hub_webhook_queue_capacity Webhook deliveries that can wait for a worker before new ones are dropped
+# TYPE hub_webhook_queue_capacity gauge
+hub_webhook_queue_capacity 100
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "hub_webhook_queue_capacity"); err != nil {
+		t.Error(err)
+	}
+}
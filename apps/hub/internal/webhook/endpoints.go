@@ -0,0 +1,170 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/webhookendpoint"
+	"github.com/formbricks/hub/apps/hub/internal/webhook/rules"
+)
+
+const (
+	// endpointRefreshInterval is how often endpoints are reloaded from the endpoint source,
+	// so endpoints changed through another Hub instance are picked up
+	endpointRefreshInterval = 10 * time.Second
+	// SignatureHeader carries the HMAC-SHA256 signature of the payload for endpoints with a secret
+	SignatureHeader = "X-Hub-Signature-256"
+)
+
+// Endpoint is a subscriber that receives webhook events
+type Endpoint struct {
+	ID         string // Set for endpoints managed through the API, whose deliveries are recorded
+	URL        string
+	Secret     string            // Signs payloads if set
+	EventTypes []EventType       // Empty for all events
+	Conditions []rules.Condition // Conditions on the event data; empty for all events
+	// Format is the payload format, FormatCloudEvents if empty. Slack endpoints post to an
+	// incoming webhook URL, or to chat.postMessage with a bot token and channel.
+	Format       string
+	SlackToken   string
+	SlackChannel string
+}
+
+// Subscribes reports whether the endpoint receives events of the given type
+func (e Endpoint) Subscribes(eventType EventType) bool {
+	return len(e.EventTypes) == 0 || slices.Contains(e.EventTypes, eventType)
+}
+
+// matchConditions returns the endpoints whose conditions the event's data satisfies. The
+// payload is only decoded if an endpoint has conditions.
+func matchConditions(endpoints []Endpoint, payload []byte) []Endpoint {
+	var data map[string]any
+	decoded := false
+
+	matched := make([]Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if len(endpoint.Conditions) > 0 {
+			if !decoded {
+				var event struct {
+					Data map[string]any `json:"data"`
+				}
+				_ = json.Unmarshal(payload, &event)
+				data, decoded = event.Data, true
+			}
+			if !rules.Match(endpoint.Conditions, data) {
+				continue
+			}
+		}
+		matched = append(matched, endpoint)
+	}
+	return matched
+}
+
+// EndpointSource provides endpoints that can change at runtime
+type EndpointSource interface {
+	Endpoints(ctx context.Context) ([]Endpoint, error)
+}
+
+// DBEndpoints provides the enabled endpoints stored in the database
+type DBEndpoints struct {
+	client *ent.Client
+}
+
+// NewDBEndpoints creates an endpoint source backed by the webhook_endpoints table
+func NewDBEndpoints(client *ent.Client) *DBEndpoints {
+	return &DBEndpoints{client: client}
+}
+
+// Endpoints returns all enabled endpoints
+func (s *DBEndpoints) Endpoints(ctx context.Context) ([]Endpoint, error) {
+	rows, err := s.client.WebhookEndpoint.Query().
+		Where(webhookendpoint.Enabled(true)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load webhook endpoints: %w", err)
+	}
+
+	endpoints := make([]Endpoint, len(rows))
+	for i, row := range rows {
+		eventTypes := make([]EventType, len(row.EventTypes))
+		for j, eventType := range row.EventTypes {
+			eventTypes[j] = EventType(eventType)
+		}
+		endpoints[i] = Endpoint{
+			ID:           row.ID.String(),
+			URL:          row.URL,
+			Secret:       row.Secret,
+			EventTypes:   eventTypes,
+			Conditions:   row.Conditions,
+			Format:       row.Format,
+			SlackToken:   row.SlackToken,
+			SlackChannel: row.SlackChannel,
+		}
+	}
+	return endpoints, nil
+}
+
+// SetEndpointSource adds the endpoints of source to the configured URLs. Endpoints are
+// reloaded every 10 seconds, or on the next dispatch after Invalidate.
+func (d *Dispatcher) SetEndpointSource(source EndpointSource) {
+	d.endpointsMu.Lock()
+	defer d.endpointsMu.Unlock()
+	d.source = source
+	d.loadedAt = time.Time{}
+}
+
+// SetURLs replaces the configured URLs that receive all events. Deliveries already queued
+// are still sent to the previous URLs.
+func (d *Dispatcher) SetURLs(urls []string) {
+	static := make([]Endpoint, len(urls))
+	for i, url := range urls {
+		static[i] = Endpoint{URL: url}
+	}
+
+	d.endpointsMu.Lock()
+	defer d.endpointsMu.Unlock()
+	d.static = static
+}
+
+// Invalidate reloads endpoints from the source on the next dispatch, e.g. after they were
+// changed through the API
+func (d *Dispatcher) Invalidate() {
+	d.endpointsMu.Lock()
+	defer d.endpointsMu.Unlock()
+	d.loadedAt = time.Time{}
+}
+
+// endpoints returns the configured endpoints and those of the source. If the source fails,
+// the endpoints loaded last are used.
+func (d *Dispatcher) endpoints(ctx context.Context) []Endpoint {
+	d.endpointsMu.Lock()
+	defer d.endpointsMu.Unlock()
+
+	if d.source != nil && time.Since(d.loadedAt) > endpointRefreshInterval {
+		loaded, err := d.source.Endpoints(ctx)
+		if err != nil {
+			d.logger.Warn("failed to reload webhook endpoints", "error", err)
+		} else {
+			d.loaded = loaded
+		}
+		// Don't hammer a failing source on every event
+		d.loadedAt = time.Now()
+	}
+
+	return append(slices.Clone(d.static), d.loaded...)
+}
+
+// Sign returns the X-Hub-Signature-256 header value for a payload: the hex-encoded
+// HMAC-SHA256 of the payload keyed with the endpoint secret, prefixed with "sha256="
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
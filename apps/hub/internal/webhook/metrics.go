@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"net/url"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Delivery results counted by hub_webhook_deliveries_total
+const (
+	resultSucceeded = "succeeded"
+	resultFailed    = "failed"
+	resultSkipped   = "skipped" // Endpoint disabled after consecutive failures
+	resultDropped   = "dropped" // Queue full
+)
+
+// dispatcherMetrics are the Prometheus metrics of a dispatcher
+type dispatcherMetrics struct {
+	deliveries *prometheus.CounterVec
+	duration   *prometheus.HistogramVec
+	collectors []prometheus.Collector
+}
+
+func newDispatcherMetrics(d *Dispatcher) *dispatcherMetrics {
+	m := &dispatcherMetrics{
+		deliveries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hub_webhook_deliveries_total",
+			Help: "Webhook deliveries by endpoint and result (succeeded, failed, skipped while the endpoint is disabled, dropped because the queue was full)",
+		}, []string{"endpoint", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "hub_webhook_delivery_duration_seconds",
+			Help:    "Response time of the last attempt of webhook deliveries that got a response",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 5},
+		}, []string{"endpoint"}),
+	}
+
+	consecutiveFailures := prometheus.NewDesc("hub_webhook_consecutive_failures",
+		"Failed deliveries in a row by endpoint; endpoints are disabled after 5", []string{"endpoint"}, nil)
+	m.collectors = []prometheus.Collector{
+		m.deliveries,
+		m.duration,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "hub_webhook_queue_length",
+			Help: "Webhook deliveries waiting for a worker",
+		}, func() float64 { return float64(len(d.jobQueue)) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "hub_webhook_queue_capacity",
+			Help: "Webhook deliveries that can wait for a worker before new ones are dropped",
+		}, func() float64 { return float64(cap(d.jobQueue)) }),
+		collectorFunc{desc: consecutiveFailures, collect: func(ch chan<- prometheus.Metric) {
+			for endpoint, failures := range d.breakers.failures() {
+				ch <- prometheus.MustNewConstMetric(consecutiveFailures, prometheus.GaugeValue, float64(failures), endpoint)
+			}
+		}},
+	}
+	return m
+}
+
+// observe counts a finished delivery
+func (m *dispatcherMetrics) observe(endpoint Endpoint, result DeliveryResult) {
+	label := metricsEndpoint(endpoint)
+	if result.Succeeded {
+		m.deliveries.WithLabelValues(label, resultSucceeded).Inc()
+	} else {
+		m.deliveries.WithLabelValues(label, resultFailed).Inc()
+	}
+	if result.StatusCode != 0 {
+		m.duration.WithLabelValues(label).Observe(result.Duration.Seconds())
+	}
+}
+
+// metricsEndpoint labels an endpoint's metrics with its ID. Configured URLs are labeled
+// with their host only, since URLs such as Slack webhooks contain credentials.
+func metricsEndpoint(endpoint Endpoint) string {
+	if endpoint.ID != "" {
+		return endpoint.ID
+	}
+	if parsed, err := url.Parse(endpoint.URL); err == nil {
+		return parsed.Host
+	}
+	return "configured"
+}
+
+// RegisterMetrics registers the dispatcher's Prometheus metrics
+func (d *Dispatcher) RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range d.metrics.collectors {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectorFunc is a collector of metrics computed on each scrape
+type collectorFunc struct {
+	desc    *prometheus.Desc
+	collect func(ch chan<- prometheus.Metric)
+}
+
+func (c collectorFunc) Describe(ch chan<- *prometheus.Desc) { ch <- c.desc }
+func (c collectorFunc) Collect(ch chan<- prometheus.Metric) { c.collect(ch) }
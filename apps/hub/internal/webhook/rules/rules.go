@@ -0,0 +1,162 @@
+// Package rules evaluates the conditions that route webhook events to an endpoint, e.g.
+// only negative feedback from NPS surveys. It has no dependencies so the conditions can be
+// stored with the endpoint in the database.
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Operators
+const (
+	OpEq       = "eq"       // Field equals value
+	OpNe       = "ne"       // Field is missing or doesn't equal value
+	OpIn       = "in"       // Field equals one of the values
+	OpNotIn    = "not_in"   // Field is missing or equals none of the values
+	OpGt       = "gt"       // Field is a number greater than value
+	OpGte      = "gte"      // Field is a number greater than or equal to value
+	OpLt       = "lt"       // Field is a number less than value
+	OpLte      = "lte"      // Field is a number less than or equal to value
+	OpContains = "contains" // Field is a list containing value, or a string containing it
+	OpExists   = "exists"   // Field is present (value true) or missing (value false)
+)
+
+// Condition compares a field of the event data with a value. Field is a dot-separated path
+// into the data, e.g. "sentiment" or "metadata.country".
+type Condition struct {
+	Field    string `json:"field" doc:"Dot-separated path into the event data, e.g. sentiment or metadata.country" example:"sentiment"`
+	Operator string `json:"operator" doc:"Comparison: eq, ne, in, not_in, gt, gte, lt, lte, contains, exists" enum:"eq,ne,in,not_in,gt,gte,lt,lte,contains,exists"`
+	Value    any    `json:"value" doc:"Value to compare with: a list for in and not_in, a number for gt, gte, lt, and lte, a boolean for exists"`
+}
+
+// Validate checks that the condition's value fits its operator. Values are expected as
+// decoded from JSON, so numbers are float64.
+func (c Condition) Validate() error {
+	if c.Field == "" || slices.Contains(strings.Split(c.Field, "."), "") {
+		return fmt.Errorf("invalid condition field %q", c.Field)
+	}
+
+	switch c.Operator {
+	case OpEq, OpNe, OpContains:
+		if !isScalar(c.Value) {
+			return fmt.Errorf("condition on %s: %s requires a string, number, or boolean value", c.Field, c.Operator)
+		}
+	case OpIn, OpNotIn:
+		values, ok := c.Value.([]any)
+		if !ok || len(values) == 0 || !all(values, isScalar) {
+			return fmt.Errorf("condition on %s: %s requires a non-empty list of strings, numbers, or booleans", c.Field, c.Operator)
+		}
+	case OpGt, OpGte, OpLt, OpLte:
+		if _, ok := c.Value.(float64); !ok {
+			return fmt.Errorf("condition on %s: %s requires a number", c.Field, c.Operator)
+		}
+	case OpExists:
+		if _, ok := c.Value.(bool); !ok {
+			return fmt.Errorf("condition on %s: exists requires true or false", c.Field)
+		}
+	default:
+		return fmt.Errorf("condition on %s: unknown operator %q", c.Field, c.Operator)
+	}
+	return nil
+}
+
+// Matches reports whether the data satisfies the condition
+func (c Condition) Matches(data map[string]any) bool {
+	value, found := lookup(data, c.Field)
+
+	switch c.Operator {
+	case OpEq:
+		return found && value == c.Value
+	case OpNe:
+		return !found || value != c.Value
+	case OpIn:
+		values, _ := c.Value.([]any)
+		return found && slices.Contains(values, value)
+	case OpNotIn:
+		values, _ := c.Value.([]any)
+		return !found || !slices.Contains(values, value)
+	case OpGt, OpGte, OpLt, OpLte:
+		number, ok := value.(float64)
+		limit, _ := c.Value.(float64)
+		if !found || !ok {
+			return false
+		}
+		switch c.Operator {
+		case OpGt:
+			return number > limit
+		case OpGte:
+			return number >= limit
+		case OpLt:
+			return number < limit
+		default:
+			return number <= limit
+		}
+	case OpContains:
+		switch v := value.(type) {
+		case []any:
+			return slices.Contains(v, c.Value)
+		case string:
+			s, ok := c.Value.(string)
+			return ok && strings.Contains(v, s)
+		}
+		return false
+	case OpExists:
+		want, _ := c.Value.(bool)
+		return found == want
+	}
+	return false
+}
+
+// Validate checks all conditions
+func Validate(conditions []Condition) error {
+	for _, c := range conditions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match reports whether the data satisfies all conditions. No conditions match all data.
+func Match(conditions []Condition, data map[string]any) bool {
+	for _, c := range conditions {
+		if !c.Matches(data) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup returns the value at a dot-separated path. JSON null counts as missing.
+func lookup(data map[string]any, path string) (any, bool) {
+	var value any = data
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, value != nil
+}
+
+func isScalar(value any) bool {
+	switch value.(type) {
+	case string, float64, bool:
+		return true
+	}
+	return false
+}
+
+func all(values []any, f func(any) bool) bool {
+	for _, value := range values {
+		if !f(value) {
+			return false
+		}
+	}
+	return true
+}
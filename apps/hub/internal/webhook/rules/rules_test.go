@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	var data map[string]any
+	_ = json.Unmarshal([]byte(`{
+		"source_type": "nps",
+		"sentiment": "negative",
+		"value_number": 3,
+		"topics": ["pricing", "support"],
+		"value_text": "Support never answers",
+		"metadata": {"country": "DE"},
+		"emotion": null
+	}`), &data)
+
+	tests := []struct {
+		name       string
+		conditions string
+		want       bool
+	}{
+		{"no conditions", `[]`, true},
+		{"detractor feedback", `[{"field":"sentiment","operator":"eq","value":"negative"},{"field":"source_type","operator":"eq","value":"nps"}]`, true},
+		{"one condition fails", `[{"field":"sentiment","operator":"eq","value":"negative"},{"field":"source_type","operator":"eq","value":"survey"}]`, false},
+		{"ne", `[{"field":"sentiment","operator":"ne","value":"positive"}]`, true},
+		{"ne missing field", `[{"field":"language","operator":"ne","value":"en"}]`, true},
+		{"in", `[{"field":"metadata.country","operator":"in","value":["DE","AT"]}]`, true},
+		{"not_in", `[{"field":"metadata.country","operator":"not_in","value":["DE","AT"]}]`, false},
+		{"lte", `[{"field":"value_number","operator":"lte","value":6}]`, true},
+		{"gt", `[{"field":"value_number","operator":"gt","value":6}]`, false},
+		{"gt non-number", `[{"field":"sentiment","operator":"gt","value":0}]`, false},
+		{"contains list", `[{"field":"topics","operator":"contains","value":"pricing"}]`, true},
+		{"contains string", `[{"field":"value_text","operator":"contains","value":"never"}]`, true},
+		{"exists", `[{"field":"metadata.country","operator":"exists","value":true}]`, true},
+		{"null is missing", `[{"field":"emotion","operator":"exists","value":false}]`, true},
+		{"path through scalar", `[{"field":"sentiment.label","operator":"exists","value":true}]`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conditions []Condition
+			if err := json.Unmarshal([]byte(tt.conditions), &conditions); err != nil {
+				t.Fatalf("invalid test conditions: %v", err)
+			}
+			if err := Validate(conditions); err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if got := Match(conditions, data); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []string{
+		`{"field":"","operator":"eq","value":"x"}`,
+		`{"field":"metadata..country","operator":"eq","value":"x"}`,
+		`{"field":"sentiment","operator":"like","value":"x"}`,
+		`{"field":"sentiment","operator":"eq","value":["x"]}`,
+		`{"field":"sentiment","operator":"in","value":[]}`,
+		`{"field":"value_number","operator":"gt","value":"5"}`,
+		`{"field":"sentiment","operator":"exists","value":"yes"}`,
+	}
+	for _, raw := range invalid {
+		var c Condition
+		_ = json.Unmarshal([]byte(raw), &c)
+		if err := c.Validate(); err == nil {
+			t.Errorf("Validate(%s) = nil, want an error", raw)
+		}
+	}
+}
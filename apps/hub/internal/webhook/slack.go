@@ -0,0 +1,316 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Payload formats of endpoints
+const (
+	// FormatCloudEvents sends events as CloudEvents JSON, signed with the endpoint secret
+	FormatCloudEvents = "cloudevents"
+	// FormatSlack posts events as Slack messages, to an incoming webhook URL or with a bot token
+	FormatSlack = "slack"
+)
+
+const (
+	// SlackPostMessageURL is the Slack Web API method that bot tokens post messages with
+	SlackPostMessageURL = "https://slack.com/api/chat.postMessage"
+	// slackMaxText is the longest text of a section block Slack accepts, less room for quoting
+	slackMaxText = 2800
+	// slackMaxSamples is the number of sample experiences shown in alert messages
+	slackMaxSamples = 3
+)
+
+// slackText is a text object of a block
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// slackBlock is a Block Kit layout block: a header, section, or context
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+// slackMessage is the body of incoming webhook and chat.postMessage requests. Text is shown
+// in notifications, blocks in the channel.
+type slackMessage struct {
+	Channel string       `json:"channel,omitempty"`
+	Text    string       `json:"text"`
+	Blocks  []slackBlock `json:"blocks"`
+}
+
+// slackExperience holds the fields of experiences shown in messages
+type slackExperience struct {
+	ID             string   `json:"id"`
+	SourceType     string   `json:"source_type"`
+	SourceName     *string  `json:"source_name"`
+	FieldLabel     *string  `json:"field_label"`
+	FieldID        string   `json:"field_id"`
+	ValueText      *string  `json:"value_text"`
+	ValueNumber    *float64 `json:"value_number"`
+	ValueBoolean   *bool    `json:"value_boolean"`
+	UserIdentifier *string  `json:"user_identifier"`
+	Sentiment      *string  `json:"sentiment"`
+	Emotion        *string  `json:"emotion"`
+	Topics         []string `json:"topics"`
+	UrgencyScore   *float64 `json:"urgency_score"`
+	UrgencyReasons []string `json:"urgency_reasons"`
+}
+
+// slackAlert holds the fields of anomaly alerts shown in messages
+type slackAlert struct {
+	Metric      string            `json:"metric"`
+	Direction   string            `json:"direction"`
+	SourceType  string            `json:"source_type"`
+	SourceID    string            `json:"source_id"`
+	WindowStart time.Time         `json:"window_start"`
+	WindowEnd   time.Time         `json:"window_end"`
+	Value       float64           `json:"value"`
+	Baseline    float64           `json:"baseline"`
+	Deviation   float64           `json:"deviation"`
+	Responses   int               `json:"responses"`
+	Samples     []slackExperience `json:"samples"`
+}
+
+// SlackMessage formats the CloudEvents payload of an event as a Slack message. Feedback is
+// quoted with its source, field, and enrichment; alerts describe the change with a few
+// sample experiences. channel is only set for bot tokens.
+func SlackMessage(payload []byte, channel string) ([]byte, error) {
+	var event struct {
+		Type EventType       `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	var title, summary string
+	var blocks []slackBlock
+	switch event.Type {
+	case EventExperienceCreated, EventExperienceUpdated, EventExperienceEnriched, EventExperienceUrgent:
+		var exp slackExperience
+		if err := json.Unmarshal(event.Data, &exp); err != nil {
+			return nil, fmt.Errorf("failed to decode experience: %w", err)
+		}
+		title = experienceTitle(event.Type, exp)
+		summary = slackEscape(truncate(experienceValue(exp), 150))
+		blocks = experienceBlocks(event.Type, exp)
+	case EventExperienceDeleted:
+		var exp slackExperience
+		if err := json.Unmarshal(event.Data, &exp); err != nil {
+			return nil, fmt.Errorf("failed to decode experience: %w", err)
+		}
+		title = "Feedback deleted"
+		summary = fmt.Sprintf("Experience `%s` was deleted.", exp.ID)
+		blocks = []slackBlock{sectionBlock(summary)}
+	case EventAlertTriggered:
+		var alert slackAlert
+		if err := json.Unmarshal(event.Data, &alert); err != nil {
+			return nil, fmt.Errorf("failed to decode alert: %w", err)
+		}
+		title, summary = alertTitle(alert), alertSummary(alert)
+		blocks = alertBlocks(alert, summary)
+	case EventWebhookDisabled, EventWebhookRecovered:
+		var health EndpointHealth
+		if err := json.Unmarshal(event.Data, &health); err != nil {
+			return nil, fmt.Errorf("failed to decode endpoint health: %w", err)
+		}
+		title, summary = healthMessage(event.Type, health)
+		blocks = []slackBlock{sectionBlock(summary)}
+	default:
+		title = string(event.Type)
+		summary = "Hub sent a " + string(event.Type) + " event."
+		blocks = []slackBlock{sectionBlock(summary)}
+	}
+
+	message := slackMessage{
+		Channel: channel,
+		Text:    title + ": " + summary,
+		Blocks:  append([]slackBlock{{Type: "header", Text: &slackText{Type: "plain_text", Text: title}}}, blocks...),
+	}
+	return json.Marshal(message)
+}
+
+// experienceTitle names the event of an experience, calling out negative and urgent feedback
+func experienceTitle(eventType EventType, exp slackExperience) string {
+	switch {
+	case eventType == EventExperienceUrgent:
+		return ":rotating_light: Urgent feedback"
+	case eventType == EventExperienceEnriched && exp.Sentiment != nil && *exp.Sentiment == "negative":
+		return ":red_circle: Negative feedback"
+	case eventType == EventExperienceEnriched:
+		return "Feedback enriched"
+	case eventType == EventExperienceUpdated:
+		return "Feedback updated"
+	}
+	return "New feedback"
+}
+
+// experienceBlocks quotes the value of an experience, followed by its source, respondent,
+// and enrichment
+func experienceBlocks(eventType EventType, exp slackExperience) []slackBlock {
+	blocks := []slackBlock{sectionBlock(quote(truncate(experienceValue(exp), slackMaxText)))}
+
+	details := []string{"*Source:* " + slackEscape(sourceOf(exp.SourceType, exp.SourceName))}
+	field := exp.FieldID
+	if exp.FieldLabel != nil && *exp.FieldLabel != "" {
+		field = *exp.FieldLabel
+	}
+	details = append(details, "*Field:* "+slackEscape(field))
+	if exp.UserIdentifier != nil && *exp.UserIdentifier != "" {
+		details = append(details, "*User:* "+slackEscape(*exp.UserIdentifier))
+	}
+	if exp.Sentiment != nil {
+		details = append(details, "*Sentiment:* "+slackEscape(*exp.Sentiment))
+	}
+	if exp.Emotion != nil {
+		details = append(details, "*Emotion:* "+slackEscape(*exp.Emotion))
+	}
+	if len(exp.Topics) > 0 {
+		details = append(details, "*Topics:* "+slackEscape(strings.Join(exp.Topics, ", ")))
+	}
+	if exp.UrgencyScore != nil {
+		details = append(details, "*Urgency:* "+strconv.FormatFloat(*exp.UrgencyScore, 'f', 2, 64))
+	}
+	blocks = append(blocks, contextBlock(strings.Join(details, "  •  ")))
+
+	if eventType == EventExperienceUrgent && len(exp.UrgencyReasons) > 0 {
+		blocks = append(blocks, sectionBlock("*Why it's urgent:* "+slackEscape(strings.Join(exp.UrgencyReasons, "; "))))
+	}
+	blocks = append(blocks, contextBlock(fmt.Sprintf("Experience `%s`", exp.ID)))
+	return blocks
+}
+
+// experienceValue returns the value of an experience as text
+func experienceValue(exp slackExperience) string {
+	switch {
+	case exp.ValueText != nil:
+		return *exp.ValueText
+	case exp.ValueNumber != nil:
+		return strconv.FormatFloat(*exp.ValueNumber, 'f', -1, 64)
+	case exp.ValueBoolean != nil:
+		return strconv.FormatBool(*exp.ValueBoolean)
+	}
+	return "(no value)"
+}
+
+// alertTitle names the metric and direction of an alert
+func alertTitle(alert slackAlert) string {
+	icon, change := ":chart_with_upwards_trend:", "spike"
+	if alert.Direction == "drop" {
+		icon, change = ":chart_with_downwards_trend:", "drop"
+	}
+	if alert.Metric == "negative_share" {
+		return icon + " Negative sentiment " + change
+	}
+	return icon + " Feedback volume " + change
+}
+
+// alertSummary compares the value of the alert's window with the baseline
+func alertSummary(alert slackAlert) string {
+	source := alert.SourceType
+	if alert.SourceID != "" {
+		source += " / " + alert.SourceID
+	}
+	source = slackEscape(source)
+	window := fmt.Sprintf("%s to %s UTC", alert.WindowStart.UTC().Format("Jan 2 15:04"), alert.WindowEnd.UTC().Format("Jan 2 15:04"))
+	deviation := strconv.FormatFloat(math.Abs(alert.Deviation), 'f', 1, 64)
+	if alert.Metric == "negative_share" {
+		return fmt.Sprintf("*%s*: %s of %d experiences were negative from %s, compared with %s usually (%s standard deviations).",
+			source, percent(alert.Value), alert.Responses, window, percent(alert.Baseline), deviation)
+	}
+	return fmt.Sprintf("*%s*: %d experiences from %s, compared with %s usually (%s standard deviations).",
+		source, int(alert.Value), window, strconv.FormatFloat(alert.Baseline, 'f', 1, 64), deviation)
+}
+
+// alertBlocks describes an alert and quotes a few of its sample experiences
+func alertBlocks(alert slackAlert, summary string) []slackBlock {
+	blocks := []slackBlock{sectionBlock(summary)}
+	for i, sample := range alert.Samples {
+		if i == slackMaxSamples {
+			break
+		}
+		blocks = append(blocks, sectionBlock(quote(truncate(experienceValue(sample), slackMaxText/slackMaxSamples))))
+	}
+	return blocks
+}
+
+// healthMessage describes a webhook endpoint that was disabled or recovered
+func healthMessage(eventType EventType, health EndpointHealth) (title, summary string) {
+	if eventType == EventWebhookRecovered {
+		return ":white_check_mark: Webhook endpoint recovered", fmt.Sprintf("%s receives events again.", slackEscape(health.URL))
+	}
+	summary = fmt.Sprintf("%s failed %d consecutive deliveries and is disabled.", slackEscape(health.URL), health.ConsecutiveFailures)
+	if health.RetryAt != nil {
+		summary += " It is retried at " + health.RetryAt.UTC().Format("15:04 UTC") + "."
+	}
+	return ":warning: Webhook endpoint disabled", summary
+}
+
+// sourceOf returns the name of a source, or its type if it has no name
+func sourceOf(sourceType string, name *string) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	return sourceType
+}
+
+// percent formats a share as a percentage
+func percent(share float64) string {
+	return strconv.FormatFloat(share*100, 'f', 0, 64) + "%"
+}
+
+// sectionBlock returns a section with Markdown text
+func sectionBlock(text string) slackBlock {
+	return slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: text}}
+}
+
+// contextBlock returns a context line with Markdown text
+func contextBlock(text string) slackBlock {
+	return slackBlock{Type: "context", Elements: []slackText{{Type: "mrkdwn", Text: text}}}
+}
+
+// quote escapes text and formats it as a block quote
+func quote(text string) string {
+	return "> " + strings.ReplaceAll(slackEscape(text), "\n", "\n> ")
+}
+
+// slackEscape escapes the characters Slack treats as control characters in messages
+func slackEscape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// truncate shortens text to at most n characters
+func truncate(text string, n int) string {
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	return string([]rune(text)[:n-1]) + "…"
+}
+
+// slackAPIError returns the error of a chat.postMessage response. The Web API responds
+// with status 200 and ok false to requests it rejects.
+func slackAPIError(resp *http.Response) error {
+	var body struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&body); err != nil {
+		return fmt.Errorf("invalid Slack response: %w", err)
+	}
+	if !body.OK {
+		return fmt.Errorf("slack rejected the message: %s", body.Error)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSlackMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType EventType
+		data      any
+		title     string
+		contains  []string
+	}{
+		{
+			name:      "negative enriched feedback",
+			eventType: EventExperienceEnriched,
+			data: map[string]any{
+				"id": "0191b4b8-1a2b-7c3d-8e4f-5a6b7c8d9e0f", "source_type": "survey", "source_name": "Checkout <survey>",
+				"field_id": "q1", "field_label": "What went wrong?", "value_text": "Payment failed\ntwice & I gave up",
+				"sentiment": "negative", "topics": []string{"payments", "checkout"},
+			},
+			title:    ":red_circle: Negative feedback",
+			contains: []string{"> Payment failed\n> twice &amp; I gave up", "*Source:* Checkout &lt;survey&gt;", "*Field:* What went wrong?", "*Topics:* payments, checkout"},
+		},
+		{
+			name:      "urgent feedback",
+			eventType: EventExperienceUrgent,
+			data:      map[string]any{"id": "1", "source_type": "zendesk", "field_id": "comment", "value_text": "Site is down", "urgency_score": 0.93, "urgency_reasons": []string{"outage"}},
+			title:     ":rotating_light: Urgent feedback",
+			contains:  []string{"*Urgency:* 0.93", "*Why it's urgent:* outage"},
+		},
+		{
+			name:      "negative share alert",
+			eventType: EventAlertTriggered,
+			data: map[string]any{
+				"metric": "negative_share", "direction": "spike", "source_type": "app_review", "source_id": "com.example.app",
+				"window_start": "2026-10-16T08:00:00Z", "window_end": "2026-10-16T09:00:00Z",
+				"value": 0.45, "baseline": 0.12, "deviation": 3.24, "responses": 40,
+				"samples": []map[string]any{{"id": "2", "value_text": "Crashes on launch"}},
+			},
+			title:    ":chart_with_upwards_trend: Negative sentiment spike",
+			contains: []string{"*app_review / com.example.app*: 45% of 40 experiences were negative", "12% usually (3.2 standard deviations)", "> Crashes on launch"},
+		},
+		{
+			name:      "disabled endpoint",
+			eventType: EventWebhookDisabled,
+			data:      EndpointHealth{URL: "https://api.example.com/hooks", ConsecutiveFailures: 5},
+			title:     ":warning: Webhook endpoint disabled",
+			contains:  []string{"https://api.example.com/hooks failed 5 consecutive deliveries"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := json.Marshal(NewEvent(tt.eventType, tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := SlackMessage(payload, "C0123456789")
+			if err != nil {
+				t.Fatalf("SlackMessage() error = %v", err)
+			}
+
+			var message slackMessage
+			if err := json.Unmarshal(body, &message); err != nil {
+				t.Fatal(err)
+			}
+			if message.Channel != "C0123456789" || !strings.HasPrefix(message.Text, tt.title+": ") {
+				t.Errorf("unexpected channel or text: %q, %q", message.Channel, message.Text)
+			}
+			if message.Blocks[0].Type != "header" || message.Blocks[0].Text.Text != tt.title {
+				t.Errorf("expected header %q, got %+v", tt.title, message.Blocks[0])
+			}
+			var text strings.Builder
+			for _, block := range message.Blocks[1:] {
+				if block.Text != nil {
+					text.WriteString(block.Text.Text + "\n")
+				}
+				for _, element := range block.Elements {
+					text.WriteString(element.Text + "\n")
+				}
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(text.String(), want) {
+					t.Errorf("expected message to contain %q, got:\n%s", want, text.String())
+				}
+			}
+		})
+	}
+}
+
+func TestDispatcher_Slack(t *testing.T) {
+	type request struct {
+		path, auth, signature string
+		message               slackMessage
+	}
+	received := make(chan request, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message slackMessage
+		_ = json.NewDecoder(r.Body).Decode(&message)
+		received <- request{r.URL.Path, r.Header.Get("Authorization"), r.Header.Get(SignatureHeader), message}
+		if r.URL.Path == "/api/chat.postMessage" {
+			_, _ = w.Write([]byte(`{"ok": true, "channel": "C0123456789", "ts": "1728000000.000100"}`))
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher(nil, newTestLogger())
+	dispatcher.client = server.Client()
+	defer func() { _ = dispatcher.Shutdown(time.Second) }()
+	dispatcher.SetEndpointSource(endpointSourceFunc(func(context.Context) ([]Endpoint, error) {
+		return []Endpoint{
+			{URL: server.URL + "/services/T000/B000/XXXX", Secret: "s3cret", Format: FormatSlack},
+			{URL: server.URL + "/api/chat.postMessage", Format: FormatSlack, SlackToken: "xoxb-token", SlackChannel: "C0123456789"},
+		}, nil
+	}))
+
+	dispatcher.Dispatch(context.Background(), EventExperienceEnriched, map[string]any{"id": "1", "source_type": "survey", "field_id": "q1", "value_text": "Too slow", "sentiment": "negative"})
+
+	for range 2 {
+		select {
+		case r := <-received:
+			if r.signature != "" {
+				t.Errorf("expected Slack messages to be unsigned, got %q", r.signature)
+			}
+			if r.message.Text != ":red_circle: Negative feedback: Too slow" {
+				t.Errorf("unexpected message text %q", r.message.Text)
+			}
+			bot := r.path == "/api/chat.postMessage"
+			if bot && (r.auth != "Bearer xoxb-token" || r.message.Channel != "C0123456789") {
+				t.Errorf("expected the bot token and channel, got %q and %q", r.auth, r.message.Channel)
+			}
+			if !bot && (r.auth != "" || r.message.Channel != "") {
+				t.Errorf("expected no token or channel for incoming webhooks, got %q and %q", r.auth, r.message.Channel)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout waiting for Slack messages")
+		}
+	}
+}
+
+func TestSlackAPIError(t *testing.T) {
+	tests := map[string]bool{
+		`{"ok": true}`: false,
+		`{"ok": false, "error": "channel_not_found"}`: true,
+		`not json`: true,
+	}
+	for body, wantErr := range tests {
+		resp := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+		if err := slackAPIError(resp); (err != nil) != wantErr {
+			t.Errorf("slackAPIError(%s) error = %v, want error %v", body, err, wantErr)
+		}
+	}
+}
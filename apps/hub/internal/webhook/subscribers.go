@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"sync"
+)
+
+// Message is a dispatched event as received by a subscription
+type Message struct {
+	EventType EventType
+	Payload   []byte // JSON-encoded Event
+}
+
+// Subscription receives the events dispatched by this Hub instance, e.g. for WebSocket
+// clients. A subscriber that doesn't keep up is closed rather than slowing down dispatch.
+type Subscription struct {
+	messages chan Message
+	once     sync.Once
+	lagged   bool // Closed because the subscriber fell behind
+	d        *Dispatcher
+}
+
+// Messages returns the channel that receives events. It is closed when the subscription
+// is closed, either by Close or because the subscriber fell behind.
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Lagged reports whether the subscription was closed because the subscriber fell behind
+func (s *Subscription) Lagged() bool {
+	s.d.subscribersMu.Lock()
+	defer s.d.subscribersMu.Unlock()
+	return s.lagged
+}
+
+// Close stops the subscription
+func (s *Subscription) Close() {
+	s.d.subscribersMu.Lock()
+	defer s.d.subscribersMu.Unlock()
+	s.closeLocked()
+}
+
+// closeLocked removes the subscription; the caller holds subscribersMu
+func (s *Subscription) closeLocked() {
+	s.once.Do(func() {
+		delete(s.d.subscribers, s)
+		close(s.messages)
+	})
+}
+
+// Subscribe returns a subscription to all events dispatched from now on. Up to bufferSize
+// events are buffered; a subscriber that falls further behind is closed.
+func (d *Dispatcher) Subscribe(bufferSize int) *Subscription {
+	d.subscribersMu.Lock()
+	defer d.subscribersMu.Unlock()
+
+	s := &Subscription{messages: make(chan Message, bufferSize), d: d}
+	d.subscribers[s] = struct{}{}
+	return s
+}
+
+// hasSubscribers reports whether any subscription is open
+func (d *Dispatcher) hasSubscribers() bool {
+	d.subscribersMu.Lock()
+	defer d.subscribersMu.Unlock()
+	return len(d.subscribers) > 0
+}
+
+// publish sends an event to all subscriptions without blocking
+func (d *Dispatcher) publish(eventType EventType, payload []byte) {
+	d.subscribersMu.Lock()
+	defer d.subscribersMu.Unlock()
+
+	for s := range d.subscribers {
+		select {
+		case s.messages <- Message{EventType: eventType, Payload: payload}:
+		default:
+			d.logger.Warn("event subscriber fell behind, closing subscription",
+				"event", eventType,
+				"buffer_size", cap(s.messages))
+			s.lagged = true
+			s.closeLocked()
+		}
+	}
+}
+
+// closeSubscriptions closes all subscriptions, e.g. on shutdown
+func (d *Dispatcher) closeSubscriptions() {
+	d.subscribersMu.Lock()
+	defer d.subscribersMu.Unlock()
+	for s := range d.subscribers {
+		s.closeLocked()
+	}
+}
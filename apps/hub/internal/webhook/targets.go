@@ -0,0 +1,172 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which isn't publicly routable
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// BlockedTargetError reports a webhook URL that resolves to an address that endpoints
+// managed through the API may not target
+type BlockedTargetError struct {
+	Host string
+	Addr netip.Addr
+}
+
+func (e *BlockedTargetError) Error() string {
+	return fmt.Sprintf("webhook target %s resolves to non-public address %s; add the host to SERVICE_WEBHOOK_ALLOWED_HOSTS to allow it", e.Host, e.Addr)
+}
+
+// TargetPolicy keeps webhook endpoints managed through the API from reaching internal
+// services: their hosts may only resolve to public addresses, unless they are allowed
+// explicitly. Addresses are checked when connecting, so DNS changes after an endpoint was
+// created are covered too.
+type TargetPolicy struct {
+	hosts    []string       // Host names; a leading "*." matches subdomains
+	prefixes []netip.Prefix // Addresses and ranges
+}
+
+// NewTargetPolicy creates a policy that additionally allows the given hosts (e.g.,
+// hooks.internal.example.com or *.internal.example.com), IP addresses, and CIDR ranges
+func NewTargetPolicy(allowed []string) *TargetPolicy {
+	p := &TargetPolicy{}
+	for _, entry := range allowed {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			p.prefixes = append(p.prefixes, prefix.Masked())
+		} else if addr, err := netip.ParseAddr(entry); err == nil {
+			p.prefixes = append(p.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		} else if entry != "" {
+			p.hosts = append(p.hosts, entry)
+		}
+	}
+	return p
+}
+
+// hostAllowed reports whether the host is on the allowlist
+func (p *TargetPolicy) hostAllowed(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, pattern := range p.hosts {
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// addrAllowed reports whether the address is public or on the allowlist
+func (p *TargetPolicy) addrAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range p.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// CheckURL rejects URLs whose host resolves to a blocked address. Hosts that don't resolve
+// are accepted, since they are checked again on every delivery.
+func (p *TargetPolicy) CheckURL(ctx context.Context, rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := parsed.Hostname()
+	if p.hostAllowed(host) {
+		return nil
+	}
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !p.addrAllowed(addr) {
+			return &BlockedTargetError{Host: host, Addr: addr}
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !p.addrAllowed(addr) {
+			return &BlockedTargetError{Host: host, Addr: addr}
+		}
+	}
+	return nil
+}
+
+// client returns an HTTP client that enforces the policy on every connection, including
+// redirects
+func (p *TargetPolicy) client(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Through a proxy, only the proxy's address would be checked when dialing, so endpoints
+	// are always connected to directly
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+		if !p.hostAllowed(host) {
+			// Check the address actually connected to, after DNS resolution
+			dialer.Control = func(_, resolved string, _ syscall.RawConn) error {
+				addrPort, err := netip.ParseAddrPort(resolved)
+				if err != nil {
+					return err
+				}
+				if !p.addrAllowed(addrPort.Addr()) {
+					return &BlockedTargetError{Host: host, Addr: addrPort.Addr().Unmap()}
+				}
+				return nil
+			}
+		}
+		return dialer.DialContext(ctx, network, address)
+	}
+	return &http.Client{Transport: transport, Timeout: timeout}
+}
+
+// SetTargetPolicy restricts the hosts that endpoints of the endpoint source may target.
+// URLs passed to the dispatcher directly are configured by the operator and not restricted.
+func (d *Dispatcher) SetTargetPolicy(policy *TargetPolicy) {
+	d.targets = policy
+	d.restrictedClient = policy.client(defaultHTTPTimeout)
+}
+
+// CheckTarget rejects URLs that the target policy doesn't allow, if one is set
+func (d *Dispatcher) CheckTarget(ctx context.Context, rawURL string) error {
+	if d.targets == nil {
+		return nil
+	}
+	return d.targets.CheckURL(ctx, rawURL)
+}
+
+// clientFor returns the HTTP client used to deliver events to the endpoint
+func (d *Dispatcher) clientFor(endpoint Endpoint) *http.Client {
+	if endpoint.ID != "" && d.restrictedClient != nil {
+		return d.restrictedClient
+	}
+	return d.client
+}
+
+// isBlockedTarget reports whether a delivery failed because the target isn't allowed
+func isBlockedTarget(err error) bool {
+	var blocked *BlockedTargetError
+	return errors.As(err, &blocked)
+}
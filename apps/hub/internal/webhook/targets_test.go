@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTargetPolicy_AddrAllowed(t *testing.T) {
+	policy := NewTargetPolicy([]string{"10.1.0.0/16", "192.168.1.5"})
+
+	tests := map[string]bool{
+		"93.184.216.34":    true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"10.0.0.1":         false,
+		"10.1.2.3":         true, // Allowed range
+		"172.16.0.1":       false,
+		"192.168.1.5":      true, // Allowed address
+		"192.168.1.6":      false,
+		"169.254.169.254":  false, // Cloud metadata
+		"100.64.0.1":       false,
+		"0.0.0.0":          false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"::ffff:127.0.0.1": false,
+	}
+	for addr, want := range tests {
+		if got := policy.addrAllowed(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("addrAllowed(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestTargetPolicy_CheckURL(t *testing.T) {
+	policy := NewTargetPolicy([]string{"*.internal.example.com", "hooks.local"})
+	ctx := context.Background()
+
+	for _, rawURL := range []string{"http://127.0.0.1:8080/hook", "http://[::1]/hook", "http://169.254.169.254/latest", "http://localhost/hook"} {
+		if err := policy.CheckURL(ctx, rawURL); !isBlockedTarget(err) {
+			t.Errorf("CheckURL(%s) = %v, want blocked", rawURL, err)
+		}
+	}
+	for _, rawURL := range []string{"https://93.184.216.34/hook", "http://a.internal.example.com/hook", "http://hooks.local/hook"} {
+		if err := policy.CheckURL(ctx, rawURL); err != nil {
+			t.Errorf("CheckURL(%s) = %v, want allowed", rawURL, err)
+		}
+	}
+}
+
+func TestDispatcher_TargetPolicy(t *testing.T) {
+	received := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher([]string{server.URL + "/configured"}, newTestLogger())
+	dispatcher.SetTargetPolicy(NewTargetPolicy(nil))
+	log := &fakeDeliveryLog{finished: make(chan DeliveryResult, 2)}
+	dispatcher.SetDeliveryLog(log)
+	dispatcher.SetEndpointSource(endpointSourceFunc(func(context.Context) ([]Endpoint, error) {
+		return []Endpoint{{ID: uuid.NewString(), URL: server.URL + "/managed"}}, nil
+	}))
+
+	dispatcher.Dispatch(context.Background(), EventExperienceCreated, map[string]any{"id": uuid.NewString()})
+
+	// The managed endpoint targets loopback and is blocked without retrying
+	result := <-log.finished
+	if result.Succeeded || result.Attempts != 1 || result.StatusCode != 0 {
+		t.Errorf("expected a blocked delivery after one attempt, got %+v", result)
+	}
+
+	// URLs configured by the operator aren't restricted
+	if path := <-received; path != "/configured" {
+		t.Errorf("expected delivery to /configured, got %s", path)
+	}
+}
+
+func TestTargetPolicy_IgnoresProxy(t *testing.T) {
+	proxied := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+	t.Setenv("HTTP_PROXY", proxy.URL)
+	t.Setenv("http_proxy", proxy.URL)
+	t.Setenv("NO_PROXY", "")
+
+	// The proxy is on an allowed address, the endpoint on a private one
+	client := NewTargetPolicy([]string{"127.0.0.1/32"}).client(5 * time.Second)
+	if transport := client.Transport.(*http.Transport); transport.Proxy != nil {
+		t.Fatal("expected the restricted client not to use a proxy")
+	}
+
+	resp, err := client.Get("http://10.255.255.1/hook")
+	if err == nil {
+		_ = resp.Body.Close()
+	}
+	if !isBlockedTarget(err) {
+		t.Errorf("expected the private endpoint to be blocked, got %v", err)
+	}
+	select {
+	case target := <-proxied:
+		t.Errorf("expected no request through the proxy, got one for %s", target)
+	default:
+	}
+}
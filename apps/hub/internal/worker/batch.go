@@ -0,0 +1,158 @@
+package worker
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/usage"
+)
+
+// canBatch reports whether an enrichment job may be analyzed together with other jobs
+func (e *Enricher) canBatch(job *queue.EnrichmentJob) bool {
+	svc := e.enrichmentSvc.Load()
+	return e.batchSize > 1 &&
+		svc != nil &&
+		svc.SupportsBatch() &&
+		utf8.RuneCountInString(job.Text) <= enrichment.MaxBatchTextLength
+}
+
+// processEnrichmentBatch claims more short enrichment jobs and analyzes them together with
+// the given job in a single request. Jobs the batch request couldn't handle are enriched
+// individually.
+func (e *Enricher) processEnrichmentBatch(ctx context.Context, workerID int, first *queue.EnrichmentJob) {
+	jobs := []*queue.EnrichmentJob{first}
+	more, err := e.queue.DequeueBatch(ctx, queue.JobTypeEnrichment, e.batchSize-1, enrichment.MaxBatchTextLength)
+	if err != nil {
+		// Jobs claimed before the error are still processed below
+		e.logger.Error("failed to dequeue enrichment batch",
+			"worker_id", workerID,
+			"error", err)
+	}
+	jobs = append(jobs, more...)
+
+	// The first job is tracked by the worker loop
+	e.track(workerID, more...)
+	defer e.untrack(more...)
+
+	// Identical text is served from the cache without taking up room in the request
+	pending := make([]*queue.EnrichmentJob, 0, len(jobs))
+	for _, job := range jobs {
+		hash := textHash(job.Text)
+		if cached := e.cachedEnrichment(ctx, job, hash); cached != nil {
+			e.logger.Debug("reusing cached enrichment",
+				"worker_id", workerID,
+				"job_id", job.ID)
+			e.saveEnrichment(ctx, workerID, job, cached, hash)
+			continue
+		}
+		pending = append(pending, job)
+	}
+
+	if len(pending) <= 1 {
+		for _, job := range pending {
+			e.processEnrichmentJob(ctx, workerID, job)
+		}
+		return
+	}
+
+	e.logger.Info("processing enrichment batch",
+		"worker_id", workerID,
+		"jobs", len(pending))
+
+	texts := make([]string, len(pending))
+	for i, job := range pending {
+		texts[i] = job.Text
+	}
+
+	result, err := e.enrichmentSvc.Load().EnrichTexts(ctx, texts)
+	if err != nil {
+		e.failBatch(ctx, workerID, pending, err)
+		return
+	}
+
+	// Tokens were spent even if saving the results fails below
+	e.recordBatchUsage(ctx, pending, result)
+
+	for i, job := range pending {
+		if result.Results[i] == nil {
+			e.logger.Debug("no batch result for job, enriching individually",
+				"worker_id", workerID,
+				"job_id", job.ID)
+			e.processEnrichmentJob(ctx, workerID, job)
+			continue
+		}
+		e.saveEnrichment(ctx, workerID, job, result.Results[i], textHash(job.Text))
+	}
+}
+
+// failBatch handles a failed batch request. Rate-limited jobs are requeued with a single
+// backoff for the whole batch; on any other error the jobs are enriched individually,
+// since a malformed batch response doesn't mean the texts can't be analyzed one by one.
+func (e *Enricher) failBatch(ctx context.Context, workerID int, jobs []*queue.EnrichmentJob, batchErr error) {
+	e.logger.Warn("batch enrichment failed",
+		"worker_id", workerID,
+		"jobs", len(jobs),
+		"error", batchErr)
+
+	if _, ok := ai.IsRateLimitError(batchErr); !ok {
+		for _, job := range jobs {
+			e.processEnrichmentJob(ctx, workerID, job)
+		}
+		return
+	}
+
+	var requeued bool
+	var retryAfter time.Duration
+	for _, job := range jobs {
+		// Keep topics populated even though the AI call failed
+		e.applyFallbackTopics(ctx, workerID, job)
+
+		if rlErr := e.requeueRateLimited(ctx, workerID, job, batchErr); rlErr != nil {
+			requeued = true
+			retryAfter = max(retryAfter, rlErr.RetryAfter)
+			continue
+		}
+		if err := e.queue.MarkFailed(ctx, job, batchErr); err != nil {
+			e.logger.Error("failed to mark job as failed",
+				"job_id", job.ID,
+				"error", err)
+		}
+	}
+
+	if requeued {
+		e.backoff(ctx, retryAfter)
+	}
+}
+
+// recordBatchUsage stores the usage of a batch request, split across the jobs that received
+// a result. Failures are logged but never fail the jobs.
+func (e *Enricher) recordBatchUsage(ctx context.Context, jobs []*queue.EnrichmentJob, result *enrichment.BatchResult) {
+	jobIDs := make([]uuid.UUID, 0, len(jobs))
+	for i, job := range jobs {
+		if result.Results[i] == nil {
+			continue
+		}
+		if jobID, err := uuid.Parse(job.ID); err == nil {
+			jobIDs = append(jobIDs, jobID)
+		}
+	}
+
+	entry := usage.Entry{
+		JobType:  usage.JobTypeEnrichment,
+		Provider: result.Provider,
+		Model:    result.Model,
+		Usage:    result.Usage,
+	}
+	if _, err := e.usage.RecordBatch(ctx, jobIDs, entry); err != nil {
+		e.logger.Warn("failed to record AI usage",
+			"jobs", len(jobs),
+			"job_type", usage.JobTypeEnrichment,
+			"error", err)
+	}
+}
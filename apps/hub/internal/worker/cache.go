@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+// textHash returns the SHA-256 hex digest of the text sent to AI providers
+func textHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// cachedEnrichment returns the enrichment of another experience with identical AI input
+// text, produced by the current prompt version and a configured model. Returns nil if
+// there is none, in which case the text has to be sent to the AI provider.
+func (e *Enricher) cachedEnrichment(ctx context.Context, job *queue.EnrichmentJob, hash string) *enrichment.Enrichment {
+	expID, err := uuid.Parse(job.ExperienceID)
+	if err != nil {
+		return nil
+	}
+
+	exp, err := e.db.ExperienceData.Query().
+		Where(
+			experiencedata.AiInputHash(hash),
+			experiencedata.IDNEQ(expID),
+			experiencedata.SentimentNotNil(),
+			experiencedata.EnrichmentVersion(enrichment.PromptVersion),
+			experiencedata.EnrichmentModelIn(e.enrichmentSvc.Load().Models()...),
+		).
+		Order(ent.Desc(experiencedata.FieldUpdatedAt)).
+		First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			e.logger.Warn("enrichment cache lookup failed", "job_id", job.ID, "error", err)
+		}
+		return nil
+	}
+
+	return &enrichment.Enrichment{
+		Sentiment:      deref(exp.Sentiment),
+		SentimentScore: deref(exp.SentimentScore),
+		Emotion:        deref(exp.Emotion),
+		Topics:         exp.Topics,
+		IsSpam:         deref(exp.IsSpam),
+		SpamConfidence: deref(exp.SpamConfidence),
+		UrgencyScore:   deref(exp.UrgencyScore),
+		UrgencyReasons: exp.UrgencyReasons,
+		Attributes:     exp.EnrichmentAttributes,
+		Provider:       deref(exp.EnrichmentProvider),
+		Model:          deref(exp.EnrichmentModel),
+	}
+}
+
+// cachedEmbedding returns the embedding of another experience with identical AI input
+// text, produced by the current embedding model, or nil if there is none
+func (e *Enricher) cachedEmbedding(ctx context.Context, job *queue.EnrichmentJob, hash string) *pgvector.Vector {
+	expID, err := uuid.Parse(job.ExperienceID)
+	if err != nil {
+		return nil
+	}
+
+	exp, err := e.db.ExperienceData.Query().
+		Where(
+			experiencedata.AiInputHash(hash),
+			experiencedata.IDNEQ(expID),
+			experiencedata.EmbeddingNotNil(),
+			experiencedata.EmbeddingModel(e.embeddingSvc.Model()),
+		).
+		Order(ent.Desc(experiencedata.FieldUpdatedAt)).
+		First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			e.logger.Warn("embedding cache lookup failed", "job_id", job.ID, "error", err)
+		}
+		return nil
+	}
+
+	return exp.Embedding
+}
+
+// deref returns the value of a pointer, or the zero value if it is nil
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/embedding"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/testdb"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// countingChat answers every prompt with the same enrichment and counts how often it was called
+type countingChat struct {
+	calls int
+}
+
+func (p *countingChat) Name() string  { return "test" }
+func (p *countingChat) Model() string { return "test-chat" }
+
+func (p *countingChat) Complete(_ context.Context, _ string) (*ai.Completion, error) {
+	p.calls++
+	return &ai.Completion{Content: `{"sentiment":"negative","sentiment_score":-0.6,"emotion":"frustration","topics":["exports"]}`}, nil
+}
+
+func TestIdenticalTextReuse(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	chat := &countingChat{}
+	embedder := &countingEmbedder{}
+	e := NewEnricher(&recordingQueue{outcomes: map[string]string{}},
+		enrichment.NewService([]ai.ChatProvider{chat}, 5, logger),
+		embedding.NewService(embedder, 5, logger),
+		client, webhook.NewDispatcher(nil, logger), nil, 0.7, 1, logger)
+
+	const label = "How can we improve?"
+	job := func(text string) *queue.EnrichmentJob {
+		t.Helper()
+		exp := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID("q1").
+			SetFieldType("text").
+			SetFieldLabel(label).
+			SetValueText(text).
+			SaveX(ctx)
+		return &queue.EnrichmentJob{ID: exp.ID.String(), ExperienceID: exp.ID.String(), Text: embedding.BuildEmbeddingText(label, text)}
+	}
+	first, duplicate, other := job("The exports keep timing out"), job("The exports keep timing out"), job("Love the new dashboard")
+
+	for _, j := range []*queue.EnrichmentJob{first, duplicate, other} {
+		e.processEnrichmentJob(ctx, 1, j)
+		if err := e.handleEmbedding(ctx, j); err != nil {
+			t.Fatalf("handleEmbedding() error = %v", err)
+		}
+	}
+
+	// The duplicate reuses the results of the first experience instead of new requests
+	if chat.calls != 2 || embedder.calls != 2 {
+		t.Errorf("got %d enrichment and %d embedding requests, want 2 each", chat.calls, embedder.calls)
+	}
+	for _, j := range []*queue.EnrichmentJob{first, duplicate, other} {
+		exp := client.ExperienceData.GetX(ctx, uuid.MustParse(j.ExperienceID))
+		if exp.Sentiment == nil || *exp.Sentiment != "negative" || exp.Embedding == nil || exp.AiInputHash == nil || *exp.AiInputHash != textHash(j.Text) {
+			t.Errorf("experience %s wasn't enriched and embedded: sentiment %v, hash %v", j.ExperienceID, exp.Sentiment, exp.AiInputHash)
+		}
+	}
+}
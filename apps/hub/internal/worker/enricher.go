@@ -1,35 +1,82 @@
 // Package worker provides background job processing for AI enrichment and embedding generation.
-// The Enricher polls the job queue and processes jobs concurrently using a configurable
-// number of worker goroutines.
+// The Enricher polls the job queue and processes jobs concurrently using pools of worker
+// goroutines, either one pool for all job types or a pool per job type. Queues that notify
+// on enqueue wake workers right away, with polling as a fallback.
 package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
 	"github.com/formbricks/hub/apps/hub/internal/embedding"
 	"github.com/formbricks/hub/apps/hub/internal/enrichment"
 	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
 	"github.com/formbricks/hub/apps/hub/internal/models"
 	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/usage"
 	"github.com/formbricks/hub/apps/hub/internal/webhook"
 	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
+
+const (
+	// maxRateLimitRequeues is how often a rate-limited job is put back in the queue before
+	// it is marked failed
+	maxRateLimitRequeues = 10
+	// defaultRateLimitBackoff is how long a worker pauses when the provider doesn't say
+	defaultRateLimitBackoff = time.Second
+	// maxRateLimitBackoff caps how long a worker pauses after a rate-limited job
+	maxRateLimitBackoff = time.Minute
+	// cancelGracePeriod is how long cancelled jobs get to unwind on shutdown before they
+	// are returned to the queue
+	cancelGracePeriod = 5 * time.Second
+	// reclaimInterval is how often processing jobs with an expired lease are returned to the queue
+	reclaimInterval = time.Minute
 )
 
+// errSuperseded means the experience was updated or deleted after the job was enqueued, so
+// the result of the job describes text the experience no longer has
+var errSuperseded = errors.New("experience text changed since the job was enqueued")
+
 // Enricher processes enrichment and embedding jobs from the queue
 type Enricher struct {
-	queue         queue.Queue
-	enrichmentSvc *enrichment.Service
-	embeddingSvc  *embedding.Service
-	db            *ent.Client
-	dispatcher    *webhook.Dispatcher
-	workers       int
-	pollInterval  time.Duration
-	logger        *slog.Logger
-	stopChan      chan struct{}
-	doneChan      chan struct{}
+	queue           queue.Queue
+	enrichmentSvc   atomic.Pointer[enrichment.Service] // Swapped when the configuration is reloaded
+	embeddingSvc    *embedding.Service
+	db              *ent.Client
+	dispatcher      *webhook.Dispatcher
+	pools           []*pool
+	handlers        map[queue.JobType]jobFunc
+	workerIDs       atomic.Int64 // Last assigned worker ID; IDs are unique across pools
+	urgentThreshold float64
+	batchSize       int
+	usage           *usage.Recorder
+	logger          *slog.Logger
+	stopChan        chan struct{}
+	doneChan        chan struct{}
+
+	// Shutdown draining
+	workersWG  sync.WaitGroup
+	cancelJobs context.CancelFunc
+
+	// Worker status reporting
+	instance string
+	mu       sync.Mutex             // Guards inFlight and workers
+	inFlight map[string]inFlightJob // Jobs being processed, by ID
+	workers  map[int]*workerStatus  // Running workers, by worker ID
+
+	notifier queue.Notifier             // Set if the queue notifies on enqueue
+	pauses   atomic.Pointer[pauseState] // Paused job types, reloaded periodically
 }
 
 // NewEnricher creates a new Enricher worker pool
@@ -39,35 +86,85 @@ func NewEnricher(
 	embeddingService *embedding.Service,
 	db *ent.Client,
 	dispatcher *webhook.Dispatcher,
-	workers int,
-	pollInterval time.Duration,
+	pools []Pool,
+	urgentThreshold float64,
+	batchSize int,
 	logger *slog.Logger,
 ) *Enricher {
-	return &Enricher{
-		queue:         q,
-		enrichmentSvc: enrichmentService,
-		embeddingSvc:  embeddingService,
-		db:            db,
-		dispatcher:    dispatcher,
-		workers:       workers,
-		pollInterval:  pollInterval,
-		logger:        logger,
-		stopChan:      make(chan struct{}),
-		doneChan:      make(chan struct{}),
+	e := &Enricher{
+		embeddingSvc:    embeddingService,
+		db:              db,
+		dispatcher:      dispatcher,
+		pools:           newPools(pools),
+		urgentThreshold: urgentThreshold,
+		batchSize:       batchSize,
+		usage:           usage.NewRecorder(db, logger),
+		logger:          logger,
+		stopChan:        make(chan struct{}),
+		doneChan:        make(chan struct{}),
+		cancelJobs:      func() {},
+		instance:        instanceName(),
+		inFlight:        make(map[string]inFlightJob),
+		workers:         make(map[int]*workerStatus),
+	}
+	if enrichmentService != nil {
+		e.enrichmentSvc.Store(enrichmentService)
+	}
+	e.queue = &reportingQueue{Queue: q, e: e}
+	e.notifier, _ = q.(queue.Notifier)
+
+	e.handlers = map[queue.JobType]jobFunc{
+		queue.JobTypeEnrichment: e.processEnrichment,
+		queue.JobTypeEmbedding:  e.runHandler(queue.JobTypeEmbedding, HandlerFunc(e.handleEmbedding)),
 	}
+
+	return e
+}
+
+// SetEnrichmentService replaces the service that enriches jobs, e.g. after the enrichment
+// models were reloaded. Jobs being enriched finish with the previous service.
+func (e *Enricher) SetEnrichmentService(svc *enrichment.Service) {
+	e.enrichmentSvc.Store(svc)
 }
 
-// Start begins processing jobs from the queue with the configured number of workers
+// Start begins processing jobs from the queue with the configured worker pools
 func (e *Enricher) Start(ctx context.Context) {
-	e.logger.Info("starting enrichment worker pool",
-		"workers", e.workers,
-		"poll_interval", e.pollInterval)
+	// Jobs keep running after Stop until they finish or the shutdown timeout cancels them
+	ctx, e.cancelJobs = context.WithCancel(ctx)
+
+	// Honor pauses right away, e.g. when restarting during an incident
+	e.refreshPauses(ctx)
+	go e.watchPauses(ctx)
+
+	for _, p := range e.pools {
+		e.logger.Info("starting enrichment worker pool",
+			"job_type", p.jobTypeName(),
+			"workers", p.minWorkers,
+			"max_workers", p.maxWorkers,
+			"poll_interval", p.pollInterval,
+			"batch_size", e.batchSize)
+
+		for i := 0; i < p.minWorkers; i++ {
+			e.startWorker(ctx, p)
+		}
 
-	// Start worker goroutines
-	for i := 0; i < e.workers; i++ {
-		go e.worker(ctx, i+1)
+		// Grow and shrink the pool with the queue depth
+		if p.autoscaled() {
+			go e.autoscale(ctx, p)
+		}
 	}
 
+	// Return jobs stranded by crashed workers to the queue
+	go e.reclaimer(ctx)
+
+	// Wake workers when jobs are enqueued instead of waiting for the next poll
+	if e.notifier != nil {
+		go e.relayNotifications(ctx, e.notifier.Notifications())
+	}
+
+	// Report worker status for GET /v1/workers
+	go e.heartbeat(ctx)
+
 	// Wait for context cancellation or stop signal
 	select {
 	case <-ctx.Done():
@@ -79,62 +176,85 @@ func (e *Enricher) Start(ctx context.Context) {
 	close(e.doneChan)
 }
 
-// Stop gracefully stops all workers
-func (e *Enricher) Stop() {
+// Stop gracefully stops all workers. Workers stop taking new jobs right away, and Stop
+// waits up to timeout for in-flight jobs to finish. Jobs still running after that are
+// cancelled and returned to the queue, so they don't wait for their lease to expire.
+func (e *Enricher) Stop(timeout time.Duration) {
 	close(e.stopChan)
 	<-e.doneChan
+
+	if e.waitForWorkers(timeout) {
+		e.logger.Info("in-flight jobs finished")
+		return
+	}
+
+	jobs := e.inFlightJobs()
+	e.logger.Warn("shutdown timeout reached, returning in-flight jobs to the queue",
+		"count", len(jobs))
+	e.cancelJobs()
+	e.waitForWorkers(cancelGracePeriod)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cancelGracePeriod)
+	defer cancel()
+	for _, job := range jobs {
+		if err := e.queue.Requeue(ctx, job); err != nil {
+			e.logger.Error("failed to return job to the queue",
+				"job_id", job.ID,
+				"error", err)
+		}
+	}
 }
 
-// worker is a single worker goroutine that polls for and processes jobs
-func (e *Enricher) worker(ctx context.Context, workerID int) {
-	ticker := time.NewTicker(e.pollInterval)
-	defer ticker.Stop()
+// waitForWorkers waits up to timeout for all workers to exit and reports whether they did
+func (e *Enricher) waitForWorkers(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		e.workersWG.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
 
-	e.logger.Debug("worker started", "worker_id", workerID)
+// reclaimer periodically returns processing jobs with an expired lease to the queue
+func (e *Enricher) reclaimer(ctx context.Context) {
+	ticker := time.NewTicker(reclaimInterval)
+	defer ticker.Stop()
 
 	for {
+		// Reclaim right away so jobs stranded by a previous crash don't wait a full interval
+		count, err := e.queue.ReclaimExpired(ctx)
+		if err != nil {
+			e.logger.Error("failed to reclaim expired jobs", "error", err)
+		} else if count > 0 {
+			e.logger.Warn("reclaimed jobs with an expired lease", "count", count)
+		}
+
 		select {
 		case <-ctx.Done():
-			e.logger.Debug("worker stopping", "worker_id", workerID)
 			return
 		case <-e.stopChan:
-			e.logger.Debug("worker stopping", "worker_id", workerID)
 			return
 		case <-ticker.C:
-			// Poll for a job
-			job, err := e.queue.Dequeue(ctx)
-			if err != nil {
-				e.logger.Error("failed to dequeue job",
-					"worker_id", workerID,
-					"error", err)
-				continue
-			}
-
-			// No jobs available
-			if job == nil {
-				continue
-			}
-
-			// Process the job
-			e.processJob(ctx, workerID, job)
 		}
 	}
 }
 
-// processJob handles processing for a single job (enrichment or embedding)
-func (e *Enricher) processJob(ctx context.Context, workerID int, job *queue.EnrichmentJob) {
-	switch job.JobType {
-	case queue.JobTypeEnrichment:
-		e.processEnrichmentJob(ctx, workerID, job)
-	case queue.JobTypeEmbedding:
-		e.processEmbeddingJob(ctx, workerID, job)
-	default:
-		e.logger.Error("unknown job type",
-			"worker_id", workerID,
-			"job_id", job.ID,
-			"job_type", job.JobType)
-		_ = e.queue.MarkFailed(ctx, job.ID, fmt.Errorf("unknown job type: %s", job.JobType))
+// processEnrichment handles an enrichment job, together with other short pending texts if
+// batching is enabled
+func (e *Enricher) processEnrichment(ctx context.Context, workerID int, job *queue.EnrichmentJob) {
+	if e.canBatch(job) {
+		e.processEnrichmentBatch(ctx, workerID, job)
+		return
 	}
+	e.processEnrichmentJob(ctx, workerID, job)
 }
 
 // processEnrichmentJob handles sentiment/emotion/topics enrichment
@@ -144,58 +264,74 @@ func (e *Enricher) processEnrichmentJob(ctx context.Context, workerID int, job *
 		"job_id", job.ID,
 		"experience_id", job.ExperienceID)
 
-	// Skip if enrichment service is not available
-	if e.enrichmentSvc == nil {
-		e.logger.Warn("enrichment service not configured, skipping job",
+	// Fall back to local keyword extraction if enrichment service is not available
+	svc := e.enrichmentSvc.Load()
+	if svc == nil {
+		e.logger.Debug("enrichment service not configured, using keyword fallback",
 			"worker_id", workerID,
 			"job_id", job.ID)
-		// Mark as complete since there's no work to do
-		_ = e.queue.MarkComplete(ctx, job.ID)
+		e.applyFallbackTopics(ctx, workerID, job)
+		// Mark as complete since there's no AI work to do
+		_ = e.queue.MarkComplete(ctx, job)
 		return
 	}
 
-	// Enrich the text
-	result, err := e.enrichmentSvc.EnrichText(ctx, job.Text)
-	if err != nil {
-		e.logger.Warn("enrichment failed",
-			"worker_id", workerID,
-			"job_id", job.ID,
-			"error", err)
+	hash := textHash(job.Text)
 
-		// Mark job as failed
-		if markErr := e.queue.MarkFailed(ctx, job.ID, err); markErr != nil {
-			e.logger.Error("failed to mark job as failed",
+	// Reuse the result for identical text instead of paying for a new API call
+	result := e.cachedEnrichment(ctx, job, hash)
+	if result != nil {
+		e.logger.Debug("reusing cached enrichment",
+			"worker_id", workerID,
+			"job_id", job.ID)
+	} else {
+		// Enrich the text
+		var err error
+		result, err = svc.EnrichText(ctx, job.Text)
+		if err != nil {
+			e.logger.Warn("enrichment failed",
+				"worker_id", workerID,
 				"job_id", job.ID,
-				"error", markErr)
+				"error", err)
+
+			// Keep topics populated even though the AI call failed
+			e.applyFallbackTopics(ctx, workerID, job)
+
+			e.failOrRequeue(ctx, workerID, job, err)
+			return
 		}
-		return
+
+		// Tokens were spent even if saving the result fails below
+		e.recordUsage(ctx, job, usage.JobTypeEnrichment, result.Provider, result.Model, result.Usage)
 	}
 
-	// Update experience with enrichment results
+	e.saveEnrichment(ctx, workerID, job, result, hash)
+}
+
+// saveEnrichment stores the enrichment results on the experience, dispatches the
+// enrichment webhooks, and marks the job as complete
+func (e *Enricher) saveEnrichment(ctx context.Context, workerID int, job *queue.EnrichmentJob, result *enrichment.Enrichment, hash string) {
 	expID, err := uuid.Parse(job.ExperienceID)
 	if err != nil {
 		e.logger.Error("invalid experience ID",
 			"experience_id", job.ExperienceID,
 			"error", err)
-		_ = e.queue.MarkFailed(ctx, job.ID, err)
+		_ = e.queue.MarkDeadLetter(ctx, job, err)
 		return
 	}
 
-	err = e.db.ExperienceData.
-		UpdateOneID(expID).
-		SetSentiment(result.Sentiment).
-		SetSentimentScore(result.SentimentScore).
-		SetEmotion(result.Emotion).
-		SetTopics(result.Topics).
-		Exec(ctx)
-
+	err = e.storeEnrichment(ctx, expID, job, result, hash)
+	if errors.Is(err, errSuperseded) {
+		e.discardSuperseded(ctx, workerID, job)
+		return
+	}
 	if err != nil {
 		e.logger.Error("failed to update experience with enrichment",
 			"worker_id", workerID,
 			"experience_id", job.ExperienceID,
 			"error", err)
 
-		if markErr := e.queue.MarkFailed(ctx, job.ID, err); markErr != nil {
+		if markErr := e.queue.MarkFailed(ctx, job, err); markErr != nil {
 			e.logger.Error("failed to mark job as failed",
 				"job_id", job.ID,
 				"error", markErr)
@@ -211,7 +347,7 @@ func (e *Enricher) processEnrichmentJob(ctx context.Context, workerID int, job *
 			"experience_id", job.ExperienceID,
 			"error", err)
 		// Still mark job as complete since enrichment was saved
-		_ = e.queue.MarkComplete(ctx, job.ID)
+		_ = e.queue.MarkComplete(ctx, job)
 		return
 	}
 
@@ -219,10 +355,16 @@ func (e *Enricher) processEnrichmentJob(ctx context.Context, workerID int, job *
 	enrichedModel := models.FromEnt(enrichedExp)
 
 	// Dispatch experience.enriched webhook
-	e.dispatcher.DispatchAsync(webhook.EventExperienceEnriched, enrichedModel)
+	e.dispatcher.DispatchAsync(ctx, webhook.EventExperienceEnriched, enrichedModel)
+
+	// Hot feedback gets a dedicated event so support tooling can route it immediately.
+	// Spam is never escalated, regardless of how alarming its wording is.
+	if result.UrgencyScore >= e.urgentThreshold && !result.IsSpam {
+		e.dispatcher.DispatchAsync(ctx, webhook.EventExperienceUrgent, enrichedModel)
+	}
 
 	// Mark job as complete
-	if err := e.queue.MarkComplete(ctx, job.ID); err != nil {
+	if err := e.queue.MarkComplete(ctx, job); err != nil {
 		e.logger.Error("failed to mark job as complete",
 			"job_id", job.ID,
 			"error", err)
@@ -233,83 +375,272 @@ func (e *Enricher) processEnrichmentJob(ctx context.Context, workerID int, job *
 		"worker_id", workerID,
 		"job_id", job.ID,
 		"experience_id", job.ExperienceID,
-		"sentiment", result.Sentiment)
+		"sentiment", result.Sentiment,
+		"is_spam", result.IsSpam,
+		"urgency_score", result.UrgencyScore)
 }
 
-// processEmbeddingJob handles vector embedding generation
-func (e *Enricher) processEmbeddingJob(ctx context.Context, workerID int, job *queue.EnrichmentJob) {
-	e.logger.Info("processing embedding job",
-		"worker_id", workerID,
-		"job_id", job.ID,
-		"experience_id", job.ExperienceID)
+// storeEnrichment stores the enrichment results on the experience, unless its text changed
+// since the job was enqueued, in which case it returns errSuperseded
+func (e *Enricher) storeEnrichment(ctx context.Context, expID uuid.UUID, job *queue.EnrichmentJob, result *enrichment.Enrichment, hash string) error {
+	valueText, err := e.currentValueText(ctx, expID, job)
+	if err != nil {
+		return err
+	}
 
-	// Skip if embedding service is not available
-	if e.embeddingSvc == nil {
-		e.logger.Warn("embedding service not configured, skipping job",
-			"worker_id", workerID,
-			"job_id", job.ID)
-		// Mark as complete since there's no work to do
-		_ = e.queue.MarkComplete(ctx, job.ID)
-		return
+	// The guard on the text drops the result if the experience is updated in the meantime
+	update := e.db.ExperienceData.
+		Update().
+		Where(experiencedata.ID(expID), experiencedata.ValueText(valueText)).
+		SetSentiment(result.Sentiment).
+		SetSentimentScore(result.SentimentScore).
+		SetEmotion(result.Emotion).
+		SetTopics(result.Topics).
+		SetIsSpam(result.IsSpam).
+		SetSpamConfidence(result.SpamConfidence).
+		SetUrgencyScore(result.UrgencyScore).
+		SetUrgencyReasons(result.UrgencyReasons).
+		SetEnrichmentProvider(result.Provider).
+		SetEnrichmentModel(result.Model).
+		SetEnrichmentVersion(enrichment.PromptVersion).
+		SetAiInputHash(hash)
+
+	// Only custom enrichers return attributes; don't keep stale ones from a previous enrichment
+	if len(result.Attributes) > 0 {
+		update.SetEnrichmentAttributes(result.Attributes)
+	} else {
+		update.ClearEnrichmentAttributes()
 	}
 
-	// Generate the embedding
-	vector, err := e.embeddingSvc.GenerateEmbedding(ctx, job.Text)
+	updated, err := update.Save(ctx)
 	if err != nil {
-		e.logger.Warn("embedding generation failed",
-			"worker_id", workerID,
+		return err
+	}
+	if updated == 0 {
+		return errSuperseded
+	}
+	return nil
+}
+
+// currentValueText returns the value text of the experience if the job's text was built
+// from it, or errSuperseded if the experience was updated or deleted since the job was
+// enqueued. Field labels don't change, so the text only changes with the value text.
+func (e *Enricher) currentValueText(ctx context.Context, expID uuid.UUID, job *queue.EnrichmentJob) (string, error) {
+	exp, err := e.db.ExperienceData.Query().
+		Where(experiencedata.ID(expID)).
+		Select(experiencedata.FieldFieldLabel, experiencedata.FieldValueText).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return "", errSuperseded
+	}
+	if err != nil {
+		return "", err
+	}
+	if exp.ValueText == nil || embedding.BuildEmbeddingText(exp.FieldLabel, *exp.ValueText) != job.Text {
+		return "", errSuperseded
+	}
+	return *exp.ValueText, nil
+}
+
+// discardSuperseded completes a job without storing its result, because the experience was
+// updated or deleted while the job ran. The update enqueued new jobs for the new text.
+func (e *Enricher) discardSuperseded(ctx context.Context, workerID int, job *queue.EnrichmentJob) {
+	e.logger.Info("discarding result of superseded job",
+		"worker_id", workerID,
+		"job_id", job.ID,
+		"job_type", job.JobType,
+		"experience_id", job.ExperienceID)
+
+	if err := e.queue.MarkComplete(ctx, job); err != nil {
+		e.logger.Error("failed to mark job as complete",
 			"job_id", job.ID,
 			"error", err)
-
-		if markErr := e.queue.MarkFailed(ctx, job.ID, err); markErr != nil {
-			e.logger.Error("failed to mark job as failed",
-				"job_id", job.ID,
-				"error", markErr)
-		}
-		return
 	}
+}
 
-	// Update experience with embedding vector
+// applyFallbackTopics stores locally extracted keywords as topics when the AI provider
+// is unavailable. Existing topics (e.g., from an earlier successful enrichment) are kept.
+func (e *Enricher) applyFallbackTopics(ctx context.Context, workerID int, job *queue.EnrichmentJob) {
 	expID, err := uuid.Parse(job.ExperienceID)
 	if err != nil {
-		e.logger.Error("invalid experience ID",
-			"experience_id", job.ExperienceID,
-			"error", err)
-		_ = e.queue.MarkFailed(ctx, job.ID, err)
+		return
+	}
+
+	topics := enrichment.ExtractKeywords(job.Text, enrichment.MaxTopics)
+	if len(topics) == 0 {
 		return
 	}
 
 	err = e.db.ExperienceData.
-		UpdateOneID(expID).
-		SetEmbedding(vector).
-		SetEmbeddingModel(e.embeddingSvc.Model()).
+		Update().
+		Where(
+			experiencedata.ID(expID),
+			experiencedata.Or(
+				experiencedata.TopicsIsNil(),
+				func(s *sql.Selector) {
+					s.Where(sqljson.LenEQ(experiencedata.FieldTopics, 0))
+				},
+			),
+		).
+		SetTopics(topics).
 		Exec(ctx)
-
 	if err != nil {
-		e.logger.Error("failed to update experience with embedding",
+		e.logger.Error("failed to store fallback topics",
 			"worker_id", workerID,
 			"experience_id", job.ExperienceID,
 			"error", err)
+		return
+	}
 
-		if markErr := e.queue.MarkFailed(ctx, job.ID, err); markErr != nil {
-			e.logger.Error("failed to mark job as failed",
-				"job_id", job.ID,
-				"error", markErr)
+	e.logger.Debug("stored fallback topics",
+		"worker_id", workerID,
+		"experience_id", job.ExperienceID,
+		"topics", topics)
+}
+
+// handleEmbedding generates the vector embedding of the job's text
+func (e *Enricher) handleEmbedding(ctx context.Context, job *queue.EnrichmentJob) error {
+	// Skip if embedding service is not available
+	if e.embeddingSvc == nil {
+		e.logger.Warn("embedding service not configured, skipping job",
+			"job_id", job.ID)
+		// Complete the job since there's no work to do
+		return nil
+	}
+
+	expID, err := uuid.Parse(job.ExperienceID)
+	if err != nil {
+		return Permanent(fmt.Errorf("invalid experience ID: %w", err))
+	}
+
+	// Don't pay for the embedding of text the experience no longer has
+	valueText, err := e.currentValueText(ctx, expID, job)
+	if errors.Is(err, errSuperseded) {
+		e.logger.Info("skipping superseded embedding job",
+			"job_id", job.ID,
+			"experience_id", job.ExperienceID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load experience: %w", err)
+	}
+
+	hash := textHash(job.Text)
+
+	// Reuse the vector for identical text instead of paying for a new API call
+	var vector pgvector.Vector
+	if cached := e.cachedEmbedding(ctx, job, hash); cached != nil {
+		e.logger.Debug("reusing cached embedding",
+			"job_id", job.ID)
+		vector = *cached
+	} else {
+		// Generate the embedding
+		var tokenUsage ai.Usage
+		vector, tokenUsage, err = e.embeddingSvc.GenerateEmbedding(ctx, job.Text)
+		if err != nil {
+			return err
 		}
-		return
+
+		e.recordUsage(ctx, job, usage.JobTypeEmbedding, e.embeddingSvc.Provider(), e.embeddingSvc.Model(), tokenUsage)
 	}
 
-	// Mark job as complete
-	if err := e.queue.MarkComplete(ctx, job.ID); err != nil {
-		e.logger.Error("failed to mark job as complete",
+	// Update experience with embedding vector, unless its text changed in the meantime
+	updated, err := e.db.ExperienceData.
+		Update().
+		Where(experiencedata.ID(expID), experiencedata.ValueText(valueText)).
+		SetEmbedding(vector).
+		SetEmbeddingModel(e.embeddingSvc.Model()).
+		SetAiInputHash(hash).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update experience with embedding: %w", err)
+	}
+	if updated == 0 {
+		e.logger.Info("discarding superseded embedding",
 			"job_id", job.ID,
-			"error", err)
-		return
+			"experience_id", job.ExperienceID)
+		return nil
 	}
 
 	e.logger.Info("embedding completed successfully",
-		"worker_id", workerID,
 		"job_id", job.ID,
 		"experience_id", job.ExperienceID,
 		"model", e.embeddingSvc.Model())
+	return nil
+}
+
+// failOrRequeue marks a job as failed, unless the AI provider rate-limited it. Rate-limited
+// jobs are put back in the queue (up to maxRateLimitRequeues times) and the worker backs off,
+// so bulk imports don't burn jobs into the failed state.
+func (e *Enricher) failOrRequeue(ctx context.Context, workerID int, job *queue.EnrichmentJob, jobErr error) {
+	if rlErr := e.requeueRateLimited(ctx, workerID, job, jobErr); rlErr != nil {
+		e.backoff(ctx, rlErr.RetryAfter)
+		return
+	}
+
+	if err := e.queue.MarkFailed(ctx, job, jobErr); err != nil {
+		e.logger.Error("failed to mark job as failed",
+			"job_id", job.ID,
+			"error", err)
+	}
+}
+
+// requeueRateLimited puts a job that failed with a rate limit error back in the queue.
+// Returns the rate limit error if the job was requeued, or nil if it has to be failed.
+func (e *Enricher) requeueRateLimited(ctx context.Context, workerID int, job *queue.EnrichmentJob, jobErr error) *ai.RateLimitError {
+	rlErr, ok := ai.IsRateLimitError(jobErr)
+	if !ok || job.Requeues >= maxRateLimitRequeues {
+		return nil
+	}
+
+	if err := e.queue.Requeue(ctx, job); err != nil {
+		e.logger.Error("failed to requeue job",
+			"job_id", job.ID,
+			"error", err)
+		return nil
+	}
+
+	e.logger.Warn("AI provider rate limit reached, job requeued",
+		"worker_id", workerID,
+		"job_id", job.ID,
+		"requeues", job.Requeues+1,
+		"retry_after", rlErr.RetryAfter)
+	return rlErr
+}
+
+// backoff pauses the worker after a rate limit, returning early on shutdown
+func (e *Enricher) backoff(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		d = defaultRateLimitBackoff
+	}
+	d = min(d, maxRateLimitBackoff)
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-e.stopChan:
+	case <-timer.C:
+	}
+}
+
+// recordUsage stores the token usage and estimated cost of a job's AI request.
+// Failures are logged but never fail the job.
+func (e *Enricher) recordUsage(ctx context.Context, job *queue.EnrichmentJob, jobType, provider, model string, tokenUsage ai.Usage) {
+	entry := usage.Entry{
+		JobType:  jobType,
+		Provider: provider,
+		Model:    model,
+		Usage:    tokenUsage,
+	}
+	if jobID, err := uuid.Parse(job.ID); err == nil {
+		entry.JobID = &jobID
+	}
+
+	if _, err := e.usage.Record(ctx, entry); err != nil {
+		e.logger.Warn("failed to record AI usage",
+			"job_id", job.ID,
+			"job_type", jobType,
+			"error", err)
+	}
 }
@@ -0,0 +1,262 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/embedding"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/ent/schema"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/testdb"
+	"github.com/formbricks/hub/apps/hub/internal/webhook"
+)
+
+// countingEmbedder returns a constant vector and counts how often it was called
+type countingEmbedder struct {
+	calls int
+}
+
+func (p *countingEmbedder) Name() string  { return "test" }
+func (p *countingEmbedder) Model() string { return "test-embedding" }
+
+func (p *countingEmbedder) Embed(_ context.Context, _ string, dimensions int) (*ai.Embedding, error) {
+	p.calls++
+	values := make([]float32, dimensions)
+	for i := range values {
+		values[i] = 0.1
+	}
+	return &ai.Embedding{Values: values}, nil
+}
+
+func TestSupersededResults(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	q := &recordingQueue{outcomes: map[string]string{}}
+	embedder := &countingEmbedder{}
+	e := NewEnricher(q, nil, embedding.NewService(embedder, 5, logger), client, webhook.NewDispatcher(nil, logger), nil, 0.7, 1, logger)
+
+	const label = "How can we improve?"
+	exp, err := client.ExperienceData.Create().
+		SetSourceType("survey").
+		SetFieldID("q1").
+		SetFieldType("text").
+		SetFieldLabel(label).
+		SetValueText("The exports keep timing out").
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	staleJob := &queue.EnrichmentJob{ID: "stale", ExperienceID: exp.ID.String(), Text: embedding.BuildEmbeddingText(label, "The exports keep timing out")}
+	currentJob := &queue.EnrichmentJob{ID: "current", ExperienceID: exp.ID.String(), Text: embedding.BuildEmbeddingText(label, "The exports are fast now")}
+
+	// The experience is updated while the jobs for its previous text are processed
+	if err := client.ExperienceData.UpdateOneID(exp.ID).SetValueText("The exports are fast now").Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("stale enrichment", func(t *testing.T) {
+		e.saveEnrichment(ctx, 1, staleJob, &enrichment.Enrichment{Sentiment: "negative", Topics: []string{"exports"}}, textHash(staleJob.Text))
+		if got := q.outcomes[staleJob.ID]; got != "completed" {
+			t.Errorf("stale job finished as %q, want completed", got)
+		}
+		stored := client.ExperienceData.GetX(ctx, exp.ID)
+		if stored.Sentiment != nil || stored.AiInputHash != nil {
+			t.Errorf("expected the stale result to be discarded, got sentiment %v", stored.Sentiment)
+		}
+	})
+
+	t.Run("current enrichment", func(t *testing.T) {
+		e.saveEnrichment(ctx, 1, currentJob, &enrichment.Enrichment{Sentiment: "positive", Topics: []string{"exports"}}, textHash(currentJob.Text))
+		if got := q.outcomes[currentJob.ID]; got != "completed" {
+			t.Errorf("current job finished as %q, want completed", got)
+		}
+		stored := client.ExperienceData.GetX(ctx, exp.ID)
+		if stored.Sentiment == nil || *stored.Sentiment != "positive" {
+			t.Errorf("expected the current result to be stored, got sentiment %v", stored.Sentiment)
+		}
+	})
+
+	t.Run("stale embedding", func(t *testing.T) {
+		if err := e.handleEmbedding(ctx, staleJob); err != nil {
+			t.Fatalf("handleEmbedding() error = %v", err)
+		}
+		if embedder.calls != 0 {
+			t.Errorf("expected no embedding request for stale text, got %d", embedder.calls)
+		}
+		if stored := client.ExperienceData.GetX(ctx, exp.ID); stored.Embedding != nil {
+			t.Error("expected no embedding for stale text")
+		}
+	})
+
+	t.Run("current embedding", func(t *testing.T) {
+		if err := e.handleEmbedding(ctx, currentJob); err != nil {
+			t.Fatalf("handleEmbedding() error = %v", err)
+		}
+		stored := client.ExperienceData.GetX(ctx, exp.ID)
+		if stored.Embedding == nil || len(stored.Embedding.Slice()) != schema.EmbeddingDimensions {
+			t.Error("expected the embedding of the current text to be stored")
+		}
+	})
+
+	t.Run("deleted experience", func(t *testing.T) {
+		job := &queue.EnrichmentJob{ID: "deleted", ExperienceID: uuid.NewString(), Text: "Gone"}
+		e.saveEnrichment(ctx, 1, job, &enrichment.Enrichment{Sentiment: "neutral"}, textHash(job.Text))
+		if got := q.outcomes[job.ID]; got != "completed" {
+			t.Errorf("job of a deleted experience finished as %q, want completed", got)
+		}
+	})
+}
+
+func TestShutdownDrain(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	q := queue.NewPostgresQueue(client, 3, time.Minute)
+	e := NewEnricher(q, nil, nil, client, nil, []Pool{{Workers: 2, PollInterval: 10 * time.Millisecond}}, 0.7, 1, logger)
+
+	// A job that finishes shortly after the shutdown starts, and one that only stops when
+	// it is cancelled
+	started := make(chan string, 2)
+	release := make(chan struct{})
+	e.Register("quick", HandlerFunc(func(_ context.Context, job *queue.EnrichmentJob) error {
+		started <- job.ID
+		<-release
+		return nil
+	}))
+	e.Register("slow", HandlerFunc(func(ctx context.Context, job *queue.EnrichmentJob) error {
+		started <- job.ID
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+
+	for _, jobType := range []queue.JobType{"quick", "slow"} {
+		exp := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID("q1").
+			SetFieldType("text").
+			SaveX(ctx)
+		if err := q.EnqueueJob(ctx, jobType, exp.ID.String(), "The exports keep timing out", queue.PriorityNormal); err != nil {
+			t.Fatalf("EnqueueJob() error = %v", err)
+		}
+	}
+
+	go e.Start(ctx)
+	for range 2 {
+		select {
+		case <-started:
+		case <-time.After(10 * time.Second):
+			t.Fatal("jobs weren't picked up")
+		}
+	}
+
+	time.AfterFunc(50*time.Millisecond, func() { close(release) })
+	e.Stop(500 * time.Millisecond)
+
+	jobs := client.EnrichmentJob.Query().AllX(ctx)
+	if len(jobs) != 2 {
+		t.Fatalf("got %d jobs, want 2", len(jobs))
+	}
+	for _, job := range jobs {
+		switch job.JobType {
+		case "quick":
+			if job.Status != "completed" {
+				t.Errorf("quick job is %q, want completed within the shutdown timeout", job.Status)
+			}
+		case "slow":
+			// Returned to the queue without counting the interrupted attempt
+			if job.Status != "pending" || job.Attempts != 0 || job.LeaseExpiresAt != nil {
+				t.Errorf("slow job is %q after %d attempts, want pending without attempts", job.Status, job.Attempts)
+			}
+		}
+	}
+}
+
+func TestUrgentDispatch(t *testing.T) {
+	client, _, cleanup := testdb.New(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Collects the event types delivered for each experience
+	var mu sync.Mutex
+	events := map[string][]webhook.EventType{}
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event struct {
+			Type webhook.EventType `json:"type"`
+			Data struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("invalid webhook payload: %v", err)
+		}
+		mu.Lock()
+		events[event.Data.ID] = append(events[event.Data.ID], event.Type)
+		mu.Unlock()
+	}))
+	defer receiver.Close()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	dispatcher := webhook.NewDispatcher([]string{receiver.URL}, logger)
+	q := &recordingQueue{outcomes: map[string]string{}}
+	e := NewEnricher(q, nil, nil, client, dispatcher, nil, 0.7, 1, logger)
+
+	tests := []struct {
+		name   string
+		score  float64
+		spam   bool
+		urgent bool
+	}{
+		{name: "above threshold", score: 0.9, urgent: true},
+		{name: "at threshold", score: 0.7, urgent: true},
+		{name: "below threshold", score: 0.5},
+		{name: "spam", score: 0.95, spam: true},
+	}
+	ids := map[string]string{}
+	for _, tt := range tests {
+		exp := client.ExperienceData.Create().
+			SetSourceType("survey").
+			SetFieldID("q1").
+			SetFieldType("text").
+			SetValueText("The checkout is broken for " + tt.name).
+			SaveX(ctx)
+		ids[tt.name] = exp.ID.String()
+
+		job := &queue.EnrichmentJob{ID: tt.name, ExperienceID: exp.ID.String(), Text: *exp.ValueText}
+		result := &enrichment.Enrichment{Sentiment: "negative", UrgencyScore: tt.score, IsSpam: tt.spam}
+		e.saveEnrichment(ctx, 1, job, result, textHash(job.Text))
+	}
+
+	// Deliveries are asynchronous; all of them are done once the dispatcher shut down
+	if err := dispatcher.Shutdown(5 * time.Second); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, tt := range tests {
+		got := events[ids[tt.name]]
+		if !slices.Contains(got, webhook.EventExperienceEnriched) {
+			t.Errorf("%s: expected experience.enriched, got %v", tt.name, got)
+		}
+		if slices.Contains(got, webhook.EventExperienceUrgent) != tt.urgent {
+			t.Errorf("%s: got events %v, want experience.urgent = %v", tt.name, got, tt.urgent)
+		}
+	}
+}
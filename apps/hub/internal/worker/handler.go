@@ -0,0 +1,129 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/formbricks/hub/apps/hub/internal/tracing"
+)
+
+// Handler processes jobs of one type. Returning nil completes the job. An error fails the
+// attempt: rate limit errors put the job back in the queue, errors wrapped with Permanent
+// move it to the dead-letter queue, and other errors are retried until the job runs out of
+// attempts. Handlers are called concurrently from all workers.
+type Handler interface {
+	Handle(ctx context.Context, job *queue.EnrichmentJob) error
+}
+
+// HandlerFunc adapts a function to the Handler interface
+type HandlerFunc func(ctx context.Context, job *queue.EnrichmentJob) error
+
+// Handle calls f(ctx, job)
+func (f HandlerFunc) Handle(ctx context.Context, job *queue.EnrichmentJob) error {
+	return f(ctx, job)
+}
+
+// permanentError marks an error that retrying can't fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps an error that retrying can't fix (e.g., invalid job data), so the job is
+// moved to the dead-letter queue right away
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// jobFunc processes a job and records the outcome in the queue itself. Built-in job types
+// use it directly where a Handler doesn't fit, e.g. enrichment batches span several jobs.
+type jobFunc func(ctx context.Context, workerID int, job *queue.EnrichmentJob)
+
+// Register sets the handler for jobs of the given type, replacing any existing handler,
+// so new kinds of asynchronous work can be added without changing the worker. Jobs are
+// enqueued with queue.Queue.EnqueueJob. Register must be called before Start.
+func (e *Enricher) Register(jobType queue.JobType, handler Handler) {
+	e.handlers[jobType] = e.runHandler(jobType, handler)
+}
+
+// runHandler wraps a Handler into a jobFunc that records the outcome in the queue
+func (e *Enricher) runHandler(jobType queue.JobType, handler Handler) jobFunc {
+	return func(ctx context.Context, workerID int, job *queue.EnrichmentJob) {
+		e.logger.Info("processing job",
+			"worker_id", workerID,
+			"job_id", job.ID,
+			"job_type", jobType,
+			"experience_id", job.ExperienceID)
+
+		err := handler.Handle(ctx, job)
+		if err != nil {
+			tracing.RecordError(trace.SpanFromContext(ctx), err)
+		}
+
+		var permanent *permanentError
+		switch {
+		case err == nil:
+			if err := e.queue.MarkComplete(ctx, job); err != nil {
+				e.logger.Error("failed to mark job as complete",
+					"job_id", job.ID,
+					"error", err)
+			}
+		case errors.As(err, &permanent):
+			e.logger.Error("job failed permanently",
+				"worker_id", workerID,
+				"job_id", job.ID,
+				"job_type", jobType,
+				"error", err)
+			if err := e.queue.MarkDeadLetter(ctx, job, err); err != nil {
+				e.logger.Error("failed to move job to the dead-letter queue",
+					"job_id", job.ID,
+					"error", err)
+			}
+		default:
+			if _, ok := ai.IsRateLimitError(err); !ok {
+				e.logger.Warn("job failed",
+					"worker_id", workerID,
+					"job_id", job.ID,
+					"job_type", jobType,
+					"attempts", job.Attempts,
+					"error", err)
+			}
+			e.failOrRequeue(ctx, workerID, job, err)
+		}
+	}
+}
+
+// processJob dispatches a job to the handler registered for its type
+func (e *Enricher) processJob(ctx context.Context, workerID int, job *queue.EnrichmentJob) {
+	handle, ok := e.handlers[job.JobType]
+	if !ok {
+		e.logger.Error("unknown job type",
+			"worker_id", workerID,
+			"job_id", job.ID,
+			"job_type", job.JobType)
+		_ = e.queue.MarkDeadLetter(ctx, job, fmt.Errorf("unknown job type: %s", job.JobType))
+		return
+	}
+
+	// Process the job in the trace of the request that enqueued it
+	ctx, span := tracing.Tracer().Start(tracing.ContextWithTraceParent(ctx, job.TraceContext), "job.process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("job.id", job.ID),
+			attribute.String("job.type", string(job.JobType)),
+			attribute.String("experience.id", job.ExperienceID),
+			attribute.Int("job.attempts", job.Attempts),
+			attribute.Int("worker.id", workerID),
+		))
+	defer span.End()
+
+	handle(ctx, workerID, job)
+}
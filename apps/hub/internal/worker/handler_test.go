@@ -0,0 +1,105 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/ai"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+// recordingQueue records how jobs finished; other Queue methods are not used by the tests
+type recordingQueue struct {
+	queue.Queue
+	outcomes map[string]string
+}
+
+func (q *recordingQueue) MarkComplete(_ context.Context, job *queue.EnrichmentJob) error {
+	q.outcomes[job.ID] = "completed"
+	return nil
+}
+
+func (q *recordingQueue) MarkFailed(_ context.Context, job *queue.EnrichmentJob, _ error) error {
+	q.outcomes[job.ID] = "failed"
+	return nil
+}
+
+func (q *recordingQueue) MarkDeadLetter(_ context.Context, job *queue.EnrichmentJob, _ error) error {
+	q.outcomes[job.ID] = "dead_letter"
+	return nil
+}
+
+func (q *recordingQueue) Requeue(_ context.Context, job *queue.EnrichmentJob) error {
+	q.outcomes[job.ID] = "requeued"
+	return nil
+}
+
+func TestRegisteredHandlers(t *testing.T) {
+	q := &recordingQueue{outcomes: map[string]string{}}
+	e := NewEnricher(q, nil, nil, nil, nil, nil, 0.7, 1, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	errInvalid := errors.New("unsupported language")
+	e.Register("translation", HandlerFunc(func(_ context.Context, job *queue.EnrichmentJob) error {
+		switch job.Text {
+		case "retry":
+			return errors.New("translation service unavailable")
+		case "invalid":
+			return Permanent(errInvalid)
+		default:
+			return nil
+		}
+	}))
+
+	tests := []struct {
+		job  queue.EnrichmentJob
+		want string
+	}{
+		{queue.EnrichmentJob{ID: "ok", JobType: "translation", Text: "Bonjour"}, "completed"},
+		{queue.EnrichmentJob{ID: "retry", JobType: "translation", Text: "retry"}, "failed"},
+		{queue.EnrichmentJob{ID: "invalid", JobType: "translation", Text: "invalid"}, "dead_letter"},
+		{queue.EnrichmentJob{ID: "unknown", JobType: "export", Text: "Bonjour"}, "dead_letter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.job.ID, func(t *testing.T) {
+			e.processJob(context.Background(), 1, &tt.job)
+			if got := q.outcomes[tt.job.ID]; got != tt.want {
+				t.Errorf("job %s finished as %q, want %q", tt.job.ID, got, tt.want)
+			}
+		})
+	}
+
+	if !errors.Is(Permanent(errInvalid), errInvalid) {
+		t.Error("Permanent() should wrap the original error")
+	}
+}
+
+func TestRateLimitedJobs(t *testing.T) {
+	q := &recordingQueue{outcomes: map[string]string{}}
+	e := NewEnricher(q, nil, nil, nil, nil, nil, 0.7, 1, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	e.Register("translation", HandlerFunc(func(_ context.Context, _ *queue.EnrichmentJob) error {
+		return &ai.RateLimitError{Provider: ai.ProviderOpenAI, Err: errors.New("too many requests")}
+	}))
+
+	// Don't wait for the backoff after each rate limit
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		job  queue.EnrichmentJob
+		want string
+	}{
+		{queue.EnrichmentJob{ID: "first", JobType: "translation", Attempts: 1}, "requeued"},
+		{queue.EnrichmentJob{ID: "requeued", JobType: "translation", Attempts: 1, Requeues: maxRateLimitRequeues - 1}, "requeued"},
+		{queue.EnrichmentJob{ID: "exhausted", JobType: "translation", Attempts: 1, Requeues: maxRateLimitRequeues}, "failed"},
+	}
+	for _, tt := range tests {
+		e.processJob(ctx, 1, &tt.job)
+		if got := q.outcomes[tt.job.ID]; got != tt.want {
+			t.Errorf("job %s finished as %q, want %q", tt.job.ID, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package worker
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+// pauseRefreshInterval is how often workers reload the paused job types from the database
+const pauseRefreshInterval = 5 * time.Second
+
+// pauseState is the set of paused job types
+type pauseState struct {
+	all     bool // All job types are paused
+	types   map[queue.JobType]bool
+	reasons map[queue.JobType]string // By job type; empty for all job types
+}
+
+// paused reports whether jobs of the given type may not be dequeued
+func (s *pauseState) paused(jobType queue.JobType) bool {
+	return s != nil && (s.all || s.types[jobType])
+}
+
+// watchPauses periodically reloads the paused job types, so pauses and resumes made
+// through the API of any Hub instance take effect within pauseRefreshInterval
+func (e *Enricher) watchPauses(ctx context.Context) {
+	if e.db == nil {
+		return
+	}
+
+	ticker := time.NewTicker(pauseRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.stopChan:
+			return
+		case <-ticker.C:
+		}
+
+		e.refreshPauses(ctx)
+	}
+}
+
+// refreshPauses loads the paused job types and logs changes. On a database error, the
+// previous state is kept.
+func (e *Enricher) refreshPauses(ctx context.Context) {
+	if e.db == nil {
+		return
+	}
+
+	pauses, err := e.db.QueuePause.Query().All(ctx)
+	if err != nil {
+		e.logger.Warn("failed to load paused job types", "error", err)
+		return
+	}
+
+	state := &pauseState{
+		types:   make(map[queue.JobType]bool),
+		reasons: make(map[queue.JobType]string),
+	}
+	for _, pause := range pauses {
+		jobType := queue.JobType(pause.JobType)
+		if jobType == "" {
+			state.all = true
+		} else {
+			state.types[jobType] = true
+		}
+		state.reasons[jobType] = pause.Reason
+	}
+
+	previous := e.pauses.Swap(state)
+	if previous == nil {
+		previous = &pauseState{}
+	}
+	for jobType, reason := range state.reasons {
+		if _, ok := previous.reasons[jobType]; !ok {
+			e.logger.Warn("job queue paused", "job_type", pausedTypeName(jobType), "reason", reason)
+		}
+	}
+	for jobType := range previous.reasons {
+		if _, ok := state.reasons[jobType]; !ok {
+			e.logger.Info("job queue resumed", "job_type", pausedTypeName(jobType))
+		}
+	}
+}
+
+// pausedTypeName returns the job type of a pause for logging
+func pausedTypeName(jobType queue.JobType) string {
+	if jobType == "" {
+		return "all"
+	}
+	return string(jobType)
+}
+
+// dequeueTypes returns the job types the pool may dequeue. An empty job type stands for
+// all types. While some job types are paused, a pool for all types dequeues the other
+// registered types one by one instead.
+func (e *Enricher) dequeueTypes(p *pool) []queue.JobType {
+	state := e.pauses.Load()
+	switch {
+	case state == nil || (!state.all && len(state.types) == 0):
+		return []queue.JobType{p.jobType}
+	case state.all:
+		return nil
+	case p.jobType != "":
+		if state.paused(p.jobType) {
+			return nil
+		}
+		return []queue.JobType{p.jobType}
+	}
+
+	types := make([]queue.JobType, 0, len(e.handlers))
+	for jobType := range e.handlers {
+		if !state.paused(jobType) {
+			types = append(types, jobType)
+		}
+	}
+	slices.Sort(types)
+	return types
+}
@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+func TestDequeueTypes(t *testing.T) {
+	e := NewEnricher(&recordingQueue{}, nil, nil, nil, nil, nil, 0.7, 1, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	allTypes := &pool{}
+	embedding := &pool{jobType: queue.JobTypeEmbedding}
+
+	pause := func(all bool, types ...queue.JobType) {
+		state := &pauseState{all: all, types: make(map[queue.JobType]bool)}
+		for _, jobType := range types {
+			state.types[jobType] = true
+		}
+		e.pauses.Store(state)
+	}
+
+	tests := []struct {
+		name  string
+		pause func()
+		pool  *pool
+		want  []queue.JobType
+	}{
+		{"not paused", func() { pause(false) }, allTypes, []queue.JobType{""}},
+		{"all paused", func() { pause(true) }, allTypes, nil},
+		{"all paused, typed pool", func() { pause(true) }, embedding, nil},
+		{"own type paused", func() { pause(false, queue.JobTypeEmbedding) }, embedding, nil},
+		{"other type paused", func() { pause(false, queue.JobTypeEnrichment) }, embedding, []queue.JobType{queue.JobTypeEmbedding}},
+		{"some types paused", func() { pause(false, queue.JobTypeEnrichment) }, allTypes, []queue.JobType{queue.JobTypeEmbedding}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.pause()
+			if got := e.dequeueTypes(tt.pool); !slices.Equal(got, tt.want) {
+				t.Errorf("dequeueTypes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
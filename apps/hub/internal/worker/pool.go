@@ -0,0 +1,257 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+// scaleInterval is how often autoscaled pools compare their size with the queue depth
+const scaleInterval = 10 * time.Second
+
+// Pool configures a group of workers. Separate pools per job type keep slow enrichment
+// requests from starving cheap embedding jobs.
+type Pool struct {
+	JobType      queue.JobType // Job type processed by the pool; empty for all types
+	Workers      int           // Workers that always run
+	PollInterval time.Duration
+
+	// With MaxWorkers above Workers, the pool grows by one worker for every JobsPerWorker
+	// pending jobs, up to MaxWorkers, and shrinks again as the queue drains
+	MaxWorkers    int
+	JobsPerWorker int
+}
+
+// pool is a running Pool
+type pool struct {
+	jobType       queue.JobType
+	minWorkers    int
+	maxWorkers    int
+	jobsPerWorker int
+	pollInterval  time.Duration
+	wake          chan struct{} // Signalled when jobs may be available before the next poll
+
+	mu   sync.Mutex
+	quit []chan struct{} // One per running worker, closed to stop it
+}
+
+// newPools creates the runtime state of the configured pools
+func newPools(configs []Pool) []*pool {
+	pools := make([]*pool, 0, len(configs))
+	for _, c := range configs {
+		pools = append(pools, &pool{
+			jobType:       c.JobType,
+			minWorkers:    c.Workers,
+			maxWorkers:    max(c.MaxWorkers, c.Workers),
+			jobsPerWorker: max(c.JobsPerWorker, 1),
+			pollInterval:  c.PollInterval,
+			wake:          make(chan struct{}, 1),
+		})
+	}
+	return pools
+}
+
+// autoscaled reports whether the pool size follows the queue depth
+func (p *pool) autoscaled() bool {
+	return p.maxWorkers > p.minWorkers
+}
+
+// size returns the number of running workers
+func (p *pool) size() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.quit)
+}
+
+// targetWorkers returns the pool size for the number of pending jobs: one worker per
+// jobsPerWorker pending jobs, bounded by the minimum and maximum
+func targetWorkers(pending, jobsPerWorker, minWorkers, maxWorkers int) int {
+	target := (pending + jobsPerWorker - 1) / jobsPerWorker
+	return min(max(target, minWorkers), maxWorkers)
+}
+
+// jobTypeName returns the job type for logging
+func (p *pool) jobTypeName() string {
+	if p.jobType == "" {
+		return "all"
+	}
+	return string(p.jobType)
+}
+
+// signalWake wakes an idle worker of the pool without blocking if one is already signalled
+func (p *pool) signalWake() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// startWorker adds a worker to the pool
+func (e *Enricher) startWorker(ctx context.Context, p *pool) {
+	quit := make(chan struct{})
+
+	p.mu.Lock()
+	p.quit = append(p.quit, quit)
+	p.mu.Unlock()
+
+	workerID := int(e.workerIDs.Add(1))
+	e.addWorker(workerID, p)
+
+	e.workersWG.Add(1)
+	go func() {
+		defer e.workersWG.Done()
+		defer e.removeWorker(workerID)
+		e.worker(ctx, workerID, p, quit)
+	}()
+}
+
+// stopWorker stops the most recently started worker of the pool after its current job
+func (e *Enricher) stopWorker(p *pool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if n := len(p.quit); n > 0 {
+		close(p.quit[n-1])
+		p.quit = p.quit[:n-1]
+	}
+}
+
+// autoscale periodically resizes the pool to the number of pending jobs. The pool grows to
+// the target size at once, so bursts drain quickly, and shrinks by one worker per interval.
+func (e *Enricher) autoscale(ctx context.Context, p *pool) {
+	ticker := time.NewTicker(scaleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.stopChan:
+			return
+		case <-ticker.C:
+		}
+
+		pending, err := e.queue.Pending(ctx, p.jobType)
+		if err != nil {
+			e.logger.Warn("failed to count pending jobs for autoscaling",
+				"job_type", p.jobTypeName(),
+				"error", err)
+			continue
+		}
+
+		// Paused jobs won't be dequeued, so they don't need workers
+		if len(e.dequeueTypes(p)) == 0 {
+			pending = 0
+		}
+
+		current := p.size()
+		target := targetWorkers(pending, p.jobsPerWorker, p.minWorkers, p.maxWorkers)
+		switch {
+		case target > current:
+			for i := current; i < target; i++ {
+				e.startWorker(ctx, p)
+			}
+			e.logger.Info("scaled up worker pool",
+				"job_type", p.jobTypeName(),
+				"pending", pending,
+				"workers", target)
+		case target < current:
+			e.stopWorker(p)
+			e.logger.Info("scaled down worker pool",
+				"job_type", p.jobTypeName(),
+				"pending", pending,
+				"workers", current-1)
+		}
+	}
+}
+
+// worker is a single worker goroutine that waits for a poll or a wake-up and then
+// processes jobs until the queue has none left for its pool
+func (e *Enricher) worker(ctx context.Context, workerID int, p *pool, quit <-chan struct{}) {
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	e.logger.Debug("worker started", "worker_id", workerID, "job_type", p.jobTypeName())
+
+	for {
+		select {
+		case <-ctx.Done():
+			e.logger.Debug("worker stopping", "worker_id", workerID)
+			return
+		case <-e.stopChan:
+			e.logger.Debug("worker stopping", "worker_id", workerID)
+			return
+		case <-quit:
+			e.logger.Debug("worker removed from pool", "worker_id", workerID)
+			return
+		case <-ticker.C:
+		case <-p.wake:
+		}
+
+		e.drain(ctx, workerID, p, quit)
+	}
+}
+
+// drain processes jobs until the queue has none left for the pool or the worker is stopped
+func (e *Enricher) drain(ctx context.Context, workerID int, p *pool, quit <-chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.stopChan:
+			return
+		case <-quit:
+			return
+		default:
+		}
+
+		job, err := e.dequeue(ctx, p)
+		if err != nil {
+			e.logger.Error("failed to dequeue job",
+				"worker_id", workerID,
+				"error", err)
+			return
+		}
+
+		// No jobs available
+		if job == nil {
+			return
+		}
+
+		// There may be more jobs, so let another idle worker check
+		p.signalWake()
+
+		e.track(workerID, job)
+		e.processJob(ctx, workerID, job)
+		e.untrack(job)
+	}
+}
+
+// dequeue claims the next job the pool may process, skipping paused job types
+func (e *Enricher) dequeue(ctx context.Context, p *pool) (*queue.EnrichmentJob, error) {
+	for _, jobType := range e.dequeueTypes(p) {
+		job, err := e.queue.Dequeue(ctx, jobType)
+		if err != nil || job != nil {
+			return job, err
+		}
+	}
+	return nil, nil
+}
+
+// relayNotifications wakes a worker of each pool for each notification from the queue
+func (e *Enricher) relayNotifications(ctx context.Context, notifications <-chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.stopChan:
+			return
+		case <-notifications:
+			for _, p := range e.pools {
+				p.signalWake()
+			}
+		}
+	}
+}
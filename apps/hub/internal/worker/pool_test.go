@@ -0,0 +1,24 @@
+package worker
+
+import "testing"
+
+func TestTargetWorkers(t *testing.T) {
+	tests := []struct {
+		name    string
+		pending int
+		want    int
+	}{
+		{"empty queue keeps the minimum", 0, 2},
+		{"small backlog keeps the minimum", 30, 2},
+		{"rounds up partial workers", 61, 4},
+		{"bounded by the maximum", 1000, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := targetWorkers(tt.pending, 20, 2, 8); got != tt.want {
+				t.Errorf("targetWorkers(%d) = %d, want %d", tt.pending, got, tt.want)
+			}
+		})
+	}
+}
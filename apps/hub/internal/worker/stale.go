@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/formbricks/hub/apps/hub/internal/embedding"
+	"github.com/formbricks/hub/apps/hub/internal/enrichment"
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	"github.com/formbricks/hub/apps/hub/internal/ent/enrichmentjob"
+	"github.com/formbricks/hub/apps/hub/internal/ent/experiencedata"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+	"github.com/google/uuid"
+)
+
+// staleBatchSize is the number of stale experiences re-enqueued per query
+const staleBatchSize = 500
+
+// EnqueueStale re-enqueues enrichment jobs for experiences whose enrichment is stale
+// (older prompt version or a model that is no longer configured). Experiences that
+// already have a pending or processing enrichment job are skipped, so calling this on
+// every startup does not pile up duplicate jobs. Returns the number of jobs enqueued.
+func (e *Enricher) EnqueueStale(ctx context.Context, currentModels []string) (int, error) {
+	enqueued := 0
+	var lastID uuid.UUID
+
+	for {
+		query := e.db.ExperienceData.Query().
+			Where(
+				enrichment.StalePredicate(currentModels),
+				experiencedata.ValueTextNotNil(),
+				experiencedata.SkipAiProcessing(false),
+			).
+			Order(ent.Asc(experiencedata.FieldID)).
+			Limit(staleBatchSize)
+		if lastID != uuid.Nil {
+			query = query.Where(experiencedata.IDGT(lastID))
+		}
+
+		batch, err := query.All(ctx)
+		if err != nil {
+			return enqueued, fmt.Errorf("failed to query stale enrichments: %w", err)
+		}
+		if len(batch) == 0 {
+			return enqueued, nil
+		}
+		lastID = batch[len(batch)-1].ID
+
+		ids := make([]uuid.UUID, len(batch))
+		for i, exp := range batch {
+			ids[i] = exp.ID
+		}
+		queued, err := e.db.EnrichmentJob.Query().
+			Where(
+				enrichmentjob.ExperienceIDIn(ids...),
+				enrichmentjob.JobType(string(queue.JobTypeEnrichment)),
+				enrichmentjob.StatusIn("pending", "processing"),
+			).
+			All(ctx)
+		if err != nil {
+			return enqueued, fmt.Errorf("failed to query pending enrichment jobs: %w", err)
+		}
+		alreadyQueued := make(map[uuid.UUID]bool, len(queued))
+		for _, job := range queued {
+			alreadyQueued[job.ExperienceID] = true
+		}
+
+		for _, exp := range batch {
+			if alreadyQueued[exp.ID] || exp.ValueText == nil {
+				continue
+			}
+			text := embedding.BuildEmbeddingText(exp.FieldLabel, *exp.ValueText)
+			// Re-enrichment is a backfill and must not delay new feedback
+			if err := e.queue.Enqueue(ctx, exp.ID.String(), text, queue.PriorityLow); err != nil {
+				return enqueued, err
+			}
+			enqueued++
+		}
+	}
+}
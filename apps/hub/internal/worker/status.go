@@ -0,0 +1,262 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/formbricks/hub/apps/hub/internal/ent"
+	entworker "github.com/formbricks/hub/apps/hub/internal/ent/worker"
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+const (
+	// HeartbeatInterval is how often workers report their status to the database
+	HeartbeatInterval = 15 * time.Second
+	// workerExpiry is how long the status of a worker that stopped reporting (e.g., because
+	// its instance crashed) is kept before it is deleted
+	workerExpiry = 10 * time.Minute
+)
+
+// workerStatus is the reported state of a running worker, guarded by Enricher.mu
+type workerStatus struct {
+	id           uuid.UUID // Database row ID
+	workerID     int
+	jobType      string
+	startedAt    time.Time
+	currentJob   *uuid.UUID
+	jobStartedAt *time.Time
+	processed    int64
+	failed       int64
+}
+
+// inFlightJob is a job being processed and the worker processing it
+type inFlightJob struct {
+	job      *queue.EnrichmentJob
+	workerID int
+}
+
+// reportingQueue counts job outcomes for the worker holding the job
+type reportingQueue struct {
+	queue.Queue
+	e *Enricher
+}
+
+// MarkComplete marks the job as completed and counts it as processed
+func (q *reportingQueue) MarkComplete(ctx context.Context, job *queue.EnrichmentJob) error {
+	err := q.Queue.MarkComplete(ctx, job)
+	if err == nil {
+		q.e.recordOutcome(job.ID, false)
+	}
+	return err
+}
+
+// MarkFailed records the failed attempt and counts it as failed
+func (q *reportingQueue) MarkFailed(ctx context.Context, job *queue.EnrichmentJob, jobErr error) error {
+	err := q.Queue.MarkFailed(ctx, job, jobErr)
+	if err == nil {
+		q.e.recordOutcome(job.ID, true)
+	}
+	return err
+}
+
+// MarkDeadLetter moves the job to the dead-letter queue and counts it as failed
+func (q *reportingQueue) MarkDeadLetter(ctx context.Context, job *queue.EnrichmentJob, jobErr error) error {
+	err := q.Queue.MarkDeadLetter(ctx, job, jobErr)
+	if err == nil {
+		q.e.recordOutcome(job.ID, true)
+	}
+	return err
+}
+
+// instanceName identifies this Hub process in worker statuses
+func instanceName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// addWorker registers the status of a started worker
+func (e *Enricher) addWorker(workerID int, p *pool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.workers[workerID] = &workerStatus{
+		id:        uuid.New(),
+		workerID:  workerID,
+		jobType:   p.jobTypeName(),
+		startedAt: time.Now(),
+	}
+}
+
+// removeWorker deletes the status of a stopped worker
+func (e *Enricher) removeWorker(workerID int) {
+	e.mu.Lock()
+	status := e.workers[workerID]
+	delete(e.workers, workerID)
+	e.mu.Unlock()
+
+	if status == nil || e.db == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := e.db.Worker.DeleteOneID(status.id).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		e.logger.Warn("failed to delete worker status", "worker_id", workerID, "error", err)
+	}
+}
+
+// track records jobs as in flight on the given worker. The worker reports the first job
+// it tracks as its current job.
+func (e *Enricher) track(workerID int, jobs ...*queue.EnrichmentJob) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	for _, job := range jobs {
+		e.inFlight[job.ID] = inFlightJob{job: job, workerID: workerID}
+
+		if status := e.workers[workerID]; status != nil && status.currentJob == nil {
+			if id, err := uuid.Parse(job.ID); err == nil {
+				status.currentJob = &id
+				status.jobStartedAt = &now
+			}
+		}
+	}
+}
+
+// untrack removes finished jobs from the in-flight jobs
+func (e *Enricher) untrack(jobs ...*queue.EnrichmentJob) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, job := range jobs {
+		entry, ok := e.inFlight[job.ID]
+		if !ok {
+			continue
+		}
+		delete(e.inFlight, job.ID)
+
+		if status := e.workers[entry.workerID]; status != nil && status.currentJob != nil &&
+			status.currentJob.String() == job.ID {
+			status.currentJob = nil
+			status.jobStartedAt = nil
+		}
+	}
+}
+
+// inFlightJobs returns the jobs currently being processed
+func (e *Enricher) inFlightJobs() []*queue.EnrichmentJob {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	jobs := make([]*queue.EnrichmentJob, 0, len(e.inFlight))
+	for _, entry := range e.inFlight {
+		jobs = append(jobs, entry.job)
+	}
+	return jobs
+}
+
+// recordOutcome counts a finished attempt for the worker processing the job
+func (e *Enricher) recordOutcome(jobID string, failed bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	entry, ok := e.inFlight[jobID]
+	if !ok {
+		return
+	}
+	status := e.workers[entry.workerID]
+	if status == nil {
+		return
+	}
+	if failed {
+		status.failed++
+	} else {
+		status.processed++
+	}
+}
+
+// workerStatuses returns a snapshot of the status of all running workers
+func (e *Enricher) workerStatuses() []workerStatus {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	statuses := make([]workerStatus, 0, len(e.workers))
+	for _, status := range e.workers {
+		statuses = append(statuses, *status)
+	}
+	return statuses
+}
+
+// heartbeat periodically reports the status of this instance's workers to the database
+func (e *Enricher) heartbeat(ctx context.Context) {
+	if e.db == nil {
+		return
+	}
+
+	ticker := time.NewTicker(HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		e.reportHeartbeats(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-e.stopChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reportHeartbeats writes the status of all running workers and deletes the statuses of
+// workers that stopped reporting
+func (e *Enricher) reportHeartbeats(ctx context.Context) {
+	now := time.Now()
+
+	for _, status := range e.workerStatuses() {
+		update := e.db.Worker.Update().
+			Where(entworker.ID(status.id)).
+			SetProcessed(status.processed).
+			SetFailed(status.failed).
+			SetLastHeartbeatAt(now)
+		if status.currentJob != nil {
+			update.SetCurrentJobID(*status.currentJob).SetCurrentJobStartedAt(*status.jobStartedAt)
+		} else {
+			update.ClearCurrentJobID().ClearCurrentJobStartedAt()
+		}
+
+		updated, err := update.Save(ctx)
+		if err == nil && updated == 0 {
+			err = e.db.Worker.Create().
+				SetID(status.id).
+				SetInstance(e.instance).
+				SetWorkerID(status.workerID).
+				SetJobType(status.jobType).
+				SetNillableCurrentJobID(status.currentJob).
+				SetNillableCurrentJobStartedAt(status.jobStartedAt).
+				SetProcessed(status.processed).
+				SetFailed(status.failed).
+				SetStartedAt(status.startedAt).
+				SetLastHeartbeatAt(now).
+				Exec(ctx)
+		}
+		if err != nil {
+			e.logger.Warn("failed to report worker heartbeat",
+				"worker_id", status.workerID,
+				"error", err)
+		}
+	}
+
+	_, err := e.db.Worker.Delete().
+		Where(entworker.LastHeartbeatAtLT(now.Add(-workerExpiry))).
+		Exec(ctx)
+	if err != nil {
+		e.logger.Warn("failed to delete expired worker statuses", "error", err)
+	}
+}
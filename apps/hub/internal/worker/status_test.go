@@ -0,0 +1,59 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/formbricks/hub/apps/hub/internal/queue"
+)
+
+func TestWorkerStatus(t *testing.T) {
+	q := &recordingQueue{outcomes: map[string]string{}}
+	e := NewEnricher(q, nil, nil, nil, nil, nil, 0.7, 1, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	e.Register("translation", HandlerFunc(func(_ context.Context, job *queue.EnrichmentJob) error {
+		if job.Text == "retry" {
+			return context.DeadlineExceeded
+		}
+		return nil
+	}))
+
+	e.addWorker(1, &pool{jobType: "translation"})
+	jobs := []*queue.EnrichmentJob{
+		{ID: "0190a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a51", JobType: "translation", Text: "Bonjour"},
+		{ID: "0190a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a52", JobType: "translation", Text: "retry"},
+		{ID: "0190a1b2-c3d4-7e5f-8a9b-0c1d2e3f4a53", JobType: "translation", Text: "Hola"},
+	}
+
+	e.track(1, jobs[0])
+	status := e.workerStatuses()[0]
+	if status.currentJob == nil || status.currentJob.String() != jobs[0].ID || status.jobStartedAt == nil {
+		t.Fatalf("current job = %v, want %s", status.currentJob, jobs[0].ID)
+	}
+
+	for _, job := range jobs {
+		e.track(1, job)
+		e.processJob(context.Background(), 1, job)
+		e.untrack(job)
+	}
+
+	status = e.workerStatuses()[0]
+	if status.processed != 2 || status.failed != 1 {
+		t.Errorf("processed = %d, failed = %d; want 2 and 1", status.processed, status.failed)
+	}
+	if status.currentJob != nil || len(e.inFlightJobs()) != 0 {
+		t.Errorf("worker still reports a current job after finishing: %v", status.currentJob)
+	}
+
+	// Outcomes of jobs not processed by a worker aren't counted
+	e.recordOutcome(jobs[0].ID, false)
+	if got := e.workerStatuses()[0].processed; got != 2 {
+		t.Errorf("processed = %d after an untracked outcome, want 2", got)
+	}
+
+	e.removeWorker(1)
+	if got := len(e.workerStatuses()); got != 0 {
+		t.Errorf("%d workers reported after removal, want 0", got)
+	}
+}
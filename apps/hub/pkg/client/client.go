@@ -0,0 +1,271 @@
+// Package client is the Go client of the Hub API. Its types mirror the OpenAPI spec
+// (apps/docs/static/openapi/hub.json), and TestTypesMatchSpec fails when they drift apart.
+//
+// Requests that fail with a network error, 429, 502, 503, or 504 are retried with
+// exponential backoff, honoring Retry-After. Requests that can't have a different effect
+// when repeated (GET, PUT, PATCH, DELETE) are always retried; POST requests only with an
+// idempotency key. CreateExperience generates a key unless one is given, so experiences
+// are never created twice.
+//
+//	hub, err := client.New("https://hub.example.com", client.Options{APIKey: os.Getenv("HUB_API_KEY")})
+//	exp, err := hub.CreateExperience(ctx, client.CreateExperienceParams{
+//		SourceType:  "survey",
+//		FieldID:     "nps",
+//		FieldType:   "nps",
+//		ValueNumber: client.Ptr(9.0),
+//	})
+//	for exp, err := range hub.Experiences(ctx, client.ListExperiencesParams{SourceType: "survey"}) {
+//		...
+//	}
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults of the options
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultMaxRetries = 3
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+	userAgent         = "formbricks-hub-go"
+)
+
+// Options configures a client
+type Options struct {
+	APIKey     string       // Sent in the X-API-Key header; required when SERVICE_API_KEY is set
+	HTTPClient *http.Client // Defaults to a client with a 30 second timeout
+	UserAgent  string       // Prepended to the client's User-Agent, e.g. the name of the service
+	MaxRetries int          // Retries of a failed request; 0 for 3, negative to never retry
+	MinBackoff time.Duration
+	MaxBackoff time.Duration // Also caps the Retry-After of responses
+}
+
+// Client calls the Hub API. It is safe for concurrent use.
+type Client struct {
+	baseURL    *url.URL
+	apiKey     string
+	http       *http.Client
+	userAgent  string
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	// sleep waits between attempts; replaced in tests
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// New returns a client of the Hub at baseURL, e.g. https://hub.example.com
+func New(baseURL string, opts Options) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: expected an http or https URL", baseURL)
+	}
+	c := &Client{
+		baseURL:    u,
+		apiKey:     opts.APIKey,
+		http:       opts.HTTPClient,
+		userAgent:  userAgent,
+		maxRetries: opts.MaxRetries,
+		minBackoff: opts.MinBackoff,
+		maxBackoff: opts.MaxBackoff,
+		sleep:      sleepContext,
+	}
+	if c.http == nil {
+		c.http = &http.Client{Timeout: defaultTimeout}
+	}
+	if opts.UserAgent != "" {
+		c.userAgent = opts.UserAgent + " " + userAgent
+	}
+	switch {
+	case c.maxRetries == 0:
+		c.maxRetries = defaultMaxRetries
+	case c.maxRetries < 0:
+		c.maxRetries = 0
+	}
+	if c.minBackoff <= 0 {
+		c.minBackoff = defaultMinBackoff
+	}
+	if c.maxBackoff <= 0 {
+		c.maxBackoff = defaultMaxBackoff
+	}
+	return c, nil
+}
+
+// Ptr returns a pointer to v, for the optional fields of parameters
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// request is a call of the API
+type request struct {
+	method         string
+	path           string
+	query          url.Values
+	body           any
+	idempotencyKey string
+}
+
+// retriable reports whether the request may be sent again after a failure. Hub's PATCH
+// requests set values, so repeating one has the same effect.
+func (r request) retriable() bool {
+	switch r.method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return r.idempotencyKey != ""
+}
+
+// do sends the request, retrying it if possible, and decodes the response into out unless
+// out is nil. Error responses are returned as *Error.
+func (c *Client) do(ctx context.Context, r request, out any) error {
+	var body []byte
+	if r.body != nil {
+		var err error
+		if body, err = json.Marshal(r.body); err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	u := c.baseURL.JoinPath(r.path)
+	u.RawQuery = r.query.Encode()
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, r, u.String(), body)
+		retry := r.retriable() && attempt < c.maxRetries
+		if err != nil {
+			if !retry || !temporary(ctx, err) {
+				return err
+			}
+			if err := c.sleep(ctx, c.backoff(attempt, nil)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if resp.StatusCode >= 400 {
+			apiErr := readError(resp)
+			if retry && retryStatus(resp.StatusCode) {
+				if err := c.sleep(ctx, c.backoff(attempt, resp.Header)); err != nil {
+					return err
+				}
+				continue
+			}
+			return apiErr
+		}
+
+		return decode(resp, out)
+	}
+}
+
+// send makes a single attempt
+func (c *Client) send(ctx context.Context, r request, u string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, u, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.apiKey != "" {
+		req.Header.Set("X-API-Key", c.apiKey)
+	}
+	if r.idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", r.idempotencyKey)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", r.method, r.path, err)
+	}
+	return resp, nil
+}
+
+// decode reads a successful response into out
+func decode(resp *http.Response, out any) error {
+	defer func() { _ = resp.Body.Close() }()
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// temporary reports whether a failed attempt may succeed when sent again
+func temporary(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryStatus reports whether a response with the status may succeed when sent again
+func retryStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait before retrying: the Retry-After of the response if it
+// has one, otherwise an exponential backoff with jitter, so clients that failed together
+// don't retry together
+func (c *Client) backoff(attempt int, header http.Header) time.Duration {
+	if wait, ok := retryAfter(header); ok {
+		return min(wait, c.maxBackoff)
+	}
+	ceiling := c.maxBackoff
+	if attempt < 30 {
+		ceiling = min(c.minBackoff<<attempt, c.maxBackoff)
+	}
+	return c.minBackoff/2 + rand.N(ceiling-c.minBackoff/2+1)
+}
+
+// retryAfter reads the Retry-After header, in seconds or as an HTTP date
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
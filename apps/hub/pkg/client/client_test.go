@@ -0,0 +1,344 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// testServer serves handler and returns a client of it that doesn't wait between retries
+func testServer(t *testing.T, opts Options, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	c, err := New(server.URL, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	return c
+}
+
+func TestNew(t *testing.T) {
+	for _, baseURL := range []string{"", "hub.example.com", "ftp://hub.example.com", "https://"} {
+		if _, err := New(baseURL, Options{}); err == nil {
+			t.Errorf("expected an error for %q", baseURL)
+		}
+	}
+	c, err := New("https://hub.example.com/", Options{})
+	if err != nil || c.maxRetries != defaultMaxRetries {
+		t.Errorf("unexpected client %+v, %v", c, err)
+	}
+	if c, _ := New("https://hub.example.com", Options{MaxRetries: -1}); c.maxRetries != 0 {
+		t.Errorf("expected no retries, got %d", c.maxRetries)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var attempts atomic.Int32
+	c := testServer(t, Options{APIKey: "secret"}, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		if r.Header.Get("X-API-Key") != "secret" {
+			t.Errorf("expected the API key, got %q", r.Header.Get("X-API-Key"))
+		}
+		if attempts.Load() < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"0192a4b8-8b6e-7c4d-9f2a-3e5b6c7d8e9f","source_type":"survey"}`))
+	})
+
+	exp, err := c.GetExperience(context.Background(), uuid.MustParse("0192a4b8-8b6e-7c4d-9f2a-3e5b6c7d8e9f"))
+	if err != nil || exp.SourceType != "survey" {
+		t.Fatalf("unexpected experience %+v, %v", exp, err)
+	}
+	if n := attempts.Load(); n != 3 {
+		t.Errorf("expected 3 attempts, got %d", n)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var attempts atomic.Int32
+	c := testServer(t, Options{MaxRetries: 2}, func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"title":"Too Many Requests","status":429,"detail":"Slow down","code":"rate_limited"}`))
+	})
+
+	_, err := c.ListWebhooks(context.Background())
+	if ErrorCode(err) != "rate_limited" || !strings.Contains(err.Error(), "Slow down") {
+		t.Errorf("expected a rate_limited error, got %v", err)
+	}
+	if n := attempts.Load(); n != 3 {
+		t.Errorf("expected the first attempt and 2 retries, got %d", n)
+	}
+}
+
+func TestPostRetries(t *testing.T) {
+	var mu sync.Mutex
+	attempts := map[string][]string{}
+	c := testServer(t, Options{}, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts[r.URL.Path] = append(attempts[r.URL.Path], r.Header.Get("Idempotency-Key"))
+		n := len(attempts[r.URL.Path])
+		mu.Unlock()
+		if n == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	})
+	ctx := context.Background()
+
+	// Without an idempotency key, a retried POST could create the question twice
+	if _, err := c.CreateQuestion(ctx, CreateQuestionParams{SourceType: "survey", FieldID: "q1"}); ErrorCode(err) != "internal_error" {
+		t.Errorf("expected the 502 to be returned, got %v", err)
+	}
+	if keys := attempts["/v1/questions"]; len(keys) != 1 || keys[0] != "" {
+		t.Errorf("expected a single attempt without a key, got %q", keys)
+	}
+
+	// Experiences are created with a generated key, sent with every attempt
+	if _, err := c.CreateExperience(ctx, CreateExperienceParams{SourceType: "survey", FieldID: "q1", FieldType: "text"}); err != nil {
+		t.Fatal(err)
+	}
+	if keys := attempts["/v1/experiences"]; len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("expected 2 attempts with the same key, got %q", keys)
+	}
+}
+
+func TestCreateExperienceRequest(t *testing.T) {
+	c := testServer(t, Options{UserAgent: "billing-service"}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/experiences" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Idempotency-Key"); got != "response-42" {
+			t.Errorf("expected the given key, got %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "billing-service formbricks-hub-go" {
+			t.Errorf("unexpected User-Agent %q", got)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if _, ok := body["IdempotencyKey"]; ok || body["value_number"] != 9.0 || body["source_type"] != "nps" {
+			t.Errorf("unexpected body %v", body)
+		}
+		if _, ok := body["value_text"]; ok {
+			t.Error("expected unset fields to be omitted")
+		}
+		_, _ = w.Write([]byte(`{"id":"0192a4b8-8b6e-7c4d-9f2a-3e5b6c7d8e9f","nps_category":"promoter"}`))
+	})
+
+	exp, err := c.CreateExperience(context.Background(), CreateExperienceParams{
+		IdempotencyKey: "response-42",
+		SourceType:     "nps",
+		FieldID:        "score",
+		FieldType:      "nps",
+		ValueNumber:    Ptr(9.0),
+	})
+	if err != nil || exp.NPSCategory == nil || *exp.NPSCategory != "promoter" {
+		t.Errorf("unexpected experience %+v, %v", exp, err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := testServer(t, Options{MaxRetries: -1}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/webhooks" {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("<html>Bad Gateway</html>"))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"title":"Not Found","status":404,"detail":"Experience not found","code":"experience_not_found"}`))
+	})
+	ctx := context.Background()
+
+	_, err := c.GetExperience(ctx, uuid.New())
+	if !IsNotFound(err) || ErrorCode(err) != "experience_not_found" {
+		t.Errorf("expected experience_not_found, got %v", err)
+	}
+	if err.Error() != "hub: 404 experience_not_found: Experience not found" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+
+	// Responses that aren't problem details keep their status
+	_, err = c.ListWebhooks(ctx)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusBadGateway || apiErr.Code != "internal_error" {
+		t.Errorf("expected a 502 error, got %#v", err)
+	}
+	if IsNotFound(err) || ErrorCode(nil) != "" {
+		t.Error("expected only 404 errors to be not found")
+	}
+}
+
+func TestExperiencesIterator(t *testing.T) {
+	const total = 5
+	var requests atomic.Int32
+	c := testServer(t, Options{}, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		q := r.URL.Query()
+		if q.Get("source_type") != "survey" || q.Get("is_spam") != "false" || q.Get("limit") != "2" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		page := Page[Experience]{Total: total, Limit: 2, Offset: offset}
+		for i := offset; i < min(offset+2, total); i++ {
+			page.Data = append(page.Data, Experience{FieldID: strconv.Itoa(i)})
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	})
+	params := ListExperiencesParams{SourceType: "survey", IsSpam: Ptr(false), Limit: 2}
+
+	var fields []string
+	for exp, err := range c.Experiences(context.Background(), params) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields = append(fields, exp.FieldID)
+	}
+	if strings.Join(fields, ",") != "0,1,2,3,4" || requests.Load() != 3 {
+		t.Errorf("expected all experiences in 3 requests, got %v in %d", fields, requests.Load())
+	}
+
+	// Breaking out of the loop stops reading pages
+	requests.Store(0)
+	for range c.Experiences(context.Background(), params) {
+		break
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected a single request, got %d", n)
+	}
+}
+
+func TestIteratorError(t *testing.T) {
+	c := testServer(t, Options{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"title":"Unauthorized","status":401,"code":"unauthorized"}`))
+	})
+	var errs int
+	for _, err := range c.Questions(context.Background(), ListQuestionsParams{}) {
+		if ErrorCode(err) != "unauthorized" {
+			t.Errorf("expected unauthorized, got %v", err)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("expected a single error, got %d", errs)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c, _ := New("https://hub.example.com", Options{MinBackoff: time.Second, MaxBackoff: 10 * time.Second})
+
+	for attempt := range 40 {
+		wait := c.backoff(attempt, nil)
+		ceiling := min(time.Second<<min(attempt, 29), 10*time.Second)
+		if wait < 500*time.Millisecond || wait > ceiling {
+			t.Errorf("attempt %d: backoff %s outside [500ms, %s]", attempt, wait, ceiling)
+		}
+	}
+
+	if wait := c.backoff(0, http.Header{"Retry-After": {"3"}}); wait != 3*time.Second {
+		t.Errorf("expected the Retry-After of 3s, got %s", wait)
+	}
+	if wait := c.backoff(0, http.Header{"Retry-After": {"3600"}}); wait != 10*time.Second {
+		t.Errorf("expected Retry-After to be capped at 10s, got %s", wait)
+	}
+	date := time.Now().Add(5 * time.Second).UTC().Format(http.TimeFormat)
+	if wait := c.backoff(0, http.Header{"Retry-After": {date}}); wait <= 3*time.Second || wait > 5*time.Second {
+		t.Errorf("expected about 5s from the Retry-After date, got %s", wait)
+	}
+}
+
+// TestTypesMatchSpec checks that the types have the fields of the OpenAPI spec, so they are
+// updated along with the API
+func TestTypesMatchSpec(t *testing.T) {
+	data, err := os.ReadFile("../../../docs/static/openapi/hub.json")
+	if err != nil {
+		t.Skipf("OpenAPI spec not found: %v", err)
+	}
+	var spec struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]any `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	for schema, v := range map[string]any{
+		"ExperienceData":            Experience{},
+		"Translation":               Translation{},
+		"CreateExperienceInputBody": CreateExperienceParams{},
+		"UpdateExperienceInputBody": UpdateExperienceParams{},
+		"ListExperiencesOutputBody": Page[Experience]{},
+		"SearchResultItem":          SearchResult{},
+		"QuestionItem":              Question{},
+		"CreateQuestionInputBody":   CreateQuestionParams{},
+		"UpdateQuestionInputBody":   UpdateQuestionParams{},
+		"ListQuestionsOutputBody":   Page[Question]{},
+		"WebhookItem":               Webhook{},
+		"Condition":                 Condition{},
+		"CreateWebhookInputBody":    CreateWebhookParams{},
+		"UpdateWebhookInputBody":    UpdateWebhookParams{},
+		"SyncChangesOutputBody":     Changes{},
+		"SyncDeletion":              Deletion{},
+		"Error":                     Error{},
+		"ErrorDetail":               ErrorDetail{},
+	} {
+		s, ok := spec.Components.Schemas[schema]
+		if !ok {
+			t.Errorf("schema %s not found in the spec", schema)
+			continue
+		}
+		fields := jsonFields(reflect.TypeOf(v))
+		for name := range s.Properties {
+			if name != "$schema" && !fields[name] {
+				t.Errorf("%T lacks the field %s of %s", v, name, schema)
+			}
+		}
+		for name := range fields {
+			if _, ok := s.Properties[name]; !ok {
+				t.Errorf("%T has the field %s, which %s doesn't have", v, name, schema)
+			}
+		}
+	}
+}
+
+// jsonFields returns the JSON names of the fields of a struct, including embedded ones
+func jsonFields(typ reflect.Type) map[string]bool {
+	fields := map[string]bool{}
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		if f.Anonymous {
+			for name := range jsonFields(f.Type) {
+				fields[name] = true
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxErrorBody caps how much of an error response is read
+const maxErrorBody = 1 << 20
+
+// Error is an error response of the API, in the problem details format (RFC 7807)
+type Error struct {
+	Type     string        `json:"type,omitempty"`
+	Title    string        `json:"title"`
+	Status   int           `json:"status"`
+	Detail   string        `json:"detail"`
+	Instance string        `json:"instance,omitempty"`
+	Code     string        `json:"code"` // Stable error code, e.g. experience_not_found; see the error reference
+	Errors   []ErrorDetail `json:"errors,omitempty"`
+}
+
+// ErrorDetail is a detail of a validation error
+type ErrorDetail struct {
+	Message  string `json:"message"`
+	Location string `json:"location"` // Where the error occurred, e.g. body.field_type
+	Value    any    `json:"value,omitempty"`
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("hub: %d %s", e.Status, e.Code)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	for _, d := range e.Errors {
+		msg += fmt.Sprintf("; %s: %s", d.Location, d.Message)
+	}
+	return msg
+}
+
+// ErrorCode returns the code of an API error, or an empty string if err isn't one
+func ErrorCode(err error) string {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+	return ""
+}
+
+// IsNotFound reports whether err is a 404 response
+func IsNotFound(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.Status == http.StatusNotFound
+}
+
+// readError reads an error response. Responses that aren't problem details, e.g. of a
+// proxy, keep their status with a generic code.
+func readError(resp *http.Response) *Error {
+	defer func() { _ = resp.Body.Close() }()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+
+	apiErr := &Error{}
+	if json.Unmarshal(body, apiErr) != nil || apiErr.Status == 0 {
+		apiErr = &Error{Title: http.StatusText(resp.StatusCode), Detail: string(body)}
+	}
+	apiErr.Status = resp.StatusCode
+	if apiErr.Code == "" {
+		apiErr.Code = statusCode(resp.StatusCode)
+	}
+	return apiErr
+}
+
+// statusCode returns the generic error code of an HTTP status, like the API
+func statusCode(status int) string {
+	switch status {
+	case http.StatusUnauthorized:
+		return "unauthorized"
+	case http.StatusForbidden:
+		return "forbidden"
+	case http.StatusNotFound:
+		return "not_found"
+	case http.StatusConflict:
+		return "conflict"
+	case http.StatusTooManyRequests:
+		return "rate_limited"
+	case http.StatusServiceUnavailable:
+		return "service_unavailable"
+	case http.StatusGatewayTimeout:
+		return "timeout"
+	}
+	if status >= 500 {
+		return "internal_error"
+	}
+	return "bad_request"
+}
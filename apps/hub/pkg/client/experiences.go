@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Experience is an experience data record: a single response to a question
+type Experience struct {
+	ID             uuid.UUID              `json:"id"`
+	CollectedAt    time.Time              `json:"collected_at"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+	SourceType     string                 `json:"source_type"`
+	SourceID       *string                `json:"source_id,omitempty"`
+	SourceName     *string                `json:"source_name,omitempty"`
+	FieldID        string                 `json:"field_id"`
+	FieldLabel     *string                `json:"field_label,omitempty"`
+	QuestionID     *uuid.UUID             `json:"question_id,omitempty"`
+	FieldType      string                 `json:"field_type"`
+	ValueText      *string                `json:"value_text,omitempty"`
+	ValueNumber    *float64               `json:"value_number,omitempty"`
+	ValueBoolean   *bool                  `json:"value_boolean,omitempty"`
+	ValueDate      *time.Time             `json:"value_date,omitempty"`
+	ValueJSON      map[string]any         `json:"value_json,omitempty"`
+	NPSCategory    *string                `json:"nps_category,omitempty"` // promoter, passive, or detractor
+	Metadata       map[string]any         `json:"metadata,omitempty"`
+	Country        *string                `json:"country,omitempty"`
+	Region         *string                `json:"region,omitempty"`
+	Device         *string                `json:"device,omitempty"`
+	Platform       *string                `json:"platform,omitempty"`
+	AppVersion     *string                `json:"app_version,omitempty"`
+	Language       *string                `json:"language,omitempty"`
+	Translations   map[string]Translation `json:"translations,omitempty"` // By ISO language code
+	UserIdentifier *string                `json:"user_identifier,omitempty"`
+	ContentHash    *string                `json:"content_hash,omitempty"`
+	DuplicateOf    *uuid.UUID             `json:"duplicate_of,omitempty"`
+
+	// AI enrichment, set once the experience is processed
+	SkipAIProcessing     bool           `json:"skip_ai_processing,omitempty"`
+	Sentiment            *string        `json:"sentiment,omitempty"`
+	SentimentScore       *float64       `json:"sentiment_score,omitempty"`
+	Emotion              *string        `json:"emotion,omitempty"`
+	Topics               []string       `json:"topics,omitempty"`
+	IsSpam               *bool          `json:"is_spam,omitempty"`
+	SpamConfidence       *float64       `json:"spam_confidence,omitempty"`
+	UrgencyScore         *float64       `json:"urgency_score,omitempty"`
+	UrgencyReasons       []string       `json:"urgency_reasons,omitempty"`
+	EnrichmentProvider   *string        `json:"enrichment_provider,omitempty"`
+	EnrichmentModel      *string        `json:"enrichment_model,omitempty"`
+	EnrichmentVersion    *int           `json:"enrichment_version,omitempty"`
+	EnrichmentAttributes map[string]any `json:"enrichment_attributes,omitempty"`
+}
+
+// Translation is a machine translation of an experience
+type Translation struct {
+	ValueText    string    `json:"value_text,omitempty"`
+	FieldLabel   string    `json:"field_label,omitempty"`
+	Provider     string    `json:"provider"`
+	Model        string    `json:"model"`
+	TranslatedAt time.Time `json:"translated_at"`
+}
+
+// CreateExperienceParams are the fields of a new experience
+type CreateExperienceParams struct {
+	// IdempotencyKey identifies the experience, e.g. the ID of the response in the source
+	// system. A request with the key of an earlier one returns the experience it created.
+	// A random key is generated if it is empty, which makes retries of this call safe.
+	IdempotencyKey string `json:"-"`
+
+	SourceType       string         `json:"source_type"`
+	SourceID         *string        `json:"source_id,omitempty"`
+	SourceName       *string        `json:"source_name,omitempty"`
+	FieldID          string         `json:"field_id"`
+	FieldLabel       *string        `json:"field_label,omitempty"`
+	FieldType        string         `json:"field_type"` // text, categorical, nps, csat, rating, number, boolean, or date
+	ValueText        *string        `json:"value_text,omitempty"`
+	ValueNumber      *float64       `json:"value_number,omitempty"`
+	ValueBoolean     *bool          `json:"value_boolean,omitempty"`
+	ValueDate        *time.Time     `json:"value_date,omitempty"`
+	ValueJSON        map[string]any `json:"value_json,omitempty"`
+	CollectedAt      *time.Time     `json:"collected_at,omitempty"` // Defaults to now
+	Metadata         map[string]any `json:"metadata,omitempty"`
+	Language         *string        `json:"language,omitempty"`
+	UserIdentifier   *string        `json:"user_identifier,omitempty"`
+	SkipAIProcessing bool           `json:"skip_ai_processing,omitempty"`
+	AIPriority       string         `json:"ai_priority,omitempty"` // low, normal, or high
+}
+
+// UpdateExperienceParams are the fields to change; nil fields are left as they are
+type UpdateExperienceParams struct {
+	ValueText      *string        `json:"value_text,omitempty"`
+	ValueNumber    *float64       `json:"value_number,omitempty"`
+	ValueBoolean   *bool          `json:"value_boolean,omitempty"`
+	ValueDate      *time.Time     `json:"value_date,omitempty"`
+	ValueJSON      map[string]any `json:"value_json,omitempty"`
+	Metadata       map[string]any `json:"metadata,omitempty"`
+	Language       *string        `json:"language,omitempty"`
+	UserIdentifier *string        `json:"user_identifier,omitempty"`
+}
+
+// ListExperiencesParams filters the experiences. Empty fields don't filter.
+type ListExperiencesParams struct {
+	SourceType     string
+	SourceID       string
+	FieldType      string
+	QuestionID     string
+	UserIdentifier string
+	ContentHash    string
+	Duplicate      *bool
+	Country        string
+	Region         string
+	Device         string
+	Platform       string
+	AppVersion     string
+	NPSCategory    string
+	IsSpam         *bool
+	MinUrgency     float64
+	UrgencyReason  string
+	Since          time.Time // Collected at or after
+	Until          time.Time // Collected at or before
+	SegmentID      string    // Only experiences of the saved segment
+
+	Limit  int // Page size, at most 1000; the API's default of 100 if 0
+	Offset int
+}
+
+// query returns the query parameters of the listing
+func (p ListExperiencesParams) query() url.Values {
+	q := url.Values{}
+	set := func(key, value string) { setParam(q, key, value) }
+	set("source_type", p.SourceType)
+	set("source_id", p.SourceID)
+	set("field_type", p.FieldType)
+	set("question_id", p.QuestionID)
+	set("user_identifier", p.UserIdentifier)
+	set("content_hash", p.ContentHash)
+	set("duplicate", formatBool(p.Duplicate))
+	set("country", p.Country)
+	set("region", p.Region)
+	set("device", p.Device)
+	set("platform", p.Platform)
+	set("app_version", p.AppVersion)
+	set("nps_category", p.NPSCategory)
+	set("is_spam", formatBool(p.IsSpam))
+	if p.MinUrgency > 0 {
+		q.Set("min_urgency", strconv.FormatFloat(p.MinUrgency, 'f', -1, 64))
+	}
+	set("urgency_reason", p.UrgencyReason)
+	set("since", formatTime(p.Since))
+	set("until", formatTime(p.Until))
+	set("segment_id", p.SegmentID)
+	setPage(q, p.Limit, p.Offset)
+	return q
+}
+
+// SearchResult is an experience found by semantic search
+type SearchResult struct {
+	Experience
+	SimilarityScore float64 `json:"similarity_score"` // 0 to 1, higher is more similar
+}
+
+// SearchParams are the query and filters of a semantic search
+type SearchParams struct {
+	Query      string
+	Limit      int // At most 100; the API's default of 10 if 0
+	SourceType string
+	Since      time.Time
+	Until      time.Time
+}
+
+// CreateExperience creates an experience
+func (c *Client) CreateExperience(ctx context.Context, params CreateExperienceParams) (*Experience, error) {
+	key := params.IdempotencyKey
+	if key == "" {
+		key = uuid.NewString()
+	}
+	var exp Experience
+	err := c.do(ctx, request{method: http.MethodPost, path: "/v1/experiences", body: params, idempotencyKey: key}, &exp)
+	if err != nil {
+		return nil, err
+	}
+	return &exp, nil
+}
+
+// GetExperience returns an experience
+func (c *Client) GetExperience(ctx context.Context, id uuid.UUID) (*Experience, error) {
+	var exp Experience
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/experiences/" + id.String()}, &exp); err != nil {
+		return nil, err
+	}
+	return &exp, nil
+}
+
+// UpdateExperience changes the fields of an experience that are set in params
+func (c *Client) UpdateExperience(ctx context.Context, id uuid.UUID, params UpdateExperienceParams) (*Experience, error) {
+	var exp Experience
+	if err := c.do(ctx, request{method: http.MethodPatch, path: "/v1/experiences/" + id.String(), body: params}, &exp); err != nil {
+		return nil, err
+	}
+	return &exp, nil
+}
+
+// DeleteExperience deletes an experience
+func (c *Client) DeleteExperience(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/v1/experiences/" + id.String()}, nil)
+}
+
+// ListExperiences returns a page of experiences, most recently collected first
+func (c *Client) ListExperiences(ctx context.Context, params ListExperiencesParams) (*Page[Experience], error) {
+	var page Page[Experience]
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/experiences", query: params.query()}, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Experiences iterates over all experiences matching the filters, reading a page at a time
+// from params.Offset. Iteration stops at the first error, which is yielded.
+func (c *Client) Experiences(ctx context.Context, params ListExperiencesParams) iter.Seq2[Experience, error] {
+	return paginate(params.Offset, func(offset int) (*Page[Experience], error) {
+		params.Offset = offset
+		return c.ListExperiences(ctx, params)
+	})
+}
+
+// SearchExperiences returns the text experiences most similar to the query. It needs
+// embeddings to be enabled on the Hub.
+func (c *Client) SearchExperiences(ctx context.Context, params SearchParams) ([]SearchResult, error) {
+	q := url.Values{"query": {params.Query}}
+	setPage(q, params.Limit, 0)
+	setParam(q, "source_type", params.SourceType)
+	setParam(q, "since", formatTime(params.Since))
+	setParam(q, "until", formatTime(params.Until))
+	var out struct {
+		Results []SearchResult `json:"results"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/experiences/search", query: q}, &out); err != nil {
+		return nil, err
+	}
+	return out.Results, nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Page is a page of a listing
+type Page[T any] struct {
+	Data   []T `json:"data"`
+	Total  int `json:"total"` // Number of items matching the filters, on all pages
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// paginate iterates over the items of the pages that list returns, from offset on, until a
+// page is empty or the total is reached
+func paginate[T any](offset int, list func(offset int) (*Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			page, err := list(offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			offset += len(page.Data)
+			if len(page.Data) == 0 || offset >= page.Total {
+				return
+			}
+		}
+	}
+}
+
+// setPage sets the limit and offset of a listing, leaving the API's defaults for zero values
+func setPage(q url.Values, limit, offset int) {
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+	if offset > 0 {
+		q.Set("offset", strconv.Itoa(offset))
+	}
+}
+
+// setParam sets a query parameter unless the value is empty
+func setParam(q url.Values, key, value string) {
+	if value != "" {
+		q.Set(key, value)
+	}
+}
+
+// formatBool formats an optional boolean filter, empty if it is nil
+func formatBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+// formatTime formats a time filter, empty if it is zero
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
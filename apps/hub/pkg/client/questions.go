@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Question is a question of the question bank, which experiences reference by question_id
+type Question struct {
+	ID         uuid.UUID         `json:"id"`
+	SourceType string            `json:"source_type"`
+	SourceID   string            `json:"source_id"`
+	FieldID    string            `json:"field_id"`
+	FieldType  string            `json:"field_type,omitempty"`
+	Label      string            `json:"label,omitempty"`
+	Labels     map[string]string `json:"labels"` // By ISO language code
+	Metadata   map[string]any    `json:"metadata,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at"`
+}
+
+// CreateQuestionParams are the fields of a new question
+type CreateQuestionParams struct {
+	SourceType string            `json:"source_type"`
+	SourceID   string            `json:"source_id,omitempty"`
+	FieldID    string            `json:"field_id"`
+	FieldType  string            `json:"field_type,omitempty"`
+	Label      string            `json:"label,omitempty"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Metadata   map[string]any    `json:"metadata,omitempty"`
+}
+
+// UpdateQuestionParams are the fields to change; nil fields are left as they are
+type UpdateQuestionParams struct {
+	Label    *string            `json:"label,omitempty"`
+	Labels   *map[string]string `json:"labels,omitempty"`   // Replaces all labels
+	Metadata *map[string]any    `json:"metadata,omitempty"` // Replaces the metadata
+}
+
+// ListQuestionsParams filters the questions. Empty fields don't filter.
+type ListQuestionsParams struct {
+	SourceType string
+	SourceID   string
+	FieldID    string
+
+	Limit  int // Page size, at most 1000; the API's default of 100 if 0
+	Offset int
+}
+
+// CreateQuestion adds a question to the question bank. Creating a question that exists
+// fails with the code already_exists.
+func (c *Client) CreateQuestion(ctx context.Context, params CreateQuestionParams) (*Question, error) {
+	var q Question
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/v1/questions", body: params}, &q); err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// GetQuestion returns a question
+func (c *Client) GetQuestion(ctx context.Context, id uuid.UUID) (*Question, error) {
+	var q Question
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/questions/" + id.String()}, &q); err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// UpdateQuestion changes the fields of a question that are set in params
+func (c *Client) UpdateQuestion(ctx context.Context, id uuid.UUID, params UpdateQuestionParams) (*Question, error) {
+	var q Question
+	if err := c.do(ctx, request{method: http.MethodPatch, path: "/v1/questions/" + id.String(), body: params}, &q); err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// DeleteQuestion deletes a question; its experiences are kept
+func (c *Client) DeleteQuestion(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/v1/questions/" + id.String()}, nil)
+}
+
+// ListQuestions returns a page of questions, oldest first
+func (c *Client) ListQuestions(ctx context.Context, params ListQuestionsParams) (*Page[Question], error) {
+	q := url.Values{}
+	setParam(q, "source_type", params.SourceType)
+	setParam(q, "source_id", params.SourceID)
+	setParam(q, "field_id", params.FieldID)
+	setPage(q, params.Limit, params.Offset)
+
+	var page Page[Question]
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/questions", query: q}, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Questions iterates over all questions matching the filters, reading a page at a time from
+// params.Offset. Iteration stops at the first error, which is yielded.
+func (c *Client) Questions(ctx context.Context, params ListQuestionsParams) iter.Seq2[Question, error] {
+	return paginate(params.Offset, func(offset int) (*Page[Question], error) {
+		params.Offset = offset
+		return c.ListQuestions(ctx, params)
+	})
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Changes is a page of the experiences changed and deleted since a sync state
+type Changes struct {
+	Records   []Experience `json:"records"`   // Upsert them by ID
+	Deletions []Deletion   `json:"deletions"` // Delete them by ID after upserting the records
+	State     string       `json:"state"`     // Store once the page is written and pass to the next read
+	HasMore   bool         `json:"has_more"`  // Whether to read again right away
+}
+
+// Deletion is an experience deleted since the previous page
+type Deletion struct {
+	ID        uuid.UUID `json:"id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// SyncChanges returns the experiences changed and deleted since the state of the previous
+// page, or from the first experience if state is empty. limit caps the records and the
+// deletions of the page, the API's default of 500 if it is 0.
+func (c *Client) SyncChanges(ctx context.Context, state string, limit int) (*Changes, error) {
+	q := url.Values{}
+	setParam(q, "state", state)
+	setPage(q, limit, 0)
+
+	var changes Changes
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/sync/changes", query: q}, &changes); err != nil {
+		return nil, err
+	}
+	return &changes, nil
+}
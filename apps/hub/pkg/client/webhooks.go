@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook is a webhook endpoint that events are sent to
+type Webhook struct {
+	ID           uuid.UUID   `json:"id"`
+	URL          string      `json:"url"`
+	Secret       string      `json:"secret,omitempty"` // Only returned when the endpoint is created
+	EventTypes   []string    `json:"event_types"`      // Empty for all events
+	Conditions   []Condition `json:"conditions"`       // Empty for all events
+	Enabled      bool        `json:"enabled"`
+	Format       string      `json:"format"` // cloudevents or slack
+	SlackChannel string      `json:"slack_channel,omitempty"`
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// Condition is a condition on the event data that must match for an event to be sent
+type Condition struct {
+	Field    string `json:"field"`    // Dot-separated path, e.g. sentiment or metadata.country
+	Operator string `json:"operator"` // eq, ne, in, not_in, gt, gte, lt, lte, contains, or exists
+	Value    any    `json:"value"`
+}
+
+// CreateWebhookParams are the fields of a new webhook endpoint
+type CreateWebhookParams struct {
+	URL          string      `json:"url,omitempty"`
+	Secret       string      `json:"secret,omitempty"` // Generated if empty
+	EventTypes   []string    `json:"event_types,omitempty"`
+	Conditions   []Condition `json:"conditions,omitempty"`
+	Enabled      *bool       `json:"enabled,omitempty"` // Defaults to true
+	Format       string      `json:"format,omitempty"`
+	SlackToken   string      `json:"slack_token,omitempty"`
+	SlackChannel string      `json:"slack_channel,omitempty"`
+}
+
+// UpdateWebhookParams are the fields to change; nil fields are left as they are
+type UpdateWebhookParams struct {
+	URL          *string      `json:"url,omitempty"`
+	Secret       *string      `json:"secret,omitempty"`
+	EventTypes   *[]string    `json:"event_types,omitempty"`
+	Conditions   *[]Condition `json:"conditions,omitempty"`
+	Enabled      *bool        `json:"enabled,omitempty"`
+	Format       *string      `json:"format,omitempty"`
+	SlackToken   *string      `json:"slack_token,omitempty"`
+	SlackChannel *string      `json:"slack_channel,omitempty"`
+}
+
+// CreateWebhook creates a webhook endpoint. The returned endpoint has the secret that
+// signs its payloads, which isn't returned again.
+func (c *Client) CreateWebhook(ctx context.Context, params CreateWebhookParams) (*Webhook, error) {
+	var w Webhook
+	if err := c.do(ctx, request{method: http.MethodPost, path: "/v1/webhooks", body: params}, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// GetWebhook returns a webhook endpoint, without its secret
+func (c *Client) GetWebhook(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	var w Webhook
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/webhooks/" + id.String()}, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// UpdateWebhook changes the fields of a webhook endpoint that are set in params
+func (c *Client) UpdateWebhook(ctx context.Context, id uuid.UUID, params UpdateWebhookParams) (*Webhook, error) {
+	var w Webhook
+	if err := c.do(ctx, request{method: http.MethodPatch, path: "/v1/webhooks/" + id.String(), body: params}, &w); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// DeleteWebhook deletes a webhook endpoint
+func (c *Client) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	return c.do(ctx, request{method: http.MethodDelete, path: "/v1/webhooks/" + id.String()}, nil)
+}
+
+// ListWebhooks returns all webhook endpoints, oldest first, without their secrets
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	var out struct {
+		Data []Webhook `json:"data"`
+	}
+	if err := c.do(ctx, request{method: http.MethodGet, path: "/v1/webhooks"}, &out); err != nil {
+		return nil, err
+	}
+	return out.Data, nil
+}